@@ -48,6 +48,9 @@ const (
 	MapperKind_FIND_MALFORMED_TAGS MapperKind = 2
 	// Exports all tags into a BigQuery table 'exported_tags'.
 	MapperKind_EXPORT_TAGS_TO_BQ MapperKind = 3
+	// Exports components found by the SBOM extractor into a BigQuery table
+	// 'exported_sboms'.
+	MapperKind_EXPORT_SBOMS_TO_BQ MapperKind = 4
 )
 
 // Enum value maps for MapperKind.
@@ -57,12 +60,14 @@ var (
 		1: "ENUMERATE_PACKAGES",
 		2: "FIND_MALFORMED_TAGS",
 		3: "EXPORT_TAGS_TO_BQ",
+		4: "EXPORT_SBOMS_TO_BQ",
 	}
 	MapperKind_value = map[string]int32{
 		"MAPPER_KIND_UNSPECIFIED": 0,
 		"ENUMERATE_PACKAGES":      1,
 		"FIND_MALFORMED_TAGS":     2,
 		"EXPORT_TAGS_TO_BQ":       3,
+		"EXPORT_SBOMS_TO_BQ":      4,
 	}
 )
 
@@ -405,30 +410,31 @@ var file_go_chromium_org_luci_cipd_api_admin_v1_admin_proto_rawDesc = string([]b
 	0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
 	0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x78, 0x65,
 	0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x54, 0x61, 0x67, 0x2a, 0x71, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4b,
-	0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x49,
-	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41,
-	0x43, 0x4b, 0x41, 0x47, 0x45, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4e, 0x44,
-	0x5f, 0x4d, 0x41, 0x4c, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x54, 0x41, 0x47, 0x53, 0x10,
-	0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41, 0x47, 0x53,
-	0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x51, 0x10, 0x03, 0x32, 0xc1, 0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12,
-	0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x1a, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2f, 0x0a,
-	0x08, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64,
-	0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x46, 0x69,
-	0x78, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x54, 0x61, 0x67, 0x46, 0x69, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2c, 0x5a, 0x2a,
-	0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f,
-	0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x64, 0x54, 0x61, 0x67, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x50, 0x50, 0x45, 0x52, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x50,
+	0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x49, 0x4e,
+	0x44, 0x5f, 0x4d, 0x41, 0x4c, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x44, 0x5f, 0x54, 0x41, 0x47, 0x53,
+	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x41, 0x47,
+	0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x51, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x42, 0x4f, 0x4d, 0x53, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x51, 0x10,
+	0x04, 0x32, 0xc1, 0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x4c,
+	0x61, 0x75, 0x6e, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x4a, 0x6f, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x08, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4a,
+	0x6f, 0x62, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0d, 0x46, 0x69, 0x78, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x64,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x1a, 0x12, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x54, 0x61, 0x67, 0x46, 0x69, 0x78, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69,
+	0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -12,471 +12,261 @@ func init() {
 			"cipd.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 123, 207, 115, 219, 200,
-			242, 31, 1, 144, 20, 61, 178, 189, 18, 252, 75, 166, 215, 114,
-			63, 174, 101, 145, 54, 69, 74, 178, 45, 91, 242, 122, 119, 41,
-			137, 146, 224, 39, 145, 90, 146, 178, 159, 189, 245, 202, 6, 129,
-			33, 57, 107, 16, 195, 7, 128, 146, 181, 142, 111, 47, 85, 57,
-			125, 243, 23, 164, 82, 201, 49, 85, 169, 92, 146, 67, 42, 85,
-			169, 122, 73, 110, 201, 57, 135, 220, 243, 63, 228, 144, 170, 111,
-			170, 103, 0, 144, 178, 101, 123, 119, 191, 249, 222, 172, 178, 202,
-			104, 76, 79, 247, 116, 207, 244, 244, 167, 103, 32, 242, 247, 211,
-			228, 90, 151, 243, 174, 67, 203, 3, 143, 7, 188, 61, 236, 148,
-			105, 127, 16, 28, 151, 4, 169, 127, 37, 27, 75, 81, 99, 110,
-			130, 164, 170, 216, 190, 254, 142, 92, 176, 120, 191, 244, 94, 251,
-			58, 17, 173, 251, 72, 238, 43, 47, 162, 230, 46, 119, 76, 183,
-			91, 226, 94, 119, 164, 38, 56, 30, 80, 191, 252, 218, 229, 71,
-			174, 84, 57, 104, 255, 31, 69, 249, 215, 170, 182, 189, 191, 254,
-			111, 212, 217, 109, 217, 115, 63, 100, 47, 61, 163, 142, 243, 71,
-			100, 110, 97, 191, 39, 255, 121, 138, 164, 245, 228, 108, 98, 121,
-			138, 252, 143, 179, 68, 57, 171, 107, 179, 9, 125, 249, 111, 103,
-			65, 116, 176, 184, 3, 235, 195, 78, 135, 122, 62, 44, 128, 20,
-			53, 239, 131, 109, 6, 38, 48, 55, 160, 158, 213, 51, 221, 46,
-			133, 14, 247, 250, 102, 64, 96, 131, 15, 142, 61, 214, 237, 5,
-			176, 188, 184, 248, 48, 236, 0, 134, 107, 149, 0, 42, 142, 3,
-			162, 205, 7, 143, 250, 212, 59, 164, 118, 137, 64, 47, 8, 6,
-			254, 90, 185, 108, 211, 67, 234, 240, 1, 245, 252, 200, 86, 139,
-			247, 165, 145, 22, 119, 22, 218, 114, 16, 101, 66, 160, 65, 109,
-			230, 7, 30, 107, 15, 3, 198, 93, 48, 93, 27, 134, 62, 5,
-			230, 130, 207, 135, 158, 69, 197, 155, 54, 115, 77, 239, 88, 140,
-			203, 47, 194, 17, 11, 122, 192, 61, 241, 63, 31, 6, 4, 250,
-			220, 102, 29, 102, 153, 40, 161, 8, 166, 71, 97, 64, 189, 62,
-			11, 2, 106, 195, 192, 227, 135, 204, 166, 54, 4, 61, 51, 128,
-			160, 135, 214, 57, 14, 63, 98, 110, 23, 44, 238, 218, 12, 59,
-			249, 216, 137, 64, 159, 6, 107, 132, 0, 254, 220, 126, 111, 96,
-			62, 240, 78, 52, 34, 139, 219, 20, 250, 67, 63, 0, 143, 6,
-			38, 115, 133, 84, 179, 205, 15, 177, 41, 244, 24, 1, 151, 7,
-			204, 162, 69, 8, 122, 204, 7, 135, 249, 1, 74, 24, 215, 232,
-			218, 239, 13, 199, 102, 190, 229, 152, 172, 79, 189, 210, 199, 6,
-			193, 220, 113, 95, 68, 131, 24, 120, 220, 30, 90, 116, 52, 14,
-			50, 26, 200, 63, 104, 28, 4, 66, 235, 108, 110, 13, 251, 212,
-			13, 204, 104, 146, 202, 220, 3, 30, 244, 168, 7, 125, 51, 160,
-			30, 51, 29, 127, 228, 106, 49, 65, 65, 143, 18, 24, 31, 125,
-			108, 84, 141, 50, 209, 19, 5, 187, 102, 159, 226, 128, 198, 215,
-			150, 203, 71, 109, 194, 239, 44, 240, 209, 34, 87, 138, 226, 158,
-			15, 125, 243, 24, 218, 20, 87, 138, 13, 1, 7, 234, 218, 220,
-			243, 41, 46, 138, 129, 199, 251, 60, 160, 32, 125, 18, 248, 96,
-			83, 143, 29, 82, 27, 58, 30, 239, 19, 233, 5, 159, 119, 130,
-			35, 92, 38, 225, 10, 2, 127, 64, 45, 92, 65, 48, 240, 24,
-			46, 44, 15, 215, 142, 43, 87, 145, 239, 139, 177, 19, 104, 237,
-			24, 77, 104, 214, 183, 90, 207, 42, 141, 42, 24, 77, 216, 111,
-			212, 159, 26, 155, 213, 77, 88, 127, 14, 173, 157, 42, 108, 212,
-			247, 159, 55, 140, 237, 157, 22, 236, 212, 119, 55, 171, 141, 38,
-			84, 106, 155, 176, 81, 175, 181, 26, 198, 250, 65, 171, 222, 104,
-			18, 200, 85, 154, 96, 52, 115, 162, 165, 82, 123, 14, 213, 63,
-			237, 55, 170, 205, 38, 212, 27, 96, 236, 237, 239, 26, 213, 77,
-			120, 86, 105, 52, 42, 181, 150, 81, 109, 22, 193, 168, 109, 236,
-			30, 108, 26, 181, 237, 34, 172, 31, 180, 160, 86, 111, 17, 216,
-			53, 246, 140, 86, 117, 19, 90, 245, 162, 80, 251, 97, 63, 168,
-			111, 193, 94, 181, 177, 177, 83, 169, 181, 42, 235, 198, 174, 209,
-			122, 46, 20, 110, 25, 173, 26, 42, 219, 170, 55, 8, 84, 96,
-			191, 210, 104, 25, 27, 7, 187, 149, 6, 236, 31, 52, 246, 235,
-			205, 42, 160, 101, 155, 70, 115, 99, 183, 98, 236, 85, 55, 75,
-			96, 212, 160, 86, 135, 234, 211, 106, 173, 5, 205, 157, 202, 238,
-			238, 73, 67, 9, 212, 159, 213, 170, 13, 28, 253, 184, 153, 176,
-			94, 133, 93, 163, 178, 190, 91, 69, 85, 194, 206, 77, 163, 81,
-			221, 104, 161, 65, 163, 167, 13, 99, 179, 90, 107, 85, 118, 139,
-			4, 154, 251, 213, 13, 163, 178, 91, 132, 234, 159, 170, 123, 251,
-			187, 149, 198, 243, 98, 40, 180, 89, 253, 241, 160, 90, 107, 25,
-			149, 93, 216, 172, 236, 85, 182, 171, 77, 200, 127, 206, 43, 251,
-			141, 250, 198, 65, 163, 186, 135, 163, 174, 111, 65, 243, 96, 189,
-			217, 50, 90, 7, 173, 42, 108, 215, 235, 155, 194, 217, 205, 106,
-			227, 169, 177, 81, 109, 62, 130, 221, 122, 83, 56, 236, 160, 89,
-			45, 18, 216, 172, 180, 42, 66, 245, 126, 163, 190, 101, 180, 154,
-			143, 240, 121, 253, 160, 105, 8, 199, 25, 181, 86, 181, 209, 56,
-			216, 111, 25, 245, 90, 1, 118, 234, 207, 170, 79, 171, 13, 216,
-			168, 28, 52, 171, 155, 194, 195, 245, 26, 90, 139, 107, 165, 90,
-			111, 60, 71, 177, 232, 7, 49, 3, 69, 120, 182, 83, 109, 237,
-			84, 27, 232, 84, 225, 173, 10, 186, 161, 217, 106, 24, 27, 173,
-			113, 182, 122, 3, 90, 245, 70, 139, 140, 217, 9, 181, 234, 246,
-			174, 177, 93, 173, 109, 84, 177, 185, 142, 98, 158, 25, 205, 106,
-			1, 42, 13, 163, 137, 12, 134, 80, 12, 207, 42, 207, 161, 126,
-			32, 172, 198, 137, 58, 104, 86, 137, 124, 30, 91, 186, 69, 49,
-			159, 96, 108, 65, 101, 243, 169, 129, 35, 15, 185, 247, 235, 205,
-			166, 17, 46, 23, 225, 182, 141, 157, 208, 231, 37, 66, 50, 68,
-			81, 117, 13, 18, 51, 248, 148, 209, 181, 92, 162, 74, 206, 16,
-			53, 51, 41, 31, 229, 203, 111, 18, 69, 241, 82, 145, 143, 242,
-			229, 205, 196, 29, 241, 50, 124, 148, 47, 231, 18, 57, 241, 146,
-			200, 71, 249, 242, 86, 226, 15, 226, 229, 77, 249, 40, 95, 206,
-			39, 30, 137, 151, 115, 242, 81, 190, 204, 39, 110, 136, 151, 55,
-			228, 227, 127, 82, 137, 154, 76, 232, 218, 114, 98, 42, 251, 239,
-			84, 168, 64, 151, 186, 212, 99, 22, 136, 12, 10, 125, 234, 251,
-			102, 151, 202, 20, 112, 204, 135, 96, 153, 46, 120, 116, 1, 19,
-			77, 192, 193, 60, 228, 204, 6, 155, 118, 152, 43, 182, 191, 225,
-			192, 193, 100, 66, 109, 114, 178, 191, 216, 126, 143, 249, 208, 131,
-			202, 190, 225, 151, 160, 2, 193, 241, 128, 89, 166, 3, 244, 141,
-			217, 31, 56, 20, 152, 143, 242, 68, 254, 10, 192, 244, 197, 46,
-			230, 209, 191, 12, 169, 31, 16, 8, 119, 53, 143, 250, 3, 238,
-			162, 230, 227, 129, 216, 250, 76, 23, 229, 97, 242, 233, 113, 187,
-			4, 91, 220, 3, 230, 250, 129, 233, 90, 52, 202, 70, 152, 95,
-			153, 69, 97, 139, 115, 120, 43, 95, 1, 120, 3, 11, 214, 77,
-			47, 255, 30, 214, 40, 9, 168, 81, 192, 220, 52, 244, 92, 31,
-			62, 210, 254, 72, 138, 121, 71, 240, 71, 75, 38, 20, 93, 91,
-			206, 156, 107, 167, 5, 219, 93, 242, 95, 151, 200, 141, 247, 17,
-			80, 192, 250, 212, 15, 204, 254, 224, 99, 40, 232, 17, 57, 211,
-			138, 120, 244, 25, 50, 225, 83, 204, 51, 254, 140, 2, 74, 94,
-			107, 68, 164, 126, 145, 164, 92, 211, 229, 254, 140, 10, 74, 62,
-			213, 144, 196, 250, 63, 85, 78, 135, 78, 231, 99, 145, 17, 124,
-			90, 254, 149, 240, 41, 30, 239, 111, 130, 80, 127, 43, 147, 9,
-			61, 53, 155, 248, 231, 138, 242, 5, 67, 125, 193, 80, 95, 48,
-			212, 23, 12, 245, 5, 67, 125, 193, 80, 255, 56, 24, 42, 70,
-			49, 248, 24, 97, 40, 35, 2, 86, 248, 24, 97, 168, 24, 88,
-			221, 140, 129, 213, 92, 162, 28, 1, 43, 124, 140, 48, 84, 12,
-			172, 110, 197, 192, 106, 126, 4, 172, 230, 99, 96, 149, 31, 1,
-			43, 124, 252, 95, 215, 5, 134, 74, 255, 85, 193, 212, 151, 253,
-			239, 215, 161, 2, 113, 234, 21, 27, 36, 245, 169, 27, 248, 96,
-			194, 128, 51, 55, 16, 219, 26, 235, 99, 154, 177, 233, 128, 186,
-			54, 117, 3, 9, 103, 142, 229, 251, 95, 184, 43, 118, 19, 135,
-			91, 166, 67, 192, 50, 29, 234, 218, 166, 87, 4, 234, 226, 238,
-			111, 35, 64, 50, 193, 226, 67, 217, 47, 68, 7, 98, 47, 237,
-			120, 166, 53, 202, 24, 81, 3, 38, 4, 132, 10, 130, 198, 140,
-			201, 29, 185, 41, 66, 171, 71, 67, 65, 12, 83, 169, 99, 6,
-			236, 80, 66, 59, 23, 232, 128, 91, 61, 48, 3, 56, 104, 109,
-			64, 159, 217, 174, 216, 209, 185, 75, 224, 137, 233, 14, 49, 13,
-			44, 21, 97, 105, 245, 193, 98, 49, 218, 168, 7, 30, 119, 232,
-			32, 96, 22, 108, 123, 180, 203, 61, 102, 186, 241, 232, 225, 168,
-			199, 172, 30, 208, 55, 1, 197, 49, 137, 13, 250, 20, 174, 182,
-			105, 189, 62, 50, 61, 91, 224, 193, 99, 106, 122, 192, 93, 138,
-			27, 32, 166, 252, 62, 115, 135, 1, 21, 249, 18, 86, 22, 99,
-			251, 28, 238, 118, 75, 176, 75, 205, 193, 200, 100, 143, 66, 206,
-			239, 83, 211, 163, 118, 14, 124, 46, 19, 176, 203, 193, 161, 230,
-			128, 132, 108, 16, 152, 109, 137, 61, 93, 74, 209, 175, 29, 129,
-			32, 3, 234, 13, 48, 183, 202, 132, 62, 244, 49, 43, 153, 240,
-			211, 242, 189, 133, 30, 66, 88, 135, 185, 212, 244, 8, 8, 233,
-			127, 206, 127, 26, 116, 224, 124, 150, 5, 103, 65, 236, 226, 8,
-			99, 5, 202, 97, 190, 200, 9, 176, 184, 184, 184, 180, 32, 254,
-			181, 22, 23, 215, 196, 191, 23, 104, 250, 234, 234, 234, 234, 194,
-			210, 242, 194, 221, 165, 214, 242, 221, 181, 251, 171, 107, 247, 87,
-			75, 171, 209, 207, 139, 18, 172, 31, 19, 156, 200, 192, 99, 86,
-			128, 3, 12, 66, 19, 133, 244, 34, 28, 81, 160, 174, 63, 244,
-			66, 232, 126, 68, 5, 114, 183, 184, 123, 72, 189, 64, 206, 175,
-			76, 74, 240, 83, 99, 107, 131, 192, 221, 187, 119, 87, 71, 182,
-			28, 29, 29, 149, 24, 13, 58, 2, 33, 122, 29, 11, 127, 145,
-			163, 20, 188, 9, 10, 136, 216, 40, 160, 102, 183, 235, 163, 81,
-			223, 64, 85, 162, 120, 159, 144, 232, 17, 150, 214, 96, 131, 247,
-			7, 195, 128, 142, 197, 130, 80, 184, 95, 111, 26, 127, 130, 87,
-			232, 153, 124, 225, 85, 41, 132, 60, 35, 166, 24, 124, 134, 64,
-			123, 4, 158, 125, 26, 188, 12, 39, 56, 47, 186, 215, 14, 118,
-			119, 11, 133, 83, 249, 196, 122, 207, 47, 22, 30, 141, 141, 105,
-			249, 115, 99, 234, 210, 0, 165, 240, 142, 109, 30, 143, 141, 205,
-			15, 188, 161, 21, 8, 5, 135, 166, 3, 193, 97, 168, 241, 4,
-			251, 173, 224, 176, 8, 98, 64, 143, 126, 175, 73, 135, 165, 224,
-			16, 169, 79, 89, 36, 153, 134, 62, 181, 224, 54, 44, 45, 46,
-			158, 180, 240, 238, 71, 45, 124, 198, 220, 187, 203, 240, 106, 155,
-			6, 205, 99, 63, 160, 125, 108, 174, 248, 91, 204, 161, 173, 147,
-			19, 177, 101, 236, 86, 91, 198, 94, 21, 58, 65, 56, 140, 143,
-			245, 185, 213, 9, 162, 145, 30, 24, 181, 214, 202, 61, 8, 152,
-			245, 218, 135, 199, 144, 207, 231, 229, 155, 66, 39, 40, 217, 71,
-			59, 172, 219, 219, 52, 3, 209, 171, 0, 223, 126, 11, 119, 151,
-			11, 240, 79, 64, 180, 237, 242, 163, 168, 41, 242, 91, 185, 12,
-			21, 28, 175, 205, 143, 124, 33, 18, 131, 101, 105, 113, 113, 108,
-			15, 243, 75, 49, 131, 220, 165, 150, 86, 62, 12, 163, 88, 26,
-			118, 95, 90, 185, 119, 239, 222, 131, 187, 43, 139, 163, 109, 163,
-			77, 59, 220, 163, 112, 224, 178, 55, 145, 148, 213, 7, 139, 239,
-			75, 41, 253, 190, 201, 204, 75, 251, 33, 159, 151, 78, 41, 139,
-			201, 194, 159, 2, 44, 140, 15, 231, 51, 43, 24, 229, 160, 187,
-			34, 57, 115, 99, 114, 196, 2, 40, 156, 88, 0, 247, 62, 186,
-			0, 158, 152, 135, 38, 188, 146, 19, 89, 178, 134, 158, 71, 221,
-			0, 89, 246, 152, 227, 48, 127, 108, 1, 224, 110, 10, 125, 241,
-			22, 30, 195, 199, 59, 124, 98, 153, 195, 227, 209, 219, 146, 75,
-			143, 214, 135, 204, 177, 169, 151, 47, 160, 97, 205, 208, 67, 161,
-			10, 233, 152, 66, 84, 155, 3, 32, 79, 77, 218, 206, 220, 0,
-			45, 15, 57, 165, 233, 161, 217, 194, 3, 133, 82, 27, 37, 231,
-			79, 184, 224, 254, 103, 92, 96, 136, 35, 130, 160, 228, 242, 163,
-			49, 171, 195, 183, 224, 242, 35, 120, 12, 39, 120, 62, 105, 232,
-			104, 220, 159, 183, 216, 229, 71, 165, 46, 13, 170, 184, 214, 228,
-			187, 124, 97, 204, 240, 147, 198, 135, 204, 72, 228, 79, 55, 116,
-			229, 163, 134, 134, 179, 21, 161, 12, 216, 63, 14, 122, 178, 140,
-			56, 177, 204, 198, 167, 41, 95, 120, 127, 13, 110, 211, 96, 99,
-			52, 235, 249, 130, 216, 233, 159, 52, 235, 53, 216, 51, 7, 3,
-			230, 118, 9, 1, 195, 149, 111, 100, 205, 94, 20, 32, 96, 204,
-			77, 199, 3, 145, 232, 78, 192, 22, 153, 56, 66, 196, 64, 68,
-			250, 249, 77, 217, 71, 170, 66, 228, 98, 34, 104, 41, 74, 49,
-			242, 45, 42, 203, 189, 69, 212, 240, 110, 225, 109, 159, 187, 65,
-			239, 221, 194, 91, 219, 60, 126, 215, 122, 139, 169, 251, 221, 218,
-			219, 62, 115, 223, 173, 189, 245, 169, 245, 238, 167, 210, 91, 4,
-			75, 24, 176, 239, 254, 252, 34, 71, 224, 168, 71, 61, 10, 178,
-			55, 10, 50, 157, 35, 243, 216, 7, 250, 6, 241, 27, 150, 122,
-			18, 9, 116, 16, 3, 216, 172, 203, 2, 31, 33, 141, 67, 33,
-			212, 84, 4, 161, 170, 72, 64, 42, 43, 130, 208, 86, 20, 169,
-			86, 168, 20, 168, 228, 23, 234, 241, 133, 129, 105, 219, 178, 120,
-			12, 142, 120, 36, 141, 154, 86, 79, 34, 178, 8, 197, 33, 250,
-			11, 55, 148, 98, 136, 159, 48, 141, 119, 57, 12, 7, 2, 36,
-			68, 93, 243, 172, 68, 75, 225, 203, 165, 211, 177, 94, 161, 72,
-			132, 126, 62, 144, 146, 165, 166, 220, 139, 28, 248, 195, 78, 135,
-			189, 65, 52, 42, 14, 239, 228, 225, 27, 174, 3, 129, 67, 243,
-			185, 131, 214, 70, 174, 240, 232, 196, 91, 34, 225, 226, 95, 134,
-			204, 163, 118, 9, 42, 32, 15, 191, 228, 98, 240, 69, 69, 206,
-			126, 161, 30, 248, 61, 62, 116, 236, 200, 149, 67, 159, 10, 44,
-			153, 55, 253, 88, 155, 13, 237, 99, 130, 195, 40, 224, 4, 184,
-			88, 3, 187, 18, 208, 124, 184, 148, 208, 145, 230, 9, 85, 3,
-			211, 243, 71, 106, 218, 148, 128, 64, 116, 136, 111, 44, 139, 14,
-			2, 104, 243, 160, 39, 116, 98, 95, 121, 100, 16, 217, 224, 127,
-			48, 14, 4, 189, 188, 211, 241, 105, 32, 192, 218, 22, 247, 162,
-			243, 201, 34, 228, 150, 23, 151, 30, 96, 110, 88, 186, 223, 90,
-			92, 90, 187, 187, 184, 182, 116, 191, 180, 184, 244, 34, 23, 174,
-			110, 31, 4, 29, 39, 151, 129, 233, 7, 4, 4, 167, 208, 207,
-			221, 17, 106, 190, 95, 4, 148, 86, 10, 3, 200, 60, 52, 155,
-			150, 199, 6, 65, 17, 177, 238, 9, 160, 102, 2, 38, 71, 224,
-			237, 159, 169, 21, 72, 140, 135, 192, 81, 46, 118, 185, 30, 197,
-			242, 199, 221, 202, 54, 61, 155, 192, 79, 1, 55, 154, 245, 166,
-			8, 178, 124, 225, 20, 120, 90, 234, 243, 95, 152, 227, 152, 34,
-			186, 168, 187, 112, 208, 44, 219, 220, 242, 203, 207, 104, 187, 60,
-			26, 74, 185, 65, 59, 212, 163, 174, 69, 203, 219, 14, 111, 155,
-			206, 203, 186, 24, 131, 95, 198, 1, 149, 199, 148, 20, 72, 124,
-			0, 107, 68, 59, 77, 81, 196, 185, 28, 18, 188, 66, 188, 136,
-			78, 47, 69, 15, 175, 34, 131, 208, 212, 54, 141, 172, 165, 54,
-			57, 213, 68, 2, 63, 189, 242, 3, 175, 35, 186, 142, 89, 196,
-			45, 191, 52, 144, 59, 27, 218, 178, 92, 118, 88, 219, 51, 189,
-			99, 1, 186, 75, 189, 160, 239, 124, 35, 158, 162, 190, 5, 113,
-			226, 66, 226, 133, 28, 41, 241, 7, 212, 130, 249, 185, 231, 11,
-			115, 253, 133, 57, 187, 53, 183, 179, 54, 183, 183, 54, 215, 44,
-			205, 117, 94, 204, 151, 96, 151, 189, 166, 71, 204, 167, 162, 200,
-			65, 7, 141, 102, 105, 232, 83, 41, 237, 9, 183, 77, 177, 88,
-			231, 125, 248, 233, 149, 209, 172, 71, 144, 102, 75, 110, 86, 118,
-			72, 230, 11, 175, 254, 156, 151, 231, 148, 107, 229, 242, 207, 220,
-			54, 23, 228, 8, 197, 209, 94, 135, 123, 93, 90, 114, 105, 80,
-			54, 7, 76, 204, 9, 154, 133, 92, 178, 140, 144, 195, 45, 127,
-			40, 94, 152, 58, 210, 65, 160, 128, 126, 228, 109, 113, 60, 104,
-			134, 102, 6, 212, 3, 203, 28, 136, 248, 224, 29, 121, 206, 111,
-			202, 72, 139, 162, 204, 151, 187, 114, 236, 254, 18, 33, 100, 82,
-			30, 113, 39, 255, 170, 100, 166, 201, 191, 80, 72, 50, 153, 80,
-			19, 122, 242, 159, 41, 234, 197, 236, 223, 41, 208, 24, 85, 183,
-			209, 202, 231, 29, 177, 224, 133, 135, 125, 230, 90, 227, 8, 139,
-			156, 14, 177, 96, 111, 232, 7, 184, 18, 62, 85, 18, 145, 211,
-			106, 162, 23, 192, 92, 203, 25, 250, 236, 16, 139, 196, 115, 36,
-			133, 195, 75, 137, 241, 77, 68, 164, 130, 100, 230, 171, 136, 212,
-			144, 212, 47, 144, 255, 45, 141, 81, 244, 228, 223, 41, 170, 158,
-			253, 159, 10, 212, 184, 187, 224, 210, 174, 172, 129, 79, 84, 210,
-			102, 84, 49, 98, 17, 121, 122, 37, 93, 11, 59, 198, 197, 229,
-			161, 233, 12, 169, 47, 143, 35, 71, 194, 196, 161, 169, 31, 48,
-			199, 129, 158, 121, 72, 193, 29, 215, 41, 68, 135, 29, 137, 172,
-			220, 100, 113, 222, 225, 30, 22, 197, 209, 201, 193, 251, 14, 11,
-			11, 198, 98, 248, 75, 78, 113, 138, 146, 18, 118, 70, 78, 81,
-			132, 217, 153, 115, 17, 169, 33, 57, 53, 29, 223, 98, 252, 253,
-			117, 242, 93, 151, 151, 172, 158, 199, 251, 108, 216, 23, 1, 230,
-			12, 45, 86, 22, 135, 237, 94, 217, 246, 251, 230, 96, 48, 246,
-			48, 104, 151, 163, 203, 158, 240, 146, 227, 34, 242, 151, 36, 127,
-			41, 98, 203, 126, 238, 110, 36, 247, 87, 141, 156, 105, 246, 76,
-			207, 54, 220, 14, 215, 47, 146, 20, 115, 109, 250, 70, 220, 132,
-			164, 26, 146, 208, 151, 72, 202, 15, 204, 128, 138, 123, 144, 243,
-			203, 215, 74, 167, 169, 42, 53, 145, 165, 33, 57, 81, 16, 245,
-			60, 238, 205, 104, 160, 228, 207, 52, 36, 161, 223, 35, 19, 150,
-			71, 113, 251, 159, 73, 130, 146, 159, 92, 206, 190, 127, 139, 82,
-			138, 115, 80, 35, 98, 197, 94, 195, 129, 45, 122, 165, 62, 223,
-			43, 100, 213, 139, 68, 163, 129, 57, 147, 254, 108, 15, 100, 211,
-			23, 136, 62, 240, 184, 37, 144, 199, 75, 234, 6, 44, 96, 212,
-			159, 153, 16, 247, 65, 211, 113, 75, 53, 108, 208, 231, 200, 249,
-			128, 7, 166, 51, 98, 205, 8, 214, 115, 226, 109, 204, 150, 39,
-			83, 17, 195, 203, 1, 245, 16, 252, 204, 156, 1, 37, 175, 54,
-			206, 71, 239, 247, 169, 215, 164, 86, 238, 95, 106, 100, 226, 9,
-			111, 139, 73, 56, 79, 84, 102, 135, 119, 81, 42, 179, 127, 143,
-			251, 199, 28, 173, 253, 46, 71, 255, 138, 233, 121, 207, 209, 159,
-			159, 154, 79, 56, 58, 253, 235, 29, 61, 241, 107, 29, 157, 57,
-			205, 209, 250, 3, 146, 246, 113, 185, 251, 51, 23, 65, 203, 79,
-			46, 223, 248, 136, 55, 163, 144, 104, 132, 236, 183, 95, 147, 148,
-			112, 177, 126, 137, 76, 55, 91, 149, 86, 245, 229, 65, 77, 156,
-			100, 111, 25, 213, 205, 169, 132, 126, 150, 100, 154, 173, 74, 163,
-			101, 212, 182, 167, 20, 125, 146, 76, 52, 14, 106, 53, 36, 84,
-			108, 170, 172, 215, 101, 147, 134, 77, 205, 131, 141, 141, 106, 179,
-			57, 149, 212, 51, 36, 185, 85, 49, 118, 167, 82, 248, 90, 48,
-			85, 55, 167, 210, 235, 75, 47, 202, 191, 113, 59, 120, 242, 239,
-			47, 145, 180, 158, 60, 159, 168, 40, 228, 191, 37, 197, 109, 224,
-			249, 132, 190, 252, 31, 147, 39, 46, 246, 150, 30, 10, 24, 186,
-			123, 176, 97, 64, 101, 24, 244, 184, 39, 142, 148, 118, 153, 69,
-			93, 129, 185, 93, 59, 188, 171, 169, 12, 76, 11, 57, 101, 75,
-			17, 158, 82, 207, 103, 220, 133, 229, 210, 34, 228, 145, 33, 23,
-			54, 229, 176, 156, 62, 230, 67, 113, 77, 227, 242, 64, 192, 78,
-			153, 213, 16, 173, 211, 55, 2, 24, 50, 4, 89, 253, 129, 195,
-			76, 204, 80, 209, 157, 81, 36, 190, 68, 224, 121, 40, 33, 78,
-			163, 22, 31, 28, 99, 46, 24, 99, 3, 51, 8, 107, 170, 40,
-			167, 99, 237, 98, 138, 145, 74, 31, 73, 62, 191, 188, 107, 108,
-			84, 107, 205, 234, 194, 114, 105, 145, 16, 56, 112, 29, 234, 143,
-			0, 180, 128, 158, 3, 113, 119, 142, 217, 217, 49, 143, 128, 123,
-			96, 118, 61, 42, 107, 4, 230, 138, 75, 33, 230, 118, 139, 241,
-			237, 209, 216, 237, 214, 9, 55, 69, 35, 99, 254, 9, 6, 113,
-			111, 22, 223, 255, 172, 87, 154, 70, 179, 72, 224, 153, 209, 218,
-			169, 31, 180, 78, 92, 222, 136, 123, 143, 77, 163, 101, 212, 107,
-			226, 102, 162, 82, 123, 14, 127, 52, 106, 155, 69, 8, 47, 206,
-			194, 130, 8, 135, 200, 208, 129, 226, 234, 181, 73, 233, 9, 245,
-			157, 240, 22, 45, 190, 219, 114, 76, 183, 59, 52, 187, 20, 186,
-			252, 144, 122, 226, 115, 129, 209, 5, 151, 56, 187, 38, 224, 176,
-			62, 147, 199, 174, 254, 135, 22, 197, 183, 0, 83, 137, 235, 225,
-			57, 188, 158, 216, 138, 14, 252, 195, 71, 45, 161, 107, 23, 19,
-			5, 178, 76, 212, 84, 66, 79, 206, 36, 64, 201, 222, 2, 17,
-			38, 50, 141, 255, 204, 219, 56, 112, 113, 192, 46, 174, 246, 64,
-			70, 83, 73, 92, 230, 167, 18, 138, 174, 205, 164, 16, 245, 36,
-			83, 136, 115, 180, 171, 234, 12, 57, 75, 82, 41, 1, 35, 180,
-			171, 234, 133, 136, 82, 117, 237, 234, 229, 43, 33, 163, 162, 107,
-			217, 152, 81, 17, 20, 137, 40, 85, 215, 178, 49, 163, 170, 107,
-			215, 98, 70, 236, 118, 77, 61, 19, 81, 216, 22, 51, 106, 186,
-			246, 117, 204, 168, 41, 72, 69, 18, 53, 85, 215, 190, 142, 25,
-			147, 186, 118, 61, 102, 76, 42, 72, 69, 18, 147, 170, 174, 93,
-			143, 25, 83, 186, 54, 27, 51, 166, 20, 164, 210, 17, 165, 234,
-			218, 108, 204, 152, 214, 181, 27, 49, 99, 90, 65, 42, 146, 152,
-			86, 117, 237, 198, 229, 43, 228, 145, 184, 240, 72, 222, 76, 44,
-			42, 217, 50, 224, 174, 132, 112, 81, 92, 206, 182, 197, 141, 38,
-			151, 216, 175, 235, 80, 233, 223, 216, 249, 165, 209, 71, 19, 55,
-			51, 211, 164, 24, 226, 73, 109, 78, 189, 144, 187, 33, 107, 227,
-			182, 137, 129, 47, 18, 125, 20, 110, 66, 134, 176, 95, 194, 59,
-			109, 78, 157, 136, 40, 69, 215, 230, 50, 231, 34, 74, 211, 181,
-			185, 105, 29, 45, 17, 208, 78, 187, 37, 103, 12, 137, 52, 82,
-			81, 55, 156, 164, 91, 113, 55, 69, 211, 181, 91, 211, 58, 217,
-			16, 221, 84, 93, 155, 87, 47, 228, 86, 160, 55, 236, 139, 207,
-			93, 76, 91, 196, 165, 128, 12, 209, 39, 45, 69, 177, 196, 59,
-			38, 115, 168, 29, 174, 33, 224, 174, 115, 28, 141, 18, 253, 61,
-			175, 102, 34, 74, 209, 181, 249, 51, 231, 35, 74, 211, 181, 249,
-			105, 157, 228, 132, 58, 77, 215, 10, 106, 62, 119, 73, 214, 191,
-			44, 128, 35, 211, 135, 48, 3, 70, 210, 180, 52, 50, 93, 139,
-			40, 69, 215, 10, 95, 127, 19, 81, 40, 224, 214, 124, 232, 203,
-			164, 174, 221, 86, 243, 185, 27, 39, 164, 133, 153, 17, 28, 211,
-			151, 135, 66, 145, 220, 100, 26, 217, 35, 185, 184, 124, 110, 199,
-			114, 147, 154, 174, 221, 190, 53, 79, 86, 132, 220, 148, 174, 221,
-			81, 243, 81, 149, 206, 2, 232, 48, 151, 249, 61, 172, 162, 89,
-			7, 2, 207, 180, 94, 139, 152, 246, 120, 23, 119, 135, 66, 164,
-			33, 149, 198, 142, 145, 6, 92, 119, 119, 190, 190, 17, 81, 154,
-			174, 221, 185, 53, 79, 238, 9, 13, 105, 93, 91, 80, 111, 228,
-			230, 193, 29, 246, 219, 212, 195, 185, 143, 211, 47, 68, 73, 19,
-			130, 222, 208, 135, 142, 233, 69, 242, 211, 41, 236, 22, 77, 43,
-			46, 215, 133, 76, 54, 162, 52, 93, 91, 184, 62, 75, 30, 10,
-			249, 19, 186, 86, 82, 111, 228, 238, 128, 200, 212, 99, 90, 98,
-			217, 220, 131, 133, 37, 96, 29, 24, 186, 226, 59, 152, 72, 199,
-			68, 10, 187, 70, 58, 38, 20, 93, 43, 101, 46, 71, 148, 166,
-			107, 165, 235, 179, 161, 151, 50, 186, 86, 86, 111, 228, 10, 224,
-			133, 59, 78, 104, 129, 216, 186, 99, 61, 3, 234, 133, 69, 66,
-			164, 33, 147, 194, 142, 145, 134, 140, 162, 107, 229, 204, 76, 68,
-			105, 186, 86, 190, 62, 75, 110, 17, 156, 160, 228, 189, 68, 69,
-			201, 102, 79, 9, 186, 241, 248, 194, 229, 125, 47, 243, 21, 185,
-			73, 146, 73, 5, 227, 235, 190, 170, 231, 174, 192, 208, 101, 127,
-			25, 82, 177, 9, 50, 27, 135, 211, 97, 52, 244, 164, 34, 226,
-			234, 126, 56, 6, 69, 196, 213, 253, 76, 220, 166, 233, 218, 253,
-			169, 105, 50, 47, 228, 41, 186, 182, 162, 234, 185, 44, 224, 86,
-			110, 58, 14, 248, 209, 6, 139, 193, 250, 51, 111, 71, 221, 48,
-			230, 86, 98, 145, 56, 168, 149, 48, 230, 20, 17, 115, 43, 83,
-			211, 34, 8, 20, 140, 185, 135, 159, 14, 2, 69, 197, 21, 242,
-			48, 92, 74, 138, 8, 169, 135, 225, 98, 85, 68, 72, 61, 12,
-			131, 64, 65, 98, 245, 215, 6, 129, 34, 130, 107, 53, 150, 139,
-			193, 181, 26, 203, 197, 224, 90, 13, 131, 64, 193, 224, 90, 251,
-			237, 65, 160, 136, 48, 91, 139, 53, 96, 152, 173, 133, 65, 160,
-			136, 48, 91, 11, 131, 64, 193, 48, 251, 246, 183, 6, 129, 162,
-			166, 68, 183, 200, 207, 24, 100, 223, 134, 65, 160, 136, 32, 251,
-			54, 12, 2, 5, 93, 248, 248, 247, 4, 129, 34, 2, 237, 113,
-			172, 3, 3, 237, 113, 24, 4, 138, 8, 180, 199, 97, 16, 40,
-			24, 104, 223, 253, 246, 32, 80, 68, 152, 125, 23, 107, 192, 48,
-			251, 46, 12, 2, 69, 132, 217, 119, 215, 103, 73, 94, 104, 200,
-			232, 218, 15, 234, 31, 114, 215, 70, 11, 15, 87, 225, 207, 188,
-			61, 31, 229, 243, 72, 102, 38, 137, 172, 49, 149, 214, 181, 31,
-			38, 47, 70, 148, 162, 107, 63, 92, 138, 102, 5, 195, 236, 135,
-			89, 136, 43, 224, 127, 117, 157, 44, 159, 10, 121, 45, 54, 176,
-			203, 230, 128, 149, 77, 187, 207, 220, 242, 225, 146, 124, 8, 171,
-			222, 36, 54, 103, 63, 245, 55, 16, 217, 127, 96, 97, 157, 179,
-			201, 153, 39, 188, 189, 193, 221, 14, 235, 234, 55, 73, 242, 53,
-			115, 101, 117, 118, 126, 121, 170, 132, 234, 75, 123, 162, 231, 31,
-			153, 107, 55, 68, 171, 62, 67, 38, 44, 222, 239, 83, 55, 16,
-			53, 219, 153, 70, 68, 234, 87, 200, 132, 237, 29, 191, 244, 134,
-			174, 40, 204, 50, 141, 180, 237, 29, 55, 134, 110, 110, 150, 164,
-			176, 254, 219, 212, 47, 145, 244, 207, 188, 253, 50, 174, 0, 83,
-			63, 243, 182, 97, 231, 58, 36, 243, 132, 183, 101, 5, 50, 79,
-			210, 150, 24, 142, 96, 153, 92, 254, 74, 14, 35, 30, 101, 35,
-			108, 214, 151, 72, 146, 185, 29, 46, 6, 49, 185, 124, 253, 244,
-			82, 39, 44, 59, 27, 130, 53, 247, 111, 21, 114, 182, 101, 118,
-			183, 216, 155, 6, 29, 112, 47, 208, 139, 36, 213, 97, 111, 40,
-			14, 7, 235, 165, 203, 82, 215, 56, 11, 18, 13, 201, 148, 229,
-			68, 107, 153, 93, 125, 138, 104, 131, 215, 114, 120, 103, 26, 248,
-			168, 103, 73, 38, 250, 88, 52, 244, 73, 76, 235, 215, 9, 105,
-			123, 252, 53, 117, 95, 6, 102, 55, 60, 49, 56, 35, 223, 160,
-			176, 107, 228, 140, 16, 46, 90, 147, 178, 175, 120, 209, 50, 187,
-			183, 255, 66, 200, 200, 253, 250, 53, 114, 101, 175, 178, 191, 95,
-			109, 188, 68, 12, 253, 94, 133, 118, 153, 232, 213, 218, 193, 94,
-			181, 129, 197, 219, 126, 101, 227, 143, 149, 237, 106, 115, 74, 209,
-			175, 144, 11, 91, 200, 189, 87, 217, 221, 170, 55, 246, 170, 155,
-			47, 91, 149, 237, 230, 148, 138, 149, 94, 245, 79, 251, 245, 70,
-			75, 188, 120, 217, 170, 191, 92, 255, 113, 74, 91, 254, 155, 66,
-			82, 21, 92, 131, 122, 129, 156, 217, 53, 135, 174, 213, 123, 194,
-			219, 250, 251, 179, 144, 157, 140, 95, 24, 155, 122, 153, 100, 42,
-			109, 238, 5, 200, 57, 222, 144, 189, 252, 65, 197, 44, 190, 123,
-			213, 111, 147, 201, 109, 26, 196, 115, 126, 162, 207, 249, 152, 144,
-			141, 139, 228, 220, 22, 123, 179, 103, 122, 175, 133, 83, 252, 147,
-			220, 250, 135, 83, 182, 94, 124, 113, 251, 215, 69, 219, 35, 115,
-			192, 158, 252, 223, 176, 182, 60, 248, 82, 91, 126, 169, 45, 255,
-			191, 214, 150, 231, 226, 218, 178, 50, 170, 45, 43, 163, 218, 114,
-			78, 60, 42, 186, 118, 41, 177, 67, 254, 139, 66, 212, 116, 66,
-			79, 126, 157, 184, 165, 100, 255, 131, 2, 34, 8, 209, 51, 242,
-			56, 183, 178, 111, 200, 143, 68, 219, 199, 176, 97, 236, 111, 198,
-			95, 163, 155, 35, 62, 238, 249, 163, 207, 142, 92, 76, 201, 148,
-			138, 114, 20, 42, 27, 187, 114, 138, 78, 114, 135, 103, 191, 184,
-			248, 162, 111, 80, 219, 199, 64, 93, 27, 44, 135, 81, 55, 240,
-			197, 197, 158, 71, 231, 125, 112, 121, 252, 57, 21, 17, 43, 210,
-			12, 88, 155, 57, 44, 56, 22, 31, 170, 50, 159, 134, 117, 111,
-			26, 209, 222, 215, 153, 115, 164, 69, 146, 105, 81, 143, 205, 170,
-			119, 178, 219, 32, 247, 18, 234, 131, 9, 125, 121, 231, 43, 176,
-			163, 56, 152, 166, 111, 204, 62, 115, 169, 31, 125, 143, 139, 27,
-			160, 252, 154, 219, 15, 184, 71, 227, 68, 95, 18, 201, 55, 45,
-			43, 181, 217, 244, 87, 17, 133, 53, 231, 212, 213, 136, 210, 116,
-			109, 246, 102, 129, 220, 21, 250, 21, 93, 3, 117, 37, 123, 11,
-			12, 151, 5, 76, 92, 73, 154, 2, 229, 122, 242, 59, 185, 241,
-			193, 196, 226, 17, 93, 66, 250, 124, 68, 169, 186, 6, 95, 93,
-			140, 40, 77, 215, 224, 198, 61, 82, 18, 226, 85, 93, 203, 169,
-			197, 236, 31, 160, 17, 126, 232, 63, 66, 17, 167, 74, 198, 241,
-			228, 210, 211, 17, 133, 221, 245, 43, 17, 165, 233, 90, 46, 119,
-			59, 116, 28, 86, 159, 234, 114, 118, 27, 182, 132, 51, 242, 114,
-			39, 98, 174, 207, 108, 185, 132, 123, 166, 107, 59, 212, 43, 64,
-			96, 118, 125, 232, 139, 253, 17, 167, 15, 219, 186, 236, 144, 186,
-			32, 115, 224, 9, 253, 184, 218, 230, 210, 23, 34, 74, 213, 181,
-			185, 139, 145, 227, 16, 159, 206, 221, 92, 36, 115, 242, 192, 227,
-			118, 98, 89, 201, 94, 133, 230, 112, 128, 155, 42, 181, 199, 237,
-			25, 63, 227, 184, 157, 250, 106, 116, 198, 113, 71, 157, 29, 59,
-			227, 184, 163, 94, 29, 59, 227, 184, 243, 245, 117, 242, 52, 58,
-			227, 88, 80, 175, 102, 13, 216, 28, 246, 7, 163, 175, 163, 17,
-			120, 13, 76, 235, 181, 248, 43, 143, 128, 195, 118, 165, 10, 14,
-			239, 250, 69, 113, 149, 71, 253, 224, 196, 16, 160, 227, 153, 125,
-			122, 196, 189, 215, 37, 50, 118, 92, 178, 160, 94, 28, 59, 46,
-			89, 184, 50, 67, 118, 163, 227, 146, 178, 154, 205, 126, 15, 91,
-			76, 124, 173, 215, 245, 229, 218, 179, 185, 59, 31, 192, 192, 244,
-			125, 120, 106, 58, 12, 113, 189, 17, 230, 238, 150, 217, 21, 119,
-			189, 232, 91, 113, 147, 221, 143, 53, 161, 9, 101, 245, 210, 216,
-			121, 75, 121, 230, 42, 217, 138, 206, 91, 150, 212, 153, 236, 42,
-			84, 223, 160, 231, 124, 97, 151, 80, 200, 92, 12, 65, 88, 103,
-			221, 31, 135, 212, 59, 14, 191, 25, 156, 167, 111, 164, 135, 17,
-			3, 248, 243, 177, 14, 156, 171, 165, 248, 148, 8, 231, 106, 233,
-			242, 21, 114, 87, 158, 151, 172, 36, 214, 148, 236, 60, 108, 210,
-			142, 8, 156, 35, 52, 229, 100, 100, 133, 23, 217, 54, 31, 59,
-			39, 89, 201, 76, 135, 231, 25, 9, 93, 123, 160, 70, 133, 103,
-			34, 141, 212, 217, 177, 99, 144, 7, 231, 166, 199, 142, 65, 30,
-			92, 188, 132, 235, 93, 30, 131, 60, 84, 47, 229, 254, 0, 166,
-			215, 102, 129, 103, 122, 199, 239, 159, 108, 200, 111, 50, 162, 98,
-			84, 73, 97, 135, 204, 216, 73, 201, 195, 51, 83, 99, 39, 37,
-			15, 47, 92, 12, 7, 164, 98, 225, 116, 97, 236, 196, 99, 85,
-			158, 41, 133, 39, 30, 171, 19, 227, 39, 30, 171, 211, 58, 89,
-			145, 53, 236, 119, 137, 31, 148, 236, 109, 48, 162, 26, 84, 196,
-			119, 132, 190, 78, 11, 196, 168, 166, 253, 46, 115, 78, 168, 22,
-			53, 237, 247, 161, 106, 89, 186, 126, 127, 162, 116, 253, 62, 115,
-			126, 172, 116, 253, 126, 90, 39, 183, 137, 154, 84, 245, 228, 102,
-			194, 80, 178, 179, 176, 73, 3, 147, 57, 126, 92, 58, 127, 168,
-			14, 13, 216, 204, 76, 145, 61, 146, 76, 170, 168, 110, 75, 189,
-			146, 253, 1, 234, 30, 235, 50, 220, 167, 113, 186, 36, 170, 45,
-			226, 94, 104, 5, 206, 49, 152, 126, 84, 108, 250, 195, 118, 248,
-			39, 32, 1, 135, 24, 146, 201, 101, 130, 226, 210, 40, 111, 50,
-			162, 20, 93, 219, 58, 171, 71, 148, 166, 107, 91, 151, 46, 147,
-			71, 66, 177, 162, 107, 59, 106, 62, 91, 130, 240, 91, 156, 15,
-			106, 109, 249, 85, 132, 227, 156, 60, 204, 148, 162, 176, 254, 222,
-			145, 209, 141, 20, 202, 186, 241, 77, 68, 105, 186, 182, 115, 107,
-			158, 148, 8, 150, 160, 201, 189, 196, 129, 146, 205, 65, 131, 250,
-			67, 71, 236, 178, 222, 208, 21, 153, 244, 4, 142, 11, 125, 131,
-			171, 124, 47, 131, 49, 155, 76, 106, 90, 66, 79, 214, 212, 166,
-			38, 228, 106, 26, 26, 83, 35, 231, 200, 57, 146, 70, 10, 61,
-			87, 79, 94, 32, 231, 201, 132, 36, 83, 72, 147, 17, 173, 232,
-			90, 125, 242, 252, 136, 214, 116, 173, 62, 173, 199, 221, 21, 93,
-			219, 79, 206, 196, 205, 184, 54, 247, 199, 186, 163, 77, 251, 147,
-			35, 241, 104, 213, 254, 229, 43, 113, 119, 85, 215, 126, 76, 102,
-			227, 102, 92, 163, 63, 142, 117, 71, 241, 63, 78, 94, 26, 209,
-			154, 174, 253, 56, 115, 149, 228, 195, 238, 154, 174, 53, 146, 87,
-			115, 87, 17, 144, 228, 114, 88, 21, 135, 243, 107, 83, 135, 138,
-			163, 137, 168, 167, 150, 66, 214, 49, 90, 209, 181, 198, 228, 197,
-			17, 141, 162, 174, 204, 136, 213, 171, 161, 83, 90, 114, 159, 69,
-			34, 137, 20, 137, 168, 180, 174, 181, 38, 207, 71, 148, 162, 107,
-			45, 153, 199, 144, 210, 116, 173, 117, 101, 38, 170, 81, 255, 95,
-			0, 0, 0, 255, 255, 106, 198, 157, 32, 110, 63, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 89, 75, 115, 27, 185,
+			118, 38, 128, 38, 69, 65, 182, 44, 193, 146, 69, 209, 150, 116,
+			134, 215, 122, 90, 38, 109, 141, 237, 248, 49, 118, 76, 73, 148,
+			46, 253, 144, 52, 36, 61, 158, 153, 141, 170, 201, 6, 41, 88,
+			100, 55, 111, 119, 211, 146, 82, 149, 170, 44, 146, 69, 126, 69,
+			170, 178, 76, 85, 118, 201, 34, 155, 91, 149, 84, 126, 84, 118,
+			73, 29, 116, 163, 69, 217, 186, 119, 236, 185, 89, 142, 86, 252,
+			26, 7, 231, 0, 7, 248, 0, 124, 71, 252, 143, 132, 223, 236,
+			120, 94, 167, 43, 75, 125, 223, 11, 189, 230, 160, 93, 146, 189,
+			126, 120, 86, 212, 80, 92, 139, 26, 139, 166, 177, 48, 194, 211,
+			21, 108, 223, 252, 91, 126, 189, 229, 245, 138, 159, 180, 111, 114,
+			221, 122, 128, 240, 128, 252, 108, 154, 59, 94, 215, 118, 59, 69,
+			207, 239, 156, 135, 9, 207, 250, 50, 40, 29, 187, 222, 137, 27,
+			133, 236, 55, 255, 135, 144, 127, 166, 108, 247, 96, 243, 95, 232,
+			252, 110, 212, 243, 32, 54, 47, 190, 151, 221, 238, 107, 52, 110,
+			96, 191, 102, 70, 251, 249, 150, 255, 47, 225, 11, 159, 78, 32,
+			84, 61, 25, 132, 118, 175, 255, 167, 38, 241, 140, 143, 54, 140,
+			141, 200, 241, 145, 64, 182, 60, 215, 9, 114, 4, 200, 10, 171,
+			25, 40, 166, 120, 218, 181, 93, 47, 200, 81, 32, 43, 233, 90,
+			4, 54, 255, 129, 92, 62, 243, 241, 196, 165, 153, 253, 198, 23,
+			206, 62, 25, 239, 175, 203, 192, 28, 127, 209, 241, 138, 173, 35,
+			223, 235, 169, 65, 79, 39, 185, 59, 104, 169, 82, 32, 253, 143,
+			210, 47, 57, 65, 207, 238, 247, 135, 126, 244, 155, 165, 158, 12,
+			2, 187, 35, 131, 56, 65, 83, 104, 95, 140, 236, 139, 198, 44,
+			255, 75, 121, 45, 252, 61, 227, 163, 245, 35, 219, 119, 170, 110,
+			219, 195, 108, 41, 215, 145, 167, 58, 139, 233, 90, 4, 196, 125,
+			158, 14, 66, 59, 148, 58, 135, 227, 27, 55, 139, 151, 133, 42,
+			214, 209, 164, 22, 89, 162, 35, 233, 251, 158, 159, 99, 64, 86,
+			70, 107, 17, 16, 15, 248, 72, 203, 151, 118, 40, 157, 156, 5,
+			100, 101, 108, 35, 255, 233, 10, 20, 147, 5, 168, 25, 83, 236,
+			53, 232, 59, 248, 51, 151, 254, 229, 94, 177, 169, 88, 231, 76,
+			134, 118, 46, 243, 139, 61, 208, 76, 220, 229, 162, 239, 123, 45,
+			25, 4, 210, 57, 148, 110, 168, 66, 37, 131, 220, 136, 222, 75,
+			147, 73, 75, 37, 110, 16, 139, 124, 60, 244, 66, 187, 123, 110,
+			154, 213, 166, 87, 245, 215, 196, 108, 133, 79, 24, 131, 195, 190,
+			244, 15, 3, 217, 202, 141, 2, 89, 161, 181, 113, 243, 253, 64,
+			250, 117, 217, 42, 252, 19, 227, 35, 175, 188, 166, 94, 132, 113,
+			78, 149, 19, 239, 99, 170, 156, 95, 147, 254, 161, 68, 179, 95,
+			149, 104, 235, 171, 19, 157, 254, 75, 18, 157, 249, 242, 68, 143,
+			124, 105, 162, 179, 151, 37, 90, 252, 21, 207, 4, 184, 221, 131,
+			220, 20, 176, 149, 177, 141, 133, 63, 145, 77, 67, 137, 90, 108,
+			190, 118, 204, 211, 122, 135, 139, 105, 62, 89, 111, 148, 27, 149,
+			195, 119, 123, 245, 131, 202, 86, 117, 167, 90, 217, 158, 72, 137,
+			43, 60, 91, 111, 148, 107, 141, 234, 222, 238, 4, 17, 99, 124,
+			164, 246, 110, 111, 15, 1, 197, 166, 242, 230, 126, 212, 196, 176,
+			169, 254, 110, 107, 171, 82, 175, 79, 88, 34, 203, 173, 157, 114,
+			245, 205, 68, 26, 63, 107, 163, 202, 246, 68, 102, 243, 254, 207,
+			165, 175, 60, 14, 94, 253, 219, 52, 207, 8, 107, 60, 85, 38,
+			252, 191, 45, 78, 174, 8, 54, 158, 18, 27, 255, 97, 193, 150,
+			215, 63, 243, 85, 231, 40, 132, 141, 123, 247, 31, 67, 227, 72,
+			194, 155, 119, 91, 85, 40, 15, 194, 35, 207, 15, 138, 156, 195,
+			27, 213, 146, 110, 32, 29, 24, 184, 142, 244, 33, 60, 146, 80,
+			238, 219, 45, 180, 140, 90, 214, 225, 7, 233, 7, 202, 115, 97,
+			163, 120, 15, 86, 208, 160, 16, 55, 21, 86, 159, 113, 56, 243,
+			6, 208, 179, 207, 192, 245, 66, 24, 4, 18, 194, 35, 21, 64,
+			91, 117, 37, 200, 211, 150, 236, 135, 160, 92, 104, 121, 189, 126,
+			87, 217, 110, 75, 194, 137, 10, 143, 32, 60, 119, 95, 228, 240,
+			83, 236, 193, 107, 134, 182, 114, 193, 134, 150, 215, 63, 3, 175,
+			61, 108, 6, 118, 200, 57, 232, 191, 163, 48, 236, 63, 45, 149,
+			78, 78, 78, 138, 182, 30, 105, 116, 100, 70, 118, 65, 233, 77,
+			117, 171, 178, 87, 175, 220, 221, 40, 222, 227, 28, 222, 185, 93,
+			25, 4, 224, 203, 63, 12, 148, 47, 29, 104, 158, 129, 221, 239,
+			119, 85, 203, 110, 118, 37, 116, 237, 19, 240, 124, 176, 59, 190,
+			148, 14, 132, 30, 142, 245, 196, 87, 161, 114, 59, 235, 16, 120,
+			237, 240, 196, 246, 37, 7, 71, 5, 161, 175, 154, 131, 240, 66,
+			154, 204, 200, 84, 112, 193, 192, 115, 193, 118, 161, 80, 174, 67,
+			181, 94, 128, 205, 114, 189, 90, 95, 231, 240, 190, 218, 248, 253,
+			254, 187, 6, 188, 47, 215, 106, 229, 189, 70, 181, 82, 135, 253,
+			26, 108, 237, 239, 109, 87, 27, 213, 253, 189, 58, 236, 239, 64,
+			121, 239, 39, 120, 93, 221, 219, 94, 7, 169, 194, 35, 233, 131,
+			60, 237, 251, 56, 122, 207, 7, 133, 9, 148, 78, 145, 67, 93,
+			202, 11, 225, 219, 94, 180, 106, 65, 95, 182, 84, 91, 181, 0,
+			175, 234, 129, 221, 145, 208, 241, 62, 74, 223, 85, 110, 7, 250,
+			210, 239, 169, 0, 23, 49, 0, 219, 117, 56, 116, 85, 79, 133,
+			118, 168, 63, 124, 54, 163, 34, 231, 89, 78, 168, 96, 19, 169,
+			57, 252, 149, 21, 76, 164, 118, 248, 40, 167, 217, 177, 228, 39,
+			75, 9, 54, 149, 90, 229, 27, 156, 166, 83, 194, 202, 165, 128,
+			228, 151, 64, 211, 4, 151, 206, 134, 15, 94, 19, 60, 31, 60,
+			87, 99, 21, 6, 16, 177, 169, 200, 57, 231, 44, 157, 34, 130,
+			229, 210, 156, 143, 113, 43, 157, 162, 41, 193, 102, 105, 142, 95,
+			225, 105, 4, 4, 209, 117, 131, 168, 96, 179, 55, 102, 98, 67,
+			34, 88, 62, 49, 36, 26, 113, 131, 168, 96, 249, 196, 144, 10,
+			118, 51, 49, 164, 4, 209, 168, 65, 216, 150, 24, 50, 193, 110,
+			37, 134, 140, 32, 50, 30, 25, 21, 236, 86, 98, 104, 9, 54,
+			151, 24, 90, 4, 145, 241, 104, 81, 193, 230, 18, 195, 180, 96,
+			243, 137, 97, 154, 32, 202, 24, 68, 5, 155, 79, 12, 51, 130,
+			45, 36, 134, 25, 130, 200, 120, 204, 80, 193, 22, 110, 204, 240,
+			103, 156, 90, 41, 97, 221, 78, 221, 35, 249, 18, 224, 169, 228,
+			247, 244, 186, 129, 221, 244, 6, 33, 4, 94, 79, 66, 160, 220,
+			78, 87, 70, 249, 77, 146, 31, 229, 217, 194, 60, 223, 206, 78,
+			242, 117, 110, 89, 58, 207, 139, 244, 122, 97, 1, 254, 70, 250,
+			222, 221, 166, 141, 196, 215, 23, 189, 161, 155, 246, 161, 231, 143,
+			214, 105, 193, 22, 233, 136, 65, 68, 176, 197, 236, 85, 131, 152,
+			96, 139, 147, 2, 103, 98, 165, 48, 191, 75, 244, 122, 220, 68,
+			50, 136, 76, 55, 92, 164, 165, 164, 27, 97, 130, 45, 77, 10,
+			190, 165, 187, 81, 193, 150, 233, 245, 194, 35, 56, 26, 244, 108,
+			23, 124, 105, 59, 154, 151, 250, 201, 0, 241, 43, 103, 29, 218,
+			158, 15, 109, 91, 117, 165, 19, 239, 33, 240, 220, 238, 153, 25,
+			37, 77, 163, 151, 172, 65, 68, 176, 229, 209, 113, 131, 152, 96,
+			203, 147, 130, 23, 116, 56, 38, 216, 42, 93, 41, 76, 195, 201,
+			145, 116, 65, 133, 112, 98, 7, 16, 223, 128, 198, 27, 203, 160,
+			209, 77, 131, 136, 96, 171, 183, 126, 103, 16, 58, 88, 90, 142,
+			115, 105, 9, 182, 70, 87, 10, 11, 23, 188, 197, 55, 35, 116,
+			237, 32, 4, 124, 24, 26, 191, 86, 6, 205, 141, 95, 220, 62,
+			107, 137, 95, 139, 9, 182, 182, 180, 204, 31, 105, 191, 105, 193,
+			238, 208, 149, 194, 106, 226, 183, 173, 92, 21, 28, 201, 0, 86,
+			84, 27, 66, 223, 110, 29, 107, 78, 251, 94, 7, 79, 135, 85,
+			19, 33, 157, 193, 142, 38, 2, 238, 187, 59, 183, 22, 12, 98,
+			130, 221, 89, 90, 230, 15, 116, 132, 140, 96, 119, 233, 66, 97,
+			25, 220, 65, 175, 41, 125, 92, 251, 228, 250, 5, 115, 105, 66,
+			120, 52, 8, 160, 109, 251, 198, 127, 38, 141, 221, 204, 178, 226,
+			118, 189, 155, 205, 27, 196, 4, 187, 59, 55, 207, 31, 107, 255,
+			35, 130, 21, 233, 66, 225, 14, 232, 251, 123, 40, 74, 226, 219,
+			243, 225, 238, 125, 80, 109, 24, 184, 90, 65, 152, 24, 35, 105,
+			236, 106, 98, 140, 16, 193, 138, 217, 27, 6, 49, 193, 138, 115,
+			243, 113, 150, 178, 130, 149, 232, 66, 97, 21, 252, 248, 196, 137,
+			103, 160, 143, 238, 36, 78, 95, 250, 16, 201, 1, 19, 33, 155,
+			198, 142, 38, 66, 150, 8, 86, 202, 230, 226, 8, 89, 38, 88,
+			105, 110, 158, 47, 113, 106, 17, 97, 61, 72, 149, 73, 62, 127,
+			9, 233, 134, 249, 133, 219, 251, 65, 246, 26, 191, 205, 45, 139,
+			32, 191, 30, 82, 81, 152, 129, 129, 171, 254, 48, 144, 104, 7,
+			202, 193, 225, 180, 149, 140, 51, 73, 52, 175, 30, 198, 99, 32,
+			154, 87, 15, 179, 73, 27, 19, 236, 225, 196, 36, 95, 214, 254,
+			136, 96, 143, 168, 40, 228, 1, 143, 114, 187, 219, 133, 192, 28,
+			176, 72, 214, 15, 94, 211, 116, 67, 206, 61, 74, 92, 226, 160,
+			30, 197, 156, 35, 154, 115, 143, 38, 38, 53, 9, 8, 165, 130,
+			61, 254, 243, 36, 32, 148, 102, 208, 232, 166, 65, 68, 176, 199,
+			241, 102, 37, 154, 82, 143, 99, 18, 16, 4, 79, 190, 148, 4,
+			68, 147, 235, 73, 226, 23, 15, 219, 39, 137, 95, 134, 174, 98,
+			18, 16, 106, 9, 246, 244, 235, 73, 64, 52, 205, 158, 38, 17,
+			144, 102, 79, 99, 18, 16, 77, 179, 167, 49, 9, 8, 158, 26,
+			223, 125, 45, 9, 8, 77, 235, 110, 38, 207, 72, 178, 239, 98,
+			18, 16, 77, 178, 239, 98, 18, 16, 76, 225, 243, 95, 67, 2,
+			162, 137, 246, 60, 89, 75, 36, 218, 243, 152, 4, 68, 19, 237,
+			121, 76, 2, 66, 71, 4, 123, 241, 245, 36, 32, 154, 102, 47,
+			146, 8, 72, 179, 23, 49, 9, 136, 166, 217, 139, 185, 121, 190,
+			162, 35, 100, 5, 123, 73, 191, 41, 220, 60, 223, 120, 184, 11,
+			63, 120, 205, 101, 115, 159, 27, 159, 89, 11, 77, 19, 148, 17,
+			236, 229, 216, 148, 65, 68, 176, 151, 211, 102, 85, 144, 102, 47,
+			231, 33, 81, 192, 127, 156, 231, 27, 151, 62, 121, 91, 170, 239,
+			148, 236, 190, 42, 217, 78, 79, 185, 165, 143, 247, 163, 31, 177,
+			234, 181, 176, 57, 255, 231, 202, 31, 249, 191, 80, 88, 23, 28,
+			62, 250, 202, 107, 110, 121, 110, 91, 117, 196, 109, 110, 29, 43,
+			55, 82, 103, 227, 27, 19, 69, 12, 95, 124, 171, 93, 188, 86,
+			174, 83, 211, 173, 88, 142, 104, 121, 189, 158, 116, 67, 45, 153,
+			71, 107, 6, 138, 25, 62, 226, 248, 103, 135, 254, 192, 213, 194,
+			44, 91, 203, 56, 254, 89, 109, 224, 22, 230, 121, 26, 245, 223,
+			182, 152, 230, 153, 15, 94, 243, 48, 81, 128, 233, 15, 94, 179,
+			234, 20, 218, 60, 251, 202, 107, 234, 167, 149, 88, 230, 153, 150,
+			30, 142, 54, 25, 219, 184, 22, 13, 35, 25, 101, 45, 110, 22,
+			247, 185, 165, 220, 182, 167, 7, 49, 182, 49, 119, 185, 212, 137,
+			101, 103, 77, 155, 22, 254, 149, 240, 43, 13, 187, 179, 163, 78,
+			107, 178, 239, 249, 161, 88, 231, 233, 182, 58, 149, 56, 28, 212,
+			75, 55, 162, 88, 195, 38, 197, 134, 221, 169, 69, 70, 121, 143,
+			179, 134, 221, 17, 19, 156, 245, 143, 163, 225, 141, 214, 240, 167,
+			200, 243, 172, 114, 131, 16, 159, 255, 113, 78, 18, 44, 230, 56,
+			111, 250, 222, 177, 116, 15, 67, 187, 19, 87, 12, 70, 163, 47,
+			232, 236, 38, 31, 213, 206, 117, 171, 165, 91, 179, 250, 67, 195,
+			238, 172, 253, 35, 225, 252, 60, 255, 226, 38, 159, 121, 91, 62,
+			56, 168, 212, 14, 241, 17, 253, 137, 68, 187, 193, 69, 101, 239,
+			221, 219, 74, 173, 220, 168, 28, 30, 148, 183, 94, 151, 119, 43,
+			245, 9, 34, 102, 248, 245, 29, 180, 126, 91, 126, 179, 179, 95,
+			123, 91, 217, 62, 108, 148, 119, 235, 19, 20, 165, 94, 229, 199,
+			131, 253, 90, 67, 127, 56, 108, 236, 31, 110, 126, 63, 193, 180,
+			159, 232, 115, 125, 115, 255, 173, 249, 110, 109, 252, 39, 225, 233,
+			50, 110, 78, 177, 202, 71, 223, 216, 3, 183, 117, 244, 202, 107,
+			138, 79, 151, 39, 63, 150, 124, 168, 110, 139, 18, 207, 150, 155,
+			158, 31, 162, 229, 112, 67, 254, 198, 103, 82, 90, 151, 229, 196,
+			26, 31, 219, 149, 97, 178, 25, 46, 244, 25, 79, 64, 212, 120,
+			143, 95, 221, 81, 167, 111, 109, 255, 88, 58, 13, 187, 19, 92,
+			180, 22, 159, 175, 229, 230, 250, 207, 107, 95, 70, 195, 103, 118,
+			95, 189, 250, 187, 153, 72, 116, 254, 248, 155, 232, 252, 77, 116,
+			254, 191, 138, 206, 171, 137, 232, 44, 159, 139, 206, 242, 185, 232,
+			92, 212, 63, 137, 96, 211, 169, 223, 243, 255, 34, 156, 102, 82,
+			194, 186, 149, 90, 34, 249, 127, 39, 160, 73, 136, 169, 179, 67,
+			245, 81, 66, 249, 160, 138, 85, 9, 189, 6, 91, 213, 131, 109,
+			192, 35, 80, 181, 36, 216, 231, 118, 158, 31, 104, 137, 161, 220,
+			80, 250, 46, 222, 213, 82, 106, 157, 10, 229, 173, 55, 209, 18,
+			93, 180, 46, 194, 219, 65, 16, 234, 138, 71, 83, 38, 238, 165,
+			235, 64, 171, 171, 164, 27, 6, 69, 220, 245, 190, 92, 14, 192,
+			245, 160, 105, 183, 142, 79, 80, 85, 233, 50, 136, 29, 170, 166,
+			234, 170, 240, 12, 239, 234, 158, 10, 100, 44, 136, 51, 248, 12,
+			188, 149, 189, 202, 27, 220, 202, 104, 161, 54, 79, 239, 228, 119,
+			33, 58, 75, 100, 0, 54, 224, 45, 133, 137, 253, 224, 53, 33,
+			60, 178, 67, 144, 167, 118, 79, 185, 216, 230, 58, 37, 212, 72,
+			234, 84, 6, 224, 216, 161, 29, 132, 158, 47, 147, 23, 64, 81,
+			223, 202, 232, 21, 197, 104, 230, 154, 65, 40, 70, 39, 102, 13,
+			98, 130, 205, 223, 94, 229, 223, 234, 248, 68, 48, 160, 143, 242,
+			75, 80, 117, 85, 168, 236, 80, 7, 193, 231, 175, 31, 234, 71,
+			192, 240, 96, 18, 247, 248, 236, 132, 204, 184, 65, 84, 48, 184,
+			54, 101, 16, 19, 12, 22, 30, 240, 162, 118, 79, 5, 43, 208,
+			245, 252, 55, 80, 147, 225, 192, 119, 131, 161, 231, 197, 165, 158,
+			81, 96, 22, 50, 147, 6, 97, 119, 49, 99, 16, 19, 172, 80,
+			88, 139, 19, 135, 178, 148, 110, 228, 119, 97, 71, 39, 99, 37,
+			58, 137, 148, 27, 40, 39, 218, 194, 71, 182, 235, 116, 165, 191,
+			10, 161, 221, 9, 160, 167, 207, 71, 220, 29, 216, 214, 81, 31,
+			165, 171, 7, 32, 253, 11, 241, 241, 169, 186, 152, 185, 110, 16,
+			21, 108, 113, 202, 36, 14, 31, 174, 139, 183, 239, 241, 197, 168,
+			18, 178, 150, 122, 72, 242, 179, 80, 31, 244, 241, 80, 149, 206,
+			240, 124, 134, 139, 31, 107, 233, 107, 113, 25, 32, 133, 186, 109,
+			62, 22, 254, 216, 116, 135, 206, 26, 68, 81, 197, 205, 241, 31,
+			76, 241, 227, 46, 157, 205, 87, 97, 123, 208, 235, 131, 107, 247,
+			80, 73, 69, 47, 178, 190, 221, 58, 198, 255, 8, 224, 102, 221,
+			45, 87, 160, 235, 117, 130, 117, 4, 161, 12, 194, 11, 67, 128,
+			182, 111, 247, 228, 137, 231, 31, 23, 147, 202, 9, 106, 57, 58,
+			101, 16, 21, 236, 238, 76, 142, 191, 49, 117, 148, 18, 205, 231,
+			255, 26, 118, 148, 235, 68, 57, 211, 123, 207, 241, 220, 229, 16,
+			250, 118, 16, 192, 15, 118, 87, 161, 234, 173, 198, 151, 122, 195,
+			238, 224, 142, 212, 185, 69, 107, 217, 75, 34, 225, 20, 74, 116,
+			218, 32, 42, 88, 41, 55, 203, 119, 76, 33, 230, 62, 205, 229,
+			159, 64, 229, 20, 51, 23, 232, 121, 233, 128, 202, 69, 10, 194,
+			166, 234, 124, 63, 144, 254, 25, 132, 250, 28, 93, 150, 218, 46,
+			122, 28, 4, 203, 73, 12, 92, 171, 251, 73, 249, 8, 107, 56,
+			247, 111, 204, 240, 182, 169, 225, 60, 160, 179, 249, 159, 146, 24,
+			72, 73, 207, 69, 206, 66, 219, 27, 184, 201, 78, 192, 219, 29,
+			228, 41, 10, 141, 208, 243, 47, 31, 1, 31, 26, 66, 208, 244,
+			122, 67, 99, 176, 80, 21, 38, 25, 197, 242, 208, 131, 153, 28,
+			255, 54, 42, 230, 60, 73, 61, 39, 249, 101, 216, 150, 109, 77,
+			222, 19, 76, 231, 69, 118, 7, 71, 222, 160, 235, 128, 227, 13,
+			21, 113, 158, 100, 39, 227, 98, 75, 10, 37, 142, 81, 197, 41,
+			45, 120, 174, 24, 132, 130, 231, 234, 164, 65, 40, 120, 166, 166,
+			145, 115, 88, 122, 17, 236, 25, 157, 46, 124, 3, 182, 223, 84,
+			161, 111, 251, 103, 159, 150, 93, 240, 62, 113, 59, 70, 41, 147,
+			52, 118, 48, 117, 21, 228, 246, 179, 209, 9, 131, 152, 96, 207,
+			174, 79, 197, 3, 162, 168, 137, 76, 245, 39, 18, 86, 25, 131,
+			80, 33, 141, 12, 151, 99, 190, 155, 20, 252, 81, 36, 176, 203,
+			169, 45, 146, 95, 131, 170, 17, 200, 250, 140, 49, 79, 195, 203,
+			14, 3, 35, 184, 203, 217, 171, 58, 180, 22, 220, 155, 113, 232,
+			72, 87, 111, 38, 178, 6, 153, 180, 153, 29, 55, 136, 9, 182,
+			57, 41, 248, 26, 167, 22, 21, 214, 110, 234, 13, 201, 207, 195,
+			182, 12, 109, 213, 13, 18, 93, 255, 121, 56, 76, 219, 110, 118,
+			130, 191, 229, 150, 69, 49, 92, 149, 206, 228, 95, 194, 190, 175,
+			58, 10, 239, 10, 92, 174, 232, 201, 189, 142, 231, 113, 43, 236,
+			158, 129, 29, 24, 37, 28, 12, 154, 61, 21, 226, 13, 29, 122,
+			241, 81, 254, 202, 28, 43, 232, 46, 131, 254, 198, 12, 34, 130,
+			85, 175, 8, 131, 152, 96, 213, 233, 27, 252, 153, 14, 76, 4,
+			123, 77, 87, 242, 69, 216, 26, 248, 190, 116, 195, 207, 10, 1,
+			154, 113, 200, 152, 11, 149, 214, 200, 21, 22, 7, 94, 211, 249,
+			216, 49, 38, 240, 245, 194, 239, 12, 98, 130, 189, 94, 90, 230,
+			69, 142, 250, 216, 58, 72, 253, 72, 242, 5, 168, 201, 96, 208,
+			213, 39, 189, 63, 112, 93, 204, 200, 133, 183, 100, 156, 27, 100,
+			218, 65, 22, 119, 185, 101, 49, 150, 18, 214, 247, 244, 7, 166,
+			253, 50, 134, 217, 255, 158, 95, 229, 87, 121, 6, 219, 48, 115,
+			53, 235, 58, 31, 231, 35, 17, 76, 35, 230, 231, 152, 8, 86,
+			27, 27, 63, 199, 76, 176, 218, 164, 72, 186, 19, 193, 234, 86,
+			46, 105, 198, 189, 89, 31, 234, 142, 115, 170, 143, 157, 187, 39,
+			76, 176, 250, 141, 153, 164, 59, 21, 172, 97, 229, 147, 102, 220,
+			163, 141, 161, 238, 184, 200, 141, 177, 233, 115, 204, 4, 107, 228,
+			102, 249, 74, 220, 157, 9, 246, 206, 154, 45, 204, 226, 163, 168,
+			80, 192, 186, 85, 188, 190, 142, 236, 74, 93, 60, 52, 61, 89,
+			26, 77, 135, 48, 17, 236, 221, 216, 212, 57, 70, 87, 51, 57,
+			189, 123, 25, 38, 229, 125, 116, 214, 91, 140, 166, 44, 68, 220,
+			160, 140, 96, 239, 199, 198, 13, 34, 130, 189, 143, 238, 82, 180,
+			100, 130, 189, 159, 201, 53, 51, 125, 223, 11, 189, 111, 255, 111,
+			0, 95, 45, 87, 81, 6, 32, 0, 0},
 	)
 }
 
@@ -132,6 +132,120 @@ func (x *ExportedTag) GetAttachedTs() *timestamppb.Timestamp {
 	return nil
 }
 
+// ExportedSBOMComponent defines a schema for 'exported_sboms_<jobid>' BigQuery
+// tables.
+//
+// It is populated by EXPORT_SBOMS_TO_BQ mapper job from results of the SBOM
+// extractor processor. Each row is a third party component found inside some
+// package instance.
+type ExportedSBOMComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instance      string                 `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`                          // an instance ID, e.g. "AdPcH..."
+	Package       string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`                            // a package name, e.g. "a/b/c"
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                  // "golang", "pypi" or "deb"
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                  // e.g. "golang.org/x/net"
+	Version       string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`                            // e.g. "v0.25.0"
+	License       string                 `protobuf:"bytes,6,opt,name=license,proto3" json:"license,omitempty"`                            // a license, if known
+	Purl          string                 `protobuf:"bytes,7,opt,name=purl,proto3" json:"purl,omitempty"`                                  // a package URL of the component
+	Source        string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`                              // a file it was found in
+	ProcessedTs   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=processed_ts,json=processedTs,proto3" json:"processed_ts,omitempty"` // when the instance was scanned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedSBOMComponent) Reset() {
+	*x = ExportedSBOMComponent{}
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedSBOMComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedSBOMComponent) ProtoMessage() {}
+
+func (x *ExportedSBOMComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedSBOMComponent.ProtoReflect.Descriptor instead.
+func (*ExportedSBOMComponent) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportedSBOMComponent) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetPurl() string {
+	if x != nil {
+		return x.Purl
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExportedSBOMComponent) GetProcessedTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedTs
+	}
+	return nil
+}
+
 var File_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDesc = string([]byte{
@@ -154,10 +268,28 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDesc = string([
 	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x73, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x6f, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63,
-	0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f,
-	0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x54, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x42, 0x4f, 0x4d, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x54, 0x73,
+	0x42, 0x2b, 0x5a, 0x29, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -172,18 +304,20 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDescGZIP() []b
 	return file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_goTypes = []any{
 	(*ExportedTag)(nil),           // 0: cipd.ExportedTag
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(*ExportedSBOMComponent)(nil), // 1: cipd.ExportedSBOMComponent
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
 }
 var file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_depIdxs = []int32{
-	1, // 0: cipd.ExportedTag.attached_ts:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: cipd.ExportedTag.attached_ts:type_name -> google.protobuf.Timestamp
+	2, // 1: cipd.ExportedSBOMComponent.processed_ts:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_init() }
@@ -197,7 +331,7 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDesc), len(file_go_chromium_org_luci_cipd_api_cipd_v1_exported_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,4201 +12,2344 @@ func init() {
 			"cipd.Storage", "cipd.Repository",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 228, 189, 107, 120, 28, 199,
-			117, 32, 138, 238, 174, 238, 25, 212, 224, 49, 40, 190, 192, 33,
-			41, 22, 135, 146, 8, 146, 192, 72, 162, 100, 61, 72, 59, 14,
-			8, 12, 41, 40, 36, 192, 29, 128, 146, 229, 60, 160, 198, 76,
-			1, 104, 105, 208, 61, 238, 238, 33, 4, 237, 221, 68, 142, 157,
-			92, 59, 138, 189, 182, 99, 199, 178, 215, 187, 218, 120, 243, 90,
-			111, 252, 138, 157, 235, 228, 238, 231, 221, 108, 54, 55, 201, 58,
-			47, 59, 47, 59, 171, 181, 215, 55, 177, 101, 59, 206, 174, 147,
-			88, 142, 179, 138, 245, 233, 126, 231, 212, 163, 123, 6, 160, 68,
-			43, 214, 253, 242, 125, 203, 31, 196, 156, 234, 122, 158, 58, 117,
-			234, 156, 83, 167, 78, 209, 191, 124, 47, 161, 124, 45, 138, 214,
-			218, 226, 166, 78, 28, 165, 209, 74, 119, 245, 166, 150, 72, 154,
-			113, 208, 73, 163, 184, 134, 105, 108, 84, 230, 168, 233, 28, 213,
-			239, 165, 99, 231, 130, 182, 152, 53, 25, 23, 69, 202, 238, 164,
-			100, 53, 104, 139, 113, 139, 59, 19, 165, 83, 215, 215, 250, 10,
-			213, 122, 75, 92, 130, 228, 6, 150, 56, 49, 84, 124, 244, 47,
-			63, 241, 156, 85, 126, 45, 252, 95, 125, 220, 165, 187, 118, 200,
-			203, 24, 37, 161, 191, 1, 245, 91, 19, 131, 13, 252, 205, 198,
-			105, 161, 227, 55, 31, 242, 215, 196, 184, 141, 201, 26, 100, 215,
-			81, 218, 18, 29, 17, 182, 68, 216, 220, 26, 119, 184, 51, 49,
-			216, 200, 165, 176, 147, 116, 172, 211, 93, 105, 7, 205, 229, 92,
-			54, 202, 157, 9, 183, 81, 150, 31, 102, 179, 204, 199, 232, 232,
-			166, 240, 31, 202, 103, 45, 97, 214, 17, 72, 206, 101, 156, 161,
-			67, 27, 34, 73, 252, 53, 177, 156, 110, 117, 196, 56, 65, 92,
-			240, 109, 184, 232, 199, 67, 73, 149, 90, 218, 234, 8, 54, 77,
-			7, 69, 216, 221, 144, 53, 184, 87, 193, 102, 61, 236, 110, 244,
-			215, 82, 132, 98, 170, 138, 66, 34, 226, 43, 65, 83, 140, 123,
-			88, 193, 177, 109, 21, 44, 202, 239, 253, 117, 232, 114, 108, 134,
-			14, 138, 135, 83, 17, 38, 65, 20, 142, 23, 176, 146, 27, 118,
-			152, 83, 209, 110, 245, 87, 145, 149, 99, 183, 211, 66, 212, 73,
-			131, 40, 76, 198, 139, 220, 154, 40, 157, 58, 184, 35, 89, 44,
-			200, 60, 13, 157, 153, 205, 209, 114, 18, 117, 227, 166, 88, 110,
-			70, 45, 177, 28, 132, 171, 209, 248, 32, 86, 112, 120, 251, 64,
-			48, 227, 76, 212, 18, 115, 225, 106, 212, 24, 73, 122, 96, 182,
-			151, 122, 201, 86, 152, 250, 15, 143, 15, 33, 133, 40, 136, 157,
-			162, 5, 209, 10, 160, 185, 241, 17, 110, 77, 140, 156, 26, 223,
-			142, 99, 249, 189, 161, 51, 86, 127, 197, 163, 163, 215, 66, 150,
-			103, 168, 187, 10, 152, 25, 183, 191, 29, 188, 201, 50, 189, 136,
-			247, 94, 36, 226, 167, 105, 41, 20, 73, 42, 90, 146, 138, 156,
-			107, 164, 67, 42, 11, 109, 39, 67, 242, 162, 200, 240, 85, 116,
-			212, 116, 105, 57, 246, 195, 53, 77, 207, 55, 189, 80, 79, 106,
-			117, 93, 174, 1, 197, 26, 35, 162, 7, 102, 179, 148, 70, 161,
-			136, 86, 151, 91, 162, 217, 30, 47, 94, 5, 75, 11, 144, 101,
-			27, 150, 34, 153, 218, 108, 179, 187, 50, 242, 44, 92, 133, 186,
-			46, 202, 133, 185, 141, 66, 47, 211, 145, 88, 192, 90, 17, 45,
-			53, 178, 65, 236, 68, 237, 5, 71, 214, 80, 197, 228, 192, 134,
-			227, 60, 200, 142, 82, 147, 176, 140, 100, 69, 145, 115, 13, 233,
-			196, 121, 127, 67, 84, 30, 161, 35, 189, 232, 97, 187, 169, 155,
-			164, 126, 156, 34, 21, 186, 13, 9, 176, 50, 117, 68, 216, 66,
-			206, 232, 54, 224, 39, 251, 238, 108, 192, 14, 14, 248, 198, 237,
-			51, 218, 83, 115, 255, 184, 43, 119, 208, 225, 158, 1, 92, 107,
-			211, 213, 223, 33, 116, 207, 142, 117, 179, 87, 209, 221, 221, 48,
-			8, 83, 17, 119, 98, 1, 36, 43, 219, 26, 255, 82, 225, 42,
-			68, 119, 57, 159, 91, 214, 210, 216, 213, 221, 158, 200, 238, 167,
-			37, 160, 15, 63, 246, 177, 66, 185, 26, 79, 93, 219, 144, 107,
-			179, 89, 201, 179, 206, 27, 44, 187, 145, 175, 139, 221, 65, 139,
-			171, 194, 79, 187, 177, 72, 198, 79, 33, 42, 15, 108, 95, 164,
-			50, 195, 162, 72, 27, 38, 51, 219, 160, 67, 87, 68, 28, 172,
-			6, 77, 217, 41, 7, 153, 207, 157, 215, 216, 169, 123, 115, 69,
-			23, 83, 63, 21, 167, 233, 229, 249, 123, 235, 141, 185, 115, 115,
-			245, 89, 217, 205, 158, 234, 43, 111, 183, 104, 41, 55, 18, 96,
-			135, 97, 119, 99, 69, 196, 106, 190, 20, 196, 14, 208, 193, 213,
-			110, 187, 45, 137, 78, 238, 165, 69, 72, 0, 130, 3, 30, 167,
-			216, 8, 242, 56, 248, 205, 42, 180, 168, 137, 114, 220, 229, 214,
-			68, 177, 97, 96, 249, 173, 35, 252, 84, 180, 198, 61, 253, 77,
-			194, 247, 144, 34, 41, 187, 213, 219, 232, 216, 182, 161, 176, 81,
-			90, 154, 173, 207, 92, 152, 110, 76, 47, 205, 45, 204, 151, 7,
-			216, 8, 205, 141, 174, 108, 157, 24, 44, 126, 185, 80, 126, 244,
-			209, 71, 31, 181, 171, 255, 201, 163, 187, 119, 98, 130, 59, 242,
-			227, 108, 208, 78, 207, 160, 167, 169, 219, 246, 87, 68, 123, 156,
-			224, 36, 156, 188, 38, 54, 91, 187, 0, 69, 26, 178, 36, 251,
-			46, 133, 26, 23, 107, 56, 113, 109, 53, 0, 115, 84, 104, 60,
-			64, 7, 225, 175, 196, 187, 39, 241, 14, 9, 136, 247, 10, 45,
-			34, 223, 107, 9, 51, 39, 26, 6, 78, 209, 18, 171, 126, 183,
-			157, 46, 95, 241, 219, 93, 129, 28, 108, 176, 49, 164, 18, 239,
-			133, 52, 118, 152, 150, 36, 155, 12, 194, 150, 120, 24, 183, 80,
-			183, 33, 57, 231, 28, 164, 64, 243, 15, 38, 81, 168, 121, 13,
-			54, 1, 9, 216, 252, 29, 253, 187, 247, 161, 157, 135, 183, 141,
-			57, 30, 163, 163, 152, 227, 86, 181, 148, 253, 246, 248, 24, 146,
-			193, 136, 76, 94, 80, 169, 213, 255, 203, 166, 4, 119, 138, 81,
-			90, 90, 186, 255, 82, 125, 121, 118, 225, 242, 217, 11, 245, 178,
-			5, 83, 143, 9, 231, 46, 44, 76, 47, 149, 109, 3, 207, 205,
-			47, 221, 126, 91, 217, 49, 5, 46, 203, 4, 146, 207, 112, 235,
-			169, 178, 203, 202, 116, 72, 86, 48, 247, 170, 250, 236, 237, 183,
-			149, 189, 222, 148, 91, 79, 149, 11, 108, 152, 14, 98, 202, 217,
-			133, 133, 11, 229, 162, 169, 115, 113, 169, 49, 55, 127, 190, 60,
-			104, 234, 60, 223, 88, 184, 124, 169, 76, 77, 13, 23, 235, 139,
-			139, 211, 231, 235, 229, 146, 201, 113, 246, 254, 165, 250, 98, 121,
-			168, 167, 91, 183, 158, 42, 15, 155, 38, 234, 243, 151, 47, 150,
-			71, 216, 24, 29, 150, 77, 232, 78, 140, 246, 37, 221, 126, 91,
-			185, 156, 117, 68, 214, 50, 214, 147, 112, 251, 109, 101, 86, 157,
-			161, 46, 146, 33, 99, 116, 228, 194, 244, 217, 250, 133, 229, 133,
-			75, 176, 104, 166, 47, 148, 173, 44, 173, 81, 191, 84, 159, 94,
-			170, 207, 150, 157, 124, 218, 63, 185, 60, 215, 168, 207, 150, 237,
-			106, 147, 238, 222, 105, 135, 220, 113, 9, 229, 104, 193, 190, 10,
-			45, 96, 93, 253, 180, 80, 253, 115, 155, 238, 218, 65, 74, 216,
-			177, 145, 87, 82, 87, 210, 178, 228, 212, 199, 119, 20, 55, 144,
-			178, 183, 201, 78, 88, 46, 47, 111, 58, 87, 145, 55, 161, 138,
-			109, 4, 251, 253, 219, 118, 115, 41, 240, 220, 126, 45, 2, 15,
-			166, 125, 123, 187, 186, 187, 195, 174, 126, 134, 142, 109, 171, 232,
-			154, 119, 215, 215, 89, 116, 252, 106, 200, 121, 1, 150, 104, 247,
-			176, 196, 51, 253, 24, 60, 114, 245, 73, 216, 54, 215, 63, 103,
-			209, 189, 59, 235, 21, 59, 246, 225, 187, 168, 183, 33, 210, 245,
-			72, 203, 201, 219, 133, 145, 139, 248, 185, 127, 178, 85, 169, 188,
-			248, 230, 92, 77, 57, 144, 189, 217, 214, 211, 55, 218, 116, 207,
-			142, 149, 239, 216, 209, 67, 148, 6, 97, 167, 155, 74, 89, 88,
-			114, 226, 65, 76, 65, 230, 5, 92, 182, 155, 154, 239, 114, 151,
-			164, 50, 9, 51, 220, 153, 117, 148, 96, 71, 175, 187, 202, 72,
-			183, 17, 230, 205, 180, 220, 108, 7, 34, 76, 151, 147, 52, 22,
-			254, 70, 16, 174, 201, 221, 246, 180, 187, 234, 183, 19, 209, 24,
-			149, 159, 23, 245, 87, 40, 129, 4, 20, 231, 74, 120, 61, 37,
-			228, 103, 83, 162, 250, 254, 65, 90, 202, 105, 97, 236, 8, 29,
-			122, 208, 191, 226, 47, 107, 205, 90, 98, 162, 4, 105, 151, 148,
-			118, 125, 51, 221, 141, 89, 162, 110, 42, 226, 229, 102, 219, 79,
-			18, 68, 90, 17, 179, 50, 248, 182, 0, 159, 102, 244, 23, 246,
-			50, 186, 11, 75, 108, 116, 219, 105, 208, 105, 139, 101, 208, 252,
-			19, 220, 114, 76, 207, 198, 32, 199, 69, 149, 1, 122, 148, 176,
-			89, 122, 8, 139, 173, 137, 80, 196, 126, 42, 150, 197, 107, 186,
-			126, 59, 89, 246, 195, 214, 242, 186, 159, 172, 143, 239, 134, 10,
-			206, 218, 227, 86, 99, 63, 100, 60, 175, 242, 213, 49, 219, 116,
-			216, 186, 219, 79, 214, 217, 105, 186, 23, 107, 73, 210, 56, 8,
-			215, 150, 155, 235, 162, 249, 208, 114, 55, 93, 189, 115, 252, 64,
-			190, 125, 236, 225, 34, 230, 153, 129, 44, 151, 211, 213, 59, 217,
-			34, 29, 130, 201, 216, 8, 30, 17, 203, 171, 81, 140, 123, 232,
-			200, 14, 172, 41, 135, 193, 218, 130, 42, 112, 49, 106, 137, 211,
-			238, 226, 165, 122, 125, 182, 81, 210, 181, 156, 139, 98, 32, 168,
-			181, 200, 32, 184, 36, 9, 106, 45, 210, 232, 125, 25, 221, 213,
-			108, 202, 49, 7, 205, 101, 165, 145, 39, 227, 229, 30, 100, 53,
-			155, 231, 101, 6, 69, 227, 9, 187, 139, 238, 201, 144, 149, 47,
-			56, 182, 109, 148, 253, 69, 95, 70, 119, 117, 182, 182, 23, 100,
-			61, 45, 118, 182, 250, 139, 221, 128, 86, 150, 88, 52, 81, 212,
-			219, 151, 207, 157, 251, 192, 106, 180, 220, 108, 46, 139, 208, 95,
-			105, 139, 101, 63, 22, 161, 159, 140, 31, 198, 204, 36, 141, 187,
-			162, 49, 210, 108, 214, 241, 227, 52, 126, 99, 39, 232, 88, 180,
-			242, 96, 83, 18, 214, 114, 39, 22, 171, 193, 195, 227, 215, 35,
-			150, 70, 225, 3, 146, 213, 37, 76, 102, 199, 105, 185, 153, 172,
-			251, 113, 7, 57, 107, 210, 241, 155, 98, 252, 6, 153, 85, 166,
-			207, 235, 100, 32, 236, 100, 51, 88, 77, 117, 141, 199, 36, 97,
-			99, 154, 170, 109, 130, 150, 59, 235, 157, 222, 134, 39, 48, 219,
-			72, 103, 189, 147, 111, 247, 40, 29, 134, 156, 89, 163, 199, 165,
-			252, 213, 89, 207, 181, 120, 27, 221, 11, 153, 54, 68, 234, 183,
-			252, 212, 207, 229, 158, 196, 220, 187, 59, 235, 157, 139, 234, 99,
-			79, 63, 227, 238, 202, 150, 161, 143, 41, 217, 79, 72, 211, 20,
-			242, 162, 213, 143, 151, 76, 217, 170, 158, 166, 67, 121, 186, 103,
-			131, 84, 82, 126, 217, 2, 33, 104, 102, 97, 22, 196, 151, 87,
-			215, 203, 54, 136, 81, 23, 230, 150, 234, 203, 141, 203, 243, 75,
-			115, 23, 235, 101, 39, 39, 216, 223, 67, 138, 39, 202, 39, 239,
-			33, 197, 27, 203, 199, 16, 61, 219, 136, 178, 250, 180, 67, 71,
-			122, 213, 114, 246, 114, 186, 79, 219, 221, 18, 145, 46, 111, 6,
-			49, 46, 214, 13, 95, 110, 156, 134, 40, 119, 171, 92, 139, 34,
-			189, 47, 136, 97, 41, 110, 248, 41, 187, 64, 15, 135, 209, 114,
-			146, 250, 97, 203, 143, 91, 203, 153, 253, 115, 217, 111, 54, 69,
-			146, 68, 114, 147, 52, 181, 28, 12, 163, 69, 149, 57, 219, 61,
-			166, 85, 214, 190, 53, 225, 92, 109, 77, 28, 160, 131, 27, 126,
-			103, 89, 132, 105, 188, 133, 178, 123, 177, 81, 220, 240, 59, 117,
-			128, 217, 189, 244, 198, 44, 235, 114, 91, 172, 249, 205, 173, 101,
-			20, 212, 209, 70, 180, 220, 140, 194, 213, 118, 208, 76, 19, 228,
-			29, 146, 255, 85, 179, 18, 23, 176, 192, 61, 73, 20, 162, 124,
-			62, 163, 115, 247, 144, 205, 208, 63, 10, 178, 233, 157, 122, 82,
-			118, 239, 33, 69, 183, 236, 221, 67, 138, 94, 185, 112, 15, 41,
-			22, 203, 131, 247, 144, 226, 96, 153, 86, 255, 229, 48, 29, 202,
-			171, 27, 160, 189, 53, 113, 195, 181, 144, 37, 31, 125, 94, 229,
-			164, 54, 3, 59, 241, 105, 79, 202, 246, 13, 89, 18, 164, 32,
-			88, 100, 66, 202, 82, 197, 134, 130, 216, 121, 234, 61, 152, 96,
-			221, 30, 214, 189, 147, 53, 59, 87, 247, 61, 139, 88, 249, 224,
-			61, 139, 203, 243, 11, 141, 139, 211, 23, 26, 170, 56, 219, 79,
-			73, 219, 127, 100, 171, 119, 207, 198, 36, 86, 163, 163, 221, 80,
-			234, 234, 48, 199, 144, 107, 52, 159, 107, 36, 251, 122, 1, 242,
-			95, 35, 93, 237, 167, 100, 83, 248, 15, 245, 238, 172, 152, 196,
-			38, 232, 80, 75, 172, 116, 215, 150, 99, 209, 242, 155, 105, 239,
-			126, 82, 194, 79, 13, 252, 194, 190, 135, 14, 194, 28, 133, 56,
-			199, 99, 136, 130, 169, 231, 71, 129, 154, 98, 93, 168, 145, 149,
-			103, 119, 211, 66, 234, 199, 107, 34, 77, 198, 119, 113, 103, 98,
-			100, 7, 27, 217, 14, 85, 45, 97, 17, 212, 148, 117, 113, 118,
-			31, 45, 43, 83, 236, 178, 82, 115, 147, 241, 221, 72, 128, 147,
-			207, 95, 165, 178, 228, 206, 202, 66, 141, 81, 209, 3, 247, 174,
-			139, 61, 223, 206, 186, 184, 76, 71, 213, 239, 229, 164, 219, 233,
-			68, 113, 58, 190, 23, 203, 191, 64, 135, 116, 101, 178, 76, 99,
-			100, 181, 7, 126, 233, 150, 91, 229, 213, 116, 164, 23, 25, 121,
-			67, 184, 115, 141, 134, 112, 80, 75, 180, 162, 6, 91, 147, 4,
-			42, 63, 105, 211, 145, 222, 129, 177, 243, 148, 233, 25, 11, 194,
-			52, 142, 90, 221, 166, 104, 169, 5, 123, 245, 118, 198, 84, 153,
-			57, 83, 36, 95, 81, 110, 21, 216, 215, 88, 209, 108, 182, 62,
-			110, 162, 187, 116, 5, 80, 217, 166, 31, 135, 32, 34, 75, 161,
-			157, 229, 62, 221, 39, 191, 176, 105, 170, 201, 101, 57, 22, 27,
-			209, 21, 209, 82, 230, 162, 171, 55, 59, 162, 10, 52, 100, 254,
-			234, 77, 212, 69, 246, 195, 40, 85, 12, 168, 60, 192, 138, 148,
-			204, 44, 52, 96, 123, 44, 211, 33, 153, 186, 124, 105, 174, 62,
-			83, 47, 219, 213, 151, 81, 79, 242, 20, 216, 58, 13, 87, 41,
-			15, 40, 80, 213, 97, 233, 175, 151, 47, 158, 173, 55, 202, 118,
-			245, 50, 29, 237, 91, 135, 108, 15, 29, 107, 212, 151, 234, 243,
-			75, 115, 11, 243, 203, 151, 231, 191, 103, 126, 225, 190, 249, 242,
-			64, 111, 178, 222, 135, 45, 182, 155, 150, 179, 228, 197, 133, 203,
-			13, 236, 205, 143, 219, 180, 220, 191, 40, 217, 62, 186, 107, 105,
-			186, 113, 190, 190, 180, 44, 13, 30, 166, 234, 221, 180, 156, 255,
-			112, 110, 14, 237, 57, 135, 233, 129, 124, 106, 253, 85, 75, 245,
-			249, 69, 108, 124, 122, 254, 60, 8, 5, 125, 245, 105, 19, 139,
-			3, 93, 237, 173, 175, 126, 97, 182, 76, 250, 147, 23, 230, 235,
-			11, 231, 202, 110, 127, 235, 104, 118, 241, 88, 133, 238, 237, 79,
-			93, 174, 207, 47, 53, 238, 47, 23, 250, 27, 94, 172, 55, 238,
-			157, 155, 169, 151, 139, 108, 47, 101, 189, 61, 90, 186, 123, 97,
-			182, 60, 184, 211, 142, 197, 202, 187, 170, 191, 96, 209, 161, 188,
-			9, 164, 135, 169, 88, 255, 216, 54, 219, 234, 167, 108, 90, 202,
-			217, 66, 64, 137, 245, 219, 237, 104, 115, 217, 111, 7, 126, 162,
-			246, 67, 138, 73, 211, 144, 114, 173, 251, 207, 181, 139, 46, 222,
-			139, 22, 93, 10, 255, 216, 176, 41, 133, 150, 234, 103, 108, 90,
-			238, 183, 142, 244, 225, 205, 186, 26, 222, 242, 227, 179, 191, 157,
-			241, 245, 239, 234, 206, 85, 119, 245, 29, 54, 43, 242, 143, 121,
-			179, 202, 147, 235, 31, 91, 116, 164, 215, 152, 211, 131, 177, 234,
-			183, 131, 177, 222, 25, 57, 114, 181, 25, 249, 255, 101, 92, 239,
-			114, 232, 112, 143, 237, 231, 90, 123, 247, 26, 58, 22, 180, 196,
-			70, 39, 74, 69, 216, 220, 90, 110, 139, 43, 162, 141, 104, 24,
-			217, 225, 116, 181, 167, 133, 218, 92, 86, 238, 2, 20, 59, 189,
-			107, 110, 182, 126, 241, 210, 194, 82, 125, 126, 230, 126, 205, 201,
-			27, 229, 160, 47, 91, 15, 194, 143, 254, 163, 88, 130, 213, 75,
-			180, 220, 63, 26, 96, 232, 59, 140, 167, 60, 192, 118, 209, 209,
-			249, 133, 229, 197, 185, 217, 250, 114, 253, 220, 185, 250, 204, 210,
-			162, 60, 104, 48, 185, 151, 202, 118, 126, 110, 222, 237, 208, 93,
-			59, 244, 132, 77, 43, 19, 161, 180, 90, 110, 23, 140, 119, 40,
-			83, 3, 237, 254, 146, 31, 167, 202, 162, 120, 156, 2, 122, 195,
-			20, 196, 251, 88, 29, 224, 72, 17, 100, 52, 75, 151, 103, 56,
-			147, 148, 117, 162, 36, 72, 131, 43, 2, 100, 40, 149, 25, 22,
-			46, 105, 148, 245, 151, 185, 48, 53, 185, 67, 177, 230, 247, 229,
-			6, 245, 195, 105, 148, 245, 23, 147, 251, 8, 29, 106, 69, 221,
-			149, 182, 80, 249, 128, 37, 91, 141, 146, 76, 51, 89, 148, 217,
-			44, 59, 102, 26, 106, 148, 100, 154, 204, 114, 140, 142, 250, 107,
-			107, 49, 84, 174, 43, 146, 134, 192, 17, 147, 140, 25, 43, 247,
-			208, 162, 198, 3, 232, 191, 128, 137, 229, 142, 180, 110, 219, 19,
-			131, 141, 98, 168, 63, 30, 161, 67, 65, 178, 156, 185, 65, 216,
-			220, 158, 40, 54, 74, 65, 98, 78, 69, 171, 63, 71, 41, 205,
-			136, 141, 189, 213, 162, 35, 114, 131, 233, 196, 34, 17, 97, 83,
-			171, 133, 59, 88, 234, 76, 41, 201, 230, 46, 169, 2, 103, 95,
-			249, 6, 203, 122, 220, 34, 143, 91, 214, 123, 173, 97, 86, 172,
-			191, 234, 210, 133, 185, 153, 185, 165, 241, 215, 23, 16, 158, 187,
-			168, 224, 47, 21, 122, 191, 127, 185, 240, 139, 150, 83, 252, 114,
-			161, 49, 188, 154, 175, 143, 181, 243, 30, 20, 246, 213, 20, 201,
-			172, 55, 117, 229, 55, 113, 246, 56, 118, 196, 195, 142, 148, 152,
-			55, 115, 97, 97, 177, 62, 139, 221, 24, 100, 100, 225, 82, 125,
-			126, 252, 75, 186, 201, 204, 217, 226, 113, 139, 238, 211, 167, 172,
-			106, 175, 21, 97, 51, 106, 105, 233, 118, 228, 212, 45, 207, 215,
-			120, 67, 21, 69, 148, 212, 85, 193, 179, 83, 219, 80, 50, 61,
-			63, 171, 250, 82, 98, 222, 165, 233, 153, 239, 169, 207, 102, 189,
-			217, 19, 239, 84, 11, 251, 65, 58, 218, 77, 87, 239, 4, 218,
-			8, 90, 242, 216, 155, 92, 237, 188, 52, 235, 209, 229, 116, 245,
-			206, 123, 77, 9, 133, 20, 217, 149, 65, 70, 230, 23, 230, 235,
-			186, 27, 120, 68, 124, 127, 214, 141, 145, 110, 79, 81, 246, 131,
-			180, 172, 205, 67, 6, 37, 238, 213, 142, 124, 179, 14, 40, 35,
-			147, 65, 198, 141, 185, 30, 236, 102, 163, 23, 234, 243, 231, 151,
-			238, 94, 190, 212, 168, 227, 201, 221, 248, 235, 117, 243, 163, 27,
-			189, 5, 217, 15, 91, 180, 36, 69, 32, 105, 147, 146, 70, 133,
-			237, 199, 29, 185, 182, 81, 2, 194, 220, 103, 239, 194, 102, 29,
-			77, 16, 251, 24, 187, 80, 63, 63, 61, 115, 255, 242, 217, 250,
-			226, 18, 112, 178, 133, 134, 164, 81, 202, 220, 233, 11, 23, 22,
-			238, 203, 16, 65, 31, 52, 213, 84, 191, 143, 14, 247, 144, 59,
-			8, 197, 40, 76, 195, 8, 22, 235, 243, 51, 121, 33, 126, 136,
-			26, 242, 46, 91, 0, 105, 226, 47, 219, 192, 70, 85, 7, 204,
-			89, 162, 83, 189, 131, 22, 53, 249, 130, 104, 142, 18, 118, 159,
-			98, 80, 164, 72, 187, 101, 11, 212, 32, 73, 211, 101, 187, 122,
-			47, 221, 179, 35, 233, 177, 163, 244, 176, 62, 191, 148, 66, 255,
-			114, 125, 126, 102, 97, 22, 212, 164, 172, 78, 74, 21, 13, 202,
-			94, 106, 250, 44, 219, 213, 57, 58, 210, 75, 64, 236, 0, 221,
-			119, 121, 233, 220, 157, 203, 247, 78, 95, 152, 155, 157, 238, 83,
-			136, 40, 85, 84, 84, 182, 161, 163, 64, 93, 101, 167, 74, 138,
-			86, 217, 170, 46, 210, 209, 62, 82, 96, 7, 233, 184, 210, 80,
-			118, 234, 21, 98, 168, 135, 56, 164, 174, 54, 91, 191, 48, 119,
-			113, 110, 9, 251, 119, 55, 165, 217, 28, 195, 158, 117, 207, 226,
-			194, 252, 242, 57, 80, 244, 150, 114, 85, 13, 82, 57, 167, 101,
-			11, 244, 145, 237, 19, 95, 182, 79, 120, 176, 99, 61, 54, 127,
-			194, 43, 62, 54, 95, 126, 11, 252, 125, 203, 124, 249, 173, 243,
-			247, 120, 197, 47, 21, 202, 95, 46, 84, 191, 230, 80, 150, 81,
-			150, 177, 121, 188, 138, 22, 141, 17, 69, 250, 108, 190, 252, 121,
-			8, 82, 23, 203, 37, 245, 25, 85, 76, 109, 160, 49, 111, 4,
-			97, 176, 209, 221, 88, 214, 150, 133, 23, 212, 152, 85, 1, 5,
-			99, 21, 254, 195, 61, 85, 184, 47, 88, 133, 44, 160, 224, 202,
-			223, 89, 116, 252, 106, 157, 125, 81, 70, 143, 121, 186, 59, 186,
-			34, 226, 56, 104, 225, 57, 134, 17, 133, 200, 11, 139, 66, 187,
-			114, 5, 207, 105, 169, 232, 44, 236, 88, 15, 3, 179, 214, 53,
-			185, 47, 92, 211, 48, 22, 209, 117, 220, 3, 4, 10, 218, 135,
-			93, 118, 50, 121, 171, 250, 75, 54, 29, 233, 117, 139, 100, 179,
-			180, 216, 142, 148, 203, 145, 156, 237, 137, 23, 240, 164, 172, 93,
-			80, 249, 27, 166, 100, 229, 247, 44, 90, 212, 201, 108, 47, 37,
-			29, 63, 93, 199, 234, 220, 179, 118, 217, 106, 32, 12, 233, 73,
-			199, 151, 238, 86, 42, 29, 96, 144, 121, 218, 194, 111, 225, 9,
-			92, 180, 177, 33, 194, 52, 209, 50, 143, 74, 159, 81, 201, 236,
-			36, 29, 75, 99, 63, 104, 247, 228, 37, 152, 183, 172, 63, 152,
-			204, 167, 233, 126, 93, 111, 75, 164, 126, 115, 93, 180, 178, 66,
-			30, 158, 180, 239, 83, 25, 102, 213, 119, 93, 182, 207, 245, 248,
-			147, 54, 29, 211, 39, 136, 45, 131, 186, 139, 148, 250, 97, 24,
-			165, 121, 228, 109, 23, 250, 182, 149, 171, 77, 155, 66, 141, 92,
-			5, 149, 175, 90, 148, 102, 159, 174, 138, 197, 195, 180, 164, 92,
-			96, 209, 171, 90, 26, 218, 168, 76, 58, 23, 180, 209, 53, 96,
-			69, 172, 5, 161, 242, 105, 146, 128, 118, 13, 32, 153, 207, 95,
-			131, 22, 19, 177, 225, 135, 105, 208, 84, 107, 104, 187, 87, 195,
-			243, 117, 190, 182, 168, 74, 55, 76, 61, 213, 9, 90, 212, 169,
-			134, 91, 14, 176, 2, 117, 22, 235, 176, 95, 32, 211, 154, 155,
-			94, 44, 219, 39, 126, 206, 166, 5, 189, 168, 119, 209, 209, 250,
-			236, 92, 31, 227, 221, 69, 71, 116, 162, 228, 110, 229, 215, 23,
-			242, 137, 151, 26, 11, 75, 11, 167, 202, 79, 109, 79, 188, 181,
-			252, 165, 2, 27, 163, 67, 58, 241, 212, 205, 167, 110, 45, 127,
-			185, 63, 233, 182, 242, 87, 208, 198, 163, 147, 110, 89, 94, 2,
-			238, 185, 48, 127, 225, 254, 178, 149, 255, 112, 42, 247, 193, 102,
-			135, 232, 62, 253, 225, 174, 187, 238, 186, 235, 142, 220, 199, 127,
-			249, 38, 175, 255, 243, 157, 185, 207, 255, 106, 251, 231, 187, 114,
-			159, 159, 120, 147, 199, 118, 209, 146, 254, 124, 113, 250, 85, 229,
-			231, 158, 123, 238, 185, 194, 217, 31, 164, 187, 154, 209, 70, 255,
-			212, 156, 45, 247, 57, 40, 36, 119, 91, 175, 158, 82, 153, 214,
-			162, 182, 31, 174, 213, 162, 120, 45, 243, 222, 7, 97, 51, 201,
-			249, 240, 119, 86, 254, 206, 178, 222, 107, 59, 231, 47, 157, 125,
-			159, 93, 57, 47, 11, 94, 210, 19, 223, 16, 171, 109, 209, 132,
-			217, 185, 231, 243, 191, 230, 208, 2, 115, 111, 28, 120, 55, 181,
-			232, 251, 70, 169, 53, 196, 156, 27, 7, 216, 169, 255, 52, 196,
-			49, 123, 51, 106, 243, 179, 221, 213, 85, 17, 39, 124, 138, 203,
-			138, 142, 37, 188, 229, 167, 62, 71, 125, 167, 185, 238, 135, 107,
-			130, 75, 25, 135, 242, 153, 168, 179, 21, 7, 107, 235, 41, 63,
-			117, 243, 205, 119, 170, 2, 124, 46, 108, 214, 56, 159, 110, 183,
-			57, 126, 75, 184, 246, 129, 169, 81, 190, 158, 166, 157, 228, 244,
-			77, 55, 181, 64, 141, 139, 58, 34, 78, 52, 54, 154, 209, 134,
-			28, 97, 51, 106, 79, 173, 200, 78, 220, 68, 41, 111, 136, 86,
-			0, 154, 200, 74, 23, 134, 192, 253, 176, 197, 187, 137, 224, 65,
-			200, 229, 66, 193, 148, 149, 32, 244, 227, 45, 236, 87, 50, 201,
-			55, 131, 116, 157, 71, 49, 254, 141, 186, 41, 229, 27, 81, 203,
-			248, 33, 78, 114, 63, 22, 188, 35, 226, 141, 32, 77, 69, 139,
-			119, 226, 232, 74, 208, 18, 45, 158, 174, 251, 41, 79, 215, 97,
-			116, 237, 118, 180, 25, 132, 107, 188, 25, 133, 146, 174, 19, 40,
-			68, 249, 134, 72, 79, 83, 202, 225, 223, 137, 190, 142, 37, 60,
-			90, 213, 61, 106, 70, 45, 193, 55, 186, 73, 202, 99, 145, 250,
-			65, 136, 181, 250, 43, 209, 21, 248, 164, 48, 70, 121, 24, 165,
-			65, 83, 76, 242, 116, 61, 72, 120, 59, 72, 82, 168, 33, 223,
-			98, 216, 234, 235, 78, 43, 72, 154, 109, 63, 216, 16, 113, 237,
-			106, 157, 8, 194, 60, 46, 116, 39, 58, 210, 196, 158, 245, 131,
-			102, 29, 249, 7, 245, 131, 114, 53, 186, 86, 212, 236, 2, 187,
-			245, 245, 36, 221, 20, 197, 60, 74, 215, 69, 204, 55, 252, 84,
-			196, 129, 223, 78, 50, 84, 227, 4, 165, 235, 130, 242, 124, 239,
-			205, 160, 230, 69, 128, 37, 161, 98, 208, 23, 161, 67, 121, 218,
-			10, 163, 236, 27, 226, 61, 72, 19, 24, 81, 40, 171, 138, 226,
-			132, 111, 248, 91, 124, 69, 0, 165, 180, 120, 26, 113, 17, 182,
-			162, 56, 17, 64, 20, 157, 56, 218, 136, 82, 193, 37, 78, 210,
-			132, 183, 68, 28, 92, 17, 45, 190, 26, 71, 27, 84, 98, 33,
-			137, 86, 211, 77, 32, 19, 69, 65, 60, 233, 136, 38, 80, 16,
-			239, 196, 1, 16, 86, 12, 180, 19, 74, 42, 74, 18, 236, 59,
-			229, 75, 119, 207, 45, 242, 197, 133, 115, 75, 247, 77, 55, 234,
-			124, 110, 145, 95, 106, 44, 220, 59, 55, 91, 159, 229, 103, 239,
-			231, 75, 119, 215, 249, 204, 194, 165, 251, 27, 115, 231, 239, 94,
-			226, 119, 47, 92, 152, 173, 55, 22, 249, 244, 252, 44, 159, 89,
-			152, 95, 106, 204, 157, 189, 188, 180, 208, 88, 164, 188, 58, 189,
-			200, 231, 22, 171, 248, 101, 122, 254, 126, 94, 127, 21, 136, 238,
-			139, 124, 161, 193, 81, 62, 175, 207, 242, 251, 166, 27, 141, 233,
-			249, 165, 185, 250, 226, 36, 159, 155, 159, 185, 112, 25, 68, 211,
-			73, 126, 246, 242, 18, 159, 95, 88, 162, 92, 9, 160, 124, 105,
-			97, 18, 155, 221, 94, 142, 47, 156, 227, 23, 235, 141, 153, 187,
-			167, 231, 151, 166, 207, 206, 93, 152, 91, 186, 31, 27, 60, 55,
-			183, 52, 15, 141, 157, 91, 104, 80, 62, 205, 47, 77, 55, 150,
-			230, 102, 46, 95, 152, 110, 240, 75, 151, 27, 151, 22, 22, 235,
-			28, 70, 54, 59, 183, 56, 115, 97, 122, 238, 98, 125, 182, 198,
-			231, 230, 249, 252, 2, 175, 223, 91, 159, 95, 226, 139, 119, 79,
-			95, 184, 208, 59, 80, 202, 23, 238, 155, 175, 55, 160, 247, 249,
-			97, 242, 179, 117, 126, 97, 110, 250, 236, 133, 58, 52, 133, 227,
-			156, 157, 107, 212, 103, 150, 96, 64, 217, 175, 153, 185, 217, 250,
-			252, 210, 244, 133, 73, 202, 23, 47, 213, 103, 230, 166, 47, 76,
-			242, 250, 171, 234, 23, 47, 93, 152, 110, 220, 63, 169, 42, 93,
-			172, 255, 147, 203, 245, 249, 165, 185, 233, 11, 124, 118, 250, 226,
-			244, 249, 250, 34, 159, 120, 33, 172, 92, 106, 44, 204, 92, 110,
-			212, 47, 66, 175, 23, 206, 241, 197, 203, 103, 23, 151, 230, 150,
-			46, 47, 213, 249, 249, 133, 133, 89, 68, 182, 58, 35, 88, 60,
-			195, 47, 44, 44, 34, 194, 46, 47, 214, 39, 41, 159, 157, 94,
-			154, 198, 166, 47, 53, 22, 206, 205, 45, 45, 158, 129, 223, 103,
-			47, 47, 206, 33, 226, 230, 230, 151, 234, 141, 198, 101, 244, 211,
-			60, 206, 239, 94, 184, 175, 126, 111, 189, 193, 103, 166, 47, 47,
-			214, 103, 17, 195, 11, 243, 48, 90, 160, 149, 250, 66, 227, 126,
-			168, 22, 240, 128, 51, 48, 201, 239, 187, 187, 190, 116, 119, 189,
-			1, 72, 69, 108, 77, 3, 26, 22, 151, 26, 115, 51, 75, 249,
-			108, 11, 13, 190, 180, 208, 88, 162, 185, 113, 242, 249, 250, 249,
-			11, 115, 231, 65, 195, 131, 207, 11, 80, 205, 125, 115, 139, 245,
-			227, 124, 186, 49, 183, 8, 25, 230, 176, 97, 126, 223, 244, 253,
-			124, 225, 50, 142, 26, 38, 234, 242, 98, 157, 202, 223, 57, 210,
-			157, 196, 249, 228, 115, 231, 248, 244, 236, 189, 115, 208, 115, 149,
-			251, 210, 194, 226, 226, 156, 34, 23, 68, 219, 204, 221, 10, 231,
-			53, 122, 234, 73, 155, 79, 119, 211, 245, 40, 62, 205, 31, 18,
-			97, 26, 133, 223, 157, 49, 118, 62, 241, 61, 152, 196, 239, 245,
-			227, 150, 127, 156, 114, 126, 214, 135, 149, 25, 133, 60, 138, 131,
-			181, 32, 244, 219, 219, 55, 160, 150, 72, 130, 181, 144, 175, 108,
-			81, 206, 23, 253, 240, 65, 127, 139, 159, 95, 23, 27, 254, 166,
-			159, 78, 242, 123, 196, 234, 42, 159, 21, 62, 176, 243, 176, 37,
-			57, 77, 130, 139, 112, 93, 112, 165, 155, 39, 146, 57, 5, 9,
-			7, 241, 138, 203, 253, 114, 69, 114, 193, 150, 88, 13, 66, 197,
-			224, 86, 163, 110, 216, 130, 188, 114, 71, 198, 220, 73, 13, 22,
-			0, 26, 51, 242, 201, 188, 233, 135, 192, 87, 210, 216, 15, 147,
-			54, 72, 83, 188, 21, 196, 162, 153, 182, 183, 128, 205, 248, 124,
-			135, 91, 109, 212, 112, 17, 63, 220, 82, 60, 49, 8, 229, 22,
-			10, 204, 114, 66, 212, 214, 106, 38, 79, 44, 165, 88, 96, 105,
-			60, 216, 232, 68, 113, 154, 28, 175, 81, 90, 164, 150, 205, 156,
-			137, 129, 113, 248, 85, 100, 206, 137, 129, 89, 58, 72, 237, 98,
-			73, 254, 148, 137, 39, 7, 38, 49, 209, 146, 63, 101, 226, 228,
-			192, 45, 152, 168, 126, 202, 196, 169, 129, 59, 48, 241, 6, 249,
-			83, 38, 214, 6, 142, 96, 226, 245, 242, 167, 76, 188, 105, 224,
-			48, 38, 30, 150, 63, 101, 226, 173, 3, 7, 233, 15, 81, 187,
-			56, 136, 63, 43, 41, 239, 191, 79, 40, 55, 158, 21, 193, 181,
-			71, 92, 11, 246, 35, 96, 163, 162, 197, 87, 68, 211, 135, 45,
-			60, 54, 130, 201, 212, 10, 144, 3, 229, 126, 123, 45, 138, 131,
-			116, 125, 35, 225, 173, 40, 60, 150, 242, 205, 40, 126, 136, 183,
-			186, 49, 96, 100, 37, 138, 210, 36, 141, 253, 78, 39, 8, 215,
-			106, 148, 62, 72, 109, 50, 192, 200, 29, 3, 223, 109, 85, 126,
-			0, 231, 93, 139, 15, 188, 25, 109, 116, 130, 182, 136, 113, 186,
-			164, 147, 230, 182, 185, 89, 20, 41, 110, 25, 126, 16, 66, 237,
-			64, 20, 178, 239, 84, 18, 0, 15, 82, 222, 241, 227, 4, 72,
-			129, 82, 234, 144, 1, 139, 57, 119, 20, 247, 211, 18, 37, 100,
-			192, 30, 96, 206, 157, 246, 4, 29, 162, 46, 0, 4, 32, 170,
-			33, 143, 57, 119, 150, 174, 211, 144, 197, 156, 59, 15, 31, 213,
-			144, 195, 156, 59, 111, 60, 70, 111, 132, 10, 93, 70, 206, 216,
-			175, 116, 43, 251, 185, 177, 121, 38, 136, 167, 52, 138, 218, 114,
-			144, 216, 152, 59, 192, 156, 51, 195, 123, 101, 21, 46, 84, 152,
-			131, 108, 9, 77, 81, 219, 29, 96, 100, 102, 160, 101, 85, 142,
-			32, 54, 86, 187, 237, 54, 79, 4, 110, 231, 15, 133, 209, 102,
-			200, 149, 150, 171, 6, 132, 21, 205, 184, 67, 244, 52, 37, 46,
-			14, 168, 110, 239, 173, 76, 241, 105, 222, 105, 251, 77, 177, 30,
-			181, 91, 34, 198, 238, 248, 33, 239, 134, 61, 85, 112, 52, 1,
-			215, 112, 196, 174, 28, 99, 221, 30, 211, 144, 205, 156, 250, 238,
-			61, 244, 93, 22, 86, 108, 49, 103, 206, 222, 87, 121, 204, 234,
-			171, 89, 87, 165, 8, 163, 25, 172, 110, 161, 112, 33, 213, 119,
-			190, 34, 214, 253, 43, 1, 236, 229, 39, 86, 196, 106, 20, 139,
-			19, 220, 231, 74, 253, 165, 124, 211, 135, 85, 29, 39, 41, 207,
-			124, 14, 106, 156, 47, 193, 106, 15, 18, 46, 86, 87, 129, 178,
-			174, 136, 246, 22, 244, 190, 26, 132, 184, 220, 5, 239, 248, 73,
-			90, 53, 253, 182, 176, 111, 101, 13, 217, 204, 153, 219, 181, 151,
-			254, 176, 141, 253, 182, 153, 179, 96, 239, 171, 60, 109, 113, 121,
-			96, 201, 229, 173, 66, 94, 213, 104, 172, 98, 131, 34, 1, 194,
-			19, 83, 45, 63, 21, 6, 195, 147, 124, 165, 171, 134, 0, 146,
-			96, 115, 157, 183, 131, 135, 148, 184, 19, 132, 205, 52, 155, 10,
-			93, 69, 211, 7, 122, 207, 137, 44, 10, 37, 72, 152, 26, 85,
-			209, 42, 207, 147, 168, 108, 69, 225, 164, 135, 165, 225, 242, 75,
-			186, 157, 78, 123, 75, 150, 56, 37, 101, 235, 91, 53, 122, 145,
-			206, 40, 95, 241, 155, 15, 109, 250, 113, 43, 193, 37, 227, 167,
-			193, 74, 208, 14, 210, 45, 131, 32, 152, 188, 5, 131, 32, 68,
-			201, 174, 189, 64, 148, 238, 128, 237, 48, 231, 146, 189, 79, 125,
-			114, 44, 128, 116, 70, 199, 102, 206, 165, 93, 123, 233, 7, 37,
-			5, 16, 230, 92, 182, 247, 86, 126, 202, 226, 117, 45, 84, 162,
-			176, 141, 216, 89, 17, 34, 228, 177, 104, 11, 96, 1, 18, 29,
-			153, 184, 133, 100, 134, 194, 55, 247, 227, 149, 32, 141, 65, 178,
-			245, 195, 22, 229, 201, 122, 212, 109, 183, 64, 120, 5, 164, 169,
-			91, 187, 176, 163, 72, 164, 164, 235, 98, 139, 111, 6, 237, 54,
-			247, 219, 155, 254, 86, 130, 76, 59, 216, 16, 83, 81, 220, 18,
-			177, 98, 71, 194, 79, 182, 168, 28, 121, 28, 36, 40, 196, 201,
-			254, 19, 11, 122, 60, 162, 33, 155, 57, 151, 199, 246, 168, 97,
-			187, 204, 185, 215, 222, 171, 62, 185, 22, 64, 58, 163, 107, 51,
-			231, 222, 177, 61, 244, 159, 97, 70, 143, 57, 175, 182, 43, 149,
-			14, 191, 180, 157, 232, 213, 50, 23, 64, 13, 107, 102, 6, 99,
-			145, 68, 109, 41, 10, 107, 178, 232, 25, 38, 149, 196, 17, 197,
-			128, 175, 64, 237, 159, 221, 52, 9, 90, 40, 34, 67, 117, 137,
-			25, 131, 103, 65, 251, 123, 52, 100, 51, 231, 213, 227, 251, 213,
-			24, 10, 204, 249, 94, 187, 162, 62, 21, 44, 128, 116, 198, 130,
-			205, 156, 239, 53, 25, 139, 204, 249, 62, 187, 170, 62, 193, 190,
-			242, 125, 246, 126, 13, 217, 204, 249, 190, 131, 71, 84, 198, 65,
-			230, 124, 191, 201, 56, 104, 1, 164, 51, 14, 218, 204, 249, 126,
-			147, 145, 50, 231, 7, 76, 70, 106, 1, 164, 51, 82, 155, 57,
-			63, 112, 240, 8, 253, 101, 73, 53, 37, 230, 52, 237, 3, 149,
-			247, 89, 61, 8, 236, 227, 22, 221, 112, 5, 118, 111, 209, 50,
-			107, 68, 29, 123, 107, 102, 160, 16, 24, 133, 237, 45, 202, 197,
-			21, 17, 155, 53, 182, 178, 197, 59, 237, 238, 90, 160, 169, 17,
-			246, 10, 241, 112, 71, 52, 83, 88, 126, 33, 230, 141, 197, 107,
-			186, 1, 16, 95, 184, 197, 165, 238, 155, 112, 88, 126, 170, 17,
-			238, 243, 80, 108, 234, 166, 13, 234, 75, 22, 116, 125, 88, 67,
-			54, 115, 154, 229, 10, 189, 153, 2, 93, 185, 107, 3, 111, 180,
-			172, 202, 81, 62, 171, 196, 145, 132, 251, 72, 130, 109, 145, 138,
-			188, 168, 161, 118, 29, 96, 81, 107, 197, 3, 244, 46, 74, 136,
-			5, 76, 122, 221, 62, 80, 157, 148, 194, 8, 232, 63, 147, 64,
-			11, 120, 226, 7, 189, 142, 163, 40, 205, 41, 162, 105, 44, 132,
-			220, 149, 44, 220, 163, 214, 109, 3, 185, 204, 89, 47, 141, 105,
-			200, 98, 206, 58, 219, 171, 33, 135, 57, 235, 251, 43, 244, 4,
-			54, 105, 49, 39, 176, 175, 171, 30, 226, 40, 166, 84, 87, 163,
-			168, 58, 137, 127, 106, 43, 126, 92, 157, 228, 34, 109, 214, 116,
-			173, 22, 129, 204, 6, 114, 153, 19, 152, 54, 96, 32, 1, 219,
-			175, 33, 135, 57, 193, 193, 67, 244, 54, 108, 195, 102, 78, 219,
-			62, 82, 57, 198, 231, 181, 70, 167, 182, 96, 20, 128, 228, 92,
-			25, 65, 206, 180, 6, 124, 165, 109, 90, 131, 133, 217, 54, 173,
-			65, 183, 219, 236, 160, 134, 28, 230, 180, 15, 115, 250, 79, 176,
-			53, 135, 57, 161, 61, 81, 153, 229, 120, 63, 78, 182, 7, 92,
-			86, 198, 4, 200, 26, 85, 125, 80, 10, 174, 137, 0, 32, 117,
-			100, 84, 163, 77, 87, 28, 2, 117, 26, 200, 101, 78, 88, 42,
-			107, 200, 98, 78, 56, 86, 213, 16, 180, 126, 195, 49, 250, 8,
-			118, 133, 48, 231, 53, 246, 141, 149, 141, 254, 174, 108, 10, 255,
-			161, 107, 235, 72, 141, 242, 115, 81, 172, 212, 227, 41, 52, 210,
-			128, 52, 189, 17, 172, 201, 219, 160, 72, 249, 53, 62, 27, 33,
-			15, 233, 38, 89, 159, 9, 54, 110, 32, 151, 57, 175, 49, 125,
-			6, 6, 248, 154, 49, 174, 33, 135, 57, 175, 57, 122, 3, 189,
-			29, 251, 236, 50, 39, 181, 39, 43, 199, 209, 194, 147, 70, 157,
-			41, 244, 75, 232, 217, 126, 242, 114, 183, 105, 207, 37, 80, 208,
-			64, 30, 115, 210, 82, 69, 67, 22, 115, 210, 3, 199, 52, 228,
-			48, 39, 61, 113, 18, 133, 31, 11, 248, 104, 215, 158, 82, 159,
-			60, 2, 144, 174, 196, 131, 111, 74, 210, 178, 144, 227, 117, 15,
-			79, 104, 200, 97, 78, 247, 228, 164, 170, 164, 192, 156, 43, 118,
-			77, 125, 42, 16, 128, 116, 37, 5, 143, 57, 87, 74, 71, 52,
-			100, 49, 231, 74, 245, 184, 134, 28, 230, 92, 153, 156, 82, 149,
-			20, 153, 179, 105, 42, 41, 18, 128, 116, 37, 69, 143, 57, 155,
-			165, 195, 26, 178, 152, 179, 201, 117, 37, 69, 135, 57, 155, 166,
-			146, 65, 230, 108, 217, 71, 213, 167, 65, 2, 144, 174, 100, 208,
-			99, 206, 86, 73, 47, 67, 224, 162, 91, 251, 244, 224, 6, 29,
-			230, 108, 29, 169, 210, 167, 45, 172, 133, 50, 231, 135, 236, 155,
-			42, 79, 89, 146, 203, 225, 89, 176, 22, 103, 19, 174, 175, 100,
-			246, 232, 25, 254, 74, 36, 55, 198, 76, 231, 202, 217, 171, 106,
-			148, 223, 31, 117, 209, 110, 146, 248, 171, 32, 55, 73, 79, 72,
-			57, 145, 34, 76, 129, 13, 202, 102, 180, 170, 178, 238, 199, 27,
-			192, 131, 227, 110, 8, 91, 43, 229, 171, 221, 176, 41, 27, 14,
-			210, 45, 77, 202, 153, 106, 144, 240, 169, 41, 76, 202, 247, 42,
-			72, 120, 40, 132, 220, 186, 219, 91, 168, 237, 41, 219, 224, 134,
-			8, 83, 148, 132, 19, 67, 66, 148, 192, 176, 13, 228, 49, 231,
-			135, 74, 154, 163, 192, 94, 242, 67, 149, 19, 26, 114, 152, 243,
-			67, 83, 53, 250, 179, 18, 93, 37, 70, 126, 196, 178, 175, 171,
-			188, 211, 146, 242, 133, 148, 228, 244, 186, 207, 241, 92, 249, 89,
-			114, 119, 209, 202, 203, 31, 85, 41, 71, 1, 243, 147, 162, 84,
-			85, 170, 158, 57, 113, 144, 82, 62, 183, 202, 31, 80, 9, 15,
-			192, 216, 164, 167, 66, 170, 172, 107, 88, 157, 209, 141, 122, 74,
-			14, 99, 183, 75, 4, 59, 106, 64, 23, 192, 210, 152, 6, 45,
-			0, 217, 184, 6, 29, 0, 15, 28, 162, 53, 28, 227, 16, 35,
-			111, 176, 108, 94, 145, 34, 84, 78, 100, 236, 31, 163, 42, 62,
-			68, 176, 128, 1, 61, 0, 75, 76, 131, 22, 128, 187, 42, 26,
-			116, 0, 60, 116, 152, 30, 167, 32, 15, 121, 63, 110, 13, 252,
-			130, 101, 85, 14, 244, 108, 99, 74, 239, 230, 233, 86, 71, 160,
-			238, 226, 16, 219, 98, 228, 199, 173, 34, 136, 137, 132, 216, 246,
-			0, 35, 111, 178, 236, 3, 88, 169, 13, 91, 18, 128, 84, 131,
-			46, 128, 106, 180, 54, 108, 74, 228, 77, 22, 219, 171, 65, 7,
-			192, 253, 21, 85, 147, 197, 200, 63, 183, 236, 19, 234, 163, 69,
-			16, 212, 53, 89, 30, 128, 165, 195, 26, 196, 204, 252, 6, 13,
-			58, 0, 78, 28, 87, 53, 217, 140, 188, 197, 178, 107, 234, 163,
-			77, 16, 212, 53, 217, 30, 128, 166, 38, 104, 246, 45, 22, 63,
-			174, 65, 7, 192, 201, 41, 85, 147, 195, 200, 219, 44, 251, 164,
-			250, 232, 16, 4, 117, 77, 142, 7, 96, 169, 162, 65, 11, 192,
-			3, 55, 106, 16, 203, 30, 63, 161, 106, 34, 140, 252, 132, 101,
-			79, 169, 143, 68, 130, 186, 38, 226, 1, 88, 186, 78, 131, 22,
-			128, 135, 39, 52, 232, 0, 120, 114, 146, 142, 64, 77, 206, 0,
-			243, 222, 97, 217, 239, 182, 28, 249, 217, 1, 172, 190, 195, 162,
-			227, 244, 32, 245, 0, 132, 41, 249, 73, 139, 28, 170, 14, 241,
-			185, 176, 217, 238, 38, 1, 110, 107, 163, 180, 32, 191, 18, 252,
-			60, 148, 37, 184, 144, 48, 204, 178, 4, 11, 18, 118, 141, 103,
-			9, 14, 36, 28, 56, 104, 90, 176, 24, 121, 167, 69, 14, 84,
-			135, 120, 253, 225, 237, 45, 192, 212, 189, 51, 223, 130, 229, 66,
-			66, 174, 5, 11, 43, 216, 181, 55, 75, 112, 32, 97, 127, 133,
-			142, 168, 22, 108, 70, 222, 101, 145, 155, 76, 6, 192, 223, 187,
-			242, 117, 194, 52, 190, 203, 26, 174, 102, 9, 22, 36, 28, 61,
-			145, 37, 56, 144, 48, 85, 83, 19, 224, 50, 242, 47, 50, 162,
-			112, 9, 130, 122, 2, 92, 15, 192, 210, 126, 13, 90, 0, 86,
-			52, 81, 184, 14, 128, 134, 40, 60, 70, 254, 165, 101, 223, 164,
-			62, 122, 4, 65, 93, 147, 135, 95, 13, 121, 121, 22, 128, 92,
-			83, 181, 231, 0, 104, 250, 84, 96, 228, 9, 203, 214, 20, 83,
-			32, 8, 234, 154, 10, 30, 128, 166, 79, 5, 11, 192, 202, 17,
-			13, 58, 0, 94, 127, 3, 253, 5, 11, 169, 194, 98, 222, 79,
-			91, 246, 207, 89, 78, 229, 95, 88, 28, 175, 33, 3, 163, 208,
-			231, 52, 60, 245, 215, 184, 188, 44, 156, 212, 120, 99, 135, 84,
-			220, 39, 148, 238, 165, 132, 105, 42, 119, 135, 4, 212, 19, 227,
-			213, 197, 99, 41, 51, 43, 217, 37, 241, 55, 140, 117, 46, 87,
-			177, 202, 180, 225, 111, 225, 169, 8, 143, 174, 136, 184, 237, 119,
-			20, 167, 178, 29, 32, 128, 159, 182, 232, 62, 69, 81, 32, 6,
-			147, 159, 185, 26, 205, 162, 164, 11, 159, 135, 178, 4, 23, 18,
-			12, 69, 161, 188, 75, 126, 38, 163, 89, 20, 121, 201, 207, 100,
-			52, 11, 82, 47, 249, 217, 171, 209, 44, 202, 185, 240, 57, 107,
-			1, 104, 246, 103, 243, 45, 88, 88, 129, 161, 89, 20, 120, 201,
-			207, 102, 236, 171, 200, 200, 207, 91, 246, 164, 154, 158, 34, 65,
-			80, 207, 101, 209, 3, 176, 52, 174, 65, 11, 192, 253, 199, 52,
-			232, 0, 120, 226, 36, 125, 29, 206, 165, 61, 200, 200, 191, 179,
-			236, 27, 42, 221, 12, 161, 114, 159, 198, 227, 145, 73, 190, 185,
-			30, 52, 215, 119, 152, 48, 61, 95, 59, 205, 13, 229, 211, 124,
-			45, 184, 34, 66, 121, 254, 2, 133, 229, 246, 44, 50, 34, 137,
-			194, 166, 222, 76, 108, 16, 100, 160, 23, 6, 116, 1, 52, 172,
-			124, 208, 2, 144, 105, 50, 31, 116, 0, 172, 94, 79, 135, 40,
-			176, 43, 239, 3, 214, 192, 159, 89, 22, 238, 23, 192, 22, 63,
-			96, 21, 15, 209, 5, 74, 136, 3, 19, 253, 33, 203, 62, 93,
-			153, 150, 246, 61, 63, 78, 68, 204, 147, 52, 138, 133, 22, 114,
-			208, 78, 215, 138, 68, 18, 30, 75, 121, 44, 154, 209, 90, 24,
-			60, 34, 248, 186, 136, 69, 141, 47, 10, 97, 4, 117, 104, 218,
-			65, 218, 248, 144, 238, 167, 99, 15, 120, 0, 42, 86, 234, 32,
-			93, 124, 200, 58, 124, 171, 6, 29, 0, 111, 191, 11, 89, 169,
-			3, 172, 244, 23, 45, 251, 191, 40, 86, 234, 32, 43, 253, 69,
-			139, 238, 161, 223, 67, 61, 0, 161, 183, 31, 181, 200, 117, 149,
-			51, 114, 7, 54, 139, 64, 46, 26, 46, 99, 203, 168, 227, 47,
-			133, 245, 190, 149, 162, 104, 204, 81, 156, 247, 163, 154, 198, 28,
-			197, 121, 63, 170, 105, 204, 81, 156, 247, 163, 214, 174, 253, 89,
-			130, 3, 9, 7, 15, 209, 71, 84, 135, 44, 70, 126, 217, 34,
-			213, 202, 131, 198, 32, 184, 53, 245, 154, 174, 223, 198, 27, 103,
-			230, 104, 173, 183, 31, 72, 21, 53, 40, 16, 103, 162, 138, 207,
-			149, 119, 5, 229, 173, 40, 5, 146, 89, 141, 163, 48, 213, 165,
-			209, 212, 8, 213, 229, 250, 15, 107, 228, 151, 243, 253, 135, 53,
-			242, 203, 214, 240, 174, 44, 1, 187, 183, 251, 80, 150, 224, 64,
-			2, 63, 66, 127, 202, 82, 3, 176, 25, 249, 184, 69, 14, 85,
-			222, 98, 237, 56, 2, 16, 52, 158, 127, 24, 151, 67, 105, 120,
-			211, 55, 112, 107, 80, 100, 146, 231, 194, 229, 96, 138, 28, 40,
-			218, 163, 204, 80, 113, 164, 171, 81, 108, 142, 19, 40, 202, 123,
-			34, 236, 110, 36, 185, 113, 194, 94, 243, 241, 252, 56, 97, 239,
-			248, 120, 126, 156, 48, 13, 31, 183, 118, 143, 103, 9, 14, 36,
-			28, 56, 72, 127, 79, 143, 211, 97, 228, 215, 44, 114, 184, 242,
-			113, 11, 36, 200, 52, 238, 138, 73, 30, 132, 173, 160, 233, 167,
-			34, 201, 78, 166, 21, 33, 5, 217, 145, 58, 223, 153, 142, 38,
-			101, 95, 253, 112, 171, 31, 37, 230, 236, 85, 87, 134, 70, 178,
-			85, 63, 0, 93, 78, 27, 206, 97, 229, 164, 40, 178, 82, 190,
-			185, 46, 66, 238, 103, 196, 219, 95, 95, 144, 240, 150, 104, 139,
-			84, 180, 114, 72, 1, 217, 231, 215, 242, 72, 113, 92, 72, 24,
-			30, 203, 18, 44, 72, 96, 149, 44, 1, 113, 112, 232, 58, 250,
-			99, 26, 41, 132, 145, 223, 0, 164, 60, 242, 188, 56, 201, 250,
-			163, 137, 21, 53, 81, 209, 226, 62, 160, 73, 186, 29, 214, 40,
-			95, 72, 215, 69, 188, 25, 36, 226, 133, 75, 105, 245, 41, 55,
-			32, 34, 59, 147, 13, 136, 184, 144, 144, 27, 16, 136, 97, 191,
-			145, 31, 16, 8, 98, 191, 1, 3, 186, 94, 114, 139, 65, 70,
-			126, 203, 34, 163, 213, 61, 74, 179, 2, 212, 45, 235, 14, 82,
-			148, 101, 32, 215, 0, 100, 27, 30, 209, 213, 12, 194, 42, 239,
-			77, 176, 85, 194, 135, 45, 228, 145, 22, 35, 191, 103, 217, 231,
-			42, 239, 181, 208, 18, 0, 35, 67, 19, 64, 55, 17, 113, 114,
-			154, 207, 46, 240, 249, 133, 37, 126, 121, 177, 94, 227, 247, 9,
-			84, 101, 20, 205, 116, 226, 168, 41, 18, 180, 57, 68, 29, 161,
-			252, 41, 112, 141, 103, 216, 201, 197, 195, 146, 212, 255, 176, 104,
-			118, 209, 104, 105, 76, 13, 205, 182, 240, 195, 110, 39, 225, 210,
-			76, 15, 252, 88, 158, 146, 41, 115, 165, 238, 80, 123, 203, 112,
-			97, 224, 13, 191, 151, 113, 97, 16, 215, 127, 207, 42, 237, 213,
-			32, 142, 104, 95, 85, 131, 14, 128, 55, 220, 168, 193, 34, 128,
-			199, 234, 18, 97, 182, 85, 28, 3, 120, 98, 150, 190, 2, 177,
-			97, 51, 242, 41, 203, 190, 190, 114, 19, 159, 14, 183, 180, 101,
-			53, 49, 179, 171, 55, 59, 109, 96, 206, 76, 119, 178, 118, 32,
-			186, 79, 101, 61, 3, 233, 237, 83, 86, 105, 143, 6, 45, 0,
-			247, 234, 237, 2, 150, 237, 167, 172, 35, 71, 233, 119, 67, 211,
-			100, 128, 121, 127, 100, 217, 159, 177, 156, 202, 205, 200, 173, 242,
-			65, 186, 120, 146, 250, 233, 14, 108, 74, 115, 125, 172, 144, 192,
-			92, 255, 145, 85, 24, 167, 51, 48, 54, 60, 101, 34, 127, 98,
-			145, 221, 149, 91, 165, 177, 37, 95, 212, 216, 138, 176, 138, 28,
-			25, 203, 117, 170, 105, 87, 158, 64, 65, 45, 163, 89, 130, 13,
-			9, 108, 151, 68, 33, 193, 125, 226, 211, 22, 217, 101, 50, 88,
-			50, 97, 36, 75, 176, 33, 97, 140, 209, 55, 75, 154, 115, 152,
-			247, 89, 203, 254, 156, 117, 166, 242, 131, 47, 48, 84, 53, 64,
-			190, 180, 48, 187, 112, 154, 175, 182, 131, 142, 62, 127, 197, 83,
-			158, 52, 226, 185, 120, 96, 40, 83, 112, 191, 221, 230, 98, 163,
-			147, 110, 41, 193, 144, 34, 209, 110, 248, 241, 67, 114, 121, 102,
-			241, 194, 204, 180, 1, 191, 249, 108, 54, 109, 160, 107, 125, 214,
-			42, 29, 212, 160, 5, 224, 161, 227, 26, 116, 0, 156, 156, 210,
-			96, 145, 145, 207, 89, 222, 105, 13, 22, 0, 28, 59, 160, 232,
-			203, 1, 250, 250, 156, 117, 232, 46, 250, 106, 16, 79, 92, 70,
-			254, 95, 203, 222, 95, 185, 200, 103, 48, 40, 75, 130, 180, 46,
-			169, 139, 55, 187, 73, 26, 109, 100, 130, 73, 184, 109, 182, 130,
-			36, 19, 127, 243, 194, 9, 8, 133, 142, 59, 0, 149, 15, 75,
-			177, 15, 143, 231, 0, 28, 211, 160, 13, 224, 158, 113, 122, 43,
-			5, 110, 228, 125, 209, 26, 248, 93, 219, 170, 220, 208, 163, 129,
-			103, 44, 62, 8, 51, 133, 92, 233, 226, 192, 161, 190, 104, 21,
-			15, 162, 56, 67, 128, 92, 159, 178, 236, 31, 181, 165, 56, 67,
-			144, 248, 158, 178, 10, 37, 186, 72, 61, 34, 143, 56, 201, 87,
-			128, 248, 102, 248, 205, 61, 91, 14, 30, 157, 196, 113, 20, 39,
-			192, 86, 227, 150, 220, 145, 54, 69, 16, 203, 111, 235, 1, 72,
-			104, 65, 211, 111, 243, 88, 248, 137, 60, 112, 4, 74, 34, 138,
-			24, 191, 162, 137, 145, 40, 98, 252, 138, 38, 70, 34, 137, 241,
-			47, 52, 49, 18, 69, 140, 127, 161, 137, 145, 40, 98, 252, 11,
-			32, 198, 77, 85, 194, 102, 228, 127, 88, 100, 87, 101, 141, 207,
-			71, 41, 127, 117, 176, 246, 106, 127, 141, 227, 45, 0, 60, 81,
-			154, 87, 87, 98, 140, 242, 146, 250, 15, 9, 126, 203, 205, 124,
-			101, 43, 21, 73, 141, 243, 203, 137, 224, 185, 216, 91, 60, 88,
-			165, 92, 223, 163, 201, 155, 128, 64, 148, 64, 46, 166, 123, 2,
-			125, 253, 31, 249, 174, 201, 174, 140, 49, 51, 24, 135, 145, 255,
-			105, 145, 221, 38, 3, 144, 226, 255, 204, 15, 223, 177, 33, 129,
-			237, 50, 131, 33, 140, 252, 245, 119, 108, 48, 183, 158, 186, 246,
-			193, 0, 121, 252, 117, 126, 48, 196, 134, 132, 220, 96, 92, 70,
-			254, 198, 34, 123, 76, 6, 80, 124, 255, 198, 34, 229, 44, 193,
-			134, 132, 93, 187, 77, 9, 143, 145, 175, 231, 75, 128, 130, 251,
-			245, 124, 9, 207, 134, 132, 92, 137, 2, 35, 79, 91, 132, 153,
-			12, 160, 200, 62, 109, 145, 225, 44, 193, 134, 132, 242, 152, 41,
-			81, 100, 228, 27, 121, 20, 131, 186, 244, 141, 60, 138, 139, 54,
-			36, 176, 93, 244, 55, 108, 85, 100, 144, 145, 191, 7, 202, 254,
-			37, 155, 47, 249, 107, 83, 45, 209, 14, 54, 130, 20, 184, 139,
-			190, 204, 84, 163, 252, 124, 28, 117, 59, 82, 196, 68, 25, 71,
-			95, 203, 195, 125, 16, 84, 169, 204, 76, 232, 175, 166, 34, 230,
-			253, 30, 183, 252, 238, 104, 83, 92, 17, 241, 164, 244, 109, 185,
-			149, 242, 96, 163, 211, 22, 198, 77, 206, 28, 78, 37, 41, 200,
-			96, 32, 102, 175, 180, 241, 24, 7, 21, 29, 228, 147, 107, 216,
-			137, 77, 52, 190, 162, 205, 84, 158, 126, 166, 177, 240, 83, 245,
-			81, 169, 113, 126, 98, 14, 233, 101, 74, 141, 243, 185, 208, 156,
-			184, 78, 238, 92, 29, 213, 30, 45, 50, 114, 79, 139, 95, 9,
-			124, 204, 249, 64, 255, 109, 154, 7, 244, 102, 154, 35, 25, 208,
-			235, 254, 62, 79, 50, 131, 54, 36, 140, 237, 162, 83, 10, 211,
-			148, 145, 111, 89, 100, 111, 245, 16, 191, 32, 194, 181, 116, 125,
-			103, 92, 155, 242, 212, 194, 252, 25, 125, 80, 27, 18, 118, 237,
-			161, 71, 85, 133, 37, 70, 158, 131, 169, 219, 197, 231, 197, 38,
-			176, 216, 43, 34, 198, 157, 244, 84, 174, 154, 146, 133, 185, 178,
-			126, 149, 108, 72, 24, 203, 152, 204, 16, 35, 143, 218, 57, 194,
-			28, 178, 48, 33, 35, 154, 33, 27, 18, 88, 70, 152, 195, 140,
-			188, 214, 206, 177, 165, 97, 11, 19, 50, 194, 28, 182, 33, 161,
-			156, 45, 151, 17, 70, 126, 216, 38, 251, 76, 134, 17, 11, 19,
-			198, 178, 4, 27, 18, 118, 239, 53, 37, 70, 25, 121, 93, 190,
-			196, 168, 133, 9, 89, 137, 81, 27, 18, 118, 239, 165, 199, 84,
-			137, 50, 35, 175, 183, 201, 158, 234, 62, 88, 247, 73, 15, 187,
-			144, 14, 36, 186, 100, 217, 194, 156, 217, 0, 203, 54, 36, 176,
-			221, 166, 170, 49, 70, 126, 228, 154, 170, 26, 179, 48, 103, 86,
-			213, 152, 13, 9, 136, 43, 216, 88, 44, 230, 189, 193, 182, 223,
-			98, 54, 22, 96, 224, 111, 176, 11, 67, 244, 4, 182, 132, 230,
-			155, 31, 179, 201, 190, 74, 229, 170, 27, 139, 110, 76, 154, 106,
-			126, 204, 54, 12, 193, 194, 253, 226, 199, 236, 61, 26, 105, 104,
-			170, 121, 44, 67, 154, 52, 189, 60, 150, 47, 1, 251, 197, 99,
-			80, 226, 119, 44, 85, 196, 102, 228, 159, 67, 7, 254, 189, 84,
-			43, 213, 41, 111, 139, 99, 188, 79, 232, 18, 26, 59, 240, 98,
-			188, 148, 25, 183, 45, 109, 88, 90, 202, 163, 2, 216, 129, 94,
-			101, 148, 7, 233, 177, 132, 139, 135, 59, 237, 160, 25, 164, 210,
-			251, 98, 61, 88, 1, 130, 223, 105, 61, 62, 208, 123, 159, 241,
-			129, 204, 193, 69, 45, 75, 237, 13, 178, 166, 244, 49, 227, 22,
-			83, 203, 80, 132, 182, 240, 252, 128, 229, 248, 246, 236, 69, 177,
-			130, 0, 186, 223, 102, 43, 163, 59, 65, 187, 194, 219, 108, 37,
-			42, 17, 180, 42, 188, 205, 86, 150, 26, 130, 232, 126, 155, 173,
-			140, 238, 4, 45, 10, 111, 179, 149, 213, 10, 141, 249, 63, 97,
-			219, 7, 213, 71, 144, 226, 127, 34, 171, 9, 244, 251, 159, 176,
-			75, 101, 13, 98, 230, 177, 125, 26, 116, 0, 172, 28, 80, 53,
-			217, 140, 188, 61, 235, 19, 108, 122, 111, 207, 106, 130, 109, 227,
-			237, 89, 77, 208, 236, 219, 237, 49, 221, 39, 216, 83, 223, 14,
-			125, 122, 143, 133, 85, 57, 140, 60, 110, 219, 251, 43, 111, 146,
-			186, 179, 142, 180, 10, 211, 152, 8, 125, 242, 18, 10, 97, 156,
-			69, 18, 145, 194, 92, 172, 242, 149, 8, 85, 225, 64, 249, 56,
-			235, 146, 82, 222, 204, 202, 26, 95, 182, 16, 165, 90, 19, 94,
-			98, 146, 231, 195, 88, 240, 40, 230, 89, 36, 209, 154, 30, 11,
-			72, 166, 143, 103, 67, 3, 201, 244, 113, 187, 52, 170, 65, 11,
-			192, 242, 110, 13, 226, 88, 246, 141, 211, 143, 217, 56, 52, 194,
-			200, 19, 182, 205, 43, 63, 111, 163, 106, 167, 207, 87, 180, 25,
-			2, 187, 156, 168, 94, 6, 73, 143, 191, 52, 252, 198, 115, 24,
-			28, 169, 254, 66, 57, 70, 151, 76, 164, 21, 192, 231, 199, 106,
-			199, 38, 65, 97, 11, 146, 126, 219, 74, 141, 103, 26, 243, 36,
-			159, 57, 121, 114, 10, 4, 6, 158, 52, 163, 14, 170, 136, 113,
-			183, 173, 4, 9, 77, 162, 171, 129, 114, 20, 199, 45, 115, 34,
-			168, 137, 154, 114, 209, 194, 214, 49, 234, 184, 236, 113, 102, 0,
-			11, 18, 154, 141, 10, 145, 238, 199, 205, 117, 209, 194, 213, 17,
-			230, 13, 101, 29, 63, 198, 115, 180, 40, 228, 176, 43, 71, 82,
-			185, 136, 162, 148, 114, 19, 113, 237, 184, 193, 58, 145, 152, 51,
-			160, 11, 160, 33, 114, 144, 116, 158, 176, 153, 166, 62, 80, 212,
-			159, 176, 175, 59, 76, 127, 16, 145, 238, 50, 242, 111, 108, 251,
-			112, 165, 99, 212, 105, 41, 193, 63, 63, 158, 249, 138, 0, 173,
-			88, 197, 238, 197, 197, 14, 104, 165, 210, 169, 39, 103, 169, 145,
-			118, 86, 63, 12, 69, 12, 123, 182, 161, 58, 211, 119, 151, 96,
-			7, 12, 136, 253, 49, 125, 7, 153, 235, 223, 216, 172, 162, 65,
-			7, 192, 67, 215, 209, 183, 74, 138, 241, 24, 249, 183, 182, 125,
-			125, 229, 135, 37, 197, 132, 221, 13, 17, 7, 77, 77, 40, 230,
-			128, 184, 231, 20, 56, 21, 15, 203, 75, 2, 120, 84, 233, 231,
-			143, 13, 149, 155, 31, 214, 181, 18, 69, 160, 233, 39, 147, 188,
-			154, 198, 93, 81, 5, 130, 175, 98, 208, 130, 170, 202, 33, 47,
-			140, 247, 183, 99, 84, 61, 104, 6, 190, 160, 218, 52, 1, 139,
-			81, 36, 77, 191, 35, 81, 227, 135, 91, 124, 211, 223, 58, 174,
-			27, 3, 105, 182, 175, 162, 25, 147, 95, 118, 75, 222, 49, 193,
-			156, 252, 187, 94, 193, 111, 57, 117, 39, 210, 144, 202, 100, 240,
-			233, 17, 196, 137, 1, 93, 0, 13, 62, 65, 34, 253, 183, 182,
-			50, 77, 19, 60, 114, 249, 183, 118, 245, 40, 125, 189, 100, 46,
-			5, 70, 222, 111, 219, 71, 42, 87, 96, 21, 33, 75, 88, 11,
-			174, 136, 68, 29, 99, 183, 4, 30, 34, 251, 28, 195, 45, 235,
-			25, 206, 123, 149, 110, 117, 196, 177, 132, 103, 113, 236, 169, 116,
-			218, 224, 249, 99, 251, 64, 158, 157, 162, 53, 14, 241, 238, 167,
-			178, 132, 25, 66, 129, 96, 55, 12, 232, 2, 104, 248, 35, 136,
-			200, 239, 183, 199, 52, 91, 46, 56, 0, 30, 230, 244, 25, 57,
-			132, 34, 35, 31, 129, 33, 124, 213, 226, 247, 44, 46, 204, 231,
-			40, 87, 247, 160, 38, 117, 120, 60, 156, 150, 92, 19, 157, 163,
-			250, 189, 105, 107, 138, 147, 160, 93, 39, 230, 235, 190, 204, 234,
-			243, 170, 137, 47, 93, 85, 202, 47, 44, 211, 172, 254, 73, 53,
-			38, 252, 116, 76, 29, 131, 83, 105, 121, 84, 123, 92, 45, 207,
-			109, 112, 15, 109, 9, 116, 234, 196, 107, 25, 210, 200, 12, 85,
-			29, 75, 100, 255, 87, 182, 0, 205, 87, 68, 156, 34, 55, 10,
-			208, 146, 208, 244, 55, 68, 123, 198, 79, 178, 181, 84, 36, 56,
-			120, 3, 186, 0, 154, 185, 7, 77, 225, 35, 25, 31, 40, 58,
-			0, 94, 199, 213, 22, 53, 200, 200, 47, 217, 246, 245, 234, 227,
-			32, 65, 80, 215, 52, 232, 1, 88, 210, 187, 27, 8, 194, 191,
-			100, 143, 107, 42, 26, 116, 0, 172, 30, 165, 31, 26, 196, 170,
-			40, 35, 191, 99, 219, 55, 84, 126, 106, 48, 51, 101, 106, 102,
-			226, 107, 57, 162, 170, 205, 142, 213, 26, 191, 79, 26, 93, 213,
-			23, 67, 38, 58, 7, 160, 8, 148, 0, 191, 249, 144, 118, 49,
-			104, 130, 24, 179, 230, 199, 173, 182, 50, 233, 201, 66, 234, 40,
-			94, 86, 216, 23, 176, 27, 89, 89, 214, 23, 89, 64, 109, 118,
-			237, 8, 168, 55, 50, 164, 157, 70, 60, 9, 214, 66, 191, 77,
-			225, 103, 212, 110, 233, 190, 53, 149, 25, 4, 103, 216, 116, 5,
-			107, 198, 56, 123, 210, 209, 177, 135, 212, 2, 181, 32, 40, 100,
-			147, 26, 139, 15, 120, 168, 38, 91, 97, 186, 46, 210, 160, 89,
-			149, 25, 38, 213, 221, 163, 254, 206, 161, 115, 124, 18, 181, 133,
-			90, 53, 148, 79, 8, 191, 185, 174, 187, 100, 198, 39, 11, 173,
-			137, 52, 193, 18, 208, 144, 105, 66, 182, 112, 188, 198, 23, 123,
-			83, 18, 202, 197, 195, 1, 250, 21, 247, 185, 175, 160, 80, 40,
-			187, 212, 146, 222, 84, 58, 98, 45, 50, 175, 233, 75, 115, 59,
-			85, 102, 132, 7, 229, 119, 42, 85, 69, 191, 221, 230, 213, 88,
-			248, 109, 53, 82, 188, 186, 144, 223, 226, 165, 10, 55, 185, 109,
-			202, 244, 73, 87, 19, 20, 64, 217, 176, 190, 177, 169, 28, 20,
-			39, 41, 79, 2, 152, 131, 48, 10, 167, 180, 121, 185, 175, 94,
-			237, 20, 139, 115, 100, 102, 205, 40, 172, 176, 156, 80, 39, 165,
-			61, 6, 119, 145, 53, 213, 18, 169, 31, 180, 129, 204, 54, 215,
-			133, 185, 171, 133, 108, 97, 51, 142, 82, 145, 35, 102, 216, 38,
-			194, 40, 69, 215, 154, 32, 201, 201, 181, 171, 221, 54, 18, 71,
-			28, 117, 195, 214, 84, 26, 7, 232, 216, 159, 115, 196, 151, 238,
-			42, 136, 150, 102, 20, 38, 65, 130, 1, 96, 248, 166, 160, 200,
-			132, 183, 141, 169, 127, 114, 185, 223, 78, 162, 73, 46, 174, 8,
-			152, 202, 168, 187, 182, 174, 68, 29, 152, 59, 45, 243, 35, 61,
-			247, 227, 193, 184, 145, 163, 157, 216, 111, 183, 183, 148, 51, 158,
-			31, 166, 230, 250, 66, 154, 157, 72, 162, 243, 54, 229, 169, 104,
-			183, 121, 176, 106, 236, 112, 65, 222, 41, 70, 250, 209, 131, 216,
-			54, 201, 147, 8, 122, 130, 164, 161, 102, 66, 207, 39, 237, 31,
-			4, 16, 6, 154, 15, 78, 245, 209, 117, 162, 169, 48, 209, 170,
-			193, 106, 219, 95, 155, 204, 119, 15, 84, 152, 88, 248, 173, 45,
-			51, 141, 52, 171, 4, 37, 192, 7, 122, 3, 203, 63, 96, 24,
-			39, 37, 200, 178, 12, 232, 2, 104, 196, 86, 80, 211, 127, 199,
-			46, 107, 118, 71, 29, 0, 171, 215, 211, 42, 5, 81, 203, 251,
-			164, 61, 240, 135, 182, 85, 217, 221, 99, 154, 212, 163, 41, 81,
-			135, 128, 16, 243, 73, 187, 120, 16, 57, 173, 11, 10, 202, 167,
-			180, 50, 224, 162, 130, 242, 41, 221, 180, 139, 10, 202, 167, 52,
-			207, 118, 81, 65, 249, 148, 86, 80, 92, 84, 80, 62, 165, 21,
-			20, 23, 52, 133, 63, 208, 60, 219, 69, 5, 229, 15, 178, 154,
-			44, 15, 64, 197, 179, 93, 84, 80, 254, 64, 243, 108, 23, 21,
-			148, 63, 0, 158, 125, 140, 218, 196, 99, 222, 159, 216, 3, 127,
-			101, 91, 149, 253, 249, 65, 132, 153, 216, 173, 70, 2, 226, 195,
-			159, 216, 69, 169, 214, 120, 48, 146, 79, 235, 145, 120, 56, 146,
-			79, 235, 246, 61, 28, 201, 167, 245, 72, 60, 28, 201, 167, 245,
-			72, 60, 28, 201, 167, 245, 72, 60, 24, 201, 159, 218, 202, 1,
-			197, 195, 145, 252, 105, 86, 19, 140, 228, 79, 237, 210, 81, 13,
-			98, 230, 235, 143, 107, 208, 1, 80, 57, 160, 120, 160, 106, 61,
-			105, 219, 58, 47, 232, 20, 79, 102, 53, 129, 192, 248, 164, 93,
-			210, 157, 128, 102, 159, 180, 247, 93, 167, 65, 7, 192, 35, 85,
-			250, 65, 144, 46, 61, 103, 128, 121, 127, 110, 219, 79, 217, 78,
-			229, 95, 217, 59, 184, 141, 104, 105, 83, 90, 25, 115, 14, 30,
-			202, 236, 184, 147, 211, 136, 8, 211, 56, 232, 243, 16, 1, 36,
-			239, 232, 30, 210, 231, 29, 194, 231, 129, 213, 168, 147, 64, 185,
-			98, 205, 5, 11, 20, 27, 158, 247, 93, 30, 217, 164, 159, 226,
-			94, 10, 165, 181, 43, 9, 79, 186, 205, 117, 253, 9, 25, 150,
-			223, 233, 196, 81, 39, 14, 252, 84, 58, 70, 42, 81, 89, 30,
-			200, 72, 215, 200, 32, 76, 111, 61, 69, 121, 43, 218, 240, 3,
-			125, 156, 228, 161, 139, 192, 159, 219, 244, 0, 250, 149, 120, 210,
-			69, 224, 11, 246, 142, 158, 43, 158, 58, 243, 255, 130, 173, 78,
-			25, 61, 117, 230, 255, 5, 91, 157, 249, 123, 234, 204, 255, 11,
-			182, 242, 92, 241, 212, 153, 255, 23, 108, 229, 185, 226, 201, 51,
-			255, 47, 218, 232, 185, 178, 83, 11, 64, 72, 95, 204, 183, 0,
-			90, 251, 23, 243, 45, 88, 88, 129, 242, 92, 241, 212, 169, 252,
-			23, 129, 48, 223, 103, 33, 61, 57, 140, 124, 213, 182, 111, 222,
-			217, 115, 232, 170, 36, 208, 251, 161, 159, 20, 40, 8, 116, 184,
-			182, 212, 247, 126, 114, 200, 31, 68, 94, 149, 52, 104, 159, 231,
-			144, 135, 218, 247, 87, 51, 106, 7, 237, 251, 171, 182, 58, 23,
-			242, 80, 251, 254, 170, 125, 232, 164, 6, 113, 100, 181, 155, 104,
-			27, 199, 73, 24, 249, 26, 168, 82, 63, 144, 53, 151, 245, 240,
-			170, 206, 53, 177, 144, 226, 236, 142, 254, 51, 244, 106, 14, 52,
-			30, 106, 172, 95, 203, 122, 10, 26, 235, 215, 50, 94, 1, 26,
-			235, 215, 180, 150, 226, 161, 198, 250, 53, 224, 85, 183, 80, 155,
-			20, 152, 247, 117, 123, 224, 239, 236, 254, 75, 5, 178, 159, 250,
-			44, 72, 178, 46, 197, 181, 64, 99, 248, 186, 93, 148, 146, 110,
-			1, 136, 242, 105, 205, 181, 10, 72, 132, 79, 235, 158, 20, 144,
-			4, 159, 214, 61, 41, 32, 1, 62, 173, 185, 86, 1, 201, 239,
-			105, 205, 181, 10, 64, 124, 223, 208, 6, 162, 2, 18, 219, 55,
-			178, 154, 128, 212, 190, 161, 213, 150, 2, 18, 218, 55, 180, 129,
-			168, 128, 100, 246, 13, 109, 32, 42, 0, 215, 250, 166, 109, 79,
-			168, 143, 48, 25, 223, 204, 106, 2, 174, 245, 77, 187, 164, 123,
-			12, 205, 126, 211, 62, 120, 84, 131, 14, 128, 55, 30, 163, 215,
-			83, 155, 20, 153, 247, 247, 246, 192, 163, 142, 85, 217, 219, 131,
-			29, 21, 106, 92, 33, 4, 52, 129, 191, 183, 139, 215, 97, 227,
-			69, 64, 200, 183, 52, 66, 138, 136, 144, 111, 233, 198, 139, 136,
-			144, 111, 105, 132, 20, 17, 33, 223, 210, 8, 41, 34, 66, 190,
-			165, 17, 82, 132, 158, 61, 107, 43, 63, 175, 34, 34, 228, 217,
-			172, 38, 96, 227, 207, 218, 37, 174, 65, 204, 124, 228, 152, 6,
-			29, 0, 79, 156, 84, 53, 217, 140, 60, 103, 43, 239, 191, 34,
-			34, 228, 185, 172, 38, 64, 200, 115, 182, 242, 254, 43, 34, 66,
-			158, 179, 149, 247, 95, 17, 17, 242, 156, 125, 253, 13, 120, 5,
-			101, 144, 121, 175, 115, 6, 222, 234, 88, 149, 106, 159, 243, 110,
-			186, 30, 181, 164, 58, 219, 139, 28, 80, 110, 94, 231, 20, 15,
-			97, 71, 6, 1, 57, 175, 119, 20, 114, 6, 17, 57, 175, 119,
-			84, 71, 6, 17, 57, 175, 119, 20, 114, 6, 17, 57, 175, 119,
-			20, 114, 6, 17, 57, 175, 119, 246, 87, 232, 59, 45, 172, 202,
-			98, 228, 13, 142, 125, 164, 242, 6, 139, 207, 133, 120, 73, 51,
-			108, 233, 251, 154, 198, 183, 39, 187, 35, 231, 235, 59, 82, 253,
-			230, 148, 77, 127, 139, 251, 9, 229, 59, 190, 196, 100, 44, 44,
-			242, 102, 152, 10, 137, 176, 10, 50, 107, 180, 221, 105, 89, 118,
-			20, 38, 234, 13, 217, 168, 128, 114, 223, 144, 141, 10, 13, 218,
-			14, 59, 168, 65, 7, 192, 195, 92, 225, 199, 102, 228, 141, 142,
-			93, 85, 31, 97, 162, 222, 152, 213, 100, 187, 0, 154, 154, 0,
-			3, 111, 116, 216, 33, 13, 58, 0, 242, 35, 170, 38, 135, 145,
-			199, 28, 251, 6, 245, 17, 120, 217, 99, 89, 77, 192, 203, 30,
-			115, 148, 147, 224, 32, 242, 178, 199, 156, 253, 92, 131, 88, 246,
-			232, 245, 180, 142, 53, 17, 70, 222, 236, 216, 119, 84, 238, 224,
-			115, 58, 244, 96, 2, 146, 170, 212, 222, 184, 124, 70, 36, 225,
-			250, 229, 14, 157, 110, 60, 159, 84, 173, 68, 214, 99, 64, 23,
-			64, 37, 21, 14, 34, 147, 122, 179, 83, 214, 93, 0, 38, 245,
-			102, 232, 130, 2, 139, 0, 222, 112, 187, 6, 11, 0, 222, 252,
-			50, 213, 65, 151, 145, 183, 236, 212, 65, 249, 144, 201, 246, 14,
-			170, 244, 254, 14, 186, 4, 235, 49, 32, 86, 107, 58, 8, 98,
-			231, 91, 178, 14, 186, 14, 128, 166, 131, 110, 17, 64, 211, 65,
-			183, 0, 224, 205, 47, 163, 31, 28, 161, 54, 161, 204, 251, 136,
-			51, 240, 65, 98, 157, 154, 231, 175, 248, 135, 255, 163, 92, 5,
-			234, 164, 167, 62, 57, 204, 235, 160, 188, 154, 235, 18, 217, 53,
-			26, 25, 50, 4, 54, 15, 244, 76, 171, 234, 151, 109, 184, 159,
-			202, 24, 71, 249, 149, 65, 249, 131, 168, 79, 152, 160, 62, 73,
-			110, 115, 146, 42, 1, 198, 37, 73, 35, 216, 132, 180, 234, 218,
-			226, 73, 59, 88, 91, 79, 219, 91, 188, 21, 172, 174, 138, 88,
-			132, 41, 236, 83, 160, 138, 250, 91, 218, 148, 197, 215, 131, 80,
-			94, 25, 85, 117, 128, 108, 180, 225, 135, 65, 167, 219, 70, 229,
-			208, 216, 138, 244, 132, 128, 116, 166, 61, 36, 160, 162, 157, 61,
-			36, 252, 100, 7, 127, 150, 19, 10, 53, 185, 186, 212, 40, 115,
-			121, 245, 142, 187, 37, 112, 215, 85, 86, 4, 121, 99, 27, 181,
-			200, 0, 214, 123, 18, 245, 105, 105, 40, 36, 160, 255, 104, 102,
-			222, 212, 194, 198, 6, 30, 208, 36, 169, 240, 91, 82, 1, 70,
-			47, 83, 248, 128, 246, 73, 173, 211, 133, 61, 157, 204, 204, 215,
-			77, 191, 221, 22, 45, 190, 83, 16, 212, 90, 222, 218, 151, 121,
-			26, 26, 246, 165, 14, 29, 154, 113, 148, 36, 104, 26, 216, 142,
-			2, 126, 159, 144, 38, 113, 169, 218, 153, 218, 210, 136, 119, 34,
-			57, 11, 210, 52, 151, 195, 17, 122, 238, 109, 10, 190, 210, 13,
-			218, 45, 116, 225, 211, 140, 113, 18, 80, 37, 169, 163, 19, 5,
-			97, 138, 141, 226, 28, 38, 217, 165, 92, 42, 241, 38, 207, 187,
-			147, 72, 186, 229, 100, 181, 3, 51, 70, 180, 195, 84, 215, 251,
-			124, 93, 21, 173, 244, 206, 182, 138, 201, 210, 92, 143, 18, 129,
-			38, 31, 25, 81, 38, 57, 77, 249, 9, 212, 242, 117, 70, 217,
-			51, 52, 15, 106, 175, 99, 212, 23, 140, 95, 11, 76, 113, 91,
-			128, 88, 222, 214, 78, 71, 81, 76, 57, 231, 81, 188, 230, 135,
-			193, 35, 42, 208, 79, 36, 175, 139, 138, 135, 59, 34, 14, 240,
-			216, 189, 173, 219, 152, 68, 68, 42, 63, 105, 213, 229, 151, 221,
-			124, 243, 205, 55, 67, 45, 233, 122, 140, 214, 2, 140, 235, 164,
-			45, 249, 234, 216, 97, 43, 234, 202, 128, 50, 73, 55, 86, 11,
-			1, 146, 90, 230, 126, 157, 156, 6, 174, 102, 86, 250, 88, 162,
-			23, 169, 102, 93, 170, 7, 181, 231, 25, 245, 138, 186, 140, 152,
-			172, 43, 244, 227, 240, 229, 253, 68, 188, 36, 101, 106, 131, 150,
-			130, 80, 223, 9, 76, 81, 27, 73, 3, 144, 76, 197, 212, 134,
-			31, 168, 89, 93, 233, 174, 78, 173, 181, 163, 21, 191, 61, 101,
-			102, 112, 42, 22, 107, 65, 146, 198, 91, 185, 56, 29, 56, 248,
-			72, 75, 168, 219, 60, 152, 147, 26, 95, 12, 54, 212, 157, 242,
-			43, 65, 75, 192, 208, 49, 162, 206, 131, 162, 153, 74, 81, 23,
-			163, 89, 64, 61, 11, 43, 15, 202, 43, 248, 83, 51, 234, 158,
-			237, 113, 28, 74, 79, 145, 77, 177, 146, 4, 169, 224, 19, 193,
-			42, 247, 175, 248, 65, 219, 95, 105, 139, 227, 234, 158, 88, 44,
-			142, 37, 60, 140, 160, 50, 60, 190, 3, 180, 63, 220, 105, 35,
-			63, 138, 54, 17, 237, 176, 214, 66, 252, 162, 48, 191, 81, 227,
-			151, 147, 46, 90, 108, 224, 59, 146, 15, 22, 142, 66, 196, 85,
-			255, 144, 106, 120, 235, 77, 250, 112, 161, 227, 220, 182, 121, 82,
-			209, 164, 160, 162, 40, 204, 225, 4, 187, 37, 167, 119, 101, 139,
-			119, 186, 105, 170, 172, 87, 138, 93, 36, 221, 149, 169, 30, 95,
-			47, 60, 194, 144, 43, 66, 47, 239, 68, 134, 191, 224, 209, 170,
-			36, 59, 140, 163, 148, 40, 162, 245, 55, 58, 109, 1, 139, 131,
-			127, 187, 81, 179, 160, 18, 153, 122, 52, 210, 108, 74, 29, 3,
-			6, 9, 79, 187, 49, 48, 219, 110, 170, 182, 2, 201, 63, 128,
-			39, 192, 116, 104, 217, 207, 16, 98, 34, 82, 222, 237, 40, 202,
-			240, 187, 105, 180, 225, 167, 65, 19, 49, 236, 39, 24, 161, 69,
-			153, 252, 53, 141, 72, 161, 145, 90, 140, 124, 196, 41, 238, 146,
-			23, 22, 41, 72, 141, 191, 226, 216, 71, 43, 95, 180, 248, 162,
-			72, 165, 57, 241, 30, 255, 138, 207, 213, 11, 72, 192, 173, 98,
-			16, 60, 252, 36, 17, 73, 110, 127, 82, 246, 255, 32, 209, 86,
-			65, 213, 49, 42, 131, 73, 192, 30, 120, 118, 75, 31, 50, 77,
-			230, 46, 187, 233, 138, 97, 233, 38, 162, 165, 227, 2, 72, 59,
-			65, 180, 154, 2, 135, 11, 194, 156, 106, 111, 76, 103, 61, 229,
-			141, 157, 45, 140, 226, 13, 28, 54, 30, 161, 74, 178, 48, 241,
-			19, 180, 230, 175, 36, 86, 41, 128, 80, 148, 142, 127, 69, 203,
-			35, 20, 165, 227, 95, 209, 210, 31, 69, 233, 248, 87, 28, 118,
-			157, 6, 29, 0, 143, 84, 233, 95, 219, 136, 50, 139, 145, 95,
-			117, 236, 147, 149, 63, 179, 249, 76, 20, 166, 113, 212, 222, 126,
-			244, 184, 25, 251, 157, 142, 136, 37, 42, 17, 121, 121, 212, 169,
-			56, 89, 181, 190, 27, 142, 126, 170, 178, 230, 3, 36, 232, 173,
-			190, 175, 192, 49, 168, 48, 205, 100, 234, 137, 227, 90, 83, 0,
-			129, 123, 83, 64, 5, 9, 218, 161, 179, 171, 192, 185, 93, 162,
-			207, 239, 182, 183, 39, 115, 171, 124, 135, 7, 227, 148, 25, 7,
-			221, 145, 212, 57, 176, 175, 156, 95, 101, 240, 28, 77, 35, 250,
-			100, 136, 246, 68, 232, 209, 116, 171, 14, 156, 131, 16, 195, 35,
-			224, 94, 43, 119, 13, 141, 50, 124, 216, 78, 86, 102, 230, 11,
-			228, 254, 95, 205, 230, 11, 228, 254, 95, 205, 230, 203, 194, 25,
-			97, 55, 106, 208, 1, 240, 248, 9, 250, 179, 14, 206, 151, 205,
-			200, 111, 57, 246, 153, 202, 59, 29, 24, 153, 114, 168, 82, 35,
-			48, 228, 142, 242, 147, 154, 162, 72, 121, 223, 103, 231, 8, 60,
-			17, 29, 31, 127, 214, 0, 51, 50, 206, 135, 138, 85, 209, 92,
-			207, 161, 88, 113, 150, 73, 101, 80, 198, 45, 90, 45, 220, 29,
-			81, 78, 149, 49, 157, 47, 173, 119, 165, 239, 73, 34, 204, 97,
-			60, 72, 28, 97, 148, 158, 216, 25, 109, 26, 95, 136, 41, 121,
-			218, 142, 215, 135, 118, 122, 29, 176, 198, 51, 167, 183, 109, 101,
-			101, 75, 218, 217, 141, 230, 8, 21, 111, 30, 100, 228, 247, 66,
-			116, 151, 39, 59, 122, 77, 116, 151, 187, 248, 74, 81, 37, 251,
-			173, 108, 146, 65, 247, 248, 45, 173, 36, 80, 84, 201, 126, 203,
-			41, 31, 213, 160, 3, 224, 141, 19, 26, 44, 2, 120, 252, 180,
-			6, 11, 0, 222, 118, 23, 61, 142, 20, 224, 48, 242, 219, 142,
-			93, 175, 28, 80, 167, 92, 146, 49, 182, 34, 129, 103, 14, 235,
-			210, 141, 74, 150, 4, 109, 238, 183, 179, 78, 56, 46, 128, 166,
-			19, 160, 205, 253, 182, 83, 158, 210, 32, 86, 124, 243, 41, 13,
-			22, 1, 188, 117, 22, 93, 160, 0, 196, 207, 183, 205, 208, 223,
-			151, 132, 72, 24, 249, 180, 99, 127, 87, 229, 87, 29, 62, 173,
-			194, 191, 100, 209, 171, 204, 113, 1, 158, 123, 116, 81, 33, 136,
-			58, 242, 188, 43, 226, 151, 151, 206, 77, 221, 201, 241, 245, 68,
-			140, 76, 18, 73, 214, 50, 73, 243, 226, 9, 200, 71, 155, 104,
-			82, 122, 184, 41, 228, 24, 131, 85, 30, 132, 50, 92, 150, 172,
-			34, 72, 180, 248, 104, 14, 112, 209, 237, 16, 228, 52, 185, 111,
-			200, 121, 247, 149, 215, 128, 58, 30, 164, 218, 83, 28, 54, 227,
-			96, 117, 11, 246, 65, 140, 174, 181, 9, 92, 235, 161, 32, 108,
-			245, 29, 110, 38, 114, 48, 18, 213, 84, 138, 132, 24, 164, 67,
-			46, 138, 110, 167, 165, 68, 227, 68, 109, 174, 126, 179, 25, 197,
-			45, 96, 5, 91, 230, 108, 229, 86, 21, 98, 65, 31, 151, 116,
-			68, 140, 225, 19, 101, 49, 196, 70, 130, 119, 98, 244, 30, 175,
-			167, 54, 231, 232, 133, 107, 204, 111, 39, 2, 15, 198, 195, 72,
-			197, 32, 58, 173, 108, 200, 121, 187, 183, 194, 120, 39, 223, 52,
-			236, 199, 209, 170, 68, 30, 213, 77, 106, 234, 32, 114, 70, 13,
-			232, 2, 104, 136, 5, 244, 238, 79, 59, 101, 205, 150, 64, 239,
-			254, 180, 115, 252, 164, 6, 139, 0, 78, 190, 66, 131, 5, 0,
-			239, 120, 57, 189, 0, 164, 66, 6, 152, 247, 95, 29, 251, 191,
-			59, 78, 229, 229, 220, 68, 136, 53, 28, 86, 29, 214, 237, 20,
-			196, 75, 171, 130, 73, 240, 136, 89, 89, 232, 66, 254, 95, 157,
-			194, 46, 58, 11, 132, 41, 93, 200, 159, 116, 200, 72, 245, 54,
-			83, 121, 22, 235, 4, 139, 67, 133, 74, 103, 155, 4, 14, 22,
-			248, 109, 45, 190, 75, 163, 53, 85, 62, 227, 79, 58, 100, 48,
-			75, 176, 33, 97, 104, 152, 158, 87, 237, 88, 140, 124, 214, 33,
-			172, 226, 201, 112, 36, 213, 155, 208, 13, 58, 139, 124, 186, 208,
-			65, 122, 55, 254, 183, 106, 102, 37, 71, 209, 238, 233, 84, 57,
-			155, 127, 214, 81, 94, 157, 84, 57, 155, 127, 214, 41, 143, 153,
-			166, 108, 70, 62, 231, 144, 61, 213, 59, 242, 67, 106, 193, 204,
-			2, 109, 168, 32, 226, 23, 130, 84, 152, 192, 153, 109, 0, 84,
-			144, 132, 92, 83, 208, 233, 207, 57, 202, 215, 149, 42, 231, 241,
-			207, 57, 187, 118, 163, 245, 135, 2, 91, 250, 188, 99, 159, 81,
-			243, 230, 18, 4, 53, 9, 184, 30, 128, 234, 44, 139, 162, 101,
-			227, 243, 206, 248, 245, 26, 116, 0, 60, 166, 153, 150, 91, 4,
-			208, 48, 45, 183, 0, 224, 109, 119, 209, 63, 147, 114, 134, 199,
-			200, 151, 28, 187, 90, 249, 67, 59, 19, 205, 206, 71, 125, 130,
-			89, 146, 198, 24, 45, 243, 219, 17, 204, 230, 86, 121, 36, 163,
-			172, 78, 110, 171, 84, 237, 210, 249, 224, 155, 189, 81, 70, 81,
-			240, 157, 66, 207, 148, 21, 63, 17, 121, 161, 199, 72, 118, 120,
-			38, 203, 59, 126, 186, 62, 9, 28, 72, 199, 23, 173, 201, 162,
-			57, 143, 146, 124, 41, 188, 158, 130, 68, 176, 93, 40, 81, 213,
-			224, 233, 206, 206, 181, 244, 247, 165, 167, 176, 142, 140, 97, 54,
-			34, 179, 130, 61, 130, 72, 54, 160, 11, 160, 17, 44, 60, 11,
-			64, 101, 80, 164, 232, 132, 244, 37, 231, 240, 17, 250, 71, 4,
-			39, 168, 192, 200, 215, 65, 176, 248, 127, 8, 95, 148, 30, 227,
-			234, 193, 76, 189, 231, 39, 189, 38, 157, 32, 148, 226, 66, 219,
-			15, 215, 186, 254, 154, 120, 37, 231, 85, 245, 172, 107, 213, 20,
-			145, 94, 142, 120, 128, 172, 47, 67, 1, 59, 14, 183, 96, 61,
-			166, 65, 19, 116, 2, 222, 184, 52, 195, 147, 173, 36, 69, 227,
-			200, 18, 158, 40, 199, 249, 150, 48, 46, 143, 160, 28, 69, 223,
-			94, 209, 38, 217, 214, 13, 62, 97, 2, 33, 182, 164, 23, 172,
-			223, 214, 33, 152, 142, 215, 40, 63, 223, 63, 168, 77, 161, 236,
-			52, 168, 125, 1, 243, 199, 216, 70, 74, 206, 81, 45, 229, 98,
-			62, 233, 35, 69, 63, 110, 111, 105, 191, 112, 220, 46, 250, 221,
-			119, 233, 14, 141, 73, 108, 108, 74, 87, 3, 233, 171, 145, 243,
-			249, 15, 66, 190, 234, 95, 137, 208, 65, 75, 174, 116, 213, 113,
-			42, 109, 0, 107, 61, 188, 32, 143, 81, 169, 239, 94, 29, 165,
-			49, 222, 154, 155, 164, 122, 143, 202, 46, 70, 225, 110, 82, 227,
-			124, 1, 67, 187, 180, 132, 218, 130, 165, 178, 159, 240, 40, 220,
-			78, 6, 242, 66, 1, 205, 239, 75, 114, 191, 23, 27, 122, 191,
-			55, 36, 89, 32, 72, 86, 6, 116, 1, 52, 155, 10, 30, 17,
-			101, 98, 80, 193, 1, 208, 136, 65, 133, 34, 128, 134, 163, 20,
-			144, 66, 111, 187, 75, 113, 174, 34, 35, 79, 59, 182, 222, 113,
-			138, 4, 65, 221, 78, 209, 5, 208, 180, 83, 180, 0, 44, 223,
-			160, 65, 7, 192, 137, 19, 26, 196, 170, 78, 190, 92, 131, 5,
-			0, 111, 63, 163, 218, 25, 100, 228, 27, 25, 135, 28, 36, 8,
-			234, 118, 6, 93, 0, 77, 59, 131, 22, 128, 102, 60, 131, 14,
-			128, 102, 60, 131, 69, 0, 205, 120, 6, 11, 0, 222, 118, 23,
-			61, 9, 154, 236, 32, 35, 127, 235, 216, 35, 213, 235, 204, 197,
-			205, 157, 222, 172, 149, 151, 184, 232, 224, 0, 228, 46, 13, 203,
-			154, 240, 246, 102, 30, 180, 21, 136, 42, 50, 101, 228, 155, 14,
-			6, 67, 35, 132, 210, 1, 128, 74, 71, 232, 215, 165, 194, 76,
-			25, 121, 214, 177, 79, 85, 190, 96, 241, 185, 164, 39, 140, 169,
-			38, 202, 87, 82, 62, 139, 180, 0, 228, 24, 73, 94, 38, 159,
-			209, 4, 14, 156, 130, 4, 163, 92, 187, 48, 180, 217, 70, 144,
-			242, 217, 252, 45, 22, 99, 40, 166, 82, 195, 184, 34, 226, 45,
-			20, 82, 243, 82, 51, 90, 209, 130, 212, 48, 108, 189, 125, 183,
-			183, 120, 176, 22, 70, 177, 104, 157, 209, 217, 161, 60, 229, 109,
-			225, 39, 105, 222, 189, 13, 47, 154, 232, 93, 29, 91, 50, 47,
-			48, 162, 216, 215, 206, 233, 203, 148, 224, 168, 13, 232, 2, 104,
-			230, 144, 90, 0, 150, 43, 26, 116, 0, 60, 116, 88, 131, 69,
-			0, 249, 45, 26, 44, 0, 120, 242, 102, 250, 67, 136, 206, 18,
-			35, 175, 37, 246, 29, 149, 215, 112, 249, 12, 117, 162, 61, 150,
-			240, 196, 11, 223, 164, 54, 250, 178, 10, 97, 186, 83, 20, 89,
-			101, 202, 85, 98, 38, 149, 156, 9, 253, 243, 251, 101, 39, 168,
-			108, 230, 228, 73, 51, 180, 18, 193, 30, 24, 208, 5, 208, 12,
-			173, 100, 1, 168, 142, 38, 40, 134, 246, 121, 45, 57, 170, 87,
-			69, 169, 8, 224, 141, 183, 107, 176, 0, 224, 45, 47, 163, 111,
-			147, 164, 50, 196, 200, 143, 16, 251, 120, 229, 117, 57, 219, 74,
-			164, 205, 111, 188, 169, 180, 47, 249, 212, 181, 98, 34, 50, 52,
-			145, 10, 154, 152, 74, 227, 110, 174, 8, 221, 105, 64, 125, 251,
-			189, 142, 17, 128, 14, 85, 154, 111, 153, 225, 14, 17, 236, 148,
-			1, 93, 0, 205, 134, 55, 100, 1, 200, 244, 106, 28, 114, 0,
-			188, 113, 130, 206, 225, 120, 134, 25, 121, 3, 177, 39, 42, 103,
-			184, 121, 48, 27, 209, 185, 173, 79, 103, 178, 112, 150, 105, 148,
-			223, 228, 77, 55, 134, 9, 214, 101, 64, 23, 64, 211, 141, 97,
-			11, 64, 86, 213, 160, 3, 224, 13, 199, 164, 51, 53, 181, 71,
-			24, 121, 51, 145, 206, 212, 153, 137, 137, 47, 110, 6, 171, 105,
-			126, 159, 147, 58, 145, 255, 144, 216, 193, 248, 4, 50, 224, 140,
-			118, 75, 69, 79, 147, 88, 128, 120, 4, 164, 127, 172, 118, 76,
-			90, 145, 186, 97, 75, 196, 73, 51, 138, 133, 9, 111, 47, 157,
-			90, 34, 61, 105, 218, 203, 60, 185, 41, 217, 218, 88, 137, 218,
-			9, 213, 26, 174, 242, 21, 205, 41, 65, 137, 156, 92, 41, 10,
-			77, 230, 34, 97, 154, 163, 3, 229, 233, 43, 207, 58, 232, 243,
-			53, 99, 90, 209, 8, 28, 33, 136, 19, 3, 186, 0, 26, 124,
-			142, 88, 0, 26, 131, 214, 136, 3, 224, 145, 163, 232, 88, 79,
-			237, 81, 70, 222, 6, 211, 218, 201, 168, 180, 179, 222, 185, 86,
-			234, 132, 172, 219, 8, 128, 238, 64, 149, 234, 253, 23, 244, 223,
-			219, 232, 164, 91, 166, 239, 163, 4, 59, 96, 64, 23, 64, 211,
-			247, 81, 11, 64, 67, 11, 163, 14, 128, 55, 28, 163, 255, 65,
-			174, 177, 50, 35, 239, 36, 246, 13, 149, 15, 90, 168, 90, 228,
-			240, 141, 214, 11, 249, 46, 130, 9, 74, 143, 36, 27, 173, 238,
-			220, 105, 211, 69, 154, 245, 113, 219, 44, 154, 79, 189, 194, 43,
-			138, 158, 121, 199, 105, 25, 199, 181, 37, 82, 17, 111, 100, 145,
-			140, 77, 39, 204, 224, 203, 4, 7, 96, 64, 23, 64, 51, 248,
-			178, 5, 32, 211, 172, 180, 236, 0, 88, 189, 158, 126, 66, 14,
-			126, 140, 145, 247, 16, 187, 86, 249, 247, 255, 128, 193, 235, 7,
-			242, 13, 22, 232, 246, 153, 122, 65, 44, 100, 102, 190, 60, 34,
-			168, 193, 196, 53, 32, 98, 140, 224, 96, 12, 232, 2, 104, 16,
-			49, 102, 1, 200, 180, 92, 48, 230, 0, 120, 114, 138, 254, 154,
-			68, 4, 99, 228, 167, 128, 35, 124, 228, 133, 16, 161, 231, 43,
-			90, 229, 113, 119, 101, 235, 31, 64, 4, 202, 197, 243, 69, 145,
-			1, 54, 221, 207, 18, 25, 193, 65, 24, 208, 5, 208, 32, 128,
-			89, 0, 154, 37, 204, 28, 0, 143, 28, 197, 232, 10, 212, 222,
-			197, 200, 79, 147, 23, 27, 93, 129, 218, 187, 8, 150, 55, 160,
-			7, 160, 138, 174, 64, 237, 93, 22, 128, 123, 117, 211, 187, 28,
-			0, 143, 28, 165, 255, 7, 54, 189, 155, 145, 159, 39, 246, 233,
-			74, 248, 162, 66, 1, 81, 115, 4, 211, 251, 82, 133, 222, 242,
-			171, 11, 250, 108, 93, 159, 201, 228, 227, 6, 81, 123, 55, 193,
-			230, 13, 232, 1, 88, 210, 93, 221, 109, 1, 168, 226, 6, 81,
-			123, 183, 3, 224, 237, 119, 209, 255, 211, 2, 49, 207, 101, 228,
-			23, 136, 189, 191, 242, 200, 63, 56, 102, 192, 139, 31, 5, 138,
-			152, 238, 0, 244, 68, 5, 24, 160, 24, 96, 224, 23, 136, 10,
-			48, 64, 49, 192, 192, 47, 144, 61, 227, 82, 54, 29, 100, 228,
-			3, 4, 35, 27, 131, 80, 107, 1, 100, 164, 88, 171, 15, 180,
-			21, 56, 68, 109, 82, 98, 222, 135, 201, 192, 107, 93, 25, 206,
-			9, 196, 154, 15, 147, 226, 94, 250, 25, 151, 18, 82, 178, 7,
-			24, 249, 117, 98, 127, 87, 229, 191, 184, 50, 194, 140, 178, 63,
-			106, 119, 106, 115, 175, 224, 22, 109, 72, 129, 92, 249, 219, 207,
-			171, 61, 87, 178, 180, 83, 121, 110, 195, 195, 28, 230, 88, 102,
-			170, 39, 172, 117, 22, 251, 166, 175, 118, 170, 170, 175, 113, 60,
-			14, 204, 5, 93, 206, 142, 219, 209, 127, 91, 199, 239, 151, 113,
-			13, 78, 115, 62, 151, 30, 75, 120, 91, 36, 9, 229, 98, 117,
-			53, 104, 6, 120, 73, 109, 29, 132, 73, 177, 41, 98, 179, 68,
-			164, 53, 18, 166, 18, 118, 121, 20, 162, 209, 33, 187, 213, 247,
-			102, 193, 182, 112, 52, 218, 254, 217, 115, 92, 206, 77, 246, 115,
-			81, 196, 255, 169, 124, 55, 69, 177, 12, 125, 111, 59, 17, 233,
-			50, 140, 77, 61, 72, 200, 95, 129, 216, 62, 35, 243, 230, 104,
-			236, 54, 152, 128, 13, 255, 97, 252, 242, 207, 122, 125, 106, 69,
-			206, 187, 1, 45, 152, 50, 66, 82, 184, 101, 186, 39, 93, 209,
-			207, 228, 16, 154, 40, 79, 71, 204, 154, 159, 42, 138, 215, 181,
-			122, 169, 27, 149, 227, 44, 0, 211, 138, 60, 164, 65, 61, 89,
-			139, 223, 103, 100, 224, 97, 20, 99, 180, 7, 199, 138, 242, 183,
-			77, 228, 249, 71, 50, 41, 99, 98, 247, 222, 117, 192, 38, 207,
-			26, 111, 120, 165, 142, 232, 227, 74, 201, 79, 165, 135, 77, 186,
-			137, 103, 222, 105, 28, 52, 205, 99, 63, 56, 251, 34, 92, 141,
-			226, 166, 50, 43, 164, 59, 189, 48, 160, 24, 68, 9, 79, 250,
-			126, 93, 51, 136, 18, 158, 244, 253, 186, 22, 239, 75, 104, 193,
-			252, 117, 82, 62, 166, 65, 7, 192, 19, 39, 53, 88, 4, 80,
-			153, 104, 75, 246, 64, 1, 192, 59, 94, 78, 191, 106, 225, 162,
-			177, 24, 249, 47, 196, 62, 87, 249, 111, 22, 159, 149, 231, 98,
-			82, 120, 202, 25, 64, 148, 49, 42, 73, 253, 176, 229, 199, 45,
-			94, 109, 229, 14, 78, 170, 220, 111, 54, 69, 146, 68, 177, 246,
-			87, 109, 250, 33, 190, 151, 131, 239, 110, 235, 187, 160, 210, 166,
-			174, 107, 210, 126, 41, 185, 160, 250, 27, 194, 199, 136, 172, 124,
-			3, 100, 93, 19, 97, 88, 201, 96, 106, 221, 10, 63, 9, 68,
-			124, 6, 227, 98, 75, 91, 136, 92, 76, 254, 149, 40, 208, 228,
-			162, 206, 144, 114, 157, 172, 26, 60, 90, 4, 71, 107, 64, 23,
-			64, 131, 71, 11, 113, 81, 190, 73, 131, 14, 128, 167, 110, 213,
-			96, 17, 192, 219, 234, 26, 44, 0, 248, 202, 89, 250, 63, 37,
-			30, 109, 70, 126, 159, 216, 183, 84, 254, 123, 166, 81, 107, 242,
-			126, 201, 148, 234, 220, 26, 250, 54, 53, 105, 165, 72, 211, 107,
-			214, 164, 115, 100, 47, 199, 111, 19, 28, 176, 1, 93, 0, 13,
-			42, 129, 172, 126, 159, 40, 101, 186, 132, 231, 92, 191, 79, 14,
-			93, 167, 193, 34, 128, 135, 111, 214, 96, 1, 192, 19, 55, 73,
-			166, 62, 200, 200, 167, 136, 189, 11, 247, 134, 210, 224, 0, 64,
-			165, 33, 153, 19, 13, 30, 121, 208, 86, 160, 204, 139, 31, 71,
-			84, 23, 112, 31, 201, 131, 182, 2, 101, 94, 132, 198, 152, 250,
-			136, 161, 151, 114, 160, 254, 250, 175, 61, 156, 91, 135, 145, 47,
-			19, 251, 186, 202, 91, 61, 16, 162, 204, 141, 36, 61, 191, 114,
-			229, 247, 122, 47, 228, 68, 83, 191, 131, 247, 16, 182, 36, 43,
-			82, 243, 70, 33, 61, 201, 206, 165, 240, 122, 150, 73, 145, 108,
-			152, 67, 202, 203, 191, 71, 108, 45, 97, 88, 57, 124, 2, 25,
-			126, 126, 23, 164, 203, 23, 122, 249, 43, 248, 45, 103, 104, 38,
-			180, 180, 242, 87, 202, 218, 81, 244, 80, 130, 161, 88, 116, 117,
-			170, 195, 23, 253, 142, 122, 42, 21, 186, 165, 56, 124, 158, 203,
-			251, 157, 101, 217, 229, 30, 190, 158, 229, 240, 219, 92, 117, 139,
-			63, 36, 182, 84, 39, 182, 101, 49, 29, 86, 58, 225, 43, 248,
-			41, 149, 237, 159, 201, 63, 134, 169, 246, 118, 168, 111, 116, 148,
-			207, 245, 69, 54, 65, 231, 193, 245, 40, 74, 36, 35, 205, 89,
-			72, 228, 188, 232, 238, 191, 2, 69, 0, 179, 66, 86, 186, 41,
-			10, 243, 220, 231, 161, 140, 88, 3, 115, 19, 244, 44, 67, 99,
-			78, 78, 35, 190, 14, 18, 3, 124, 123, 72, 108, 201, 91, 252,
-			234, 226, 1, 85, 129, 29, 244, 1, 16, 159, 190, 52, 135, 210,
-			21, 222, 237, 216, 22, 135, 5, 207, 164, 181, 147, 17, 62, 58,
-			227, 39, 148, 7, 171, 217, 197, 83, 185, 2, 119, 190, 79, 135,
-			87, 81, 22, 150, 234, 167, 117, 152, 117, 101, 115, 53, 82, 124,
-			223, 211, 66, 124, 90, 186, 92, 104, 177, 7, 169, 74, 198, 164,
-			166, 90, 45, 151, 119, 114, 85, 5, 185, 71, 12, 112, 239, 219,
-			232, 177, 238, 74, 35, 188, 82, 142, 244, 198, 164, 188, 247, 178,
-			13, 202, 33, 184, 68, 12, 232, 2, 104, 184, 129, 99, 1, 88,
-			222, 175, 65, 92, 79, 7, 15, 209, 235, 213, 122, 255, 11, 98,
-			15, 87, 247, 225, 153, 127, 59, 72, 65, 186, 80, 71, 117, 43,
-			109, 65, 213, 130, 117, 32, 155, 89, 249, 80, 99, 30, 180, 21,
-			120, 88, 213, 248, 85, 168, 145, 97, 141, 161, 31, 70, 203, 126,
-			178, 12, 53, 235, 202, 8, 228, 48, 165, 137, 213, 11, 218, 10,
-			252, 172, 131, 171, 159, 48, 242, 77, 98, 95, 170, 252, 158, 163,
-			172, 123, 242, 220, 77, 62, 219, 178, 238, 135, 173, 54, 114, 243,
-			85, 121, 1, 58, 139, 142, 106, 246, 192, 68, 111, 115, 237, 104,
-			83, 196, 77, 63, 209, 209, 30, 65, 40, 232, 36, 57, 11, 77,
-			255, 133, 100, 19, 246, 46, 123, 70, 4, 230, 91, 95, 69, 13,
-			219, 91, 138, 22, 97, 67, 212, 193, 59, 208, 90, 148, 240, 7,
-			204, 189, 233, 7, 64, 158, 137, 240, 108, 186, 27, 74, 55, 248,
-			236, 36, 91, 31, 225, 107, 135, 27, 41, 50, 246, 190, 109, 97,
-			244, 64, 188, 73, 155, 138, 141, 78, 132, 111, 165, 108, 8, 31,
-			29, 21, 253, 53, 31, 8, 139, 175, 196, 209, 67, 34, 148, 46,
-			161, 9, 111, 117, 5, 213, 150, 51, 165, 188, 6, 97, 214, 75,
-			60, 253, 191, 42, 198, 212, 81, 189, 17, 15, 20, 186, 77, 233,
-			77, 1, 59, 102, 40, 29, 10, 49, 148, 60, 70, 119, 107, 69,
-			155, 161, 116, 231, 166, 60, 69, 167, 110, 148, 20, 215, 253, 22,
-			58, 237, 162, 124, 129, 162, 133, 48, 164, 75, 228, 252, 26, 208,
-			5, 208, 144, 46, 208, 198, 55, 73, 249, 118, 13, 58, 0, 222,
-			117, 70, 131, 69, 0, 95, 190, 128, 190, 18, 0, 226, 231, 87,
-			204, 163, 66, 91, 130, 61, 241, 153, 23, 173, 208, 150, 240, 84,
-			246, 153, 172, 103, 174, 7, 160, 82, 104, 75, 120, 42, 251, 140,
-			86, 104, 75, 120, 42, 251, 12, 40, 180, 11, 216, 180, 199, 200,
-			179, 228, 59, 23, 219, 182, 132, 103, 140, 207, 102, 157, 241, 176,
-			129, 146, 110, 221, 179, 0, 60, 172, 101, 37, 207, 1, 240, 118,
-			25, 214, 174, 228, 50, 242, 168, 251, 210, 132, 181, 43, 129, 214,
-			249, 168, 171, 180, 206, 18, 106, 157, 143, 186, 74, 235, 44, 161,
-			214, 249, 168, 187, 103, 28, 149, 199, 33, 230, 189, 206, 29, 248,
-			89, 79, 42, 143, 67, 22, 35, 175, 115, 139, 187, 233, 175, 193,
-			42, 31, 2, 229, 241, 49, 215, 174, 87, 62, 226, 40, 110, 219,
-			220, 30, 156, 171, 134, 118, 153, 7, 244, 68, 214, 38, 58, 43,
-			181, 102, 167, 115, 188, 38, 189, 76, 240, 157, 254, 7, 12, 143,
-			149, 43, 83, 86, 99, 120, 181, 62, 233, 70, 127, 205, 147, 39,
-			251, 61, 182, 148, 186, 234, 103, 254, 242, 244, 42, 193, 51, 148,
-			147, 202, 186, 31, 194, 60, 26, 247, 193, 77, 88, 180, 53, 110,
-			84, 121, 77, 92, 212, 96, 120, 69, 180, 163, 77, 205, 145, 50,
-			75, 16, 174, 115, 179, 117, 169, 87, 162, 212, 67, 52, 242, 32,
-			131, 242, 239, 197, 193, 188, 98, 102, 161, 49, 251, 253, 200, 70,
-			84, 194, 226, 82, 99, 110, 254, 252, 247, 243, 137, 92, 68, 142,
-			227, 32, 223, 246, 220, 18, 215, 17, 188, 87, 169, 148, 132, 170,
-			24, 92, 163, 170, 151, 65, 22, 37, 84, 232, 23, 155, 76, 100,
-			71, 212, 9, 36, 38, 253, 102, 42, 61, 112, 115, 211, 34, 137,
-			114, 8, 213, 164, 199, 92, 219, 128, 30, 128, 234, 74, 216, 16,
-			170, 73, 143, 185, 42, 210, 207, 16, 170, 73, 143, 185, 251, 43,
-			26, 44, 2, 120, 96, 86, 131, 5, 0, 95, 49, 131, 81, 167,
-			134, 200, 0, 243, 126, 220, 181, 223, 229, 202, 168, 83, 67, 232,
-			139, 242, 227, 110, 1, 54, 28, 15, 64, 32, 159, 55, 187, 100,
-			180, 50, 106, 236, 142, 27, 248, 238, 5, 250, 95, 12, 41, 55,
-			147, 55, 187, 36, 151, 96, 67, 194, 240, 8, 253, 73, 71, 213,
-			97, 49, 242, 147, 46, 25, 174, 252, 136, 147, 223, 158, 123, 144,
-			174, 188, 220, 37, 251, 86, 46, 78, 219, 145, 140, 52, 178, 213,
-			17, 84, 227, 184, 134, 94, 230, 189, 225, 109, 131, 16, 8, 80,
-			106, 170, 104, 58, 85, 12, 31, 212, 228, 20, 247, 34, 244, 52,
-			158, 137, 226, 150, 145, 27, 212, 5, 46, 164, 246, 26, 71, 161,
-			85, 106, 19, 32, 60, 101, 17, 90, 228, 154, 245, 183, 120, 140,
-			97, 54, 248, 134, 216, 136, 226, 45, 80, 229, 252, 53, 35, 24,
-			97, 159, 32, 147, 223, 150, 254, 255, 17, 108, 97, 105, 42, 98,
-			237, 33, 229, 3, 71, 199, 123, 6, 250, 226, 5, 238, 142, 62,
-			197, 78, 161, 198, 211, 243, 85, 170, 154, 237, 192, 71, 200, 184,
-			72, 250, 73, 118, 79, 163, 133, 69, 177, 217, 116, 93, 132, 84,
-			102, 231, 61, 17, 107, 164, 163, 115, 110, 234, 44, 57, 49, 197,
-			44, 193, 134, 132, 210, 16, 178, 253, 33, 233, 182, 243, 184, 171,
-			2, 190, 13, 41, 239, 155, 199, 93, 229, 125, 51, 164, 188, 111,
-			30, 119, 119, 237, 166, 127, 40, 185, 141, 197, 200, 79, 185, 246,
-			129, 202, 175, 59, 138, 63, 99, 52, 11, 53, 227, 125, 65, 243,
-			86, 243, 230, 135, 78, 28, 108, 4, 40, 187, 170, 229, 132, 215,
-			5, 80, 80, 226, 190, 180, 255, 24, 83, 209, 54, 206, 17, 26,
-			79, 185, 26, 111, 248, 74, 143, 241, 67, 83, 123, 123, 139, 226,
-			235, 179, 218, 194, 155, 250, 107, 38, 120, 85, 230, 39, 42, 36,
-			139, 152, 204, 95, 80, 246, 227, 216, 223, 66, 91, 179, 140, 37,
-			137, 226, 132, 185, 74, 209, 238, 143, 206, 183, 210, 142, 86, 106,
-			89, 148, 179, 73, 201, 125, 180, 95, 1, 200, 157, 50, 10, 112,
-			154, 115, 124, 147, 238, 196, 168, 232, 43, 31, 9, 137, 52, 19,
-			67, 110, 27, 75, 203, 162, 163, 241, 32, 164, 185, 224, 104, 234,
-			13, 55, 254, 128, 30, 184, 84, 55, 182, 71, 35, 236, 15, 148,
-			214, 148, 158, 210, 210, 92, 147, 11, 150, 38, 121, 134, 69, 112,
-			86, 13, 232, 2, 168, 36, 137, 33, 164, 162, 159, 114, 203, 154,
-			253, 88, 14, 128, 251, 43, 244, 221, 46, 82, 132, 205, 200, 7,
-			92, 251, 214, 202, 99, 46, 82, 196, 131, 73, 126, 223, 208, 166,
-			119, 145, 185, 182, 47, 162, 150, 39, 167, 198, 28, 80, 168, 43,
-			71, 145, 138, 164, 163, 130, 162, 228, 185, 9, 160, 197, 188, 78,
-			141, 88, 135, 114, 183, 223, 198, 87, 2, 124, 237, 80, 172, 197,
-			126, 27, 39, 125, 53, 120, 88, 135, 212, 162, 124, 34, 8, 211,
-			219, 111, 155, 228, 93, 245, 55, 81, 127, 49, 19, 38, 168, 95,
-			199, 107, 156, 79, 231, 131, 129, 171, 129, 220, 179, 184, 44, 119,
-			9, 42, 131, 242, 41, 194, 148, 50, 101, 110, 60, 146, 135, 104,
-			83, 18, 78, 55, 200, 205, 50, 234, 12, 48, 252, 0, 239, 87,
-			0, 7, 147, 97, 236, 214, 253, 14, 108, 28, 42, 144, 184, 100,
-			68, 38, 216, 144, 138, 226, 163, 57, 229, 106, 59, 146, 214, 12,
-			121, 47, 41, 107, 182, 70, 249, 98, 246, 236, 219, 61, 139, 203,
-			243, 151, 47, 158, 173, 55, 140, 145, 69, 13, 2, 173, 125, 61,
-			230, 49, 209, 202, 119, 94, 93, 129, 163, 70, 247, 202, 125, 171,
-			202, 27, 12, 85, 29, 55, 109, 41, 71, 65, 185, 235, 121, 184,
-			117, 104, 127, 83, 232, 200, 66, 227, 226, 244, 5, 140, 32, 208,
-			163, 86, 154, 151, 186, 82, 35, 176, 103, 51, 108, 66, 73, 68,
-			106, 178, 243, 62, 81, 202, 175, 21, 111, 103, 248, 45, 60, 197,
-			149, 183, 106, 214, 162, 104, 173, 182, 225, 167, 235, 181, 57, 160,
-			3, 145, 17, 54, 168, 64, 31, 200, 8, 27, 132, 203, 15, 184,
-			234, 80, 103, 8, 153, 221, 7, 92, 245, 112, 208, 16, 218, 122,
-			62, 224, 30, 56, 168, 193, 34, 128, 135, 78, 105, 176, 0, 224,
-			196, 45, 106, 95, 181, 152, 247, 65, 215, 254, 101, 179, 175, 194,
-			34, 249, 160, 91, 24, 198, 184, 145, 67, 50, 154, 227, 135, 93,
-			194, 42, 251, 212, 145, 88, 206, 135, 73, 94, 169, 149, 28, 86,
-			134, 114, 252, 176, 171, 124, 43, 135, 84, 40, 199, 15, 187, 229,
-			49, 122, 92, 85, 101, 49, 242, 81, 168, 106, 63, 86, 181, 141,
-			230, 146, 92, 101, 150, 204, 155, 85, 6, 28, 255, 163, 249, 202,
-			108, 70, 62, 182, 99, 101, 217, 69, 21, 93, 22, 26, 254, 88,
-			190, 50, 89, 184, 60, 70, 223, 69, 113, 233, 59, 140, 124, 210,
-			181, 79, 86, 126, 140, 106, 15, 188, 220, 29, 188, 21, 179, 115,
-			181, 253, 71, 130, 246, 214, 43, 57, 191, 224, 63, 178, 101, 52,
-			56, 237, 35, 162, 68, 228, 41, 185, 239, 103, 65, 90, 83, 180,
-			172, 170, 61, 127, 83, 223, 22, 144, 215, 19, 114, 6, 44, 188,
-			22, 138, 114, 149, 108, 109, 82, 114, 144, 0, 35, 201, 169, 124,
-			199, 146, 44, 194, 26, 114, 99, 229, 118, 172, 250, 7, 92, 85,
-			203, 9, 153, 4, 161, 54, 4, 121, 8, 162, 232, 190, 167, 86,
-			197, 216, 181, 88, 151, 213, 135, 157, 85, 225, 3, 49, 210, 159,
-			52, 52, 235, 83, 13, 117, 213, 6, 99, 105, 226, 21, 82, 24,
-			109, 159, 233, 197, 143, 5, 95, 141, 133, 144, 231, 167, 104, 48,
-			50, 33, 133, 80, 210, 166, 92, 248, 107, 34, 6, 81, 162, 13,
-			88, 213, 162, 68, 111, 180, 43, 115, 155, 211, 40, 36, 250, 234,
-			165, 185, 144, 64, 205, 166, 213, 115, 108, 27, 119, 5, 79, 186,
-			107, 107, 34, 73, 115, 175, 10, 24, 67, 191, 143, 111, 90, 131,
-			72, 30, 136, 43, 74, 48, 163, 106, 123, 235, 233, 79, 79, 196,
-			51, 12, 136, 29, 197, 234, 180, 41, 183, 180, 87, 162, 232, 161,
-			135, 132, 144, 49, 20, 163, 43, 34, 94, 135, 185, 72, 183, 58,
-			202, 40, 169, 222, 66, 235, 241, 85, 14, 182, 49, 16, 125, 163,
-			128, 251, 232, 99, 46, 123, 172, 94, 47, 12, 83, 17, 175, 170,
-			19, 120, 63, 236, 57, 121, 142, 90, 226, 12, 69, 103, 10, 117,
-			149, 2, 67, 122, 73, 141, 55, 22, 27, 126, 238, 74, 61, 136,
-			142, 221, 24, 166, 1, 4, 22, 32, 181, 88, 248, 173, 169, 196,
-			95, 21, 230, 105, 55, 154, 107, 44, 200, 247, 39, 247, 96, 163,
-			236, 240, 25, 244, 170, 76, 181, 191, 181, 110, 12, 106, 67, 102,
-			12, 99, 151, 146, 163, 185, 125, 39, 27, 68, 114, 110, 118, 99,
-			121, 45, 25, 247, 156, 182, 140, 108, 213, 91, 33, 16, 125, 16,
-			42, 107, 134, 126, 180, 83, 232, 151, 123, 20, 89, 246, 70, 140,
-			193, 201, 235, 15, 33, 133, 142, 206, 64, 236, 72, 115, 210, 139,
-			84, 61, 53, 18, 73, 167, 124, 202, 131, 118, 123, 10, 86, 10,
-			94, 72, 69, 239, 205, 13, 63, 197, 11, 211, 65, 162, 40, 83,
-			133, 147, 213, 75, 124, 198, 111, 163, 1, 106, 46, 153, 147, 107,
-			56, 120, 68, 180, 38, 142, 83, 45, 238, 245, 46, 117, 212, 15,
-			229, 139, 28, 202, 0, 217, 187, 24, 215, 253, 100, 91, 83, 25,
-			59, 57, 231, 7, 24, 198, 57, 31, 144, 95, 214, 24, 139, 4,
-			157, 45, 114, 178, 186, 31, 180, 65, 132, 154, 144, 247, 56, 112,
-			57, 83, 188, 193, 108, 122, 153, 121, 193, 225, 27, 188, 77, 200,
-			135, 119, 52, 205, 206, 227, 16, 228, 141, 6, 116, 1, 52, 34,
-			149, 99, 1, 168, 2, 156, 14, 161, 93, 241, 147, 238, 62, 189,
-			17, 57, 69, 0, 247, 159, 208, 96, 1, 192, 235, 143, 227, 187,
-			193, 100, 8, 246, 180, 63, 118, 237, 59, 42, 255, 218, 226, 221,
-			80, 207, 196, 50, 78, 156, 90, 1, 188, 25, 197, 177, 104, 166,
-			33, 176, 1, 121, 105, 66, 35, 21, 212, 42, 21, 55, 160, 150,
-			55, 151, 209, 190, 59, 197, 232, 195, 134, 117, 162, 48, 212, 131,
-			184, 156, 132, 138, 242, 124, 212, 163, 248, 208, 92, 120, 123, 57,
-			0, 34, 123, 108, 64, 23, 64, 131, 11, 98, 1, 168, 162, 102,
-			13, 161, 161, 234, 143, 221, 234, 245, 26, 44, 2, 168, 194, 15,
-			12, 225, 61, 141, 63, 118, 111, 126, 25, 253, 178, 196, 133, 203,
-			200, 147, 174, 125, 75, 229, 79, 243, 238, 160, 192, 105, 94, 178,
-			163, 43, 125, 104, 152, 188, 184, 131, 43, 21, 221, 245, 154, 92,
-			64, 145, 116, 53, 214, 92, 130, 67, 53, 32, 142, 220, 32, 209,
-			181, 0, 44, 107, 155, 128, 235, 0, 168, 142, 173, 134, 240, 166,
-			195, 147, 174, 58, 182, 26, 194, 155, 14, 79, 186, 39, 110, 162,
-			175, 68, 28, 122, 140, 124, 206, 181, 39, 43, 183, 124, 251, 79,
-			166, 202, 250, 60, 130, 53, 24, 208, 5, 208, 116, 205, 179, 0,
-			52, 180, 238, 57, 0, 238, 219, 175, 193, 34, 128, 149, 147, 26,
-			44, 0, 120, 195, 9, 25, 10, 101, 8, 132, 174, 63, 115, 237,
-			219, 100, 40, 20, 105, 5, 200, 118, 35, 57, 211, 114, 127, 235,
-			125, 248, 186, 19, 7, 40, 162, 71, 93, 37, 58, 200, 157, 169,
-			37, 86, 186, 107, 218, 171, 33, 145, 226, 99, 38, 90, 244, 189,
-			22, 154, 136, 48, 145, 4, 222, 140, 5, 70, 223, 240, 219, 217,
-			116, 20, 8, 246, 204, 128, 46, 128, 102, 204, 5, 11, 192, 178,
-			22, 37, 11, 14, 128, 135, 143, 104, 176, 8, 96, 245, 86, 13,
-			226, 32, 167, 78, 209, 251, 81, 178, 180, 153, 247, 69, 215, 254,
-			138, 235, 84, 230, 84, 116, 85, 224, 178, 141, 250, 82, 125, 126,
-			105, 110, 97, 126, 121, 113, 225, 114, 99, 166, 62, 153, 63, 73,
-			209, 36, 168, 174, 149, 100, 182, 248, 149, 32, 244, 99, 237, 234,
-			55, 132, 145, 170, 191, 232, 22, 246, 42, 245, 31, 31, 158, 124,
-			202, 37, 21, 37, 224, 201, 199, 37, 159, 202, 236, 1, 54, 202,
-			162, 79, 185, 227, 251, 77, 9, 139, 145, 47, 229, 75, 88, 50,
-			33, 43, 1, 2, 231, 151, 242, 37, 108, 70, 190, 236, 146, 253,
-			38, 3, 84, 241, 101, 87, 189, 76, 128, 9, 152, 99, 223, 56,
-			218, 69, 135, 64, 236, 254, 170, 107, 107, 230, 87, 36, 8, 106,
-			60, 23, 61, 0, 75, 154, 206, 139, 22, 128, 7, 52, 179, 40,
-			58, 0, 30, 59, 78, 255, 51, 82, 15, 113, 152, 247, 87, 174,
-			253, 247, 174, 83, 249, 69, 245, 34, 108, 111, 224, 70, 169, 81,
-			68, 171, 38, 62, 64, 63, 109, 161, 149, 7, 159, 139, 79, 35,
-			77, 72, 176, 173, 248, 57, 151, 9, 188, 208, 35, 165, 189, 110,
-			168, 93, 210, 194, 190, 74, 86, 164, 56, 215, 222, 202, 142, 35,
-			66, 154, 139, 10, 11, 34, 137, 190, 201, 129, 157, 201, 38, 13,
-			246, 138, 191, 114, 11, 251, 20, 66, 241, 245, 183, 191, 118, 201,
-			65, 133, 63, 249, 60, 219, 95, 187, 42, 212, 59, 38, 216, 144,
-			80, 57, 96, 74, 88, 140, 252, 77, 54, 5, 242, 41, 161, 191,
-			201, 166, 192, 193, 73, 251, 27, 152, 2, 93, 194, 102, 228, 235,
-			46, 185, 222, 100, 128, 42, 190, 238, 146, 195, 89, 2, 230, 168,
-			30, 53, 37, 28, 70, 158, 206, 247, 10, 186, 253, 116, 190, 87,
-			142, 13, 9, 185, 94, 17, 70, 190, 145, 145, 146, 131, 27, 193,
-			55, 50, 82, 114, 240, 165, 141, 111, 100, 164, 228, 0, 183, 255,
-			219, 124, 9, 224, 122, 127, 155, 47, 225, 218, 144, 144, 43, 225,
-			49, 242, 205, 252, 200, 129, 25, 125, 51, 63, 114, 207, 134, 132,
-			220, 200, 11, 140, 252, 93, 126, 160, 176, 148, 255, 46, 223, 104,
-			193, 134, 132, 67, 215, 153, 18, 69, 70, 254, 87, 126, 228, 64,
-			148, 255, 43, 63, 242, 162, 13, 9, 185, 145, 15, 50, 242, 140,
-			75, 14, 152, 12, 131, 22, 38, 236, 205, 18, 108, 72, 80, 225,
-			178, 134, 32, 255, 179, 174, 125, 92, 81, 249, 32, 65, 80, 47,
-			137, 65, 15, 64, 21, 245, 107, 8, 235, 122, 214, 85, 81, 191,
-			134, 240, 70, 199, 179, 238, 141, 19, 82, 109, 117, 6, 152, 247,
-			156, 107, 255, 176, 167, 212, 86, 140, 196, 247, 156, 75, 213, 240,
-			101, 36, 190, 71, 61, 114, 68, 246, 68, 197, 222, 123, 212, 83,
-			145, 241, 48, 193, 131, 132, 225, 221, 89, 130, 5, 9, 123, 14,
-			102, 9, 14, 36, 28, 230, 180, 170, 234, 180, 24, 121, 173, 71,
-			174, 171, 50, 190, 36, 30, 78, 229, 129, 172, 10, 161, 109, 10,
-			89, 4, 51, 101, 237, 88, 46, 36, 168, 247, 226, 134, 84, 4,
-			190, 215, 122, 187, 247, 103, 9, 14, 36, 28, 60, 164, 144, 68,
-			25, 121, 157, 103, 235, 61, 142, 18, 4, 53, 146, 168, 7, 96,
-			73, 239, 58, 212, 2, 176, 162, 153, 12, 117, 0, 156, 186, 9,
-			79, 191, 134, 236, 18, 35, 63, 234, 189, 216, 211, 175, 33, 188,
-			210, 240, 163, 89, 211, 37, 15, 64, 117, 250, 53, 132, 87, 26,
-			126, 212, 219, 171, 183, 230, 146, 3, 224, 145, 163, 116, 22, 231,
-			199, 98, 222, 27, 61, 251, 95, 120, 78, 229, 54, 62, 183, 227,
-			43, 213, 250, 104, 99, 51, 8, 91, 209, 166, 180, 107, 103, 79,
-			144, 200, 89, 5, 84, 189, 209, 163, 227, 244, 143, 44, 156, 2,
-			180, 70, 188, 201, 35, 147, 149, 255, 108, 245, 60, 131, 156, 5,
-			137, 212, 118, 195, 77, 223, 40, 173, 58, 240, 8, 15, 66, 249,
-			72, 131, 48, 207, 57, 8, 63, 110, 7, 218, 8, 155, 170, 208,
-			197, 147, 61, 86, 142, 252, 109, 230, 250, 236, 28, 110, 93, 23,
-			234, 231, 167, 103, 238, 239, 245, 79, 150, 46, 128, 57, 63, 128,
-			30, 13, 93, 63, 253, 2, 58, 97, 28, 180, 132, 212, 250, 36,
-			5, 200, 39, 77, 223, 148, 17, 141, 133, 196, 249, 166, 140, 56,
-			165, 113, 229, 77, 222, 158, 99, 89, 130, 3, 9, 39, 78, 226,
-			195, 66, 67, 242, 73, 211, 183, 2, 102, 214, 250, 16, 147, 195,
-			201, 138, 104, 70, 27, 34, 233, 189, 248, 134, 111, 10, 25, 181,
-			89, 198, 47, 150, 178, 157, 174, 3, 109, 245, 113, 176, 6, 106,
-			250, 166, 31, 135, 121, 83, 141, 122, 45, 245, 173, 249, 206, 91,
-			30, 36, 228, 58, 111, 97, 223, 114, 157, 7, 138, 127, 43, 116,
-			254, 138, 234, 188, 205, 200, 59, 160, 243, 171, 216, 121, 35, 61,
-			130, 72, 32, 27, 148, 225, 229, 131, 213, 222, 1, 169, 216, 31,
-			166, 215, 217, 184, 131, 148, 34, 9, 168, 103, 197, 122, 71, 156,
-			245, 29, 120, 247, 59, 242, 125, 7, 214, 252, 142, 108, 181, 74,
-			219, 209, 59, 188, 221, 89, 223, 97, 131, 120, 7, 244, 253, 63,
-			104, 154, 116, 24, 121, 183, 71, 142, 87, 222, 223, 79, 147, 189,
-			61, 13, 35, 222, 142, 66, 64, 226, 213, 41, 82, 142, 132, 246,
-			145, 98, 219, 79, 210, 237, 244, 152, 247, 15, 223, 153, 248, 232,
-			181, 81, 31, 168, 120, 239, 206, 35, 193, 241, 32, 33, 55, 129,
-			176, 7, 190, 219, 219, 115, 125, 150, 128, 99, 62, 54, 161, 88,
-			214, 16, 35, 239, 241, 212, 35, 200, 67, 120, 55, 232, 61, 25,
-			223, 24, 242, 0, 52, 44, 107, 200, 2, 176, 162, 55, 129, 33,
-			7, 192, 201, 26, 158, 154, 15, 217, 195, 140, 60, 225, 125, 231,
-			78, 205, 135, 240, 134, 208, 19, 89, 103, 134, 61, 0, 75, 154,
-			107, 13, 91, 0, 30, 214, 18, 236, 176, 3, 160, 58, 53, 31,
-			114, 25, 121, 175, 247, 210, 156, 154, 15, 185, 3, 80, 185, 58,
-			53, 31, 194, 83, 243, 247, 122, 234, 212, 124, 8, 79, 205, 223,
-			235, 237, 25, 167, 7, 161, 31, 131, 140, 252, 180, 103, 15, 87,
-			71, 205, 5, 197, 7, 209, 198, 40, 107, 26, 28, 128, 207, 202,
-			119, 102, 8, 29, 244, 242, 160, 173, 192, 187, 84, 77, 63, 227,
-			217, 35, 213, 73, 19, 3, 74, 42, 144, 147, 234, 239, 114, 180,
-			146, 68, 160, 251, 45, 183, 162, 229, 48, 74, 151, 187, 137, 105,
-			198, 130, 178, 202, 41, 124, 8, 125, 251, 242, 160, 173, 192, 33,
-			106, 147, 97, 230, 253, 188, 55, 240, 97, 117, 204, 15, 40, 254,
-			121, 175, 184, 27, 247, 164, 97, 96, 224, 239, 243, 236, 163, 47,
-			110, 79, 26, 70, 78, 249, 62, 61, 157, 195, 200, 39, 223, 167,
-			247, 164, 97, 228, 146, 239, 211, 123, 210, 48, 242, 200, 247, 121,
-			71, 170, 72, 91, 195, 176, 146, 223, 255, 29, 164, 173, 97, 228,
-			124, 239, 207, 58, 3, 124, 239, 253, 154, 182, 134, 145, 235, 189,
-			95, 211, 214, 48, 242, 188, 247, 107, 218, 26, 118, 25, 249, 208,
-			75, 68, 91, 195, 64, 91, 31, 210, 180, 53, 140, 180, 245, 33,
-			77, 91, 195, 72, 91, 31, 242, 148, 71, 198, 8, 243, 62, 226,
-			13, 124, 74, 77, 213, 136, 197, 200, 71, 188, 226, 46, 250, 0,
-			37, 100, 4, 166, 234, 99, 158, 205, 43, 13, 243, 92, 108, 238,
-			50, 140, 246, 237, 151, 199, 198, 27, 190, 140, 238, 110, 156, 38,
-			228, 107, 226, 254, 134, 48, 87, 247, 18, 140, 130, 167, 197, 37,
-			232, 203, 8, 206, 230, 199, 52, 2, 71, 208, 171, 250, 99, 158,
-			82, 62, 71, 112, 54, 63, 230, 149, 15, 104, 208, 1, 240, 186,
-			195, 244, 139, 22, 118, 207, 98, 228, 227, 158, 125, 75, 229, 79,
-			50, 147, 137, 10, 37, 252, 18, 58, 251, 202, 120, 60, 47, 173,
-			193, 68, 63, 65, 44, 135, 13, 68, 246, 241, 12, 71, 32, 78,
-			126, 60, 195, 145, 133, 88, 80, 246, 146, 17, 36, 178, 143, 123,
-			202, 94, 50, 130, 30, 211, 31, 247, 148, 189, 100, 4, 61, 166,
-			63, 238, 157, 184, 9, 157, 244, 70, 6, 25, 249, 143, 222, 243,
-			56, 233, 141, 0, 103, 249, 143, 154, 149, 140, 32, 103, 201, 131,
-			182, 2, 127, 203, 198, 233, 176, 25, 249, 77, 207, 94, 168, 252,
-			138, 253, 191, 135, 147, 158, 244, 142, 107, 72, 215, 55, 36, 162,
-			126, 15, 185, 62, 127, 184, 156, 59, 28, 125, 30, 127, 184, 17,
-			20, 74, 126, 51, 155, 113, 16, 73, 126, 51, 155, 113, 160, 251,
-			223, 244, 148, 63, 220, 8, 138, 35, 191, 233, 221, 117, 90, 131,
-			69, 0, 207, 204, 163, 30, 52, 34, 95, 147, 255, 77, 239, 229,
-			23, 145, 251, 142, 64, 230, 79, 188, 104, 238, 59, 130, 146, 194,
-			39, 178, 158, 129, 156, 240, 9, 205, 125, 71, 80, 74, 248, 132,
-			230, 190, 35, 40, 35, 124, 66, 115, 223, 17, 24, 212, 239, 126,
-			7, 185, 239, 8, 90, 99, 127, 55, 235, 12, 241, 0, 44, 233,
-			214, 65, 9, 255, 93, 205, 125, 71, 208, 26, 251, 187, 154, 251,
-			142, 184, 140, 124, 242, 37, 226, 190, 35, 192, 125, 63, 169, 185,
-			239, 8, 114, 223, 79, 106, 238, 59, 130, 220, 247, 147, 154, 251,
-			142, 50, 239, 15, 189, 129, 191, 80, 220, 119, 212, 98, 228, 15,
-			189, 226, 56, 253, 38, 240, 183, 81, 96, 191, 159, 1, 254, 246,
-			229, 62, 254, 38, 237, 132, 47, 57, 151, 147, 237, 188, 212, 183,
-			26, 114, 65, 225, 213, 196, 142, 226, 174, 240, 25, 61, 177, 163,
-			184, 43, 124, 70, 211, 255, 40, 238, 10, 159, 209, 28, 111, 20,
-			119, 133, 207, 104, 142, 55, 138, 78, 100, 159, 209, 28, 111, 20,
-			157, 200, 62, 3, 28, 239, 21, 136, 82, 139, 145, 39, 95, 52,
-			249, 143, 34, 43, 126, 50, 235, 24, 236, 247, 79, 106, 242, 31,
-			69, 86, 252, 164, 38, 255, 81, 100, 197, 79, 2, 249, 127, 64,
-			78, 167, 205, 200, 231, 61, 251, 214, 202, 19, 253, 22, 96, 125,
-			56, 37, 39, 66, 152, 39, 220, 123, 231, 123, 71, 187, 48, 221,
-			209, 48, 252, 237, 218, 133, 233, 14, 134, 225, 81, 228, 66, 159,
-			207, 6, 139, 241, 138, 178, 89, 0, 84, 126, 222, 83, 134, 225,
-			81, 228, 66, 159, 247, 14, 115, 13, 22, 1, 60, 114, 74, 131,
-			5, 0, 39, 111, 161, 119, 35, 38, 28, 70, 254, 220, 179, 207,
-			84, 78, 127, 251, 150, 129, 30, 249, 97, 20, 249, 209, 159, 103,
-			125, 4, 126, 244, 231, 94, 233, 70, 13, 90, 0, 30, 187, 93,
-			131, 216, 238, 93, 167, 145, 31, 141, 194, 240, 158, 250, 14, 242,
-			163, 81, 228, 71, 79, 101, 157, 1, 126, 244, 148, 230, 71, 163,
-			200, 143, 158, 210, 252, 104, 20, 249, 209, 83, 154, 31, 141, 186,
-			140, 124, 229, 37, 226, 71, 163, 192, 143, 190, 162, 249, 209, 40,
-			242, 163, 175, 104, 126, 52, 138, 252, 232, 43, 154, 31, 149, 153,
-			247, 151, 222, 192, 107, 11, 146, 31, 149, 45, 70, 254, 210, 43,
-			238, 197, 181, 83, 6, 118, 244, 181, 23, 109, 76, 42, 227, 162,
-			254, 154, 198, 78, 25, 5, 247, 175, 233, 181, 83, 198, 69, 253,
-			53, 189, 118, 202, 184, 168, 191, 230, 29, 57, 74, 255, 212, 193,
-			182, 45, 70, 158, 241, 236, 83, 249, 171, 93, 89, 56, 196, 151,
-			144, 15, 170, 70, 94, 106, 38, 168, 99, 204, 212, 232, 169, 47,
-			91, 120, 134, 125, 154, 203, 96, 247, 38, 120, 241, 45, 38, 106,
-			241, 173, 167, 116, 160, 252, 236, 85, 85, 121, 214, 117, 44, 201,
-			94, 237, 111, 92, 154, 161, 156, 243, 213, 216, 223, 16, 155, 81,
-			252, 80, 141, 243, 251, 4, 247, 59, 81, 59, 90, 3, 34, 198,
-			135, 188, 35, 31, 35, 229, 169, 208, 68, 230, 197, 233, 136, 71,
-			221, 56, 17, 237, 43, 34, 81, 87, 118, 56, 223, 20, 50, 92,
-			147, 14, 161, 215, 77, 76, 56, 92, 124, 75, 76, 9, 96, 155,
-			248, 66, 125, 160, 124, 28, 148, 19, 177, 12, 194, 215, 140, 218,
-			80, 209, 89, 25, 196, 214, 208, 5, 240, 212, 103, 50, 186, 0,
-			241, 246, 25, 205, 102, 202, 200, 83, 159, 209, 204, 190, 140, 60,
-			245, 25, 79, 133, 132, 41, 163, 120, 251, 140, 167, 66, 194, 148,
-			81, 188, 125, 198, 59, 121, 51, 46, 240, 50, 48, 220, 103, 191,
-			131, 11, 188, 140, 28, 241, 217, 172, 171, 232, 225, 175, 23, 120,
-			25, 57, 226, 179, 122, 129, 151, 145, 35, 62, 171, 23, 120, 217,
-			101, 228, 209, 194, 75, 179, 192, 203, 232, 128, 95, 80, 11, 188,
-			44, 29, 240, 11, 106, 129, 151, 165, 3, 126, 65, 45, 240, 49,
-			230, 189, 174, 48, 240, 111, 212, 2, 31, 179, 24, 121, 93, 161,
-			184, 135, 254, 9, 172, 178, 49, 244, 160, 46, 216, 167, 42, 255,
-			45, 127, 129, 18, 29, 73, 94, 226, 251, 147, 208, 198, 75, 127,
-			125, 82, 197, 2, 252, 223, 109, 137, 141, 33, 235, 125, 115, 193,
-			54, 160, 11, 160, 90, 98, 99, 210, 45, 190, 160, 150, 216, 24,
-			178, 222, 55, 23, 212, 18, 27, 67, 121, 234, 205, 5, 181, 196,
-			198, 80, 158, 122, 115, 225, 228, 205, 244, 175, 64, 168, 25, 35,
-			3, 204, 251, 137, 130, 253, 206, 130, 211, 123, 235, 86, 121, 31,
-			181, 196, 148, 140, 146, 57, 133, 46, 96, 19, 81, 44, 125, 130,
-			130, 144, 223, 189, 180, 116, 9, 214, 100, 219, 15, 155, 226, 184,
-			156, 252, 150, 216, 232, 68, 169, 8, 97, 90, 163, 152, 135, 34,
-			72, 215, 69, 252, 74, 153, 119, 197, 79, 68, 11, 163, 171, 245,
-			185, 97, 230, 174, 34, 158, 175, 47, 1, 113, 172, 200, 56, 150,
-			254, 170, 160, 122, 218, 165, 125, 244, 210, 229, 220, 247, 172, 57,
-			227, 102, 148, 243, 177, 207, 159, 0, 92, 90, 88, 92, 210, 200,
-			196, 123, 7, 63, 81, 80, 167, 152, 99, 242, 222, 193, 219, 11,
-			234, 212, 108, 76, 93, 51, 120, 123, 65, 157, 154, 141, 169, 107,
-			6, 111, 47, 84, 14, 208, 235, 85, 9, 139, 145, 119, 20, 200,
-			120, 117, 183, 188, 233, 39, 146, 92, 95, 168, 41, 102, 201, 108,
-			187, 178, 4, 27, 18, 246, 238, 163, 119, 168, 122, 108, 70, 126,
-			178, 64, 118, 85, 143, 229, 81, 39, 31, 65, 209, 111, 74, 96,
-			148, 95, 57, 7, 73, 86, 53, 222, 115, 40, 168, 247, 211, 199,
-			148, 115, 252, 79, 22, 198, 24, 30, 174, 141, 217, 22, 243, 30,
-			47, 216, 239, 42, 220, 168, 102, 29, 120, 244, 227, 25, 1, 129,
-			220, 251, 120, 65, 29, 212, 141, 97, 71, 31, 47, 28, 156, 210,
-			160, 3, 160, 10, 102, 59, 134, 60, 250, 93, 5, 239, 6, 13,
-			22, 0, 28, 187, 30, 133, 138, 49, 104, 247, 61, 133, 23, 43,
-			84, 140, 33, 71, 126, 79, 214, 49, 224, 200, 239, 41, 40, 161,
-			98, 12, 135, 249, 158, 130, 18, 42, 198, 144, 35, 191, 167, 160,
-			238, 103, 141, 1, 244, 68, 225, 59, 183, 61, 140, 161, 48, 250,
-			68, 214, 25, 16, 70, 159, 40, 148, 116, 235, 32, 140, 62, 81,
-			80, 219, 195, 24, 10, 163, 79, 20, 212, 246, 48, 230, 50, 242,
-			222, 151, 104, 123, 24, 67, 75, 179, 222, 30, 198, 164, 165, 89,
-			111, 15, 99, 210, 210, 12, 219, 195, 99, 14, 181, 9, 99, 222,
-			191, 43, 12, 252, 199, 130, 85, 249, 150, 205, 167, 141, 75, 154,
-			113, 22, 7, 134, 228, 27, 35, 96, 134, 54, 227, 176, 104, 176,
-			100, 30, 2, 109, 111, 97, 212, 94, 225, 203, 136, 145, 122, 20,
-			234, 53, 10, 249, 46, 162, 14, 52, 105, 110, 183, 158, 62, 125,
-			73, 189, 233, 33, 195, 119, 231, 95, 122, 139, 162, 182, 126, 37,
-			40, 81, 108, 21, 53, 28, 188, 243, 8, 29, 156, 205, 61, 233,
-			137, 33, 207, 146, 90, 79, 32, 198, 190, 46, 4, 97, 207, 35,
-			160, 178, 132, 124, 231, 128, 199, 34, 237, 198, 161, 236, 95, 86,
-			237, 233, 211, 170, 138, 137, 227, 146, 83, 117, 226, 72, 62, 102,
-			219, 151, 109, 38, 234, 108, 45, 69, 19, 199, 143, 171, 163, 64,
-			124, 124, 19, 215, 229, 229, 252, 51, 34, 230, 173, 17, 253, 80,
-			137, 12, 177, 207, 44, 70, 254, 93, 161, 120, 128, 254, 182, 77,
-			9, 97, 206, 0, 243, 62, 92, 176, 63, 90, 112, 42, 255, 183,
-			45, 173, 89, 185, 56, 165, 61, 239, 146, 100, 206, 177, 248, 244,
-			140, 138, 192, 108, 102, 81, 190, 143, 181, 166, 98, 164, 82, 238,
-			243, 86, 148, 78, 233, 208, 228, 45, 29, 23, 34, 72, 150, 179,
-			231, 20, 212, 91, 178, 60, 88, 93, 205, 149, 206, 87, 25, 230,
-			30, 39, 225, 19, 45, 17, 70, 70, 151, 149, 143, 123, 195, 84,
-			245, 208, 0, 44, 235, 164, 255, 246, 244, 241, 26, 229, 245, 218,
-			90, 109, 242, 159, 242, 239, 173, 174, 70, 81, 117, 82, 222, 62,
-			249, 254, 73, 254, 255, 177, 247, 46, 192, 113, 36, 215, 129, 32,
-			234, 211, 31, 36, 248, 1, 11, 32, 8, 54, 135, 100, 78, 115,
-			56, 4, 200, 102, 131, 192, 112, 70, 26, 82, 148, 166, 9, 52,
-			201, 158, 1, 1, 168, 1, 204, 104, 102, 98, 6, 44, 116, 87,
-			3, 101, 118, 87, 181, 170, 170, 9, 246, 232, 38, 172, 147, 188,
-			171, 253, 200, 182, 108, 235, 214, 146, 29, 178, 181, 242, 249, 179,
-			62, 237, 174, 37, 135, 118, 45, 91, 171, 240, 71, 183, 27, 97,
-			105, 215, 150, 207, 62, 159, 45, 91, 246, 249, 228, 189, 179, 45,
-			127, 206, 246, 110, 88, 231, 143, 46, 222, 123, 153, 89, 213, 141,
-			6, 57, 163, 207, 222, 238, 5, 21, 163, 32, 50, 59, 43, 63,
-			47, 95, 190, 124, 239, 229, 251, 188, 152, 223, 180, 131, 226, 166,
-			253, 74, 190, 128, 147, 193, 170, 86, 162, 9, 127, 53, 49, 35,
-			198, 225, 243, 226, 148, 248, 102, 186, 8, 45, 197, 97, 181, 208,
-			94, 224, 99, 25, 102, 33, 25, 183, 200, 94, 224, 227, 25, 51,
-			143, 36, 209, 18, 246, 2, 31, 207, 136, 71, 49, 75, 228, 234,
-			251, 120, 70, 188, 12, 90, 194, 94, 224, 227, 153, 241, 227, 113,
-			133, 1, 21, 252, 97, 213, 167, 102, 153, 63, 153, 49, 79, 169,
-			6, 64, 71, 127, 50, 217, 39, 112, 187, 63, 9, 135, 81, 85,
-			224, 39, 214, 137, 184, 194, 128, 138, 135, 41, 136, 164, 5, 179,
-			252, 68, 70, 167, 124, 82, 22, 206, 241, 19, 146, 226, 88, 40,
-			83, 125, 34, 51, 50, 38, 139, 26, 20, 199, 39, 101, 209, 128,
-			226, 177, 135, 40, 175, 190, 5, 147, 251, 100, 70, 63, 147, 251,
-			118, 45, 145, 30, 250, 30, 216, 84, 128, 157, 218, 217, 182, 35,
-			196, 98, 244, 198, 64, 230, 207, 191, 237, 192, 129, 15, 24, 220,
-			66, 148, 218, 9, 19, 236, 216, 33, 175, 119, 2, 114, 19, 66,
-			123, 212, 34, 47, 139, 96, 55, 34, 211, 62, 29, 222, 132, 211,
-			155, 35, 227, 11, 90, 8, 170, 79, 198, 75, 3, 64, 125, 50,
-			35, 28, 30, 44, 4, 211, 39, 51, 34, 152, 155, 133, 64, 250,
-			100, 230, 244, 163, 2, 72, 186, 101, 254, 116, 70, 88, 153, 88,
-			120, 71, 252, 116, 220, 147, 158, 130, 162, 234, 9, 192, 240, 211,
-			25, 235, 17, 89, 52, 160, 40, 222, 53, 45, 40, 253, 76, 70,
-			100, 169, 179, 144, 192, 255, 76, 220, 147, 145, 130, 162, 112, 110,
-			180, 144, 192, 255, 76, 230, 208, 41, 89, 196, 111, 31, 61, 35,
-			122, 50, 45, 243, 83, 25, 93, 254, 104, 82, 81, 246, 100, 166,
-			160, 168, 230, 100, 106, 80, 20, 49, 186, 44, 84, 21, 124, 42,
-			70, 129, 148, 101, 254, 155, 140, 46, 151, 158, 50, 177, 40, 123,
-			74, 225, 175, 106, 78, 41, 13, 138, 135, 36, 190, 164, 12, 40,
-			138, 252, 99, 22, 220, 150, 159, 206, 136, 148, 115, 22, 26, 63,
-			126, 58, 238, 41, 157, 130, 162, 154, 83, 90, 131, 162, 245, 176,
-			44, 26, 80, 124, 228, 52, 251, 2, 92, 27, 99, 86, 250, 23,
-			51, 67, 255, 115, 86, 203, 253, 181, 78, 106, 241, 178, 215, 105,
-			133, 194, 193, 145, 111, 145, 7, 2, 159, 194, 55, 106, 100, 83,
-			200, 209, 129, 130, 45, 201, 128, 15, 225, 52, 229, 185, 130, 123,
-			4, 51, 0, 132, 53, 191, 45, 146, 96, 137, 240, 52, 194, 174,
-			205, 73, 36, 212, 146, 138, 33, 124, 52, 33, 215, 90, 188, 60,
-			225, 230, 216, 102, 68, 127, 168, 61, 12, 38, 220, 31, 194, 109,
-			63, 136, 196, 243, 212, 142, 115, 230, 142, 202, 90, 212, 178, 239,
-			98, 40, 116, 52, 89, 63, 31, 186, 17, 89, 1, 215, 109, 138,
-			48, 69, 220, 85, 211, 1, 18, 222, 9, 73, 16, 65, 175, 89,
-			124, 227, 114, 67, 30, 214, 28, 207, 14, 92, 159, 124, 176, 58,
-			56, 51, 101, 145, 130, 47, 242, 24, 251, 73, 188, 224, 99, 204,
-			168, 94, 135, 95, 124, 40, 161, 247, 132, 2, 223, 246, 219, 78,
-			163, 67, 62, 25, 146, 247, 167, 59, 4, 206, 87, 34, 196, 15,
-			197, 64, 155, 91, 254, 198, 228, 46, 187, 38, 216, 48, 186, 134,
-			198, 52, 203, 252, 197, 76, 150, 120, 195, 49, 224, 248, 63, 155,
-			209, 127, 57, 67, 134, 87, 99, 200, 15, 127, 54, 147, 25, 71,
-			162, 55, 70, 252, 240, 231, 50, 194, 238, 108, 76, 240, 195, 159,
-			203, 8, 187, 179, 49, 193, 15, 127, 46, 35, 236, 206, 198, 136,
-			31, 254, 247, 25, 243, 144, 106, 160, 81, 197, 190, 184, 66, 135,
-			138, 131, 163, 234, 11, 221, 50, 255, 67, 242, 11, 232, 226, 63,
-			36, 191, 160, 22, 137, 47, 12, 203, 252, 165, 140, 57, 169, 26,
-			192, 105, 253, 37, 201, 93, 99, 133, 14, 21, 19, 71, 104, 153,
-			250, 144, 149, 254, 124, 70, 255, 66, 198, 164, 101, 82, 214, 98,
-			121, 58, 198, 144, 212, 126, 62, 35, 178, 243, 141, 81, 214, 226,
-			204, 209, 51, 178, 104, 64, 241, 236, 57, 118, 144, 138, 89, 232,
-			172, 240, 133, 140, 65, 211, 209, 135, 178, 135, 44, 243, 87, 50,
-			194, 58, 13, 202, 99, 67, 150, 249, 191, 40, 168, 65, 133, 102,
-			153, 191, 154, 49, 79, 176, 81, 241, 197, 132, 149, 254, 181, 140,
-			249, 235, 153, 148, 106, 50, 62, 100, 153, 255, 91, 198, 44, 199,
-			21, 154, 101, 254, 70, 79, 133, 110, 153, 191, 153, 49, 231, 197,
-			214, 105, 86, 250, 183, 51, 250, 255, 174, 182, 14, 131, 225, 103,
-			50, 7, 5, 144, 208, 184, 234, 139, 241, 78, 145, 209, 209, 23,
-			51, 194, 44, 113, 76, 120, 116, 125, 49, 35, 204, 18, 199, 200,
-			163, 235, 119, 50, 194, 177, 106, 76, 184, 109, 253, 78, 70, 56,
-			234, 142, 9, 183, 173, 223, 201, 8, 71, 221, 49, 114, 219, 250,
-			221, 140, 121, 80, 53, 64, 69, 114, 70, 120, 101, 143, 9, 223,
-			172, 223, 205, 236, 63, 32, 54, 66, 179, 210, 191, 151, 209, 255,
-			72, 109, 4, 92, 12, 191, 23, 111, 4, 200, 34, 191, 39, 239,
-			188, 49, 28, 255, 247, 224, 78, 22, 69, 3, 138, 252, 97, 177,
-			17, 90, 22, 58, 203, 255, 145, 218, 8, 13, 54, 226, 255, 136,
-			55, 66, 195, 141, 248, 18, 192, 93, 85, 104, 150, 249, 251, 241,
-			70, 104, 176, 17, 255, 49, 99, 254, 95, 106, 35, 52, 220, 136,
-			63, 0, 48, 171, 10, 205, 50, 255, 48, 99, 150, 4, 220, 117,
-			43, 253, 199, 25, 253, 255, 86, 112, 135, 37, 255, 113, 38, 243,
-			144, 128, 9, 90, 47, 255, 73, 198, 156, 18, 32, 32, 235, 229,
-			63, 145, 140, 195, 152, 176, 94, 254, 19, 184, 76, 228, 23, 154,
-			101, 254, 105, 12, 69, 178, 94, 254, 211, 24, 138, 100, 189, 252,
-			167, 4, 69, 250, 66, 183, 204, 63, 139, 143, 12, 89, 47, 255,
-			89, 124, 100, 116, 209, 2, 143, 12, 192, 93, 183, 210, 127, 158,
-			209, 255, 90, 193, 29, 110, 176, 63, 143, 225, 14, 151, 199, 159,
-			103, 68, 110, 211, 49, 236, 237, 207, 51, 15, 191, 81, 22, 13,
-			40, 94, 126, 147, 128, 187, 158, 133, 206, 174, 252, 181, 130, 187,
-			14, 112, 255, 139, 24, 238, 58, 194, 253, 47, 227, 3, 160, 35,
-			220, 255, 83, 12, 119, 29, 224, 254, 159, 51, 230, 95, 41, 184,
-			235, 8, 247, 175, 196, 248, 174, 35, 220, 255, 159, 24, 223, 13,
-			43, 253, 183, 25, 253, 93, 89, 9, 119, 56, 243, 127, 155, 201,
-			28, 22, 48, 65, 3, 228, 191, 203, 152, 92, 128, 128, 12, 144,
-			255, 46, 35, 236, 89, 199, 132, 1, 242, 223, 101, 78, 156, 84,
-			95, 104, 150, 249, 213, 24, 238, 100, 128, 252, 213, 24, 238, 100,
-			128, 252, 213, 24, 238, 104, 128, 252, 206, 172, 58, 33, 100, 128,
-			252, 206, 172, 58, 33, 100, 128, 252, 206, 172, 120, 86, 31, 51,
-			129, 201, 248, 239, 179, 166, 60, 148, 134, 57, 4, 229, 253, 7,
-			100, 123, 36, 184, 189, 21, 186, 168, 160, 141, 51, 172, 244, 187,
-			179, 250, 123, 179, 114, 227, 160, 195, 119, 103, 213, 198, 129, 88,
-			250, 238, 172, 176, 198, 26, 67, 58, 248, 238, 172, 176, 198, 26,
-			67, 174, 229, 221, 217, 194, 121, 177, 113, 70, 22, 58, 43, 190,
-			55, 43, 55, 206, 128, 141, 251, 182, 172, 218, 56, 3, 55, 238,
-			239, 101, 213, 198, 25, 184, 113, 127, 63, 171, 54, 206, 128, 141,
-			123, 79, 214, 252, 135, 217, 148, 106, 2, 27, 247, 143, 178, 102,
-			41, 174, 208, 44, 243, 31, 103, 213, 198, 153, 86, 250, 59, 178,
-			250, 119, 171, 141, 195, 204, 163, 89, 97, 238, 63, 102, 154, 176,
-			113, 223, 169, 38, 1, 101, 13, 43, 30, 138, 43, 116, 168, 56,
-			201, 213, 23, 154, 101, 190, 47, 171, 232, 191, 137, 27, 247, 190,
-			172, 162, 255, 38, 110, 220, 251, 178, 19, 71, 212, 23, 186, 101,
-			126, 87, 214, 180, 84, 3, 52, 176, 140, 119, 146, 142, 212, 119,
-			101, 71, 15, 9, 184, 155, 86, 250, 123, 178, 250, 247, 43, 184,
-			3, 143, 247, 61, 49, 220, 205, 52, 20, 133, 193, 255, 24, 242,
-			120, 223, 147, 61, 118, 78, 22, 13, 40, 22, 103, 4, 220, 205,
-			44, 116, 118, 225, 251, 21, 220, 77, 128, 251, 251, 99, 184, 155,
-			8, 247, 255, 33, 134, 187, 137, 112, 255, 39, 49, 220, 77, 128,
-			251, 247, 102, 205, 15, 42, 184, 155, 8, 247, 239, 203, 154, 139,
-			2, 204, 41, 43, 253, 161, 172, 254, 79, 21, 152, 129, 91, 252,
-			80, 54, 115, 72, 128, 0, 19, 247, 255, 64, 12, 85, 74, 206,
-			255, 3, 89, 161, 218, 194, 10, 29, 42, 132, 65, 248, 24, 37,
-			232, 255, 193, 172, 121, 64, 53, 208, 168, 98, 56, 174, 208, 161,
-			98, 223, 126, 245, 133, 110, 153, 31, 206, 170, 35, 151, 66, 48,
-			127, 56, 43, 76, 200, 177, 2, 91, 28, 205, 9, 48, 167, 172,
-			244, 71, 178, 250, 71, 21, 152, 129, 1, 254, 72, 12, 230, 84,
-			26, 138, 66, 5, 52, 134, 12, 240, 71, 178, 19, 15, 203, 162,
-			1, 197, 71, 78, 11, 48, 167, 178, 208, 217, 163, 31, 85, 96,
-			78, 1, 152, 127, 40, 6, 115, 10, 193, 252, 63, 246, 84, 104,
-			150, 249, 195, 89, 117, 65, 164, 178, 99, 186, 101, 254, 72, 12,
-			247, 20, 192, 253, 71, 179, 230, 63, 83, 112, 79, 33, 220, 127,
-			60, 107, 46, 199, 21, 32, 218, 103, 205, 171, 196, 96, 13, 91,
-			230, 191, 200, 234, 20, 23, 111, 108, 120, 8, 74, 35, 7, 104,
-			198, 104, 116, 147, 44, 234, 170, 104, 152, 99, 41, 43, 253, 19,
-			89, 253, 231, 178, 38, 125, 138, 118, 95, 217, 253, 226, 104, 147,
-			221, 87, 86, 104, 122, 198, 200, 238, 43, 123, 248, 40, 59, 69,
-			159, 154, 191, 144, 213, 115, 249, 9, 244, 149, 82, 58, 237, 200,
-			9, 35, 215, 219, 98, 162, 67, 13, 90, 169, 14, 53, 42, 202,
-			14, 97, 43, 127, 1, 58, 188, 36, 58, 252, 76, 86, 31, 203,
-			159, 39, 189, 183, 200, 104, 184, 229, 70, 219, 157, 77, 76, 99,
-			184, 217, 105, 160, 138, 102, 70, 165, 172, 148, 73, 21, 198, 82,
-			58, 124, 188, 223, 18, 29, 3, 10, 36, 139, 242, 215, 143, 234,
-			76, 55, 199, 173, 244, 103, 179, 67, 191, 159, 213, 114, 31, 210,
-			121, 73, 106, 140, 234, 74, 71, 216, 27, 49, 87, 197, 143, 23,
-			121, 175, 49, 9, 147, 138, 30, 36, 210, 235, 178, 94, 47, 200,
-			190, 20, 56, 130, 27, 94, 117, 162, 158, 244, 168, 94, 189, 63,
-			52, 69, 232, 160, 163, 166, 224, 246, 81, 69, 213, 137, 68, 122,
-			216, 100, 57, 241, 190, 79, 218, 38, 101, 151, 109, 243, 16, 197,
-			1, 30, 58, 118, 80, 219, 142, 159, 72, 106, 77, 63, 116, 194,
-			136, 183, 236, 168, 134, 25, 28, 132, 34, 180, 32, 130, 198, 146,
-			234, 73, 230, 53, 8, 40, 74, 36, 224, 22, 32, 218, 103, 179,
-			217, 28, 251, 9, 157, 153, 230, 184, 49, 100, 165, 127, 57, 171,
-			127, 33, 107, 228, 126, 16, 21, 124, 118, 155, 22, 137, 201, 33,
-			68, 154, 93, 105, 191, 44, 226, 134, 118, 60, 247, 237, 29, 71,
-			194, 46, 25, 144, 223, 141, 40, 78, 61, 86, 20, 249, 146, 79,
-			137, 102, 149, 45, 179, 76, 8, 75, 6, 7, 78, 93, 104, 78,
-			17, 227, 108, 145, 63, 95, 46, 132, 245, 110, 151, 112, 94, 218,
-			107, 221, 220, 15, 40, 133, 11, 121, 230, 10, 233, 200, 141, 148,
-			15, 168, 216, 22, 41, 59, 38, 82, 243, 98, 108, 89, 78, 193,
-			96, 185, 13, 242, 26, 61, 101, 65, 135, 0, 196, 94, 21, 243,
-			56, 42, 138, 126, 57, 203, 242, 72, 33, 198, 73, 81, 244, 121,
-			73, 16, 198, 133, 162, 232, 243, 89, 193, 72, 141, 11, 199, 146,
-			207, 103, 133, 245, 244, 184, 80, 20, 125, 62, 43, 28, 75, 198,
-			133, 162, 232, 243, 112, 59, 45, 137, 62, 129, 217, 207, 154, 179,
-			185, 55, 203, 216, 69, 97, 18, 79, 73, 232, 149, 201, 186, 200,
-			132, 187, 238, 120, 42, 113, 69, 60, 101, 57, 0, 48, 201, 191,
-			154, 156, 19, 176, 201, 191, 154, 221, 127, 36, 174, 192, 33, 39,
-			11, 113, 133, 1, 21, 51, 23, 216, 138, 152, 147, 110, 153, 191,
-			158, 53, 207, 229, 158, 186, 247, 156, 206, 68, 175, 121, 86, 152,
-			184, 46, 57, 43, 96, 34, 127, 61, 57, 43, 0, 196, 175, 103,
-			39, 31, 141, 43, 12, 168, 152, 62, 43, 55, 99, 216, 50, 127,
-			3, 46, 101, 177, 25, 64, 44, 127, 67, 242, 66, 88, 214, 250,
-			43, 116, 81, 33, 191, 192, 6, 163, 135, 84, 3, 109, 87, 133,
-			46, 42, 196, 144, 204, 50, 127, 19, 56, 7, 209, 1, 27, 130,
-			242, 254, 35, 72, 185, 198, 1, 27, 126, 43, 171, 211, 243, 216,
-			56, 226, 194, 111, 201, 203, 104, 28, 49, 225, 183, 178, 35, 167,
-			100, 81, 131, 226, 35, 5, 89, 52, 160, 56, 115, 129, 189, 75,
-			195, 174, 64, 16, 202, 234, 103, 114, 17, 5, 140, 118, 61, 183,
-			213, 105, 37, 114, 31, 73, 220, 159, 114, 61, 225, 248, 62, 45,
-			109, 123, 220, 16, 157, 29, 208, 105, 62, 232, 212, 48, 34, 151,
-			138, 182, 35, 143, 7, 53, 147, 62, 41, 168, 134, 86, 135, 87,
-			206, 24, 16, 231, 119, 227, 5, 0, 218, 252, 110, 118, 196, 146,
-			69, 156, 226, 88, 94, 22, 13, 40, 158, 126, 148, 253, 125, 90,
-			128, 110, 153, 95, 130, 5, 220, 165, 5, 216, 119, 113, 1, 189,
-			68, 229, 181, 77, 94, 205, 157, 37, 28, 82, 250, 230, 30, 56,
-			77, 23, 205, 76, 119, 45, 2, 240, 236, 75, 241, 34, 0, 203,
-			190, 20, 47, 2, 224, 252, 165, 120, 17, 128, 97, 95, 130, 69,
-			252, 166, 198, 116, 243, 176, 149, 254, 114, 118, 232, 47, 135, 181,
-			220, 183, 240, 178, 87, 179, 219, 161, 200, 128, 238, 14, 180, 84,
-			82, 17, 171, 68, 212, 52, 74, 231, 9, 4, 85, 68, 207, 97,
-			252, 154, 219, 116, 18, 175, 23, 148, 73, 205, 78, 36, 90, 43,
-			178, 185, 91, 223, 200, 156, 243, 241, 108, 80, 233, 6, 19, 167,
-			187, 224, 176, 102, 153, 95, 206, 102, 39, 216, 187, 14, 49, 211,
-			60, 12, 168, 251, 129, 97, 253, 225, 220, 95, 140, 242, 18, 95,
-			244, 165, 79, 124, 156, 159, 223, 230, 109, 215, 161, 72, 19, 189,
-			61, 114, 187, 55, 37, 43, 172, 149, 145, 175, 126, 216, 246, 61,
-			138, 137, 101, 39, 179, 98, 197, 105, 239, 85, 192, 233, 4, 64,
-			221, 80, 100, 122, 118, 40, 93, 9, 81, 239, 70, 167, 9, 133,
-			202, 66, 57, 44, 200, 145, 235, 206, 93, 39, 8, 11, 125, 241,
-			241, 227, 100, 45, 34, 85, 170, 219, 114, 155, 118, 0, 125, 249,
-			104, 209, 134, 87, 142, 72, 185, 92, 224, 161, 221, 229, 59, 142,
-			8, 118, 78, 75, 80, 33, 122, 247, 12, 188, 14, 96, 21, 153,
-			44, 125, 95, 197, 219, 125, 149, 241, 69, 7, 99, 196, 251, 254,
-			109, 184, 141, 48, 73, 127, 108, 108, 23, 175, 27, 123, 191, 87,
-			87, 47, 139, 224, 189, 47, 191, 172, 254, 129, 255, 94, 126, 25,
-			126, 180, 197, 143, 155, 53, 252, 167, 238, 112, 222, 224, 124, 107,
-			219, 101, 252, 57, 39, 78, 55, 175, 178, 235, 241, 166, 216, 79,
-			138, 99, 28, 182, 109, 143, 115, 204, 164, 199, 123, 255, 151, 124,
-			47, 225, 252, 69, 187, 224, 78, 115, 254, 34, 191, 88, 224, 23,
-			10, 124, 174, 192, 47, 240, 151, 176, 29, 156, 232, 157, 109, 191,
-			185, 123, 97, 69, 241, 225, 102, 223, 135, 5, 126, 17, 190, 93,
-			67, 255, 165, 77, 167, 201, 167, 228, 234, 167, 233, 147, 90, 161,
-			190, 235, 147, 199, 229, 39, 248, 190, 48, 69, 96, 18, 237, 157,
-			66, 99, 87, 251, 89, 217, 158, 146, 147, 55, 124, 95, 52, 222,
-			42, 108, 239, 106, 252, 152, 106, 76, 121, 189, 167, 102, 167, 101,
-			204, 16, 0, 211, 121, 94, 82, 96, 19, 177, 243, 26, 20, 166,
-			38, 17, 24, 88, 132, 127, 137, 66, 167, 217, 224, 83, 110, 209,
-			41, 202, 0, 200, 152, 172, 150, 39, 145, 30, 209, 85, 102, 181,
-			119, 163, 233, 68, 172, 117, 25, 155, 130, 158, 4, 21, 103, 42,
-			2, 187, 133, 164, 8, 231, 188, 233, 111, 137, 192, 49, 142, 135,
-			188, 144, 8, 9, 40, 162, 186, 81, 0, 118, 122, 139, 19, 124,
-			149, 66, 114, 140, 64, 21, 185, 65, 156, 22, 29, 131, 190, 213,
-			110, 243, 169, 182, 31, 134, 238, 102, 179, 43, 57, 51, 52, 143,
-			145, 129, 89, 226, 55, 188, 120, 139, 195, 105, 161, 188, 230, 50,
-			67, 128, 136, 105, 162, 192, 181, 179, 237, 135, 14, 225, 23, 66,
-			77, 121, 125, 228, 99, 158, 57, 175, 160, 136, 102, 51, 42, 64,
-			25, 102, 12, 244, 8, 90, 69, 216, 134, 155, 114, 46, 10, 137,
-			99, 131, 6, 21, 188, 30, 198, 146, 0, 165, 8, 100, 161, 12,
-			65, 70, 208, 73, 192, 79, 36, 127, 87, 196, 38, 108, 7, 104,
-			230, 1, 3, 215, 2, 63, 12, 227, 229, 99, 110, 75, 33, 25,
-			240, 150, 31, 162, 101, 142, 191, 121, 199, 245, 59, 161, 4, 46,
-			62, 134, 170, 181, 213, 243, 2, 174, 24, 131, 87, 229, 182, 151,
-			220, 111, 50, 147, 127, 114, 27, 66, 158, 8, 199, 67, 79, 29,
-			5, 245, 176, 145, 72, 198, 59, 96, 213, 189, 168, 122, 38, 164,
-			227, 45, 67, 244, 80, 236, 105, 76, 80, 47, 176, 202, 141, 66,
-			241, 4, 43, 218, 18, 174, 208, 140, 4, 186, 36, 214, 227, 56,
-			121, 10, 46, 212, 131, 11, 49, 0, 229, 107, 62, 241, 232, 208,
-			203, 166, 179, 229, 122, 158, 112, 239, 24, 4, 25, 202, 141, 17,
-			110, 219, 1, 73, 42, 118, 111, 166, 8, 58, 34, 50, 71, 62,
-			126, 131, 139, 124, 154, 24, 118, 74, 241, 96, 15, 90, 113, 114,
-			153, 161, 223, 146, 41, 196, 251, 90, 66, 207, 202, 68, 161, 229,
-			216, 194, 95, 152, 98, 29, 216, 248, 252, 239, 120, 117, 123, 192,
-			33, 226, 249, 173, 192, 239, 180, 243, 194, 22, 3, 137, 228, 166,
-			143, 114, 17, 197, 66, 245, 234, 42, 181, 66, 242, 100, 38, 224,
-			149, 76, 202, 29, 99, 52, 116, 20, 95, 152, 104, 232, 229, 171,
-			80, 14, 34, 250, 31, 116, 42, 146, 204, 186, 1, 74, 186, 190,
-			23, 135, 254, 2, 150, 187, 105, 183, 17, 80, 243, 113, 250, 200,
-			40, 16, 14, 43, 234, 5, 55, 113, 140, 196, 91, 107, 59, 240,
-			55, 237, 77, 138, 146, 163, 30, 163, 34, 31, 230, 68, 182, 118,
-			60, 194, 243, 44, 161, 36, 109, 96, 200, 3, 39, 178, 61, 138,
-			233, 137, 177, 46, 85, 232, 137, 120, 148, 26, 201, 96, 128, 23,
-			104, 16, 18, 212, 19, 153, 186, 59, 9, 7, 239, 195, 200, 48,
-			127, 96, 88, 87, 197, 52, 20, 133, 54, 255, 48, 50, 204, 31,
-			24, 30, 127, 72, 22, 13, 40, 162, 82, 207, 52, 15, 131, 44,
-			251, 193, 97, 253, 203, 195, 164, 190, 58, 140, 146, 218, 7, 135,
-			153, 197, 254, 81, 134, 165, 161, 140, 161, 235, 134, 205, 66, 238,
-			43, 105, 94, 137, 217, 26, 130, 19, 208, 105, 137, 174, 123, 241,
-			104, 210, 12, 73, 248, 150, 51, 181, 70, 184, 54, 202, 137, 72,
-			156, 194, 0, 49, 97, 78, 200, 49, 159, 140, 32, 106, 34, 183,
-			42, 230, 156, 182, 137, 74, 2, 147, 72, 50, 111, 224, 251, 209,
-			192, 25, 200, 148, 119, 64, 145, 68, 28, 35, 33, 35, 11, 178,
-			44, 79, 98, 177, 143, 191, 65, 102, 25, 70, 193, 187, 31, 239,
-			192, 199, 240, 14, 124, 3, 94, 152, 44, 38, 209, 151, 136, 18,
-			55, 157, 162, 76, 43, 3, 219, 57, 245, 216, 52, 231, 51, 51,
-			248, 157, 76, 42, 80, 196, 197, 77, 189, 97, 90, 113, 14, 51,
-			51, 216, 165, 106, 0, 55, 240, 212, 116, 130, 181, 152, 153, 225,
-			179, 113, 152, 56, 121, 140, 7, 172, 180, 103, 112, 74, 174, 147,
-			132, 228, 69, 156, 165, 186, 129, 251, 193, 212, 243, 241, 21, 126,
-			241, 50, 57, 141, 245, 143, 65, 93, 238, 234, 124, 174, 183, 115,
-			180, 8, 237, 31, 65, 102, 66, 152, 19, 93, 15, 106, 132, 203,
-			223, 221, 253, 236, 64, 182, 15, 219, 138, 204, 10, 49, 109, 160,
-			100, 53, 136, 29, 91, 174, 48, 110, 138, 111, 88, 242, 130, 80,
-			94, 115, 69, 206, 43, 13, 224, 98, 133, 75, 48, 139, 29, 197,
-			5, 78, 238, 218, 124, 216, 121, 133, 26, 125, 215, 116, 47, 107,
-			23, 211, 250, 169, 56, 2, 143, 164, 241, 76, 158, 26, 226, 234,
-			36, 217, 194, 232, 168, 210, 156, 189, 229, 214, 252, 166, 239, 77,
-			11, 225, 255, 176, 80, 147, 124, 108, 88, 8, 255, 135, 133, 61,
-			205, 199, 134, 247, 91, 113, 133, 6, 21, 99, 71, 226, 10, 3,
-			42, 114, 199, 226, 138, 44, 84, 60, 116, 142, 141, 178, 172, 168,
-			208, 161, 230, 248, 89, 246, 43, 186, 56, 243, 154, 101, 254, 20,
-			156, 249, 159, 211, 101, 26, 6, 216, 20, 153, 102, 41, 218, 14,
-			28, 16, 219, 120, 195, 239, 4, 138, 221, 186, 196, 195, 8, 200,
-			65, 211, 245, 64, 60, 192, 191, 107, 126, 179, 211, 242, 10, 140,
-			195, 141, 13, 63, 196, 252, 107, 129, 110, 153, 29, 23, 110, 165,
-			48, 236, 180, 156, 58, 221, 206, 118, 152, 232, 104, 186, 128, 159,
-			82, 63, 228, 127, 8, 237, 3, 21, 107, 152, 188, 13, 229, 141,
-			65, 176, 199, 16, 191, 34, 122, 112, 173, 91, 228, 201, 128, 118,
-			174, 231, 16, 254, 81, 151, 202, 152, 24, 186, 124, 197, 9, 252,
-			243, 100, 34, 11, 12, 136, 138, 55, 216, 245, 59, 116, 91, 236,
-			136, 220, 62, 118, 189, 206, 248, 44, 198, 44, 6, 250, 37, 84,
-			3, 117, 55, 108, 55, 237, 174, 184, 134, 108, 96, 79, 131, 196,
-			222, 105, 38, 2, 53, 222, 59, 45, 5, 21, 137, 189, 211, 16,
-			236, 137, 189, 211, 12, 168, 72, 236, 157, 150, 133, 138, 196, 222,
-			105, 176, 119, 63, 5, 123, 247, 195, 251, 196, 222, 233, 150, 249,
-			7, 195, 230, 116, 238, 253, 251, 0, 185, 17, 87, 87, 81, 240,
-			132, 251, 173, 226, 53, 252, 94, 27, 54, 149, 106, 62, 129, 177,
-			5, 97, 85, 132, 225, 236, 128, 35, 175, 249, 45, 193, 82, 35,
-			177, 116, 99, 67, 106, 188, 187, 85, 224, 136, 36, 214, 11, 225,
-			29, 63, 32, 121, 148, 113, 59, 138, 236, 218, 54, 105, 122, 251,
-			218, 99, 238, 43, 204, 110, 47, 110, 66, 180, 218, 220, 61, 174,
-			239, 161, 146, 195, 169, 117, 48, 26, 23, 52, 11, 41, 169, 56,
-			247, 124, 194, 41, 70, 246, 84, 125, 95, 209, 109, 172, 218, 147,
-			129, 124, 36, 76, 39, 237, 48, 41, 16, 224, 160, 48, 161, 166,
-			99, 3, 91, 177, 81, 119, 104, 222, 27, 106, 66, 216, 193, 109,
-			199, 105, 195, 29, 104, 111, 5, 118, 123, 27, 167, 173, 26, 32,
-			186, 209, 4, 152, 4, 214, 212, 102, 39, 66, 246, 169, 230, 123,
-			30, 197, 128, 140, 252, 105, 82, 210, 82, 252, 70, 121, 154, 138,
-			116, 51, 170, 190, 49, 48, 167, 52, 45, 220, 236, 82, 46, 196,
-			254, 197, 248, 49, 196, 226, 59, 149, 56, 134, 88, 104, 80, 201,
-			88, 150, 49, 226, 234, 118, 252, 137, 8, 138, 154, 76, 20, 119,
-			89, 253, 136, 33, 64, 2, 105, 210, 57, 51, 35, 204, 140, 208,
-			171, 184, 77, 177, 213, 4, 223, 71, 220, 170, 132, 67, 65, 194,
-			16, 240, 33, 18, 121, 47, 17, 105, 60, 110, 135, 145, 19, 184,
-			225, 109, 166, 86, 160, 186, 219, 77, 49, 81, 178, 195, 76, 233,
-			128, 24, 126, 28, 245, 155, 244, 4, 65, 24, 21, 25, 95, 114,
-			118, 16, 38, 136, 185, 168, 36, 75, 112, 77, 126, 39, 106, 119,
-			112, 91, 203, 116, 201, 135, 151, 88, 207, 197, 130, 233, 210, 148,
-			58, 1, 239, 222, 121, 177, 252, 36, 226, 54, 124, 31, 197, 227,
-			61, 126, 222, 180, 131, 226, 128, 110, 55, 237, 128, 110, 191, 65,
-			119, 217, 166, 253, 10, 191, 194, 31, 187, 124, 207, 110, 95, 145,
-			163, 150, 60, 193, 160, 3, 36, 118, 181, 185, 71, 31, 45, 53,
-			243, 251, 245, 36, 91, 170, 137, 214, 253, 206, 102, 19, 132, 57,
-			159, 216, 3, 209, 193, 130, 56, 24, 10, 79, 128, 246, 214, 252,
-			96, 75, 218, 46, 11, 129, 74, 34, 129, 31, 240, 40, 176, 93,
-			244, 98, 151, 40, 34, 186, 162, 81, 185, 252, 94, 241, 58, 36,
-			8, 194, 134, 110, 54, 109, 239, 54, 33, 189, 60, 13, 34, 188,
-			58, 49, 130, 216, 13, 8, 22, 197, 251, 79, 47, 62, 90, 124,
-			174, 56, 112, 79, 168, 217, 21, 254, 56, 237, 202, 89, 126, 53,
-			137, 216, 10, 90, 200, 186, 157, 165, 168, 234, 184, 108, 190, 40,
-			214, 42, 209, 59, 20, 77, 36, 146, 11, 102, 163, 200, 207, 206,
-			220, 179, 103, 33, 76, 240, 179, 124, 43, 192, 180, 209, 226, 131,
-			62, 196, 162, 31, 249, 21, 254, 132, 218, 21, 225, 235, 195, 235,
-			125, 203, 15, 19, 215, 145, 110, 226, 61, 17, 95, 71, 122, 10,
-			42, 132, 105, 238, 97, 241, 142, 240, 7, 195, 227, 143, 196, 21,
-			6, 84, 156, 153, 66, 45, 62, 84, 24, 150, 249, 135, 195, 230,
-			89, 213, 192, 48, 177, 34, 238, 211, 72, 65, 69, 162, 79, 76,
-			120, 52, 60, 126, 58, 174, 192, 62, 166, 166, 85, 159, 166, 101,
-			254, 209, 176, 57, 167, 26, 152, 84, 17, 247, 105, 166, 160, 34,
-			209, 39, 230, 61, 26, 30, 63, 31, 87, 24, 80, 113, 97, 150,
-			77, 49, 195, 60, 156, 178, 210, 127, 58, 172, 255, 197, 112, 42,
-			119, 148, 151, 99, 113, 25, 95, 5, 125, 31, 48, 81, 24, 249,
-			31, 78, 13, 89, 230, 159, 14, 239, 167, 208, 246, 135, 241, 233,
-			55, 89, 212, 69, 241, 79, 52, 166, 155, 19, 86, 250, 43, 195,
-			67, 223, 199, 180, 220, 239, 104, 130, 77, 222, 20, 220, 102, 224,
-			52, 73, 134, 219, 118, 219, 124, 211, 137, 118, 28, 199, 235, 139,
-			48, 76, 194, 124, 20, 246, 235, 190, 153, 200, 159, 94, 226, 215,
-			101, 123, 117, 107, 39, 211, 180, 133, 161, 95, 115, 99, 55, 103,
-			12, 214, 10, 212, 95, 141, 194, 146, 202, 244, 216, 157, 69, 232,
-			170, 136, 111, 117, 188, 26, 9, 185, 113, 188, 17, 241, 81, 79,
-			34, 46, 210, 123, 79, 104, 150, 249, 149, 225, 236, 81, 118, 151,
-			153, 230, 4, 8, 133, 127, 51, 172, 159, 206, 125, 11, 47, 121,
-			188, 164, 28, 210, 228, 197, 22, 146, 30, 1, 21, 12, 192, 232,
-			98, 216, 169, 93, 64, 64, 125, 159, 188, 169, 152, 84, 178, 200,
-			140, 141, 222, 86, 114, 30, 66, 216, 157, 64, 22, 248, 111, 164,
-			176, 59, 129, 194, 238, 223, 12, 11, 83, 133, 9, 100, 127, 255,
-			102, 120, 130, 203, 162, 1, 197, 83, 143, 160, 176, 59, 1, 194,
-			238, 223, 14, 235, 31, 100, 36, 236, 78, 160, 176, 251, 183, 195,
-			108, 156, 125, 167, 198, 210, 80, 198, 128, 119, 204, 44, 228, 190,
-			53, 41, 235, 162, 51, 127, 239, 125, 218, 255, 102, 145, 156, 105,
-			226, 181, 20, 35, 149, 144, 138, 30, 47, 123, 169, 129, 178, 251,
-			121, 178, 162, 124, 58, 40, 146, 114, 10, 113, 121, 66, 70, 220,
-			99, 2, 253, 39, 4, 199, 255, 78, 38, 184, 198, 9, 25, 113,
-			143, 9, 174, 113, 66, 70, 220, 99, 130, 107, 156, 16, 28, 255,
-			59, 153, 224, 26, 39, 36, 199, 255, 78, 118, 252, 44, 91, 22,
-			235, 214, 44, 243, 221, 204, 124, 36, 247, 150, 254, 117, 35, 14,
-			116, 195, 200, 105, 145, 196, 35, 24, 183, 193, 235, 79, 204, 27,
-			184, 221, 119, 39, 231, 13, 220, 238, 187, 153, 56, 182, 19, 130,
-			219, 125, 55, 27, 63, 25, 87, 24, 80, 145, 63, 133, 193, 216,
-			38, 136, 147, 125, 15, 51, 143, 231, 182, 250, 39, 133, 242, 1,
-			241, 4, 141, 208, 193, 77, 193, 4, 49, 114, 119, 122, 241, 140,
-			17, 47, 134, 199, 50, 142, 164, 147, 176, 179, 39, 3, 130, 196,
-			228, 129, 8, 189, 39, 57, 121, 160, 141, 239, 73, 2, 29, 0,
-			246, 30, 54, 54, 25, 87, 24, 80, 113, 236, 33, 246, 5, 137,
-			74, 134, 101, 190, 151, 153, 199, 114, 191, 168, 237, 194, 37, 241,
-			90, 254, 90, 38, 47, 98, 169, 223, 119, 242, 20, 52, 13, 67,
-			134, 98, 175, 177, 67, 0, 208, 133, 182, 29, 70, 9, 225, 54,
-			112, 154, 206, 29, 144, 101, 48, 254, 243, 84, 232, 139, 40, 51,
-			222, 86, 164, 76, 194, 241, 204, 94, 193, 46, 207, 19, 23, 54,
-			157, 128, 16, 80, 250, 247, 38, 33, 4, 148, 254, 189, 73, 8,
-			1, 165, 127, 47, 27, 155, 136, 43, 16, 32, 71, 115, 236, 91,
-			8, 64, 230, 144, 149, 126, 31, 51, 191, 151, 165, 114, 111, 227,
-			213, 88, 36, 25, 184, 190, 51, 161, 112, 192, 147, 238, 178, 123,
-			156, 71, 214, 71, 51, 196, 224, 104, 239, 247, 62, 54, 124, 136,
-			93, 163, 35, 64, 38, 214, 223, 205, 210, 7, 115, 23, 201, 175,
-			73, 4, 151, 147, 163, 136, 16, 30, 84, 162, 80, 177, 68, 228,
-			237, 77, 236, 248, 16, 27, 150, 253, 104, 216, 81, 79, 149, 14,
-			85, 251, 15, 176, 55, 169, 209, 52, 203, 124, 63, 75, 31, 200,
-			157, 165, 141, 138, 53, 94, 168, 119, 13, 18, 194, 112, 171, 19,
-			137, 108, 80, 113, 135, 26, 125, 62, 156, 172, 210, 161, 106, 223,
-			126, 246, 70, 53, 134, 110, 153, 255, 132, 165, 71, 115, 143, 2,
-			81, 22, 25, 129, 252, 94, 104, 133, 202, 27, 171, 167, 127, 244,
-			101, 100, 233, 145, 100, 21, 246, 118, 128, 76, 40, 39, 232, 98,
-			254, 0, 19, 230, 180, 19, 226, 98, 254, 64, 18, 5, 204, 52,
-			84, 236, 63, 28, 87, 104, 80, 49, 113, 34, 174, 48, 160, 226,
-			225, 60, 251, 224, 8, 155, 217, 242, 139, 181, 237, 192, 111, 185,
-			157, 86, 209, 15, 182, 102, 154, 157, 154, 59, 3, 140, 138, 239,
-			205, 108, 190, 125, 166, 189, 57, 35, 92, 156, 104, 71, 173, 236,
-			230, 219, 195, 218, 182, 211, 178, 115, 152, 226, 163, 233, 40, 27,
-			168, 153, 216, 245, 140, 218, 230, 207, 176, 125, 168, 95, 18, 78,
-			97, 214, 17, 150, 217, 124, 59, 42, 183, 38, 53, 174, 77, 13,
-			87, 211, 155, 111, 95, 235, 182, 157, 75, 111, 101, 25, 49, 138,
-			117, 188, 72, 221, 22, 101, 183, 197, 100, 23, 147, 159, 251, 119,
-			38, 215, 166, 70, 230, 38, 138, 114, 30, 61, 191, 87, 101, 63,
-			87, 79, 189, 240, 240, 125, 151, 246, 244, 7, 134, 89, 218, 50,
-			15, 14, 157, 209, 216, 87, 77, 166, 237, 179, 140, 131, 67, 86,
-			238, 73, 190, 128, 122, 73, 111, 43, 169, 216, 7, 36, 81, 246,
-			11, 50, 34, 211, 156, 200, 133, 41, 2, 51, 205, 125, 210, 228,
-			243, 126, 187, 27, 184, 91, 219, 17, 159, 187, 48, 55, 139, 120,
-			182, 184, 62, 95, 225, 37, 204, 206, 128, 47, 184, 139, 110, 205,
-			241, 66, 167, 78, 74, 104, 68, 141, 82, 27, 88, 68, 249, 75,
-			129, 63, 235, 4, 248, 88, 49, 87, 188, 64, 137, 208, 242, 226,
-			167, 252, 244, 101, 134, 170, 16, 96, 36, 122, 211, 126, 187, 248,
-			228, 133, 225, 241, 93, 79, 36, 238, 166, 172, 87, 50, 151, 184,
-			232, 163, 200, 48, 119, 56, 244, 224, 111, 34, 39, 98, 243, 154,
-			223, 150, 201, 18, 100, 51, 110, 71, 76, 168, 68, 183, 163, 168,
-			125, 105, 102, 102, 103, 103, 167, 104, 227, 76, 9, 160, 212, 46,
-			156, 89, 172, 204, 151, 151, 86, 203, 231, 231, 138, 23, 24, 227,
-			235, 30, 166, 186, 80, 185, 5, 54, 69, 126, 145, 26, 154, 56,
-			52, 237, 29, 84, 40, 111, 5, 34, 135, 169, 235, 201, 52, 81,
-			5, 30, 250, 141, 104, 7, 69, 218, 186, 11, 2, 192, 102, 39,
-			234, 1, 147, 156, 153, 27, 246, 52, 192, 96, 205, 60, 95, 90,
-			229, 149, 213, 60, 191, 90, 90, 173, 172, 22, 24, 127, 174, 178,
-			118, 99, 121, 125, 141, 63, 87, 170, 86, 75, 75, 107, 149, 242,
-			42, 95, 174, 242, 249, 229, 37, 138, 124, 186, 202, 151, 175, 241,
-			210, 210, 243, 252, 153, 202, 210, 66, 129, 147, 135, 54, 119, 238,
-			2, 9, 12, 209, 137, 27, 61, 154, 235, 137, 84, 246, 114, 120,
-			21, 83, 67, 58, 243, 170, 68, 175, 91, 254, 29, 135, 130, 124,
-			98, 174, 156, 80, 89, 216, 49, 142, 233, 170, 196, 179, 194, 174,
-			21, 21, 25, 203, 50, 77, 183, 140, 67, 67, 135, 216, 48, 211,
-			141, 33, 203, 24, 27, 58, 11, 149, 89, 203, 56, 60, 244, 70,
-			168, 204, 142, 208, 159, 140, 233, 230, 144, 101, 30, 25, 202, 105,
-			140, 49, 52, 163, 54, 142, 100, 199, 217, 179, 204, 68, 138, 106,
-			28, 213, 79, 228, 42, 252, 170, 187, 245, 214, 142, 19, 116, 101,
-			118, 63, 116, 115, 163, 148, 128, 180, 128, 56, 5, 72, 175, 179,
-			120, 126, 171, 131, 169, 81, 242, 112, 107, 33, 91, 158, 50, 145,
-			7, 50, 142, 234, 170, 148, 178, 140, 163, 35, 135, 100, 73, 179,
-			140, 163, 214, 81, 89, 50, 44, 227, 232, 67, 199, 217, 159, 107,
-			76, 203, 88, 230, 233, 161, 51, 90, 238, 63, 106, 226, 84, 73,
-			13, 48, 142, 124, 190, 233, 220, 113, 154, 202, 139, 82, 60, 202,
-			248, 62, 34, 141, 60, 225, 157, 118, 221, 142, 80, 137, 199, 215,
-			129, 247, 38, 125, 1, 108, 143, 31, 68, 60, 255, 58, 41, 88,
-			158, 228, 51, 197, 200, 171, 132, 197, 152, 132, 10, 3, 153, 133,
-			145, 221, 106, 243, 43, 124, 150, 191, 56, 165, 200, 140, 232, 100,
-			186, 40, 72, 24, 191, 194, 243, 107, 149, 155, 229, 213, 181, 210,
-			205, 149, 252, 75, 148, 139, 248, 85, 6, 251, 148, 25, 178, 140,
-			71, 245, 41, 216, 155, 204, 144, 110, 25, 167, 51, 167, 232, 111,
-			19, 234, 25, 253, 157, 182, 140, 71, 71, 142, 208, 223, 154, 101,
-			60, 58, 121, 146, 254, 54, 44, 227, 209, 252, 25, 246, 199, 15,
-			177, 39, 6, 175, 204, 109, 215, 103, 236, 182, 248, 227, 206, 236,
-			12, 37, 63, 216, 104, 250, 91, 130, 68, 155, 240, 75, 238, 245,
-			82, 246, 252, 151, 77, 118, 160, 132, 125, 45, 250, 91, 152, 59,
-			217, 154, 96, 105, 138, 39, 32, 233, 53, 149, 172, 243, 108, 88,
-			193, 105, 82, 231, 218, 148, 113, 245, 224, 239, 125, 234, 145, 17,
-			54, 172, 32, 82, 141, 91, 88, 147, 44, 211, 182, 107, 183, 237,
-			45, 103, 210, 192, 126, 100, 209, 202, 177, 44, 224, 30, 80, 169,
-			73, 19, 127, 82, 101, 248, 234, 14, 81, 193, 201, 20, 125, 37,
-			138, 150, 197, 204, 200, 222, 10, 39, 211, 220, 152, 26, 174, 226,
-			223, 208, 83, 203, 137, 236, 186, 29, 217, 147, 25, 172, 87, 101,
-			107, 156, 165, 26, 77, 248, 32, 139, 63, 80, 193, 58, 197, 246,
-			215, 236, 102, 115, 131, 184, 156, 168, 59, 57, 140, 163, 236, 131,
-			202, 138, 168, 131, 70, 109, 199, 9, 226, 70, 140, 26, 65, 165,
-			106, 116, 132, 101, 168, 81, 123, 114, 132, 224, 132, 63, 183, 173,
-			227, 140, 117, 66, 39, 216, 176, 183, 28, 47, 154, 220, 135, 191,
-			13, 67, 77, 9, 42, 172, 51, 236, 160, 8, 151, 179, 33, 87,
-			186, 31, 219, 28, 16, 213, 226, 22, 128, 126, 218, 129, 143, 219,
-			236, 214, 39, 15, 80, 63, 162, 166, 82, 135, 159, 129, 216, 58,
-			97, 4, 63, 31, 164, 159, 69, 77, 165, 110, 157, 96, 35, 118,
-			39, 218, 222, 168, 111, 110, 4, 206, 157, 201, 81, 216, 175, 234,
-			48, 84, 45, 108, 86, 157, 59, 176, 70, 122, 187, 13, 157, 13,
-			96, 118, 39, 15, 209, 26, 101, 37, 72, 71, 214, 195, 76, 149,
-			55, 156, 32, 152, 180, 176, 205, 136, 172, 43, 7, 129, 85, 96,
-			150, 106, 2, 155, 191, 209, 9, 157, 218, 228, 24, 14, 55, 42,
-			127, 89, 115, 91, 206, 122, 232, 212, 174, 158, 123, 97, 250, 53,
-			225, 247, 101, 187, 237, 62, 253, 235, 19, 112, 81, 31, 24, 186,
-			168, 177, 207, 208, 69, 125, 96, 200, 122, 112, 219, 62, 184, 109,
-			191, 206, 219, 118, 116, 104, 191, 184, 98, 173, 161, 167, 228, 21,
-			43, 254, 132, 43, 120, 124, 232, 73, 246, 21, 157, 174, 219, 227,
-			67, 23, 181, 220, 151, 117, 222, 75, 35, 165, 53, 5, 183, 57,
-			93, 20, 56, 221, 91, 68, 148, 111, 197, 119, 48, 166, 34, 18,
-			118, 232, 248, 27, 111, 250, 91, 97, 50, 249, 152, 205, 55, 157,
-			48, 2, 97, 7, 174, 181, 166, 191, 197, 219, 126, 27, 77, 54,
-			101, 142, 74, 215, 59, 79, 201, 79, 69, 86, 209, 176, 200, 87,
-			253, 22, 166, 205, 196, 247, 154, 150, 221, 37, 167, 220, 192, 71,
-			245, 189, 219, 224, 54, 23, 100, 130, 215, 2, 59, 220, 118, 194,
-			132, 129, 121, 163, 217, 193, 26, 84, 240, 203, 52, 165, 34, 144,
-			16, 153, 66, 72, 43, 115, 140, 69, 26, 185, 106, 5, 106, 85,
-			171, 145, 31, 192, 70, 60, 23, 184, 145, 195, 75, 43, 149, 2,
-			15, 29, 135, 41, 159, 137, 90, 211, 239, 212, 37, 71, 143, 158,
-			19, 238, 214, 219, 225, 203, 153, 186, 95, 11, 103, 0, 19, 157,
-			243, 118, 219, 61, 5, 116, 26, 175, 213, 13, 202, 178, 136, 59,
-			201, 20, 119, 115, 60, 59, 193, 74, 146, 187, 57, 161, 143, 231,
-			47, 226, 172, 171, 43, 243, 42, 228, 18, 190, 136, 228, 145, 106,
-			20, 171, 78, 219, 15, 221, 200, 15, 186, 51, 139, 110, 24, 173,
-			4, 78, 195, 189, 155, 79, 178, 46, 39, 244, 108, 130, 117, 57,
-			49, 124, 48, 193, 186, 156, 176, 198, 216, 25, 28, 76, 179, 140,
-			147, 122, 41, 159, 227, 45, 183, 22, 248, 161, 83, 67, 131, 208,
-			208, 133, 99, 238, 180, 253, 218, 182, 236, 82, 75, 65, 203, 140,
-			44, 193, 119, 89, 201, 27, 105, 134, 101, 156, 28, 63, 44, 75,
-			89, 203, 56, 57, 241, 20, 27, 101, 195, 88, 250, 220, 191, 51,
-			161, 245, 145, 183, 176, 55, 226, 144, 186, 101, 112, 253, 112, 254,
-			28, 159, 175, 172, 44, 112, 113, 79, 98, 96, 14, 241, 103, 27,
-			23, 195, 167, 220, 6, 153, 35, 79, 203, 57, 232, 41, 248, 84,
-			46, 11, 230, 206, 135, 71, 101, 201, 176, 12, 62, 54, 206, 206,
-			226, 24, 134, 101, 60, 172, 79, 228, 143, 211, 24, 242, 194, 229,
-			149, 133, 1, 189, 26, 41, 104, 44, 123, 53, 52, 203, 120, 120,
-			88, 174, 204, 128, 142, 198, 15, 179, 34, 246, 106, 90, 70, 94,
-			63, 156, 127, 184, 119, 230, 98, 47, 7, 244, 108, 166, 224, 3,
-			217, 51, 64, 33, 175, 230, 107, 26, 150, 145, 31, 27, 103, 5,
-			236, 57, 101, 25, 143, 232, 199, 242, 39, 133, 94, 23, 239, 53,
-			160, 102, 246, 86, 56, 160, 223, 148, 9, 205, 85, 9, 62, 86,
-			124, 106, 74, 179, 140, 71, 172, 9, 89, 50, 44, 227, 145, 163,
-			57, 246, 56, 142, 146, 182, 140, 211, 250, 201, 252, 84, 223, 40,
-			146, 139, 224, 183, 157, 238, 160, 225, 210, 38, 124, 167, 74, 41,
-			203, 56, 173, 134, 75, 107, 150, 113, 218, 202, 201, 146, 97, 25,
-			167, 143, 159, 96, 111, 192, 225, 50, 192, 11, 62, 148, 63, 171,
-			82, 206, 194, 125, 176, 233, 251, 77, 199, 246, 56, 114, 40, 241,
-			171, 33, 206, 69, 14, 145, 17, 92, 164, 40, 165, 128, 143, 148,
-			3, 102, 128, 147, 180, 142, 200, 18, 240, 146, 185, 99, 108, 14,
-			7, 204, 90, 198, 148, 126, 44, 127, 154, 75, 94, 70, 121, 251,
-			80, 226, 64, 247, 21, 34, 187, 192, 255, 200, 222, 179, 41, 248,
-			72, 238, 81, 86, 179, 140, 169, 97, 9, 189, 172, 97, 25, 83,
-			71, 115, 236, 9, 236, 125, 216, 50, 166, 245, 135, 242, 211, 113,
-			239, 194, 77, 137, 66, 27, 162, 238, 175, 101, 215, 29, 121, 110,
-			229, 8, 195, 41, 248, 80, 142, 48, 172, 89, 198, 180, 26, 97,
-			216, 176, 140, 233, 163, 199, 196, 97, 100, 150, 113, 86, 159, 200,
-			231, 120, 101, 133, 219, 245, 122, 144, 72, 167, 8, 83, 118, 2,
-			217, 37, 75, 65, 75, 217, 37, 211, 44, 227, 172, 66, 44, 102,
-			88, 198, 217, 177, 195, 236, 28, 118, 57, 98, 25, 231, 244, 163,
-			249, 19, 113, 196, 144, 252, 122, 232, 4, 231, 145, 61, 203, 243,
-			109, 199, 174, 199, 221, 142, 164, 160, 181, 236, 118, 68, 179, 140,
-			115, 195, 99, 178, 100, 88, 198, 185, 137, 73, 118, 25, 187, 221,
-			103, 25, 5, 253, 68, 190, 200, 175, 151, 202, 112, 25, 227, 59,
-			131, 60, 8, 8, 9, 10, 141, 80, 31, 180, 189, 251, 82, 240,
-			181, 28, 102, 159, 102, 25, 133, 225, 73, 89, 50, 44, 163, 112,
-			236, 56, 123, 18, 135, 217, 111, 25, 231, 245, 163, 249, 66, 172,
-			167, 83, 185, 95, 107, 190, 87, 11, 156, 200, 225, 155, 118, 237,
-			182, 67, 233, 20, 224, 22, 144, 131, 236, 79, 193, 183, 114, 144,
-			253, 154, 101, 156, 87, 107, 217, 111, 88, 198, 249, 137, 73, 113,
-			246, 14, 88, 70, 81, 63, 154, 63, 201, 231, 129, 148, 243, 181,
-			192, 38, 74, 33, 6, 234, 155, 252, 129, 20, 52, 151, 253, 30,
-			208, 44, 163, 168, 250, 61, 96, 88, 70, 113, 98, 82, 76, 254,
-			160, 101, 204, 224, 228, 129, 83, 91, 184, 202, 3, 231, 14, 37,
-			227, 189, 63, 82, 30, 76, 193, 183, 146, 216, 30, 212, 44, 99,
-			38, 43, 7, 57, 104, 88, 198, 204, 196, 164, 56, 99, 163, 150,
-			49, 139, 103, 172, 102, 123, 190, 135, 230, 179, 91, 112, 103, 72,
-			102, 148, 116, 187, 244, 152, 158, 95, 126, 38, 175, 14, 245, 104,
-			10, 190, 148, 235, 24, 213, 44, 99, 86, 97, 229, 168, 97, 25,
-			179, 71, 143, 177, 11, 56, 196, 33, 203, 152, 211, 143, 229, 79,
-			9, 104, 136, 126, 157, 32, 240, 19, 25, 81, 49, 189, 165, 236,
-			251, 80, 10, 62, 145, 125, 31, 210, 44, 99, 110, 88, 222, 14,
-			135, 12, 203, 152, 155, 204, 177, 55, 99, 223, 150, 101, 60, 166,
-			243, 252, 44, 69, 215, 14, 219, 142, 23, 197, 209, 199, 19, 208,
-			7, 66, 145, 188, 161, 228, 72, 86, 10, 58, 144, 128, 178, 52,
-			203, 120, 44, 43, 137, 145, 101, 88, 198, 99, 199, 79, 110, 166,
-			41, 178, 56, 251, 217, 103, 246, 210, 5, 246, 203, 155, 53, 59,
-			76, 10, 154, 249, 231, 216, 240, 50, 106, 135, 171, 78, 195, 58,
-			199, 134, 183, 237, 112, 123, 195, 110, 110, 249, 40, 52, 30, 152,
-			59, 80, 196, 59, 249, 134, 29, 110, 151, 154, 91, 126, 53, 187,
-			45, 254, 2, 185, 101, 219, 185, 187, 81, 119, 183, 156, 48, 66,
-			57, 114, 184, 58, 188, 237, 220, 93, 192, 138, 252, 109, 54, 118,
-			221, 137, 168, 239, 245, 234, 98, 149, 22, 107, 157, 97, 105, 210,
-			70, 99, 255, 35, 115, 7, 169, 127, 53, 135, 170, 248, 217, 58,
-			199, 14, 213, 253, 29, 175, 233, 219, 245, 13, 96, 215, 61, 187,
-			229, 136, 81, 70, 229, 15, 215, 68, 125, 254, 172, 92, 197, 122,
-			117, 17, 38, 70, 150, 164, 27, 157, 160, 41, 100, 223, 97, 170,
-			89, 15, 154, 249, 111, 97, 214, 85, 103, 203, 245, 214, 219, 208,
-			197, 215, 48, 175, 4, 140, 244, 123, 195, 40, 223, 97, 99, 215,
-			92, 207, 13, 183, 123, 7, 43, 178, 177, 14, 86, 108, 248, 109,
-			135, 76, 119, 64, 246, 163, 169, 30, 162, 159, 150, 229, 47, 149,
-			186, 85, 100, 172, 225, 7, 53, 103, 3, 58, 198, 65, 7, 76,
-			112, 24, 155, 192, 36, 242, 101, 54, 54, 15, 76, 65, 243, 235,
-			26, 54, 255, 239, 53, 118, 112, 189, 183, 22, 36, 201, 1, 31,
-			143, 248, 137, 217, 130, 220, 76, 195, 0, 252, 5, 98, 80, 205,
-			122, 208, 180, 206, 178, 116, 24, 217, 81, 39, 68, 117, 194, 129,
-			57, 139, 22, 66, 3, 173, 226, 47, 85, 209, 34, 177, 43, 230,
-			189, 119, 229, 20, 219, 143, 167, 118, 67, 156, 90, 161, 116, 216,
-			135, 149, 55, 169, 238, 236, 101, 150, 149, 123, 100, 29, 101, 135,
-			111, 148, 86, 111, 108, 148, 22, 175, 47, 111, 172, 47, 173, 174,
-			148, 231, 43, 215, 42, 229, 133, 209, 33, 43, 203, 204, 213, 27,
-			165, 217, 81, 205, 98, 44, 189, 122, 163, 52, 247, 248, 19, 163,
-			250, 217, 14, 219, 151, 156, 162, 117, 156, 29, 93, 95, 89, 92,
-			46, 45, 108, 172, 174, 149, 214, 214, 87, 251, 58, 217, 207, 134,
-			233, 231, 202, 210, 245, 81, 13, 138, 207, 150, 171, 149, 107, 207,
-			67, 81, 135, 226, 202, 250, 213, 197, 202, 234, 141, 242, 194, 168,
-			97, 141, 176, 76, 185, 90, 93, 174, 150, 23, 70, 77, 107, 31,
-			203, 206, 151, 150, 230, 203, 139, 229, 133, 209, 212, 220, 63, 212,
-			89, 70, 240, 233, 214, 37, 182, 47, 121, 164, 172, 163, 4, 141,
-			1, 199, 44, 215, 3, 40, 104, 251, 102, 54, 146, 192, 122, 107,
-			146, 126, 223, 125, 16, 114, 135, 147, 219, 17, 239, 251, 83, 108,
-			95, 18, 147, 229, 216, 3, 176, 251, 30, 61, 36, 145, 82, 246,
-			48, 0, 81, 247, 232, 225, 245, 41, 29, 126, 184, 196, 50, 86,
-			234, 192, 208, 239, 104, 247, 210, 58, 204, 190, 225, 129, 214, 225,
-			129, 214, 225, 27, 163, 117, 248, 138, 193, 244, 244, 144, 101, 158,
-			28, 122, 65, 203, 125, 217, 224, 229, 187, 109, 63, 116, 84, 162,
-			244, 243, 130, 237, 69, 24, 135, 66, 244, 70, 126, 82, 196, 250,
-			164, 48, 150, 36, 120, 73, 179, 17, 94, 106, 54, 123, 69, 50,
-			41, 240, 133, 124, 138, 18, 165, 243, 246, 118, 55, 68, 238, 136,
-			194, 22, 202, 136, 193, 110, 157, 180, 14, 194, 99, 64, 140, 88,
-			144, 142, 28, 170, 195, 64, 137, 219, 28, 37, 37, 242, 125, 69,
-			215, 106, 244, 33, 84, 118, 46, 20, 1, 120, 179, 203, 132, 31,
-			8, 221, 252, 48, 201, 155, 126, 168, 92, 24, 164, 82, 65, 202,
-			14, 165, 149, 138, 242, 51, 147, 209, 29, 154, 93, 25, 103, 83,
-			114, 184, 8, 255, 94, 55, 148, 88, 61, 64, 166, 136, 165, 249,
-			69, 68, 36, 74, 29, 106, 215, 91, 174, 39, 141, 86, 233, 76,
-			209, 211, 4, 227, 73, 154, 36, 60, 71, 66, 202, 14, 36, 44,
-			252, 68, 164, 132, 186, 27, 56, 104, 139, 189, 217, 229, 53, 17,
-			237, 20, 95, 189, 61, 76, 73, 205, 56, 221, 89, 92, 221, 109,
-			194, 168, 58, 142, 18, 42, 102, 95, 68, 85, 71, 122, 8, 21,
-			7, 7, 217, 183, 105, 204, 76, 163, 174, 227, 148, 254, 166, 220,
-			14, 95, 161, 160, 156, 100, 29, 139, 30, 46, 235, 213, 197, 30,
-			87, 122, 201, 53, 55, 156, 168, 182, 141, 78, 109, 210, 154, 130,
-			87, 241, 169, 57, 228, 75, 203, 107, 27, 215, 150, 215, 151, 22,
-			56, 93, 141, 194, 15, 181, 65, 166, 125, 103, 240, 221, 61, 236,
-			212, 182, 227, 79, 247, 177, 84, 154, 20, 36, 167, 210, 150, 44,
-			233, 150, 113, 106, 236, 81, 89, 50, 44, 227, 212, 236, 37, 246,
-			179, 89, 156, 176, 102, 25, 151, 244, 167, 114, 31, 207, 114, 202,
-			30, 30, 57, 152, 147, 181, 31, 10, 100, 247, 74, 232, 32, 127,
-			196, 199, 125, 250, 166, 46, 226, 37, 184, 234, 173, 28, 185, 106,
-			84, 63, 193, 169, 164, 47, 4, 15, 204, 100, 224, 9, 101, 188,
-			223, 106, 251, 129, 29, 116, 99, 119, 131, 41, 105, 71, 203, 239,
-			184, 54, 63, 19, 115, 22, 103, 132, 117, 180, 178, 210, 96, 137,
-			76, 176, 114, 98, 0, 105, 215, 19, 49, 179, 21, 110, 86, 157,
-			176, 211, 194, 115, 40, 176, 68, 102, 225, 159, 38, 68, 108, 32,
-			6, 73, 62, 93, 116, 37, 162, 80, 96, 10, 249, 200, 239, 193,
-			178, 66, 210, 79, 174, 105, 119, 188, 218, 54, 147, 1, 194, 227,
-			80, 32, 226, 128, 40, 99, 12, 96, 230, 164, 41, 6, 198, 247,
-			14, 48, 74, 50, 192, 183, 210, 72, 66, 241, 182, 231, 239, 16,
-			121, 160, 79, 146, 253, 96, 164, 114, 91, 160, 174, 152, 169, 136,
-			219, 93, 192, 220, 131, 128, 98, 2, 132, 208, 22, 129, 40, 166,
-			32, 236, 152, 121, 197, 147, 216, 24, 198, 48, 34, 191, 211, 118,
-			179, 203, 241, 174, 100, 220, 222, 177, 187, 130, 94, 197, 120, 166,
-			98, 132, 59, 119, 221, 48, 10, 225, 96, 4, 78, 212, 65, 250,
-			90, 90, 172, 150, 75, 11, 207, 111, 148, 223, 86, 89, 93, 91,
-			21, 120, 203, 16, 113, 119, 175, 81, 122, 118, 193, 90, 227, 165,
-			118, 157, 136, 79, 181, 157, 96, 219, 110, 135, 50, 73, 189, 130,
-			223, 166, 95, 239, 114, 32, 109, 177, 181, 143, 128, 102, 163, 217,
-			157, 46, 96, 20, 14, 204, 177, 95, 195, 204, 194, 142, 55, 8,
-			155, 69, 94, 39, 59, 136, 152, 72, 226, 46, 183, 29, 136, 97,
-			145, 63, 183, 237, 120, 146, 36, 244, 33, 68, 146, 126, 237, 208,
-			56, 205, 26, 170, 124, 201, 59, 130, 172, 68, 164, 104, 217, 105,
-			162, 181, 213, 238, 29, 68, 42, 239, 241, 62, 102, 167, 39, 226,
-			111, 50, 152, 185, 202, 154, 34, 236, 243, 113, 71, 182, 2, 219,
-			139, 152, 160, 104, 10, 13, 72, 83, 141, 121, 150, 125, 116, 239,
-			109, 251, 97, 232, 192, 127, 72, 202, 225, 50, 143, 67, 149, 244,
-			90, 242, 51, 212, 254, 212, 2, 39, 162, 64, 206, 253, 179, 131,
-			147, 140, 94, 119, 174, 215, 240, 21, 177, 209, 128, 132, 164, 15,
-			201, 146, 110, 25, 151, 172, 71, 100, 201, 176, 140, 75, 51, 111,
-			102, 255, 42, 133, 196, 70, 183, 140, 103, 244, 171, 185, 31, 75,
-			137, 131, 36, 239, 63, 97, 215, 213, 191, 77, 5, 1, 15, 178,
-			85, 14, 49, 127, 22, 33, 20, 128, 79, 198, 170, 134, 107, 194,
-			185, 219, 150, 94, 1, 226, 28, 239, 125, 250, 229, 177, 39, 219,
-			119, 229, 98, 0, 71, 28, 6, 74, 30, 241, 34, 191, 102, 187,
-			205, 78, 128, 143, 240, 117, 31, 64, 39, 246, 92, 216, 87, 139,
-			41, 179, 4, 106, 69, 62, 154, 126, 117, 218, 64, 130, 144, 193,
-			199, 57, 59, 234, 221, 0, 105, 137, 155, 140, 186, 46, 140, 90,
-			161, 50, 94, 8, 158, 77, 177, 196, 132, 207, 132, 143, 179, 100,
-			216, 28, 17, 170, 171, 156, 107, 118, 108, 55, 234, 201, 29, 66,
-			238, 227, 72, 142, 186, 187, 40, 9, 205, 28, 147, 248, 220, 227,
-			222, 17, 199, 79, 145, 227, 93, 39, 73, 30, 97, 36, 5, 232,
-			34, 0, 194, 87, 40, 236, 238, 148, 191, 138, 28, 141, 216, 97,
-			60, 196, 54, 101, 76, 107, 117, 60, 183, 38, 174, 16, 202, 127,
-			112, 134, 246, 248, 140, 112, 185, 232, 157, 112, 18, 59, 72, 171,
-			143, 48, 128, 206, 128, 237, 86, 215, 123, 207, 248, 64, 47, 128,
-			179, 0, 208, 147, 77, 111, 96, 147, 31, 132, 63, 176, 107, 225,
-			97, 175, 80, 28, 110, 201, 103, 212, 125, 138, 104, 172, 238, 83,
-			221, 176, 140, 103, 102, 159, 98, 127, 101, 32, 138, 27, 150, 241,
-			188, 126, 53, 247, 135, 6, 79, 202, 56, 220, 222, 244, 131, 232,
-			222, 216, 142, 228, 49, 226, 45, 255, 142, 131, 161, 223, 35, 159,
-			75, 145, 144, 48, 8, 54, 3, 9, 63, 170, 153, 149, 160, 41,
-			126, 68, 215, 172, 38, 70, 169, 237, 180, 25, 102, 66, 143, 47,
-			214, 45, 59, 216, 196, 248, 236, 114, 135, 35, 225, 95, 206, 3,
-			167, 134, 246, 203, 216, 71, 15, 164, 147, 72, 61, 165, 34, 47,
-			187, 145, 112, 45, 199, 224, 5, 11, 194, 173, 25, 175, 77, 73,
-			170, 99, 40, 134, 234, 166, 168, 33, 48, 128, 88, 7, 188, 97,
-			187, 77, 52, 163, 147, 179, 65, 95, 193, 175, 29, 231, 56, 231,
-			215, 74, 149, 197, 242, 194, 198, 74, 181, 172, 228, 8, 217, 205,
-			238, 189, 69, 240, 41, 73, 28, 102, 164, 164, 116, 33, 87, 17,
-			52, 40, 190, 2, 145, 19, 154, 249, 166, 131, 97, 51, 4, 175,
-			88, 231, 158, 191, 51, 173, 144, 196, 208, 44, 227, 121, 133, 36,
-			134, 110, 25, 207, 43, 36, 49, 0, 45, 102, 159, 98, 239, 209,
-			153, 158, 26, 178, 204, 250, 208, 182, 150, 251, 103, 26, 95, 85,
-			230, 105, 112, 52, 209, 247, 161, 185, 229, 7, 110, 180, 221, 18,
-			220, 179, 10, 77, 191, 167, 56, 65, 162, 107, 228, 4, 118, 83,
-			196, 20, 70, 31, 30, 52, 181, 177, 189, 104, 215, 49, 145, 124,
-			167, 138, 120, 211, 71, 42, 25, 218, 43, 135, 9, 190, 189, 200,
-			230, 150, 103, 190, 161, 255, 67, 222, 57, 5, 108, 106, 61, 181,
-			159, 141, 48, 51, 133, 172, 179, 163, 63, 4, 0, 75, 17, 7,
-			235, 232, 71, 100, 73, 183, 12, 39, 119, 76, 52, 212, 44, 163,
-			161, 26, 106, 88, 74, 203, 146, 110, 25, 13, 213, 80, 183, 140,
-			45, 213, 16, 62, 219, 34, 29, 110, 138, 206, 240, 86, 238, 24,
-			123, 154, 30, 138, 155, 67, 190, 150, 123, 51, 47, 37, 229, 30,
-			63, 230, 201, 229, 179, 206, 189, 119, 65, 190, 124, 54, 179, 135,
-			216, 91, 228, 203, 103, 75, 63, 154, 159, 67, 159, 45, 185, 177,
-			34, 173, 163, 34, 141, 72, 12, 35, 159, 111, 57, 20, 186, 5,
-			246, 80, 189, 123, 166, 161, 7, 150, 120, 247, 108, 141, 140, 39,
-			222, 61, 91, 71, 38, 197, 35, 171, 102, 25, 158, 126, 84, 60,
-			178, 122, 194, 84, 94, 165, 178, 228, 219, 206, 93, 225, 158, 35,
-			158, 93, 207, 216, 155, 181, 186, 211, 40, 22, 139, 103, 146, 47,
-			162, 158, 210, 114, 3, 96, 61, 245, 18, 0, 23, 185, 119, 100,
-			18, 237, 216, 52, 203, 12, 134, 94, 33, 59, 54, 104, 21, 100,
-			143, 177, 121, 102, 98, 4, 95, 35, 210, 143, 228, 158, 232, 7,
-			100, 130, 115, 77, 112, 127, 138, 131, 69, 9, 72, 24, 173, 97,
-			242, 113, 35, 210, 71, 100, 73, 179, 140, 104, 159, 37, 75, 134,
-			101, 68, 135, 39, 216, 39, 52, 28, 79, 179, 140, 174, 126, 50,
-			247, 163, 26, 240, 149, 194, 14, 186, 32, 120, 47, 193, 72, 129,
-			44, 160, 156, 228, 40, 159, 14, 242, 244, 243, 98, 43, 23, 220,
-			16, 37, 97, 160, 12, 226, 241, 72, 136, 143, 228, 4, 71, 164,
-			146, 162, 197, 73, 141, 116, 17, 233, 52, 230, 239, 220, 12, 252,
-			157, 208, 9, 66, 30, 170, 32, 56, 112, 193, 41, 69, 2, 19,
-			159, 226, 103, 114, 133, 0, 232, 174, 0, 52, 6, 45, 54, 186,
-			195, 57, 89, 50, 44, 163, 123, 252, 4, 27, 97, 186, 169, 91,
-			169, 87, 135, 254, 190, 70, 144, 134, 197, 190, 154, 61, 196, 62,
-			161, 51, 147, 130, 246, 254, 61, 77, 159, 204, 253, 136, 206, 75,
-			82, 194, 188, 177, 182, 182, 178, 74, 114, 166, 191, 155, 107, 70,
-			75, 1, 71, 68, 178, 235, 73, 45, 130, 10, 33, 124, 189, 113,
-			188, 72, 8, 46, 69, 94, 190, 219, 118, 3, 71, 230, 100, 71,
-			183, 148, 142, 39, 84, 43, 232, 39, 131, 81, 204, 237, 22, 166,
-			43, 133, 155, 195, 21, 144, 217, 205, 198, 224, 91, 159, 96, 99,
-			128, 115, 2, 230, 165, 213, 114, 234, 32, 58, 54, 187, 196, 25,
-			57, 36, 182, 225, 148, 182, 58, 54, 240, 182, 142, 12, 74, 142,
-			65, 95, 186, 189, 193, 109, 68, 200, 72, 165, 184, 40, 242, 5,
-			31, 174, 34, 244, 149, 117, 90, 109, 188, 64, 49, 65, 8, 165,
-			27, 223, 207, 82, 0, 182, 20, 194, 45, 43, 139, 26, 20, 135,
-			199, 100, 209, 128, 226, 196, 17, 246, 18, 211, 83, 154, 149, 126,
-			175, 54, 244, 126, 77, 251, 198, 19, 191, 17, 102, 96, 56, 203,
-			247, 106, 41, 224, 155, 205, 20, 6, 191, 254, 118, 77, 71, 247,
-			155, 148, 200, 183, 175, 233, 199, 100, 81, 135, 226, 137, 147, 172,
-			136, 109, 53, 203, 252, 78, 77, 31, 205, 115, 37, 178, 80, 136,
-			131, 152, 171, 160, 187, 137, 137, 207, 53, 250, 96, 68, 22, 117,
-			40, 30, 56, 200, 222, 128, 189, 233, 150, 249, 62, 232, 109, 122,
-			128, 160, 170, 186, 37, 153, 182, 183, 91, 140, 106, 27, 119, 75,
-			29, 29, 56, 200, 222, 136, 221, 26, 150, 249, 93, 208, 237, 217,
-			228, 233, 223, 182, 161, 67, 199, 227, 237, 206, 102, 147, 174, 85,
-			65, 90, 231, 75, 171, 178, 95, 67, 195, 79, 101, 191, 134, 14,
-			197, 3, 7, 129, 166, 166, 52, 221, 180, 204, 239, 134, 126, 103,
-			133, 127, 35, 38, 173, 106, 216, 145, 221, 164, 39, 60, 149, 247,
-			129, 14, 163, 167, 114, 114, 201, 238, 129, 126, 125, 183, 166, 15,
-			203, 162, 14, 197, 3, 7, 217, 101, 236, 62, 101, 153, 223, 3,
-			221, 159, 239, 227, 101, 48, 70, 156, 100, 98, 64, 158, 78, 178,
-			119, 178, 235, 148, 134, 95, 171, 162, 14, 197, 3, 7, 49, 249,
-			153, 97, 165, 191, 87, 27, 250, 231, 26, 37, 63, 131, 53, 126,
-			175, 150, 205, 177, 119, 155, 204, 164, 88, 208, 63, 164, 233, 71,
-			114, 127, 105, 188, 78, 218, 217, 238, 168, 219, 73, 41, 251, 164,
-			193, 131, 16, 184, 247, 150, 220, 11, 200, 21, 227, 177, 20, 82,
-			19, 210, 198, 61, 100, 120, 82, 159, 219, 59, 118, 87, 73, 242,
-			177, 57, 114, 200, 81, 173, 234, 109, 241, 41, 215, 35, 165, 194,
-			107, 17, 244, 167, 69, 232, 77, 209, 238, 230, 250, 234, 26, 218,
-			236, 55, 187, 92, 61, 184, 209, 16, 5, 88, 109, 83, 220, 145,
-			82, 2, 199, 254, 118, 208, 209, 103, 23, 3, 149, 144, 114, 165,
-			253, 180, 148, 213, 209, 116, 35, 137, 232, 146, 4, 41, 97, 158,
-			24, 178, 132, 231, 169, 148, 203, 237, 218, 109, 185, 43, 98, 206,
-			118, 40, 163, 185, 176, 93, 18, 243, 84, 175, 214, 101, 26, 216,
-			185, 30, 77, 37, 144, 70, 65, 156, 12, 116, 201, 251, 33, 129,
-			248, 34, 30, 248, 15, 105, 251, 44, 89, 52, 160, 120, 120, 130,
-			125, 85, 67, 148, 209, 44, 243, 163, 154, 126, 52, 247, 103, 26,
-			121, 173, 200, 101, 11, 123, 113, 144, 88, 157, 192, 189, 147, 56,
-			210, 200, 22, 136, 211, 65, 116, 34, 76, 166, 103, 69, 47, 160,
-			65, 202, 38, 242, 68, 238, 58, 81, 140, 86, 42, 216, 159, 219,
-			136, 53, 75, 232, 255, 142, 151, 176, 64, 16, 140, 85, 164, 126,
-			6, 9, 229, 140, 218, 213, 51, 20, 140, 66, 94, 218, 162, 85,
-			49, 241, 59, 34, 3, 198, 94, 101, 201, 239, 20, 184, 180, 52,
-			66, 64, 21, 17, 32, 35, 227, 178, 104, 64, 241, 8, 101, 30,
-			52, 173, 244, 79, 104, 67, 255, 70, 28, 62, 160, 0, 63, 161,
-			101, 143, 177, 183, 50, 147, 194, 121, 127, 92, 211, 31, 206, 205,
-			3, 28, 123, 13, 51, 6, 233, 142, 146, 58, 154, 196, 83, 26,
-			28, 37, 49, 53, 147, 178, 245, 200, 107, 134, 2, 132, 127, 92,
-			27, 126, 72, 22, 13, 40, 158, 228, 236, 63, 235, 56, 1, 205,
-			50, 63, 165, 233, 199, 114, 255, 167, 142, 199, 213, 137, 10, 168,
-			240, 135, 139, 45, 84, 10, 140, 200, 231, 225, 109, 183, 29, 159,
-			158, 30, 53, 35, 192, 22, 21, 247, 20, 17, 132, 88, 15, 117,
-			23, 36, 168, 111, 204, 205, 196, 120, 159, 8, 10, 183, 91, 89,
-			47, 85, 250, 164, 97, 245, 219, 145, 219, 74, 38, 57, 196, 68,
-			233, 136, 69, 168, 147, 136, 130, 78, 40, 228, 92, 53, 58, 94,
-			80, 83, 141, 100, 140, 32, 196, 58, 87, 41, 173, 128, 31, 64,
-			137, 123, 154, 130, 12, 136, 187, 93, 242, 13, 128, 40, 117, 199,
-			115, 209, 170, 18, 109, 235, 146, 180, 167, 200, 75, 241, 133, 47,
-			184, 11, 82, 189, 81, 78, 171, 149, 114, 245, 102, 101, 117, 181,
-			178, 188, 180, 177, 80, 94, 170, 148, 23, 212, 46, 1, 2, 125,
-			74, 158, 55, 10, 202, 254, 41, 109, 223, 132, 44, 26, 80, 60,
-			154, 67, 4, 74, 89, 233, 159, 213, 134, 126, 65, 32, 16, 208,
-			249, 159, 149, 8, 132, 129, 202, 127, 254, 27, 138, 64, 41, 68,
-			160, 159, 151, 8, 68, 161, 207, 127, 94, 34, 80, 10, 17, 232,
-			231, 1, 129, 96, 106, 105, 43, 253, 111, 53, 124, 241, 132, 169,
-			165, 53, 203, 252, 183, 90, 246, 8, 251, 8, 80, 137, 52, 204,
-			237, 179, 154, 158, 203, 125, 15, 82, 9, 191, 109, 99, 52, 229,
-			72, 220, 144, 192, 231, 38, 93, 44, 1, 13, 6, 171, 37, 108,
-			192, 69, 12, 246, 17, 107, 21, 164, 189, 143, 84, 124, 221, 118,
-			8, 39, 122, 40, 28, 172, 138, 175, 5, 14, 198, 242, 98, 34,
-			94, 8, 41, 25, 105, 177, 105, 92, 236, 103, 229, 98, 211, 184,
-			216, 207, 106, 195, 135, 101, 209, 128, 226, 228, 81, 246, 5, 90,
-			145, 102, 153, 191, 2, 156, 239, 231, 52, 241, 164, 162, 40, 113,
-			130, 218, 191, 14, 245, 63, 70, 11, 72, 104, 119, 119, 49, 205,
-			75, 126, 31, 111, 76, 47, 28, 226, 25, 53, 214, 192, 35, 158,
-			203, 84, 60, 235, 213, 69, 169, 106, 181, 155, 161, 159, 12, 13,
-			221, 27, 58, 165, 23, 20, 90, 10, 87, 39, 65, 161, 225, 98,
-			5, 127, 154, 70, 148, 252, 21, 224, 79, 103, 16, 18, 186, 101,
-			254, 26, 236, 237, 195, 156, 204, 19, 246, 210, 148, 169, 222, 245,
-			52, 126, 113, 64, 22, 53, 40, 30, 148, 128, 6, 150, 237, 215,
-			0, 208, 63, 75, 128, 54, 44, 243, 11, 192, 147, 124, 76, 195,
-			240, 36, 177, 198, 132, 24, 2, 41, 104, 245, 241, 42, 49, 103,
-			23, 235, 21, 37, 137, 113, 189, 36, 15, 47, 130, 172, 198, 239,
-			67, 137, 219, 104, 207, 107, 136, 137, 136, 190, 29, 47, 114, 155,
-			125, 175, 81, 82, 85, 163, 214, 107, 164, 113, 9, 35, 178, 168,
-			65, 81, 92, 168, 105, 244, 171, 253, 2, 92, 168, 243, 184, 92,
-			211, 50, 191, 8, 84, 248, 113, 92, 173, 48, 205, 80, 107, 181,
-			249, 118, 167, 101, 123, 34, 29, 83, 179, 207, 94, 76, 141, 104,
-			166, 176, 23, 185, 127, 112, 211, 124, 81, 27, 158, 144, 69, 3,
-			138, 71, 115, 202, 132, 235, 171, 135, 216, 177, 126, 159, 76, 140,
-			80, 35, 204, 181, 14, 246, 121, 86, 230, 51, 44, 85, 134, 223,
-			175, 190, 202, 198, 106, 126, 171, 223, 243, 242, 42, 195, 95, 49,
-			38, 216, 138, 246, 130, 252, 121, 203, 111, 218, 222, 22, 26, 7,
-			168, 97, 208, 92, 124, 6, 129, 73, 67, 182, 55, 255, 74, 211,
-			62, 162, 27, 215, 87, 174, 254, 184, 126, 130, 78, 79, 113, 69,
-			186, 116, 62, 231, 52, 155, 207, 64, 227, 53, 248, 238, 233, 79,
-			143, 178, 180, 101, 158, 24, 154, 27, 101, 159, 219, 135, 118, 22,
-			39, 134, 172, 185, 159, 219, 167, 50, 193, 202, 52, 176, 252, 124,
-			156, 187, 150, 36, 21, 184, 97, 106, 219, 182, 183, 229, 8, 7,
-			127, 214, 99, 156, 113, 225, 141, 242, 228, 86, 188, 90, 145, 227,
-			91, 56, 254, 22, 170, 140, 184, 197, 216, 90, 189, 238, 220, 113,
-			154, 128, 232, 97, 210, 100, 93, 30, 237, 243, 194, 232, 126, 6,
-			117, 141, 202, 52, 65, 94, 152, 130, 80, 8, 79, 124, 168, 217,
-			116, 61, 32, 108, 48, 47, 249, 204, 236, 7, 50, 76, 40, 227,
-			45, 191, 174, 174, 220, 2, 241, 47, 78, 208, 114, 49, 68, 129,
-			82, 87, 34, 85, 141, 122, 194, 225, 214, 124, 79, 70, 195, 70,
-			43, 138, 150, 19, 9, 143, 56, 126, 182, 111, 98, 97, 127, 188,
-			227, 22, 61, 199, 163, 61, 72, 164, 226, 180, 213, 36, 196, 48,
-			97, 25, 38, 9, 71, 178, 211, 116, 233, 81, 62, 57, 162, 120,
-			250, 143, 167, 83, 119, 195, 90, 211, 118, 91, 14, 197, 219, 25,
-			52, 9, 215, 75, 194, 66, 78, 66, 100, 154, 140, 231, 193, 226,
-			137, 124, 93, 243, 144, 201, 39, 251, 34, 43, 219, 94, 125, 70,
-			186, 120, 3, 39, 232, 4, 174, 221, 140, 131, 44, 41, 11, 154,
-			132, 93, 138, 12, 9, 12, 139, 90, 18, 198, 35, 81, 34, 91,
-			101, 18, 183, 60, 63, 254, 45, 20, 81, 52, 48, 173, 25, 117,
-			5, 178, 164, 8, 163, 42, 117, 166, 142, 87, 247, 131, 208, 17,
-			89, 55, 91, 126, 228, 136, 236, 155, 81, 40, 184, 237, 186, 138,
-			173, 232, 134, 202, 110, 70, 5, 154, 85, 150, 41, 237, 192, 5,
-			196, 10, 0, 119, 188, 132, 81, 10, 82, 203, 27, 149, 85, 190,
-			186, 124, 109, 237, 185, 82, 181, 204, 43, 171, 124, 165, 186, 252,
-			108, 101, 161, 188, 192, 175, 62, 207, 215, 110, 148, 249, 252, 242,
-			202, 243, 213, 202, 245, 27, 107, 252, 198, 242, 226, 66, 185, 186,
-			202, 75, 75, 11, 124, 126, 121, 105, 173, 90, 185, 186, 190, 182,
-			92, 93, 101, 202, 234, 6, 126, 41, 45, 61, 207, 203, 111, 91,
-			169, 150, 87, 209, 212, 166, 114, 115, 101, 177, 82, 94, 72, 24,
-			224, 20, 120, 101, 105, 126, 113, 125, 161, 178, 116, 189, 192, 175,
-			174, 175, 241, 165, 229, 53, 198, 23, 43, 55, 43, 107, 229, 5,
-			190, 182, 92, 192, 97, 119, 127, 199, 151, 175, 241, 155, 229, 234,
-			252, 141, 210, 210, 90, 233, 106, 101, 177, 178, 246, 60, 14, 120,
-			173, 178, 182, 4, 131, 93, 91, 174, 50, 94, 226, 43, 165, 234,
-			90, 101, 126, 125, 177, 84, 229, 43, 235, 213, 149, 229, 213, 50,
-			135, 149, 45, 84, 86, 231, 23, 75, 149, 155, 229, 133, 34, 175,
-			44, 241, 165, 101, 94, 126, 182, 188, 180, 198, 87, 111, 148, 22,
-			23, 123, 23, 202, 248, 242, 115, 75, 229, 170, 48, 20, 82, 203,
-			228, 87, 203, 124, 177, 82, 186, 186, 88, 134, 161, 112, 157, 11,
-			149, 106, 121, 126, 13, 22, 20, 255, 53, 95, 89, 40, 47, 173,
-			149, 22, 11, 140, 163, 181, 94, 105, 177, 192, 203, 111, 43, 223,
-			92, 89, 44, 85, 159, 47, 136, 78, 87, 203, 111, 93, 47, 47,
-			173, 85, 74, 139, 124, 161, 116, 179, 116, 189, 188, 202, 167, 238,
-			7, 149, 149, 234, 242, 252, 122, 181, 124, 19, 102, 189, 124, 141,
-			175, 174, 95, 93, 93, 171, 172, 173, 175, 149, 249, 245, 229, 229,
-			5, 4, 246, 106, 185, 250, 108, 101, 190, 188, 122, 153, 47, 46,
-			175, 34, 192, 214, 87, 203, 5, 198, 23, 74, 107, 37, 28, 122,
-			165, 186, 124, 173, 178, 182, 122, 25, 254, 190, 186, 190, 90, 65,
-			192, 85, 150, 214, 202, 213, 234, 250, 202, 90, 101, 121, 105, 154,
-			223, 88, 126, 174, 252, 108, 185, 202, 231, 75, 235, 171, 229, 5,
-			132, 240, 242, 18, 172, 22, 112, 165, 188, 92, 125, 30, 186, 5,
-			56, 224, 14, 20, 248, 115, 55, 202, 107, 55, 202, 85, 0, 42,
-			66, 171, 4, 96, 88, 93, 171, 86, 230, 215, 146, 205, 150, 171,
-			124, 109, 185, 186, 198, 18, 235, 228, 75, 229, 235, 139, 149, 235,
-			229, 165, 249, 50, 252, 188, 12, 221, 60, 87, 89, 45, 79, 243,
-			82, 181, 178, 10, 13, 42, 56, 48, 127, 174, 244, 60, 95, 94,
-			199, 85, 195, 70, 173, 175, 150, 25, 253, 157, 64, 221, 2, 238,
-			39, 175, 92, 227, 165, 133, 103, 43, 48, 115, 209, 122, 101, 121,
-			117, 181, 34, 208, 5, 193, 54, 127, 67, 192, 92, 217, 91, 241,
-			161, 73, 97, 111, 149, 31, 42, 75, 123, 43, 248, 147, 42, 79,
-			13, 21, 176, 82, 163, 63, 169, 242, 145, 161, 115, 88, 41, 254,
-			164, 202, 211, 67, 121, 172, 100, 244, 39, 85, 62, 58, 244, 48,
-			86, 62, 66, 127, 82, 229, 153, 161, 203, 88, 121, 154, 254, 164,
-			202, 169, 161, 147, 88, 121, 146, 254, 252, 25, 114, 44, 51, 230,
-			134, 70, 115, 255, 82, 231, 37, 178, 66, 112, 107, 34, 172, 156,
-			180, 30, 199, 43, 160, 235, 119, 208, 8, 33, 112, 206, 11, 121,
-			220, 190, 227, 187, 50, 118, 57, 144, 191, 14, 90, 223, 97, 208,
-			161, 158, 239, 145, 252, 118, 253, 78, 192, 75, 43, 149, 176, 200,
-			75, 50, 164, 98, 79, 8, 104, 37, 236, 216, 97, 143, 93, 191,
-			12, 193, 161, 44, 219, 209, 245, 153, 100, 145, 210, 74, 69, 152,
-			20, 20, 69, 230, 29, 178, 43, 147, 183, 145, 20, 246, 18, 241,
-			231, 57, 15, 218, 53, 126, 213, 14, 166, 250, 163, 60, 32, 171,
-			49, 45, 196, 153, 144, 239, 241, 59, 121, 89, 243, 87, 89, 252,
-			64, 50, 151, 221, 175, 56, 160, 191, 203, 179, 135, 250, 57, 32,
-			18, 125, 247, 98, 129, 62, 160, 177, 244, 42, 182, 176, 46, 179,
-			52, 138, 128, 225, 164, 198, 141, 169, 145, 185, 83, 187, 34, 81,
-			80, 67, 10, 56, 17, 162, 247, 95, 85, 124, 146, 123, 43, 27,
-			73, 84, 91, 163, 204, 184, 237, 116, 133, 105, 51, 252, 105, 21,
-			88, 10, 157, 76, 132, 237, 245, 196, 174, 206, 159, 133, 95, 171,
-			212, 232, 146, 254, 70, 45, 255, 113, 157, 165, 176, 210, 186, 204,
-			152, 215, 105, 54, 55, 168, 3, 178, 170, 207, 237, 234, 96, 169,
-			211, 108, 98, 251, 27, 67, 213, 97, 79, 22, 172, 83, 108, 31,
-			69, 215, 220, 136, 199, 215, 110, 12, 85, 71, 168, 86, 53, 34,
-			49, 78, 52, 66, 55, 109, 104, 68, 181, 212, 232, 36, 99, 155,
-			190, 47, 167, 97, 114, 109, 42, 11, 67, 65, 29, 53, 120, 19,
-			246, 210, 169, 69, 162, 73, 10, 151, 122, 100, 15, 56, 138, 238,
-			59, 181, 72, 173, 18, 46, 122, 241, 109, 26, 191, 221, 189, 202,
-			69, 55, 140, 212, 42, 155, 178, 112, 53, 205, 204, 219, 174, 87,
-			207, 95, 102, 195, 170, 133, 85, 100, 105, 236, 76, 238, 232, 94,
-			64, 23, 173, 206, 30, 99, 195, 10, 136, 214, 1, 198, 150, 214,
-			23, 23, 55, 158, 45, 45, 174, 151, 71, 135, 174, 126, 235, 96,
-			30, 121, 132, 22, 35, 153, 228, 153, 215, 200, 36, 211, 194, 95,
-			23, 151, 252, 193, 227, 196, 37, 191, 172, 61, 224, 146, 31, 112,
-			201, 15, 184, 228, 7, 92, 242, 3, 46, 249, 1, 151, 252, 205,
-			225, 146, 21, 159, 10, 127, 74, 46, 249, 154, 100, 157, 225, 79,
-			201, 37, 43, 214, 249, 17, 197, 58, 159, 22, 149, 226, 79, 201,
-			37, 43, 214, 249, 81, 197, 58, 159, 137, 89, 231, 51, 138, 117,
-			158, 138, 89, 103, 248, 243, 39, 13, 178, 170, 153, 27, 122, 92,
-			203, 253, 136, 193, 111, 209, 133, 123, 171, 55, 126, 182, 122, 185,
-			23, 154, 83, 188, 209, 11, 104, 150, 228, 134, 34, 72, 33, 105,
-			248, 67, 38, 76, 173, 91, 118, 27, 223, 181, 186, 158, 221, 18,
-			33, 198, 225, 134, 174, 211, 183, 33, 26, 53, 163, 161, 130, 74,
-			234, 94, 80, 131, 51, 222, 66, 2, 188, 233, 36, 130, 130, 109,
-			118, 185, 205, 61, 27, 3, 98, 171, 201, 9, 251, 135, 158, 164,
-			6, 174, 199, 56, 133, 74, 195, 244, 70, 178, 123, 204, 220, 196,
-			159, 94, 85, 203, 33, 213, 176, 232, 72, 218, 165, 179, 158, 88,
-			131, 117, 184, 165, 154, 66, 89, 107, 71, 125, 227, 138, 199, 14,
-			10, 139, 10, 212, 116, 203, 161, 200, 220, 202, 91, 136, 194, 244,
-			137, 53, 40, 123, 79, 57, 37, 249, 146, 249, 244, 234, 242, 82,
-			127, 215, 24, 230, 66, 238, 133, 27, 82, 27, 165, 165, 141, 185,
-			243, 3, 194, 225, 119, 200, 50, 46, 234, 60, 87, 224, 235, 158,
-			204, 120, 8, 59, 224, 55, 246, 222, 129, 164, 225, 210, 69, 125,
-			60, 97, 184, 116, 241, 240, 177, 132, 225, 210, 197, 19, 39, 217,
-			103, 116, 50, 38, 186, 50, 116, 83, 203, 253, 107, 157, 223, 66,
-			46, 174, 15, 75, 246, 24, 73, 32, 132, 112, 165, 160, 27, 140,
-			113, 96, 161, 11, 176, 163, 200, 39, 23, 104, 87, 208, 230, 201,
-			150, 126, 250, 5, 76, 167, 84, 235, 4, 33, 236, 185, 216, 52,
-			129, 122, 126, 192, 109, 166, 46, 101, 137, 83, 37, 113, 121, 209,
-			131, 14, 141, 222, 99, 226, 226, 83, 40, 66, 79, 218, 79, 134,
-			14, 227, 119, 236, 192, 181, 189, 8, 62, 223, 12, 81, 61, 142,
-			2, 88, 87, 254, 192, 93, 175, 142, 178, 31, 190, 170, 161, 46,
-			249, 190, 59, 39, 192, 35, 55, 14, 103, 34, 246, 77, 211, 44,
-			227, 74, 118, 63, 203, 51, 211, 212, 178, 67, 150, 249, 22, 125,
-			209, 200, 141, 99, 127, 192, 100, 171, 153, 75, 27, 164, 44, 108,
-			201, 91, 178, 251, 208, 81, 31, 13, 183, 74, 230, 241, 92, 46,
-			25, 78, 210, 70, 112, 246, 126, 134, 251, 90, 50, 247, 39, 140,
-			179, 74, 7, 38, 19, 198, 89, 165, 99, 15, 177, 105, 105, 155,
-			53, 111, 62, 148, 123, 168, 183, 75, 17, 13, 187, 183, 83, 45,
-			5, 109, 89, 194, 30, 106, 126, 228, 72, 194, 30, 106, 62, 119,
-			76, 116, 170, 91, 70, 121, 119, 167, 226, 57, 171, 183, 83, 61,
-			5, 109, 85, 73, 179, 140, 178, 234, 84, 55, 44, 163, 156, 59,
-			134, 177, 53, 52, 40, 92, 55, 39, 115, 199, 123, 59, 149, 113,
-			29, 122, 123, 53, 82, 208, 88, 154, 110, 25, 154, 101, 92, 23,
-			54, 114, 26, 26, 121, 94, 159, 56, 194, 206, 99, 175, 166, 101,
-			84, 204, 135, 114, 124, 215, 84, 37, 209, 235, 237, 216, 76, 67,
-			123, 85, 210, 44, 163, 162, 166, 107, 26, 150, 81, 201, 29, 19,
-			29, 167, 44, 227, 25, 243, 120, 127, 199, 42, 135, 136, 64, 19,
-			213, 113, 42, 13, 237, 229, 142, 165, 52, 203, 120, 70, 237, 88,
-			202, 176, 140, 103, 142, 61, 196, 126, 76, 35, 179, 212, 213, 161,
-			103, 181, 220, 247, 107, 252, 150, 146, 169, 110, 137, 52, 71, 24,
-			224, 63, 242, 61, 238, 120, 157, 86, 194, 212, 93, 225, 41, 177,
-			136, 10, 97, 36, 93, 98, 10, 109, 81, 11, 209, 241, 36, 247,
-			118, 47, 68, 239, 29, 29, 155, 221, 130, 174, 111, 21, 99, 187,
-			209, 213, 212, 1, 150, 147, 118, 163, 235, 250, 161, 220, 126, 190,
-			212, 135, 174, 194, 140, 116, 93, 223, 151, 48, 35, 93, 63, 56,
-			202, 222, 165, 161, 113, 157, 249, 226, 208, 203, 90, 238, 14, 191,
-			165, 196, 79, 177, 218, 157, 192, 110, 183, 157, 128, 219, 129, 223,
-			193, 12, 75, 125, 249, 215, 98, 226, 112, 191, 149, 244, 246, 140,
-			205, 236, 32, 64, 3, 26, 105, 211, 247, 98, 246, 16, 187, 36,
-			76, 250, 140, 151, 244, 135, 114, 231, 97, 99, 251, 134, 187, 15,
-			189, 213, 49, 210, 223, 75, 186, 42, 165, 45, 227, 165, 145, 81,
-			89, 210, 44, 227, 165, 67, 71, 100, 201, 176, 140, 151, 114, 199,
-			148, 2, 230, 23, 102, 217, 201, 126, 5, 140, 138, 3, 183, 151,
-			14, 230, 50, 27, 94, 75, 198, 138, 19, 81, 12, 80, 209, 97,
-			84, 101, 209, 26, 103, 41, 207, 246, 252, 16, 53, 24, 169, 42,
-			21, 174, 254, 61, 109, 176, 92, 126, 64, 117, 41, 69, 243, 185,
-			215, 40, 154, 171, 249, 190, 46, 233, 252, 231, 102, 88, 198, 74,
-			157, 24, 250, 78, 237, 129, 120, 254, 64, 60, 127, 32, 158, 63,
-			16, 207, 31, 136, 231, 15, 196, 243, 255, 146, 226, 121, 37, 22,
-			207, 43, 247, 22, 207, 103, 98, 241, 124, 230, 235, 21, 207, 127,
-			243, 56, 138, 231, 233, 111, 211, 224, 234, 203, 125, 246, 56, 47,
-			113, 117, 245, 246, 74, 95, 109, 223, 21, 161, 226, 221, 22, 37,
-			64, 110, 59, 94, 221, 33, 131, 127, 116, 49, 131, 250, 87, 80,
-			246, 161, 188, 151, 77, 198, 107, 118, 211, 241, 234, 118, 80, 160,
-			56, 105, 210, 210, 169, 38, 29, 5, 100, 80, 62, 76, 51, 25,
-			216, 181, 248, 198, 144, 63, 192, 133, 0, 172, 2, 150, 225, 198,
-			244, 155, 68, 20, 201, 72, 23, 59, 114, 133, 143, 33, 136, 113,
-			34, 31, 70, 219, 175, 109, 115, 59, 226, 235, 107, 243, 188, 229,
-			214, 61, 164, 232, 190, 199, 248, 211, 182, 215, 129, 107, 96, 182,
-			192, 103, 159, 124, 195, 133, 130, 36, 212, 237, 192, 111, 58, 237,
-			200, 173, 241, 235, 129, 179, 229, 131, 100, 166, 102, 47, 36, 76,
-			74, 37, 26, 18, 129, 30, 208, 106, 211, 174, 221, 222, 177, 3,
-			74, 58, 221, 117, 236, 64, 4, 96, 198, 43, 191, 229, 122, 157,
-			72, 56, 100, 61, 113, 65, 173, 175, 233, 123, 91, 69, 190, 232,
-			216, 237, 120, 201, 129, 195, 243, 97, 203, 177, 3, 167, 158, 231,
-			152, 87, 192, 134, 219, 136, 55, 29, 187, 205, 68, 51, 17, 145,
-			210, 13, 185, 231, 56, 232, 76, 233, 7, 113, 162, 77, 113, 161,
-			83, 220, 73, 155, 191, 56, 119, 241, 252, 182, 223, 161, 20, 69,
-			118, 192, 56, 246, 254, 210, 212, 189, 153, 14, 216, 207, 25, 108,
-			57, 45, 153, 219, 0, 185, 28, 55, 164, 212, 82, 23, 46, 92,
-			152, 61, 143, 255, 173, 93, 184, 112, 9, 255, 123, 1, 150, 254,
-			228, 147, 79, 62, 121, 126, 118, 238, 252, 99, 179, 107, 115, 143,
-			93, 122, 252, 201, 75, 143, 63, 89, 124, 82, 254, 239, 133, 34,
-			191, 218, 101, 176, 145, 81, 224, 214, 34, 149, 111, 212, 142, 168,
-			247, 2, 223, 113, 184, 227, 133, 232, 105, 11, 181, 59, 14, 138,
-			246, 20, 88, 82, 228, 55, 22, 185, 173, 94, 172, 94, 155, 103,
-			252, 177, 199, 30, 123, 50, 94, 203, 206, 206, 78, 209, 117, 162,
-			6, 114, 136, 65, 163, 6, 255, 135, 22, 197, 232, 110, 52, 13,
-			28, 155, 52, 121, 68, 142, 253, 148, 74, 99, 21, 103, 180, 226,
-			179, 151, 248, 188, 223, 106, 119, 34, 39, 113, 22, 112, 192, 149,
-			229, 213, 202, 219, 248, 45, 128, 204, 212, 244, 173, 162, 96, 121,
-			226, 70, 138, 249, 20, 47, 157, 49, 243, 28, 58, 209, 134, 216,
-			224, 41, 252, 124, 105, 125, 113, 113, 122, 122, 96, 59, 196, 247,
-			169, 11, 211, 151, 19, 115, 154, 187, 223, 156, 182, 156, 8, 122,
-			241, 27, 117, 187, 155, 152, 155, 208, 106, 192, 79, 119, 236, 38,
-			143, 238, 136, 17, 123, 154, 63, 26, 221, 41, 112, 156, 208, 229,
-			175, 117, 73, 119, 138, 209, 29, 40, 221, 107, 69, 212, 168, 19,
-			58, 53, 126, 150, 207, 94, 184, 208, 187, 194, 199, 246, 92, 225,
-			115, 174, 247, 216, 28, 191, 117, 221, 137, 86, 49, 137, 10, 252,
-			92, 10, 175, 185, 77, 12, 71, 156, 88, 236, 181, 202, 98, 121,
-			173, 114, 179, 204, 27, 145, 152, 198, 94, 223, 60, 218, 136, 228,
-			76, 215, 43, 75, 107, 24, 57, 188, 118, 59, 228, 87, 248, 212,
-			212, 20, 213, 76, 55, 162, 98, 125, 231, 134, 187, 181, 189, 96,
-			71, 248, 213, 52, 127, 211, 155, 248, 99, 115, 211, 252, 191, 227,
-			248, 219, 162, 191, 35, 127, 146, 112, 155, 153, 225, 37, 152, 111,
-			29, 3, 54, 184, 181, 219, 112, 88, 102, 47, 92, 72, 208, 176,
-			176, 168, 26, 16, 149, 154, 125, 98, 247, 49, 82, 189, 193, 231,
-			179, 79, 92, 188, 120, 241, 13, 143, 61, 113, 33, 38, 27, 194,
-			81, 125, 221, 115, 239, 202, 94, 158, 124, 195, 133, 254, 94, 138,
-			95, 219, 102, 78, 209, 250, 249, 212, 20, 1, 101, 6, 55, 11,
-			254, 55, 205, 207, 39, 167, 115, 31, 12, 134, 126, 0, 92, 178,
-			159, 211, 137, 126, 16, 1, 166, 123, 16, 224, 226, 158, 8, 240,
-			180, 125, 199, 230, 183, 104, 35, 139, 34, 201, 31, 52, 185, 233,
-			54, 155, 110, 152, 64, 0, 160, 166, 188, 133, 181, 252, 10, 223,
-			251, 131, 123, 160, 57, 191, 18, 215, 22, 61, 103, 231, 106, 199,
-			109, 214, 157, 96, 106, 26, 22, 182, 42, 32, 36, 134, 32, 192,
-			76, 51, 149, 104, 22, 218, 44, 209, 218, 93, 47, 130, 149, 139,
-			150, 180, 116, 177, 108, 132, 192, 116, 113, 19, 122, 158, 234, 1,
-			193, 227, 247, 1, 65, 5, 109, 52, 162, 162, 231, 239, 36, 86,
-			45, 106, 185, 231, 239, 240, 43, 188, 167, 205, 61, 23, 26, 207,
-			251, 254, 43, 246, 252, 157, 226, 150, 19, 149, 1, 215, 168, 110,
-			106, 58, 177, 240, 222, 197, 139, 198, 80, 152, 26, 188, 208, 39,
-			246, 92, 168, 204, 225, 40, 184, 12, 190, 210, 141, 182, 73, 140,
-			232, 65, 179, 228, 54, 77, 77, 247, 227, 224, 117, 39, 154, 143,
-			119, 125, 106, 26, 41, 61, 170, 92, 110, 218, 237, 182, 235, 109,
-			49, 198, 43, 30, 213, 144, 204, 78, 22, 204, 9, 48, 117, 219,
-			164, 218, 77, 178, 45, 66, 185, 72, 28, 3, 195, 235, 231, 117,
-			221, 62, 52, 20, 112, 46, 24, 147, 168, 64, 221, 80, 45, 12,
-			150, 127, 7, 112, 13, 175, 158, 127, 71, 203, 247, 162, 237, 87,
-			207, 191, 163, 110, 119, 95, 93, 123, 7, 92, 221, 175, 94, 122,
-			71, 203, 245, 94, 189, 244, 142, 208, 169, 189, 250, 98, 241, 29,
-			192, 44, 193, 129, 125, 245, 165, 23, 242, 76, 228, 100, 166, 175,
-			201, 213, 30, 243, 205, 138, 72, 89, 42, 2, 53, 166, 154, 173,
-			187, 91, 110, 132, 185, 167, 155, 14, 23, 35, 21, 56, 14, 85,
-			96, 156, 6, 43, 112, 28, 141, 98, 69, 225, 144, 113, 110, 215,
-			182, 93, 175, 11, 143, 229, 29, 95, 246, 230, 216, 181, 109, 226,
-			200, 36, 23, 7, 220, 159, 32, 40, 133, 132, 134, 126, 203, 231,
-			29, 124, 184, 121, 82, 126, 74, 174, 245, 84, 57, 59, 152, 215,
-			155, 46, 48, 28, 95, 38, 237, 163, 145, 242, 47, 228, 121, 216,
-			105, 52, 220, 187, 9, 13, 58, 6, 17, 114, 91, 14, 242, 161,
-			83, 249, 245, 181, 249, 252, 244, 229, 158, 90, 150, 52, 217, 23,
-			42, 253, 200, 127, 140, 144, 33, 68, 137, 220, 125, 197, 9, 164,
-			7, 129, 0, 101, 39, 116, 144, 151, 156, 194, 136, 11, 117, 17,
-			166, 98, 179, 203, 96, 26, 211, 100, 172, 222, 14, 92, 47, 146,
-			238, 134, 125, 168, 68, 233, 217, 147, 67, 161, 63, 106, 144, 140,
-			94, 132, 28, 29, 240, 55, 53, 140, 16, 135, 30, 83, 48, 38,
-			124, 43, 114, 117, 138, 53, 132, 187, 230, 129, 14, 57, 152, 181,
-			10, 153, 181, 158, 7, 171, 252, 220, 133, 217, 55, 192, 221, 48,
-			251, 248, 218, 133, 217, 75, 143, 93, 184, 52, 251, 120, 241, 194,
-			236, 11, 121, 129, 221, 33, 199, 178, 186, 92, 40, 209, 21, 182,
-			196, 241, 125, 47, 230, 154, 31, 47, 96, 208, 188, 162, 56, 64,
-			246, 29, 123, 21, 159, 194, 10, 148, 56, 53, 193, 168, 217, 124,
-			1, 227, 72, 36, 226, 59, 169, 60, 110, 2, 31, 17, 253, 49,
-			139, 187, 29, 212, 25, 127, 49, 242, 43, 171, 203, 171, 120, 200,
-			166, 166, 7, 176, 167, 197, 150, 255, 138, 219, 108, 218, 120, 186,
-			28, 239, 252, 250, 42, 5, 34, 127, 206, 217, 156, 137, 167, 50,
-			83, 149, 126, 8, 51, 215, 155, 254, 166, 221, 220, 160, 40, 136,
-			225, 12, 76, 104, 38, 49, 200, 52, 83, 22, 112, 21, 73, 105,
-			232, 165, 136, 166, 196, 111, 1, 191, 136, 206, 204, 242, 143, 91,
-			114, 65, 226, 185, 73, 172, 22, 211, 94, 15, 88, 34, 227, 47,
-			222, 10, 163, 160, 129, 159, 38, 86, 228, 215, 194, 98, 155, 40,
-			27, 172, 101, 110, 166, 233, 110, 6, 118, 208, 69, 166, 187, 184,
-			29, 181, 154, 167, 240, 47, 249, 237, 52, 106, 92, 152, 66, 100,
-			57, 72, 216, 118, 106, 252, 204, 233, 231, 207, 159, 110, 157, 63,
-			93, 95, 59, 125, 227, 210, 233, 155, 151, 78, 175, 22, 79, 55,
-			94, 56, 83, 228, 139, 238, 109, 103, 199, 13, 241, 205, 18, 247,
-			42, 222, 165, 78, 232, 80, 111, 79, 251, 117, 27, 145, 245, 76,
-			200, 95, 188, 85, 89, 93, 150, 44, 205, 53, 34, 86, 117, 81,
-			156, 154, 190, 245, 210, 20, 147, 97, 6, 191, 197, 175, 219, 231,
-			105, 134, 168, 218, 107, 96, 218, 78, 207, 137, 102, 236, 182, 139,
-			123, 2, 203, 130, 86, 36, 70, 208, 116, 103, 118, 119, 143, 75,
-			141, 199, 96, 124, 26, 224, 168, 98, 30, 202, 180, 127, 1, 175,
-			217, 109, 60, 31, 126, 35, 153, 220, 80, 145, 252, 80, 6, 186,
-			147, 68, 150, 81, 202, 197, 33, 205, 50, 191, 77, 203, 30, 98,
-			31, 210, 196, 59, 166, 249, 15, 52, 125, 60, 247, 29, 90, 242,
-			205, 68, 98, 190, 223, 64, 132, 167, 128, 189, 24, 42, 35, 230,
-			176, 216, 96, 22, 139, 223, 20, 81, 246, 239, 37, 18, 177, 65,
-			50, 209, 11, 20, 87, 32, 116, 239, 72, 159, 17, 74, 76, 248,
-			15, 52, 61, 35, 139, 26, 20, 179, 7, 101, 209, 128, 162, 53,
-			198, 126, 95, 19, 129, 30, 204, 239, 208, 116, 43, 247, 191, 106,
-			124, 201, 247, 206, 123, 206, 22, 201, 192, 61, 146, 180, 45, 37,
-			70, 16, 34, 7, 75, 210, 75, 226, 67, 37, 92, 210, 139, 2,
-			169, 35, 227, 206, 80, 105, 74, 17, 181, 208, 15, 223, 75, 142,
-			137, 93, 139, 15, 69, 216, 4, 18, 206, 27, 126, 0, 66, 177,
-			212, 28, 244, 3, 76, 8, 140, 5, 241, 127, 54, 0, 40, 90,
-			10, 215, 41, 129, 162, 225, 178, 179, 251, 101, 209, 128, 226, 232,
-			33, 245, 138, 241, 153, 34, 59, 218, 255, 138, 97, 123, 123, 186,
-			209, 60, 193, 140, 146, 215, 181, 142, 178, 44, 38, 48, 136, 67,
-			6, 103, 160, 188, 30, 52, 173, 241, 164, 241, 231, 62, 97, 228,
-			121, 245, 206, 224, 151, 139, 108, 201, 83, 62, 55, 133, 215, 248,
-			102, 97, 123, 221, 246, 230, 107, 125, 170, 248, 237, 2, 61, 85,
-			252, 211, 7, 79, 21, 15, 158, 42, 30, 60, 85, 60, 120, 170,
-			120, 240, 84, 241, 224, 169, 226, 155, 245, 84, 49, 31, 251, 219,
-			204, 223, 219, 223, 102, 58, 246, 183, 153, 254, 122, 253, 109, 254,
-			230, 16, 190, 74, 164, 190, 21, 46, 185, 220, 159, 28, 226, 183,
-			74, 94, 247, 86, 28, 249, 210, 246, 184, 29, 108, 186, 17, 122,
-			158, 43, 145, 45, 225, 198, 77, 119, 143, 242, 193, 177, 81, 47,
-			68, 241, 91, 89, 28, 124, 183, 222, 147, 113, 92, 186, 198, 136,
-			64, 7, 178, 207, 216, 171, 151, 203, 27, 153, 11, 102, 93, 18,
-			67, 149, 90, 148, 194, 12, 213, 110, 207, 116, 60, 248, 135, 151,
-			208, 200, 11, 89, 41, 65, 99, 129, 162, 99, 146, 238, 78, 228,
-			54, 221, 168, 203, 27, 29, 79, 178, 106, 1, 183, 235, 68, 176,
-			237, 102, 34, 164, 169, 12, 129, 44, 166, 6, 125, 194, 84, 139,
-			189, 10, 235, 21, 24, 15, 47, 86, 26, 218, 142, 211, 87, 120,
-			124, 254, 220, 57, 165, 34, 245, 125, 222, 240, 125, 126, 133, 23,
-			139, 69, 161, 187, 131, 46, 109, 175, 43, 74, 192, 42, 65, 111,
-			215, 2, 191, 53, 213, 240, 125, 169, 225, 43, 22, 5, 113, 119,
-			27, 124, 10, 26, 173, 227, 72, 107, 254, 212, 163, 208, 106, 58,
-			118, 18, 82, 45, 95, 237, 85, 96, 223, 115, 142, 32, 158, 188,
-			134, 73, 242, 43, 240, 87, 17, 190, 191, 231, 228, 220, 112, 234,
-			154, 239, 23, 107, 77, 59, 12, 147, 115, 163, 110, 161, 5, 77,
-			34, 209, 74, 121, 39, 73, 221, 171, 31, 12, 234, 119, 213, 110,
-			57, 192, 137, 149, 104, 132, 45, 39, 90, 112, 26, 118, 167, 25,
-			85, 132, 3, 213, 212, 244, 125, 7, 28, 248, 81, 236, 29, 197,
-			147, 74, 241, 123, 66, 173, 87, 59, 70, 99, 93, 243, 253, 169,
-			98, 177, 56, 173, 182, 147, 96, 54, 53, 221, 187, 191, 8, 190,
-			221, 208, 131, 159, 43, 180, 180, 133, 242, 234, 124, 181, 178, 178,
-			182, 92, 157, 190, 36, 151, 19, 111, 124, 226, 123, 234, 33, 49,
-			237, 139, 247, 153, 246, 117, 95, 134, 173, 135, 41, 95, 186, 194,
-			31, 109, 111, 22, 175, 249, 254, 59, 138, 197, 162, 128, 63, 140,
-			84, 224, 78, 16, 192, 207, 200, 32, 23, 151, 156, 157, 228, 160,
-			110, 3, 127, 126, 248, 10, 247, 220, 102, 12, 240, 120, 53, 114,
-			43, 19, 53, 125, 195, 189, 218, 219, 21, 141, 84, 92, 247, 90,
-			118, 16, 110, 219, 205, 53, 159, 112, 236, 254, 227, 208, 243, 24,
-			46, 84, 158, 87, 197, 41, 109, 118, 121, 187, 159, 114, 80, 48,
-			33, 76, 63, 7, 88, 64, 226, 248, 25, 60, 215, 196, 75, 219,
-			109, 55, 68, 126, 186, 209, 105, 54, 139, 248, 3, 176, 74, 103,
-			164, 135, 31, 82, 43, 160, 100, 146, 181, 35, 48, 179, 152, 92,
-			120, 205, 46, 151, 161, 105, 161, 147, 46, 127, 123, 199, 110, 82,
-			228, 46, 252, 154, 34, 3, 98, 68, 183, 72, 38, 53, 63, 51,
-			115, 70, 241, 131, 114, 136, 2, 79, 196, 107, 225, 249, 134, 239,
-			23, 55, 237, 0, 39, 119, 119, 166, 91, 124, 37, 79, 171, 233,
-			170, 204, 115, 140, 186, 206, 195, 111, 128, 22, 79, 175, 46, 47,
-			49, 126, 229, 202, 149, 43, 123, 155, 200, 145, 79, 34, 209, 121,
-			178, 26, 236, 136, 48, 197, 60, 112, 182, 58, 77, 59, 96, 3,
-			62, 65, 182, 213, 137, 169, 117, 129, 59, 173, 77, 7, 245, 147,
-			2, 219, 132, 36, 97, 123, 44, 73, 93, 201, 152, 238, 214, 83,
-			48, 219, 91, 82, 59, 169, 226, 42, 39, 214, 94, 148, 40, 45,
-			101, 6, 25, 52, 63, 22, 251, 26, 110, 211, 17, 71, 87, 98,
-			248, 138, 19, 132, 190, 23, 163, 138, 208, 27, 99, 144, 225, 13,
-			132, 205, 21, 62, 123, 185, 239, 87, 128, 191, 252, 113, 46, 73,
-			11, 56, 143, 123, 202, 227, 148, 243, 151, 120, 126, 16, 174, 244,
-			78, 170, 72, 211, 200, 23, 212, 199, 56, 129, 37, 187, 5, 29,
-			188, 137, 134, 125, 115, 252, 43, 76, 160, 239, 199, 120, 22, 34,
-			88, 119, 63, 120, 149, 158, 124, 199, 105, 54, 207, 83, 56, 14,
-			192, 200, 109, 122, 230, 239, 132, 145, 223, 18, 251, 223, 187, 123,
-			133, 129, 6, 239, 50, 198, 150, 26, 6, 246, 12, 159, 176, 113,
-			199, 24, 191, 117, 135, 44, 40, 105, 203, 182, 253, 38, 61, 199,
-			39, 71, 2, 244, 149, 91, 45, 3, 145, 136, 157, 102, 50, 74,
-			144, 164, 83, 24, 137, 72, 46, 229, 197, 126, 247, 194, 133, 14,
-			25, 183, 190, 244, 226, 75, 211, 151, 190, 214, 157, 232, 237, 42,
-			177, 25, 184, 18, 248, 126, 182, 56, 55, 59, 23, 230, 119, 251,
-			198, 126, 107, 118, 132, 125, 106, 68, 170, 173, 62, 164, 233, 19,
-			185, 127, 62, 194, 75, 128, 151, 51, 129, 35, 4, 85, 196, 24,
-			132, 101, 199, 115, 223, 222, 113, 154, 221, 222, 48, 62, 123, 49,
-			54, 108, 47, 110, 169, 72, 33, 90, 4, 86, 162, 244, 41, 142,
-			6, 183, 35, 222, 116, 80, 57, 236, 123, 14, 207, 207, 228, 121,
-			109, 219, 14, 236, 90, 228, 4, 164, 146, 71, 26, 18, 58, 91,
-			45, 97, 22, 18, 81, 40, 199, 51, 33, 198, 145, 141, 69, 89,
-			220, 114, 54, 144, 52, 73, 97, 81, 205, 156, 52, 221, 140, 223,
-			130, 46, 246, 4, 236, 173, 105, 154, 1, 126, 30, 7, 52, 167,
-			196, 41, 42, 125, 24, 177, 95, 152, 49, 172, 0, 75, 65, 228,
-			202, 23, 243, 50, 174, 25, 105, 222, 157, 250, 180, 208, 111, 183,
-			81, 247, 133, 146, 181, 99, 99, 24, 222, 14, 218, 221, 182, 3,
-			167, 230, 183, 218, 110, 19, 70, 144, 177, 91, 73, 102, 199, 236,
-			0, 152, 126, 83, 42, 35, 186, 76, 56, 8, 136, 72, 206, 66,
-			245, 161, 130, 200, 222, 69, 80, 1, 87, 195, 111, 248, 59, 206,
-			29, 39, 32, 138, 187, 94, 93, 12, 5, 162, 11, 58, 206, 40,
-			73, 169, 195, 111, 109, 71, 81, 251, 86, 129, 254, 13, 111, 161,
-			187, 130, 231, 139, 95, 99, 37, 175, 124, 47, 105, 118, 209, 45,
-			161, 211, 230, 182, 32, 208, 50, 36, 56, 229, 245, 107, 135, 138,
-			224, 161, 237, 138, 60, 17, 117, 149, 242, 27, 227, 61, 145, 246,
-			32, 132, 163, 112, 22, 8, 130, 26, 145, 162, 189, 209, 117, 167,
-			38, 133, 239, 80, 24, 129, 172, 94, 132, 15, 74, 30, 6, 37,
-			229, 215, 203, 107, 50, 76, 63, 220, 95, 136, 19, 116, 119, 216,
-			187, 143, 32, 48, 90, 47, 189, 248, 18, 156, 13, 225, 109, 209,
-			163, 28, 177, 163, 130, 208, 7, 160, 110, 36, 118, 159, 128, 241,
-			40, 161, 141, 82, 253, 36, 51, 128, 212, 48, 149, 79, 211, 247,
-			111, 119, 218, 50, 64, 24, 223, 180, 67, 149, 65, 0, 6, 196,
-			171, 207, 15, 72, 215, 137, 169, 79, 226, 109, 175, 211, 190, 219,
-			114, 50, 202, 163, 223, 246, 186, 12, 31, 162, 161, 107, 68, 201,
-			0, 159, 234, 11, 178, 165, 76, 198, 74, 236, 191, 231, 56, 100,
-			46, 180, 41, 226, 223, 5, 119, 224, 104, 114, 152, 6, 41, 234,
-			240, 87, 68, 168, 34, 159, 90, 15, 85, 74, 206, 228, 45, 78,
-			155, 102, 123, 24, 177, 153, 243, 205, 192, 177, 111, 163, 238, 139,
-			122, 40, 78, 99, 228, 170, 200, 185, 36, 52, 228, 66, 230, 176,
-			113, 10, 2, 245, 197, 187, 107, 179, 203, 237, 59, 182, 219, 36,
-			235, 34, 218, 38, 191, 209, 112, 107, 174, 221, 100, 49, 31, 19,
-			56, 64, 8, 68, 50, 25, 87, 197, 5, 68, 93, 13, 154, 251,
-			43, 116, 218, 116, 182, 92, 15, 99, 28, 136, 23, 140, 221, 212,
-			178, 200, 75, 40, 222, 220, 180, 187, 124, 238, 194, 220, 99, 5,
-			17, 206, 147, 2, 185, 243, 29, 183, 238, 52, 101, 186, 75, 232,
-			151, 144, 151, 97, 246, 31, 71, 105, 172, 72, 203, 229, 249, 188,
-			221, 180, 49, 59, 66, 252, 187, 180, 195, 90, 69, 108, 13, 229,
-			99, 218, 54, 112, 28, 189, 39, 137, 79, 9, 189, 20, 5, 97,
-			32, 244, 158, 86, 238, 98, 34, 195, 8, 178, 20, 189, 163, 199,
-			234, 165, 208, 105, 217, 94, 228, 214, 194, 34, 75, 106, 245, 63,
-			36, 35, 65, 145, 86, 255, 67, 218, 240, 161, 132, 86, 255, 67,
-			218, 248, 97, 246, 156, 84, 234, 127, 88, 211, 173, 92, 69, 41,
-			202, 209, 31, 206, 173, 223, 75, 210, 21, 4, 147, 20, 148, 42,
-			48, 175, 20, 20, 99, 53, 250, 135, 123, 213, 232, 31, 238, 85,
-			163, 127, 56, 169, 70, 255, 190, 81, 118, 68, 168, 209, 131, 118,
-			109, 70, 36, 91, 32, 37, 58, 19, 231, 52, 104, 215, 114, 123,
-			235, 218, 243, 13, 150, 22, 201, 210, 44, 102, 98, 110, 114, 13,
-			141, 255, 241, 111, 107, 146, 101, 100, 178, 54, 202, 17, 39, 139,
-			86, 145, 101, 132, 103, 220, 164, 129, 174, 255, 227, 187, 92, 255,
-			75, 94, 183, 42, 27, 93, 181, 217, 129, 132, 42, 62, 104, 215,
-			174, 142, 208, 184, 82, 17, 255, 134, 221, 138, 248, 45, 199, 195,
-			206, 102, 98, 92, 76, 172, 243, 50, 253, 67, 30, 4, 213, 149,
-			249, 167, 191, 237, 0, 165, 48, 191, 160, 177, 79, 197, 201, 196,
-			62, 214, 151, 194, 252, 162, 212, 113, 46, 46, 206, 255, 183, 152,
-			69, 236, 65, 18, 177, 158, 225, 191, 169, 73, 196, 198, 100, 150,
-			114, 107, 232, 148, 80, 131, 141, 199, 166, 193, 227, 202, 52, 248,
-			240, 208, 146, 84, 194, 193, 159, 84, 57, 17, 171, 214, 38, 148,
-			106, 237, 72, 236, 185, 123, 68, 105, 230, 38, 135, 206, 72, 117,
-			29, 252, 73, 149, 71, 99, 37, 28, 252, 249, 105, 225, 164, 155,
-			31, 186, 160, 229, 62, 102, 32, 35, 117, 139, 78, 144, 112, 214,
-			138, 211, 164, 55, 253, 45, 10, 63, 67, 49, 239, 252, 186, 211,
-			84, 57, 182, 194, 142, 75, 102, 169, 13, 63, 128, 173, 109, 224,
-			59, 124, 4, 212, 106, 43, 176, 91, 152, 89, 199, 241, 238, 184,
-			129, 239, 1, 225, 12, 11, 34, 178, 58, 102, 251, 42, 175, 174,
-			97, 140, 27, 36, 227, 213, 149, 121, 17, 240, 6, 131, 136, 51,
-			149, 0, 225, 197, 173, 234, 202, 124, 252, 144, 190, 229, 70, 219,
-			157, 77, 98, 185, 131, 118, 109, 186, 200, 203, 118, 109, 59, 158,
-			188, 228, 100, 164, 112, 7, 60, 103, 224, 56, 188, 237, 58, 53,
-			122, 119, 168, 219, 145, 125, 73, 172, 6, 200, 82, 161, 55, 154,
-			31, 221, 112, 84, 37, 200, 13, 220, 36, 207, 139, 128, 62, 13,
-			116, 168, 236, 68, 148, 11, 199, 222, 132, 63, 241, 144, 38, 193,
-			131, 2, 145, 191, 131, 121, 89, 252, 224, 182, 184, 62, 162, 216,
-			142, 168, 180, 82, 225, 11, 78, 232, 110, 121, 252, 122, 199, 173,
-			59, 241, 250, 118, 101, 96, 71, 58, 85, 199, 182, 51, 20, 227,
-			122, 58, 225, 175, 155, 207, 30, 96, 142, 244, 215, 61, 173, 31,
-			202, 189, 13, 247, 50, 145, 49, 172, 208, 159, 52, 11, 153, 38,
-			175, 211, 82, 73, 148, 153, 226, 194, 130, 118, 173, 56, 239, 215,
-			157, 151, 118, 85, 20, 147, 201, 216, 79, 171, 28, 181, 48, 133,
-			211, 217, 125, 9, 223, 222, 211, 7, 71, 217, 151, 229, 99, 181,
-			113, 78, 63, 156, 251, 162, 198, 75, 92, 61, 29, 158, 111, 216,
-			53, 196, 138, 94, 152, 247, 207, 209, 245, 120, 217, 219, 106, 186,
-			225, 118, 17, 24, 101, 68, 135, 193, 223, 38, 62, 66, 19, 116,
-			188, 45, 49, 197, 147, 227, 37, 32, 158, 88, 16, 161, 74, 81,
-			108, 238, 75, 247, 248, 73, 6, 159, 150, 230, 237, 36, 70, 201,
-			172, 28, 24, 119, 180, 152, 76, 160, 112, 174, 39, 129, 194, 57,
-			149, 197, 90, 51, 44, 227, 220, 216, 56, 235, 202, 148, 241, 51,
-			250, 185, 92, 147, 151, 212, 203, 157, 138, 253, 36, 66, 230, 7,
-			1, 141, 209, 139, 134, 156, 184, 75, 242, 83, 172, 249, 173, 22,
-			112, 33, 14, 5, 159, 78, 138, 231, 104, 18, 65, 71, 139, 164,
-			15, 53, 73, 221, 132, 177, 85, 41, 109, 25, 51, 35, 39, 18,
-			25, 232, 103, 78, 62, 154, 200, 64, 63, 51, 125, 86, 49, 7,
-			63, 245, 159, 116, 118, 225, 181, 37, 28, 14, 156, 182, 159, 204,
-			56, 156, 187, 87, 148, 203, 220, 61, 3, 64, 229, 238, 231, 157,
-			152, 219, 139, 99, 201, 189, 222, 236, 200, 249, 51, 108, 255, 10,
-			230, 234, 151, 201, 115, 39, 88, 154, 146, 247, 11, 243, 0, 81,
-			202, 191, 157, 29, 233, 105, 184, 236, 93, 117, 182, 237, 102, 99,
-			185, 97, 229, 88, 86, 230, 83, 23, 31, 169, 178, 117, 137, 29,
-			160, 14, 54, 68, 176, 46, 17, 90, 106, 140, 82, 159, 246, 116,
-			89, 221, 223, 78, 22, 243, 31, 210, 217, 1, 106, 112, 83, 164,
-			183, 223, 107, 118, 22, 103, 35, 13, 215, 219, 114, 2, 52, 78,
-			19, 140, 86, 178, 202, 186, 204, 70, 58, 237, 186, 29, 57, 27,
-			0, 76, 140, 29, 53, 40, 114, 147, 178, 103, 171, 50, 106, 14,
-			21, 214, 73, 245, 49, 156, 72, 140, 42, 53, 44, 27, 172, 135,
-			78, 96, 21, 152, 105, 215, 154, 225, 100, 10, 249, 184, 201, 228,
-			226, 228, 220, 139, 165, 249, 197, 42, 182, 202, 149, 153, 81, 154,
-			95, 180, 78, 48, 51, 240, 155, 50, 86, 22, 163, 143, 170, 126,
-			211, 169, 98, 189, 117, 130, 49, 152, 123, 205, 109, 219, 205, 112,
-			82, 231, 6, 12, 26, 215, 228, 55, 216, 145, 138, 183, 237, 4,
-			110, 228, 212, 251, 224, 180, 192, 198, 218, 78, 176, 33, 64, 223,
-			18, 213, 34, 194, 212, 248, 160, 233, 85, 15, 181, 157, 160, 183,
-			42, 255, 30, 141, 29, 134, 249, 132, 21, 79, 110, 20, 5, 88,
-			179, 222, 204, 82, 48, 69, 25, 179, 106, 42, 158, 251, 174, 182,
-			88, 171, 42, 233, 179, 92, 145, 237, 75, 86, 223, 15, 20, 249,
-			54, 59, 180, 232, 134, 209, 107, 66, 85, 235, 33, 54, 172, 226,
-			29, 32, 42, 100, 171, 113, 133, 117, 154, 29, 16, 137, 78, 54,
-			182, 221, 122, 221, 241, 16, 23, 178, 213, 253, 162, 246, 6, 86,
-			230, 23, 153, 149, 28, 81, 172, 59, 199, 178, 66, 53, 74, 75,
-			31, 174, 170, 50, 254, 134, 173, 29, 185, 89, 170, 156, 63, 203,
-			14, 172, 80, 59, 57, 249, 73, 150, 17, 95, 74, 59, 28, 81,
-			204, 255, 107, 141, 101, 229, 123, 201, 222, 205, 172, 115, 44, 43,
-			99, 217, 237, 149, 42, 91, 53, 176, 78, 177, 253, 129, 179, 229,
-			134, 145, 19, 56, 245, 141, 205, 46, 197, 78, 171, 238, 139, 43,
-			175, 118, 173, 183, 244, 52, 138, 66, 145, 140, 250, 94, 135, 36,
-			209, 193, 90, 152, 255, 168, 198, 38, 171, 162, 66, 174, 64, 129,
-			238, 130, 202, 135, 77, 155, 44, 14, 9, 181, 39, 237, 86, 95,
-			86, 236, 179, 187, 86, 40, 50, 144, 171, 190, 227, 5, 206, 177,
-			97, 149, 243, 91, 28, 238, 193, 217, 149, 171, 89, 153, 0, 60,
-			223, 100, 227, 176, 197, 178, 183, 240, 190, 91, 99, 29, 99, 195,
-			109, 123, 203, 217, 8, 221, 87, 156, 201, 113, 20, 242, 178, 80,
-			177, 234, 190, 226, 88, 199, 25, 195, 31, 49, 42, 248, 228, 97,
-			202, 7, 14, 53, 107, 80, 145, 111, 177, 195, 125, 163, 9, 192,
-			20, 216, 176, 74, 118, 43, 206, 83, 255, 58, 227, 6, 214, 163,
-			236, 160, 231, 220, 141, 54, 18, 67, 141, 227, 80, 251, 161, 122,
-			69, 13, 247, 237, 26, 155, 88, 117, 236, 160, 182, 253, 58, 214,
-			119, 156, 153, 145, 189, 69, 232, 59, 50, 55, 76, 179, 88, 179,
-			183, 170, 88, 253, 117, 45, 223, 103, 71, 118, 77, 231, 155, 10,
-			128, 79, 107, 204, 168, 58, 13, 144, 201, 49, 167, 62, 45, 21,
-			255, 78, 66, 64, 223, 251, 84, 25, 247, 59, 85, 39, 217, 8,
-			217, 107, 209, 153, 18, 215, 130, 172, 186, 218, 133, 75, 71, 53,
-			136, 66, 17, 106, 240, 158, 151, 142, 108, 190, 22, 230, 159, 98,
-			163, 11, 78, 211, 137, 28, 24, 84, 108, 221, 235, 90, 76, 254,
-			28, 59, 8, 40, 87, 117, 26, 247, 223, 251, 252, 44, 27, 141,
-			27, 139, 157, 57, 206, 204, 192, 105, 200, 77, 25, 150, 39, 182,
-			81, 197, 234, 252, 63, 214, 152, 177, 102, 111, 13, 8, 36, 217,
-			99, 75, 56, 44, 108, 9, 1, 94, 118, 20, 129, 192, 157, 160,
-			65, 76, 86, 17, 188, 84, 131, 215, 68, 127, 212, 199, 107, 97,
-			190, 203, 14, 149, 176, 180, 102, 111, 189, 6, 92, 127, 93, 244,
-			83, 30, 12, 99, 224, 193, 128, 161, 23, 156, 255, 111, 134, 254,
-			109, 141, 141, 202, 99, 162, 174, 255, 251, 108, 136, 52, 238, 180,
-			30, 102, 251, 68, 130, 183, 13, 96, 161, 197, 142, 140, 136, 186,
-			181, 110, 219, 233, 231, 172, 204, 221, 156, 85, 223, 174, 166, 238,
-			183, 171, 123, 5, 205, 28, 188, 171, 223, 173, 177, 195, 180, 173,
-			138, 83, 249, 198, 194, 119, 142, 101, 21, 127, 100, 136, 8, 156,
-			61, 196, 71, 141, 171, 218, 225, 164, 104, 195, 255, 43, 154, 212,
-			71, 53, 54, 6, 39, 248, 155, 52, 37, 139, 153, 183, 157, 46,
-			225, 225, 112, 21, 255, 254, 186, 46, 132, 128, 110, 223, 120, 182,
-			130, 230, 36, 151, 174, 189, 182, 165, 191, 230, 59, 225, 25, 118,
-			184, 234, 132, 126, 243, 142, 35, 20, 146, 247, 135, 209, 36, 203,
-			136, 167, 8, 73, 93, 69, 49, 255, 50, 59, 124, 221, 81, 247,
-			249, 122, 117, 241, 27, 11, 240, 252, 251, 117, 54, 188, 18, 248,
-			53, 39, 12, 253, 192, 58, 192, 116, 183, 46, 250, 211, 221, 186,
-			117, 142, 165, 48, 103, 39, 246, 115, 64, 50, 59, 170, 61, 10,
-			245, 78, 149, 218, 192, 9, 148, 153, 34, 224, 4, 190, 6, 225,
-			71, 54, 95, 11, 173, 25, 150, 166, 183, 35, 65, 143, 247, 10,
-			149, 91, 21, 205, 128, 214, 160, 8, 47, 72, 1, 21, 242, 215,
-			88, 10, 231, 100, 29, 102, 135, 86, 215, 74, 107, 229, 141, 245,
-			37, 180, 149, 188, 86, 41, 47, 140, 14, 89, 35, 44, 179, 82,
-			94, 90, 168, 44, 93, 31, 213, 172, 253, 108, 120, 117, 125, 126,
-			190, 92, 94, 40, 47, 140, 234, 22, 99, 105, 202, 119, 58, 106,
-			228, 255, 129, 206, 142, 44, 8, 147, 182, 152, 201, 252, 134, 162,
-			250, 41, 182, 95, 26, 205, 109, 224, 253, 71, 18, 194, 62, 89,
-			9, 119, 100, 79, 35, 36, 208, 102, 111, 35, 184, 10, 172, 25,
-			54, 166, 26, 181, 229, 222, 16, 35, 144, 173, 90, 242, 39, 181,
-			107, 161, 117, 142, 29, 82, 31, 168, 99, 144, 198, 230, 163, 242,
-			7, 37, 159, 253, 129, 198, 38, 119, 131, 66, 156, 163, 36, 247,
-			172, 221, 135, 123, 150, 247, 188, 62, 240, 158, 191, 207, 21, 100,
-			205, 128, 156, 170, 214, 102, 98, 163, 131, 125, 248, 88, 77, 52,
-			233, 57, 226, 169, 215, 72, 221, 94, 6, 138, 75, 75, 165, 52,
-			216, 223, 224, 211, 246, 63, 233, 108, 162, 127, 128, 175, 1, 146,
-			69, 198, 72, 69, 6, 136, 179, 215, 168, 195, 53, 49, 64, 195,
-			186, 200, 246, 139, 246, 244, 112, 58, 136, 233, 4, 210, 178, 143,
-			90, 93, 197, 70, 112, 229, 138, 175, 144, 242, 154, 24, 131, 74,
-			12, 140, 180, 247, 36, 27, 105, 58, 91, 118, 173, 187, 17, 110,
-			219, 179, 242, 78, 166, 170, 213, 109, 123, 214, 186, 194, 172, 120,
-			158, 27, 118, 211, 181, 67, 7, 174, 102, 99, 208, 124, 71, 213,
-			124, 75, 212, 48, 239, 177, 19, 18, 88, 87, 125, 63, 2, 1,
-			174, 125, 181, 227, 213, 155, 206, 253, 132, 243, 28, 203, 202, 72,
-			129, 82, 74, 150, 229, 36, 173, 53, 122, 105, 237, 167, 117, 118,
-			114, 207, 1, 197, 54, 85, 88, 170, 225, 198, 58, 137, 199, 104,
-			21, 247, 249, 170, 168, 234, 175, 185, 77, 167, 74, 61, 228, 126,
-			73, 99, 251, 123, 126, 184, 7, 150, 157, 85, 114, 45, 237, 182,
-			85, 220, 165, 90, 85, 18, 237, 235, 146, 46, 78, 49, 19, 102,
-			35, 168, 238, 174, 134, 248, 163, 18, 8, 82, 9, 129, 192, 98,
-			38, 162, 69, 26, 209, 2, 255, 62, 251, 22, 102, 86, 253, 166,
-			99, 141, 179, 209, 234, 242, 98, 63, 237, 101, 44, 93, 45, 151,
-			22, 202, 213, 81, 13, 254, 126, 174, 90, 89, 43, 87, 71, 117,
-			107, 152, 165, 208, 42, 126, 212, 56, 27, 50, 107, 183, 168, 110,
-			157, 98, 39, 171, 229, 235, 149, 213, 181, 106, 105, 173, 178, 188,
-			180, 1, 132, 125, 125, 181, 175, 247, 3, 140, 81, 163, 114, 181,
-			188, 48, 170, 89, 19, 204, 146, 249, 22, 19, 245, 186, 53, 202,
-			246, 45, 45, 175, 109, 80, 154, 111, 160, 245, 115, 95, 220, 207,
-			88, 213, 193, 108, 181, 126, 208, 181, 222, 204, 14, 93, 119, 162,
-			62, 85, 215, 32, 77, 98, 110, 160, 138, 203, 90, 97, 57, 188,
-			177, 7, 235, 204, 6, 118, 116, 92, 158, 244, 193, 223, 92, 101,
-			227, 235, 168, 5, 236, 171, 31, 56, 254, 30, 179, 90, 96, 163,
-			215, 157, 168, 71, 119, 54, 120, 46, 199, 238, 161, 101, 179, 222,
-			134, 107, 235, 249, 45, 161, 162, 61, 62, 160, 191, 248, 231, 123,
-			247, 252, 22, 198, 98, 77, 152, 117, 132, 154, 238, 210, 198, 229,
-			38, 119, 255, 32, 58, 184, 204, 70, 110, 184, 117, 71, 40, 192,
-			20, 108, 122, 244, 97, 185, 221, 97, 239, 49, 153, 130, 117, 133,
-			237, 95, 247, 182, 191, 158, 207, 73, 136, 254, 218, 62, 191, 202,
-			70, 251, 21, 90, 86, 31, 241, 207, 157, 72, 42, 178, 6, 40,
-			190, 110, 176, 253, 61, 138, 31, 43, 23, 67, 170, 95, 55, 35,
-			119, 98, 176, 166, 104, 137, 29, 236, 211, 161, 88, 15, 81, 251,
-			193, 154, 30, 137, 189, 123, 41, 94, 138, 108, 120, 30, 51, 179,
-			193, 77, 20, 223, 250, 123, 66, 227, 50, 27, 86, 26, 9, 107,
-			66, 210, 215, 94, 21, 197, 158, 31, 63, 201, 178, 82, 191, 96,
-			29, 142, 87, 153, 80, 78, 228, 38, 250, 171, 197, 60, 175, 48,
-			22, 75, 246, 18, 3, 119, 201, 250, 247, 192, 1, 22, 75, 231,
-			242, 243, 93, 242, 250, 158, 159, 151, 217, 129, 94, 1, 212, 58,
-			150, 156, 65, 159, 184, 117, 175, 110, 122, 69, 70, 217, 205, 64,
-			65, 242, 30, 221, 236, 75, 138, 77, 214, 209, 24, 102, 253, 93,
-			228, 6, 253, 164, 64, 122, 160, 87, 18, 146, 179, 25, 40, 31,
-			229, 250, 112, 222, 122, 51, 59, 208, 43, 251, 200, 207, 7, 74,
-			68, 185, 126, 118, 198, 122, 43, 27, 237, 103, 92, 37, 141, 218,
-			131, 183, 151, 199, 108, 79, 126, 247, 25, 128, 111, 146, 127, 139,
-			225, 59, 128, 109, 204, 61, 52, 248, 71, 209, 89, 35, 150, 49,
-			250, 24, 7, 235, 145, 251, 240, 21, 212, 253, 233, 215, 196, 125,
-			92, 61, 247, 194, 244, 107, 122, 177, 187, 108, 183, 221, 167, 63,
-			242, 41, 157, 101, 172, 212, 129, 161, 239, 204, 106, 236, 51, 177,
-			121, 206, 39, 123, 205, 115, 102, 223, 128, 175, 225, 139, 235, 243,
-			21, 94, 194, 124, 149, 248, 150, 255, 223, 156, 149, 206, 3, 51,
-			157, 255, 130, 102, 58, 251, 133, 201, 140, 53, 244, 148, 52, 195,
-			17, 127, 26, 67, 150, 49, 62, 116, 26, 255, 212, 44, 227, 240,
-			208, 163, 248, 167, 110, 25, 19, 228, 13, 103, 24, 150, 113, 132,
-			44, 109, 12, 211, 50, 38, 135, 158, 100, 127, 48, 198, 244, 244,
-			144, 149, 186, 49, 244, 11, 134, 150, 251, 194, 24, 191, 137, 86,
-			155, 33, 159, 175, 172, 44, 72, 231, 2, 229, 175, 235, 6, 220,
-			14, 67, 191, 230, 74, 71, 48, 122, 47, 101, 140, 151, 148, 35,
-			2, 190, 254, 3, 119, 91, 231, 53, 191, 217, 116, 106, 210, 55,
-			66, 4, 226, 67, 195, 20, 33, 45, 136, 120, 0, 91, 129, 223,
-			105, 83, 68, 253, 166, 19, 22, 147, 189, 9, 235, 9, 145, 99,
-			170, 25, 59, 224, 2, 140, 101, 236, 231, 216, 183, 22, 176, 218,
-			247, 208, 194, 50, 96, 104, 74, 131, 25, 139, 209, 148, 154, 215,
-			124, 111, 171, 233, 203, 8, 209, 197, 228, 156, 5, 137, 146, 166,
-			11, 94, 45, 112, 34, 168, 174, 217, 129, 71, 9, 218, 69, 228,
-			130, 78, 109, 91, 78, 254, 146, 176, 51, 181, 121, 224, 160, 11,
-			71, 179, 199, 252, 84, 25, 189, 35, 134, 187, 81, 200, 87, 111,
-			148, 230, 30, 127, 130, 79, 193, 212, 86, 111, 148, 102, 113, 13,
-			126, 19, 182, 90, 2, 122, 154, 215, 221, 45, 39, 140, 10, 61,
-			129, 250, 55, 187, 92, 73, 19, 34, 196, 190, 52, 28, 145, 118,
-			151, 114, 15, 84, 2, 83, 105, 155, 169, 92, 83, 118, 247, 128,
-			246, 194, 50, 132, 80, 72, 217, 82, 41, 107, 44, 166, 194, 205,
-			43, 168, 84, 22, 242, 137, 133, 73, 255, 103, 177, 192, 238, 46,
-			40, 134, 124, 170, 229, 135, 81, 179, 139, 102, 242, 128, 197, 232,
-			231, 223, 176, 107, 64, 128, 218, 190, 176, 156, 194, 204, 250, 128,
-			101, 53, 191, 213, 130, 238, 155, 174, 39, 205, 86, 10, 124, 179,
-			35, 146, 211, 66, 31, 189, 87, 29, 175, 174, 204, 147, 3, 216,
-			89, 140, 43, 67, 194, 50, 236, 66, 8, 40, 133, 160, 37, 48,
-			146, 157, 181, 12, 153, 132, 116, 174, 233, 239, 56, 1, 166, 128,
-			223, 118, 238, 210, 79, 64, 90, 136, 144, 145, 153, 143, 136, 176,
-			35, 205, 176, 93, 143, 95, 188, 128, 142, 5, 188, 180, 58, 95,
-			169, 200, 112, 125, 211, 69, 154, 194, 50, 18, 134, 65, 3, 82,
-			80, 153, 77, 59, 116, 158, 184, 56, 69, 13, 248, 57, 254, 226,
-			75, 155, 221, 200, 121, 71, 224, 52, 138, 55, 236, 112, 187, 212,
-			220, 242, 95, 157, 86, 19, 112, 2, 135, 159, 161, 79, 48, 105,
-			249, 122, 117, 241, 124, 104, 55, 28, 229, 186, 70, 110, 40, 94,
-			157, 159, 57, 135, 13, 0, 91, 237, 200, 241, 236, 132, 187, 59,
-			64, 69, 96, 155, 156, 23, 222, 1, 201, 37, 93, 28, 176, 36,
-			178, 239, 113, 60, 1, 171, 121, 242, 58, 16, 88, 128, 135, 19,
-			237, 206, 67, 167, 121, 71, 68, 142, 124, 197, 109, 115, 96, 89,
-			221, 59, 50, 60, 7, 230, 184, 72, 184, 54, 197, 84, 2, 46,
-			187, 174, 200, 172, 142, 246, 241, 180, 142, 154, 239, 161, 81, 62,
-			224, 4, 92, 162, 124, 126, 177, 162, 208, 64, 122, 147, 201, 164,
-			250, 132, 88, 76, 37, 237, 175, 217, 9, 51, 55, 160, 79, 50,
-			73, 120, 28, 205, 30, 61, 101, 5, 138, 146, 113, 122, 211, 247,
-			111, 83, 118, 140, 192, 247, 35, 188, 141, 240, 118, 12, 49, 62,
-			27, 186, 134, 132, 228, 148, 193, 243, 246, 204, 230, 76, 45, 79,
-			206, 28, 172, 63, 129, 118, 15, 209, 35, 172, 145, 46, 36, 216,
-			139, 176, 183, 63, 19, 114, 207, 199, 152, 8, 120, 20, 25, 207,
-			171, 232, 160, 56, 86, 30, 77, 184, 242, 197, 34, 253, 97, 123,
-			93, 97, 17, 30, 186, 45, 23, 14, 51, 128, 136, 82, 174, 39,
-			156, 74, 232, 54, 102, 228, 227, 130, 238, 44, 49, 245, 219, 182,
-			113, 72, 215, 131, 125, 13, 221, 26, 15, 221, 45, 15, 195, 85,
-			192, 78, 210, 93, 86, 224, 209, 182, 223, 217, 218, 22, 199, 218,
-			111, 68, 142, 199, 212, 233, 174, 59, 158, 31, 57, 176, 196, 166,
-			29, 97, 220, 135, 72, 56, 20, 138, 245, 2, 160, 61, 17, 232,
-			179, 39, 33, 9, 3, 202, 209, 8, 236, 153, 200, 247, 155, 33,
-			241, 69, 77, 215, 235, 220, 61, 111, 183, 234, 79, 92, 204, 239,
-			213, 9, 95, 132, 70, 60, 232, 144, 117, 62, 92, 218, 208, 158,
-			17, 126, 69, 14, 109, 166, 202, 215, 76, 25, 229, 41, 222, 145,
-			39, 130, 112, 244, 216, 254, 59, 94, 195, 15, 0, 201, 60, 32,
-			184, 120, 165, 134, 145, 239, 43, 218, 35, 80, 10, 253, 150, 237,
-			218, 54, 119, 164, 109, 126, 163, 103, 169, 136, 50, 109, 160, 93,
-			83, 194, 157, 44, 228, 121, 249, 35, 130, 93, 216, 93, 193, 214,
-			193, 164, 24, 207, 139, 154, 105, 52, 201, 68, 119, 13, 60, 21,
-			242, 40, 112, 249, 106, 134, 100, 52, 42, 242, 121, 229, 235, 128,
-			86, 152, 104, 221, 66, 30, 147, 12, 125, 203, 107, 53, 192, 81,
-			12, 70, 225, 55, 201, 42, 111, 170, 52, 191, 56, 77, 231, 85,
-			154, 215, 135, 124, 7, 93, 216, 252, 166, 56, 156, 91, 129, 237,
-			137, 44, 32, 240, 11, 89, 41, 134, 138, 61, 161, 84, 251, 52,
-			87, 138, 7, 134, 104, 43, 174, 124, 4, 152, 231, 216, 104, 26,
-			186, 43, 132, 23, 118, 77, 102, 178, 165, 133, 114, 21, 7, 21,
-			214, 124, 193, 83, 162, 85, 177, 230, 183, 128, 212, 148, 230, 23,
-			147, 108, 3, 185, 93, 136, 97, 197, 249, 194, 185, 132, 187, 191,
-			142, 185, 36, 74, 232, 96, 247, 76, 146, 37, 174, 28, 191, 161,
-			250, 194, 165, 120, 221, 120, 41, 155, 78, 211, 223, 1, 208, 38,
-			207, 244, 76, 29, 78, 53, 176, 110, 85, 31, 144, 22, 109, 78,
-			1, 52, 210, 232, 17, 225, 40, 2, 205, 202, 51, 220, 112, 61,
-			184, 173, 227, 89, 1, 28, 224, 190, 69, 199, 21, 47, 116, 235,
-			78, 239, 57, 137, 66, 167, 217, 40, 112, 28, 215, 37, 203, 97,
-			192, 205, 182, 31, 134, 174, 140, 174, 214, 108, 146, 45, 46, 46,
-			15, 17, 37, 113, 143, 247, 95, 174, 133, 56, 2, 141, 45, 99,
-			167, 36, 65, 211, 3, 145, 196, 84, 200, 40, 55, 61, 164, 89,
-			198, 141, 172, 197, 62, 97, 48, 51, 141, 86, 185, 85, 253, 169,
-			220, 143, 26, 188, 42, 50, 101, 198, 56, 218, 187, 99, 187, 48,
-			166, 136, 113, 116, 144, 135, 23, 46, 143, 118, 179, 9, 92, 171,
-			79, 24, 79, 65, 58, 16, 47, 26, 42, 235, 39, 138, 92, 232,
-			46, 130, 187, 47, 137, 93, 131, 193, 146, 201, 50, 155, 236, 176,
-			144, 16, 74, 127, 57, 68, 112, 187, 201, 183, 48, 122, 26, 207,
-			3, 73, 57, 47, 91, 158, 191, 227, 58, 59, 78, 16, 230, 137,
-			141, 44, 48, 162, 157, 59, 174, 112, 53, 134, 105, 241, 6, 102,
-			53, 194, 117, 172, 148, 171, 55, 43, 171, 171, 149, 229, 165, 141,
-			133, 242, 82, 165, 188, 16, 103, 184, 17, 62, 165, 98, 33, 64,
-			67, 105, 17, 137, 253, 134, 249, 224, 140, 119, 79, 120, 147, 238,
-			32, 182, 123, 153, 130, 26, 239, 9, 90, 87, 68, 123, 236, 155,
-			42, 227, 75, 203, 107, 27, 215, 150, 215, 151, 226, 57, 238, 99,
-			169, 52, 217, 53, 87, 211, 71, 100, 73, 183, 140, 234, 228, 105,
-			89, 50, 44, 163, 122, 225, 205, 236, 95, 153, 184, 195, 154, 101,
-			188, 172, 87, 115, 63, 102, 190, 254, 29, 78, 208, 132, 158, 165,
-			254, 255, 112, 231, 151, 224, 174, 35, 175, 129, 93, 56, 208, 75,
-			131, 66, 33, 235, 41, 40, 170, 100, 50, 253, 107, 196, 61, 21,
-			158, 245, 200, 201, 194, 167, 61, 159, 13, 128, 109, 129, 137, 56,
-			111, 226, 30, 163, 88, 218, 64, 37, 194, 208, 105, 33, 197, 0,
-			6, 195, 77, 114, 86, 10, 202, 2, 182, 83, 110, 196, 226, 91,
-			196, 149, 10, 113, 224, 186, 218, 78, 16, 193, 69, 129, 161, 206,
-			6, 12, 63, 173, 80, 76, 3, 196, 73, 115, 89, 210, 45, 227,
-			229, 135, 139, 178, 100, 88, 198, 203, 79, 174, 176, 79, 101, 16,
-			197, 116, 203, 136, 244, 133, 220, 191, 200, 112, 210, 176, 163, 116,
-			92, 67, 117, 229, 127, 77, 244, 228, 107, 197, 13, 100, 56, 40,
-			138, 1, 175, 109, 59, 181, 219, 33, 63, 147, 176, 170, 57, 19,
-			103, 194, 129, 142, 123, 159, 12, 84, 114, 181, 138, 96, 214, 118,
-			237, 89, 239, 129, 107, 2, 29, 239, 114, 231, 174, 27, 70, 97,
-			204, 253, 38, 134, 83, 146, 159, 88, 188, 226, 132, 91, 118, 84,
-			219, 22, 190, 231, 20, 177, 54, 241, 85, 33, 9, 175, 30, 18,
-			67, 175, 245, 27, 43, 213, 178, 210, 124, 236, 34, 136, 106, 210,
-			114, 44, 156, 31, 239, 130, 104, 13, 130, 109, 207, 244, 148, 147,
-			173, 240, 34, 69, 99, 122, 38, 36, 140, 34, 95, 245, 121, 219,
-			14, 67, 225, 207, 152, 252, 18, 67, 150, 34, 218, 80, 76, 91,
-			55, 114, 147, 72, 78, 144, 12, 119, 211, 105, 232, 206, 25, 208,
-			161, 162, 200, 9, 28, 236, 133, 47, 219, 3, 42, 92, 62, 122,
-			149, 223, 86, 89, 93, 91, 29, 68, 38, 162, 152, 39, 216, 177,
-			187, 196, 48, 55, 157, 40, 49, 150, 47, 78, 241, 142, 29, 138,
-			195, 80, 47, 242, 21, 59, 164, 40, 156, 52, 219, 129, 200, 130,
-			9, 180, 129, 63, 133, 73, 9, 135, 11, 12, 237, 89, 23, 94,
-			163, 203, 30, 15, 59, 196, 18, 202, 252, 214, 131, 59, 82, 231,
-			140, 236, 223, 235, 73, 232, 168, 131, 14, 55, 68, 148, 206, 201,
-			18, 28, 230, 99, 211, 178, 100, 88, 70, 116, 241, 42, 251, 14,
-			186, 75, 12, 203, 124, 151, 166, 95, 203, 125, 53, 102, 23, 108,
-			225, 225, 33, 184, 206, 62, 210, 41, 252, 117, 251, 143, 249, 186,
-			135, 194, 215, 174, 23, 66, 58, 149, 34, 14, 131, 160, 4, 242,
-			6, 72, 234, 203, 166, 36, 137, 100, 10, 0, 54, 111, 250, 56,
-			15, 114, 70, 65, 127, 104, 114, 200, 198, 112, 189, 188, 255, 197,
-			77, 133, 75, 133, 201, 82, 220, 92, 219, 235, 10, 0, 87, 34,
-			41, 46, 216, 50, 23, 132, 115, 183, 141, 127, 211, 50, 5, 77,
-			37, 126, 140, 120, 187, 30, 148, 116, 41, 179, 34, 146, 44, 121,
-			244, 64, 242, 78, 92, 6, 50, 132, 1, 189, 224, 146, 143, 25,
-			177, 210, 83, 20, 81, 133, 36, 101, 161, 100, 68, 175, 158, 77,
-			7, 67, 79, 120, 212, 239, 52, 17, 76, 90, 190, 240, 14, 78,
-			110, 197, 238, 139, 204, 243, 123, 160, 40, 2, 251, 134, 161, 212,
-			36, 17, 9, 138, 240, 94, 240, 3, 210, 117, 137, 218, 158, 131,
-			79, 14, 188, 128, 15, 26, 32, 68, 122, 66, 22, 117, 40, 30,
-			121, 68, 22, 17, 93, 102, 22, 216, 103, 116, 196, 30, 211, 50,
-			255, 145, 166, 191, 144, 251, 132, 190, 23, 246, 216, 177, 198, 84,
-			186, 165, 220, 11, 141, 144, 48, 239, 248, 193, 109, 194, 187, 16,
-			132, 98, 56, 140, 118, 184, 107, 187, 11, 66, 243, 45, 180, 70,
-			107, 49, 96, 130, 228, 149, 131, 93, 36, 238, 123, 234, 169, 207,
-			59, 132, 186, 88, 7, 154, 179, 199, 43, 109, 81, 206, 190, 192,
-			3, 59, 118, 237, 142, 183, 227, 76, 8, 157, 196, 173, 26, 228,
-			69, 5, 3, 123, 50, 94, 51, 97, 182, 83, 71, 241, 36, 84,
-			48, 55, 53, 0, 99, 154, 203, 162, 14, 197, 135, 223, 40, 139,
-			6, 20, 231, 223, 198, 126, 136, 174, 230, 148, 101, 254, 128, 166,
-			151, 114, 239, 207, 240, 69, 32, 121, 189, 26, 221, 176, 179, 41,
-			239, 71, 30, 187, 7, 36, 180, 207, 49, 172, 233, 107, 161, 4,
-			199, 80, 62, 117, 55, 0, 26, 83, 119, 194, 154, 227, 213, 109,
-			47, 194, 107, 95, 121, 111, 52, 41, 242, 68, 226, 103, 161, 66,
-			17, 39, 1, 35, 34, 98, 158, 211, 166, 115, 215, 173, 249, 91,
-			129, 221, 222, 38, 29, 239, 46, 97, 19, 192, 163, 38, 254, 98,
-			222, 206, 23, 80, 134, 163, 127, 64, 144, 19, 127, 56, 51, 141,
-			252, 75, 5, 20, 224, 68, 138, 214, 188, 157, 103, 50, 136, 139,
-			216, 251, 119, 200, 158, 46, 65, 87, 51, 155, 240, 133, 132, 2,
-			85, 213, 242, 47, 189, 10, 199, 71, 246, 227, 249, 222, 249, 196,
-			186, 138, 123, 244, 179, 199, 108, 250, 251, 150, 191, 210, 32, 34,
-			77, 128, 24, 169, 103, 148, 248, 116, 35, 188, 123, 118, 78, 109,
-			219, 29, 87, 201, 145, 49, 122, 9, 37, 9, 190, 170, 192, 205,
-			120, 199, 241, 24, 93, 175, 114, 32, 91, 30, 236, 221, 36, 66,
-			236, 43, 9, 148, 130, 62, 248, 189, 25, 103, 89, 207, 54, 203,
-			62, 17, 105, 125, 95, 242, 92, 4, 118, 194, 226, 94, 205, 66,
-			239, 152, 172, 111, 40, 62, 5, 162, 18, 197, 88, 198, 57, 139,
-			211, 191, 237, 58, 129, 29, 212, 182, 187, 49, 113, 108, 250, 24,
-			109, 124, 186, 128, 75, 68, 104, 238, 94, 143, 160, 170, 189, 50,
-			156, 156, 43, 186, 243, 18, 9, 177, 251, 72, 38, 35, 127, 218,
-			192, 129, 53, 110, 6, 254, 142, 224, 28, 3, 101, 229, 34, 131,
-			10, 139, 48, 77, 184, 75, 9, 137, 91, 80, 241, 26, 220, 4,
-			192, 250, 83, 52, 136, 104, 27, 93, 68, 155, 161, 163, 142, 116,
-			74, 131, 83, 154, 30, 149, 69, 29, 138, 135, 242, 178, 104, 64,
-			241, 252, 91, 216, 187, 136, 140, 166, 45, 243, 71, 53, 253, 106,
-			238, 47, 52, 158, 176, 213, 224, 45, 91, 210, 65, 169, 49, 176,
-			67, 78, 110, 83, 138, 86, 6, 78, 203, 191, 3, 87, 67, 68,
-			2, 0, 61, 48, 73, 43, 16, 169, 29, 38, 190, 73, 82, 252,
-			152, 109, 182, 27, 13, 167, 6, 146, 69, 20, 242, 14, 58, 115,
-			78, 197, 106, 13, 17, 134, 185, 225, 68, 181, 109, 123, 179, 233,
-			76, 247, 176, 243, 3, 120, 119, 165, 65, 35, 18, 163, 112, 125,
-			23, 35, 158, 144, 234, 20, 196, 210, 26, 0, 33, 125, 72, 22,
-			117, 40, 90, 146, 38, 166, 13, 40, 158, 123, 138, 125, 66, 67,
-			136, 101, 44, 243, 95, 106, 250, 66, 238, 71, 52, 222, 99, 160,
-			50, 24, 102, 242, 68, 217, 91, 182, 235, 17, 55, 112, 6, 64,
-			119, 199, 193, 96, 175, 141, 36, 212, 191, 169, 139, 204, 104, 48,
-			239, 244, 152, 44, 234, 80, 28, 151, 104, 145, 49, 160, 120, 254,
-			42, 251, 193, 20, 46, 50, 107, 153, 63, 7, 139, 124, 95, 138,
-			247, 152, 209, 168, 77, 79, 206, 103, 42, 25, 128, 178, 217, 196,
-			45, 85, 138, 163, 2, 143, 236, 173, 176, 192, 3, 167, 17, 50,
-			238, 68, 181, 233, 24, 91, 98, 228, 239, 83, 140, 117, 188, 186,
-			95, 228, 203, 94, 205, 233, 215, 19, 19, 95, 92, 47, 8, 60,
-			217, 242, 61, 132, 15, 192, 83, 33, 166, 47, 125, 190, 68, 156,
-			174, 182, 31, 193, 213, 104, 55, 81, 134, 8, 28, 251, 54, 230,
-			38, 246, 59, 33, 111, 187, 30, 92, 138, 219, 110, 24, 249, 1,
-			58, 254, 207, 87, 86, 22, 152, 204, 208, 68, 143, 20, 36, 55,
-			117, 66, 249, 124, 232, 249, 145, 122, 56, 172, 217, 20, 51, 169,
-			64, 7, 214, 245, 184, 115, 55, 10, 156, 22, 156, 251, 16, 95,
-			31, 231, 125, 84, 228, 145, 54, 23, 206, 144, 164, 110, 73, 93,
-			28, 208, 44, 100, 55, 155, 14, 201, 43, 74, 71, 74, 112, 162,
-			183, 40, 140, 127, 66, 28, 96, 188, 70, 197, 114, 216, 245, 150,
-			43, 181, 187, 151, 104, 58, 254, 142, 231, 4, 33, 74, 176, 125,
-			196, 6, 73, 43, 144, 36, 33, 104, 72, 42, 147, 184, 40, 240,
-			134, 219, 45, 201, 238, 226, 77, 233, 57, 20, 59, 196, 241, 240,
-			70, 171, 44, 61, 91, 90, 172, 44, 108, 148, 170, 215, 215, 49,
-			136, 173, 248, 76, 138, 71, 32, 4, 219, 77, 224, 169, 241, 205,
-			155, 39, 148, 83, 110, 175, 198, 124, 48, 179, 152, 213, 0, 67,
-			21, 58, 103, 117, 40, 42, 116, 206, 26, 80, 60, 127, 149, 253,
-			120, 26, 209, 121, 216, 50, 127, 21, 208, 249, 251, 211, 92, 154,
-			107, 133, 201, 247, 22, 245, 96, 235, 245, 195, 105, 202, 109, 72,
-			177, 139, 252, 7, 69, 110, 16, 74, 237, 224, 7, 20, 127, 149,
-			228, 64, 192, 147, 29, 27, 39, 155, 224, 127, 186, 78, 52, 141,
-			15, 252, 40, 130, 98, 142, 52, 238, 57, 59, 162, 59, 22, 239,
-			36, 128, 126, 30, 161, 42, 222, 253, 18, 105, 183, 157, 187, 78,
-			173, 19, 9, 117, 141, 138, 114, 45, 159, 107, 113, 167, 102, 139,
-			188, 20, 69, 192, 186, 147, 6, 151, 38, 48, 104, 149, 155, 93,
-			220, 61, 212, 183, 247, 25, 175, 225, 86, 204, 193, 209, 227, 73,
-			147, 76, 17, 98, 161, 32, 38, 221, 187, 63, 40, 10, 123, 117,
-			82, 35, 185, 175, 56, 50, 192, 20, 231, 178, 121, 140, 172, 244,
-			134, 185, 42, 0, 87, 93, 153, 199, 104, 71, 174, 136, 145, 174,
-			60, 57, 21, 137, 16, 253, 4, 210, 106, 23, 202, 143, 37, 40,
-			195, 174, 1, 208, 52, 197, 147, 225, 18, 81, 2, 236, 95, 35,
-			209, 98, 25, 169, 206, 141, 228, 177, 70, 41, 88, 234, 147, 68,
-			76, 137, 216, 78, 149, 123, 254, 142, 208, 22, 160, 40, 211, 243,
-			200, 47, 16, 32, 177, 235, 155, 93, 212, 189, 3, 121, 130, 219,
-			185, 32, 69, 75, 198, 119, 155, 192, 138, 193, 226, 199, 168, 255,
-			151, 189, 119, 141, 142, 236, 168, 14, 133, 117, 170, 234, 156, 110,
-			149, 222, 165, 150, 212, 58, 146, 70, 71, 61, 182, 37, 205, 232,
-			49, 163, 177, 193, 30, 143, 135, 209, 204, 104, 198, 178, 199, 51,
-			195, 72, 14, 121, 144, 37, 31, 169, 143, 164, 206, 180, 250, 40,
-			221, 173, 25, 203, 89, 249, 22, 182, 193, 248, 17, 243, 90, 124,
-			124, 14, 223, 194, 124, 4, 12, 49, 139, 124, 126, 224, 133, 175,
-			99, 140, 185, 38, 1, 3, 78, 96, 57, 92, 3, 23, 184, 94,
-			102, 37, 43, 193, 230, 145, 96, 108, 30, 206, 37, 119, 237, 93,
-			143, 115, 186, 213, 26, 143, 29, 15, 33, 43, 232, 151, 246, 233,
-			115, 106, 87, 237, 170, 218, 123, 215, 174, 253, 192, 29, 21, 171,
-			149, 174, 87, 5, 38, 19, 67, 243, 149, 60, 225, 168, 211, 94,
-			88, 84, 210, 226, 101, 4, 5, 175, 177, 155, 55, 10, 138, 122,
-			11, 54, 139, 57, 134, 213, 19, 0, 187, 250, 53, 72, 1, 220,
-			182, 159, 255, 47, 41, 13, 185, 96, 223, 182, 200, 21, 238, 87,
-			44, 165, 212, 87, 220, 24, 152, 197, 55, 130, 87, 173, 160, 232,
-			73, 83, 82, 17, 183, 242, 166, 3, 211, 103, 215, 176, 40, 121,
-			222, 240, 191, 119, 104, 218, 200, 83, 147, 169, 152, 201, 137, 152,
-			144, 161, 6, 183, 96, 128, 134, 207, 112, 2, 96, 106, 72, 131,
-			20, 192, 93, 151, 243, 199, 164, 54, 213, 32, 216, 63, 90, 228,
-			184, 251, 201, 232, 80, 186, 209, 157, 1, 245, 60, 28, 44, 172,
-			78, 121, 12, 5, 25, 105, 238, 241, 160, 191, 111, 92, 11, 138,
-			57, 117, 107, 87, 73, 211, 205, 174, 116, 100, 161, 161, 114, 124,
-			9, 113, 109, 233, 254, 195, 181, 160, 184, 62, 252, 159, 131, 226,
-			13, 22, 16, 209, 233, 208, 32, 1, 176, 115, 68, 131, 20, 192,
-			215, 31, 229, 159, 147, 71, 210, 70, 193, 254, 183, 69, 46, 114,
-			31, 72, 120, 7, 148, 141, 88, 178, 215, 98, 176, 40, 253, 131,
-			208, 183, 160, 32, 241, 202, 155, 96, 89, 180, 18, 223, 64, 135,
-			155, 149, 53, 153, 214, 71, 186, 25, 97, 33, 80, 105, 41, 70,
-			82, 155, 15, 55, 240, 209, 50, 222, 130, 42, 211, 15, 106, 3,
-			168, 110, 40, 135, 158, 216, 237, 254, 88, 85, 193, 162, 60, 244,
-			179, 140, 87, 188, 153, 18, 226, 206, 72, 185, 187, 40, 57, 191,
-			185, 36, 206, 154, 94, 108, 156, 240, 49, 111, 10, 175, 31, 117,
-			99, 56, 156, 2, 143, 206, 11, 153, 73, 121, 121, 153, 217, 159,
-			241, 180, 55, 19, 200, 122, 60, 109, 235, 220, 69, 104, 51, 128,
-			133, 6, 11, 6, 253, 8, 148, 192, 241, 23, 150, 149, 26, 137,
-			30, 15, 161, 170, 253, 228, 35, 30, 89, 133, 212, 16, 66, 102,
-			243, 206, 231, 215, 189, 21, 255, 164, 82, 14, 205, 143, 153, 108,
-			174, 180, 16, 158, 10, 138, 106, 156, 74, 171, 65, 171, 28, 168,
-			53, 74, 226, 46, 106, 66, 162, 13, 7, 15, 63, 97, 245, 166,
-			81, 118, 74, 204, 169, 201, 165, 165, 86, 93, 41, 133, 165, 242,
-			104, 49, 22, 166, 224, 169, 168, 163, 92, 97, 233, 85, 107, 52,
-			138, 187, 86, 94, 135, 188, 198, 202, 141, 106, 59, 182, 164, 36,
-			45, 112, 17, 226, 249, 181, 74, 253, 241, 188, 154, 54, 118, 213,
-			108, 92, 40, 201, 35, 148, 180, 242, 41, 106, 168, 222, 76, 238,
-			63, 118, 98, 54, 26, 183, 249, 8, 180, 101, 92, 108, 139, 210,
-			230, 25, 69, 110, 213, 186, 99, 52, 249, 125, 171, 165, 97, 128,
-			39, 59, 121, 154, 159, 47, 134, 39, 3, 153, 51, 119, 163, 242,
-			108, 182, 124, 163, 5, 187, 216, 105, 209, 32, 1, 176, 85, 243,
-			220, 70, 10, 224, 150, 93, 252, 95, 164, 200, 105, 18, 236, 237,
-			132, 236, 119, 191, 107, 121, 39, 212, 105, 4, 87, 165, 50, 169,
-			123, 62, 246, 31, 212, 119, 57, 64, 125, 168, 192, 142, 170, 247,
-			126, 109, 23, 68, 109, 109, 183, 201, 130, 49, 27, 2, 53, 17,
-			0, 91, 245, 9, 181, 137, 2, 184, 125, 31, 255, 185, 36, 80,
-			179, 96, 239, 36, 228, 82, 247, 89, 45, 147, 225, 236, 165, 114,
-			156, 101, 229, 77, 104, 45, 177, 12, 219, 87, 101, 33, 136, 36,
-			244, 171, 34, 148, 18, 38, 255, 1, 132, 106, 182, 96, 236, 78,
-			179, 6, 9, 128, 45, 91, 52, 72, 1, 28, 190, 132, 63, 215,
-			128, 132, 106, 17, 236, 94, 66, 14, 186, 223, 110, 240, 164, 79,
-			63, 10, 220, 200, 175, 20, 165, 114, 37, 175, 195, 60, 155, 185,
-			188, 36, 87, 233, 100, 110, 53, 210, 254, 34, 55, 28, 95, 86,
-			223, 157, 69, 161, 94, 12, 188, 204, 201, 96, 125, 183, 76, 136,
-			236, 173, 250, 185, 26, 251, 41, 230, 38, 90, 45, 102, 180, 167,
-			91, 92, 206, 0, 101, 81, 166, 75, 51, 159, 209, 58, 35, 126,
-			137, 10, 121, 88, 212, 194, 136, 199, 10, 98, 237, 198, 60, 248,
-			208, 75, 20, 127, 49, 231, 33, 24, 59, 86, 227, 195, 179, 99,
-			33, 198, 26, 42, 116, 57, 85, 167, 205, 95, 218, 36, 39, 115,
-			46, 210, 2, 149, 97, 120, 97, 25, 95, 175, 22, 149, 5, 238,
-			97, 4, 162, 190, 237, 140, 185, 136, 26, 250, 69, 178, 37, 150,
-			211, 77, 93, 9, 74, 237, 38, 191, 30, 23, 152, 198, 138, 36,
-			69, 81, 41, 40, 96, 41, 184, 80, 210, 203, 92, 148, 215, 36,
-			154, 154, 10, 163, 146, 141, 120, 254, 66, 49, 44, 193, 20, 231,
-			35, 193, 58, 108, 110, 82, 215, 189, 108, 8, 107, 80, 166, 208,
-			197, 251, 73, 73, 145, 221, 210, 246, 15, 99, 86, 101, 126, 98,
-			100, 230, 222, 202, 90, 190, 156, 91, 205, 87, 248, 184, 234, 12,
-			214, 232, 139, 87, 140, 103, 23, 212, 104, 81, 107, 49, 158, 144,
-			249, 117, 105, 89, 241, 134, 214, 228, 253, 23, 108, 242, 97, 121,
-			203, 163, 221, 224, 100, 151, 148, 247, 44, 28, 50, 114, 11, 35,
-			210, 33, 128, 203, 238, 235, 132, 207, 82, 240, 71, 202, 70, 116,
-			181, 143, 174, 41, 85, 26, 172, 78, 96, 135, 174, 243, 178, 89,
-			163, 71, 68, 135, 152, 72, 99, 65, 111, 166, 149, 213, 176, 136,
-			101, 92, 145, 43, 64, 199, 205, 74, 174, 177, 70, 113, 172, 72,
-			50, 169, 116, 0, 37, 95, 91, 165, 3, 122, 160, 214, 163, 153,
-			155, 255, 178, 106, 199, 127, 5, 37, 163, 197, 2, 110, 111, 236,
-			226, 45, 4, 64, 99, 23, 111, 161, 0, 142, 238, 231, 55, 200,
-			203, 233, 86, 193, 30, 5, 209, 240, 60, 245, 100, 156, 86, 45,
-			209, 32, 199, 182, 238, 157, 198, 116, 206, 177, 211, 27, 238, 1,
-			52, 62, 212, 48, 25, 22, 195, 181, 114, 174, 16, 192, 6, 150,
-			55, 207, 101, 45, 39, 42, 92, 29, 204, 214, 26, 50, 246, 71,
-			104, 37, 139, 189, 49, 70, 241, 105, 233, 109, 187, 176, 224, 151,
-			116, 46, 244, 181, 82, 176, 184, 134, 201, 224, 189, 197, 220, 181,
-			48, 51, 165, 133, 34, 26, 156, 74, 202, 71, 119, 172, 122, 59,
-			140, 112, 47, 138, 139, 147, 22, 13, 153, 76, 28, 119, 196, 252,
-			186, 180, 47, 204, 40, 49, 160, 252, 130, 160, 55, 114, 177, 206,
-			140, 160, 255, 179, 222, 178, 208, 200, 240, 171, 93, 255, 133, 106,
-			91, 198, 127, 192, 250, 87, 107, 162, 213, 130, 69, 96, 86, 76,
-			43, 1, 208, 172, 152, 86, 10, 224, 232, 126, 254, 217, 36, 174,
-			152, 54, 193, 158, 34, 228, 136, 123, 95, 178, 182, 50, 97, 92,
-			57, 130, 66, 25, 143, 246, 27, 21, 139, 201, 24, 123, 171, 120,
-			125, 93, 30, 84, 79, 6, 235, 163, 50, 143, 41, 104, 17, 35,
-			202, 191, 31, 70, 114, 50, 144, 175, 240, 88, 68, 130, 138, 197,
-			208, 94, 63, 42, 193, 124, 85, 121, 169, 249, 124, 56, 175, 170,
-			19, 95, 180, 115, 194, 187, 114, 30, 203, 22, 230, 174, 83, 103,
-			100, 21, 17, 115, 82, 233, 31, 40, 232, 140, 244, 138, 100, 148,
-			170, 2, 181, 113, 3, 163, 253, 219, 28, 59, 165, 150, 176, 224,
-			231, 171, 70, 34, 87, 127, 54, 200, 174, 201, 156, 246, 65, 118,
-			183, 218, 78, 56, 0, 169, 95, 241, 136, 79, 27, 218, 200, 232,
-			160, 48, 196, 84, 244, 107, 184, 108, 99, 46, 51, 139, 21, 242,
-			66, 146, 7, 59, 191, 226, 175, 106, 226, 33, 81, 85, 141, 128,
-			48, 242, 32, 144, 98, 92, 141, 75, 146, 98, 227, 132, 108, 140,
-			201, 137, 121, 196, 236, 246, 124, 224, 117, 203, 170, 84, 161, 10,
-			170, 8, 178, 28, 17, 250, 186, 10, 169, 55, 84, 10, 2, 175,
-			58, 255, 133, 73, 112, 186, 24, 37, 68, 29, 198, 219, 197, 152,
-			10, 53, 194, 227, 149, 107, 70, 176, 58, 23, 28, 27, 213, 194,
-			146, 221, 68, 87, 34, 32, 27, 204, 107, 101, 196, 233, 111, 36,
-			212, 175, 153, 132, 106, 179, 128, 133, 56, 41, 13, 18, 0, 59,
-			182, 105, 144, 2, 120, 209, 21, 252, 81, 121, 69, 39, 4, 251,
-			71, 224, 55, 247, 218, 181, 37, 212, 6, 126, 179, 169, 180, 154,
-			82, 47, 160, 238, 88, 177, 166, 101, 16, 76, 108, 85, 75, 183,
-			11, 201, 12, 148, 251, 134, 116, 123, 2, 69, 15, 87, 183, 244,
-			5, 173, 218, 224, 149, 89, 132, 95, 126, 205, 115, 179, 232, 71,
-			244, 167, 170, 164, 199, 80, 110, 113, 211, 47, 75, 202, 103, 158,
-			123, 53, 67, 171, 37, 247, 26, 60, 25, 172, 15, 202, 136, 39,
-			236, 223, 160, 183, 26, 174, 202, 206, 203, 171, 234, 66, 88, 230,
-			149, 46, 153, 120, 125, 83, 246, 97, 6, 113, 116, 149, 102, 193,
-			77, 199, 129, 39, 17, 69, 16, 229, 204, 239, 123, 181, 130, 178,
-			81, 86, 202, 46, 99, 77, 26, 221, 144, 172, 226, 87, 225, 36,
-			25, 49, 188, 255, 252, 226, 85, 88, 176, 130, 205, 114, 23, 4,
-			64, 179, 220, 5, 5, 240, 162, 43, 248, 29, 50, 182, 160, 93,
-			176, 231, 9, 57, 236, 222, 66, 149, 81, 99, 195, 250, 142, 159,
-			100, 171, 4, 235, 148, 92, 170, 198, 189, 175, 66, 76, 40, 15,
-			36, 221, 12, 110, 236, 200, 23, 248, 100, 176, 174, 194, 164, 242,
-			185, 82, 153, 199, 60, 141, 212, 194, 172, 208, 255, 177, 180, 54,
-			166, 184, 174, 234, 28, 134, 85, 199, 140, 45, 92, 154, 88, 94,
-			181, 154, 244, 43, 177, 177, 156, 221, 60, 182, 91, 48, 53, 142,
-			208, 32, 1, 176, 253, 2, 13, 82, 0, 119, 78, 241, 239, 201,
-			121, 76, 9, 118, 61, 37, 111, 112, 255, 39, 245, 102, 241, 220,
-			22, 25, 204, 99, 10, 75, 81, 134, 104, 150, 100, 4, 19, 74,
-			230, 51, 24, 72, 64, 60, 87, 53, 162, 143, 118, 232, 191, 173,
-			124, 181, 38, 213, 143, 163, 58, 162, 50, 102, 111, 80, 142, 151,
-			25, 127, 126, 33, 27, 44, 142, 141, 141, 141, 101, 198, 244, 87,
-			197, 96, 17, 45, 12, 250, 29, 101, 113, 55, 191, 163, 165, 64,
-			254, 164, 131, 121, 119, 142, 237, 220, 49, 182, 43, 243, 235, 56,
-			187, 21, 230, 208, 216, 76, 171, 174, 191, 156, 108, 133, 147, 184,
-			153, 157, 114, 88, 195, 150, 97, 22, 70, 202, 130, 185, 54, 27,
-			60, 69, 0, 52, 27, 60, 69, 1, 188, 232, 50, 254, 86, 121,
-			119, 214, 33, 216, 45, 148, 236, 115, 95, 176, 188, 227, 50, 160,
-			19, 93, 56, 115, 75, 133, 32, 27, 21, 53, 141, 155, 144, 202,
-			161, 244, 16, 218, 224, 77, 97, 2, 76, 127, 237, 233, 31, 91,
-			195, 146, 42, 29, 22, 144, 193, 208, 172, 131, 0, 104, 104, 214,
-			65, 1, 188, 104, 47, 255, 186, 60, 165, 118, 10, 246, 167, 148,
-			204, 184, 143, 71, 241, 56, 49, 71, 101, 21, 201, 186, 209, 3,
-			64, 202, 155, 213, 160, 144, 85, 161, 145, 24, 115, 80, 66, 229,
-			215, 184, 64, 224, 112, 162, 139, 58, 37, 144, 101, 183, 253, 146,
-			116, 166, 195, 27, 122, 67, 241, 211, 126, 133, 227, 39, 86, 61,
-			95, 247, 78, 47, 135, 43, 210, 253, 71, 170, 167, 42, 196, 12,
-			163, 148, 226, 183, 158, 104, 25, 131, 167, 152, 186, 16, 181, 247,
-			124, 62, 174, 124, 169, 123, 211, 66, 22, 253, 177, 203, 168, 10,
-			248, 5, 121, 173, 121, 149, 191, 46, 29, 161, 43, 110, 226, 74,
-			88, 196, 40, 234, 160, 140, 129, 95, 8, 100, 224, 197, 8, 218,
-			65, 149, 87, 41, 38, 222, 219, 198, 53, 37, 42, 169, 128, 154,
-			209, 162, 159, 47, 5, 58, 244, 194, 252, 162, 156, 6, 74, 232,
-			149, 83, 77, 16, 25, 24, 240, 235, 184, 8, 95, 142, 167, 119,
-			90, 176, 178, 140, 19, 64, 39, 1, 176, 107, 76, 131, 20, 192,
-			75, 222, 200, 175, 151, 60, 189, 75, 176, 143, 80, 114, 149, 251,
-			99, 114, 198, 101, 40, 195, 243, 165, 10, 24, 197, 17, 122, 87,
-			199, 34, 248, 213, 175, 165, 32, 191, 56, 42, 195, 12, 228, 10,
-			200, 170, 200, 235, 56, 29, 127, 115, 118, 136, 157, 29, 186, 44,
-			152, 3, 195, 55, 186, 8, 128, 134, 111, 116, 81, 0, 47, 186,
-			146, 191, 151, 227, 132, 165, 5, 123, 140, 146, 101, 247, 109, 252,
-			12, 19, 134, 137, 9, 209, 227, 247, 218, 50, 86, 65, 148, 5,
-			232, 189, 121, 157, 211, 166, 42, 186, 151, 107, 227, 113, 133, 71,
-			189, 212, 88, 115, 11, 24, 200, 30, 47, 204, 166, 75, 222, 224,
-			71, 152, 180, 70, 6, 204, 151, 98, 49, 29, 50, 110, 253, 196,
-			241, 3, 166, 238, 43, 240, 79, 116, 254, 147, 46, 63, 145, 209,
-			126, 126, 173, 176, 176, 28, 51, 87, 3, 99, 225, 24, 54, 51,
-			230, 77, 97, 37, 25, 109, 240, 210, 166, 110, 255, 76, 129, 177,
-			222, 80, 204, 45, 175, 34, 66, 10, 57, 203, 233, 229, 48, 31,
-			72, 107, 187, 241, 172, 247, 75, 222, 134, 12, 128, 177, 40, 94,
-			238, 45, 132, 69, 233, 200, 132, 108, 183, 34, 207, 160, 54, 42,
-			192, 25, 253, 204, 121, 11, 185, 119, 92, 71, 251, 74, 183, 161,
-			160, 132, 41, 47, 214, 10, 210, 114, 34, 71, 121, 218, 95, 175,
-			189, 71, 34, 197, 86, 43, 76, 225, 25, 239, 213, 254, 157, 155,
-			5, 29, 223, 116, 12, 189, 41, 178, 188, 113, 9, 169, 64, 145,
-			151, 221, 91, 191, 186, 109, 5, 164, 155, 218, 141, 138, 127, 60,
-			121, 141, 62, 135, 154, 242, 120, 37, 100, 212, 51, 179, 94, 80,
-			200, 74, 243, 139, 170, 183, 27, 41, 42, 92, 58, 228, 193, 65,
-			224, 148, 138, 23, 148, 137, 182, 241, 122, 101, 227, 118, 146, 197,
-			88, 15, 79, 205, 122, 227, 230, 199, 241, 61, 234, 215, 189, 227,
-			219, 199, 247, 168, 185, 219, 203, 185, 194, 134, 222, 241, 216, 53,
-			207, 247, 118, 237, 152, 240, 138, 129, 242, 188, 151, 86, 45, 169,
-			69, 173, 21, 243, 70, 154, 92, 3, 250, 209, 53, 166, 39, 213,
-			204, 245, 216, 149, 213, 50, 5, 164, 187, 46, 32, 138, 100, 27,
-			90, 13, 138, 203, 254, 170, 186, 233, 241, 243, 249, 245, 225, 42,
-			46, 89, 177, 250, 10, 104, 156, 168, 94, 133, 209, 85, 75, 180,
-			224, 126, 229, 2, 82, 113, 201, 180, 5, 108, 209, 233, 211, 32,
-			1, 112, 203, 30, 13, 82, 0, 15, 47, 242, 231, 44, 78, 236,
-			58, 225, 252, 13, 173, 251, 54, 181, 220, 219, 44, 25, 83, 35,
-			25, 90, 174, 80, 229, 48, 229, 77, 30, 56, 162, 44, 136, 107,
-			165, 160, 24, 153, 81, 227, 182, 26, 233, 154, 128, 173, 200, 76,
-			68, 213, 78, 87, 104, 116, 89, 10, 48, 18, 87, 7, 224, 206,
-			203, 152, 152, 88, 216, 205, 24, 159, 56, 54, 254, 154, 254, 113,
-			222, 192, 169, 93, 103, 9, 246, 55, 212, 174, 231, 141, 156, 217,
-			88, 173, 247, 111, 41, 233, 2, 186, 216, 178, 220, 227, 223, 82,
-			34, 52, 72, 0, 236, 232, 228, 31, 176, 240, 101, 75, 176, 39,
-			41, 105, 114, 223, 97, 121, 39, 2, 63, 27, 20, 229, 181, 155,
-			84, 220, 55, 58, 199, 97, 56, 138, 118, 168, 215, 135, 104, 121,
-			167, 171, 50, 41, 240, 248, 69, 36, 70, 248, 161, 127, 185, 188,
-			212, 93, 68, 139, 145, 180, 226, 232, 9, 168, 106, 6, 230, 99,
-			88, 206, 185, 45, 203, 68, 62, 73, 101, 237, 74, 27, 35, 147,
-			217, 147, 180, 161, 145, 223, 44, 123, 79, 4, 123, 10, 122, 127,
-			157, 247, 38, 32, 186, 234, 124, 54, 244, 130, 83, 65, 113, 93,
-			70, 95, 232, 123, 20, 61, 182, 17, 111, 53, 191, 166, 163, 52,
-			209, 23, 77, 111, 236, 17, 174, 29, 86, 107, 220, 169, 74, 61,
-			88, 141, 102, 37, 60, 37, 111, 116, 77, 71, 129, 144, 79, 69,
-			29, 149, 61, 107, 104, 228, 15, 200, 142, 82, 193, 190, 69, 73,
-			163, 123, 151, 229, 29, 147, 78, 223, 155, 116, 244, 116, 52, 14,
-			213, 81, 12, 152, 169, 166, 21, 26, 225, 106, 132, 116, 155, 40,
-			254, 216, 178, 147, 247, 225, 232, 59, 178, 190, 121, 67, 21, 235,
-			84, 141, 130, 90, 216, 237, 132, 6, 9, 128, 188, 129, 55, 98,
-			193, 62, 231, 105, 90, 247, 93, 106, 193, 18, 100, 176, 202, 158,
-			166, 201, 14, 126, 153, 46, 24, 253, 12, 37, 41, 119, 220, 155,
-			140, 2, 132, 96, 148, 85, 254, 219, 230, 88, 143, 185, 60, 198,
-			226, 21, 75, 159, 161, 21, 21, 75, 159, 161, 245, 45, 177, 138,
-			165, 207, 80, 209, 142, 221, 176, 132, 243, 247, 180, 238, 71, 170,
-			27, 176, 92, 254, 158, 38, 251, 249, 231, 45, 206, 152, 5, 253,
-			120, 142, 146, 78, 247, 65, 11, 37, 133, 137, 51, 148, 164, 14,
-			148, 130, 128, 60, 71, 202, 69, 25, 255, 40, 35, 122, 189, 121,
-			140, 240, 243, 194, 197, 40, 48, 92, 213, 68, 85, 182, 85, 191,
-			16, 22, 214, 87, 194, 53, 12, 134, 243, 189, 252, 218, 66, 110,
-			212, 95, 43, 47, 131, 206, 92, 140, 69, 252, 193, 32, 165, 212,
-			216, 22, 125, 179, 219, 252, 39, 127, 0, 246, 179, 123, 79, 176,
-			226, 231, 242, 158, 159, 205, 22, 131, 82, 105, 175, 36, 136, 133,
-			4, 121, 78, 19, 196, 66, 130, 60, 71, 85, 9, 87, 11, 9,
-			242, 28, 77, 117, 240, 97, 28, 180, 37, 216, 15, 41, 217, 234,
-			246, 224, 152, 253, 133, 242, 154, 159, 175, 12, 94, 28, 211, 237,
-			90, 14, 190, 219, 162, 65, 252, 180, 117, 139, 6, 41, 128, 3,
-			25, 254, 30, 139, 19, 70, 132, 243, 19, 90, 247, 127, 51, 203,
-			189, 209, 170, 14, 65, 206, 197, 236, 125, 218, 145, 201, 47, 75,
-			221, 113, 131, 97, 40, 210, 64, 167, 203, 38, 108, 13, 157, 246,
-			177, 144, 115, 69, 50, 138, 138, 101, 92, 25, 48, 7, 243, 13,
-			99, 253, 9, 77, 118, 242, 102, 206, 24, 161, 117, 194, 121, 129,
-			146, 127, 165, 20, 7, 64, 40, 208, 233, 5, 0, 198, 185, 3,
-			32, 172, 135, 159, 82, 38, 220, 126, 148, 9, 58, 184, 59, 87,
-			194, 172, 53, 250, 156, 9, 235, 191, 133, 39, 228, 7, 14, 126,
-			145, 140, 30, 88, 240, 160, 190, 41, 122, 64, 225, 65, 107, 27,
-			191, 213, 82, 72, 44, 193, 94, 162, 108, 171, 123, 29, 46, 3,
-			185, 37, 49, 103, 69, 220, 183, 184, 188, 28, 175, 148, 11, 178,
-			37, 74, 77, 132, 186, 166, 140, 141, 193, 92, 76, 153, 61, 39,
-			115, 133, 236, 222, 221, 123, 208, 34, 190, 55, 163, 119, 13, 54,
-			186, 123, 108, 108, 76, 186, 170, 226, 2, 146, 150, 49, 211, 59,
-			139, 97, 103, 26, 163, 7, 54, 60, 104, 106, 143, 30, 96, 119,
-			83, 91, 162, 7, 20, 30, 12, 100, 248, 77, 176, 135, 8, 208,
-			236, 122, 70, 82, 238, 181, 106, 210, 37, 193, 226, 119, 124, 209,
-			140, 111, 216, 207, 166, 240, 179, 177, 171, 196, 210, 34, 121, 58,
-			48, 111, 196, 43, 133, 134, 51, 193, 22, 43, 0, 91, 132, 141,
-			150, 209, 76, 129, 224, 30, 184, 158, 169, 61, 64, 112, 34, 174,
-			103, 138, 41, 16, 156, 134, 235, 153, 104, 231, 31, 149, 189, 182,
-			4, 187, 137, 145, 110, 247, 255, 177, 188, 201, 130, 23, 174, 250,
-			127, 184, 102, 174, 92, 101, 234, 143, 200, 37, 170, 226, 164, 81,
-			161, 119, 199, 6, 26, 63, 10, 215, 202, 45, 141, 247, 123, 197,
-			224, 20, 112, 99, 153, 197, 9, 49, 248, 121, 47, 60, 21, 20,
-			145, 173, 227, 93, 163, 42, 138, 173, 71, 5, 243, 113, 83, 52,
-			42, 11, 251, 93, 159, 210, 32, 5, 176, 43, 205, 111, 148, 163,
-			34, 130, 221, 198, 200, 136, 187, 230, 189, 73, 153, 51, 34, 122,
-			130, 2, 104, 28, 4, 203, 186, 28, 126, 57, 183, 18, 72, 11,
-			76, 193, 95, 138, 14, 242, 82, 77, 30, 241, 114, 75, 133, 176,
-			8, 186, 57, 52, 167, 92, 103, 202, 97, 205, 1, 154, 46, 19,
-			7, 123, 209, 163, 65, 11, 192, 222, 65, 13, 82, 0, 183, 109,
-			231, 239, 147, 93, 166, 130, 189, 11, 38, 226, 237, 150, 55, 173,
-			217, 175, 154, 135, 112, 17, 14, 106, 56, 213, 21, 29, 143, 221,
-			176, 156, 187, 81, 80, 27, 59, 166, 9, 15, 162, 238, 93, 17,
-			225, 41, 118, 187, 43, 205, 111, 145, 163, 96, 130, 189, 151, 145,
-			180, 251, 71, 168, 159, 200, 21, 4, 172, 107, 253, 172, 24, 23,
-			110, 229, 21, 127, 117, 21, 70, 13, 135, 116, 142, 17, 89, 42,
-			35, 148, 201, 65, 181, 182, 57, 187, 200, 149, 77, 199, 153, 236,
-			139, 1, 29, 0, 27, 154, 53, 104, 1, 216, 210, 174, 65, 10,
-			96, 103, 23, 202, 74, 42, 156, 59, 88, 221, 7, 153, 148, 149,
-			48, 226, 59, 88, 178, 159, 127, 156, 112, 198, 40, 236, 243, 59,
-			25, 153, 112, 255, 95, 226, 29, 15, 138, 163, 213, 138, 130, 25,
-			178, 246, 141, 48, 137, 111, 194, 98, 86, 199, 212, 168, 143, 242,
-			65, 97, 169, 188, 188, 33, 178, 27, 167, 39, 38, 110, 43, 210,
-			163, 84, 164, 8, 27, 207, 102, 180, 166, 128, 14, 100, 235, 220,
-			164, 80, 208, 55, 54, 240, 77, 85, 112, 184, 250, 112, 8, 213,
-			12, 191, 44, 59, 38, 83, 104, 105, 150, 196, 209, 41, 116, 85,
-			158, 4, 100, 169, 92, 217, 59, 207, 151, 167, 150, 101, 255, 148,
-			188, 228, 88, 223, 152, 192, 77, 169, 165, 64, 43, 134, 196, 50,
-			160, 3, 96, 67, 183, 6, 45, 0, 221, 81, 13, 82, 0, 119,
-			236, 196, 57, 96, 194, 249, 51, 86, 119, 183, 154, 3, 152, 173,
-			63, 99, 201, 62, 148, 95, 12, 228, 215, 135, 25, 185, 139, 73,
-			249, 197, 80, 126, 125, 152, 113, 16, 111, 14, 128, 48, 71, 31,
-			97, 76, 32, 175, 102, 74, 60, 125, 132, 41, 241, 196, 148, 120,
-			250, 8, 83, 226, 137, 41, 241, 244, 17, 214, 218, 198, 143, 2,
-			6, 104, 224, 207, 25, 201, 184, 251, 188, 171, 11, 122, 222, 208,
-			167, 162, 120, 198, 156, 30, 213, 185, 119, 20, 33, 24, 18, 226,
-			207, 53, 33, 24, 246, 231, 207, 89, 67, 151, 6, 45, 0, 211,
-			125, 26, 164, 0, 122, 3, 252, 247, 57, 97, 182, 112, 238, 97,
-			117, 15, 50, 235, 156, 156, 137, 152, 109, 9, 118, 15, 75, 118,
-			227, 73, 129, 217, 48, 240, 251, 65, 138, 93, 247, 50, 26, 41,
-			44, 237, 60, 134, 192, 84, 72, 50, 15, 179, 124, 235, 47, 209,
-			3, 171, 20, 114, 115, 224, 198, 236, 176, 43, 129, 95, 40, 121,
-			25, 25, 105, 185, 88, 213, 174, 150, 99, 54, 202, 177, 251, 53,
-			227, 177, 145, 70, 247, 107, 57, 102, 35, 141, 238, 7, 57, 54,
-			139, 221, 182, 4, 123, 128, 145, 14, 247, 144, 55, 189, 40, 77,
-			220, 35, 138, 93, 108, 150, 149, 97, 49, 118, 214, 142, 231, 65,
-			66, 243, 141, 66, 2, 66, 231, 1, 70, 28, 13, 34, 150, 68,
-			171, 6, 41, 128, 237, 41, 254, 6, 236, 2, 17, 236, 83, 140,
-			184, 238, 78, 180, 173, 23, 215, 2, 93, 55, 59, 80, 17, 231,
-			145, 73, 207, 80, 179, 180, 150, 47, 27, 108, 196, 198, 22, 52,
-			54, 24, 211, 167, 88, 162, 67, 131, 20, 192, 116, 55, 238, 14,
-			71, 56, 127, 201, 234, 30, 85, 187, 195, 177, 4, 251, 75, 150,
-			116, 249, 20, 103, 204, 129, 41, 252, 52, 35, 253, 238, 235, 189,
-			35, 213, 73, 36, 76, 118, 9, 197, 73, 85, 78, 78, 213, 45,
-			116, 63, 86, 221, 113, 112, 201, 126, 90, 47, 89, 7, 167, 227,
-			211, 172, 161, 77, 131, 22, 128, 194, 213, 32, 5, 176, 111, 11,
-			127, 19, 118, 193, 18, 236, 51, 208, 133, 233, 151, 239, 194, 194,
-			114, 46, 31, 75, 221, 48, 164, 18, 249, 121, 229, 162, 159, 195,
-			216, 206, 193, 241, 193, 97, 211, 41, 208, 211, 62, 19, 117, 10,
-			38, 232, 51, 81, 167, 44, 196, 107, 58, 5, 19, 244, 25, 232,
-			212, 187, 65, 47, 79, 8, 231, 243, 172, 238, 113, 102, 185, 19,
-			94, 101, 249, 4, 149, 0, 53, 50, 87, 108, 76, 74, 112, 110,
-			54, 95, 194, 18, 236, 243, 44, 217, 201, 27, 57, 99, 9, 152,
-			184, 47, 48, 34, 39, 60, 129, 4, 255, 130, 94, 255, 9, 36,
-			248, 23, 88, 125, 171, 6, 41, 128, 237, 41, 254, 1, 139, 19,
-			219, 18, 206, 87, 88, 221, 255, 96, 150, 187, 215, 219, 88, 230,
-			68, 187, 49, 3, 185, 55, 68, 145, 158, 56, 126, 32, 22, 151,
-			122, 110, 12, 47, 48, 47, 95, 97, 118, 23, 26, 94, 240, 180,
-			249, 85, 70, 240, 102, 221, 150, 135, 180, 175, 50, 50, 160, 65,
-			2, 224, 121, 231, 243, 73, 124, 215, 18, 236, 73, 70, 186, 51,
-			187, 42, 141, 168, 160, 196, 197, 253, 153, 227, 23, 118, 24, 160,
-			94, 8, 79, 115, 213, 162, 37, 219, 104, 212, 32, 1, 176, 43,
-			205, 95, 143, 8, 136, 96, 127, 7, 8, 134, 43, 17, 84, 39,
-			29, 211, 1, 137, 199, 174, 212, 205, 66, 215, 254, 142, 145, 148,
-			6, 177, 161, 174, 52, 223, 135, 205, 82, 193, 190, 6, 205, 78,
-			84, 54, 27, 57, 255, 199, 195, 176, 117, 32, 49, 250, 82, 232,
-			246, 65, 239, 248, 26, 35, 205, 26, 36, 0, 118, 165, 249, 20,
-			39, 44, 41, 156, 111, 178, 186, 239, 50, 203, 125, 125, 148, 138,
-			56, 39, 147, 4, 152, 176, 191, 184, 215, 96, 20, 112, 169, 239,
-			171, 96, 253, 37, 45, 193, 190, 201, 146, 173, 252, 117, 156, 177,
-			36, 204, 203, 183, 128, 137, 14, 121, 147, 241, 100, 186, 81, 148,
-			77, 101, 162, 80, 181, 41, 147, 184, 82, 191, 165, 87, 106, 18,
-			39, 244, 91, 122, 165, 38, 113, 165, 126, 11, 86, 234, 62, 68,
-			98, 9, 246, 29, 208, 115, 39, 164, 71, 66, 80, 196, 155, 76,
-			149, 169, 165, 226, 214, 91, 179, 73, 21, 159, 110, 208, 193, 97,
-			252, 59, 140, 52, 104, 16, 91, 108, 76, 105, 144, 2, 216, 149,
-			70, 174, 156, 132, 89, 121, 154, 145, 30, 119, 167, 180, 53, 156,
-			94, 14, 227, 75, 165, 2, 231, 80, 184, 86, 94, 93, 147, 34,
-			99, 216, 96, 3, 174, 252, 116, 52, 56, 232, 255, 211, 172, 190,
-			83, 131, 20, 192, 110, 87, 97, 163, 130, 61, 195, 200, 152, 187,
-			51, 58, 119, 108, 118, 173, 92, 27, 27, 117, 176, 133, 30, 13,
-			90, 0, 246, 14, 107, 16, 219, 31, 25, 69, 25, 80, 47, 156,
-			127, 96, 117, 207, 43, 25, 80, 111, 9, 246, 15, 44, 233, 241,
-			34, 103, 172, 30, 166, 242, 123, 140, 120, 110, 214, 59, 182, 86,
-			94, 8, 163, 185, 52, 33, 230, 35, 38, 105, 163, 201, 241, 42,
-			243, 175, 230, 242, 50, 2, 2, 47, 215, 225, 192, 170, 50, 232,
-			202, 75, 44, 117, 177, 44, 243, 85, 99, 42, 118, 213, 247, 122,
-			84, 106, 190, 167, 54, 4, 128, 22, 128, 29, 61, 26, 164, 0,
-			110, 233, 231, 111, 179, 176, 135, 150, 96, 63, 128, 147, 194, 105,
-			212, 125, 205, 29, 80, 44, 156, 28, 184, 112, 141, 40, 115, 147,
-			90, 70, 95, 229, 155, 68, 215, 145, 73, 117, 12, 35, 232, 64,
-			97, 131, 113, 213, 72, 1, 96, 58, 13, 139, 233, 7, 90, 160,
-			212, 227, 98, 250, 1, 107, 104, 215, 32, 5, 176, 179, 139, 223,
-			47, 59, 77, 4, 251, 49, 144, 245, 195, 86, 172, 215, 149, 237,
-			155, 238, 197, 211, 35, 196, 34, 251, 171, 221, 63, 184, 87, 14,
-			151, 2, 164, 45, 42, 224, 154, 23, 232, 132, 2, 229, 48, 158,
-			173, 160, 210, 45, 36, 62, 74, 174, 179, 99, 43, 74, 154, 17,
-			194, 49, 244, 199, 140, 180, 105, 208, 2, 80, 232, 105, 129, 37,
-			251, 99, 152, 22, 208, 53, 185, 112, 126, 206, 234, 222, 106, 159,
-			35, 93, 147, 91, 130, 253, 156, 37, 123, 129, 243, 50, 14, 107,
-			244, 37, 96, 55, 195, 222, 81, 197, 108, 34, 9, 172, 180, 203,
-			138, 144, 113, 53, 34, 142, 252, 230, 37, 189, 37, 57, 46, 180,
-			151, 52, 191, 225, 184, 208, 94, 2, 126, 115, 5, 98, 177, 4,
-			251, 37, 35, 93, 238, 30, 239, 232, 218, 202, 188, 76, 81, 30,
-			11, 140, 9, 117, 50, 166, 176, 128, 87, 25, 171, 200, 232, 178,
-			193, 162, 175, 242, 113, 237, 220, 177, 195, 32, 6, 117, 227, 151,
-			76, 218, 121, 1, 196, 182, 147, 109, 26, 164, 0, 166, 58, 249,
-			50, 34, 38, 130, 221, 104, 147, 110, 247, 119, 189, 223, 66, 7,
-			210, 112, 209, 27, 172, 170, 242, 57, 24, 229, 144, 89, 45, 6,
-			167, 48, 149, 75, 36, 139, 185, 119, 160, 210, 75, 8, 244, 197,
-			149, 64, 222, 57, 200, 208, 28, 137, 24, 88, 212, 141, 182, 161,
-			7, 12, 249, 70, 187, 190, 93, 131, 20, 192, 206, 52, 50, 141,
-			6, 225, 188, 221, 174, 123, 135, 45, 153, 70, 131, 37, 216, 219,
-			237, 100, 31, 63, 193, 25, 107, 128, 9, 185, 197, 38, 25, 247,
-			160, 201, 12, 31, 179, 235, 71, 222, 130, 27, 60, 5, 107, 103,
-			69, 0, 236, 13, 168, 69, 222, 98, 19, 3, 58, 0, 170, 253,
-			213, 128, 83, 119, 139, 157, 234, 211, 32, 5, 208, 27, 144, 60,
-			162, 1, 6, 114, 187, 77, 182, 184, 167, 21, 9, 203, 42, 77,
-			166, 95, 242, 6, 227, 84, 204, 21, 188, 90, 213, 168, 106, 208,
-			12, 43, 96, 168, 188, 128, 170, 198, 67, 133, 55, 19, 94, 99,
-			169, 148, 79, 102, 12, 48, 237, 183, 107, 250, 54, 224, 180, 223,
-			110, 215, 167, 53, 72, 1, 236, 233, 195, 29, 212, 40, 156, 247,
-			218, 117, 31, 59, 87, 59, 168, 209, 18, 236, 189, 118, 114, 11,
-			63, 192, 25, 107, 132, 9, 123, 159, 77, 58, 220, 139, 106, 239,
-			32, 25, 42, 88, 145, 117, 97, 72, 23, 152, 209, 34, 167, 17,
-			119, 211, 251, 244, 232, 26, 113, 74, 222, 103, 171, 221, 212, 136,
-			83, 242, 62, 187, 61, 197, 159, 39, 136, 18, 142, 236, 54, 73,
-			187, 127, 79, 100, 92, 35, 108, 212, 48, 60, 137, 124, 118, 72,
-			166, 117, 89, 43, 196, 239, 67, 209, 44, 51, 121, 228, 8, 80,
-			185, 20, 84, 250, 74, 73, 143, 97, 57, 177, 166, 68, 65, 172,
-			228, 139, 204, 17, 164, 134, 81, 146, 171, 44, 88, 92, 204, 45,
-			160, 119, 13, 30, 237, 100, 50, 180, 50, 143, 229, 123, 135, 109,
-			172, 2, 237, 22, 115, 121, 80, 134, 230, 215, 199, 188, 201, 124,
-			62, 94, 128, 193, 4, 43, 161, 15, 149, 138, 39, 230, 94, 41,
-			88, 245, 139, 126, 25, 148, 142, 96, 213, 243, 23, 203, 129, 118,
-			194, 145, 137, 87, 177, 47, 27, 226, 142, 100, 47, 74, 88, 166,
-			12, 77, 87, 176, 202, 140, 71, 161, 140, 177, 66, 102, 50, 31,
-			148, 117, 78, 6, 236, 174, 52, 207, 225, 184, 112, 216, 104, 117,
-			133, 215, 227, 155, 168, 17, 79, 61, 119, 234, 77, 212, 136, 50,
-			235, 78, 91, 153, 178, 26, 113, 61, 222, 105, 43, 83, 86, 35,
-			174, 199, 59, 237, 206, 46, 228, 127, 141, 192, 134, 62, 100, 191,
-			38, 252, 175, 17, 25, 205, 135, 108, 197, 255, 26, 145, 209, 124,
-			200, 86, 252, 175, 17, 25, 205, 135, 108, 197, 255, 26, 1, 250,
-			232, 175, 130, 255, 53, 162, 137, 242, 163, 209, 10, 6, 165, 233,
-			163, 154, 255, 53, 162, 210, 244, 81, 205, 255, 154, 132, 243, 113,
-			187, 238, 62, 197, 255, 154, 44, 193, 62, 110, 39, 251, 145, 255,
-			53, 193, 118, 250, 196, 107, 203, 255, 154, 144, 255, 125, 66, 79,
-			93, 19, 242, 191, 79, 104, 254, 215, 132, 155, 237, 19, 154, 255,
-			53, 225, 102, 251, 4, 240, 191, 183, 91, 216, 33, 75, 176, 123,
-			129, 255, 173, 191, 60, 255, 171, 93, 65, 175, 6, 213, 184, 23,
-			22, 95, 33, 7, 108, 66, 14, 120, 175, 166, 112, 19, 174, 184,
-			123, 53, 7, 108, 194, 21, 119, 47, 112, 192, 207, 193, 57, 187,
-			89, 56, 15, 217, 117, 127, 109, 91, 110, 214, 59, 113, 118, 185,
-			73, 106, 166, 36, 169, 84, 146, 54, 75, 73, 114, 142, 78, 230,
-			205, 150, 96, 15, 217, 201, 6, 62, 206, 25, 107, 134, 149, 241,
-			176, 77, 132, 59, 96, 24, 173, 202, 112, 177, 193, 151, 26, 73,
-			210, 140, 76, 245, 97, 77, 176, 102, 156, 231, 135, 237, 250, 38,
-			13, 82, 0, 91, 219, 240, 22, 184, 25, 166, 249, 17, 96, 227,
-			227, 21, 173, 107, 162, 68, 209, 113, 42, 211, 139, 186, 70, 50,
-			184, 96, 114, 30, 137, 112, 89, 216, 156, 98, 224, 205, 56, 57,
-			143, 0, 3, 223, 141, 184, 136, 96, 143, 194, 174, 28, 169, 85,
-			232, 41, 134, 36, 230, 227, 106, 16, 129, 38, 249, 168, 173, 14,
-			94, 205, 184, 253, 31, 181, 213, 193, 171, 25, 183, 255, 163, 118,
-			87, 154, 31, 70, 68, 84, 176, 199, 0, 209, 37, 209, 193, 43,
-			118, 121, 33, 43, 43, 85, 94, 92, 212, 60, 18, 53, 227, 238,
-			126, 44, 26, 30, 236, 238, 199, 236, 122, 141, 149, 34, 158, 174,
-			52, 63, 132, 88, 153, 96, 127, 101, 147, 17, 247, 226, 232, 0,
-			6, 120, 54, 191, 243, 217, 4, 41, 115, 176, 161, 30, 13, 90,
-			0, 170, 187, 155, 102, 188, 45, 248, 43, 123, 219, 118, 100, 41,
-			45, 194, 121, 220, 174, 251, 27, 197, 82, 90, 44, 193, 30, 183,
-			147, 105, 92, 56, 45, 176, 112, 190, 244, 10, 22, 78, 11, 46,
-			156, 47, 233, 209, 182, 224, 194, 249, 146, 94, 56, 45, 184, 112,
-			190, 164, 23, 78, 11, 76, 192, 19, 175, 122, 225, 180, 224, 194,
-			121, 34, 194, 101, 97, 115, 106, 225, 180, 224, 194, 121, 2, 22,
-			14, 12, 178, 85, 56, 95, 181, 235, 254, 78, 13, 178, 213, 18,
-			236, 171, 118, 178, 139, 79, 112, 198, 90, 97, 144, 79, 66, 55,
-			206, 59, 131, 34, 143, 142, 211, 70, 135, 111, 197, 113, 62, 169,
-			113, 183, 226, 56, 159, 212, 184, 91, 113, 156, 79, 106, 220, 109,
-			194, 121, 202, 174, 251, 166, 194, 141, 209, 101, 64, 224, 55, 113,
-			198, 218, 0, 247, 55, 64, 31, 153, 246, 162, 212, 142, 139, 21,
-			236, 90, 206, 251, 194, 38, 236, 26, 111, 166, 214, 208, 113, 59,
-			206, 184, 219, 144, 113, 127, 67, 51, 238, 54, 100, 220, 223, 208,
-			50, 183, 13, 251, 251, 13, 45, 115, 219, 176, 191, 223, 0, 153,
-			251, 54, 202, 9, 19, 194, 249, 71, 187, 238, 95, 108, 203, 189,
-			11, 181, 36, 19, 245, 186, 59, 22, 74, 22, 15, 243, 65, 38,
-			24, 247, 106, 191, 50, 88, 47, 109, 150, 148, 97, 36, 202, 169,
-			170, 204, 183, 185, 162, 178, 43, 85, 39, 164, 149, 74, 7, 76,
-			186, 41, 24, 16, 133, 118, 168, 172, 74, 26, 158, 200, 108, 240,
-			99, 55, 153, 28, 120, 69, 231, 102, 151, 161, 185, 50, 168, 106,
-			120, 127, 165, 215, 29, 232, 92, 67, 126, 105, 67, 154, 144, 225,
-			152, 213, 43, 47, 51, 107, 251, 101, 239, 194, 29, 59, 184, 55,
-			191, 94, 62, 103, 238, 94, 12, 3, 179, 128, 135, 239, 227, 140,
-			9, 52, 137, 216, 164, 205, 157, 0, 234, 202, 41, 217, 16, 100,
-			140, 229, 2, 224, 159, 223, 243, 71, 175, 219, 49, 122, 201, 220,
-			155, 71, 127, 127, 187, 90, 18, 2, 215, 236, 247, 244, 154, 21,
-			210, 192, 97, 215, 55, 106, 144, 2, 216, 210, 202, 143, 33, 58,
-			75, 176, 231, 108, 210, 238, 78, 42, 217, 141, 8, 55, 160, 153,
-			28, 253, 93, 137, 233, 188, 161, 225, 109, 219, 71, 222, 60, 58,
-			54, 190, 251, 210, 61, 151, 237, 221, 247, 230, 55, 207, 253, 209,
-			31, 255, 95, 94, 12, 59, 236, 214, 231, 34, 236, 22, 34, 168,
-			111, 214, 32, 5, 176, 77, 32, 155, 23, 192, 230, 127, 40, 217,
-			60, 114, 95, 121, 41, 105, 102, 22, 216, 47, 206, 86, 13, 222,
-			39, 80, 203, 251, 97, 132, 8, 253, 100, 52, 195, 21, 200, 230,
-			127, 8, 12, 247, 98, 68, 68, 5, 251, 103, 96, 184, 219, 34,
-			134, 11, 45, 163, 151, 165, 198, 87, 27, 13, 117, 240, 211, 30,
-			13, 90, 0, 42, 22, 43, 144, 175, 255, 179, 102, 177, 237, 194,
-			249, 137, 93, 247, 146, 226, 0, 237, 150, 96, 63, 177, 147, 221,
-			200, 242, 219, 97, 94, 95, 4, 238, 115, 49, 46, 76, 195, 119,
-			240, 74, 56, 204, 103, 171, 50, 106, 156, 14, 226, 30, 106, 145,
-			116, 107, 199, 217, 125, 81, 15, 187, 29, 103, 247, 69, 205, 145,
-			218, 113, 118, 95, 4, 142, 52, 142, 72, 45, 193, 126, 6, 244,
-			29, 144, 254, 82, 47, 223, 58, 232, 236, 63, 211, 178, 179, 29,
-			103, 239, 103, 90, 118, 182, 227, 236, 253, 12, 136, 186, 31, 91,
-			39, 130, 253, 2, 152, 218, 133, 222, 177, 90, 105, 124, 36, 134,
-			33, 188, 151, 140, 87, 186, 40, 7, 197, 97, 131, 16, 68, 225,
-			47, 52, 255, 106, 71, 217, 253, 11, 205, 191, 218, 113, 82, 127,
-			161, 249, 87, 59, 78, 234, 47, 108, 117, 253, 157, 18, 206, 47,
-			237, 186, 183, 57, 146, 218, 41, 75, 176, 95, 2, 181, 47, 231,
-			140, 165, 128, 218, 111, 113, 72, 135, 187, 251, 108, 169, 173, 194,
-			182, 229, 169, 166, 24, 174, 168, 14, 166, 144, 222, 111, 113, 20,
-			189, 83, 72, 239, 183, 56, 138, 222, 41, 164, 247, 91, 156, 246,
-			20, 223, 137, 104, 45, 193, 110, 112, 72, 183, 187, 117, 3, 189,
-			55, 109, 31, 40, 126, 131, 163, 40, 158, 66, 138, 223, 224, 40,
-			138, 167, 144, 226, 55, 56, 138, 226, 41, 160, 248, 91, 157, 77,
-			41, 174, 112, 156, 145, 226, 41, 164, 248, 91, 29, 98, 64, 7,
-			64, 69, 241, 20, 82, 252, 173, 142, 162, 120, 10, 41, 254, 86,
-			167, 179, 11, 173, 6, 29, 194, 185, 205, 169, 251, 255, 156, 115,
-			100, 53, 232, 176, 4, 187, 205, 73, 74, 29, 169, 3, 166, 240,
-			118, 135, 180, 185, 23, 191, 66, 70, 248, 71, 59, 71, 46, 220,
-			177, 227, 143, 213, 120, 59, 112, 2, 111, 215, 19, 216, 129, 19,
-			120, 187, 163, 216, 97, 7, 78, 224, 237, 78, 75, 43, 63, 136,
-			72, 45, 193, 222, 233, 16, 225, 190, 46, 198, 14, 43, 18, 56,
-			172, 151, 3, 153, 197, 161, 180, 162, 74, 220, 44, 251, 5, 149,
-			204, 193, 160, 4, 30, 248, 78, 71, 29, 64, 59, 112, 78, 223,
-			233, 36, 155, 52, 72, 1, 108, 109, 227, 51, 136, 146, 8, 246,
-			110, 135, 184, 238, 148, 119, 108, 85, 157, 250, 175, 154, 190, 106,
-			74, 251, 138, 123, 229, 245, 85, 163, 51, 25, 31, 6, 148, 91,
-			35, 222, 106, 49, 183, 226, 23, 115, 121, 153, 105, 233, 234, 105,
-			211, 3, 96, 142, 239, 142, 6, 13, 227, 122, 183, 83, 223, 161,
-			65, 10, 96, 186, 155, 223, 75, 176, 11, 84, 176, 247, 195, 178,
-			253, 16, 241, 14, 197, 203, 193, 84, 165, 153, 170, 204, 201, 16,
-			229, 67, 65, 25, 15, 90, 137, 183, 243, 117, 82, 86, 66, 127,
-			101, 13, 203, 161, 204, 158, 147, 193, 122, 228, 236, 54, 12, 167,
-			192, 138, 58, 158, 220, 20, 242, 84, 73, 53, 165, 58, 176, 107,
-			162, 170, 220, 231, 194, 178, 143, 113, 49, 69, 153, 237, 228, 184,
-			142, 35, 175, 10, 89, 207, 21, 148, 115, 178, 60, 159, 151, 198,
-			188, 105, 229, 78, 148, 43, 120, 53, 107, 137, 143, 112, 47, 92,
-			45, 199, 242, 185, 100, 55, 102, 111, 168, 244, 180, 236, 64, 109,
-			255, 253, 17, 125, 65, 42, 188, 223, 81, 194, 167, 3, 165, 194,
-			251, 29, 117, 185, 211, 1, 27, 238, 3, 64, 222, 157, 155, 74,
-			185, 200, 105, 186, 134, 12, 234, 32, 204, 198, 22, 52, 54, 80,
-			243, 63, 16, 97, 3, 53, 255, 3, 128, 237, 50, 196, 102, 11,
-			246, 65, 135, 140, 184, 227, 155, 56, 149, 157, 81, 222, 117, 16,
-			219, 193, 239, 123, 52, 104, 1, 168, 228, 93, 7, 38, 180, 255,
-			160, 163, 228, 93, 167, 112, 62, 236, 212, 125, 92, 113, 224, 78,
-			75, 176, 15, 59, 201, 62, 52, 232, 116, 194, 246, 189, 11, 56,
-			240, 158, 87, 40, 239, 34, 15, 37, 45, 149, 58, 113, 11, 223,
-			165, 199, 223, 137, 91, 248, 46, 205, 131, 59, 113, 11, 223, 5,
-			60, 120, 23, 34, 182, 4, 251, 24, 80, 251, 252, 205, 100, 94,
-			45, 12, 192, 133, 63, 166, 185, 112, 39, 238, 216, 143, 105, 46,
-			220, 137, 59, 246, 99, 142, 188, 139, 101, 157, 176, 99, 239, 118,
-			200, 176, 59, 92, 193, 133, 107, 102, 156, 65, 140, 6, 11, 172,
-			132, 187, 53, 235, 237, 68, 214, 123, 183, 211, 208, 163, 65, 11,
-			192, 222, 243, 52, 72, 1, 28, 28, 66, 82, 119, 9, 231, 47,
-			156, 186, 7, 20, 169, 187, 44, 193, 254, 2, 72, 125, 132, 51,
-			214, 5, 164, 190, 7, 72, 189, 247, 21, 10, 187, 200, 97, 43,
-			18, 72, 93, 72, 236, 123, 52, 177, 187, 144, 216, 247, 104, 98,
-			119, 33, 177, 239, 1, 98, 95, 132, 168, 45, 193, 238, 3, 98,
-			15, 110, 38, 240, 106, 227, 0, 114, 223, 167, 201, 221, 133, 228,
-			190, 79, 147, 187, 11, 201, 125, 31, 144, 251, 56, 226, 32, 130,
-			125, 18, 200, 189, 223, 164, 206, 48, 178, 78, 37, 94, 175, 44,
-			233, 21, 22, 101, 218, 137, 237, 58, 229, 132, 95, 140, 135, 239,
-			65, 139, 12, 155, 52, 160, 3, 160, 154, 135, 46, 156, 135, 79,
-			234, 121, 232, 194, 121, 248, 164, 158, 135, 180, 112, 30, 116, 234,
-			62, 167, 230, 33, 109, 9, 246, 160, 147, 236, 65, 165, 35, 13,
-			243, 240, 208, 43, 82, 58, 240, 200, 25, 203, 160, 163, 58, 152,
-			198, 57, 120, 72, 207, 65, 26, 231, 224, 33, 61, 7, 105, 156,
-			131, 135, 180, 210, 145, 134, 238, 62, 92, 67, 233, 208, 71, 218,
-			90, 237, 3, 253, 31, 214, 244, 79, 35, 253, 31, 214, 244, 79,
-			35, 253, 31, 6, 250, 207, 98, 251, 68, 176, 71, 28, 210, 227,
-			30, 242, 140, 115, 236, 73, 56, 248, 33, 130, 149, 156, 244, 251,
-			53, 133, 68, 194, 200, 112, 167, 123, 16, 79, 0, 97, 186, 0,
-			115, 240, 136, 158, 131, 52, 10, 172, 71, 28, 229, 34, 147, 198,
-			57, 120, 196, 17, 157, 26, 164, 0, 118, 187, 124, 26, 123, 68,
-			5, 251, 172, 67, 186, 220, 75, 99, 198, 98, 93, 30, 185, 166,
-			169, 56, 18, 2, 32, 39, 101, 110, 113, 217, 50, 48, 242, 207,
-			106, 81, 157, 70, 70, 254, 89, 39, 169, 187, 65, 17, 83, 170,
-			19, 85, 146, 52, 116, 249, 49, 160, 244, 197, 175, 218, 86, 44,
-			155, 5, 126, 254, 88, 52, 189, 192, 207, 31, 115, 148, 37, 56,
-			141, 252, 252, 49, 71, 89, 130, 187, 133, 243, 121, 167, 238, 9,
-			181, 224, 186, 45, 193, 62, 239, 36, 123, 249, 213, 156, 177, 110,
-			88, 112, 143, 195, 206, 56, 236, 29, 84, 9, 248, 226, 233, 154,
-			98, 142, 156, 131, 154, 233, 207, 149, 75, 131, 27, 178, 99, 68,
-			201, 49, 160, 3, 221, 104, 83, 120, 92, 79, 77, 55, 218, 20,
-			30, 215, 219, 163, 27, 23, 227, 227, 122, 123, 116, 227, 98, 124,
-			28, 182, 7, 26, 131, 187, 97, 226, 190, 236, 156, 157, 49, 184,
-			50, 59, 203, 25, 239, 194, 252, 194, 43, 52, 6, 119, 163, 18,
-			246, 101, 77, 228, 110, 92, 227, 95, 118, 148, 49, 184, 27, 215,
-			248, 151, 29, 117, 29, 230, 10, 231, 73, 167, 238, 235, 231, 74,
-			177, 117, 45, 193, 158, 4, 118, 13, 10, 159, 11, 179, 246, 53,
-			96, 19, 83, 27, 217, 132, 185, 149, 223, 32, 28, 229, 0, 195,
-			60, 186, 179, 70, 78, 237, 106, 180, 46, 114, 140, 175, 233, 209,
-			186, 56, 73, 95, 211, 28, 195, 197, 73, 250, 26, 112, 140, 163,
-			216, 3, 75, 176, 167, 160, 7, 251, 176, 7, 85, 185, 60, 228,
-			12, 132, 249, 83, 129, 244, 189, 168, 170, 223, 30, 203, 145, 101,
-			144, 3, 169, 159, 138, 144, 91, 216, 190, 65, 14, 164, 126, 10,
-			144, 3, 169, 123, 132, 243, 29, 167, 238, 187, 231, 138, 212, 61,
-			150, 96, 223, 209, 74, 72, 15, 144, 250, 233, 87, 164, 132, 156,
-			246, 101, 70, 175, 165, 160, 140, 89, 48, 12, 133, 123, 144, 194,
-			79, 235, 65, 246, 32, 133, 159, 214, 131, 236, 65, 10, 63, 173,
-			149, 144, 30, 160, 240, 51, 181, 149, 144, 51, 96, 0, 174, 252,
-			140, 230, 202, 61, 72, 198, 103, 52, 87, 238, 65, 50, 62, 3,
-			92, 249, 191, 91, 156, 176, 94, 225, 60, 235, 212, 253, 171, 99,
-			185, 243, 222, 113, 29, 159, 27, 197, 188, 160, 17, 71, 37, 118,
-			144, 119, 23, 103, 72, 158, 137, 183, 136, 220, 91, 13, 138, 50,
-			68, 19, 203, 119, 199, 83, 250, 156, 155, 185, 234, 181, 4, 123,
-			22, 120, 109, 51, 103, 172, 151, 213, 9, 231, 57, 135, 252, 179,
-			35, 189, 165, 123, 49, 6, 237, 57, 39, 209, 136, 222, 210, 189,
-			50, 10, 237, 251, 14, 115, 209, 23, 186, 87, 197, 145, 125, 223,
-			97, 29, 209, 3, 2, 15, 210, 221, 230, 11, 75, 176, 31, 196,
-			191, 176, 228, 131, 134, 232, 1, 129, 7, 177, 47, 136, 96, 63,
-			140, 127, 129, 86, 37, 135, 53, 69, 15, 240, 141, 216, 23, 84,
-			176, 31, 197, 191, 0, 9, 242, 35, 135, 241, 232, 1, 129, 7,
-			233, 110, 188, 189, 235, 133, 81, 60, 239, 144, 86, 247, 160, 55,
-			173, 175, 146, 43, 11, 211, 75, 209, 161, 102, 84, 219, 223, 23,
-			114, 171, 217, 57, 153, 174, 97, 14, 51, 5, 172, 239, 62, 181,
-			51, 35, 23, 78, 47, 46, 205, 231, 245, 210, 236, 69, 194, 60,
-			239, 212, 55, 104, 144, 2, 216, 220, 130, 54, 183, 94, 24, 209,
-			11, 112, 196, 29, 193, 165, 105, 86, 137, 138, 122, 48, 120, 227,
-			110, 87, 99, 26, 17, 172, 208, 23, 180, 180, 236, 69, 122, 190,
-			160, 15, 182, 189, 184, 66, 95, 128, 131, 237, 21, 136, 136, 8,
-			246, 83, 56, 136, 236, 137, 14, 34, 166, 125, 111, 49, 87, 200,
-			149, 224, 20, 162, 75, 198, 15, 85, 248, 73, 162, 148, 54, 34,
-			169, 23, 85, 180, 159, 234, 83, 73, 47, 78, 203, 79, 245, 169,
-			164, 23, 213, 131, 159, 194, 169, 100, 47, 34, 166, 130, 253, 220,
-			33, 231, 185, 59, 208, 206, 60, 115, 245, 129, 3, 83, 83, 218,
-			167, 10, 107, 131, 96, 17, 187, 74, 82, 71, 23, 181, 189, 104,
-			242, 251, 185, 67, 210, 26, 180, 0, 236, 238, 215, 32, 54, 159,
-			217, 202, 47, 68, 100, 76, 176, 151, 28, 210, 238, 94, 128, 200,
-			100, 244, 190, 198, 132, 244, 43, 22, 225, 132, 32, 179, 148, 25,
-			20, 160, 1, 188, 20, 205, 24, 104, 0, 47, 57, 202, 74, 218,
-			139, 26, 192, 75, 78, 155, 64, 13, 160, 79, 56, 255, 230, 212,
-			221, 158, 144, 26, 64, 159, 37, 216, 191, 57, 201, 126, 212, 141,
-			251, 48, 154, 43, 65, 58, 220, 253, 175, 134, 193, 201, 223, 22,
-			67, 224, 233, 170, 103, 125, 50, 46, 43, 161, 122, 214, 39, 227,
-			178, 18, 138, 205, 245, 201, 184, 172, 68, 123, 138, 95, 130, 232,
-			45, 193, 110, 76, 144, 110, 119, 251, 25, 217, 92, 77, 60, 176,
-			148, 110, 76, 40, 102, 215, 135, 75, 233, 198, 132, 98, 118, 125,
-			184, 148, 110, 76, 116, 165, 249, 36, 226, 33, 130, 221, 4, 120,
-			118, 193, 82, 82, 69, 25, 3, 163, 87, 105, 195, 189, 246, 99,
-			207, 20, 131, 197, 82, 70, 250, 0, 26, 124, 160, 96, 222, 148,
-			80, 110, 235, 125, 50, 162, 44, 145, 208, 248, 96, 205, 220, 20,
-			225, 163, 130, 221, 252, 10, 240, 149, 253, 165, 13, 248, 64, 177,
-			188, 57, 194, 7, 139, 232, 230, 8, 31, 69, 12, 234, 22, 178,
-			15, 203, 107, 38, 72, 191, 123, 201, 217, 225, 139, 18, 50, 84,
-			99, 133, 117, 117, 107, 132, 21, 11, 78, 38, 18, 174, 6, 41,
-			128, 125, 91, 208, 3, 191, 15, 40, 242, 39, 9, 210, 231, 190,
-			254, 236, 176, 106, 197, 178, 26, 167, 45, 219, 209, 56, 109, 11,
-			192, 68, 90, 131, 20, 192, 158, 94, 92, 203, 91, 132, 243, 174,
-			68, 221, 199, 213, 90, 222, 98, 9, 246, 174, 68, 210, 195, 109,
-			187, 5, 214, 242, 123, 18, 36, 237, 238, 168, 90, 76, 186, 208,
-			153, 50, 87, 40, 215, 206, 197, 92, 94, 86, 138, 85, 253, 216,
-			130, 122, 234, 123, 18, 196, 128, 22, 128, 202, 135, 97, 11, 174,
-			220, 247, 36, 58, 187, 248, 29, 22, 98, 179, 4, 187, 3, 176,
-			221, 98, 201, 74, 32, 241, 108, 73, 241, 77, 115, 246, 119, 116,
-			60, 202, 227, 127, 92, 250, 156, 170, 122, 50, 149, 201, 16, 150,
-			253, 82, 148, 3, 9, 239, 27, 47, 195, 56, 12, 51, 14, 139,
-			97, 215, 12, 232, 0, 168, 12, 180, 91, 112, 163, 220, 145, 104,
-			209, 195, 130, 141, 114, 7, 12, 235, 1, 57, 44, 34, 216, 157,
-			48, 172, 187, 44, 149, 16, 191, 234, 158, 172, 214, 176, 244, 109,
-			200, 201, 96, 93, 14, 193, 164, 92, 47, 112, 243, 123, 110, 37,
-			40, 149, 253, 149, 85, 111, 168, 230, 201, 225, 236, 135, 140, 214,
-			234, 170, 33, 195, 6, 184, 51, 26, 50, 112, 251, 59, 163, 33,
-			163, 55, 87, 52, 100, 216, 157, 119, 194, 144, 63, 40, 135, 76,
-			5, 251, 72, 130, 156, 231, 190, 203, 242, 102, 34, 105, 22, 108,
-			154, 204, 170, 210, 155, 90, 165, 181, 170, 162, 71, 174, 200, 85,
-			90, 252, 179, 30, 87, 12, 93, 213, 232, 40, 195, 30, 26, 208,
-			1, 176, 33, 165, 65, 11, 192, 142, 126, 13, 226, 112, 50, 91,
-			121, 1, 7, 199, 4, 187, 59, 65, 134, 221, 107, 162, 195, 247,
-			102, 83, 250, 138, 122, 107, 78, 137, 85, 125, 101, 18, 161, 1,
-			29, 0, 213, 217, 111, 11, 242, 147, 187, 19, 234, 236, 183, 5,
-			249, 201, 221, 137, 193, 33, 212, 236, 251, 133, 115, 111, 162, 238,
-			191, 37, 206, 145, 102, 223, 111, 9, 118, 111, 34, 217, 199, 87,
-			57, 99, 253, 192, 44, 30, 0, 193, 55, 31, 229, 140, 169, 204,
-			117, 85, 45, 132, 80, 0, 161, 15, 118, 85, 32, 163, 55, 152,
-			43, 44, 22, 253, 241, 114, 24, 230, 75, 227, 160, 104, 141, 231,
-			115, 133, 181, 107, 71, 253, 149, 236, 235, 46, 28, 84, 148, 233,
-			71, 193, 248, 128, 22, 140, 253, 200, 94, 30, 208, 130, 177, 31,
-			217, 203, 3, 32, 24, 247, 99, 247, 44, 193, 30, 4, 1, 114,
-			161, 44, 87, 44, 123, 246, 178, 242, 81, 119, 79, 54, 9, 27,
-			255, 65, 45, 33, 251, 113, 227, 63, 168, 37, 100, 63, 110, 252,
-			7, 65, 130, 0, 95, 245, 132, 243, 112, 162, 238, 187, 138, 175,
-			122, 150, 96, 15, 39, 146, 91, 144, 175, 122, 64, 170, 71, 94,
-			61, 95, 245, 144, 175, 62, 162, 151, 132, 135, 3, 127, 68, 243,
-			85, 15, 7, 254, 8, 236, 198, 143, 81, 196, 102, 9, 246, 197,
-			4, 233, 113, 239, 160, 192, 87, 43, 227, 38, 162, 212, 86, 138,
-			34, 82, 149, 221, 24, 65, 129, 61, 197, 124, 206, 126, 126, 41,
-			244, 100, 238, 232, 252, 105, 127, 189, 36, 175, 124, 84, 100, 35,
-			254, 136, 6, 123, 244, 108, 148, 14, 232, 186, 224, 163, 68, 97,
-			10, 73, 152, 12, 133, 7, 240, 185, 41, 47, 41, 235, 254, 73,
-			47, 204, 66, 22, 111, 123, 12, 74, 93, 45, 126, 221, 203, 134,
-			24, 78, 90, 90, 91, 93, 13, 139, 152, 12, 143, 199, 47, 29,
-			100, 193, 194, 172, 46, 231, 164, 95, 243, 181, 39, 37, 52, 57,
-			172, 242, 202, 40, 188, 171, 185, 133, 147, 216, 196, 124, 80, 42,
-			115, 137, 20, 81, 233, 143, 209, 142, 164, 84, 254, 98, 176, 56,
-			135, 181, 59, 130, 146, 50, 169, 21, 178, 48, 112, 172, 6, 27,
-			22, 189, 83, 126, 62, 151, 53, 101, 13, 49, 57, 55, 234, 243,
-			56, 167, 218, 39, 53, 191, 238, 29, 250, 157, 105, 51, 173, 176,
-			188, 190, 168, 151, 151, 135, 203, 235, 139, 137, 198, 78, 13, 82,
-			0, 187, 93, 60, 181, 120, 32, 86, 158, 72, 144, 45, 238, 65,
-			111, 38, 74, 17, 89, 45, 50, 207, 122, 122, 37, 10, 224, 242,
-			79, 68, 29, 64, 55, 161, 68, 99, 183, 6, 41, 128, 189, 125,
-			120, 160, 246, 0, 250, 10, 44, 226, 243, 189, 153, 220, 117, 134,
-			197, 111, 192, 163, 60, 53, 84, 27, 160, 131, 125, 37, 161, 142,
-			43, 30, 114, 218, 175, 36, 146, 122, 229, 82, 108, 179, 179, 139,
-			63, 105, 33, 10, 38, 216, 215, 97, 207, 126, 206, 242, 102, 46,
-			159, 220, 233, 101, 115, 75, 65, 169, 92, 27, 213, 144, 95, 194,
-			75, 41, 105, 51, 25, 54, 217, 4, 116, 6, 255, 48, 175, 137,
-			96, 106, 62, 35, 95, 198, 101, 5, 51, 54, 239, 47, 156, 60,
-			237, 23, 179, 88, 39, 204, 47, 203, 120, 150, 117, 174, 139, 143,
-			98, 174, 133, 224, 116, 80, 52, 173, 96, 34, 133, 83, 65, 49,
-			183, 40, 139, 197, 132, 139, 181, 22, 135, 74, 163, 102, 40, 0,
-			250, 224, 215, 53, 211, 242, 144, 127, 127, 61, 161, 110, 142, 60,
-			228, 223, 95, 7, 30, 242, 28, 65, 10, 216, 130, 61, 147, 32,
-			35, 238, 183, 137, 119, 64, 91, 162, 96, 23, 6, 165, 218, 84,
-			136, 50, 81, 171, 82, 147, 147, 71, 142, 224, 74, 46, 233, 85,
-			172, 170, 223, 199, 182, 138, 202, 19, 129, 78, 109, 177, 22, 13,
-			215, 142, 149, 254, 212, 140, 96, 65, 85, 209, 83, 185, 214, 36,
-			227, 84, 164, 216, 216, 41, 100, 27, 47, 183, 201, 48, 133, 30,
-			48, 66, 131, 68, 110, 171, 178, 151, 15, 124, 12, 232, 64, 165,
-			183, 228, 13, 70, 68, 30, 244, 114, 229, 82, 144, 95, 196, 9,
-			197, 21, 130, 168, 134, 244, 37, 52, 247, 242, 193, 146, 191, 176,
-			62, 87, 90, 246, 119, 202, 221, 46, 109, 176, 176, 1, 1, 97,
-			220, 173, 205, 35, 54, 67, 106, 27, 208, 1, 176, 65, 79, 13,
-			104, 209, 207, 36, 58, 6, 53, 72, 1, 220, 182, 29, 37, 237,
-			128, 112, 254, 41, 81, 119, 67, 242, 28, 73, 218, 1, 75, 176,
-			127, 74, 36, 47, 224, 191, 205, 25, 27, 0, 241, 241, 108, 130,
-			164, 220, 43, 42, 142, 152, 166, 4, 121, 181, 213, 178, 70, 190,
-			66, 153, 165, 78, 171, 30, 154, 2, 3, 40, 81, 159, 213, 139,
-			115, 0, 5, 203, 179, 9, 21, 58, 61, 128, 130, 229, 217, 132,
-			104, 231, 127, 77, 177, 31, 150, 96, 47, 194, 25, 233, 83, 212,
-			155, 94, 148, 85, 131, 70, 98, 238, 244, 193, 181, 254, 66, 25,
-			61, 237, 253, 114, 172, 138, 119, 136, 98, 62, 39, 171, 16, 78,
-			201, 127, 163, 132, 80, 50, 162, 86, 213, 152, 247, 148, 245, 203,
-			47, 121, 153, 61, 114, 124, 123, 199, 247, 156, 242, 139, 57, 191,
-			80, 222, 139, 233, 79, 246, 251, 114, 147, 111, 44, 83, 88, 128,
-			150, 151, 128, 20, 167, 130, 130, 138, 10, 184, 70, 125, 91, 186,
-			198, 148, 254, 57, 173, 60, 204, 164, 69, 187, 176, 182, 162, 227,
-			173, 194, 69, 111, 53, 239, 151, 161, 11, 37, 149, 58, 235, 247,
-			50, 49, 61, 36, 51, 226, 101, 86, 252, 5, 3, 140, 141, 141,
-			253, 254, 176, 42, 252, 136, 150, 113, 105, 109, 64, 69, 8, 120,
-			75, 80, 200, 202, 113, 105, 178, 87, 150, 113, 151, 161, 230, 121,
-			181, 17, 100, 84, 154, 174, 52, 162, 19, 189, 170, 228, 171, 69,
-			105, 125, 46, 132, 85, 9, 40, 43, 2, 211, 99, 122, 38, 199,
-			180, 129, 42, 83, 100, 85, 193, 73, 152, 70, 134, 243, 104, 64,
-			27, 64, 117, 239, 51, 128, 82, 232, 197, 132, 10, 141, 30, 64,
-			41, 244, 34, 28, 88, 95, 34, 184, 6, 136, 96, 215, 39, 73,
-			135, 251, 125, 82, 177, 24, 181, 5, 27, 239, 5, 229, 112, 207,
-			194, 118, 237, 77, 198, 210, 95, 196, 137, 99, 188, 74, 174, 145,
-			195, 187, 198, 219, 30, 155, 75, 69, 244, 109, 126, 62, 191, 45,
-			250, 38, 239, 35, 167, 201, 149, 116, 103, 42, 104, 178, 25, 73,
-			160, 33, 20, 25, 113, 255, 242, 205, 26, 203, 21, 207, 148, 211,
-			146, 71, 149, 7, 206, 144, 214, 82, 37, 23, 132, 133, 129, 126,
-			150, 27, 83, 104, 150, 130, 50, 102, 80, 220, 56, 115, 32, 30,
-			174, 79, 154, 237, 10, 27, 242, 250, 164, 82, 128, 7, 80, 94,
-			95, 159, 84, 190, 176, 25, 225, 188, 45, 89, 247, 206, 164, 212,
-			71, 51, 150, 96, 111, 75, 38, 7, 209, 208, 155, 161, 117, 194,
-			185, 41, 73, 110, 79, 74, 67, 111, 6, 211, 98, 220, 148, 228,
-			93, 252, 18, 238, 0, 8, 28, 231, 237, 73, 214, 165, 98, 157,
-			35, 163, 150, 138, 183, 5, 17, 81, 153, 36, 85, 26, 91, 241,
-			83, 27, 191, 141, 61, 176, 224, 65, 131, 136, 30, 80, 120, 208,
-			209, 201, 119, 43, 108, 150, 96, 55, 39, 217, 64, 102, 27, 106,
-			120, 199, 174, 196, 237, 45, 83, 152, 198, 42, 116, 74, 243, 168,
-			236, 74, 212, 58, 232, 80, 55, 39, 149, 9, 58, 163, 210, 47,
-			221, 156, 236, 236, 141, 30, 80, 120, 208, 239, 97, 206, 42, 120,
-			64, 4, 187, 37, 201, 122, 50, 253, 218, 216, 2, 11, 116, 99,
-			138, 186, 8, 7, 168, 73, 183, 36, 149, 9, 26, 31, 88, 240,
-			160, 185, 51, 122, 64, 225, 65, 183, 107, 112, 80, 193, 110, 5,
-			2, 246, 87, 169, 101, 241, 112, 229, 3, 147, 51, 17, 14, 56,
-			146, 222, 26, 199, 1, 170, 210, 173, 201, 230, 136, 108, 20, 219,
-			236, 232, 228, 59, 20, 14, 38, 216, 109, 73, 150, 202, 120, 216,
-			90, 60, 14, 187, 18, 95, 132, 4, 116, 145, 219, 226, 115, 3,
-			218, 200, 109, 201, 134, 150, 232, 1, 133, 7, 162, 157, 111, 85,
-			72, 108, 193, 254, 36, 201, 218, 51, 237, 88, 239, 165, 4, 154,
-			159, 86, 242, 162, 102, 108, 249, 86, 125, 244, 192, 130, 7, 188,
-			57, 122, 64, 225, 1, 218, 83, 25, 203, 192, 250, 122, 71, 146,
-			108, 149, 203, 15, 111, 59, 223, 145, 84, 12, 41, 131, 167, 157,
-			119, 36, 27, 210, 26, 180, 0, 236, 222, 162, 65, 10, 224, 64,
-			102, 222, 193, 106, 224, 187, 248, 167, 250, 249, 196, 82, 56, 182,
-			176, 92, 12, 87, 114, 107, 43, 99, 97, 113, 105, 60, 191, 182,
-			144, 147, 7, 73, 127, 85, 253, 115, 106, 231, 56, 230, 146, 42,
-			141, 225, 119, 130, 193, 83, 119, 199, 217, 125, 89, 12, 86, 67,
-			249, 157, 219, 47, 55, 236, 56, 66, 243, 107, 139, 227, 198, 46,
-			35, 95, 200, 124, 142, 114, 123, 10, 48, 137, 173, 156, 157, 204,
-			21, 178, 105, 203, 179, 134, 154, 39, 90, 198, 160, 181, 49, 252,
-			233, 202, 92, 33, 123, 2, 127, 20, 173, 156, 158, 94, 14, 211,
-			196, 179, 134, 234, 79, 192, 191, 98, 140, 51, 56, 76, 165, 169,
-			103, 13, 53, 76, 184, 99, 138, 67, 104, 132, 99, 179, 26, 225,
-			9, 124, 79, 164, 121, 66, 173, 221, 52, 195, 86, 52, 40, 92,
-			158, 212, 171, 57, 109, 227, 79, 6, 6, 188, 197, 96, 49, 237,
-			72, 188, 197, 96, 17, 158, 148, 253, 165, 116, 66, 62, 41, 251,
-			75, 226, 82, 222, 184, 84, 244, 11, 229, 32, 59, 87, 12, 243,
-			65, 58, 233, 209, 161, 134, 137, 180, 28, 72, 85, 26, 168, 201,
-			3, 71, 78, 52, 168, 183, 79, 132, 249, 0, 62, 46, 6, 167,
-			194, 147, 250, 227, 250, 151, 251, 88, 189, 141, 31, 119, 112, 103,
-			37, 59, 119, 50, 88, 79, 115, 236, 142, 189, 146, 189, 50, 88,
-			23, 221, 60, 185, 146, 157, 67, 167, 146, 116, 131, 28, 235, 74,
-			22, 111, 185, 197, 5, 188, 101, 37, 59, 167, 92, 246, 230, 202,
-			235, 171, 65, 186, 17, 223, 104, 90, 201, 30, 144, 79, 103, 215,
-			87, 3, 113, 62, 111, 94, 201, 206, 197, 220, 85, 210, 77, 250,
-			181, 152, 231, 221, 182, 31, 17, 94, 111, 166, 74, 184, 188, 115,
-			234, 183, 166, 142, 206, 206, 93, 57, 125, 244, 224, 220, 213, 71,
-			103, 142, 79, 29, 152, 62, 52, 61, 117, 176, 181, 78, 116, 114,
-			113, 252, 196, 212, 161, 233, 223, 158, 155, 60, 112, 100, 238, 192,
-			229, 147, 71, 15, 79, 29, 108, 205, 138, 20, 111, 57, 62, 121,
-			224, 202, 201, 195, 83, 115, 7, 78, 76, 77, 206, 78, 29, 108,
-			125, 220, 138, 63, 61, 56, 117, 100, 10, 158, 126, 209, 18, 237,
-			188, 89, 63, 189, 124, 250, 224, 193, 169, 163, 173, 95, 178, 68,
-			7, 111, 213, 15, 175, 62, 170, 30, 127, 25, 31, 79, 31, 157,
-			153, 157, 60, 122, 32, 106, 248, 19, 164, 226, 177, 110, 249, 47,
-			42, 31, 159, 152, 58, 52, 55, 51, 53, 219, 250, 255, 19, 209,
-			197, 69, 197, 227, 171, 143, 194, 15, 247, 16, 225, 242, 14, 243,
-			195, 236, 228, 225, 185, 201, 217, 217, 201, 3, 151, 79, 29, 108,
-			189, 119, 227, 111, 7, 167, 212, 111, 247, 17, 209, 207, 93, 243,
-			219, 85, 83, 179, 147, 7, 39, 103, 39, 163, 143, 239, 223, 228,
-			5, 211, 194, 39, 201, 254, 237, 191, 59, 124, 86, 155, 241, 82,
-			127, 53, 119, 197, 67, 93, 220, 17, 172, 185, 238, 152, 197, 255,
-			138, 113, 171, 81, 208, 230, 58, 49, 241, 16, 243, 14, 132, 171,
-			235, 197, 220, 210, 114, 217, 155, 216, 177, 83, 122, 122, 99, 174,
-			237, 201, 181, 242, 114, 88, 68, 165, 227, 72, 110, 33, 40, 128,
-			22, 25, 41, 81, 147, 171, 254, 2, 188, 41, 127, 25, 241, 180,
-			186, 50, 49, 182, 67, 250, 106, 100, 212, 79, 153, 225, 75, 185,
-			183, 30, 174, 97, 153, 75, 144, 86, 107, 165, 64, 31, 244, 243,
-			192, 120, 23, 130, 85, 172, 41, 128, 197, 167, 115, 145, 153, 167,
-			28, 53, 63, 198, 189, 223, 81, 45, 132, 243, 152, 130, 203, 247,
-			22, 194, 213, 117, 205, 189, 213, 107, 158, 95, 230, 42, 179, 243,
-			114, 185, 188, 186, 123, 124, 252, 244, 233, 211, 99, 62, 246, 84,
-			82, 71, 190, 87, 26, 63, 50, 125, 96, 234, 232, 204, 212, 232,
-			196, 216, 14, 153, 74, 60, 40, 149, 60, 29, 191, 139, 105, 115,
-			87, 177, 206, 216, 124, 62, 240, 242, 254, 105, 204, 173, 185, 84,
-			12, 164, 88, 205, 21, 48, 69, 106, 174, 176, 52, 226, 149, 194,
-			197, 242, 105, 172, 114, 153, 205, 193, 185, 122, 126, 173, 92, 65,
-			38, 221, 179, 92, 169, 226, 5, 121, 89, 157, 153, 156, 241, 166,
-			103, 50, 222, 254, 201, 153, 233, 153, 17, 238, 189, 105, 122, 246,
-			242, 99, 87, 207, 122, 111, 154, 60, 113, 98, 242, 232, 236, 244,
-			212, 140, 119, 236, 132, 103, 82, 106, 207, 120, 199, 14, 121, 147,
-			71, 127, 199, 131, 45, 53, 162, 19, 128, 6, 215, 194, 57, 29,
-			211, 127, 230, 128, 128, 152, 88, 121, 70, 93, 132, 106, 244, 58,
-			255, 177, 58, 122, 44, 120, 121, 191, 176, 180, 6, 178, 123, 41,
-			60, 21, 20, 241, 50, 115, 53, 40, 174, 228, 74, 48, 137, 120,
-			252, 231, 241, 104, 225, 141, 35, 26, 227, 60, 201, 45, 34, 104,
-			107, 93, 19, 252, 151, 20, 84, 212, 237, 227, 245, 156, 36, 27,
-			204, 191, 180, 78, 208, 84, 221, 110, 252, 215, 18, 180, 163, 110,
-			152, 115, 204, 140, 204, 210, 117, 163, 22, 231, 50, 113, 48, 77,
-			219, 205, 188, 65, 229, 13, 166, 221, 164, 143, 55, 234, 180, 193,
-			180, 155, 164, 53, 68, 4, 237, 238, 233, 229, 151, 168, 156, 193,
-			180, 151, 244, 184, 35, 58, 33, 164, 20, 84, 187, 171, 203, 148,
-			202, 188, 220, 58, 95, 88, 163, 206, 231, 75, 123, 73, 74, 67,
-			68, 208, 222, 46, 23, 83, 203, 128, 162, 67, 251, 241, 10, 77,
-			71, 60, 109, 108, 55, 138, 191, 26, 138, 229, 121, 55, 173, 67,
-			207, 250, 73, 155, 134, 160, 193, 142, 180, 26, 29, 21, 212, 35,
-			221, 234, 39, 32, 136, 103, 94, 164, 68, 80, 207, 188, 200, 4,
-			29, 48, 47, 50, 11, 160, 86, 13, 17, 65, 7, 204, 139, 182,
-			160, 25, 243, 162, 109, 1, 36, 52, 68, 4, 205, 116, 164, 249,
-			229, 248, 162, 35, 232, 249, 100, 171, 123, 105, 148, 210, 166, 246,
-			200, 54, 205, 118, 111, 6, 232, 88, 208, 148, 198, 226, 16, 65,
-			207, 239, 207, 168, 238, 36, 4, 189, 128, 108, 85, 63, 37, 44,
-			128, 244, 139, 9, 34, 232, 5, 230, 197, 164, 160, 131, 230, 197,
-			164, 5, 144, 126, 49, 73, 4, 29, 52, 47, 214, 11, 58, 100,
-			94, 172, 183, 0, 210, 51, 87, 79, 4, 29, 50, 47, 114, 65,
-			135, 205, 139, 220, 2, 168, 75, 67, 68, 208, 97, 243, 98, 131,
-			160, 219, 204, 139, 13, 22, 64, 250, 197, 6, 34, 232, 54, 243,
-			98, 163, 160, 219, 205, 139, 141, 22, 64, 189, 26, 34, 130, 110,
-			55, 47, 54, 9, 58, 98, 94, 108, 178, 0, 210, 47, 54, 17,
-			65, 71, 250, 51, 252, 79, 9, 166, 43, 102, 175, 175, 59, 102,
-			185, 127, 66, 60, 148, 147, 178, 206, 182, 172, 80, 236, 149, 202,
-			197, 181, 133, 242, 26, 176, 31, 153, 192, 51, 31, 46, 41, 111,
-			117, 153, 39, 9, 149, 200, 176, 188, 236, 237, 207, 45, 189, 17,
-			195, 246, 135, 48, 17, 120, 118, 57, 92, 192, 216, 249, 92, 80,
-			26, 70, 51, 15, 156, 165, 252, 178, 95, 42, 135, 197, 64, 190,
-			84, 90, 14, 79, 163, 177, 7, 88, 87, 48, 239, 93, 61, 173,
-			124, 52, 48, 131, 161, 186, 22, 83, 23, 194, 129, 15, 189, 144,
-			233, 3, 76, 43, 186, 118, 111, 100, 54, 41, 5, 197, 156, 159,
-			207, 93, 23, 100, 213, 96, 252, 146, 151, 43, 169, 124, 209, 17,
-			119, 46, 201, 252, 56, 92, 213, 62, 148, 214, 122, 96, 85, 133,
-			108, 112, 173, 244, 95, 224, 50, 119, 51, 125, 125, 178, 9, 8,
-			138, 78, 51, 244, 98, 210, 1, 36, 100, 152, 95, 144, 94, 76,
-			26, 52, 100, 9, 122, 113, 99, 171, 134, 168, 160, 23, 183, 167,
-			48, 199, 18, 242, 132, 75, 72, 91, 102, 24, 253, 128, 42, 243,
-			124, 106, 151, 148, 88, 130, 227, 189, 25, 174, 90, 177, 108, 248,
-			48, 169, 33, 104, 166, 190, 81, 67, 84, 208, 75, 90, 90, 249,
-			187, 45, 68, 65, 4, 189, 140, 156, 239, 222, 136, 153, 202, 243,
-			210, 32, 152, 83, 121, 221, 241, 76, 12, 99, 147, 181, 50, 87,
-			114, 249, 124, 174, 20, 44, 132, 210, 146, 186, 144, 67, 206, 58,
-			230, 29, 245, 11, 161, 124, 92, 146, 229, 145, 253, 121, 29, 132,
-			47, 13, 111, 114, 99, 122, 193, 74, 174, 172, 115, 184, 235, 34,
-			155, 229, 162, 95, 40, 249, 11, 202, 102, 46, 123, 8, 91, 251,
-			50, 210, 163, 33, 75, 208, 203, 122, 61, 13, 81, 65, 47, 219,
-			122, 30, 186, 244, 35, 251, 121, 3, 233, 200, 76, 196, 88, 88,
-			65, 213, 146, 142, 74, 182, 194, 0, 148, 114, 182, 77, 117, 101,
-			88, 163, 162, 54, 180, 160, 9, 5, 12, 236, 13, 245, 122, 42,
-			40, 180, 222, 158, 226, 35, 136, 138, 9, 186, 143, 116, 102, 250,
-			43, 18, 101, 77, 31, 68, 242, 24, 157, 102, 155, 110, 151, 217,
-			240, 186, 110, 23, 248, 221, 190, 250, 54, 13, 81, 65, 247, 165,
-			58, 84, 187, 182, 160, 147, 164, 13, 218, 53, 37, 183, 42, 219,
-			4, 205, 204, 180, 107, 227, 235, 186, 93, 96, 143, 147, 102, 98,
-			109, 42, 232, 100, 75, 43, 122, 199, 35, 123, 220, 79, 218, 50,
-			219, 84, 29, 233, 161, 92, 193, 27, 60, 185, 251, 212, 32, 154,
-			215, 134, 43, 81, 128, 30, 103, 80, 56, 54, 124, 169, 81, 0,
-			111, 220, 111, 80, 56, 84, 208, 253, 45, 173, 24, 201, 139, 188,
-			241, 16, 185, 112, 226, 124, 111, 178, 32, 83, 61, 231, 22, 23,
-			189, 24, 181, 99, 170, 176, 153, 219, 4, 131, 143, 12, 228, 8,
-			122, 168, 33, 163, 33, 75, 208, 67, 91, 199, 53, 68, 5, 61,
-			52, 177, 75, 109, 160, 164, 160, 135, 201, 133, 234, 167, 36, 3,
-			72, 55, 146, 116, 4, 61, 108, 26, 1, 214, 123, 216, 52, 146,
-			164, 130, 30, 158, 216, 133, 30, 250, 200, 122, 175, 36, 29, 153,
-			86, 207, 143, 220, 115, 79, 6, 235, 124, 98, 212, 59, 36, 55,
-			178, 41, 207, 189, 81, 77, 213, 107, 199, 12, 165, 222, 134, 230,
-			52, 161, 128, 147, 95, 89, 223, 162, 33, 42, 232, 149, 66, 102,
-			21, 66, 78, 126, 132, 116, 101, 46, 139, 227, 213, 161, 67, 38,
-			126, 198, 108, 182, 224, 90, 105, 178, 175, 8, 61, 42, 105, 172,
-			220, 134, 198, 52, 86, 16, 11, 71, 204, 202, 226, 84, 208, 35,
-			169, 78, 126, 1, 98, 109, 16, 244, 42, 178, 37, 211, 29, 199,
-			26, 111, 82, 183, 216, 96, 195, 139, 186, 69, 144, 31, 87, 213,
-			167, 53, 68, 5, 189, 170, 167, 143, 159, 143, 45, 54, 10, 122,
-			148, 244, 101, 210, 241, 22, 99, 135, 40, 221, 96, 163, 13, 239,
-			233, 6, 65, 206, 28, 173, 239, 210, 16, 21, 244, 168, 219, 107,
-			14, 238, 183, 54, 243, 11, 207, 242, 224, 174, 200, 82, 113, 116,
-			127, 217, 131, 248, 55, 45, 222, 48, 165, 190, 156, 245, 151, 68,
-			51, 39, 57, 121, 24, 175, 63, 65, 114, 217, 138, 211, 49, 169,
-			58, 29, 199, 206, 212, 180, 242, 76, 221, 202, 41, 28, 75, 229,
-			73, 27, 254, 21, 41, 110, 203, 19, 169, 60, 98, 75, 64, 244,
-			243, 6, 227, 242, 61, 191, 174, 206, 217, 92, 63, 218, 191, 46,
-			46, 141, 189, 80, 46, 225, 177, 251, 204, 167, 125, 243, 241, 108,
-			233, 149, 29, 150, 254, 180, 65, 30, 150, 182, 158, 241, 176, 116,
-			201, 111, 14, 75, 191, 57, 44, 189, 198, 135, 165, 97, 254, 168,
-			37, 117, 197, 158, 186, 173, 150, 123, 191, 229, 197, 54, 164, 74,
-			51, 129, 158, 210, 11, 203, 193, 138, 76, 91, 61, 168, 55, 59,
-			186, 41, 205, 237, 249, 131, 112, 62, 151, 221, 59, 24, 41, 138,
-			152, 31, 166, 20, 197, 62, 174, 198, 195, 16, 167, 126, 251, 248,
-			177, 19, 179, 32, 213, 102, 230, 102, 143, 205, 237, 127, 35, 102,
-			9, 15, 138, 222, 31, 132, 243, 168, 78, 198, 235, 41, 202, 123,
-			251, 98, 14, 43, 70, 72, 213, 211, 47, 248, 249, 245, 82, 78,
-			222, 182, 250, 75, 165, 152, 70, 215, 147, 108, 231, 99, 90, 163,
-			235, 37, 173, 153, 1, 111, 104, 245, 228, 210, 8, 170, 4, 35,
-			94, 46, 59, 140, 57, 20, 214, 100, 133, 22, 20, 46, 90, 177,
-			179, 225, 131, 100, 76, 233, 235, 173, 111, 136, 41, 125, 189, 205,
-			45, 170, 101, 75, 208, 62, 210, 153, 25, 168, 210, 52, 180, 194,
-			55, 153, 61, 190, 112, 249, 216, 216, 88, 133, 178, 215, 87, 161,
-			236, 245, 25, 137, 0, 202, 94, 95, 170, 131, 111, 215, 186, 222,
-			22, 210, 145, 217, 82, 165, 46, 85, 102, 101, 54, 90, 152, 13,
-			111, 39, 99, 90, 216, 22, 163, 26, 129, 226, 181, 165, 61, 165,
-			196, 2, 197, 243, 97, 38, 141, 158, 107, 48, 111, 177, 10, 91,
-			101, 127, 41, 174, 107, 245, 87, 232, 90, 253, 70, 177, 0, 93,
-			171, 191, 165, 149, 15, 106, 93, 203, 35, 237, 25, 87, 85, 11,
-			223, 188, 73, 80, 179, 188, 10, 53, 203, 171, 111, 142, 169, 89,
-			94, 155, 80, 67, 183, 241, 252, 153, 217, 130, 169, 101, 252, 44,
-			22, 66, 45, 143, 108, 162, 62, 219, 248, 118, 92, 203, 26, 168,
-			79, 197, 180, 172, 129, 174, 52, 247, 180, 150, 149, 33, 35, 153,
-			118, 157, 89, 76, 134, 118, 66, 243, 70, 157, 194, 87, 122, 98,
-			234, 84, 166, 119, 48, 166, 78, 101, 182, 109, 55, 194, 240, 6,
-			151, 239, 57, 59, 97, 136, 215, 238, 202, 5, 114, 14, 206, 85,
-			113, 161, 56, 94, 187, 141, 112, 101, 37, 44, 140, 207, 255, 225,
-			248, 234, 252, 184, 140, 180, 85, 102, 240, 204, 227, 140, 167, 126,
-			43, 214, 226, 145, 112, 105, 170, 80, 46, 174, 139, 1, 222, 104,
-			114, 81, 206, 41, 185, 73, 79, 52, 152, 103, 211, 89, 120, 69,
-			166, 59, 43, 75, 25, 39, 133, 104, 131, 121, 182, 127, 93, 120,
-			188, 177, 28, 172, 172, 206, 45, 149, 230, 86, 253, 242, 178, 18,
-			166, 28, 158, 29, 46, 29, 247, 203, 203, 98, 7, 79, 73, 7,
-			160, 32, 59, 167, 151, 60, 224, 147, 2, 86, 232, 223, 244, 73,
-			127, 58, 11, 95, 72, 26, 84, 125, 33, 197, 175, 208, 191, 197,
-			190, 24, 229, 245, 165, 181, 121, 121, 4, 65, 73, 76, 247, 183,
-			124, 247, 211, 231, 53, 240, 250, 217, 233, 171, 166, 102, 102, 39,
-			175, 58, 126, 34, 122, 67, 12, 243, 68, 169, 236, 3, 15, 66,
-			169, 92, 227, 101, 253, 187, 216, 206, 147, 218, 143, 62, 157, 172,
-			253, 174, 121, 65, 12, 242, 22, 149, 172, 115, 78, 221, 42, 166,
-			235, 177, 207, 205, 234, 177, 146, 164, 162, 143, 115, 229, 203, 8,
-			227, 146, 22, 240, 122, 245, 100, 58, 43, 186, 121, 178, 92, 244,
-			229, 160, 149, 21, 28, 225, 233, 172, 232, 225, 245, 32, 91, 231,
-			74, 185, 235, 164, 253, 155, 2, 254, 124, 48, 147, 187, 46, 16,
-			163, 92, 84, 44, 158, 210, 106, 16, 100, 209, 252, 77, 79, 180,
-			197, 127, 153, 129, 31, 64, 7, 10, 101, 78, 216, 116, 179, 196,
-			162, 64, 208, 120, 240, 126, 46, 221, 34, 53, 30, 4, 94, 153,
-			78, 114, 107, 74, 234, 36, 23, 157, 73, 39, 153, 152, 248, 141,
-			78, 242, 27, 157, 228, 53, 214, 73, 46, 225, 223, 167, 82, 39,
-			241, 234, 46, 178, 220, 167, 169, 87, 139, 255, 213, 86, 78, 174,
-			137, 239, 146, 107, 170, 116, 18, 165, 146, 20, 131, 133, 176, 152,
-			173, 206, 58, 140, 142, 74, 241, 175, 65, 35, 41, 141, 200, 250,
-			121, 198, 78, 89, 14, 189, 133, 48, 159, 15, 22, 176, 240, 93,
-			84, 160, 20, 112, 195, 26, 42, 237, 30, 31, 95, 40, 206, 175,
-			45, 141, 45, 132, 43, 227, 59, 39, 94, 183, 243, 146, 139, 47,
-			174, 44, 61, 58, 31, 200, 220, 151, 97, 17, 173, 110, 49, 245,
-			72, 58, 136, 229, 10, 163, 43, 193, 74, 88, 92, 247, 230, 215,
-			22, 23, 131, 98, 105, 204, 155, 193, 250, 254, 202, 119, 1, 107,
-			131, 204, 7, 94, 182, 24, 202, 178, 30, 152, 164, 75, 242, 30,
-			111, 161, 40, 125, 209, 230, 131, 197, 176, 40, 125, 31, 243, 107,
-			37, 237, 12, 42, 27, 68, 231, 94, 244, 122, 196, 195, 171, 41,
-			241, 165, 221, 236, 242, 106, 67, 25, 226, 233, 68, 198, 88, 113,
-			206, 155, 60, 62, 141, 158, 35, 60, 26, 112, 62, 92, 203, 234,
-			115, 17, 140, 123, 62, 183, 132, 233, 53, 199, 179, 225, 66, 105,
-			28, 43, 1, 141, 250, 171, 185, 173, 112, 40, 197, 155, 191, 57,
-			116, 2, 66, 14, 80, 138, 20, 56, 47, 217, 139, 5, 189, 80,
-			129, 3, 141, 160, 215, 184, 178, 94, 141, 254, 110, 199, 180, 108,
-			27, 155, 62, 24, 215, 221, 6, 72, 34, 166, 187, 13, 36, 83,
-			49, 221, 13, 244, 1, 163, 187, 101, 136, 155, 25, 136, 27, 231,
-			74, 225, 74, 16, 22, 130, 125, 202, 209, 25, 122, 95, 161, 187,
-			101, 42, 116, 183, 76, 125, 71, 76, 119, 203, 164, 187, 149, 169,
-			139, 8, 186, 149, 184, 42, 67, 252, 225, 25, 47, 156, 255, 131,
-			96, 161, 28, 57, 117, 250, 75, 88, 97, 165, 24, 248, 202, 209,
-			75, 139, 192, 184, 62, 183, 181, 66, 159, 219, 106, 80, 129, 10,
-			183, 53, 221, 205, 135, 180, 62, 119, 62, 201, 100, 122, 144, 185,
-			205, 7, 42, 228, 23, 171, 89, 150, 189, 147, 133, 240, 116, 33,
-			174, 210, 157, 95, 161, 210, 157, 95, 223, 23, 83, 233, 206, 247,
-			6, 248, 54, 173, 210, 93, 64, 50, 153, 62, 237, 63, 28, 183,
-			123, 232, 220, 252, 113, 173, 238, 130, 10, 173, 238, 2, 211, 42,
-			104, 117, 23, 120, 3, 74, 81, 180, 5, 29, 34, 147, 25, 215,
-			91, 201, 45, 20, 141, 13, 178, 148, 195, 219, 128, 213, 112, 97,
-			57, 174, 209, 13, 153, 25, 4, 141, 110, 40, 217, 22, 211, 232,
-			134, 82, 154, 20, 118, 82, 208, 161, 206, 125, 188, 149, 215, 35,
-			244, 196, 23, 160, 3, 67, 93, 111, 80, 40, 29, 65, 135, 201,
-			27, 206, 2, 165, 99, 195, 155, 137, 152, 226, 55, 156, 108, 137,
-			41, 126, 195, 88, 107, 15, 161, 164, 160, 195, 169, 189, 10, 165,
-			163, 80, 14, 119, 92, 166, 80, 38, 4, 221, 70, 246, 157, 5,
-			202, 132, 13, 111, 38, 98, 230, 180, 109, 201, 214, 152, 57, 109,
-			91, 187, 94, 181, 137, 164, 160, 219, 58, 222, 160, 80, 38, 20,
-			202, 109, 157, 123, 49, 161, 4, 154, 219, 70, 72, 95, 102, 200,
-			59, 60, 57, 101, 146, 139, 107, 255, 46, 185, 182, 47, 220, 57,
-			177, 107, 212, 159, 95, 200, 6, 139, 102, 57, 39, 109, 248, 46,
-			25, 51, 197, 141, 24, 83, 82, 146, 10, 58, 34, 111, 187, 164,
-			41, 110, 148, 116, 103, 70, 106, 68, 93, 154, 210, 118, 218, 129,
-			78, 49, 158, 184, 221, 109, 180, 194, 238, 54, 90, 223, 30, 179,
-			187, 141, 118, 166, 149, 109, 149, 11, 58, 70, 186, 50, 253, 222,
-			1, 224, 30, 222, 44, 232, 71, 222, 244, 193, 170, 130, 172, 113,
-			203, 218, 88, 133, 101, 109, 172, 194, 178, 54, 150, 234, 84, 19,
-			210, 32, 232, 14, 210, 149, 113, 85, 98, 59, 84, 26, 170, 188,
-			98, 34, 211, 218, 14, 51, 33, 13, 150, 160, 59, 204, 178, 107,
-			160, 130, 238, 72, 117, 98, 102, 52, 52, 173, 237, 36, 94, 102,
-			187, 103, 52, 55, 111, 220, 203, 174, 233, 236, 251, 186, 97, 144,
-			136, 158, 92, 1, 113, 107, 219, 78, 131, 163, 209, 18, 116, 103,
-			210, 141, 89, 219, 118, 246, 245, 171, 125, 221, 36, 232, 46, 210,
-			153, 233, 65, 159, 188, 5, 191, 52, 38, 57, 158, 242, 56, 11,
-			10, 107, 43, 186, 205, 38, 27, 94, 213, 164, 104, 178, 4, 221,
-			101, 206, 126, 77, 84, 208, 93, 237, 29, 252, 60, 108, 179, 89,
-			208, 11, 73, 42, 211, 85, 25, 96, 41, 171, 204, 23, 204, 225,
-			183, 217, 134, 215, 116, 123, 205, 150, 160, 23, 154, 115, 90, 51,
-			21, 244, 194, 182, 118, 125, 8, 250, 63, 1, 0, 0, 255, 255,
-			161, 198, 251, 43, 115, 124, 2, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 125, 116, 100, 201,
+			85, 32, 136, 235, 125, 100, 42, 51, 164, 146, 178, 66, 170, 146,
+			42, 171, 171, 42, 58, 251, 163, 164, 42, 85, 170, 75, 253, 229,
+			174, 182, 219, 157, 146, 178, 170, 212, 173, 146, 228, 148, 212, 237,
+			182, 127, 237, 244, 83, 102, 164, 244, 92, 153, 239, 101, 191, 247,
+			178, 84, 217, 28, 207, 207, 24, 51, 96, 12, 131, 49, 204, 96,
+			224, 152, 217, 3, 103, 240, 44, 48, 11, 120, 199, 112, 0, 47,
+			120, 152, 57, 204, 178, 124, 204, 2, 235, 153, 221, 97, 240, 156,
+			195, 178, 24, 131, 57, 203, 26, 214, 7, 206, 204, 28, 239, 185,
+			55, 62, 222, 203, 15, 85, 85, 183, 219, 208, 204, 184, 254, 40,
+			101, 196, 139, 184, 17, 113, 227, 198, 189, 55, 110, 220, 184, 65,
+			190, 253, 189, 132, 237, 251, 254, 126, 147, 47, 182, 3, 63, 242,
+			247, 58, 141, 197, 58, 15, 107, 129, 219, 142, 252, 160, 136, 121,
+			116, 82, 148, 40, 170, 18, 133, 119, 147, 227, 87, 221, 38, 95,
+			213, 5, 183, 121, 68, 223, 66, 236, 134, 219, 228, 179, 6, 179,
+			230, 198, 150, 30, 44, 246, 85, 42, 246, 214, 216, 130, 236, 10,
+			214, 184, 48, 158, 249, 192, 151, 254, 151, 175, 26, 185, 111, 134,
+			255, 11, 31, 79, 145, 169, 33, 101, 41, 37, 182, 231, 180, 0,
+			190, 49, 151, 173, 224, 111, 58, 75, 70, 219, 78, 237, 166, 179,
+			207, 103, 77, 204, 86, 73, 122, 150, 144, 58, 111, 115, 175, 206,
+			189, 90, 119, 214, 98, 214, 92, 182, 146, 200, 161, 23, 201, 241,
+			118, 103, 175, 233, 214, 170, 137, 98, 132, 89, 115, 169, 74, 78,
+			124, 88, 141, 11, 159, 39, 147, 135, 220, 185, 153, 44, 58, 134,
+			69, 39, 32, 59, 81, 112, 133, 140, 183, 120, 24, 58, 251, 188,
+			26, 117, 219, 124, 214, 70, 92, 176, 1, 92, 244, 227, 97, 76,
+			214, 218, 233, 182, 57, 45, 145, 44, 247, 58, 45, 1, 33, 117,
+			4, 54, 203, 94, 167, 213, 15, 37, 3, 213, 36, 136, 209, 144,
+			7, 183, 220, 26, 159, 77, 35, 128, 243, 3, 0, 182, 197, 247,
+			126, 24, 170, 30, 93, 33, 89, 126, 59, 226, 94, 232, 250, 222,
+			236, 40, 2, 121, 104, 0, 200, 85, 151, 55, 235, 253, 32, 226,
+			122, 244, 9, 50, 234, 183, 35, 215, 247, 194, 217, 12, 51, 230,
+			198, 150, 238, 27, 2, 162, 201, 55, 69, 153, 138, 42, 76, 215,
+			72, 46, 244, 59, 65, 141, 87, 107, 126, 157, 87, 93, 175, 225,
+			207, 102, 17, 192, 185, 1, 0, 219, 88, 112, 197, 175, 243, 53,
+			175, 225, 87, 38, 194, 158, 52, 61, 73, 210, 97, 215, 139, 156,
+			219, 179, 227, 72, 33, 50, 69, 151, 200, 40, 175, 187, 208, 238,
+			236, 4, 51, 230, 38, 150, 102, 7, 32, 151, 197, 247, 138, 42,
+			88, 248, 249, 52, 153, 188, 23, 178, 124, 154, 164, 26, 128, 153,
+			89, 243, 181, 224, 77, 212, 233, 69, 124, 250, 117, 34, 190, 68,
+			198, 60, 30, 70, 188, 46, 168, 200, 186, 71, 58, 36, 162, 210,
+			32, 25, 218, 175, 139, 12, 223, 73, 38, 117, 151, 170, 129, 227,
+			237, 43, 122, 94, 188, 91, 79, 138, 101, 85, 175, 2, 213, 42,
+			19, 26, 14, 166, 233, 42, 33, 190, 199, 253, 70, 181, 206, 107,
+			205, 217, 204, 17, 88, 218, 132, 34, 253, 221, 203, 98, 197, 85,
+			94, 107, 210, 167, 98, 242, 28, 61, 130, 186, 110, 136, 133, 57,
+			64, 161, 187, 100, 34, 224, 176, 86, 120, 93, 142, 44, 139, 157,
+			40, 222, 117, 100, 21, 89, 13, 7, 82, 57, 166, 160, 96, 146,
+			62, 64, 116, 70, 21, 56, 28, 178, 164, 108, 101, 92, 101, 110,
+			56, 45, 158, 127, 149, 76, 244, 162, 135, 78, 147, 84, 24, 57,
+			65, 132, 204, 49, 85, 17, 9, 154, 35, 22, 247, 234, 200, 25,
+			83, 21, 248, 73, 159, 141, 7, 108, 225, 128, 31, 30, 232, 110,
+			47, 228, 254, 113, 231, 159, 36, 199, 122, 6, 112, 175, 77, 23,
+			126, 195, 38, 39, 134, 194, 166, 239, 36, 211, 29, 207, 245, 34,
+			30, 180, 3, 14, 36, 43, 186, 56, 251, 133, 209, 35, 136, 110,
+			55, 89, 90, 64, 169, 76, 245, 128, 16, 153, 244, 37, 50, 6,
+			244, 225, 4, 14, 36, 229, 106, 92, 186, 183, 33, 23, 87, 227,
+			154, 203, 214, 183, 27, 102, 37, 9, 139, 62, 73, 50, 13, 238,
+			68, 157, 128, 135, 179, 75, 136, 202, 211, 3, 112, 175, 138, 2,
+			219, 60, 170, 232, 194, 180, 69, 198, 111, 241, 192, 109, 184, 53,
+			4, 141, 243, 48, 177, 244, 150, 123, 236, 212, 11, 137, 170, 219,
+			145, 19, 241, 43, 100, 119, 227, 133, 114, 101, 237, 234, 90, 121,
+			85, 116, 179, 7, 124, 254, 251, 12, 50, 150, 24, 9, 176, 67,
+			175, 211, 218, 227, 129, 156, 47, 153, 162, 167, 73, 182, 209, 105,
+			54, 5, 209, 193, 180, 101, 43, 25, 200, 0, 130, 3, 209, 43,
+			217, 8, 228, 227, 111, 154, 39, 25, 69, 148, 179, 41, 102, 204,
+			101, 42, 58, 45, 190, 181, 185, 19, 241, 250, 108, 90, 125, 19,
+			233, 231, 236, 140, 157, 75, 21, 30, 35, 199, 7, 134, 66, 39,
+			201, 216, 106, 121, 101, 189, 84, 41, 237, 172, 109, 110, 228, 70,
+			232, 4, 73, 140, 46, 103, 92, 200, 102, 254, 120, 52, 247, 129,
+			15, 124, 224, 3, 102, 225, 95, 164, 201, 244, 48, 38, 56, 148,
+			31, 199, 131, 182, 122, 6, 93, 34, 169, 166, 179, 199, 155, 179,
+			54, 78, 194, 197, 123, 98, 179, 197, 117, 168, 82, 17, 53, 233,
+			51, 18, 53, 128, 130, 137, 165, 11, 247, 6, 1, 248, 171, 68,
+			227, 105, 146, 133, 191, 2, 239, 105, 129, 119, 200, 64, 188, 231,
+			73, 6, 249, 94, 157, 235, 57, 81, 105, 224, 20, 117, 222, 112,
+			58, 205, 168, 122, 203, 105, 118, 56, 114, 176, 108, 101, 92, 102,
+			190, 0, 121, 244, 28, 25, 67, 110, 87, 117, 189, 58, 191, 141,
+			34, 52, 85, 17, 156, 115, 13, 114, 96, 218, 223, 23, 250, 158,
+			226, 53, 0, 33, 3, 25, 216, 252, 147, 49, 183, 16, 210, 251,
+			204, 240, 225, 245, 51, 9, 122, 158, 76, 98, 137, 71, 229, 82,
+			118, 154, 179, 199, 145, 12, 38, 68, 246, 166, 204, 45, 252, 172,
+			73, 108, 64, 6, 76, 253, 206, 75, 91, 229, 234, 234, 230, 238,
+			242, 122, 57, 103, 192, 212, 99, 198, 213, 245, 205, 210, 78, 206,
+			212, 233, 181, 141, 157, 39, 30, 203, 89, 186, 194, 174, 200, 176,
+			147, 5, 30, 93, 202, 165, 104, 142, 140, 99, 250, 234, 218, 59,
+			203, 171, 79, 60, 150, 75, 247, 230, 60, 186, 148, 27, 165, 199,
+			72, 22, 115, 150, 55, 55, 215, 115, 25, 13, 115, 123, 167, 178,
+			182, 113, 45, 151, 213, 48, 175, 85, 54, 119, 183, 114, 68, 67,
+			184, 81, 222, 222, 46, 93, 43, 231, 198, 116, 137, 229, 151, 118,
+			202, 219, 185, 241, 158, 110, 61, 186, 148, 59, 166, 155, 40, 111,
+			236, 222, 200, 77, 208, 227, 228, 24, 38, 183, 85, 39, 38, 251,
+			178, 158, 120, 44, 151, 139, 59, 34, 160, 28, 239, 201, 120, 226,
+			177, 28, 45, 172, 144, 20, 146, 33, 165, 100, 98, 189, 180, 92,
+			94, 175, 110, 110, 193, 162, 41, 173, 231, 140, 56, 175, 82, 222,
+			42, 151, 118, 202, 171, 57, 43, 153, 247, 142, 221, 181, 74, 121,
+			53, 103, 22, 106, 100, 122, 152, 132, 28, 186, 132, 18, 180, 96,
+			30, 65, 11, 8, 171, 159, 22, 10, 255, 167, 73, 166, 134, 104,
+			9, 67, 27, 121, 59, 73, 9, 90, 22, 156, 122, 126, 160, 9,
+			0, 132, 148, 221, 7, 173, 34, 234, 37, 245, 77, 235, 8, 125,
+			19, 64, 12, 16, 236, 203, 3, 210, 92, 40, 60, 79, 12, 173,
+			222, 215, 56, 230, 189, 54, 169, 158, 26, 34, 213, 159, 38, 199,
+			7, 0, 221, 179, 116, 253, 22, 131, 204, 30, 133, 156, 187, 176,
+			68, 179, 135, 37, 62, 221, 143, 193, 251, 135, 162, 0, 219, 25,
+			152, 235, 79, 26, 228, 228, 240, 125, 197, 208, 62, 60, 67, 210,
+			45, 30, 29, 248, 74, 79, 30, 84, 70, 110, 224, 231, 62, 88,
+			21, 89, 43, 169, 190, 89, 71, 168, 111, 178, 55, 3, 61, 253,
+			176, 73, 78, 12, 5, 62, 180, 163, 103, 8, 113, 189, 118, 39,
+			18, 186, 48, 32, 44, 91, 201, 98, 14, 50, 47, 224, 178, 157,
+			72, 127, 183, 240, 59, 17, 89, 88, 224, 45, 113, 71, 109, 236,
+			232, 217, 35, 70, 218, 223, 79, 250, 8, 201, 213, 154, 46, 247,
+			162, 106, 24, 5, 220, 105, 185, 222, 190, 144, 182, 87, 82, 13,
+			167, 25, 242, 202, 164, 248, 188, 173, 190, 66, 13, 36, 160, 32,
+			81, 35, 221, 83, 67, 124, 214, 53, 10, 255, 44, 75, 198, 18,
+			187, 48, 122, 63, 25, 127, 159, 115, 203, 169, 170, 157, 181, 129,
+			59, 235, 49, 200, 219, 146, 187, 235, 71, 200, 52, 36, 171, 126,
+			39, 226, 65, 181, 214, 116, 194, 16, 16, 133, 155, 188, 108, 133,
+			194, 183, 77, 248, 180, 162, 190, 208, 199, 201, 20, 228, 86, 91,
+			157, 102, 228, 182, 155, 188, 10, 59, 255, 112, 150, 36, 123, 118,
+			28, 74, 220, 144, 5, 160, 71, 33, 93, 37, 103, 32, 179, 186,
+			207, 61, 30, 56, 17, 175, 242, 87, 58, 78, 51, 172, 58, 94,
+			189, 122, 224, 132, 7, 179, 211, 0, 96, 217, 156, 53, 42, 167,
+			160, 224, 53, 89, 174, 140, 197, 74, 94, 253, 186, 19, 30, 208,
+			43, 228, 36, 124, 4, 140, 184, 222, 126, 181, 118, 192, 107, 55,
+			171, 157, 168, 241, 150, 217, 211, 201, 246, 177, 135, 219, 88, 102,
+			5, 138, 236, 70, 141, 183, 208, 109, 50, 14, 115, 215, 114, 95,
+			229, 213, 134, 31, 160, 12, 157, 88, 154, 191, 211, 62, 182, 184,
+			41, 43, 220, 240, 235, 252, 74, 106, 123, 171, 92, 94, 173, 140,
+			41, 40, 87, 253, 128, 158, 33, 100, 223, 215, 8, 30, 67, 172,
+			101, 247, 125, 133, 222, 199, 201, 84, 173, 38, 198, 236, 214, 170,
+			114, 71, 30, 206, 230, 122, 144, 85, 171, 225, 96, 221, 154, 164,
+			241, 144, 62, 69, 78, 196, 200, 74, 86, 60, 158, 172, 56, 165,
+			241, 148, 168, 250, 56, 153, 106, 119, 7, 43, 210, 158, 22, 219,
+			221, 254, 106, 15, 161, 149, 37, 224, 53, 80, 237, 102, 103, 146,
+			165, 19, 31, 104, 145, 228, 106, 181, 42, 247, 156, 189, 38, 175,
+			58, 1, 247, 156, 112, 246, 28, 22, 182, 163, 160, 195, 43, 19,
+			181, 90, 25, 63, 150, 240, 27, 189, 64, 142, 251, 123, 239, 171,
+			9, 194, 170, 182, 3, 222, 112, 111, 207, 62, 136, 88, 154, 132,
+			15, 72, 86, 91, 152, 77, 231, 73, 174, 22, 30, 56, 65, 27,
+			57, 107, 216, 118, 106, 124, 246, 33, 81, 84, 228, 111, 168, 108,
+			32, 236, 240, 208, 109, 68, 10, 226, 121, 44, 54, 134, 121, 18,
+			218, 28, 201, 181, 15, 218, 189, 13, 207, 97, 177, 137, 246, 65,
+			59, 217, 238, 3, 228, 88, 251, 32, 217, 232, 60, 22, 27, 111,
+			31, 36, 90, 124, 140, 156, 132, 66, 45, 30, 57, 117, 39, 114,
+			18, 165, 23, 176, 244, 116, 251, 160, 125, 67, 126, 236, 233, 103,
+			208, 217, 235, 106, 250, 184, 132, 101, 199, 32, 79, 81, 200, 235,
+			222, 126, 124, 221, 54, 91, 133, 43, 100, 60, 73, 247, 52, 75,
+			4, 229, 231, 12, 80, 130, 86, 54, 87, 203, 213, 237, 181, 119,
+			149, 115, 38, 168, 81, 235, 107, 59, 229, 106, 101, 119, 99, 103,
+			237, 70, 57, 103, 37, 20, 251, 231, 236, 204, 133, 220, 197, 231,
+			236, 204, 195, 185, 243, 136, 158, 1, 162, 44, 252, 165, 69, 38,
+			122, 183, 229, 244, 173, 100, 70, 217, 221, 66, 30, 85, 15, 221,
+			0, 23, 107, 203, 17, 130, 83, 19, 229, 180, 44, 181, 205, 163,
+			23, 221, 128, 95, 245, 131, 150, 19, 209, 117, 114, 206, 243, 171,
+			97, 228, 120, 117, 39, 168, 87, 99, 251, 103, 213, 169, 213, 120,
+			24, 250, 193, 172, 153, 132, 114, 159, 231, 111, 203, 194, 177, 244,
+			40, 201, 162, 125, 107, 194, 58, 106, 77, 156, 38, 217, 150, 211,
+			174, 114, 47, 10, 186, 168, 187, 103, 42, 153, 150, 211, 46, 67,
+			154, 190, 64, 30, 142, 139, 86, 155, 124, 223, 169, 117, 171, 160,
+			151, 87, 209, 70, 84, 173, 249, 94, 163, 233, 214, 162, 112, 118,
+			76, 243, 191, 66, 92, 99, 29, 43, 60, 23, 250, 30, 110, 145,
+			86, 84, 233, 158, 93, 235, 248, 155, 130, 108, 122, 167, 222, 206,
+			165, 158, 179, 51, 169, 92, 250, 57, 59, 147, 206, 141, 62, 103,
+			103, 50, 185, 236, 115, 118, 38, 155, 35, 133, 79, 28, 35, 227,
+			201, 237, 6, 45, 145, 84, 13, 5, 46, 76, 241, 196, 210, 3,
+			119, 220, 156, 20, 87, 64, 18, 95, 73, 11, 221, 190, 34, 106,
+			130, 22, 4, 139, 140, 131, 6, 2, 251, 19, 153, 162, 215, 72,
+			250, 125, 33, 148, 192, 237, 235, 196, 210, 131, 119, 134, 253, 220,
+			54, 2, 207, 62, 183, 93, 221, 216, 172, 220, 40, 173, 87, 100,
+			117, 122, 138, 216, 77, 231, 213, 110, 175, 204, 198, 44, 90, 36,
+			147, 29, 79, 236, 213, 97, 142, 161, 212, 100, 178, 212, 68, 252,
+			117, 29, 202, 223, 35, 93, 157, 34, 54, 24, 165, 123, 37, 43,
+			102, 209, 57, 50, 94, 231, 123, 157, 253, 106, 192, 235, 78, 45,
+			234, 149, 39, 99, 248, 169, 130, 95, 232, 243, 36, 11, 19, 231,
+			193, 240, 112, 235, 54, 177, 116, 233, 206, 40, 144, 83, 172, 42,
+			85, 226, 250, 244, 58, 25, 141, 156, 96, 159, 71, 225, 236, 20,
+			179, 230, 38, 150, 138, 247, 2, 106, 7, 171, 0, 94, 43, 170,
+			58, 125, 145, 228, 164, 41, 182, 42, 183, 185, 225, 236, 52, 242,
+			173, 133, 59, 131, 148, 150, 220, 85, 81, 169, 50, 201, 123, 210,
+			189, 235, 226, 196, 107, 89, 23, 187, 100, 82, 254, 174, 134, 157,
+			118, 219, 15, 162, 217, 147, 204, 184, 123, 135, 20, 48, 81, 167,
+			50, 209, 232, 73, 127, 253, 150, 91, 254, 93, 100, 162, 23, 25,
+			73, 67, 184, 117, 143, 134, 112, 58, 29, 111, 212, 64, 52, 137,
+			221, 87, 254, 31, 153, 100, 162, 119, 96, 244, 26, 161, 178, 78,
+			213, 245, 162, 192, 175, 119, 106, 188, 62, 107, 220, 165, 157, 227,
+			178, 206, 154, 174, 146, 4, 148, 88, 5, 230, 61, 2, 90, 141,
+			215, 199, 34, 153, 82, 0, 0, 216, 161, 19, 120, 160, 84, 195,
+			208, 179, 21, 154, 248, 244, 162, 248, 66, 75, 68, 145, 75, 53,
+			224, 45, 255, 22, 175, 207, 218, 119, 105, 118, 66, 86, 168, 136,
+			242, 133, 69, 146, 66, 246, 67, 9, 145, 12, 40, 55, 66, 51,
+			196, 94, 217, 172, 172, 230, 12, 144, 135, 34, 183, 186, 181, 86,
+			94, 41, 231, 204, 194, 227, 36, 45, 120, 10, 136, 78, 205, 85,
+			114, 35, 50, 41, 97, 24, 234, 235, 238, 141, 229, 114, 37, 103,
+			22, 118, 201, 100, 223, 58, 164, 39, 200, 241, 74, 121, 167, 188,
+			1, 198, 129, 234, 238, 198, 243, 27, 155, 47, 130, 101, 173, 39,
+			91, 201, 97, 131, 78, 147, 92, 156, 189, 189, 185, 91, 193, 222,
+			124, 167, 73, 114, 253, 139, 146, 206, 144, 169, 157, 82, 229, 90,
+			121, 167, 138, 150, 137, 24, 244, 52, 201, 37, 63, 92, 93, 67,
+			123, 206, 57, 114, 58, 153, 91, 126, 231, 78, 121, 99, 27, 90,
+			169, 148, 54, 174, 129, 82, 208, 7, 79, 153, 88, 44, 24, 65,
+			242, 195, 213, 181, 242, 250, 106, 206, 238, 207, 222, 220, 40, 111,
+			94, 205, 165, 250, 91, 71, 179, 75, 154, 230, 201, 201, 254, 220,
+			106, 121, 99, 167, 242, 82, 110, 180, 191, 225, 237, 114, 229, 133,
+			181, 149, 114, 46, 67, 79, 18, 154, 252, 112, 163, 188, 115, 125,
+			115, 53, 151, 29, 38, 177, 104, 110, 170, 240, 147, 6, 25, 79,
+			154, 64, 122, 152, 138, 241, 102, 19, 182, 133, 223, 54, 201, 88,
+			194, 22, 2, 166, 66, 167, 217, 244, 15, 171, 78, 211, 117, 66,
+			41, 15, 9, 102, 149, 32, 231, 94, 229, 207, 189, 171, 46, 233,
+			215, 173, 186, 140, 190, 9, 85, 151, 84, 46, 93, 248, 223, 77,
+			146, 235, 183, 142, 244, 225, 205, 56, 10, 111, 201, 241, 153, 175,
+			101, 124, 253, 82, 221, 58, 82, 170, 15, 17, 86, 246, 155, 89,
+			88, 37, 201, 245, 115, 6, 153, 144, 219, 78, 133, 216, 36, 198,
+			10, 175, 5, 99, 189, 51, 114, 255, 81, 51, 242, 55, 50, 174,
+			31, 176, 200, 177, 30, 219, 207, 189, 246, 238, 21, 114, 220, 173,
+			243, 86, 219, 143, 192, 243, 160, 218, 228, 183, 120, 19, 209, 48,
+			177, 180, 120, 103, 235, 82, 113, 45, 174, 183, 14, 213, 174, 76,
+			173, 173, 150, 111, 108, 109, 238, 148, 55, 86, 94, 82, 66, 162,
+			146, 75, 128, 199, 98, 61, 75, 240, 129, 215, 130, 240, 175, 27,
+			38, 11, 91, 36, 215, 63, 26, 96, 232, 67, 198, 147, 27, 161,
+			83, 100, 114, 99, 179, 186, 189, 182, 90, 174, 150, 175, 94, 45,
+			175, 236, 108, 139, 131, 6, 93, 122, 39, 103, 38, 231, 230, 7,
+			45, 50, 53, 164, 39, 180, 36, 77, 132, 194, 106, 121, 233, 94,
+			122, 95, 132, 221, 253, 150, 19, 68, 210, 162, 56, 79, 0, 189,
+			94, 228, 54, 92, 30, 200, 3, 28, 11, 15, 112, 38, 227, 124,
+			100, 35, 116, 129, 208, 182, 31, 186, 145, 123, 11, 28, 33, 212,
+			105, 15, 44, 92, 187, 146, 83, 95, 214, 188, 72, 151, 246, 248,
+			190, 211, 87, 26, 182, 31, 86, 37, 167, 190, 232, 210, 247, 147,
+			241, 186, 223, 1, 171, 140, 128, 10, 44, 217, 168, 140, 137, 60,
+			93, 68, 154, 205, 226, 99, 166, 241, 202, 152, 200, 19, 69, 206,
+			147, 73, 103, 127, 63, 0, 224, 10, 144, 48, 4, 78, 232, 108,
+			44, 152, 127, 142, 100, 20, 30, 224, 228, 9, 48, 81, 109, 11,
+			235, 182, 9, 39, 79, 158, 250, 120, 63, 25, 119, 195, 170, 62,
+			243, 159, 53, 153, 57, 151, 169, 140, 185, 161, 62, 21, 45, 124,
+			146, 16, 18, 19, 27, 253, 30, 131, 76, 8, 1, 211, 6, 83,
+			187, 87, 83, 219, 194, 33, 150, 58, 93, 75, 232, 228, 91, 178,
+			194, 242, 219, 191, 221, 48, 62, 110, 216, 31, 55, 140, 31, 49,
+			142, 209, 76, 249, 157, 91, 235, 107, 43, 107, 59, 179, 31, 26,
+			197, 244, 218, 13, 153, 254, 194, 104, 239, 247, 63, 30, 253, 148,
+			97, 101, 254, 120, 180, 114, 172, 145, 132, 71, 155, 73, 15, 10,
+			243, 168, 141, 100, 220, 155, 178, 244, 155, 88, 158, 199, 142, 164,
+			177, 35, 99, 52, 189, 178, 190, 185, 93, 94, 197, 110, 100, 169,
+			189, 185, 85, 222, 152, 253, 130, 106, 50, 118, 182, 248, 184, 65,
+			102, 212, 41, 171, 148, 181, 220, 171, 249, 117, 165, 221, 78, 44,
+			93, 190, 83, 227, 21, 89, 21, 81, 82, 150, 21, 151, 47, 13,
+			160, 164, 180, 177, 42, 251, 50, 70, 211, 91, 165, 149, 231, 203,
+			171, 113, 111, 78, 4, 195, 160, 208, 191, 71, 38, 193, 218, 10,
+			180, 225, 214, 81, 185, 158, 181, 143, 58, 47, 141, 123, 4, 230,
+			215, 23, 116, 13, 137, 20, 49, 59, 89, 106, 111, 108, 110, 148,
+			85, 55, 240, 0, 252, 165, 184, 27, 19, 157, 158, 170, 244, 239,
+			145, 156, 50, 15, 105, 148, 164, 142, 58, 242, 141, 59, 32, 141,
+			76, 26, 25, 15, 39, 122, 48, 77, 39, 215, 203, 27, 215, 118,
+			174, 87, 183, 42, 101, 60, 185, 155, 253, 144, 106, 126, 178, 213,
+			91, 145, 126, 208, 32, 99, 194, 122, 131, 6, 39, 105, 84, 120,
+			248, 78, 131, 71, 13, 8, 75, 47, 63, 133, 205, 90, 138, 32,
+			102, 40, 93, 47, 95, 43, 173, 188, 84, 93, 46, 111, 239, 0,
+			39, 219, 172, 8, 26, 37, 52, 85, 90, 95, 223, 124, 49, 70,
+			4, 121, 159, 6, 83, 248, 255, 145, 99, 61, 228, 14, 74, 49,
+			42, 211, 48, 130, 237, 242, 198, 74, 82, 137, 31, 39, 154, 188,
+			115, 6, 29, 39, 154, 248, 115, 38, 176, 81, 217, 1, 125, 150,
+			104, 21, 158, 36, 25, 69, 190, 160, 154, 163, 134, 221, 183, 49,
+			200, 16, 164, 221, 156, 1, 219, 32, 65, 211, 57, 179, 240, 2,
+			57, 49, 148, 244, 232, 3, 228, 156, 58, 191, 172, 138, 126, 150,
+			55, 86, 54, 87, 215, 54, 174, 37, 96, 18, 34, 105, 80, 244,
+			82, 209, 103, 206, 44, 172, 145, 137, 94, 2, 162, 167, 201, 204,
+			238, 206, 213, 183, 84, 95, 40, 173, 175, 173, 150, 250, 54, 68,
+			132, 72, 42, 202, 153, 176, 51, 3, 234, 202, 89, 5, 59, 99,
+			228, 140, 194, 54, 153, 236, 35, 5, 122, 31, 153, 149, 59, 148,
+			97, 189, 154, 34, 253, 196, 33, 140, 160, 171, 229, 245, 181, 27,
+			107, 112, 32, 107, 22, 174, 19, 18, 207, 49, 200, 172, 231, 182,
+			55, 55, 170, 87, 97, 163, 183, 147, 0, 149, 37, 98, 78, 115,
+			6, 236, 71, 6, 39, 62, 103, 94, 72, 131, 196, 250, 200, 198,
+			133, 116, 230, 35, 27, 185, 143, 194, 223, 143, 110, 228, 190, 103,
+			227, 185, 116, 230, 11, 163, 185, 63, 30, 45, 252, 223, 22, 161,
+			49, 101, 105, 155, 199, 59, 73, 70, 27, 81, 132, 207, 230, 91,
+			239, 64, 144, 170, 90, 34, 75, 238, 118, 229, 151, 138, 134, 6,
+			59, 230, 150, 235, 185, 173, 78, 171, 42, 55, 194, 119, 223, 49,
+			203, 10, 50, 141, 32, 156, 219, 61, 32, 82, 119, 5, 225, 220,
+			78, 128, 200, 255, 149, 65, 102, 143, 234, 236, 235, 50, 122, 108,
+			144, 105, 255, 22, 15, 2, 183, 14, 71, 21, 85, 173, 10, 217,
+			119, 87, 133, 166, 18, 21, 101, 118, 72, 151, 65, 98, 221, 230,
+			245, 24, 82, 234, 238, 144, 142, 97, 21, 5, 227, 57, 32, 80,
+			216, 125, 152, 57, 43, 214, 183, 10, 159, 54, 201, 68, 175, 91,
+			36, 93, 37, 153, 166, 47, 93, 142, 196, 108, 207, 221, 197, 147,
+			178, 184, 46, 203, 87, 116, 205, 252, 111, 25, 36, 163, 178, 233,
+			73, 98, 183, 157, 232, 0, 29, 126, 83, 203, 102, 206, 168, 96,
+			26, 242, 195, 182, 227, 205, 154, 113, 62, 164, 225, 164, 166, 201,
+			29, 224, 164, 213, 154, 223, 106, 113, 47, 10, 165, 217, 101, 82,
+			230, 175, 200, 108, 240, 206, 141, 2, 199, 109, 246, 148, 181, 177,
+			108, 78, 125, 208, 133, 175, 144, 83, 10, 110, 157, 71, 78, 237,
+			128, 215, 227, 74, 224, 64, 153, 173, 204, 200, 2, 171, 242, 187,
+			170, 219, 231, 122, 252, 191, 154, 228, 184, 58, 65, 172, 107, 212,
+			221, 32, 196, 241, 60, 63, 74, 34, 111, 80, 233, 27, 168, 87,
+			44, 233, 74, 149, 4, 128, 252, 159, 26, 132, 196, 159, 142, 196,
+			226, 57, 50, 38, 93, 96, 225, 164, 84, 26, 218, 136, 200, 186,
+			234, 54, 57, 216, 224, 246, 248, 190, 235, 73, 159, 38, 145, 80,
+			174, 1, 182, 118, 13, 160, 21, 146, 9, 121, 203, 241, 34, 183,
+			134, 4, 54, 177, 244, 196, 107, 234, 124, 113, 91, 214, 174, 104,
+			56, 133, 57, 146, 81, 185, 154, 91, 142, 208, 81, 98, 109, 151,
+			119, 114, 6, 28, 253, 148, 214, 215, 74, 219, 57, 243, 194, 39,
+			77, 50, 42, 87, 18, 8, 142, 242, 234, 90, 31, 227, 157, 34,
+			19, 42, 83, 112, 183, 220, 135, 70, 147, 153, 91, 149, 205, 157,
+			205, 165, 220, 31, 13, 102, 62, 154, 251, 194, 40, 61, 78, 198,
+			85, 230, 210, 35, 75, 143, 230, 254, 184, 63, 235, 177, 220, 23,
+			209, 198, 163, 178, 46, 87, 119, 128, 123, 110, 110, 172, 191, 148,
+			51, 146, 31, 150, 18, 31, 76, 122, 134, 204, 168, 15, 79, 61,
+			245, 212, 83, 79, 38, 62, 126, 226, 187, 210, 253, 159, 223, 146,
+			248, 252, 195, 131, 159, 159, 74, 124, 254, 199, 223, 149, 166, 83,
+			100, 76, 125, 190, 81, 122, 103, 238, 171, 95, 253, 234, 87, 71,
+			151, 255, 30, 153, 170, 249, 173, 254, 169, 89, 206, 245, 57, 40,
+			132, 215, 141, 119, 93, 146, 133, 246, 253, 166, 227, 237, 23, 253,
+			96, 63, 246, 222, 135, 83, 139, 48, 225, 195, 223, 222, 251, 43,
+			195, 248, 17, 211, 186, 182, 181, 252, 227, 102, 254, 154, 168, 184,
+			37, 75, 23, 43, 188, 209, 228, 53, 32, 68, 242, 3, 99, 100,
+			113, 223, 47, 214, 14, 2, 191, 229, 118, 90, 8, 181, 217, 169,
+			185, 139, 176, 150, 124, 111, 113, 239, 149, 197, 246, 222, 162, 116,
+			76, 144, 55, 3, 50, 123, 175, 132, 181, 3, 222, 114, 242, 119,
+			189, 69, 80, 56, 223, 119, 124, 51, 67, 70, 247, 94, 169, 234,
+			3, 156, 108, 37, 189, 247, 10, 168, 15, 87, 222, 161, 189, 37,
+			232, 157, 29, 206, 102, 127, 227, 95, 3, 157, 143, 45, 157, 44,
+			170, 126, 244, 124, 215, 110, 20, 203, 15, 188, 235, 254, 187, 14,
+			237, 185, 143, 103, 73, 154, 218, 147, 35, 231, 13, 242, 85, 155,
+			24, 227, 212, 154, 28, 161, 249, 167, 216, 42, 111, 184, 96, 9,
+			102, 122, 63, 18, 50, 55, 100, 210, 132, 194, 235, 204, 245, 24,
+			14, 113, 137, 9, 223, 114, 230, 123, 205, 110, 145, 44, 253, 188,
+			205, 86, 252, 118, 55, 112, 247, 15, 34, 182, 244, 200, 210, 101,
+			182, 115, 192, 217, 250, 238, 202, 26, 43, 117, 162, 3, 63, 8,
+			139, 132, 176, 117, 183, 198, 189, 144, 215, 89, 199, 171, 243, 128,
+			69, 7, 156, 149, 218, 78, 13, 74, 138, 47, 11, 236, 5, 30,
+			128, 39, 40, 91, 42, 62, 194, 230, 160, 64, 65, 126, 42, 204,
+			63, 77, 88, 215, 239, 176, 150, 211, 101, 158, 31, 177, 78, 200,
+			89, 116, 224, 134, 12, 152, 6, 227, 183, 107, 188, 29, 65, 255,
+			106, 126, 171, 221, 116, 29, 175, 198, 217, 161, 27, 29, 176, 40,
+			6, 95, 36, 236, 37, 9, 193, 223, 139, 28, 215, 99, 14, 171,
+			249, 237, 46, 243, 27, 201, 98, 204, 137, 8, 97, 248, 239, 32,
+			138, 218, 87, 22, 23, 15, 15, 15, 139, 14, 246, 84, 208, 138,
+			40, 23, 46, 174, 175, 173, 148, 55, 182, 203, 151, 150, 138, 143,
+			16, 194, 118, 189, 38, 15, 67, 22, 240, 87, 58, 110, 192, 235,
+			108, 175, 203, 156, 118, 187, 233, 214, 64, 136, 178, 166, 115, 200,
+			252, 128, 57, 251, 1, 231, 117, 22, 249, 208, 215, 195, 192, 141,
+			92, 111, 127, 129, 133, 126, 35, 58, 116, 2, 78, 88, 221, 133,
+			253, 230, 94, 39, 234, 65, 147, 234, 153, 27, 246, 20, 240, 61,
+			230, 120, 172, 80, 218, 102, 107, 219, 5, 182, 92, 218, 94, 219,
+			94, 32, 236, 197, 181, 157, 235, 155, 187, 59, 236, 197, 82, 165,
+			82, 218, 216, 89, 43, 111, 179, 205, 10, 91, 217, 220, 16, 11,
+			113, 155, 109, 94, 101, 165, 141, 151, 216, 243, 107, 27, 171, 11,
+			140, 187, 209, 1, 15, 24, 191, 13, 187, 201, 144, 249, 1, 115,
+			1, 129, 188, 94, 36, 108, 155, 243, 158, 230, 27, 190, 152, 181,
+			176, 205, 107, 224, 120, 203, 96, 81, 118, 156, 125, 206, 246, 65,
+			236, 35, 233, 180, 121, 208, 114, 67, 152, 196, 144, 57, 94, 157,
+			176, 166, 219, 114, 133, 4, 8, 7, 71, 84, 36, 36, 67, 12,
+			147, 90, 199, 71, 142, 147, 44, 49, 173, 17, 106, 77, 141, 92,
+			128, 204, 12, 181, 78, 140, 188, 5, 50, 51, 99, 226, 39, 33,
+			166, 61, 66, 237, 153, 145, 188, 65, 8, 177, 236, 17, 131, 90,
+			51, 153, 105, 242, 2, 177, 237, 17, 115, 132, 90, 167, 204, 179,
+			249, 53, 182, 236, 238, 191, 163, 195, 131, 46, 195, 173, 33, 131,
+			117, 7, 248, 238, 232, 1, 32, 209, 192, 39, 215, 11, 35, 238,
+			212, 213, 252, 23, 246, 59, 60, 12, 121, 189, 192, 124, 143, 23,
+			9, 25, 39, 41, 128, 107, 3, 96, 157, 74, 81, 235, 212, 216,
+			113, 149, 50, 168, 117, 138, 158, 82, 41, 139, 90, 167, 238, 59,
+			67, 190, 108, 16, 99, 148, 218, 15, 141, 156, 55, 242, 127, 100,
+			200, 85, 5, 8, 128, 134, 176, 83, 151, 208, 136, 197, 228, 162,
+			21, 104, 9, 35, 223, 71, 162, 81, 43, 188, 211, 174, 59, 17,
+			15, 96, 229, 236, 130, 103, 254, 21, 65, 149, 110, 11, 236, 153,
+			172, 240, 26, 57, 88, 225, 105, 81, 93, 238, 216, 216, 55, 73,
+			18, 103, 174, 23, 61, 241, 24, 139, 220, 22, 15, 35, 167, 213,
+			102, 111, 99, 151, 217, 187, 231, 52, 155, 145, 64, 230, 139, 146,
+			133, 177, 183, 177, 2, 248, 50, 108, 239, 148, 110, 108, 21, 94,
+			126, 26, 193, 188, 159, 192, 60, 141, 142, 80, 235, 97, 115, 14,
+			230, 102, 116, 196, 164, 214, 67, 163, 15, 136, 223, 54, 228, 19,
+			241, 59, 77, 173, 135, 199, 102, 196, 111, 131, 90, 15, 207, 158,
+			19, 191, 45, 106, 61, 92, 56, 79, 254, 236, 62, 242, 196, 240,
+			145, 185, 237, 250, 162, 211, 150, 63, 110, 93, 94, 20, 30, 11,
+			213, 166, 191, 47, 89, 180, 13, 95, 242, 175, 149, 179, 23, 190,
+			100, 147, 9, 225, 210, 176, 238, 239, 11, 167, 132, 147, 218, 93,
+			79, 242, 107, 233, 134, 119, 137, 100, 53, 158, 80, 79, 177, 150,
+			39, 255, 224, 51, 15, 142, 145, 172, 198, 72, 37, 46, 145, 188,
+			179, 101, 245, 222, 217, 202, 147, 12, 208, 30, 112, 169, 89, 96,
+			236, 217, 138, 78, 67, 173, 91, 130, 11, 162, 18, 147, 173, 168,
+			36, 56, 236, 69, 206, 190, 210, 244, 240, 55, 56, 160, 43, 159,
+			27, 188, 187, 148, 173, 232, 52, 232, 77, 141, 38, 84, 200, 96,
+			5, 145, 160, 15, 144, 99, 53, 167, 217, 172, 10, 235, 91, 212,
+			69, 63, 175, 108, 101, 28, 50, 215, 100, 30, 20, 106, 115, 30,
+			196, 133, 136, 40, 4, 153, 186, 208, 12, 25, 21, 133, 218, 232,
+			156, 145, 173, 164, 33, 185, 214, 6, 167, 175, 78, 200, 131, 170,
+			179, 207, 189, 72, 222, 70, 202, 66, 78, 9, 50, 192, 105, 90,
+			186, 184, 84, 213, 72, 143, 97, 153, 9, 153, 45, 165, 0, 192,
+			105, 7, 62, 76, 77, 213, 173, 227, 229, 165, 108, 37, 43, 115,
+			214, 234, 240, 25, 152, 45, 15, 35, 248, 60, 41, 62, 203, 156,
+			181, 58, 61, 75, 198, 156, 78, 116, 80, 173, 239, 85, 3, 126,
+			11, 125, 0, 172, 74, 22, 178, 86, 247, 42, 252, 150, 244, 85,
+			109, 251, 94, 40, 46, 95, 225, 241, 191, 240, 85, 197, 76, 208,
+			16, 193, 147, 74, 23, 226, 65, 48, 75, 165, 135, 146, 204, 43,
+			7, 1, 152, 45, 117, 17, 152, 252, 106, 39, 228, 181, 217, 41,
+			108, 46, 167, 190, 236, 184, 45, 190, 27, 242, 218, 242, 197, 119,
+			205, 223, 19, 125, 63, 237, 180, 221, 231, 254, 221, 73, 16, 212,
+			19, 35, 143, 25, 228, 95, 9, 65, 61, 49, 66, 191, 33, 109,
+			191, 33, 109, 191, 70, 105, 155, 27, 57, 38, 69, 44, 29, 121,
+			86, 137, 88, 249, 19, 68, 240, 244, 200, 83, 228, 175, 77, 33,
+			110, 207, 140, 60, 102, 228, 191, 100, 178, 94, 30, 201, 234, 32,
+			213, 120, 200, 28, 38, 4, 5, 118, 247, 189, 130, 41, 191, 55,
+			150, 193, 17, 42, 63, 168, 137, 137, 111, 172, 233, 239, 35, 125,
+			238, 128, 242, 230, 2, 128, 61, 30, 70, 140, 55, 26, 32, 214,
+			154, 254, 62, 107, 251, 237, 78, 19, 246, 104, 172, 19, 194, 32,
+			93, 239, 82, 139, 183, 252, 160, 203, 246, 58, 141, 6, 15, 194,
+			34, 219, 246, 91, 156, 129, 71, 153, 203, 67, 80, 232, 8, 219,
+			227, 172, 30, 248, 237, 54, 104, 169, 13, 230, 48, 201, 38, 88,
+			45, 112, 194, 3, 30, 178, 61, 222, 240, 3, 206, 220, 136, 53,
+			154, 29, 204, 1, 220, 10, 128, 208, 157, 171, 90, 89, 8, 89,
+			171, 19, 70, 0, 16, 168, 219, 137, 92, 61, 2, 61, 170, 237,
+			200, 15, 96, 34, 94, 12, 220, 136, 179, 210, 214, 218, 2, 11,
+			57, 39, 72, 193, 225, 149, 197, 197, 90, 211, 239, 212, 149, 70,
+			95, 243, 91, 139, 123, 238, 254, 43, 80, 115, 177, 238, 215, 194,
+			69, 160, 68, 126, 201, 105, 187, 15, 0, 223, 70, 177, 10, 142,
+			110, 146, 25, 134, 68, 107, 55, 103, 50, 39, 73, 73, 105, 55,
+			103, 205, 233, 194, 99, 56, 157, 149, 173, 21, 38, 68, 210, 2,
+			227, 197, 253, 34, 43, 32, 251, 40, 86, 56, 158, 146, 248, 65,
+			119, 113, 221, 13, 165, 167, 103, 33, 169, 186, 156, 53, 51, 42,
+			101, 80, 235, 108, 118, 82, 165, 44, 106, 157, 165, 83, 228, 60,
+			54, 102, 80, 235, 156, 89, 42, 228, 89, 203, 173, 5, 126, 200,
+			107, 190, 87, 15, 89, 232, 130, 82, 205, 219, 126, 237, 64, 129,
+			52, 82, 80, 114, 84, 165, 160, 94, 70, 233, 70, 134, 69, 173,
+			115, 211, 39, 84, 42, 67, 173, 115, 39, 159, 37, 57, 146, 197,
+			212, 111, 252, 107, 27, 74, 207, 188, 157, 188, 5, 155, 52, 169,
+			197, 204, 19, 133, 139, 108, 101, 109, 107, 149, 73, 57, 9, 235,
+			67, 253, 20, 158, 172, 108, 206, 109, 176, 155, 158, 127, 232, 205,
+			171, 62, 152, 41, 168, 170, 134, 101, 26, 212, 98, 217, 156, 74,
+			89, 212, 98, 83, 211, 228, 2, 182, 97, 81, 235, 126, 243, 100,
+			225, 140, 104, 67, 9, 92, 182, 182, 58, 4, 170, 149, 130, 194,
+			10, 170, 101, 80, 235, 254, 172, 26, 153, 5, 128, 166, 79, 144,
+			34, 66, 181, 169, 85, 48, 79, 20, 238, 239, 237, 185, 156, 203,
+			33, 144, 237, 20, 84, 80, 144, 1, 11, 5, 221, 95, 219, 162,
+			86, 97, 106, 154, 44, 32, 228, 20, 181, 30, 52, 79, 23, 206,
+			225, 156, 75, 185, 6, 123, 7, 103, 63, 28, 2, 55, 101, 67,
+			113, 157, 130, 202, 90, 79, 77, 25, 212, 122, 144, 158, 84, 41,
+			139, 90, 15, 158, 202, 147, 199, 177, 149, 52, 181, 30, 50, 207,
+			21, 230, 250, 90, 81, 90, 4, 187, 201, 187, 195, 154, 75, 219,
+			80, 79, 167, 82, 212, 122, 72, 55, 151, 54, 168, 245, 16, 205,
+			171, 148, 69, 173, 135, 206, 156, 37, 79, 98, 115, 163, 160, 11,
+			222, 87, 184, 192, 212, 249, 4, 40, 197, 123, 190, 223, 228, 142,
+			199, 80, 67, 1, 94, 157, 232, 139, 106, 98, 84, 106, 145, 50,
+			149, 2, 61, 82, 53, 56, 106, 80, 235, 97, 58, 163, 82, 160,
+			75, 230, 79, 147, 37, 108, 48, 67, 173, 57, 243, 116, 225, 33,
+			166, 116, 25, 216, 58, 162, 76, 0, 85, 192, 15, 220, 87, 5,
+			219, 5, 253, 71, 65, 207, 164, 160, 146, 154, 163, 140, 65, 173,
+			185, 172, 194, 94, 198, 162, 214, 220, 169, 60, 121, 2, 161, 103,
+			169, 53, 111, 222, 87, 152, 143, 161, 251, 192, 125, 164, 34, 195,
+			162, 3, 39, 98, 45, 167, 206, 213, 186, 85, 45, 100, 83, 80,
+			81, 181, 144, 53, 168, 53, 175, 91, 200, 90, 212, 154, 63, 117,
+			90, 46, 70, 66, 173, 11, 230, 201, 66, 158, 173, 109, 49, 167,
+			94, 23, 114, 163, 161, 187, 204, 3, 5, 146, 164, 160, 164, 2,
+			73, 12, 106, 93, 208, 132, 69, 44, 106, 93, 152, 58, 65, 46,
+			34, 200, 49, 106, 93, 52, 79, 21, 206, 50, 60, 194, 4, 104,
+			133, 221, 144, 7, 151, 80, 61, 43, 176, 3, 238, 212, 99, 176,
+			99, 41, 40, 173, 192, 142, 25, 212, 186, 152, 157, 82, 41, 139,
+			90, 23, 79, 206, 146, 167, 17, 236, 56, 181, 22, 204, 179, 133,
+			34, 187, 86, 42, 131, 48, 134, 253, 159, 94, 8, 136, 137, 3,
+			199, 171, 55, 121, 125, 216, 244, 142, 167, 160, 182, 106, 102, 220,
+			160, 214, 66, 118, 86, 165, 44, 106, 45, 156, 62, 67, 158, 194,
+			102, 142, 81, 235, 146, 121, 170, 176, 32, 81, 14, 231, 199, 26,
+			33, 190, 87, 131, 179, 104, 182, 7, 222, 173, 94, 93, 73, 1,
+			213, 200, 177, 20, 212, 85, 141, 28, 51, 168, 117, 73, 143, 229,
+			152, 69, 173, 75, 39, 103, 229, 218, 155, 160, 86, 209, 60, 85,
+			56, 199, 86, 128, 149, 179, 157, 192, 17, 156, 194, 111, 12, 235,
+			252, 68, 10, 138, 43, 184, 19, 6, 181, 138, 26, 238, 132, 69,
+			173, 226, 201, 89, 217, 249, 73, 106, 45, 98, 231, 65, 83, 91,
+			93, 102, 1, 191, 229, 34, 126, 238, 78, 148, 147, 41, 168, 171,
+			152, 237, 164, 65, 173, 197, 140, 106, 100, 210, 162, 214, 226, 201,
+			89, 185, 198, 114, 212, 186, 140, 107, 172, 230, 120, 190, 231, 214,
+			156, 38, 219, 7, 153, 161, 148, 81, 6, 234, 46, 155, 19, 162,
+			99, 243, 249, 130, 94, 212, 185, 20, 212, 84, 227, 200, 25, 212,
+			186, 172, 169, 50, 103, 81, 235, 242, 169, 211, 228, 17, 108, 226,
+			56, 181, 150, 204, 211, 133, 7, 36, 54, 36, 92, 30, 4, 126,
+			160, 183, 152, 110, 131, 57, 94, 87, 193, 62, 158, 130, 42, 10,
+			246, 113, 131, 90, 75, 89, 37, 29, 142, 91, 212, 90, 154, 205,
+			147, 103, 16, 54, 165, 214, 163, 38, 43, 92, 198, 77, 41, 11,
+			219, 220, 147, 148, 3, 202, 64, 2, 251, 192, 40, 146, 18, 74,
+			181, 68, 83, 0, 64, 33, 138, 26, 212, 122, 52, 163, 152, 17,
+			181, 168, 245, 232, 153, 115, 123, 105, 220, 51, 62, 74, 62, 251,
+			60, 89, 188, 39, 125, 124, 177, 230, 200, 29, 163, 216, 104, 22,
+			94, 36, 217, 205, 189, 247, 241, 90, 84, 225, 13, 122, 145, 100,
+			225, 146, 78, 213, 105, 238, 251, 242, 56, 126, 162, 8, 197, 138,
+			112, 45, 167, 212, 220, 247, 43, 153, 3, 249, 11, 246, 45, 7,
+			252, 118, 181, 238, 238, 243, 48, 146, 246, 238, 236, 1, 191, 189,
+			138, 25, 133, 155, 100, 234, 26, 143, 4, 236, 221, 202, 122, 69,
+			144, 26, 61, 79, 210, 62, 230, 33, 252, 177, 165, 73, 1, 95,
+			247, 161, 34, 63, 211, 139, 228, 120, 221, 63, 244, 154, 190, 83,
+			199, 187, 71, 137, 139, 206, 57, 245, 225, 170, 204, 47, 92, 80,
+			163, 216, 173, 172, 67, 199, 66, 119, 223, 227, 245, 106, 39, 104,
+			74, 91, 101, 86, 228, 236, 6, 205, 194, 251, 8, 93, 6, 211,
+			251, 110, 27, 64, 188, 142, 126, 37, 112, 100, 222, 25, 71, 133,
+			14, 132, 70, 241, 220, 240, 160, 183, 177, 34, 153, 234, 96, 70,
+			213, 111, 131, 29, 31, 28, 91, 93, 181, 77, 63, 46, 62, 109,
+			170, 47, 107, 117, 90, 36, 164, 225, 67, 116, 13, 104, 121, 214,
+			28, 222, 193, 44, 22, 129, 137, 42, 148, 201, 212, 10, 40, 5,
+			205, 175, 169, 217, 194, 111, 26, 100, 114, 183, 55, 23, 118, 146,
+			67, 42, 143, 233, 60, 177, 161, 149, 205, 0, 254, 37, 97, 136,
+			156, 221, 160, 73, 47, 144, 116, 24, 57, 81, 39, 148, 39, 121,
+			84, 12, 68, 52, 4, 23, 203, 59, 97, 69, 150, 72, 204, 138,
+			125, 231, 89, 121, 128, 28, 195, 85, 91, 149, 171, 86, 26, 29,
+			198, 49, 83, 30, 6, 95, 120, 154, 100, 212, 28, 209, 83, 228,
+			196, 245, 210, 246, 245, 106, 105, 253, 218, 102, 117, 119, 99, 123,
+			171, 188, 34, 110, 173, 163, 163, 239, 246, 245, 210, 101, 113, 238,
+			189, 125, 189, 180, 244, 248, 19, 57, 243, 66, 135, 140, 39, 187,
+			72, 207, 144, 83, 187, 91, 235, 155, 165, 213, 234, 246, 78, 105,
+			103, 119, 187, 15, 200, 49, 146, 21, 159, 181, 227, 175, 56, 174,
+			134, 164, 73, 143, 145, 236, 214, 238, 242, 250, 218, 246, 117, 56,
+			140, 167, 99, 100, 180, 92, 169, 108, 194, 45, 95, 27, 142, 197,
+			87, 74, 27, 43, 229, 245, 242, 106, 46, 181, 244, 97, 147, 140,
+			74, 61, 157, 94, 33, 227, 201, 37, 69, 79, 9, 204, 13, 89,
+			102, 249, 30, 68, 193, 162, 120, 134, 140, 37, 168, 158, 206, 138,
+			239, 131, 11, 33, 127, 34, 57, 29, 241, 188, 63, 75, 198, 147,
+			148, 172, 218, 30, 66, 221, 119, 128, 144, 36, 74, 5, 97, 8,
+			161, 30, 1, 225, 181, 25, 29, 254, 73, 137, 140, 210, 212, 196,
+			200, 127, 52, 238, 100, 117, 184, 252, 228, 55, 172, 14, 223, 176,
+			58, 188, 49, 86, 135, 191, 182, 136, 153, 30, 161, 246, 185, 145,
+			119, 25, 249, 47, 89, 172, 124, 187, 237, 135, 60, 100, 53, 223,
+			3, 239, 254, 75, 82, 237, 69, 83, 66, 40, 183, 222, 168, 79,
+			66, 66, 238, 228, 213, 198, 11, 36, 30, 82, 98, 169, 217, 236,
+			221, 146, 169, 13, 95, 200, 230, 156, 90, 212, 113, 154, 172, 125,
+			208, 13, 81, 59, 194, 74, 243, 204, 9, 64, 183, 8, 93, 220,
+			150, 224, 46, 196, 13, 137, 106, 113, 1, 213, 217, 100, 83, 129,
+			222, 110, 51, 220, 41, 133, 81, 208, 169, 161, 127, 3, 123, 31,
+			88, 16, 2, 222, 224, 1, 135, 77, 115, 116, 192, 91, 108, 175,
+			75, 160, 167, 110, 192, 132, 228, 135, 78, 222, 240, 195, 72, 145,
+			166, 50, 42, 168, 189, 67, 105, 107, 13, 136, 1, 21, 67, 116,
+			179, 244, 156, 102, 179, 11, 39, 4, 209, 65, 172, 225, 34, 254,
+			107, 126, 32, 116, 59, 236, 121, 108, 30, 8, 113, 72, 165, 149,
+			117, 60, 44, 106, 118, 81, 193, 172, 183, 92, 47, 92, 16, 43,
+			71, 172, 41, 88, 141, 126, 131, 176, 36, 79, 18, 91, 23, 176,
+			207, 128, 99, 188, 208, 77, 247, 184, 216, 59, 213, 221, 128, 215,
+			34, 209, 23, 113, 43, 59, 132, 207, 13, 215, 115, 154, 238, 171,
+			156, 48, 33, 179, 152, 150, 109, 33, 11, 35, 39, 0, 114, 238,
+			237, 125, 17, 77, 29, 105, 48, 117, 156, 203, 76, 146, 15, 25,
+			196, 78, 227, 73, 206, 3, 230, 91, 243, 135, 108, 75, 220, 137,
+			1, 27, 145, 208, 66, 216, 110, 101, 93, 116, 172, 230, 120, 186,
+			59, 208, 52, 143, 106, 7, 176, 80, 132, 84, 3, 212, 86, 120,
+			212, 9, 188, 144, 109, 108, 238, 84, 175, 110, 238, 110, 172, 66,
+			31, 162, 14, 80, 85, 157, 131, 141, 8, 214, 4, 63, 31, 50,
+			207, 103, 97, 167, 118, 16, 87, 29, 39, 41, 232, 133, 65, 173,
+			7, 210, 84, 165, 76, 106, 61, 48, 245, 176, 74, 89, 212, 122,
+			224, 242, 21, 242, 217, 12, 118, 216, 160, 214, 21, 243, 217, 252,
+			167, 50, 108, 205, 115, 35, 215, 137, 192, 46, 230, 13, 96, 1,
+			122, 181, 41, 201, 65, 125, 4, 27, 152, 172, 35, 182, 69, 2,
+			159, 48, 241, 82, 171, 70, 243, 19, 172, 74, 81, 67, 234, 192,
+			68, 118, 23, 240, 14, 213, 34, 112, 13, 14, 156, 160, 203, 148,
+			11, 11, 155, 107, 7, 254, 45, 183, 206, 235, 236, 150, 235, 176,
+			243, 177, 102, 113, 158, 29, 30, 184, 181, 3, 22, 30, 248, 157,
+			102, 157, 237, 193, 132, 193, 180, 58, 201, 94, 3, 166, 93, 143,
+			137, 99, 116, 77, 155, 21, 30, 118, 90, 184, 14, 37, 149, 160,
+			70, 92, 243, 155, 243, 176, 58, 8, 131, 243, 227, 240, 64, 233,
+			233, 18, 20, 82, 154, 131, 123, 25, 232, 110, 146, 202, 22, 100,
+			87, 14, 221, 102, 147, 53, 157, 142, 87, 59, 192, 69, 194, 146,
+			161, 125, 212, 2, 17, 35, 62, 31, 50, 80, 230, 24, 238, 41,
+			197, 106, 225, 1, 11, 221, 58, 28, 217, 177, 53, 185, 17, 20,
+			88, 4, 147, 133, 96, 15, 162, 74, 18, 14, 152, 2, 15, 29,
+			73, 186, 178, 167, 78, 51, 224, 78, 189, 187, 192, 220, 136, 32,
+			137, 73, 20, 66, 89, 68, 162, 236, 130, 56, 63, 44, 178, 53,
+			185, 175, 173, 57, 97, 140, 35, 144, 61, 1, 111, 55, 187, 12,
+			101, 37, 97, 206, 161, 3, 84, 239, 68, 73, 58, 83, 109, 49,
+			126, 219, 13, 163, 16, 150, 82, 192, 35, 240, 252, 222, 103, 165,
+			245, 74, 185, 180, 250, 82, 181, 252, 206, 181, 237, 157, 109, 73,
+			183, 4, 9, 119, 112, 140, 117, 159, 135, 222, 121, 49, 214, 120,
+			168, 93, 30, 177, 185, 54, 15, 14, 156, 118, 200, 220, 228, 184,
+			207, 135, 108, 207, 175, 119, 25, 176, 54, 21, 108, 160, 174, 176,
+			217, 104, 118, 231, 97, 244, 44, 140, 96, 70, 0, 5, 126, 155,
+			123, 195, 168, 25, 38, 28, 186, 22, 68, 132, 233, 0, 12, 8,
+			4, 152, 97, 145, 189, 120, 192, 61, 197, 18, 250, 8, 34, 201,
+			191, 112, 230, 107, 78, 179, 134, 38, 95, 130, 80, 1, 17, 129,
+			50, 2, 133, 16, 38, 193, 219, 31, 50, 131, 128, 139, 146, 199,
+			250, 148, 29, 89, 91, 241, 26, 55, 148, 188, 16, 165, 137, 227,
+			122, 48, 223, 55, 57, 252, 1, 62, 178, 31, 56, 94, 68, 36,
+			71, 83, 253, 147, 150, 234, 200, 103, 135, 7, 62, 191, 197, 3,
+			214, 246, 195, 16, 206, 134, 161, 22, 111, 193, 129, 61, 34, 73,
+			45, 32, 6, 33, 38, 0, 141, 78, 72, 128, 89, 113, 176, 66,
+			20, 241, 252, 188, 191, 119, 176, 146, 91, 104, 137, 6, 207, 41,
+			197, 108, 12, 96, 33, 233, 227, 42, 101, 82, 235, 10, 125, 80,
+			165, 44, 106, 93, 89, 124, 134, 252, 108, 10, 153, 141, 73, 173,
+			231, 205, 229, 252, 63, 77, 201, 133, 164, 228, 31, 23, 204, 191,
+			127, 154, 22, 36, 62, 224, 155, 27, 133, 204, 227, 135, 146, 160,
+			0, 125, 43, 146, 123, 131, 152, 224, 183, 219, 188, 22, 73, 126,
+			138, 160, 143, 94, 253, 106, 217, 179, 134, 27, 132, 17, 81, 214,
+			117, 88, 226, 208, 80, 114, 137, 23, 217, 85, 199, 109, 118, 2,
+			14, 107, 191, 238, 3, 234, 228, 156, 11, 245, 78, 81, 5, 73,
+			144, 86, 228, 51, 160, 141, 78, 27, 52, 40, 84, 240, 177, 207,
+			92, 159, 27, 64, 67, 192, 35, 245, 205, 141, 8, 9, 199, 69,
+			137, 21, 15, 4, 102, 88, 9, 40, 108, 244, 38, 231, 109, 214,
+			246, 177, 151, 4, 166, 80, 44, 141, 46, 114, 2, 232, 224, 161,
+			227, 70, 177, 14, 132, 241, 67, 32, 27, 217, 81, 119, 128, 147,
+			136, 158, 243, 58, 185, 163, 220, 145, 203, 79, 179, 227, 254, 41,
+			210, 75, 24, 89, 1, 192, 40, 195, 230, 11, 36, 0, 172, 137,
+			186, 226, 241, 170, 53, 161, 14, 227, 34, 134, 121, 131, 179, 237,
+			14, 88, 121, 176, 232, 65, 224, 119, 246, 15, 216, 121, 192, 87,
+			39, 60, 79, 164, 171, 67, 79, 135, 227, 166, 23, 164, 85, 31,
+			113, 0, 192, 64, 237, 214, 226, 189, 167, 125, 224, 23, 160, 89,
+			0, 234, 23, 216, 94, 39, 98, 129, 3, 34, 180, 183, 119, 137,
+			81, 185, 81, 200, 155, 13, 77, 226, 96, 153, 127, 94, 203, 83,
+			36, 99, 45, 79, 77, 139, 90, 207, 95, 126, 150, 252, 149, 133,
+			36, 110, 81, 235, 37, 115, 57, 255, 39, 22, 75, 238, 113, 152,
+			179, 231, 7, 209, 157, 169, 29, 144, 183, 22, 49, 184, 140, 26,
+			194, 244, 70, 62, 83, 91, 66, 65, 65, 192, 11, 145, 241, 131,
+			220, 101, 122, 163, 41, 63, 2, 13, 213, 192, 18, 29, 178, 78,
+			27, 120, 81, 55, 33, 88, 247, 157, 96, 207, 217, 231, 69, 61,
+			195, 48, 234, 22, 40, 112, 1, 175, 1, 39, 22, 48, 252, 198,
+			17, 68, 61, 119, 120, 224, 68, 200, 77, 92, 176, 9, 119, 217,
+			30, 159, 135, 254, 174, 250, 28, 148, 144, 8, 197, 166, 98, 213,
+			186, 22, 106, 96, 66, 82, 212, 16, 25, 160, 202, 5, 172, 225,
+			184, 77, 94, 79, 208, 219, 21, 216, 206, 188, 126, 154, 99, 140,
+			93, 45, 173, 173, 151, 209, 71, 94, 239, 35, 20, 152, 129, 218,
+			176, 244, 60, 166, 119, 226, 208, 35, 189, 75, 151, 251, 42, 129,
+			141, 57, 183, 200, 139, 146, 157, 136, 158, 239, 113, 24, 167, 20,
+			12, 188, 206, 60, 255, 112, 94, 19, 137, 101, 80, 235, 37, 77,
+			36, 150, 73, 173, 151, 52, 145, 192, 177, 203, 75, 151, 159, 37,
+			223, 102, 18, 51, 53, 66, 237, 250, 200, 129, 145, 255, 239, 13,
+			38, 111, 231, 241, 58, 138, 8, 0, 14, 22, 166, 192, 141, 14,
+			90, 82, 123, 150, 74, 231, 29, 182, 19, 128, 200, 117, 55, 226,
+			129, 211, 100, 96, 23, 19, 138, 179, 112, 181, 113, 188, 104, 96,
+			153, 40, 189, 179, 230, 123, 66, 235, 239, 99, 149, 132, 129, 107,
+			107, 152, 208, 219, 139, 100, 105, 115, 241, 13, 253, 135, 186, 115,
+			10, 116, 231, 122, 234, 24, 25, 35, 118, 10, 85, 103, 110, 222,
+			7, 8, 131, 132, 65, 45, 110, 206, 168, 148, 73, 45, 158, 63,
+			45, 11, 26, 212, 106, 232, 130, 32, 125, 26, 102, 90, 165, 76,
+			106, 53, 116, 65, 147, 90, 251, 186, 32, 172, 225, 125, 113, 132,
+			8, 41, 248, 150, 63, 77, 158, 19, 7, 197, 205, 17, 223, 200,
+			63, 195, 74, 201, 125, 143, 31, 235, 228, 234, 88, 231, 206, 179,
+			160, 78, 62, 155, 153, 227, 228, 237, 234, 228, 179, 101, 158, 42,
+			44, 1, 36, 61, 177, 98, 215, 29, 179, 70, 100, 134, 145, 207,
+			246, 121, 132, 83, 13, 115, 168, 204, 193, 35, 105, 128, 160, 83,
+			6, 181, 90, 99, 211, 42, 101, 81, 171, 53, 51, 43, 15, 89,
+			13, 106, 121, 230, 41, 121, 200, 10, 32, 152, 19, 50, 216, 253,
+			4, 168, 224, 29, 240, 219, 160, 233, 224, 110, 30, 109, 231, 231,
+			157, 189, 90, 157, 55, 138, 197, 226, 121, 5, 30, 78, 68, 61,
+			109, 229, 6, 196, 122, 250, 36, 0, 78, 68, 189, 153, 89, 244,
+			99, 51, 168, 29, 140, 188, 42, 252, 216, 160, 84, 144, 57, 77,
+			86, 136, 109, 27, 48, 222, 200, 156, 201, 63, 209, 143, 200, 88,
+			239, 73, 106, 127, 90, 131, 197, 29, 144, 116, 90, 51, 112, 204,
+			145, 57, 166, 82, 6, 181, 162, 113, 170, 82, 22, 181, 162, 19,
+			39, 201, 167, 13, 108, 207, 160, 86, 215, 60, 151, 255, 164, 1,
+			186, 51, 56, 1, 114, 160, 119, 104, 66, 43, 82, 176, 23, 64,
+			241, 185, 39, 101, 162, 212, 233, 87, 228, 84, 174, 186, 33, 238,
+			132, 65, 191, 145, 135, 71, 114, 251, 232, 213, 154, 157, 186, 212,
+			80, 246, 221, 91, 168, 17, 10, 203, 115, 17, 249, 180, 115, 19,
+			142, 230, 3, 255, 48, 228, 65, 200, 66, 231, 22, 174, 49, 44,
+			20, 27, 18, 136, 172, 138, 213, 212, 8, 1, 209, 93, 137, 104,
+			195, 4, 20, 118, 179, 121, 149, 178, 168, 213, 61, 115, 150, 140,
+			17, 211, 54, 105, 234, 253, 35, 127, 223, 16, 152, 6, 250, 125,
+			127, 230, 56, 249, 180, 73, 108, 219, 52, 71, 168, 253, 173, 134,
+			57, 155, 255, 49, 147, 149, 212, 14, 243, 250, 206, 206, 214, 182,
+			216, 103, 250, 131, 90, 51, 240, 137, 171, 128, 105, 96, 51, 110,
+			172, 124, 75, 131, 16, 158, 222, 192, 41, 99, 77, 72, 35, 176,
+			100, 184, 96, 15, 112, 26, 17, 108, 82, 192, 107, 161, 227, 73,
+			211, 10, 168, 13, 225, 129, 31, 68, 204, 105, 249, 29, 79, 216,
+			1, 92, 137, 153, 65, 53, 6, 207, 250, 164, 26, 3, 154, 19,
+			40, 47, 173, 22, 175, 195, 118, 19, 216, 11, 65, 123, 28, 116,
+			220, 69, 198, 190, 223, 113, 64, 183, 149, 150, 168, 3, 64, 45,
+			200, 51, 184, 133, 232, 130, 178, 29, 104, 230, 20, 27, 46, 138,
+			108, 213, 7, 81, 68, 152, 19, 129, 224, 67, 1, 218, 118, 2,
+			108, 174, 72, 200, 49, 146, 2, 180, 165, 16, 111, 25, 149, 52,
+			32, 153, 157, 82, 73, 11, 146, 39, 103, 200, 203, 196, 76, 25,
+			52, 253, 157, 198, 200, 247, 25, 198, 27, 207, 252, 198, 136, 149,
+			50, 12, 106, 127, 167, 145, 2, 189, 217, 78, 193, 210, 177, 191,
+			203, 48, 25, 116, 4, 82, 6, 38, 79, 171, 164, 9, 201, 179,
+			231, 224, 216, 62, 101, 152, 6, 181, 191, 219, 48, 115, 5, 166,
+			183, 44, 160, 58, 10, 225, 164, 21, 45, 207, 63, 36, 178, 58,
+			52, 245, 221, 134, 57, 166, 146, 38, 36, 39, 38, 225, 196, 45,
+			101, 152, 38, 181, 63, 10, 208, 230, 123, 73, 6, 4, 82, 12,
+			86, 5, 185, 73, 130, 133, 126, 124, 52, 6, 43, 0, 77, 76,
+			130, 87, 68, 202, 48, 45, 106, 127, 15, 128, 189, 144, 0, 11,
+			82, 142, 237, 113, 238, 49, 140, 222, 30, 30, 160, 121, 8, 11,
+			172, 148, 182, 21, 92, 203, 192, 170, 10, 174, 101, 66, 114, 98,
+			18, 120, 106, 202, 48, 109, 106, 127, 12, 224, 94, 134, 106, 1,
+			103, 135, 60, 224, 172, 225, 68, 78, 147, 161, 221, 63, 100, 245,
+			78, 160, 54, 109, 82, 84, 35, 69, 43, 240, 182, 129, 16, 178,
+			42, 105, 66, 114, 98, 18, 14, 130, 83, 134, 153, 162, 246, 247,
+			2, 248, 75, 125, 186, 204, 161, 19, 198, 74, 12, 236, 167, 147,
+			234, 157, 2, 157, 50, 176, 182, 78, 154, 144, 156, 0, 71, 21,
+			211, 182, 104, 250, 251, 141, 145, 127, 102, 24, 100, 140, 88, 54,
+			140, 241, 251, 141, 76, 158, 124, 139, 77, 108, 219, 130, 233, 255,
+			81, 195, 156, 201, 255, 165, 245, 26, 121, 103, 187, 163, 165, 147,
+			148, 68, 11, 76, 57, 60, 200, 13, 247, 209, 59, 247, 5, 212,
+			138, 113, 89, 202, 93, 19, 242, 198, 35, 246, 240, 104, 18, 64,
+			139, 128, 222, 201, 199, 238, 200, 33, 67, 215, 105, 111, 159, 205,
+			129, 39, 187, 19, 242, 100, 119, 143, 220, 232, 207, 47, 144, 100,
+			185, 27, 187, 219, 59, 232, 179, 223, 236, 50, 125, 224, 38, 154,
+			88, 0, 100, 52, 121, 212, 179, 3, 71, 120, 160, 155, 146, 65,
+			5, 42, 177, 203, 85, 254, 211, 106, 175, 14, 228, 209, 67, 232,
+			138, 5, 233, 205, 188, 80, 200, 180, 228, 136, 247, 229, 78, 237,
+			166, 154, 21, 217, 103, 39, 68, 198, 132, 86, 200, 254, 29, 243,
+			220, 121, 217, 140, 24, 195, 60, 236, 235, 147, 27, 76, 100, 141,
+			146, 57, 89, 32, 249, 236, 31, 149, 132, 15, 52, 97, 0, 81,
+			140, 83, 149, 180, 32, 121, 226, 36, 249, 42, 8, 63, 11, 86,
+			223, 79, 26, 230, 169, 252, 159, 27, 172, 148, 84, 47, 164, 191,
+			56, 236, 88, 121, 224, 222, 82, 212, 3, 214, 66, 80, 11, 228,
+			234, 16, 124, 34, 100, 135, 96, 234, 208, 75, 133, 12, 49, 85,
+			192, 220, 194, 214, 10, 204, 50, 154, 172, 84, 112, 96, 160, 53,
+			61, 70, 55, 84, 66, 88, 18, 200, 158, 31, 29, 196, 159, 97,
+			135, 114, 94, 207, 234, 121, 84, 70, 181, 208, 150, 165, 138, 137,
+			239, 72, 12, 45, 39, 2, 187, 90, 162, 158, 70, 151, 145, 70,
+			12, 232, 36, 34, 100, 108, 90, 37, 45, 72, 206, 128, 31, 134,
+			105, 219, 52, 253, 211, 198, 200, 255, 36, 23, 31, 112, 128, 159,
+			54, 50, 167, 201, 59, 136, 109, 219, 176, 246, 62, 101, 152, 247,
+			231, 87, 0, 143, 189, 142, 25, 195, 108, 71, 49, 45, 116, 89,
+			226, 40, 13, 150, 146, 236, 154, 141, 98, 230, 83, 74, 204, 216,
+			56, 147, 159, 50, 178, 247, 169, 164, 5, 45, 158, 99, 228, 43,
+			32, 203, 109, 152, 201, 207, 24, 230, 233, 252, 23, 76, 208, 99,
+			66, 30, 45, 48, 87, 234, 230, 161, 54, 96, 68, 62, 11, 111,
+			186, 237, 120, 245, 244, 152, 25, 1, 183, 104, 184, 119, 194, 176,
+			211, 194, 181, 167, 76, 217, 188, 158, 228, 190, 177, 54, 19, 211,
+			189, 27, 222, 193, 88, 175, 76, 250, 194, 194, 42, 227, 99, 198,
+			246, 32, 208, 46, 5, 21, 161, 77, 34, 10, 58, 161, 220, 231,
+			234, 214, 193, 166, 198, 230, 160, 48, 191, 237, 180, 218, 77, 14,
+			150, 83, 238, 65, 113, 137, 92, 208, 7, 112, 199, 13, 187, 41,
+			6, 225, 3, 96, 227, 161, 245, 6, 32, 148, 58, 247, 92, 176,
+			63, 132, 96, 204, 241, 147, 188, 167, 200, 74, 177, 192, 151, 218,
+			133, 48, 189, 133, 4, 88, 227, 86, 185, 114, 99, 109, 27, 195,
+			24, 173, 150, 55, 214, 202, 171, 122, 150, 128, 128, 62, 163, 214,
+			155, 13, 138, 152, 253, 25, 99, 252, 164, 74, 90, 240, 245, 20,
+			40, 102, 166, 157, 162, 233, 207, 26, 35, 255, 82, 18, 16, 240,
+			249, 207, 42, 2, 74, 1, 1, 253, 202, 27, 74, 64, 41, 36,
+			160, 95, 81, 4, 148, 66, 2, 250, 21, 69, 64, 41, 36, 160,
+			95, 49, 206, 49, 236, 90, 154, 166, 127, 213, 192, 19, 79, 16,
+			44, 105, 131, 218, 191, 106, 100, 102, 200, 143, 0, 151, 72, 67,
+			223, 126, 221, 48, 243, 249, 239, 69, 46, 225, 183, 157, 87, 58,
+			92, 238, 4, 164, 158, 171, 250, 11, 179, 230, 134, 71, 153, 37,
+			28, 160, 69, 160, 129, 132, 85, 65, 249, 251, 40, 195, 215, 77,
+			208, 145, 253, 160, 151, 195, 193, 178, 96, 59, 96, 99, 68, 203,
+			180, 19, 198, 70, 70, 49, 15, 105, 28, 236, 175, 171, 193, 166,
+			113, 176, 191, 110, 100, 79, 168, 164, 5, 67, 152, 61, 69, 126,
+			79, 140, 200, 160, 246, 239, 128, 230, 251, 27, 134, 60, 82, 209,
+			156, 56, 193, 237, 95, 131, 249, 159, 69, 190, 62, 245, 25, 170,
+			52, 111, 248, 125, 186, 49, 115, 19, 199, 168, 177, 5, 30, 233,
+			92, 218, 155, 160, 107, 202, 212, 234, 52, 67, 95, 74, 34, 210,
+			107, 112, 29, 68, 133, 145, 194, 209, 41, 84, 0, 73, 254, 142,
+			210, 79, 211, 72, 146, 191, 99, 156, 156, 33, 139, 136, 9, 147,
+			218, 159, 131, 185, 189, 159, 9, 247, 132, 94, 251, 77, 207, 20,
+			138, 250, 102, 26, 107, 76, 72, 112, 192, 120, 62, 103, 76, 42,
+			68, 131, 202, 246, 57, 99, 246, 20, 249, 172, 64, 180, 69, 237,
+			223, 3, 157, 228, 103, 12, 118, 213, 15, 18, 22, 19, 48, 143,
+			116, 66, 181, 209, 234, 211, 85, 98, 205, 46, 182, 43, 42, 22,
+			227, 122, 73, 29, 190, 19, 242, 70, 167, 153, 56, 31, 74, 72,
+			163, 35, 197, 16, 193, 195, 2, 143, 117, 188, 200, 109, 38, 71,
+			139, 170, 8, 72, 86, 52, 45, 137, 17, 89, 105, 28, 194, 152,
+			28, 32, 40, 93, 191, 167, 4, 106, 218, 180, 112, 128, 39, 78,
+			226, 230, 53, 13, 138, 229, 231, 129, 11, 63, 142, 163, 69, 203,
+			109, 98, 172, 14, 59, 232, 180, 28, 224, 254, 78, 29, 15, 145,
+			122, 252, 197, 116, 139, 118, 10, 161, 168, 249, 3, 73, 243, 121,
+			35, 123, 82, 37, 45, 72, 158, 202, 107, 23, 174, 95, 50, 200,
+			233, 254, 59, 153, 192, 202, 186, 210, 93, 107, 224, 81, 167, 81,
+			146, 42, 195, 247, 229, 247, 15, 191, 136, 74, 240, 235, 22, 36,
+			183, 140, 119, 21, 239, 122, 5, 21, 145, 41, 154, 236, 185, 131,
+			122, 182, 255, 14, 234, 139, 188, 217, 124, 30, 10, 239, 128, 155,
+			185, 30, 192, 31, 164, 200, 125, 253, 3, 16, 146, 235, 168, 17,
+			124, 220, 32, 233, 109, 44, 65, 159, 38, 105, 228, 224, 42, 180,
+			193, 96, 112, 80, 81, 80, 220, 23, 13, 241, 130, 83, 69, 86,
+			201, 191, 131, 140, 37, 178, 225, 74, 245, 77, 222, 149, 158, 73,
+			214, 77, 222, 165, 11, 201, 240, 135, 112, 253, 180, 31, 56, 134,
+			187, 145, 97, 17, 175, 152, 111, 49, 10, 159, 50, 73, 10, 51,
+			233, 211, 132, 120, 240, 208, 6, 126, 147, 78, 113, 249, 1, 0,
+			27, 157, 102, 19, 203, 95, 31, 169, 100, 61, 149, 160, 15, 144,
+			113, 17, 181, 93, 86, 135, 246, 141, 235, 35, 149, 49, 145, 171,
+			11, 245, 68, 236, 1, 183, 168, 236, 245, 145, 222, 152, 61, 231,
+			8, 1, 247, 98, 89, 4, 188, 161, 50, 208, 20, 228, 137, 2,
+			111, 37, 227, 2, 219, 178, 8, 56, 64, 141, 45, 205, 12, 244,
+			84, 224, 81, 130, 239, 212, 34, 61, 202, 166, 27, 170, 186, 105,
+			172, 59, 56, 74, 112, 199, 215, 163, 108, 170, 196, 114, 154, 216,
+			55, 93, 175, 94, 120, 154, 100, 117, 9, 90, 36, 105, 4, 166,
+			102, 244, 40, 164, 203, 82, 23, 78, 147, 172, 70, 34, 4, 121,
+			218, 216, 93, 95, 135, 208, 31, 187, 229, 220, 200, 242, 255, 127,
+			56, 137, 143, 137, 193, 40, 26, 95, 188, 71, 26, 23, 3, 127,
+			93, 68, 254, 85, 131, 156, 235, 39, 114, 125, 85, 238, 40, 58,
+			127, 154, 100, 119, 84, 25, 184, 24, 39, 29, 61, 145, 152, 172,
+			138, 74, 194, 69, 55, 207, 241, 252, 80, 190, 19, 32, 18, 203,
+			223, 106, 12, 31, 251, 132, 6, 169, 134, 191, 116, 143, 195, 215,
+			253, 125, 93, 24, 248, 67, 131, 156, 234, 199, 128, 227, 29, 201,
+			165, 158, 32, 86, 201, 235, 210, 83, 36, 3, 92, 38, 225, 145,
+			57, 10, 105, 240, 7, 236, 137, 77, 58, 46, 23, 225, 242, 173,
+			225, 163, 206, 148, 188, 174, 26, 239, 194, 61, 142, 215, 241, 186,
+			237, 189, 215, 58, 204, 31, 204, 145, 25, 57, 204, 160, 93, 91,
+			148, 103, 141, 8, 159, 18, 217, 112, 208, 174, 229, 143, 198, 69,
+			161, 1, 220, 13, 170, 193, 149, 71, 56, 245, 198, 145, 167, 42,
+			248, 27, 232, 64, 249, 42, 202, 167, 240, 100, 146, 22, 201, 104,
+			157, 71, 142, 219, 12, 229, 59, 96, 211, 253, 104, 40, 150, 188,
+			110, 69, 21, 90, 118, 200, 68, 2, 85, 65, 187, 182, 60, 38,
+			218, 85, 136, 122, 114, 16, 81, 251, 220, 67, 96, 114, 201, 56,
+			109, 55, 76, 140, 243, 105, 241, 71, 80, 71, 101, 107, 229, 185,
+			15, 77, 136, 27, 124, 143, 24, 228, 51, 177, 47, 221, 207, 244,
+			221, 224, 123, 76, 41, 90, 235, 235, 43, 127, 23, 157, 232, 190,
+			225, 67, 247, 55, 231, 67, 55, 165, 46, 233, 209, 145, 7, 164,
+			59, 221, 244, 200, 57, 200, 204, 156, 131, 155, 123, 231, 100, 230,
+			137, 145, 13, 229, 99, 7, 63, 69, 230, 201, 145, 2, 102, 18,
+			241, 83, 100, 206, 140, 44, 96, 166, 252, 41, 50, 103, 71, 206,
+			99, 166, 33, 126, 138, 204, 83, 35, 247, 99, 230, 131, 226, 231,
+			47, 89, 226, 228, 167, 48, 242, 136, 145, 255, 25, 11, 13, 208,
+			239, 21, 43, 232, 189, 120, 149, 46, 113, 75, 176, 233, 239, 163,
+			171, 157, 84, 249, 252, 58, 111, 202, 189, 19, 4, 152, 112, 197,
+			61, 193, 134, 31, 192, 212, 194, 157, 60, 48, 208, 181, 3, 127,
+			63, 112, 90, 45, 48, 53, 113, 239, 150, 27, 248, 30, 198, 186,
+			89, 144, 7, 11, 144, 95, 41, 111, 239, 192, 13, 60, 216, 89,
+			227, 70, 9, 19, 210, 134, 46, 29, 155, 246, 186, 236, 221, 112,
+			1, 226, 229, 57, 117, 65, 111, 223, 141, 14, 58, 123, 120, 53,
+			111, 63, 104, 215, 230, 139, 172, 236, 212, 14, 226, 206, 75, 142,
+			162, 125, 70, 192, 192, 22, 112, 206, 218, 46, 7, 127, 52, 191,
+			129, 22, 227, 43, 194, 90, 138, 142, 57, 11, 242, 183, 172, 41,
+			188, 5, 69, 150, 100, 55, 160, 196, 195, 178, 170, 193, 69, 39,
+			215, 171, 51, 191, 3, 39, 213, 1, 135, 131, 237, 14, 216, 228,
+			220, 176, 7, 61, 0, 225, 0, 108, 125, 62, 59, 244, 131, 155,
+			98, 153, 186, 202, 84, 73, 216, 187, 193, 67, 112, 149, 195, 193,
+			5, 187, 214, 113, 235, 252, 229, 185, 35, 47, 32, 34, 159, 170,
+			99, 217, 69, 236, 85, 56, 159, 56, 109, 43, 100, 38, 8, 87,
+			167, 109, 15, 153, 199, 243, 239, 196, 185, 76, 56, 204, 41, 71,
+			45, 185, 61, 220, 227, 176, 43, 135, 136, 124, 250, 14, 17, 97,
+			239, 150, 237, 5, 237, 90, 17, 46, 79, 191, 60, 144, 17, 7,
+			85, 128, 251, 98, 250, 138, 6, 116, 225, 161, 204, 184, 74, 193,
+			125, 177, 201, 28, 249, 146, 161, 14, 229, 46, 154, 39, 242, 159,
+			55, 88, 137, 213, 33, 108, 2, 108, 201, 46, 53, 156, 26, 204,
+			126, 31, 206, 251, 251, 232, 122, 172, 236, 237, 55, 221, 240, 160,
+			200, 74, 94, 23, 201, 97, 120, 221, 68, 165, 166, 95, 147, 71,
+			211, 48, 1, 96, 137, 139, 49, 158, 24, 144, 32, 149, 162, 156,
+			220, 151, 239, 240, 73, 217, 94, 253, 32, 134, 77, 148, 105, 72,
+			108, 187, 53, 98, 140, 158, 219, 86, 6, 222, 182, 82, 151, 184,
+			224, 252, 240, 226, 212, 52, 233, 170, 27, 147, 139, 230, 197, 124,
+			147, 149, 24, 40, 148, 176, 209, 147, 99, 145, 174, 77, 53, 39,
+			8, 68, 27, 189, 100, 200, 96, 110, 193, 1, 9, 172, 8, 224,
+			60, 226, 123, 44, 228, 112, 252, 68, 52, 54, 96, 241, 134, 200,
+			199, 96, 53, 73, 163, 144, 238, 164, 105, 67, 219, 58, 149, 166,
+			214, 226, 216, 89, 149, 130, 123, 73, 231, 30, 150, 247, 146, 192,
+			177, 99, 113, 254, 130, 86, 14, 126, 238, 187, 45, 242, 200, 189,
+			221, 183, 1, 239, 90, 169, 27, 225, 133, 155, 252, 157, 54, 121,
+			249, 59, 110, 160, 242, 119, 211, 60, 243, 71, 105, 44, 249, 197,
+			123, 235, 172, 190, 28, 84, 56, 79, 142, 137, 123, 183, 210, 37,
+			31, 95, 94, 192, 12, 169, 190, 201, 84, 225, 21, 50, 211, 83,
+			112, 211, 91, 230, 7, 78, 179, 177, 217, 128, 160, 14, 234, 58,
+			161, 172, 164, 211, 244, 10, 153, 16, 0, 170, 242, 22, 148, 220,
+			154, 77, 9, 207, 255, 30, 144, 149, 99, 237, 100, 178, 240, 9,
+			147, 76, 136, 2, 234, 41, 150, 163, 122, 71, 25, 25, 107, 184,
+			222, 62, 68, 166, 117, 61, 117, 73, 41, 153, 69, 159, 38, 99,
+			34, 66, 73, 21, 212, 226, 89, 235, 136, 157, 143, 86, 182, 43,
+			68, 20, 135, 12, 122, 78, 87, 134, 21, 41, 99, 96, 200, 2,
+			112, 21, 145, 46, 16, 219, 169, 53, 67, 249, 138, 170, 188, 116,
+			209, 219, 247, 98, 105, 101, 189, 130, 165, 242, 101, 98, 149, 86,
+			214, 233, 89, 98, 7, 126, 83, 197, 67, 37, 162, 82, 197, 111,
+			242, 10, 230, 195, 83, 201, 48, 156, 154, 219, 118, 154, 176, 89,
+			128, 168, 24, 137, 156, 66, 149, 204, 172, 121, 7, 60, 112, 35,
+			94, 239, 195, 211, 42, 153, 106, 243, 64, 62, 148, 163, 159, 185,
+			145, 59, 180, 233, 97, 221, 171, 28, 111, 243, 160, 55, 171, 240,
+			109, 6, 57, 1, 253, 9, 215, 60, 53, 81, 232, 227, 203, 233,
+			51, 36, 5, 93, 84, 123, 190, 185, 184, 239, 3, 101, 49, 87,
+			103, 138, 106, 249, 34, 25, 79, 102, 223, 13, 21, 133, 54, 57,
+			30, 95, 17, 191, 11, 169, 210, 251, 224, 145, 140, 90, 39, 8,
+			221, 91, 92, 198, 75, 143, 51, 232, 67, 100, 66, 158, 243, 87,
+			15, 220, 122, 157, 139, 56, 109, 153, 202, 49, 153, 123, 29, 51,
+			11, 235, 132, 38, 91, 148, 227, 206, 147, 140, 116, 167, 23, 67,
+			207, 86, 116, 26, 150, 129, 192, 55, 87, 147, 165, 211, 133, 11,
+			100, 66, 190, 16, 164, 58, 159, 136, 182, 34, 247, 73, 50, 89,
+			248, 57, 131, 100, 214, 18, 225, 85, 134, 23, 163, 23, 19, 65,
+			89, 142, 184, 41, 166, 11, 136, 224, 33, 251, 110, 24, 241, 128,
+			215, 171, 123, 93, 25, 225, 101, 60, 206, 92, 238, 210, 183, 247,
+			20, 146, 129, 253, 238, 188, 72, 18, 0, 118, 66, 136, 180, 63,
+			91, 145, 25, 106, 4, 26, 117, 143, 232, 235, 96, 234, 149, 9,
+			25, 4, 0, 202, 11, 163, 101, 223, 165, 176, 11, 3, 35, 148,
+			23, 240, 52, 236, 120, 128, 75, 36, 171, 175, 188, 201, 197, 61,
+			252, 114, 81, 37, 163, 238, 191, 21, 154, 100, 26, 166, 88, 65,
+			11, 239, 58, 53, 16, 27, 185, 13, 193, 106, 67, 247, 85, 142,
+			47, 156, 165, 96, 254, 247, 249, 182, 251, 42, 135, 235, 112, 240,
+			187, 138, 70, 113, 124, 173, 4, 194, 191, 192, 227, 225, 144, 81,
+			104, 145, 19, 125, 173, 73, 196, 44, 144, 172, 26, 134, 90, 79,
+			253, 227, 140, 11, 208, 135, 201, 164, 199, 111, 71, 213, 68, 83,
+			211, 216, 212, 49, 200, 222, 210, 205, 125, 23, 62, 249, 231, 4,
+			181, 131, 215, 48, 190, 51, 50, 100, 15, 144, 239, 216, 82, 86,
+			244, 98, 199, 217, 151, 209, 123, 190, 150, 225, 251, 100, 102, 160,
+			59, 95, 87, 4, 252, 146, 65, 44, 184, 235, 58, 236, 221, 192,
+			163, 159, 167, 79, 174, 42, 235, 110, 171, 234, 28, 25, 107, 249,
+			117, 56, 72, 193, 53, 37, 197, 130, 202, 90, 238, 210, 167, 19,
+			5, 34, 21, 70, 244, 142, 66, 71, 21, 223, 9, 11, 207, 146,
+			220, 42, 111, 242, 136, 195, 165, 79, 57, 117, 175, 105, 48, 133,
+			139, 100, 18, 72, 174, 194, 27, 119, 159, 251, 194, 101, 146, 139,
+			11, 203, 153, 57, 67, 236, 128, 55, 212, 164, 72, 122, 128, 238,
+			96, 118, 225, 35, 6, 177, 118, 156, 253, 33, 134, 216, 161, 239,
+			208, 128, 24, 117, 34, 25, 8, 84, 243, 32, 162, 178, 4, 190,
+			116, 129, 123, 226, 63, 186, 242, 78, 88, 232, 146, 227, 37, 76,
+			237, 56, 251, 247, 64, 235, 175, 137, 127, 170, 133, 97, 13, 93,
+			24, 208, 244, 42, 255, 219, 105, 250, 247, 13, 146, 83, 203, 68,
+			9, 238, 187, 77, 136, 50, 190, 193, 37, 95, 233, 223, 152, 124,
+			33, 115, 76, 230, 129, 161, 172, 95, 179, 178, 7, 53, 171, 190,
+			89, 77, 221, 109, 86, 211, 175, 105, 86, 63, 102, 144, 19, 98,
+			90, 213, 232, 222, 96, 252, 46, 37, 66, 146, 89, 210, 130, 221,
+			195, 124, 116, 187, 186, 28, 118, 106, 149, 191, 201, 58, 245, 147,
+			6, 153, 130, 21, 252, 117, 234, 18, 37, 246, 77, 222, 21, 75,
+			32, 91, 193, 223, 95, 147, 60, 12, 200, 116, 111, 111, 37, 207,
+			73, 14, 221, 184, 183, 161, 223, 179, 76, 120, 30, 98, 154, 135,
+			126, 243, 22, 151, 6, 201, 187, 227, 40, 17, 223, 78, 114, 87,
+			153, 44, 188, 135, 156, 184, 198, 181, 60, 79, 132, 62, 120, 99,
+			16, 94, 248, 102, 131, 204, 36, 26, 88, 229, 205, 123, 153, 211,
+			7, 136, 189, 231, 132, 71, 130, 199, 143, 112, 221, 94, 60, 221,
+			118, 148, 140, 147, 159, 11, 63, 96, 146, 99, 61, 29, 160, 243,
+			125, 106, 220, 113, 81, 21, 63, 246, 233, 111, 11, 36, 91, 135,
+			108, 29, 30, 160, 175, 33, 64, 89, 6, 75, 192, 241, 192, 67,
+			36, 133, 191, 143, 234, 146, 248, 10, 106, 6, 254, 16, 52, 7,
+			236, 200, 170, 136, 102, 80, 11, 1, 102, 84, 175, 67, 236, 110,
+			184, 35, 43, 223, 115, 38, 152, 5, 65, 36, 66, 208, 132, 107,
+			7, 240, 248, 179, 42, 34, 194, 19, 142, 203, 76, 93, 72, 62,
+			41, 38, 11, 141, 170, 119, 161, 49, 19, 11, 21, 190, 207, 36,
+			217, 45, 17, 176, 197, 15, 232, 4, 49, 117, 148, 4, 211, 173,
+			211, 139, 248, 64, 116, 164, 222, 151, 144, 202, 168, 46, 143, 70,
+			23, 94, 17, 101, 64, 238, 169, 131, 236, 170, 12, 190, 125, 23,
+			14, 169, 138, 239, 132, 116, 145, 164, 133, 239, 203, 172, 125, 199,
+			163, 192, 138, 44, 6, 178, 0, 77, 44, 50, 118, 130, 72, 20,
+			174, 146, 20, 246, 9, 94, 9, 128, 72, 7, 229, 190, 64, 7,
+			99, 100, 116, 171, 188, 17, 135, 57, 216, 222, 93, 89, 41, 151,
+			87, 33, 102, 62, 196, 79, 16, 215, 49, 114, 86, 225, 219, 77,
+			50, 35, 66, 35, 239, 113, 69, 58, 111, 236, 202, 128, 57, 172,
+			203, 22, 170, 160, 136, 200, 29, 220, 184, 202, 4, 29, 166, 167,
+			16, 10, 80, 187, 183, 16, 136, 106, 241, 232, 156, 44, 212, 86,
+			115, 35, 20, 181, 12, 60, 58, 39, 62, 233, 89, 11, 49, 90,
+			137, 204, 141, 183, 213, 32, 209, 50, 149, 156, 42, 174, 24, 84,
+			225, 139, 6, 153, 29, 68, 133, 228, 115, 201, 221, 141, 113, 151,
+			221, 141, 210, 195, 204, 161, 122, 216, 93, 84, 4, 186, 168, 227,
+			82, 250, 1, 40, 85, 86, 140, 93, 61, 178, 74, 162, 72, 143,
+			244, 73, 221, 163, 244, 121, 15, 72, 68, 49, 212, 21, 180, 17,
+			190, 177, 115, 94, 248, 9, 147, 156, 236, 111, 224, 117, 96, 178,
+			72, 136, 124, 183, 59, 224, 141, 163, 90, 205, 214, 100, 3, 13,
+			250, 24, 57, 38, 203, 239, 185, 158, 19, 116, 135, 113, 39, 224,
+			99, 227, 162, 212, 50, 22, 2, 69, 87, 214, 74, 112, 41, 217,
+			176, 98, 83, 242, 5, 182, 240, 192, 185, 44, 23, 34, 17, 89,
+			219, 7, 206, 101, 250, 54, 66, 37, 132, 128, 55, 196, 211, 111,
+			146, 87, 13, 233, 175, 124, 138, 188, 194, 27, 248, 34, 28, 15,
+			11, 30, 57, 171, 144, 181, 236, 251, 17, 108, 176, 219, 203, 29,
+			136, 102, 117, 55, 227, 73, 158, 100, 110, 57, 129, 11, 247, 52,
+			148, 21, 67, 165, 147, 178, 208, 234, 149, 133, 191, 100, 146, 115,
+			71, 54, 40, 167, 105, 141, 164, 4, 51, 21, 82, 253, 81, 37,
+			56, 238, 88, 171, 168, 243, 129, 233, 86, 4, 132, 252, 191, 49,
+			200, 177, 158, 15, 119, 160, 178, 56, 12, 141, 152, 109, 90, 28,
+			48, 125, 107, 137, 149, 164, 200, 35, 196, 80, 76, 74, 15, 16,
+			27, 122, 51, 107, 15, 47, 136, 31, 245, 238, 51, 149, 216, 125,
+			82, 98, 35, 89, 164, 145, 44, 240, 247, 133, 183, 19, 27, 204,
+			93, 248, 208, 227, 230, 122, 63, 239, 37, 36, 13, 238, 217, 229,
+			138, 136, 85, 243, 98, 101, 109, 7, 222, 149, 164, 89, 146, 218,
+			124, 113, 163, 92, 201, 89, 23, 66, 66, 7, 77, 41, 226, 173,
+			150, 107, 107, 219, 59, 21, 241, 174, 202, 208, 16, 54, 19, 132,
+			136, 66, 101, 8, 76, 131, 239, 153, 40, 119, 240, 68, 62, 62,
+			15, 13, 55, 246, 196, 45, 68, 224, 245, 23, 26, 100, 44, 33,
+			248, 225, 241, 149, 213, 242, 250, 78, 105, 120, 51, 199, 201, 49,
+			241, 53, 22, 35, 147, 100, 76, 100, 97, 107, 57, 19, 100, 143,
+			200, 216, 221, 40, 189, 80, 90, 91, 47, 45, 175, 151, 115, 214,
+			210, 143, 65, 23, 117, 248, 10, 250, 12, 188, 6, 33, 77, 115,
+			138, 21, 209, 97, 22, 229, 252, 80, 83, 39, 221, 34, 121, 84,
+			172, 134, 219, 78, 135, 2, 58, 163, 56, 202, 240, 58, 203, 100,
+			122, 23, 173, 193, 125, 249, 67, 219, 63, 162, 87, 171, 36, 119,
+			141, 71, 61, 54, 212, 225, 125, 57, 125, 7, 107, 43, 125, 39,
+			201, 247, 67, 73, 152, 234, 207, 12, 129, 23, 127, 190, 51, 228,
+			183, 19, 18, 91, 68, 233, 140, 40, 154, 180, 145, 138, 222, 205,
+			14, 126, 144, 0, 158, 38, 99, 215, 221, 58, 87, 79, 165, 43,
+			44, 244, 216, 69, 243, 131, 238, 67, 232, 222, 70, 223, 70, 142,
+			237, 122, 7, 95, 75, 117, 97, 76, 121, 125, 213, 151, 73, 174,
+			223, 176, 73, 251, 132, 76, 254, 172, 68, 222, 81, 6, 208, 235,
+			228, 88, 143, 1, 144, 230, 99, 76, 233, 204, 190, 57, 238, 251,
+			38, 33, 109, 144, 201, 62, 91, 26, 189, 79, 192, 26, 48, 177,
+			245, 80, 239, 192, 87, 9, 175, 72, 178, 43, 232, 160, 10, 18,
+			47, 214, 46, 142, 196, 198, 211, 36, 171, 45, 83, 84, 170, 6,
+			253, 166, 170, 35, 43, 63, 69, 50, 202, 206, 68, 165, 86, 28,
+			219, 157, 84, 213, 190, 108, 217, 207, 183, 17, 18, 91, 120, 20,
+			5, 14, 216, 124, 142, 108, 249, 109, 132, 172, 242, 254, 234, 171,
+			252, 94, 171, 151, 201, 68, 175, 33, 130, 202, 73, 26, 106, 158,
+			184, 19, 152, 85, 62, 12, 204, 42, 127, 109, 96, 198, 147, 219,
+			103, 21, 101, 171, 119, 75, 45, 64, 228, 135, 125, 210, 40, 157,
+			232, 221, 17, 171, 222, 12, 221, 39, 231, 251, 104, 158, 62, 67,
+			38, 122, 247, 192, 170, 122, 111, 174, 170, 222, 35, 36, 33, 90,
+			217, 85, 146, 75, 148, 68, 89, 66, 207, 12, 64, 72, 110, 125,
+			243, 146, 37, 246, 214, 121, 7, 201, 245, 43, 218, 244, 76, 175,
+			130, 161, 242, 21, 156, 179, 71, 125, 150, 152, 121, 158, 76, 244,
+			234, 155, 244, 116, 111, 13, 165, 133, 10, 112, 247, 13, 255, 40,
+			129, 53, 226, 61, 145, 214, 91, 132, 62, 70, 31, 236, 173, 216,
+			247, 89, 129, 127, 232, 46, 165, 68, 59, 175, 45, 114, 218, 111,
+			255, 182, 41, 66, 167, 253, 86, 230, 27, 161, 211, 190, 17, 58,
+			237, 111, 34, 116, 154, 244, 5, 155, 30, 121, 8, 114, 33, 124,
+			194, 137, 145, 135, 241, 167, 9, 46, 94, 243, 248, 211, 2, 199,
+			46, 244, 220, 178, 108, 112, 231, 122, 138, 124, 113, 10, 35, 174,
+			165, 174, 143, 252, 123, 203, 200, 255, 222, 20, 187, 225, 120, 112,
+			6, 219, 19, 49, 45, 84, 17, 207, 220, 128, 57, 97, 232, 215,
+			92, 39, 25, 25, 26, 252, 153, 74, 170, 44, 224, 222, 193, 203,
+			113, 117, 86, 243, 155, 242, 169, 37, 112, 74, 241, 61, 206, 84,
+			204, 27, 185, 187, 65, 7, 42, 135, 237, 7, 126, 167, 13, 63,
+			101, 204, 182, 4, 52, 233, 141, 19, 117, 219, 224, 52, 214, 236,
+			234, 88, 95, 128, 99, 136, 6, 230, 129, 183, 164, 162, 11, 164,
+			106, 223, 3, 79, 29, 116, 32, 115, 34, 7, 47, 128, 193, 66,
+			0, 23, 23, 111, 191, 233, 183, 212, 61, 142, 100, 159, 37, 139,
+			82, 174, 48, 50, 120, 176, 235, 213, 156, 192, 195, 210, 68, 134,
+			115, 134, 219, 159, 178, 243, 87, 224, 58, 10, 86, 8, 184, 12,
+			29, 183, 144, 8, 62, 163, 175, 46, 33, 133, 67, 196, 29, 17,
+			8, 147, 205, 65, 215, 32, 60, 38, 142, 193, 111, 194, 26, 151,
+			29, 9, 231, 101, 72, 56, 8, 214, 35, 111, 243, 9, 183, 53,
+			189, 251, 17, 55, 109, 180, 35, 146, 186, 119, 163, 230, 64, 223,
+			7, 9, 121, 224, 234, 219, 186, 208, 249, 65, 8, 145, 207, 28,
+			117, 123, 9, 47, 225, 52, 120, 32, 46, 225, 16, 184, 78, 83,
+			208, 88, 89, 91, 45, 36, 6, 166, 66, 94, 200, 1, 118, 7,
+			176, 24, 178, 57, 136, 134, 210, 236, 50, 215, 3, 127, 57, 248,
+			18, 241, 160, 1, 145, 147, 57, 70, 244, 211, 145, 56, 144, 202,
+			192, 245, 8, 192, 55, 93, 79, 185, 65, 137, 184, 50, 120, 215,
+			7, 96, 244, 138, 76, 112, 238, 155, 199, 48, 39, 23, 240, 118,
+			137, 216, 220, 195, 101, 146, 16, 86, 19, 162, 86, 160, 81, 198,
+			51, 130, 0, 227, 42, 84, 65, 111, 20, 7, 21, 123, 124, 1,
+			160, 49, 233, 218, 230, 52, 15, 157, 110, 40, 35, 78, 225, 61,
+			155, 199, 30, 97, 181, 3, 39, 96, 165, 237, 149, 181, 53, 137,
+			50, 240, 155, 195, 46, 108, 34, 99, 24, 214, 160, 120, 181, 0,
+			236, 194, 79, 60, 54, 39, 10, 176, 139, 236, 221, 47, 239, 117,
+			35, 254, 77, 1, 111, 232, 120, 183, 239, 159, 215, 29, 128, 11,
+			216, 231, 69, 149, 243, 64, 70, 187, 149, 245, 75, 161, 211, 224,
+			56, 1, 29, 175, 141, 6, 87, 76, 156, 191, 136, 5, 128, 90,
+			157, 136, 123, 242, 114, 18, 64, 65, 172, 72, 106, 83, 253, 66,
+			25, 144, 28, 210, 99, 67, 134, 36, 252, 197, 32, 226, 18, 226,
+			106, 5, 168, 74, 137, 111, 36, 112, 128, 195, 91, 33, 111, 222,
+			146, 145, 81, 94, 117, 219, 12, 84, 95, 23, 50, 176, 82, 232,
+			67, 184, 140, 186, 120, 0, 207, 105, 38, 184, 4, 219, 81, 81,
+			83, 218, 34, 158, 159, 24, 7, 132, 78, 233, 180, 4, 153, 131,
+			16, 101, 43, 235, 107, 154, 12, 36, 227, 209, 119, 148, 5, 97,
+			17, 29, 111, 161, 230, 36, 220, 38, 185, 59, 52, 150, 1, 33,
+			76, 110, 70, 100, 72, 151, 166, 239, 223, 100, 77, 247, 38, 103,
+			129, 239, 71, 40, 141, 96, 112, 44, 236, 134, 17, 111, 201, 136,
+			45, 50, 42, 182, 179, 184, 183, 88, 43, 204, 23, 161, 243, 36,
+			94, 212, 226, 62, 98, 76, 250, 16, 156, 7, 169, 6, 58, 219,
+			232, 52, 155, 8, 5, 107, 169, 216, 130, 158, 47, 151, 34, 97,
+			5, 12, 168, 4, 215, 137, 161, 84, 88, 64, 151, 192, 66, 177,
+			40, 126, 64, 116, 6, 31, 137, 42, 116, 91, 46, 92, 238, 210,
+			209, 101, 66, 132, 136, 195, 144, 210, 152, 176, 166, 19, 70, 216,
+			90, 130, 251, 193, 229, 84, 15, 214, 14, 144, 106, 232, 214, 48,
+			168, 5, 136, 34, 156, 73, 33, 203, 224, 202, 25, 134, 136, 18,
+			252, 202, 111, 68, 112, 237, 84, 173, 238, 58, 247, 124, 8, 132,
+			196, 218, 77, 39, 106, 248, 65, 171, 39, 184, 38, 220, 64, 131,
+			147, 76, 160, 197, 134, 31, 20, 217, 213, 248, 66, 42, 97, 5,
+			215, 107, 4, 206, 98, 228, 251, 205, 80, 40, 72, 77, 215, 235,
+			220, 190, 228, 180, 234, 79, 60, 86, 56, 10, 8, 91, 135, 66,
+			44, 232, 120, 232, 23, 13, 66, 27, 202, 19, 65, 95, 17, 151,
+			129, 41, 212, 245, 55, 113, 65, 151, 213, 124, 239, 22, 240, 33,
+			223, 75, 50, 95, 96, 248, 220, 195, 112, 206, 117, 230, 1, 195,
+			237, 125, 90, 43, 65, 82, 64, 227, 232, 243, 203, 155, 28, 156,
+			138, 149, 150, 163, 122, 9, 184, 14, 219, 192, 187, 230, 240, 126,
+			23, 178, 70, 245, 17, 209, 46, 253, 248, 96, 234, 160, 83, 132,
+			21, 100, 206, 60, 4, 57, 16, 17, 55, 112, 85, 168, 165, 192,
+			212, 41, 44, 32, 218, 141, 138, 108, 165, 19, 4, 220, 131, 64,
+			154, 110, 34, 86, 10, 188, 169, 7, 177, 167, 84, 24, 58, 56,
+			60, 14, 252, 166, 240, 242, 156, 43, 173, 172, 207, 203, 96, 130,
+			50, 140, 8, 220, 248, 115, 34, 6, 110, 93, 98, 113, 238, 99,
+			220, 15, 96, 232, 248, 5, 39, 55, 8, 181, 122, 34, 110, 46,
+			203, 247, 52, 0, 119, 16, 75, 77, 142, 76, 234, 32, 30, 135,
+			1, 186, 112, 165, 50, 57, 197, 11, 2, 52, 76, 19, 216, 151,
+			202, 21, 108, 84, 122, 135, 6, 207, 202, 82, 224, 111, 12, 220,
+			179, 180, 178, 158, 84, 27, 128, 75, 16, 213, 172, 92, 95, 24,
+			216, 37, 28, 172, 29, 107, 73, 0, 28, 174, 11, 38, 59, 73,
+			18, 34, 199, 111, 104, 88, 56, 20, 79, 203, 37, 136, 186, 209,
+			244, 15, 65, 122, 37, 215, 244, 98, 29, 86, 53, 168, 110, 96,
+			148, 33, 194, 135, 25, 80, 163, 156, 104, 97, 72, 242, 129, 26,
+			29, 31, 20, 158, 186, 9, 18, 186, 27, 226, 1, 228, 45, 60,
+			248, 0, 43, 77, 62, 240, 32, 155, 150, 23, 177, 101, 148, 32,
+			152, 219, 144, 64, 88, 21, 12, 35, 136, 241, 60, 65, 6, 55,
+			161, 119, 106, 120, 72, 40, 9, 57, 174, 33, 201, 145, 138, 120,
+			173, 126, 7, 110, 255, 66, 69, 152, 131, 36, 106, 122, 48, 146,
+			232, 74, 34, 194, 234, 245, 12, 37, 159, 182, 84, 132, 213, 138,
+			249, 108, 254, 147, 150, 142, 101, 22, 211, 104, 239, 140, 13, 80,
+			12, 96, 6, 246, 80, 24, 214, 38, 126, 132, 66, 199, 152, 65,
+			243, 169, 160, 11, 69, 112, 210, 81, 149, 215, 213, 236, 43, 102,
+			215, 128, 48, 89, 210, 211, 31, 62, 240, 16, 25, 225, 30, 12,
+			155, 57, 130, 192, 157, 38, 219, 111, 250, 123, 78, 147, 21, 128,
+			165, 92, 82, 37, 47, 221, 114, 249, 33, 15, 194, 130, 80, 35,
+			23, 136, 224, 157, 135, 174, 10, 154, 225, 64, 64, 14, 184, 230,
+			131, 152, 27, 188, 193, 46, 252, 191, 147, 241, 52, 241, 166, 60,
+			196, 5, 144, 131, 72, 204, 55, 244, 7, 123, 60, 216, 97, 208,
+			88, 144, 33, 15, 12, 83, 114, 227, 35, 81, 235, 70, 11, 195,
+			186, 74, 18, 97, 220, 84, 31, 227, 144, 180, 149, 244, 76, 34,
+			36, 109, 101, 246, 33, 149, 178, 168, 85, 121, 228, 25, 242, 179,
+			182, 10, 73, 251, 30, 179, 146, 255, 167, 246, 107, 159, 225, 4,
+			79, 232, 25, 234, 127, 133, 51, 191, 1, 178, 14, 153, 169, 219,
+			72, 14, 9, 102, 46, 49, 251, 16, 54, 66, 238, 245, 52, 22,
+			229, 16, 7, 167, 125, 65, 6, 148, 132, 168, 39, 168, 201, 134,
+			188, 175, 218, 16, 220, 46, 16, 25, 97, 78, 202, 49, 206, 235,
+			242, 57, 144, 48, 228, 45, 228, 24, 42, 188, 72, 47, 44, 24,
+			176, 196, 237, 156, 27, 145, 88, 138, 184, 202, 176, 14, 59, 135,
+			54, 15, 34, 8, 78, 208, 8, 252, 214, 176, 169, 141, 3, 240,
+			193, 141, 131, 247, 164, 153, 74, 153, 212, 122, 207, 253, 69, 149,
+			178, 168, 245, 158, 167, 182, 200, 103, 70, 85, 32, 210, 200, 92,
+			205, 255, 15, 163, 76, 88, 234, 241, 82, 84, 13, 205, 158, 111,
+			38, 126, 242, 122, 105, 99, 39, 25, 58, 246, 128, 215, 110, 134,
+			236, 124, 194, 75, 235, 188, 12, 218, 35, 217, 109, 239, 209, 131,
+			186, 182, 47, 89, 11, 25, 156, 179, 222, 5, 215, 23, 80, 72,
+			105, 191, 137, 230, 244, 206, 79, 14, 94, 107, 194, 24, 219, 5,
+			65, 214, 132, 54, 145, 172, 165, 194, 11, 136, 42, 241, 184, 201,
+			208, 96, 143, 253, 12, 81, 119, 90, 181, 133, 1, 143, 88, 23,
+			182, 214, 176, 177, 237, 233, 30, 4, 182, 128, 168, 41, 42, 80,
+			56, 94, 135, 39, 114, 135, 1, 15, 182, 177, 182, 19, 134, 34,
+			187, 167, 38, 134, 43, 64, 178, 1, 153, 134, 47, 165, 38, 137,
+			92, 96, 50, 28, 228, 211, 0, 142, 15, 1, 168, 57, 114, 12,
+			163, 15, 191, 228, 8, 172, 244, 199, 93, 30, 194, 38, 18, 49,
+			195, 49, 168, 51, 40, 204, 96, 166, 79, 244, 87, 174, 98, 140,
+			117, 5, 163, 226, 245, 34, 219, 114, 48, 172, 19, 145, 189, 29,
+			74, 44, 176, 238, 161, 95, 248, 26, 158, 188, 192, 131, 49, 206,
+			235, 226, 53, 90, 182, 233, 65, 252, 104, 112, 41, 144, 209, 79,
+			194, 35, 0, 233, 117, 214, 193, 117, 89, 79, 98, 71, 47, 116,
+			144, 16, 81, 58, 175, 82, 38, 181, 162, 211, 243, 42, 5, 17,
+			2, 31, 91, 38, 255, 192, 150, 225, 88, 237, 15, 26, 230, 213,
+			252, 87, 99, 117, 193, 145, 55, 134, 164, 214, 153, 152, 22, 96,
+			157, 174, 55, 64, 227, 48, 127, 187, 30, 110, 190, 6, 78, 26,
+			101, 100, 112, 24, 178, 180, 14, 134, 90, 2, 196, 92, 56, 100,
+			115, 138, 69, 18, 141, 0, 135, 53, 125, 236, 135, 184, 134, 133,
+			193, 119, 26, 126, 208, 194, 93, 241, 252, 2, 235, 63, 185, 83,
+			56, 133, 22, 113, 235, 9, 138, 118, 87, 34, 120, 45, 82, 219,
+			5, 7, 222, 187, 131, 85, 200, 111, 183, 241, 183, 24, 166, 228,
+			169, 160, 103, 41, 221, 174, 135, 36, 193, 42, 228, 17, 193, 178,
+			212, 210, 131, 157, 119, 66, 24, 200, 11, 133, 76, 156, 56, 163,
+			168, 149, 170, 244, 156, 131, 243, 223, 85, 145, 71, 225, 110, 41,
+			222, 18, 19, 193, 227, 28, 79, 192, 157, 79, 198, 24, 22, 228,
+			212, 51, 21, 131, 130, 204, 243, 19, 178, 44, 132, 229, 41, 55,
+			22, 210, 146, 132, 120, 113, 192, 236, 210, 4, 78, 138, 145, 206,
+			100, 110, 207, 194, 23, 241, 57, 32, 16, 171, 65, 237, 15, 26,
+			233, 147, 42, 105, 66, 114, 230, 65, 149, 68, 114, 89, 92, 37,
+			255, 202, 68, 234, 177, 169, 253, 29, 134, 249, 174, 252, 167, 205,
+			163, 168, 71, 202, 123, 184, 40, 171, 174, 57, 221, 137, 140, 144,
+			49, 195, 133, 72, 196, 23, 11, 97, 83, 12, 139, 209, 9, 7,
+			166, 123, 65, 90, 190, 165, 213, 72, 70, 39, 3, 196, 104, 66,
+			211, 32, 98, 28, 73, 72, 189, 68, 42, 173, 62, 187, 16, 169,
+			233, 136, 211, 222, 162, 234, 253, 130, 14, 141, 124, 224, 200, 160,
+			167, 56, 29, 231, 67, 0, 18, 151, 2, 129, 86, 231, 17, 52,
+			236, 169, 104, 123, 58, 176, 81, 69, 238, 67, 4, 86, 33, 8,
+			202, 119, 24, 105, 166, 146, 38, 36, 239, 127, 139, 74, 90, 144,
+			92, 121, 39, 249, 81, 33, 154, 83, 212, 254, 97, 195, 44, 229,
+			191, 111, 148, 193, 249, 85, 24, 239, 137, 128, 224, 194, 206, 158,
+			146, 143, 44, 190, 110, 146, 176, 62, 199, 184, 22, 181, 165, 17,
+			28, 54, 166, 42, 82, 58, 184, 102, 113, 175, 14, 94, 44, 64,
+			52, 250, 54, 80, 179, 139, 116, 148, 248, 44, 77, 40, 114, 37,
+			184, 33, 11, 69, 176, 222, 38, 191, 237, 214, 224, 126, 111, 251,
+			64, 216, 120, 7, 54, 155, 128, 30, 221, 241, 119, 23, 156, 194,
+			2, 238, 245, 196, 159, 218, 98, 93, 253, 224, 139, 141, 194, 203,
+			11, 184, 63, 150, 239, 9, 22, 156, 2, 81, 113, 231, 228, 220,
+			127, 147, 130, 116, 133, 189, 27, 161, 188, 188, 32, 105, 74, 101,
+			213, 10, 47, 191, 31, 22, 180, 130, 227, 249, 222, 165, 196, 184,
+			138, 71, 192, 57, 162, 55, 253, 176, 213, 87, 209, 8, 192, 98,
+			186, 165, 158, 86, 226, 213, 141, 248, 86, 205, 33, 171, 80, 64,
+			25, 60, 26, 135, 90, 161, 140, 162, 135, 228, 37, 141, 36, 120,
+			153, 30, 36, 227, 45, 48, 236, 160, 120, 85, 13, 57, 114, 200,
+			67, 88, 132, 156, 87, 16, 148, 154, 63, 248, 61, 6, 158, 5,
+			210, 51, 205, 10, 38, 128, 2, 123, 154, 2, 141, 104, 151, 17,
+			252, 123, 44, 11, 189, 109, 146, 190, 166, 216, 28, 108, 149, 156,
+			8, 226, 164, 98, 159, 229, 234, 63, 112, 121, 0, 150, 160, 110,
+			204, 28, 225, 18, 109, 196, 235, 243, 11, 56, 68, 196, 230, 224,
+			120, 36, 87, 77, 172, 106, 9, 16, 250, 138, 215, 195, 5, 11,
+			113, 250, 88, 166, 120, 247, 161, 17, 112, 24, 163, 136, 76, 43,
+			215, 165, 242, 150, 145, 183, 107, 229, 205, 112, 249, 174, 74, 188,
+			227, 150, 92, 188, 230, 120, 4, 119, 13, 48, 111, 42, 186, 55,
+			111, 134, 92, 47, 105, 8, 128, 246, 195, 70, 58, 167, 146, 38,
+			36, 143, 23, 84, 210, 130, 228, 165, 183, 147, 15, 10, 54, 154,
+			166, 246, 39, 13, 115, 57, 255, 23, 6, 75, 248, 124, 176, 150,
+			163, 248, 160, 108, 30, 152, 151, 184, 134, 167, 121, 165, 240, 188,
+			197, 120, 232, 184, 1, 128, 210, 177, 55, 137, 50, 120, 11, 189,
+			73, 113, 252, 88, 109, 118, 26, 13, 94, 131, 157, 69, 20, 202,
+			200, 113, 115, 177, 89, 67, 190, 19, 129, 193, 135, 225, 6, 191,
+			148, 78, 146, 183, 14, 209, 221, 85, 47, 37, 139, 209, 180, 62,
+			160, 136, 39, 118, 117, 26, 99, 16, 151, 237, 147, 70, 250, 184,
+			74, 154, 144, 164, 138, 39, 166, 45, 72, 94, 124, 22, 3, 27,
+			167, 71, 204, 81, 106, 255, 148, 97, 174, 230, 127, 204, 96, 61,
+			142, 46, 195, 113, 166, 86, 148, 179, 239, 184, 50, 94, 219, 121,
+			56, 12, 128, 83, 31, 12, 150, 151, 192, 250, 215, 117, 144, 163,
+			6, 244, 59, 61, 165, 146, 38, 36, 167, 21, 89, 140, 90, 144,
+			188, 180, 76, 254, 177, 120, 13, 34, 67, 237, 127, 1, 131, 252,
+			104, 138, 245, 184, 227, 232, 73, 79, 246, 103, 206, 105, 250, 222,
+			190, 216, 21, 195, 210, 4, 37, 94, 27, 142, 22, 240, 97, 89,
+			56, 129, 106, 132, 132, 241, 168, 54, 31, 83, 75, 76, 252, 125,
+			134, 177, 142, 87, 247, 139, 241, 163, 54, 170, 33, 136, 191, 129,
+			189, 169, 47, 72, 58, 217, 135, 99, 192, 134, 143, 248, 212, 132,
+			169, 131, 171, 225, 162, 117, 152, 120, 201, 193, 117, 154, 160, 164,
+			236, 5, 220, 185, 201, 192, 85, 210, 239, 132, 172, 237, 122, 30,
+			68, 120, 119, 33, 64, 43, 200, 11, 60, 169, 4, 243, 93, 8,
+			79, 75, 128, 29, 95, 29, 87, 118, 66, 117, 153, 31, 76, 110,
+			234, 224, 176, 230, 132, 29, 144, 50, 11, 98, 193, 186, 30, 227,
+			183, 163, 128, 183, 96, 221, 135, 120, 250, 184, 2, 143, 237, 192,
+			169, 28, 88, 115, 217, 129, 120, 5, 170, 103, 84, 94, 24, 1,
+			123, 244, 27, 98, 116, 240, 61, 182, 145, 66, 73, 117, 22, 229,
+			236, 249, 183, 248, 66, 95, 244, 91, 173, 114, 224, 11, 76, 146,
+			243, 93, 17, 221, 241, 15, 61, 8, 238, 236, 55, 72, 31, 190,
+			241, 168, 2, 89, 146, 220, 104, 200, 206, 132, 9, 65, 129, 18,
+			110, 128, 182, 6, 117, 83, 64, 135, 35, 0, 98, 123, 40, 209,
+			214, 54, 94, 40, 173, 175, 173, 86, 75, 149, 107, 187, 55, 202,
+			27, 59, 170, 154, 218, 30, 193, 38, 216, 105, 130, 78, 141, 161,
+			78, 134, 189, 49, 32, 209, 51, 92, 89, 204, 24, 64, 161, 154,
+			156, 51, 38, 36, 53, 57, 103, 44, 72, 94, 90, 38, 63, 158,
+			70, 114, 206, 82, 251, 127, 3, 114, 254, 161, 52, 83, 238, 97,
+			97, 242, 188, 69, 18, 171, 82, 13, 19, 120, 154, 139, 31, 118,
+			16, 247, 81, 117, 112, 120, 162, 194, 203, 235, 125, 32, 208, 201,
+			161, 35, 3, 119, 107, 253, 167, 203, 163, 121, 208, 101, 212, 123,
+			76, 208, 48, 188, 150, 50, 240, 146, 3, 160, 126, 37, 17, 86,
+			51, 14, 212, 237, 51, 126, 155, 215, 58, 145, 52, 215, 248, 202,
+			82, 171, 142, 107, 113, 166, 46, 247, 4, 219, 84, 29, 24, 54,
+			202, 61, 120, 243, 1, 223, 42, 209, 216, 80, 199, 114, 56, 21,
+			75, 176, 244, 88, 210, 133, 84, 135, 218, 147, 1, 253, 122, 230,
+			7, 182, 90, 48, 116, 245, 246, 2, 116, 82, 122, 114, 200, 226,
+			49, 177, 138, 51, 76, 29, 247, 113, 107, 69, 63, 75, 6, 16,
+			244, 205, 96, 205, 34, 36, 28, 245, 18, 44, 118, 239, 209, 4,
+			103, 24, 104, 192, 13, 227, 55, 32, 22, 112, 152, 3, 99, 20,
+			188, 88, 30, 145, 38, 34, 17, 227, 46, 88, 217, 147, 100, 140,
+			146, 216, 175, 22, 162, 107, 75, 107, 1, 110, 101, 98, 154, 137,
+			95, 210, 72, 204, 250, 94, 23, 195, 178, 3, 123, 2, 233, 172,
+			158, 203, 9, 9, 27, 116, 217, 149, 141, 197, 135, 81, 184, 162,
+			188, 216, 80, 160, 168, 162, 133, 28, 4, 140, 162, 32, 252, 66,
+			181, 219, 243, 3, 41, 45, 238, 34, 40, 200, 16, 187, 212, 160,
+			160, 200, 26, 176, 88, 244, 54, 44, 107, 66, 114, 230, 156, 74,
+			90, 144, 188, 176, 76, 62, 47, 164, 33, 161, 246, 127, 48, 204,
+			231, 242, 255, 198, 144, 74, 189, 194, 139, 116, 198, 144, 93, 89,
+			232, 121, 209, 4, 95, 247, 185, 211, 192, 212, 222, 21, 172, 154,
+			192, 243, 230, 191, 214, 161, 41, 35, 207, 80, 166, 162, 39, 39,
+			102, 66, 26, 27, 196, 128, 1, 106, 62, 67, 76, 72, 78, 207,
+			169, 164, 5, 201, 71, 175, 147, 95, 17, 218, 212, 24, 181, 191,
+			96, 152, 91, 249, 255, 49, 222, 148, 170, 22, 99, 188, 200, 39,
+			171, 33, 112, 126, 179, 41, 183, 161, 32, 35, 245, 57, 30, 96,
+			230, 29, 29, 30, 184, 242, 212, 46, 81, 215, 111, 28, 121, 164,
+			35, 158, 68, 137, 146, 36, 68, 148, 165, 251, 149, 14, 15, 186,
+			243, 127, 55, 48, 62, 102, 0, 18, 211, 39, 84, 210, 132, 228,
+			201, 5, 149, 180, 32, 249, 228, 6, 249, 151, 98, 75, 58, 78,
+			237, 255, 98, 152, 143, 231, 255, 249, 40, 19, 174, 177, 138, 189,
+			6, 188, 1, 92, 87, 104, 43, 16, 84, 232, 182, 220, 88, 72,
+			171, 12, 134, 114, 135, 197, 230, 176, 86, 71, 132, 137, 130, 179,
+			217, 58, 107, 251, 112, 140, 140, 231, 15, 136, 106, 93, 177, 127,
+			38, 81, 97, 39, 202, 244, 163, 159, 186, 83, 14, 61, 114, 67,
+			14, 225, 170, 123, 247, 59, 172, 0, 207, 31, 133, 81, 1, 250,
+			87, 8, 177, 237, 2, 204, 13, 184, 214, 34, 231, 215, 135, 196,
+			117, 221, 139, 193, 9, 47, 178, 114, 113, 191, 24, 3, 195, 225,
+			120, 68, 125, 14, 89, 161, 84, 64, 213, 165, 176, 92, 96, 202,
+			155, 9, 100, 125, 179, 155, 136, 133, 133, 54, 3, 32, 52, 224,
+			57, 137, 135, 153, 184, 3, 161, 189, 113, 66, 161, 107, 91, 128,
+			20, 192, 158, 131, 104, 3, 99, 143, 23, 35, 66, 188, 247, 6,
+			129, 169, 197, 203, 27, 209, 65, 2, 75, 133, 186, 27, 214, 32,
+			62, 153, 28, 167, 212, 106, 208, 42, 7, 106, 141, 148, 184, 13,
+			133, 72, 52, 59, 129, 242, 18, 249, 26, 136, 92, 52, 210, 78,
+			9, 14, 17, 93, 24, 104, 136, 56, 135, 205, 160, 31, 70, 151,
+			130, 196, 181, 10, 245, 66, 59, 24, 137, 95, 175, 70, 35, 185,
+			107, 195, 239, 181, 94, 188, 161, 202, 141, 31, 244, 34, 75, 225,
+			2, 137, 16, 247, 175, 175, 233, 65, 37, 13, 71, 111, 161, 132,
+			149, 79, 98, 67, 246, 166, 180, 188, 89, 217, 41, 175, 14, 84,
+			2, 109, 25, 73, 30, 108, 215, 92, 191, 114, 15, 79, 134, 245,
+			31, 183, 184, 145, 146, 159, 131, 210, 144, 227, 206, 78, 236, 230,
+			247, 2, 184, 207, 139, 100, 56, 168, 60, 235, 37, 63, 110, 80,
+			251, 191, 24, 233, 73, 149, 52, 33, 153, 83, 60, 119, 220, 130,
+			228, 217, 71, 201, 255, 35, 68, 206, 49, 106, 127, 155, 105, 46,
+			231, 255, 192, 96, 21, 185, 27, 65, 170, 148, 38, 117, 230, 224,
+			41, 93, 39, 24, 250, 26, 167, 44, 247, 166, 37, 136, 190, 233,
+			150, 40, 56, 102, 192, 152, 53, 130, 142, 153, 144, 204, 169, 29,
+			234, 49, 11, 146, 23, 159, 37, 127, 45, 16, 52, 65, 237, 239,
+			54, 205, 167, 243, 127, 162, 100, 50, 236, 189, 100, 204, 60, 176,
+			100, 31, 33, 150, 97, 249, 202, 168, 22, 177, 132, 126, 93, 136,
+			146, 194, 228, 111, 1, 81, 19, 240, 156, 138, 153, 158, 80, 73,
+			120, 78, 197, 156, 60, 171, 146, 22, 36, 231, 159, 34, 127, 58,
+			134, 136, 154, 164, 246, 79, 155, 230, 106, 254, 63, 140, 129, 234,
+			12, 178, 55, 236, 241, 43, 69, 169, 220, 203, 235, 0, 39, 219,
+			110, 83, 160, 11, 130, 254, 199, 218, 159, 18, 223, 184, 225, 133,
+			114, 112, 89, 1, 57, 111, 225, 38, 239, 94, 193, 176, 14, 224,
+			174, 228, 14, 89, 79, 9, 55, 81, 53, 192, 184, 69, 176, 116,
+			118, 123, 228, 12, 96, 22, 101, 58, 44, 238, 132, 214, 169, 234,
+			128, 196, 19, 165, 164, 48, 2, 179, 88, 232, 55, 59, 192, 27,
+			175, 192, 194, 119, 160, 151, 204, 141, 149, 14, 224, 53, 48, 118,
+			168, 136, 74, 135, 239, 197, 125, 232, 213, 229, 196, 102, 18, 234,
+			119, 60, 247, 149, 14, 172, 243, 254, 72, 245, 186, 34, 128, 195,
+			133, 7, 197, 147, 67, 128, 157, 185, 7, 94, 39, 174, 163, 157,
+			75, 116, 173, 181, 85, 141, 191, 88, 182, 72, 6, 178, 199, 245,
+			145, 160, 208, 110, 154, 221, 216, 171, 170, 30, 91, 145, 132, 40,
+			10, 193, 223, 153, 192, 224, 16, 95, 250, 160, 124, 40, 210, 228,
+			84, 104, 149, 108, 129, 57, 181, 192, 15, 97, 138, 155, 177, 96,
+			157, 215, 39, 169, 93, 86, 247, 97, 177, 162, 30, 5, 182, 5,
+			46, 49, 114, 69, 28, 31, 192, 152, 193, 239, 125, 143, 39, 209,
+			76, 88, 11, 222, 30, 109, 55, 227, 1, 135, 108, 78, 162, 0,
+			235, 249, 65, 66, 66, 235, 102, 1, 37, 37, 237, 9, 9, 38,
+			7, 32, 52, 54, 215, 17, 231, 95, 176, 200, 197, 91, 211, 218,
+			13, 78, 116, 73, 122, 207, 194, 38, 195, 173, 45, 8, 135, 0,
+			156, 194, 46, 235, 8, 227, 133, 20, 252, 177, 178, 17, 31, 237,
+			251, 13, 53, 238, 184, 179, 42, 32, 34, 186, 206, 11, 176, 90,
+			143, 136, 55, 49, 177, 198, 130, 222, 76, 234, 205, 59, 156, 1,
+			236, 184, 166, 228, 33, 52, 138, 99, 69, 148, 1, 133, 11, 98,
+			125, 99, 149, 14, 232, 129, 164, 71, 61, 55, 255, 205, 170, 29,
+			255, 45, 40, 25, 147, 6, 112, 123, 109, 23, 159, 52, 33, 169,
+			237, 226, 147, 22, 36, 47, 45, 147, 15, 138, 195, 233, 28, 181,
+			127, 217, 52, 87, 243, 127, 97, 177, 85, 126, 148, 104, 208, 175,
+			188, 242, 32, 166, 34, 109, 134, 4, 125, 114, 72, 135, 88, 224,
+			119, 34, 215, 227, 176, 128, 113, 45, 10, 88, 78, 16, 243, 53,
+			92, 183, 122, 105, 205, 105, 251, 35, 64, 129, 19, 233, 132, 81,
+			28, 227, 209, 50, 191, 86, 115, 66, 201, 22, 160, 17, 245, 118,
+			67, 195, 189, 13, 235, 39, 172, 5, 104, 112, 10, 165, 143, 110,
+			177, 127, 57, 44, 16, 57, 74, 20, 89, 137, 215, 217, 113, 69,
+			236, 117, 133, 125, 97, 91, 138, 1, 233, 23, 4, 189, 17, 58,
+			242, 54, 156, 146, 221, 228, 106, 201, 2, 144, 249, 215, 75, 255,
+			242, 60, 250, 111, 149, 254, 37, 77, 228, 12, 32, 2, 77, 49,
+			57, 19, 146, 154, 98, 114, 22, 36, 47, 45, 171, 215, 218, 143,
+			83, 251, 223, 154, 230, 122, 254, 103, 50, 195, 149, 9, 237, 202,
+			193, 189, 8, 183, 246, 131, 138, 69, 41, 193, 222, 122, 138, 195,
+			179, 218, 204, 97, 55, 121, 247, 18, 170, 17, 168, 69, 44, 72,
+			255, 126, 24, 216, 77, 46, 138, 144, 196, 187, 146, 242, 46, 134,
+			242, 250, 17, 21, 161, 144, 199, 156, 96, 207, 141, 240, 157, 152,
+			189, 166, 191, 199, 58, 109, 224, 122, 143, 95, 94, 98, 207, 239,
+			129, 126, 24, 186, 175, 202, 61, 178, 188, 17, 115, 83, 234, 31,
+			40, 232, 180, 244, 138, 101, 20, 194, 30, 182, 128, 139, 132, 173,
+			196, 219, 78, 161, 37, 212, 156, 102, 223, 72, 212, 51, 66, 245,
+			14, 198, 235, 142, 120, 253, 138, 92, 78, 64, 189, 82, 191, 34,
+			122, 133, 197, 184, 129, 209, 48, 207, 247, 219, 32, 118, 58, 72,
+			182, 241, 71, 191, 145, 196, 175, 68, 15, 118, 190, 229, 180, 21,
+			242, 16, 169, 172, 229, 180, 113, 179, 165, 61, 8, 132, 24, 151,
+			227, 18, 168, 24, 156, 144, 193, 59, 57, 9, 143, 152, 43, 204,
+			209, 15, 118, 197, 151, 42, 192, 247, 2, 26, 132, 73, 65, 224,
+			108, 14, 14, 243, 148, 105, 82, 187, 174, 168, 128, 185, 242, 40,
+			31, 60, 172, 230, 241, 116, 49, 161, 66, 45, 16, 214, 243, 112,
+			19, 188, 56, 7, 219, 70, 41, 40, 129, 204, 164, 43, 17, 160,
+			13, 230, 181, 247, 230, 234, 55, 36, 212, 155, 76, 66, 29, 55,
+			128, 133, 164, 167, 85, 210, 132, 228, 137, 11, 42, 105, 65, 242,
+			241, 231, 200, 47, 139, 35, 58, 74, 237, 47, 0, 191, 249, 233,
+			212, 112, 9, 213, 67, 175, 46, 191, 131, 180, 42, 203, 2, 176,
+			8, 123, 105, 90, 92, 130, 73, 80, 181, 184, 49, 34, 152, 129,
+			116, 223, 216, 75, 62, 205, 71, 164, 47, 104, 223, 2, 87, 151,
+			36, 228, 99, 80, 119, 165, 121, 162, 137, 126, 65, 85, 149, 143,
+			166, 195, 121, 204, 81, 53, 67, 233, 51, 175, 132, 153, 250, 46,
+			61, 106, 132, 205, 245, 252, 77, 222, 149, 239, 221, 225, 2, 60,
+			207, 218, 126, 91, 116, 94, 28, 85, 227, 67, 70, 61, 46, 153,
+			120, 124, 19, 57, 48, 131, 56, 186, 94, 179, 224, 145, 227, 192,
+			157, 136, 68, 136, 116, 230, 119, 216, 176, 203, 221, 40, 43, 69,
+			151, 1, 160, 158, 56, 20, 208, 61, 132, 159, 96, 120, 127, 247,
+			197, 43, 5, 67, 111, 76, 238, 20, 12, 189, 49, 185, 83, 48,
+			244, 154, 143, 63, 71, 62, 46, 238, 22, 76, 81, 251, 47, 76,
+			243, 90, 254, 195, 150, 52, 106, 12, 208, 183, 22, 15, 131, 130,
+			181, 44, 72, 85, 25, 157, 123, 197, 132, 244, 64, 82, 96, 112,
+			97, 203, 49, 243, 58, 16, 178, 188, 38, 5, 158, 41, 36, 225,
+			105, 36, 9, 179, 71, 255, 135, 152, 213, 160, 37, 13, 116, 110,
+			174, 239, 85, 119, 120, 64, 63, 8, 163, 215, 173, 38, 41, 131,
+			253, 155, 96, 30, 167, 12, 152, 154, 52, 85, 73, 19, 146, 83,
+			15, 171, 164, 5, 201, 203, 101, 242, 69, 49, 143, 211, 212, 254,
+			102, 203, 124, 123, 254, 223, 91, 108, 7, 247, 109, 177, 193, 60,
+			161, 176, 160, 181, 66, 58, 151, 200, 171, 165, 119, 48, 144, 128,
+			120, 238, 3, 162, 182, 118, 120, 43, 64, 250, 106, 149, 228, 199,
+			75, 234, 70, 165, 30, 216, 218, 170, 116, 188, 44, 232, 167, 183,
+			139, 5, 233, 153, 37, 14, 10, 192, 194, 160, 202, 72, 139, 187,
+			254, 142, 150, 2, 241, 73, 246, 226, 202, 229, 226, 229, 71, 138,
+			143, 22, 222, 140, 179, 219, 99, 14, 77, 204, 180, 236, 250, 221,
+			100, 43, 236, 196, 245, 236, 68, 254, 16, 91, 134, 38, 140, 105,
+			3, 230, 90, 47, 240, 105, 19, 146, 122, 129, 79, 91, 144, 124,
+			252, 109, 228, 67, 226, 236, 236, 4, 181, 63, 108, 153, 207, 230,
+			255, 95, 131, 109, 137, 11, 157, 192, 235, 224, 166, 161, 124, 42,
+			60, 233, 188, 172, 238, 21, 162, 135, 80, 15, 205, 234, 57, 5,
+			223, 141, 55, 61, 254, 85, 111, 53, 206, 78, 24, 128, 6, 141,
+			179, 19, 38, 36, 53, 206, 78, 88, 144, 124, 252, 25, 242, 11,
+			98, 207, 113, 146, 218, 255, 157, 101, 94, 207, 255, 68, 70, 13,
+			147, 57, 224, 68, 18, 57, 108, 143, 71, 135, 240, 154, 115, 116,
+			152, 180, 103, 36, 237, 120, 210, 231, 70, 150, 239, 67, 108, 207,
+			5, 17, 17, 100, 82, 131, 193, 187, 187, 234, 224, 18, 92, 203,
+			157, 48, 113, 121, 14, 191, 42, 19, 145, 27, 170, 237, 104, 228,
+			75, 233, 47, 141, 42, 202, 106, 122, 160, 30, 103, 239, 131, 82,
+			19, 234, 9, 184, 31, 69, 96, 43, 12, 122, 94, 0, 118, 194,
+			174, 87, 59, 8, 124, 207, 239, 132, 205, 238, 21, 121, 173, 33,
+			8, 35, 40, 212, 140, 29, 185, 123, 2, 89, 33, 103, 9, 1,
+			108, 39, 225, 93, 142, 32, 241, 194, 103, 216, 217, 11, 97, 22,
+			61, 1, 37, 84, 96, 36, 20, 88, 147, 47, 41, 115, 173, 124,
+			201, 61, 166, 63, 129, 69, 16, 246, 112, 229, 136, 176, 129, 240,
+			88, 176, 128, 180, 89, 210, 131, 171, 63, 16, 220, 65, 189, 8,
+			172, 33, 36, 189, 224, 80, 21, 105, 129, 233, 35, 108, 201, 235,
+			43, 202, 243, 183, 127, 74, 212, 27, 173, 108, 69, 34, 25, 143,
+			212, 27, 32, 233, 228, 187, 200, 132, 53, 212, 11, 243, 67, 231,
+			20, 14, 213, 20, 49, 139, 193, 200, 213, 128, 243, 4, 196, 50,
+			124, 42, 124, 47, 174, 7, 115, 24, 131, 132, 187, 10, 9, 59,
+			235, 30, 111, 128, 94, 138, 94, 29, 253, 173, 107, 171, 51, 172,
+			56, 71, 68, 175, 128, 23, 205, 241, 85, 62, 16, 168, 61, 144,
+			241, 22, 149, 28, 65, 76, 50, 69, 182, 169, 206, 159, 251, 230,
+			63, 57, 11, 189, 47, 101, 195, 133, 205, 163, 3, 177, 8, 130,
+			21, 191, 229, 252, 250, 65, 114, 182, 241, 74, 192, 155, 142, 201,
+			72, 197, 252, 46, 98, 251, 164, 1, 204, 67, 251, 121, 156, 52,
+			33, 57, 83, 84, 73, 11, 146, 79, 93, 37, 255, 78, 216, 195,
+			102, 168, 253, 211, 150, 185, 157, 255, 159, 227, 155, 127, 137, 43,
+			17, 242, 206, 188, 102, 45, 241, 156, 32, 217, 180, 185, 87, 151,
+			151, 176, 241, 118, 19, 152, 229, 213, 235, 55, 106, 76, 177, 75,
+			128, 84, 253, 133, 205, 207, 137, 31, 30, 37, 71, 82, 22, 80,
+			207, 94, 151, 29, 30, 192, 226, 3, 59, 184, 216, 8, 203, 203,
+			172, 120, 31, 50, 233, 95, 129, 139, 1, 114, 193, 192, 134, 78,
+			152, 176, 76, 18, 219, 60, 233, 161, 1, 97, 127, 234, 112, 148,
+			0, 155, 14, 199, 19, 14, 20, 55, 156, 174, 184, 114, 161, 152,
+			37, 172, 8, 22, 194, 205, 138, 4, 233, 139, 104, 27, 192, 198,
+			224, 138, 23, 62, 251, 174, 252, 215, 33, 44, 80, 245, 2, 81,
+			152, 232, 197, 2, 46, 174, 134, 3, 254, 188, 202, 129, 66, 125,
+			145, 238, 73, 226, 109, 215, 126, 132, 160, 102, 29, 190, 233, 40,
+			241, 30, 180, 199, 25, 48, 203, 198, 100, 56, 3, 102, 217, 152,
+			12, 103, 192, 44, 107, 61, 245, 14, 242, 205, 66, 123, 156, 165,
+			246, 47, 90, 230, 141, 252, 151, 205, 59, 146, 33, 56, 113, 170,
+			205, 102, 66, 218, 237, 134, 188, 222, 183, 21, 5, 174, 121, 73,
+			92, 104, 18, 166, 232, 186, 140, 241, 144, 196, 227, 192, 152, 20,
+			153, 251, 193, 29, 135, 248, 95, 165, 149, 98, 214, 128, 57, 208,
+			26, 202, 172, 9, 73, 173, 161, 204, 90, 144, 124, 252, 121, 242,
+			125, 4, 39, 236, 20, 181, 255, 15, 203, 60, 200, 127, 43, 185,
+			195, 132, 97, 200, 86, 216, 123, 129, 239, 172, 131, 62, 143, 32,
+			72, 217, 158, 138, 158, 213, 23, 71, 128, 168, 99, 170, 158, 187,
+			59, 98, 111, 236, 214, 64, 21, 232, 120, 24, 40, 200, 105, 194,
+			217, 147, 122, 172, 13, 43, 97, 120, 44, 17, 154, 35, 76, 220,
+			30, 67, 79, 23, 8, 5, 35, 239, 102, 134, 12, 52, 53, 116,
+			51, 134, 179, 48, 208, 248, 149, 232, 223, 235, 120, 181, 131, 196,
+			193, 24, 48, 22, 194, 124, 224, 118, 172, 28, 4, 154, 129, 196,
+			135, 106, 206, 16, 247, 157, 120, 22, 230, 18, 14, 192, 61, 119,
+			49, 145, 179, 28, 30, 248, 77, 46, 206, 245, 244, 29, 30, 39,
+			100, 3, 177, 81, 19, 241, 2, 8, 171, 249, 129, 112, 153, 68,
+			182, 171, 131, 144, 65, 104, 86, 101, 190, 244, 216, 93, 98, 148,
+			21, 193, 235, 71, 198, 21, 0, 70, 217, 129, 43, 74, 16, 92,
+			167, 227, 9, 27, 173, 208, 227, 14, 157, 238, 240, 53, 34, 71,
+			193, 235, 122, 107, 38, 143, 56, 21, 2, 222, 216, 197, 2, 155,
+			213, 132, 214, 4, 196, 206, 152, 51, 72, 66, 12, 117, 207, 230,
+			93, 215, 214, 223, 220, 178, 2, 212, 149, 175, 160, 137, 33, 25,
+			38, 75, 89, 188, 80, 178, 64, 40, 17, 160, 6, 124, 150, 144,
+			123, 117, 148, 111, 82, 199, 78, 104, 238, 120, 208, 29, 112, 48,
+			100, 72, 173, 90, 62, 17, 129, 154, 255, 224, 114, 186, 66, 0,
+			13, 215, 202, 59, 108, 81, 127, 92, 124, 171, 252, 250, 204, 226,
+			197, 197, 183, 202, 185, 123, 134, 16, 217, 26, 222, 195, 193, 174,
+			49, 135, 61, 250, 200, 18, 11, 184, 188, 227, 19, 249, 241, 126,
+			173, 19, 52, 181, 52, 121, 47, 108, 8, 222, 171, 123, 210, 207,
+			92, 55, 159, 239, 163, 24, 148, 238, 224, 198, 222, 142, 164, 108,
+			159, 107, 243, 224, 0, 76, 244, 184, 140, 224, 144, 107, 62, 158,
+			248, 65, 169, 227, 161, 25, 180, 159, 10, 213, 244, 132, 9, 130,
+			251, 27, 23, 144, 146, 75, 158, 50, 128, 45, 166, 207, 168, 164,
+			9, 201, 179, 111, 85, 73, 11, 146, 215, 26, 228, 79, 13, 98,
+			166, 70, 104, 250, 15, 173, 145, 191, 176, 140, 252, 71, 12, 113,
+			123, 15, 167, 26, 86, 176, 156, 40, 217, 29, 86, 90, 89, 71,
+			150, 86, 130, 2, 65, 124, 96, 147, 180, 10, 163, 0, 16, 78,
+			182, 48, 152, 88, 99, 147, 67, 2, 50, 236, 50, 169, 87, 171,
+			171, 254, 123, 93, 88, 50, 201, 11, 126, 69, 178, 180, 185, 248,
+			134, 254, 35, 100, 140, 88, 169, 17, 131, 218, 127, 104, 165, 178,
+			100, 156, 216, 41, 8, 40, 98, 255, 95, 150, 57, 3, 120, 129,
+			148, 129, 73, 170, 146, 38, 36, 79, 156, 36, 159, 48, 176, 176,
+			65, 237, 47, 90, 230, 177, 252, 63, 0, 191, 48, 7, 226, 243,
+			32, 10, 112, 215, 163, 135, 25, 83, 1, 16, 150, 202, 213, 230,
+			58, 177, 251, 146, 49, 91, 98, 253, 10, 46, 207, 55, 155, 242,
+			38, 11, 160, 2, 148, 204, 121, 109, 47, 86, 19, 208, 7, 6,
+			230, 3, 116, 70, 209, 93, 67, 244, 47, 163, 146, 38, 36, 199,
+			198, 201, 183, 139, 222, 155, 212, 254, 51, 232, 253, 171, 236, 69,
+			64, 186, 236, 124, 221, 135, 203, 104, 65, 55, 146, 251, 54, 20,
+			43, 122, 108, 11, 172, 221, 236, 168, 251, 224, 232, 245, 42, 7,
+			20, 46, 16, 233, 76, 63, 56, 240, 5, 169, 7, 203, 209, 180,
+			124, 240, 1, 230, 13, 121, 39, 52, 133, 79, 45, 218, 127, 22,
+			119, 84, 244, 108, 108, 156, 252, 115, 209, 81, 139, 218, 95, 182,
+			204, 241, 252, 63, 49, 216, 230, 161, 119, 135, 142, 30, 198, 227,
+			144, 29, 133, 157, 228, 0, 174, 208, 220, 63, 36, 120, 132, 142,
+			23, 146, 32, 59, 225, 121, 131, 111, 47, 117, 143, 6, 212, 67,
+			167, 114, 20, 150, 129, 221, 30, 85, 73, 19, 146, 100, 140, 140,
+			227, 83, 179, 233, 175, 88, 35, 127, 109, 25, 64, 130, 54, 144,
+			224, 87, 172, 204, 9, 242, 54, 124, 15, 115, 132, 218, 127, 101,
+			153, 211, 249, 69, 86, 138, 175, 34, 194, 40, 251, 110, 138, 40,
+			11, 160, 136, 26, 36, 144, 9, 213, 83, 88, 63, 163, 146, 6,
+			36, 179, 147, 42, 105, 65, 146, 78, 97, 55, 12, 154, 254, 79,
+			214, 200, 223, 183, 69, 55, 128, 92, 254, 147, 149, 57, 71, 126,
+			21, 222, 43, 53, 96, 41, 124, 208, 54, 79, 230, 127, 206, 128,
+			37, 42, 207, 105, 34, 121, 107, 240, 144, 75, 5, 1, 153, 29,
+			204, 128, 236, 170, 140, 29, 192, 246, 240, 46, 49, 243, 27, 90,
+			91, 66, 251, 192, 158, 138, 222, 197, 28, 207, 247, 186, 45, 184,
+			18, 133, 92, 1, 246, 192, 151, 156, 78, 116, 192, 224, 217, 68,
+			221, 154, 24, 164, 144, 26, 23, 226, 58, 87, 244, 47, 241, 1,
+			216, 207, 149, 183, 242, 150, 227, 54, 33, 118, 27, 132, 184, 124,
+			70, 32, 196, 64, 132, 124, 208, 54, 51, 42, 9, 151, 190, 237,
+			236, 113, 149, 180, 32, 57, 125, 130, 204, 227, 160, 13, 106, 127,
+			171, 109, 62, 144, 63, 141, 99, 118, 106, 81, 199, 105, 246, 94,
+			147, 150, 136, 54, 76, 35, 141, 101, 39, 85, 18, 171, 230, 206,
+			170, 164, 5, 201, 251, 11, 228, 99, 6, 49, 109, 147, 166, 63,
+			98, 143, 252, 164, 109, 228, 191, 197, 144, 224, 244, 233, 141, 155,
+			56, 89, 80, 46, 147, 224, 3, 4, 186, 227, 128, 9, 58, 214,
+			64, 215, 34, 125, 65, 22, 175, 7, 185, 161, 154, 130, 161, 100,
+			220, 123, 53, 23, 230, 27, 86, 221, 71, 236, 204, 73, 50, 65,
+			108, 27, 130, 111, 166, 191, 211, 54, 191, 215, 182, 112, 0, 166,
+			5, 84, 249, 157, 54, 57, 70, 22, 73, 26, 62, 3, 61, 252,
+			3, 219, 166, 249, 115, 40, 19, 84, 24, 9, 55, 4, 41, 160,
+			247, 153, 64, 255, 147, 100, 84, 84, 72, 99, 141, 76, 156, 97,
+			64, 70, 246, 88, 156, 97, 65, 70, 238, 56, 249, 14, 67, 54,
+			98, 80, 251, 99, 182, 253, 64, 254, 85, 36, 3, 193, 51, 49,
+			58, 142, 222, 35, 75, 53, 67, 5, 13, 171, 227, 117, 130, 56,
+			8, 26, 234, 154, 176, 85, 65, 211, 73, 139, 21, 222, 122, 211,
+			245, 234, 207, 92, 121, 43, 158, 189, 61, 83, 80, 171, 6, 129,
+			94, 41, 22, 139, 194, 41, 30, 9, 8, 82, 137, 254, 27, 54,
+			118, 102, 60, 206, 72, 65, 198, 177, 169, 56, 3, 187, 59, 125,
+			54, 206, 176, 32, 227, 254, 2, 249, 251, 176, 134, 76, 192, 217,
+			199, 109, 115, 58, 127, 91, 78, 186, 152, 39, 61, 221, 110, 236,
+			36, 235, 68, 3, 235, 25, 195, 117, 92, 73, 90, 112, 117, 69,
+			17, 193, 3, 175, 192, 193, 99, 226, 106, 230, 113, 137, 121, 192,
+			191, 97, 19, 85, 80, 76, 193, 196, 53, 240, 113, 181, 6, 76,
+			156, 136, 143, 219, 146, 41, 152, 56, 13, 31, 183, 233, 20, 249,
+			49, 209, 107, 131, 218, 159, 176, 205, 83, 249, 239, 55, 88, 201,
+			99, 126, 219, 121, 165, 163, 157, 59, 112, 22, 244, 153, 109, 216,
+			187, 211, 232, 209, 187, 19, 3, 77, 110, 133, 135, 69, 195, 7,
+			37, 178, 13, 87, 42, 189, 72, 198, 139, 195, 22, 156, 38, 3,
+			55, 126, 100, 235, 160, 78, 138, 151, 120, 36, 139, 181, 77, 156,
+			143, 79, 196, 163, 2, 6, 246, 9, 59, 59, 173, 146, 22, 36,
+			103, 102, 201, 183, 136, 81, 153, 212, 254, 17, 219, 92, 200, 119,
+			216, 139, 210, 156, 17, 79, 4, 40, 128, 218, 21, 25, 190, 96,
+			80, 66, 56, 91, 131, 174, 139, 152, 178, 122, 35, 143, 186, 104,
+			176, 192, 220, 125, 207, 7, 99, 208, 33, 128, 147, 119, 3, 34,
+			127, 232, 0, 117, 151, 205, 52, 246, 226, 180, 74, 26, 144, 188,
+			239, 188, 74, 90, 144, 188, 112, 145, 252, 35, 209, 101, 184, 8,
+			12, 19, 241, 109, 6, 91, 83, 236, 87, 206, 131, 223, 0, 235,
+			19, 78, 117, 79, 199, 245, 144, 190, 158, 163, 176, 82, 216, 49,
+			133, 120, 16, 117, 159, 140, 17, 111, 97, 183, 103, 102, 201, 135,
+			197, 40, 108, 106, 255, 132, 109, 206, 230, 191, 9, 56, 133, 92,
+			199, 192, 186, 186, 247, 196, 184, 112, 41, 183, 156, 118, 27, 132,
+			12, 108, 210, 9, 115, 116, 184, 62, 71, 71, 187, 235, 28, 205,
+			46, 92, 197, 179, 77, 211, 22, 125, 209, 201, 52, 36, 199, 38,
+			84, 210, 128, 228, 228, 148, 74, 90, 144, 60, 57, 131, 178, 210,
+			162, 233, 159, 178, 71, 126, 86, 202, 74, 24, 241, 79, 217, 153,
+			115, 228, 199, 77, 98, 219, 22, 172, 243, 79, 219, 230, 82, 254,
+			135, 76, 182, 197, 3, 25, 225, 43, 38, 47, 61, 100, 201, 173,
+			149, 75, 27, 24, 238, 235, 234, 246, 158, 172, 212, 228, 222, 126,
+			116, 160, 46, 227, 106, 95, 27, 156, 158, 132, 184, 213, 176, 65,
+			169, 150, 85, 117, 96, 64, 165, 41, 224, 253, 152, 46, 236, 206,
+			241, 142, 191, 62, 27, 134, 58, 125, 97, 40, 100, 197, 57, 84,
+			51, 156, 72, 116, 76, 4, 235, 83, 44, 137, 160, 251, 121, 27,
+			29, 152, 229, 35, 239, 162, 119, 112, 11, 9, 148, 210, 3, 231,
+			22, 76, 18, 132, 176, 210, 221, 83, 38, 80, 169, 150, 2, 174,
+			108, 68, 150, 78, 166, 33, 57, 118, 74, 37, 13, 72, 230, 47,
+			169, 164, 5, 201, 71, 46, 227, 28, 216, 52, 253, 243, 246, 200,
+			103, 229, 28, 216, 6, 181, 127, 222, 206, 156, 65, 249, 101, 131,
+			252, 250, 5, 219, 252, 140, 148, 95, 54, 202, 175, 95, 176, 9,
+			136, 183, 180, 109, 131, 176, 161, 246, 47, 218, 54, 69, 94, 109,
+			75, 241, 244, 139, 74, 60, 217, 82, 60, 253, 162, 18, 79, 182,
+			20, 79, 191, 104, 231, 142, 147, 13, 104, 1, 0, 252, 178, 109,
+			22, 242, 207, 178, 93, 79, 205, 27, 152, 149, 253, 224, 142, 209,
+			131, 250, 163, 124, 73, 68, 216, 136, 136, 95, 86, 136, 176, 81,
+			92, 254, 178, 61, 54, 163, 146, 224, 11, 104, 207, 158, 81, 73,
+			112, 254, 179, 217, 253, 228, 101, 98, 218, 41, 154, 254, 85, 123,
+			228, 183, 108, 227, 235, 178, 39, 178, 33, 160, 195, 175, 218, 153,
+			83, 184, 83, 176, 83, 48, 240, 95, 3, 41, 246, 234, 93, 52,
+			82, 88, 207, 176, 30, 251, 36, 25, 195, 119, 9, 84, 77, 48,
+			154, 53, 67, 159, 232, 13, 55, 198, 161, 110, 113, 199, 11, 89,
+			1, 4, 90, 188, 145, 86, 112, 149, 28, 75, 161, 28, 251, 53,
+			197, 120, 82, 72, 44, 191, 166, 228, 88, 10, 137, 229, 215, 64,
+			142, 237, 16, 219, 78, 129, 126, 243, 235, 182, 121, 34, 127, 21,
+			12, 219, 104, 226, 94, 144, 236, 226, 168, 248, 47, 210, 92, 164,
+			214, 166, 62, 220, 113, 154, 77, 221, 5, 16, 58, 191, 110, 155,
+			105, 149, 196, 86, 70, 115, 42, 105, 65, 114, 106, 154, 188, 29,
+			187, 96, 82, 251, 55, 109, 51, 159, 191, 12, 93, 136, 130, 14,
+			95, 80, 129, 237, 100, 108, 11, 165, 216, 37, 168, 5, 194, 203,
+			232, 214, 204, 20, 66, 80, 173, 193, 152, 126, 211, 30, 61, 161,
+			146, 22, 36, 103, 79, 225, 234, 72, 211, 244, 111, 219, 35, 255,
+			86, 174, 14, 8, 49, 241, 219, 118, 38, 79, 202, 196, 182, 211,
+			48, 133, 191, 107, 155, 231, 242, 79, 178, 245, 254, 112, 53, 58,
+			142, 141, 228, 164, 50, 250, 175, 236, 22, 184, 33, 40, 137, 155,
+			70, 146, 253, 93, 69, 178, 105, 156, 142, 223, 181, 199, 142, 171,
+			164, 1, 173, 208, 188, 74, 90, 144, 60, 115, 150, 188, 136, 93,
+			48, 168, 253, 57, 232, 194, 218, 221, 187, 80, 59, 112, 155, 106,
+			185, 128, 43, 139, 12, 25, 202, 162, 192, 113, 241, 22, 249, 249,
+			197, 243, 138, 161, 164, 81, 79, 251, 92, 220, 41, 152, 160, 207,
+			197, 157, 2, 173, 224, 115, 113, 167, 12, 11, 146, 103, 206, 146,
+			239, 1, 189, 124, 148, 166, 127, 223, 30, 249, 143, 182, 145, 95,
+			82, 49, 150, 165, 154, 47, 67, 45, 199, 230, 138, 193, 240, 39,
+			95, 159, 197, 7, 97, 51, 126, 31, 212, 242, 113, 98, 219, 163,
+			48, 113, 159, 183, 77, 49, 225, 163, 136, 240, 207, 43, 250, 31,
+			69, 132, 127, 222, 206, 230, 84, 210, 130, 194, 83, 211, 96, 159,
+			48, 83, 6, 77, 255, 145, 61, 242, 37, 219, 200, 63, 195, 6,
+			31, 128, 82, 23, 38, 0, 221, 3, 247, 213, 193, 42, 29, 223,
+			128, 255, 186, 140, 51, 5, 243, 242, 71, 118, 10, 164, 171, 157,
+			194, 221, 230, 23, 108, 19, 125, 120, 32, 5, 158, 90, 182, 121,
+			191, 74, 130, 167, 150, 253, 224, 67, 164, 132, 101, 193, 174, 97,
+			155, 167, 10, 143, 246, 26, 81, 65, 137, 75, 222, 156, 72, 30,
+			216, 97, 40, 12, 207, 63, 36, 18, 34, 180, 254, 69, 219, 28,
+			87, 73, 176, 141, 216, 51, 179, 228, 73, 108, 192, 164, 246, 159,
+			64, 3, 243, 189, 13, 244, 133, 223, 211, 87, 159, 55, 159, 87,
+			96, 97, 125, 254, 137, 109, 78, 171, 36, 2, 154, 153, 37, 207,
+			34, 88, 139, 218, 127, 10, 96, 151, 122, 193, 74, 27, 237, 158,
+			10, 46, 0, 154, 156, 175, 67, 22, 160, 215, 150, 130, 15, 122,
+			199, 159, 218, 230, 132, 74, 154, 144, 156, 153, 37, 101, 98, 218,
+			25, 154, 254, 115, 123, 228, 175, 109, 35, 255, 164, 118, 17, 132,
+			89, 118, 146, 23, 140, 19, 110, 106, 137, 171, 221, 234, 188, 10,
+			232, 15, 226, 92, 252, 185, 157, 201, 145, 39, 136, 109, 103, 96,
+			94, 190, 12, 76, 116, 142, 149, 146, 97, 187, 85, 165, 30, 86,
+			191, 88, 87, 156, 58, 131, 148, 250, 101, 69, 169, 25, 156, 208,
+			47, 43, 74, 205, 32, 107, 248, 178, 61, 53, 13, 136, 177, 51,
+			128, 181, 191, 4, 61, 119, 73, 248, 62, 129, 139, 119, 77, 199,
+			132, 210, 253, 5, 171, 174, 98, 147, 50, 18, 134, 110, 14, 54,
+			227, 127, 105, 155, 99, 42, 137, 16, 199, 167, 85, 210, 130, 228,
+			204, 44, 114, 229, 12, 216, 151, 190, 98, 155, 167, 243, 151, 113,
+			147, 9, 122, 116, 146, 84, 122, 218, 156, 243, 59, 81, 187, 35,
+			238, 7, 43, 142, 147, 65, 174, 252, 149, 120, 112, 208, 255, 175,
+			216, 217, 147, 42, 105, 65, 242, 84, 94, 182, 6, 22, 23, 219,
+			44, 230, 47, 199, 251, 142, 163, 142, 149, 135, 183, 102, 165, 17,
+			194, 105, 149, 4, 139, 142, 125, 223, 188, 74, 34, 252, 133, 75,
+			40, 3, 178, 52, 253, 159, 237, 145, 239, 72, 9, 25, 0, 129,
+			21, 254, 179, 157, 97, 36, 32, 182, 157, 133, 169, 252, 64, 202,
+			100, 249, 58, 219, 236, 68, 53, 63, 158, 75, 29, 204, 98, 65,
+			135, 135, 213, 209, 164, 69, 164, 103, 183, 41, 238, 90, 225, 225,
+			58, 152, 40, 100, 172, 110, 113, 136, 37, 15, 150, 193, 32, 36,
+			31, 125, 144, 125, 207, 162, 82, 243, 129, 148, 88, 16, 208, 5,
+			3, 250, 112, 226, 180, 74, 90, 144, 60, 123, 142, 124, 43, 40,
+			26, 89, 192, 227, 135, 82, 230, 108, 254, 16, 175, 139, 232, 51,
+			160, 68, 224, 10, 224, 194, 67, 226, 89, 72, 5, 55, 62, 202,
+			215, 33, 245, 99, 147, 106, 17, 246, 211, 232, 7, 0, 234, 239,
+			144, 96, 35, 186, 211, 64, 76, 31, 74, 73, 129, 146, 69, 98,
+			250, 80, 106, 108, 74, 37, 45, 248, 122, 114, 134, 124, 74, 116,
+			26, 28, 166, 0, 173, 63, 106, 36, 122, 221, 11, 95, 119, 47,
+			25, 136, 37, 17, 67, 164, 223, 209, 12, 78, 85, 246, 57, 226,
+			22, 68, 159, 230, 5, 42, 116, 73, 228, 43, 24, 61, 212, 4,
+			11, 164, 103, 148, 242, 62, 189, 236, 147, 150, 227, 89, 220, 134,
+			126, 56, 101, 30, 87, 73, 240, 1, 75, 81, 53, 45, 38, 56,
+			125, 165, 206, 158, 67, 93, 147, 208, 244, 71, 83, 35, 63, 148,
+			250, 58, 233, 154, 16, 238, 226, 163, 169, 204, 125, 192, 121, 109,
+			2, 52, 250, 177, 148, 121, 34, 63, 207, 54, 36, 179, 137, 37,
+			176, 212, 46, 245, 120, 225, 136, 73, 142, 136, 32, 191, 249, 88,
+			74, 46, 73, 130, 132, 246, 177, 148, 228, 55, 4, 249, 205, 199,
+			82, 83, 211, 228, 57, 108, 197, 160, 246, 63, 76, 153, 51, 249,
+			183, 178, 13, 237, 239, 19, 67, 21, 39, 90, 224, 134, 5, 71,
+			136, 30, 120, 25, 3, 163, 171, 243, 134, 35, 35, 255, 93, 126,
+			228, 17, 221, 48, 168, 27, 255, 48, 101, 142, 170, 36, 194, 206,
+			28, 87, 73, 11, 146, 211, 39, 201, 1, 54, 108, 82, 251, 7,
+			83, 230, 169, 252, 187, 216, 11, 96, 142, 2, 22, 124, 190, 239,
+			125, 234, 243, 113, 180, 42, 48, 137, 184, 96, 33, 13, 120, 216,
+			246, 189, 144, 23, 201, 255, 199, 222, 183, 6, 71, 114, 28, 103,
+			206, 116, 247, 116, 247, 52, 222, 133, 25, 96, 48, 192, 0, 141,
+			198, 238, 226, 177, 120, 236, 98, 151, 20, 151, 111, 236, 46, 118,
+			9, 115, 185, 75, 3, 160, 44, 217, 114, 128, 3, 76, 3, 59,
+			226, 0, 131, 155, 25, 112, 9, 57, 124, 161, 71, 240, 172, 19,
+			69, 242, 232, 144, 169, 103, 232, 40, 7, 245, 160, 37, 74, 150,
+			142, 228, 201, 60, 137, 183, 18, 69, 241, 161, 135, 41, 6, 67,
+			12, 158, 205, 96, 72, 114, 132, 197, 147, 121, 188, 8, 93, 240,
+			116, 62, 133, 125, 241, 101, 87, 86, 247, 96, 1, 18, 171, 16,
+			239, 151, 254, 77, 245, 116, 103, 86, 101, 101, 101, 101, 102, 101,
+			101, 34, 131, 137, 98, 19, 72, 200, 138, 143, 27, 92, 42, 210,
+			74, 117, 11, 34, 234, 19, 33, 61, 176, 180, 62, 145, 72, 182,
+			115, 83, 71, 179, 35, 67, 66, 163, 65, 152, 159, 78, 196, 62,
+			43, 133, 6, 178, 97, 124, 58, 97, 231, 156, 89, 199, 48, 26,
+			48, 33, 247, 37, 52, 47, 123, 156, 245, 163, 144, 252, 163, 172,
+			182, 45, 110, 94, 24, 147, 188, 125, 254, 21, 96, 111, 32, 45,
+			242, 62, 94, 95, 13, 36, 35, 238, 227, 245, 213, 64, 83, 119,
+			95, 34, 149, 227, 38, 226, 130, 18, 110, 127, 32, 35, 26, 48,
+			144, 251, 19, 90, 111, 246, 156, 36, 97, 77, 38, 228, 205, 87,
+			221, 193, 40, 21, 139, 107, 238, 118, 245, 243, 182, 161, 153, 11,
+			55, 190, 204, 64, 42, 171, 201, 212, 197, 77, 210, 245, 59, 153,
+			92, 78, 141, 1, 211, 126, 63, 211, 183, 129, 100, 196, 253, 137,
+			100, 134, 155, 58, 254, 237, 206, 209, 10, 106, 20, 230, 23, 19,
+			177, 199, 222, 170, 21, 132, 92, 6, 95, 76, 216, 189, 206, 49,
+			199, 48, 26, 49, 97, 15, 98, 5, 93, 178, 253, 10, 10, 46,
+			37, 135, 204, 94, 94, 118, 135, 100, 66, 47, 101, 163, 55, 210,
+			106, 122, 144, 71, 215, 72, 83, 242, 32, 175, 166, 70, 90, 77,
+			15, 98, 53, 253, 18, 222, 143, 70, 76, 201, 195, 16, 219, 255,
+			160, 5, 55, 168, 177, 80, 203, 229, 91, 104, 255, 160, 48, 244,
+			229, 242, 198, 90, 244, 60, 148, 220, 50, 83, 167, 78, 129, 202,
+			85, 191, 62, 86, 10, 157, 112, 143, 7, 19, 171, 138, 161, 68,
+			138, 75, 81, 52, 18, 15, 3, 94, 187, 106, 13, 49, 37, 197,
+			37, 68, 206, 209, 62, 45, 211, 46, 214, 156, 72, 101, 9, 156,
+			72, 202, 43, 189, 203, 197, 18, 148, 161, 197, 205, 113, 119, 170,
+			84, 138, 150, 122, 81, 215, 34, 41, 134, 42, 240, 115, 228, 145,
+			252, 112, 61, 95, 193, 121, 87, 181, 230, 175, 187, 249, 101, 124,
+			141, 62, 112, 138, 103, 234, 203, 5, 55, 28, 131, 94, 84, 169,
+			176, 34, 120, 138, 196, 177, 138, 93, 38, 84, 65, 244, 192, 162,
+			95, 227, 236, 47, 212, 221, 192, 61, 71, 227, 162, 97, 227, 99,
+			122, 61, 186, 136, 26, 201, 234, 121, 152, 23, 81, 35, 157, 70,
+			60, 156, 144, 174, 172, 70, 226, 199, 135, 19, 210, 149, 213, 72,
+			252, 248, 112, 162, 163, 147, 228, 95, 35, 20, 160, 175, 255, 118,
+			228, 95, 35, 233, 66, 95, 103, 249, 215, 72, 30, 205, 175, 179,
+			252, 107, 36, 23, 230, 215, 89, 254, 53, 162, 245, 205, 255, 31,
+			242, 175, 145, 92, 148, 223, 12, 57, 24, 138, 243, 55, 89, 254,
+			53, 146, 210, 244, 77, 150, 127, 77, 194, 252, 86, 34, 246, 148,
+			148, 127, 200, 124, 241, 173, 132, 221, 71, 242, 175, 9, 203, 233,
+			241, 223, 174, 252, 107, 34, 249, 247, 56, 79, 93, 19, 201, 191,
+			199, 89, 254, 53, 209, 98, 123, 156, 229, 95, 19, 45, 182, 199,
+			33, 255, 254, 12, 242, 175, 9, 244, 125, 18, 242, 111, 243, 205,
+			229, 223, 246, 53, 63, 183, 161, 154, 131, 51, 144, 139, 147, 128,
+			77, 36, 1, 159, 100, 10, 55, 17, 199, 61, 201, 18, 176, 137,
+			56, 238, 201, 68, 119, 206, 121, 28, 118, 118, 179, 48, 159, 77,
+			196, 254, 62, 17, 207, 22, 220, 217, 221, 101, 65, 90, 223, 66,
+			110, 153, 173, 52, 156, 125, 103, 199, 228, 71, 111, 145, 101, 142,
+			84, 31, 207, 38, 236, 6, 103, 194, 49, 140, 102, 112, 198, 115,
+			9, 77, 100, 251, 149, 160, 149, 185, 116, 46, 184, 181, 65, 107,
+			161, 153, 132, 234, 115, 76, 176, 102, 154, 231, 231, 18, 201, 38,
+			110, 234, 128, 215, 218, 70, 167, 192, 205, 152, 230, 231, 33, 198,
+			39, 234, 160, 51, 81, 194, 123, 184, 50, 167, 148, 60, 70, 82,
+			184, 48, 57, 207, 135, 184, 48, 57, 207, 179, 0, 111, 166, 201,
+			121, 30, 2, 252, 114, 194, 133, 107, 127, 88, 149, 163, 219, 149,
+			148, 139, 32, 137, 196, 184, 42, 68, 208, 36, 127, 156, 144, 134,
+			87, 51, 45, 255, 31, 39, 164, 225, 213, 76, 203, 255, 199, 137,
+			206, 140, 115, 146, 16, 33, 204, 4, 136, 142, 132, 134, 87, 228,
+			240, 130, 50, 188, 46, 215, 31, 92, 108, 107, 18, 53, 211, 234,
+			126, 49, 28, 30, 86, 247, 139, 9, 121, 0, 209, 76, 171, 251,
+			69, 96, 61, 65, 88, 13, 97, 252, 93, 66, 27, 205, 94, 22,
+			26, 96, 24, 208, 206, 103, 62, 59, 32, 53, 76, 2, 212, 205,
+			205, 56, 154, 242, 236, 166, 153, 78, 11, 254, 46, 49, 178, 159,
+			84, 170, 22, 97, 190, 156, 136, 253, 131, 20, 41, 72, 4, 240,
+			114, 194, 206, 16, 227, 180, 128, 113, 126, 114, 17, 140, 211, 66,
+			140, 243, 19, 30, 109, 11, 49, 206, 79, 152, 113, 90, 136, 113,
+			126, 194, 140, 211, 130, 9, 248, 217, 111, 204, 56, 45, 180, 170,
+			127, 22, 226, 2, 227, 252, 140, 25, 167, 133, 24, 231, 103, 96,
+			28, 12, 178, 85, 152, 255, 152, 136, 253, 119, 57, 72, 220, 93,
+			255, 199, 132, 221, 233, 76, 58, 134, 209, 138, 65, 190, 130, 110,
+			236, 217, 94, 13, 33, 79, 31, 98, 90, 66, 29, 190, 149, 198,
+			249, 10, 227, 110, 165, 113, 190, 194, 184, 91, 105, 156, 175, 48,
+			238, 54, 97, 190, 154, 136, 253, 79, 137, 27, 247, 88, 95, 5,
+			129, 225, 105, 108, 3, 238, 215, 160, 143, 204, 40, 153, 13, 76,
+			81, 113, 29, 204, 251, 210, 14, 226, 154, 114, 114, 108, 64, 25,
+			169, 19, 220, 109, 36, 184, 95, 99, 193, 221, 70, 130, 251, 53,
+			222, 115, 219, 168, 191, 175, 241, 158, 219, 70, 253, 125, 13, 123,
+			238, 237, 186, 163, 25, 66, 152, 255, 146, 136, 125, 208, 140, 103,
+			63, 75, 90, 146, 186, 95, 127, 121, 120, 191, 94, 233, 64, 74,
+			8, 242, 42, 132, 78, 116, 189, 191, 89, 221, 41, 253, 139, 76,
+			6, 27, 41, 222, 86, 172, 72, 191, 210, 214, 212, 215, 129, 210,
+			1, 105, 161, 74, 147, 132, 151, 200, 100, 254, 54, 110, 79, 122,
+			245, 186, 25, 187, 100, 170, 249, 213, 72, 44, 56, 95, 235, 169,
+			149, 107, 80, 213, 232, 252, 138, 249, 14, 58, 215, 16, 42, 142,
+			169, 145, 6, 9, 137, 134, 35, 94, 47, 42, 96, 71, 210, 253,
+			240, 129, 3, 168, 20, 80, 123, 203, 194, 189, 12, 92, 1, 253,
+			23, 200, 112, 56, 158, 4, 56, 229, 189, 166, 214, 150, 157, 116,
+			175, 151, 249, 12, 46, 76, 103, 64, 133, 73, 240, 227, 143, 242,
+			99, 239, 57, 48, 118, 100, 225, 93, 99, 127, 188, 95, 178, 132,
+			32, 158, 125, 175, 41, 121, 86, 16, 15, 188, 215, 76, 54, 114,
+			19, 14, 14, 179, 165, 213, 57, 67, 232, 16, 122, 98, 106, 237,
+			217, 41, 185, 119, 19, 15, 92, 128, 102, 106, 236, 15, 3, 76,
+			123, 134, 134, 71, 246, 143, 190, 107, 108, 124, 226, 242, 43, 174,
+			188, 234, 234, 107, 223, 245, 174, 133, 63, 249, 211, 127, 235, 70,
+			176, 99, 181, 190, 63, 196, 142, 213, 250, 126, 51, 217, 204, 77,
+			196, 182, 152, 109, 130, 196, 188, 128, 214, 119, 187, 73, 98, 158,
+			164, 47, 81, 60, 156, 89, 248, 50, 105, 182, 182, 145, 125, 130,
+			180, 188, 219, 67, 68, 144, 50, 183, 155, 82, 224, 10, 18, 243,
+			183, 155, 157, 25, 231, 50, 66, 132, 236, 96, 166, 54, 154, 29,
+			9, 5, 46, 32, 159, 203, 71, 56, 105, 59, 17, 43, 52, 221,
+			164, 79, 187, 185, 137, 44, 100, 166, 20, 177, 130, 228, 250, 159,
+			153, 82, 196, 182, 11, 243, 14, 51, 246, 231, 102, 32, 1, 112,
+			37, 244, 14, 211, 238, 34, 145, 223, 142, 121, 189, 211, 212, 210,
+			217, 203, 220, 249, 136, 240, 163, 17, 159, 45, 151, 10, 91, 114,
+			247, 156, 227, 219, 233, 156, 127, 75, 246, 167, 157, 102, 247, 78,
+			30, 118, 59, 205, 238, 157, 166, 148, 72, 237, 180, 194, 239, 52,
+			219, 83, 36, 215, 219, 65, 147, 187, 65, 223, 126, 119, 126, 119,
+			208, 161, 179, 223, 109, 202, 189, 179, 157, 52, 168, 187, 77, 185,
+			119, 182, 147, 172, 189, 27, 68, 61, 74, 208, 53, 97, 220, 99,
+			106, 153, 236, 97, 247, 76, 36, 186, 82, 130, 100, 12, 67, 116,
+			46, 169, 2, 157, 131, 52, 244, 76, 222, 118, 58, 138, 191, 199,
+			148, 242, 171, 157, 188, 64, 247, 152, 82, 126, 181, 211, 222, 125,
+			143, 41, 229, 87, 59, 77, 234, 61, 166, 60, 254, 78, 9, 243,
+			47, 204, 216, 167, 36, 181, 113, 207, 242, 47, 64, 237, 235, 28,
+			195, 72, 129, 218, 31, 5, 181, 47, 223, 45, 181, 101, 130, 8,
+			234, 60, 188, 29, 178, 131, 41, 162, 247, 71, 153, 222, 41, 162,
+			247, 71, 153, 222, 41, 162, 247, 71, 65, 239, 131, 132, 54, 46,
+			140, 143, 131, 222, 3, 238, 252, 110, 225, 131, 226, 31, 103, 138,
+			167, 136, 226, 31, 103, 138, 167, 136, 226, 31, 103, 138, 167, 176,
+			94, 62, 185, 51, 197, 11, 254, 155, 83, 60, 69, 20, 255, 36,
+			83, 60, 69, 20, 255, 36, 83, 60, 69, 20, 255, 36, 83, 60,
+			69, 20, 255, 36, 40, 14, 175, 65, 90, 152, 127, 105, 198, 254,
+			179, 249, 22, 121, 13, 112, 237, 243, 47, 77, 59, 208, 145, 210,
+			152, 194, 251, 33, 8, 47, 187, 72, 65, 248, 39, 7, 71, 15,
+			31, 56, 240, 167, 114, 188, 105, 154, 192, 251, 121, 2, 211, 52,
+			129, 247, 179, 56, 76, 211, 4, 222, 15, 113, 120, 156, 144, 198,
+			133, 241, 57, 83, 19, 217, 75, 221, 183, 111, 159, 42, 102, 179,
+			230, 7, 249, 98, 234, 110, 35, 6, 105, 99, 20, 74, 200, 192,
+			207, 153, 210, 0, 77, 211, 156, 126, 206, 180, 155, 184, 169, 227,
+			223, 214, 54, 103, 142, 80, 106, 194, 248, 130, 169, 101, 179, 211,
+			238, 153, 117, 105, 245, 223, 48, 115, 195, 52, 199, 138, 187, 181,
+			205, 117, 165, 51, 169, 24, 6, 218, 183, 80, 101, 163, 184, 154,
+			175, 20, 229, 157, 193, 155, 102, 84, 15, 32, 28, 191, 16, 14,
+			26, 179, 250, 5, 51, 153, 230, 166, 142, 102, 166, 203, 249, 107,
+			120, 75, 210, 152, 228, 175, 130, 109, 63, 163, 185, 39, 194, 156,
+			15, 23, 36, 180, 83, 216, 17, 180, 177, 25, 102, 94, 10, 47,
+			158, 30, 188, 148, 40, 68, 62, 156, 160, 90, 238, 144, 119, 229,
+			45, 254, 102, 24, 236, 54, 12, 43, 48, 156, 199, 179, 254, 109,
+			142, 42, 25, 44, 211, 247, 6, 234, 192, 161, 201, 250, 215, 168,
+			182, 46, 238, 197, 84, 80, 38, 24, 247, 50, 100, 198, 138, 250,
+			232, 33, 128, 71, 164, 33, 219, 231, 213, 113, 119, 70, 134, 19,
+			161, 110, 102, 109, 155, 156, 24, 168, 111, 40, 41, 95, 146, 23,
+			101, 46, 200, 19, 35, 95, 85, 244, 133, 182, 255, 213, 144, 190,
+			208, 246, 191, 202, 155, 79, 154, 118, 133, 175, 154, 242, 112, 39,
+			141, 5, 247, 16, 200, 123, 112, 199, 93, 78, 81, 118, 187, 61,
+			40, 173, 25, 9, 130, 192, 216, 16, 102, 242, 80, 136, 13, 106,
+			254, 67, 192, 118, 21, 97, 75, 8, 227, 17, 108, 117, 19, 225,
+			86, 167, 192, 191, 233, 126, 151, 214, 18, 38, 125, 223, 205, 205,
+			56, 154, 114, 191, 75, 83, 233, 140, 71, 120, 191, 235, 16, 230,
+			163, 102, 236, 91, 82, 2, 227, 46, 229, 163, 166, 157, 35, 135,
+			78, 7, 150, 239, 55, 32, 129, 175, 188, 200, 253, 78, 245, 85,
+			237, 74, 29, 180, 132, 191, 193, 227, 239, 160, 37, 252, 13, 150,
+			193, 29, 180, 132, 191, 1, 25, 124, 136, 16, 199, 133, 241, 24,
+			168, 189, 215, 157, 223, 61, 6, 72, 225, 199, 88, 10, 119, 208,
+			138, 125, 140, 165, 112, 7, 73, 225, 199, 64, 97, 156, 8, 116,
+			64, 10, 159, 55, 181, 225, 236, 112, 157, 20, 190, 32, 221, 133,
+			218, 3, 21, 22, 112, 194, 121, 22, 189, 29, 36, 122, 207, 155,
+			13, 221, 220, 140, 227, 223, 158, 61, 252, 175, 142, 230, 224, 16,
+			109, 118, 157, 194, 252, 142, 25, 251, 158, 36, 117, 103, 92, 24,
+			223, 1, 169, 79, 57, 134, 209, 9, 82, 127, 23, 164, 190, 250,
+			34, 55, 59, 213, 227, 200, 134, 212, 73, 196, 254, 46, 19, 187,
+			147, 136, 253, 93, 38, 118, 39, 17, 251, 187, 32, 246, 37, 132,
+			58, 46, 140, 167, 64, 236, 65, 119, 254, 98, 112, 128, 220, 79,
+			49, 185, 59, 137, 220, 79, 49, 185, 59, 137, 220, 79, 129, 220,
+			55, 18, 14, 77, 24, 207, 128, 220, 71, 85, 146, 30, 181, 215,
+			201, 18, 15, 117, 153, 106, 48, 33, 148, 224, 102, 63, 39, 183,
+			201, 87, 212, 61, 163, 0, 1, 230, 225, 25, 158, 135, 78, 154,
+			135, 103, 120, 30, 58, 105, 11, 124, 134, 231, 161, 147, 132, 229,
+			51, 60, 15, 25, 97, 254, 208, 140, 253, 55, 57, 15, 153, 184,
+			48, 126, 104, 218, 221, 164, 116, 100, 48, 15, 207, 94, 148, 210,
+			65, 38, 167, 162, 144, 50, 59, 51, 52, 7, 207, 242, 28, 100,
+			104, 14, 158, 229, 57, 200, 208, 28, 60, 203, 74, 71, 6, 221,
+			125, 110, 27, 165, 163, 86, 222, 25, 62, 232, 255, 28, 211, 63,
+			67, 244, 127, 142, 233, 159, 33, 250, 63, 7, 250, 35, 104, 41,
+			3, 118, 127, 222, 212, 186, 179, 39, 92, 150, 134, 148, 16, 6,
+			26, 7, 121, 131, 105, 88, 170, 100, 81, 57, 116, 220, 113, 15,
+			162, 169, 102, 84, 23, 48, 7, 207, 243, 28, 100, 72, 155, 127,
+			222, 148, 33, 50, 25, 154, 131, 231, 77, 209, 193, 77, 120, 135,
+			204, 174, 172, 51, 67, 61, 210, 133, 241, 130, 169, 117, 102, 175,
+			136, 56, 139, 165, 47, 112, 123, 87, 113, 184, 9, 224, 0, 32,
+			168, 98, 16, 64, 134, 32, 127, 129, 183, 234, 12, 213, 95, 123,
+			193, 148, 190, 226, 12, 9, 242, 23, 204, 84, 7, 169, 36, 25,
+			116, 249, 69, 80, 250, 178, 223, 216, 87, 28, 96, 129, 60, 127,
+			49, 156, 94, 200, 243, 23, 77, 233, 9, 206, 144, 219, 230, 69,
+			83, 122, 130, 187, 132, 249, 146, 25, 251, 153, 100, 56, 220, 153,
+			122, 201, 180, 123, 156, 155, 28, 195, 232, 2, 195, 189, 140, 149,
+			113, 210, 61, 46, 83, 125, 70, 19, 195, 69, 2, 57, 7, 89,
+			232, 47, 212, 170, 131, 23, 228, 225, 9, 211, 240, 160, 63, 93,
+			228, 83, 120, 153, 167, 166, 139, 124, 10, 47, 243, 242, 232, 34,
+			102, 124, 153, 151, 71, 23, 49, 227, 203, 88, 30, 228, 12, 238,
+			194, 196, 253, 212, 220, 157, 51, 184, 62, 15, 212, 27, 158, 133,
+			229, 215, 46, 210, 25, 220, 69, 110, 163, 159, 50, 145, 187, 136,
+			199, 127, 106, 74, 103, 112, 23, 241, 248, 79, 77, 121, 28, 150,
+			21, 230, 43, 102, 236, 127, 188, 85, 138, 109, 22, 94, 35, 136,
+			107, 40, 124, 89, 204, 218, 47, 32, 38, 166, 47, 20, 19, 234,
+			84, 126, 171, 164, 128, 8, 11, 50, 206, 96, 244, 210, 25, 18,
+			174, 232, 44, 73, 140, 95, 240, 104, 179, 52, 73, 191, 96, 137,
+			145, 165, 73, 250, 5, 36, 6, 194, 70, 179, 152, 163, 87, 209,
+			131, 107, 169, 7, 12, 77, 146, 54, 152, 129, 114, 9, 85, 118,
+			170, 190, 239, 206, 6, 141, 183, 203, 183, 34, 217, 248, 20, 114,
+			144, 250, 213, 16, 57, 72, 253, 106, 136, 28, 164, 126, 21, 200,
+			97, 67, 116, 11, 243, 127, 153, 177, 255, 243, 86, 145, 186, 27,
+			81, 54, 172, 132, 116, 131, 212, 175, 95, 148, 18, 114, 46, 15,
+			141, 187, 140, 203, 127, 148, 111, 71, 81, 184, 155, 40, 252, 58,
+			15, 178, 155, 40, 252, 58, 15, 178, 155, 40, 252, 58, 43, 33,
+			221, 160, 240, 175, 182, 87, 66, 222, 0, 3, 164, 242, 175, 88,
+			42, 119, 19, 199, 254, 138, 165, 114, 55, 113, 236, 175, 32, 149,
+			65, 198, 30, 97, 254, 171, 25, 251, 119, 214, 91, 68, 198, 158,
+			184, 48, 254, 213, 180, 251, 40, 226, 170, 7, 100, 124, 159, 69,
+			17, 87, 59, 144, 113, 177, 92, 11, 239, 254, 242, 18, 236, 33,
+			146, 189, 207, 146, 36, 235, 33, 146, 189, 207, 146, 36, 235, 33,
+			146, 189, 207, 106, 79, 57, 135, 9, 73, 92, 24, 31, 0, 146,
+			125, 245, 36, 139, 228, 41, 8, 211, 229, 132, 40, 64, 179, 15,
+			88, 146, 102, 61, 68, 179, 15, 88, 141, 140, 2, 52, 251, 128,
+			37, 181, 149, 30, 236, 100, 183, 91, 90, 103, 118, 112, 71, 20,
+			152, 157, 170, 156, 30, 133, 3, 250, 193, 237, 33, 14, 76, 238,
+			237, 86, 163, 224, 38, 60, 77, 86, 186, 195, 153, 113, 80, 104,
+			206, 252, 144, 21, 187, 219, 138, 103, 175, 8, 178, 246, 132, 161,
+			141, 121, 142, 195, 41, 47, 191, 113, 130, 162, 113, 98, 101, 10,
+			22, 254, 144, 149, 104, 165, 104, 68, 186, 183, 115, 135, 165, 81,
+			9, 154, 132, 70, 164, 188, 195, 210, 178, 220, 212, 132, 113, 135,
+			149, 235, 117, 198, 28, 25, 146, 251, 97, 75, 235, 243, 250, 234,
+			19, 217, 4, 169, 21, 194, 180, 53, 142, 252, 26, 68, 251, 176,
+			165, 181, 112, 83, 67, 51, 215, 235, 12, 17, 48, 77, 24, 119,
+			2, 88, 54, 2, 76, 6, 6, 145, 136, 14, 225, 0, 239, 157,
+			150, 214, 196, 77, 250, 50, 215, 11, 95, 95, 16, 24, 124, 23,
+			224, 208, 105, 121, 197, 231, 26, 146, 144, 223, 148, 87, 102, 52,
+			146, 200, 138, 105, 193, 144, 177, 45, 223, 101, 105, 237, 220, 212,
+			208, 204, 245, 210, 14, 153, 19, 230, 159, 91, 177, 207, 88, 193,
+			14, 153, 67, 148, 141, 101, 167, 201, 184, 206, 129, 106, 31, 177,
+			180, 46, 41, 107, 195, 9, 80, 67, 145, 9, 115, 162, 1, 99,
+			170, 248, 6, 68, 93, 36, 237, 145, 228, 135, 28, 109, 136, 31,
+			145, 227, 52, 114, 52, 23, 31, 177, 154, 83, 220, 212, 129, 179,
+			51, 67, 60, 151, 3, 77, 238, 181, 180, 108, 118, 208, 157, 170,
+			75, 228, 181, 125, 222, 36, 133, 3, 124, 125, 47, 243, 92, 142,
+			248, 250, 94, 171, 49, 205, 77, 29, 255, 102, 186, 104, 233, 228,
+			192, 215, 31, 179, 180, 142, 236, 190, 237, 130, 21, 229, 244, 7,
+			150, 224, 177, 169, 57, 133, 2, 108, 253, 177, 16, 5, 122, 250,
+			49, 171, 177, 141, 155, 184, 129, 101, 165, 210, 228, 73, 204, 97,
+			246, 62, 129, 165, 19, 120, 18, 145, 28, 183, 142, 140, 216, 206,
+			165, 151, 92, 126, 15, 197, 234, 19, 150, 84, 172, 114, 164, 88,
+			125, 194, 178, 5, 55, 9, 94, 186, 3, 188, 97, 228, 160, 88,
+			125, 202, 210, 188, 236, 168, 139, 244, 12, 85, 142, 217, 147, 121,
+			149, 182, 205, 246, 164, 16, 225, 2, 205, 167, 44, 169, 173, 228,
+			200, 86, 254, 148, 37, 21, 201, 28, 233, 86, 159, 178, 68, 142,
+			155, 58, 154, 110, 63, 89, 78, 57, 40, 157, 159, 182, 180, 61,
+			217, 171, 183, 224, 45, 174, 109, 17, 105, 193, 53, 103, 138, 124,
+			11, 51, 254, 74, 15, 141, 234, 73, 194, 32, 112, 170, 73, 208,
+			85, 79, 112, 133, 225, 211, 150, 232, 227, 166, 142, 166, 55, 64,
+			14, 234, 28, 230, 226, 62, 244, 100, 228, 13, 40, 80, 151, 255,
+			73, 97, 53, 17, 186, 20, 98, 53, 19, 104, 42, 172, 38, 66,
+			151, 66, 172, 38, 66, 151, 44, 111, 192, 249, 22, 206, 192, 123,
+			133, 249, 128, 21, 251, 47, 86, 60, 187, 136, 124, 115, 200, 37,
+			80, 174, 132, 23, 39, 165, 200, 138, 132, 229, 190, 65, 174, 119,
+			10, 69, 113, 144, 148, 10, 151, 28, 145, 139, 98, 173, 62, 3,
+			229, 91, 179, 83, 245, 198, 133, 241, 128, 101, 183, 209, 149, 155,
+			94, 92, 99, 254, 43, 75, 251, 138, 21, 92, 185, 233, 165, 139,
+			204, 127, 101, 89, 141, 116, 229, 166, 215, 160, 43, 55, 95, 180,
+			140, 44, 93, 185, 233, 149, 151, 145, 191, 104, 25, 233, 240, 129,
+			134, 7, 153, 46, 245, 69, 92, 24, 95, 138, 126, 129, 165, 248,
+			37, 203, 104, 8, 31, 104, 120, 16, 249, 66, 19, 198, 131, 209,
+			47, 176, 178, 30, 180, 140, 166, 240, 1, 189, 17, 249, 66, 23,
+			198, 151, 163, 95, 96, 181, 124, 217, 50, 156, 240, 129, 134, 7,
+			153, 46, 10, 1, 233, 197, 40, 190, 102, 105, 173, 217, 227, 238,
+			12, 199, 35, 133, 193, 6, 188, 48, 229, 220, 148, 43, 124, 136,
+			139, 28, 94, 11, 193, 70, 183, 64, 233, 102, 54, 47, 191, 245,
+			160, 23, 48, 78, 47, 109, 214, 95, 227, 205, 186, 151, 164, 218,
+			215, 172, 100, 3, 55, 117, 160, 108, 110, 161, 5, 219, 139, 17,
+			61, 100, 105, 34, 59, 170, 228, 170, 148, 7, 197, 106, 20, 111,
+			52, 118, 87, 50, 108, 47, 197, 5, 61, 196, 146, 161, 151, 68,
+			219, 67, 150, 244, 142, 246, 146, 154, 243, 144, 213, 218, 70, 110,
+			164, 94, 136, 182, 71, 44, 109, 52, 123, 101, 232, 205, 82, 240,
+			221, 229, 226, 90, 177, 138, 195, 201, 202, 198, 26, 85, 121, 30,
+			170, 11, 182, 39, 83, 79, 217, 53, 189, 100, 231, 63, 98, 105,
+			221, 220, 132, 107, 203, 146, 174, 173, 94, 218, 160, 30, 177, 70,
+			246, 59, 87, 19, 98, 68, 9, 97, 65, 30, 160, 195, 202, 185,
+			155, 142, 29, 155, 158, 70, 224, 44, 246, 16, 127, 84, 90, 27,
+			91, 72, 29, 70, 251, 244, 210, 185, 209, 215, 45, 45, 195, 77,
+			4, 33, 89, 93, 125, 220, 36, 240, 222, 0, 9, 240, 94, 200,
+			191, 71, 45, 173, 61, 187, 143, 144, 5, 41, 96, 24, 19, 224,
+			83, 21, 60, 78, 170, 171, 80, 64, 212, 61, 26, 206, 24, 68,
+			221, 163, 150, 60, 106, 235, 37, 51, 242, 81, 171, 77, 208, 38,
+			217, 39, 204, 199, 172, 216, 247, 229, 38, 217, 7, 247, 150, 101,
+			247, 145, 131, 165, 15, 220, 116, 30, 154, 215, 209, 223, 68, 75,
+			14, 254, 91, 46, 195, 3, 45, 123, 214, 71, 188, 116, 158, 123,
+			214, 71, 188, 116, 158, 21, 191, 62, 82, 252, 206, 67, 43, 59,
+			66, 232, 227, 194, 248, 54, 246, 232, 253, 238, 252, 197, 226, 1,
+			43, 125, 155, 183, 176, 62, 98, 165, 111, 91, 82, 99, 238, 35,
+			86, 250, 182, 213, 153, 193, 29, 13, 163, 15, 172, 244, 4, 240,
+			28, 2, 43, 201, 26, 226, 190, 50, 206, 249, 244, 151, 47, 67,
+			121, 56, 160, 247, 2, 189, 64, 225, 195, 134, 241, 132, 37, 111,
+			90, 245, 145, 151, 226, 9, 203, 98, 124, 224, 160, 39, 66, 124,
+			8, 55, 186, 8, 124, 56, 197, 217, 138, 15, 155, 232, 147, 33,
+			62, 136, 133, 39, 67, 124, 240, 78, 60, 105, 201, 80, 150, 62,
+			48, 209, 211, 150, 214, 151, 61, 178, 59, 124, 106, 25, 93, 128,
+			21, 124, 245, 116, 136, 21, 124, 245, 180, 101, 101, 185, 137, 43,
+			109, 208, 53, 113, 141, 171, 15, 20, 249, 158, 165, 229, 178, 111,
+			219, 29, 86, 246, 78, 108, 197, 153, 8, 224, 48, 78, 108, 150,
+			223, 179, 172, 12, 55, 113, 135, 205, 234, 238, 33, 94, 118, 133,
+			249, 183, 86, 236, 21, 201, 203, 110, 92, 24, 127, 107, 217, 46,
+			45, 91, 23, 188, 252, 35, 75, 203, 100, 15, 108, 97, 38, 174,
+			203, 43, 125, 222, 82, 221, 91, 46, 150, 74, 116, 50, 32, 251,
+			225, 146, 110, 247, 35, 222, 77, 93, 226, 220, 31, 89, 50, 16,
+			206, 37, 206, 253, 145, 213, 209, 233, 124, 12, 206, 14, 23, 243,
+			255, 2, 176, 253, 123, 164, 91, 217, 146, 114, 47, 186, 104, 118,
+			31, 232, 225, 168, 248, 14, 247, 198, 58, 21, 128, 67, 114, 56,
+			141, 119, 85, 237, 211, 11, 224, 84, 247, 42, 186, 204, 167, 198,
+			17, 55, 168, 107, 170, 105, 162, 41, 79, 249, 92, 90, 40, 47,
+			88, 242, 148, 207, 165, 133, 242, 2, 134, 245, 80, 48, 44, 77,
+			24, 47, 97, 88, 159, 141, 203, 250, 77, 124, 100, 240, 6, 195,
+			226, 35, 117, 100, 246, 167, 33, 168, 10, 65, 107, 142, 250, 191,
+			184, 234, 87, 107, 249, 213, 117, 119, 104, 91, 247, 211, 238, 135,
+			140, 197, 178, 117, 200, 88, 0, 47, 133, 67, 134, 180, 127, 41,
+			28, 50, 230, 234, 165, 112, 200, 88, 171, 47, 97, 200, 255, 49,
+			24, 50, 162, 136, 32, 238, 255, 67, 220, 157, 11, 119, 179, 200,
+			102, 179, 37, 35, 98, 148, 12, 42, 55, 226, 22, 122, 20, 43,
+			142, 59, 115, 252, 162, 198, 21, 65, 183, 101, 116, 186, 65, 61,
+			84, 77, 19, 205, 134, 20, 55, 17, 20, 101, 165, 251, 184, 73,
+			195, 241, 6, 156, 53, 26, 156, 33, 140, 159, 91, 218, 112, 246,
+			230, 208, 131, 187, 211, 148, 94, 84, 111, 149, 171, 113, 75, 95,
+			161, 146, 255, 60, 236, 43, 162, 212, 126, 110, 73, 7, 162, 75,
+			42, 249, 207, 45, 233, 64, 116, 105, 159, 250, 185, 53, 56, 68,
+			158, 184, 126, 97, 190, 102, 197, 254, 247, 91, 229, 215, 232, 71,
+			60, 148, 101, 231, 156, 117, 199, 48, 250, 33, 44, 126, 137, 141,
+			111, 49, 76, 60, 38, 253, 0, 188, 9, 110, 217, 132, 104, 163,
+			163, 139, 60, 91, 110, 195, 187, 131, 197, 181, 229, 74, 126, 162,
+			86, 46, 151, 170, 65, 178, 212, 82, 113, 109, 227, 182, 177, 252,
+			106, 225, 210, 195, 131, 146, 50, 253, 180, 49, 254, 146, 55, 198,
+			126, 18, 47, 191, 228, 141, 177, 159, 196, 203, 47, 177, 49, 226,
+			180, 191, 31, 28, 251, 58, 54, 144, 195, 238, 124, 232, 161, 120,
+			211, 253, 145, 187, 23, 128, 196, 14, 249, 58, 239, 144, 253, 180,
+			240, 95, 231, 29, 178, 159, 22, 254, 235, 216, 65, 32, 87, 61,
+			97, 254, 179, 21, 251, 140, 29, 200, 85, 47, 46, 140, 127, 182,
+			236, 94, 146, 171, 30, 72, 245, 235, 223, 92, 174, 122, 36, 87,
+			127, 205, 44, 225, 209, 192, 127, 205, 114, 213, 163, 129, 255, 26,
+			171, 241, 243, 58, 97, 139, 11, 227, 131, 182, 214, 157, 253, 152,
+			238, 206, 110, 181, 103, 195, 252, 136, 146, 34, 129, 42, 123, 225,
+			53, 60, 162, 26, 149, 31, 201, 151, 86, 202, 148, 203, 208, 205,
+			151, 206, 229, 55, 171, 65, 220, 128, 188, 30, 79, 127, 226, 120,
+			40, 8, 143, 15, 110, 49, 113, 134, 224, 0, 133, 170, 123, 166,
+			18, 106, 115, 134, 97, 185, 189, 5, 101, 170, 209, 195, 229, 34,
+			50, 70, 172, 69, 80, 202, 172, 52, 84, 40, 13, 250, 168, 91,
+			221, 88, 71, 29, 48, 72, 13, 39, 122, 114, 141, 195, 217, 13,
+			202, 113, 65, 130, 150, 95, 203, 115, 56, 62, 64, 14, 203, 218,
+			19, 18, 239, 122, 17, 105, 141, 97, 26, 250, 200, 203, 143, 55,
+			208, 220, 84, 56, 40, 112, 93, 170, 252, 21, 127, 121, 129, 74,
+			205, 249, 85, 121, 46, 131, 114, 211, 48, 41, 233, 102, 22, 10,
+			189, 20, 11, 170, 10, 55, 213, 146, 161, 99, 127, 218, 43, 249,
+			98, 67, 105, 211, 61, 241, 78, 142, 51, 240, 136, 189, 62, 104,
+			75, 246, 242, 136, 189, 62, 104, 55, 118, 112, 83, 199, 191, 93,
+			89, 178, 90, 60, 40, 96, 119, 216, 90, 111, 246, 184, 59, 23,
+			58, 66, 182, 110, 153, 187, 158, 222, 0, 5, 164, 252, 29, 97,
+			7, 176, 102, 238, 176, 27, 187, 184, 169, 163, 217, 147, 35, 175,
+			172, 7, 153, 127, 151, 173, 101, 178, 123, 221, 185, 136, 3, 227,
+			2, 60, 81, 71, 134, 71, 129, 189, 119, 217, 210, 92, 241, 200,
+			145, 113, 151, 109, 51, 231, 66, 7, 187, 203, 238, 232, 116, 158,
+			195, 62, 226, 97, 15, 186, 215, 214, 186, 178, 143, 199, 221, 185,
+			235, 166, 14, 186, 133, 226, 10, 196, 230, 182, 168, 16, 133, 120,
+			214, 191, 77, 58, 222, 135, 85, 74, 26, 46, 56, 85, 46, 49,
+			17, 100, 166, 54, 41, 151, 137, 147, 49, 99, 200, 106, 125, 46,
+			95, 41, 80, 89, 219, 124, 45, 184, 20, 185, 233, 200, 235, 30,
+			24, 130, 123, 218, 63, 231, 87, 20, 20, 202, 198, 115, 171, 95,
+			41, 46, 111, 210, 53, 138, 242, 242, 118, 204, 33, 115, 113, 42,
+			10, 64, 31, 188, 215, 150, 66, 203, 35, 249, 125, 175, 45, 195,
+			15, 60, 146, 223, 247, 218, 157, 25, 231, 23, 8, 38, 241, 160,
+			16, 222, 103, 107, 163, 217, 191, 215, 220, 99, 124, 156, 129, 85,
+			232, 87, 183, 167, 66, 232, 143, 148, 149, 209, 113, 11, 7, 156,
+			92, 101, 46, 246, 11, 74, 131, 144, 75, 69, 38, 27, 66, 152,
+			108, 20, 162, 146, 218, 145, 74, 245, 44, 8, 150, 100, 209, 103,
+			153, 176, 51, 16, 156, 146, 20, 23, 118, 10, 29, 126, 211, 69,
+			70, 121, 88, 33, 8, 21, 146, 96, 89, 213, 220, 146, 143, 192,
+			109, 169, 244, 86, 221, 193, 144, 200, 131, 50, 75, 57, 77, 40,
+			113, 8, 161, 26, 226, 72, 38, 199, 45, 249, 43, 249, 165, 205,
+			133, 234, 217, 252, 193, 96, 181, 7, 137, 79, 176, 0, 55, 214,
+			234, 99, 163, 61, 114, 57, 221, 103, 43, 177, 138, 224, 141, 251,
+			108, 169, 21, 120, 184, 112, 111, 220, 103, 167, 7, 185, 9, 231,
+			143, 61, 178, 159, 118, 218, 1, 97, 126, 222, 142, 125, 203, 126,
+			139, 118, 218, 1, 36, 224, 177, 237, 125, 206, 59, 28, 195, 24,
+			192, 246, 241, 128, 173, 165, 178, 191, 87, 103, 98, 202, 252, 27,
+			23, 30, 125, 169, 68, 156, 252, 42, 59, 175, 89, 245, 96, 10,
+			12, 208, 142, 250, 0, 51, 231, 0, 109, 44, 15, 216, 50, 255,
+			198, 0, 109, 44, 15, 216, 162, 221, 121, 2, 27, 203, 0, 196,
+			195, 195, 182, 214, 151, 125, 68, 71, 250, 11, 74, 223, 63, 26,
+			185, 147, 229, 223, 150, 95, 66, 81, 83, 186, 73, 172, 80, 163,
+			20, 244, 109, 249, 213, 98, 80, 52, 123, 58, 248, 25, 38, 78,
+			12, 210, 50, 176, 59, 91, 122, 191, 16, 96, 124, 101, 48, 190,
+			171, 39, 174, 188, 53, 95, 41, 230, 215, 106, 87, 227, 230, 133,
+			123, 52, 95, 13, 220, 99, 170, 124, 78, 4, 18, 6, 185, 84,
+			94, 67, 222, 169, 224, 106, 217, 205, 242, 219, 234, 205, 170, 82,
+			229, 57, 25, 166, 236, 96, 163, 129, 107, 133, 47, 237, 34, 31,
+			111, 41, 95, 67, 23, 170, 50, 255, 226, 31, 121, 17, 61, 4,
+			233, 117, 86, 243, 75, 170, 49, 62, 62, 254, 199, 195, 178, 78,
+			57, 29, 217, 7, 222, 6, 82, 132, 32, 91, 252, 181, 66, 224,
+			166, 103, 178, 83, 46, 36, 217, 219, 170, 204, 87, 82, 146, 11,
+			1, 159, 58, 50, 67, 134, 210, 136, 57, 131, 55, 170, 45, 161,
+			168, 119, 57, 156, 80, 245, 145, 252, 102, 52, 170, 103, 58, 148,
+			210, 89, 166, 27, 86, 137, 77, 213, 164, 195, 186, 121, 152, 217,
+			126, 128, 206, 31, 31, 182, 165, 207, 115, 128, 118, 161, 135, 109,
+			153, 95, 99, 128, 148, 156, 135, 237, 92, 175, 243, 127, 33, 160,
+			6, 176, 13, 157, 183, 181, 116, 246, 159, 180, 58, 102, 228, 99,
+			80, 10, 46, 9, 134, 187, 139, 3, 80, 119, 42, 146, 67, 41,
+			74, 28, 21, 154, 120, 115, 48, 188, 155, 221, 253, 145, 185, 148,
+			68, 31, 201, 151, 74, 35, 225, 55, 37, 170, 82, 128, 61, 87,
+			118, 166, 142, 38, 59, 145, 4, 36, 166, 45, 35, 122, 73, 105,
+			39, 96, 197, 202, 27, 37, 70, 118, 84, 240, 210, 27, 229, 70,
+			150, 25, 106, 193, 24, 20, 172, 127, 97, 30, 230, 42, 114, 167,
+			214, 202, 117, 221, 12, 166, 10, 219, 195, 249, 112, 185, 98, 65,
+			158, 183, 165, 2, 60, 64, 251, 245, 121, 91, 94, 168, 216, 35,
+			204, 239, 216, 177, 31, 74, 125, 116, 15, 98, 158, 108, 123, 144,
+			28, 189, 123, 244, 152, 48, 159, 176, 181, 239, 219, 129, 163, 119,
+			15, 229, 86, 122, 194, 118, 58, 157, 35, 142, 105, 236, 9, 114,
+			43, 125, 215, 54, 58, 101, 194, 12, 73, 21, 89, 35, 163, 20,
+			108, 17, 161, 170, 1, 53, 41, 112, 182, 210, 167, 9, 250, 54,
+			242, 0, 49, 80, 118, 131, 8, 31, 32, 10, 202, 166, 227, 139,
+			0, 27, 28, 51, 182, 209, 239, 141, 144, 134, 119, 230, 122, 90,
+			222, 112, 218, 85, 101, 93, 89, 105, 104, 193, 61, 26, 116, 37,
+			132, 14, 29, 234, 73, 91, 186, 160, 247, 200, 28, 126, 79, 218,
+			29, 61, 225, 3, 56, 122, 236, 62, 151, 18, 31, 226, 13, 77,
+			24, 79, 217, 70, 183, 60, 224, 147, 199, 245, 161, 68, 98, 245,
+			60, 196, 1, 53, 233, 41, 91, 186, 160, 233, 1, 66, 174, 236,
+			230, 142, 240, 1, 130, 174, 236, 174, 172, 194, 1, 55, 15, 8,
+			216, 183, 69, 45, 139, 230, 188, 56, 54, 53, 23, 226, 128, 199,
+			243, 233, 40, 14, 168, 74, 79, 219, 205, 33, 217, 160, 44, 61,
+			13, 178, 29, 144, 56, 16, 135, 101, 27, 41, 207, 37, 28, 209,
+			100, 30, 245, 248, 66, 36, 208, 69, 158, 137, 206, 13, 180, 145,
+			103, 236, 134, 150, 240, 1, 226, 181, 32, 242, 7, 36, 18, 120,
+			150, 108, 163, 221, 107, 199, 214, 27, 28, 93, 177, 146, 23, 130,
+			33, 255, 147, 109, 36, 195, 7, 240, 64, 217, 78, 115, 248, 0,
+			62, 40, 155, 252, 169, 134, 177, 7, 252, 245, 3, 91, 27, 8,
+			216, 143, 66, 102, 126, 192, 2, 105, 15, 89, 59, 63, 176, 27,
+			50, 220, 68, 142, 36, 187, 171, 151, 155, 200, 145, 100, 247, 123,
+			139, 230, 122, 165, 92, 43, 31, 114, 30, 233, 115, 38, 119, 174,
+			192, 145, 95, 151, 63, 110, 61, 56, 225, 99, 99, 168, 142, 211,
+			119, 194, 192, 211, 236, 129, 221, 125, 137, 220, 85, 193, 119, 217,
+			190, 96, 193, 78, 80, 107, 113, 99, 121, 66, 249, 101, 130, 23,
+			188, 199, 117, 39, 49, 13, 76, 98, 192, 49, 110, 41, 174, 21,
+			50, 113, 55, 62, 212, 60, 217, 50, 14, 104, 227, 244, 215, 245,
+			197, 181, 194, 44, 253, 41, 90, 29, 253, 220, 217, 114, 70, 115,
+			227, 67, 201, 89, 252, 20, 227, 142, 1, 99, 42, 163, 187, 241,
+			161, 134, 201, 236, 184, 148, 16, 140, 112, 124, 158, 17, 206, 210,
+			123, 34, 227, 88, 146, 119, 51, 6, 65, 225, 166, 200, 58, 54,
+			115, 115, 38, 65, 127, 169, 54, 240, 86, 252, 229, 140, 73, 143,
+			245, 138, 191, 140, 39, 181, 252, 74, 198, 10, 158, 212, 242, 43,
+			226, 10, 167, 113, 165, 146, 95, 171, 249, 133, 5, 100, 229, 204,
+			216, 174, 62, 212, 48, 153, 9, 6, 178, 37, 151, 224, 212, 177,
+			83, 179, 13, 242, 109, 36, 20, 197, 199, 21, 255, 214, 242, 45,
+			252, 113, 242, 205, 62, 150, 111, 211, 199, 105, 199, 92, 45, 44,
+			220, 226, 111, 102, 28, 234, 78, 98, 181, 112, 189, 191, 41, 186,
+			28, 123, 181, 176, 64, 145, 137, 153, 6, 250, 195, 90, 45, 80,
+			56, 153, 216, 231, 180, 172, 22, 22, 228, 169, 226, 2, 226, 190,
+			51, 141, 244, 70, 211, 106, 1, 170, 180, 191, 86, 155, 223, 92,
+			247, 197, 94, 167, 121, 181, 176, 16, 137, 121, 204, 52, 241, 107,
+			145, 240, 237, 145, 215, 52, 39, 169, 166, 74, 100, 157, 142, 233,
+			183, 79, 159, 158, 95, 184, 126, 230, 244, 241, 133, 155, 78, 207,
+			221, 56, 125, 108, 230, 196, 204, 244, 241, 214, 152, 232, 112, 196,
+			141, 179, 211, 39, 102, 222, 177, 48, 117, 236, 212, 194, 177, 235,
+			166, 78, 159, 156, 62, 222, 90, 16, 41, 167, 229, 198, 169, 99,
+			215, 79, 157, 156, 94, 56, 54, 59, 61, 53, 63, 125, 188, 245,
+			169, 120, 244, 233, 241, 233, 83, 211, 120, 250, 116, 92, 180, 59,
+			205, 252, 244, 186, 153, 227, 199, 167, 79, 183, 62, 19, 23, 105,
+			167, 149, 31, 222, 116, 90, 62, 254, 30, 61, 158, 57, 61, 55,
+			63, 117, 250, 88, 8, 248, 75, 90, 221, 99, 134, 252, 96, 253,
+			227, 217, 233, 19, 11, 115, 211, 243, 173, 95, 214, 68, 167, 35,
+			234, 30, 223, 116, 26, 127, 124, 69, 19, 89, 39, 173, 254, 152,
+			159, 58, 185, 48, 53, 63, 63, 117, 236, 186, 233, 227, 173, 127,
+			125, 225, 127, 199, 167, 229, 127, 95, 213, 68, 159, 147, 85, 255,
+			221, 48, 61, 63, 117, 124, 106, 126, 42, 252, 248, 107, 59, 188,
+			160, 32, 252, 39, 237, 232, 254, 63, 28, 222, 213, 98, 188, 34,
+			191, 94, 252, 189, 191, 233, 116, 76, 97, 52, 199, 206, 196, 157,
+			239, 24, 78, 188, 81, 232, 205, 49, 49, 249, 55, 134, 123, 172,
+			188, 190, 25, 84, 232, 153, 60, 112, 48, 184, 46, 68, 5, 27,
+			166, 54, 106, 103, 203, 21, 178, 234, 78, 21, 151, 80, 211, 189,
+			16, 81, 162, 166, 214, 225, 162, 229, 127, 70, 93, 86, 87, 38,
+			199, 15, 4, 1, 127, 158, 252, 203, 27, 190, 194, 113, 55, 203,
+			27, 200, 195, 72, 187, 213, 70, 21, 78, 10, 50, 244, 81, 26,
+			225, 182, 37, 127, 29, 182, 11, 25, 149, 165, 98, 232, 230, 169,
+			133, 224, 199, 29, 247, 157, 18, 66, 121, 17, 106, 59, 21, 239,
+			95, 223, 100, 233, 45, 95, 67, 81, 8, 89, 30, 224, 108, 173,
+			182, 126, 249, 196, 196, 185, 115, 231, 198, 243, 212, 211, 128, 58,
+			193, 123, 213, 137, 83, 51, 199, 166, 79, 207, 77, 143, 77, 142,
+			31, 128, 21, 188, 70, 23, 240, 56, 9, 4, 108, 97, 100, 231,
+			44, 46, 209, 229, 236, 82, 254, 28, 98, 76, 243, 43, 21, 63,
+			240, 95, 22, 215, 92, 36, 100, 45, 174, 173, 192, 1, 187, 92,
+			59, 71, 69, 217, 11, 184, 253, 94, 92, 220, 168, 213, 145, 137,
+			123, 86, 172, 214, 189, 16, 28, 86, 123, 83, 115, 238, 204, 156,
+			231, 30, 157, 154, 155, 153, 27, 117, 220, 63, 152, 153, 191, 238,
+			204, 77, 243, 238, 31, 76, 205, 206, 78, 157, 158, 159, 153, 158,
+			115, 207, 204, 186, 170, 46, 195, 156, 123, 230, 132, 59, 117, 250,
+			157, 46, 150, 212, 40, 103, 145, 246, 111, 67, 244, 2, 229, 144,
+			70, 217, 130, 34, 226, 142, 221, 57, 223, 175, 67, 207, 73, 244,
+			165, 233, 177, 228, 150, 242, 107, 43, 27, 216, 187, 87, 144, 95,
+			150, 14, 51, 215, 253, 202, 106, 177, 10, 157, 147, 204, 127, 39,
+			154, 114, 226, 194, 17, 141, 59, 142, 237, 196, 53, 161, 183, 198,
+			154, 240, 203, 22, 186, 136, 93, 235, 36, 29, 205, 110, 80, 63,
+			245, 152, 208, 83, 177, 203, 241, 84, 143, 11, 61, 29, 27, 118,
+			28, 74, 175, 111, 100, 98, 99, 113, 199, 9, 178, 207, 235, 153,
+			68, 179, 211, 32, 147, 207, 235, 93, 90, 206, 105, 228, 220, 243,
+			122, 151, 150, 225, 150, 38, 244, 174, 238, 30, 231, 136, 76, 60,
+			175, 247, 104, 221, 217, 81, 206, 42, 28, 108, 84, 151, 75, 237,
+			71, 221, 30, 37, 211, 66, 37, 157, 108, 228, 164, 240, 122, 143,
+			150, 146, 96, 49, 132, 158, 206, 44, 210, 112, 33, 15, 187, 208,
+			251, 232, 8, 141, 175, 205, 94, 8, 55, 188, 196, 59, 20, 41,
+			22, 162, 160, 163, 103, 125, 90, 27, 183, 52, 161, 247, 165, 51,
+			114, 116, 186, 208, 93, 173, 75, 142, 14, 4, 113, 213, 139, 186,
+			38, 116, 87, 189, 104, 8, 189, 95, 189, 104, 196, 209, 106, 229,
+			150, 38, 244, 126, 245, 98, 66, 232, 158, 122, 49, 17, 71, 75,
+			112, 75, 19, 186, 151, 206, 32, 86, 60, 17, 211, 76, 161, 239,
+			213, 6, 178, 87, 184, 156, 11, 97, 135, 145, 237, 88, 50, 69,
+			13, 208, 140, 3, 20, 99, 49, 53, 161, 239, 237, 243, 100, 119,
+			44, 161, 239, 211, 6, 228, 95, 86, 28, 45, 126, 209, 210, 132,
+			190, 79, 189, 104, 11, 125, 80, 189, 104, 199, 209, 226, 23, 109,
+			77, 232, 131, 234, 197, 164, 208, 135, 212, 139, 201, 56, 90, 60,
+			115, 73, 77, 232, 67, 234, 69, 71, 232, 195, 234, 69, 39, 142,
+			86, 39, 183, 52, 161, 15, 171, 23, 27, 132, 62, 162, 94, 108,
+			136, 163, 197, 47, 54, 104, 66, 31, 81, 47, 54, 10, 125, 191,
+			122, 177, 49, 142, 86, 15, 183, 52, 161, 239, 87, 47, 54, 9,
+			125, 84, 189, 216, 20, 71, 139, 95, 108, 210, 132, 62, 218, 231,
+			57, 31, 215, 40, 231, 189, 241, 182, 216, 153, 120, 246, 195, 154,
+			75, 251, 36, 132, 94, 222, 93, 41, 149, 23, 243, 37, 56, 229,
+			54, 150, 106, 27, 21, 191, 16, 204, 140, 91, 42, 175, 200, 43,
+			79, 100, 183, 147, 137, 68, 113, 143, 71, 139, 43, 191, 143, 124,
+			43, 238, 16, 86, 117, 190, 112, 182, 188, 68, 201, 96, 138, 126,
+			117, 24, 11, 23, 47, 30, 207, 215, 242, 240, 76, 251, 193, 75,
+			213, 179, 229, 115, 148, 228, 2, 162, 203, 95, 116, 111, 154, 145,
+			49, 26, 248, 211, 147, 199, 98, 242, 64, 216, 207, 163, 23, 100,
+			166, 135, 80, 224, 30, 64, 250, 179, 208, 109, 82, 245, 43, 197,
+			124, 169, 248, 30, 191, 32, 7, 147, 71, 34, 64, 89, 116, 32,
+			148, 206, 180, 10, 203, 203, 142, 44, 213, 29, 156, 130, 66, 84,
+			173, 21, 124, 212, 201, 31, 119, 32, 5, 16, 55, 163, 191, 205,
+			110, 2, 65, 17, 19, 35, 244, 203, 180, 52, 72, 136, 134, 137,
+			86, 3, 183, 226, 66, 191, 172, 177, 149, 91, 186, 208, 47, 107,
+			79, 81, 216, 40, 140, 34, 253, 136, 214, 230, 129, 8, 50, 60,
+			69, 5, 112, 115, 72, 74, 36, 75, 254, 213, 158, 35, 161, 196,
+			19, 66, 63, 162, 217, 220, 2, 152, 100, 35, 183, 116, 161, 31,
+			105, 105, 117, 238, 129, 187, 149, 228, 195, 85, 218, 94, 164, 176,
+			159, 245, 243, 37, 23, 138, 172, 172, 183, 88, 8, 99, 248, 130,
+			210, 238, 171, 197, 82, 169, 88, 245, 151, 202, 168, 121, 81, 241,
+			151, 138, 36, 89, 199, 221, 211, 249, 181, 114, 240, 152, 194, 255,
+			28, 55, 191, 200, 153, 92, 2, 199, 27, 77, 127, 213, 245, 87,
+			139, 53, 121, 182, 135, 146, 156, 84, 19, 190, 86, 201, 175, 85,
+			243, 75, 210, 103, 30, 244, 16, 75, 251, 42, 173, 155, 91, 113,
+			161, 95, 213, 227, 114, 75, 23, 250, 85, 3, 123, 232, 94, 24,
+			170, 72, 232, 215, 104, 105, 111, 50, 34, 194, 2, 19, 8, 213,
+			7, 216, 145, 2, 142, 144, 202, 217, 136, 236, 202, 48, 163, 210,
+			19, 66, 191, 70, 17, 74, 143, 11, 253, 154, 36, 79, 133, 14,
+			232, 237, 41, 103, 148, 80, 25, 66, 191, 86, 235, 240, 250, 162,
+			33, 89, 238, 204, 113, 34, 143, 210, 105, 70, 24, 174, 145, 192,
+			235, 12, 23, 242, 238, 218, 100, 27, 183, 116, 161, 95, 155, 74,
+			75, 184, 9, 161, 79, 105, 109, 128, 171, 42, 196, 214, 195, 132,
+			194, 166, 224, 38, 232, 117, 134, 11, 241, 56, 165, 38, 54, 161,
+			11, 125, 170, 165, 149, 146, 174, 145, 120, 60, 170, 181, 121, 35,
+			110, 30, 23, 160, 41, 7, 244, 224, 45, 151, 223, 58, 8, 224,
+			171, 195, 245, 40, 160, 199, 41, 20, 102, 66, 232, 71, 85, 215,
+			33, 27, 143, 42, 20, 166, 46, 244, 163, 45, 173, 148, 14, 34,
+			166, 89, 66, 63, 161, 29, 158, 220, 139, 132, 242, 84, 47, 160,
+			184, 188, 236, 70, 168, 29, 81, 133, 213, 220, 90, 6, 62, 82,
+			45, 83, 232, 39, 26, 60, 110, 197, 133, 126, 98, 96, 130, 91,
+			186, 208, 79, 76, 30, 146, 11, 200, 22, 250, 73, 237, 176, 252,
+			203, 54, 208, 98, 32, 182, 41, 244, 147, 10, 8, 68, 239, 73,
+			5, 196, 214, 133, 126, 114, 242, 16, 5, 43, 198, 180, 164, 208,
+			175, 215, 210, 94, 43, 242, 212, 72, 171, 195, 189, 197, 223, 116,
+			38, 199, 220, 19, 56, 162, 145, 108, 95, 71, 27, 165, 166, 50,
+			239, 168, 161, 36, 19, 0, 199, 132, 130, 36, 191, 62, 217, 194,
+			45, 93, 232, 215, 139, 20, 133, 96, 197, 52, 71, 232, 167, 180,
+			78, 239, 42, 55, 127, 225, 253, 83, 117, 9, 83, 45, 54, 255,
+			54, 28, 60, 249, 133, 186, 251, 171, 85, 198, 234, 36, 0, 140,
+			177, 98, 91, 56, 165, 56, 203, 209, 133, 126, 42, 213, 225, 236,
+			35, 172, 13, 66, 191, 65, 235, 245, 186, 162, 88, 163, 32, 25,
+			98, 67, 2, 47, 50, 68, 236, 31, 55, 36, 51, 220, 210, 133,
+			126, 67, 119, 206, 217, 75, 16, 27, 133, 126, 90, 203, 121, 153,
+			40, 196, 136, 17, 197, 0, 27, 19, 120, 143, 1, 98, 159, 57,
+			157, 236, 228, 150, 46, 244, 211, 217, 30, 101, 184, 127, 37, 227,
+			28, 222, 157, 249, 205, 100, 169, 51, 221, 223, 212, 16, 127, 49,
+			238, 52, 76, 203, 47, 231, 243, 43, 162, 217, 209, 138, 129, 49,
+			158, 156, 213, 138, 133, 58, 235, 88, 219, 98, 29, 71, 108, 106,
+			189, 222, 166, 110, 117, 116, 152, 165, 129, 165, 141, 159, 34, 229,
+			36, 104, 70, 165, 137, 29, 52, 68, 159, 211, 160, 238, 13, 45,
+			110, 74, 59, 219, 225, 71, 71, 55, 197, 21, 145, 23, 106, 213,
+			140, 245, 166, 214, 190, 250, 120, 190, 234, 125, 68, 115, 210, 60,
+			182, 185, 163, 103, 110, 56, 86, 94, 93, 47, 175, 193, 233, 16,
+			29, 85, 124, 231, 81, 105, 245, 163, 18, 142, 1, 190, 32, 159,
+			67, 114, 150, 126, 11, 225, 24, 144, 168, 114, 168, 244, 27, 190,
+			6, 233, 16, 149, 163, 229, 38, 254, 41, 5, 202, 180, 28, 43,
+			55, 1, 103, 125, 163, 82, 146, 142, 5, 250, 45, 58, 28, 179,
+			90, 222, 168, 44, 249, 25, 155, 158, 202, 150, 184, 202, 105, 148,
+			81, 28, 1, 85, 146, 111, 74, 149, 6, 245, 254, 124, 245, 226,
+			108, 200, 207, 183, 7, 54, 228, 165, 111, 104, 67, 30, 249, 157,
+			13, 249, 59, 27, 242, 183, 108, 67, 14, 59, 255, 21, 17, 228,
+			49, 97, 116, 199, 6, 226, 217, 175, 197, 221, 136, 156, 146, 41,
+			156, 96, 141, 161, 212, 244, 42, 21, 190, 113, 7, 89, 6, 46,
+			64, 179, 93, 184, 242, 221, 229, 197, 98, 225, 234, 193, 80, 127,
+			174, 229, 23, 75, 126, 53, 204, 43, 16, 238, 46, 139, 155, 238,
+			244, 59, 110, 60, 51, 59, 191, 48, 63, 117, 114, 110, 97, 254,
+			204, 194, 209, 223, 167, 10, 28, 126, 197, 125, 119, 121, 145, 180,
+			236, 165, 72, 22, 62, 160, 195, 89, 11, 85, 99, 10, 52, 242,
+			252, 90, 190, 180, 89, 45, 86, 161, 4, 3, 127, 68, 209, 237,
+			182, 219, 157, 113, 86, 116, 123, 180, 86, 175, 223, 29, 90, 191,
+			101, 5, 137, 241, 81, 57, 160, 88, 24, 70, 111, 40, 139, 145,
+			140, 112, 227, 189, 34, 150, 192, 7, 188, 87, 64, 105, 238, 73,
+			42, 205, 88, 23, 122, 79, 115, 139, 132, 28, 23, 122, 78, 235,
+			240, 250, 183, 40, 96, 172, 7, 79, 21, 110, 92, 186, 14, 197,
+			126, 24, 50, 116, 224, 156, 130, 12, 19, 57, 167, 54, 202, 184,
+			46, 244, 92, 42, 237, 236, 103, 21, 184, 87, 75, 123, 189, 91,
+			180, 72, 134, 44, 11, 101, 200, 79, 181, 4, 222, 102, 176, 208,
+			208, 123, 149, 198, 8, 125, 180, 183, 61, 37, 119, 75, 157, 204,
+			102, 47, 3, 21, 195, 197, 188, 169, 42, 58, 20, 231, 199, 253,
+			132, 10, 218, 167, 0, 66, 5, 237, 83, 250, 22, 84, 208, 190,
+			150, 86, 103, 144, 85, 80, 87, 107, 247, 178, 174, 204, 46, 181,
+			35, 72, 104, 159, 174, 2, 9, 237, 211, 77, 54, 115, 11, 38,
+			123, 155, 144, 67, 79, 192, 16, 239, 242, 122, 41, 95, 118, 190,
+			80, 64, 200, 81, 109, 116, 7, 171, 2, 202, 103, 191, 2, 11,
+			229, 179, 63, 153, 138, 40, 159, 253, 157, 25, 199, 101, 229, 211,
+			211, 70, 189, 118, 206, 218, 73, 135, 69, 4, 158, 97, 153, 244,
+			10, 235, 248, 208, 50, 189, 158, 65, 110, 233, 66, 247, 70, 246,
+			59, 119, 195, 196, 140, 11, 99, 52, 118, 105, 60, 251, 126, 205,
+			221, 118, 175, 123, 179, 149, 82, 93, 44, 175, 110, 179, 84, 156,
+			93, 173, 21, 236, 170, 219, 44, 22, 28, 118, 201, 248, 117, 21,
+			146, 129, 87, 29, 62, 116, 41, 87, 194, 176, 199, 241, 160, 136,
+			85, 165, 124, 14, 88, 242, 112, 20, 86, 112, 208, 84, 169, 109,
+			146, 131, 144, 118, 236, 48, 155, 106, 177, 224, 147, 137, 25, 70,
+			76, 49, 179, 203, 245, 6, 78, 30, 181, 115, 180, 42, 144, 98,
+			95, 31, 219, 253, 170, 160, 194, 105, 250, 152, 156, 67, 74, 201,
+			175, 143, 201, 85, 17, 199, 153, 156, 62, 38, 87, 5, 18, 242,
+			235, 227, 187, 92, 21, 113, 156, 31, 235, 227, 10, 44, 186, 56,
+			46, 87, 5, 85, 77, 211, 199, 219, 83, 184, 12, 103, 196, 177,
+			216, 38, 52, 225, 117, 187, 222, 74, 25, 46, 59, 111, 212, 245,
+			214, 55, 215, 139, 65, 201, 174, 130, 191, 168, 96, 98, 165, 77,
+			40, 152, 232, 207, 68, 178, 137, 91, 186, 208, 39, 90, 219, 72,
+			211, 141, 99, 217, 29, 208, 132, 215, 37, 187, 22, 0, 166, 173,
+			237, 182, 137, 53, 191, 166, 32, 98, 169, 29, 80, 16, 177, 212,
+			14, 40, 136, 88, 106, 7, 90, 219, 156, 28, 65, 52, 132, 126,
+			144, 44, 133, 0, 226, 173, 7, 198, 39, 47, 25, 63, 160, 0,
+			97, 129, 29, 84, 128, 176, 192, 14, 170, 225, 26, 186, 208, 15,
+			182, 167, 28, 143, 0, 37, 132, 62, 169, 165, 189, 52, 85, 53,
+			146, 74, 65, 113, 217, 189, 101, 173, 124, 110, 141, 161, 97, 93,
+			77, 42, 104, 88, 87, 147, 10, 26, 214, 213, 100, 123, 10, 215,
+			18, 141, 56, 214, 213, 33, 77, 120, 110, 100, 78, 16, 1, 38,
+			89, 80, 113, 19, 3, 134, 41, 119, 72, 1, 198, 34, 59, 164,
+			198, 11, 83, 238, 80, 107, 27, 201, 170, 56, 76, 185, 195, 90,
+			138, 52, 123, 156, 22, 242, 146, 101, 174, 100, 128, 86, 2, 239,
+			49, 64, 184, 195, 14, 75, 147, 39, 78, 246, 218, 97, 209, 78,
+			102, 109, 92, 179, 133, 126, 137, 54, 230, 245, 169, 186, 235, 33,
+			119, 2, 112, 117, 41, 191, 182, 198, 210, 32, 78, 38, 220, 37,
+			82, 26, 196, 201, 132, 187, 164, 103, 136, 76, 184, 56, 153, 112,
+			151, 236, 31, 85, 22, 195, 251, 179, 206, 149, 187, 179, 24, 40,
+			54, 73, 198, 137, 47, 192, 249, 20, 181, 28, 38, 182, 135, 81,
+			94, 93, 45, 175, 77, 44, 254, 155, 137, 245, 197, 137, 32, 167,
+			141, 60, 43, 244, 158, 50, 156, 212, 219, 35, 16, 79, 149, 87,
+			144, 77, 99, 83, 244, 59, 141, 42, 235, 251, 130, 52, 46, 244,
+			217, 6, 245, 108, 166, 128, 87, 130, 196, 194, 144, 72, 139, 155,
+			242, 160, 175, 65, 61, 59, 186, 41, 92, 167, 177, 230, 175, 174,
+			47, 172, 84, 23, 214, 243, 181, 179, 82, 9, 119, 240, 236, 100,
+			245, 198, 124, 237, 172, 56, 224, 164, 130, 40, 73, 191, 176, 192,
+			196, 4, 190, 64, 53, 23, 252, 31, 187, 67, 103, 10, 248, 34,
+			160, 193, 150, 47, 2, 173, 93, 240, 127, 145, 47, 198, 156, 100,
+			117, 99, 49, 240, 211, 144, 10, 175, 31, 109, 249, 233, 55, 246,
+			52, 56, 201, 249, 153, 27, 166, 231, 230, 167, 110, 184, 113, 54,
+			124, 67, 12, 59, 86, 181, 150, 135, 70, 146, 177, 182, 127, 153,
+			255, 23, 251, 29, 155, 47, 27, 101, 236, 237, 223, 85, 47, 136,
+			65, 167, 69, 166, 197, 95, 96, 75, 35, 73, 125, 110, 150, 143,
+			165, 94, 45, 114, 142, 35, 5, 45, 40, 17, 28, 19, 38, 229,
+			147, 153, 2, 142, 10, 113, 8, 78, 131, 150, 71, 133, 212, 158,
+			41, 136, 110, 39, 9, 78, 95, 168, 22, 223, 19, 28, 18, 234,
+			179, 54, 30, 32, 174, 81, 140, 57, 162, 142, 121, 170, 235, 190,
+			95, 160, 51, 66, 125, 182, 45, 250, 207, 28, 254, 128, 217, 83,
+			14, 170, 47, 100, 154, 3, 44, 178, 9, 179, 144, 110, 30, 101,
+			90, 232, 121, 208, 184, 56, 11, 229, 67, 169, 192, 66, 185, 228,
+			141, 44, 148, 201, 201, 223, 89, 40, 191, 179, 80, 126, 203, 22,
+			202, 17, 231, 159, 244, 192, 66, 113, 99, 151, 196, 179, 47, 235,
+			238, 118, 242, 111, 123, 5, 236, 230, 232, 42, 185, 121, 139, 133,
+			34, 149, 174, 138, 191, 84, 174, 20, 182, 214, 247, 160, 104, 206,
+			232, 215, 80, 185, 170, 48, 37, 162, 135, 57, 181, 178, 187, 84,
+			46, 149, 252, 37, 42, 49, 13, 159, 38, 117, 138, 204, 36, 156,
+			148, 86, 47, 159, 152, 88, 170, 44, 110, 172, 140, 47, 149, 87,
+			39, 14, 78, 94, 122, 240, 200, 101, 151, 169, 178, 181, 164, 136,
+			45, 34, 6, 203, 95, 94, 70, 136, 120, 169, 188, 18, 81, 0,
+			131, 40, 218, 226, 218, 216, 170, 191, 138, 18, 104, 139, 27, 184,
+			176, 92, 29, 119, 231, 202, 171, 190, 10, 240, 162, 42, 124, 139,
+			190, 91, 168, 148, 215, 215, 161, 56, 35, 43, 129, 148, 61, 238,
+			82, 37, 8, 216, 93, 244, 151, 113, 218, 80, 172, 185, 203, 165,
+			141, 42, 71, 204, 7, 0, 209, 29, 242, 59, 186, 112, 181, 200,
+			216, 226, 69, 95, 197, 34, 151, 228, 130, 82, 196, 227, 146, 33,
+			84, 219, 217, 157, 186, 113, 134, 194, 235, 156, 112, 192, 165, 242,
+			70, 129, 221, 36, 24, 247, 98, 113, 5, 231, 40, 155, 19, 133,
+			242, 82, 117, 2, 204, 239, 143, 229, 215, 139, 3, 136, 183, 160,
+			240, 8, 196, 74, 72, 9, 91, 13, 205, 57, 215, 238, 161, 210,
+			185, 116, 110, 1, 251, 160, 71, 197, 251, 223, 68, 65, 193, 103,
+			120, 111, 27, 159, 57, 206, 26, 61, 52, 203, 126, 205, 226, 22,
+			172, 3, 155, 173, 131, 152, 180, 14, 148, 37, 231, 105, 89, 175,
+			95, 170, 107, 100, 107, 64, 229, 45, 175, 249, 215, 202, 219, 32,
+			152, 53, 169, 109, 5, 150, 156, 39, 181, 142, 224, 176, 211, 75,
+			242, 89, 10, 148, 75, 47, 211, 37, 207, 3, 52, 161, 15, 104,
+			89, 89, 139, 233, 228, 156, 91, 94, 124, 183, 191, 84, 227, 88,
+			171, 106, 45, 191, 130, 137, 205, 87, 252, 188, 140, 134, 229, 45,
+			144, 81, 65, 231, 28, 80, 168, 160, 115, 14, 40, 84, 80, 51,
+			7, 50, 93, 164, 199, 210, 209, 195, 94, 205, 243, 186, 233, 8,
+			31, 21, 144, 17, 253, 9, 30, 128, 168, 140, 168, 119, 129, 129,
+			183, 87, 193, 132, 214, 185, 55, 153, 227, 22, 192, 184, 253, 206,
+			8, 27, 120, 251, 52, 207, 203, 241, 37, 139, 144, 35, 203, 107,
+			92, 5, 139, 161, 66, 5, 221, 167, 160, 66, 5, 221, 167, 160,
+			66, 5, 221, 231, 246, 75, 179, 49, 33, 244, 33, 109, 202, 203,
+			186, 171, 197, 165, 138, 58, 168, 169, 22, 161, 139, 249, 235, 229,
+			165, 179, 12, 18, 122, 232, 144, 154, 65, 232, 161, 67, 54, 91,
+			204, 208, 67, 135, 82, 76, 138, 132, 45, 244, 161, 142, 107, 157,
+			86, 39, 137, 55, 237, 239, 63, 137, 14, 12, 117, 94, 35, 81,
+			154, 56, 172, 188, 102, 23, 40, 161, 161, 14, 43, 148, 208, 80,
+			135, 237, 22, 110, 233, 66, 31, 22, 237, 220, 178, 133, 62, 156,
+			186, 90, 162, 52, 37, 202, 225, 244, 85, 18, 165, 133, 99, 207,
+			107, 119, 129, 18, 58, 236, 136, 66, 9, 29, 118, 196, 102, 3,
+			30, 58, 236, 72, 59, 115, 173, 101, 11, 125, 36, 125, 141, 68,
+			105, 73, 148, 35, 29, 87, 83, 98, 138, 24, 116, 220, 81, 45,
+			231, 13, 185, 39, 167, 166, 93, 169, 152, 112, 168, 168, 228, 237,
+			195, 7, 39, 15, 141, 229, 23, 151, 10, 254, 178, 98, 103, 59,
+			129, 239, 120, 230, 160, 236, 142, 42, 127, 59, 148, 221, 209, 32,
+			36, 0, 167, 8, 66, 31, 211, 186, 188, 209, 109, 174, 166, 171,
+			34, 210, 28, 101, 44, 5, 15, 35, 73, 70, 236, 188, 224, 112,
+			98, 44, 201, 164, 76, 194, 206, 235, 200, 200, 3, 40, 71, 232,
+			227, 26, 130, 20, 143, 65, 122, 184, 243, 208, 143, 112, 176, 85,
+			94, 142, 134, 206, 50, 92, 39, 98, 232, 5, 199, 15, 227, 202,
+			171, 130, 227, 135, 241, 84, 135, 156, 144, 6, 216, 90, 157, 94,
+			86, 166, 144, 134, 110, 181, 53, 116, 80, 158, 49, 144, 85, 198,
+			19, 130, 243, 135, 3, 138, 237, 26, 96, 149, 165, 58, 40, 197,
+			67, 76, 107, 132, 213, 229, 122, 251, 93, 165, 185, 185, 19, 110,
+			97, 131, 235, 92, 49, 96, 164, 80, 112, 3, 14, 96, 28, 56,
+			146, 56, 168, 38, 29, 71, 18, 7, 237, 44, 183, 96, 176, 229,
+			250, 228, 186, 110, 130, 201, 212, 225, 117, 67, 178, 186, 75, 249,
+			234, 120, 32, 241, 100, 88, 46, 98, 197, 25, 102, 83, 196, 186,
+			138, 105, 77, 100, 93, 49, 35, 53, 193, 186, 106, 79, 59, 123,
+			8, 102, 51, 172, 166, 148, 215, 89, 127, 11, 125, 20, 210, 34,
+			191, 182, 201, 240, 154, 35, 198, 85, 76, 107, 38, 227, 138, 189,
+			54, 205, 48, 174, 218, 218, 23, 205, 245, 74, 185, 86, 62, 244,
+			255, 6, 0, 51, 153, 87, 43, 49, 104, 1, 0},
 	)
 }
 
@@ -155,6 +155,59 @@ func (RegistrationStatus) EnumDescriptor() ([]byte, []int) {
 	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{1}
 }
 
+// DeltaStatus is a status of a delta between two instances.
+type DeltaStatus int32
+
+const (
+	DeltaStatus_DELTA_STATUS_UNSPECIFIED DeltaStatus = 0
+	DeltaStatus_DELTA_PENDING            DeltaStatus = 1 // the delta is being calculated
+	DeltaStatus_DELTA_READY              DeltaStatus = 2 // the delta can be fetched
+	DeltaStatus_DELTA_UNAVAILABLE        DeltaStatus = 3 // there will be no delta, fetch the instance
+)
+
+// Enum value maps for DeltaStatus.
+var (
+	DeltaStatus_name = map[int32]string{
+		0: "DELTA_STATUS_UNSPECIFIED",
+		1: "DELTA_PENDING",
+		2: "DELTA_READY",
+		3: "DELTA_UNAVAILABLE",
+	}
+	DeltaStatus_value = map[string]int32{
+		"DELTA_STATUS_UNSPECIFIED": 0,
+		"DELTA_PENDING":            1,
+		"DELTA_READY":              2,
+		"DELTA_UNAVAILABLE":        3,
+	}
+)
+
+func (x DeltaStatus) Enum() *DeltaStatus {
+	p := new(DeltaStatus)
+	*p = x
+	return p
+}
+
+func (x DeltaStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeltaStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes[2].Descriptor()
+}
+
+func (DeltaStatus) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes[2]
+}
+
+func (x DeltaStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeltaStatus.Descriptor instead.
+func (DeltaStatus) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{2}
+}
+
 type Processor_State int32
 
 const (
@@ -191,11 +244,11 @@ func (x Processor_State) String() string {
 }
 
 func (Processor_State) Descriptor() protoreflect.EnumDescriptor {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes[2].Descriptor()
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes[3].Descriptor()
 }
 
 func (Processor_State) Type() protoreflect.EnumType {
-	return &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes[2]
+	return &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes[3]
 }
 
 func (x Processor_State) Number() protoreflect.EnumNumber {
@@ -204,7 +257,7 @@ func (x Processor_State) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Processor_State.Descriptor instead.
 func (Processor_State) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{28, 0}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{30, 0}
 }
 
 type PrefixRequest struct {
@@ -1955,6 +2008,168 @@ func (x *GetInstanceURLRequest) GetInstance() *ObjectRef {
 	return nil
 }
 
+type GetInstanceDeltaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The package that holds both instances.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// The instance the client already has.
+	Base *ObjectRef `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// The instance the client wants to get.
+	Target        *ObjectRef `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstanceDeltaRequest) Reset() {
+	*x = GetInstanceDeltaRequest{}
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstanceDeltaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstanceDeltaRequest) ProtoMessage() {}
+
+func (x *GetInstanceDeltaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstanceDeltaRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceDeltaRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetInstanceDeltaRequest) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *GetInstanceDeltaRequest) GetBase() *ObjectRef {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *GetInstanceDeltaRequest) GetTarget() *ObjectRef {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type InstanceDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The status of the delta. Other fields are set only for DELTA_READY.
+	Status DeltaStatus `protobuf:"varint,1,opt,name=status,proto3,enum=cipd.DeltaStatus" json:"status,omitempty"`
+	// A signed URL to fetch the delta from.
+	DeltaUrl *ObjectURL `protobuf:"bytes,2,opt,name=delta_url,json=deltaUrl,proto3" json:"delta_url,omitempty"`
+	// A reference to the delta in the CAS.
+	Delta *ObjectRef `protobuf:"bytes,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The size of the delta in bytes.
+	DeltaSize int64 `protobuf:"varint,4,opt,name=delta_size,json=deltaSize,proto3" json:"delta_size,omitempty"`
+	// Files present only in the target instance.
+	AddedFiles []string `protobuf:"bytes,5,rep,name=added_files,json=addedFiles,proto3" json:"added_files,omitempty"`
+	// Files present in both instances, but with different content.
+	ChangedFiles []string `protobuf:"bytes,6,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	// Files present only in the base instance.
+	RemovedFiles  []string `protobuf:"bytes,7,rep,name=removed_files,json=removedFiles,proto3" json:"removed_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstanceDelta) Reset() {
+	*x = InstanceDelta{}
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstanceDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceDelta) ProtoMessage() {}
+
+func (x *InstanceDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceDelta.ProtoReflect.Descriptor instead.
+func (*InstanceDelta) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InstanceDelta) GetStatus() DeltaStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeltaStatus_DELTA_STATUS_UNSPECIFIED
+}
+
+func (x *InstanceDelta) GetDeltaUrl() *ObjectURL {
+	if x != nil {
+		return x.DeltaUrl
+	}
+	return nil
+}
+
+func (x *InstanceDelta) GetDelta() *ObjectRef {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *InstanceDelta) GetDeltaSize() int64 {
+	if x != nil {
+		return x.DeltaSize
+	}
+	return 0
+}
+
+func (x *InstanceDelta) GetAddedFiles() []string {
+	if x != nil {
+		return x.AddedFiles
+	}
+	return nil
+}
+
+func (x *InstanceDelta) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *InstanceDelta) GetRemovedFiles() []string {
+	if x != nil {
+		return x.RemovedFiles
+	}
+	return nil
+}
+
 // Processor describes a state of some post-registration processing step
 // performed on an instance.
 type Processor struct {
@@ -1975,7 +2190,7 @@ type Processor struct {
 
 func (x *Processor) Reset() {
 	*x = Processor{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1987,7 +2202,7 @@ func (x *Processor) String() string {
 func (*Processor) ProtoMessage() {}
 
 func (x *Processor) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[28]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,7 +2215,7 @@ func (x *Processor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Processor.ProtoReflect.Descriptor instead.
 func (*Processor) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{28}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Processor) GetId() string {
@@ -2058,7 +2273,7 @@ type DescribeInstanceRequest struct {
 
 func (x *DescribeInstanceRequest) Reset() {
 	*x = DescribeInstanceRequest{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2070,7 +2285,7 @@ func (x *DescribeInstanceRequest) String() string {
 func (*DescribeInstanceRequest) ProtoMessage() {}
 
 func (x *DescribeInstanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[29]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,7 +2298,7 @@ func (x *DescribeInstanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeInstanceRequest.ProtoReflect.Descriptor instead.
 func (*DescribeInstanceRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{29}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DescribeInstanceRequest) GetPackage() string {
@@ -2157,7 +2372,7 @@ type DescribeInstanceResponse struct {
 
 func (x *DescribeInstanceResponse) Reset() {
 	*x = DescribeInstanceResponse{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2169,7 +2384,7 @@ func (x *DescribeInstanceResponse) String() string {
 func (*DescribeInstanceResponse) ProtoMessage() {}
 
 func (x *DescribeInstanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[30]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2182,7 +2397,7 @@ func (x *DescribeInstanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeInstanceResponse.ProtoReflect.Descriptor instead.
 func (*DescribeInstanceResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{30}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *DescribeInstanceResponse) GetInstance() *Instance {
@@ -2234,7 +2449,7 @@ type DescribeClientRequest struct {
 
 func (x *DescribeClientRequest) Reset() {
 	*x = DescribeClientRequest{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2246,7 +2461,7 @@ func (x *DescribeClientRequest) String() string {
 func (*DescribeClientRequest) ProtoMessage() {}
 
 func (x *DescribeClientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[31]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2259,7 +2474,7 @@ func (x *DescribeClientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeClientRequest.ProtoReflect.Descriptor instead.
 func (*DescribeClientRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{31}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DescribeClientRequest) GetPackage() string {
@@ -2317,7 +2532,7 @@ type DescribeClientResponse struct {
 
 func (x *DescribeClientResponse) Reset() {
 	*x = DescribeClientResponse{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2329,7 +2544,7 @@ func (x *DescribeClientResponse) String() string {
 func (*DescribeClientResponse) ProtoMessage() {}
 
 func (x *DescribeClientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[32]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,7 +2557,7 @@ func (x *DescribeClientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeClientResponse.ProtoReflect.Descriptor instead.
 func (*DescribeClientResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{32}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DescribeClientResponse) GetInstance() *Instance {
@@ -2418,7 +2633,7 @@ type DescribeBootstrapBundleRequest struct {
 
 func (x *DescribeBootstrapBundleRequest) Reset() {
 	*x = DescribeBootstrapBundleRequest{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2430,7 +2645,7 @@ func (x *DescribeBootstrapBundleRequest) String() string {
 func (*DescribeBootstrapBundleRequest) ProtoMessage() {}
 
 func (x *DescribeBootstrapBundleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[33]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2443,7 +2658,7 @@ func (x *DescribeBootstrapBundleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeBootstrapBundleRequest.ProtoReflect.Descriptor instead.
 func (*DescribeBootstrapBundleRequest) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{33}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *DescribeBootstrapBundleRequest) GetPrefix() string {
@@ -2476,7 +2691,7 @@ type DescribeBootstrapBundleResponse struct {
 
 func (x *DescribeBootstrapBundleResponse) Reset() {
 	*x = DescribeBootstrapBundleResponse{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2488,7 +2703,7 @@ func (x *DescribeBootstrapBundleResponse) String() string {
 func (*DescribeBootstrapBundleResponse) ProtoMessage() {}
 
 func (x *DescribeBootstrapBundleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[34]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2501,7 +2716,7 @@ func (x *DescribeBootstrapBundleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeBootstrapBundleResponse.ProtoReflect.Descriptor instead.
 func (*DescribeBootstrapBundleResponse) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{34}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DescribeBootstrapBundleResponse) GetFiles() []*DescribeBootstrapBundleResponse_BootstrapFile {
@@ -2525,7 +2740,7 @@ type PrefixMetadata_ACL struct {
 
 func (x *PrefixMetadata_ACL) Reset() {
 	*x = PrefixMetadata_ACL{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2537,7 +2752,7 @@ func (x *PrefixMetadata_ACL) String() string {
 func (*PrefixMetadata_ACL) ProtoMessage() {}
 
 func (x *PrefixMetadata_ACL) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[35]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2576,7 +2791,7 @@ type RolesInPrefixResponse_RoleInPrefix struct {
 
 func (x *RolesInPrefixResponse_RoleInPrefix) Reset() {
 	*x = RolesInPrefixResponse_RoleInPrefix{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2588,7 +2803,7 @@ func (x *RolesInPrefixResponse_RoleInPrefix) String() string {
 func (*RolesInPrefixResponse_RoleInPrefix) ProtoMessage() {}
 
 func (x *RolesInPrefixResponse_RoleInPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[36]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2625,7 +2840,7 @@ type DescribeBootstrapBundleResponse_BootstrapFile struct {
 
 func (x *DescribeBootstrapBundleResponse_BootstrapFile) Reset() {
 	*x = DescribeBootstrapBundleResponse_BootstrapFile{}
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2637,7 +2852,7 @@ func (x *DescribeBootstrapBundleResponse_BootstrapFile) String() string {
 func (*DescribeBootstrapBundleResponse_BootstrapFile) ProtoMessage() {}
 
 func (x *DescribeBootstrapBundleResponse_BootstrapFile) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[37]
+	mi := &file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2650,7 +2865,7 @@ func (x *DescribeBootstrapBundleResponse_BootstrapFile) ProtoReflect() protorefl
 
 // Deprecated: Use DescribeBootstrapBundleResponse_BootstrapFile.ProtoReflect.Descriptor instead.
 func (*DescribeBootstrapBundleResponse_BootstrapFile) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{34, 0}
+	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *DescribeBootstrapBundleResponse_BootstrapFile) GetPackage() string {
@@ -2929,7 +3144,33 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc = string([]byt
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x08,
 	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
 	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52,
-	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x02, 0x0a, 0x09, 0x50, 0x72,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x99, 0x02,
+	0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12,
+	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x08,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x64, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x09, 0x50, 0x72,
 	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72,
@@ -3041,101 +3282,112 @@ var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc = string([]byt
 	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44,
 	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45,
 	0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
-	0x54, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x03, 0x32, 0xde, 0x0d, 0x0a,
-	0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64,
-	0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x14, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4f, 0x6e, 0x42, 0x65, 0x68,
-	0x61, 0x6c, 0x66, 0x4f, 0x66, 0x12, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4f, 0x6e, 0x42, 0x65, 0x68, 0x61,
-	0x6c, 0x66, 0x4f, 0x66, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
-	0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x48, 0x69, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3d, 0x0a, 0x0d, 0x55, 0x6e, 0x68, 0x69, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d,
-	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a,
-	0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c,
-	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63,
-	0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x12, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x12, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x66, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x69,
-	0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67,
-	0x73, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54,
-	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73,
-	0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x45, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0e, 0x44, 0x65, 0x74, 0x61,
-	0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x45, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x19, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x70,
-	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x54, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x66, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x44,
+	0x45, 0x4c, 0x54, 0x41, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x4c,
+	0x54, 0x41, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x44, 0x45, 0x4c, 0x54, 0x41, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a,
+	0x11, 0x44, 0x45, 0x4c, 0x54, 0x41, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x03, 0x32, 0xa6, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x50, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e,
+	0x68, 0x65, 0x72, 0x69, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x1a, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x13, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x4f, 0x6e, 0x42, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x12, 0x1d, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4f, 0x6e, 0x42, 0x65, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x66, 0x1a, 0x1b, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x48, 0x69,
+	0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x6e, 0x68, 0x69, 0x64,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x69, 0x70,
+	0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x12, 0x09, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x12, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x39, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x15, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x44, 0x65,
+	0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69,
+	0x70, 0x64, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x45, 0x0a, 0x0e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
+	0x1b, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63,
+	0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x46, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
 	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x69, 0x70, 0x64,
 	0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
@@ -3170,163 +3422,173 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescGZIP() []byte
 	return file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_goTypes = []any{
 	(Role)(0),                                             // 0: cipd.Role
 	(RegistrationStatus)(0),                               // 1: cipd.RegistrationStatus
-	(Processor_State)(0),                                  // 2: cipd.Processor.State
-	(*PrefixRequest)(nil),                                 // 3: cipd.PrefixRequest
-	(*PrefixRequestOnBehalfOf)(nil),                       // 4: cipd.PrefixRequestOnBehalfOf
-	(*PrefixMetadata)(nil),                                // 5: cipd.PrefixMetadata
-	(*InheritedPrefixMetadata)(nil),                       // 6: cipd.InheritedPrefixMetadata
-	(*RolesInPrefixResponse)(nil),                         // 7: cipd.RolesInPrefixResponse
-	(*ListPrefixRequest)(nil),                             // 8: cipd.ListPrefixRequest
-	(*ListPrefixResponse)(nil),                            // 9: cipd.ListPrefixResponse
-	(*PackageRequest)(nil),                                // 10: cipd.PackageRequest
-	(*Instance)(nil),                                      // 11: cipd.Instance
-	(*RegisterInstanceResponse)(nil),                      // 12: cipd.RegisterInstanceResponse
-	(*ListInstancesRequest)(nil),                          // 13: cipd.ListInstancesRequest
-	(*ListInstancesResponse)(nil),                         // 14: cipd.ListInstancesResponse
-	(*SearchInstancesRequest)(nil),                        // 15: cipd.SearchInstancesRequest
-	(*SearchInstancesResponse)(nil),                       // 16: cipd.SearchInstancesResponse
-	(*Ref)(nil),                                           // 17: cipd.Ref
-	(*DeleteRefRequest)(nil),                              // 18: cipd.DeleteRefRequest
-	(*ListRefsRequest)(nil),                               // 19: cipd.ListRefsRequest
-	(*ListRefsResponse)(nil),                              // 20: cipd.ListRefsResponse
-	(*Tag)(nil),                                           // 21: cipd.Tag
-	(*AttachTagsRequest)(nil),                             // 22: cipd.AttachTagsRequest
-	(*DetachTagsRequest)(nil),                             // 23: cipd.DetachTagsRequest
-	(*InstanceMetadata)(nil),                              // 24: cipd.InstanceMetadata
-	(*AttachMetadataRequest)(nil),                         // 25: cipd.AttachMetadataRequest
-	(*DetachMetadataRequest)(nil),                         // 26: cipd.DetachMetadataRequest
-	(*ListMetadataRequest)(nil),                           // 27: cipd.ListMetadataRequest
-	(*ListMetadataResponse)(nil),                          // 28: cipd.ListMetadataResponse
-	(*ResolveVersionRequest)(nil),                         // 29: cipd.ResolveVersionRequest
-	(*GetInstanceURLRequest)(nil),                         // 30: cipd.GetInstanceURLRequest
-	(*Processor)(nil),                                     // 31: cipd.Processor
-	(*DescribeInstanceRequest)(nil),                       // 32: cipd.DescribeInstanceRequest
-	(*DescribeInstanceResponse)(nil),                      // 33: cipd.DescribeInstanceResponse
-	(*DescribeClientRequest)(nil),                         // 34: cipd.DescribeClientRequest
-	(*DescribeClientResponse)(nil),                        // 35: cipd.DescribeClientResponse
-	(*DescribeBootstrapBundleRequest)(nil),                // 36: cipd.DescribeBootstrapBundleRequest
-	(*DescribeBootstrapBundleResponse)(nil),               // 37: cipd.DescribeBootstrapBundleResponse
-	(*PrefixMetadata_ACL)(nil),                            // 38: cipd.PrefixMetadata.ACL
-	(*RolesInPrefixResponse_RoleInPrefix)(nil),            // 39: cipd.RolesInPrefixResponse.RoleInPrefix
-	(*DescribeBootstrapBundleResponse_BootstrapFile)(nil), // 40: cipd.DescribeBootstrapBundleResponse.BootstrapFile
-	(*timestamppb.Timestamp)(nil),                         // 41: google.protobuf.Timestamp
-	(*ObjectRef)(nil),                                     // 42: cipd.ObjectRef
-	(*UploadOperation)(nil),                               // 43: cipd.UploadOperation
-	(*structpb.Struct)(nil),                               // 44: google.protobuf.Struct
-	(*ObjectURL)(nil),                                     // 45: cipd.ObjectURL
-	(*status.Status)(nil),                                 // 46: google.rpc.Status
-	(*emptypb.Empty)(nil),                                 // 47: google.protobuf.Empty
+	(DeltaStatus)(0),                                      // 2: cipd.DeltaStatus
+	(Processor_State)(0),                                  // 3: cipd.Processor.State
+	(*PrefixRequest)(nil),                                 // 4: cipd.PrefixRequest
+	(*PrefixRequestOnBehalfOf)(nil),                       // 5: cipd.PrefixRequestOnBehalfOf
+	(*PrefixMetadata)(nil),                                // 6: cipd.PrefixMetadata
+	(*InheritedPrefixMetadata)(nil),                       // 7: cipd.InheritedPrefixMetadata
+	(*RolesInPrefixResponse)(nil),                         // 8: cipd.RolesInPrefixResponse
+	(*ListPrefixRequest)(nil),                             // 9: cipd.ListPrefixRequest
+	(*ListPrefixResponse)(nil),                            // 10: cipd.ListPrefixResponse
+	(*PackageRequest)(nil),                                // 11: cipd.PackageRequest
+	(*Instance)(nil),                                      // 12: cipd.Instance
+	(*RegisterInstanceResponse)(nil),                      // 13: cipd.RegisterInstanceResponse
+	(*ListInstancesRequest)(nil),                          // 14: cipd.ListInstancesRequest
+	(*ListInstancesResponse)(nil),                         // 15: cipd.ListInstancesResponse
+	(*SearchInstancesRequest)(nil),                        // 16: cipd.SearchInstancesRequest
+	(*SearchInstancesResponse)(nil),                       // 17: cipd.SearchInstancesResponse
+	(*Ref)(nil),                                           // 18: cipd.Ref
+	(*DeleteRefRequest)(nil),                              // 19: cipd.DeleteRefRequest
+	(*ListRefsRequest)(nil),                               // 20: cipd.ListRefsRequest
+	(*ListRefsResponse)(nil),                              // 21: cipd.ListRefsResponse
+	(*Tag)(nil),                                           // 22: cipd.Tag
+	(*AttachTagsRequest)(nil),                             // 23: cipd.AttachTagsRequest
+	(*DetachTagsRequest)(nil),                             // 24: cipd.DetachTagsRequest
+	(*InstanceMetadata)(nil),                              // 25: cipd.InstanceMetadata
+	(*AttachMetadataRequest)(nil),                         // 26: cipd.AttachMetadataRequest
+	(*DetachMetadataRequest)(nil),                         // 27: cipd.DetachMetadataRequest
+	(*ListMetadataRequest)(nil),                           // 28: cipd.ListMetadataRequest
+	(*ListMetadataResponse)(nil),                          // 29: cipd.ListMetadataResponse
+	(*ResolveVersionRequest)(nil),                         // 30: cipd.ResolveVersionRequest
+	(*GetInstanceURLRequest)(nil),                         // 31: cipd.GetInstanceURLRequest
+	(*GetInstanceDeltaRequest)(nil),                       // 32: cipd.GetInstanceDeltaRequest
+	(*InstanceDelta)(nil),                                 // 33: cipd.InstanceDelta
+	(*Processor)(nil),                                     // 34: cipd.Processor
+	(*DescribeInstanceRequest)(nil),                       // 35: cipd.DescribeInstanceRequest
+	(*DescribeInstanceResponse)(nil),                      // 36: cipd.DescribeInstanceResponse
+	(*DescribeClientRequest)(nil),                         // 37: cipd.DescribeClientRequest
+	(*DescribeClientResponse)(nil),                        // 38: cipd.DescribeClientResponse
+	(*DescribeBootstrapBundleRequest)(nil),                // 39: cipd.DescribeBootstrapBundleRequest
+	(*DescribeBootstrapBundleResponse)(nil),               // 40: cipd.DescribeBootstrapBundleResponse
+	(*PrefixMetadata_ACL)(nil),                            // 41: cipd.PrefixMetadata.ACL
+	(*RolesInPrefixResponse_RoleInPrefix)(nil),            // 42: cipd.RolesInPrefixResponse.RoleInPrefix
+	(*DescribeBootstrapBundleResponse_BootstrapFile)(nil), // 43: cipd.DescribeBootstrapBundleResponse.BootstrapFile
+	(*timestamppb.Timestamp)(nil),                         // 44: google.protobuf.Timestamp
+	(*ObjectRef)(nil),                                     // 45: cipd.ObjectRef
+	(*UploadOperation)(nil),                               // 46: cipd.UploadOperation
+	(*ObjectURL)(nil),                                     // 47: cipd.ObjectURL
+	(*structpb.Struct)(nil),                               // 48: google.protobuf.Struct
+	(*status.Status)(nil),                                 // 49: google.rpc.Status
+	(*emptypb.Empty)(nil),                                 // 50: google.protobuf.Empty
 }
 var file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_depIdxs = []int32{
-	3,  // 0: cipd.PrefixRequestOnBehalfOf.prefix_request:type_name -> cipd.PrefixRequest
-	41, // 1: cipd.PrefixMetadata.update_time:type_name -> google.protobuf.Timestamp
-	38, // 2: cipd.PrefixMetadata.acls:type_name -> cipd.PrefixMetadata.ACL
-	5,  // 3: cipd.InheritedPrefixMetadata.per_prefix_metadata:type_name -> cipd.PrefixMetadata
-	39, // 4: cipd.RolesInPrefixResponse.roles:type_name -> cipd.RolesInPrefixResponse.RoleInPrefix
-	42, // 5: cipd.Instance.instance:type_name -> cipd.ObjectRef
-	41, // 6: cipd.Instance.registered_ts:type_name -> google.protobuf.Timestamp
+	4,  // 0: cipd.PrefixRequestOnBehalfOf.prefix_request:type_name -> cipd.PrefixRequest
+	44, // 1: cipd.PrefixMetadata.update_time:type_name -> google.protobuf.Timestamp
+	41, // 2: cipd.PrefixMetadata.acls:type_name -> cipd.PrefixMetadata.ACL
+	6,  // 3: cipd.InheritedPrefixMetadata.per_prefix_metadata:type_name -> cipd.PrefixMetadata
+	42, // 4: cipd.RolesInPrefixResponse.roles:type_name -> cipd.RolesInPrefixResponse.RoleInPrefix
+	45, // 5: cipd.Instance.instance:type_name -> cipd.ObjectRef
+	44, // 6: cipd.Instance.registered_ts:type_name -> google.protobuf.Timestamp
 	1,  // 7: cipd.RegisterInstanceResponse.status:type_name -> cipd.RegistrationStatus
-	11, // 8: cipd.RegisterInstanceResponse.instance:type_name -> cipd.Instance
-	43, // 9: cipd.RegisterInstanceResponse.upload_op:type_name -> cipd.UploadOperation
-	11, // 10: cipd.ListInstancesResponse.instances:type_name -> cipd.Instance
-	21, // 11: cipd.SearchInstancesRequest.tags:type_name -> cipd.Tag
-	11, // 12: cipd.SearchInstancesResponse.instances:type_name -> cipd.Instance
-	42, // 13: cipd.Ref.instance:type_name -> cipd.ObjectRef
-	41, // 14: cipd.Ref.modified_ts:type_name -> google.protobuf.Timestamp
-	17, // 15: cipd.ListRefsResponse.refs:type_name -> cipd.Ref
-	41, // 16: cipd.Tag.attached_ts:type_name -> google.protobuf.Timestamp
-	42, // 17: cipd.AttachTagsRequest.instance:type_name -> cipd.ObjectRef
-	21, // 18: cipd.AttachTagsRequest.tags:type_name -> cipd.Tag
-	42, // 19: cipd.DetachTagsRequest.instance:type_name -> cipd.ObjectRef
-	21, // 20: cipd.DetachTagsRequest.tags:type_name -> cipd.Tag
-	41, // 21: cipd.InstanceMetadata.attached_ts:type_name -> google.protobuf.Timestamp
-	42, // 22: cipd.AttachMetadataRequest.instance:type_name -> cipd.ObjectRef
-	24, // 23: cipd.AttachMetadataRequest.metadata:type_name -> cipd.InstanceMetadata
-	42, // 24: cipd.DetachMetadataRequest.instance:type_name -> cipd.ObjectRef
-	24, // 25: cipd.DetachMetadataRequest.metadata:type_name -> cipd.InstanceMetadata
-	42, // 26: cipd.ListMetadataRequest.instance:type_name -> cipd.ObjectRef
-	24, // 27: cipd.ListMetadataResponse.metadata:type_name -> cipd.InstanceMetadata
-	42, // 28: cipd.GetInstanceURLRequest.instance:type_name -> cipd.ObjectRef
-	2,  // 29: cipd.Processor.state:type_name -> cipd.Processor.State
-	41, // 30: cipd.Processor.finished_ts:type_name -> google.protobuf.Timestamp
-	44, // 31: cipd.Processor.result:type_name -> google.protobuf.Struct
-	42, // 32: cipd.DescribeInstanceRequest.instance:type_name -> cipd.ObjectRef
-	11, // 33: cipd.DescribeInstanceResponse.instance:type_name -> cipd.Instance
-	17, // 34: cipd.DescribeInstanceResponse.refs:type_name -> cipd.Ref
-	21, // 35: cipd.DescribeInstanceResponse.tags:type_name -> cipd.Tag
-	31, // 36: cipd.DescribeInstanceResponse.processors:type_name -> cipd.Processor
-	24, // 37: cipd.DescribeInstanceResponse.metadata:type_name -> cipd.InstanceMetadata
-	42, // 38: cipd.DescribeClientRequest.instance:type_name -> cipd.ObjectRef
-	11, // 39: cipd.DescribeClientResponse.instance:type_name -> cipd.Instance
-	42, // 40: cipd.DescribeClientResponse.client_ref:type_name -> cipd.ObjectRef
-	45, // 41: cipd.DescribeClientResponse.client_binary:type_name -> cipd.ObjectURL
-	42, // 42: cipd.DescribeClientResponse.client_ref_aliases:type_name -> cipd.ObjectRef
-	40, // 43: cipd.DescribeBootstrapBundleResponse.files:type_name -> cipd.DescribeBootstrapBundleResponse.BootstrapFile
-	0,  // 44: cipd.PrefixMetadata.ACL.role:type_name -> cipd.Role
-	0,  // 45: cipd.RolesInPrefixResponse.RoleInPrefix.role:type_name -> cipd.Role
-	46, // 46: cipd.DescribeBootstrapBundleResponse.BootstrapFile.status:type_name -> google.rpc.Status
-	42, // 47: cipd.DescribeBootstrapBundleResponse.BootstrapFile.instance:type_name -> cipd.ObjectRef
-	42, // 48: cipd.DescribeBootstrapBundleResponse.BootstrapFile.file:type_name -> cipd.ObjectRef
-	3,  // 49: cipd.Repository.GetPrefixMetadata:input_type -> cipd.PrefixRequest
-	3,  // 50: cipd.Repository.GetInheritedPrefixMetadata:input_type -> cipd.PrefixRequest
-	5,  // 51: cipd.Repository.UpdatePrefixMetadata:input_type -> cipd.PrefixMetadata
-	3,  // 52: cipd.Repository.GetRolesInPrefix:input_type -> cipd.PrefixRequest
-	4,  // 53: cipd.Repository.GetRolesInPrefixOnBehalfOf:input_type -> cipd.PrefixRequestOnBehalfOf
-	8,  // 54: cipd.Repository.ListPrefix:input_type -> cipd.ListPrefixRequest
-	10, // 55: cipd.Repository.HidePackage:input_type -> cipd.PackageRequest
-	10, // 56: cipd.Repository.UnhidePackage:input_type -> cipd.PackageRequest
-	10, // 57: cipd.Repository.DeletePackage:input_type -> cipd.PackageRequest
-	11, // 58: cipd.Repository.RegisterInstance:input_type -> cipd.Instance
-	13, // 59: cipd.Repository.ListInstances:input_type -> cipd.ListInstancesRequest
-	15, // 60: cipd.Repository.SearchInstances:input_type -> cipd.SearchInstancesRequest
-	17, // 61: cipd.Repository.CreateRef:input_type -> cipd.Ref
-	18, // 62: cipd.Repository.DeleteRef:input_type -> cipd.DeleteRefRequest
-	19, // 63: cipd.Repository.ListRefs:input_type -> cipd.ListRefsRequest
-	22, // 64: cipd.Repository.AttachTags:input_type -> cipd.AttachTagsRequest
-	23, // 65: cipd.Repository.DetachTags:input_type -> cipd.DetachTagsRequest
-	25, // 66: cipd.Repository.AttachMetadata:input_type -> cipd.AttachMetadataRequest
-	26, // 67: cipd.Repository.DetachMetadata:input_type -> cipd.DetachMetadataRequest
-	27, // 68: cipd.Repository.ListMetadata:input_type -> cipd.ListMetadataRequest
-	29, // 69: cipd.Repository.ResolveVersion:input_type -> cipd.ResolveVersionRequest
-	30, // 70: cipd.Repository.GetInstanceURL:input_type -> cipd.GetInstanceURLRequest
-	32, // 71: cipd.Repository.DescribeInstance:input_type -> cipd.DescribeInstanceRequest
-	34, // 72: cipd.Repository.DescribeClient:input_type -> cipd.DescribeClientRequest
-	36, // 73: cipd.Repository.DescribeBootstrapBundle:input_type -> cipd.DescribeBootstrapBundleRequest
-	5,  // 74: cipd.Repository.GetPrefixMetadata:output_type -> cipd.PrefixMetadata
-	6,  // 75: cipd.Repository.GetInheritedPrefixMetadata:output_type -> cipd.InheritedPrefixMetadata
-	5,  // 76: cipd.Repository.UpdatePrefixMetadata:output_type -> cipd.PrefixMetadata
-	7,  // 77: cipd.Repository.GetRolesInPrefix:output_type -> cipd.RolesInPrefixResponse
-	7,  // 78: cipd.Repository.GetRolesInPrefixOnBehalfOf:output_type -> cipd.RolesInPrefixResponse
-	9,  // 79: cipd.Repository.ListPrefix:output_type -> cipd.ListPrefixResponse
-	47, // 80: cipd.Repository.HidePackage:output_type -> google.protobuf.Empty
-	47, // 81: cipd.Repository.UnhidePackage:output_type -> google.protobuf.Empty
-	47, // 82: cipd.Repository.DeletePackage:output_type -> google.protobuf.Empty
-	12, // 83: cipd.Repository.RegisterInstance:output_type -> cipd.RegisterInstanceResponse
-	14, // 84: cipd.Repository.ListInstances:output_type -> cipd.ListInstancesResponse
-	16, // 85: cipd.Repository.SearchInstances:output_type -> cipd.SearchInstancesResponse
-	47, // 86: cipd.Repository.CreateRef:output_type -> google.protobuf.Empty
-	47, // 87: cipd.Repository.DeleteRef:output_type -> google.protobuf.Empty
-	20, // 88: cipd.Repository.ListRefs:output_type -> cipd.ListRefsResponse
-	47, // 89: cipd.Repository.AttachTags:output_type -> google.protobuf.Empty
-	47, // 90: cipd.Repository.DetachTags:output_type -> google.protobuf.Empty
-	47, // 91: cipd.Repository.AttachMetadata:output_type -> google.protobuf.Empty
-	47, // 92: cipd.Repository.DetachMetadata:output_type -> google.protobuf.Empty
-	28, // 93: cipd.Repository.ListMetadata:output_type -> cipd.ListMetadataResponse
-	11, // 94: cipd.Repository.ResolveVersion:output_type -> cipd.Instance
-	45, // 95: cipd.Repository.GetInstanceURL:output_type -> cipd.ObjectURL
-	33, // 96: cipd.Repository.DescribeInstance:output_type -> cipd.DescribeInstanceResponse
-	35, // 97: cipd.Repository.DescribeClient:output_type -> cipd.DescribeClientResponse
-	37, // 98: cipd.Repository.DescribeBootstrapBundle:output_type -> cipd.DescribeBootstrapBundleResponse
-	74, // [74:99] is the sub-list for method output_type
-	49, // [49:74] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	12, // 8: cipd.RegisterInstanceResponse.instance:type_name -> cipd.Instance
+	46, // 9: cipd.RegisterInstanceResponse.upload_op:type_name -> cipd.UploadOperation
+	12, // 10: cipd.ListInstancesResponse.instances:type_name -> cipd.Instance
+	22, // 11: cipd.SearchInstancesRequest.tags:type_name -> cipd.Tag
+	12, // 12: cipd.SearchInstancesResponse.instances:type_name -> cipd.Instance
+	45, // 13: cipd.Ref.instance:type_name -> cipd.ObjectRef
+	44, // 14: cipd.Ref.modified_ts:type_name -> google.protobuf.Timestamp
+	18, // 15: cipd.ListRefsResponse.refs:type_name -> cipd.Ref
+	44, // 16: cipd.Tag.attached_ts:type_name -> google.protobuf.Timestamp
+	45, // 17: cipd.AttachTagsRequest.instance:type_name -> cipd.ObjectRef
+	22, // 18: cipd.AttachTagsRequest.tags:type_name -> cipd.Tag
+	45, // 19: cipd.DetachTagsRequest.instance:type_name -> cipd.ObjectRef
+	22, // 20: cipd.DetachTagsRequest.tags:type_name -> cipd.Tag
+	44, // 21: cipd.InstanceMetadata.attached_ts:type_name -> google.protobuf.Timestamp
+	45, // 22: cipd.AttachMetadataRequest.instance:type_name -> cipd.ObjectRef
+	25, // 23: cipd.AttachMetadataRequest.metadata:type_name -> cipd.InstanceMetadata
+	45, // 24: cipd.DetachMetadataRequest.instance:type_name -> cipd.ObjectRef
+	25, // 25: cipd.DetachMetadataRequest.metadata:type_name -> cipd.InstanceMetadata
+	45, // 26: cipd.ListMetadataRequest.instance:type_name -> cipd.ObjectRef
+	25, // 27: cipd.ListMetadataResponse.metadata:type_name -> cipd.InstanceMetadata
+	45, // 28: cipd.GetInstanceURLRequest.instance:type_name -> cipd.ObjectRef
+	45, // 29: cipd.GetInstanceDeltaRequest.base:type_name -> cipd.ObjectRef
+	45, // 30: cipd.GetInstanceDeltaRequest.target:type_name -> cipd.ObjectRef
+	2,  // 31: cipd.InstanceDelta.status:type_name -> cipd.DeltaStatus
+	47, // 32: cipd.InstanceDelta.delta_url:type_name -> cipd.ObjectURL
+	45, // 33: cipd.InstanceDelta.delta:type_name -> cipd.ObjectRef
+	3,  // 34: cipd.Processor.state:type_name -> cipd.Processor.State
+	44, // 35: cipd.Processor.finished_ts:type_name -> google.protobuf.Timestamp
+	48, // 36: cipd.Processor.result:type_name -> google.protobuf.Struct
+	45, // 37: cipd.DescribeInstanceRequest.instance:type_name -> cipd.ObjectRef
+	12, // 38: cipd.DescribeInstanceResponse.instance:type_name -> cipd.Instance
+	18, // 39: cipd.DescribeInstanceResponse.refs:type_name -> cipd.Ref
+	22, // 40: cipd.DescribeInstanceResponse.tags:type_name -> cipd.Tag
+	34, // 41: cipd.DescribeInstanceResponse.processors:type_name -> cipd.Processor
+	25, // 42: cipd.DescribeInstanceResponse.metadata:type_name -> cipd.InstanceMetadata
+	45, // 43: cipd.DescribeClientRequest.instance:type_name -> cipd.ObjectRef
+	12, // 44: cipd.DescribeClientResponse.instance:type_name -> cipd.Instance
+	45, // 45: cipd.DescribeClientResponse.client_ref:type_name -> cipd.ObjectRef
+	47, // 46: cipd.DescribeClientResponse.client_binary:type_name -> cipd.ObjectURL
+	45, // 47: cipd.DescribeClientResponse.client_ref_aliases:type_name -> cipd.ObjectRef
+	43, // 48: cipd.DescribeBootstrapBundleResponse.files:type_name -> cipd.DescribeBootstrapBundleResponse.BootstrapFile
+	0,  // 49: cipd.PrefixMetadata.ACL.role:type_name -> cipd.Role
+	0,  // 50: cipd.RolesInPrefixResponse.RoleInPrefix.role:type_name -> cipd.Role
+	49, // 51: cipd.DescribeBootstrapBundleResponse.BootstrapFile.status:type_name -> google.rpc.Status
+	45, // 52: cipd.DescribeBootstrapBundleResponse.BootstrapFile.instance:type_name -> cipd.ObjectRef
+	45, // 53: cipd.DescribeBootstrapBundleResponse.BootstrapFile.file:type_name -> cipd.ObjectRef
+	4,  // 54: cipd.Repository.GetPrefixMetadata:input_type -> cipd.PrefixRequest
+	4,  // 55: cipd.Repository.GetInheritedPrefixMetadata:input_type -> cipd.PrefixRequest
+	6,  // 56: cipd.Repository.UpdatePrefixMetadata:input_type -> cipd.PrefixMetadata
+	4,  // 57: cipd.Repository.GetRolesInPrefix:input_type -> cipd.PrefixRequest
+	5,  // 58: cipd.Repository.GetRolesInPrefixOnBehalfOf:input_type -> cipd.PrefixRequestOnBehalfOf
+	9,  // 59: cipd.Repository.ListPrefix:input_type -> cipd.ListPrefixRequest
+	11, // 60: cipd.Repository.HidePackage:input_type -> cipd.PackageRequest
+	11, // 61: cipd.Repository.UnhidePackage:input_type -> cipd.PackageRequest
+	11, // 62: cipd.Repository.DeletePackage:input_type -> cipd.PackageRequest
+	12, // 63: cipd.Repository.RegisterInstance:input_type -> cipd.Instance
+	14, // 64: cipd.Repository.ListInstances:input_type -> cipd.ListInstancesRequest
+	16, // 65: cipd.Repository.SearchInstances:input_type -> cipd.SearchInstancesRequest
+	18, // 66: cipd.Repository.CreateRef:input_type -> cipd.Ref
+	19, // 67: cipd.Repository.DeleteRef:input_type -> cipd.DeleteRefRequest
+	20, // 68: cipd.Repository.ListRefs:input_type -> cipd.ListRefsRequest
+	23, // 69: cipd.Repository.AttachTags:input_type -> cipd.AttachTagsRequest
+	24, // 70: cipd.Repository.DetachTags:input_type -> cipd.DetachTagsRequest
+	26, // 71: cipd.Repository.AttachMetadata:input_type -> cipd.AttachMetadataRequest
+	27, // 72: cipd.Repository.DetachMetadata:input_type -> cipd.DetachMetadataRequest
+	28, // 73: cipd.Repository.ListMetadata:input_type -> cipd.ListMetadataRequest
+	30, // 74: cipd.Repository.ResolveVersion:input_type -> cipd.ResolveVersionRequest
+	31, // 75: cipd.Repository.GetInstanceURL:input_type -> cipd.GetInstanceURLRequest
+	32, // 76: cipd.Repository.GetInstanceDelta:input_type -> cipd.GetInstanceDeltaRequest
+	35, // 77: cipd.Repository.DescribeInstance:input_type -> cipd.DescribeInstanceRequest
+	37, // 78: cipd.Repository.DescribeClient:input_type -> cipd.DescribeClientRequest
+	39, // 79: cipd.Repository.DescribeBootstrapBundle:input_type -> cipd.DescribeBootstrapBundleRequest
+	6,  // 80: cipd.Repository.GetPrefixMetadata:output_type -> cipd.PrefixMetadata
+	7,  // 81: cipd.Repository.GetInheritedPrefixMetadata:output_type -> cipd.InheritedPrefixMetadata
+	6,  // 82: cipd.Repository.UpdatePrefixMetadata:output_type -> cipd.PrefixMetadata
+	8,  // 83: cipd.Repository.GetRolesInPrefix:output_type -> cipd.RolesInPrefixResponse
+	8,  // 84: cipd.Repository.GetRolesInPrefixOnBehalfOf:output_type -> cipd.RolesInPrefixResponse
+	10, // 85: cipd.Repository.ListPrefix:output_type -> cipd.ListPrefixResponse
+	50, // 86: cipd.Repository.HidePackage:output_type -> google.protobuf.Empty
+	50, // 87: cipd.Repository.UnhidePackage:output_type -> google.protobuf.Empty
+	50, // 88: cipd.Repository.DeletePackage:output_type -> google.protobuf.Empty
+	13, // 89: cipd.Repository.RegisterInstance:output_type -> cipd.RegisterInstanceResponse
+	15, // 90: cipd.Repository.ListInstances:output_type -> cipd.ListInstancesResponse
+	17, // 91: cipd.Repository.SearchInstances:output_type -> cipd.SearchInstancesResponse
+	50, // 92: cipd.Repository.CreateRef:output_type -> google.protobuf.Empty
+	50, // 93: cipd.Repository.DeleteRef:output_type -> google.protobuf.Empty
+	21, // 94: cipd.Repository.ListRefs:output_type -> cipd.ListRefsResponse
+	50, // 95: cipd.Repository.AttachTags:output_type -> google.protobuf.Empty
+	50, // 96: cipd.Repository.DetachTags:output_type -> google.protobuf.Empty
+	50, // 97: cipd.Repository.AttachMetadata:output_type -> google.protobuf.Empty
+	50, // 98: cipd.Repository.DetachMetadata:output_type -> google.protobuf.Empty
+	29, // 99: cipd.Repository.ListMetadata:output_type -> cipd.ListMetadataResponse
+	12, // 100: cipd.Repository.ResolveVersion:output_type -> cipd.Instance
+	47, // 101: cipd.Repository.GetInstanceURL:output_type -> cipd.ObjectURL
+	33, // 102: cipd.Repository.GetInstanceDelta:output_type -> cipd.InstanceDelta
+	36, // 103: cipd.Repository.DescribeInstance:output_type -> cipd.DescribeInstanceResponse
+	38, // 104: cipd.Repository.DescribeClient:output_type -> cipd.DescribeClientResponse
+	40, // 105: cipd.Repository.DescribeBootstrapBundle:output_type -> cipd.DescribeBootstrapBundleResponse
+	80, // [80:106] is the sub-list for method output_type
+	54, // [54:80] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() }
@@ -3340,8 +3602,8 @@ func file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc), len(file_go_chromium_org_luci_cipd_api_cipd_v1_repo_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   38,
+			NumEnums:      4,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
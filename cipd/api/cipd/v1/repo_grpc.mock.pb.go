@@ -256,6 +256,26 @@ func (mr *MockRepositoryClientMockRecorder) GetInheritedPrefixMetadata(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInheritedPrefixMetadata", reflect.TypeOf((*MockRepositoryClient)(nil).GetInheritedPrefixMetadata), varargs...)
 }
 
+// GetInstanceDelta mocks base method.
+func (m *MockRepositoryClient) GetInstanceDelta(ctx context.Context, in *GetInstanceDeltaRequest, opts ...grpc.CallOption) (*InstanceDelta, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInstanceDelta", varargs...)
+	ret0, _ := ret[0].(*InstanceDelta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceDelta indicates an expected call of GetInstanceDelta.
+func (mr *MockRepositoryClientMockRecorder) GetInstanceDelta(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceDelta", reflect.TypeOf((*MockRepositoryClient)(nil).GetInstanceDelta), varargs...)
+}
+
 // GetInstanceURL mocks base method.
 func (m *MockRepositoryClient) GetInstanceURL(ctx context.Context, in *GetInstanceURLRequest, opts ...grpc.CallOption) (*ObjectURL, error) {
 	m.ctrl.T.Helper()
@@ -724,6 +744,21 @@ func (mr *MockRepositoryServerMockRecorder) GetInheritedPrefixMetadata(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInheritedPrefixMetadata", reflect.TypeOf((*MockRepositoryServer)(nil).GetInheritedPrefixMetadata), arg0, arg1)
 }
 
+// GetInstanceDelta mocks base method.
+func (m *MockRepositoryServer) GetInstanceDelta(arg0 context.Context, arg1 *GetInstanceDeltaRequest) (*InstanceDelta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceDelta", arg0, arg1)
+	ret0, _ := ret[0].(*InstanceDelta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceDelta indicates an expected call of GetInstanceDelta.
+func (mr *MockRepositoryServerMockRecorder) GetInstanceDelta(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceDelta", reflect.TypeOf((*MockRepositoryServer)(nil).GetInstanceDelta), arg0, arg1)
+}
+
 // GetInstanceURL mocks base method.
 func (m *MockRepositoryServer) GetInstanceURL(arg0 context.Context, arg1 *GetInstanceURLRequest) (*ObjectURL, error) {
 	m.ctrl.T.Helper()
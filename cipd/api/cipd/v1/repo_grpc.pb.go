@@ -56,6 +56,7 @@ const (
 	Repository_ListMetadata_FullMethodName               = "/cipd.Repository/ListMetadata"
 	Repository_ResolveVersion_FullMethodName             = "/cipd.Repository/ResolveVersion"
 	Repository_GetInstanceURL_FullMethodName             = "/cipd.Repository/GetInstanceURL"
+	Repository_GetInstanceDelta_FullMethodName           = "/cipd.Repository/GetInstanceDelta"
 	Repository_DescribeInstance_FullMethodName           = "/cipd.Repository/DescribeInstance"
 	Repository_DescribeClient_FullMethodName             = "/cipd.Repository/DescribeClient"
 	Repository_DescribeBootstrapBundle_FullMethodName    = "/cipd.Repository/DescribeBootstrapBundle"
@@ -422,6 +423,28 @@ type RepositoryClient interface {
 	//	INVALID_ARGUMENT if the request is malformed.
 	//	NOT_FOUND if there's no such instance.
 	GetInstanceURL(ctx context.Context, in *GetInstanceURLRequest, opts ...grpc.CallOption) (*ObjectURL, error)
+	// Returns a delta between two instances of a package.
+	//
+	// The delta can be used to assemble the target instance file given the base
+	// instance file, which is useful to clients that already have the base
+	// instance cached. Deltas are calculated asynchronously: the first call
+	// returns DELTA_PENDING and schedules the calculation, subsequent calls
+	// return DELTA_READY with a URL to fetch the delta from, or
+	// DELTA_UNAVAILABLE if it makes no sense to use the delta (e.g. it is not
+	// much smaller than the target instance itself). Clients must fall back to
+	// fetching the target instance fully if the delta is not ready.
+	//
+	// Deltas are calculated only if the base instance was registered before the
+	// target instance and only for a limited number of base instances per target
+	// instance. Otherwise returns DELTA_UNAVAILABLE right away.
+	//
+	// See go.chromium.org/luci/cipd/client/cipd/delta for the delta format.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a READER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if either instance doesn't exist.
+	GetInstanceDelta(ctx context.Context, in *GetInstanceDeltaRequest, opts ...grpc.CallOption) (*InstanceDelta, error)
 	// Returns information about a package instance.
 	//
 	// Depending on fields set in the request, returns details such as when the
@@ -710,6 +733,16 @@ func (c *repositoryClient) GetInstanceURL(ctx context.Context, in *GetInstanceUR
 	return out, nil
 }
 
+func (c *repositoryClient) GetInstanceDelta(ctx context.Context, in *GetInstanceDeltaRequest, opts ...grpc.CallOption) (*InstanceDelta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceDelta)
+	err := c.cc.Invoke(ctx, Repository_GetInstanceDelta_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryClient) DescribeInstance(ctx context.Context, in *DescribeInstanceRequest, opts ...grpc.CallOption) (*DescribeInstanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DescribeInstanceResponse)
@@ -1101,6 +1134,28 @@ type RepositoryServer interface {
 	//	INVALID_ARGUMENT if the request is malformed.
 	//	NOT_FOUND if there's no such instance.
 	GetInstanceURL(context.Context, *GetInstanceURLRequest) (*ObjectURL, error)
+	// Returns a delta between two instances of a package.
+	//
+	// The delta can be used to assemble the target instance file given the base
+	// instance file, which is useful to clients that already have the base
+	// instance cached. Deltas are calculated asynchronously: the first call
+	// returns DELTA_PENDING and schedules the calculation, subsequent calls
+	// return DELTA_READY with a URL to fetch the delta from, or
+	// DELTA_UNAVAILABLE if it makes no sense to use the delta (e.g. it is not
+	// much smaller than the target instance itself). Clients must fall back to
+	// fetching the target instance fully if the delta is not ready.
+	//
+	// Deltas are calculated only if the base instance was registered before the
+	// target instance and only for a limited number of base instances per target
+	// instance. Otherwise returns DELTA_UNAVAILABLE right away.
+	//
+	// See go.chromium.org/luci/cipd/client/cipd/delta for the delta format.
+	//
+	// Returns:
+	//   PERMISSION_DENIED if the caller is not a READER for the prefix.
+	//   INVALID_ARGUMENT if the request is malformed.
+	//   NOT_FOUND if either instance doesn't exist.
+	GetInstanceDelta(context.Context, *GetInstanceDeltaRequest) (*InstanceDelta, error)
 	// Returns information about a package instance.
 	//
 	// Depending on fields set in the request, returns details such as when the
@@ -1235,6 +1290,9 @@ func (UnimplementedRepositoryServer) ResolveVersion(context.Context, *ResolveVer
 func (UnimplementedRepositoryServer) GetInstanceURL(context.Context, *GetInstanceURLRequest) (*ObjectURL, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceURL not implemented")
 }
+func (UnimplementedRepositoryServer) GetInstanceDelta(context.Context, *GetInstanceDeltaRequest) (*InstanceDelta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceDelta not implemented")
+}
 func (UnimplementedRepositoryServer) DescribeInstance(context.Context, *DescribeInstanceRequest) (*DescribeInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeInstance not implemented")
 }
@@ -1661,6 +1719,24 @@ func _Repository_GetInstanceURL_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repository_GetInstanceDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).GetInstanceDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Repository_GetInstanceDelta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).GetInstanceDelta(ctx, req.(*GetInstanceDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Repository_DescribeInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeInstanceRequest)
 	if err := dec(in); err != nil {
@@ -1810,6 +1886,10 @@ var Repository_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInstanceURL",
 			Handler:    _Repository_GetInstanceURL_Handler,
 		},
+		{
+			MethodName: "GetInstanceDelta",
+			Handler:    _Repository_GetInstanceDelta_Handler,
+		},
 		{
 			MethodName: "DescribeInstance",
 			Handler:    _Repository_DescribeInstance_Handler,
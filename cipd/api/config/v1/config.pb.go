@@ -135,6 +135,151 @@ func (x *BootstrapConfig) GetPrefix() string {
 	return ""
 }
 
+// A schema for the retention.cfg config file.
+//
+// It defines when old package instances are deleted.
+type RetentionConfigFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A list of retention policies, will be scanned in order.
+	//
+	// A package is governed by the first policy with a matching prefix.
+	Policy        []*RetentionPolicy `protobuf:"bytes,1,rep,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionConfigFile) Reset() {
+	*x = RetentionConfigFile{}
+	mi := &file_go_chromium_org_luci_cipd_api_config_v1_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionConfigFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionConfigFile) ProtoMessage() {}
+
+func (x *RetentionConfigFile) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_config_v1_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionConfigFile.ProtoReflect.Descriptor instead.
+func (*RetentionConfigFile) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetentionConfigFile) GetPolicy() []*RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// RetentionPolicy defines what instances of packages under a prefix to keep.
+//
+// An instance is deleted (along with its tags, metadata and the package file)
+// only if ALL of the following is true:
+//   - It is not among the `keep_last` most recently registered instances.
+//   - It was registered more than `keep_days` days ago.
+//   - No ref points to it.
+//   - It has no tags with keys listed in `protected_tag_keys`.
+//
+// At least one of `keep_last` or `keep_days` must be set.
+type RetentionPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The package prefix of matching packages e.g. "infra/ci/builds".
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// How many most recently registered instances of a package to always keep.
+	KeepLast int32 `protobuf:"varint,2,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	// Instances younger than this number of days are always kept.
+	KeepDays int32 `protobuf:"varint,3,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"`
+	// Instances with a tag with any of these keys are always kept, e.g.
+	// "release" to keep all instances tagged with "release:<something>".
+	ProtectedTagKeys []string `protobuf:"bytes,4,rep,name=protected_tag_keys,json=protectedTagKeys,proto3" json:"protected_tag_keys,omitempty"`
+	// If true, only report what would be deleted without deleting anything.
+	//
+	// The report is a single log line per package (starting with "Retention dry
+	// run") in logs of "apply-retention" tasks of the "retention" task queue.
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_go_chromium_org_luci_cipd_api_config_v1_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_api_config_v1_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RetentionPolicy) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetKeepLast() int32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetKeepDays() int32 {
+	if x != nil {
+		return x.KeepDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetProtectedTagKeys() []string {
+	if x != nil {
+		return x.ProtectedTagKeys
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
 var File_go_chromium_org_luci_cipd_api_config_v1_config_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDesc = string([]byte{
@@ -150,11 +295,26 @@ var file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDesc = string([
 	0x69, 0x67, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x22, 0x29, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x2d,
-	0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x4b,
+	0x0a, 0x13, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xaa, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70,
+	0x4c, 0x61, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69,
+	0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -169,18 +329,21 @@ func file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDescGZIP() []b
 	return file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cipd_api_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_go_chromium_org_luci_cipd_api_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_go_chromium_org_luci_cipd_api_config_v1_config_proto_goTypes = []any{
 	(*BootstrapConfigFile)(nil), // 0: cipd.config.BootstrapConfigFile
 	(*BootstrapConfig)(nil),     // 1: cipd.config.BootstrapConfig
+	(*RetentionConfigFile)(nil), // 2: cipd.config.RetentionConfigFile
+	(*RetentionPolicy)(nil),     // 3: cipd.config.RetentionPolicy
 }
 var file_go_chromium_org_luci_cipd_api_config_v1_config_proto_depIdxs = []int32{
 	1, // 0: cipd.config.BootstrapConfigFile.bootstrap_config:type_name -> cipd.config.BootstrapConfig
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: cipd.config.RetentionConfigFile.policy:type_name -> cipd.config.RetentionPolicy
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_api_config_v1_config_proto_init() }
@@ -194,7 +357,7 @@ func file_go_chromium_org_luci_cipd_api_config_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDesc), len(file_go_chromium_org_luci_cipd_api_config_v1_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,6 +16,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
 	"go.chromium.org/luci/auth/identity"
@@ -28,6 +29,7 @@ import (
 
 	"go.chromium.org/luci/cipd/appengine/impl"
 	"go.chromium.org/luci/cipd/appengine/impl/bootstrap"
+	"go.chromium.org/luci/cipd/appengine/impl/retention"
 
 	// Using transactional datastore TQ tasks.
 	_ "go.chromium.org/luci/server/tq/txn/datastore"
@@ -44,7 +46,15 @@ func main() {
 		})
 
 		// Periodically refresh the global service config in the datastore.
-		cron.RegisterHandler("import-config", bootstrap.ImportConfig)
+		cron.RegisterHandler("import-config", func(ctx context.Context) error {
+			if err := bootstrap.ImportConfig(ctx); err != nil {
+				return err
+			}
+			return retention.ImportConfig(ctx)
+		})
+
+		// Periodically delete old instances according to retention.cfg.
+		cron.RegisterHandler("apply-retention", svc.Retention.CronHandler)
 
 		// PubSub push handler processing messages produced by events.go.
 		oidcMW := router.NewMiddlewareChain(
@@ -0,0 +1,130 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/dsmapper"
+
+	api "go.chromium.org/luci/cipd/api/admin/v1"
+	cipdapi "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/repo/processing"
+)
+
+func init() {
+	initMapper(mapperDef{
+		Kind: api.MapperKind_EXPORT_SBOMS_TO_BQ,
+		Func: exportSBOMsToBQ,
+		Config: dsmapper.JobConfig{
+			Query:         dsmapper.Query{Kind: "ProcessingResult"},
+			ShardCount:    256,
+			PageSize:      256, // note: 500 is a strict limit imposed by GetMulti
+			TrackProgress: true,
+		},
+	})
+}
+
+func exportSBOMsToBQ(ctx context.Context, job dsmapper.JobID, _ *api.JobConfig, keys []*datastore.Key) error {
+	rows, err := sbomRows(ctx, job, keys)
+	if err != nil {
+		return err
+	}
+	return uploadToBQ(ctx, job, "exported_sboms", rows)
+}
+
+// sbomRows converts successful SBOM extractor results into BigQuery rows.
+//
+// Skips results of other processors.
+func sbomRows(ctx context.Context, job dsmapper.JobID, keys []*datastore.Key) ([]bigquery.ValueSaver, error) {
+	var results []*model.ProcessingResult
+	for _, key := range keys {
+		if key.StringID() != processing.SBOMExtractorProcID {
+			continue
+		}
+		// This check should never be hit, but just in case...
+		if key.Parent() == nil || key.Parent().Parent() == nil {
+			logging.Errorf(ctx, "Skipping orphaned processing result: %s", key.Encode())
+			continue
+		}
+		results = append(results, &model.ProcessingResult{
+			ProcID:   key.StringID(),
+			Instance: key.Parent(),
+		})
+	}
+	if len(results) == 0 {
+		return nil, nil
+	}
+
+	if err := datastore.Get(ctx, results); err != nil {
+		merr, ok := err.(errors.MultiError)
+		if !ok {
+			return nil, errors.Annotate(err, "GetMulti RPC error when fetching %d results", len(results)).Tag(transient.Tag).Err()
+		}
+		present := results[:0]
+		for i, err := range merr {
+			switch {
+			case err == nil:
+				present = append(present, results[i])
+			case err != datastore.ErrNoSuchEntity:
+				return nil, errors.Annotate(err, "failed to fetch the processing result").Tag(transient.Tag).Err()
+			}
+		}
+		results = present
+	}
+
+	var rows []bigquery.ValueSaver
+	for _, r := range results {
+		if !r.Success {
+			continue
+		}
+		res := &processing.SBOMExtractorResult{}
+		if err := r.ReadResult(res); err != nil {
+			logging.Errorf(ctx, "Skipping broken SBOM result %s: %s", datastore.KeyForObj(ctx, r).Encode(), err)
+			continue
+		}
+		for i, c := range res.Components {
+			license := c.LicenseExpression
+			if license == "" {
+				license = c.License
+			}
+			rows = append(rows, &bq.Row{
+				InsertID: bqInsertID(fmt.Sprintf("export_sboms:%d", i), job, datastore.KeyForObj(ctx, r)),
+				Message: &cipdapi.ExportedSBOMComponent{
+					Instance:    r.Instance.StringID(),
+					Package:     r.Instance.Parent().StringID(),
+					Type:        c.Type,
+					Name:        c.Name,
+					Version:     c.Version,
+					License:     license,
+					Purl:        c.PURL(),
+					Source:      c.Source,
+					ProcessedTs: timestamppb.New(r.CreatedTs),
+				},
+			})
+		}
+	}
+	return rows, nil
+}
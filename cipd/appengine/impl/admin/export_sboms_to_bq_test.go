@@ -0,0 +1,91 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/bq"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	cipdapi "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/repo/processing"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+)
+
+func TestSBOMRows(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		ctx, _, _ := SetupTest()
+
+		inst := &model.Instance{
+			InstanceID: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Package:    model.PackageKey(ctx, "a/b"),
+		}
+		instKey := datastore.KeyForObj(ctx, inst)
+
+		sbom := &model.ProcessingResult{
+			ProcID:    processing.SBOMExtractorProcID,
+			Instance:  instKey,
+			CreatedTs: testutil.TestTime,
+			Success:   true,
+		}
+		assert.Loosely(t, sbom.WriteResult(processing.SBOMExtractorResult{
+			Components: []processing.SBOMComponent{
+				{Type: "golang", Name: "golang.org/x/net", Version: "v0.25.0", Source: "go.mod"},
+				{Type: "pypi", Name: "six", Version: "1.16.0", License: "MIT License", Source: "METADATA"},
+			},
+		}), should.BeNil)
+		other := &model.ProcessingResult{
+			ProcID:    processing.CompressionInspectorProcID,
+			Instance:  instKey,
+			CreatedTs: testutil.TestTime,
+			Success:   true,
+		}
+		assert.Loosely(t, datastore.Put(ctx, sbom, other), should.BeNil)
+
+		rows, err := sbomRows(ctx, 123, []*datastore.Key{
+			datastore.KeyForObj(ctx, sbom),
+			datastore.KeyForObj(ctx, other),
+			datastore.NewKey(ctx, "ProcessingResult", processing.SBOMExtractorProcID, 0,
+				datastore.NewKey(ctx, "PackageInstance", "missing", 0, model.PackageKey(ctx, "a/b"))),
+		})
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, rows, should.HaveLength(2))
+
+		msg := func(i int) *cipdapi.ExportedSBOMComponent {
+			return rows[i].(*bq.Row).Message.(*cipdapi.ExportedSBOMComponent)
+		}
+		assert.Loosely(t, msg(0), should.Match(&cipdapi.ExportedSBOMComponent{
+			Instance:    inst.InstanceID,
+			Package:     "a/b",
+			Type:        "golang",
+			Name:        "golang.org/x/net",
+			Version:     "v0.25.0",
+			Purl:        "pkg:golang/golang.org/x/net@v0.25.0",
+			Source:      "go.mod",
+			ProcessedTs: timestamppb.New(testutil.TestTime),
+		}))
+		assert.Loosely(t, msg(1).License, should.Equal("MIT License"))
+		assert.Loosely(t, rows[0].(*bq.Row).InsertID, should.NotEqual(rows[1].(*bq.Row).InsertID))
+	})
+}
@@ -81,7 +81,7 @@ func exportTagsToBQ(ctx context.Context, job dsmapper.JobID, _ *api.JobConfig, k
 	if err != nil {
 		return transient.Tag.Apply(err)
 	}
-	return uploadToBQ(ctx, job, rows)
+	return uploadToBQ(ctx, job, "exported_tags", rows)
 }
 
 // bqInsertID returns a hash of its inputs.
@@ -91,12 +91,13 @@ func bqInsertID(seed string, job dsmapper.JobID, key *datastore.Key) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-// uploadToBQ makes insertAll RPC to push rows to BigQuery.
-func uploadToBQ(ctx context.Context, job dsmapper.JobID, rows []bigquery.ValueSaver) error {
+// uploadToBQ makes insertAll RPC to push rows to the BigQuery table
+// "<table>_<job>".
+func uploadToBQ(ctx context.Context, job dsmapper.JobID, table string, rows []bigquery.ValueSaver) error {
 	if len(rows) == 0 {
 		return nil
 	}
-	logging.Infof(ctx, "Uploading %d rows to exported_tags_%d", len(rows), job)
+	logging.Infof(ctx, "Uploading %d rows to %s_%d", len(rows), table, job)
 
 	// Note: auth.GetTokenSource doesn't work with BQ on GAE, since it starts to
 	// demand "real" Appengine context. http.Client is OK though.
@@ -115,7 +116,7 @@ func uploadToBQ(ctx context.Context, job dsmapper.JobID, rows []bigquery.ValueSa
 	defer client.Close()
 
 	// Each mapper job will get its own table.
-	ins := client.Dataset("cipd").Table("exported_tags").Inserter()
+	ins := client.Dataset("cipd").Table(table).Inserter()
 	ins.TableTemplateSuffix = fmt.Sprintf("_%d", job)
 	err = ins.Put(ctx, rows)
 
@@ -68,6 +68,12 @@ type StorageServer interface {
 	// Returns grpc errors. In particular NotFound is returned if there's no such
 	// object in the storage.
 	GetReader(ctx context.Context, ref *api.ObjectRef) (gs.Reader, error)
+
+	// DeleteObject deletes an object from the storage.
+	//
+	// The caller is responsible for making sure nothing refers to the object
+	// anymore. Deleting a missing object is not an error. Returns grpc errors.
+	DeleteObject(ctx context.Context, ref *api.ObjectRef) error
 }
 
 // Internal returns non-ACLed implementation of StorageService.
@@ -156,6 +162,19 @@ func (s *storageImpl) GetReader(ctx context.Context, ref *api.ObjectRef) (r gs.R
 	return r, nil
 }
 
+// DeleteObject is part of StorageServer interface.
+func (s *storageImpl) DeleteObject(ctx context.Context, ref *api.ObjectRef) (err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err = common.ValidateObjectRef(ref, common.KnownHash); err != nil {
+		return errors.Annotate(err, "bad ref").Err()
+	}
+	if err = s.getGS(ctx).Delete(ctx, s.settings.ObjectPath(ref)); err != nil {
+		return errors.Annotate(err, "can't delete the object").Err()
+	}
+	return nil
+}
+
 // GetObjectURL implements the corresponding RPC method, see the proto doc.
 func (s *storageImpl) GetObjectURL(ctx context.Context, r *api.GetObjectURLRequest) (resp *api.ObjectURL, err error) {
 	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
@@ -102,6 +102,39 @@ func TestGetReader(t *testing.T) {
 	})
 }
 
+func TestDeleteObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	gsMock := &mockedGS{deleteCalls: []string{}}
+
+	impl := storageImpl{
+		settings: &settings.Settings{StorageGSPath: "/bucket/path"},
+		getGS:    func(context.Context) gs.GoogleStorage { return gsMock },
+	}
+
+	ftt.Run("OK", t, func(t *ftt.Test) {
+		err := impl.DeleteObject(ctx, &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: strings.Repeat("a", 64),
+		})
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, gsMock.deleteCalls, should.Match([]string{
+			"/bucket/path/SHA256/" + strings.Repeat("a", 64),
+		}))
+	})
+
+	ftt.Run("Bad object ref", t, func(t *ftt.Test) {
+		err := impl.DeleteObject(ctx, &api.ObjectRef{
+			HashAlgo:  api.HashAlgo_SHA256,
+			HexDigest: "zzz",
+		})
+		assert.Loosely(t, status.Code(err), should.Equal(codes.InvalidArgument))
+		assert.Loosely(t, err, should.ErrLike("bad ref"))
+	})
+}
+
 func TestGetObjectURL(t *testing.T) {
 	t.Parallel()
 
@@ -23,6 +23,7 @@ import (
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/common/sync/parallel"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/grpcutil"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 )
@@ -46,7 +47,8 @@ func DeletePackage(ctx context.Context, pkg string) error {
 	// Note that to maintain data model consistency during the deletion, we delete
 	// various metadata first, and PackageInstance entities second (to make sure
 	// we don't have metadata with dangling pointers to deleted instances).
-	err := deleteEntityKinds(ctx, pkg, []string{
+	err := deleteEntityKinds(ctx, PackageKey(ctx, pkg), []string{
+		"InstanceDelta",
 		"InstanceMetadata",
 		"InstanceTag",
 		"PackageRef",
@@ -55,7 +57,7 @@ func DeletePackage(ctx context.Context, pkg string) error {
 	if err != nil {
 		return err
 	}
-	if err := deleteEntityKinds(ctx, pkg, []string{"PackageInstance"}); err != nil {
+	if err := deleteEntityKinds(ctx, PackageKey(ctx, pkg), []string{"PackageInstance"}); err != nil {
 		return nil
 	}
 
@@ -63,7 +65,8 @@ func DeletePackage(ctx context.Context, pkg string) error {
 	// were deleting stuff above. There should be very few (usually 0) such
 	// entities, so it's OK to delete them transactionally.
 	return Txn(ctx, "DeletePackage", func(ctx context.Context) error {
-		err := deleteEntityKinds(ctx, pkg, []string{
+		err := deleteEntityKinds(ctx, PackageKey(ctx, pkg), []string{
+			"InstanceDelta",
 			"InstanceMetadata",
 			"InstanceTag",
 			"PackageRef",
@@ -83,13 +86,85 @@ func DeletePackage(ctx context.Context, pkg string) error {
 	})
 }
 
+// DeleteInstance deletes an instance and all entities associated with it.
+//
+// Refuses to delete instances that are pointed to by some ref. Uses the same
+// approach as DeletePackage: most of the metadata is deleted
+// non-transactionally first, and the rest is cleaned up in the transaction.
+//
+// Before touching the metadata, the instance is transactionally verified to be
+// unreferenced and marked as being deleted. Such instances are not ready (see
+// CheckReady), so no refs can be moved to them while the deletion is in
+// progress.
+//
+// Note that it doesn't touch the instance file in the storage.
+//
+// Returns grpc-tagged errors (in particular NotFound if there's no such
+// instance and FailedPrecondition if some ref points to it).
+func DeleteInstance(ctx context.Context, inst *Instance) error {
+	err := Txn(ctx, "MarkInstanceDeleting", func(ctx context.Context) error {
+		if err := CheckInstanceExists(ctx, inst); err != nil {
+			return err
+		}
+		if err := checkNoRefs(ctx, inst); err != nil {
+			return err
+		}
+		if inst.Deleting {
+			return nil // retrying a partially done deletion
+		}
+		inst.Deleting = true
+		return transient.Tag.Apply(datastore.Put(ctx, inst))
+	})
+	if err != nil {
+		return err
+	}
+
+	kinds := []string{
+		"InstanceDelta",
+		"InstanceMetadata",
+		"InstanceTag",
+		"ProcessingResult",
+	}
+	root := datastore.KeyForObj(ctx, inst)
+	if err := deleteEntityKinds(ctx, root, kinds); err != nil {
+		return err
+	}
+
+	return Txn(ctx, "DeleteInstance", func(ctx context.Context) error {
+		if err := deleteEntityKinds(ctx, root, kinds); err != nil {
+			return err
+		}
+		if err := datastore.Delete(ctx, root); err != nil {
+			return transient.Tag.Apply(err)
+		}
+		return EmitEvent(ctx, &api.Event{
+			Kind:     api.EventKind_INSTANCE_DELETED,
+			Package:  inst.Package.StringID(),
+			Instance: inst.InstanceID,
+		})
+	})
+}
+
+// checkNoRefs returns FailedPrecondition error if some ref points to the
+// instance.
+func checkNoRefs(ctx context.Context, inst *Instance) error {
+	refs, err := ListInstanceRefs(ctx, inst)
+	if err != nil {
+		return errors.Annotate(err, "failed to list refs").Err()
+	}
+	if len(refs) != 0 {
+		return errors.Reason("the instance is referenced by ref %q", refs[0].Name).Tag(grpcutil.FailedPreconditionTag).Err()
+	}
+	return nil
+}
+
 var (
 	// Number of keys to delete at once in deleteKinds. Replaced in tests.
 	deletionBatchSize = 256
 )
 
 // deleteEntityKinds deletes all entities of given kinds under given root.
-func deleteEntityKinds(ctx context.Context, pkg string, kindsToDelete []string) error {
+func deleteEntityKinds(ctx context.Context, root *datastore.Key, kindsToDelete []string) error {
 	logging.Infof(ctx, "Deleting %s...", strings.Join(kindsToDelete, ", "))
 	return transient.Tag.Apply(parallel.WorkPool(len(kindsToDelete)+1, func(tasks chan<- func() error) {
 		// A channel that receives keys to delete. Set some arbitrary buffer size to
@@ -102,7 +177,7 @@ func deleteEntityKinds(ctx context.Context, pkg string, kindsToDelete []string)
 			kind := kind
 			tasks <- func() error {
 				q := datastore.NewQuery(kind).
-					Ancestor(PackageKey(ctx, pkg)).
+					Ancestor(root).
 					KeysOnly(true)
 
 				count := 0
@@ -123,3 +123,98 @@ func TestDeletePackage(t *testing.T) {
 		assert.Loosely(t, GetEvents(ctx), should.HaveLength(len(events)))
 	})
 }
+
+func TestDeleteInstance(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		ctx, _, _ := testutil.TestingContext()
+
+		register := func(iid string) *Instance {
+			reg, inst, _ := RegisterInstance(ctx, &Instance{
+				InstanceID: iid,
+				Package:    PackageKey(ctx, "pkg"),
+			}, nil)
+			assert.Loosely(t, reg, should.BeTrue)
+			assert.Loosely(t, datastore.Put(ctx, &ProcessingResult{
+				ProcID:   "zzz",
+				Instance: datastore.KeyForObj(ctx, inst),
+			}), should.BeNil)
+			assert.Loosely(t, AttachTags(ctx, inst, []*api.Tag{
+				{Key: "k", Value: iid[:1]},
+			}), should.BeNil)
+			assert.Loosely(t, AttachMetadata(ctx, inst, []*api.InstanceMetadata{
+				{Key: "k", Value: []byte(iid[:1])},
+			}), should.BeNil)
+			return inst
+		}
+
+		// Returns number of entities under the instance, including itself.
+		entitiesCount := func(inst *Instance) (count int) {
+			q := datastore.NewQuery("").Ancestor(datastore.KeyForObj(ctx, inst)).KeysOnly(true)
+			assert.Loosely(t, datastore.Run(ctx, q, func(k *datastore.Key) {
+				count++
+			}), should.BeNil)
+			return
+		}
+
+		inst1 := register(strings.Repeat("a", 40))
+		inst2 := register(strings.Repeat("b", 40))
+		assert.Loosely(t, SetRef(ctx, "latest", inst2), should.BeNil)
+
+		assert.Loosely(t, entitiesCount(inst1), should.Equal(4))
+		assert.Loosely(t, entitiesCount(inst2), should.Equal(4))
+
+		t.Run("Deletes unreferenced instance", func(t *ftt.Test) {
+			assert.Loosely(t, DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			}), should.BeNil)
+
+			assert.Loosely(t, entitiesCount(inst1), should.BeZero)
+			assert.Loosely(t, entitiesCount(inst2), should.Equal(4))
+
+			events := GetEvents(ctx)
+			assert.Loosely(t, events[len(events)-1], should.Match(&api.Event{
+				Kind:     api.EventKind_INSTANCE_DELETED,
+				Package:  "pkg",
+				Instance: inst1.InstanceID,
+				Who:      string(testutil.TestUser),
+				When:     timestamppb.New(testutil.TestTime),
+			}))
+
+			// Now it is gone.
+			err := DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			})
+			assert.Loosely(t, grpcutil.Code(err), should.Equal(codes.NotFound))
+		})
+
+		t.Run("Refuses to delete referenced instance", func(t *ftt.Test) {
+			err := DeleteInstance(ctx, &Instance{
+				InstanceID: inst2.InstanceID,
+				Package:    inst2.Package,
+			})
+			assert.Loosely(t, grpcutil.Code(err), should.Equal(codes.FailedPrecondition))
+			assert.Loosely(t, entitiesCount(inst2), should.Equal(4))
+		})
+
+		t.Run("Refs can't be moved to instance being deleted", func(t *ftt.Test) {
+			// Emulate a deletion that was interrupted midway.
+			inst1.Deleting = true
+			assert.Loosely(t, datastore.Put(ctx, inst1), should.BeNil)
+
+			err := SetRef(ctx, "latest", inst1)
+			assert.Loosely(t, grpcutil.Code(err), should.Equal(codes.FailedPrecondition))
+			assert.Loosely(t, err, should.ErrLike("the instance is being deleted"))
+
+			// The deletion can be resumed.
+			assert.Loosely(t, DeleteInstance(ctx, &Instance{
+				InstanceID: inst1.InstanceID,
+				Package:    inst1.Package,
+			}), should.BeNil)
+			assert.Loosely(t, entitiesCount(inst1), should.BeZero)
+		})
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// InstanceDelta holds information about a delta between two package instances.
+//
+// It is created when a client asks for the delta for the first time and
+// updated once the delta is calculated asynchronously. Immutable after that.
+//
+// Entity ID is the base instance ID. Parent entity is PackageInstance of the
+// target instance.
+type InstanceDelta struct {
+	_kind  string                `gae:"$kind,InstanceDelta"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	BaseID   string         `gae:"$id"`     // see common.ObjectRefToInstanceID()
+	Instance *datastore.Key `gae:"$parent"` // the target instance
+
+	CreatedTs  time.Time `gae:"created_ts"`  // when the delta was requested
+	FinishedTs time.Time `gae:"finished_ts"` // when it was calculated or zero
+
+	Success   bool   `gae:"success"`          // true if the delta can be used
+	Error     string `gae:"error,noindex"`    // for Success == false
+	DeltaID   string `gae:"delta_id,noindex"` // the delta in the CAS, see common.ObjectRefToInstanceID()
+	DeltaSize int64  `gae:"delta_size,noindex"`
+	FilesRaw  []byte `gae:"files,noindex"` // zlib-compressed JSON with deltaFiles
+}
+
+// deltaFiles is stored in InstanceDelta.FilesRaw.
+//
+// File lists can be large, so they are stored compressed.
+type deltaFiles struct {
+	Added   []string `json:"added,omitempty"`
+	Changed []string `json:"changed,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// InstanceDeltaKey returns a key of InstanceDelta entity.
+func InstanceDeltaKey(ctx context.Context, pkg string, base, target *api.ObjectRef) *datastore.Key {
+	inst := datastore.NewKey(ctx, "PackageInstance", common.ObjectRefToInstanceID(target), 0, PackageKey(ctx, pkg))
+	return datastore.NewKey(ctx, "InstanceDelta", common.ObjectRefToInstanceID(base), 0, inst)
+}
+
+// WriteFiles overwrites FilesRaw with compressed lists of files.
+func (d *InstanceDelta) WriteFiles(added, changed, removed []string) error {
+	out := bytes.Buffer{}
+	z := zlib.NewWriter(&out)
+	err := json.NewEncoder(z).Encode(&deltaFiles{
+		Added:   added,
+		Changed: changed,
+		Removed: removed,
+	})
+	if err != nil {
+		z.Close()
+		return errors.Annotate(err, "failed to serialize the file lists").Err()
+	}
+	if err := z.Close(); err != nil {
+		return errors.Annotate(err, "failed to close zlib writer").Err()
+	}
+	d.FilesRaw = out.Bytes()
+	return nil
+}
+
+// Proto returns cipd.InstanceDelta proto with information from this entity.
+//
+// Doesn't populate the delta URL.
+func (d *InstanceDelta) Proto() (*api.InstanceDelta, error) {
+	switch {
+	case d.FinishedTs.IsZero():
+		return &api.InstanceDelta{Status: api.DeltaStatus_DELTA_PENDING}, nil
+	case !d.Success:
+		return &api.InstanceDelta{Status: api.DeltaStatus_DELTA_UNAVAILABLE}, nil
+	}
+
+	var files deltaFiles
+	if len(d.FilesRaw) != 0 {
+		z, err := zlib.NewReader(bytes.NewReader(d.FilesRaw))
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to open the blob for zlib decompression").Err()
+		}
+		if err := json.NewDecoder(z).Decode(&files); err != nil {
+			z.Close()
+			return nil, errors.Annotate(err, "failed to decompress or deserialize the file lists").Err()
+		}
+		if err := z.Close(); err != nil {
+			return nil, errors.Annotate(err, "failed to close zlib reader").Err()
+		}
+	}
+
+	return &api.InstanceDelta{
+		Status:       api.DeltaStatus_DELTA_READY,
+		Delta:        common.InstanceIDToObjectRef(d.DeltaID),
+		DeltaSize:    d.DeltaSize,
+		AddedFiles:   files.Added,
+		ChangedFiles: files.Changed,
+		RemovedFiles: files.Removed,
+	}, nil
+}
+
+// FetchInstanceDelta fetches the InstanceDelta entity.
+//
+// Returns nil if it doesn't exist yet.
+func FetchInstanceDelta(ctx context.Context, pkg string, base, target *api.ObjectRef) (*InstanceDelta, error) {
+	ent := &InstanceDelta{}
+	if !datastore.PopulateKey(ent, InstanceDeltaKey(ctx, pkg, base, target)) {
+		panic("impossible")
+	}
+	switch err := datastore.Get(ctx, ent); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, nil
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to fetch the delta entity").Tag(transient.Tag).Err()
+	}
+	return ent, nil
+}
+
+// ListInstanceDeltas returns all deltas that have the given instance as
+// a target.
+func ListInstanceDeltas(ctx context.Context, inst *Instance) (out []*InstanceDelta, err error) {
+	q := datastore.NewQuery("InstanceDelta").Ancestor(datastore.KeyForObj(ctx, inst))
+	if err := datastore.GetAll(ctx, q, &out); err != nil {
+		return nil, errors.Annotate(err, "datastore query failed").Tag(transient.Tag).Err()
+	}
+	return out, nil
+}
+
+// CountInstanceDeltas returns how many deltas have the given instance as
+// a target, regardless of their status.
+//
+// Can be called as part of a transaction.
+func CountInstanceDeltas(ctx context.Context, inst *Instance) (int64, error) {
+	q := datastore.NewQuery("InstanceDelta").Ancestor(datastore.KeyForObj(ctx, inst))
+	n, err := datastore.Count(ctx, q)
+	if err != nil {
+		return 0, errors.Annotate(err, "datastore query failed").Tag(transient.Tag).Err()
+	}
+	return n, nil
+}
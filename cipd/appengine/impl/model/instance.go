@@ -50,12 +50,21 @@ type Instance struct {
 	RegisteredBy string    `gae:"registered_by"` // who registered it
 	RegisteredTs time.Time `gae:"registered_ts"` // when it was registered
 
+	// IndexedID is the same as InstanceID, but indexed.
+	//
+	// Used to find instances of different packages sharing the same file. It is
+	// populated by RegisterInstance and is absent in old entities.
+	IndexedID string `gae:"instance_id"`
+
 	// Names of currently running or scheduled processors.
 	ProcessorsPending []string `gae:"processors_pending"`
 	// Names of processors that successfully finished the processing.
 	ProcessorsSuccess []string `gae:"processors_success"`
 	// Names of processors that returned fatal errors.
 	ProcessorsFailure []string `gae:"processors_failure"`
+
+	// Deleting is true if the instance is being deleted by DeleteInstance.
+	Deleting bool `gae:"deleting,noindex"`
 }
 
 // Proto returns cipd.Instance proto with information from this entity.
@@ -83,9 +92,12 @@ func (e *Instance) FromProto(ctx context.Context, p *api.Instance) *Instance {
 	return e
 }
 
-// CheckReady returns an error if the instance has pending or failed processors.
+// CheckReady returns an error if the instance has pending or failed processors
+// or it is being deleted.
 func (e *Instance) CheckReady() error {
 	switch {
+	case e.Deleting:
+		return errors.Reason("the instance is being deleted").Tag(grpcutil.FailedPreconditionTag).Err()
 	case len(e.ProcessorsFailure) != 0:
 		return errors.Reason("some processors failed to process this instance: %s",
 			strings.Join(e.ProcessorsFailure, ", ")).Tag(grpcutil.AbortedTag).Err()
@@ -151,6 +163,7 @@ func RegisterInstance(ctx context.Context, inst *Instance, cb func(context.Conte
 
 		// Let the caller do more stuff inside this txn, e.g. start TQ tasks.
 		toPut := *inst
+		toPut.IndexedID = inst.InstanceID
 		if cb != nil {
 			if err := cb(ctx, &toPut); err != nil {
 				return errors.Annotate(err, "instance registration callback error").Err()
@@ -208,6 +221,18 @@ func ListInstances(ctx context.Context, pkg string, pageSize int32, cursor datas
 	return
 }
 
+// ListInstancesByID lists instances with the given ID across all packages.
+//
+// Uses an eventually consistent query. Doesn't find instances registered before
+// IndexedID was introduced.
+func ListInstancesByID(ctx context.Context, iid string) (out []*Instance, err error) {
+	q := datastore.NewQuery("PackageInstance").Eq("instance_id", iid)
+	if err := datastore.GetAll(ctx, q, &out); err != nil {
+		return nil, errors.Annotate(err, "datastore query failed").Tag(transient.Tag).Err()
+	}
+	return
+}
+
 // CheckInstanceExists fetches the instance and verifies it exists.
 //
 // Can be called as part of a transaction. Updates 'inst' in place.
@@ -236,7 +261,8 @@ func CheckInstanceExists(ctx context.Context, inst *Instance) error {
 // Returns gRPC-tagged errors:
 //
 //	NotFound if there's no such instance or package.
-//	FailedPrecondition if some processors are still running.
+//	FailedPrecondition if some processors are still running or the instance
+//	  is being deleted.
 //	Aborted if some processors have failed.
 func CheckInstanceReady(ctx context.Context, inst *Instance) error {
 	if err := CheckInstanceExists(ctx, inst); err != nil {
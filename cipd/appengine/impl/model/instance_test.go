@@ -80,6 +80,7 @@ func TestRegisterInstance(t *testing.T) {
 				Package:           inst.Package,
 				RegisteredBy:      inst.RegisteredBy,
 				RegisteredTs:      inst.RegisteredTs,
+				IndexedID:         inst.InstanceID,
 				ProcessorsPending: []string{"a"},
 			}
 			assert.Loosely(t, out, should.Resemble(expected))
@@ -127,6 +128,7 @@ func TestRegisterInstance(t *testing.T) {
 				Package:           inst.Package,
 				RegisteredBy:      inst.RegisteredBy,
 				RegisteredTs:      inst.RegisteredTs,
+				IndexedID:         inst.InstanceID,
 				ProcessorsPending: []string{"a"},
 			}))
 
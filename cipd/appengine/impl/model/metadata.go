@@ -86,6 +86,27 @@ func (md *InstanceMetadata) Proto() *api.InstanceMetadata {
 //	Aborted if some processors have failed.
 //	Internal on fingerprint collision.
 func AttachMetadata(ctx context.Context, inst *Instance, md []*api.InstanceMetadata) error {
+	return attachMetadata(ctx, inst, md, CheckInstanceReady)
+}
+
+// AttachProcessingMetadata is like AttachMetadata, except it doesn't require
+// the instance to be ready.
+//
+// It is used by processors to attach metadata they extract from the package
+// while the processing is still running.
+//
+// Returns gRPC-tagged errors:
+//
+//	NotFound if there's no such instance or package.
+//	Internal on fingerprint collision.
+func AttachProcessingMetadata(ctx context.Context, inst *Instance, md []*api.InstanceMetadata) error {
+	return attachMetadata(ctx, inst, md, CheckInstanceExists)
+}
+
+// attachMetadata implements AttachMetadata and AttachProcessingMetadata.
+//
+// Calls `check` inside the transaction to verify the instance state.
+func attachMetadata(ctx context.Context, inst *Instance, md []*api.InstanceMetadata, check func(context.Context, *Instance) error) error {
 	now := clock.Now(ctx).UTC()
 	who := string(auth.CurrentIdentity(ctx))
 
@@ -109,7 +130,7 @@ func AttachMetadata(ctx context.Context, inst *Instance, md []*api.InstanceMetad
 	md = filtered
 
 	return Txn(ctx, "AttachMetadata", func(ctx context.Context) error {
-		if err := CheckInstanceReady(ctx, inst); err != nil {
+		if err := check(ctx, inst); err != nil {
 			return err
 		}
 
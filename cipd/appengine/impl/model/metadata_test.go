@@ -298,6 +298,20 @@ func TestMetadata(t *testing.T) {
 			assert.Loosely(t, err, should.ErrLike("the instance is not ready yet"))
 		})
 
+		t.Run("AttachProcessingMetadata to not ready instance", func(t *ftt.Test) {
+			inst := putInst("pkg", digest, []string{"proc"})
+
+			err := AttachProcessingMetadata(ctx, inst, []*api.InstanceMetadata{
+				{
+					Key:   "key",
+					Value: []byte("some value"),
+				},
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, getMD("key", "some value", inst), should.Resemble(
+				expMD("key", "some value", inst, "text/plain", 0, testutil.TestUser)))
+		})
+
 		t.Run("DetachMetadata happy paths", func(t *ftt.Test) {
 			inst := putInst("pkg", digest, nil)
 
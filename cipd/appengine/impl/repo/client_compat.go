@@ -0,0 +1,120 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/repo/processing"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// clientVersionRe extracts the version of the CIPD client from its user agent.
+//
+// The user agent looks like "cipd 2.7.0 (...)", possibly with some prefix
+// (see CIPD_HTTP_USER_AGENT_PREFIX).
+var clientVersionRe = regexp.MustCompile(`(?:^|[\s/])cipd (\d+)\.(\d+)\.(\d+)\b`)
+
+// clientVersion is a parsed CIPD client version.
+type clientVersion [3]int
+
+// clientVersionFromUA extracts the CIPD client version from the user agent.
+//
+// Returns false if the user agent doesn't look like a CIPD client.
+func clientVersionFromUA(ua string) (clientVersion, bool) {
+	m := clientVersionRe.FindStringSubmatch(ua)
+	if m == nil {
+		return clientVersion{}, false
+	}
+	return toClientVersion(m[1:])
+}
+
+func toClientVersion(parts []string) (clientVersion, bool) {
+	var out clientVersion
+	for i, p := range parts {
+		var err error
+		if out[i], err = strconv.Atoi(p); err != nil {
+			return clientVersion{}, false
+		}
+	}
+	return out, true
+}
+
+// less returns true if 'v' is older than 'o'.
+func (v clientVersion) less(o clientVersion) bool {
+	for i := range v {
+		if v[i] != o[i] {
+			return v[i] < o[i]
+		}
+	}
+	return false
+}
+
+// zstdMinClientVersion is common.ZstdMinClientVersion parsed.
+var zstdMinClientVersion = func() clientVersion {
+	v, ok := clientVersionFromUA("cipd " + common.ZstdMinClientVersion)
+	if !ok {
+		panic("bad common.ZstdMinClientVersion")
+	}
+	return v
+}()
+
+// checkClientCanUnpack returns FailedPrecondition error if the calling CIPD
+// client is too old to unpack the given instance.
+//
+// Requests from anything that is not a CIPD client (e.g. browsers) are always
+// allowed. Packages that were never inspected by processing.CompressionInspector
+// are assumed to be compatible with all clients.
+func checkClientCanUnpack(ctx context.Context, inst *model.Instance) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var ver clientVersion
+	found := false
+	for _, ua := range md.Get("user-agent") {
+		if ver, found = clientVersionFromUA(ua); found {
+			break
+		}
+	}
+	if !found || !ver.less(zstdMinClientVersion) {
+		return nil
+	}
+
+	switch res, err := processing.GetCompressionInspectorResult(ctx, inst); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil
+	case transient.Tag.In(err):
+		return err
+	case err != nil:
+		// The instance is likely broken, let the client discover this itself.
+		logging.Warningf(ctx, "Ignoring compression inspector failure: %s", err)
+		return nil
+	case res.UsesZstd():
+		return status.Errorf(codes.FailedPrecondition,
+			"the package instance is compressed using zstd which is not supported by CIPD client v%d.%d.%d, "+
+				"please update the client to v%s or newer",
+			ver[0], ver[1], ver[2], common.ZstdMinClientVersion)
+	}
+	return nil
+}
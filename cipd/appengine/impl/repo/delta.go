@@ -0,0 +1,239 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repo
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/iotools"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/tq"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/gs"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/repo/processing"
+	"go.chromium.org/luci/cipd/appengine/impl/repo/tasks"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// deltaPendingTimeout is how long to wait for a delta calculation before
+// giving up on it.
+//
+// Matches task_age_limit of "calculate-delta" queue in queue.yaml.
+const deltaPendingTimeout = 24 * time.Hour
+
+// maxDeltasPerTarget limits how many deltas can be calculated for a single
+// target instance.
+//
+// Deltas are calculated on demand of any reader, so without a limit a client
+// could make the backend calculate and store a delta for every pair of
+// instances. Clients usually update from one of a few recent versions.
+const maxDeltasPerTarget = 10
+
+// GetInstanceDelta implements the corresponding RPC method, see the proto doc.
+func (impl *repoImpl) GetInstanceDelta(ctx context.Context, r *api.GetInstanceDeltaRequest) (resp *api.InstanceDelta, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	// Validate the request.
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Base, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'base': %s", err)
+	}
+	if err := common.ValidateObjectRef(r.Target, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'target': %s", err)
+	}
+	if common.ObjectRefToInstanceID(r.Base) == common.ObjectRefToInstanceID(r.Target) {
+		return nil, status.Errorf(codes.InvalidArgument, "'base' and 'target' are the same instance")
+	}
+
+	// Check ACLs.
+	if _, err := impl.checkRole(ctx, r.Package, api.Role_READER); err != nil {
+		return nil, err
+	}
+
+	// Make sure both instances actually exist.
+	insts := make([]*model.Instance, 2)
+	for i, ref := range []*api.ObjectRef{r.Base, r.Target} {
+		insts[i] = (&model.Instance{}).FromProto(ctx, &api.Instance{
+			Package:  r.Package,
+			Instance: ref,
+		})
+		if err := model.CheckInstanceExists(ctx, insts[i]); err != nil {
+			return nil, err
+		}
+	}
+	baseInst, targetInst := insts[0], insts[1]
+
+	ent, err := model.FetchInstanceDelta(ctx, r.Package, r.Base, r.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// Schedule the calculation if this is the first time this delta is needed.
+	// Deltas are calculated only when updating to a newer instance and only for
+	// a limited number of bases per target.
+	if ent == nil {
+		if !baseInst.RegisteredTs.Before(targetInst.RegisteredTs) {
+			return &api.InstanceDelta{Status: api.DeltaStatus_DELTA_UNAVAILABLE}, nil
+		}
+		err := model.Txn(ctx, "GetInstanceDelta", func(ctx context.Context) error {
+			switch ent, err = model.FetchInstanceDelta(ctx, r.Package, r.Base, r.Target); {
+			case err != nil:
+				return err
+			case ent != nil:
+				return nil // someone else scheduled it already
+			}
+			switch n, err := model.CountInstanceDeltas(ctx, targetInst); {
+			case err != nil:
+				return err
+			case n >= maxDeltasPerTarget:
+				return nil // ent is nil, see below
+			}
+			ent = &model.InstanceDelta{CreatedTs: clock.Now(ctx).UTC()}
+			datastore.PopulateKey(ent, model.InstanceDeltaKey(ctx, r.Package, r.Base, r.Target))
+			if err := datastore.Put(ctx, ent); err != nil {
+				return transient.Tag.Apply(err)
+			}
+			return impl.tq.AddTask(ctx, &tq.Task{
+				Title: common.ObjectRefToInstanceID(r.Target),
+				Payload: &tasks.CalculateDelta{
+					Package: r.Package,
+					Base:    r.Base,
+					Target:  r.Target,
+				},
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+		if ent == nil {
+			logging.Warningf(ctx, "Too many deltas for %s already, not calculating another one", targetInst.InstanceID)
+			return &api.InstanceDelta{Status: api.DeltaStatus_DELTA_UNAVAILABLE}, nil
+		}
+	}
+
+	// If the calculation is stuck for too long, it is not going to finish.
+	if ent.FinishedTs.IsZero() && clock.Now(ctx).Sub(ent.CreatedTs) > deltaPendingTimeout {
+		return &api.InstanceDelta{Status: api.DeltaStatus_DELTA_UNAVAILABLE}, nil
+	}
+
+	resp, err = ent.Proto()
+	if err != nil || resp.Status != api.DeltaStatus_DELTA_READY {
+		return resp, err
+	}
+
+	// Ask CAS generate an URL for us. Note that CAS does caching internally.
+	resp.DeltaUrl, err = impl.cas.GetObjectURL(ctx, &api.GetObjectURLRequest{
+		Object: resp.Delta,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// calculateDeltaTask calculates a delta between two instances, storing it in
+// the CAS.
+//
+// Returning a transient error here causes the task queue service to retry the
+// task.
+func (impl *repoImpl) calculateDeltaTask(ctx context.Context, t *tasks.CalculateDelta) error {
+	ent, err := model.FetchInstanceDelta(ctx, t.Package, t.Base, t.Target)
+	switch {
+	case err != nil:
+		return err
+	case ent == nil:
+		logging.Warningf(ctx, "The delta entity is unexpectedly gone, skipping")
+		return nil
+	case !ent.FinishedTs.IsZero():
+		logging.Infof(ctx, "The delta was already calculated, skipping")
+		return nil
+	}
+
+	res, err := impl.calculateDelta(ctx, t)
+	if transient.Tag.In(err) {
+		return err // retry the whole thing
+	}
+
+	ent.FinishedTs = clock.Now(ctx).UTC()
+	switch {
+	case err != nil:
+		logging.WithError(err).Errorf(ctx, "Failed to calculate the delta")
+		ent.Error = err.Error()
+	case res.Ref == nil:
+		logging.Infof(ctx, "The delta is too large (%d bytes), not storing it", res.Size)
+		ent.Error = "the delta is too large"
+	default:
+		logging.Infof(ctx, "Stored the delta (%d bytes) as %s", res.Size, common.ObjectRefToInstanceID(res.Ref))
+		ent.Success = true
+		ent.DeltaID = common.ObjectRefToInstanceID(res.Ref)
+		ent.DeltaSize = res.Size
+		if err := ent.WriteFiles(res.Plan.Added, res.Plan.Changed, res.Plan.Removed); err != nil {
+			return err
+		}
+	}
+
+	if err := datastore.Put(ctx, ent); err != nil {
+		return errors.Annotate(err, "failed to store the delta entity").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// calculateDelta opens both instances and runs processing.DeltaCalculator.
+func (impl *repoImpl) calculateDelta(ctx context.Context, t *tasks.CalculateDelta) (*processing.DeltaResult, error) {
+	open := func(ref *api.ObjectRef) (gs.Reader, error) {
+		r, err := impl.cas.GetReader(ctx, ref)
+		switch code := status.Code(err); {
+		case code == codes.NotFound:
+			return nil, errors.Annotate(err, "package instance is not in the storage").Err()
+		case code != codes.OK:
+			return nil, errors.Annotate(err, "failed to open the object for reading").Tag(transient.Tag).Err()
+		}
+		return r, nil
+	}
+
+	base, err := open(t.Base)
+	if err != nil {
+		return nil, err
+	}
+	target, err := open(t.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read in 512 Kb chunks, keep 2 of them buffered, same as packageReader.
+	calc := processing.DeltaCalculator{
+		Base:       iotools.NewBufferingReaderAt(base, 512*1024, 2),
+		BaseSize:   base.Size(),
+		Target:     iotools.NewBufferingReaderAt(target, 512*1024, 2),
+		TargetSize: target.Size(),
+		CAS:        impl.cas,
+		HashAlgo:   t.Target.HashAlgo,
+		Uploader:   impl.deltaUploader,
+	}
+	return calc.Run(ctx)
+}
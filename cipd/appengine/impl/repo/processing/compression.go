@@ -0,0 +1,100 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// CompressionInspectorProcID is identifier of CompressionInspector processor.
+const CompressionInspectorProcID = "compression:v1"
+
+// CompressionInspector is a processor that records what compression methods
+// are used by files inside the package.
+//
+// It is used to refuse serving packages compressed with zstd to CIPD clients
+// that can't unpack them. It runs only for instances registered by clients
+// that declared (via common.CompressionHintMetadataKey) the package uses
+// a non-default compression method, other instances are assumed to be
+// compressed with Deflate.
+type CompressionInspector struct{}
+
+// CompressionInspectorResult is stored as JSON in model.ProcessingResult.
+type CompressionInspectorResult struct {
+	Methods []string `json:"methods"` // e.g. ["deflate", "zstd"]
+}
+
+// UsesZstd returns true if some files in the package are compressed with zstd.
+func (r *CompressionInspectorResult) UsesZstd() bool {
+	return slices.Contains(r.Methods, "zstd")
+}
+
+// ID is part of Processor interface.
+func (c *CompressionInspector) ID() string {
+	return CompressionInspectorProcID
+}
+
+// Applicable is part of Processor interface.
+func (c *CompressionInspector) Applicable(ctx context.Context, inst *model.Instance) (bool, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return len(md.Get(common.CompressionHintMetadataKey)) != 0, nil
+}
+
+// Run is part of Processor interface.
+func (c *CompressionInspector) Run(ctx context.Context, inst *model.Instance, pkg *PackageReader) (Result, error) {
+	return Result{
+		Result: CompressionInspectorResult{
+			Methods: pkg.CompressionMethods(),
+		},
+	}, nil
+}
+
+// GetCompressionInspectorResult returns results of CompressionInspector.
+//
+// Returns:
+//
+//	(result, nil) on success.
+//	(nil, datastore.ErrNoSuchEntity) if results are not available.
+//	(nil, transient-tagged error) on retrieval errors.
+//	(nil, non-transient-tagged error) if the inspector failed.
+func GetCompressionInspectorResult(ctx context.Context, inst *model.Instance) (*CompressionInspectorResult, error) {
+	r := &model.ProcessingResult{
+		ProcID:   CompressionInspectorProcID,
+		Instance: datastore.KeyForObj(ctx, inst),
+	}
+	switch err := datastore.Get(ctx, r); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, err
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	case !r.Success:
+		return nil, errors.Reason("compression inspection failed: %s", r.Error).Err()
+	}
+	out := &CompressionInspectorResult{}
+	if err := r.ReadResult(out); err != nil {
+		return nil, errors.Annotate(err, "failed to parse the inspector result").Err()
+	}
+	return out, nil
+}
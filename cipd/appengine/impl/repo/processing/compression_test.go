@@ -0,0 +1,129 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/common"
+)
+
+func TestCompressionInspector(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With datastore", t, func(t *ftt.Test) {
+		ctx := memory.Use(context.Background())
+		inst := instance(ctx, "some/pkg", api.HashAlgo_SHA256)
+		proc := &CompressionInspector{}
+
+		t.Run("Applicable", func(t *ftt.Test) {
+			yes, err := proc.Applicable(ctx, inst)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, yes, should.BeFalse)
+
+			hinted := metadata.NewIncomingContext(ctx, metadata.Pairs(common.CompressionHintMetadataKey, "zstd"))
+			yes, err = proc.Applicable(hinted, inst)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, yes, should.BeTrue)
+		})
+
+		t.Run("Zstd package", func(t *ftt.Test) {
+			pkg, err := NewPackageReader(zstdZip(t))
+			assert.Loosely(t, err, should.BeNil)
+
+			// Can read zstd-compressed files.
+			r, size, err := pkg.Open("zstd")
+			assert.Loosely(t, err, should.BeNil)
+			defer r.Close()
+			assert.Loosely(t, size, should.Equal(11))
+			body, err := io.ReadAll(r)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, string(body), should.Equal("zstd body 1"))
+
+			res, err := proc.Run(ctx, inst, pkg)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Err, should.BeNil)
+			assert.Loosely(t, res.Result, should.Match(CompressionInspectorResult{
+				Methods: []string{"deflate", "zstd", "store"},
+			}))
+
+			stored := &model.ProcessingResult{
+				ProcID:   CompressionInspectorProcID,
+				Instance: datastore.KeyForObj(ctx, inst),
+				Success:  true,
+			}
+			assert.Loosely(t, stored.WriteResult(res.Result), should.BeNil)
+			assert.Loosely(t, datastore.Put(ctx, stored), should.BeNil)
+
+			out, err := GetCompressionInspectorResult(ctx, inst)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, out.UsesZstd(), should.BeTrue)
+		})
+
+		t.Run("Deflate package", func(t *ftt.Test) {
+			res, err := proc.Run(ctx, inst, packageReader(map[string]string{
+				"file": "body",
+			}))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Result, should.Match(CompressionInspectorResult{
+				Methods: []string{"deflate"},
+			}))
+			r := res.Result.(CompressionInspectorResult)
+			assert.Loosely(t, r.UsesZstd(), should.BeFalse)
+		})
+
+		t.Run("No results", func(t *ftt.Test) {
+			_, err := GetCompressionInspectorResult(ctx, inst)
+			assert.Loosely(t, err, should.Equal(datastore.ErrNoSuchEntity))
+		})
+	})
+}
+
+// zstdZip returns a zip file with files compressed using different methods.
+func zstdZip(t testing.TB) (*bytes.Reader, int64) {
+	buf := bytes.Buffer{}
+	w := zip.NewWriter(&buf)
+	w.RegisterCompressor(zstd.ZipMethodWinZip, zstd.ZipCompressor())
+
+	add := func(name, body string, method uint16) {
+		fw, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: method})
+		assert.Loosely(t, err, should.BeNil)
+		_, err = fw.Write([]byte(body))
+		assert.Loosely(t, err, should.BeNil)
+	}
+	add("deflate", "deflate body", zip.Deflate)
+	add("zstd", "zstd body 1", zstd.ZipMethodWinZip)
+	add("stored", "stored body", zip.Store)
+	add("zstd-again", "zstd body 2", zstd.ZipMethodWinZip)
+	assert.Loosely(t, w.Close(), should.BeNil)
+
+	r := bytes.NewReader(buf.Bytes())
+	return r, r.Size()
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"context"
+	"hash"
+	"io"
+
+	"go.chromium.org/luci/common/errors"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas"
+	"go.chromium.org/luci/cipd/client/cipd/delta"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// DeltaCalculator calculates a delta between two package instances, writing
+// it to the CAS.
+type DeltaCalculator struct {
+	// Base is the instance the delta is calculated from.
+	Base io.ReaderAt
+	// BaseSize is the size of the base instance file.
+	BaseSize int64
+
+	// Target is the instance the delta is calculated to.
+	Target io.ReaderAt
+	// TargetSize is the size of the target instance file.
+	TargetSize int64
+
+	// CAS is the destination CAS implementation.
+	CAS cas.StorageServer
+
+	// HashAlgo is the hash algorithm to use to name the delta in the CAS.
+	HashAlgo api.HashAlgo
+
+	// MaxSizeRatio is the maximum ratio between sizes of the delta and the
+	// target instance at which the delta is still worth storing.
+	//
+	// Default is 0.7.
+	MaxSizeRatio float64
+
+	// Uploader returns io.Writer that uploads to the given destination URL.
+	//
+	// If nil, will use a Google Storage uploader. Useful in tests.
+	Uploader func(ctx context.Context, size int64, uploadURL string) io.Writer
+
+	// BufferSize is size of the buffer for GS uploads (default is 2 Mb).
+	BufferSize int
+}
+
+// DeltaResult is a result of a delta calculation.
+type DeltaResult struct {
+	Plan *delta.Plan    // the calculated plan, always set
+	Ref  *api.ObjectRef // reference to the delta in the CAS or nil if too large
+	Size int64          // the size of the encoded delta in bytes
+}
+
+// Run calculates the delta and uploads it to the CAS if it is small enough.
+func (dc *DeltaCalculator) Run(ctx context.Context) (*DeltaResult, error) {
+	plan, err := delta.Compute(dc.Base, dc.BaseSize, dc.Target, dc.TargetSize)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to calculate the delta").Err()
+	}
+
+	res := &DeltaResult{
+		Plan: plan,
+		Size: plan.EncodedSize(),
+	}
+
+	maxRatio := dc.MaxSizeRatio
+	if maxRatio == 0 {
+		maxRatio = 0.7
+	}
+	if float64(res.Size) > float64(dc.TargetSize)*maxRatio {
+		return res, nil
+	}
+
+	// Encode the delta in a background goroutine, streaming it to the CAS.
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(plan.Encode(pw, dc.Target))
+	}()
+	defer pr.Close() // unblocks the goroutine if the upload fails
+
+	res.Ref, err = uploadToCAS(ctx, &casUpload{
+		CAS:         dc.CAS,
+		PrimaryHash: dc.HashAlgo,
+		Hashes:      map[api.HashAlgo]hash.Hash{dc.HashAlgo: common.MustNewHash(dc.HashAlgo)},
+		Uploader:    dc.Uploader,
+		BufferSize:  dc.BufferSize,
+	}, fullReader{pr}, res.Size)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/client/cipd/delta"
+	"go.chromium.org/luci/cipd/common"
+)
+
+func TestDeltaCalculator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	randomString := func(seed int64) string {
+		blob := make([]byte, 64*1024)
+		rand.New(rand.NewSource(seed)).Read(blob)
+		return string(blob)
+	}
+
+	ftt.Run("With mocks", t, func(t *ftt.Test) {
+		var publishedRef *api.ObjectRef
+
+		cas := testutil.MockCAS{
+			BeginUploadImpl: func(_ context.Context, r *api.BeginUploadRequest) (*api.UploadOperation, error) {
+				return &api.UploadOperation{
+					OperationId: "op_id",
+					UploadUrl:   "http://example.com/upload",
+				}, nil
+			},
+			FinishUploadImpl: func(_ context.Context, r *api.FinishUploadRequest) (*api.UploadOperation, error) {
+				publishedRef = r.ForceHash
+				return &api.UploadOperation{Status: api.UploadStatus_PUBLISHED}, nil
+			},
+		}
+
+		uploaded := bytes.Buffer{}
+
+		calc := func(base, target []byte) (*DeltaResult, error) {
+			dc := DeltaCalculator{
+				Base:       bytes.NewReader(base),
+				BaseSize:   int64(len(base)),
+				Target:     bytes.NewReader(target),
+				TargetSize: int64(len(target)),
+				CAS:        &cas,
+				HashAlgo:   api.HashAlgo_SHA256,
+				Uploader: func(_ context.Context, size int64, uploadURL string) io.Writer {
+					assert.Loosely(t, uploadURL, should.Equal("http://example.com/upload"))
+					return &uploaded
+				},
+			}
+			return dc.Run(ctx)
+		}
+
+		base := testutil.MakeZip(map[string]string{
+			"a": randomString(1),
+			"b": randomString(2),
+		})
+
+		t.Run("Small delta", func(t *ftt.Test) {
+			target := testutil.MakeZip(map[string]string{
+				"a": randomString(1),
+				"b": randomString(2),
+				"c": "new file",
+			})
+
+			res, err := calc(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Plan.Added, should.Match([]string{"c"}))
+			assert.Loosely(t, res.Size, should.Equal(int64(uploaded.Len())))
+
+			h := common.MustNewHash(api.HashAlgo_SHA256)
+			h.Write(uploaded.Bytes())
+			assert.Loosely(t, res.Ref, should.Match(&api.ObjectRef{
+				HashAlgo:  api.HashAlgo_SHA256,
+				HexDigest: common.HexDigest(h),
+			}))
+			assert.Loosely(t, publishedRef, should.Match(res.Ref))
+
+			// The uploaded delta is usable.
+			out := bytes.Buffer{}
+			_, err = delta.Apply(bytes.NewReader(base), bytes.NewReader(uploaded.Bytes()), &out)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, out.Bytes(), should.Match(target))
+		})
+
+		t.Run("Large delta", func(t *ftt.Test) {
+			target := testutil.MakeZip(map[string]string{
+				"a": randomString(3),
+				"b": randomString(4),
+			})
+
+			res, err := calc(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Plan.Changed, should.Match([]string{"a", "b"}))
+			assert.Loosely(t, res.Ref, should.BeNil)
+			assert.Loosely(t, uploaded.Len(), should.BeZero)
+		})
+	})
+}
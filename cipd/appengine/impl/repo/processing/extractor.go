@@ -81,48 +81,89 @@ func (ex *Extractor) Run(ctx context.Context, path string) (*ExtractionResult, e
 	}
 	defer reader.Close() // we don't care about errors here
 
+	// Copy in 2 Mb chunks, calculating digests on the fly.
+	//
+	// We use fullReader to make sure we write full 2 Mb chunks to GS. Otherwise
+	// 'reader' uses 32 Kb buffers and they are flushed as 32 Kb buffers to Google
+	// Storage too (which doesn't work). Remember, in Go an io.Reader can choose
+	// to read less than asked and zip readers use 32 Kb buffers. CopyBuffer just
+	// sends them to the writer right away.
+	//
+	// Note that reads from Google Storage are already properly buffered by
+	// PackageReader implementation, so it's OK if the zip reader reads small
+	// chunks from the underlying file reader. We basically read 512 Kb buffer
+	// from GS, then unzip it in memory via small 32 Kb chunks into 2 Mb output
+	// buffer, and then flush it to GS.
+	extractedRef, err := uploadToCAS(ctx, &casUpload{
+		CAS:         ex.CAS,
+		PrimaryHash: ex.PrimaryHash,
+		Hashes:      hashes,
+		Uploader:    ex.Uploader,
+		BufferSize:  ex.BufferSize,
+	}, fullReader{reader}, size)
+	if err != nil {
+		return nil, err
+	}
+
+	// Success!
+	return &ExtractionResult{
+		Path:   path,
+		Ref:    extractedRef,
+		Size:   size,
+		Hashes: hashes,
+	}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// casUpload describes how to upload a blob to the CAS.
+type casUpload struct {
+	// CAS is the destination CAS implementation.
+	CAS cas.StorageServer
+	// PrimaryHash is the hash algorithm to use to name the blob in the CAS.
+	PrimaryHash api.HashAlgo
+	// Hashes are all hashes to calculate, must include PrimaryHash.
+	Hashes map[api.HashAlgo]hash.Hash
+	// Uploader is a factory of GS uploaders or nil to use gsUploader.
+	Uploader func(ctx context.Context, size int64, uploadURL string) io.Writer
+	// BufferSize is size of the buffer for GS uploads (default is 2 Mb).
+	BufferSize int
+}
+
+// uploadToCAS copies 'size' bytes from 'src' into a new CAS object.
+//
+// Returns a reference to the uploaded object.
+func uploadToCAS(ctx context.Context, up *casUpload, src io.Reader, size int64) (*api.ObjectRef, error) {
 	// Start writing the result to CAS.
-	op, err := ex.CAS.BeginUpload(ctx, &api.BeginUploadRequest{
-		HashAlgo: ex.PrimaryHash,
+	op, err := up.CAS.BeginUpload(ctx, &api.BeginUploadRequest{
+		HashAlgo: up.PrimaryHash,
 	})
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to open a CAS upload").Tag(transient.Tag).Err()
 	}
 
 	// Grab an io.Writer that uploads to Google Storage.
-	factory := ex.Uploader
+	factory := up.Uploader
 	if factory == nil {
 		factory = gsUploader
 	}
 	uploader := factory(ctx, size, op.UploadUrl)
 
 	// Copy in 2 Mb chunks by default.
-	bufferSize := ex.BufferSize
+	bufferSize := up.BufferSize
 	if bufferSize == 0 {
 		bufferSize = 2 * 1024 * 1024
 	}
 
 	// Copy, calculating digests on the fly.
-	//
-	// We use fullReader to make sure we write full 2 Mb chunks to GS. Otherwise
-	// 'reader' uses 32 Kb buffers and they are flushed as 32 Kb buffers to Google
-	// Storage too (which doesn't work). Remember, in Go an io.Reader can choose
-	// to read less than asked and zip readers use 32 Kb buffers. CopyBuffer just
-	// sends them to the writer right away.
-	//
-	// Note that reads from Google Storage are already properly buffered by
-	// PackageReader implementation, so it's OK if the zip reader reads small
-	// chunks from the underlying file reader. We basically read 512 Kb buffer
-	// from GS, then unzip it in memory via small 32 Kb chunks into 2 Mb output
-	// buffer, and then flush it to GS.
-	writeTo := make([]io.Writer, 0, 1+len(hashes))
+	writeTo := make([]io.Writer, 0, 1+len(up.Hashes))
 	writeTo = append(writeTo, uploader)
-	for _, hash := range hashes {
+	for _, hash := range up.Hashes {
 		writeTo = append(writeTo, hash)
 	}
 	copied, err := io.CopyBuffer(
 		io.MultiWriter(writeTo...),
-		fullReader{reader},
+		src,
 		make([]byte, bufferSize))
 	if err == nil && copied != size {
 		err = errors.Reason("unexpected file size: expecting %d bytes, read %d bytes", size, copied).Err()
@@ -137,7 +178,7 @@ func (ex *Extractor) Run(ctx context.Context, path string) (*ExtractionResult, e
 	if err != nil {
 		// Best effort cleanup of the upload session. It's not a big deal if this
 		// fails and the upload stays as garbage.
-		_, cancelErr := ex.CAS.CancelUpload(ctx, &api.CancelUploadRequest{
+		_, cancelErr := up.CAS.CancelUpload(ctx, &api.CancelUploadRequest{
 			UploadOperationId: op.OperationId,
 		})
 		if cancelErr != nil {
@@ -148,13 +189,13 @@ func (ex *Extractor) Run(ctx context.Context, path string) (*ExtractionResult, e
 
 	// Skip the hash calculation in CAS by enforcing the hash, we've just
 	// calculated it.
-	extractedRef := &api.ObjectRef{
-		HashAlgo:  ex.PrimaryHash,
-		HexDigest: common.HexDigest(hashes[ex.PrimaryHash]),
+	ref := &api.ObjectRef{
+		HashAlgo:  up.PrimaryHash,
+		HexDigest: common.HexDigest(up.Hashes[up.PrimaryHash]),
 	}
-	op, err = ex.CAS.FinishUpload(ctx, &api.FinishUploadRequest{
+	op, err = up.CAS.FinishUpload(ctx, &api.FinishUploadRequest{
 		UploadOperationId: op.OperationId,
-		ForceHash:         extractedRef,
+		ForceHash:         ref,
 	})
 
 	// CAS should publish the object right away.
@@ -165,17 +206,9 @@ func (ex *Extractor) Run(ctx context.Context, path string) (*ExtractionResult, e
 		return nil, errors.Reason("unexpected upload status from CAS %s: %s", op.Status, op.ErrorMessage).Err()
 	}
 
-	// Success!
-	return &ExtractionResult{
-		Path:   path,
-		Ref:    extractedRef,
-		Size:   size,
-		Hashes: hashes,
-	}, nil
+	return ref, nil
 }
 
-////////////////////////////////////////////////////////////////////////////////
-
 func gsUploader(ctx context.Context, size int64, uploadURL string) io.Writer {
 	// Authentication is handled through the tokens in the upload session URL.
 	tr, err := auth.GetRPCTransport(ctx, auth.NoAuth)
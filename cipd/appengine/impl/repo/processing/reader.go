@@ -15,10 +15,12 @@
 package processing
 
 import (
+	"fmt"
 	"io"
 	"math"
 
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/errors"
 )
@@ -42,6 +44,7 @@ func NewPackageReader(r io.ReaderAt, size int64) (*PackageReader, error) {
 		// in case of transient Google Storage errors.
 		return nil, err
 	}
+	zr.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	return &PackageReader{zr}, nil
 }
 
@@ -54,6 +57,36 @@ func (p *PackageReader) Files() []string {
 	return files
 }
 
+// CompressionMethods returns names of compression methods used by files inside
+// the package, e.g. "store", "deflate" or "zstd".
+//
+// Each method is listed once, in order of the first appearance.
+func (p *PackageReader) CompressionMethods() []string {
+	var out []string
+	seen := map[uint16]bool{}
+	for _, f := range p.zr.File {
+		if !seen[f.Method] {
+			seen[f.Method] = true
+			out = append(out, compressionMethodName(f.Method))
+		}
+	}
+	return out
+}
+
+// compressionMethodName returns a human readable name of a zip compression
+// method.
+func compressionMethodName(m uint16) string {
+	switch m {
+	case zip.Store:
+		return "store"
+	case zip.Deflate:
+		return "deflate"
+	case zstd.ZipMethodWinZip:
+		return "zstd"
+	}
+	return fmt.Sprintf("method-%d", m)
+}
+
 // Open opens some file inside the package for reading.
 //
 // Returns the ReadCloser and the uncompressed file size.
@@ -0,0 +1,558 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/common"
+)
+
+const (
+	// SBOMExtractorProcID is identifier of SBOMExtractor processor.
+	SBOMExtractorProcID = "sbom:v1"
+
+	// SBOMMetadataKey is the instance metadata key with the SPDX document.
+	SBOMMetadataKey = "sbom"
+
+	// SBOMContentType is the content type of the SBOM metadata entry.
+	SBOMContentType = "application/spdx+json"
+)
+
+// maxManifestSize is the maximum size of a manifest file the SBOM extractor
+// would read. Larger files are skipped.
+const maxManifestSize = 8 * 1024 * 1024
+
+// SBOMExtractor is a processor that looks for known package manifests and
+// license files inside the package and attaches an SPDX SBOM document to the
+// instance as SBOMMetadataKey metadata.
+//
+// The extraction is best-effort: fatal errors are recorded in the result (see
+// SBOMExtractorResult.Error) instead of marking the instance as broken.
+//
+// Recognizes:
+//   - Go module lists: go.mod and vendor/modules.txt.
+//   - Python wheels metadata: *.dist-info/METADATA.
+//   - Debian package database: var/lib/dpkg/status.
+//   - License files: LICENSE, COPYING, NOTICE etc.
+type SBOMExtractor struct{}
+
+// SBOMComponent is a third party component found inside a package.
+type SBOMComponent struct {
+	Type              string `json:"type"`                         // "golang", "pypi" or "deb"
+	Name              string `json:"name"`                         // e.g. "golang.org/x/net"
+	Version           string `json:"version,omitempty"`            // e.g. "v0.1.0"
+	License           string `json:"license,omitempty"`            // a free form license name, if known
+	LicenseExpression string `json:"license_expression,omitempty"` // an SPDX license expression, if known
+	Source            string `json:"source"`                       // a file inside the package it was found in
+}
+
+// PURL returns a package URL identifying the component.
+func (c *SBOMComponent) PURL() string {
+	purl := fmt.Sprintf("pkg:%s/%s", c.Type, c.Name)
+	if c.Version != "" {
+		purl += "@" + url.PathEscape(c.Version)
+	}
+	return purl
+}
+
+// SBOMExtractorResult is stored as JSON in model.ProcessingResult.
+type SBOMExtractorResult struct {
+	Components   []SBOMComponent `json:"components"`      // sorted by (type, name, version, source)
+	LicenseFiles []string        `json:"license_files"`   // sorted
+	Attached     bool            `json:"attached"`        // false if the SBOM is too large or on errors
+	Error        string          `json:"error,omitempty"` // a fatal extraction error, if any
+}
+
+// ID is part of Processor interface.
+func (e *SBOMExtractor) ID() string {
+	return SBOMExtractorProcID
+}
+
+// Applicable is part of Processor interface.
+func (e *SBOMExtractor) Applicable(ctx context.Context, inst *model.Instance) (bool, error) {
+	return true, nil
+}
+
+// Run is part of Processor interface.
+func (e *SBOMExtractor) Run(ctx context.Context, inst *model.Instance, pkg *PackageReader) (res Result, err error) {
+	var result SBOMExtractorResult
+
+	// Record fatal errors in the result, since a broken SBOM should not make the
+	// whole instance unusable. Return transient errors as is to retry the task.
+	defer func() {
+		if err != nil && !transient.Tag.In(err) {
+			logging.Warningf(ctx, "SBOM extraction failed: %s", err)
+			result.Error = err.Error()
+			res.Result = result
+			err = nil
+		}
+	}()
+
+	var components []SBOMComponent
+	licenses := map[string]string{} // file path => its SHA1
+
+	for _, f := range pkg.Files() {
+		if strings.HasPrefix(f, ".cipdpkg/") {
+			continue
+		}
+
+		var parse func(src string, body []byte) []SBOMComponent
+		switch {
+		case path.Base(f) == "go.mod":
+			parse = parseGoMod
+		case f == "vendor/modules.txt" || strings.HasSuffix(f, "/vendor/modules.txt"):
+			parse = parseGoModulesTxt
+		case path.Base(f) == "METADATA" && strings.HasSuffix(path.Dir(f), ".dist-info"):
+			parse = parseWheelMetadata
+		case f == "var/lib/dpkg/status" || strings.HasSuffix(f, "/var/lib/dpkg/status"):
+			parse = parseDpkgStatus
+		case !isLicenseFile(f):
+			continue
+		}
+
+		var body []byte
+		switch body, err = readPackageFile(pkg, f); {
+		case err != nil:
+			return
+		case body == nil:
+			logging.Warningf(ctx, "Skipping %q: it is too large", f)
+			continue
+		}
+
+		if parse != nil {
+			components = append(components, parse(f, body)...)
+		} else {
+			digest := sha1.Sum(body)
+			licenses[f] = hex.EncodeToString(digest[:])
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		l, r := components[i], components[j]
+		if l.Type != r.Type {
+			return l.Type < r.Type
+		}
+		if l.Name != r.Name {
+			return l.Name < r.Name
+		}
+		if l.Version != r.Version {
+			return l.Version < r.Version
+		}
+		return l.Source < r.Source
+	})
+	licenseFiles := make([]string, 0, len(licenses))
+	for f := range licenses {
+		licenseFiles = append(licenseFiles, f)
+	}
+	sort.Strings(licenseFiles)
+
+	result.Components = components
+	result.LicenseFiles = licenseFiles
+
+	doc, err := json.Marshal(buildSPDX(inst, components, licenseFiles, licenses))
+	if err != nil {
+		err = errors.Annotate(err, "failed to serialize the SBOM").Err()
+		return
+	}
+
+	if len(doc) > common.MetadataMaxLen {
+		logging.Warningf(ctx, "The SBOM is too large to be attached (%d bytes)", len(doc))
+	} else {
+		// Use a copy, since AttachProcessingMetadata updates the entity in place.
+		err = model.AttachProcessingMetadata(ctx, &model.Instance{
+			InstanceID: inst.InstanceID,
+			Package:    inst.Package,
+		}, []*api.InstanceMetadata{
+			{
+				Key:         SBOMMetadataKey,
+				Value:       doc,
+				ContentType: SBOMContentType,
+			},
+		})
+		if err != nil {
+			err = errors.Annotate(err, "failed to attach the SBOM").Err()
+			return
+		}
+		result.Attached = true
+	}
+
+	logging.Infof(ctx, "Found %d components and %d license files in %s",
+		len(components), len(licenseFiles), inst.Package.StringID())
+
+	res.Result = result
+	return
+}
+
+// GetSBOMExtractorResult returns results of SBOMExtractor.
+//
+// Returns:
+//
+//	(result, nil) on success.
+//	(nil, datastore.ErrNoSuchEntity) if results are not available.
+//	(nil, transient-tagged error) on retrieval errors.
+//	(nil, non-transient-tagged error) if the extractor failed.
+func GetSBOMExtractorResult(ctx context.Context, inst *model.Instance) (*SBOMExtractorResult, error) {
+	r := &model.ProcessingResult{
+		ProcID:   SBOMExtractorProcID,
+		Instance: datastore.KeyForObj(ctx, inst),
+	}
+	switch err := datastore.Get(ctx, r); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, err
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	case !r.Success:
+		return nil, errors.Reason("SBOM extraction failed: %s", r.Error).Err()
+	}
+	out := &SBOMExtractorResult{}
+	if err := r.ReadResult(out); err != nil {
+		return nil, errors.Annotate(err, "failed to parse the extractor result").Err()
+	}
+	return out, nil
+}
+
+// readPackageFile reads a file from the package.
+//
+// Returns nil if the file is larger than maxManifestSize.
+func readPackageFile(pkg *PackageReader, name string) ([]byte, error) {
+	r, size, err := pkg.Open(name)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to open %q", name).Err()
+	}
+	defer func() { _ = r.Close() }()
+	if size > maxManifestSize {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read %q", name).Err()
+	}
+	return body, nil
+}
+
+// isLicenseFile returns true if the file looks like a license file.
+func isLicenseFile(f string) bool {
+	name := strings.ToLower(path.Base(f))
+	for _, ext := range []string{".txt", ".md", ".rst"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	switch name {
+	case "license", "licence", "copying", "notice", "unlicense":
+		return true
+	}
+	return strings.HasPrefix(name, "license-") || strings.HasPrefix(name, "license_")
+}
+
+// lines calls the callback for each line in the body, with the trailing
+// whitespace trimmed.
+func lines(body []byte, cb func(line string)) {
+	s := bufio.NewScanner(bytes.NewReader(body))
+	s.Buffer(nil, maxManifestSize)
+	for s.Scan() {
+		cb(strings.TrimRight(s.Text(), " \t\r"))
+	}
+}
+
+// parseGoMod extracts requirements from a go.mod file.
+func parseGoMod(src string, body []byte) (out []SBOMComponent) {
+	inBlock := false
+	lines(body, func(line string) {
+		if idx := strings.Index(line, "//"); idx != -1 {
+			line = line[:idx]
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			return
+		case inBlock && fields[0] == ")":
+			inBlock = false
+			return
+		case !inBlock && fields[0] == "require":
+			if len(fields) == 2 && fields[1] == "(" {
+				inBlock = true
+				return
+			}
+			fields = fields[1:]
+		case !inBlock:
+			return
+		}
+		if len(fields) == 2 {
+			out = append(out, SBOMComponent{
+				Type:    "golang",
+				Name:    strings.Trim(fields[0], `"`),
+				Version: fields[1],
+				Source:  src,
+			})
+		}
+	})
+	return
+}
+
+// parseGoModulesTxt extracts modules from a vendor/modules.txt file.
+//
+// Modules there are listed as "# <module> <version> [=> <replacement>]".
+func parseGoModulesTxt(src string, body []byte) (out []SBOMComponent) {
+	lines(body, func(line string) {
+		fields := strings.Fields(line)
+		if len(fields) >= 3 && fields[0] == "#" {
+			out = append(out, SBOMComponent{
+				Type:    "golang",
+				Name:    fields[1],
+				Version: fields[2],
+				Source:  src,
+			})
+		}
+	})
+	return
+}
+
+// parseWheelMetadata extracts the Python distribution name, version and
+// license from *.dist-info/METADATA file.
+func parseWheelMetadata(src string, body []byte) []SBOMComponent {
+	c := SBOMComponent{Type: "pypi", Source: src}
+	headers := true
+	lines(body, func(line string) {
+		if line == "" {
+			headers = false // the rest is the description
+		}
+		if !headers {
+			return
+		}
+		key, val, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(key, " ") || strings.HasPrefix(key, "\t") {
+			return // a continuation line
+		}
+		val = strings.TrimSpace(val)
+		switch key {
+		case "Name":
+			c.Name = val
+		case "Version":
+			c.Version = val
+		case "License":
+			c.License = val
+		case "License-Expression":
+			c.LicenseExpression = val
+		}
+	})
+	if c.Name == "" {
+		return nil
+	}
+	return []SBOMComponent{c}
+}
+
+// parseDpkgStatus extracts installed packages from var/lib/dpkg/status.
+func parseDpkgStatus(src string, body []byte) (out []SBOMComponent) {
+	var c SBOMComponent
+	installed := true
+	flush := func() {
+		if c.Name != "" && installed {
+			c.Type = "deb"
+			c.Source = src
+			out = append(out, c)
+		}
+		c = SBOMComponent{}
+		installed = true
+	}
+	lines(body, func(line string) {
+		if line == "" {
+			flush()
+			return
+		}
+		key, val, ok := strings.Cut(line, ":")
+		if !ok || strings.HasPrefix(key, " ") || strings.HasPrefix(key, "\t") {
+			return // a continuation line
+		}
+		val = strings.TrimSpace(val)
+		switch key {
+		case "Package":
+			c.Name = val
+		case "Version":
+			c.Version = val
+		case "Status":
+			installed = strings.HasSuffix(val, " installed")
+		}
+	})
+	flush()
+	return
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// SPDX 2.3 JSON document.
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Files             []spdxFile         `json:"files,omitempty"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	LicenseComments  string            `json:"licenseComments,omitempty"`
+	CopyrightText    string            `json:"copyrightText"`
+	SourceInfo       string            `json:"sourceInfo,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxFile struct {
+	SPDXID           string         `json:"SPDXID"`
+	FileName         string         `json:"fileName"`
+	Checksums        []spdxChecksum `json:"checksums"`
+	LicenseConcluded string         `json:"licenseConcluded"`
+	CopyrightText    string         `json:"copyrightText"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+// spdxNoAssertion is used in SPDX fields we have no information about.
+const spdxNoAssertion = "NOASSERTION"
+
+// buildSPDX builds an SPDX document describing the instance.
+//
+// The output depends only on its inputs (in particular it uses the instance
+// registration time as the document creation time), so that retries of the
+// processor produce identical metadata.
+func buildSPDX(inst *model.Instance, components []SBOMComponent, licenseFiles []string, digests map[string]string) *spdxDocument {
+	pkg := inst.Package.StringID()
+	doc := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              fmt.Sprintf("%s@%s", pkg, inst.InstanceID),
+		DocumentNamespace: fmt.Sprintf("urn:cipd:sbom:%s:%s", url.PathEscape(pkg), inst.InstanceID),
+		CreationInfo: spdxCreationInfo{
+			Created:  inst.RegisteredTs.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: cipd-" + SBOMExtractorProcID},
+		},
+		Packages: []spdxPackage{
+			{
+				SPDXID:           "SPDXRef-Package",
+				Name:             pkg,
+				VersionInfo:      inst.InstanceID,
+				DownloadLocation: spdxNoAssertion,
+				LicenseConcluded: spdxNoAssertion,
+				LicenseDeclared:  spdxNoAssertion,
+				CopyrightText:    spdxNoAssertion,
+			},
+		},
+		Relationships: []spdxRelationship{
+			{
+				SPDXElementID:      "SPDXRef-DOCUMENT",
+				RelationshipType:   "DESCRIBES",
+				RelatedSPDXElement: "SPDXRef-Package",
+			},
+		},
+	}
+
+	for i, c := range components {
+		p := spdxPackage{
+			SPDXID:           fmt.Sprintf("SPDXRef-Component-%d", i+1),
+			Name:             c.Name,
+			VersionInfo:      c.Version,
+			DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion,
+			LicenseDeclared:  spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+			SourceInfo:       "found in " + c.Source,
+			ExternalRefs: []spdxExternalRef{
+				{
+					ReferenceCategory: "PACKAGE-MANAGER",
+					ReferenceType:     "purl",
+					ReferenceLocator:  c.PURL(),
+				},
+			},
+		}
+		if c.LicenseExpression != "" {
+			p.LicenseDeclared = c.LicenseExpression
+		} else if c.License != "" {
+			p.LicenseComments = "Declared license: " + c.License
+		}
+		doc.Packages = append(doc.Packages, p)
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      "SPDXRef-Package",
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: p.SPDXID,
+		})
+	}
+
+	for i, f := range licenseFiles {
+		id := fmt.Sprintf("SPDXRef-File-%d", i+1)
+		doc.Files = append(doc.Files, spdxFile{
+			SPDXID:           id,
+			FileName:         "./" + f,
+			Checksums:        []spdxChecksum{{Algorithm: "SHA1", ChecksumValue: digests[f]}},
+			LicenseConcluded: spdxNoAssertion,
+			CopyrightText:    spdxNoAssertion,
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      "SPDXRef-Package",
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: id,
+		})
+	}
+
+	return doc
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+)
+
+const testGoMod = `module example.com/tool
+
+go 1.22
+
+require golang.org/x/sys v0.20.0
+
+require (
+	github.com/google/uuid v1.6.0
+	golang.org/x/net v0.25.0 // indirect
+)
+
+replace (
+	golang.org/x/net => ../net
+)
+`
+
+const testModulesTxt = `# github.com/google/uuid v1.6.0
+## explicit; go 1.12
+github.com/google/uuid
+# golang.org/x/net v0.25.0 => ../net
+## explicit
+golang.org/x/net/http2
+`
+
+const testWheelMetadata = `Metadata-Version: 2.4
+Name: requests
+Version: 2.32.3
+License-Expression: Apache-2.0
+Classifier: Programming Language :: Python
+
+Name: not-a-header
+`
+
+const testLegacyWheelMetadata = `Metadata-Version: 2.1
+Name: six
+Version: 1.16.0
+License: MIT License
+	that continues here
+`
+
+const testDpkgStatus = `Package: libc6
+Status: install ok installed
+Version: 2.36-9
+Description: GNU C Library
+ with a continuation: line
+
+Package: removed
+Status: deinstall ok config-files
+Version: 1.0
+
+Package: zlib1g
+Status: install ok installed
+Version: 1:1.2.13+dfsg-1
+`
+
+func TestSBOMExtractor(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With datastore", t, func(t *ftt.Test) {
+		ctx := memory.Use(context.Background())
+		datastore.GetTestable(ctx).AutoIndex(true)
+		inst := instance(ctx, "some/pkg", api.HashAlgo_SHA256)
+		inst.RegisteredTs = testutil.TestTime
+		inst.ProcessorsPending = []string{SBOMExtractorProcID}
+		assert.Loosely(t, datastore.Put(ctx, &model.Package{Name: "some/pkg"}, inst), should.BeNil)
+
+		proc := &SBOMExtractor{}
+
+		// Returns SPDX document attached to the instance.
+		attached := func() (docs []map[string]any) {
+			md, err := model.ListMetadata(ctx, inst)
+			assert.Loosely(t, err, should.BeNil)
+			for _, m := range md {
+				assert.Loosely(t, m.Key, should.Equal(SBOMMetadataKey))
+				assert.Loosely(t, m.ContentType, should.Equal(SBOMContentType))
+				var doc map[string]any
+				assert.Loosely(t, json.Unmarshal(m.Value, &doc), should.BeNil)
+				docs = append(docs, doc)
+			}
+			return
+		}
+
+		t.Run("Applicable", func(t *ftt.Test) {
+			yes, err := proc.Applicable(ctx, inst)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, yes, should.BeTrue)
+		})
+
+		t.Run("Finds components", func(t *ftt.Test) {
+			pkg := packageReader(map[string]string{
+				".cipdpkg/manifest.json":                    "{}",
+				"go.mod":                                    testGoMod,
+				"vendor/modules.txt":                        testModulesTxt,
+				"py/requests-2.32.3.dist-info/METADATA":     testWheelMetadata,
+				"py/six-1.16.0.dist-info/METADATA":          testLegacyWheelMetadata,
+				"sysroot/var/lib/dpkg/status":               testDpkgStatus,
+				"LICENSE":                                   "license text",
+				"py/requests-2.32.3.dist-info/LICENSE.txt":  "another license text",
+				"bin/tool":                                  "binary",
+				"py/requests-2.32.3.dist-info/RECORD":       "",
+				"py/requests-2.32.3.dist-info/license.html": "not a license file",
+			})
+
+			res, err := proc.Run(ctx, inst, pkg)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Err, should.BeNil)
+			assert.Loosely(t, res.Result, should.Match(SBOMExtractorResult{
+				Components: []SBOMComponent{
+					{Type: "deb", Name: "libc6", Version: "2.36-9", Source: "sysroot/var/lib/dpkg/status"},
+					{Type: "deb", Name: "zlib1g", Version: "1:1.2.13+dfsg-1", Source: "sysroot/var/lib/dpkg/status"},
+					{Type: "golang", Name: "github.com/google/uuid", Version: "v1.6.0", Source: "go.mod"},
+					{Type: "golang", Name: "github.com/google/uuid", Version: "v1.6.0", Source: "vendor/modules.txt"},
+					{Type: "golang", Name: "golang.org/x/net", Version: "v0.25.0", Source: "go.mod"},
+					{Type: "golang", Name: "golang.org/x/net", Version: "v0.25.0", Source: "vendor/modules.txt"},
+					{Type: "golang", Name: "golang.org/x/sys", Version: "v0.20.0", Source: "go.mod"},
+					{
+						Type:              "pypi",
+						Name:              "requests",
+						Version:           "2.32.3",
+						LicenseExpression: "Apache-2.0",
+						Source:            "py/requests-2.32.3.dist-info/METADATA",
+					},
+					{
+						Type:    "pypi",
+						Name:    "six",
+						Version: "1.16.0",
+						License: "MIT License",
+						Source:  "py/six-1.16.0.dist-info/METADATA",
+					},
+				},
+				LicenseFiles: []string{
+					"LICENSE",
+					"py/requests-2.32.3.dist-info/LICENSE.txt",
+				},
+				Attached: true,
+			}))
+
+			docs := attached()
+			assert.Loosely(t, docs, should.HaveLength(1))
+			doc := docs[0]
+			assert.Loosely(t, doc["spdxVersion"], should.Equal("SPDX-2.3"))
+			assert.Loosely(t, doc["creationInfo"].(map[string]any)["created"], should.Equal(
+				testutil.TestTime.UTC().Format("2006-01-02T15:04:05Z07:00")))
+			assert.Loosely(t, doc["packages"], should.HaveLength(10))
+			assert.Loosely(t, doc["files"], should.HaveLength(2))
+			assert.Loosely(t, doc["relationships"], should.HaveLength(12))
+
+			requests := doc["packages"].([]any)[8].(map[string]any)
+			assert.Loosely(t, requests["name"], should.Equal("requests"))
+			assert.Loosely(t, requests["licenseDeclared"], should.Equal("Apache-2.0"))
+			assert.Loosely(t, requests["externalRefs"].([]any)[0].(map[string]any)["referenceLocator"],
+				should.Equal("pkg:pypi/requests@2.32.3"))
+
+			t.Run("Idempotent", func(t *ftt.Test) {
+				again, err := proc.Run(ctx, inst, pkg)
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, again, should.Match(res))
+				assert.Loosely(t, attached(), should.HaveLength(1))
+			})
+
+			t.Run("GetSBOMExtractorResult", func(t *ftt.Test) {
+				stored := &model.ProcessingResult{
+					ProcID:   SBOMExtractorProcID,
+					Instance: datastore.KeyForObj(ctx, inst),
+					Success:  true,
+				}
+				assert.Loosely(t, stored.WriteResult(res.Result), should.BeNil)
+				assert.Loosely(t, datastore.Put(ctx, stored), should.BeNil)
+
+				out, err := GetSBOMExtractorResult(ctx, inst)
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, out, should.Match(&SBOMExtractorResult{
+					Components:   res.Result.(SBOMExtractorResult).Components,
+					LicenseFiles: res.Result.(SBOMExtractorResult).LicenseFiles,
+					Attached:     true,
+				}))
+			})
+		})
+
+		t.Run("Empty package", func(t *ftt.Test) {
+			res, err := proc.Run(ctx, inst, packageReader(map[string]string{
+				"file": "body",
+			}))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Err, should.BeNil)
+			assert.Loosely(t, res.Result, should.Match(SBOMExtractorResult{
+				LicenseFiles: []string{},
+				Attached:     true,
+			}))
+			docs := attached()
+			assert.Loosely(t, docs, should.HaveLength(1))
+			assert.Loosely(t, docs[0]["packages"], should.HaveLength(1))
+		})
+
+		t.Run("Missing instance", func(t *ftt.Test) {
+			res, err := proc.Run(ctx, instance(ctx, "another/pkg", api.HashAlgo_SHA256), packageReader(map[string]string{
+				"file": "body",
+			}))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, res.Err, should.BeNil)
+			assert.Loosely(t, res.Result.(SBOMExtractorResult).Attached, should.BeFalse)
+			assert.Loosely(t, res.Result.(SBOMExtractorResult).Error, should.ContainSubstring("failed to attach the SBOM"))
+		})
+
+		t.Run("No results", func(t *ftt.Test) {
+			_, err := GetSBOMExtractorResult(ctx, inst)
+			assert.Loosely(t, err, should.Equal(datastore.ErrNoSuchEntity))
+		})
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"sort"
 	"strings"
@@ -68,6 +69,8 @@ func Public(internalCAS cas.StorageServer, d *tq.Dispatcher) Server {
 	impl.registerTasks()
 	impl.registerProcessor(&processing.ClientExtractor{CAS: internalCAS})
 	impl.registerProcessor(&processing.BootstrapPackageExtractor{CAS: internalCAS})
+	impl.registerProcessor(&processing.CompressionInspector{})
+	impl.registerProcessor(&processing.SBOMExtractor{})
 	return impl
 }
 
@@ -92,6 +95,9 @@ type repoImpl struct {
 
 	procs    []processing.Processor          // in order of registerProcessor calls
 	procsMap map[string]processing.Processor // ID => processing.Processor
+
+	// Used to upload deltas to GS, mocked in tests. See DeltaCalculator.
+	deltaUploader func(ctx context.Context, size int64, uploadURL string) io.Writer
 }
 
 // registerTasks adds tasks to the tq Dispatcher.
@@ -106,6 +112,17 @@ func (impl *repoImpl) registerTasks() {
 			return impl.runProcessorsTask(ctx, m.(*tasks.RunProcessors))
 		},
 	})
+
+	// See queue.yaml for "calculate-delta" task queue definition.
+	impl.tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "calculate-delta",
+		Prototype: &tasks.CalculateDelta{},
+		Kind:      tq.Transactional,
+		Queue:     "calculate-delta",
+		Handler: func(ctx context.Context, m proto.Message) error {
+			return impl.calculateDeltaTask(ctx, m.(*tasks.CalculateDelta))
+		},
+	})
 }
 
 // registerProcessor adds a new processor.
@@ -1353,6 +1370,12 @@ func (impl *repoImpl) GetInstanceURL(ctx context.Context, r *api.GetInstanceURLR
 		return nil, err
 	}
 
+	// Old clients can't unpack packages that use new compression methods. Tell
+	// them to update instead of letting them fail in a confusing way.
+	if err := checkClientCanUnpack(ctx, inst); err != nil {
+		return nil, err
+	}
+
 	// Ask CAS generate an URL for us. Note that CAS does caching internally.
 	return impl.cas.GetObjectURL(ctx, &api.GetObjectURLRequest{
 		Object: r.Instance,
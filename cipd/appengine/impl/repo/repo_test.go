@@ -15,9 +15,11 @@
 package repo
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -28,6 +30,7 @@ import (
 	"github.com/julienschmidt/httprouter"
 	statuspb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -50,6 +53,7 @@ import (
 	"go.chromium.org/luci/cipd/appengine/impl/repo/processing"
 	"go.chromium.org/luci/cipd/appengine/impl/repo/tasks"
 	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/client/cipd/delta"
 	"go.chromium.org/luci/cipd/common"
 
 	// Using transactional datastore TQ tasks.
@@ -1254,6 +1258,38 @@ func TestProcessors(t *testing.T) {
 			// Failed.
 			assert.Loosely(t, fetchProcFail("proc"), should.Equal("boom"))
 		})
+
+		t.Run("runProcessorsTask with broken SBOM", func(t *ftt.Test) {
+			impl.registerProcessor(&processing.SBOMExtractor{})
+			storeInstance([]string{processing.SBOMExtractorProcID})
+
+			// Corrupt the body of the license file to make the extractor fail.
+			brokenZip := testutil.MakeZip(map[string]string{
+				"LICENSE": strings.Repeat("license text", 100),
+			})
+			idx := bytes.Index(brokenZip, []byte("LICENSE")) + len("LICENSE")
+			brokenZip[idx+5] ^= 0xff
+
+			cas.GetReaderImpl = func(_ context.Context, ref *api.ObjectRef) (gs.Reader, error) {
+				return testutil.NewMockGSReader(brokenZip), nil
+			}
+
+			err := impl.runProcessorsTask(ctx, &tasks.RunProcessors{Instance: inst})
+			assert.Loosely(t, err, should.BeNil)
+
+			// The instance is ready regardless.
+			inst := fetchInstance()
+			assert.Loosely(t, inst.CheckReady(), should.BeNil)
+			assert.Loosely(t, inst.ProcessorsSuccess, should.Match([]string{processing.SBOMExtractorProcID}))
+
+			// The error is recorded in the result.
+			res := fetchProcRes(processing.SBOMExtractorProcID)
+			assert.Loosely(t, res.Success, should.BeTrue)
+			var out processing.SBOMExtractorResult
+			assert.Loosely(t, res.ReadResult(&out), should.BeNil)
+			assert.Loosely(t, out.Attached, should.BeFalse)
+			assert.Loosely(t, out.Error, should.ContainSubstring(`failed to read "LICENSE"`))
+		})
 	})
 }
 
@@ -2647,6 +2683,60 @@ func TestGetInstanceURLAndDownloads(t *testing.T) {
 				assert.Loosely(t, status.Code(err), should.Equal(codes.NotFound))
 				assert.Loosely(t, err, should.ErrLike("no such instance"))
 			})
+
+			t.Run("Zstd packages", func(t *ftt.Test) {
+				withUA := func(ua string) context.Context {
+					return metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent", ua))
+				}
+
+				storeMethods := func(methods ...string) {
+					res := &model.ProcessingResult{
+						ProcID:   processing.CompressionInspectorProcID,
+						Instance: datastore.KeyForObj(ctx, inst),
+						Success:  true,
+					}
+					assert.Loosely(t, res.WriteResult(processing.CompressionInspectorResult{
+						Methods: methods,
+					}), should.BeNil)
+					assert.Loosely(t, datastore.Put(ctx, res), should.BeNil)
+				}
+
+				call := func(ctx context.Context) error {
+					_, err := impl.GetInstanceURL(ctx, &api.GetInstanceURLRequest{
+						Package:  inst.Package.StringID(),
+						Instance: inst.Proto().Instance,
+					})
+					return err
+				}
+
+				t.Run("Old client", func(t *ftt.Test) {
+					storeMethods("deflate", "zstd")
+					err := call(withUA("prefix/cipd 2.7.3 (linux-amd64)"))
+					assert.Loosely(t, status.Code(err), should.Equal(codes.FailedPrecondition))
+					assert.Loosely(t, err, should.ErrLike("not supported by CIPD client v2.7.3, please update the client to v2.8.0 or newer"))
+				})
+
+				t.Run("New client", func(t *ftt.Test) {
+					storeMethods("deflate", "zstd")
+					assert.Loosely(t, call(withUA("cipd 2.8.0 (linux-amd64)")), should.BeNil)
+					assert.Loosely(t, call(withUA("cipd 3.0.0")), should.BeNil)
+				})
+
+				t.Run("Not a CIPD client", func(t *ftt.Test) {
+					storeMethods("deflate", "zstd")
+					assert.Loosely(t, call(withUA("Mozilla/5.0")), should.BeNil)
+					assert.Loosely(t, call(ctx), should.BeNil)
+				})
+
+				t.Run("Old client and deflate package", func(t *ftt.Test) {
+					storeMethods("deflate")
+					assert.Loosely(t, call(withUA("cipd 2.7.0")), should.BeNil)
+				})
+
+				t.Run("Old client and uninspected package", func(t *ftt.Test) {
+					assert.Loosely(t, call(withUA("cipd 2.7.0")), should.BeNil)
+				})
+			})
 		})
 
 		t.Run("Raw download handler", func(t *ftt.Test) {
@@ -2708,6 +2798,244 @@ func TestGetInstanceURLAndDownloads(t *testing.T) {
 	})
 }
 
+func TestInstanceDeltas(t *testing.T) {
+	t.Parallel()
+
+	randomString := func(seed int64) string {
+		blob := make([]byte, 64*1024)
+		rand.New(rand.NewSource(seed)).Read(blob)
+		return string(blob)
+	}
+
+	baseZip := testutil.MakeZip(map[string]string{
+		"file1": randomString(1),
+		"file2": randomString(2),
+	})
+	targetZip := testutil.MakeZip(map[string]string{
+		"file1": randomString(1),
+		"file2": "changed",
+		"file3": "added",
+	})
+	unrelatedZip := testutil.MakeZip(map[string]string{
+		"file1": randomString(3),
+		"file2": randomString(4),
+	})
+
+	ftt.Run("With fakes", t, func(t *ftt.Test) {
+		ctx, tc, as := testutil.TestingContext()
+		ctx = as("reader@example.com")
+
+		meta := testutil.MetadataStore{}
+		meta.Populate("a", &api.PrefixMetadata{
+			Acls: []*api.PrefixMetadata_ACL{
+				{
+					Role:       api.Role_READER,
+					Principals: []string{"user:reader@example.com"},
+				},
+			},
+		})
+
+		zips := map[string][]byte{}
+		put := func(id string, body []byte, registered time.Time) *api.ObjectRef {
+			zips[id] = body
+			inst := &model.Instance{
+				InstanceID:   id,
+				Package:      model.PackageKey(ctx, "a/pkg"),
+				RegisteredBy: "user:1@example.com",
+				RegisteredTs: registered,
+			}
+			assert.Loosely(t, datastore.Put(ctx, inst), should.BeNil)
+			return inst.Proto().Instance
+		}
+		assert.Loosely(t, datastore.Put(ctx, &model.Package{Name: "a/pkg"}), should.BeNil)
+
+		base := put(strings.Repeat("1", 40), baseZip, testutil.TestTime)
+		target := put(strings.Repeat("2", 40), targetZip, testutil.TestTime.Add(time.Hour))
+		unrelated := put(strings.Repeat("3", 40), unrelatedZip, testutil.TestTime.Add(2*time.Hour))
+
+		uploaded := map[string][]byte{}
+		var uploading bytes.Buffer
+
+		cas := testutil.MockCAS{
+			GetReaderImpl: func(_ context.Context, ref *api.ObjectRef) (gs.Reader, error) {
+				if body, ok := zips[ref.HexDigest]; ok {
+					return testutil.NewMockGSReader(body), nil
+				}
+				return nil, status.Errorf(codes.NotFound, "no such object")
+			},
+			BeginUploadImpl: func(_ context.Context, r *api.BeginUploadRequest) (*api.UploadOperation, error) {
+				uploading.Reset()
+				return &api.UploadOperation{OperationId: "op_id", UploadUrl: "http://example.com/upload"}, nil
+			},
+			FinishUploadImpl: func(_ context.Context, r *api.FinishUploadRequest) (*api.UploadOperation, error) {
+				uploaded[r.ForceHash.HexDigest] = append([]byte(nil), uploading.Bytes()...)
+				return &api.UploadOperation{Status: api.UploadStatus_PUBLISHED}, nil
+			},
+			GetObjectURLImpl: func(_ context.Context, r *api.GetObjectURLRequest) (*api.ObjectURL, error) {
+				return &api.ObjectURL{SignedUrl: "http://example.com/" + r.Object.HexDigest}, nil
+			},
+		}
+
+		dispatcher := &tq.Dispatcher{}
+		ctx, sched := tq.TestingContext(ctx, dispatcher)
+
+		impl := repoImpl{
+			tq:   dispatcher,
+			meta: &meta,
+			cas:  &cas,
+			deltaUploader: func(context.Context, int64, string) io.Writer {
+				return &uploading
+			},
+		}
+		impl.registerTasks()
+
+		getDelta := func(base, target *api.ObjectRef) (*api.InstanceDelta, error) {
+			return impl.GetInstanceDelta(ctx, &api.GetInstanceDeltaRequest{
+				Package: "a/pkg",
+				Base:    base,
+				Target:  target,
+			})
+		}
+
+		runTasks := func() {
+			for _, task := range sched.Tasks() {
+				assert.Loosely(t, impl.calculateDeltaTask(ctx, task.Payload.(*tasks.CalculateDelta)), should.BeNil)
+			}
+		}
+
+		t.Run("Happy path", func(t *ftt.Test) {
+			// The first call schedules the calculation.
+			resp, err := getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_PENDING))
+			assert.Loosely(t, sched.Tasks(), should.HaveLength(1))
+			assert.Loosely(t, sched.Tasks()[0].Payload, should.Match(&tasks.CalculateDelta{
+				Package: "a/pkg",
+				Base:    base,
+				Target:  target,
+			}))
+
+			// The second call doesn't schedule it again.
+			resp, err = getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_PENDING))
+			assert.Loosely(t, sched.Tasks(), should.HaveLength(1))
+
+			runTasks()
+
+			resp, err = getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_READY))
+			assert.Loosely(t, resp.AddedFiles, should.Match([]string{"file3"}))
+			assert.Loosely(t, resp.ChangedFiles, should.Match([]string{"file2"}))
+			assert.Loosely(t, resp.RemovedFiles, should.HaveLength(0))
+			assert.Loosely(t, resp.DeltaUrl.SignedUrl, should.Equal("http://example.com/"+resp.Delta.HexDigest))
+
+			// The stored delta is usable.
+			blob := uploaded[resp.Delta.HexDigest]
+			assert.Loosely(t, int64(len(blob)), should.Equal(resp.DeltaSize))
+			out := bytes.Buffer{}
+			_, err = delta.Apply(bytes.NewReader(baseZip), bytes.NewReader(blob), &out)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, out.Bytes(), should.Match(targetZip))
+		})
+
+		t.Run("Too large delta", func(t *ftt.Test) {
+			resp, err := getDelta(base, unrelated)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_PENDING))
+
+			runTasks()
+
+			resp, err = getDelta(base, unrelated)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_UNAVAILABLE))
+			assert.Loosely(t, uploaded, should.HaveLength(0))
+		})
+
+		t.Run("Broken instance", func(t *ftt.Test) {
+			zips[target.HexDigest] = []byte("not a zip")
+
+			_, err := getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+
+			runTasks()
+
+			resp, err := getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_UNAVAILABLE))
+
+			ent, err := model.FetchInstanceDelta(ctx, "a/pkg", base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, ent.Error, should.ContainSubstring("opening the target instance"))
+		})
+
+		t.Run("Stuck calculation", func(t *ftt.Test) {
+			_, err := getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+
+			tc.Add(deltaPendingTimeout + time.Minute)
+
+			resp, err := getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_UNAVAILABLE))
+		})
+
+		t.Run("Older target", func(t *ftt.Test) {
+			resp, err := getDelta(target, base)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_UNAVAILABLE))
+			assert.Loosely(t, sched.Tasks(), should.HaveLength(0))
+		})
+
+		t.Run("Too many deltas", func(t *ftt.Test) {
+			for i := 0; i < maxDeltasPerTarget; i++ {
+				other := &api.ObjectRef{HashAlgo: api.HashAlgo_SHA1, HexDigest: fmt.Sprintf("%040d", i)}
+				ent := &model.InstanceDelta{CreatedTs: testutil.TestTime}
+				datastore.PopulateKey(ent, model.InstanceDeltaKey(ctx, "a/pkg", other, unrelated))
+				assert.Loosely(t, datastore.Put(ctx, ent), should.BeNil)
+			}
+
+			resp, err := getDelta(base, unrelated)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_UNAVAILABLE))
+			assert.Loosely(t, sched.Tasks(), should.HaveLength(0))
+
+			// Other targets are unaffected.
+			resp, err = getDelta(base, target)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, resp.Status, should.Equal(api.DeltaStatus_DELTA_PENDING))
+		})
+
+		t.Run("Same instance", func(t *ftt.Test) {
+			_, err := getDelta(base, base)
+			assert.Loosely(t, status.Code(err), should.Equal(codes.InvalidArgument))
+			assert.Loosely(t, err, should.ErrLike("are the same instance"))
+		})
+
+		t.Run("Bad target", func(t *ftt.Test) {
+			_, err := getDelta(base, &api.ObjectRef{HashAlgo: api.HashAlgo_SHA1, HexDigest: "huh"})
+			assert.Loosely(t, status.Code(err), should.Equal(codes.InvalidArgument))
+			assert.Loosely(t, err, should.ErrLike("bad 'target'"))
+		})
+
+		t.Run("No access", func(t *ftt.Test) {
+			_, err := impl.GetInstanceDelta(ctx, &api.GetInstanceDeltaRequest{
+				Package: "b",
+				Base:    base,
+				Target:  target,
+			})
+			assert.Loosely(t, status.Code(err), should.Equal(codes.PermissionDenied))
+		})
+
+		t.Run("Missing instance", func(t *ftt.Test) {
+			_, err := getDelta(base, &api.ObjectRef{HashAlgo: api.HashAlgo_SHA1, HexDigest: strings.Repeat("4", 40)})
+			assert.Loosely(t, status.Code(err), should.Equal(codes.NotFound))
+			assert.Loosely(t, err, should.ErrLike("no such instance"))
+		})
+	})
+}
+
 func TestDescribeInstance(t *testing.T) {
 	t.Parallel()
 
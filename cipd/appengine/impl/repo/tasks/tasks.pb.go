@@ -81,6 +81,67 @@ func (x *RunProcessors) GetInstance() *v1.Instance {
 	return nil
 }
 
+// CalculateDelta task calculates a delta between two instances of a package.
+type CalculateDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       string                 `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"` // the package that holds both instances
+	Base          *v1.ObjectRef          `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`       // the instance to calculate the delta from
+	Target        *v1.ObjectRef          `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`   // the instance to calculate the delta to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateDelta) Reset() {
+	*x = CalculateDelta{}
+	mi := &file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDelta) ProtoMessage() {}
+
+func (x *CalculateDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDelta.ProtoReflect.Descriptor instead.
+func (*CalculateDelta) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CalculateDelta) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *CalculateDelta) GetBase() *v1.ObjectRef {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CalculateDelta) GetTarget() *v1.ObjectRef {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
 var File_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDesc = string([]byte{
@@ -88,18 +149,29 @@ var file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDesc
 	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x70, 0x65,
 	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6c, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f,
 	0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x30, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x12, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x2f, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72,
 	0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63,
 	0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x76, 0x31, 0x2f,
-	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a, 0x0d, 0x52, 0x75,
-	0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x6f, 0x2e, 0x63, 0x68,
+	0x63, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x30, 0x67, 0x6f, 0x2e, 0x63, 0x68,
 	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
-	0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69,
-	0x6d, 0x70, 0x6c, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3b, 0x0a, 0x0d, 0x52,
+	0x75, 0x6e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x78, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x69, 0x70, 0x64,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
+	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6c, 0x2f, 0x72,
+	0x65, 0x70, 0x6f, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 })
 
 var (
@@ -114,18 +186,22 @@ func file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDes
 	return file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_goTypes = []any{
-	(*RunProcessors)(nil), // 0: tasks.RunProcessors
-	(*v1.Instance)(nil),   // 1: cipd.Instance
+	(*RunProcessors)(nil),  // 0: tasks.RunProcessors
+	(*CalculateDelta)(nil), // 1: tasks.CalculateDelta
+	(*v1.Instance)(nil),    // 2: cipd.Instance
+	(*v1.ObjectRef)(nil),   // 3: cipd.ObjectRef
 }
 var file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_depIdxs = []int32{
-	1, // 0: tasks.RunProcessors.instance:type_name -> cipd.Instance
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: tasks.RunProcessors.instance:type_name -> cipd.Instance
+	3, // 1: tasks.CalculateDelta.base:type_name -> cipd.ObjectRef
+	3, // 2: tasks.CalculateDelta.target:type_name -> cipd.ObjectRef
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_init() }
@@ -139,7 +215,7 @@ func file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_init()
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDesc), len(file_go_chromium_org_luci_cipd_appengine_impl_repo_tasks_tasks_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
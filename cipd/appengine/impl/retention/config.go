@@ -0,0 +1,121 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/server/cfgcache"
+	"go.chromium.org/luci/config/validation"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	configpb "go.chromium.org/luci/cipd/api/config/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+var cachedCfg = cfgcache.Register(&cfgcache.Entry{
+	Path: "retention.cfg",
+	Type: (*configpb.RetentionConfigFile)(nil),
+	Validator: func(ctx *validation.Context, msg proto.Message) error {
+		validateConfig(ctx, msg.(*configpb.RetentionConfigFile))
+		return nil
+	},
+})
+
+// ImportConfig is called from a cron to import retention.cfg into datastore.
+func ImportConfig(ctx context.Context) error {
+	_, err := cachedCfg.Update(ctx, nil)
+	if errors.Unwrap(err) == config.ErrNoConfig {
+		logging.Warningf(ctx, "No retention.cfg config file")
+		return nil
+	}
+	return err
+}
+
+// Policies returns all retention policies, in order they are defined in the
+// config.
+func Policies(ctx context.Context) ([]*configpb.RetentionPolicy, error) {
+	cfg, err := cachedCfg.Get(ctx, nil)
+	if err != nil {
+		if errors.Contains(err, datastore.ErrNoSuchEntity) {
+			return nil, nil
+		}
+		return nil, errors.Annotate(err, "failed to fetch the retention config").Tag(transient.Tag).Err()
+	}
+	return cfg.(*configpb.RetentionConfigFile).Policy, nil
+}
+
+// PolicyFor returns a retention policy that applies to the package or nil.
+//
+// Policies are scanned in order. The first one with a matching prefix wins.
+func PolicyFor(ctx context.Context, pkg string) (*configpb.RetentionPolicy, error) {
+	policies, err := Policies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range policies {
+		if matchesPolicy(pkg, p) {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+// matchesPolicy returns true if the given `p` applies to the package.
+func matchesPolicy(pkg string, p *configpb.RetentionPolicy) bool {
+	return p.Prefix == pkg || strings.HasPrefix(pkg, p.Prefix+"/")
+}
+
+// validateConfig validates retention.cfg contents.
+func validateConfig(ctx *validation.Context, cfg *configpb.RetentionConfigFile) {
+	seen := map[string]bool{}
+	for i, p := range cfg.Policy {
+		ctx.Enter("policy #%d (%q)", i+1, p.Prefix)
+		switch prefix, err := common.ValidatePackagePrefix(p.Prefix); {
+		case err != nil:
+			ctx.Errorf("%s", err)
+		case prefix == "":
+			ctx.Errorf("the root prefix is not allowed")
+		case prefix != p.Prefix:
+			ctx.Errorf("the prefix should be given as %q", prefix)
+		case seen[prefix]:
+			ctx.Errorf("the prefix is already used by another policy")
+		default:
+			seen[prefix] = true
+		}
+		if p.KeepLast < 0 {
+			ctx.Errorf("keep_last should be non-negative")
+		}
+		if p.KeepDays < 0 {
+			ctx.Errorf("keep_days should be non-negative")
+		}
+		if p.KeepLast == 0 && p.KeepDays == 0 {
+			ctx.Errorf("at least one of keep_last or keep_days is required")
+		}
+		for _, key := range p.ProtectedTagKeys {
+			if err := common.ValidateInstanceTag(key + ":x"); err != nil {
+				ctx.Errorf("bad protected tag key %q", key)
+			}
+		}
+		ctx.Exit()
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/iotools"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/server/tq"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	configpb "go.chromium.org/luci/cipd/api/config/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/cas"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/repo/processing"
+	"go.chromium.org/luci/cipd/appengine/impl/retention/tasks"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// maxDeletionsPerTask limits how many instances a single task deletes.
+//
+// Whatever remains is picked up by the next cron run.
+const maxDeletionsPerTask = 500
+
+// manifestName is a path to the package manifest file inside the package.
+const manifestName = ".cipdpkg/manifest.json"
+
+// Retention deletes old instances according to retention policies.
+type Retention struct {
+	cas cas.StorageServer
+	tq  *tq.Dispatcher
+}
+
+// New constructs Retention and registers its tasks in the dispatcher.
+func New(cas cas.StorageServer, d *tq.Dispatcher) *Retention {
+	r := &Retention{cas: cas, tq: d}
+	r.registerTasks()
+	return r
+}
+
+// registerTasks adds tasks to the tq Dispatcher.
+func (r *Retention) registerTasks() {
+	// See queue.yaml for "retention" task queue definition.
+	r.tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "apply-retention",
+		Prototype: &tasks.ApplyRetention{},
+		Kind:      tq.NonTransactional,
+		Queue:     "retention",
+		Handler: func(ctx context.Context, m proto.Message) error {
+			return r.applyRetentionTask(ctx, m.(*tasks.ApplyRetention))
+		},
+	})
+}
+
+// CronHandler is called from a cron to enqueue a retention task per package
+// covered by some retention policy.
+func (r *Retention) CronHandler(ctx context.Context) error {
+	policies, err := Policies(ctx)
+	if err != nil {
+		return err
+	}
+
+	seen := stringset.New(0)
+	for _, p := range policies {
+		pkgs, err := model.ListPackages(ctx, p.Prefix, true)
+		if err != nil {
+			return err
+		}
+		// ListPackages doesn't include the prefix itself.
+		switch err := model.CheckPackageExists(ctx, p.Prefix); {
+		case err == nil:
+			pkgs = append(pkgs, p.Prefix)
+		case grpcutil.Code(err) != codes.NotFound:
+			return err
+		}
+		for _, pkg := range pkgs {
+			if !seen.Add(pkg) {
+				continue
+			}
+			err := r.tq.AddTask(ctx, &tq.Task{
+				Title:   pkg,
+				Payload: &tasks.ApplyRetention{Package: pkg},
+			})
+			if err != nil {
+				return errors.Annotate(err, "failed to enqueue the task for %q", pkg).Err()
+			}
+		}
+	}
+
+	logging.Infof(ctx, "Enqueued retention tasks for %d packages", seen.Len())
+	return nil
+}
+
+// applyRetentionTask deletes instances of a package not protected by its
+// retention policy.
+//
+// Returning a transient error here causes the task queue service to retry the
+// task.
+func (r *Retention) applyRetentionTask(ctx context.Context, t *tasks.ApplyRetention) error {
+	policy, err := PolicyFor(ctx, t.Package)
+	switch {
+	case err != nil:
+		return err
+	case policy == nil:
+		logging.Warningf(ctx, "No retention policy for %q anymore, skipping", t.Package)
+		return nil
+	}
+
+	victims, err := r.evaluate(ctx, t.Package, policy)
+	if err != nil {
+		return err
+	}
+
+	if policy.DryRun {
+		logging.Infof(ctx, "%s", dryRunReport(t.Package, policy, victims))
+		return nil
+	}
+
+	deleted := 0
+	var errs errors.MultiError
+	for _, inst := range victims {
+		switch err := r.deleteInstance(ctx, inst); {
+		case err == nil:
+			deleted++
+		case grpcutil.Code(err) == codes.FailedPrecondition:
+			logging.Warningf(ctx, "Skipping %s: %s", inst.InstanceID, err)
+		default:
+			logging.Errorf(ctx, "Failed to delete %s: %s", inst.InstanceID, err)
+			errs = append(errs, err)
+		}
+	}
+	logging.Infof(ctx, "Deleted %d instances of %q", deleted, t.Package)
+
+	if len(errs) != 0 {
+		return errors.Annotate(errs, "failed to delete %d instances", len(errs)).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// dryRunReport returns a summary of what a dry run policy would delete.
+//
+// It is logged as a single line per package, to make it easy to find all
+// reports in logs by searching for "Retention dry run".
+func dryRunReport(pkg string, p *configpb.RetentionPolicy, victims []*model.Instance) string {
+	ids := make([]string, len(victims))
+	for i, inst := range victims {
+		ids[i] = inst.InstanceID
+	}
+	report := fmt.Sprintf("Retention dry run: policy for %q would delete %d instance(s) of %q", p.Prefix, len(victims), pkg)
+	if len(victims) >= maxDeletionsPerTask {
+		report += " (or more, the limit per run is reached)"
+	}
+	if len(ids) != 0 {
+		report += ": " + strings.Join(ids, ", ")
+	}
+	return report
+}
+
+// evaluate returns instances of the package the policy allows to delete.
+//
+// Returns up to maxDeletionsPerTask instances, most recent first.
+func (r *Retention) evaluate(ctx context.Context, pkg string, p *configpb.RetentionPolicy) ([]*model.Instance, error) {
+	refs, err := model.ListPackageRefs(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	referenced := stringset.New(len(refs))
+	for _, ref := range refs {
+		referenced.Add(ref.InstanceID)
+	}
+
+	cutoff := clock.Now(ctx).Add(-time.Duration(p.KeepDays) * 24 * time.Hour)
+
+	var out []*model.Instance
+	var cursor datastore.Cursor
+	idx := 0
+	for {
+		var insts []*model.Instance
+		var err error
+		if insts, cursor, err = model.ListInstances(ctx, pkg, 100, cursor); err != nil {
+			return nil, errors.Annotate(err, "failed to list instances").Err()
+		}
+		for _, inst := range insts {
+			idx++
+			switch {
+			case idx <= int(p.KeepLast):
+				continue
+			case p.KeepDays > 0 && inst.RegisteredTs.After(cutoff):
+				continue
+			case referenced.Has(inst.InstanceID):
+				continue
+			}
+			switch protected, err := hasProtectedTags(ctx, inst, p.ProtectedTagKeys); {
+			case err != nil:
+				return nil, err
+			case protected:
+				continue
+			}
+			if out = append(out, inst); len(out) >= maxDeletionsPerTask {
+				return out, nil
+			}
+		}
+		if cursor == nil {
+			return out, nil
+		}
+	}
+}
+
+// hasProtectedTags returns true if the instance has a tag with any of the
+// given keys.
+func hasProtectedTags(ctx context.Context, inst *model.Instance, keys []string) (bool, error) {
+	if len(keys) == 0 {
+		return false, nil
+	}
+	tags, err := model.ListInstanceTags(ctx, inst)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range tags {
+		for _, key := range keys {
+			if strings.HasPrefix(t.Tag, key+":") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// deleteInstance deletes the instance entities, the instance file and files
+// of deltas that have the instance as a target.
+//
+// The instance file is deleted only if its manifest says it belongs to this
+// package and no other package has an instance with the same ID: the backend
+// doesn't verify that package files uploaded to the storage match the package
+// they are registered in, so the same file may potentially be shared by
+// instances of different packages. Delta files are content-addressed too, so
+// they are deleted under the same conditions.
+func (r *Retention) deleteInstance(ctx context.Context, inst *model.Instance) error {
+	pkg := inst.Package.StringID()
+	ref := common.InstanceIDToObjectRef(inst.InstanceID)
+
+	owned, err := r.ownsFile(ctx, pkg, ref)
+	switch {
+	case transient.Tag.In(err):
+		return err
+	case err != nil:
+		// Broken packages are deleted too, but their files are left alone.
+		logging.Warningf(ctx, "Failed to check the file of %s: %s", inst.InstanceID, err)
+	}
+
+	// Collect delta files before DeleteInstance deletes InstanceDelta entities.
+	deltas, err := model.ListInstanceDeltas(ctx, inst)
+	if err != nil {
+		return errors.Annotate(err, "failed to list deltas").Err()
+	}
+
+	if err := model.DeleteInstance(ctx, inst); err != nil {
+		return err
+	}
+	if !owned {
+		logging.Warningf(ctx, "Keeping the file of %s: it doesn't belong to %q", inst.InstanceID, pkg)
+		return nil
+	}
+
+	// Note that the query is eventually consistent and may still return the
+	// instance we've just deleted.
+	others, err := model.ListInstancesByID(ctx, inst.InstanceID)
+	if err != nil {
+		return errors.Annotate(err, "failed to check other instances sharing the file").Err()
+	}
+	for _, other := range others {
+		if !other.Package.Equal(inst.Package) {
+			logging.Warningf(ctx, "Keeping the file of %s: it is also used by %q", inst.InstanceID, other.Package.StringID())
+			return nil
+		}
+	}
+
+	for _, delta := range deltas {
+		if delta.DeltaID == "" {
+			continue // failed or still being calculated
+		}
+		if err := r.cas.DeleteObject(ctx, common.InstanceIDToObjectRef(delta.DeltaID)); err != nil {
+			return errors.Annotate(err, "failed to delete the delta file %s", delta.DeltaID).Err()
+		}
+	}
+	if err := r.cas.DeleteObject(ctx, ref); err != nil {
+		return errors.Annotate(err, "failed to delete the instance file").Err()
+	}
+	return nil
+}
+
+// ownsFile returns true if the package file has a manifest naming `pkg`.
+//
+// Returns false if there's no such file.
+func (r *Retention) ownsFile(ctx context.Context, pkg string, ref *api.ObjectRef) (bool, error) {
+	rawReader, err := r.cas.GetReader(ctx, ref)
+	switch code := status.Code(err); {
+	case code == codes.NotFound:
+		return false, nil
+	case code != codes.OK:
+		return false, errors.Annotate(err, "failed to open the object for reading").Tag(transient.Tag).Err()
+	}
+
+	pkgReader, err := processing.NewPackageReader(
+		iotools.NewBufferingReaderAt(rawReader, 512*1024, 2),
+		rawReader.Size())
+	if err != nil {
+		return false, errors.Annotate(err, "error when opening the package").Err()
+	}
+	rc, _, err := pkgReader.Open(manifestName)
+	if err != nil {
+		return false, errors.Annotate(err, "error when opening the manifest").Err()
+	}
+	defer func() { _ = rc.Close() }()
+
+	var manifest struct {
+		PackageName string `json:"package_name"`
+	}
+	if err := json.NewDecoder(rc).Decode(&manifest); err != nil {
+		return false, errors.Annotate(err, "error when reading the manifest").Err()
+	}
+	return manifest.PackageName == pkg, nil
+}
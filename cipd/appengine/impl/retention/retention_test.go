@@ -0,0 +1,264 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/memlogger"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/config"
+	"go.chromium.org/luci/config/cfgclient"
+	"go.chromium.org/luci/config/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/caching"
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	configpb "go.chromium.org/luci/cipd/api/config/v1"
+	"go.chromium.org/luci/cipd/appengine/impl/gs"
+	"go.chromium.org/luci/cipd/appengine/impl/model"
+	"go.chromium.org/luci/cipd/appengine/impl/retention/tasks"
+	"go.chromium.org/luci/cipd/appengine/impl/testutil"
+	"go.chromium.org/luci/cipd/common"
+)
+
+func TestRetention(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With mocks", t, func(t *ftt.Test) {
+		ctx, _, _ := testutil.TestingContext()
+
+		configs := map[config.Set]memory.Files{
+			"services/${appid}": map[string]string{},
+		}
+		mockConfig := func(body string) {
+			configs["services/${appid}"][cachedCfg.Path] = body
+			assert.Loosely(t, ImportConfig(ctx), should.BeNil)
+		}
+		ctx = cfgclient.Use(ctx, memory.New(configs))
+		ctx = caching.WithEmptyProcessCache(ctx)
+
+		// Package files in the storage, keyed by their hex digest.
+		files := map[string][]byte{}
+		var deleted []string
+
+		cas := &testutil.MockCAS{
+			GetReaderImpl: func(_ context.Context, ref *api.ObjectRef) (gs.Reader, error) {
+				if blob, ok := files[ref.HexDigest]; ok {
+					return testutil.NewMockGSReader(blob), nil
+				}
+				return nil, status.Errorf(codes.NotFound, "no such object")
+			},
+			DeleteObjectImpl: func(_ context.Context, ref *api.ObjectRef) error {
+				delete(files, ref.HexDigest)
+				deleted = append(deleted, ref.HexDigest)
+				return nil
+			},
+		}
+
+		dispatcher := &tq.Dispatcher{}
+		ctx, sched := tq.TestingContext(ctx, dispatcher)
+		r := New(cas, dispatcher)
+
+		// Registers an instance of `pkg` with a file whose manifest says it
+		// belongs to `owner`.
+		register := func(pkg, owner string, idx int, age time.Duration) string {
+			iid := strings.Repeat(fmt.Sprintf("%d", idx), 40)
+			files[iid] = testutil.MakeZip(map[string]string{
+				manifestName: fmt.Sprintf(`{"format_version": "1.1", "package_name": %q}`, owner),
+			})
+			reg, _, err := model.RegisterInstance(ctx, &model.Instance{
+				InstanceID:   iid,
+				Package:      model.PackageKey(ctx, pkg),
+				RegisteredTs: testutil.TestTime.Add(-age),
+			}, nil)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, reg, should.BeTrue)
+			return iid
+		}
+
+		instance := func(pkg, iid string) *model.Instance {
+			return &model.Instance{
+				InstanceID: iid,
+				Package:    model.PackageKey(ctx, pkg),
+			}
+		}
+
+		remaining := func(pkg string) []string {
+			insts, _, err := model.ListInstances(ctx, pkg, 100, nil)
+			assert.Loosely(t, err, should.BeNil)
+			out := make([]string, len(insts))
+			for i, inst := range insts {
+				out[i] = inst.InstanceID
+			}
+			return out
+		}
+
+		const day = 24 * time.Hour
+
+		iid0 := register("a/pkg", "a/pkg", 0, time.Hour)
+		iid1 := register("a/pkg", "a/pkg", 1, 4*day)
+		iid2 := register("a/pkg", "a/pkg", 2, 5*day)
+		iid3 := register("a/pkg", "a/pkg", 3, 6*day)
+		iid4 := register("a/pkg", "a/pkg", 4, 7*day)
+		iid5 := register("a/pkg", "another/pkg", 5, 8*day)
+		iid6 := register("b/pkg", "b/pkg", 6, 9*day)
+
+		assert.Loosely(t, model.SetRef(ctx, "stable", instance("a/pkg", iid3)), should.BeNil)
+		assert.Loosely(t, model.AttachTags(ctx, instance("a/pkg", iid4), []*api.Tag{
+			{Key: "release", Value: "1.0"},
+		}), should.BeNil)
+
+		// iid2 has a delta from iid1 stored in the storage and a failed delta
+		// from iid0.
+		deltaID := strings.Repeat("d", 40)
+		files[deltaID] = []byte("delta")
+		putDelta := func(base, deltaID string) {
+			ent := &model.InstanceDelta{Success: deltaID != "", DeltaID: deltaID}
+			datastore.PopulateKey(ent, model.InstanceDeltaKey(ctx, "a/pkg",
+				common.InstanceIDToObjectRef(base),
+				common.InstanceIDToObjectRef(iid2)))
+			assert.Loosely(t, datastore.Put(ctx, ent), should.BeNil)
+		}
+		putDelta(iid1, deltaID)
+		putDelta(iid0, "")
+
+		apply := func() {
+			datastore.GetTestable(ctx).CatchupIndexes()
+			assert.Loosely(t, r.CronHandler(ctx), should.BeNil)
+			sched.Run(ctx, tqtesting.StopWhenDrained())
+			datastore.GetTestable(ctx).CatchupIndexes()
+		}
+
+		t.Run("Deletes unprotected instances", func(t *ftt.Test) {
+			mockConfig(`
+				policy {
+					prefix: "a"
+					keep_last: 2
+					keep_days: 3
+					protected_tag_keys: "release"
+				}
+			`)
+			apply()
+
+			// Kept the recent, referenced and protected instances.
+			assert.Loosely(t, remaining("a/pkg"), should.Match([]string{iid0, iid1, iid3, iid4}))
+			// Deleted the file and the delta. Kept the file not owned by the package.
+			assert.Loosely(t, deleted, should.Match([]string{deltaID, iid2}))
+			assert.Loosely(t, files[iid5], should.NotBeNil)
+			// Didn't touch packages not covered by policies.
+			assert.Loosely(t, remaining("b/pkg"), should.Match([]string{iid6}))
+
+			// Tags, refs etc. are still there.
+			tags, err := model.ListInstanceTags(ctx, instance("a/pkg", iid4))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, tags, should.HaveLength(1))
+
+			// The second run is noop.
+			deleted = nil
+			apply()
+			assert.Loosely(t, remaining("a/pkg"), should.Match([]string{iid0, iid1, iid3, iid4}))
+			assert.Loosely(t, deleted, should.BeEmpty)
+		})
+
+		t.Run("Applies policies for the exact package", func(t *ftt.Test) {
+			mockConfig(`
+				policy {
+					prefix: "b/pkg"
+					keep_days: 1
+				}
+			`)
+			apply()
+			assert.Loosely(t, remaining("a/pkg"), should.HaveLength(6))
+			assert.Loosely(t, remaining("b/pkg"), should.BeEmpty)
+			assert.Loosely(t, deleted, should.Match([]string{iid6}))
+		})
+
+		t.Run("Dry run", func(t *ftt.Test) {
+			mockConfig(`
+				policy {
+					prefix: "a"
+					keep_last: 1
+					dry_run: true
+				}
+			`)
+			apply()
+			assert.Loosely(t, remaining("a/pkg"), should.HaveLength(6))
+			assert.Loosely(t, deleted, should.BeEmpty)
+
+			// Reports a summary per package.
+			ctx := memlogger.Use(ctx)
+			log := logging.Get(ctx).(*memlogger.MemLogger)
+			assert.Loosely(t, r.applyRetentionTask(ctx, &tasks.ApplyRetention{Package: "a/pkg"}), should.BeNil)
+			assert.Loosely(t, log.Messages(), should.HaveLength(1))
+			assert.Loosely(t, log.Messages()[0].Msg, should.Equal(fmt.Sprintf(
+				`Retention dry run: policy for "a" would delete 4 instance(s) of "a/pkg": %s, %s, %s, %s`,
+				iid1, iid2, iid4, iid5)))
+		})
+
+		t.Run("No config", func(t *ftt.Test) {
+			apply()
+			assert.Loosely(t, remaining("a/pkg"), should.HaveLength(6))
+			assert.Loosely(t, deleted, should.BeEmpty)
+		})
+
+		t.Run("Evaluates policies", func(t *ftt.Test) {
+			// Unaffected by the ref and tags.
+			victims, err := r.evaluate(ctx, "a/pkg", &configpb.RetentionPolicy{
+				KeepLast: 1,
+			})
+			assert.Loosely(t, err, should.BeNil)
+			ids := make([]string, len(victims))
+			for i, inst := range victims {
+				ids[i] = inst.InstanceID
+			}
+			assert.Loosely(t, ids, should.Match([]string{iid1, iid2, iid4, iid5}))
+		})
+
+		t.Run("Keeps files shared with other packages", func(t *ftt.Test) {
+			// An instance of another package uses the same file as iid2.
+			reg, _, err := model.RegisterInstance(ctx, instance("c/pkg", iid2), nil)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, reg, should.BeTrue)
+			datastore.GetTestable(ctx).CatchupIndexes()
+
+			assert.Loosely(t, r.deleteInstance(ctx, instance("a/pkg", iid2)), should.BeNil)
+			assert.Loosely(t, remaining("a/pkg"), should.HaveLength(5))
+			assert.Loosely(t, remaining("c/pkg"), should.Match([]string{iid2}))
+			assert.Loosely(t, deleted, should.BeEmpty)
+			assert.Loosely(t, files[iid2], should.NotBeNil)
+			assert.Loosely(t, files[deltaID], should.NotBeNil)
+		})
+
+		t.Run("Skips instances that got referenced", func(t *ftt.Test) {
+			err := r.deleteInstance(ctx, instance("a/pkg", iid3))
+			assert.Loosely(t, err, should.ErrLike("referenced by ref"))
+			assert.Loosely(t, remaining("a/pkg"), should.HaveLength(6))
+			assert.Loosely(t, deleted, should.BeEmpty)
+		})
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: go.chromium.org/luci/cipd/appengine/impl/retention/tasks/tasks.proto
+
+package tasks
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApplyRetention task applies the retention policy to a single package.
+type ApplyRetention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       string                 `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"` // the package to apply the policy to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyRetention) Reset() {
+	*x = ApplyRetention{}
+	mi := &file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRetention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRetention) ProtoMessage() {}
+
+func (x *ApplyRetention) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRetention.ProtoReflect.Descriptor instead.
+func (*ApplyRetention) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplyRetention) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+var File_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDesc = string([]byte{
+	0x0a, 0x44, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x70, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6d, 0x70, 0x6c, 0x2f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x2a, 0x0a,
+	0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63,
+	0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x2f, 0x69, 0x6d, 0x70, 0x6c, 0x2f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescData []byte
+)
+
+func file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDesc), len(file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDesc)))
+	})
+	return file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_goTypes = []any{
+	(*ApplyRetention)(nil), // 0: tasks.ApplyRetention
+}
+var file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_init() }
+func file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_init() {
+	if File_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDesc), len(file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_goTypes,
+		DependencyIndexes: file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_depIdxs,
+		MessageInfos:      file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_msgTypes,
+	}.Build()
+	File_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto = out.File
+	file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_goTypes = nil
+	file_go_chromium_org_luci_cipd_appengine_impl_retention_tasks_tasks_proto_depIdxs = nil
+}
@@ -39,6 +39,7 @@ import (
 	"go.chromium.org/luci/cipd/appengine/impl/cas"
 	"go.chromium.org/luci/cipd/appengine/impl/model"
 	"go.chromium.org/luci/cipd/appengine/impl/repo"
+	"go.chromium.org/luci/cipd/appengine/impl/retention"
 	"go.chromium.org/luci/cipd/appengine/impl/settings"
 )
 
@@ -62,6 +63,9 @@ type Services struct {
 
 	// EventLogger can flush events to BigQuery.
 	EventLogger *model.BigQueryEventLogger
+
+	// Retention deletes old instances according to retention policies.
+	Retention *retention.Retention
 }
 
 // Main initializes the core server modules and launches the callback.
@@ -98,6 +102,7 @@ func Main(extra []module.Module, cb func(srv *server.Server, svc *Services) erro
 			PublicRepo:  repo.Public(internalCAS, &tq.Default),
 			AdminAPI:    admin.AdminAPI(&dsmapper.Default),
 			EventLogger: ev,
+			Retention:   retention.New(internalCAS, &tq.Default),
 		})
 	})
 }
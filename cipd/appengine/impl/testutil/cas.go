@@ -28,6 +28,7 @@ type MockCAS struct {
 	Err error // an error to return or nil to pass through to the callback
 
 	GetReaderImpl    func(context.Context, *api.ObjectRef) (gs.Reader, error)
+	DeleteObjectImpl func(context.Context, *api.ObjectRef) error
 	GetObjectURLImpl func(context.Context, *api.GetObjectURLRequest) (*api.ObjectURL, error)
 	BeginUploadImpl  func(context.Context, *api.BeginUploadRequest) (*api.UploadOperation, error)
 	FinishUploadImpl func(context.Context, *api.FinishUploadRequest) (*api.UploadOperation, error)
@@ -45,6 +46,18 @@ func (m *MockCAS) GetReader(ctx context.Context, ref *api.ObjectRef) (gs.Reader,
 	return m.GetReaderImpl(ctx, ref)
 }
 
+// DeleteObject implements the corresponding method of cas.StorageServer
+// interface.
+func (m *MockCAS) DeleteObject(ctx context.Context, ref *api.ObjectRef) error {
+	if m.Err != nil {
+		return m.Err
+	}
+	if m.DeleteObjectImpl == nil {
+		panic("must not be called")
+	}
+	return m.DeleteObjectImpl(ctx, ref)
+}
+
 // GetObjectURL implements the corresponding RPC method, see the proto doc.
 func (m *MockCAS) GetObjectURL(ctx context.Context, r *api.GetObjectURLRequest) (*api.ObjectURL, error) {
 	if m.Err != nil {
@@ -44,6 +44,17 @@ func TestingContext(mocks ...authtest.MockedDatum) (context.Context, testclock.T
 
 	datastore.GetTestable(ctx).AutoIndex(true)
 
+	// AutoIndex doesn't work inside transactions. Add indexes used by
+	// transactional queries explicitly.
+	datastore.GetTestable(ctx).AddIndexes(&datastore.IndexDefinition{
+		Kind:     "PackageRef",
+		Ancestor: true,
+		SortBy: []datastore.IndexColumn{
+			{Property: "instance_id"},
+			{Property: "modified_ts", Descending: true},
+		},
+	})
+
 	as := func(email string) context.Context {
 		return auth.WithState(ctx, &authtest.FakeState{
 			Identity: identity.Identity("user:" + email),
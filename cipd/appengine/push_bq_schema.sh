@@ -37,3 +37,12 @@ bqschemaupdater \
   -message-dir "$THIS_DIR/../api/cipd/v1" \
   -message "cipd.ExportedTag" \
   -disable-partitioning  # doesn't work with template tables
+
+# Note: this table is used as a template table to create 'exported_sboms_<jobid>'
+# tables that contain SBOM components exported by a single specific mapper job.
+bqschemaupdater \
+  -table "$PROJECT_ID.cipd.exported_sboms" \
+  -friendly-name "SBOM components exported via EXPORT_SBOMS_TO_BQ mapper job." \
+  -message-dir "$THIS_DIR/../api/cipd/v1" \
+  -message "cipd.ExportedSBOMComponent" \
+  -disable-partitioning  # doesn't work with template tables
@@ -25,6 +25,7 @@ import (
 
 	"github.com/klauspost/compress/flate"
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
@@ -54,9 +55,18 @@ type Options struct {
 	// InstallMode defines how to install the package: "copy" or "symlink".
 	InstallMode pkg.InstallMode
 
-	// CompressionLevel defines deflate compression level in range [0-9].
+	// CompressionLevel defines compression level in range [0-9].
+	//
+	// 0 disables compression. For zstd, it is mapped to the closest zstd
+	// encoder level.
 	CompressionLevel int
 
+	// Compression defines what compression method to use for files.
+	//
+	// Default is pkg.CompressionDeflate. Note that packages compressed with
+	// pkg.CompressionZstd can't be installed by old CIPD clients.
+	Compression pkg.Compression
+
 	// HashAlgo specifies what hashing algorithm to use for computing instance ID.
 	//
 	// By default it is common.DefaultHashAlgo.
@@ -83,6 +93,9 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	if err != nil {
 		return common.Pin{}, err
 	}
+	if err := pkg.ValidateCompression(opts.Compression); err != nil {
+		return common.Pin{}, err
+	}
 
 	// Make sure hash algo is supported.
 	if opts.HashAlgo == 0 {
@@ -123,7 +136,7 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	}
 
 	// Write the final zip file, calculate its hash to use for instance ID.
-	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel); err != nil {
+	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel, opts.Compression); err != nil {
 		return common.Pin{}, err
 	}
 	return common.Pin{
@@ -137,8 +150,11 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 
 // zipInputFiles deterministically builds a zip archive out of input files and
 // writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int) error {
-	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files), level)
+func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int, compression pkg.Compression) error {
+	if compression == "" {
+		compression = pkg.CompressionDeflate
+	}
+	logging.Infof(ctx, "About to zip %d files using %s with compression level %d", len(files), compression, level)
 
 	writer := zip.NewWriter(w)
 	defer writer.Close()
@@ -147,6 +163,16 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		return flate.NewWriter(out, level)
 	})
 
+	method := zip.Deflate
+	if compression == pkg.CompressionZstd {
+		// Use a single encoder goroutine to get deterministic output.
+		method = zstd.ZipMethodWinZip
+		writer.RegisterCompressor(method, zstd.ZipCompressor(
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+			zstd.WithEncoderConcurrency(1),
+		))
+	}
+
 	// Reports zipping progress to the log each second.
 	lastReport := time.Time{}
 	progress := func(count int) {
@@ -169,10 +195,15 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		// are zero valued. See also zip.FileInfoHeader() implementation.
 		fh := zip.FileHeader{
 			Name:   in.Name(),
-			Method: zip.Deflate,
+			Method: method,
 		}
-		if level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in) {
+		switch {
+		case level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in):
 			fh.Method = zip.Store
+		case in.Name() == pkg.ManifestName:
+			// Old clients and backends must be able to read the manifest of any
+			// package to at least report a meaningful error.
+			fh.Method = zip.Deflate
 		}
 
 		mode := os.FileMode(0400)
@@ -347,7 +378,7 @@ func makeManifestFile(opts Options) (fs.File, error) {
 	if opts.VersionFile != "" && !fs.IsCleanSlashPath(opts.VersionFile) {
 		return nil, errors.Reason("version file path should be a clean path relative to a package root: %s", opts.VersionFile).Tag(cipderr.BadArgument).Err()
 	}
-	if err := pkg.ValidateInstallMode(opts.InstallMode); err != nil {
+	if err := pkg.ValidatePackageInstallMode(opts.InstallMode); err != nil {
 		return nil, err
 	}
 	formatVer := pkg.ManifestFormatVersion
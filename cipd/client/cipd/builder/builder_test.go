@@ -21,10 +21,12 @@ import (
 	"encoding/hex"
 	"io"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/testing/ftt"
 	"go.chromium.org/luci/common/testing/truth/assert"
@@ -267,6 +269,60 @@ func TestBuildInstance(t *testing.T) {
 		}
 	})
 
+	ftt.Run("Building package with zstd compression", t, func(t *ftt.Test) {
+		build := func(level int) []byte {
+			out := bytes.Buffer{}
+			_, err := BuildInstance(ctx, Options{
+				Input: []fs.File{
+					fs.NewTestFile("testing/qwerty", strings.Repeat("12345", 100), fs.TestFileOpts{}),
+					fs.NewTestFile("already.zip", "zip", fs.TestFileOpts{}),
+					fs.NewTestSymlink("rel_symlink", "testing/qwerty"),
+				},
+				Output:           &out,
+				PackageName:      "testing",
+				CompressionLevel: level,
+				Compression:      pkg.CompressionZstd,
+			})
+			assert.Loosely(t, err, should.BeNil)
+			return out.Bytes()
+		}
+
+		methods := func(data []byte) map[string]uint16 {
+			z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			assert.Loosely(t, err, should.BeNil)
+			out := map[string]uint16{}
+			for _, zf := range z.File {
+				out[zf.Name] = zf.Method
+			}
+			return out
+		}
+
+		for lvl := 1; lvl <= 9; lvl++ {
+			out := build(lvl)
+			assert.Loosely(t, build(lvl), should.Resemble(out)) // deterministic
+			assert.Loosely(t, methods(out), should.Resemble(map[string]uint16{
+				"testing/qwerty": zstd.ZipMethodWinZip,
+				"already.zip":    zip.Store,
+				"rel_symlink":    zip.Store,
+				pkg.ManifestName: zip.Deflate,
+			}))
+			files := readZip(out)
+			assert.Loosely(t, files, should.HaveLength(4))
+			assert.Loosely(t, string(files[0].body), should.Equal(strings.Repeat("12345", 100)))
+		}
+
+		assert.Loosely(t, methods(build(0))["testing/qwerty"], should.Equal(zip.Store))
+	})
+
+	ftt.Run("Bad compression method fails", t, func(t *ftt.Test) {
+		_, err := BuildInstance(ctx, Options{
+			Output:      &bytes.Buffer{},
+			PackageName: "testing",
+			Compression: "bzip2",
+		})
+		assert.Loosely(t, err, should.ErrLike(`invalid compression method "bzip2"`))
+	})
+
 	ftt.Run("Duplicate files fail", t, func(t *ftt.Test) {
 		_, err := BuildInstance(ctx, Options{
 			Input: []fs.File{
@@ -334,6 +390,7 @@ func readZip(data []byte) []zippedFile {
 	if err != nil {
 		panic("Failed to open zip file")
 	}
+	z.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	files := make([]zippedFile, len(z.File))
 	for i, zf := range z.File {
 		reader, err := zf.Open()
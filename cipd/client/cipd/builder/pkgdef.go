@@ -46,6 +46,9 @@ type PackageDef struct {
 	// InstallMode defines how to deploy the package file: "copy" or "symlink".
 	InstallMode pkg.InstallMode `yaml:"install_mode"`
 
+	// Compression defines how to compress files: "deflate" (default) or "zstd".
+	Compression pkg.Compression
+
 	// PreserveModTime instructs CIPD to preserve the mtime of the files.
 	PreserveModTime bool `yaml:"preserve_mtime"`
 
@@ -102,7 +105,10 @@ func LoadPackageDef(r io.Reader, vars map[string]string) (PackageDef, error) {
 	if err = common.ValidatePackageName(out.Package); err != nil {
 		return PackageDef{}, err
 	}
-	if err = pkg.ValidateInstallMode(out.InstallMode); err != nil {
+	if err = pkg.ValidatePackageInstallMode(out.InstallMode); err != nil {
+		return PackageDef{}, err
+	}
+	if err = pkg.ValidateCompression(out.Compression); err != nil {
 		return PackageDef{}, err
 	}
 
@@ -46,6 +46,7 @@ func TestLoadPackageDef(t *testing.T) {
 			"package": "package/${var1}",
 			"root": "../..",
 			"install_mode": "copy",
+			"compression": "zstd",
 			"data": [
 				{
 					"file": "some_file_${var1}"
@@ -77,6 +78,7 @@ func TestLoadPackageDef(t *testing.T) {
 			Package:     "package/value1",
 			Root:        "../..",
 			InstallMode: "copy",
+			Compression: "zstd",
 			Data: []PackageChunkDef{
 				{
 					File: "some_file_value1",
@@ -132,6 +134,12 @@ func TestLoadPackageDef(t *testing.T) {
 		assert.Loosely(t, err, should.NotBeNil)
 	})
 
+	ftt.Run("LoadPackageDef bad compression", t, func(t *ftt.Test) {
+		body := strings.NewReader(`{"package": "abc", "compression": "bzip2"}`)
+		_, err := LoadPackageDef(body, nil)
+		assert.Loosely(t, err, should.ErrLike(`invalid compression method "bzip2"`))
+	})
+
 	ftt.Run("LoadPackageDef bad package name", t, func(t *ftt.Test) {
 		body := strings.NewReader(`{"package": "not a valid name"}`)
 		_, err := LoadPackageDef(body, nil)
@@ -0,0 +1,243 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle implements offline bundles of CIPD package instances.
+//
+// A bundle is a tar archive with a JSON manifest followed by package instance
+// files. It is produced by 'cipd bundle-export' on a machine with access to
+// the CIPD backend and consumed by 'cipd bundle-import' on machines without
+// one.
+//
+// The layout of the archive:
+//
+//	manifest.json                 the Manifest, always the first entry
+//	instances/<instance ID>       instance files, in order of Manifest.Instances
+package bundle
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"io"
+	"path"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+// FormatVersion is the current version of the bundle format.
+const FormatVersion = 1
+
+const (
+	manifestName = "manifest.json"
+	instancesDir = "instances"
+)
+
+// Manifest describes the content of a bundle.
+type Manifest struct {
+	FormatVersion int        `json:"format_version"`
+	ServiceURL    string     `json:"service_url"`
+	Instances     []Instance `json:"instances"`
+}
+
+// Instance is a package instance stored in a bundle.
+type Instance struct {
+	Package    string `json:"package"`
+	InstanceID string `json:"instance_id"`
+
+	// Versions are versions from ensure files that resolved to this instance.
+	Versions []string `json:"versions,omitempty"`
+
+	// Signatures are publisher signatures of this instance, if any.
+	//
+	// They are imported into the local cache to allow verifying them offline.
+	Signatures [][]byte `json:"signatures,omitempty"`
+}
+
+// Pin returns the pin of this instance.
+func (i *Instance) Pin() common.Pin {
+	return common.Pin{PackageName: i.Package, InstanceID: i.InstanceID}
+}
+
+// Validate checks the manifest is well-formed.
+func (m *Manifest) Validate() error {
+	if m.FormatVersion != FormatVersion {
+		return errors.Reason("unsupported bundle format version %d, expecting %d", m.FormatVersion, FormatVersion).Tag(cipderr.BadArgument).Err()
+	}
+	if m.ServiceURL == "" {
+		return errors.Reason("the bundle manifest has no service URL").Tag(cipderr.BadArgument).Err()
+	}
+	seen := make(map[string]bool, len(m.Instances))
+	for _, inst := range m.Instances {
+		if err := common.ValidatePin(inst.Pin(), common.KnownHash); err != nil {
+			return errors.Annotate(err, "bad instance in the bundle manifest").Err()
+		}
+		if seen[inst.InstanceID] {
+			return errors.Reason("instance %s is listed in the bundle manifest twice", inst.InstanceID).Tag(cipderr.BadArgument).Err()
+		}
+		seen[inst.InstanceID] = true
+		for _, v := range inst.Versions {
+			if err := common.ValidateInstanceVersion(v); err != nil {
+				return errors.Annotate(err, "bad version of %s in the bundle manifest", inst.Package).Err()
+			}
+		}
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Writer writes a bundle.
+type Writer struct {
+	tw      *tar.Writer
+	pending []Instance // instances still to be written
+	now     time.Time  // mtime of all entries
+}
+
+// NewWriter writes the manifest and returns a writer for the instances it
+// lists.
+//
+// Instances must be added via AddInstance in the same order as they are listed
+// in the manifest.
+func NewWriter(w io.Writer, m *Manifest) (*Writer, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	blob, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, errors.Annotate(err, "serializing the bundle manifest").Tag(cipderr.IO).Err()
+	}
+
+	bw := &Writer{
+		tw:      tar.NewWriter(w),
+		pending: m.Instances,
+		now:     time.Now().Truncate(time.Second),
+	}
+	if err := bw.writeEntry(manifestName, int64(len(blob)), func(w io.Writer) error {
+		_, err := w.Write(blob)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return bw, nil
+}
+
+// AddInstance writes the next instance file.
+//
+// Doesn't verify the hash of the instance file. It is verified when importing.
+func (w *Writer) AddInstance(pin common.Pin, src pkg.Source) error {
+	if len(w.pending) == 0 || w.pending[0].Pin() != pin {
+		return errors.Reason("unexpected instance %s, the bundle manifest lists a different one", pin).Tag(cipderr.BadArgument).Err()
+	}
+	w.pending = w.pending[1:]
+	return w.writeEntry(path.Join(instancesDir, pin.InstanceID), src.Size(), func(w io.Writer) error {
+		_, err := io.Copy(w, io.NewSectionReader(src, 0, src.Size()))
+		return err
+	})
+}
+
+// Close finishes writing the bundle.
+//
+// Doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if len(w.pending) != 0 {
+		return errors.Reason("%d instance(s) listed in the bundle manifest were not written", len(w.pending)).Tag(cipderr.BadArgument).Err()
+	}
+	if err := w.tw.Close(); err != nil {
+		return errors.Annotate(err, "finishing the bundle").Tag(cipderr.IO).Err()
+	}
+	return nil
+}
+
+func (w *Writer) writeEntry(name string, size int64, body func(io.Writer) error) error {
+	err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+		ModTime:  w.now,
+		Format:   tar.FormatPAX,
+	})
+	if err == nil {
+		err = body(w.tw)
+	}
+	if err != nil {
+		return errors.Annotate(err, "writing %s to the bundle", name).Tag(cipderr.IO).Err()
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Reader reads a bundle.
+type Reader struct {
+	tr       *tar.Reader
+	manifest *Manifest
+	next     int // index of the next instance in manifest.Instances
+}
+
+// NewReader reads and validates the manifest of the bundle.
+func NewReader(r io.Reader) (*Reader, error) {
+	tr := tar.NewReader(r)
+
+	hdr, err := tr.Next()
+	switch {
+	case err != nil:
+		return nil, errors.Annotate(err, "reading the bundle").Tag(cipderr.BadArgument).Err()
+	case hdr.Name != manifestName:
+		return nil, errors.Reason("not a CIPD bundle: the first entry is %q, not %q", hdr.Name, manifestName).Tag(cipderr.BadArgument).Err()
+	}
+
+	m := &Manifest{}
+	if err := json.NewDecoder(tr).Decode(m); err != nil {
+		return nil, errors.Annotate(err, "reading the bundle manifest").Tag(cipderr.BadArgument).Err()
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &Reader{tr: tr, manifest: m}, nil
+}
+
+// Manifest is the manifest of the bundle.
+func (r *Reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// Next advances to the next instance in the bundle.
+//
+// Returns the instance and a reader with its body, valid until the next call
+// to Next. Returns io.EOF when there are no more instances.
+func (r *Reader) Next() (*Instance, io.Reader, int64, error) {
+	hdr, err := r.tr.Next()
+	switch {
+	case err == io.EOF && r.next == len(r.manifest.Instances):
+		return nil, nil, 0, io.EOF
+	case err == io.EOF:
+		return nil, nil, 0, errors.Reason("the bundle is truncated: %d instance(s) are missing", len(r.manifest.Instances)-r.next).Tag(cipderr.BadArgument).Err()
+	case err != nil:
+		return nil, nil, 0, errors.Annotate(err, "reading the bundle").Tag(cipderr.BadArgument).Err()
+	case r.next == len(r.manifest.Instances):
+		return nil, nil, 0, errors.Reason("unexpected entry %q in the bundle", hdr.Name).Tag(cipderr.BadArgument).Err()
+	}
+
+	inst := &r.manifest.Instances[r.next]
+	if want := path.Join(instancesDir, inst.InstanceID); hdr.Name != want {
+		return nil, nil, 0, errors.Reason("unexpected entry %q in the bundle, expecting %q", hdr.Name, want).Tag(cipderr.BadArgument).Err()
+	}
+	r.next++
+	return inst, r.tr, hdr.Size, nil
+}
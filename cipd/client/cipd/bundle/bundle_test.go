@@ -0,0 +1,132 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+)
+
+type bytesSource struct {
+	*bytes.Reader
+}
+
+func (bytesSource) Close(ctx context.Context, corrupt bool) error { return nil }
+
+func TestBundle(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With manifest", t, func(t *ftt.Test) {
+		inst1 := Instance{
+			Package:    "a/pkg",
+			InstanceID: strings.Repeat("a", 40),
+			Versions:   []string{"version:1", "latest"},
+		}
+		inst2 := Instance{
+			Package:    "b/pkg",
+			InstanceID: strings.Repeat("b", 40),
+		}
+		manifest := &Manifest{
+			FormatVersion: FormatVersion,
+			ServiceURL:    "https://cipd.example.com",
+			Instances:     []Instance{inst1, inst2},
+		}
+
+		src := func(body string) pkg.Source {
+			return bytesSource{bytes.NewReader([]byte(body))}
+		}
+
+		t.Run("Round trip", func(t *ftt.Test) {
+			out := bytes.Buffer{}
+			w, err := NewWriter(&out, manifest)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, w.AddInstance(inst1.Pin(), src("body 1")), should.BeNil)
+			assert.Loosely(t, w.AddInstance(inst2.Pin(), src("body 2")), should.BeNil)
+			assert.Loosely(t, w.Close(), should.BeNil)
+
+			r, err := NewReader(bytes.NewReader(out.Bytes()))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, r.Manifest(), should.Match(manifest))
+
+			var got []string
+			for {
+				inst, body, size, err := r.Next()
+				if err == io.EOF {
+					break
+				}
+				assert.Loosely(t, err, should.BeNil)
+				blob, err := io.ReadAll(body)
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, int64(len(blob)), should.Equal(size))
+				got = append(got, inst.Package+" "+string(blob))
+			}
+			assert.Loosely(t, got, should.Match([]string{
+				"a/pkg body 1",
+				"b/pkg body 2",
+			}))
+		})
+
+		t.Run("Wrong order", func(t *ftt.Test) {
+			w, err := NewWriter(&bytes.Buffer{}, manifest)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, w.AddInstance(inst2.Pin(), src("body 2")), should.ErrLike("unexpected instance"))
+		})
+
+		t.Run("Missing instances", func(t *ftt.Test) {
+			out := bytes.Buffer{}
+			w, err := NewWriter(&out, manifest)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, w.AddInstance(inst1.Pin(), src("body 1")), should.BeNil)
+			assert.Loosely(t, w.Close(), should.ErrLike("1 instance(s) listed in the bundle manifest were not written"))
+
+			// The reader notices too.
+			r, err := NewReader(bytes.NewReader(out.Bytes()))
+			assert.Loosely(t, err, should.BeNil)
+			_, _, _, err = r.Next()
+			assert.Loosely(t, err, should.BeNil)
+			_, _, _, err = r.Next()
+			assert.Loosely(t, err, should.ErrLike("the bundle is truncated"))
+		})
+
+		t.Run("Bad manifest", func(t *ftt.Test) {
+			manifest.FormatVersion = 666
+			_, err := NewWriter(&bytes.Buffer{}, manifest)
+			assert.Loosely(t, err, should.ErrLike("unsupported bundle format version"))
+
+			manifest.FormatVersion = FormatVersion
+			manifest.Instances = append(manifest.Instances, inst1)
+			_, err = NewWriter(&bytes.Buffer{}, manifest)
+			assert.Loosely(t, err, should.ErrLike("is listed in the bundle manifest twice"))
+
+			manifest.Instances = []Instance{{Package: "a/pkg", InstanceID: "huh"}}
+			_, err = NewWriter(&bytes.Buffer{}, manifest)
+			assert.Loosely(t, err, should.ErrLike("bad instance in the bundle manifest"))
+		})
+
+		t.Run("Not a bundle", func(t *ftt.Test) {
+			_, err := NewReader(strings.NewReader("garbage"))
+			assert.Loosely(t, err, should.ErrLike("reading the bundle"))
+		})
+	})
+}
@@ -59,6 +59,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/prototext"
 
@@ -74,7 +75,9 @@ import (
 	"go.chromium.org/luci/hardcoded/chromeinfra"
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd/bundle"
 	"go.chromium.org/luci/cipd/client/cipd/configpb"
+	"go.chromium.org/luci/cipd/client/cipd/delta"
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/ensure"
@@ -85,6 +88,7 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/plugin"
 	"go.chromium.org/luci/cipd/client/cipd/proxyclient"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/client/cipd/ui"
 	"go.chromium.org/luci/cipd/common"
@@ -119,6 +123,7 @@ const (
 const (
 	EnvConfigFile          = "CIPD_CONFIG_FILE"
 	EnvCacheDir            = "CIPD_CACHE_DIR"
+	EnvContentStoreDir     = "CIPD_CONTENT_STORE_DIR"
 	EnvHTTPUserAgentPrefix = "CIPD_HTTP_USER_AGENT_PREFIX"
 	EnvMaxThreads          = "CIPD_MAX_THREADS"
 	EnvParallelDownloads   = "CIPD_PARALLEL_DOWNLOADS"
@@ -131,7 +136,7 @@ var (
 	// ClientPackage is a package with the CIPD client. Used during self-update.
 	ClientPackage = "infra/tools/cipd/${platform}"
 	// UserAgent is HTTP user agent string for CIPD client.
-	UserAgent = "cipd 2.7.0"
+	UserAgent = "cipd 2.8.0"
 )
 
 func init() {
@@ -249,6 +254,13 @@ type Client interface {
 	// AttachMetadataWhenReady attaches metadata to an instance.
 	AttachMetadataWhenReady(ctx context.Context, pin common.Pin, md []Metadata) error
 
+	// FetchSignatures returns publisher signatures attached to an instance.
+	//
+	// Signatures are values of signing.MetadataKey metadata entries. They are
+	// returned as is, without any verification. Uses the local cache if
+	// possible.
+	FetchSignatures(ctx context.Context, pin common.Pin) ([][]byte, error)
+
 	// FetchPackageRefs returns information about all refs defined for a package.
 	//
 	// The returned list is sorted by modification timestamp (newest first).
@@ -273,6 +285,16 @@ type Client interface {
 	// returns an error.
 	FetchInstanceTo(ctx context.Context, pin common.Pin, output io.WriteSeeker) error
 
+	// ImportBundle puts package instances from an offline bundle into the cache.
+	//
+	// Verifies hashes of all instances and records tags they were exported with
+	// and their signatures in the tag cache, so that 'ensure' can later resolve
+	// and verify them without talking to the backend. Requires the cache
+	// directory to be configured.
+	//
+	// Returns pins of all imported instances.
+	ImportBundle(ctx context.Context, r *bundle.Reader) (common.PinSlice, error)
+
 	// ListPackages returns a list packages and prefixes under the given prefix.
 	ListPackages(ctx context.Context, prefix string, recursive, includeHidden bool) ([]string, error)
 
@@ -359,6 +381,13 @@ type ClientOptions struct {
 	// root. If both Root and CacheDir are empty, tag cache is disabled.
 	CacheDir string
 
+	// ContentStoreDir is a directory with files of packages installed in
+	// "hardlink" mode.
+	//
+	// It can be shared by multiple site roots, as long as they are on the same
+	// file system with it. If empty, each site root uses its own content store.
+	ContentStoreDir string
+
 	// Versions is optional database of (pkg, version) => instance ID resolutions.
 	//
 	// If set, it will be used for all version resolutions done by the client.
@@ -417,6 +446,12 @@ type ClientOptions struct {
 	// Will be started lazily when needed.
 	AdmissionPlugin []string
 
+	// SignaturePolicies define what packages must be signed and by what keys.
+	//
+	// If a package matches some policy, EnsurePackages refuses to install it
+	// unless it has a valid publisher signature. See configpb.SignaturePolicy.
+	SignaturePolicies []*configpb.SignaturePolicy
+
 	// ProxyURL is an URL of a local HTTP server to use for backend calls.
 	//
 	// This should be a regular clear text (not TLS) HTTP 1.1 or 2.0 server that
@@ -477,6 +512,14 @@ func (opts *ClientOptions) LoadFromEnv(ctx context.Context) error {
 			opts.CacheDir = v
 		}
 	}
+	if opts.ContentStoreDir == "" {
+		if v := env.Get(EnvContentStoreDir); v != "" {
+			if !filepath.IsAbs(v) {
+				return errors.Reason("bad %s %q: not an absolute path", EnvContentStoreDir, v).Tag(cipderr.BadArgument).Err()
+			}
+			opts.ContentStoreDir = v
+		}
+	}
 	if opts.MaxThreads == 0 {
 		if v := env.Get(EnvMaxThreads); v != "" {
 			maxThreads, err := strconv.Atoi(v)
@@ -561,6 +604,9 @@ func (opts *ClientOptions) LoadFromEnv(ctx context.Context) error {
 				opts.AdmissionPlugin = append([]string{cfg.Plugins.Admission.Cmd}, cfg.Plugins.Admission.Args...)
 			}
 		}
+		if len(opts.SignaturePolicies) == 0 {
+			opts.SignaturePolicies = cfg.SignaturePolicies
+		}
 	}
 
 	return nil
@@ -766,11 +812,18 @@ func NewClient(opts ClientOptions) (Client, error) {
 		cas:           cas,
 		repo:          repo,
 		storage:       s,
-		deployer:      deployer.New(opts.Root),
+		deployer:      deployer.NewWithContentStore(opts.Root, opts.ContentStoreDir),
 		proxyAddr:     proxyAddr,
 		pluginHost:    pluginHost,
 	}
 
+	if len(opts.SignaturePolicies) != 0 {
+		client.signatures, err = signing.NewVerifier(opts.SignaturePolicies)
+		if err != nil {
+			return nil, errors.Annotate(err, "bad signature policies").Err()
+		}
+	}
+
 	if len(opts.AdmissionPlugin) != 0 && client.pluginHost != nil {
 		client.pluginAdmission, err = client.pluginHost.NewAdmissionPlugin(opts.AdmissionPlugin)
 		if err != nil {
@@ -847,6 +900,9 @@ type clientImpl struct {
 	// Plugin system.
 	pluginHost      plugin.Host            // nil if disabled
 	pluginAdmission plugin.AdmissionPlugin // nil if disabled
+
+	// signatures verifies publisher signatures, nil if not required.
+	signatures *signing.Verifier
 }
 
 type batchAwareOp int
@@ -876,6 +932,9 @@ func (c *clientImpl) cleanupTrash(ctx context.Context) {
 	if f := c.deployer.FS(); f != nil {
 		f.EnsureDirectoryGone(ctx, filepath.Join(f.Root(), fs.SiteServiceDir, "tmp"))
 		f.CleanupTrash(ctx)
+		if err := c.deployer.CollectGarbage(ctx); err != nil {
+			logging.Warningf(ctx, "Failed to cleanup the content store: %s", err)
+		}
 	}
 }
 
@@ -964,6 +1023,7 @@ func (c *clientImpl) instanceCache(ctx context.Context) (*internal.InstanceCache
 		FS:                fs.NewFileSystem(cacheDir, ""),
 		Tmp:               tmp,
 		Fetcher:           c.remoteFetchInstance,
+		DeltaFetcher:      c.remoteFetchInstanceDelta,
 		ParallelDownloads: parallelDownloads,
 	}
 	cache.Launch(ctx) // start background download goroutines
@@ -1417,10 +1477,20 @@ func (c *clientImpl) RegisterInstance(ctx context.Context, pin common.Pin, src p
 		done()
 	}()
 
+	// Let the backend know if the package needs a recent client to be installed.
+	// It uses this to refuse serving the package to old clients.
+	rpcCtx := ctx
+	switch zstd, err := reader.UsesZstd(src); {
+	case err != nil:
+		return err
+	case zstd:
+		rpcCtx = metadata.AppendToOutgoingContext(ctx, common.CompressionHintMetadataKey, string(pkg.CompressionZstd))
+	}
+
 	// attemptToRegister calls RegisterInstance RPC and logs the result.
 	attemptToRegister := func() (*api.UploadOperation, error) {
 		logging.Infof(ctx, "Registering %s", pin)
-		resp, err := c.repo.RegisterInstance(ctx, &api.Instance{
+		resp, err := c.repo.RegisterInstance(rpcCtx, &api.Instance{
 			Package:  pin.PackageName,
 			Instance: common.InstanceIDToObjectRef(pin.InstanceID),
 		}, expectedCodes)
@@ -1721,6 +1791,84 @@ func (c *clientImpl) AttachMetadataWhenReady(ctx context.Context, pin common.Pin
 	return err
 }
 
+func (c *clientImpl) FetchSignatures(ctx context.Context, pin common.Pin) (sigs [][]byte, err error) {
+	defer func() {
+		cipderr.AttachDetails(&err, cipderr.Details{
+			Package: pin.PackageName,
+			Version: pin.InstanceID,
+		})
+	}()
+
+	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
+		return nil, err
+	}
+	sigs, _, err = c.fetchSignatures(ctx, pin, true)
+	return sigs, err
+}
+
+// fetchSignatures implements FetchSignatures, optionally skipping the cache.
+//
+// Returns true if the signatures came from the cache.
+func (c *clientImpl) fetchSignatures(ctx context.Context, pin common.Pin, useCache bool) (sigs [][]byte, cached bool, err error) {
+	// Signatures never become invalid, so cached ones are as good as fresh. The
+	// cache is also the only source of signatures when working offline.
+	cache := c.getTagCache()
+	if cache != nil && useCache {
+		switch sigs, err := cache.ResolveSignatures(ctx, pin); {
+		case err != nil:
+			logging.Warningf(ctx, "Could not query signatures cache: %s", err)
+		case len(sigs) != 0:
+			logging.Debugf(ctx, "Signatures cache hit for %s", pin)
+			return sigs, true, nil
+		}
+	}
+
+	resp, err := c.repo.ListMetadata(ctx, &api.ListMetadataRequest{
+		Package:  pin.PackageName,
+		Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+		Keys:     []string{signing.MetadataKey},
+	}, expectedCodes)
+	if err != nil {
+		return nil, false, c.rpcErr(err, nil)
+	}
+	for _, md := range resp.Metadata {
+		sigs = append(sigs, md.Value)
+	}
+
+	if cache != nil && len(sigs) != 0 {
+		if err := cache.AddSignatures(ctx, pin, sigs); err != nil {
+			logging.Warningf(ctx, "Could not add signatures to the cache: %s", err)
+		}
+		c.doBatchAwareOp(ctx, batchAwareOpSaveTagCache)
+	}
+	return sigs, false, nil
+}
+
+// checkSignatures verifies the instance has all signatures required by
+// the signature policies.
+func (c *clientImpl) checkSignatures(ctx context.Context, pin common.Pin) error {
+	if c.signatures == nil || !c.signatures.Required(pin.PackageName) {
+		return nil
+	}
+	sigs, cached, err := c.fetchSignatures(ctx, pin, true)
+	if err != nil {
+		return errors.Annotate(err, "fetching signatures").Err()
+	}
+	verr := c.signatures.Verify(pin, sigs)
+	if verr == nil || !cached {
+		return verr
+	}
+
+	// New signatures may have been attached since the cached ones were fetched
+	// (e.g. when rotating keys). Recheck with fresh ones.
+	fresh, _, err := c.fetchSignatures(ctx, pin, false)
+	if err != nil {
+		logging.Warningf(ctx, "Could not refetch signatures: %s", err)
+		return verr
+	}
+	return c.signatures.Verify(pin, fresh)
+}
+
 // How long to wait between retries in retryUntilReady.
 const retryDelay = 5 * time.Second
 
@@ -1924,6 +2072,113 @@ func (c *clientImpl) FetchInstanceTo(ctx context.Context, pin common.Pin, output
 	return nil
 }
 
+func (c *clientImpl) ImportBundle(ctx context.Context, r *bundle.Reader) (pins common.PinSlice, err error) {
+	if c.CacheDir == "" {
+		return nil, errors.Reason("importing a bundle requires the cache directory to be configured").Tag(cipderr.BadArgument).Err()
+	}
+
+	// Tags in the tag cache are keyed by the service host, they must match.
+	manifest := r.Manifest()
+	bundleURL, err := url.Parse(manifest.ServiceURL)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad service URL in the bundle manifest").Tag(cipderr.BadArgument).Err()
+	}
+	ourURL, err := url.Parse(c.ServiceURL)
+	if err != nil {
+		panic(err) // the URL has been validated in NewClient already
+	}
+	if bundleURL.Host != ourURL.Host {
+		return nil, errors.Reason("the bundle was exported from %s, but the client is using %s", manifest.ServiceURL, c.ServiceURL).Tag(cipderr.BadArgument).Err()
+	}
+
+	ctx, done := ui.NewActivity(ctx, nil, "")
+	defer done()
+
+	// The fetcher reads the current instance from the bundle. Instances are
+	// requested one by one, so the cache calls it at most once per instance.
+	var body io.Reader
+	var size int64
+	cache := &internal.InstanceCache{
+		FS: fs.NewFileSystem(filepath.Join(c.CacheDir, "instances"), ""),
+		Fetcher: func(ctx context.Context, pin common.Pin, output io.WriteSeeker) error {
+			if body == nil {
+				return errors.Reason("the bundle entry for %s was already consumed", pin).Tag(cipderr.IO).Err()
+			}
+			src := body
+			body = nil
+			return importBundleEntry(pin, src, size, output)
+		},
+	}
+	cache.Launch(ctx)
+	defer cache.Close(ctx)
+
+	tags := c.getTagCache()
+	for {
+		inst, entry, entrySize, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return pins, err
+		}
+		pin := inst.Pin()
+		body, size = entry, entrySize
+
+		cache.RequestInstances(ctx, []*internal.InstanceRequest{
+			{Context: ctx, Pin: pin},
+		})
+		res := cache.WaitInstance()
+		if res.Err != nil {
+			cipderr.AttachDetails(&res.Err, cipderr.Details{
+				Package: pin.PackageName,
+				Version: pin.InstanceID,
+			})
+			return pins, res.Err
+		}
+		if err := res.Source.Close(ctx, false); err != nil {
+			logging.Warningf(ctx, "Failed to close the instance file: %s", err)
+		}
+		logging.Infof(ctx, "Imported %s", pin)
+		pins = append(pins, pin)
+
+		// Only tags are cacheable. Refs can move, so they are never resolved
+		// through the cache.
+		for _, v := range inst.Versions {
+			if common.ValidateInstanceTag(v) != nil {
+				continue
+			}
+			if err := tags.AddTag(ctx, pin, v); err != nil {
+				logging.Warningf(ctx, "Could not add tag %q to the tag cache: %s", v, err)
+			}
+		}
+		if len(inst.Signatures) != 0 {
+			if err := tags.AddSignatures(ctx, pin, inst.Signatures); err != nil {
+				logging.Warningf(ctx, "Could not add signatures to the tag cache: %s", err)
+			}
+		}
+	}
+
+	c.doBatchAwareOp(ctx, batchAwareOpSaveTagCache)
+	return pins, nil
+}
+
+// importBundleEntry copies an instance file from a bundle into 'output' and
+// verifies its hash along the way.
+func importBundleEntry(pin common.Pin, src io.Reader, size int64, output io.Writer) error {
+	objRef := common.InstanceIDToObjectRef(pin.InstanceID)
+	hash := common.MustNewHash(objRef.HashAlgo)
+	switch n, err := io.Copy(io.MultiWriter(output, hash), src); {
+	case err != nil:
+		return errors.Annotate(err, "copying %s from the bundle", pin).Tag(cipderr.IO).Err()
+	case n != size:
+		return errors.Reason("the bundle entry for %s is truncated", pin).Tag(cipderr.BadArgument).Err()
+	}
+	if digest := common.HexDigest(hash); objRef.HexDigest != digest {
+		return errors.Reason("package hash mismatch: expecting %q, got %q", objRef.HexDigest, digest).Tag(cipderr.HashMismatch).Err()
+	}
+	return nil
+}
+
 // remoteFetchInstance fetches the package file into 'output' and verifies its
 // hash along the way. Assumes 'pin' is already validated.
 func (c *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pin, output io.WriteSeeker) (err error) {
@@ -1973,6 +2228,76 @@ func (c *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pin, ou
 	return
 }
 
+// remoteFetchInstanceDelta fetches a delta between 'basePin' and 'pin' from the
+// backend and uses it to assemble the package file in 'output' given the base
+// package file. Verifies the hash of the result.
+//
+// Fails if the backend doesn't have the delta ready. The caller is expected to
+// fall back to remoteFetchInstance in that case.
+func (c *clientImpl) remoteFetchInstanceDelta(ctx context.Context, base io.ReaderAt, basePin, pin common.Pin, output io.WriteSeeker) (err error) {
+	if err = common.ValidateInstanceID(pin.InstanceID, common.KnownHash); err != nil {
+		return
+	}
+	if err = common.ValidateInstanceID(basePin.InstanceID, common.KnownHash); err != nil {
+		return
+	}
+
+	objRef := common.InstanceIDToObjectRef(pin.InstanceID)
+
+	resp, err := c.repo.GetInstanceDelta(ctx, &api.GetInstanceDeltaRequest{
+		Package: pin.PackageName,
+		Base:    common.InstanceIDToObjectRef(basePin.InstanceID),
+		Target:  objRef,
+	}, expectedCodes)
+	switch {
+	case err != nil:
+		return c.rpcErr(err, nil)
+	case resp.Status != api.DeltaStatus_DELTA_READY:
+		return errors.Reason("the delta is not available yet (%s)", resp.Status).Err()
+	}
+	if err = common.ValidateObjectRef(resp.Delta, common.KnownHash); err != nil {
+		return errors.Annotate(err, "bad delta reference from the backend").Tag(cipderr.RPC).Err()
+	}
+
+	// Deltas are usually small, but may still be too large to be kept in memory.
+	tmp, err := os.CreateTemp(c.CacheDir, "delta_")
+	if err != nil {
+		return errors.Annotate(err, "creating a temp file for the delta").Tag(cipderr.IO).Err()
+	}
+	defer func() {
+		_ = tmp.Close()
+		if err := os.Remove(tmp.Name()); err != nil {
+			logging.Warningf(ctx, "Failed to remove the temp file: %s", err)
+		}
+	}()
+
+	logging.Infof(ctx, "Fetching the delta (%d bytes)", resp.DeltaSize)
+	deltaHash := common.MustNewHash(resp.Delta.HashAlgo)
+	if err = c.storage.download(ctx, resp.DeltaUrl.GetSignedUrl(), tmp, deltaHash); err != nil {
+		return
+	}
+	if digest := common.HexDigest(deltaHash); resp.Delta.HexDigest != digest {
+		return errors.Reason("delta hash mismatch: expecting %q, got %q", resp.Delta.HexDigest, digest).Tag(cipderr.HashMismatch).Err()
+	}
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return errors.Annotate(err, "rewinding the delta file").Tag(cipderr.IO).Err()
+	}
+
+	hash := common.MustNewHash(objRef.HashAlgo)
+	if _, err = delta.Apply(base, tmp, io.MultiWriter(output, hash)); err != nil {
+		return
+	}
+
+	// Make sure we assembled what we've asked for.
+	if digest := common.HexDigest(hash); objRef.HexDigest != digest {
+		return errors.Reason("package hash mismatch: expecting %q, got %q", objRef.HexDigest, digest).Tag(cipderr.HashMismatch).Err()
+	}
+
+	logging.Infof(ctx, "Assembled %s using the delta: %d files added, %d changed, %d removed",
+		pin, len(resp.AddedFiles), len(resp.ChangedFiles), len(resp.RemovedFiles))
+	return nil
+}
+
 func (c *clientImpl) FindDeployed(ctx context.Context) (common.PinSliceBySubdir, error) {
 	return c.deployer.FindDeployed(ctx)
 }
@@ -2087,6 +2412,8 @@ func (c *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSlice
 		pin       common.Pin         // the pin we are fetching
 		updates   []pinAction        // what to do with it when we get it
 		attempts  int                // incremented on a retry after detecting a corruption
+
+		signaturesVerified bool // true if publisher signatures were checked already
 	}
 
 	// Start fetching all packages we will need. Need pre-populate all activities
@@ -2180,6 +2507,19 @@ func (c *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSlice
 			checkDone()
 		}
 
+		// Check publisher signatures before deploying anything. Once passed, there's
+		// no need to recheck them on a retry. Note that if the first attempt failed
+		// to open the package, signatures are not checked yet and are checked on
+		// the retry instead.
+		if deployErr == nil && !state.signaturesVerified {
+			if err := c.checkSignatures(ctx, state.pin); err != nil {
+				deployErr = err
+				logging.Errorf(unzipCtx, "Signature check failed: %s", err)
+			} else {
+				state.signaturesVerified = true
+			}
+		}
+
 		// Keep installing stuff as long as it keeps working (no errors).
 		actionIdx := 0
 		for deployErr == nil && actionIdx < len(state.updates) {
@@ -2237,6 +2577,8 @@ func (c *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSlice
 						pin:       state.pin,
 						updates:   state.updates[actionIdx:],
 						attempts:  state.attempts + 1,
+
+						signaturesVerified: state.signaturesVerified,
 					},
 				},
 			})
@@ -2294,7 +2636,7 @@ func (c *clientImpl) makeRepairChecker(ctx context.Context, OverrideInstallMode
 				NeedsReinstall:  true,
 				ReinstallReason: fmt.Sprintf("expected to see instance %q, but saw %q", pin.InstanceID, state.Pin.InstanceID),
 			}
-		case OverrideInstallMode != "" && state.ActualInstallMode != OverrideInstallMode:
+		case OverrideInstallMode != "" && state.ActualInstallMode != pickInstallMode(OverrideInstallMode):
 			// This package is installed at the right version, but not with the
 			// requested override install mode.
 			return &RepairPlan{
@@ -2320,6 +2662,15 @@ func (c *clientImpl) makeRepairChecker(ctx context.Context, OverrideInstallMode
 	}
 }
 
+// pickInstallMode returns the install mode that is actually used on this
+// platform when the given mode is requested.
+func pickInstallMode(im pkg.InstallMode) pkg.InstallMode {
+	if picked, err := pkg.PickInstallMode(im); err == nil {
+		return picked
+	}
+	return im
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // pRPC error handling.
 
@@ -17,7 +17,13 @@ package cipd
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"math/rand"
 	"net/http"
 	"os"
@@ -42,14 +48,19 @@ import (
 
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/bundle"
+	"go.chromium.org/luci/cipd/client/cipd/configpb"
+	"go.chromium.org/luci/cipd/client/cipd/delta"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/internal"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/platform"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -1169,6 +1180,104 @@ func TestEnsurePackage(t *testing.T) {
 			})
 		}
 
+		t.Run("EnsurePackages checks signatures", func(t *ftt.Test) {
+			trusted := ed25519.NewKeyFromSeed([]byte(strings.Repeat("1", ed25519.SeedSize)))
+			untrusted := ed25519.NewKeyFromSeed([]byte(strings.Repeat("2", ed25519.SeedSize)))
+
+			der, err := x509.MarshalPKIXPublicKey(trusted.Public())
+			assert.Loosely(t, err, should.BeNil)
+			client.signatures, err = signing.NewVerifier([]*configpb.SignaturePolicy{
+				{
+					PackagePrefixes: []string{"pkg"},
+					PublicKeys:      []string{string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+				},
+			})
+			assert.Loosely(t, err, should.BeNil)
+
+			expectSignatures := func(keys ...ed25519.PrivateKey) {
+				resp := &api.ListMetadataResponse{}
+				for _, key := range keys {
+					sig, err := signing.Sign(key, pin)
+					assert.Loosely(t, err, should.BeNil)
+					resp.Metadata = append(resp.Metadata, &api.InstanceMetadata{
+						Key:   signing.MetadataKey,
+						Value: sig,
+					})
+				}
+				repo.expect(rpcCall{
+					method: "ListMetadata",
+					in: &api.ListMetadataRequest{
+						Package:  pin.PackageName,
+						Instance: common.InstanceIDToObjectRef(pin.InstanceID),
+						Keys:     []string{signing.MetadataKey},
+					},
+					out: resp,
+				})
+			}
+
+			t.Run("Signed", func(t *ftt.Test) {
+				setupRemoteInstance(body, pin, repo, storage)
+				expectSignatures(untrusted, trusted)
+				_, err := ensurePackages(common.PinSliceBySubdir{"1": {pin}})
+				assert.Loosely(t, err, should.BeNil)
+
+				// The second time uses cached signatures.
+				setupRemoteInstance(body, pin, repo, storage)
+				_, err = ensurePackages(common.PinSliceBySubdir{"1": {pin}, "2": {pin}})
+				assert.Loosely(t, err, should.BeNil)
+			})
+
+			t.Run("Unsigned", func(t *ftt.Test) {
+				setupRemoteInstance(body, pin, repo, storage)
+				expectSignatures()
+				_, err := ensurePackages(common.PinSliceBySubdir{"": {pin}})
+				assert.Loosely(t, err, should.ErrLike("is not signed"))
+				assert.Loosely(t, cipderr.ToCode(err), should.Equal(cipderr.NotAdmitted))
+
+				_, err = os.Stat(filepath.Join(client.Root, "test_name"))
+				assert.Loosely(t, os.IsNotExist(err), should.BeTrue)
+			})
+
+			t.Run("Wrongly signed", func(t *ftt.Test) {
+				setupRemoteInstance(body, pin, repo, storage)
+				expectSignatures(untrusted)
+				_, err := ensurePackages(common.PinSliceBySubdir{"": {pin}})
+				assert.Loosely(t, err, should.ErrLike("has no valid signature"))
+			})
+
+			t.Run("Wrongly signed, refetched after corruption", func(t *ftt.Test) {
+				cacheDir := setupInstanceCache(client, t)
+
+				// Populate the cache without checking signatures.
+				verifier := client.signatures
+				client.signatures = nil
+				setupRemoteInstance(body, pin, repo, storage)
+				_, err := ensurePackages(common.PinSliceBySubdir{"1": {pin}})
+				assert.Loosely(t, err, should.BeNil)
+				client.signatures = verifier
+
+				// Corrupt the zip directory at the end of the cached file, so the first
+				// attempt fails to even open the package.
+				f, err := os.OpenFile(filepath.Join(cacheDir, "instances", pin.InstanceID), os.O_RDWR, 0644)
+				assert.Loosely(t, err, should.BeNil)
+				_, err = f.Seek(-100, io.SeekEnd)
+				assert.Loosely(t, err, should.BeNil)
+				_, err = f.Write(bytes.Repeat([]byte{0}, 100))
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, f.Close(), should.BeNil)
+
+				// The retry fetches a good copy, but it still must not be installed.
+				setupRemoteInstance(body, pin, repo, storage)
+				expectSignatures(untrusted)
+				_, err = ensurePackages(common.PinSliceBySubdir{"2": {pin}})
+				assert.Loosely(t, err, should.ErrLike("has no valid signature"))
+				assert.Loosely(t, storage.downloads(), should.Equal(2))
+
+				_, err = os.Stat(filepath.Join(client.Root, "2", "test_name"))
+				assert.Loosely(t, os.IsNotExist(err), should.BeTrue)
+			})
+		})
+
 		t.Run("EnsurePackages uses instance cache", func(t *ftt.Test) {
 			setupRemoteInstance(body, pin, repo, storage)
 			cacheDir := setupInstanceCache(client, t)
@@ -1215,6 +1324,102 @@ func TestEnsurePackage(t *testing.T) {
 			assert.Loosely(t, storage.downloads(), should.Equal(2))
 		})
 
+		t.Run("EnsurePackages uses deltas", func(t *ftt.Test) {
+			setupRemoteInstance(body, pin, repo, storage)
+			cacheDir := setupInstanceCache(client, t)
+
+			// The initial fetch populates the cache.
+			_, err := ensurePackages(common.PinSliceBySubdir{"": {pin}})
+			assert.Loosely(t, err, should.BeNil)
+
+			// A new version of the package.
+			body2, pin2 := buildTestInstance("pkg", map[string]string{
+				"test_name": testFileBody,
+				"new_file":  "new file body",
+			})
+
+			deltaReq := &api.GetInstanceDeltaRequest{
+				Package: "pkg",
+				Base:    common.InstanceIDToObjectRef(pin.InstanceID),
+				Target:  common.InstanceIDToObjectRef(pin2.InstanceID),
+			}
+
+			t.Run("Delta is ready", func(t *ftt.Test) {
+				plan, err := delta.Compute(
+					bytes.NewReader(body), int64(len(body)),
+					bytes.NewReader(body2), int64(len(body2)))
+				assert.Loosely(t, err, should.BeNil)
+				enc := bytes.Buffer{}
+				assert.Loosely(t, plan.Encode(&enc, bytes.NewReader(body2)), should.BeNil)
+
+				deltaURL := "https://example.com/fake/delta"
+				storage.putStored(deltaURL, enc.String())
+
+				h := sha256.Sum256(enc.Bytes())
+				repo.expect(rpcCall{
+					method: "GetInstanceDelta",
+					in:     deltaReq,
+					out: &api.InstanceDelta{
+						Status:     api.DeltaStatus_DELTA_READY,
+						DeltaUrl:   &api.ObjectURL{SignedUrl: deltaURL},
+						Delta:      &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256, HexDigest: hex.EncodeToString(h[:])},
+						DeltaSize:  int64(enc.Len()),
+						AddedFiles: plan.Added,
+					},
+				})
+
+				_, err = ensurePackages(common.PinSliceBySubdir{"": {pin2}})
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, storage.downloads(), should.Equal(2)) // the package and the delta
+
+				// Assembled the correct file.
+				cached, err := os.ReadFile(filepath.Join(cacheDir, "instances", pin2.InstanceID))
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, cached, should.Match(body2))
+
+				newFile, err := os.ReadFile(filepath.Join(client.Root, "new_file"))
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, string(newFile), should.Equal("new file body"))
+			})
+
+			t.Run("Delta is pending", func(t *ftt.Test) {
+				repo.expect(rpcCall{
+					method: "GetInstanceDelta",
+					in:     deltaReq,
+					out:    &api.InstanceDelta{Status: api.DeltaStatus_DELTA_PENDING},
+				})
+				setupRemoteInstance(body2, pin2, repo, storage)
+
+				_, err = ensurePackages(common.PinSliceBySubdir{"": {pin2}})
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, storage.downloads(), should.Equal(2)) // both packages fully
+			})
+
+			t.Run("Delta is broken", func(t *ftt.Test) {
+				deltaURL := "https://example.com/fake/delta"
+				storage.putStored(deltaURL, "garbage")
+
+				h := sha256.Sum256([]byte("garbage"))
+				repo.expect(rpcCall{
+					method: "GetInstanceDelta",
+					in:     deltaReq,
+					out: &api.InstanceDelta{
+						Status:   api.DeltaStatus_DELTA_READY,
+						DeltaUrl: &api.ObjectURL{SignedUrl: deltaURL},
+						Delta:    &api.ObjectRef{HashAlgo: api.HashAlgo_SHA256, HexDigest: hex.EncodeToString(h[:])},
+					},
+				})
+				setupRemoteInstance(body2, pin2, repo, storage)
+
+				_, err = ensurePackages(common.PinSliceBySubdir{"": {pin2}})
+				assert.Loosely(t, err, should.BeNil)
+
+				cached, err := os.ReadFile(filepath.Join(cacheDir, "instances", pin2.InstanceID))
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, cached, should.Match(body2))
+			})
+		})
+
 		// TODO: Add more tests.
 	})
 }
@@ -1222,6 +1427,99 @@ func TestEnsurePackage(t *testing.T) {
 ////////////////////////////////////////////////////////////////////////////////
 // Client self-update.
 
+func TestImportBundle(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With mocks", t, func(t *ftt.Test) {
+		ctx := makeTestContext()
+		client, _, _, _ := mockedCipdClient(t)
+
+		body1, pin1 := buildTestInstance("pkg/a", map[string]string{"file_a": "body a"})
+		body2, pin2 := buildTestInstance("pkg/b", map[string]string{"file_b": "body b"})
+
+		makeBundle := func(serviceURL string, bodies ...[]byte) *bundle.Reader {
+			m := &bundle.Manifest{
+				FormatVersion: bundle.FormatVersion,
+				ServiceURL:    serviceURL,
+				Instances: []bundle.Instance{
+					{
+						Package:    pin1.PackageName,
+						InstanceID: pin1.InstanceID,
+						Versions:   []string{"version:1", "latest"},
+						Signatures: [][]byte{[]byte("signature")},
+					},
+					{Package: pin2.PackageName, InstanceID: pin2.InstanceID},
+				},
+			}
+			out := bytes.Buffer{}
+			w, err := bundle.NewWriter(&out, m)
+			assert.Loosely(t, err, should.BeNil)
+			for i, body := range bodies {
+				assert.Loosely(t, w.AddInstance(m.Instances[i].Pin(), bytesInstance(body)), should.BeNil)
+			}
+			if len(bodies) == len(m.Instances) {
+				assert.Loosely(t, w.Close(), should.BeNil)
+			}
+			r, err := bundle.NewReader(&out)
+			assert.Loosely(t, err, should.BeNil)
+			return r
+		}
+
+		t.Run("Works", func(t *ftt.Test) {
+			setupInstanceCache(client, t)
+
+			pins, err := client.ImportBundle(ctx, makeBundle(client.ServiceURL, body1, body2))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, pins, should.Match(common.PinSlice{pin1, pin2}))
+
+			// Resolves tags and installs packages without any RPCs.
+			pin, err := client.ResolveVersion(ctx, "pkg/a", "version:1")
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, pin, should.Equal(pin1))
+
+			// Signatures are available too.
+			sigs, err := client.FetchSignatures(ctx, pin1)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, sigs, should.Match([][]byte{[]byte("signature")}))
+
+			_, err = client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin1, pin2}}, nil)
+			assert.Loosely(t, err, should.BeNil)
+			got, err := os.ReadFile(filepath.Join(client.Root, "file_b"))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, string(got), should.Equal("body b"))
+
+			// Importing again is a noop.
+			pins, err = client.ImportBundle(ctx, makeBundle(client.ServiceURL, body1, body2))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, pins, should.Match(common.PinSlice{pin1, pin2}))
+		})
+
+		t.Run("Hash mismatch", func(t *ftt.Test) {
+			setupInstanceCache(client, t)
+			pins, err := client.ImportBundle(ctx, makeBundle(client.ServiceURL, body1, body1))
+			assert.Loosely(t, err, should.ErrLike("package hash mismatch"))
+			assert.Loosely(t, pins, should.Match(common.PinSlice{pin1}))
+		})
+
+		t.Run("Truncated bundle", func(t *ftt.Test) {
+			setupInstanceCache(client, t)
+			_, err := client.ImportBundle(ctx, makeBundle(client.ServiceURL, body1))
+			assert.Loosely(t, err, should.ErrLike("the bundle is truncated"))
+		})
+
+		t.Run("Wrong service", func(t *ftt.Test) {
+			setupInstanceCache(client, t)
+			_, err := client.ImportBundle(ctx, makeBundle("https://another.example.com", body1, body2))
+			assert.Loosely(t, err, should.ErrLike("the bundle was exported from https://another.example.com"))
+		})
+
+		t.Run("No cache dir", func(t *ftt.Test) {
+			_, err := client.ImportBundle(ctx, makeBundle(client.ServiceURL, body1, body2))
+			assert.Loosely(t, err, should.ErrLike("requires the cache directory"))
+		})
+	})
+}
+
 func TestMaybeUpdateClient(t *testing.T) {
 	t.Parallel()
 
@@ -51,10 +51,16 @@ const (
 // If the config file is present, but can't be read or parsed, all CIPD client
 // calls will fail.
 type ClientConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plugins       *ClientConfig_Plugins  `protobuf:"bytes,1,opt,name=plugins,proto3" json:"plugins,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Plugins *ClientConfig_Plugins  `protobuf:"bytes,1,opt,name=plugins,proto3" json:"plugins,omitempty"`
+	// Signatures the packages must have to be installed.
+	//
+	// If a package matches some policy, 'cipd ensure' refuses to install it
+	// unless it has a valid publisher signature made by one of the policy keys.
+	// If a package matches multiple policies, all of them must be satisfied.
+	SignaturePolicies []*SignaturePolicy `protobuf:"bytes,2,rep,name=signature_policies,json=signaturePolicies,proto3" json:"signature_policies,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ClientConfig) Reset() {
@@ -94,6 +100,13 @@ func (x *ClientConfig) GetPlugins() *ClientConfig_Plugins {
 	return nil
 }
 
+func (x *ClientConfig) GetSignaturePolicies() []*SignaturePolicy {
+	if x != nil {
+		return x.SignaturePolicies
+	}
+	return nil
+}
+
 // Plugin defines how to execute a plugin subprocess.
 type Plugin struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -149,6 +162,70 @@ func (x *Plugin) GetArgs() []string {
 	return nil
 }
 
+// SignaturePolicy defines what keys are trusted to sign some packages.
+//
+// Signatures are attached by publishers to package instances as metadata (see
+// 'cipd sign' subcommand). They are verified locally using keys listed here.
+type SignaturePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Package name prefixes this policy applies to, e.g. "infra/tools".
+	//
+	// A prefix matches the package with the same name and all packages under it.
+	// An empty prefix matches all packages.
+	PackagePrefixes []string `protobuf:"bytes,1,rep,name=package_prefixes,json=packagePrefixes,proto3" json:"package_prefixes,omitempty"`
+	// PEM-encoded ed25519 public keys trusted to sign the packages. Required.
+	//
+	// A public key can be generated from a private one with e.g.
+	// `openssl pkey -in private.pem -pubout`.
+	PublicKeys    []string `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignaturePolicy) Reset() {
+	*x = SignaturePolicy{}
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignaturePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignaturePolicy) ProtoMessage() {}
+
+func (x *SignaturePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignaturePolicy.ProtoReflect.Descriptor instead.
+func (*SignaturePolicy) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignaturePolicy) GetPackagePrefixes() []string {
+	if x != nil {
+		return x.PackagePrefixes
+	}
+	return nil
+}
+
+func (x *SignaturePolicy) GetPublicKeys() []string {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
 // Plugins the CIPD client will load.
 type ClientConfig_Plugins struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -160,7 +237,7 @@ type ClientConfig_Plugins struct {
 
 func (x *ClientConfig_Plugins) Reset() {
 	*x = ClientConfig_Plugins{}
-	mi := &file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +249,7 @@ func (x *ClientConfig_Plugins) String() string {
 func (*ClientConfig_Plugins) ProtoMessage() {}
 
 func (x *ClientConfig_Plugins) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,23 +280,34 @@ var file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_rawDesc = s
 	0x6e, 0x74, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62,
 	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x63,
 	0x69, 0x70, 0x64, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x67, 0x22, 0xeb, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x12, 0x42, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
 	0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x07, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x1a, 0x43, 0x0a, 0x07, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x06, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x42, 0x30, 0x5a, 0x2e, 0x67,
-	0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c,
-	0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f,
-	0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x43, 0x0a, 0x07, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x69, 0x70, 0x64, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x52, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x2e, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6d, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22,
+	0x5d, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x30,
+	0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -234,20 +322,22 @@ func file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_rawDescGZI
 	return file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_goTypes = []any{
 	(*ClientConfig)(nil),         // 0: cipd.client.config.ClientConfig
 	(*Plugin)(nil),               // 1: cipd.client.config.Plugin
-	(*ClientConfig_Plugins)(nil), // 2: cipd.client.config.ClientConfig.Plugins
+	(*SignaturePolicy)(nil),      // 2: cipd.client.config.SignaturePolicy
+	(*ClientConfig_Plugins)(nil), // 3: cipd.client.config.ClientConfig.Plugins
 }
 var file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_depIdxs = []int32{
-	2, // 0: cipd.client.config.ClientConfig.plugins:type_name -> cipd.client.config.ClientConfig.Plugins
-	1, // 1: cipd.client.config.ClientConfig.Plugins.admission:type_name -> cipd.client.config.Plugin
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: cipd.client.config.ClientConfig.plugins:type_name -> cipd.client.config.ClientConfig.Plugins
+	2, // 1: cipd.client.config.ClientConfig.signature_policies:type_name -> cipd.client.config.SignaturePolicy
+	1, // 2: cipd.client.config.ClientConfig.Plugins.admission:type_name -> cipd.client.config.Plugin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_init() }
@@ -261,7 +351,7 @@ func file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_rawDesc), len(file_go_chromium_org_luci_cipd_client_cipd_configpb_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,384 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package delta implements file-level deltas between CIPD package instances.
+//
+// A delta describes how to assemble a target instance file given some base
+// instance file. It is a sequence of operations, each either copying a range
+// of bytes from the base file or inserting some literal bytes carried by the
+// delta itself. Files whose compressed bodies are identical in both instances
+// are copied from the base, everything else (new and modified files, zip
+// headers, the central directory) is carried as literals.
+//
+// Encoded deltas have the following format:
+//
+//	"CIPDDLT1" magic
+//	uvarint target file size
+//	sequence of operations, each is one of:
+//	  0x01 uvarint(offset) uvarint(length)   copy from the base file
+//	  0x02 uvarint(length) <length bytes>    literal bytes
+//	0x00 end marker
+//
+// The caller is responsible for verifying the hash of the assembled file.
+package delta
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"github.com/klauspost/compress/zip"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+// magic is the header of encoded deltas.
+var magic = []byte("CIPDDLT1")
+
+const (
+	opEnd     byte = 0
+	opCopy    byte = 1
+	opLiteral byte = 2
+)
+
+// OpKind is a kind of a delta operation.
+type OpKind byte
+
+const (
+	// OpCopy copies a range of bytes from the base file.
+	OpCopy OpKind = OpKind(opCopy)
+	// OpLiteral inserts a range of bytes from the target file.
+	OpLiteral OpKind = OpKind(opLiteral)
+)
+
+// Op is a single operation of a delta plan.
+type Op struct {
+	Kind   OpKind // either OpCopy or OpLiteral
+	Offset int64  // offset in the base file for OpCopy, in the target for OpLiteral
+	Length int64  // number of bytes to copy
+}
+
+// Plan describes how to assemble the target file from the base file.
+//
+// It refers to byte ranges of the target file instead of holding them. Use
+// Encode to produce the actual delta.
+type Plan struct {
+	TargetSize int64 // size of the target instance file
+	Ops        []Op  // operations to perform, in order
+
+	Added   []string // files present only in the target, sorted
+	Changed []string // files present in both, but with different content, sorted
+	Removed []string // files present only in the base, sorted
+}
+
+// LiteralSize is the total number of bytes carried by the delta as literals.
+func (p *Plan) LiteralSize() int64 {
+	total := int64(0)
+	for _, op := range p.Ops {
+		if op.Kind == OpLiteral {
+			total += op.Length
+		}
+	}
+	return total
+}
+
+// EncodedSize is the size of the delta produced by Encode.
+func (p *Plan) EncodedSize() int64 {
+	total := int64(len(magic)) + uvarintLen(p.TargetSize) + 1
+	for _, op := range p.Ops {
+		switch op.Kind {
+		case OpCopy:
+			total += 1 + uvarintLen(op.Offset) + uvarintLen(op.Length)
+		case OpLiteral:
+			total += 1 + uvarintLen(op.Length) + op.Length
+		}
+	}
+	return total
+}
+
+// Encode writes the delta to 'w', reading literals from the target file.
+func (p *Plan) Encode(w io.Writer, target io.ReaderAt) error {
+	bw := bufio.NewWriter(w)
+	buf := make([]byte, 2*binary.MaxVarintLen64+1)
+
+	if _, err := bw.Write(magic); err != nil {
+		return err
+	}
+	if _, err := bw.Write(binary.AppendUvarint(buf[:0], uint64(p.TargetSize))); err != nil {
+		return err
+	}
+
+	for _, op := range p.Ops {
+		hdr := append(buf[:0], byte(op.Kind))
+		switch op.Kind {
+		case OpCopy:
+			hdr = binary.AppendUvarint(hdr, uint64(op.Offset))
+			hdr = binary.AppendUvarint(hdr, uint64(op.Length))
+		case OpLiteral:
+			hdr = binary.AppendUvarint(hdr, uint64(op.Length))
+		default:
+			return errors.Reason("unknown delta op kind %d", op.Kind).Err()
+		}
+		if _, err := bw.Write(hdr); err != nil {
+			return err
+		}
+		if op.Kind == OpLiteral {
+			switch n, err := io.Copy(bw, io.NewSectionReader(target, op.Offset, op.Length)); {
+			case err != nil:
+				return err
+			case n != op.Length:
+				return errors.Reason("the target file is truncated at offset %d", op.Offset+n).Err()
+			}
+		}
+	}
+
+	if err := bw.WriteByte(opEnd); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// Compute calculates a plan to assemble the target instance file from the base
+// one.
+//
+// Both files must be valid zip archives. Files whose raw (compressed) bodies
+// are byte-to-byte identical are copied from the base, regardless of their
+// names.
+func Compute(base io.ReaderAt, baseSize int64, target io.ReaderAt, targetSize int64) (*Plan, error) {
+	baseZip, err := zip.NewReader(base, baseSize)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening the base instance").Err()
+	}
+	targetZip, err := zip.NewReader(target, targetSize)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening the target instance").Err()
+	}
+
+	// Index base files by their name and by properties of their raw body.
+	baseByName := make(map[string]*zip.File, len(baseZip.File))
+	baseByKey := map[rawKey][]*zip.File{}
+	for _, f := range baseZip.File {
+		baseByName[f.Name] = f
+		if f.CompressedSize64 != 0 {
+			key := keyOf(f)
+			baseByKey[key] = append(baseByKey[key], f)
+		}
+	}
+
+	digests := map[*zip.File][sha256.Size]byte{}
+	digest := func(f *zip.File) ([sha256.Size]byte, error) {
+		if d, ok := digests[f]; ok {
+			return d, nil
+		}
+		var d [sha256.Size]byte
+		r, err := f.OpenRaw()
+		if err != nil {
+			return d, err
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return d, err
+		}
+		h.Sum(d[:0])
+		digests[f] = d
+		return d, nil
+	}
+
+	// findBase returns a base file with the same raw body as 'f' or nil.
+	findBase := func(f *zip.File) (*zip.File, error) {
+		candidates := baseByKey[keyOf(f)]
+		if len(candidates) == 0 {
+			return nil, nil
+		}
+		// Prefer the file with the same name, it is the most likely match.
+		if same := baseByName[f.Name]; same != nil && keyOf(same) == keyOf(f) {
+			candidates = append([]*zip.File{same}, candidates...)
+		}
+		want, err := digest(f)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range candidates {
+			switch got, err := digest(c); {
+			case err != nil:
+				return nil, err
+			case bytes.Equal(got[:], want[:]):
+				return c, nil
+			}
+		}
+		return nil, nil
+	}
+
+	type match struct {
+		targetOff int64
+		baseOff   int64
+		length    int64
+	}
+	var matches []match
+
+	plan := &Plan{TargetSize: targetSize}
+	seen := make(map[string]bool, len(targetZip.File))
+
+	for _, f := range targetZip.File {
+		seen[f.Name] = true
+
+		var b *zip.File
+		if f.CompressedSize64 != 0 {
+			if b, err = findBase(f); err != nil {
+				return nil, errors.Annotate(err, "reading %q", f.Name).Err()
+			}
+		}
+		if b != nil {
+			targetOff, err := f.DataOffset()
+			if err != nil {
+				return nil, errors.Annotate(err, "reading the target header of %q", f.Name).Err()
+			}
+			baseOff, err := b.DataOffset()
+			if err != nil {
+				return nil, errors.Annotate(err, "reading the base header of %q", b.Name).Err()
+			}
+			matches = append(matches, match{targetOff, baseOff, int64(f.CompressedSize64)})
+		}
+
+		switch same := baseByName[f.Name]; {
+		case same == nil:
+			plan.Added = append(plan.Added, f.Name)
+		case b != same || same.ExternalAttrs != f.ExternalAttrs:
+			if f.CompressedSize64 == 0 && same.CompressedSize64 == 0 && same.CRC32 == f.CRC32 && same.ExternalAttrs == f.ExternalAttrs {
+				continue // both are empty
+			}
+			plan.Changed = append(plan.Changed, f.Name)
+		}
+	}
+
+	for _, f := range baseZip.File {
+		if !seen[f.Name] {
+			plan.Removed = append(plan.Removed, f.Name)
+		}
+	}
+
+	sort.Strings(plan.Added)
+	sort.Strings(plan.Changed)
+	sort.Strings(plan.Removed)
+
+	// Fill gaps between copied bodies with literals.
+	sort.Slice(matches, func(i, j int) bool { return matches[i].targetOff < matches[j].targetOff })
+	cursor := int64(0)
+	for _, m := range matches {
+		if m.targetOff < cursor {
+			return nil, errors.Reason("overlapping files in the target instance at offset %d", m.targetOff).Err()
+		}
+		if m.targetOff > cursor {
+			plan.Ops = append(plan.Ops, Op{Kind: OpLiteral, Offset: cursor, Length: m.targetOff - cursor})
+		}
+		plan.Ops = append(plan.Ops, Op{Kind: OpCopy, Offset: m.baseOff, Length: m.length})
+		cursor = m.targetOff + m.length
+	}
+	if cursor < targetSize {
+		plan.Ops = append(plan.Ops, Op{Kind: OpLiteral, Offset: cursor, Length: targetSize - cursor})
+	}
+
+	return plan, nil
+}
+
+// Apply assembles the target file by applying the encoded delta to the base.
+//
+// Returns the number of bytes written to 'out'. The caller is responsible for
+// verifying the hash of the result.
+func Apply(base io.ReaderAt, delta io.Reader, out io.Writer) (int64, error) {
+	r := bufio.NewReader(delta)
+
+	hdr := make([]byte, len(magic))
+	if _, err := io.ReadFull(r, hdr); err != nil || !bytes.Equal(hdr, magic) {
+		return 0, errors.Reason("not a CIPD instance delta").Tag(cipderr.BadArgument).Err()
+	}
+	targetSize, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, corrupted(err)
+	}
+
+	written := int64(0)
+	for {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return written, corrupted(err)
+		}
+
+		var src io.Reader
+		var length uint64
+		switch kind {
+		case opEnd:
+			if written != int64(targetSize) {
+				return written, errors.Reason("assembled %d bytes, but the delta expects %d", written, targetSize).Tag(cipderr.BadArgument).Err()
+			}
+			return written, nil
+		case opCopy:
+			offset, err := binary.ReadUvarint(r)
+			if err != nil {
+				return written, corrupted(err)
+			}
+			if length, err = binary.ReadUvarint(r); err != nil {
+				return written, corrupted(err)
+			}
+			src = io.NewSectionReader(base, int64(offset), int64(length))
+		case opLiteral:
+			if length, err = binary.ReadUvarint(r); err != nil {
+				return written, corrupted(err)
+			}
+			src = r
+		default:
+			return written, errors.Reason("unknown delta op kind %d", kind).Tag(cipderr.BadArgument).Err()
+		}
+
+		n, err := io.CopyN(out, src, int64(length))
+		written += n
+		switch {
+		case err == io.EOF && kind == opCopy:
+			return written, errors.Reason("the base file is too short").Tag(cipderr.BadArgument).Err()
+		case err == io.EOF:
+			return written, corrupted(io.ErrUnexpectedEOF)
+		case err != nil:
+			return written, errors.Annotate(err, "assembling the instance").Tag(cipderr.IO).Err()
+		}
+	}
+}
+
+// rawKey identifies a raw body of a file inside a zip archive.
+type rawKey struct {
+	method uint16
+	crc32  uint32
+	csize  uint64
+	usize  uint64
+}
+
+func keyOf(f *zip.File) rawKey {
+	return rawKey{f.Method, f.CRC32, f.CompressedSize64, f.UncompressedSize64}
+}
+
+func uvarintLen(v int64) int64 {
+	var buf [binary.MaxVarintLen64]byte
+	return int64(binary.PutUvarint(buf[:], uint64(v)))
+}
+
+func corrupted(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return errors.Annotate(err, "corrupted delta").Tag(cipderr.BadArgument).Err()
+}
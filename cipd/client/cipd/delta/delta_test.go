@@ -0,0 +1,159 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delta
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/klauspost/compress/zip"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+type testFile struct {
+	name string
+	body []byte
+}
+
+func makeZip(t testing.TB, files ...testFile) []byte {
+	out := bytes.Buffer{}
+	zw := zip.NewWriter(&out)
+	for _, f := range files {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(f.body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+func randomBlob(seed int64, size int) []byte {
+	blob := make([]byte, size)
+	rand.New(rand.NewSource(seed)).Read(blob)
+	return blob
+}
+
+func roundTrip(t testing.TB, base, target []byte) (*Plan, []byte) {
+	plan, err := Compute(bytes.NewReader(base), int64(len(base)), bytes.NewReader(target), int64(len(target)))
+	assert.Loosely(t, err, should.BeNil, truth.LineContext())
+
+	enc := bytes.Buffer{}
+	assert.Loosely(t, plan.Encode(&enc, bytes.NewReader(target)), should.BeNil, truth.LineContext())
+	assert.Loosely(t, int64(enc.Len()), should.Equal(plan.EncodedSize()), truth.LineContext())
+
+	out := bytes.Buffer{}
+	n, err := Apply(bytes.NewReader(base), bytes.NewReader(enc.Bytes()), &out)
+	assert.Loosely(t, err, should.BeNil, truth.LineContext())
+	assert.Loosely(t, n, should.Equal(int64(len(target))), truth.LineContext())
+	assert.Loosely(t, out.Bytes(), should.Match(target), truth.LineContext())
+
+	return plan, enc.Bytes()
+}
+
+func TestDelta(t *testing.T) {
+	t.Parallel()
+
+	big1 := randomBlob(1, 100*1024)
+	big2 := randomBlob(2, 100*1024)
+	big3 := randomBlob(3, 100*1024)
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		base := makeZip(t,
+			testFile{"a", big1},
+			testFile{"b", big2},
+			testFile{"c", []byte("removed")},
+			testFile{"empty", nil},
+		)
+		target := makeZip(t,
+			testFile{"a", big1},
+			testFile{"b", []byte("modified")},
+			testFile{"d", big3},
+			testFile{"empty", nil},
+			testFile{"moved", big2},
+		)
+
+		plan, enc := roundTrip(t, base, target)
+		assert.Loosely(t, plan.Added, should.Match([]string{"d", "moved"}))
+		assert.Loosely(t, plan.Changed, should.Match([]string{"b"}))
+		assert.Loosely(t, plan.Removed, should.Match([]string{"c"}))
+
+		// Only "d" is carried by the delta, "a" and "moved" are copied.
+		assert.Loosely(t, plan.LiteralSize(), should.BeLessThan(int64(len(big3)+4096)))
+		assert.Loosely(t, len(enc), should.BeLessThan(len(target)/2))
+	})
+
+	ftt.Run("Identical instances", t, func(t *ftt.Test) {
+		inst := makeZip(t, testFile{"a", big1}, testFile{"b", big2})
+		plan, _ := roundTrip(t, inst, inst)
+		assert.Loosely(t, plan.Added, should.HaveLength(0))
+		assert.Loosely(t, plan.Changed, should.HaveLength(0))
+		assert.Loosely(t, plan.Removed, should.HaveLength(0))
+		assert.Loosely(t, plan.LiteralSize(), should.BeLessThan(int64(1024)))
+	})
+
+	ftt.Run("Unrelated instances", t, func(t *ftt.Test) {
+		base := makeZip(t, testFile{"a", big1})
+		target := makeZip(t, testFile{"a", big2})
+		plan, _ := roundTrip(t, base, target)
+		assert.Loosely(t, plan.Changed, should.Match([]string{"a"}))
+		assert.Loosely(t, plan.LiteralSize(), should.Equal(int64(len(target))))
+	})
+
+	ftt.Run("Not a zip", t, func(t *ftt.Test) {
+		inst := makeZip(t, testFile{"a", big1})
+		_, err := Compute(bytes.NewReader(inst), int64(len(inst)), bytes.NewReader([]byte("zzz")), 3)
+		assert.Loosely(t, err, should.ErrLike("opening the target instance"))
+	})
+
+	ftt.Run("Apply errors", t, func(t *ftt.Test) {
+		base := makeZip(t, testFile{"a", big1}, testFile{"b", big2})
+		target := makeZip(t, testFile{"a", big1}, testFile{"c", big3})
+		_, enc := roundTrip(t, base, target)
+
+		apply := func(base, enc []byte) error {
+			_, err := Apply(bytes.NewReader(base), bytes.NewReader(enc), &bytes.Buffer{})
+			return err
+		}
+
+		t.Run("Bad magic", func(t *ftt.Test) {
+			err := apply(base, []byte("garbage"))
+			assert.Loosely(t, err, should.ErrLike("not a CIPD instance delta"))
+			assert.Loosely(t, cipderr.ToCode(err), should.Equal(cipderr.BadArgument))
+		})
+
+		t.Run("Truncated", func(t *ftt.Test) {
+			err := apply(base, enc[:len(enc)-100])
+			assert.Loosely(t, err, should.ErrLike("corrupted delta"))
+		})
+
+		t.Run("Wrong base", func(t *ftt.Test) {
+			err := apply(base[:1000], enc)
+			assert.Loosely(t, err, should.ErrLike("the base file is too short"))
+		})
+	})
+}
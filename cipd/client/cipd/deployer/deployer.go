@@ -70,6 +70,10 @@ import (
 // copied to the site root directory and .cipd/pkgs/* contains only metadata,
 // such as description and manifest files with a list of extracted files (to
 // know what to uninstall).
+//
+// "hardlink" install method is similar to "copy", except regular files are
+// hardlinked from a content store (see store.go) instead of being moved from
+// .cipd/pkgs/*.
 
 // DeployedPackage represents a state of the deployed (or partially deployed)
 // package, as returned by CheckDeployed.
@@ -175,12 +179,35 @@ type Deployer interface {
 	// the one specified in the pin, returns an error.
 	RepairDeployed(ctx context.Context, subdir string, pin common.Pin, overrideInstallMode pkg.InstallMode, maxThreads int, params RepairParams) error
 
+	// CollectGarbage removes blobs not used by any site root from the content
+	// store used by "hardlink" install mode.
+	//
+	// Blobs are also released when packages are removed or updated, so this is
+	// needed only to catch leftovers (e.g. from site roots deleted manually).
+	// Does a full scan of the store at most once a day, all other calls are
+	// noops.
+	CollectGarbage(ctx context.Context) error
+
 	// FS returns an fs.FileSystem rooted at the deployer root dir.
 	FS() fs.FileSystem
 }
 
 // New return default Deployer implementation.
+//
+// Packages installed in "hardlink" mode use a content store local to the site
+// root. Use NewWithContentStore to share the content store between site roots.
 func New(root string) Deployer {
+	return NewWithContentStore(root, "")
+}
+
+// NewWithContentStore returns default Deployer implementation that uses the
+// given directory as a content store for packages installed in "hardlink"
+// mode.
+//
+// The content store can be shared by many site roots, as long as they all are
+// on the same file system. If storeDir is empty, uses a content store local to
+// the site root.
+func NewWithContentStore(root, storeDir string) Deployer {
 	var err error
 	if root == "" {
 		err = errors.Reason("site root path is not provided").Tag(cipderr.BadArgument).Err()
@@ -190,11 +217,21 @@ func New(root string) Deployer {
 			err = errors.Annotate(err, "bad site root path").Tag(cipderr.BadArgument).Err()
 		}
 	}
+	if err == nil {
+		if storeDir == "" {
+			storeDir = filepath.Join(root, filepath.FromSlash(contentStoreDir))
+		} else if storeDir, err = filepath.Abs(filepath.Clean(storeDir)); err != nil {
+			err = errors.Annotate(err, "bad content store path").Tag(cipderr.BadArgument).Err()
+		}
+	}
 	if err != nil {
 		return errDeployer{err}
 	}
 	trashDir := filepath.Join(root, fs.SiteServiceDir, "trash")
-	return &deployerImpl{fs.NewFileSystem(root, trashDir)}
+	return &deployerImpl{
+		fs:    fs.NewFileSystem(root, trashDir),
+		store: &contentStore{dir: storeDir},
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -220,6 +257,8 @@ func (d errDeployer) RepairDeployed(context.Context, string, common.Pin, pkg.Ins
 	return d.err
 }
 
+func (d errDeployer) CollectGarbage(context.Context) error { return d.err }
+
 func (d errDeployer) FS() fs.FileSystem { return nil }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -285,7 +324,8 @@ func writeDescription(d *description, w io.Writer) error {
 
 // deployerImpl implements Deployer interface.
 type deployerImpl struct {
-	fs fs.FileSystem
+	fs    fs.FileSystem
+	store *contentStore
 }
 
 func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst pkg.Instance, overrideInstallMode pkg.InstallMode, maxThreads int) (pin common.Pin, err error) {
@@ -412,10 +452,10 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 		}
 	}()
 
-	// When using 'copy' install mode all files (except .cipdpkg/*) are moved away
-	// from 'destPath', leaving only an empty husk with directory structure.
-	// Remove it to save some inodes.
-	if installMode == pkg.InstallModeCopy {
+	// When using 'copy' or 'hardlink' install mode all files (except .cipdpkg/*)
+	// are moved away from 'destPath', leaving only an empty husk with directory
+	// structure. Remove it to save some inodes.
+	if installMode == pkg.InstallModeCopy || installMode == pkg.InstallModeHardlink {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -432,12 +472,17 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst p
 		}()
 	}
 
-	// Remove no longer present files from the site root directory.
+	// Remove no longer present files from the site root directory. If the
+	// previous version was hardlinked, some of its blobs in the content store may
+	// be unused now.
 	if len(prevManifest.Files) > 0 {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			d.removeFromSiteRoot(ctx, subdir, prevManifest.Files, keep)
+			if prevManifest.ActualInstallMode == pkg.InstallModeHardlink {
+				d.store.release(ctx, prevManifest.Files)
+			}
 		}()
 	}
 
@@ -586,6 +631,9 @@ func (d *deployerImpl) RemoveDeployed(ctx context.Context, subdir, packageName s
 		return nil
 	case deployed.Deployed:
 		d.removeFromSiteRoot(ctx, subdir, deployed.Manifest.Files, nil)
+		if deployed.ActualInstallMode == pkg.InstallModeHardlink {
+			d.store.release(ctx, deployed.Manifest.Files)
+		}
 	default:
 		// The package was partially installed in the guts, but not into the site
 		// root. We can just remove the guts thus forgetting about the package.
@@ -631,6 +679,10 @@ func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, pin co
 	installMode := p.InstallMode
 	if overrideInstallMode != "" {
 		installMode = overrideInstallMode
+	} else if p.ActualInstallMode == pkg.InstallModeHardlink {
+		// Hardlink mode is never requested by packages themselves, it is always an
+		// override. Keep using it when repairing hardlinked packages.
+		installMode = pkg.InstallModeHardlink
 	}
 
 	// See the comment about locking in DeployInstance.
@@ -725,7 +777,7 @@ func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, pin co
 
 	// Cleanup empty directories left in the guts after files have been moved
 	// away, just like DeployInstance does. Best effort.
-	if installMode == pkg.InstallModeCopy {
+	if installMode == pkg.InstallModeCopy || installMode == pkg.InstallModeHardlink {
 		_, _ = removeEmptyTree(p.instancePath, func(string) bool { return true })
 	}
 
@@ -747,6 +799,10 @@ func (d *deployerImpl) TempDir(ctx context.Context, prefix string, mode os.FileM
 	return tmp, nil
 }
 
+func (d *deployerImpl) CollectGarbage(ctx context.Context) error {
+	return d.store.collectGarbage(ctx, clock.Now(ctx))
+}
+
 func (d *deployerImpl) FS() fs.FileSystem {
 	return d.fs
 }
@@ -1199,6 +1255,7 @@ func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files [
 	})
 
 	// Finally create all leaf files.
+	crossDevice := false
 	for _, f := range files {
 		// Native path relative to the subdir, e.g. bin/tool
 		relPath := filepath.FromSlash(f.Name)
@@ -1232,6 +1289,13 @@ func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files [
 				logging.Warningf(ctx, "Failed to move %s to %s: %s", srcAbs, destAbs, err)
 				return nil, err
 			}
+		case pkg.InstallModeHardlink:
+			// E.g. <base>/.cipd/pkgs/name/<id>/bin/tool.
+			srcAbs := filepath.Join(srcDir, relPath)
+			if err := d.linkFromStore(ctx, f, srcAbs, destAbs, &crossDevice); err != nil {
+				logging.Warningf(ctx, "Failed to link %s to %s: %s", srcAbs, destAbs, err)
+				return nil, err
+			}
 		default:
 			// Should not happen. ValidateInstallMode checks this.
 			panic("impossible state")
@@ -1240,6 +1304,23 @@ func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files [
 	return touched, nil
 }
 
+// linkFromStore hardlinks a file into the site root through the content store.
+//
+// Files that can't be shared (symlinks, writable files) are moved from the guts
+// as in "copy" mode. If the content store is on a different file system, logs
+// a warning (once per 'crossDevice' flag) and falls back to "copy" mode too.
+func (d *deployerImpl) linkFromStore(ctx context.Context, f pkg.FileInfo, srcAbs, destAbs string, crossDevice *bool) error {
+	if !*crossDevice && d.store.canStore(f) {
+		err := d.store.link(ctx, d.fs, f, srcAbs, destAbs)
+		if !isCrossDevice(err) {
+			return err
+		}
+		logging.Warningf(ctx, "The content store %s is on a different file system, copying files instead", d.store.dir)
+		*crossDevice = true
+	}
+	return d.fs.Replace(ctx, srcAbs, destAbs)
+}
+
 // removeFromSiteRoot deletes files from the site root directory unless they
 // are present in the 'keep' set.
 //
@@ -1344,9 +1425,14 @@ func (d *deployerImpl) checkIntegrity(ctx context.Context, p *DeployedPackage, m
 			continue
 		case f.Symlink == "": // a regular file (not a symlink)
 			switch {
-			case p.InstallMode == pkg.InstallModeCopy:
+			case p.ActualInstallMode == pkg.InstallModeHardlink && d.store.has(f):
+				// In 'hardlink' mode the file can be relinked from the content store if
+				// it is still there.
+				relink = append(relink, f.Name)
+			case p.InstallMode == pkg.InstallModeCopy || p.ActualInstallMode == pkg.InstallModeHardlink:
 				// In 'copy' mode regular files are stored in the site root directly.
 				// If they are gone, we need to refetch the package to restore them.
+				// Same for 'hardlink' mode if the content store doesn't have the file.
 				redeploy = append(redeploy, f.Name)
 			case d.isPresentInGuts(ctx, p.instancePath, f):
 				// This is 'symlink' mode and the original file in .cipd guts exist. We
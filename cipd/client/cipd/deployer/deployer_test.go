@@ -1089,6 +1089,134 @@ func TestDeployInstanceCopyModeWindows(t *testing.T) {
 	})
 }
 
+func TestDeployInstanceHardlinkMode(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	ftt.Run("Given two site roots sharing a content store", t, func(t *ftt.Test) {
+		tempDir := t.TempDir()
+		storeDir := filepath.Join(tempDir, "store")
+		root1 := filepath.Join(tempDir, "root1")
+		root2 := filepath.Join(tempDir, "root2")
+		d1 := NewWithContentStore(root1, storeDir)
+		d2 := NewWithContentStore(root2, storeDir)
+
+		inst := makeTestInstance("test/package", []fs.File{
+			fs.NewTestFile("some/file/path", "data a", fs.TestFileOpts{}),
+			fs.NewTestFile("some/executable", "data b", fs.TestFileOpts{Executable: true}),
+			fs.NewTestFile("some/writable", "data c", fs.TestFileOpts{Writable: true}),
+			fs.NewTestSymlink("some/symlink", "executable"),
+		}, pkg.InstallModeCopy)
+
+		// Shard directories are never removed, skip them.
+		blobs := func() (out []string) {
+			for _, p := range scanDir(filepath.Join(storeDir, "blobs")) {
+				if !strings.HasSuffix(p, "!") {
+					out = append(out, p)
+				}
+			}
+			return
+		}
+
+		sameFile := func(rel string) bool {
+			fi1, err := os.Stat(filepath.Join(root1, filepath.FromSlash(rel)))
+			assert.Loosely(t, err, should.BeNil)
+			fi2, err := os.Stat(filepath.Join(root2, filepath.FromSlash(rel)))
+			assert.Loosely(t, err, should.BeNil)
+			return os.SameFile(fi1, fi2)
+		}
+
+		_, err := d1.DeployInstance(ctx, "", inst, pkg.InstallModeHardlink, 0)
+		assert.Loosely(t, err, should.BeNil)
+		_, err = d2.DeployInstance(ctx, "", inst, pkg.InstallModeHardlink, 0)
+		assert.Loosely(t, err, should.BeNil)
+
+		t.Run("Files are shared", func(t *ftt.Test) {
+			assert.Loosely(t, scanDir(root1), should.Resemble([]string{
+				".cipd/pkgs/0/-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC/.cipdpkg/manifest.json",
+				".cipd/pkgs/0/_current:-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC",
+				".cipd/pkgs/0/description.json",
+				".cipd/tmp!",
+				"some/executable*",
+				"some/file/path",
+				"some/symlink:executable",
+				"some/writable",
+			}))
+			assert.Loosely(t, readFile(t, root2, "some/file/path"), should.Equal("data a"))
+
+			assert.Loosely(t, sameFile("some/file/path"), should.BeTrue)
+			assert.Loosely(t, sameFile("some/executable"), should.BeTrue)
+			assert.Loosely(t, sameFile("some/writable"), should.BeFalse)
+
+			manifest, err := d1.(*deployerImpl).readManifest(
+				ctx, filepath.Join(root1, ".cipd/pkgs/0/-wEu41lw0_aOomrCDp4gKs0uClIlMg25S2j-UMHKwFYC"))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, manifest.InstallMode, should.Equal(pkg.InstallModeCopy))
+			assert.Loosely(t, manifest.ActualInstallMode, should.Equal(pkg.InstallModeHardlink))
+		})
+
+		t.Run("Blobs are released when no longer used", func(t *ftt.Test) {
+			assert.Loosely(t, blobs(), should.HaveLength(2))
+
+			assert.Loosely(t, d1.RemoveDeployed(ctx, "", "test/package"), should.BeNil)
+			assert.Loosely(t, blobs(), should.HaveLength(2))
+
+			assert.Loosely(t, d2.RemoveDeployed(ctx, "", "test/package"), should.BeNil)
+			assert.Loosely(t, blobs(), should.HaveLength(0))
+		})
+
+		t.Run("Missing files are relinked from the store", func(t *ftt.Test) {
+			assert.Loosely(t, os.Remove(filepath.Join(root1, "some", "file", "path")), should.BeNil)
+
+			dp, err := d1.CheckDeployed(ctx, "", "test/package", CheckPresence, pkg.WithoutManifest)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, dp.ToRedeploy, should.HaveLength(0))
+			assert.Loosely(t, dp.ToRelink, should.Resemble([]string{"some/file/path"}))
+
+			err = d1.RepairDeployed(ctx, "", dp.Pin, "", 1, RepairParams{
+				ToRelink: dp.ToRelink,
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, sameFile("some/file/path"), should.BeTrue)
+		})
+
+		t.Run("Files missing from the store are redeployed", func(t *ftt.Test) {
+			assert.Loosely(t, os.RemoveAll(root1), should.BeNil)
+			assert.Loosely(t, os.Remove(filepath.Join(root2, "some", "file", "path")), should.BeNil)
+			assert.Loosely(t, d2.CollectGarbage(ctx), should.BeNil)
+
+			dp, err := d2.CheckDeployed(ctx, "", "test/package", CheckPresence, pkg.WithoutManifest)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, dp.ToRedeploy, should.Resemble([]string{"some/file/path"}))
+			assert.Loosely(t, dp.ToRelink, should.HaveLength(0))
+
+			err = d2.RepairDeployed(ctx, "", dp.Pin, "", 1, RepairParams{
+				Instance:   inst,
+				ToRedeploy: dp.ToRedeploy,
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, readFile(t, root2, "some/file/path"), should.Equal("data a"))
+			assert.Loosely(t, blobs(), should.HaveLength(2))
+		})
+
+		t.Run("CollectGarbage removes unused blobs", func(t *ftt.Test) {
+			assert.Loosely(t, os.RemoveAll(root1), should.BeNil)
+			assert.Loosely(t, os.RemoveAll(root2), should.BeNil)
+
+			assert.Loosely(t, d1.CollectGarbage(ctx), should.BeNil)
+			assert.Loosely(t, blobs(), should.HaveLength(0))
+
+			_, err := os.Stat(filepath.Join(storeDir, "last_gc"))
+			assert.Loosely(t, err, should.BeNil)
+		})
+	})
+}
+
 func TestDeployInstanceSwitchingModes(t *testing.T) {
 	t.Parallel()
 
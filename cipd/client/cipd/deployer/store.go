@@ -0,0 +1,259 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// File system layout of a content store used by "hardlink" install mode:
+// <store>/
+//   blobs/
+//     sha256/
+//       3f/
+//         3f0a...b1      (a read-only file with this SHA256 digest)
+//         3f0a...b1.x    (same, but executable)
+//         ...
+//   last_gc            (touched after each full garbage collection)
+//
+// The store is shared by all site roots on the machine that use it. Each blob
+// is hardlinked into site roots that have files with the same content and
+// attributes. The link count of a blob is its reference count: a blob with
+// a single link (the one in the store itself) is not used by anything and can
+// be removed.
+//
+// Blobs are added by hardlinking freshly extracted files into the store, so
+// a blob always has at least two links when it becomes visible. This allows
+// garbage collection to run concurrently with deployments without any locking.
+
+const (
+	// contentStoreDir is a default location of the content store (relative to
+	// the site root) if no machine-wide store is configured.
+	contentStoreDir = fs.SiteServiceDir + "/store"
+
+	// contentStoreGCInterval is how often to do a full scan of the content store
+	// in CollectGarbage.
+	contentStoreGCInterval = 24 * time.Hour
+)
+
+// contentStore is a content-addressed store of deployed files.
+type contentStore struct {
+	dir string // absolute path to the store root
+}
+
+// canStore returns true if the file can be shared through the store.
+//
+// Only regular read-only files with known hashes can be shared. Hardlinked
+// files share attributes, so writable files are never shared: modifying them
+// would affect all site roots at once.
+func (s *contentStore) canStore(f pkg.FileInfo) bool {
+	return f.Symlink == "" && !f.Writable && common.ValidateInstanceID(f.Hash, common.AnyHash) == nil
+}
+
+// blobPath returns a path to a blob with the file content and attributes.
+//
+// The file must be storable (see canStore).
+func (s *contentStore) blobPath(f pkg.FileInfo) string {
+	ref := common.InstanceIDToObjectRef(f.Hash)
+	name := ref.HexDigest
+	if f.Executable {
+		name += ".x"
+	}
+	if f.ModTime != 0 {
+		name += "." + strconv.FormatInt(f.ModTime, 10)
+	}
+	algo := strings.ToLower(ref.HashAlgo.String())
+	return filepath.Join(s.dir, "blobs", algo, ref.HexDigest[:2], name)
+}
+
+// has returns true if the store has a blob for the given file.
+func (s *contentStore) has(f pkg.FileInfo) bool {
+	if !s.canStore(f) {
+		return false
+	}
+	_, err := os.Lstat(s.blobPath(f))
+	return err == nil
+}
+
+// link places a hardlink to the blob with the file content at dest.
+//
+// 'src' is a path to the extracted file on the same file system as 'dest'
+// (usually in the instance directory in the site root guts). If it exists, it
+// is added to the store, unless the store already has an identical blob. If it
+// doesn't exist, the store must already have the blob. In both cases 'src' ends
+// up being a hardlink to the blob and it is moved to 'dest'.
+//
+// The file must be storable (see canStore). Returns an error wrapping
+// syscall.EXDEV if the store is on a different file system.
+func (s *contentStore) link(ctx context.Context, fsys fs.FileSystem, f pkg.FileInfo, src, dest string) error {
+	blob := s.blobPath(f)
+	switch _, err := os.Lstat(src); {
+	case err == nil:
+		if err := s.adopt(ctx, f, src, blob); err != nil {
+			return err
+		}
+	case os.IsNotExist(err):
+		if _, err := fsys.EnsureDirectory(ctx, filepath.Dir(src)); err != nil {
+			return err
+		}
+		if err := os.Link(blob, src); err != nil {
+			return errors.Annotate(err, "linking %q from the content store", f.Name).Err()
+		}
+	default:
+		return err
+	}
+	return fsys.Replace(ctx, src, dest)
+}
+
+// adopt makes 'src' a hardlink to the blob, adding the blob if necessary.
+func (s *contentStore) adopt(ctx context.Context, f pkg.FileInfo, src, blob string) error {
+	// Blobs are shared, make sure they can't be modified in place by accident.
+	mode := os.FileMode(0444)
+	if f.Executable {
+		mode |= 0111
+	}
+	if err := os.Chmod(src, mode); err != nil {
+		return errors.Annotate(err, "making %q read-only", f.Name).Err()
+	}
+
+	if err := os.MkdirAll(filepath.Dir(blob), 0777); err != nil {
+		return errors.Annotate(err, "creating a content store directory").Err()
+	}
+
+	// Try to add the file as a new blob first. This fails if there's such blob
+	// already.
+	switch err := os.Link(src, blob); {
+	case err == nil:
+		return nil
+	case !os.IsExist(err):
+		return errors.Annotate(err, "adding %q to the content store", f.Name).Err()
+	}
+
+	// Replace 'src' with a link to the existing blob to get rid of the duplicate.
+	tmp := src + ".cipd_blob"
+	switch err := os.Link(blob, tmp); {
+	case os.IsNotExist(err):
+		// The blob was just garbage collected by someone else. 'src' is still
+		// a perfectly valid file, it is just not shared with anything. This is
+		// rare, don't bother retrying.
+		logging.Debugf(ctx, "The blob for %q disappeared, not sharing it", f.Name)
+		return nil
+	case err != nil:
+		return errors.Annotate(err, "linking %q from the content store", f.Name).Err()
+	}
+	if err := os.Rename(tmp, src); err != nil {
+		os.Remove(tmp)
+		return errors.Annotate(err, "replacing %q with a link to the blob", f.Name).Err()
+	}
+	return nil
+}
+
+// release removes blobs of the given files from the store if nothing else
+// links to them anymore.
+//
+// Best effort. Logs errors.
+func (s *contentStore) release(ctx context.Context, files []pkg.FileInfo) {
+	removed := 0
+	for _, f := range files {
+		if s.canStore(f) && s.maybeRemove(ctx, s.blobPath(f)) {
+			removed++
+		}
+	}
+	if removed != 0 {
+		logging.Debugf(ctx, "Removed %d unused blobs from the content store", removed)
+	}
+}
+
+// collectGarbage scans the store and removes all unused blobs.
+//
+// Does nothing if the previous scan happened less than contentStoreGCInterval
+// ago.
+func (s *contentStore) collectGarbage(ctx context.Context, now time.Time) error {
+	blobs := filepath.Join(s.dir, "blobs")
+	if _, err := os.Stat(blobs); os.IsNotExist(err) {
+		return nil // the store is not used at all
+	}
+
+	stamp := filepath.Join(s.dir, "last_gc")
+	if fi, err := os.Stat(stamp); err == nil && now.Sub(fi.ModTime()) < contentStoreGCInterval {
+		return nil
+	}
+
+	logging.Infof(ctx, "Cleaning up the content store %s...", s.dir)
+	removed := 0
+	err := filepath.Walk(blobs, func(path string, info os.FileInfo, err error) error {
+		switch {
+		case os.IsNotExist(err):
+			return nil // removed concurrently
+		case err != nil:
+			return err
+		case info.Mode().IsRegular() && s.maybeRemove(ctx, path):
+			removed++
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "scanning the content store").Err()
+	}
+	logging.Infof(ctx, "Removed %d unused blobs from the content store", removed)
+
+	if err := os.WriteFile(stamp, nil, 0666); err != nil {
+		return errors.Annotate(err, "updating the content store GC timestamp").Err()
+	}
+	if err := os.Chtimes(stamp, now, now); err != nil {
+		return errors.Annotate(err, "updating the content store GC timestamp").Err()
+	}
+	return nil
+}
+
+// maybeRemove removes the blob if it has no links other than the store one.
+//
+// Returns true if the blob was removed.
+func (s *contentStore) maybeRemove(ctx context.Context, blob string) bool {
+	fi, err := os.Lstat(blob)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logging.Warningf(ctx, "Failed to stat blob %q: %s", blob, err)
+		}
+		return false
+	}
+	if n, ok := linkCount(fi); !ok || n > 1 {
+		return false
+	}
+	if err := os.Remove(blob); err != nil && !os.IsNotExist(err) {
+		logging.Warningf(ctx, "Failed to remove blob %q: %s", blob, err)
+		return false
+	}
+	return true
+}
+
+// isCrossDevice returns true if the error indicates a hardlink can't be
+// created because paths are on different file systems.
+func isCrossDevice(err error) bool {
+	return errors.Is(err, syscall.EXDEV)
+}
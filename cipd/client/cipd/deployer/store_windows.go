@@ -0,0 +1,29 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+// +build windows
+
+package deployer
+
+import (
+	"os"
+)
+
+// linkCount returns the number of hardlinks to a file.
+//
+// Not implemented on Windows, where "hardlink" install mode is not used.
+func linkCount(fi os.FileInfo) (uint64, bool) {
+	return 0, false
+}
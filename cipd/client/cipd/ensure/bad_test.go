@@ -123,7 +123,7 @@ var badEnsureFiles = []struct {
 	{
 		"symlink override mode",
 		"$overrideinstallmode symlink",
-		"only copy mode is allowed",
+		"only copy and hardlink modes are allowed",
 	},
 
 	{
@@ -67,6 +67,13 @@
 //     solves. We recommend that all ensure files have this setting, and in the
 //     future this will become automatically set. See crbug.com/1329641 for
 //     additional discussion.
+//   - `$OverrideInstallMode hardlink` forces all packages in this ensure-file
+//     to be installed with hardlink mode: files are stored once in
+//     a machine-wide content store (see `CIPD_CONTENT_STORE_DIR`) and
+//     hardlinked into the site root. This saves disk space when many site
+//     roots on the same machine use the same packages. Installed files are
+//     read-only and shared between all site roots, so they must not be
+//     modified in place. Falls back to copy mode on Windows.
 //
 // # Package Definitions
 //
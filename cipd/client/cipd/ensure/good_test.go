@@ -218,6 +218,20 @@ var goodEnsureFiles = []struct {
 		}},
 	},
 
+	{
+		"OverrideInstallMode hardlink",
+		f(
+			"$OverrideInstallMode hardlink",
+			"",
+			"some/package version",
+		),
+		&ResolvedFile{"", deployer.NotParanoid, pkg.InstallModeHardlink, common.PinSliceBySubdir{
+			"": {
+				p("some/package", "version"),
+			},
+		}},
+	},
+
 	{
 		"empty",
 		"",
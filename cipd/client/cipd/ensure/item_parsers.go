@@ -95,8 +95,8 @@ func overrideInstallModeParser(_ *itemParserState, f *File, val string) error {
 	if err := pkg.ValidateInstallMode(im); err != nil {
 		return err
 	}
-	if im != pkg.InstallModeCopy {
-		return errors.Reason("only copy mode is allowed").Tag(cipderr.BadArgument).Err()
+	if im != pkg.InstallModeCopy && im != pkg.InstallModeHardlink {
+		return errors.Reason("only copy and hardlink modes are allowed").Tag(cipderr.BadArgument).Err()
 	}
 	f.OverrideInstallMode = im
 	return nil
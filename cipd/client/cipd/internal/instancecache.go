@@ -22,6 +22,7 @@ import (
 	"io"
 	"math/rand"
 	"os"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -71,6 +72,12 @@ const (
 // It must check the hash of the downloaded package matches the pin.
 type Fetcher func(ctx context.Context, pin common.Pin, f io.WriteSeeker) error
 
+// DeltaFetcher knows how to populate a file in the cache given its pin and
+// some other instance of the same package already in the cache.
+//
+// It must check the hash of the assembled package matches the pin.
+type DeltaFetcher func(ctx context.Context, base io.ReaderAt, basePin, pin common.Pin, f io.WriteSeeker) error
+
 // InstanceRequest is passed to RequestInstances.
 type InstanceRequest struct {
 	Context context.Context    // carries the cancellation signal
@@ -112,6 +119,13 @@ type InstanceCache struct {
 	// It must check the hash of the downloaded package matches the pin.
 	Fetcher Fetcher
 
+	// DeltaFetcher, if set, is tried before Fetcher on cache misses if the cache
+	// has some other instance of the same package.
+	//
+	// If it fails, the cache falls back to Fetcher. Not used by temporary
+	// caches, since they never have other instances around.
+	DeltaFetcher DeltaFetcher
+
 	// ParallelDownloads limits how many parallel fetches can happen at once.
 	//
 	// The zero value means to do fetches in a blocking way in WaitInstance.
@@ -433,12 +447,15 @@ func (c *InstanceCache) openOrFetch(ctx context.Context, pin common.Pin) (*os.Fi
 			logging.Warningf(ctx, "Could not get %s from the cache: %s", pin, err)
 		default:
 			logging.Infof(ctx, "Cache hit for %s", pin)
-			c.touch(ctx, pin.InstanceID, false) // bump its last access time
+			c.touch(ctx, pin, false) // bump its last access time
 			return file, nil
 		}
 
 		// No such cached instance, download it.
 		err := c.FS.EnsureFile(ctx, path, func(f *os.File) error {
+			if c.fetchDelta(ctx, pin, f) {
+				return nil
+			}
 			return c.Fetcher(ctx, pin, f)
 		})
 		if err != nil {
@@ -467,26 +484,104 @@ func (c *InstanceCache) openOrFetch(ctx context.Context, pin common.Pin) (*os.Fi
 			logging.Errorf(ctx, "Failed to open the instance %s: %s", pin, err)
 			return nil, errors.Annotate(err, "opening the cached instance %s", pin).Tag(cipderr.IO).Err()
 		default:
-			c.touch(ctx, pin.InstanceID, true) // mark it as accessed, run the GC
+			c.touch(ctx, pin, true) // mark it as accessed, run the GC
 			return file, nil
 		}
 	}
 }
 
+// fetchDelta tries to populate the file using DeltaFetcher.
+//
+// Returns true if the file was populated. On failures logs the error, resets
+// the file and returns false.
+func (c *InstanceCache) fetchDelta(ctx context.Context, pin common.Pin, f *os.File) bool {
+	if c.DeltaFetcher == nil || c.Tmp {
+		return false
+	}
+
+	basePin, base := c.openDeltaBase(ctx, pin)
+	if base == nil {
+		return false
+	}
+	defer func() { _ = base.Close() }()
+
+	logging.Infof(ctx, "Trying to fetch %s as a delta from %s", pin, basePin.InstanceID)
+	err := c.DeltaFetcher(ctx, base, basePin, pin, f)
+	if err == nil {
+		return true
+	}
+	logging.Infof(ctx, "Falling back to fetching %s fully: %s", pin, err)
+
+	// Get rid of whatever was written to the file before the failure.
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		logging.Warningf(ctx, "Failed to rewind the file: %s", err)
+	}
+	if err := f.Truncate(0); err != nil {
+		logging.Warningf(ctx, "Failed to truncate the file: %s", err)
+	}
+	return false
+}
+
+// openDeltaBase opens the most recently used cached instance of the same
+// package as 'pin'.
+//
+// Returns nil file if there's no such instance.
+func (c *InstanceCache) openDeltaBase(ctx context.Context, pin common.Pin) (common.Pin, *os.File) {
+	type candidate struct {
+		instanceID string
+		lastAccess time.Time
+	}
+	var candidates []candidate
+
+	c.withState(ctx, clock.Now(ctx), func(s *messages.InstanceCache) (save bool) {
+		for instanceID, entry := range s.Entries {
+			if entry.Package == pin.PackageName && instanceID != pin.InstanceID {
+				candidates = append(candidates, candidate{instanceID, entry.LastAccess.AsTime()})
+			}
+		}
+		return false
+	})
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastAccess.After(candidates[j].lastAccess)
+	})
+
+	for _, cand := range candidates {
+		if common.ValidateInstanceID(cand.instanceID, common.KnownHash) != nil {
+			continue
+		}
+		path, err := c.FS.RootRelToAbs(cand.instanceID)
+		if err != nil {
+			continue
+		}
+		switch file, err := c.FS.OpenFile(path); {
+		case os.IsNotExist(err):
+			// Was evicted already, try the next one.
+		case err != nil:
+			logging.Warningf(ctx, "Could not open %s from the cache: %s", cand.instanceID, err)
+		default:
+			return common.Pin{PackageName: pin.PackageName, InstanceID: cand.instanceID}, file
+		}
+	}
+
+	return common.Pin{}, nil
+}
+
 // touch updates the cache state file (best effort).
-func (c *InstanceCache) touch(ctx context.Context, instanceID string, gc bool) {
+func (c *InstanceCache) touch(ctx context.Context, pin common.Pin, gc bool) {
 	now := clock.Now(ctx)
 	c.withState(ctx, now, func(s *messages.InstanceCache) (save bool) {
 		// Update LastAccess time.
-		entry := s.Entries[instanceID]
+		entry := s.Entries[pin.InstanceID]
 		if entry == nil {
 			entry = &messages.InstanceCache_Entry{}
 			if s.Entries == nil {
 				s.Entries = map[string]*messages.InstanceCache_Entry{}
 			}
-			s.Entries[instanceID] = entry
+			s.Entries[pin.InstanceID] = entry
 		}
 		entry.LastAccess = timestamppb.New(now)
+		entry.Package = pin.PackageName
 		// Optionally collect old entries.
 		if gc {
 			c.gc(ctx, s, now)
@@ -219,6 +219,91 @@ func TestInstanceCache(t *testing.T) {
 			assert.Loosely(t, res.Source.Close(ctx, false), should.BeNil)
 		})
 
+		t.Run("Delta fetches", func(t *ftt.Test) {
+			type deltaCall struct {
+				Base    string
+				BasePin common.Pin
+				Pin     common.Pin
+			}
+			var deltaCalls []deltaCall
+			var deltaErr error
+
+			cache := &InstanceCache{
+				FS:      fs,
+				Fetcher: fetcher,
+				DeltaFetcher: func(ctx context.Context, base io.ReaderAt, basePin, pin common.Pin, w io.WriteSeeker) error {
+					buf := make([]byte, len(fakeData(basePin)))
+					_, err := base.ReadAt(buf, 0)
+					assert.Loosely(t, err, should.BeNil)
+					deltaCalls = append(deltaCalls, deltaCall{string(buf), basePin, pin})
+					if deltaErr != nil {
+						_, _ = w.Write([]byte("garbage that must be discarded"))
+						return deltaErr
+					}
+					_, err = w.Write([]byte(fakeData(pin)))
+					return err
+				},
+			}
+			cache.Launch(ctx)
+			defer cache.Close(ctx)
+
+			other := common.Pin{PackageName: "other", InstanceID: strings.Repeat("b", 40)}
+
+			t.Run("No base", func(t *ftt.Test) {
+				putNew(cache, pin(0))
+				assert.Loosely(t, deltaCalls, should.HaveLength(0))
+			})
+
+			t.Run("Uses the most recent instance of the same package", func(t *ftt.Test) {
+				putNew(cache, pin(0))
+				tc.Add(time.Minute)
+				putNew(cache, other)
+				tc.Add(time.Minute)
+
+				created, src := access(cache, pin(1))
+				assert.Loosely(t, created, should.BeFalse) // not via the Fetcher
+				assert.Loosely(t, readSrc(src), should.Equal(fakeData(pin(1))))
+				assert.Loosely(t, src.Close(ctx, false), should.BeNil)
+
+				tc.Add(time.Minute)
+				created, src = access(cache, pin(2))
+				assert.Loosely(t, created, should.BeFalse)
+				assert.Loosely(t, src.Close(ctx, false), should.BeNil)
+
+				assert.Loosely(t, deltaCalls, should.Match([]deltaCall{
+					{fakeData(pin(0)), pin(0), pin(1)},
+					{fakeData(pin(1)), pin(1), pin(2)},
+				}))
+			})
+
+			t.Run("Falls back to full fetch", func(t *ftt.Test) {
+				deltaErr = fmt.Errorf("boom")
+
+				putNew(cache, pin(0))
+				tc.Add(time.Minute)
+				putNew(cache, pin(1))
+				assert.Loosely(t, deltaCalls, should.HaveLength(1))
+
+				testHas(cache, pin(1))
+			})
+
+			t.Run("Not used by temp caches", func(t *ftt.Test) {
+				putNew(cache, pin(0))
+
+				cache := &InstanceCache{
+					FS:           fs,
+					Tmp:          true,
+					Fetcher:      fetcher,
+					DeltaFetcher: cache.DeltaFetcher,
+				}
+				cache.Launch(ctx)
+				defer cache.Close(ctx)
+
+				putNew(cache, pin(1))
+				assert.Loosely(t, deltaCalls, should.HaveLength(0))
+			})
+		})
+
 		t.Run("Concurrency", func(t *ftt.Test) {
 			cache := &InstanceCache{
 				FS:      fs,
@@ -92,10 +92,11 @@ func (x *BlobWithSHA256) GetSha256() []byte {
 type TagCache struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Capped list of entries, most recently resolved is last.
-	Entries       []*TagCache_Entry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	FileEntries   []*TagCache_FileEntry `protobuf:"bytes,2,rep,name=file_entries,json=fileEntries,proto3" json:"file_entries,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Entries          []*TagCache_Entry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	FileEntries      []*TagCache_FileEntry      `protobuf:"bytes,2,rep,name=file_entries,json=fileEntries,proto3" json:"file_entries,omitempty"`
+	SignatureEntries []*TagCache_SignatureEntry `protobuf:"bytes,3,rep,name=signature_entries,json=signatureEntries,proto3" json:"signature_entries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TagCache) Reset() {
@@ -142,6 +143,13 @@ func (x *TagCache) GetFileEntries() []*TagCache_FileEntry {
 	return nil
 }
 
+func (x *TagCache) GetSignatureEntries() []*TagCache_SignatureEntry {
+	if x != nil {
+		return x.SignatureEntries
+	}
+	return nil
+}
+
 // InstanceCache stores a list of instances and their last access time.
 //
 // This cache does not depend on a service being used, since an instance's ID is
@@ -345,19 +353,92 @@ func (x *TagCache_FileEntry) GetObjectRef() string {
 	return ""
 }
 
+type TagCache_SignatureEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`                         // e.g. 'chrome-infra-packages.appspot.com'
+	Package       string                 `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`                         // name of a signed CIPD package
+	InstanceId    string                 `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"` // identifier of the signed instance
+	Signatures    [][]byte               `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`                   // publisher signatures, as stored in metadata
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCache_SignatureEntry) Reset() {
+	*x = TagCache_SignatureEntry{}
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCache_SignatureEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCache_SignatureEntry) ProtoMessage() {}
+
+func (x *TagCache_SignatureEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCache_SignatureEntry.ProtoReflect.Descriptor instead.
+func (*TagCache_SignatureEntry) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *TagCache_SignatureEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *TagCache_SignatureEntry) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *TagCache_SignatureEntry) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *TagCache_SignatureEntry) GetSignatures() [][]byte {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 // Entry stores info about an instance.
 type InstanceCache_Entry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// LastAccess is last time this instance was retrieved from or put to the
 	// cache.
-	LastAccess    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_access,json=lastAccess,proto3" json:"last_access,omitempty"`
+	LastAccess *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_access,json=lastAccess,proto3" json:"last_access,omitempty"`
+	// Package is a name of the package this instance belongs to.
+	//
+	// Used to find a base for delta fetches. May be empty for entries added
+	// by older clients.
+	Package       string `protobuf:"bytes,3,opt,name=package,proto3" json:"package,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InstanceCache_Entry) Reset() {
 	*x = InstanceCache_Entry{}
-	mi := &file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -369,7 +450,7 @@ func (x *InstanceCache_Entry) String() string {
 func (*InstanceCache_Entry) ProtoMessage() {}
 
 func (x *InstanceCache_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,6 +473,13 @@ func (x *InstanceCache_Entry) GetLastAccess() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *InstanceCache_Entry) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
 var File_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_rawDesc = string([]byte{
@@ -406,7 +494,7 @@ var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_
 	0x48, 0x41, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
 	0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
-	0x36, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x8e, 0x03, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x43,
+	0x36, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xe6, 0x04, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x43,
 	0x61, 0x63, 0x68, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
 	0x2e, 0x54, 0x61, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
@@ -414,7 +502,12 @@ var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_
 	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x61, 0x63,
 	0x68, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x69,
-	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x6e, 0x0a, 0x05, 0x45, 0x6e, 0x74,
+	0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x54, 0x61, 0x67, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x6e, 0x0a, 0x05, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07,
 	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
@@ -431,30 +524,40 @@ var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_
 	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x62, 0x6a,
 	0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x22, 0xad, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x1a, 0x44, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a,
-	0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x6f, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69,
-	0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x69, 0x70,
-	0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x1a, 0x85, 0x01, 0x0a, 0x0e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0xc7, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x1a,
+	0x5e, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x1a,
+	0x59, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x6f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
+	0x63, 0x69, 0x2f, 0x63, 0x69, 0x70, 0x64, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x63,
+	0x69, 0x70, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -469,29 +572,31 @@ func file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto
 	return file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_goTypes = []any{
-	(*BlobWithSHA256)(nil),        // 0: messages.BlobWithSHA256
-	(*TagCache)(nil),              // 1: messages.TagCache
-	(*InstanceCache)(nil),         // 2: messages.InstanceCache
-	(*TagCache_Entry)(nil),        // 3: messages.TagCache.Entry
-	(*TagCache_FileEntry)(nil),    // 4: messages.TagCache.FileEntry
-	(*InstanceCache_Entry)(nil),   // 5: messages.InstanceCache.Entry
-	nil,                           // 6: messages.InstanceCache.EntriesEntry
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*BlobWithSHA256)(nil),          // 0: messages.BlobWithSHA256
+	(*TagCache)(nil),                // 1: messages.TagCache
+	(*InstanceCache)(nil),           // 2: messages.InstanceCache
+	(*TagCache_Entry)(nil),          // 3: messages.TagCache.Entry
+	(*TagCache_FileEntry)(nil),      // 4: messages.TagCache.FileEntry
+	(*TagCache_SignatureEntry)(nil), // 5: messages.TagCache.SignatureEntry
+	(*InstanceCache_Entry)(nil),     // 6: messages.InstanceCache.Entry
+	nil,                             // 7: messages.InstanceCache.EntriesEntry
+	(*timestamppb.Timestamp)(nil),   // 8: google.protobuf.Timestamp
 }
 var file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_depIdxs = []int32{
 	3, // 0: messages.TagCache.entries:type_name -> messages.TagCache.Entry
 	4, // 1: messages.TagCache.file_entries:type_name -> messages.TagCache.FileEntry
-	6, // 2: messages.InstanceCache.entries:type_name -> messages.InstanceCache.EntriesEntry
-	7, // 3: messages.InstanceCache.last_synced:type_name -> google.protobuf.Timestamp
-	7, // 4: messages.InstanceCache.Entry.last_access:type_name -> google.protobuf.Timestamp
-	5, // 5: messages.InstanceCache.EntriesEntry.value:type_name -> messages.InstanceCache.Entry
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	5, // 2: messages.TagCache.signature_entries:type_name -> messages.TagCache.SignatureEntry
+	7, // 3: messages.InstanceCache.entries:type_name -> messages.InstanceCache.EntriesEntry
+	8, // 4: messages.InstanceCache.last_synced:type_name -> google.protobuf.Timestamp
+	8, // 5: messages.InstanceCache.Entry.last_access:type_name -> google.protobuf.Timestamp
+	6, // 6: messages.InstanceCache.EntriesEntry.value:type_name -> messages.InstanceCache.Entry
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_init() }
@@ -505,7 +610,7 @@ func file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_rawDesc), len(file_go_chromium_org_luci_cipd_client_cipd_internal_messages_messages_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -56,19 +56,25 @@ const (
 // the client itself SHOULD have for this instance ID. The client then
 // calculates the hash of itself to see if it's actually already at that
 // instance ID.
+//
+// Finally, it stores publisher signatures of instances, to allow verifying them
+// without calling the backend. Signatures are verified each time they are used,
+// so caching them is safe.
 type TagCache struct {
 	fs      fs.FileSystem
 	service string
 
 	lock sync.Mutex
 
-	cache      *messages.TagCache                       // the last loaded state, if not nil.
-	addedTags  map[tagKey]*messages.TagCache_Entry      // entries added by AddTag
-	addedFiles map[fileKey]*messages.TagCache_FileEntry // entries added by AddExtractedObjectRef
+	cache      *messages.TagCache                           // the last loaded state, if not nil.
+	addedTags  map[tagKey]*messages.TagCache_Entry          // entries added by AddTag
+	addedFiles map[fileKey]*messages.TagCache_FileEntry     // entries added by AddExtractedObjectRef
+	addedSigs  map[sigKey]*messages.TagCache_SignatureEntry // entries added by AddSignatures
 }
 
 type tagKey string
 type fileKey string
+type sigKey string
 
 // makeTagKey constructs key for the TagCache.addedTags map.
 //
@@ -85,6 +91,11 @@ func makeFileKey(pkg, instance, file string) fileKey {
 	return fileKey(pkg + ":" + instance + ":" + file)
 }
 
+// makeSigKey constructs key for the TagCache.addedSigs map.
+func makeSigKey(pin common.Pin) sigKey {
+	return sigKey(pin.PackageName + ":" + pin.InstanceID)
+}
+
 // NewTagCache initializes TagCache.
 //
 // fs will be the root of the cache. It will be searched for tagcache.db file.
@@ -185,6 +196,35 @@ func (c *TagCache) ResolveExtractedObjectRef(ctx context.Context, pin common.Pin
 	return nil, nil
 }
 
+// ResolveSignatures returns cached signatures of the instance or nil if there
+// are none in the cache.
+//
+// Returns error if the cache can't be read.
+func (c *TagCache) ResolveSignatures(ctx context.Context, pin common.Pin) ([][]byte, error) {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if e := c.addedSigs[makeSigKey(pin)]; e != nil {
+		return e.Signatures, nil
+	}
+
+	if err := c.lazyLoadLocked(ctx); err != nil {
+		return nil, err
+	}
+
+	for i := len(c.cache.SignatureEntries) - 1; i >= 0; i-- {
+		e := c.cache.SignatureEntries[i]
+		if e.Package == pin.PackageName && e.InstanceId == pin.InstanceID {
+			return e.Signatures, nil
+		}
+	}
+	return nil, nil
+}
+
 // AddTag records that (pin.PackageName, tag) maps to pin.InstanceID.
 //
 // Call 'Save' later to persist these changes to the cache file on disk.
@@ -245,6 +285,30 @@ func (c *TagCache) AddExtractedObjectRef(ctx context.Context, pin common.Pin, fi
 	return nil
 }
 
+// AddSignatures records publisher signatures of the instance, replacing any
+// previously recorded ones.
+//
+// Call 'Save' later to persist these changes to the cache file on disk.
+func (c *TagCache) AddSignatures(ctx context.Context, pin common.Pin, sigs [][]byte) error {
+	if err := common.ValidatePin(pin, common.AnyHash); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.addedSigs == nil {
+		c.addedSigs = make(map[sigKey]*messages.TagCache_SignatureEntry)
+	}
+	c.addedSigs[makeSigKey(pin)] = &messages.TagCache_SignatureEntry{
+		Service:    c.service,
+		Package:    pin.PackageName,
+		InstanceId: pin.InstanceID,
+		Signatures: sigs,
+	}
+	return nil
+}
+
 // Save stores all pending cache updates to the file system.
 //
 // It effectively resets the object to the initial state.
@@ -254,7 +318,7 @@ func (c *TagCache) Save(ctx context.Context) error {
 
 	// Nothing to store? Just clean the state, so that ResolveTag can fetch the
 	// up-to-date cache from disk later if needed.
-	if len(c.addedTags) == 0 && len(c.addedFiles) == 0 {
+	if len(c.addedTags) == 0 && len(c.addedFiles) == 0 && len(c.addedSigs) == 0 {
 		c.cache = nil
 		return nil
 	}
@@ -270,6 +334,11 @@ func (c *TagCache) Save(ctx context.Context) error {
 		sortedFiles = append(sortedFiles, string(k))
 	}
 	sort.Strings(sortedFiles)
+	sortedSigs := make([]string, 0, len(c.addedSigs))
+	for k := range c.addedSigs {
+		sortedSigs = append(sortedSigs, string(k))
+	}
+	sort.Strings(sortedSigs)
 
 	// Load the most recent data to avoid overwriting it. Load ALL entries, even
 	// if they belong to different service: we have one global cache file and
@@ -314,10 +383,29 @@ func (c *TagCache) Save(ctx context.Context) error {
 		mergedFiles = mergedFiles[len(mergedFiles)-tagCacheMaxExeSize:]
 	}
 
+	// And for signature entries.
+	mergedSigs := make([]*messages.TagCache_SignatureEntry, 0, len(recent.SignatureEntries)+len(c.addedSigs))
+	for _, e := range recent.SignatureEntries {
+		key := makeSigKey(common.Pin{PackageName: e.Package, InstanceID: e.InstanceId})
+		if e.Service != c.service || c.addedSigs[key] == nil {
+			mergedSigs = append(mergedSigs, e)
+		}
+	}
+	for _, k := range sortedSigs {
+		mergedSigs = append(mergedSigs, c.addedSigs[sigKey(k)])
+	}
+	if len(mergedSigs) > tagCacheMaxSize {
+		mergedSigs = mergedSigs[len(mergedSigs)-tagCacheMaxSize:]
+	}
+
 	// Serialize and write to disk. We still can accidentally replace someone
 	// else's changes, but the probability should be relatively low. It can happen
 	// only if two processes call 'Save' at the exact same time.
-	updated := &messages.TagCache{Entries: mergedTags, FileEntries: mergedFiles}
+	updated := &messages.TagCache{
+		Entries:          mergedTags,
+		FileEntries:      mergedFiles,
+		SignatureEntries: mergedSigs,
+	}
 	if err := c.dumpToDisk(ctx, updated); err != nil {
 		return err
 	}
@@ -326,6 +414,7 @@ func (c *TagCache) Save(ctx context.Context) error {
 	c.cache = updated
 	c.addedTags = nil
 	c.addedFiles = nil
+	c.addedSigs = nil
 
 	return nil
 }
@@ -372,6 +461,11 @@ func (c *TagCache) loadFromDisk(ctx context.Context, allServices bool) (*message
 			filtered.FileEntries = append(filtered.FileEntries, e)
 		}
 	}
+	for _, e := range cache.SignatureEntries {
+		if e.Service != "" && (e.Service == c.service || allServices) {
+			filtered.SignatureEntries = append(filtered.SignatureEntries, e)
+		}
+	}
 
 	return filtered, nil
 }
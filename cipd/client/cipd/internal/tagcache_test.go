@@ -120,6 +120,34 @@ func TestTagCacheWorks(t *testing.T) {
 			assert.Loosely(t, file, should.Match(numberedObjRef(2)))
 		})
 
+		t.Run("signatures", func(t *ftt.Test) {
+			tc := NewTagCache(fs, "service.example.com")
+			sigs, err := tc.ResolveSignatures(ctx, cannedPin)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, sigs, should.BeNil)
+
+			assert.Loosely(t, tc.AddSignatures(ctx, cannedPin, [][]byte{[]byte("sig1")}), should.BeNil)
+			sigs, err = tc.ResolveSignatures(ctx, cannedPin)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, sigs, should.Match([][]byte{[]byte("sig1")}))
+
+			// Replace existing.
+			assert.Loosely(t, tc.AddSignatures(ctx, cannedPin, [][]byte{[]byte("sig2")}), should.BeNil)
+			assert.Loosely(t, tc.Save(ctx), should.BeNil)
+
+			// Load.
+			another := NewTagCache(fs, "service.example.com")
+			sigs, err = another.ResolveSignatures(ctx, cannedPin)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, sigs, should.Match([][]byte{[]byte("sig2")}))
+
+			// Not visible to other services.
+			other := NewTagCache(fs, "another.example.com")
+			sigs, err = other.ResolveSignatures(ctx, cannedPin)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, sigs, should.BeNil)
+		})
+
 		t.Run("many tags", func(t *ftt.Test) {
 			tc := NewTagCache(fs, "service.example.com")
 
@@ -0,0 +1,981 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	cipdpb "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// caller is the identity all changes are attributed to.
+//
+// The local server doesn't do any authentication.
+const caller = string(identity.AnonymousIdentity)
+
+// defaultPageSize is used by paginated RPCs when the page size is not given.
+const defaultPageSize = 100
+
+// repoServer implements cipd.Repository service on top of the store.
+//
+// Every caller has OWNER role in every prefix. Instances are ready as soon as
+// they are registered, since there are no processors.
+type repoServer struct {
+	cipdpb.UnimplementedRepositoryServer
+
+	store *store
+	cas   *storageServer
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Prefix metadata and ACLs.
+
+// GetPrefixMetadata implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) GetPrefixMetadata(ctx context.Context, r *cipdpb.PrefixRequest) (resp *cipdpb.PrefixMetadata, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if r.Prefix, err = common.ValidatePackagePrefix(r.Prefix); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix': %s", err)
+	}
+	err = s.store.view(func(st *state) error {
+		p := st.Prefixes[r.Prefix]
+		if p == nil {
+			return noMetadataErr(r.Prefix)
+		}
+		resp = p.toProto(r.Prefix)
+		return nil
+	})
+	return resp, err
+}
+
+// GetInheritedPrefixMetadata implements the corresponding RPC method, see the
+// proto doc.
+func (s *repoServer) GetInheritedPrefixMetadata(ctx context.Context, r *cipdpb.PrefixRequest) (resp *cipdpb.InheritedPrefixMetadata, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if r.Prefix, err = common.ValidatePackagePrefix(r.Prefix); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix': %s", err)
+	}
+	err = s.store.view(func(st *state) error {
+		resp = &cipdpb.InheritedPrefixMetadata{}
+		for _, pfx := range parentPrefixes(r.Prefix) {
+			if p := st.Prefixes[pfx]; p != nil {
+				resp.PerPrefixMetadata = append(resp.PerPrefixMetadata, p.toProto(pfx))
+			}
+		}
+		return nil
+	})
+	return resp, err
+}
+
+// UpdatePrefixMetadata implements the corresponding RPC method, see the proto
+// doc.
+func (s *repoServer) UpdatePrefixMetadata(ctx context.Context, r *cipdpb.PrefixMetadata) (resp *cipdpb.PrefixMetadata, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.NormalizePrefixMetadata(r); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad prefix metadata: %s", err)
+	}
+	if r.Prefix == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the root metadata is not modifiable")
+	}
+
+	err = s.store.update(func(st *state) error {
+		cur := st.Prefixes[r.Prefix]
+		var curFingerprint string
+		if cur != nil {
+			curFingerprint = cur.Fingerprint
+		}
+		if curFingerprint != r.Fingerprint {
+			switch {
+			case curFingerprint == "":
+				return noMetadataErr(r.Prefix)
+			case r.Fingerprint == "":
+				return status.Errorf(
+					codes.AlreadyExists, "metadata for prefix %q already exists and has fingerprint %q, "+
+						"use combination of GetPrefixMetadata and UpdatePrefixMetadata to "+
+						"update it", r.Prefix, curFingerprint)
+			default:
+				return status.Errorf(
+					codes.FailedPrecondition, "metadata for prefix %q was updated concurrently "+
+						"(the fingerprint in the request %q doesn't match the current fingerprint %q), "+
+						"fetch new metadata with GetPrefixMetadata and reapply your "+
+						"changes", r.Prefix, r.Fingerprint, curFingerprint)
+			}
+		}
+
+		upd := &prefixState{
+			ACLs:       make(map[string][]string, len(r.Acls)),
+			UpdateUser: caller,
+			UpdateTime: now(ctx),
+		}
+		for _, acl := range r.Acls {
+			upd.ACLs[acl.Role.String()] = acl.Principals
+		}
+		resp = upd.toProto(r.Prefix)
+		upd.Fingerprint = prefixMetadataFingerprint(resp)
+		resp.Fingerprint = upd.Fingerprint
+		st.Prefixes[r.Prefix] = upd
+		return nil
+	})
+	return resp, err
+}
+
+// GetRolesInPrefix implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) GetRolesInPrefix(ctx context.Context, r *cipdpb.PrefixRequest) (resp *cipdpb.RolesInPrefixResponse, err error) {
+	if _, err := common.ValidatePackagePrefix(r.Prefix); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix': %s", err)
+	}
+	return allRoles(), nil
+}
+
+// GetRolesInPrefixOnBehalfOf implements the corresponding RPC method, see the
+// proto doc.
+func (s *repoServer) GetRolesInPrefixOnBehalfOf(ctx context.Context, r *cipdpb.PrefixRequestOnBehalfOf) (resp *cipdpb.RolesInPrefixResponse, err error) {
+	if _, err := identity.MakeIdentity(r.Identity); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'identity': %s", err)
+	}
+	return s.GetRolesInPrefix(ctx, r.PrefixRequest)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Prefix listing and package management.
+
+// ListPrefix implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) ListPrefix(ctx context.Context, r *cipdpb.ListPrefixRequest) (resp *cipdpb.ListPrefixResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if r.Prefix, err = common.ValidatePackagePrefix(r.Prefix); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'prefix': %s", err)
+	}
+	base := r.Prefix
+	if base != "" {
+		base += "/"
+	}
+
+	pkgs := stringset.New(0)
+	dirs := stringset.New(0)
+	err = s.store.view(func(st *state) error {
+		for name, pkg := range st.Packages {
+			rest, ok := strings.CutPrefix(name, base)
+			if !ok || (pkg.Hidden && !r.IncludeHidden) {
+				continue
+			}
+			chunks := strings.Split(rest, "/")
+			if !r.Recursive {
+				chunks = chunks[:min(len(chunks), 2)]
+			}
+			if len(chunks) == 1 || r.Recursive {
+				pkgs.Add(name)
+			}
+			for i := 1; i < len(chunks); i++ {
+				dirs.Add(base + strings.Join(chunks[:i], "/"))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cipdpb.ListPrefixResponse{
+		Packages: pkgs.ToSortedSlice(),
+		Prefixes: dirs.ToSortedSlice(),
+	}, nil
+}
+
+// HidePackage implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) HidePackage(ctx context.Context, r *cipdpb.PackageRequest) (*emptypb.Empty, error) {
+	return s.setHidden(ctx, r.Package, true)
+}
+
+// UnhidePackage implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) UnhidePackage(ctx context.Context, r *cipdpb.PackageRequest) (*emptypb.Empty, error) {
+	return s.setHidden(ctx, r.Package, false)
+}
+
+func (s *repoServer) setHidden(ctx context.Context, pkg string, hidden bool) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageName(pkg); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	err = s.store.update(func(st *state) error {
+		p := st.getPackage(pkg)
+		if p == nil {
+			return noPackageErr(pkg)
+		}
+		p.Hidden = hidden
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeletePackage implements the corresponding RPC method, see the proto doc.
+//
+// CAS objects are never deleted.
+func (s *repoServer) DeletePackage(ctx context.Context, r *cipdpb.PackageRequest) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	err = s.store.update(func(st *state) error {
+		if st.getPackage(r.Package) == nil {
+			return noPackageErr(r.Package)
+		}
+		delete(st.Packages, r.Package)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Package instances.
+
+// RegisterInstance implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) RegisterInstance(ctx context.Context, r *cipdpb.Instance) (resp *cipdpb.RegisterInstanceResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+
+	err = s.store.update(func(st *state) error {
+		if inst := st.getInstance(r.Package, r.Instance); inst != nil {
+			resp = &cipdpb.RegisterInstanceResponse{
+				Status:   cipdpb.RegistrationStatus_ALREADY_REGISTERED,
+				Instance: inst.toProto(r.Package, r.Instance),
+			}
+			return nil
+		}
+
+		switch uploaded, err := s.store.hasObject(r.Instance); {
+		case err != nil:
+			return err
+		case !uploaded:
+			op, err := s.cas.beginUpload(st, &uploadState{
+				HashAlgo:  r.Instance.HashAlgo,
+				HexDigest: r.Instance.HexDigest,
+				Status:    cipdpb.UploadStatus_UPLOADING,
+			})
+			if err != nil {
+				return err
+			}
+			resp = &cipdpb.RegisterInstanceResponse{
+				Status:   cipdpb.RegistrationStatus_NOT_UPLOADED,
+				UploadOp: op,
+			}
+			return nil
+		}
+
+		pkg := st.getPackage(r.Package)
+		if pkg == nil {
+			pkg = &packageState{}
+			st.Packages[r.Package] = pkg
+		}
+		if pkg.Instances == nil {
+			pkg.Instances = map[string]*instanceState{}
+		}
+		inst := &instanceState{
+			RegisteredBy: caller,
+			RegisteredTS: now(ctx),
+		}
+		pkg.Instances[common.ObjectRefToInstanceID(r.Instance)] = inst
+		resp = &cipdpb.RegisterInstanceResponse{
+			Status:   cipdpb.RegistrationStatus_REGISTERED,
+			Instance: inst.toProto(r.Package, r.Instance),
+		}
+		return nil
+	})
+	return resp, err
+}
+
+// ListInstances implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) ListInstances(ctx context.Context, r *cipdpb.ListInstancesRequest) (resp *cipdpb.ListInstancesResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	insts, err := s.findInstances(r.Package, nil)
+	if err != nil {
+		return nil, err
+	}
+	page, next, err := paginate(insts, r.PageSize, r.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &cipdpb.ListInstancesResponse{Instances: page, NextPageToken: next}, nil
+}
+
+// SearchInstances implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) SearchInstances(ctx context.Context, r *cipdpb.SearchInstancesRequest) (resp *cipdpb.SearchInstancesResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	if err := validateTagList(r.Tags); err != nil {
+		return nil, err
+	}
+	insts, err := s.findInstances(r.Package, r.Tags)
+	if err != nil {
+		return nil, err
+	}
+	page, next, err := paginate(insts, r.PageSize, r.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &cipdpb.SearchInstancesResponse{Instances: page, NextPageToken: next}, nil
+}
+
+// findInstances returns instances that have all given tags, most recently
+// registered first.
+func (s *repoServer) findInstances(pkgName string, tags []*cipdpb.Tag) (out []*cipdpb.Instance, err error) {
+	err = s.store.view(func(st *state) error {
+		pkg := st.getPackage(pkgName)
+		if pkg == nil {
+			return noPackageErr(pkgName)
+		}
+		for iid, inst := range pkg.Instances {
+			if inst.hasTags(tags) {
+				out = append(out, inst.toProto(pkgName, common.InstanceIDToObjectRef(iid)))
+			}
+		}
+		return nil
+	})
+	sort.Slice(out, func(i, j int) bool {
+		ti, tj := out[i].RegisteredTs.AsTime(), out[j].RegisteredTs.AsTime()
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return out[i].Instance.HexDigest < out[j].Instance.HexDigest
+	})
+	return out, err
+}
+
+// GetInstanceURL implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) GetInstanceURL(ctx context.Context, r *cipdpb.GetInstanceURLRequest) (resp *cipdpb.ObjectURL, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	err = s.store.view(func(st *state) error {
+		if st.getInstance(r.Package, r.Instance) == nil {
+			return noInstanceErr()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cipdpb.ObjectURL{SignedUrl: s.cas.objectURL(r.Instance, "")}, nil
+}
+
+// GetInstanceDelta implements the corresponding RPC method, see the proto doc.
+//
+// Deltas are never available, the client falls back to fetching the full
+// instance.
+func (s *repoServer) GetInstanceDelta(ctx context.Context, r *cipdpb.GetInstanceDeltaRequest) (resp *cipdpb.InstanceDelta, err error) {
+	return &cipdpb.InstanceDelta{Status: cipdpb.DeltaStatus_DELTA_UNAVAILABLE}, nil
+}
+
+// DescribeInstance implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) DescribeInstance(ctx context.Context, r *cipdpb.DescribeInstanceRequest) (resp *cipdpb.DescribeInstanceResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	err = s.store.view(func(st *state) error {
+		inst := st.getInstance(r.Package, r.Instance)
+		if inst == nil {
+			return noInstanceErr()
+		}
+		resp = &cipdpb.DescribeInstanceResponse{
+			Instance: inst.toProto(r.Package, r.Instance),
+		}
+		if r.DescribeRefs {
+			iid := common.ObjectRefToInstanceID(r.Instance)
+			for _, ref := range listRefs(r.Package, st.getPackage(r.Package)) {
+				if common.ObjectRefToInstanceID(ref.Instance) == iid {
+					resp.Refs = append(resp.Refs, ref)
+				}
+			}
+		}
+		if r.DescribeTags {
+			resp.Tags = inst.tagsProto()
+		}
+		if r.DescribeMetadata {
+			resp.Metadata = inst.metadataProto(nil)
+		}
+		return nil
+	})
+	return resp, err
+}
+
+// ResolveVersion implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) ResolveVersion(ctx context.Context, r *cipdpb.ResolveVersionRequest) (resp *cipdpb.Instance, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	if err := common.ValidateInstanceVersion(r.Version); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'version': %s", err)
+	}
+
+	err = s.store.view(func(st *state) error {
+		pkg := st.getPackage(r.Package)
+		if pkg == nil {
+			return noPackageErr(r.Package)
+		}
+
+		var iid string
+		switch {
+		case common.ValidateInstanceID(r.Version, common.AnyHash) == nil:
+			if pkg.Instances[r.Version] == nil {
+				return noInstanceErr()
+			}
+			iid = r.Version
+		case common.ValidatePackageRef(r.Version) == nil:
+			ref := pkg.Refs[r.Version]
+			if ref == nil {
+				return status.Errorf(codes.NotFound, "no such ref")
+			}
+			iid = ref.InstanceID
+		default:
+			tag := []*cipdpb.Tag{common.MustParseInstanceTag(r.Version)}
+			for id, inst := range pkg.Instances {
+				if inst.hasTags(tag) {
+					if iid != "" {
+						return status.Errorf(codes.FailedPrecondition, "ambiguity when resolving the tag, more than one instance has it")
+					}
+					iid = id
+				}
+			}
+			if iid == "" {
+				return status.Errorf(codes.NotFound, "no such tag")
+			}
+		}
+
+		inst := pkg.Instances[iid]
+		if inst == nil {
+			return noInstanceErr()
+		}
+		resp = inst.toProto(r.Package, common.InstanceIDToObjectRef(iid))
+		return nil
+	})
+	return resp, err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Refs.
+
+// CreateRef implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) CreateRef(ctx context.Context, r *cipdpb.Ref) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageRef(r.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'name': %s", err)
+	}
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+
+	err = s.store.update(func(st *state) error {
+		if st.getInstance(r.Package, r.Instance) == nil {
+			return noInstanceErr()
+		}
+		pkg := st.getPackage(r.Package)
+		iid := common.ObjectRefToInstanceID(r.Instance)
+		if cur := pkg.Refs[r.Name]; cur != nil && cur.InstanceID == iid {
+			return nil // already there
+		}
+		if pkg.Refs == nil {
+			pkg.Refs = map[string]*refState{}
+		}
+		pkg.Refs[r.Name] = &refState{
+			InstanceID: iid,
+			ModifiedBy: caller,
+			ModifiedTS: now(ctx),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteRef implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) DeleteRef(ctx context.Context, r *cipdpb.DeleteRefRequest) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageRef(r.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'name': %s", err)
+	}
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+
+	err = s.store.update(func(st *state) error {
+		pkg := st.getPackage(r.Package)
+		if pkg == nil {
+			return noPackageErr(r.Package)
+		}
+		delete(pkg.Refs, r.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListRefs implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) ListRefs(ctx context.Context, r *cipdpb.ListRefsRequest) (resp *cipdpb.ListRefsResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidatePackageName(r.Package); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	err = s.store.view(func(st *state) error {
+		pkg := st.getPackage(r.Package)
+		if pkg == nil {
+			return noPackageErr(r.Package)
+		}
+		resp = &cipdpb.ListRefsResponse{Refs: listRefs(r.Package, pkg)}
+		return nil
+	})
+	return resp, err
+}
+
+// listRefs returns all refs of a package, most recently modified first.
+func listRefs(pkgName string, pkg *packageState) []*cipdpb.Ref {
+	out := make([]*cipdpb.Ref, 0, len(pkg.Refs))
+	for name, ref := range pkg.Refs {
+		out = append(out, &cipdpb.Ref{
+			Name:       name,
+			Package:    pkgName,
+			Instance:   common.InstanceIDToObjectRef(ref.InstanceID),
+			ModifiedBy: ref.ModifiedBy,
+			ModifiedTs: timestamppb.New(ref.ModifiedTS),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ti, tj := out[i].ModifiedTs.AsTime(), out[j].ModifiedTs.AsTime()
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Tags.
+
+// AttachTags implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) AttachTags(ctx context.Context, r *cipdpb.AttachTagsRequest) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	if err := validateTagList(r.Tags); err != nil {
+		return nil, err
+	}
+
+	err = s.store.update(func(st *state) error {
+		inst := st.getInstance(r.Package, r.Instance)
+		if inst == nil {
+			return noInstanceErr()
+		}
+		ts := now(ctx)
+		for _, t := range r.Tags {
+			if !inst.hasTags([]*cipdpb.Tag{t}) {
+				inst.Tags = append(inst.Tags, &tagState{
+					Tag:        common.JoinInstanceTag(t),
+					AttachedBy: caller,
+					AttachedTS: ts,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DetachTags implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) DetachTags(ctx context.Context, r *cipdpb.DetachTagsRequest) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	if err := validateTagList(r.Tags); err != nil {
+		return nil, err
+	}
+
+	err = s.store.update(func(st *state) error {
+		inst := st.getInstance(r.Package, r.Instance)
+		if inst == nil {
+			return noInstanceErr()
+		}
+		detach := stringset.New(len(r.Tags))
+		for _, t := range r.Tags {
+			detach.Add(common.JoinInstanceTag(t))
+		}
+		inst.Tags = slices.DeleteFunc(inst.Tags, func(t *tagState) bool {
+			return detach.Has(t.Tag)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Instance metadata.
+
+// AttachMetadata implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) AttachMetadata(ctx context.Context, r *cipdpb.AttachMetadataRequest) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	if len(r.Metadata) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'metadata': cannot be empty")
+	}
+	for _, m := range r.Metadata {
+		if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'metadata' key: %s", err)
+		}
+		if err := common.ValidateInstanceMetadataLen(len(m.Value)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "metadata with key %q: %s", m.Key, err)
+		}
+		if err := common.ValidateContentType(m.ContentType); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "metadata with key %q: %s", m.Key, err)
+		}
+	}
+
+	err = s.store.update(func(st *state) error {
+		inst := st.getInstance(r.Package, r.Instance)
+		if inst == nil {
+			return noInstanceErr()
+		}
+		ts := now(ctx)
+		for _, m := range r.Metadata {
+			fp := common.InstanceMetadataFingerprint(m.Key, m.Value)
+			if inst.findMetadata(fp) == -1 {
+				inst.Metadata = append(inst.Metadata, &metadataState{
+					Key:         m.Key,
+					Value:       m.Value,
+					ContentType: m.ContentType,
+					Fingerprint: fp,
+					AttachedBy:  caller,
+					AttachedTS:  ts,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DetachMetadata implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) DetachMetadata(ctx context.Context, r *cipdpb.DetachMetadataRequest) (resp *emptypb.Empty, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	fps := make([]string, len(r.Metadata))
+	for i, m := range r.Metadata {
+		if m.Fingerprint != "" {
+			if err := common.ValidateInstanceMetadataFingerprint(m.Fingerprint); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "bad metadata fingerprint %q: %s", m.Fingerprint, err)
+			}
+			fps[i] = m.Fingerprint
+		} else {
+			if err := common.ValidateInstanceMetadataKey(m.Key); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "bad 'metadata' key: %s", err)
+			}
+			fps[i] = common.InstanceMetadataFingerprint(m.Key, m.Value)
+		}
+	}
+
+	err = s.store.update(func(st *state) error {
+		inst := st.getInstance(r.Package, r.Instance)
+		if inst == nil {
+			return noInstanceErr()
+		}
+		for _, fp := range fps {
+			if idx := inst.findMetadata(fp); idx != -1 {
+				inst.Metadata = slices.Delete(inst.Metadata, idx, idx+1)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListMetadata implements the corresponding RPC method, see the proto doc.
+func (s *repoServer) ListMetadata(ctx context.Context, r *cipdpb.ListMetadataRequest) (resp *cipdpb.ListMetadataResponse, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := validatePackageAndInstance(r.Package, r.Instance); err != nil {
+		return nil, err
+	}
+	for _, key := range r.Keys {
+		if err := common.ValidateInstanceMetadataKey(key); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad metadata key %q: %s", key, err)
+		}
+	}
+	if r.PageToken != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'page_token': not supported yet")
+	}
+
+	err = s.store.view(func(st *state) error {
+		inst := st.getInstance(r.Package, r.Instance)
+		if inst == nil {
+			return noInstanceErr()
+		}
+		resp = &cipdpb.ListMetadataResponse{Metadata: inst.metadataProto(r.Keys)}
+		return nil
+	})
+	return resp, err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Helpers.
+
+func noMetadataErr(prefix string) error {
+	return status.Errorf(codes.NotFound, "prefix %q has no metadata", prefix)
+}
+
+func noPackageErr(pkg string) error {
+	return status.Errorf(codes.NotFound, "no such package: %s", pkg)
+}
+
+func noInstanceErr() error {
+	return status.Errorf(codes.NotFound, "no such instance")
+}
+
+func validatePackageAndInstance(pkg string, inst *cipdpb.ObjectRef) error {
+	if err := common.ValidatePackageName(pkg); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad 'package': %s", err)
+	}
+	if err := common.ValidateObjectRef(inst, common.KnownHash); err != nil {
+		return status.Errorf(codes.InvalidArgument, "bad 'instance': %s", err)
+	}
+	return nil
+}
+
+func validateTagList(tags []*cipdpb.Tag) error {
+	if len(tags) == 0 {
+		return status.Errorf(codes.InvalidArgument, "bad 'tags': cannot be empty")
+	}
+	for _, t := range tags {
+		if err := common.ValidateInstanceTag(common.JoinInstanceTag(t)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "bad tag in 'tags': %s", err)
+		}
+	}
+	return nil
+}
+
+// now returns the current time, rounded to microseconds like in the datastore.
+func now(ctx context.Context) time.Time {
+	return clock.Now(ctx).UTC().Truncate(time.Microsecond)
+}
+
+// parentPrefixes returns "a", "a/b", "a/b/c" for "a/b/c".
+func parentPrefixes(prefix string) []string {
+	if prefix == "" {
+		return nil
+	}
+	chunks := strings.Split(prefix, "/")
+	out := make([]string, len(chunks))
+	for i := range chunks {
+		out[i] = strings.Join(chunks[:i+1], "/")
+	}
+	return out
+}
+
+// allRoles is returned by GetRolesInPrefix, since there are no ACLs.
+func allRoles() *cipdpb.RolesInPrefixResponse {
+	return &cipdpb.RolesInPrefixResponse{
+		Roles: []*cipdpb.RolesInPrefixResponse_RoleInPrefix{
+			{Role: cipdpb.Role_READER},
+			{Role: cipdpb.Role_WRITER},
+			{Role: cipdpb.Role_OWNER},
+		},
+	}
+}
+
+// prefixMetadataFingerprint calculates the fingerprint the same way the real
+// backend does: base64-encoded SHA1 of the serialized proto.
+func prefixMetadataFingerprint(m *cipdpb.PrefixMetadata) string {
+	blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	h := sha1.New()
+	h.Write([]byte("PrefixMetadata:"))
+	h.Write(blob)
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+// paginate returns a page of results and the token for the next one.
+//
+// Page tokens are just offsets in the full list of results.
+func paginate[T any](all []T, pageSize int32, pageToken string) (page []T, next string, err error) {
+	if pageSize < 0 {
+		return nil, "", status.Errorf(codes.InvalidArgument, "bad 'page_size' %d: it should be non-negative", pageSize)
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	offset := 0
+	if pageToken != "" {
+		if offset, err = strconv.Atoi(pageToken); err != nil || offset < 0 {
+			return nil, "", status.Errorf(codes.InvalidArgument, "bad 'page_token'")
+		}
+	}
+	if offset >= len(all) {
+		return nil, "", nil
+	}
+	end := min(offset+int(pageSize), len(all))
+	if end < len(all) {
+		next = strconv.Itoa(end)
+	}
+	return all[offset:end], next, nil
+}
+
+func (p *prefixState) toProto(prefix string) *cipdpb.PrefixMetadata {
+	m := &cipdpb.PrefixMetadata{
+		Prefix:      prefix,
+		Fingerprint: p.Fingerprint,
+		UpdateTime:  timestamppb.New(p.UpdateTime),
+		UpdateUser:  p.UpdateUser,
+	}
+	for role, principals := range p.ACLs {
+		m.Acls = append(m.Acls, &cipdpb.PrefixMetadata_ACL{
+			Role:       cipdpb.Role(cipdpb.Role_value[role]),
+			Principals: principals,
+		})
+	}
+	sort.Slice(m.Acls, func(i, j int) bool { return m.Acls[i].Role < m.Acls[j].Role })
+	return m
+}
+
+func (i *instanceState) toProto(pkg string, ref *cipdpb.ObjectRef) *cipdpb.Instance {
+	return &cipdpb.Instance{
+		Package:      pkg,
+		Instance:     ref,
+		RegisteredBy: i.RegisteredBy,
+		RegisteredTs: timestamppb.New(i.RegisteredTS),
+	}
+}
+
+// hasTags is true if the instance has all given tags.
+func (i *instanceState) hasTags(tags []*cipdpb.Tag) bool {
+	for _, t := range tags {
+		kv := common.JoinInstanceTag(t)
+		if !slices.ContainsFunc(i.Tags, func(ts *tagState) bool { return ts.Tag == kv }) {
+			return false
+		}
+	}
+	return true
+}
+
+// tagsProto returns all tags, most recently attached first.
+func (i *instanceState) tagsProto() []*cipdpb.Tag {
+	out := make([]*cipdpb.Tag, 0, len(i.Tags))
+	for idx := len(i.Tags) - 1; idx >= 0; idx-- {
+		t := common.MustParseInstanceTag(i.Tags[idx].Tag)
+		t.AttachedBy = i.Tags[idx].AttachedBy
+		t.AttachedTs = timestamppb.New(i.Tags[idx].AttachedTS)
+		out = append(out, t)
+	}
+	return out
+}
+
+// findMetadata returns an index of the metadata entry or -1 if not found.
+func (i *instanceState) findMetadata(fingerprint string) int {
+	return slices.IndexFunc(i.Metadata, func(m *metadataState) bool {
+		return m.Fingerprint == fingerprint
+	})
+}
+
+// metadataProto returns metadata with given keys (or all if `keys` is empty),
+// most recently attached first.
+func (i *instanceState) metadataProto(keys []string) []*cipdpb.InstanceMetadata {
+	out := make([]*cipdpb.InstanceMetadata, 0, len(i.Metadata))
+	for idx := len(i.Metadata) - 1; idx >= 0; idx-- {
+		m := i.Metadata[idx]
+		if len(keys) != 0 && !slices.Contains(keys, m.Key) {
+			continue
+		}
+		out = append(out, &cipdpb.InstanceMetadata{
+			Key:         m.Key,
+			Value:       m.Value,
+			ContentType: m.ContentType,
+			Fingerprint: m.Fingerprint,
+			AttachedBy:  m.AttachedBy,
+			AttachedTs:  timestamppb.New(m.AttachedTS),
+		})
+	}
+	return out
+}
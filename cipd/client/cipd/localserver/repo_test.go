@@ -0,0 +1,256 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	cipdpb "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+func TestRepo(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	setup := func(t *testing.T) *repoServer {
+		store, err := openStore(t.TempDir())
+		assert.NoErr(t, err)
+		return &repoServer{
+			store: store,
+			cas:   &storageServer{store: store, baseURL: "http://example.com"},
+		}
+	}
+
+	// putObject puts the object into the CAS directly and returns its ref.
+	putObject := func(t *testing.T, repo *repoServer, body string) *cipdpb.ObjectRef {
+		digest := sha256.Sum256([]byte(body))
+		ref := &cipdpb.ObjectRef{
+			HashAlgo:  cipdpb.HashAlgo_SHA256,
+			HexDigest: hex.EncodeToString(digest[:]),
+		}
+		path := repo.store.objectPath(ref)
+		assert.NoErr(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoErr(t, os.WriteFile(path, []byte(body), 0600))
+		return ref
+	}
+
+	register := func(t *testing.T, repo *repoServer, pkg, body string) *cipdpb.ObjectRef {
+		ref := putObject(t, repo, body)
+		resp, err := repo.RegisterInstance(ctx, &cipdpb.Instance{Package: pkg, Instance: ref})
+		assert.NoErr(t, err)
+		assert.That(t, resp.Status, should.Equal(cipdpb.RegistrationStatus_REGISTERED))
+		return ref
+	}
+
+	t.Run("RegisterInstance NOT_UPLOADED", func(t *testing.T) {
+		repo := setup(t)
+		resp, err := repo.RegisterInstance(ctx, &cipdpb.Instance{
+			Package: "a/b",
+			Instance: &cipdpb.ObjectRef{
+				HashAlgo:  cipdpb.HashAlgo_SHA256,
+				HexDigest: hex.EncodeToString(make([]byte, 32)),
+			},
+		})
+		assert.NoErr(t, err)
+		assert.That(t, resp.Status, should.Equal(cipdpb.RegistrationStatus_NOT_UPLOADED))
+		assert.That(t, resp.UploadOp.Status, should.Equal(cipdpb.UploadStatus_UPLOADING))
+		assert.That(t, resp.UploadOp.UploadUrl, should.Equal("http://example.com/upload/"+resp.UploadOp.OperationId))
+	})
+
+	t.Run("ListPrefix", func(t *testing.T) {
+		repo := setup(t)
+		register(t, repo, "a/b", "1")
+		register(t, repo, "a/c/d", "2")
+		register(t, repo, "a/c/e/f", "3")
+		register(t, repo, "z", "4")
+
+		_, err := repo.HidePackage(ctx, &cipdpb.PackageRequest{Package: "a/c/d"})
+		assert.NoErr(t, err)
+
+		resp, err := repo.ListPrefix(ctx, &cipdpb.ListPrefixRequest{Prefix: "a"})
+		assert.NoErr(t, err)
+		assert.That(t, resp.Packages, should.Match([]string{"a/b"}))
+		assert.That(t, resp.Prefixes, should.Match([]string{"a/c"}))
+
+		resp, err = repo.ListPrefix(ctx, &cipdpb.ListPrefixRequest{Prefix: "a", Recursive: true})
+		assert.NoErr(t, err)
+		assert.That(t, resp.Packages, should.Match([]string{"a/b", "a/c/e/f"}))
+		assert.That(t, resp.Prefixes, should.Match([]string{"a/c", "a/c/e"}))
+
+		resp, err = repo.ListPrefix(ctx, &cipdpb.ListPrefixRequest{Recursive: true, IncludeHidden: true})
+		assert.NoErr(t, err)
+		assert.That(t, resp.Packages, should.Match([]string{"a/b", "a/c/d", "a/c/e/f", "z"}))
+		assert.That(t, resp.Prefixes, should.Match([]string{"a", "a/c", "a/c/e"}))
+	})
+
+	t.Run("ResolveVersion", func(t *testing.T) {
+		repo := setup(t)
+		ref1 := register(t, repo, "a/b", "1")
+		ref2 := register(t, repo, "a/b", "2")
+
+		for _, ref := range []*cipdpb.ObjectRef{ref1, ref2} {
+			_, err := repo.AttachTags(ctx, &cipdpb.AttachTagsRequest{
+				Package:  "a/b",
+				Instance: ref,
+				Tags:     []*cipdpb.Tag{{Key: "k", Value: "shared"}},
+			})
+			assert.NoErr(t, err)
+		}
+		_, err := repo.AttachTags(ctx, &cipdpb.AttachTagsRequest{
+			Package:  "a/b",
+			Instance: ref2,
+			Tags:     []*cipdpb.Tag{{Key: "k", Value: "unique"}},
+		})
+		assert.NoErr(t, err)
+		_, err = repo.CreateRef(ctx, &cipdpb.Ref{Name: "latest", Package: "a/b", Instance: ref1})
+		assert.NoErr(t, err)
+
+		resolve := func(version string) (*cipdpb.ObjectRef, error) {
+			inst, err := repo.ResolveVersion(ctx, &cipdpb.ResolveVersionRequest{
+				Package: "a/b",
+				Version: version,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return inst.Instance, nil
+		}
+
+		got, err := resolve("latest")
+		assert.NoErr(t, err)
+		assert.That(t, got, should.Match(ref1))
+
+		got, err = resolve("k:unique")
+		assert.NoErr(t, err)
+		assert.That(t, got, should.Match(ref2))
+
+		_, err = resolve("k:shared")
+		assert.That(t, status.Code(err), should.Equal(codes.FailedPrecondition))
+		_, err = resolve("k:missing")
+		assert.That(t, status.Code(err), should.Equal(codes.NotFound))
+		_, err = resolve("missing")
+		assert.That(t, err, should.ErrLike("no such ref"))
+
+		_, err = repo.ResolveVersion(ctx, &cipdpb.ResolveVersionRequest{
+			Package: "a/missing",
+			Version: "latest",
+		})
+		assert.That(t, err, should.ErrLike("no such package"))
+	})
+
+	t.Run("UpdatePrefixMetadata", func(t *testing.T) {
+		repo := setup(t)
+
+		_, err := repo.GetPrefixMetadata(ctx, &cipdpb.PrefixRequest{Prefix: "a"})
+		assert.That(t, status.Code(err), should.Equal(codes.NotFound))
+
+		md, err := repo.UpdatePrefixMetadata(ctx, &cipdpb.PrefixMetadata{
+			Prefix: "a",
+			Acls: []*cipdpb.PrefixMetadata_ACL{
+				{Role: cipdpb.Role_READER, Principals: []string{"group:all"}},
+			},
+		})
+		assert.NoErr(t, err)
+		assert.That(t, md.Fingerprint, should.NotEqual(""))
+
+		// Creating it again is an error.
+		_, err = repo.UpdatePrefixMetadata(ctx, &cipdpb.PrefixMetadata{Prefix: "a"})
+		assert.That(t, status.Code(err), should.Equal(codes.AlreadyExists))
+
+		// Updating with a stale fingerprint is an error.
+		_, err = repo.UpdatePrefixMetadata(ctx, &cipdpb.PrefixMetadata{Prefix: "a", Fingerprint: "stale"})
+		assert.That(t, status.Code(err), should.Equal(codes.FailedPrecondition))
+
+		// Updating with the correct fingerprint works.
+		md.Acls = append(md.Acls, &cipdpb.PrefixMetadata_ACL{
+			Role:       cipdpb.Role_OWNER,
+			Principals: []string{"user:someone@example.com"},
+		})
+		upd, err := repo.UpdatePrefixMetadata(ctx, md)
+		assert.NoErr(t, err)
+		assert.That(t, upd.Fingerprint, should.NotEqual(md.Fingerprint))
+
+		inherited, err := repo.GetInheritedPrefixMetadata(ctx, &cipdpb.PrefixRequest{Prefix: "a/b/c"})
+		assert.NoErr(t, err)
+		assert.That(t, inherited.PerPrefixMetadata, should.Match([]*cipdpb.PrefixMetadata{upd}))
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		repo := setup(t)
+		ref := register(t, repo, "a/b", "1")
+
+		for _, val := range []string{"v1", "v2", "v1"} {
+			_, err := repo.AttachMetadata(ctx, &cipdpb.AttachMetadataRequest{
+				Package:  "a/b",
+				Instance: ref,
+				Metadata: []*cipdpb.InstanceMetadata{{Key: "k", Value: []byte(val)}},
+			})
+			assert.NoErr(t, err)
+		}
+
+		list := func() (vals []string) {
+			resp, err := repo.ListMetadata(ctx, &cipdpb.ListMetadataRequest{
+				Package:  "a/b",
+				Instance: ref,
+				Keys:     []string{"k"},
+			})
+			assert.NoErr(t, err)
+			for _, md := range resp.Metadata {
+				vals = append(vals, string(md.Value))
+			}
+			return
+		}
+		assert.That(t, list(), should.Match([]string{"v2", "v1"}))
+
+		_, err := repo.DetachMetadata(ctx, &cipdpb.DetachMetadataRequest{
+			Package:  "a/b",
+			Instance: ref,
+			Metadata: []*cipdpb.InstanceMetadata{{Key: "k", Value: []byte("v2")}},
+		})
+		assert.NoErr(t, err)
+		assert.That(t, list(), should.Match([]string{"v1"}))
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	all := []int{1, 2, 3, 4, 5}
+
+	page, next, err := paginate(all, 2, "")
+	assert.NoErr(t, err)
+	assert.That(t, page, should.Match([]int{1, 2}))
+	assert.That(t, next, should.Equal("2"))
+
+	page, next, err = paginate(all, 2, "4")
+	assert.NoErr(t, err)
+	assert.That(t, page, should.Match([]int{5}))
+	assert.That(t, next, should.Equal(""))
+
+	_, _, err = paginate(all, 2, "huh")
+	assert.That(t, status.Code(err), should.Equal(codes.InvalidArgument))
+}
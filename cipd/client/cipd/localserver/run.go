@@ -0,0 +1,62 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"net"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/system/signals"
+)
+
+// Params is high-level configuration of the local server.
+type Params struct {
+	// Root is a directory with the server state, will be created if missing.
+	Root string
+	// Address is a TCP address to listen on, e.g. "127.0.0.1:0".
+	Address string
+	// Started is called once the server is listening to connections.
+	Started func(serviceURL string)
+}
+
+// Run runs the local CIPD server until SIGTERM.
+//
+// Returns an error if the server failed to start or died unexpectedly.
+func Run(ctx context.Context, params Params) error {
+	listener, err := net.Listen("tcp", params.Address)
+	if err != nil {
+		return errors.Annotate(err, "failed to listen on %q", params.Address).Err()
+	}
+
+	srv := &Server{
+		Listener: listener,
+		Root:     params.Root,
+	}
+
+	stop := signals.HandleInterrupt(func() {
+		logging.Infof(ctx, "Got a signal, shutting down the local CIPD server...")
+		if err := srv.Stop(ctx); err != nil {
+			logging.Errorf(ctx, "Failed to shutdown: %s", err)
+		}
+	})
+	defer stop()
+
+	if params.Started != nil {
+		params.Started(srv.ServiceURL())
+	}
+	return srv.Serve(ctx)
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localserver implements a self-contained CIPD backend that keeps all
+// its state in a local directory.
+//
+// It implements cipd.Repository and cipd.Storage pRPC services and serves CAS
+// objects over plain HTTP. This is enough for the CIPD client to register,
+// tag, resolve, fetch and install packages without any network access or
+// Google Storage, e.g. in hermetic tests of tools that use CIPD.
+//
+// There's no authentication or authorization: every caller is an OWNER of
+// every prefix. Package processors (e.g. client binary extraction) and
+// instance deltas are not supported.
+package localserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/runtime/paniccatcher"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/server/router"
+
+	cipdpb "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+// Server is a CIPD backend serving data from a local directory.
+type Server struct {
+	// Listener to accept incoming connections.
+	Listener net.Listener
+	// Root is a directory with the server state, will be created if missing.
+	Root string
+
+	m       sync.Mutex
+	httpSrv *http.Server  // the actual serving server
+	stopped chan struct{} // closed after graceful shutdown
+	done    bool          // true if `stopped` was already closed
+}
+
+// ServiceURL is the URL to pass to the CIPD client as the service URL.
+func (srv *Server) ServiceURL() string {
+	return "http://" + srv.Listener.Addr().String()
+}
+
+// Serve blocks running the serving loop.
+//
+// Uses the given context as the base context for all requests.
+//
+// Returns nil some time after Stop is called once all in-flight requests are
+// done. Returns an error if the server failed to start or died unexpectedly.
+func (srv *Server) Serve(ctx context.Context) error {
+	httpSrv, stopped, err := srv.initialize(ctx)
+	if err != nil {
+		return err
+	}
+	// This blocks as long as the listening port is listening.
+	if err = httpSrv.Serve(srv.Listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	// Wait until all in-flight requests are done.
+	<-stopped
+	return nil
+}
+
+// Stop initiates the server shutdown and waits for it to finish.
+//
+// At some point it will unblock Serve.
+func (srv *Server) Stop(ctx context.Context) error {
+	srv.m.Lock()
+	done := srv.done
+	httpSrv := srv.httpSrv
+	srv.m.Unlock()
+	if done || httpSrv == nil {
+		return nil
+	}
+	// This blocks until all in-flight requests are done.
+	if err := httpSrv.Shutdown(ctx); err != nil {
+		return err
+	}
+	// Notify Serve it can return now.
+	srv.m.Lock()
+	if !srv.done {
+		srv.done = true
+		close(srv.stopped)
+	}
+	srv.m.Unlock()
+	return nil
+}
+
+// initialize initializes server guts.
+func (srv *Server) initialize(ctx context.Context) (*http.Server, chan struct{}, error) {
+	srv.m.Lock()
+	defer srv.m.Unlock()
+	if srv.httpSrv != nil {
+		return nil, nil, errors.Reason("already started").Err()
+	}
+
+	store, err := openStore(srv.Root)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "failed to initialize the root directory").Err()
+	}
+	storage := &storageServer{
+		store:   store,
+		baseURL: srv.ServiceURL(),
+	}
+	repo := &repoServer{
+		store: store,
+		cas:   storage,
+	}
+
+	prpcSrv := &prpc.Server{}
+	cipdpb.RegisterRepositoryServer(prpcSrv, repo)
+	cipdpb.RegisterStorageServer(prpcSrv, storage)
+
+	prpcRouter := router.New()
+	prpcSrv.InstallHandlers(prpcRouter, nil)
+
+	srv.httpSrv = &http.Server{
+		Addr:        srv.Listener.Addr().String(), // just for logs
+		BaseContext: func(net.Listener) context.Context { return ctx },
+		Handler: http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			defer paniccatcher.Catch(func(p *paniccatcher.Panic) {
+				// ErrAbortHandler is explicitly used by net/http to signal that the panic
+				// is "expected" and it should not be logged.
+				if p.Reason != http.ErrAbortHandler {
+					p.Log(ctx, "Caught panic during handling of %q: %s", req.RequestURI, p.Reason)
+					http.Error(rw, "Internal Server Error. See logs.", http.StatusInternalServerError)
+				}
+			})
+			switch {
+			case strings.HasPrefix(req.URL.Path, "/cas/"):
+				storage.serveObject(rw, req)
+			case strings.HasPrefix(req.URL.Path, "/upload/"):
+				storage.acceptUpload(rw, req)
+			default:
+				prpcRouter.ServeHTTP(rw, req)
+			}
+		}),
+	}
+
+	srv.stopped = make(chan struct{})
+	return srv.httpSrv, srv.stopped, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+)
+
+func TestEndToEnd(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := startServer(t, t.TempDir())
+
+	siteRoot := t.TempDir()
+	client, err := cipd.NewClient(cipd.ClientOptions{
+		ServiceURL: srv.ServiceURL(),
+		Root:       siteRoot,
+	})
+	assert.NoErr(t, err)
+	defer client.Close(ctx)
+
+	data, pin := buildInstance(t, "some/pkg", map[string]string{"file": "hello"})
+
+	// Upload and register.
+	assert.NoErr(t, client.RegisterInstance(ctx, pin, pkg.NewBytesSource(data), time.Minute))
+	// Already registered, this is fine.
+	assert.NoErr(t, client.RegisterInstance(ctx, pin, pkg.NewBytesSource(data), time.Minute))
+
+	// Refs, tags and metadata.
+	assert.NoErr(t, client.SetRefWhenReady(ctx, "latest", pin))
+	assert.NoErr(t, client.AttachTagsWhenReady(ctx, pin, []string{"version:1"}))
+	assert.NoErr(t, client.AttachMetadataWhenReady(ctx, pin, []cipd.Metadata{
+		{Key: "some-key", Value: []byte("some value"), ContentType: "text/plain"},
+	}))
+
+	// All kinds of versions are resolvable.
+	for _, v := range []string{pin.InstanceID, "latest", "version:1"} {
+		resolved, err := client.ResolveVersion(ctx, "some/pkg", v)
+		assert.NoErr(t, err)
+		assert.That(t, resolved, should.Equal(pin))
+	}
+	_, err = client.ResolveVersion(ctx, "some/pkg", "version:2")
+	assert.That(t, err, should.ErrLike("no such tag"))
+
+	desc, err := client.DescribeInstance(ctx, pin, &cipd.DescribeInstanceOpts{
+		DescribeRefs:     true,
+		DescribeTags:     true,
+		DescribeMetadata: true,
+	})
+	assert.NoErr(t, err)
+	assert.That(t, desc.Refs[0].Ref, should.Equal("latest"))
+	assert.That(t, desc.Tags[0].Tag, should.Equal("version:1"))
+	assert.That(t, string(desc.Metadata[0].Value), should.Equal("some value"))
+
+	pkgs, err := client.ListPackages(ctx, "", true, false)
+	assert.NoErr(t, err)
+	assert.That(t, pkgs, should.Match([]string{"some/", "some/pkg"}))
+
+	// Install it.
+	_, err = client.EnsurePackages(ctx, common.PinSliceBySubdir{"": {pin}}, nil)
+	assert.NoErr(t, err)
+	body, err := os.ReadFile(filepath.Join(siteRoot, "file"))
+	assert.NoErr(t, err)
+	assert.That(t, string(body), should.Equal("hello"))
+
+	// The state survives the server restart.
+	assert.NoErr(t, srv.Stop(ctx))
+	srv = startServer(t, srv.Root)
+	client, err = cipd.NewClient(cipd.ClientOptions{
+		ServiceURL: srv.ServiceURL(),
+		Root:       t.TempDir(),
+	})
+	assert.NoErr(t, err)
+	defer client.Close(ctx)
+	resolved, err := client.ResolveVersion(ctx, "some/pkg", "latest")
+	assert.NoErr(t, err)
+	assert.That(t, resolved, should.Equal(pin))
+}
+
+func startServer(t *testing.T, root string) *Server {
+	ctx := context.Background()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoErr(t, err)
+
+	srv := &Server{Listener: listener, Root: root}
+	go func() { assert.NoErr(t, srv.Serve(ctx)) }()
+	t.Cleanup(func() { assert.NoErr(t, srv.Stop(ctx)) })
+	return srv
+}
+
+func buildInstance(t *testing.T, name string, files map[string]string) ([]byte, common.Pin) {
+	var input []fs.File
+	for path, body := range files {
+		input = append(input, fs.NewTestFile(path, body, fs.TestFileOpts{}))
+	}
+	out := bytes.Buffer{}
+	pin, err := builder.BuildInstance(context.Background(), builder.Options{
+		Input:       input,
+		Output:      &out,
+		PackageName: name,
+	})
+	assert.NoErr(t, err)
+	return out.Bytes(), pin
+}
@@ -0,0 +1,401 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	cipdpb "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// storageServer implements cipd.Storage service on top of the store.
+type storageServer struct {
+	cipdpb.UnimplementedStorageServer
+
+	store   *store
+	baseURL string // e.g. "http://127.0.0.1:12345"
+}
+
+// GetObjectURL implements the corresponding RPC method, see the proto doc.
+func (s *storageServer) GetObjectURL(ctx context.Context, r *cipdpb.GetObjectURLRequest) (resp *cipdpb.ObjectURL, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if err := common.ValidateObjectRef(r.Object, common.KnownHash); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'object' field: %s", err)
+	}
+	if strings.ContainsAny(r.DownloadFilename, "\"\r\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'download_filename' field, contains one of %q", "\"\r\n")
+	}
+	switch yes, err := s.store.hasObject(r.Object); {
+	case err != nil:
+		return nil, err
+	case !yes:
+		return nil, status.Errorf(codes.NotFound, "the object is not in the store")
+	}
+	return &cipdpb.ObjectURL{SignedUrl: s.objectURL(r.Object, r.DownloadFilename)}, nil
+}
+
+// BeginUpload implements the corresponding RPC method, see the proto doc.
+func (s *storageServer) BeginUpload(ctx context.Context, r *cipdpb.BeginUploadRequest) (resp *cipdpb.UploadOperation, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	// Either Object or HashAlgo should be given. If both are, algos must match.
+	upload := &uploadState{Status: cipdpb.UploadStatus_UPLOADING}
+	if r.Object != nil {
+		if err := common.ValidateObjectRef(r.Object, common.KnownHash); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'object': %s", err)
+		}
+		if r.HashAlgo != 0 && r.HashAlgo != r.Object.HashAlgo {
+			return nil, status.Errorf(codes.InvalidArgument, "'hash_algo' and 'object.hash_algo' do not match")
+		}
+		switch yes, err := s.store.hasObject(r.Object); {
+		case err != nil:
+			return nil, err
+		case yes:
+			return nil, status.Errorf(codes.AlreadyExists, "the object is already in the store")
+		}
+		upload.HashAlgo = r.Object.HashAlgo
+		upload.HexDigest = r.Object.HexDigest
+	} else if err := common.ValidateHashAlgo(r.HashAlgo); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad 'hash_algo': %s", err)
+	} else {
+		upload.HashAlgo = r.HashAlgo
+	}
+
+	err = s.store.update(func(st *state) error {
+		resp, err = s.beginUpload(st, upload)
+		return err
+	})
+	return resp, err
+}
+
+// FinishUpload implements the corresponding RPC method, see the proto doc.
+//
+// Unlike the real backend, verifies the uploaded data synchronously, so the
+// returned operation is never in VERIFYING state.
+func (s *storageServer) FinishUpload(ctx context.Context, r *cipdpb.FinishUploadRequest) (resp *cipdpb.UploadOperation, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	if r.ForceHash != nil {
+		if err := common.ValidateObjectRef(r.ForceHash, common.KnownHash); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad 'force_hash' field: %s", err)
+		}
+	}
+
+	err = s.store.update(func(st *state) error {
+		upload := st.Uploads[r.UploadOperationId]
+		if upload == nil {
+			return status.Errorf(codes.NotFound, "no such upload operation")
+		}
+		if upload.Status == cipdpb.UploadStatus_UPLOADING {
+			if err := s.verifyUpload(ctx, r.UploadOperationId, upload, r.ForceHash); err != nil {
+				return err
+			}
+		}
+		resp = s.uploadOp(r.UploadOperationId, upload)
+		return nil
+	})
+	return resp, err
+}
+
+// CancelUpload implements the corresponding RPC method, see the proto doc.
+func (s *storageServer) CancelUpload(ctx context.Context, r *cipdpb.CancelUploadRequest) (resp *cipdpb.UploadOperation, err error) {
+	defer func() { err = grpcutil.GRPCifyAndLogErr(ctx, err) }()
+
+	err = s.store.update(func(st *state) error {
+		upload := st.Uploads[r.UploadOperationId]
+		switch {
+		case upload == nil:
+			return status.Errorf(codes.NotFound, "no such upload operation")
+		case upload.Status == cipdpb.UploadStatus_UPLOADING:
+			upload.Status = cipdpb.UploadStatus_CANCELED
+			s.removeUploadData(ctx, r.UploadOperationId)
+		case upload.Status != cipdpb.UploadStatus_ERRORED && upload.Status != cipdpb.UploadStatus_CANCELED:
+			return status.Errorf(codes.FailedPrecondition, "the operation is in state %s and can't be canceled", upload.Status)
+		}
+		resp = s.uploadOp(r.UploadOperationId, upload)
+		return nil
+	})
+	return resp, err
+}
+
+// beginUpload registers a new upload operation in the state.
+func (s *storageServer) beginUpload(st *state, upload *uploadState) (*cipdpb.UploadOperation, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, errors.Annotate(err, "failed to generate upload operation ID").Tag(grpcutil.InternalTag).Err()
+	}
+	opID := hex.EncodeToString(buf)
+	if err := os.WriteFile(s.store.uploadPath(opID), nil, 0600); err != nil {
+		return nil, errors.Annotate(err, "failed to create the upload file").Tag(grpcutil.InternalTag).Err()
+	}
+	st.Uploads[opID] = upload
+	return s.uploadOp(opID, upload), nil
+}
+
+// verifyUpload hashes the uploaded data and moves it into the CAS.
+//
+// Updates `upload` in place. Verification failures are reported through the
+// operation status, the returned error indicates only internal errors.
+func (s *storageServer) verifyUpload(ctx context.Context, opID string, upload *uploadState, forceHash *cipdpb.ObjectRef) error {
+	expected := forceHash
+	if expected == nil && upload.HexDigest != "" {
+		expected = &cipdpb.ObjectRef{HashAlgo: upload.HashAlgo, HexDigest: upload.HexDigest}
+	}
+	algo := upload.HashAlgo
+	if expected != nil {
+		algo = expected.HashAlgo
+	}
+
+	path := s.store.uploadPath(opID)
+	h := common.MustNewHash(algo)
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Annotate(err, "failed to open the uploaded file").Tag(grpcutil.InternalTag).Err()
+	}
+	_, err = io.Copy(h, f)
+	_ = f.Close()
+	if err != nil {
+		return errors.Annotate(err, "failed to read the uploaded file").Tag(grpcutil.InternalTag).Err()
+	}
+	got := common.ObjectRefFromHash(h)
+
+	if expected != nil && expected.HexDigest != got.HexDigest {
+		upload.Status = cipdpb.UploadStatus_ERRORED
+		upload.ErrorMessage = fmt.Sprintf("expected %s to be %s, got %s", algo, expected.HexDigest, got.HexDigest)
+		s.removeUploadData(ctx, opID)
+		return nil
+	}
+
+	dest := s.store.objectPath(got)
+	if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+		return errors.Annotate(err, "failed to create the CAS directory").Tag(grpcutil.InternalTag).Err()
+	}
+	if err := os.Rename(path, dest); err != nil {
+		return errors.Annotate(err, "failed to move the uploaded file to the CAS").Tag(grpcutil.InternalTag).Err()
+	}
+	upload.Status = cipdpb.UploadStatus_PUBLISHED
+	upload.HashAlgo = got.HashAlgo
+	upload.HexDigest = got.HexDigest
+	return nil
+}
+
+// removeUploadData deletes the file with uploaded data, logging errors.
+func (s *storageServer) removeUploadData(ctx context.Context, opID string) {
+	if err := os.Remove(s.store.uploadPath(opID)); err != nil && !os.IsNotExist(err) {
+		logging.Warningf(ctx, "Failed to remove the upload file: %s", err)
+	}
+}
+
+// uploadOp converts uploadState to its proto representation.
+func (s *storageServer) uploadOp(opID string, upload *uploadState) *cipdpb.UploadOperation {
+	op := &cipdpb.UploadOperation{
+		OperationId:  opID,
+		UploadUrl:    s.baseURL + "/upload/" + opID,
+		Status:       upload.Status,
+		ErrorMessage: upload.ErrorMessage,
+	}
+	if upload.Status == cipdpb.UploadStatus_PUBLISHED {
+		op.Object = &cipdpb.ObjectRef{HashAlgo: upload.HashAlgo, HexDigest: upload.HexDigest}
+	}
+	return op
+}
+
+// objectURL returns an URL that can be used to fetch the object.
+func (s *storageServer) objectURL(ref *cipdpb.ObjectRef, filename string) string {
+	u := fmt.Sprintf("%s/cas/%s/%s", s.baseURL, strings.ToLower(ref.HashAlgo.String()), ref.HexDigest)
+	if filename != "" {
+		u += "?filename=" + url.QueryEscape(filename)
+	}
+	return u
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// HTTP handlers.
+
+// serveObject handles "GET /cas/<algo>/<digest>" requests.
+func (s *storageServer) serveObject(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" && req.Method != "HEAD" {
+		http.Error(rw, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	algo, digest, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/cas/"), "/")
+	ref := &cipdpb.ObjectRef{
+		HashAlgo:  cipdpb.HashAlgo(cipdpb.HashAlgo_value[strings.ToUpper(algo)]),
+		HexDigest: digest,
+	}
+	if err := common.ValidateObjectRef(ref, common.KnownHash); err != nil {
+		http.Error(rw, fmt.Sprintf("Bad object reference: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	f, err := os.Open(s.store.objectPath(ref))
+	if err != nil {
+		if os.IsNotExist(err) {
+			http.Error(rw, "No such object", http.StatusNotFound)
+		} else {
+			logging.Errorf(req.Context(), "Failed to open the object: %s", err)
+			http.Error(rw, "Failed to open the object", http.StatusInternalServerError)
+		}
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	if filename := req.URL.Query().Get("filename"); filename != "" {
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	}
+	rw.Header().Set("Content-Type", "application/octet-stream")
+	http.ServeContent(rw, req, "", time.Time{}, f)
+}
+
+// acceptUpload handles "PUT /upload/<op ID>" requests.
+//
+// Implements a subset of Google Storage resumable upload protocol used by the
+// CIPD client: each request uploads the next chunk of the file, identified by
+// "Content-Range: bytes <first>-<last>/<total>" header. A request with
+// "Content-Range: bytes */<total>" header asks for the uploaded offset. The
+// reply is either HTTP 200 if the entire file is uploaded or HTTP 308 with
+// "Range: bytes=0-<last>" header if only a part of it is.
+func (s *storageServer) acceptUpload(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != "PUT" {
+		http.Error(rw, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	opID := strings.TrimPrefix(req.URL.Path, "/upload/")
+	first, last, total, err := parseContentRange(req.Header.Get("Content-Range"))
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("Bad Content-Range header: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// Note: the data is written outside of the store lock to avoid blocking
+	// RPCs while a large file is being uploaded.
+	var code int
+	var msg string
+	err = s.store.view(func(st *state) error {
+		switch upload := st.Uploads[opID]; {
+		case upload == nil:
+			code, msg = http.StatusNotFound, "No such upload operation"
+		case upload.Status != cipdpb.UploadStatus_UPLOADING:
+			code, msg = http.StatusConflict, fmt.Sprintf("The upload operation is %s", upload.Status)
+		}
+		return nil
+	})
+	if err != nil {
+		code, msg = http.StatusInternalServerError, err.Error()
+	}
+
+	var size int64
+	if code == 0 {
+		size, code, msg = s.writeChunk(opID, first, last, req.Body)
+	}
+	switch {
+	case code != 0:
+		// Already have an error.
+	case size >= total:
+		code = http.StatusOK
+	default:
+		if size > 0 {
+			rw.Header().Set("Range", fmt.Sprintf("bytes=0-%d", size-1))
+		}
+		code = http.StatusPermanentRedirect
+	}
+	if msg != "" {
+		if code == http.StatusInternalServerError {
+			logging.Errorf(req.Context(), "Upload failed: %s", msg)
+		}
+		http.Error(rw, msg, code)
+	} else {
+		rw.WriteHeader(code)
+	}
+}
+
+// writeChunk writes the body at the given offset of the upload file.
+//
+// `first` is -1 if there's no body and only the current size is needed.
+// Returns the new size of the uploaded data on success or an HTTP status code
+// with an error message on failure.
+func (s *storageServer) writeChunk(opID string, first, last int64, body io.Reader) (size int64, code int, msg string) {
+	f, err := os.OpenFile(s.store.uploadPath(opID), os.O_RDWR, 0600)
+	if err != nil {
+		return 0, http.StatusInternalServerError, fmt.Sprintf("failed to open the upload file: %s", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	size, err = f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, http.StatusInternalServerError, fmt.Sprintf("failed to seek the upload file: %s", err)
+	}
+	if first == -1 {
+		return size, 0, ""
+	}
+
+	// Allow overwriting already uploaded data (e.g. when the client retries
+	// after a transient error), but do not allow gaps.
+	if first > size {
+		return 0, http.StatusBadRequest, fmt.Sprintf("the chunk starts at %d, but only %d bytes were uploaded", first, size)
+	}
+	if err := f.Truncate(first); err != nil {
+		return 0, http.StatusInternalServerError, fmt.Sprintf("failed to truncate the upload file: %s", err)
+	}
+	if _, err := f.Seek(first, io.SeekStart); err != nil {
+		return 0, http.StatusInternalServerError, fmt.Sprintf("failed to seek the upload file: %s", err)
+	}
+	written, err := io.Copy(f, io.LimitReader(body, last-first+1))
+	if err != nil {
+		return 0, http.StatusInternalServerError, fmt.Sprintf("failed to write the upload file: %s", err)
+	}
+	return first + written, 0, ""
+}
+
+// parseContentRange parses "bytes <first>-<last>/<total>" or "bytes */<total>".
+//
+// Returns -1 as `first` and `last` in the latter case.
+func parseContentRange(val string) (first, last, total int64, err error) {
+	rng, ok := strings.CutPrefix(val, "bytes ")
+	if !ok {
+		return 0, 0, 0, errors.Reason("expecting \"bytes ...\", got %q", val).Err()
+	}
+	if _, err := fmt.Sscanf(rng, "*/%d", &total); err == nil {
+		return -1, -1, total, nil
+	}
+	if _, err := fmt.Sscanf(rng, "%d-%d/%d", &first, &last, &total); err != nil {
+		return 0, 0, 0, errors.Reason("unrecognized range %q", rng).Err()
+	}
+	if first < 0 || last < first || last >= total {
+		return 0, 0, 0, errors.Reason("invalid range %q", rng).Err()
+	}
+	return first, last, total, nil
+}
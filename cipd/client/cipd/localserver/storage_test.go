@@ -0,0 +1,130 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/grpc/prpc"
+
+	cipdpb "go.chromium.org/luci/cipd/api/cipd/v1"
+)
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	srv := startServer(t, t.TempDir())
+	cas := cipdpb.NewStorageClient(&prpc.Client{
+		Host:    strings.TrimPrefix(srv.ServiceURL(), "http://"),
+		Options: &prpc.Options{Insecure: true},
+	})
+
+	const body = "0123456789"
+	digest := sha256.Sum256([]byte(body))
+	ref := &cipdpb.ObjectRef{
+		HashAlgo:  cipdpb.HashAlgo_SHA256,
+		HexDigest: hex.EncodeToString(digest[:]),
+	}
+
+	// put sends a chunk of the upload using Google Storage resumable protocol.
+	put := func(t *testing.T, url, rng, chunk string) *http.Response {
+		req, err := http.NewRequest("PUT", url, strings.NewReader(chunk))
+		assert.NoErr(t, err)
+		req.Header.Set("Content-Range", rng)
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoErr(t, err)
+		assert.NoErr(t, resp.Body.Close())
+		return resp
+	}
+
+	t.Run("Resumable upload", func(t *testing.T) {
+		op, err := cas.BeginUpload(ctx, &cipdpb.BeginUploadRequest{Object: ref})
+		assert.NoErr(t, err)
+
+		resp := put(t, op.UploadUrl, fmt.Sprintf("bytes */%d", len(body)), "")
+		assert.That(t, resp.StatusCode, should.Equal(http.StatusPermanentRedirect))
+		assert.That(t, resp.Header.Get("Range"), should.Equal(""))
+
+		resp = put(t, op.UploadUrl, "bytes 0-5/10", body[:6])
+		assert.That(t, resp.StatusCode, should.Equal(http.StatusPermanentRedirect))
+		assert.That(t, resp.Header.Get("Range"), should.Equal("bytes=0-5"))
+
+		// Gaps are not allowed.
+		resp = put(t, op.UploadUrl, "bytes 8-9/10", body[8:])
+		assert.That(t, resp.StatusCode, should.Equal(http.StatusBadRequest))
+
+		// Overlaps are fine.
+		resp = put(t, op.UploadUrl, "bytes 4-9/10", body[4:])
+		assert.That(t, resp.StatusCode, should.Equal(http.StatusOK))
+
+		op, err = cas.FinishUpload(ctx, &cipdpb.FinishUploadRequest{UploadOperationId: op.OperationId})
+		assert.NoErr(t, err)
+		assert.That(t, op.Status, should.Equal(cipdpb.UploadStatus_PUBLISHED))
+		assert.That(t, op.Object, should.Match(ref))
+
+		// Now it is in the CAS and can be fetched.
+		_, err = cas.BeginUpload(ctx, &cipdpb.BeginUploadRequest{Object: ref})
+		assert.That(t, status.Code(err), should.Equal(codes.AlreadyExists))
+
+		url, err := cas.GetObjectURL(ctx, &cipdpb.GetObjectURLRequest{Object: ref})
+		assert.NoErr(t, err)
+		get, err := http.Get(url.SignedUrl)
+		assert.NoErr(t, err)
+		defer func() { _ = get.Body.Close() }()
+		assert.That(t, get.StatusCode, should.Equal(http.StatusOK))
+		assert.That(t, get.ContentLength, should.Equal(int64(len(body))))
+	})
+
+	t.Run("Hash mismatch", func(t *testing.T) {
+		op, err := cas.BeginUpload(ctx, &cipdpb.BeginUploadRequest{HashAlgo: cipdpb.HashAlgo_SHA256})
+		assert.NoErr(t, err)
+
+		resp := put(t, op.UploadUrl, "bytes 0-2/3", "abc")
+		assert.That(t, resp.StatusCode, should.Equal(http.StatusOK))
+
+		op, err = cas.FinishUpload(ctx, &cipdpb.FinishUploadRequest{
+			UploadOperationId: op.OperationId,
+			ForceHash:         ref,
+		})
+		assert.NoErr(t, err)
+		assert.That(t, op.Status, should.Equal(cipdpb.UploadStatus_ERRORED))
+		assert.That(t, op.ErrorMessage, should.HavePrefix("expected SHA256 to be"))
+
+		// The operation is closed now.
+		resp = put(t, op.UploadUrl, "bytes */3", "")
+		assert.That(t, resp.StatusCode, should.Equal(http.StatusConflict))
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		op, err := cas.BeginUpload(ctx, &cipdpb.BeginUploadRequest{HashAlgo: cipdpb.HashAlgo_SHA256})
+		assert.NoErr(t, err)
+		op, err = cas.CancelUpload(ctx, &cipdpb.CancelUploadRequest{UploadOperationId: op.OperationId})
+		assert.NoErr(t, err)
+		assert.That(t, op.Status, should.Equal(cipdpb.UploadStatus_CANCELED))
+	})
+}
@@ -0,0 +1,233 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localserver
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	cipdpb "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/common"
+)
+
+// stateFile is the name of the file with all non-CAS state, relative to root.
+const stateFile = "state.json"
+
+// state is everything the server knows, except the CAS objects themselves.
+//
+// It is serialized as JSON into a single file. This is fine for the scale
+// the local server is designed for (tests with at most hundreds of packages).
+type state struct {
+	Prefixes map[string]*prefixState  `json:"prefixes,omitempty"` // by prefix
+	Packages map[string]*packageState `json:"packages,omitempty"` // by package name
+	Uploads  map[string]*uploadState  `json:"uploads,omitempty"`  // by operation ID
+}
+
+type prefixState struct {
+	ACLs        map[string][]string `json:"acls,omitempty"` // role name => principals
+	Fingerprint string              `json:"fingerprint"`
+	UpdateUser  string              `json:"update_user"`
+	UpdateTime  time.Time           `json:"update_time"`
+}
+
+type packageState struct {
+	Hidden    bool                      `json:"hidden,omitempty"`
+	Instances map[string]*instanceState `json:"instances,omitempty"` // by instance ID
+	Refs      map[string]*refState      `json:"refs,omitempty"`      // by ref name
+}
+
+type instanceState struct {
+	RegisteredBy string           `json:"registered_by"`
+	RegisteredTS time.Time        `json:"registered_ts"`
+	Tags         []*tagState      `json:"tags,omitempty"`     // oldest first
+	Metadata     []*metadataState `json:"metadata,omitempty"` // oldest first
+}
+
+type refState struct {
+	InstanceID string    `json:"instance_id"`
+	ModifiedBy string    `json:"modified_by"`
+	ModifiedTS time.Time `json:"modified_ts"`
+}
+
+type tagState struct {
+	Tag        string    `json:"tag"` // "k:v"
+	AttachedBy string    `json:"attached_by"`
+	AttachedTS time.Time `json:"attached_ts"`
+}
+
+type metadataState struct {
+	Key         string    `json:"key"`
+	Value       []byte    `json:"value"`
+	ContentType string    `json:"content_type"`
+	Fingerprint string    `json:"fingerprint"`
+	AttachedBy  string    `json:"attached_by"`
+	AttachedTS  time.Time `json:"attached_ts"`
+}
+
+type uploadState struct {
+	HashAlgo     cipdpb.HashAlgo     `json:"hash_algo"`            // the algo to verify the upload with
+	HexDigest    string              `json:"hex_digest,omitempty"` // the expected digest, if known
+	Status       cipdpb.UploadStatus `json:"status"`
+	ErrorMessage string              `json:"error_message,omitempty"`
+}
+
+// store manages the on-disk layout of the server root directory:
+//
+//	<root>/state.json            - serialized `state`
+//	<root>/cas/<algo>/<digest>   - verified CAS objects
+//	<root>/uploads/<op ID>       - data of in-progress uploads
+//
+// All state mutations are serialized through a lock and are committed by
+// atomically replacing the state file.
+type store struct {
+	root string
+	m    sync.Mutex
+}
+
+// openStore initializes the directory structure in the root directory.
+func openStore(root string) (*store, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad root path").Err()
+	}
+	for _, dir := range []string{"cas", "uploads"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0700); err != nil {
+			return nil, errors.Annotate(err, "failed to create %q", dir).Err()
+		}
+	}
+	return &store{root: root}, nil
+}
+
+// view calls the callback with the current state.
+//
+// The callback must not modify the state.
+func (s *store) view(cb func(st *state) error) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	st, err := s.load()
+	if err != nil {
+		return err
+	}
+	return cb(st)
+}
+
+// update calls the callback with the current state and stores the modified
+// state if the callback succeeds.
+func (s *store) update(cb func(st *state) error) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	st, err := s.load()
+	if err != nil {
+		return err
+	}
+	if err := cb(st); err != nil {
+		return err
+	}
+	return s.save(st)
+}
+
+// load reads the state from the disk.
+func (s *store) load() (*state, error) {
+	st := &state{}
+	switch blob, err := os.ReadFile(filepath.Join(s.root, stateFile)); {
+	case os.IsNotExist(err):
+		// Fresh root.
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to read the state").Tag(grpcutil.InternalTag).Err()
+	default:
+		if err := json.Unmarshal(blob, st); err != nil {
+			return nil, errors.Annotate(err, "malformed state file").Tag(grpcutil.InternalTag).Err()
+		}
+	}
+	if st.Prefixes == nil {
+		st.Prefixes = map[string]*prefixState{}
+	}
+	if st.Packages == nil {
+		st.Packages = map[string]*packageState{}
+	}
+	if st.Uploads == nil {
+		st.Uploads = map[string]*uploadState{}
+	}
+	return st, nil
+}
+
+// save atomically replaces the state on the disk.
+func (s *store) save(st *state) error {
+	blob, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return errors.Annotate(err, "failed to serialize the state").Tag(grpcutil.InternalTag).Err()
+	}
+	tmp, err := os.CreateTemp(s.root, stateFile+".*")
+	if err != nil {
+		return errors.Annotate(err, "failed to create a temp file").Tag(grpcutil.InternalTag).Err()
+	}
+	_, err = tmp.Write(blob)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(s.root, stateFile))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return errors.Annotate(err, "failed to write the state").Tag(grpcutil.InternalTag).Err()
+	}
+	return nil
+}
+
+// objectPath is a path to a file with the given CAS object.
+//
+// The object reference must be valid.
+func (s *store) objectPath(ref *cipdpb.ObjectRef) string {
+	return filepath.Join(s.root, "cas", strings.ToLower(ref.HashAlgo.String()), ref.HexDigest)
+}
+
+// hasObject is true if the given CAS object is present.
+func (s *store) hasObject(ref *cipdpb.ObjectRef) (bool, error) {
+	switch _, err := os.Stat(s.objectPath(ref)); {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, errors.Annotate(err, "failed to check the object presence").Tag(grpcutil.InternalTag).Err()
+	}
+}
+
+// uploadPath is a path to a file with the data of the upload operation.
+func (s *store) uploadPath(opID string) string {
+	return filepath.Join(s.root, "uploads", opID)
+}
+
+// getPackage returns the state of the package or nil if it is not registered.
+func (st *state) getPackage(pkg string) *packageState {
+	return st.Packages[pkg]
+}
+
+// getInstance returns the state of the instance or nil if it is not
+// registered.
+func (st *state) getInstance(pkg string, ref *cipdpb.ObjectRef) *instanceState {
+	if p := st.getPackage(pkg); p != nil {
+		return p.Instances[common.ObjectRefToInstanceID(ref)]
+	}
+	return nil
+}
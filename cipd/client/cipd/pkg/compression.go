@@ -0,0 +1,65 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+// Compression defines how files are compressed inside a package.
+type Compression string
+
+const (
+	// CompressionDeflate is the default compression method.
+	//
+	// Packages that use it can be read by all CIPD clients.
+	CompressionDeflate Compression = "deflate"
+
+	// CompressionZstd uses Zstandard compression.
+	//
+	// It is a lot faster to decompress than Deflate at comparable compression
+	// ratios. Packages that use it can only be installed by CIPD clients that
+	// are at least 2.8.0. The backend refuses to serve them to older clients.
+	CompressionZstd Compression = "zstd"
+)
+
+// Set is called by 'flag' package when parsing command line options.
+func (c *Compression) Set(value string) error {
+	val := Compression(value)
+	if err := ValidateCompression(val); err != nil {
+		return err
+	}
+	*c = val
+	return nil
+}
+
+// String is needed to conform to flag.Value interface.
+func (c Compression) String() string {
+	return string(c)
+}
+
+// ValidateCompression returns non nil if the compression method is invalid.
+//
+// Valid methods are: "" (same as "deflate"), "deflate" (aka CompressionDeflate),
+// "zstd" (aka CompressionZstd).
+func ValidateCompression(c Compression) error {
+	switch c {
+	case "", CompressionDeflate, CompressionZstd:
+		return nil
+	}
+	return errors.Reason("invalid compression method %q", c).Tag(cipderr.BadArgument).Err()
+}
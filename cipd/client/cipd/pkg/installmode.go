@@ -38,6 +38,20 @@ const (
 	// other OSes. If installation is aborted midway, the package may end up
 	// in inconsistent state.
 	InstallModeCopy InstallMode = "copy"
+
+	// InstallModeHardlink is used when files should be shared between site roots.
+	//
+	// In this mode all regular read-only files are extracted into a machine-wide
+	// content-addressed store and then hardlinked to the site root directory.
+	// Files with the same content are stored only once, regardless of how many
+	// site roots (or packages) use them. Symlinks and writable files are
+	// installed as in "copy" mode.
+	//
+	// The content store must be on the same file system as the site root. Can
+	// only be requested by the client (e.g. via $OverrideInstallMode in ensure
+	// files), not by the package itself. Not supported on Windows, "copy" mode
+	// is used there instead.
+	InstallModeHardlink InstallMode = "hardlink"
 )
 
 // Set is called by 'flag' package when parsing command line options.
@@ -58,14 +72,30 @@ func (m InstallMode) String() string {
 // ValidateInstallMode returns non nil if install mode is invalid.
 //
 // Valid modes are: "" (client will pick platform default), "copy"
-// (aka InstallModeCopy), "symlink" (aka InstallModeSymlink).
+// (aka InstallModeCopy), "symlink" (aka InstallModeSymlink), "hardlink"
+// (aka InstallModeHardlink).
 func ValidateInstallMode(mode InstallMode) error {
-	if mode == "" || mode == InstallModeCopy || mode == InstallModeSymlink {
+	switch mode {
+	case "", InstallModeCopy, InstallModeSymlink, InstallModeHardlink:
 		return nil
 	}
 	return errors.Reason("invalid install mode %q", mode).Tag(cipderr.BadArgument).Err()
 }
 
+// ValidatePackageInstallMode is like ValidateInstallMode, but it also rejects
+// install modes that can't be requested by packages themselves.
+//
+// Used when building packages.
+func ValidatePackageInstallMode(mode InstallMode) error {
+	if err := ValidateInstallMode(mode); err != nil {
+		return err
+	}
+	if mode == InstallModeHardlink {
+		return errors.Reason("install mode %q can't be requested by a package, only by the client", mode).Tag(cipderr.BadArgument).Err()
+	}
+	return nil
+}
+
 // PickInstallMode validates the install mode and picks the correct default
 // for the platform if no install mode is given.
 func PickInstallMode(im InstallMode) (InstallMode, error) {
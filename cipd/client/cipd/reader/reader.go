@@ -31,6 +31,7 @@ import (
 
 	"github.com/klauspost/compress/flate"
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -503,7 +504,7 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 
 	// List files and package manifest.
 	var err error
-	if inst.zip, err = zip.NewReader(inst.data, inst.data.Size()); err != nil {
+	if inst.zip, err = openZip(inst.data); err != nil {
 		return errors.Annotate(err, "reading instance file zip header").Tag(cipderr.IO).Err()
 	}
 	inst.files = make([]fs.File, len(inst.zip.File))
@@ -578,6 +579,10 @@ func IsCorruptionError(err error) bool {
 		switch err {
 		case io.ErrUnexpectedEOF, zip.ErrFormat, zip.ErrChecksum, zip.ErrAlgorithm, ErrHashMismatch:
 			return true
+		case zstd.ErrMagicMismatch, zstd.ErrReservedBlockType, zstd.ErrCompressedSizeTooBig,
+			zstd.ErrBlockTooSmall, zstd.ErrUnexpectedBlockSize, zstd.ErrFrameSizeMismatch,
+			zstd.ErrCRCMismatch:
+			return true
 		default:
 			_, flateCorrupt := err.(flate.CorruptInputError)
 			return flateCorrupt
@@ -585,9 +590,37 @@ func IsCorruptionError(err error) bool {
 	})
 }
 
+// UsesZstd returns true if some files in the package are compressed with zstd.
+//
+// Such packages can't be installed by old CIPD clients. Reads only the zip
+// central directory.
+func UsesZstd(r pkg.Source) (bool, error) {
+	zr, err := openZip(r)
+	if err != nil {
+		return false, errors.Annotate(err, "reading instance file zip header").Tag(cipderr.IO).Err()
+	}
+	for _, f := range zr.File {
+		if f.Method == zstd.ZipMethodWinZip {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Utilities.
 
+// openZip opens the package zip archive, registering all supported
+// decompressors.
+func openZip(r pkg.Source) (*zip.Reader, error) {
+	zr, err := zip.NewReader(r, r.Size())
+	if err != nil {
+		return nil, err
+	}
+	zr.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
+	return zr, nil
+}
+
 // calculateHash reads the entire file, passing it through the digester.
 func calculateHash(r pkg.Source, h hash.Hash) error {
 	_, err := io.Copy(h, io.NewSectionReader(r, 0, r.Size()))
@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -390,6 +391,51 @@ func TestPackageReading(t *testing.T) {
 			shouldBeSameJSONDict(goodManifest))
 	})
 
+	ftt.Run("ExtractFiles works with zstd packages", t, func(t *ftt.Test) {
+		build := func(compression pkg.Compression) *bytes.Buffer {
+			out := bytes.Buffer{}
+			_, err := builder.BuildInstance(ctx, builder.Options{
+				Input: []fs.File{
+					fs.NewTestFile("testing/qwerty", strings.Repeat("12345", 100), fs.TestFileOpts{}),
+					fs.NewTestFile("abc", "duh", fs.TestFileOpts{Executable: true}),
+					fs.NewTestSymlink("rel_symlink", "abc"),
+				},
+				Output:           &out,
+				PackageName:      "testing",
+				CompressionLevel: 5,
+				Compression:      compression,
+			})
+			assert.Loosely(t, err, should.BeNil)
+			return &out
+		}
+
+		out := build(pkg.CompressionZstd)
+
+		zstd, err := UsesZstd(bytesFile(out))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, zstd, should.BeTrue)
+
+		zstd, err = UsesZstd(bytesFile(build(pkg.CompressionDeflate)))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, zstd, should.BeFalse)
+
+		inst, err := OpenInstance(ctx, bytesFile(out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		assert.Loosely(t, err, should.BeNil)
+		defer inst.Close(ctx, false)
+
+		dest := &testDestination{}
+		_, err = ExtractFilesTxn(ctx, inst.Files(), dest, 16, pkg.WithManifest, "")
+		assert.Loosely(t, err, should.BeNil)
+
+		assert.Loosely(t, string(dest.fileByName("testing/qwerty").Bytes()), should.Equal(strings.Repeat("12345", 100)))
+		assert.Loosely(t, string(dest.fileByName("abc").Bytes()), should.Equal("duh"))
+		assert.Loosely(t, dest.fileByName("abc").executable, should.BeTrue)
+		assert.Loosely(t, dest.fileByName("rel_symlink").symlinkTarget, should.Equal("abc"))
+	})
+
 	ftt.Run("ExtractFiles handles v1 packages correctly", t, func(t *ftt.Test) {
 		// ZipInfos in packages with format_version "1" always have the writable bit
 		// set, and always have 0 timestamp. During the extraction of such package,
@@ -296,6 +296,14 @@ func (m *mockedRepoClient) ResolveVersion(ctx context.Context, in *api.ResolveVe
 	return out.(*api.Instance), nil
 }
 
+func (m *mockedRepoClient) GetInstanceDelta(ctx context.Context, in *api.GetInstanceDeltaRequest, opts ...grpc.CallOption) (*api.InstanceDelta, error) {
+	out, err := m.call("GetInstanceDelta", in, opts)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*api.InstanceDelta), nil
+}
+
 func (m *mockedRepoClient) GetInstanceURL(ctx context.Context, in *api.GetInstanceURLRequest, opts ...grpc.CallOption) (*api.ObjectURL, error) {
 	out, err := m.call("GetInstanceURL", in, opts)
 	if err != nil {
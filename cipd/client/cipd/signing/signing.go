@@ -0,0 +1,224 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing implements publisher signatures of package instances.
+//
+// A publisher signs a package instance with an ed25519 private key and
+// attaches the signature to the instance as metadata with MetadataKey key.
+// Clients verify signatures locally using public keys from the client config
+// (see SignaturePolicy in configpb), no backend calls are needed for that.
+//
+// The signed message binds together the package name and the instance ID
+// (i.e. the hash of the package file), so a signature can't be reused for
+// a different package or a different instance.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cipd/client/cipd/configpb"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+const (
+	// MetadataKey is the instance metadata key with signatures.
+	MetadataKey = "cipd_signature"
+
+	// ContentType is the content type of signature metadata entries.
+	ContentType = "application/vnd.cipd.signature+json"
+
+	// signedMessagePrefix versions the format of the signed message.
+	signedMessagePrefix = "cipd-instance-signature-v1"
+)
+
+// signature is JSON-serialized as a value of a signature metadata entry.
+type signature struct {
+	KeyID     string `json:"key_id"`
+	Signature []byte `json:"signature"`
+}
+
+// KeyID returns a short identifier of the public key, for logs and errors.
+func KeyID(key ed25519.PublicKey) string {
+	digest := sha256.Sum256(key)
+	return hex.EncodeToString(digest[:8])
+}
+
+// signedMessage returns the message that is actually signed.
+func signedMessage(pin common.Pin) []byte {
+	return []byte(signedMessagePrefix + "\x00" + pin.PackageName + "\x00" + pin.InstanceID)
+}
+
+// ParsePrivateKey parses a PEM-encoded PKCS #8 ed25519 private key.
+//
+// Such keys can be generated with `openssl genpkey -algorithm ed25519`.
+func ParsePrivateKey(blob []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(blob)
+	if block == nil {
+		return nil, errors.Reason("not a PEM-encoded private key").Tag(cipderr.BadArgument).Err()
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad private key").Tag(cipderr.BadArgument).Err()
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.Reason("not an ed25519 private key").Tag(cipderr.BadArgument).Err()
+	}
+	return priv, nil
+}
+
+// ParsePublicKey parses a PEM-encoded PKIX ed25519 public key.
+func ParsePublicKey(blob []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(blob)
+	if block == nil {
+		return nil, errors.Reason("not a PEM-encoded public key").Tag(cipderr.BadArgument).Err()
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad public key").Tag(cipderr.BadArgument).Err()
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.Reason("not an ed25519 public key").Tag(cipderr.BadArgument).Err()
+	}
+	return pub, nil
+}
+
+// Sign signs the instance and returns the signature to store as metadata.
+func Sign(key ed25519.PrivateKey, pin common.Pin) ([]byte, error) {
+	if err := common.ValidatePin(pin, common.KnownHash); err != nil {
+		return nil, err
+	}
+	blob, err := json.Marshal(&signature{
+		KeyID:     KeyID(key.Public().(ed25519.PublicKey)),
+		Signature: ed25519.Sign(key, signedMessage(pin)),
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "serializing the signature").Tag(cipderr.BadArgument).Err()
+	}
+	return blob, nil
+}
+
+// policy is a parsed configpb.SignaturePolicy.
+type policy struct {
+	prefixes []string
+	keys     []ed25519.PublicKey
+}
+
+// Verifier checks signatures of instances according to signature policies.
+type Verifier struct {
+	policies []policy
+}
+
+// NewVerifier parses signature policies from the client config.
+func NewVerifier(cfg []*configpb.SignaturePolicy) (*Verifier, error) {
+	v := &Verifier{policies: make([]policy, len(cfg))}
+	for i, p := range cfg {
+		if len(p.PackagePrefixes) == 0 {
+			return nil, errors.Reason("signature policy #%d: no package prefixes", i+1).Tag(cipderr.BadArgument).Err()
+		}
+		if len(p.PublicKeys) == 0 {
+			return nil, errors.Reason("signature policy #%d: no public keys", i+1).Tag(cipderr.BadArgument).Err()
+		}
+		for _, prefix := range p.PackagePrefixes {
+			prefix, err := common.ValidatePackagePrefix(prefix)
+			if err != nil {
+				return nil, errors.Annotate(err, "signature policy #%d", i+1).Err()
+			}
+			v.policies[i].prefixes = append(v.policies[i].prefixes, prefix)
+		}
+		for _, blob := range p.PublicKeys {
+			key, err := ParsePublicKey([]byte(blob))
+			if err != nil {
+				return nil, errors.Annotate(err, "signature policy #%d", i+1).Err()
+			}
+			v.policies[i].keys = append(v.policies[i].keys, key)
+		}
+	}
+	return v, nil
+}
+
+// matchingPolicies returns policies that apply to the package.
+func (v *Verifier) matchingPolicies(pkg string) []*policy {
+	var out []*policy
+	for i := range v.policies {
+		p := &v.policies[i]
+		for _, prefix := range p.prefixes {
+			if prefix == "" || pkg == prefix || strings.HasPrefix(pkg, prefix+"/") {
+				out = append(out, p)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// Required is true if the package must be signed.
+func (v *Verifier) Required(pkg string) bool {
+	return len(v.matchingPolicies(pkg)) != 0
+}
+
+// Verify checks the instance has a valid signature for each policy that
+// applies to its package.
+//
+// Signatures are values of MetadataKey metadata entries of the instance.
+// Malformed signatures are ignored. Returns an error tagged with
+// cipderr.NotAdmitted if the check fails.
+func (v *Verifier) Verify(pin common.Pin, sigs [][]byte) error {
+	policies := v.matchingPolicies(pin.PackageName)
+	if len(policies) == 0 {
+		return nil
+	}
+	if len(sigs) == 0 {
+		return errors.Reason("%s is not signed", pin).Tag(cipderr.NotAdmitted).Err()
+	}
+
+	parsed := make([]signature, 0, len(sigs))
+	for _, blob := range sigs {
+		var sig signature
+		if err := json.Unmarshal(blob, &sig); err == nil {
+			parsed = append(parsed, sig)
+		}
+	}
+
+	msg := signedMessage(pin)
+	for _, p := range policies {
+		if !p.verify(msg, parsed) {
+			return errors.Reason("%s has no valid signature from keys trusted for %q", pin, p.prefixes).Tag(cipderr.NotAdmitted).Err()
+		}
+	}
+	return nil
+}
+
+// verify is true if some of the signatures is valid and made by a policy key.
+func (p *policy) verify(msg []byte, sigs []signature) bool {
+	for _, key := range p.keys {
+		id := KeyID(key)
+		for _, sig := range sigs {
+			if sig.KeyID == id && ed25519.Verify(key, msg, sig.Signature) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/cipd/client/cipd/configpb"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+func TestSigning(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With keys", t, func(t *ftt.Test) {
+		pub1, priv1 := genKey(t, "1")
+		pub2, priv2 := genKey(t, "2")
+
+		pin := common.Pin{PackageName: "infra/tools/pkg", InstanceID: strings.Repeat("a", 40)}
+
+		verifier, err := NewVerifier([]*configpb.SignaturePolicy{
+			{
+				PackagePrefixes: []string{"infra/tools/"},
+				PublicKeys:      []string{pub1},
+			},
+			{
+				PackagePrefixes: []string{"infra/tools/pkg", "other"},
+				PublicKeys:      []string{pub1, pub2},
+			},
+		})
+		assert.Loosely(t, err, should.BeNil)
+
+		sign := func(key ed25519.PrivateKey, pin common.Pin) []byte {
+			sig, err := Sign(key, pin)
+			assert.Loosely(t, err, should.BeNil)
+			return sig
+		}
+
+		t.Run("Required", func(t *ftt.Test) {
+			assert.Loosely(t, verifier.Required("infra/tools"), should.BeTrue)
+			assert.Loosely(t, verifier.Required("infra/tools/pkg/sub"), should.BeTrue)
+			assert.Loosely(t, verifier.Required("other"), should.BeTrue)
+			assert.Loosely(t, verifier.Required("infra/toolsy"), should.BeFalse)
+			assert.Loosely(t, verifier.Required("unrelated"), should.BeFalse)
+		})
+
+		t.Run("Valid", func(t *ftt.Test) {
+			// Satisfies both policies.
+			assert.Loosely(t, verifier.Verify(pin, [][]byte{sign(priv1, pin)}), should.BeNil)
+			// Garbage is ignored.
+			assert.Loosely(t, verifier.Verify(pin, [][]byte{[]byte("garbage"), sign(priv1, pin)}), should.BeNil)
+			// Unrelated packages need no signatures.
+			assert.Loosely(t, verifier.Verify(common.Pin{PackageName: "unrelated", InstanceID: pin.InstanceID}, nil), should.BeNil)
+		})
+
+		t.Run("Unsigned", func(t *ftt.Test) {
+			err := verifier.Verify(pin, nil)
+			assert.Loosely(t, err, should.ErrLike("is not signed"))
+			assert.Loosely(t, cipderr.ToCode(err), should.Equal(cipderr.NotAdmitted))
+		})
+
+		t.Run("Untrusted key", func(t *ftt.Test) {
+			// Satisfies only the second policy.
+			err := verifier.Verify(pin, [][]byte{sign(priv2, pin)})
+			assert.Loosely(t, err, should.ErrLike(`has no valid signature from keys trusted for ["infra/tools"]`))
+		})
+
+		t.Run("Wrong instance", func(t *ftt.Test) {
+			another := common.Pin{PackageName: pin.PackageName, InstanceID: strings.Repeat("b", 40)}
+			err := verifier.Verify(pin, [][]byte{sign(priv1, another)})
+			assert.Loosely(t, err, should.ErrLike("has no valid signature"))
+		})
+
+		t.Run("Wrong package", func(t *ftt.Test) {
+			another := common.Pin{PackageName: "infra/tools/another", InstanceID: pin.InstanceID}
+			err := verifier.Verify(pin, [][]byte{sign(priv1, another)})
+			assert.Loosely(t, err, should.ErrLike("has no valid signature"))
+		})
+
+		t.Run("Bad policies", func(t *ftt.Test) {
+			_, err := NewVerifier([]*configpb.SignaturePolicy{{PublicKeys: []string{pub1}}})
+			assert.Loosely(t, err, should.ErrLike("no package prefixes"))
+			_, err = NewVerifier([]*configpb.SignaturePolicy{{PackagePrefixes: []string{"a"}}})
+			assert.Loosely(t, err, should.ErrLike("no public keys"))
+			_, err = NewVerifier([]*configpb.SignaturePolicy{{PackagePrefixes: []string{"BAD"}, PublicKeys: []string{pub1}}})
+			assert.Loosely(t, err, should.ErrLike("invalid package prefix"))
+			_, err = NewVerifier([]*configpb.SignaturePolicy{{PackagePrefixes: []string{"a"}, PublicKeys: []string{"zzz"}}})
+			assert.Loosely(t, err, should.ErrLike("not a PEM-encoded public key"))
+		})
+	})
+
+	ftt.Run("Private keys", t, func(t *ftt.Test) {
+		_, priv := genKey(t, "1")
+		der, err := x509.MarshalPKCS8PrivateKey(priv)
+		assert.Loosely(t, err, should.BeNil)
+
+		parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, parsed.Equal(priv), should.BeTrue)
+
+		_, err = ParsePrivateKey([]byte("zzz"))
+		assert.Loosely(t, err, should.ErrLike("not a PEM-encoded private key"))
+	})
+}
+
+// genKey deterministically generates a key pair, returning the public key as
+// PEM.
+func genKey(t testing.TB, seed string) (string, ed25519.PrivateKey) {
+	priv := ed25519.NewKeyFromSeed([]byte(strings.Repeat(seed, ed25519.SeedSize)))
+	der, err := x509.MarshalPKIXPublicKey(priv.Public())
+	assert.Loosely(t, err, should.BeNil)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), priv
+}
@@ -51,14 +51,17 @@ import (
 	api "go.chromium.org/luci/cipd/api/cipd/v1"
 	"go.chromium.org/luci/cipd/client/cipd"
 	"go.chromium.org/luci/cipd/client/cipd/builder"
+	"go.chromium.org/luci/cipd/client/cipd/bundle"
 	"go.chromium.org/luci/cipd/client/cipd/deployer"
 	"go.chromium.org/luci/cipd/client/cipd/digests"
 	"go.chromium.org/luci/cipd/client/cipd/ensure"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
+	"go.chromium.org/luci/cipd/client/cipd/localserver"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
 	"go.chromium.org/luci/cipd/client/cipd/proxyserver"
 	"go.chromium.org/luci/cipd/client/cipd/proxyserver/proxypb"
 	"go.chromium.org/luci/cipd/client/cipd/reader"
+	"go.chromium.org/luci/cipd/client/cipd/signing"
 	"go.chromium.org/luci/cipd/client/cipd/template"
 	"go.chromium.org/luci/cipd/client/cipd/ui"
 	"go.chromium.org/luci/cipd/common"
@@ -512,10 +515,13 @@ type inputOptions struct {
 	preserveModTime  bool
 	preserveWritable bool
 
-	// Deflate compression level (if [1-9]) or 0 to disable compression.
+	// Compression level (if [1-9]) or 0 to disable compression.
 	//
 	// Default is 5.
 	compressionLevel int
+
+	// Compression method to use, overrides the one in the package definition.
+	compression pkg.Compression
 }
 
 func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
@@ -542,7 +548,9 @@ func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
 
 	// Options for the builder.
 	f.IntVar(&opts.compressionLevel, "compression-level", 5,
-		"Deflate compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+		"Compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+	f.Var(&opts.compression, "compression",
+		"Compression method: \"deflate\" (default) or \"zstd\". Packages compressed with zstd can be installed only by CIPD clients v2.8.0 or newer.")
 }
 
 // prepareInput processes inputOptions by collecting all files to be added to
@@ -584,6 +592,7 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			PackageName:      packageName,
 			InstallMode:      opts.installMode,
 			CompressionLevel: opts.compressionLevel,
+			Compression:      opts.compression,
 		}, nil
 	}
 
@@ -623,12 +632,17 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 		if err != nil {
 			return empty, err
 		}
+		compression := pkgDef.Compression
+		if opts.compression != "" {
+			compression = opts.compression
+		}
 		return builder.Options{
 			Input:            files,
 			PackageName:      pkgDef.Package,
 			VersionFile:      pkgDef.VersionFile(),
 			InstallMode:      pkgDef.InstallMode,
 			CompressionLevel: opts.compressionLevel,
+			Compression:      compression,
 		}, nil
 	}
 
@@ -1607,6 +1621,255 @@ func (c *ensureFileResolveRun) Run(a subcommands.Application, args []string, env
 	return c.doneWithPinMap(pinMap, nil)
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'bundle-export' subcommand.
+
+func cmdBundleExport(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "bundle-export [options]",
+		ShortDesc: "writes all packages from an ensure file into an offline bundle",
+		LongDesc: `Writes all packages from an ensure file into an offline bundle.
+
+Resolves versions of all packages for all verified platforms in the "ensure"
+file and writes the resolved instances into a single archive that can be
+imported with "cipd bundle-import" on machines without access to the backend.
+After the import, an unmodified "cipd ensure" using the same ensure file and
+the same cache directory works offline.
+
+Tags are resolved offline through the tag cache. Refs (like "latest") can move
+and are never resolved from the cache, so ensure files that use them should pin
+them via the $ResolvedVersions directive (see "cipd ensure-file-resolve").
+
+Publisher signatures of instances are exported too, so that signature policies
+from the client config can be enforced offline.
+
+Imported instances are subject to the usual cache eviction policy, so "ensure"
+should be called soon after the import.
+
+    cipd bundle-export -ensure-file ensure_file -bundle packages.bundle
+`,
+		Advanced: true,
+		CommandRun: func() subcommands.CommandRun {
+			c := &bundleExportRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
+			c.ensureFileOptions.registerFlags(&c.Flags, withoutEnsureOutFlag, withoutLegacyListFlag)
+			c.Flags.StringVar(&c.bundlePath, "bundle", "<path>", "A path to write the bundle to.")
+			return c
+		},
+	}
+}
+
+type bundleExportRun struct {
+	cipdSubcommand
+	clientOptions
+	ensureFileOptions
+
+	bundlePath string
+}
+
+func (c *bundleExportRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+
+	ef, err := c.loadEnsureFile(ctx, &c.clientOptions, requireVerifyPlatforms, parseVersionsFile)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	return c.doneWithPins(exportBundle(ctx, ef, c.bundlePath, c.clientOptions))
+}
+
+func exportBundle(ctx context.Context, ef *ensure.File, bundlePath string, clientOpts clientOptions) ([]pinInfo, error) {
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close(ctx)
+
+	// Collect all instances along with versions that resolve to them.
+	instances := map[string]*bundle.Instance{}
+	mu := sync.Mutex{}
+	resolver := cipd.Resolver{
+		Client: client,
+		Visitor: func(pkg, ver, iid string) {
+			if common.ValidateInstanceID(ver, common.AnyHash) == nil {
+				return // instance IDs are resolved offline already
+			}
+			if ef.ResolvedVersions == "" && common.ValidateInstanceTag(ver) != nil {
+				logging.Warningf(ctx, "Package %s uses ref %q, it can't be resolved offline without $ResolvedVersions file", pkg, ver)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			inst := instances[iid]
+			if inst == nil {
+				inst = &bundle.Instance{Package: pkg, InstanceID: iid}
+				instances[iid] = inst
+			}
+			if !slices.Contains(inst.Versions, ver) {
+				inst.Versions = append(inst.Versions, ver)
+			}
+		},
+	}
+	results, err := resolver.ResolveAllPlatforms(ctx, ef)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick up instances pinned by instance IDs (they are skipped by the visitor).
+	for _, resolved := range results {
+		for _, pins := range resolved.PackagesBySubdir {
+			for _, pin := range pins {
+				if instances[pin.InstanceID] == nil {
+					instances[pin.InstanceID] = &bundle.Instance{Package: pin.PackageName, InstanceID: pin.InstanceID}
+				}
+			}
+		}
+	}
+
+	manifest := &bundle.Manifest{
+		FormatVersion: bundle.FormatVersion,
+		ServiceURL:    clientOpts.resolvedServiceURL(ctx),
+	}
+	for _, inst := range instances {
+		sort.Strings(inst.Versions)
+		if inst.Signatures, err = client.FetchSignatures(ctx, inst.Pin()); err != nil {
+			return nil, err
+		}
+		manifest.Instances = append(manifest.Instances, *inst)
+	}
+	sort.Slice(manifest.Instances, func(i, j int) bool {
+		l, r := manifest.Instances[i], manifest.Instances[j]
+		if l.Package != r.Package {
+			return l.Package < r.Package
+		}
+		return l.InstanceID < r.InstanceID
+	})
+
+	out, err := os.Create(bundlePath)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening the bundle file for writing").Tag(cipderr.IO).Err()
+	}
+	ok := false
+	defer func() {
+		if !ok {
+			out.Close()
+			os.Remove(bundlePath)
+		}
+	}()
+
+	w, err := bundle.NewWriter(out, manifest)
+	if err != nil {
+		return nil, err
+	}
+	pins := make([]pinInfo, 0, len(manifest.Instances))
+	for _, inst := range manifest.Instances {
+		pin := inst.Pin()
+		src, err := client.FetchInstance(ctx, pin)
+		if err != nil {
+			return nil, err
+		}
+		err = w.AddInstance(pin, src)
+		if cerr := src.Close(ctx, false); cerr != nil {
+			logging.Warningf(ctx, "Failed to close the instance file: %s", cerr)
+		}
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, pinInfo{Pkg: pin.PackageName, Pin: &pin})
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if err := out.Close(); err != nil {
+		return nil, errors.Annotate(err, "flushing the bundle file").Tag(cipderr.IO).Err()
+	}
+	ok = true
+
+	fmt.Printf("Exported %d instance(s) to %s.\n\n", len(pins), bundlePath)
+	return pins, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'bundle-import' subcommand.
+
+func cmdBundleImport(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "bundle-import [options]",
+		ShortDesc: "puts packages from an offline bundle into the cache",
+		LongDesc: `Puts packages from an offline bundle into the cache.
+
+Verifies hashes of all package instances in a bundle produced by
+"cipd bundle-export" and puts them into the cache directory, along with tags
+used to export them and their publisher signatures. Afterwards "cipd ensure" that uses the same cache directory
+can install these packages without access to the backend.
+
+The cache directory must be specified via -cache-dir flag or $CIPD_CACHE_DIR
+environment variable. By default uses the service URL recorded in the bundle.
+
+    cipd bundle-import -bundle packages.bundle -cache-dir /var/cache/cipd
+`,
+		Advanced: true,
+		CommandRun: func() subcommands.CommandRun {
+			c := &bundleImportRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
+			c.Flags.StringVar(&c.bundlePath, "bundle", "<path>", "A path to the bundle to import.")
+			return c
+		},
+	}
+}
+
+type bundleImportRun struct {
+	cipdSubcommand
+	clientOptions
+
+	bundlePath string
+}
+
+func (c *bundleImportRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.doneWithPins(importBundle(ctx, c.bundlePath, c.clientOptions))
+}
+
+func importBundle(ctx context.Context, bundlePath string, clientOpts clientOptions) ([]pinInfo, error) {
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening the bundle").Tag(cipderr.IO).Err()
+	}
+	defer f.Close()
+
+	r, err := bundle.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the same service as the bundle, unless asked otherwise.
+	if clientOpts.serviceURL == "" && environ.FromCtx(ctx).Get(cipd.EnvCIPDServiceURL) == "" {
+		clientOpts.serviceURL = r.Manifest().ServiceURL
+	}
+
+	client, err := clientOpts.makeCIPDClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close(ctx)
+
+	imported, err := client.ImportBundle(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	pins := make([]pinInfo, len(imported))
+	for i, pin := range imported {
+		pins[i] = pinInfo{Pkg: pin.PackageName, Pin: &imported[i]}
+	}
+	return pins, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'puppet-check-updates' subcommand.
 
@@ -2273,6 +2536,85 @@ func (c *setMetadataRun) Run(a subcommands.Application, args []string, env subco
 	}))
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'sign' subcommand.
+
+func cmdSign(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "sign <package or package prefix> -signing-key <path> [options]",
+		ShortDesc: "attaches a publisher signature to an instance",
+		LongDesc: `Attaches a publisher signature to an instance.
+
+Signs the instance with an ed25519 private key and attaches the signature as
+instance metadata. Clients with signature policies in their config verify
+such signatures before installing packages.
+
+The key must be a PEM-encoded PKCS #8 ed25519 private key, e.g. generated with
+"openssl genpkey -algorithm ed25519 -out private.pem". Its public key (e.g.
+from "openssl pkey -in private.pem -pubout") goes into the client config.
+
+    cipd sign infra/tools/pkg -version latest -signing-key private.pem
+`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &signRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params, withoutRootDir, withoutMaxThreads)
+			c.Flags.StringVar(&c.version, "version", "<version>",
+				"Package version to resolve. Could also be a tag or a ref.")
+			c.Flags.StringVar(&c.signingKey, "signing-key", "<path>",
+				"A path to a PEM-encoded ed25519 private key to sign with.")
+			return c
+		},
+	}
+}
+
+type signRun struct {
+	cipdSubcommand
+	clientOptions
+
+	version    string
+	signingKey string
+}
+
+func (c *signRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 1, 1) {
+		return 1
+	}
+
+	ctx := cli.GetContext(a, c, env)
+
+	blob, err := os.ReadFile(c.signingKey)
+	if err != nil {
+		return c.done(nil, errors.Annotate(err, "reading the signing key").Tag(cipderr.IO).Err())
+	}
+	key, err := signing.ParsePrivateKey(blob)
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	pkgPrefix, err := expandTemplate(args[0])
+	if err != nil {
+		return c.done(nil, err)
+	}
+
+	return c.doneWithPins(visitPins(ctx, &visitPinsArgs{
+		clientOptions: c.clientOptions,
+		packagePrefix: pkgPrefix,
+		version:       c.version,
+		updatePin: func(client cipd.Client, pin common.Pin) error {
+			sig, err := signing.Sign(key, pin)
+			if err != nil {
+				return err
+			}
+			return client.AttachMetadataWhenReady(ctx, pin, []cipd.Metadata{{
+				Key:         signing.MetadataKey,
+				Value:       sig,
+				ContentType: signing.ContentType,
+			}})
+		},
+	}))
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'expand-package-name' subcommand.
 
@@ -3638,6 +3980,66 @@ func readProxyPolicy(ctx context.Context, path string) (*proxypb.Policy, error)
 	return &policy, nil
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'serve-local' subcommand.
+
+func cmdServeLocal(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "serve-local -root <path> [-port <port>]",
+		ShortDesc: "Runs a local CIPD backend that stores packages in a directory",
+		LongDesc: "Runs a local CIPD backend that stores packages in a directory.\n\n" +
+			"It implements enough of the CIPD backend API to register, tag and fetch " +
+			"packages without any network access. There's no authentication, every " +
+			"caller can do anything. Intended for hermetic tests of tools that use CIPD.\n\n" +
+			"Once the server is running, it prints CIPD_SERVICE_URL=<url> to stdout " +
+			"and closes stdout, so callers can read it until EOF to get the URL. " +
+			"Pass this URL to other CIPD commands via -service-url flag or " +
+			"CIPD_SERVICE_URL environment variable.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &serveLocalRun{}
+			c.registerBaseFlags()
+			c.Flags.StringVar(&c.root, "root", "", "Path to a directory to store the server state in (will be created if missing).")
+			c.Flags.IntVar(&c.port, "port", 0, "Localhost TCP port to listen on (default is to pick any free port).")
+			return c
+		},
+	}
+}
+
+type serveLocalRun struct {
+	cipdSubcommand
+
+	root string // -root flag
+	port int    // -port flag
+}
+
+func (c *serveLocalRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(nil, runServeLocal(ctx, c.root, c.port))
+}
+
+func runServeLocal(ctx context.Context, root string, port int) error {
+	if root == "" {
+		return errors.Reason("-root is required").Tag(cipderr.BadArgument).Err()
+	}
+	err := localserver.Run(ctx, localserver.Params{
+		Root:    root,
+		Address: fmt.Sprintf("127.0.0.1:%d", port),
+		Started: func(serviceURL string) {
+			logging.Infof(ctx, "Serving packages from %s", root)
+			_, _ = fmt.Fprintf(os.Stdout, "%s=%s\n", cipd.EnvCIPDServiceURL, serviceURL)
+			_ = os.Stdout.Close()
+		},
+	})
+	if err != nil {
+		return errors.Annotate(err, "local CIPD server").Tag(cipderr.IO).Err()
+	}
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Main.
 
@@ -3676,6 +4078,11 @@ func GetApplication(params Parameters) *cli.Application {
 				ShortDesc: "Directory with shared instance and tags cache " +
 					"(-cache-dir, if given, takes precedence).",
 			},
+			cipd.EnvContentStoreDir: {
+				Advanced: true,
+				ShortDesc: "Directory with files of packages installed in hardlink mode, " +
+					"shared by all site roots on the same file system.",
+			},
 			cipd.EnvMaxThreads: {
 				Advanced: true,
 				ShortDesc: "Number of worker threads for extracting packages. " +
@@ -3729,6 +4136,7 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdSetRef(params),
 			cmdSetTag(params),
 			cmdSetMetadata(params),
+			cmdSign(params),
 
 			// High level local write commands.
 			{},
@@ -3741,6 +4149,8 @@ func GetApplication(params Parameters) *cli.Application {
 			{Advanced: true},
 			cmdEnsureFileVerify(params),
 			cmdEnsureFileResolve(params),
+			cmdBundleExport(params),
+			cmdBundleImport(params),
 
 			// User friendly subcommands that operates within a site root. Implemented
 			// in friendly.go. These are advanced because they're half-baked.
@@ -3776,6 +4186,7 @@ func GetApplication(params Parameters) *cli.Application {
 			// Utility commands.
 			{Advanced: true},
 			cmdProxy(params),
+			cmdServeLocal(params),
 		},
 	}
 }
@@ -0,0 +1,29 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+// ZstdMinClientVersion is the first version of the CIPD client that can
+// install packages with files compressed using zstd.
+//
+// The backend refuses to serve such packages to older clients.
+const ZstdMinClientVersion = "2.8.0"
+
+// CompressionHintMetadataKey is a gRPC metadata key used by the client to tell
+// the backend during instance registration how files in the instance are
+// compressed (e.g. "zstd").
+//
+// The backend uses it to decide whether it needs to inspect the package to
+// gate access to it by old clients.
+const CompressionHintMetadataKey = "x-cipd-compression-hint"
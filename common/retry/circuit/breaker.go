@@ -0,0 +1,271 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+)
+
+// ErrOpen is returned by functions wrapped with Breaker.Wrap when the breaker
+// is open.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is a state of a circuit breaker.
+type State int
+
+const (
+	// Closed means the backend is healthy and retries are allowed.
+	Closed State = iota
+	// Open means the backend is unhealthy and all retries are refused.
+	Open
+	// HalfOpen means the cooldown has passed and the breaker is letting a single
+	// call through to check whether the backend has recovered.
+	HalfOpen
+)
+
+// String implements fmt.Stringer.
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Breaker is a circuit breaker shared by all calls to some backend.
+//
+// It trips into the Open state when it observes Threshold or more failures
+// within the last Window. While open, iterators produced by it return
+// retry.Stop right away. After Cooldown, the breaker becomes half-open and
+// Wrap lets a single probe call through, rejecting all others. If the probe
+// succeeds (as reported via Succeeded), the breaker closes. If it fails, the
+// breaker opens again.
+//
+// Only errors indicating the backend is unhealthy are counted as failures:
+// errors tagged with transient.Tag and errors with gRPC code Unavailable. Other
+// errors (e.g. NotFound or InvalidArgument) mean the backend is responding.
+//
+// Failures are reported by iterators produced via Iterator or Factory (i.e.
+// each call to Next with such an error is one failure). Successes must be
+// reported via Succeeded or Wrap.
+//
+// The zero value is a usable breaker with default parameters. Must not be
+// copied after first use.
+type Breaker struct {
+	// Name identifies the breaker in metrics.
+	Name string
+	// Threshold is the number of failures within Window that trip the breaker.
+	// Default is 5.
+	Threshold int
+	// Window is the time window to count failures in. Default is 1 min.
+	Window time.Duration
+	// Cooldown is how long the breaker stays open. Default is 30 sec.
+	Cooldown time.Duration
+
+	m        sync.Mutex
+	state    State
+	failures window
+	openedAt time.Time
+	probing  bool // true if a probe call is in flight in HalfOpen state
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State(ctx context.Context) State {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.cooldown(ctx, clock.Now(ctx))
+	return b.state
+}
+
+// Iterator wraps `it` into an iterator that consults the breaker first.
+//
+// The returned iterator records every failure it observes in the breaker and
+// returns retry.Stop if the breaker is open. Otherwise it returns whatever `it`
+// returns.
+func (b *Breaker) Iterator(it retry.Iterator) retry.Iterator {
+	return retry.NewIterator(func(ctx context.Context, err error) time.Duration {
+		if !b.failed(ctx, err) {
+			return retry.Stop
+		}
+		return it.Next(ctx, err)
+	})
+}
+
+// Factory wraps iterators produced by `f` via Iterator.
+func (b *Breaker) Factory(f retry.Factory) retry.Factory {
+	return func() retry.Iterator {
+		return b.Iterator(f())
+	}
+}
+
+// Succeeded reports a successful call.
+//
+// Closes the breaker if it is half-open.
+func (b *Breaker) Succeeded(ctx context.Context) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	now := clock.Now(ctx)
+	b.cooldown(ctx, now)
+	if b.state == HalfOpen {
+		b.failures.reset()
+		b.setState(ctx, Closed)
+	}
+}
+
+// Wrap returns a function that calls `fn` unless the breaker is open.
+//
+// If the breaker is open, or it is half-open and some other call is already
+// probing the backend, the returned function fails with ErrOpen without calling
+// `fn`. Calls that succeed or fail with errors not counted as failures are
+// reported via Succeeded. A failed probe opens the breaker again.
+func (b *Breaker) Wrap(ctx context.Context, fn func() error) func() error {
+	return func() error {
+		probe, ok := b.admit(ctx)
+		if !ok {
+			breakerRejected.Add(ctx, 1, b.Name)
+			return ErrOpen
+		}
+		err := fn()
+		switch {
+		case err == nil || !isFailure(err):
+			b.Succeeded(ctx)
+		case probe:
+			b.probeFailed(ctx)
+		}
+		return err
+	}
+}
+
+// isFailure returns true if the error indicates the backend is unhealthy.
+func isFailure(err error) bool {
+	return transient.Tag.In(err) || status.Code(err) == codes.Unavailable
+}
+
+// admit returns true if a call is allowed to proceed.
+//
+// In HalfOpen state only one call is allowed, it is the probe. It holds the
+// probe slot until the breaker changes its state.
+func (b *Breaker) admit(ctx context.Context) (probe, ok bool) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.cooldown(ctx, clock.Now(ctx))
+	switch {
+	case b.state == Open:
+		return false, false
+	case b.state == HalfOpen && b.probing:
+		return false, false
+	case b.state == HalfOpen:
+		b.probing = true
+		return true, true
+	default:
+		return false, true
+	}
+}
+
+// probeFailed opens the breaker again after a failed probe.
+func (b *Breaker) probeFailed(ctx context.Context) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.state == HalfOpen {
+		b.trip(ctx, clock.Now(ctx))
+	}
+}
+
+// failed records a failure and returns true if a retry is allowed.
+//
+// Errors that are not counted as failures don't affect the breaker state.
+func (b *Breaker) failed(ctx context.Context, err error) bool {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	now := clock.Now(ctx)
+	b.cooldown(ctx, now)
+
+	switch {
+	case b.state == Open || errors.Is(err, ErrOpen):
+		// Refuse the retry.
+	case !isFailure(err):
+		return true
+	case b.state == Closed:
+		b.failures.span = b.window()
+		b.failures.add(now, 1)
+		if b.failures.sum(now) < int64(b.threshold()) {
+			return true
+		}
+		b.trip(ctx, now)
+	case b.state == HalfOpen:
+		// The backend is still unhealthy.
+		b.trip(ctx, now)
+	}
+
+	breakerRejected.Add(ctx, 1, b.Name)
+	return false
+}
+
+// cooldown moves the breaker into HalfOpen state if the cooldown has passed.
+func (b *Breaker) cooldown(ctx context.Context, now time.Time) {
+	if b.state == Open && now.Sub(b.openedAt) >= b.cooldownPeriod() {
+		b.setState(ctx, HalfOpen)
+	}
+}
+
+func (b *Breaker) trip(ctx context.Context, now time.Time) {
+	b.openedAt = now
+	b.failures.reset()
+	b.setState(ctx, Open)
+	breakerTrips.Add(ctx, 1, b.Name)
+}
+
+func (b *Breaker) setState(ctx context.Context, s State) {
+	b.state = s
+	b.probing = false
+	breakerState.Set(ctx, s.String(), b.Name)
+}
+
+func (b *Breaker) threshold() int {
+	if b.Threshold > 0 {
+		return b.Threshold
+	}
+	return 5
+}
+
+func (b *Breaker) window() time.Duration {
+	if b.Window > 0 {
+		return b.Window
+	}
+	return time.Minute
+}
+
+func (b *Breaker) cooldownPeriod() time.Duration {
+	if b.Cooldown > 0 {
+		return b.Cooldown
+	}
+	return 30 * time.Second
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package circuit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/common/tsmon"
+)
+
+var errBoom = transient.Tag.Apply(errors.New("boom"))
+
+func constant() retry.Iterator {
+	return &retry.Limited{Delay: time.Second, Retries: -1}
+}
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run(`A Breaker`, t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx, _ = tsmon.WithDummyInMemory(ctx)
+		tc.SetTimerCallback(func(d time.Duration, _ clock.Timer) { tc.Add(d) })
+
+		b := &Breaker{
+			Name:      "test",
+			Threshold: 3,
+			Window:    time.Minute,
+			Cooldown:  30 * time.Second,
+		}
+		f := b.Factory(constant)
+
+		trip := func() {
+			it := f()
+			for i := 0; i < 2; i++ {
+				assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			}
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(retry.Stop))
+		}
+
+		t.Run(`Trips after threshold failures`, func(t *ftt.Test) {
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+			trip()
+			assert.Loosely(t, b.State(ctx), should.Equal(Open))
+			assert.Loosely(t, breakerTrips.Get(ctx, "test"), should.Equal(1))
+			assert.Loosely(t, breakerState.Get(ctx, "test"), should.Equal("open"))
+
+			// Other calls are affected too.
+			assert.Loosely(t, f().Next(ctx, errBoom), should.Equal(retry.Stop))
+		})
+
+		t.Run(`Old failures expire`, func(t *ftt.Test) {
+			it := f()
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			tc.Add(2 * time.Minute)
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+		})
+
+		t.Run(`Counts only backend failures`, func(t *ftt.Test) {
+			it := f()
+			for i := 0; i < 5; i++ {
+				assert.Loosely(t, it.Next(ctx, errors.New("fatal")), should.Equal(time.Second))
+				assert.Loosely(t, it.Next(ctx, status.Errorf(codes.NotFound, "boo")), should.Equal(time.Second))
+				assert.Loosely(t, it.Next(ctx, status.Errorf(codes.InvalidArgument, "boo")), should.Equal(time.Second))
+			}
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+
+			for i := 0; i < 2; i++ {
+				assert.Loosely(t, it.Next(ctx, status.Errorf(codes.Unavailable, "boo")), should.Equal(time.Second))
+			}
+			assert.Loosely(t, it.Next(ctx, status.Errorf(codes.Unavailable, "boo")), should.Equal(retry.Stop))
+			assert.Loosely(t, b.State(ctx), should.Equal(Open))
+		})
+
+		t.Run(`Half-opens after cooldown and closes on success`, func(t *ftt.Test) {
+			trip()
+			tc.Add(30 * time.Second)
+			assert.Loosely(t, b.State(ctx), should.Equal(HalfOpen))
+
+			// Lets a probe through.
+			assert.Loosely(t, f().Next(ctx, errBoom), should.Equal(retry.Stop))
+			assert.Loosely(t, b.State(ctx), should.Equal(Open))
+
+			tc.Add(30 * time.Second)
+			b.Succeeded(ctx)
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+			assert.Loosely(t, f().Next(ctx, errBoom), should.Equal(time.Second))
+		})
+
+		t.Run(`Wrap`, func(t *ftt.Test) {
+			calls := 0
+			fn := b.Wrap(ctx, func() error {
+				calls++
+				return errBoom
+			})
+
+			err := retry.Retry(ctx, f, fn, nil)
+			assert.Loosely(t, err, should.Equal(errBoom))
+			assert.Loosely(t, calls, should.Equal(3))
+			assert.Loosely(t, b.State(ctx), should.Equal(Open))
+
+			// Doesn't even try while open.
+			err = retry.Retry(ctx, f, fn, nil)
+			assert.Loosely(t, err, should.Equal(ErrOpen))
+			assert.Loosely(t, calls, should.Equal(3))
+
+			// Succeeds after the cooldown.
+			tc.Add(30 * time.Second)
+			err = retry.Retry(ctx, f, b.Wrap(ctx, func() error { return nil }), nil)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+		})
+
+		t.Run(`Lets a single probe through while half-open`, func(t *ftt.Test) {
+			trip()
+			tc.Add(30 * time.Second)
+
+			var nested error
+			probe := b.Wrap(ctx, func() error {
+				// All other calls are rejected while the probe is running.
+				nested = b.Wrap(ctx, func() error { return nil })()
+				return nil
+			})
+			assert.Loosely(t, probe(), should.BeNil)
+			assert.Loosely(t, nested, should.Equal(ErrOpen))
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+		})
+
+		t.Run(`Failed probe opens the breaker`, func(t *ftt.Test) {
+			trip()
+			tc.Add(30 * time.Second)
+
+			assert.Loosely(t, b.Wrap(ctx, func() error { return errBoom })(), should.Equal(errBoom))
+			assert.Loosely(t, b.State(ctx), should.Equal(Open))
+			assert.Loosely(t, b.Wrap(ctx, func() error { return nil })(), should.Equal(ErrOpen))
+		})
+
+		t.Run(`Non-failure errors close the breaker after a probe`, func(t *ftt.Test) {
+			trip()
+			tc.Add(30 * time.Second)
+
+			err := b.Wrap(ctx, func() error { return status.Errorf(codes.NotFound, "boo") })()
+			assert.Loosely(t, status.Code(err), should.Equal(codes.NotFound))
+			assert.Loosely(t, b.State(ctx), should.Equal(Closed))
+		})
+
+		t.Run(`Concurrent use`, func(t *ftt.Test) {
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					it := f()
+					for j := 0; j < 10; j++ {
+						it.Next(ctx, errBoom)
+						b.Succeeded(ctx)
+					}
+				}()
+			}
+			wg.Wait()
+			assert.Loosely(t, b.State(ctx), should.Equal(Open))
+		})
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/retry"
+)
+
+// Budget limits retries to a fraction of successful calls.
+//
+// Within any Window, iterators produced by the budget allow at most
+// MinRetries + Ratio*successes retries, where `successes` is the number of
+// successful calls reported via Succeeded or Wrap during that window. Once the
+// budget is exhausted, iterators return retry.Stop.
+//
+// This bounds the extra load retries can put on a struggling backend: when
+// most calls fail, there are few successes and hence few retries.
+//
+// The zero value is a usable budget with default parameters. Must not be
+// copied after first use.
+type Budget struct {
+	// Name identifies the budget in metrics.
+	Name string
+	// Ratio is how many retries are allowed per successful call. Default is 0.1.
+	Ratio float64
+	// MinRetries is how many retries are allowed within Window regardless of
+	// successes. This allows retries when the traffic is low. Default is 10.
+	MinRetries int
+	// Window is the time window to count retries and successes in. Default is
+	// 10 sec.
+	Window time.Duration
+
+	m         sync.Mutex
+	successes window
+	retries   window
+}
+
+// Iterator wraps `it` into an iterator that consults the budget first.
+//
+// The returned iterator asks `it` first and spends one retry from the budget
+// only if `it` wants to retry. It returns retry.Stop if either `it` stops or the
+// budget is exhausted.
+func (b *Budget) Iterator(it retry.Iterator) retry.Iterator {
+	return retry.NewIterator(func(ctx context.Context, err error) time.Duration {
+		delay := it.Next(ctx, err)
+		if delay == retry.Stop || !b.spend(ctx) {
+			return retry.Stop
+		}
+		return delay
+	})
+}
+
+// Factory wraps iterators produced by `f` via Iterator.
+func (b *Budget) Factory(f retry.Factory) retry.Factory {
+	return func() retry.Iterator {
+		return b.Iterator(f())
+	}
+}
+
+// Succeeded reports a successful call.
+func (b *Budget) Succeeded(ctx context.Context) {
+	b.m.Lock()
+	defer b.m.Unlock()
+	b.successes.span = b.window()
+	b.successes.add(clock.Now(ctx), 1)
+}
+
+// Wrap returns a function that calls `fn` and reports its successes via
+// Succeeded.
+func (b *Budget) Wrap(ctx context.Context, fn func() error) func() error {
+	return func() error {
+		if err := fn(); err != nil {
+			return err
+		}
+		b.Succeeded(ctx)
+		return nil
+	}
+}
+
+// spend takes one retry from the budget, returning false if it is exhausted.
+func (b *Budget) spend(ctx context.Context) (allowed bool) {
+	defer func() { budgetRetries.Add(ctx, 1, b.Name, allowed) }()
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	now := clock.Now(ctx)
+	b.successes.span = b.window()
+	b.retries.span = b.window()
+
+	limit := float64(b.minRetries()) + b.ratio()*float64(b.successes.sum(now))
+	if float64(b.retries.sum(now)) >= limit {
+		return false
+	}
+	b.retries.add(now, 1)
+	return true
+}
+
+func (b *Budget) ratio() float64 {
+	if b.Ratio > 0 {
+		return b.Ratio
+	}
+	return 0.1
+}
+
+func (b *Budget) minRetries() int {
+	if b.MinRetries > 0 {
+		return b.MinRetries
+	}
+	return 10
+}
+
+func (b *Budget) window() time.Duration {
+	if b.Window > 0 {
+		return b.Window
+	}
+	return 10 * time.Second
+}
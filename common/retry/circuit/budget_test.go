@@ -0,0 +1,108 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package circuit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/common/tsmon"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run(`A Budget`, t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx, _ = tsmon.WithDummyInMemory(ctx)
+		tc.SetTimerCallback(func(d time.Duration, _ clock.Timer) { tc.Add(d) })
+
+		b := &Budget{
+			Name:       "test",
+			Ratio:      0.5,
+			MinRetries: 2,
+			Window:     10 * time.Second,
+		}
+		f := b.Factory(constant)
+
+		t.Run(`Allows MinRetries without successes`, func(t *ftt.Test) {
+			it := f()
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, f().Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(retry.Stop))
+			assert.Loosely(t, budgetRetries.Get(ctx, "test", true), should.Equal(2))
+			assert.Loosely(t, budgetRetries.Get(ctx, "test", false), should.Equal(1))
+		})
+
+		t.Run(`Doesn't spend the budget when the iterator stops`, func(t *ftt.Test) {
+			stopping := b.Iterator(&retry.Limited{Delay: time.Second, Retries: 0})
+			for i := 0; i < 5; i++ {
+				assert.Loosely(t, stopping.Next(ctx, errBoom), should.Equal(retry.Stop))
+			}
+			assert.Loosely(t, budgetRetries.Get(ctx, "test", true), should.BeZero)
+			assert.Loosely(t, budgetRetries.Get(ctx, "test", false), should.BeZero)
+
+			// The budget is still available to other callers.
+			it := f()
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(retry.Stop))
+		})
+
+		t.Run(`Successes add to the budget`, func(t *ftt.Test) {
+			for i := 0; i < 4; i++ {
+				b.Succeeded(ctx)
+			}
+			it := f()
+			for i := 0; i < 4; i++ {
+				assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			}
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(retry.Stop))
+		})
+
+		t.Run(`Budget is replenished over time`, func(t *ftt.Test) {
+			it := f()
+			it.Next(ctx, errBoom)
+			it.Next(ctx, errBoom)
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(retry.Stop))
+			tc.Add(11 * time.Second)
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+		})
+
+		t.Run(`Wrap reports successes`, func(t *ftt.Test) {
+			fails := 3
+			fn := b.Wrap(ctx, func() error {
+				if fails > 0 {
+					fails--
+					return errBoom
+				}
+				return nil
+			})
+			// Only 2 retries are allowed.
+			assert.Loosely(t, retry.Retry(ctx, f, fn, nil), should.Equal(errBoom))
+			// The third attempt succeeds and raises the limit to 2.5 retries.
+			assert.Loosely(t, retry.Retry(ctx, f, fn, nil), should.BeNil)
+			it := f()
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(time.Second))
+			assert.Loosely(t, it.Next(ctx, errBoom), should.Equal(retry.Stop))
+		})
+	})
+}
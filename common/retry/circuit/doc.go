@@ -0,0 +1,41 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package circuit implements retry iterators that share state across many
+// retry.Retry calls.
+//
+// A regular retry.Iterator decides on retries looking only at the single call
+// it is used for. When a backend is down, every caller keeps retrying it
+// independently, amplifying the load on it. Iterators in this package observe
+// all calls made to a backend and stop retrying when it appears unhealthy:
+//
+//   - Breaker is a circuit breaker: it trips after too many failures within
+//     a time window and refuses all retries until a cooldown passes.
+//   - Budget caps the number of retries to a fraction of successful calls.
+//
+// Both are safe for concurrent use and report their state via tsmon metrics.
+// Since they can't observe successful calls through the retry.Iterator
+// interface, callers must report them, usually by wrapping the retried
+// function with Wrap:
+//
+//	var backend = &circuit.Breaker{Name: "backend"}
+//
+//	err := retry.Retry(ctx,
+//	    backend.Factory(transient.Only(retry.Default)),
+//	    backend.Wrap(ctx, doCall),
+//	    nil)
+//
+// This lives in a separate package from common/retry since tsmon itself
+// depends on common/retry.
+package circuit
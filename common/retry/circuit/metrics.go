@@ -0,0 +1,47 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuit
+
+import (
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+)
+
+var (
+	breakerState = metric.NewString(
+		"retry/circuit/state",
+		"Current state of a circuit breaker: closed, open or half-open.",
+		nil,
+		field.String("name")) // Breaker.Name
+
+	breakerTrips = metric.NewCounter(
+		"retry/circuit/trips",
+		"Number of times a circuit breaker transitioned into the open state.",
+		nil,
+		field.String("name")) // Breaker.Name
+
+	breakerRejected = metric.NewCounter(
+		"retry/circuit/rejected",
+		"Number of attempts and retries refused by an open circuit breaker.",
+		nil,
+		field.String("name")) // Breaker.Name
+
+	budgetRetries = metric.NewCounter(
+		"retry/budget/retries",
+		"Number of retries requested from a retry budget.",
+		nil,
+		field.String("name"),  // Budget.Name
+		field.Bool("allowed")) // false if the budget was exhausted
+)
@@ -0,0 +1,82 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuit
+
+import (
+	"time"
+)
+
+// windowBuckets is how many buckets a window is split into.
+const windowBuckets = 10
+
+// window counts events that happened during the last `span` of time.
+//
+// It is approximate: events are grouped into buckets of span/windowBuckets
+// size, and entire buckets expire at once.
+//
+// Not safe for concurrent use.
+type window struct {
+	span    time.Duration
+	buckets [windowBuckets]bucket
+}
+
+type bucket struct {
+	start time.Time
+	count int64
+}
+
+// add records `n` events happened at `now`.
+func (w *window) add(now time.Time, n int64) {
+	b, start := w.bucket(now)
+	if !b.start.Equal(start) {
+		*b = bucket{start: start}
+	}
+	b.count += n
+}
+
+// sum returns the number of events within the window that ends at `now`.
+func (w *window) sum(now time.Time) (total int64) {
+	width := w.width()
+	oldest := now.Truncate(width).Add(-w.span + width)
+	for _, b := range w.buckets {
+		if !b.start.Before(oldest) && !b.start.After(now) {
+			total += b.count
+		}
+	}
+	return
+}
+
+// reset forgets all recorded events.
+func (w *window) reset() {
+	w.buckets = [windowBuckets]bucket{}
+}
+
+func (w *window) width() time.Duration {
+	width := w.span / windowBuckets
+	if width <= 0 {
+		width = 1
+	}
+	return width
+}
+
+func (w *window) bucket(now time.Time) (*bucket, time.Time) {
+	width := w.width()
+	start := now.Truncate(width)
+	idx := (start.UnixNano() / int64(width)) % windowBuckets
+	if idx < 0 {
+		idx += windowBuckets
+	}
+	return &w.buckets[idx], start
+}
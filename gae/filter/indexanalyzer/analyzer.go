@@ -0,0 +1,327 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package indexanalyzer implements a datastore filter that records executed
+// queries together with composite indexes they need.
+//
+// It is meant to be used in tests and dev servers backed by gae/impl/memory to
+// catch index.yaml drift before it hits production:
+//
+//	idx, _ := datastore.FindAndParseIndexYAML(".")
+//	a := &indexanalyzer.Analyzer{Indexes: idx}
+//	ctx = a.Filter(memory.Use(ctx))
+//	... run tests ...
+//	for _, w := range a.Warnings() {
+//	  t.Errorf("%s", w)
+//	}
+//	yaml, _ := a.IndexYAML() // merged index.yaml
+//
+// Required indexes are determined using the index selection logic of
+// gae/impl/memory, which mirrors the production datastore.
+package indexanalyzer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// DefaultLargeScan is the default value of Analyzer.LargeScan.
+const DefaultLargeScan = 1000
+
+// Analyzer records queries executed through contexts produced by Filter.
+//
+// Safe for concurrent use. Fields must not be changed after the first query.
+type Analyzer struct {
+	// Indexes are composite indexes known to exist, usually loaded from
+	// index.yaml via datastore.FindAndParseIndexYAML.
+	Indexes []*ds.IndexDefinition
+
+	// LargeScan is the number of entities a single query execution may fetch
+	// before the query is reported as a large scan. Default is
+	// DefaultLargeScan.
+	LargeScan int
+
+	m       sync.Mutex
+	queries map[string]*Query
+}
+
+// Query describes a query shape and its executions.
+type Query struct {
+	// Shape is a GQL-like representation of the query with values elided.
+	Shape string
+	// Kind is the kind the query is for, or "" for kindless queries.
+	Kind string
+	// Required is a composite index the query needs or nil if builtin indexes
+	// are sufficient.
+	Required *ds.IndexDefinition
+	// Missing is true if Required is not covered by Analyzer.Indexes.
+	Missing bool
+	// Unbounded is true if the query has no equality filters, no ancestor and
+	// no limit, i.e. it may scan the entire kind.
+	Unbounded bool
+	// Executions is how many times the query ran.
+	Executions int
+	// MaxFetched is the largest number of entities fetched by an execution.
+	MaxFetched int
+}
+
+// Filter installs the analyzer into the context.
+func (a *Analyzer) Filter(ctx context.Context) context.Context {
+	return ds.AddRawFilters(ctx, func(ic context.Context, inner ds.RawInterface) ds.RawInterface {
+		return &analyzingDatastore{inner, ic, a}
+	})
+}
+
+// Queries returns all recorded queries, ordered by their shapes.
+func (a *Analyzer) Queries() []Query {
+	a.m.Lock()
+	defer a.m.Unlock()
+	out := make([]Query, 0, len(a.queries))
+	for _, q := range a.queries {
+		out = append(out, *q)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Shape < out[j].Shape })
+	return out
+}
+
+// Warnings returns human readable warnings about recorded queries that need
+// missing indexes or may scan large ranges.
+func (a *Analyzer) Warnings() []string {
+	var out []string
+	for _, q := range a.Queries() {
+		if q.Missing {
+			yaml, _ := q.Required.YAMLString()
+			out = append(out, fmt.Sprintf("%s: needs a missing index:\n%s", q.Shape, yaml))
+		}
+		if q.Unbounded {
+			out = append(out, fmt.Sprintf("%s: may scan the entire kind, consider adding filters or a limit", q.Shape))
+		}
+		if q.MaxFetched >= a.largeScan() {
+			out = append(out, fmt.Sprintf("%s: fetched %d entities in a single execution", q.Shape, q.MaxFetched))
+		}
+	}
+	return out
+}
+
+// IndexYAML returns the contents of index.yaml with all Indexes and all
+// indexes required by recorded queries.
+func (a *Analyzer) IndexYAML() ([]byte, error) {
+	var all []*ds.IndexDefinition
+	add := func(idx *ds.IndexDefinition) {
+		for _, existing := range all {
+			if existing.Normalize().Equal(idx.Normalize()) {
+				return
+			}
+		}
+		all = append(all, idx)
+	}
+	for _, idx := range a.Indexes {
+		add(idx)
+	}
+	for _, q := range a.Queries() {
+		if q.Required != nil {
+			add(q.Required)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Less(all[j]) })
+
+	out := strings.Builder{}
+	out.WriteString("indexes:\n")
+	for _, idx := range all {
+		yaml, err := idx.YAMLString()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&out, "\n%s\n", yaml)
+	}
+	return []byte(out.String()), nil
+}
+
+// record records a query execution, returning the recorded query.
+//
+// Returns nil if the query is invalid. The datastore will reject it anyway.
+func (a *Analyzer) record(ctx context.Context, fq *ds.FinalizedQuery) *Query {
+	shape := queryShape(fq)
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	q := a.queries[shape]
+	if q == nil {
+		kc := ds.GetKeyContext(ctx)
+		required, err := memory.MissingIndex(kc, fq, nil)
+		if err != nil {
+			return nil
+		}
+		q = &Query{
+			Shape:     shape,
+			Kind:      fq.Kind(),
+			Required:  required,
+			Unbounded: isUnbounded(fq),
+		}
+		if required != nil {
+			missing, err := memory.MissingIndex(kc, fq, a.Indexes)
+			if err != nil {
+				return nil
+			}
+			if q.Missing = missing != nil; q.Missing {
+				logging.Warningf(ctx, "indexanalyzer: %s: needs a missing index %s", shape, required)
+			}
+		}
+		if a.queries == nil {
+			a.queries = map[string]*Query{}
+		}
+		a.queries[shape] = q
+	}
+	q.Executions++
+	return q
+}
+
+// fetched records that an execution of a query fetched `n` entities.
+func (a *Analyzer) fetched(ctx context.Context, q *Query, n int) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if n > q.MaxFetched {
+		if limit := a.largeScan(); q.MaxFetched < limit && n >= limit {
+			logging.Warningf(ctx, "indexanalyzer: %s: fetched %d entities in a single execution", q.Shape, n)
+		}
+		q.MaxFetched = n
+	}
+}
+
+func (a *Analyzer) largeScan() int {
+	if a.LargeScan > 0 {
+		return a.LargeScan
+	}
+	return DefaultLargeScan
+}
+
+// analyzingDatastore is a datastore.RawInterface implementation that records
+// queries in an Analyzer.
+type analyzingDatastore struct {
+	ds.RawInterface
+
+	ctx context.Context
+	a   *Analyzer
+}
+
+func (d *analyzingDatastore) Run(fq *ds.FinalizedQuery, cb ds.RawRunCB) error {
+	q := d.a.record(d.ctx, fq)
+	if q == nil {
+		return d.RawInterface.Run(fq, cb)
+	}
+	n := 0
+	defer func() { d.a.fetched(d.ctx, q, n) }()
+	return d.RawInterface.Run(fq, func(key *ds.Key, val ds.PropertyMap, getCursor ds.CursorCB) error {
+		n++
+		return cb(key, val, getCursor)
+	})
+}
+
+func (d *analyzingDatastore) Count(fq *ds.FinalizedQuery) (int64, error) {
+	q := d.a.record(d.ctx, fq)
+	count, err := d.RawInterface.Count(fq)
+	if q != nil && err == nil {
+		d.a.fetched(d.ctx, q, int(count))
+	}
+	return count, err
+}
+
+// queryShape returns a GQL-like representation of the query with values
+// replaced by "?".
+func queryShape(fq *ds.FinalizedQuery) string {
+	out := strings.Builder{}
+	out.WriteString("SELECT ")
+	switch {
+	case fq.KeysOnly():
+		out.WriteString("__key__")
+	case len(fq.Project()) > 0:
+		if fq.Distinct() {
+			out.WriteString("DISTINCT ")
+		}
+		out.WriteString(strings.Join(fq.Project(), ", "))
+	default:
+		out.WriteString("*")
+	}
+	if kind := fq.Kind(); kind != "" {
+		fmt.Fprintf(&out, " FROM %s", kind)
+	}
+
+	var conds []string
+	if fq.Ancestor() != nil {
+		conds = append(conds, "__key__ HAS ANCESTOR ?")
+	}
+	for _, prop := range sortedKeys(fq.EqFilters()) {
+		if prop == "__ancestor__" {
+			continue
+		}
+		for range fq.EqFilters()[prop] {
+			conds = append(conds, prop+" = ?")
+		}
+	}
+	for _, prop := range sortedKeys(fq.InFilters()) {
+		for range fq.InFilters()[prop] {
+			conds = append(conds, prop+" IN ?")
+		}
+	}
+	if field, op, _ := fq.IneqFilterLow(); field != "" {
+		conds = append(conds, fmt.Sprintf("%s %s ?", field, op))
+	}
+	if field, op, _ := fq.IneqFilterHigh(); field != "" {
+		conds = append(conds, fmt.Sprintf("%s %s ?", field, op))
+	}
+	if len(conds) > 0 {
+		fmt.Fprintf(&out, " WHERE %s", strings.Join(conds, " AND "))
+	}
+
+	orders := fq.Orders()
+	if n := len(orders); n > 0 && orders[n-1] == (ds.IndexColumn{Property: "__key__"}) {
+		orders = orders[:n-1] // implicit
+	}
+	if len(orders) > 0 {
+		cols := make([]string, len(orders))
+		for i, col := range orders {
+			cols[i] = col.String()
+		}
+		fmt.Fprintf(&out, " ORDER BY %s", strings.Join(cols, ", "))
+	}
+
+	if _, ok := fq.Limit(); ok {
+		out.WriteString(" LIMIT ?")
+	}
+	return out.String()
+}
+
+// isUnbounded is true if the query may scan the entire kind.
+func isUnbounded(fq *ds.FinalizedQuery) bool {
+	_, hasLimit := fq.Limit()
+	return !hasLimit && len(fq.EqFilters()) == 0 && len(fq.InFilters()) == 0
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
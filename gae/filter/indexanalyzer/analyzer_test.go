@@ -0,0 +1,159 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+type Build struct {
+	ID      int64 `gae:"$id"`
+	Project string
+	Status  string
+	Created int64
+}
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With analyzer", t, func(t *ftt.Test) {
+		declared := &ds.IndexDefinition{
+			Kind: "Build",
+			SortBy: []ds.IndexColumn{
+				{Property: "Project"},
+				{Property: "Created", Descending: true},
+			},
+		}
+		a := &Analyzer{Indexes: []*ds.IndexDefinition{declared}, LargeScan: 5}
+
+		ctx := memory.Use(context.Background())
+		ds.GetTestable(ctx).AutoIndex(true)
+		ds.GetTestable(ctx).Consistent(true)
+		ctx = a.Filter(ctx)
+
+		for i := 1; i <= 10; i++ {
+			assert.Loosely(t, ds.Put(ctx, &Build{
+				ID:      int64(i),
+				Project: fmt.Sprintf("p%d", i%2),
+				Status:  "OK",
+				Created: int64(i),
+			}), should.BeNil)
+		}
+		ds.GetTestable(ctx).CatchupIndexes()
+
+		run := func(q *ds.Query) {
+			var out []*Build
+			assert.Loosely(t, ds.GetAll(ctx, q, &out), should.BeNil)
+		}
+
+		t.Run("Builtin indexes", func(t *ftt.Test) {
+			run(ds.NewQuery("Build").Eq("Project", "p0").Limit(10))
+			run(ds.NewQuery("Build").Eq("Project", "p1").Limit(10))
+
+			qs := a.Queries()
+			assert.Loosely(t, qs, should.HaveLength(1))
+			assert.Loosely(t, qs[0].Shape, should.Equal("SELECT * FROM Build WHERE Project = ? LIMIT ?"))
+			assert.Loosely(t, qs[0].Required, should.BeNil)
+			assert.Loosely(t, qs[0].Missing, should.BeFalse)
+			assert.Loosely(t, qs[0].Executions, should.Equal(2))
+			assert.Loosely(t, qs[0].MaxFetched, should.Equal(5))
+			assert.Loosely(t, a.Warnings(), should.HaveLength(1)) // large scan
+		})
+
+		t.Run("Declared index", func(t *ftt.Test) {
+			run(ds.NewQuery("Build").Eq("Project", "p0").Order("-Created").Limit(2))
+
+			qs := a.Queries()
+			assert.Loosely(t, qs, should.HaveLength(1))
+			assert.Loosely(t, qs[0].Required.Equal(declared), should.BeTrue)
+			assert.Loosely(t, qs[0].Missing, should.BeFalse)
+			assert.Loosely(t, a.Warnings(), should.BeEmpty)
+		})
+
+		t.Run("Missing index", func(t *ftt.Test) {
+			run(ds.NewQuery("Build").Eq("Status", "OK").Order("Created").Limit(2))
+			n, err := ds.Count(ctx, ds.NewQuery("Build").Eq("Status", "OK").Order("Created").Limit(2))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, n, should.Equal(2))
+
+			qs := a.Queries()
+			assert.Loosely(t, qs, should.HaveLength(1))
+			assert.Loosely(t, qs[0].Shape, should.Equal("SELECT * FROM Build WHERE Status = ? ORDER BY Created LIMIT ?"))
+			assert.Loosely(t, qs[0].Missing, should.BeTrue)
+			assert.Loosely(t, qs[0].Executions, should.Equal(2))
+
+			warnings := a.Warnings()
+			assert.Loosely(t, warnings, should.HaveLength(1))
+			assert.Loosely(t, warnings[0], should.ContainSubstring("needs a missing index"))
+
+			yaml, err := a.IndexYAML()
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, string(yaml), should.Equal(strings.Join([]string{
+				"indexes:",
+				"",
+				"- kind: Build",
+				"  properties:",
+				"  - name: Project",
+				"  - name: Created",
+				"    direction: desc",
+				"",
+				"- kind: Build",
+				"  properties:",
+				"  - name: Status",
+				"  - name: Created",
+				"",
+			}, "\n")))
+
+			parsed, err := ds.ParseIndexYAML(strings.NewReader(string(yaml)))
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, parsed, should.HaveLength(2))
+		})
+
+		t.Run("Unbounded scan", func(t *ftt.Test) {
+			var keys []*ds.Key
+			assert.Loosely(t, ds.GetAll(ctx, ds.NewQuery("Build").Gt("Created", 8).KeysOnly(true), &keys), should.BeNil)
+			assert.Loosely(t, keys, should.HaveLength(2))
+
+			qs := a.Queries()
+			assert.Loosely(t, qs, should.HaveLength(1))
+			assert.Loosely(t, qs[0].Shape, should.Equal("SELECT __key__ FROM Build WHERE Created > ? ORDER BY Created"))
+			assert.Loosely(t, qs[0].Unbounded, should.BeTrue)
+			assert.Loosely(t, a.Warnings(), should.HaveLength(1))
+		})
+
+		t.Run("Transactions", func(t *ftt.Test) {
+			err := ds.RunInTransaction(ctx, func(ctx context.Context) error {
+				var out []*Build
+				return ds.GetAll(ctx, ds.NewQuery("Build").Ancestor(ds.MakeKey(ctx, "Build", 1)), &out)
+			}, nil)
+			assert.Loosely(t, err, should.BeNil)
+
+			qs := a.Queries()
+			assert.Loosely(t, qs, should.HaveLength(1))
+			assert.Loosely(t, qs[0].Shape, should.Equal("SELECT * FROM Build WHERE __key__ HAS ANCESTOR ?"))
+			assert.Loosely(t, qs[0].Unbounded, should.BeFalse)
+		})
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/datastore/export"
+)
+
+// ExportDatastore dumps all entities and composite index definitions from the
+// datastore in the context into a directory in the Cloud Datastore managed
+// export format.
+//
+// The result can be loaded back with export.Import. See the export package for
+// details and limitations of the format.
+//
+// The context must be produced by Use or UseWithAppID and must not be
+// transactional.
+func ExportDatastore(c context.Context, dir string) error {
+	data, err := persistableData(c)
+	if err != nil {
+		return err
+	}
+	snap := data.takeSnapshot()
+
+	w, err := export.NewWriter(dir)
+	if err != nil {
+		return err
+	}
+	if err := exportEntities(snap, data.aid, w); err != nil {
+		w.Close()
+		return err
+	}
+
+	var idx []*ds.IndexDefinition
+	walkCompIdxs(snap, nil, func(def *ds.IndexDefinition) bool {
+		// Drop the implicit trailing __key__ column added by Normalize.
+		if n := len(def.SortBy); n > 0 && def.SortBy[n-1] == (ds.IndexColumn{Property: "__key__"}) {
+			def.SortBy = def.SortBy[:n-1]
+		}
+		idx = append(idx, def)
+		return true
+	})
+	if len(idx) > 0 {
+		if err := w.WriteIndexes(idx); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func exportEntities(snap memStore, aid string, w *export.Writer) error {
+	for _, ns := range namespaces(snap) {
+		// Namespaces are part of the collection names, not the stored keys.
+		dec := ds.Deserializer{KeyContext: ds.MkKeyContext(aid, ns)}
+		var err error
+		snap.GetCollection("ents:" + ns).ForEachItem(func(k, v []byte) bool {
+			var prop ds.Property
+			if prop, err = dec.Property(bytes.NewBuffer(k)); err != nil {
+				return false
+			}
+			key := prop.Value().(*ds.Key)
+			if strings.HasPrefix(key.Kind(), "__") {
+				return true // e.g. entity group metadata
+			}
+			var pm ds.PropertyMap
+			if pm, err = dec.PropertyMap(bytes.NewBuffer(v)); err != nil {
+				return false
+			}
+			stripSpecialProps(pm)
+			err = w.Write(key, pm)
+			return err == nil
+		})
+		if err != nil {
+			return errors.Annotate(err, "exporting namespace %q", ns).Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/datastore/export"
+	infoS "go.chromium.org/luci/gae/service/info"
+)
+
+func TestExportDatastore(t *testing.T) {
+	t.Parallel()
+
+	type Inner struct {
+		Val int64
+	}
+	type Ent struct {
+		Kind   string  `gae:"$kind"`
+		ID     string  `gae:"$id"`
+		Parent *ds.Key `gae:"$parent"`
+
+		Int   int64
+		Str   string
+		Text  string `gae:",noindex"`
+		Bytes []byte
+		Float float64
+		Bool  bool
+		Time  time.Time
+		Geo   ds.GeoPoint
+		Ref   *ds.Key
+		Tags  []string
+		Inner Inner `gae:",lsp"`
+	}
+
+	ftt.Run("Round trip", t, func(t *ftt.Test) {
+		c := Use(context.Background())
+		idx := &ds.IndexDefinition{
+			Kind:     "Ent",
+			Ancestor: true,
+			SortBy: []ds.IndexColumn{
+				{Property: "Tags"},
+				{Property: "Int", Descending: true},
+			},
+		}
+		ds.GetTestable(c).AddIndexes(idx)
+
+		root := ds.MakeKey(c, "Root", 1)
+		ents := []*Ent{
+			{
+				Kind:   "Ent",
+				ID:     "full",
+				Parent: root,
+				Int:    1,
+				Str:    "str",
+				Text:   "text",
+				Bytes:  []byte("bytes"),
+				Float:  1.5,
+				Bool:   true,
+				Time:   time.Date(2025, 1, 2, 3, 4, 5, 6000, time.UTC),
+				Geo:    ds.GeoPoint{Lat: 1, Lng: 2},
+				Ref:    ds.MakeKey(c, "Other", "x"),
+				Tags:   []string{"a", "b"},
+				Inner:  Inner{Val: 5},
+			},
+			{Kind: "Ent", ID: "sparse", Parent: root, Int: 2, Tags: []string{"a"}},
+			{Kind: "Ent", ID: "root"},
+		}
+		assert.Loosely(t, ds.Put(c, ents), should.BeNil)
+
+		nsCtx, err := infoS.Namespace(c, "ns")
+		assert.Loosely(t, err, should.BeNil)
+		nsEnt := &Ent{Kind: "Ent", ID: "in-ns", Int: 3}
+		assert.Loosely(t, ds.Put(nsCtx, nsEnt), should.BeNil)
+
+		dir := t.TempDir()
+		assert.Loosely(t, ExportDatastore(c, dir), should.BeNil)
+
+		c2 := Use(context.Background())
+		assert.Loosely(t, export.Import(c2, dir), should.BeNil)
+
+		t.Run("Entities", func(t *ftt.Test) {
+			got := []*Ent{
+				{Kind: "Ent", ID: "full", Parent: root},
+				{Kind: "Ent", ID: "sparse", Parent: root},
+				{Kind: "Ent", ID: "root"},
+			}
+			assert.Loosely(t, ds.Get(c2, got), should.BeNil)
+			assert.Loosely(t, got, should.Match(ents))
+		})
+
+		t.Run("Namespaces", func(t *ftt.Test) {
+			nsCtx2, err := infoS.Namespace(c2, "ns")
+			assert.Loosely(t, err, should.BeNil)
+			got := &Ent{Kind: "Ent", ID: "in-ns"}
+			assert.Loosely(t, ds.Get(nsCtx2, got), should.BeNil)
+			assert.Loosely(t, got, should.Match(nsEnt))
+
+			// Not in the default namespace.
+			assert.Loosely(t, ds.Get(c2, &Ent{Kind: "Ent", ID: "in-ns"}), should.Equal(ds.ErrNoSuchEntity))
+		})
+
+		t.Run("Composite indexes", func(t *ftt.Test) {
+			var got []*Ent
+			q := ds.NewQuery("Ent").Ancestor(root).Eq("Tags", "a").Order("-Int")
+			assert.Loosely(t, ds.GetAll(c2, q, &got), should.BeNil)
+			assert.Loosely(t, got, should.Match([]*Ent{ents[1], ents[0]}))
+		})
+
+		t.Run("Export of the import is the same", func(t *ftt.Test) {
+			dir2 := t.TempDir()
+			assert.Loosely(t, ExportDatastore(c2, dir2), should.BeNil)
+			idx1, err := export.ReadIndexes(dir)
+			assert.Loosely(t, err, should.BeNil)
+			idx2, err := export.ReadIndexes(dir2)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, idx2, should.Match(idx1))
+			assert.Loosely(t, idx1, should.Match([]*ds.IndexDefinition{idx}))
+		})
+	})
+}
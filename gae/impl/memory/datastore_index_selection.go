@@ -42,6 +42,29 @@ func (e *ErrMissingIndex) Error() string {
 		"Insufficient indexes. Consider adding:\n%s", yaml)
 }
 
+// MissingIndex returns a composite index the query needs, if the builtin
+// indexes and the given composite indexes are not sufficient to run it.
+//
+// Returns nil if the query can run using existing indexes. Uses the same logic
+// as the datastore implementation in this package, see ErrMissingIndex.
+func MissingIndex(kc ds.KeyContext, fq *ds.FinalizedQuery, compIdx []*ds.IndexDefinition) (*ds.IndexDefinition, error) {
+	q, err := reduce(fq, kc, false)
+	if err != nil {
+		return nil, err
+	}
+	if q.kind == "" {
+		// Kindless queries always use the builtin key index.
+		return nil, nil
+	}
+	s := newMemStore()
+	addIndexes(s, kc.AppID, compIdx)
+	_, err = getRelevantIndexes(q, s.Snapshot())
+	if mi, ok := err.(*ErrMissingIndex); ok {
+		return mi.Missing, nil
+	}
+	return nil, err
+}
+
 // reducedQuery contains only the pieces of the query necessary to iterate for
 // results.
 //
@@ -313,9 +336,7 @@ func getRelevantIndexes(q *reducedQuery, s memStore) (indexDefinitionSortableSli
 			Ancestor: q.eqFilters["__ancestor__"] != nil,
 		}
 		terms := missingTerms.ToSlice()
-		if serializationDeterministic {
-			sort.Strings(terms)
-		}
+		sort.Strings(terms)
 		for _, term := range terms {
 			remains.SortBy = append(remains.SortBy, ds.IndexColumn{Property: term})
 		}
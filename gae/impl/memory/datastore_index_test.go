@@ -392,3 +392,41 @@ func TestUpdateIndexes(t *testing.T) {
 		}
 	})
 }
+
+func TestMissingIndex(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("MissingIndex", t, func(t *ftt.Test) {
+		kc := ds.MkKeyContext("dev~app", "")
+		fq := func(q *ds.Query) *ds.FinalizedQuery {
+			f, err := q.Finalize()
+			assert.Loosely(t, err, should.BeNil)
+			return f
+		}
+		want := &ds.IndexDefinition{
+			Kind: "Foo",
+			SortBy: []ds.IndexColumn{
+				{Property: "A"},
+				{Property: "B", Descending: true},
+			},
+		}
+
+		t.Run("builtin indexes", func(t *ftt.Test) {
+			idx, err := MissingIndex(kc, fq(ds.NewQuery("Foo").Eq("A", 1)), nil)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, idx, should.BeNil)
+		})
+
+		t.Run("missing", func(t *ftt.Test) {
+			idx, err := MissingIndex(kc, fq(ds.NewQuery("Foo").Eq("A", 1).Order("-B")), nil)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, idx.Equal(want), should.BeTrue)
+		})
+
+		t.Run("present", func(t *ftt.Test) {
+			idx, err := MissingIndex(kc, fq(ds.NewQuery("Foo").Eq("A", 1).Order("-B")), []*ds.IndexDefinition{want})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, idx, should.BeNil)
+		})
+	})
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go.chromium.org/luci/common/data/cmpbin"
+	"go.chromium.org/luci/common/errors"
+)
+
+// persistMagic is the header of the datastore snapshot format.
+//
+// Bump the version suffix when changing the format or the schema of the memory
+// store (see README.md).
+const persistMagic = "luci/gae/impl/memory datastore snapshot v1"
+
+// SaveDatastore writes a snapshot of the datastore in the context to `w`.
+//
+// The snapshot contains everything stored in the datastore: entities, compound
+// index definitions, index rows, entity group versions and ID allocation state.
+// It can be restored with LoadDatastore.
+//
+// The context must be produced by Use or UseWithAppID and must not be
+// transactional. It is fine to use the datastore concurrently: the snapshot
+// is taken atomically.
+func SaveDatastore(c context.Context, w io.Writer) error {
+	data, err := persistableData(c)
+	if err != nil {
+		return err
+	}
+	snap := data.takeSnapshot()
+
+	buf := bufio.NewWriter(w)
+	if _, err := cmpbin.WriteString(buf, persistMagic); err != nil {
+		return errors.Annotate(err, "writing header").Err()
+	}
+	if _, err := cmpbin.WriteString(buf, data.aid); err != nil {
+		return errors.Annotate(err, "writing app ID").Err()
+	}
+
+	names := snap.GetCollectionNames()
+	sort.Strings(names)
+	if _, err := cmpbin.WriteUint(buf, uint64(len(names))); err != nil {
+		return errors.Annotate(err, "writing collection count").Err()
+	}
+	for _, name := range names {
+		if err := saveCollection(buf, snap.GetCollection(name)); err != nil {
+			return errors.Annotate(err, "writing collection %q", name).Err()
+		}
+	}
+	return buf.Flush()
+}
+
+// LoadDatastore replaces the content of the datastore in the context with
+// a snapshot produced by SaveDatastore.
+//
+// The snapshot must be produced for the same app ID, since the app ID is a part
+// of all stored keys.
+//
+// Settings of the datastore (e.g. Consistent or AutoIndex) are not part of the
+// snapshot and are left unchanged. If the datastore is not always consistent,
+// indexes are caught up with the loaded data.
+func LoadDatastore(c context.Context, r io.Reader) error {
+	data, err := persistableData(c)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewReader(r)
+	switch magic, _, err := cmpbin.ReadString(buf); {
+	case err != nil:
+		return errors.Annotate(err, "reading header").Err()
+	case magic != persistMagic:
+		return errors.Reason("not a datastore snapshot or unsupported version").Err()
+	}
+	switch aid, _, err := cmpbin.ReadString(buf); {
+	case err != nil:
+		return errors.Annotate(err, "reading app ID").Err()
+	case aid != data.aid:
+		return errors.Reason("the snapshot is for app %q, but the datastore is for %q", aid, data.aid).Err()
+	}
+
+	count, _, err := cmpbin.ReadUint(buf)
+	if err != nil {
+		return errors.Annotate(err, "reading collection count").Err()
+	}
+	head := newMemStore()
+	for i := uint64(0); i < count; i++ {
+		if err := loadCollection(buf, head); err != nil {
+			return errors.Annotate(err, "reading collection #%d", i).Err()
+		}
+	}
+
+	data.rwlock.Lock()
+	defer data.rwlock.Unlock()
+	data.head = head
+	if data.snap != nil {
+		data.snap = head.Snapshot()
+	}
+	return nil
+}
+
+// SaveDatastoreFile writes a snapshot of the datastore in the context into
+// a file.
+//
+// The file is replaced atomically: if the process crashes midway, the previous
+// snapshot (if any) stays intact.
+func SaveDatastoreFile(c context.Context, path string) (err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+	if err := SaveDatastore(c, f); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// LoadDatastoreFile loads a snapshot produced by SaveDatastoreFile.
+//
+// If the file doesn't exist, returns an error that matches os.ErrNotExist and
+// leaves the datastore untouched.
+func LoadDatastoreFile(c context.Context, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return errors.Annotate(LoadDatastore(c, f), "loading %s", path).Err()
+}
+
+// persistableData returns the datastore state in the context.
+func persistableData(c context.Context) (*dataStoreData, error) {
+	mc, ok := c.Value(&memContextKey).(memContext)
+	if !ok {
+		return nil, errors.New("not a context produced by memory.Use")
+	}
+	if c.Value(&currentTxnKey) != nil {
+		return nil, errors.New("can't persist the datastore inside a transaction")
+	}
+	return mc.Get(memContextDSIdx).(*dataStoreData), nil
+}
+
+func saveCollection(w *bufio.Writer, coll memCollection) error {
+	var items [][]byte
+	coll.ForEachItem(func(k, v []byte) bool {
+		items = append(items, k, v)
+		return true
+	})
+	if _, err := cmpbin.WriteString(w, coll.Name()); err != nil {
+		return err
+	}
+	if _, err := cmpbin.WriteUint(w, uint64(len(items)/2)); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if _, err := cmpbin.WriteBytes(w, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadCollection(r *bufio.Reader, store memStore) error {
+	name, _, err := cmpbin.ReadString(r)
+	if err != nil {
+		return err
+	}
+	count, _, err := cmpbin.ReadUint(r)
+	if err != nil {
+		return err
+	}
+	coll := store.GetOrCreateCollection(name)
+	for i := uint64(0); i < count; i++ {
+		k, _, err := cmpbin.ReadBytes(r)
+		if err != nil {
+			return err
+		}
+		v, _, err := cmpbin.ReadBytes(r)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			v = []byte{} // index rows have empty, but non-nil values
+		}
+		coll.Set(k, v)
+	}
+	return nil
+}
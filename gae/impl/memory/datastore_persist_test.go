@@ -0,0 +1,150 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+func TestPersistence(t *testing.T) {
+	t.Parallel()
+
+	type Ent struct {
+		ID     int64   `gae:"$id"`
+		Parent *ds.Key `gae:"$parent"`
+		Name   string
+		Val    int64
+	}
+
+	ftt.Run("With a populated datastore", t, func(t *ftt.Test) {
+		c := Use(context.Background())
+		ds.GetTestable(c).AddIndexes(&ds.IndexDefinition{
+			Kind: "Ent",
+			SortBy: []ds.IndexColumn{
+				{Property: "Name"},
+				{Property: "Val", Descending: true},
+			},
+		})
+		ds.GetTestable(c).CatchupIndexes()
+
+		root := &Ent{Name: "root", Val: 1}
+		assert.Loosely(t, ds.Put(c, root), should.BeNil)
+		rootKey := ds.KeyForObj(c, root)
+		children := []*Ent{
+			{Parent: rootKey, Name: "child", Val: 1},
+			{Parent: rootKey, Name: "child", Val: 2},
+		}
+		assert.Loosely(t, ds.Put(c, children), should.BeNil)
+		assert.Loosely(t, ds.Delete(c, children[0]), should.BeNil)
+		ds.GetTestable(c).CatchupIndexes()
+
+		version := testGetMeta(c, rootKey)
+
+		restored := func(t testing.TB) context.Context {
+			buf := bytes.Buffer{}
+			assert.Loosely(t, SaveDatastore(c, &buf), should.BeNil)
+			c2 := Use(context.Background())
+			assert.Loosely(t, LoadDatastore(c2, &buf), should.BeNil)
+			return c2
+		}
+
+		t.Run("Entities survive", func(t *ftt.Test) {
+			c2 := restored(t)
+
+			got := &Ent{ID: root.ID}
+			assert.Loosely(t, ds.Get(c2, got), should.BeNil)
+			assert.Loosely(t, got, should.Match(root))
+
+			got = &Ent{ID: children[1].ID, Parent: rootKey}
+			assert.Loosely(t, ds.Get(c2, got), should.BeNil)
+			assert.Loosely(t, got, should.Match(children[1]))
+
+			got = &Ent{ID: children[0].ID, Parent: rootKey}
+			assert.Loosely(t, ds.Get(c2, got), should.Equal(ds.ErrNoSuchEntity))
+		})
+
+		t.Run("Entity groups survive", func(t *ftt.Test) {
+			c2 := restored(t)
+			assert.Loosely(t, testGetMeta(c2, rootKey), should.Equal(version))
+
+			// IDs are not reused.
+			ent := &Ent{Parent: rootKey, Name: "child", Val: 3}
+			assert.Loosely(t, ds.Put(c2, ent), should.BeNil)
+			assert.Loosely(t, ent.ID, should.BeGreaterThan(children[1].ID))
+			ent = &Ent{Name: "another root"}
+			assert.Loosely(t, ds.Put(c2, ent), should.BeNil)
+			assert.Loosely(t, ent.ID, should.BeGreaterThan(root.ID))
+		})
+
+		t.Run("Indexes survive", func(t *ftt.Test) {
+			c2 := restored(t)
+			var ents []*Ent
+			q := ds.NewQuery("Ent").Eq("Name", "child").Order("-Val")
+			assert.Loosely(t, ds.GetAll(c2, q, &ents), should.BeNil)
+			assert.Loosely(t, ents, should.Match([]*Ent{children[1]}))
+		})
+
+		t.Run("Via a file", func(t *ftt.Test) {
+			path := filepath.Join(t.TempDir(), "datastore.snapshot")
+
+			c2 := Use(context.Background())
+			err := LoadDatastoreFile(c2, path)
+			assert.Loosely(t, errors.Is(err, os.ErrNotExist), should.BeTrue)
+
+			assert.Loosely(t, SaveDatastoreFile(c, path), should.BeNil)
+			assert.Loosely(t, LoadDatastoreFile(c2, path), should.BeNil)
+			got := &Ent{ID: root.ID}
+			assert.Loosely(t, ds.Get(c2, got), should.BeNil)
+			assert.Loosely(t, got, should.Match(root))
+
+			// Overwrites the existing snapshot.
+			assert.Loosely(t, ds.Delete(c, root), should.BeNil)
+			assert.Loosely(t, SaveDatastoreFile(c, path), should.BeNil)
+			assert.Loosely(t, LoadDatastoreFile(c2, path), should.BeNil)
+			assert.Loosely(t, ds.Get(c2, got), should.Equal(ds.ErrNoSuchEntity))
+		})
+
+		t.Run("Rejects a snapshot of another app", func(t *ftt.Test) {
+			buf := bytes.Buffer{}
+			assert.Loosely(t, SaveDatastore(c, &buf), should.BeNil)
+			c2 := UseWithAppID(context.Background(), "dev~another")
+			err := LoadDatastore(c2, &buf)
+			assert.Loosely(t, err, should.ErrLike(`the snapshot is for app "dev~app"`))
+		})
+
+		t.Run("Rejects garbage", func(t *ftt.Test) {
+			err := LoadDatastore(c, bytes.NewBufferString("garbage"))
+			assert.Loosely(t, err, should.NotBeNil)
+		})
+
+		t.Run("Refuses to work in a transaction", func(t *ftt.Test) {
+			err := ds.RunInTransaction(c, func(c context.Context) error {
+				return SaveDatastore(c, &bytes.Buffer{})
+			}, nil)
+			assert.Loosely(t, err, should.ErrLike("inside a transaction"))
+		})
+	})
+}
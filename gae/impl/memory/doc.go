@@ -16,6 +16,13 @@
 // backs to local memory ONLY. This is useful for unittesting, and is also used
 // for the nested-transaction filter implementation.
 //
+// # Persistence
+//
+// The datastore state is lost when the process exits. Local dev servers that
+// want to keep it across restarts can use SaveDatastoreFile on exit (or
+// periodically) and LoadDatastoreFile on start. Snapshots include entities,
+// indexes, entity group versions and ID allocation state.
+//
 // # Debug EnvVars
 //
 // To debug backend store memory access for a binary that uses this memory
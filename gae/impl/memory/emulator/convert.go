@@ -0,0 +1,258 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// keyFromProto converts a key in the given project to *ds.Key.
+//
+// The key may be incomplete only if allowIncomplete is true.
+func keyFromProto(project string, k *pb.Key, allowIncomplete bool) (*ds.Key, error) {
+	if k == nil || len(k.Path) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "a key must have a non-empty path")
+	}
+	var ns string
+	if p := k.PartitionId; p != nil {
+		if p.ProjectId != "" && p.ProjectId != project {
+			return nil, status.Errorf(codes.InvalidArgument, "key belongs to project %q, not %q", p.ProjectId, project)
+		}
+		if p.DatabaseId != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "only the default database is supported")
+		}
+		ns = p.NamespaceId
+	}
+	toks := make([]ds.KeyTok, len(k.Path))
+	for i, el := range k.Path {
+		if el.Kind == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "key path element #%d has no kind", i)
+		}
+		toks[i] = ds.KeyTok{Kind: el.Kind, IntID: el.GetId(), StringID: el.GetName()}
+		if toks[i].IsIncomplete() && (i != len(k.Path)-1 || !allowIncomplete) {
+			return nil, status.Errorf(codes.InvalidArgument, "key path element #%d is incomplete", i)
+		}
+	}
+	return ds.MkKeyContext(project, ns).NewKeyToks(toks), nil
+}
+
+// keyToProto converts a key to a key in the given project.
+func keyToProto(project string, k *ds.Key) *pb.Key {
+	_, ns, toks := k.Split()
+	path := make([]*pb.Key_PathElement, len(toks))
+	for i, tok := range toks {
+		path[i] = &pb.Key_PathElement{Kind: tok.Kind}
+		switch {
+		case tok.StringID != "":
+			path[i].IdType = &pb.Key_PathElement_Name{Name: tok.StringID}
+		case tok.IntID != 0:
+			path[i].IdType = &pb.Key_PathElement_Id{Id: tok.IntID}
+		}
+	}
+	return &pb.Key{
+		PartitionId: &pb.PartitionId{ProjectId: project, NamespaceId: ns},
+		Path:        path,
+	}
+}
+
+// entityFromProto converts an entity to its key and properties.
+//
+// The key is nil if the entity doesn't have one, which is allowed only for
+// embedded entities.
+func entityFromProto(project string, ent *pb.Entity, allowIncomplete bool) (*ds.Key, ds.PropertyMap, error) {
+	var key *ds.Key
+	if ent.Key != nil {
+		var err error
+		if key, err = keyFromProto(project, ent.Key, allowIncomplete); err != nil {
+			return nil, nil, err
+		}
+	}
+	pm := make(ds.PropertyMap, len(ent.Properties))
+	for name, val := range ent.Properties {
+		if name == "" || strings.HasPrefix(name, "$") || (strings.HasPrefix(name, "__") && strings.HasSuffix(name, "__")) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "property name %q is not allowed", name)
+		}
+		pdata, err := valueFromProto(project, val, true)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "property %q: %s", name, status.Convert(err).Message())
+		}
+		pm[name] = pdata
+	}
+	return key, pm, nil
+}
+
+// entityToProto converts an entity to the proto form.
+//
+// Meta properties (starting with "$") are skipped. The key may be nil.
+func entityToProto(project string, key *ds.Key, pm ds.PropertyMap) (*pb.Entity, error) {
+	names := make([]string, 0, len(pm))
+	for name := range pm {
+		if !strings.HasPrefix(name, "$") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	ent := &pb.Entity{Properties: make(map[string]*pb.Value, len(names))}
+	if key != nil {
+		ent.Key = keyToProto(project, key)
+	}
+	for _, name := range names {
+		val, err := propertyDataToProto(project, pm[name])
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", name, err)
+		}
+		ent.Properties[name] = val
+	}
+	return ent, nil
+}
+
+// valueFromProto converts a value to ds.Property or, for arrays, to
+// ds.PropertySlice.
+func valueFromProto(project string, v *pb.Value, allowArray bool) (ds.PropertyData, error) {
+	var val any
+	switch t := v.GetValueType().(type) {
+	case nil, *pb.Value_NullValue:
+		val = nil
+	case *pb.Value_BooleanValue:
+		val = t.BooleanValue
+	case *pb.Value_IntegerValue:
+		val = t.IntegerValue
+	case *pb.Value_DoubleValue:
+		val = t.DoubleValue
+	case *pb.Value_TimestampValue:
+		val = t.TimestampValue.AsTime()
+	case *pb.Value_StringValue:
+		val = t.StringValue
+	case *pb.Value_BlobValue:
+		val = t.BlobValue
+	case *pb.Value_GeoPointValue:
+		val = ds.GeoPoint{Lat: t.GeoPointValue.GetLatitude(), Lng: t.GeoPointValue.GetLongitude()}
+	case *pb.Value_KeyValue:
+		key, err := keyFromProto(project, t.KeyValue, false)
+		if err != nil {
+			return nil, err
+		}
+		val = key
+	case *pb.Value_EntityValue:
+		key, pm, err := entityFromProto(project, t.EntityValue, true)
+		if err != nil {
+			return nil, err
+		}
+		if key != nil {
+			ds.PopulateKey(pm, key)
+		}
+		val = pm
+	case *pb.Value_ArrayValue:
+		if !allowArray {
+			return nil, status.Errorf(codes.InvalidArgument, "arrays cannot contain arrays")
+		}
+		if v.ExcludeFromIndexes {
+			return nil, status.Errorf(codes.InvalidArgument, "exclude_from_indexes must be set on array elements instead of the array")
+		}
+		slice := make(ds.PropertySlice, len(t.ArrayValue.Values))
+		for i, elem := range t.ArrayValue.Values {
+			prop, err := valueFromProto(project, elem, false)
+			if err != nil {
+				return nil, err
+			}
+			slice[i] = prop.(ds.Property)
+		}
+		return slice, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported value type %T", t)
+	}
+
+	indexSetting := ds.ShouldIndex
+	if v.ExcludeFromIndexes {
+		indexSetting = ds.NoIndex
+	}
+	var prop ds.Property
+	if err := prop.SetValue(val, indexSetting); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	return prop, nil
+}
+
+// propertyDataToProto converts ds.Property or ds.PropertySlice to a value.
+func propertyDataToProto(project string, pdata ds.PropertyData) (*pb.Value, error) {
+	switch t := pdata.(type) {
+	case ds.Property:
+		return propertyToProto(project, t)
+	case ds.PropertySlice:
+		vals := make([]*pb.Value, len(t))
+		for i, prop := range t {
+			var err error
+			if vals[i], err = propertyToProto(project, prop); err != nil {
+				return nil, err
+			}
+		}
+		return &pb.Value{ValueType: &pb.Value_ArrayValue{ArrayValue: &pb.ArrayValue{Values: vals}}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported PropertyData type %T", pdata)
+	}
+}
+
+// propertyToProto converts a single ds.Property to a value.
+func propertyToProto(project string, prop ds.Property) (*pb.Value, error) {
+	v := &pb.Value{ExcludeFromIndexes: prop.IndexSetting() == ds.NoIndex}
+	switch val := prop.Value().(type) {
+	case nil:
+		v.ValueType = &pb.Value_NullValue{NullValue: structpb.NullValue_NULL_VALUE}
+	case bool:
+		v.ValueType = &pb.Value_BooleanValue{BooleanValue: val}
+	case int64:
+		v.ValueType = &pb.Value_IntegerValue{IntegerValue: val}
+	case float64:
+		v.ValueType = &pb.Value_DoubleValue{DoubleValue: val}
+	case time.Time:
+		v.ValueType = &pb.Value_TimestampValue{TimestampValue: timestamppb.New(val)}
+	case string:
+		v.ValueType = &pb.Value_StringValue{StringValue: val}
+	case []byte:
+		v.ValueType = &pb.Value_BlobValue{BlobValue: val}
+	case ds.GeoPoint:
+		v.ValueType = &pb.Value_GeoPointValue{GeoPointValue: &latlng.LatLng{Latitude: val.Lat, Longitude: val.Lng}}
+	case *ds.Key:
+		v.ValueType = &pb.Value_KeyValue{KeyValue: keyToProto(project, val)}
+	case ds.PropertyMap:
+		var key *ds.Key
+		kc := ds.MkKeyContext(project, "")
+		if k, _ := kc.NewKeyFromMeta(val); k != nil && !k.IsIncomplete() {
+			key = k
+		}
+		ent, err := entityToProto(project, key, val)
+		if err != nil {
+			return nil, err
+		}
+		v.ValueType = &pb.Value_EntityValue{EntityValue: ent}
+	default:
+		return nil, fmt.Errorf("unsupported property type %T", val)
+	}
+	return v, nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulator
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// queryBatchSize is the maximum number of entities returned by RunQuery.
+const queryBatchSize = 300
+
+// RunQuery implements pb.DatastoreServer.
+func (s *Server) RunQuery(ctx context.Context, req *pb.RunQueryRequest) (*pb.RunQueryResponse, error) {
+	q := req.GetQuery()
+	switch {
+	case q == nil:
+		return nil, status.Errorf(codes.Unimplemented, "GQL queries are not supported")
+	case req.PropertyMask != nil:
+		return nil, status.Errorf(codes.Unimplemented, "property masks are not supported")
+	case req.ExplainOptions != nil:
+		return nil, status.Errorf(codes.Unimplemented, "explain options are not supported")
+	}
+	if p := req.PartitionId; p != nil {
+		if p.ProjectId != "" && p.ProjectId != req.ProjectId {
+			return nil, status.Errorf(codes.InvalidArgument, "partition belongs to project %q, not %q", p.ProjectId, req.ProjectId)
+		}
+		if p.DatabaseId != req.DatabaseId {
+			return nil, status.Errorf(codes.InvalidArgument, "partition database doesn't match the request database")
+		}
+	}
+
+	dctx, txn, err := s.readContext(req.ProjectId, req.DatabaseId, req.ReadOptions)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.RunQueryResponse{Query: q}
+	if txn != nil {
+		txn.m.Lock()
+		defer txn.m.Unlock()
+		if _, ok := req.ReadOptions.GetConsistencyType().(*pb.ReadOptions_NewTransaction); ok {
+			resp.Transaction = []byte(txn.id)
+		}
+	}
+	dctx = withNamespace(dctx, req.PartitionId.GetNamespaceId())
+	raw := ds.Raw(dctx)
+
+	dq, err := queryFromProto(raw, req.ProjectId, q)
+	if err != nil {
+		return nil, err
+	}
+	if req.ReadOptions.GetReadConsistency() == pb.ReadOptions_EVENTUAL {
+		dq = dq.EventualConsistency(true)
+	}
+	fq, err := dq.Finalize()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad query: %s", err)
+	}
+	if resp.Batch, err = runQuery(raw, req.ProjectId, fq, q); err != nil {
+		return nil, grpcErr(err)
+	}
+	return resp, nil
+}
+
+// queryFromProto converts a query to *ds.Query, ignoring its offset and limit.
+func queryFromProto(raw ds.RawInterface, project string, q *pb.Query) (*ds.Query, error) {
+	if len(q.Kind) > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "at most one kind is supported")
+	}
+	var kind string
+	if len(q.Kind) == 1 {
+		kind = q.Kind[0].Name
+	}
+	dq := ds.NewQuery(kind)
+
+	var err error
+	if dq, err = addFilter(dq, project, q.Filter); err != nil {
+		return nil, err
+	}
+
+	for _, o := range q.Order {
+		prop := o.GetProperty().GetName()
+		if o.Direction == pb.PropertyOrder_DESCENDING {
+			prop = "-" + prop
+		}
+		dq = dq.Order(prop)
+	}
+
+	proj := make([]string, len(q.Projection))
+	for i, p := range q.Projection {
+		proj[i] = p.GetProperty().GetName()
+	}
+	switch {
+	case len(proj) == 1 && proj[0] == "__key__":
+		dq = dq.KeysOnly(true)
+	case len(proj) > 0:
+		dq = dq.Project(proj...)
+	}
+
+	if len(q.DistinctOn) > 0 {
+		distinct := make(map[string]bool, len(q.DistinctOn))
+		for _, p := range q.DistinctOn {
+			distinct[p.GetName()] = true
+		}
+		if len(distinct) != len(proj) {
+			return nil, status.Errorf(codes.Unimplemented, "distinct_on must match the projection")
+		}
+		for _, p := range proj {
+			if !distinct[p] {
+				return nil, status.Errorf(codes.Unimplemented, "distinct_on must match the projection")
+			}
+		}
+		dq = dq.Distinct(true)
+	}
+
+	if len(q.StartCursor) > 0 {
+		cur, err := raw.DecodeCursor(string(q.StartCursor))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad start cursor: %s", err)
+		}
+		dq = dq.Start(cur)
+	}
+	if len(q.EndCursor) > 0 {
+		cur, err := raw.DecodeCursor(string(q.EndCursor))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad end cursor: %s", err)
+		}
+		dq = dq.End(cur)
+	}
+	return dq, nil
+}
+
+// addFilter adds a filter to the query.
+func addFilter(dq *ds.Query, project string, f *pb.Filter) (*ds.Query, error) {
+	switch ft := f.GetFilterType().(type) {
+	case nil:
+		return dq, nil
+
+	case *pb.Filter_CompositeFilter:
+		if ft.CompositeFilter.Op != pb.CompositeFilter_AND {
+			return nil, status.Errorf(codes.Unimplemented, "only AND composite filters are supported")
+		}
+		for _, sub := range ft.CompositeFilter.Filters {
+			var err error
+			if dq, err = addFilter(dq, project, sub); err != nil {
+				return nil, err
+			}
+		}
+		return dq, nil
+
+	case *pb.Filter_PropertyFilter:
+		pf := ft.PropertyFilter
+		prop := pf.GetProperty().GetName()
+
+		if pf.Op == pb.PropertyFilter_IN {
+			arr := pf.Value.GetArrayValue()
+			if arr == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "IN filter on %q requires an array value", prop)
+			}
+			if len(arr.Values) != 1 {
+				return nil, status.Errorf(codes.Unimplemented, "IN filters with more than one value are not supported")
+			}
+			vals := make([]any, len(arr.Values))
+			for i, v := range arr.Values {
+				pdata, err := valueFromProto(project, v, false)
+				if err != nil {
+					return nil, err
+				}
+				p := pdata.(ds.Property)
+				vals[i] = p.Value()
+			}
+			return dq.In(prop, vals...), nil
+		}
+
+		pdata, err := valueFromProto(project, pf.Value, false)
+		if err != nil {
+			return nil, err
+		}
+		p := pdata.(ds.Property)
+		val := p.Value()
+
+		switch pf.Op {
+		case pb.PropertyFilter_LESS_THAN:
+			return dq.Lt(prop, val), nil
+		case pb.PropertyFilter_LESS_THAN_OR_EQUAL:
+			return dq.Lte(prop, val), nil
+		case pb.PropertyFilter_GREATER_THAN:
+			return dq.Gt(prop, val), nil
+		case pb.PropertyFilter_GREATER_THAN_OR_EQUAL:
+			return dq.Gte(prop, val), nil
+		case pb.PropertyFilter_EQUAL:
+			return dq.Eq(prop, val), nil
+		case pb.PropertyFilter_HAS_ANCESTOR:
+			key, ok := val.(*ds.Key)
+			if prop != "__key__" || !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "HAS_ANCESTOR filter requires a key value and the __key__ property")
+			}
+			return dq.Ancestor(key), nil
+		default:
+			return nil, status.Errorf(codes.Unimplemented, "filter operator %s is not supported", pf.Op)
+		}
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported filter %T", ft)
+	}
+}
+
+// runQuery runs the query, returning one batch of results.
+//
+// Applies the offset and limit of the original query `q`.
+func runQuery(raw ds.RawInterface, project string, fq *ds.FinalizedQuery, q *pb.Query) (*pb.QueryResultBatch, error) {
+	batch := &pb.QueryResultBatch{
+		EntityResultType: pb.EntityResult_FULL,
+		EndCursor:        q.StartCursor,
+		MoreResults:      pb.QueryResultBatch_NO_MORE_RESULTS,
+	}
+	switch {
+	case fq.KeysOnly():
+		batch.EntityResultType = pb.EntityResult_KEY_ONLY
+	case len(fq.Project()) > 0:
+		batch.EntityResultType = pb.EntityResult_PROJECTION
+	}
+
+	want, limited := queryBatchSize, false
+	if q.Limit != nil && int(q.Limit.Value) <= queryBatchSize {
+		want, limited = int(q.Limit.Value), true
+	}
+
+	err := raw.Run(fq, func(key *ds.Key, pm ds.PropertyMap, getCursor ds.CursorCB) error {
+		if batch.SkippedResults < q.Offset {
+			cur, err := getCursor()
+			if err != nil {
+				return err
+			}
+			batch.SkippedResults++
+			batch.SkippedCursor = []byte(cur.String())
+			batch.EndCursor = batch.SkippedCursor
+			return nil
+		}
+		if len(batch.EntityResults) == want {
+			if limited {
+				batch.MoreResults = pb.QueryResultBatch_MORE_RESULTS_AFTER_LIMIT
+			} else {
+				batch.MoreResults = pb.QueryResultBatch_NOT_FINISHED
+			}
+			return ds.Stop
+		}
+		cur, err := getCursor()
+		if err != nil {
+			return err
+		}
+		ent, err := entityToProto(project, key, pm)
+		if err != nil {
+			return err
+		}
+		batch.EndCursor = []byte(cur.String())
+		batch.EntityResults = append(batch.EntityResults, &pb.EntityResult{
+			Entity: ent,
+			Cursor: batch.EndCursor,
+		})
+		return nil
+	})
+	if err != nil && err != ds.Stop {
+		return nil, err
+	}
+	return batch, nil
+}
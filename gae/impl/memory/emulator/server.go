@@ -0,0 +1,295 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package emulator serves gae/impl/memory datastore over the public
+// google.datastore.v1 gRPC API, i.e. it is a replacement for the Cloud
+// Datastore emulator.
+//
+// This allows non-Go code (e.g. Python or TypeScript services in integration
+// tests) to share the same datastore implementation with Go code.
+//
+// Supported RPCs are Lookup, RunQuery, BeginTransaction, Commit, Rollback and
+// AllocateIds. Known limitations:
+//   - Only the default database is supported.
+//   - GQL queries, OR, NOT_EQUAL and NOT_IN filters are not supported.
+//   - IN filters are supported only with a single value.
+//   - Entity versions, read times and property masks are not supported.
+//   - Aggregation queries and ReserveIds are not supported.
+package emulator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+)
+
+// Server implements pb.DatastoreServer on top of a datastore implementation.
+type Server struct {
+	pb.UnimplementedDatastoreServer
+
+	// Datastore returns a context with a datastore implementation for the given
+	// project.
+	//
+	// The returned context is used beyond the lifetime of the RPC, e.g. to run
+	// transactions. Usually it is produced by Memory. Required.
+	Datastore func(project string) (context.Context, error)
+
+	m    sync.Mutex
+	txns map[string]*transaction
+
+	// commitM makes non-transactional commits atomic relative to each other.
+	commitM sync.Mutex
+}
+
+// Memory returns a callback for Server.Datastore that lazily creates an
+// independent gae/impl/memory datastore per project, using the project ID as
+// the app ID.
+//
+// If setup is not nil, it is called once for each new datastore, e.g. to
+// configure it via datastore.GetTestable.
+func Memory(ctx context.Context, setup func(ctx context.Context, project string) error) func(project string) (context.Context, error) {
+	var m sync.Mutex
+	projects := map[string]context.Context{}
+	return func(project string) (context.Context, error) {
+		m.Lock()
+		defer m.Unlock()
+		if pctx := projects[project]; pctx != nil {
+			return pctx, nil
+		}
+		pctx := memory.UseWithAppID(ctx, project)
+		if setup != nil {
+			if err := setup(pctx, project); err != nil {
+				return nil, err
+			}
+		}
+		projects[project] = pctx
+		return pctx, nil
+	}
+}
+
+// Lookup implements pb.DatastoreServer.
+func (s *Server) Lookup(ctx context.Context, req *pb.LookupRequest) (*pb.LookupResponse, error) {
+	if req.PropertyMask != nil {
+		return nil, status.Errorf(codes.Unimplemented, "property masks are not supported")
+	}
+	keys := make([]*ds.Key, len(req.Keys))
+	for i, k := range req.Keys {
+		var err error
+		if keys[i], err = keyFromProto(req.ProjectId, k, false); err != nil {
+			return nil, err
+		}
+	}
+
+	dctx, txn, err := s.readContext(req.ProjectId, req.DatabaseId, req.ReadOptions)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.LookupResponse{}
+	if txn != nil {
+		txn.m.Lock()
+		defer txn.m.Unlock()
+		if _, ok := req.ReadOptions.GetConsistencyType().(*pb.ReadOptions_NewTransaction); ok {
+			resp.Transaction = []byte(txn.id)
+		}
+	}
+
+	err = byNamespace(keys, func(ns string, idx []int) error {
+		batch := make([]*ds.Key, len(idx))
+		for i, j := range idx {
+			batch[i] = keys[j]
+		}
+		var errs []error
+		err := ds.Raw(withNamespace(dctx, ns)).GetMulti(batch, nil, func(i int, pm ds.PropertyMap, err error) {
+			switch {
+			case errors.Is(err, ds.ErrNoSuchEntity):
+				resp.Missing = append(resp.Missing, &pb.EntityResult{
+					Entity: &pb.Entity{Key: keyToProto(req.ProjectId, batch[i])},
+				})
+			case err != nil:
+				errs = append(errs, err)
+			default:
+				ent, err := entityToProto(req.ProjectId, batch[i], pm)
+				if err != nil {
+					errs = append(errs, err)
+					return
+				}
+				resp.Found = append(resp.Found, &pb.EntityResult{Entity: ent})
+			}
+		})
+		if err == nil && len(errs) > 0 {
+			err = errs[0]
+		}
+		return err
+	})
+	if err != nil {
+		return nil, grpcErr(err)
+	}
+	return resp, nil
+}
+
+// AllocateIds implements pb.DatastoreServer.
+func (s *Server) AllocateIds(ctx context.Context, req *pb.AllocateIdsRequest) (*pb.AllocateIdsResponse, error) {
+	keys := make([]*ds.Key, len(req.Keys))
+	for i, k := range req.Keys {
+		var err error
+		if keys[i], err = keyFromProto(req.ProjectId, k, true); err != nil {
+			return nil, err
+		}
+		if !keys[i].IsIncomplete() {
+			return nil, status.Errorf(codes.InvalidArgument, "key #%d is complete", i)
+		}
+	}
+	dctx, err := s.datastore(req.ProjectId, req.DatabaseId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AllocateIdsResponse{Keys: make([]*pb.Key, len(keys))}
+	err = byNamespace(keys, func(ns string, idx []int) error {
+		batch := make([]*ds.Key, len(idx))
+		for i, j := range idx {
+			batch[i] = keys[j]
+		}
+		var errs []error
+		err := ds.Raw(withNamespace(dctx, ns)).AllocateIDs(batch, func(i int, key *ds.Key, err error) {
+			if err != nil {
+				errs = append(errs, err)
+			} else {
+				resp.Keys[idx[i]] = keyToProto(req.ProjectId, key)
+			}
+		})
+		if err == nil && len(errs) > 0 {
+			err = errs[0]
+		}
+		return err
+	})
+	if err != nil {
+		return nil, grpcErr(err)
+	}
+	return resp, nil
+}
+
+// datastore returns a datastore context for the given project and namespace.
+func (s *Server) datastore(project, database, namespace string) (context.Context, error) {
+	switch {
+	case project == "":
+		return nil, status.Errorf(codes.InvalidArgument, "project_id is required")
+	case database != "":
+		return nil, status.Errorf(codes.InvalidArgument, "only the default database is supported")
+	}
+	ctx, err := s.Datastore(project)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get the datastore for project %q: %s", project, err)
+	}
+	return withNamespace(ctx, namespace), nil
+}
+
+// readContext returns a datastore context to use for reads.
+//
+// If the reads happen in a transaction, returns it as well.
+func (s *Server) readContext(project, database string, opts *pb.ReadOptions) (context.Context, *transaction, error) {
+	switch c := opts.GetConsistencyType().(type) {
+	case nil, *pb.ReadOptions_ReadConsistency_:
+		ctx, err := s.datastore(project, database, "")
+		return ctx, nil, err
+	case *pb.ReadOptions_Transaction:
+		txn, err := s.transaction(project, c.Transaction, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		return txn.ctx, txn, nil
+	case *pb.ReadOptions_NewTransaction:
+		ctx, err := s.datastore(project, database, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		txn, err := s.begin(ctx, project, c.NewTransaction.GetReadOnly() != nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		return txn.ctx, txn, nil
+	default:
+		return nil, nil, status.Errorf(codes.Unimplemented, "read times are not supported")
+	}
+}
+
+// withNamespace returns a context with the given namespace.
+func withNamespace(ctx context.Context, ns string) context.Context {
+	if info.GetNamespace(ctx) == ns {
+		return ctx
+	}
+	return info.MustNamespace(ctx, ns)
+}
+
+// byNamespace calls cb for each group of keys sharing the same namespace.
+//
+// `idx` are the indexes of keys in the group.
+func byNamespace(keys []*ds.Key, cb func(ns string, idx []int) error) error {
+	var order []string
+	groups := map[string][]int{}
+	for i, k := range keys {
+		ns := k.Namespace()
+		if _, ok := groups[ns]; !ok {
+			order = append(order, ns)
+		}
+		groups[ns] = append(groups[ns], i)
+	}
+	for _, ns := range order {
+		if err := cb(ns, groups[ns]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newID returns a random transaction ID.
+func newID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// grpcErr converts a datastore error to a gRPC error.
+func grpcErr(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var missingIdx *memory.ErrMissingIndex
+	switch {
+	case errors.Is(err, ds.ErrNoSuchEntity):
+		return status.Errorf(codes.NotFound, "%s", err)
+	case errors.Is(err, ds.ErrConcurrentTransaction):
+		return status.Errorf(codes.Aborted, "too much contention on these datastore entities, please try again")
+	case ds.IsErrInvalidKey(err):
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	case errors.As(err, &missingIdx):
+		yaml, _ := missingIdx.Missing.YAMLString()
+		return status.Errorf(codes.FailedPrecondition, "no matching index found. recommended index is:\n%s", yaml)
+	default:
+		return status.Errorf(codes.Internal, "%s", err)
+	}
+}
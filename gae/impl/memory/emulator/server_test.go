@@ -0,0 +1,347 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+type Entity struct {
+	Name    string
+	Count   int64
+	Tags    []string
+	Created time.Time
+	Blob    []byte `datastore:",noindex"`
+	Ref     *datastore.Key
+	Nested  Nested
+}
+
+type Nested struct {
+	Value string
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With server", t, func(t *ftt.Test) {
+		ctx := context.Background()
+
+		srv := &Server{
+			Datastore: Memory(ctx, func(ctx context.Context, project string) error {
+				ds.GetTestable(ctx).Consistent(true)
+				ds.GetTestable(ctx).AutoIndex(true)
+				return nil
+			}),
+		}
+
+		lis := bufconn.Listen(1024 * 1024)
+		gsrv := grpc.NewServer()
+		pb.RegisterDatastoreServer(gsrv, srv)
+		go func() { _ = gsrv.Serve(lis) }()
+		t.Cleanup(gsrv.Stop)
+
+		conn, err := grpc.NewClient("passthrough:///ignored",
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		assert.Loosely(t, err, should.BeNil)
+		t.Cleanup(func() { _ = conn.Close() })
+
+		client, err := datastore.NewClient(ctx, "test-project", option.WithGRPCConn(conn))
+		assert.Loosely(t, err, should.BeNil)
+
+		created := time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+		t.Run("Put and Get", func(t *ftt.Test) {
+			key := datastore.NameKey("Entity", "a", nil)
+			ent := &Entity{
+				Name:    "a",
+				Count:   1,
+				Tags:    []string{"x", "y"},
+				Created: created,
+				Blob:    []byte("blob"),
+				Ref:     datastore.IDKey("Other", 123, nil),
+				Nested:  Nested{Value: "nested"},
+			}
+			_, err := client.Put(ctx, key, ent)
+			assert.Loosely(t, err, should.BeNil)
+
+			got := &Entity{}
+			assert.Loosely(t, client.Get(ctx, key, got), should.BeNil)
+			assert.Loosely(t, got.Name, should.Equal("a"))
+			assert.Loosely(t, got.Tags, should.Match([]string{"x", "y"}))
+			assert.Loosely(t, got.Created.Equal(created), should.BeTrue)
+			assert.Loosely(t, got.Blob, should.Match([]byte("blob")))
+			assert.Loosely(t, got.Ref.Equal(ent.Ref), should.BeTrue)
+			assert.Loosely(t, got.Nested.Value, should.Equal("nested"))
+
+			// Visible through the Go API too.
+			dctx, err := srv.Datastore("test-project")
+			assert.Loosely(t, err, should.BeNil)
+			pm := ds.PropertyMap{"$key": ds.MkPropertyNI(ds.MakeKey(dctx, "Entity", "a"))}
+			assert.Loosely(t, ds.Get(dctx, pm), should.BeNil)
+			assert.Loosely(t, pm.Slice("Tags"), should.HaveLength(2))
+
+			t.Run("Missing", func(t *ftt.Test) {
+				err := client.Get(ctx, datastore.NameKey("Entity", "missing", nil), got)
+				assert.Loosely(t, err, should.Equal(datastore.ErrNoSuchEntity))
+			})
+
+			t.Run("Delete", func(t *ftt.Test) {
+				assert.Loosely(t, client.Delete(ctx, key), should.BeNil)
+				assert.Loosely(t, client.Get(ctx, key, got), should.Equal(datastore.ErrNoSuchEntity))
+			})
+
+			t.Run("Insert and update", func(t *ftt.Test) {
+				_, err := client.Mutate(ctx, datastore.NewInsert(key, ent))
+				assert.Loosely(t, status.Code(errUnwrap(err)), should.Equal(codes.AlreadyExists))
+
+				_, err = client.Mutate(ctx, datastore.NewUpdate(datastore.NameKey("Entity", "b", nil), ent))
+				assert.Loosely(t, status.Code(errUnwrap(err)), should.Equal(codes.NotFound))
+			})
+		})
+
+		t.Run("Incomplete keys and AllocateIDs", func(t *ftt.Test) {
+			key, err := client.Put(ctx, datastore.IncompleteKey("Entity", nil), &Entity{Name: "auto"})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, key.ID, should.NotEqual(0))
+
+			keys, err := client.AllocateIDs(ctx, []*datastore.Key{
+				datastore.IncompleteKey("Entity", nil),
+				datastore.IncompleteKey("Entity", nil),
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, keys[0].ID, should.NotEqual(0))
+			assert.Loosely(t, keys[0].ID, should.NotEqual(keys[1].ID))
+		})
+
+		t.Run("Namespaces", func(t *ftt.Test) {
+			key := datastore.NameKey("Entity", "a", nil)
+			key.Namespace = "ns"
+			_, err := client.Put(ctx, key, &Entity{Name: "in ns"})
+			assert.Loosely(t, err, should.BeNil)
+
+			var got []*Entity
+			_, err = client.GetAll(ctx, datastore.NewQuery("Entity").Namespace("ns"), &got)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, got, should.HaveLength(1))
+			assert.Loosely(t, got[0].Name, should.Equal("in ns"))
+
+			got = nil
+			_, err = client.GetAll(ctx, datastore.NewQuery("Entity"), &got)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, got, should.BeEmpty)
+		})
+
+		t.Run("Queries", func(t *ftt.Test) {
+			parent := datastore.NameKey("Parent", "p", nil)
+			var keys []*datastore.Key
+			var ents []*Entity
+			for i := 0; i < 10; i++ {
+				keys = append(keys, datastore.IDKey("Entity", int64(i+1), parent))
+				ents = append(ents, &Entity{Name: fmt.Sprintf("e%d", i), Count: int64(i), Tags: []string{"all", fmt.Sprintf("t%d", i%2)}})
+			}
+			_, err := client.PutMulti(ctx, keys, ents)
+			assert.Loosely(t, err, should.BeNil)
+
+			names := func(q *datastore.Query) []string {
+				var got []*Entity
+				_, err := client.GetAll(ctx, q, &got)
+				assert.Loosely(t, err, should.BeNil, truth.LineContext())
+				out := make([]string, len(got))
+				for i, e := range got {
+					out[i] = e.Name
+				}
+				return out
+			}
+
+			t.Run("Filters and orders", func(t *ftt.Test) {
+				q := datastore.NewQuery("Entity").
+					FilterField("Tags", "=", "t1").
+					FilterField("Count", ">", 4).
+					Order("-Count")
+				assert.Loosely(t, names(q), should.Match([]string{"e9", "e7", "e5"}))
+			})
+
+			t.Run("IN filter", func(t *ftt.Test) {
+				q := datastore.NewQuery("Entity").FilterField("Count", "in", []any{3})
+				assert.Loosely(t, names(q), should.Match([]string{"e3"}))
+
+				_, err := client.GetAll(ctx, datastore.NewQuery("Entity").KeysOnly().FilterField("Count", "in", []any{1, 3}), nil)
+				assert.Loosely(t, status.Code(err), should.Equal(codes.Unimplemented))
+			})
+
+			t.Run("Ancestor", func(t *ftt.Test) {
+				q := datastore.NewQuery("Entity").Ancestor(parent).Limit(2)
+				assert.Loosely(t, names(q), should.Match([]string{"e0", "e1"}))
+			})
+
+			t.Run("Offset and limit", func(t *ftt.Test) {
+				q := datastore.NewQuery("Entity").Order("Count").Offset(3).Limit(2)
+				assert.Loosely(t, names(q), should.Match([]string{"e3", "e4"}))
+			})
+
+			t.Run("Keys only", func(t *ftt.Test) {
+				got, err := client.GetAll(ctx, datastore.NewQuery("Entity").KeysOnly().FilterField("Count", "<", 2), nil)
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, got, should.HaveLength(2))
+				assert.Loosely(t, got[0].Equal(keys[0]), should.BeTrue)
+			})
+
+			t.Run("Projection", func(t *ftt.Test) {
+				var got []*Entity
+				_, err := client.GetAll(ctx, datastore.NewQuery("Entity").Project("Count").FilterField("Count", ">=", 8), &got)
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, got, should.HaveLength(2))
+				assert.Loosely(t, got[0].Count, should.Equal(8))
+				assert.Loosely(t, got[0].Name, should.BeEmpty)
+			})
+
+			t.Run("Cursors", func(t *ftt.Test) {
+				it := client.Run(ctx, datastore.NewQuery("Entity").Order("Count").Limit(4))
+				for {
+					var e Entity
+					if _, err := it.Next(&e); err == iterator.Done {
+						break
+					} else {
+						assert.Loosely(t, err, should.BeNil)
+					}
+				}
+				cur, err := it.Cursor()
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, names(datastore.NewQuery("Entity").Order("Count").Start(cur).Limit(2)), should.Match([]string{"e4", "e5"}))
+			})
+
+			t.Run("Count", func(t *ftt.Test) {
+				// The client runs a keys-only query.
+				n, err := client.Count(ctx, datastore.NewQuery("Entity").Ancestor(parent))
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, n, should.Equal(10))
+			})
+		})
+
+		t.Run("Large results", func(t *ftt.Test) {
+			keys := make([]*datastore.Key, queryBatchSize+50)
+			ents := make([]*Entity, len(keys))
+			for i := range keys {
+				keys[i] = datastore.IDKey("Many", int64(i+1), nil)
+				ents[i] = &Entity{Count: int64(i)}
+			}
+			for i := 0; i < len(keys); i += 100 {
+				_, err := client.PutMulti(ctx, keys[i:min(i+100, len(keys))], ents[i:min(i+100, len(keys))])
+				assert.Loosely(t, err, should.BeNil)
+			}
+			got, err := client.GetAll(ctx, datastore.NewQuery("Many").KeysOnly(), nil)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, got, should.HaveLength(len(keys)))
+		})
+
+		t.Run("Transactions", func(t *ftt.Test) {
+			key := datastore.NameKey("Entity", "txn", nil)
+
+			t.Run("Commit", func(t *ftt.Test) {
+				_, err := client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+					var e Entity
+					if err := tx.Get(key, &e); err != datastore.ErrNoSuchEntity {
+						return fmt.Errorf("unexpected %v", err)
+					}
+					_, err := tx.Put(key, &Entity{Name: "txn"})
+					return err
+				})
+				assert.Loosely(t, err, should.BeNil)
+
+				var e Entity
+				assert.Loosely(t, client.Get(ctx, key, &e), should.BeNil)
+				assert.Loosely(t, e.Name, should.Equal("txn"))
+			})
+
+			t.Run("Rollback", func(t *ftt.Test) {
+				tx, err := client.NewTransaction(ctx)
+				assert.Loosely(t, err, should.BeNil)
+				_, err = tx.Put(key, &Entity{Name: "txn"})
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, tx.Rollback(), should.BeNil)
+
+				var e Entity
+				assert.Loosely(t, client.Get(ctx, key, &e), should.Equal(datastore.ErrNoSuchEntity))
+
+				_, err = tx.Commit()
+				assert.Loosely(t, err, should.NotBeNil)
+			})
+
+			t.Run("Conflict", func(t *ftt.Test) {
+				_, err := client.Put(ctx, key, &Entity{Name: "v1"})
+				assert.Loosely(t, err, should.BeNil)
+
+				tx, err := client.NewTransaction(ctx)
+				assert.Loosely(t, err, should.BeNil)
+				var e Entity
+				assert.Loosely(t, tx.Get(key, &e), should.BeNil)
+
+				_, err = client.Put(ctx, key, &Entity{Name: "v2"})
+				assert.Loosely(t, err, should.BeNil)
+
+				_, err = tx.Put(key, &Entity{Name: "v3"})
+				assert.Loosely(t, err, should.BeNil)
+				_, err = tx.Commit()
+				assert.Loosely(t, err, should.Equal(datastore.ErrConcurrentTransaction))
+
+				assert.Loosely(t, client.Get(ctx, key, &e), should.BeNil)
+				assert.Loosely(t, e.Name, should.Equal("v2"))
+			})
+
+			t.Run("Read-only", func(t *ftt.Test) {
+				tx, err := client.NewTransaction(ctx, datastore.ReadOnly)
+				assert.Loosely(t, err, should.BeNil)
+				_, err = tx.Put(key, &Entity{Name: "txn"})
+				assert.Loosely(t, err, should.BeNil)
+				_, err = tx.Commit()
+				assert.Loosely(t, status.Code(err), should.Equal(codes.InvalidArgument))
+			})
+		})
+	})
+}
+
+// errUnwrap returns the first error of a datastore.MultiError.
+func errUnwrap(err error) error {
+	if me, ok := err.(datastore.MultiError); ok && len(me) > 0 {
+		return me[0]
+	}
+	return err
+}
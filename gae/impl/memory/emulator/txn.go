@@ -0,0 +1,292 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emulator
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// transactionTimeout is how long a transaction can stay open before it is
+// rolled back. Matches the production datastore.
+const transactionTimeout = 270 * time.Second
+
+// errRollback is returned by the transaction body to roll it back.
+var errRollback = errors.New("rolled back")
+
+// transaction is an open transaction.
+//
+// It is backed by a goroutine blocked inside ds.RunInTransaction until the
+// transaction is committed or rolled back.
+type transaction struct {
+	id       string
+	project  string
+	readOnly bool
+
+	m   sync.Mutex      // serializes operations in the transaction
+	ctx context.Context // the transactional context
+
+	finish chan func(ctx context.Context) error // receives the final body
+	done   chan error                           // receives the commit result
+	timer  *time.Timer
+}
+
+// BeginTransaction implements pb.DatastoreServer.
+func (s *Server) BeginTransaction(ctx context.Context, req *pb.BeginTransactionRequest) (*pb.BeginTransactionResponse, error) {
+	dctx, err := s.datastore(req.ProjectId, req.DatabaseId, "")
+	if err != nil {
+		return nil, err
+	}
+	txn, err := s.begin(dctx, req.ProjectId, req.GetTransactionOptions().GetReadOnly() != nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BeginTransactionResponse{Transaction: []byte(txn.id)}, nil
+}
+
+// Commit implements pb.DatastoreServer.
+func (s *Server) Commit(ctx context.Context, req *pb.CommitRequest) (*pb.CommitResponse, error) {
+	var results []*pb.MutationResult
+	body := func(ctx context.Context) (err error) {
+		results, err = mutate(ctx, req.ProjectId, req.Mutations)
+		return
+	}
+
+	var err error
+	switch sel := req.TransactionSelector.(type) {
+	case *pb.CommitRequest_Transaction:
+		if req.Mode == pb.CommitRequest_NON_TRANSACTIONAL {
+			return nil, status.Errorf(codes.InvalidArgument, "a transaction can't be used in a non-transactional commit")
+		}
+		txn, terr := s.transaction(req.ProjectId, sel.Transaction, true)
+		if terr != nil {
+			return nil, terr
+		}
+		if txn.readOnly && len(req.Mutations) > 0 {
+			txn.end(func(context.Context) error { return errRollback })
+			return nil, status.Errorf(codes.InvalidArgument, "mutations are not allowed in read-only transactions")
+		}
+		err = txn.end(body)
+
+	case *pb.CommitRequest_SingleUseTransaction:
+		dctx, derr := s.datastore(req.ProjectId, req.DatabaseId, "")
+		if derr != nil {
+			return nil, derr
+		}
+		err = ds.RunInTransaction(dctx, body, &ds.TransactionOptions{Attempts: 1})
+
+	default:
+		if req.Mode == pb.CommitRequest_TRANSACTIONAL {
+			return nil, status.Errorf(codes.InvalidArgument, "a transaction is required in a transactional commit")
+		}
+		dctx, derr := s.datastore(req.ProjectId, req.DatabaseId, "")
+		if derr != nil {
+			return nil, derr
+		}
+		s.commitM.Lock()
+		err = body(dctx)
+		s.commitM.Unlock()
+	}
+	if err != nil {
+		return nil, grpcErr(err)
+	}
+	return &pb.CommitResponse{MutationResults: results}, nil
+}
+
+// Rollback implements pb.DatastoreServer.
+func (s *Server) Rollback(ctx context.Context, req *pb.RollbackRequest) (*pb.RollbackResponse, error) {
+	txn, err := s.transaction(req.ProjectId, req.Transaction, true)
+	if err != nil {
+		return nil, err
+	}
+	txn.end(func(context.Context) error { return errRollback })
+	return &pb.RollbackResponse{}, nil
+}
+
+// begin opens a new transaction.
+func (s *Server) begin(ctx context.Context, project string, readOnly bool) (*transaction, error) {
+	txn := &transaction{
+		id:       newID(),
+		project:  project,
+		readOnly: readOnly,
+		finish:   make(chan func(ctx context.Context) error, 1),
+		done:     make(chan error, 1),
+	}
+
+	ready := make(chan context.Context)
+	go func() {
+		txn.done <- ds.RunInTransaction(ctx, func(ctx context.Context) error {
+			ready <- ctx
+			return (<-txn.finish)(ctx)
+		}, &ds.TransactionOptions{Attempts: 1, ReadOnly: readOnly})
+	}()
+	select {
+	case txn.ctx = <-ready:
+	case err := <-txn.done:
+		return nil, grpcErr(err)
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.txns == nil {
+		s.txns = map[string]*transaction{}
+	}
+	s.txns[txn.id] = txn
+	txn.timer = time.AfterFunc(transactionTimeout, func() {
+		if txn, err := s.transaction(project, []byte(txn.id), true); err == nil {
+			txn.end(func(context.Context) error { return errRollback })
+		}
+	})
+	return txn, nil
+}
+
+// transaction returns an open transaction given its ID.
+//
+// If `remove` is true, the transaction is removed from the set of open
+// transactions, and the caller must end it.
+func (s *Server) transaction(project string, id []byte, remove bool) (*transaction, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	txn := s.txns[string(id)]
+	switch {
+	case txn == nil:
+		return nil, status.Errorf(codes.InvalidArgument, "transaction %q is invalid or has expired", id)
+	case txn.project != project:
+		return nil, status.Errorf(codes.InvalidArgument, "transaction %q belongs to another project", id)
+	}
+	if remove {
+		delete(s.txns, txn.id)
+		txn.timer.Stop()
+	}
+	return txn, nil
+}
+
+// end finishes the transaction by running `body` in it and committing it if
+// the body succeeds.
+func (t *transaction) end(body func(ctx context.Context) error) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.finish <- body
+	if err := <-t.done; err != nil && err != errRollback {
+		return err
+	}
+	return nil
+}
+
+// mutate applies mutations, returning their results.
+func mutate(ctx context.Context, project string, muts []*pb.Mutation) ([]*pb.MutationResult, error) {
+	results := make([]*pb.MutationResult, len(muts))
+	for i, mut := range muts {
+		var err error
+		if results[i], err = applyMutation(ctx, project, mut); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+func applyMutation(ctx context.Context, project string, mut *pb.Mutation) (*pb.MutationResult, error) {
+	if mut.PropertyMask != nil || len(mut.PropertyTransforms) > 0 {
+		return nil, status.Errorf(codes.Unimplemented, "property masks and transforms are not supported")
+	}
+
+	var ent *pb.Entity
+	var mustExist, mustNotExist bool
+	switch op := mut.Operation.(type) {
+	case *pb.Mutation_Insert:
+		ent, mustNotExist = op.Insert, true
+	case *pb.Mutation_Update:
+		ent, mustExist = op.Update, true
+	case *pb.Mutation_Upsert:
+		ent = op.Upsert
+	case *pb.Mutation_Delete:
+		key, err := keyFromProto(project, op.Delete, false)
+		if err != nil {
+			return nil, err
+		}
+		var derr error
+		err = ds.Raw(withNamespace(ctx, key.Namespace())).DeleteMulti([]*ds.Key{key}, func(_ int, err error) {
+			derr = err
+		})
+		if err == nil {
+			err = derr
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &pb.MutationResult{}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported mutation %T", op)
+	}
+
+	key, pm, err := entityFromProto(project, ent, !mustExist)
+	switch {
+	case err != nil:
+		return nil, err
+	case key == nil:
+		return nil, status.Errorf(codes.InvalidArgument, "the entity key is required")
+	}
+	raw := ds.Raw(withNamespace(ctx, key.Namespace()))
+
+	if mustExist || (mustNotExist && !key.IsIncomplete()) {
+		var exists bool
+		var gerr error
+		err := raw.GetMulti([]*ds.Key{key}, nil, func(_ int, _ ds.PropertyMap, err error) {
+			switch {
+			case err == nil:
+				exists = true
+			case !errors.Is(err, ds.ErrNoSuchEntity):
+				gerr = err
+			}
+		})
+		if err == nil {
+			err = gerr
+		}
+		switch {
+		case err != nil:
+			return nil, err
+		case mustExist && !exists:
+			return nil, status.Errorf(codes.NotFound, "no entity to update: %s", key)
+		case mustNotExist && exists:
+			return nil, status.Errorf(codes.AlreadyExists, "entity already exists: %s", key)
+		}
+	}
+
+	var newKey *ds.Key
+	var perr error
+	err = raw.PutMulti([]*ds.Key{key}, []ds.PropertyMap{pm}, func(_ int, k *ds.Key, err error) {
+		newKey, perr = k, err
+	})
+	if err == nil {
+		err = perr
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.MutationResult{}
+	if key.IsIncomplete() {
+		res.Key = keyToProto(project, newKey)
+	}
+	return res, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export reads and writes Cloud Datastore managed exports.
+//
+// A managed export (produced by `gcloud datastore export`) is a directory with
+// metadata files and data files named `output-<N>`, usually grouped by
+// namespace and kind:
+//
+//	<export>/
+//	  <export>.overall_export_metadata
+//	  all_namespaces/kind_<Kind>/all_namespaces_kind_<Kind>.export_metadata
+//	  all_namespaces/kind_<Kind>/output-0
+//	  all_namespaces/kind_<Kind>/output-1
+//	  ...
+//
+// Data files are in the LevelDB log format, each record being a serialized
+// legacy App Engine EntityProto. The reader ignores metadata files and just
+// reads all data files found in the directory. The writer produces data files
+// with the same layout, but doesn't produce metadata files, since their format
+// is not public. Such exports can be read back by this package, but not
+// imported into Cloud Datastore.
+//
+// Managed exports don't include composite index definitions. To allow them to
+// round-trip, the writer stores them in `index.yaml` in the root of the export
+// directory, and Import adds them to the datastore if it supports it.
+//
+// This package is primarily useful for tests and local dev servers that need
+// a production-like dataset, see Import and memory.ExportDatastore.
+package export
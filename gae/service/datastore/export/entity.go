@@ -0,0 +1,288 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/gae/service/blobstore"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	pb "go.chromium.org/luci/gae/service/datastore/internal/protos/datastore"
+)
+
+// entityToProto converts an entity to an EntityProto.
+//
+// If `key` is nil, the result has no key (as is the case for embedded
+// entities).
+func entityToProto(key *ds.Key, pm ds.PropertyMap) (*pb.EntityProto, error) {
+	ent := &pb.EntityProto{}
+	if key != nil {
+		_, _, toks := key.Split()
+		ent.Key = &pb.Reference{
+			App:       proto.String(key.AppID()),
+			NameSpace: nsOrNil(key.Namespace()),
+			Path:      &pb.Path{Element: make([]*pb.Path_Element, len(toks))},
+		}
+		for i, tok := range toks {
+			ent.Key.Path.Element[i] = pathElement(tok)
+		}
+		ent.EntityGroup = &pb.Path{Element: []*pb.Path_Element{pathElement(toks[0])}}
+	}
+
+	names := make([]string, 0, len(pm))
+	for name := range pm {
+		if !isMetaKey(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		_, multiple := pm[name].(ds.PropertySlice)
+		for _, prop := range pm.Slice(name) {
+			p, err := propertyToProto(name, prop, multiple)
+			if err != nil {
+				return nil, fmt.Errorf("property %q: %w", name, err)
+			}
+			if prop.IndexSetting() == ds.ShouldIndex && prop.Type() != ds.PTPropertyMap {
+				ent.Property = append(ent.Property, p)
+			} else {
+				ent.RawProperty = append(ent.RawProperty, p)
+			}
+		}
+	}
+	return ent, nil
+}
+
+func propertyToProto(name string, prop ds.Property, multiple bool) (*pb.Property, error) {
+	p := &pb.Property{
+		Name:     proto.String(name),
+		Multiple: proto.Bool(multiple),
+		Value:    &pb.PropertyValue{},
+	}
+	v := p.Value
+
+	meaning := pb.Property_NO_MEANING
+	switch val := prop.Value().(type) {
+	case nil:
+		// Leave the value empty.
+	case int64:
+		v.Int64Value = proto.Int64(val)
+	case bool:
+		v.BooleanValue = proto.Bool(val)
+	case string:
+		v.StringValue = proto.String(val)
+	case float64:
+		v.DoubleValue = proto.Float64(val)
+	case []byte:
+		v.StringValue = proto.String(string(val))
+		if prop.IndexSetting() == ds.ShouldIndex {
+			meaning = pb.Property_BYTESTRING
+		} else {
+			meaning = pb.Property_BLOB
+		}
+	case ds.GeoPoint:
+		v.Pointvalue = &pb.PropertyValue_PointValue{X: proto.Float64(val.Lat), Y: proto.Float64(val.Lng)}
+		meaning = pb.Property_GEORSS_POINT
+	case blobstore.Key:
+		v.StringValue = proto.String(string(val))
+		meaning = pb.Property_BLOBKEY
+	case *ds.Key:
+		v.Referencevalue = referenceValue(val)
+	case ds.PropertyMap:
+		ent, err := entityToProto(nil, val)
+		if err != nil {
+			return nil, err
+		}
+		blob, err := proto.MarshalOptions{AllowPartial: true, Deterministic: true}.Marshal(ent)
+		if err != nil {
+			return nil, err
+		}
+		v.StringValue = proto.String(string(blob))
+		meaning = pb.Property_ENTITY_PROTO
+	default:
+		if prop.Type() != ds.PTTime {
+			return nil, fmt.Errorf("unsupported property type %s", prop.Type())
+		}
+		v.Int64Value = proto.Int64(ds.TimeToInt(prop.Value().(time.Time)))
+		meaning = pb.Property_GD_WHEN
+	}
+
+	if meaning != pb.Property_NO_MEANING {
+		p.Meaning = meaning.Enum()
+	}
+	return p, nil
+}
+
+// entityFromProto converts an EntityProto to an entity.
+//
+// Keys are put into the given app, preserving their namespaces. If the proto
+// has no key, returns a nil key.
+func entityFromProto(appID string, ent *pb.EntityProto) (*ds.Key, ds.PropertyMap, error) {
+	var key *ds.Key
+	if ref := ent.GetKey(); ref != nil {
+		var err error
+		if key, err = referenceToKey(appID, ref.GetNameSpace(), ref.GetPath().GetElement()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	pm := make(ds.PropertyMap, len(ent.Property)+len(ent.RawProperty))
+	add := func(p *pb.Property, indexed bool) error {
+		name := p.GetName()
+		if name == "" {
+			return fmt.Errorf("there's a property without a name")
+		}
+		prop, err := propertyFromProto(appID, p, indexed)
+		if err != nil {
+			return fmt.Errorf("property %q: %w", name, err)
+		}
+		switch cur := pm[name].(type) {
+		case ds.PropertySlice:
+			pm[name] = append(cur, prop)
+		case ds.Property:
+			pm[name] = ds.PropertySlice{cur, prop}
+		case nil:
+			if p.GetMultiple() {
+				pm[name] = ds.PropertySlice{prop}
+			} else {
+				pm[name] = prop
+			}
+		}
+		return nil
+	}
+	for _, p := range ent.Property {
+		if err := add(p, true); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, p := range ent.RawProperty {
+		if err := add(p, false); err != nil {
+			return nil, nil, err
+		}
+	}
+	return key, pm, nil
+}
+
+func propertyFromProto(appID string, p *pb.Property, indexed bool) (ds.Property, error) {
+	mk := ds.MkPropertyNI
+	if indexed {
+		mk = ds.MkProperty
+	}
+
+	v := p.GetValue()
+	meaning := p.GetMeaning()
+	switch {
+	case v.Int64Value != nil && meaning == pb.Property_GD_WHEN:
+		return mk(ds.IntToTime(v.GetInt64Value())), nil
+	case v.Int64Value != nil:
+		return mk(v.GetInt64Value()), nil
+	case v.BooleanValue != nil:
+		return mk(v.GetBooleanValue()), nil
+	case v.DoubleValue != nil:
+		return mk(v.GetDoubleValue()), nil
+	case v.StringValue != nil:
+		switch meaning {
+		case pb.Property_BLOB, pb.Property_BYTESTRING:
+			return mk([]byte(v.GetStringValue())), nil
+		case pb.Property_BLOBKEY:
+			return mk(blobstore.Key(v.GetStringValue())), nil
+		case pb.Property_ENTITY_PROTO:
+			ent := &pb.EntityProto{}
+			if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal([]byte(v.GetStringValue()), ent); err != nil {
+				return ds.Property{}, fmt.Errorf("bad embedded entity: %w", err)
+			}
+			_, pm, err := entityFromProto(appID, ent)
+			if err != nil {
+				return ds.Property{}, err
+			}
+			return ds.MkPropertyNI(pm), nil
+		default:
+			return mk(v.GetStringValue()), nil
+		}
+	case v.Pointvalue != nil:
+		return mk(ds.GeoPoint{Lat: v.Pointvalue.GetX(), Lng: v.Pointvalue.GetY()}), nil
+	case v.Referencevalue != nil:
+		ref := v.Referencevalue
+		elems := make([]*pb.Path_Element, len(ref.Pathelement))
+		for i, e := range ref.Pathelement {
+			elems[i] = &pb.Path_Element{Type: e.Type, Id: e.Id, Name: e.Name}
+		}
+		key, err := referenceToKey(appID, ref.GetNameSpace(), elems)
+		if err != nil {
+			return ds.Property{}, err
+		}
+		return mk(key), nil
+	case v.Uservalue != nil:
+		return ds.Property{}, fmt.Errorf("user values are not supported")
+	default:
+		return mk(nil), nil
+	}
+}
+
+func referenceToKey(appID, ns string, elems []*pb.Path_Element) (*ds.Key, error) {
+	if len(elems) == 0 {
+		return nil, fmt.Errorf("empty key path")
+	}
+	toks := make([]ds.KeyTok, len(elems))
+	for i, e := range elems {
+		toks[i] = ds.KeyTok{Kind: e.GetType(), IntID: e.GetId(), StringID: e.GetName()}
+	}
+	key := ds.MkKeyContext(appID, ns).NewKeyToks(toks)
+	if !key.Valid(false, ds.MkKeyContext(appID, ns)) {
+		return nil, fmt.Errorf("invalid key %s", key)
+	}
+	return key, nil
+}
+
+func referenceValue(key *ds.Key) *pb.PropertyValue_ReferenceValue {
+	_, _, toks := key.Split()
+	ref := &pb.PropertyValue_ReferenceValue{
+		App:         proto.String(key.AppID()),
+		NameSpace:   nsOrNil(key.Namespace()),
+		Pathelement: make([]*pb.PropertyValue_ReferenceValue_PathElement, len(toks)),
+	}
+	for i, tok := range toks {
+		e := pathElement(tok)
+		ref.Pathelement[i] = &pb.PropertyValue_ReferenceValue_PathElement{Type: e.Type, Id: e.Id, Name: e.Name}
+	}
+	return ref
+}
+
+func pathElement(tok ds.KeyTok) *pb.Path_Element {
+	e := &pb.Path_Element{Type: proto.String(tok.Kind)}
+	if tok.StringID != "" {
+		e.Name = proto.String(tok.StringID)
+	} else {
+		e.Id = proto.Int64(tok.IntID)
+	}
+	return e
+}
+
+func nsOrNil(ns string) *string {
+	if ns == "" {
+		return nil
+	}
+	return proto.String(ns)
+}
+
+// isMetaKey is true for PropertyMap keys that hold metadata (like "$key").
+func isMetaKey(name string) bool {
+	return len(name) > 0 && name[0] == '$'
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/gae/service/blobstore"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	pb "go.chromium.org/luci/gae/service/datastore/internal/protos/datastore"
+)
+
+func TestEntityProto(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Round trip", t, func(t *ftt.Test) {
+		kc := ds.MkKeyContext("s~prod", "ns")
+		key := kc.NewKey("Parent", "p", 0, nil)
+		key = kc.NewKey("Child", "", 123, key)
+		ref := ds.MkKeyContext("s~prod", "").NewKey("Other", "x", 0, nil)
+
+		pm := ds.PropertyMap{
+			"$kind":    ds.MkProperty("Child"),
+			"Int":      ds.MkProperty(int64(1)),
+			"Bool":     ds.MkProperty(true),
+			"Str":      ds.MkProperty("str"),
+			"Text":     ds.MkPropertyNI("long text"),
+			"Float":    ds.MkProperty(1.5),
+			"Bytes":    ds.MkProperty([]byte("indexed")),
+			"Blob":     ds.MkPropertyNI([]byte("unindexed")),
+			"Time":     ds.MkProperty(time.Date(2025, 1, 2, 3, 4, 5, 6000, time.UTC)),
+			"Geo":      ds.MkProperty(ds.GeoPoint{Lat: 1, Lng: 2}),
+			"Key":      ds.MkProperty(ref),
+			"BlobKey":  ds.MkProperty(blobstore.Key("blob")),
+			"Null":     ds.MkProperty(nil),
+			"Single":   ds.PropertySlice{ds.MkProperty("one")},
+			"Multi":    ds.PropertySlice{ds.MkProperty(int64(1)), ds.MkPropertyNI("two")},
+			"Embedded": ds.MkPropertyNI(ds.PropertyMap{"Inner": ds.MkProperty(int64(5))}),
+		}
+
+		ent, err := entityToProto(key, pm)
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, ent.EntityGroup.Element, should.HaveLength(1))
+		assert.Loosely(t, ent.EntityGroup.Element[0].GetName(), should.Equal("p"))
+
+		blob, err := proto.Marshal(ent)
+		assert.Loosely(t, err, should.BeNil)
+		ent = &pb.EntityProto{}
+		assert.Loosely(t, proto.Unmarshal(blob, ent), should.BeNil)
+
+		t.Run("Same app", func(t *ftt.Test) {
+			gotKey, gotPM, err := entityFromProto("s~prod", ent)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, gotKey.Equal(key), should.BeTrue)
+
+			delete(pm, "$kind")
+			assertSamePM(t, gotPM, pm)
+		})
+
+		t.Run("Another app", func(t *ftt.Test) {
+			gotKey, gotPM, err := entityFromProto("dev~app", ent)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, gotKey.AppID(), should.Equal("dev~app"))
+			assert.Loosely(t, gotKey.Namespace(), should.Equal("ns"))
+			assert.Loosely(t, gotKey.Parent().StringID(), should.Equal("p"))
+
+			gotProp := gotPM["Key"].(ds.Property)
+			gotRef := gotProp.Value().(*ds.Key)
+			assert.Loosely(t, gotRef.Equal(ds.MkKeyContext("dev~app", "").NewKey("Other", "x", 0, nil)), should.BeTrue)
+		})
+	})
+
+	ftt.Run("Rejects user values", t, func(t *ftt.Test) {
+		ent := &pb.EntityProto{
+			Property: []*pb.Property{{
+				Name:     proto.String("User"),
+				Multiple: proto.Bool(false),
+				Value: &pb.PropertyValue{
+					Uservalue: &pb.PropertyValue_UserValue{
+						Email:      proto.String("a@example.com"),
+						AuthDomain: proto.String("example.com"),
+					},
+				},
+			}},
+		}
+		_, _, err := entityFromProto("dev~app", ent)
+		assert.Loosely(t, err, should.ErrLike("user values are not supported"))
+	})
+}
+
+// assertSamePM asserts two property maps have the same properties of the same
+// types.
+func assertSamePM(t testing.TB, got, want ds.PropertyMap) {
+	t.Helper()
+	assert.Loosely(t, len(got), should.Equal(len(want)), truth.LineContext())
+	for name, wantData := range want {
+		gotData := got[name]
+		assert.Loosely(t, gotData != nil, should.BeTrue, truth.Explain("property %q", name))
+		assert.Loosely(t, reflect.TypeOf(gotData), should.Equal(reflect.TypeOf(wantData)), truth.Explain("property %q", name))
+		gotSlice, wantSlice := gotData.Slice(), wantData.Slice()
+		assert.Loosely(t, gotSlice, should.HaveLength(len(wantSlice)), truth.Explain("property %q", name))
+		for i := range wantSlice {
+			g, w := &gotSlice[i], &wantSlice[i]
+			assert.Loosely(t, g.Type(), should.Equal(w.Type()), truth.Explain("property %q", name))
+			assert.Loosely(t, g.IndexSetting(), should.Equal(w.IndexSetting()), truth.Explain("property %q", name))
+			if w.Type() == ds.PTPropertyMap {
+				assertSamePM(t, g.Value().(ds.PropertyMap), w.Value().(ds.PropertyMap))
+			} else {
+				assert.Loosely(t, g.Equal(w), should.BeTrue, truth.Explain("property %q: %v != %v", name, g, w))
+			}
+		}
+	}
+}
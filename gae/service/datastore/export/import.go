@@ -0,0 +1,94 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/errors"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+)
+
+// importBatchSize is how many entities to put at once.
+const importBatchSize = 500
+
+// Import puts all entities from an export in the given directory into the
+// datastore.
+//
+// Keys are put into the app of the context, but keep their namespaces. If the
+// datastore is testable (e.g. it is gae/impl/memory) and the export has
+// composite index definitions, adds them to the datastore and catches up
+// indexes at the end.
+func Import(ctx context.Context, dir string) error {
+	idx, err := ReadIndexes(dir)
+	if err != nil {
+		return err
+	}
+	testable := ds.GetTestable(ctx)
+	if testable != nil && len(idx) > 0 {
+		testable.AddIndexes(idx...)
+	}
+
+	var keys []*ds.Key
+	var vals []ds.PropertyMap
+	flush := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		nsCtx, err := info.Namespace(ctx, keys[0].Namespace())
+		if err != nil {
+			return err
+		}
+		var merr errors.MultiError
+		err = ds.Raw(nsCtx).PutMulti(keys, vals, func(i int, _ *ds.Key, err error) {
+			if err != nil {
+				merr = append(merr, errors.Annotate(err, "putting %s", keys[i]).Err())
+			}
+		})
+		keys, vals = keys[:0], vals[:0]
+		if err != nil {
+			return err
+		}
+		return merr.AsError()
+	}
+
+	err = Read(ctx, dir, func(key *ds.Key, pm ds.PropertyMap) error {
+		// Batches must not span namespaces.
+		if len(keys) > 0 && keys[0].Namespace() != key.Namespace() {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		keys = append(keys, key)
+		vals = append(vals, pm)
+		if len(keys) == importBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		return err
+	}
+
+	if testable != nil {
+		testable.CatchupIndexes()
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// This file implements the LevelDB log format, see
+// https://github.com/google/leveldb/blob/main/doc/log_format.md
+
+const (
+	blockSize  = 32 * 1024
+	headerSize = 7 // checksum (4 bytes), length (2 bytes), type (1 byte)
+)
+
+// Chunk types.
+const (
+	fullChunk   = 1
+	firstChunk  = 2
+	middleChunk = 3
+	lastChunk   = 4
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// maskedCRC returns the checksum of a chunk, as LevelDB stores it.
+func maskedCRC(typ byte, data []byte) uint32 {
+	c := crc32.Update(0, crcTable, []byte{typ})
+	c = crc32.Update(c, crcTable, data)
+	return (c>>15 | c<<17) + 0xa282ead8
+}
+
+// recordWriter writes records in the LevelDB log format.
+type recordWriter struct {
+	w   io.Writer
+	off int // offset within the current block
+	buf [headerSize]byte
+}
+
+// write writes a single record.
+func (w *recordWriter) write(rec []byte) error {
+	first := true
+	for {
+		if left := blockSize - w.off; left < headerSize {
+			// Not enough space for a header, pad the block with zeros.
+			if _, err := w.w.Write(make([]byte, left)); err != nil {
+				return err
+			}
+			w.off = 0
+		}
+
+		n := min(len(rec), blockSize-w.off-headerSize)
+		last := n == len(rec)
+		var typ byte
+		switch {
+		case first && last:
+			typ = fullChunk
+		case first:
+			typ = firstChunk
+		case last:
+			typ = lastChunk
+		default:
+			typ = middleChunk
+		}
+
+		binary.LittleEndian.PutUint32(w.buf[0:4], maskedCRC(typ, rec[:n]))
+		binary.LittleEndian.PutUint16(w.buf[4:6], uint16(n))
+		w.buf[6] = typ
+		if _, err := w.w.Write(w.buf[:]); err != nil {
+			return err
+		}
+		if _, err := w.w.Write(rec[:n]); err != nil {
+			return err
+		}
+		w.off += headerSize + n
+		rec = rec[n:]
+		first = false
+
+		if last {
+			return nil
+		}
+	}
+}
+
+// recordReader reads records in the LevelDB log format.
+type recordReader struct {
+	r     io.Reader
+	block [blockSize]byte
+	data  []byte // unread part of the current block
+}
+
+// read returns the next record or io.EOF if there are no more records.
+//
+// The returned slice is valid until the next call.
+func (r *recordReader) read() (rec []byte, err error) {
+	inRecord := false
+	for {
+		if len(r.data) < headerSize {
+			// The rest of the block is padding, read the next one.
+			n, err := io.ReadFull(r.r, r.block[:])
+			switch {
+			case err == io.EOF || (err == io.ErrUnexpectedEOF && n < headerSize):
+				if inRecord {
+					return nil, io.ErrUnexpectedEOF
+				}
+				return nil, io.EOF
+			case err != nil && err != io.ErrUnexpectedEOF:
+				return nil, err
+			}
+			r.data = r.block[:n]
+		}
+
+		checksum := binary.LittleEndian.Uint32(r.data[0:4])
+		length := int(binary.LittleEndian.Uint16(r.data[4:6]))
+		typ := r.data[6]
+		if typ == 0 && length == 0 {
+			// Zero-filled trailer of a preallocated block.
+			r.data = nil
+			continue
+		}
+		if headerSize+length > len(r.data) {
+			return nil, fmt.Errorf("corrupted chunk: length %d exceeds the block", length)
+		}
+		chunk := r.data[headerSize : headerSize+length]
+		if maskedCRC(typ, chunk) != checksum {
+			return nil, fmt.Errorf("corrupted chunk: checksum mismatch")
+		}
+		r.data = r.data[headerSize+length:]
+
+		switch {
+		case typ == fullChunk && !inRecord:
+			return chunk, nil
+		case typ == firstChunk && !inRecord:
+			rec = append(rec[:0], chunk...)
+			inRecord = true
+		case typ == middleChunk && inRecord:
+			rec = append(rec, chunk...)
+		case typ == lastChunk && inRecord:
+			return append(rec, chunk...), nil
+		default:
+			return nil, fmt.Errorf("corrupted chunk: unexpected chunk type %d", typ)
+		}
+	}
+}
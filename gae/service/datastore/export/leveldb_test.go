@@ -0,0 +1,77 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+)
+
+func TestRecords(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Round trip", t, func(t *ftt.Test) {
+		recs := [][]byte{
+			[]byte("small"),
+			{},
+			bytes.Repeat([]byte("a"), blockSize-2*headerSize-5-3), // leaves < headerSize
+			bytes.Repeat([]byte("b"), 3*blockSize+17),             // spans blocks
+			[]byte("last"),
+		}
+
+		buf := bytes.Buffer{}
+		w := recordWriter{w: &buf}
+		for _, rec := range recs {
+			assert.Loosely(t, w.write(rec), should.BeNil)
+		}
+
+		r := recordReader{r: bytes.NewReader(buf.Bytes())}
+		for _, rec := range recs {
+			got, err := r.read()
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, got, should.Match(rec))
+		}
+		_, err := r.read()
+		assert.Loosely(t, err, should.Equal(io.EOF))
+	})
+
+	ftt.Run("Detects corruption", t, func(t *ftt.Test) {
+		buf := bytes.Buffer{}
+		w := recordWriter{w: &buf}
+		assert.Loosely(t, w.write([]byte(strings.Repeat("x", 100))), should.BeNil)
+
+		blob := buf.Bytes()
+		blob[headerSize+10] ^= 1
+		r := recordReader{r: bytes.NewReader(blob)}
+		_, err := r.read()
+		assert.Loosely(t, err, should.ErrLike("checksum mismatch"))
+	})
+
+	ftt.Run("Detects truncation", t, func(t *ftt.Test) {
+		buf := bytes.Buffer{}
+		w := recordWriter{w: &buf}
+		assert.Loosely(t, w.write(bytes.Repeat([]byte("x"), 2*blockSize)), should.BeNil)
+
+		r := recordReader{r: bytes.NewReader(buf.Bytes()[:blockSize])}
+		_, err := r.read()
+		assert.Loosely(t, err, should.Equal(io.ErrUnexpectedEOF))
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	pb "go.chromium.org/luci/gae/service/datastore/internal/protos/datastore"
+)
+
+// indexYAML is the name of the file with composite index definitions.
+const indexYAML = "index.yaml"
+
+// Read reads all entities from an export in the given directory.
+//
+// Keys are created in the app of the context (see datastore.GetKeyContext),
+// but keep their namespaces. This also applies to key-valued properties.
+//
+// Calls `cb` for each entity. If `cb` returns an error, stops reading and
+// returns this error as is.
+func Read(ctx context.Context, dir string, cb func(*ds.Key, ds.PropertyMap) error) error {
+	appID := ds.GetKeyContext(ctx).AppID
+
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasPrefix(d.Name(), "output-") {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		return errors.Annotate(err, "listing export files").Err()
+	}
+	sort.Strings(files)
+
+	for _, path := range files {
+		if err := readFile(path, appID, cb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadIndexes reads composite index definitions stored in an export by Writer.
+//
+// Returns nil if there are none.
+func ReadIndexes(dir string) ([]*ds.IndexDefinition, error) {
+	f, err := os.Open(filepath.Join(dir, indexYAML))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	defer f.Close()
+	idx, err := ds.ParseIndexYAML(f)
+	if err != nil {
+		return nil, errors.Annotate(err, "parsing %s", indexYAML).Err()
+	}
+	return idx, nil
+}
+
+func readFile(path, appID string, cb func(*ds.Key, ds.PropertyMap) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := recordReader{r: bufio.NewReader(f)}
+	for i := 0; ; i++ {
+		rec, err := r.read()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return errors.Annotate(err, "reading record #%d in %s", i, path).Err()
+		}
+
+		ent := &pb.EntityProto{}
+		if err := (proto.UnmarshalOptions{AllowPartial: true}).Unmarshal(rec, ent); err != nil {
+			return errors.Annotate(err, "bad entity in record #%d in %s", i, path).Err()
+		}
+		if ent.Key == nil {
+			return errors.Reason("entity without a key in record #%d in %s", i, path).Err()
+		}
+		key, pm, err := entityFromProto(appID, ent)
+		if err != nil {
+			return errors.Annotate(err, "bad entity in record #%d in %s", i, path).Err()
+		}
+		if err := cb(key, pm); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// Writer writes entities into an export directory.
+//
+// Entities are grouped into files by kind, using the same layout as managed
+// exports of all namespaces. Must be closed with Close.
+type Writer struct {
+	dir   string
+	kinds map[string]*kindFile
+}
+
+type kindFile struct {
+	f   *os.File
+	buf *bufio.Writer
+	rw  recordWriter
+}
+
+// NewWriter creates the export directory (if necessary) and returns a writer
+// into it.
+func NewWriter(dir string) (*Writer, error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	return &Writer{dir: dir, kinds: map[string]*kindFile{}}, nil
+}
+
+// Write writes one entity.
+func (w *Writer) Write(key *ds.Key, pm ds.PropertyMap) error {
+	ent, err := entityToProto(key, pm)
+	if err != nil {
+		return errors.Annotate(err, "entity %s", key).Err()
+	}
+	blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(ent)
+	if err != nil {
+		return errors.Annotate(err, "entity %s", key).Err()
+	}
+	kf, err := w.kindFile(key.Kind())
+	if err != nil {
+		return err
+	}
+	return kf.rw.write(blob)
+}
+
+// WriteIndexes stores composite index definitions to be picked up by
+// ReadIndexes.
+func (w *Writer) WriteIndexes(idx []*ds.IndexDefinition) error {
+	idx = append([]*ds.IndexDefinition(nil), idx...)
+	sort.Slice(idx, func(i, j int) bool { return idx[i].Less(idx[j]) })
+	out := strings.Builder{}
+	out.WriteString("indexes:\n")
+	for _, def := range idx {
+		yaml, err := def.YAMLString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&out, "\n%s\n", yaml)
+	}
+	return os.WriteFile(filepath.Join(w.dir, indexYAML), []byte(out.String()), 0666)
+}
+
+// Close flushes and closes all files.
+func (w *Writer) Close() error {
+	var merr errors.MultiError
+	for _, kf := range w.kinds {
+		if err := kf.buf.Flush(); err != nil {
+			merr = append(merr, err)
+		}
+		if err := kf.f.Close(); err != nil {
+			merr = append(merr, err)
+		}
+	}
+	w.kinds = nil
+	return merr.AsError()
+}
+
+func (w *Writer) kindFile(kind string) (*kindFile, error) {
+	if kf := w.kinds[kind]; kf != nil {
+		return kf, nil
+	}
+	dir := filepath.Join(w.dir, "all_namespaces", "kind_"+url.PathEscape(kind))
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(filepath.Join(dir, "output-0"))
+	if err != nil {
+		return nil, err
+	}
+	kf := &kindFile{f: f, buf: bufio.NewWriter(f)}
+	kf.rw.w = kf.buf
+	w.kinds[kind] = kf
+	return kf, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command dsemulator serves the in-memory datastore implementation over the
+// google.datastore.v1 gRPC API, as a replacement for the Cloud Datastore
+// emulator.
+//
+// See README.md for details.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+
+	pb "cloud.google.com/go/datastore/apiv1/datastorepb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/system/signals"
+
+	"go.chromium.org/luci/gae/impl/memory/emulator"
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+const help = `Usage of %[1]s:
+
+%[1]s serves the in-memory datastore implementation (gae/impl/memory) over
+the google.datastore.v1 gRPC API. Point clients to it by setting
+DATASTORE_EMULATOR_HOST to the listening address.
+
+Each project ID gets its own independent datastore. All queries are strongly
+consistent.
+
+Options:
+`
+
+type app struct {
+	out io.Writer
+
+	listen         string
+	indexYAML      string
+	requireIndexes bool
+
+	indexes []*ds.IndexDefinition
+}
+
+func (a *app) parseArgs(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(a.out)
+	fs.Usage = func() {
+		fmt.Fprintf(a.out, help, args[0])
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&a.listen, "listen", "localhost:8081", "Address to listen on")
+	fs.StringVar(&a.indexYAML, "index-yaml", "", "Path to index.yaml with composite indexes to add to each datastore")
+	fs.BoolVar(&a.requireIndexes, "require-indexes", false,
+		"Fail queries that need composite indexes missing from -index-yaml instead of adding them automatically")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		fs.Usage()
+		return errors.Reason("unexpected arguments %q", fs.Args()).Err()
+	}
+	if a.indexYAML != "" {
+		f, err := os.Open(a.indexYAML)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		if a.indexes, err = ds.ParseIndexYAML(f); err != nil {
+			return errors.Annotate(err, "parsing %s", a.indexYAML).Err()
+		}
+	}
+	return nil
+}
+
+func (a *app) run(ctx context.Context) error {
+	srv := &emulator.Server{
+		Datastore: emulator.Memory(ctx, func(ctx context.Context, project string) error {
+			t := ds.GetTestable(ctx)
+			t.AddIndexes(a.indexes...)
+			t.AutoIndex(!a.requireIndexes)
+			t.Consistent(true)
+			fmt.Fprintf(a.out, "created datastore for project %q\n", project)
+			return nil
+		}),
+	}
+
+	lis, err := net.Listen("tcp", a.listen)
+	if err != nil {
+		return err
+	}
+	gsrv := grpc.NewServer()
+	pb.RegisterDatastoreServer(gsrv, srv)
+	defer signals.HandleInterrupt(gsrv.GracefulStop)()
+
+	fmt.Fprintf(a.out, "serving on %s, export DATASTORE_EMULATOR_HOST=%s\n", lis.Addr(), lis.Addr())
+	return gsrv.Serve(lis)
+}
+
+func main() {
+	a := &app{out: os.Stderr}
+	if err := a.parseArgs(flag.NewFlagSet(os.Args[0], flag.ContinueOnError), os.Args); err != nil {
+		if err != flag.ErrHelp {
+			fmt.Fprintf(a.out, "error: %s\n", err)
+		}
+		os.Exit(1)
+	}
+	if err := a.run(context.Background()); err != nil {
+		fmt.Fprintf(a.out, "error: %s\n", err)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command dsexport converts between Cloud Datastore managed exports and
+// snapshots of the gae/impl/memory datastore.
+//
+// See README.md for details.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore/export"
+)
+
+const help = `Usage of %[1]s:
+
+%[1]s converts between Cloud Datastore managed exports and snapshots of the
+in-memory datastore implementation (gae/impl/memory) loadable with
+memory.LoadDatastoreFile.
+
+  %[1]s import -export <dir> -snapshot <file> [-app <app ID>]
+      Loads a managed export into a new snapshot file.
+
+  %[1]s export -snapshot <file> -export <dir> [-app <app ID>]
+      Dumps a snapshot file into a managed export directory.
+
+Options:
+`
+
+type app struct {
+	out io.Writer
+
+	command   string
+	exportDir string
+	snapshot  string
+	appID     string
+}
+
+func (a *app) parseArgs(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(a.out)
+	fs.Usage = func() {
+		fmt.Fprintf(a.out, help, args[0])
+		fs.PrintDefaults()
+	}
+
+	fs.StringVar(&a.exportDir, "export", "", "Path to the managed export directory (required)")
+	fs.StringVar(&a.snapshot, "snapshot", "", "Path to the memory datastore snapshot file (required)")
+	fs.StringVar(&a.appID, "app", "dev~app",
+		"App ID of the memory datastore. All keys are moved into this app on import.")
+
+	if len(args) < 2 {
+		fs.Usage()
+		return errors.New("missing command")
+	}
+	a.command = args[1]
+	if err := fs.Parse(args[2:]); err != nil {
+		return err
+	}
+
+	fail := errors.MultiError(nil)
+	if a.command != "import" && a.command != "export" {
+		fail = append(fail, errors.Reason("unknown command %q", a.command).Err())
+	}
+	if a.exportDir == "" {
+		fail = append(fail, errors.New("must specify -export"))
+	}
+	if a.snapshot == "" {
+		fail = append(fail, errors.New("must specify -snapshot"))
+	}
+	if len(fail) > 0 {
+		for _, e := range fail {
+			fmt.Fprintln(a.out, "error:", e)
+		}
+		fmt.Fprintln(a.out)
+		fs.Usage()
+		return fail
+	}
+	return nil
+}
+
+func (a *app) run(ctx context.Context) error {
+	ctx = memory.UseWithAppID(ctx, a.appID)
+	switch a.command {
+	case "import":
+		if err := export.Import(ctx, a.exportDir); err != nil {
+			return errors.Annotate(err, "importing %s", a.exportDir).Err()
+		}
+		return memory.SaveDatastoreFile(ctx, a.snapshot)
+	case "export":
+		if err := memory.LoadDatastoreFile(ctx, a.snapshot); err != nil {
+			return err
+		}
+		return memory.ExportDatastore(ctx, a.exportDir)
+	default:
+		panic("impossible")
+	}
+}
+
+func main() {
+	a := &app{out: os.Stderr}
+	if err := a.parseArgs(flag.NewFlagSet(os.Args[0], flag.ContinueOnError), os.Args); err != nil {
+		os.Exit(1)
+	}
+	if err := a.run(context.Background()); err != nil {
+		fmt.Fprintf(a.out, "error: %s\n", err)
+		os.Exit(2)
+	}
+}
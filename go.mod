@@ -65,6 +65,7 @@ require (
 	github.com/leemcloughlin/gofarmhash v0.0.0-20160919192320-0a055c5b87a8
 	github.com/luci/gtreap v0.0.0-20161228054646-35df89791e8f
 	github.com/maruel/subcommands v1.1.1
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/mattn/go-tty v0.0.7
 	github.com/mgutz/ansi v0.0.0-20200706080929-d51e80ef957d
 	github.com/op/go-logging v0.0.0-20160315200505-970db520ece7
@@ -327,11 +327,14 @@ func (c *Client) call(ctx context.Context, options *Options, req []byte) ([]byte
 	buf := &bytes.Buffer{}
 	contentType := ""
 
+	// The retry-after hint from the server from the last attempt, if any.
+	var retryAfter time.Duration
+
 	// Send the request in a retry loop. Use transient.Tag to propagate the retry
 	// signal from the loop body.
-	err = retry.Retry(ctx, transient.Only(options.Retry), func() (err error) {
+	err = retry.Retry(ctx, transient.Only(respectRetryAfter(options.Retry, &retryAfter)), func() (err error) {
 		// Note: `buf` is reset inside, it is safe to reuse it across attempts.
-		contentType, err = c.attemptCall(ctx, options, httpReq, buf)
+		contentType, retryAfter, err = c.attemptCall(ctx, options, httpReq, buf)
 		if err == nil {
 			return
 		}
@@ -343,6 +346,10 @@ func (c *Client) call(ctx context.Context, options *Options, req []byte) ([]byte
 				return
 			}
 		}
+		// If the server is shedding load and asked us to come back later, do so.
+		if retryAfter > 0 {
+			return grpcutil.WrapIfTransientOr(err, codes.DeadlineExceeded, codes.ResourceExhausted)
+		}
 		// Retry on regular transient errors and on per-RPC deadline. If this is
 		// a global deadline (i.e. `ctx` expired), the retry loop will just exit.
 		return grpcutil.WrapIfTransientOr(err, codes.DeadlineExceeded)
@@ -429,16 +436,48 @@ func (c *Client) concurrencySem() *semaphore.Weighted {
 	return c.sem
 }
 
+// respectRetryAfter wraps a retry factory to make it wait at least as long as
+// the server asked via the retry-after hint of the last attempt.
+func respectRetryAfter(f retry.Factory, hint *time.Duration) retry.Factory {
+	if f == nil {
+		return nil
+	}
+	return func() retry.Iterator {
+		it := f()
+		if it == nil {
+			return nil
+		}
+		return retryAfterIterator{it, hint}
+	}
+}
+
+// maxRetryAfter caps retry-after hints from servers.
+const maxRetryAfter = time.Minute
+
+type retryAfterIterator struct {
+	retry.Iterator
+	hint *time.Duration
+}
+
+func (it retryAfterIterator) Next(ctx context.Context, err error) time.Duration {
+	delay := it.Iterator.Next(ctx, err)
+	if delay == retry.Stop {
+		return delay
+	}
+	return max(delay, min(*it.hint, maxRetryAfter))
+}
+
 // attemptCall makes one attempt at performing an RPC.
 //
 // Writes the raw response to the provided buffer, returns its content type.
+// On errors returns a retry-after hint from the server, if it sent one.
 //
 // Returns gRPC errors.
-func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Request, buf *bytes.Buffer) (contentType string, err error) {
+func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Request, buf *bytes.Buffer) (contentType string, retryAfter time.Duration, err error) {
 	// Wait until there's an execution slot available.
 	if sem := c.concurrencySem(); sem != nil {
 		if err := sem.Acquire(ctx, 1); err != nil {
-			return "", status.FromContextError(err).Err()
+			return "", 0, status.FromContextError(err).Err()
 		}
 		defer sem.Release(1)
 	}
@@ -485,7 +524,7 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 			// The request has already expired. This will likely never happen, since
 			// the outer Retry loop will have expired, but there is a very slight
 			// possibility of a race.
-			return "", status.Errorf(codes.DeadlineExceeded, "prpc: attempt deadline exceeded: %s", context.Cause(ctx))
+			return "", 0, status.Errorf(codes.DeadlineExceeded, "prpc: attempt deadline exceeded: %s", context.Cause(ctx))
 		}
 		logging.Debugf(ctx, "RPC %s/%s.%s [deadline %s]", options.host, options.serviceName, options.methodName, delta)
 		req.Header.Set(HeaderTimeout, EncodeTimeout(delta))
@@ -514,23 +553,23 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 		err = c.testPostHTTP(ctx, err)
 	}
 	if err != nil {
-		return "", status.Errorf(codeForErr(err), "prpc: sending request: %s", err)
+		return "", 0, status.Errorf(codeForErr(err), "prpc: sending request: %s", err)
 	}
 
 	if options.resHeaderMetadata != nil {
 		md, err := headersIntoMetadata(res.Header)
 		if err != nil {
-			return "", status.Errorf(codes.Internal, "prpc: decoding headers: %s", err)
+			return "", 0, status.Errorf(codes.Internal, "prpc: decoding headers: %s", err)
 		}
 		*options.resHeaderMetadata = md
 	}
 	if err := c.readResponseBody(ctx, buf, res); err != nil {
-		return "", err
+		return "", 0, err
 	}
 	if options.resTrailerMetadata != nil {
 		md, err := headersIntoMetadata(res.Trailer)
 		if err != nil {
-			return "", status.Errorf(codes.Internal, "prpc: decoding trailers: %s", err)
+			return "", 0, status.Errorf(codes.Internal, "prpc: decoding trailers: %s", err)
 		}
 		*options.resTrailerMetadata = md
 	}
@@ -541,8 +580,11 @@ func (c *Client) attemptCall(ctx context.Context, options *Options, req *http.Re
 	// expect details to be encoded based on the "Accept" header in the request
 	// (which matches the format of outCodec).
 	err = c.readStatus(res, buf, options.respCodec)
+	if err != nil {
+		retryAfter = retryAfterHint(res.Header)
+	}
 
-	return res.Header.Get("Content-Type"), err
+	return res.Header.Get("Content-Type"), retryAfter, err
 }
 
 // maxResponseSize is a maximum length of the uncompressed response to read.
@@ -137,6 +137,22 @@ func transientErrors(count int, grpcHeader bool, httpStatus int, then http.Handl
 	}
 }
 
+func resourceExhausted(count int, retryAfter string, then http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if count > 0 {
+			count--
+			if retryAfter != "" {
+				w.Header().Set(HeaderRetryAfter, retryAfter)
+			}
+			w.Header().Set(HeaderGRPCCode, strconv.Itoa(int(codes.ResourceExhausted)))
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintln(w, "Overloaded")
+			return
+		}
+		then.ServeHTTP(w, r)
+	}
+}
+
 func advanceClockAndErr(tc testclock.TestClock, d time.Duration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		tc.Add(d)
@@ -450,6 +466,31 @@ func TestClient(t *testing.T) {
 				)
 			})
 
+			t.Run("RESOURCE_EXHAUSTED with retry-after", func(t *ftt.Test) {
+				client, server := setUp(resourceExhausted(2, "3", sayHello(t)))
+				defer server.Close()
+
+				var sleeps []time.Duration
+				tc.SetTimerCallback(func(d time.Duration, _ clock.Timer) {
+					sleeps = append(sleeps, d)
+					tc.Add(d)
+				})
+				defer tc.SetTimerCallback(nil)
+
+				err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+				assert.Loosely(t, err, should.BeNil)
+				assert.Loosely(t, res.Message, should.Equal("Hello John"))
+				assert.Loosely(t, sleeps, should.Match([]time.Duration{3 * time.Second, 3 * time.Second}))
+			})
+
+			t.Run("RESOURCE_EXHAUSTED without retry-after", func(t *ftt.Test) {
+				client, server := setUp(resourceExhausted(1, "", sayHello(t)))
+				defer server.Close()
+
+				err := client.Call(ctx, "prpc.Greeter", "SayHello", req, res)
+				assert.Loosely(t, status.Code(err), should.Equal(codes.ResourceExhausted))
+			})
+
 			t.Run("HTTP 500 many", func(t *ftt.Test) {
 				client, server := setUp(transientErrors(10, true, http.StatusInternalServerError, sayHello(t)))
 				defer server.Close()
@@ -38,6 +38,16 @@
 //
 //	go install go.chromium.org/luci/grpc/cmd/cproto
 //
+// # Priorities and load shedding
+//
+// Server.Priority can be used to assign priority classes to RPCs based on
+// the method being called or on the caller. Load shedding mechanisms (like
+// server/limiter) use PriorityFromContext to reject lower priority RPCs first
+// when the server is overloaded. Such rejections come with a "Retry-After"
+// response header (see SetRetryAfter). The pRPC client waits at least that
+// long before retrying, and also retries RESOURCE_EXHAUSTED errors that come
+// with this header.
+//
 // # Protocol
 //
 // # v1.5
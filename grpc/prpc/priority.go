@@ -0,0 +1,151 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Priority is a priority class of an RPC.
+//
+// When the server is overloaded, load shedding mechanisms (like the one in
+// server/limiter) reject lower priority calls first.
+type Priority int
+
+const (
+	// PriorityLow is for calls that can be delayed, e.g. batch jobs.
+	PriorityLow Priority = -1
+	// PriorityNormal is the default priority.
+	PriorityNormal Priority = 0
+	// PriorityHigh is for calls that should be served even under heavy load,
+	// e.g. calls from interactive users.
+	PriorityHigh Priority = 1
+)
+
+// String returns a name of the priority used in logs and metrics.
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("priority(%d)", int(p))
+	}
+}
+
+// PriorityFunc returns a priority class of an RPC.
+//
+// Receives the full method name ("/<service>/<method>") and the request
+// context, which can be used to look at the caller (e.g. via server/auth).
+type PriorityFunc func(ctx context.Context, fullMethod string) Priority
+
+// MethodPriorities returns a PriorityFunc that looks up priorities of methods
+// by their full names ("/<service>/<method>").
+//
+// Methods not in the map get PriorityNormal.
+func MethodPriorities(m map[string]Priority) PriorityFunc {
+	return func(ctx context.Context, fullMethod string) Priority {
+		if p, ok := m[fullMethod]; ok {
+			return p
+		}
+		return PriorityNormal
+	}
+}
+
+var priorityContextKey = "context key with *priorityResolver"
+
+// priorityResolver is put into the context to resolve the priority lazily.
+type priorityResolver struct {
+	fn         PriorityFunc // nil if the priority is fixed
+	fullMethod string
+	fixed      Priority
+}
+
+// WithPriority returns a context that has the given RPC priority.
+//
+// It overrides whatever priority the server assigned to the RPC.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, &priorityContextKey, &priorityResolver{fixed: p})
+}
+
+// PriorityFromContext returns the priority of an RPC being handled.
+//
+// When called from within a pRPC server with Server.Priority set, calls this
+// callback, passing it the given context. This allows the callback to look at
+// the caller identity established by interceptors (e.g. authentication) that
+// ran after the pRPC server accepted the request.
+//
+// Returns PriorityNormal if the priority is not known.
+func PriorityFromContext(ctx context.Context) Priority {
+	switch r, _ := ctx.Value(&priorityContextKey).(*priorityResolver); {
+	case r == nil:
+		return PriorityNormal
+	case r.fn != nil:
+		return r.fn(ctx, r.fullMethod)
+	default:
+		return r.fixed
+	}
+}
+
+// withPriorityFunc returns a context where the priority is resolved via `fn`.
+func withPriorityFunc(ctx context.Context, fn PriorityFunc, fullMethod string) context.Context {
+	return context.WithValue(ctx, &priorityContextKey, &priorityResolver{fn: fn, fullMethod: fullMethod})
+}
+
+// HeaderRetryAfter is a response header (and the corresponding gRPC metadata
+// key) with a hint for how long the client should wait before retrying.
+//
+// Its value is a number of seconds, as in the standard HTTP Retry-After header.
+// The pRPC client respects it when retrying UNAVAILABLE and RESOURCE_EXHAUSTED
+// errors.
+const HeaderRetryAfter = "Retry-After"
+
+// SetRetryAfter sets the retry-after hint in the response metadata.
+//
+// Should be used together with returning an UNAVAILABLE or RESOURCE_EXHAUSTED
+// error. The hint is rounded up to whole seconds.
+//
+// Works for both pRPC and gRPC requests, see SetHeader.
+func SetRetryAfter(ctx context.Context, d time.Duration) error {
+	secs := int64((d + time.Second - 1) / time.Second)
+	if secs < 1 {
+		secs = 1
+	}
+	return SetHeader(ctx, metadata.Pairs(HeaderRetryAfter, strconv.FormatInt(secs, 10)))
+}
+
+// retryAfterHint parses the retry-after hint in the response headers.
+//
+// Returns 0 if there is no valid hint. Date values are not supported.
+func retryAfterHint(h http.Header) time.Duration {
+	v := h.Get(HeaderRetryAfter)
+	if v == "" {
+		return 0
+	}
+	secs, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || secs <= 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
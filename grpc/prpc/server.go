@@ -285,6 +285,17 @@ type Server struct {
 	//  - https://github.com/protocolbuffers/protobuf/issues/8547
 	EnableNonStandardFieldMasks bool
 
+	// Priority, if not nil, is used to assign a priority class to RPCs.
+	//
+	// It is called lazily by PriorityFromContext, which is used by load
+	// shedding mechanisms (like the one in server/limiter) to decide what calls
+	// to reject first under overload. Since it is called with the context of the
+	// interceptor that asks for the priority, it can look at the caller identity
+	// established by preceding interceptors.
+	//
+	// See also MethodPriorities. If nil, all RPCs have PriorityNormal.
+	Priority PriorityFunc
+
 	mu        sync.RWMutex
 	services  map[string]*service
 	overrides map[string]map[string]Override
@@ -492,7 +503,7 @@ func (s *Server) handlePOST(c *router.Context) {
 	default:
 		// Note: this at most populates some of c.Writer.Header(). The actual RPC
 		// response or error are placed into `res` and flushed below.
-		s.parseAndCall(c.Request, c.Writer, service, method, &res)
+		s.parseAndCall(c.Request, c.Writer, serviceName, service, method, &res)
 	}
 
 	// Ignore client's gzip preference if the server doesn't want to do
@@ -599,7 +610,7 @@ func (s *Server) lookup(serviceName, methodName string) (override Override, serv
 //
 // `rw` is used to pass it to http.MaxBytesReader(...) and to write headers to
 // via SetHeader(...).
-func (s *Server) parseAndCall(req *http.Request, rw http.ResponseWriter, service *service, method grpc.MethodDesc, r *response) {
+func (s *Server) parseAndCall(req *http.Request, rw http.ResponseWriter, serviceName string, service *service, method grpc.MethodDesc, r *response) {
 	respFmt, perr := responseFormat(req.Header.Get(headerAccept))
 	if perr != nil {
 		r.err = perr
@@ -632,6 +643,9 @@ func (s *Server) parseAndCall(req *http.Request, rw http.ResponseWriter, service
 	defer cancelFunc()
 
 	methodCtx = context.WithValue(methodCtx, &requestContextKey, &requestContext{header: rw.Header()})
+	if s.Priority != nil {
+		methodCtx = withPriorityFunc(methodCtx, s.Priority, fmt.Sprintf("/%s/%s", serviceName, method.MethodName))
+	}
 
 	// Populate peer.Peer if we can manage to parse the address. This may fail
 	// if the server is exposed via a Unix socket, for example.
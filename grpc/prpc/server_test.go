@@ -24,8 +24,10 @@ import (
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -158,6 +160,28 @@ func TestServer(t *testing.T) {
 				}))
 			})
 
+			t.Run("Priority and retry-after hint", func(t *ftt.Test) {
+				var seen []Priority
+				server.Priority = MethodPriorities(map[string]Priority{
+					"/prpc.Greeter/SayHello": PriorityLow,
+				})
+				server.UnaryServerInterceptor = func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+					seen = append(seen, PriorityFromContext(ctx))
+					seen = append(seen, PriorityFromContext(WithPriority(ctx, PriorityHigh)))
+					assert.Loosely(t, SetRetryAfter(ctx, 1500*time.Millisecond), should.BeNil)
+					return nil, status.Errorf(codes.ResourceExhausted, "overloaded")
+				}
+				defer func() {
+					server.Priority = nil
+					server.UnaryServerInterceptor = nil
+				}()
+
+				r.ServeHTTP(res, req)
+				assert.Loosely(t, res.Code, should.Equal(http.StatusTooManyRequests))
+				assert.Loosely(t, res.Header().Get("Retry-After"), should.Equal("2"))
+				assert.Loosely(t, seen, should.Match([]Priority{PriorityLow, PriorityHigh}))
+			})
+
 			t.Run("Status details", func(t *ftt.Test) {
 				greeterSvc.errDetails = &errdetails.DebugInfo{Detail: "x"}
 				r.ServeHTTP(res, req)
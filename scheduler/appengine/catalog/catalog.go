@@ -24,6 +24,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
@@ -35,6 +36,7 @@ import (
 	"go.chromium.org/luci/server/auth/realms"
 
 	"go.chromium.org/luci/scheduler/appengine/engine/policy"
+	"go.chromium.org/luci/scheduler/appengine/internal"
 	"go.chromium.org/luci/scheduler/appengine/messages"
 	"go.chromium.org/luci/scheduler/appengine/schedule"
 	"go.chromium.org/luci/scheduler/appengine/task"
@@ -149,6 +151,24 @@ type Definition struct {
 	// TriggeredJobIDs is a list of jobIDs which this job triggers.
 	// It's set only for triggering jobs.
 	TriggeredJobIDs []string
+
+	// BlackoutWindows is serialized internal.BlackoutWindowList proto with
+	// blackout windows from the project config that apply to this job.
+	//
+	// It is nil if there are no such windows. It is never set for triggering
+	// jobs.
+	BlackoutWindows []byte
+
+	// DependsOn is a sorted list of jobIDs that must all succeed within the same
+	// lineage before this job is triggered.
+	//
+	// It's set only for regular jobs. References to jobs that are disabled or
+	// invalid are removed.
+	DependsOn []string
+
+	// DependentJobIDs is a sorted list of jobIDs that depend on this job, i.e.
+	// have it in their DependsOn lists.
+	DependentJobIDs []string
 }
 
 // New returns implementation of Catalog.
@@ -274,6 +294,8 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			Schedule:         schedule,
 			Task:             packed,
 			TriggeringPolicy: marshalTriggeringPolicy(job.TriggeringPolicy),
+			BlackoutWindows:  marshalBlackoutWindows(job.Id, cfg.BlackoutWindow),
+			DependsOn:        normalizeDependsOn(projectID, job),
 		})
 	}
 
@@ -317,6 +339,9 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 		})
 	}
 
+	// Link dependent jobs, now that we know which ones are valid.
+	linkDependencies(c, out)
+
 	// Mark project as valid even if not all its jobs/triggers are.
 	return out, nil
 }
@@ -344,6 +369,13 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 	projectID := strings.TrimPrefix(configSet, "projects/")
 
 	knownIDs := stringset.New(len(cfg.Job) + len(cfg.Trigger))
+	allJobIDs := getAllJobIDs(&cfg)
+	triggerIDs := stringset.New(len(cfg.Trigger))
+	for _, trigger := range cfg.Trigger {
+		if trigger.Id != "" {
+			triggerIDs.Add(trigger.Id)
+		}
+	}
 	// Jobs.
 	ctx.Enter("job")
 	for _, job := range cfg.Job {
@@ -357,13 +389,16 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 		}
 		realmID := validateRealm(ctx, projectID, job.Realm)
 		cat.validateJobProto(ctx, job, realmID)
+		validateDependsOn(ctx, job, allJobIDs, triggerIDs)
 		ctx.Exit()
 	}
+	if cycle := findDependencyCycle(cfg.Job); cycle != nil {
+		ctx.Errorf("dependencies form a cycle: %s", strings.Join(cycle, " -> "))
+	}
 	ctx.Exit()
 
 	// Triggers.
 	ctx.Enter("trigger")
-	allJobIDs := getAllJobIDs(&cfg)
 	for _, trigger := range cfg.Trigger {
 		id := "(empty)"
 		if trigger.Id != "" {
@@ -379,6 +414,23 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 	}
 	ctx.Exit()
 
+	// Blackout windows.
+	ctx.Enter("blackout_window")
+	windowIDs := stringset.New(len(cfg.BlackoutWindow))
+	for _, w := range cfg.BlackoutWindow {
+		id := "(empty)"
+		if w.Id != "" {
+			id = w.Id
+		}
+		ctx.Enter(id)
+		if w.Id != "" && !windowIDs.Add(w.Id) {
+			ctx.Errorf("duplicate id %q", w.Id)
+		}
+		validateBlackoutWindow(ctx, w, allJobIDs)
+		ctx.Exit()
+	}
+	ctx.Exit()
+
 	return nil
 }
 
@@ -497,6 +549,59 @@ func (cat *catalog) validateTriggeringPolicy(ctx *validation.Context, p *message
 	}
 }
 
+// validateBlackoutWindow validates BlackoutWindow proto.
+//
+// Takes a set of all defined job IDs, to verify the window references only
+// declared jobs. Errors are returned via validation.Context.
+func validateBlackoutWindow(ctx *validation.Context, w *messages.BlackoutWindow, jobIDs stringset.Set) {
+	if w.Id == "" {
+		ctx.Errorf("missing 'id' field")
+	}
+
+	oneTime := w.Start != "" || w.End != ""
+	recurring := w.Schedule != "" || w.Duration != nil
+	switch {
+	case oneTime && recurring:
+		ctx.Errorf("either 'start' and 'end' or 'schedule' and 'duration' should be set, not both")
+	case oneTime:
+		start, err := time.Parse(time.RFC3339, w.Start)
+		if err != nil {
+			ctx.Errorf("bad 'start' field - %s", err)
+		}
+		end, err := time.Parse(time.RFC3339, w.End)
+		if err != nil {
+			ctx.Errorf("bad 'end' field - %s", err)
+		}
+		if !start.IsZero() && !end.IsZero() && !end.After(start) {
+			ctx.Errorf("'end' should be after 'start'")
+		}
+	case recurring:
+		switch sched, err := schedule.Parse(w.Schedule, 0); {
+		case err != nil:
+			ctx.Errorf("%s is not valid value for 'schedule' field - %s", w.Schedule, err)
+		case !sched.IsAbsolute() || w.Schedule == "triggered":
+			ctx.Errorf("%s is not valid value for 'schedule' field - expecting a cron-like expression", w.Schedule)
+		}
+		if w.Duration == nil {
+			ctx.Errorf("missing 'duration' field")
+		} else if err := w.Duration.CheckValid(); err != nil || w.Duration.AsDuration() <= 0 {
+			ctx.Errorf("bad 'duration' field - should be positive")
+		}
+	default:
+		ctx.Errorf("either 'start' and 'end' or 'schedule' and 'duration' should be set")
+	}
+
+	if _, ok := messages.BlackoutWindow_Action_name[int32(w.Action)]; !ok {
+		ctx.Errorf("unrecognized action %d", w.Action)
+	}
+
+	for _, id := range w.Job {
+		if !jobIDs.Has(id) {
+			ctx.Errorf("referencing unknown job %q", id)
+		}
+	}
+}
+
 // extractTaskProto visits all fields of a proto and sniffs ones that correspond
 // to task definitions (as registered via RegisterTaskManager). It ensures
 // there's one and only one such field, validates it, and returns it.
@@ -576,6 +681,145 @@ func normalizeTriggeredJobIDs(projectID string, t *messages.Trigger) []string {
 	return out
 }
 
+// validateDependsOn validates 'depends_on' field of a job.
+//
+// Takes a set of all defined job IDs (including triggers) and a set of trigger
+// IDs, to verify the job depends only on declared jobs. Triggers never run
+// invocations that could succeed, so depending on them is an error.
+func validateDependsOn(ctx *validation.Context, j *messages.Job, allJobIDs, triggerIDs stringset.Set) {
+	for _, id := range j.DependsOn {
+		switch {
+		case id == j.Id:
+			ctx.Errorf("the job can't depend on itself")
+		case triggerIDs.Has(id):
+			ctx.Errorf("%q in 'depends_on' field is a trigger, only jobs can be depended on", id)
+		case !allJobIDs.Has(id):
+			ctx.Errorf("referencing unknown job %q in 'depends_on' field", id)
+		}
+	}
+}
+
+// findDependencyCycle returns a list of job IDs that form a dependency cycle
+// (with the first ID repeated at the end) or nil if there are no cycles.
+//
+// Ignores references to unknown jobs.
+func findDependencyCycle(jobs []*messages.Job) []string {
+	deps := make(map[string][]string, len(jobs))
+	for _, j := range jobs {
+		deps[j.Id] = j.DependsOn
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(jobs))
+	var stack []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		switch state[id] {
+		case visiting:
+			for i, s := range stack {
+				if s == id {
+					return append(append([]string(nil), stack[i:]...), id)
+				}
+			}
+			panic("impossible")
+		case visited:
+			return nil
+		}
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, dep := range deps[id] {
+			if _, known := deps[dep]; known && dep != id {
+				if cycle := visit(dep); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = visited
+		return nil
+	}
+
+	for _, j := range jobs {
+		if cycle := visit(j.Id); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// normalizeDependsOn returns sorted list of jobIDs without duplicates.
+func normalizeDependsOn(projectID string, j *messages.Job) []string {
+	if len(j.DependsOn) == 0 {
+		return nil
+	}
+	set := stringset.New(len(j.DependsOn))
+	for _, id := range j.DependsOn {
+		set.Add(projectID + "/" + id)
+	}
+	out := set.ToSlice()
+	sort.Strings(out)
+	return out
+}
+
+// linkDependencies removes references to unknown jobs from DependsOn and
+// populates DependentJobIDs based on DependsOn.
+//
+// Jobs may be unknown if they are disabled or have invalid definitions.
+func linkDependencies(c context.Context, defs []Definition) {
+	idx := make(map[string]int, len(defs))
+	for i, def := range defs {
+		idx[def.JobID] = i
+	}
+	for i := range defs {
+		def := &defs[i]
+		if len(def.DependsOn) == 0 {
+			continue
+		}
+		filtered := def.DependsOn[:0]
+		for _, dep := range def.DependsOn {
+			if j, ok := idx[dep]; ok {
+				filtered = append(filtered, dep)
+				defs[j].DependentJobIDs = append(defs[j].DependentJobIDs, def.JobID)
+			} else {
+				logging.Warningf(c, "Job %q depends on unknown or disabled job %q, ignoring the dependency", def.JobID, dep)
+			}
+		}
+		if len(filtered) == 0 {
+			filtered = nil
+		}
+		def.DependsOn = filtered
+	}
+	for i := range defs {
+		sort.Strings(defs[i].DependentJobIDs)
+	}
+}
+
+// marshalBlackoutWindows serializes blackout windows that apply to the given
+// job into internal.BlackoutWindowList proto.
+//
+// Returns nil if there are no such windows.
+func marshalBlackoutWindows(jobID string, windows []*messages.BlackoutWindow) []byte {
+	var filtered []*messages.BlackoutWindow
+	for _, w := range windows {
+		if len(w.Job) == 0 || stringset.NewFromSlice(w.Job...).Has(jobID) {
+			filtered = append(filtered, w)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	out, err := proto.Marshal(&internal.BlackoutWindowList{Windows: filtered})
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal BlackoutWindowList - %s", err))
+	}
+	return out
+}
+
 // marshalTriggeringPolicy serializes TriggeringPolicy proto.
 func marshalTriggeringPolicy(p *messages.TriggeringPolicy) []byte {
 	if p == nil {
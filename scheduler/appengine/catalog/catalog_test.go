@@ -92,10 +92,19 @@ func TestProtoValidation(t *testing.T) {
 			Id:       "good",
 			Schedule: "blah",
 		}), should.ErrLike("not valid value for 'schedule' field"))
+		assert.Loosely(t, call(&messages.Job{
+			Id:       "good",
+			Schedule: "0 9 * * * in Mars/Olympus_Mons",
+		}), should.ErrLike("bad time zone"))
 		assert.Loosely(t, call(&messages.Job{
 			Id:       "good",
 			Schedule: "* * * * *",
 		}), should.ErrLike("can't find a recognized task definition"))
+		assert.Loosely(t, call(&messages.Job{
+			Id:       "good",
+			Schedule: "0 9 * * 1-5 in America/Los_Angeles",
+			Noop:     &messages.NoopTask{},
+		}), should.BeNil)
 		assert.Loosely(t, call(&messages.Job{
 			Id:               "good",
 			Schedule:         "* * * * *",
@@ -419,6 +428,251 @@ func TestValidateConfig(t *testing.T) {
 			`)), should.BeNil)
 			assert.Loosely(t, ctx.Finalize(), should.ErrLike(`duplicate id "dup"`))
 		})
+
+		t.Run("blackout windows", func(t *ftt.Test) {
+			validate := func(windows string) error {
+				ctx := &validation.Context{Context: testContext()}
+				assert.Loosely(t, rules.ValidateConfig(ctx, "projects/p", "luci-scheduler.cfg", []byte(`
+					job {
+						id: "job"
+						noop: { }
+					}
+				`+windows)), should.BeNil)
+				return ctx.Finalize()
+			}
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "holidays"
+					start: "2024-12-20T00:00:00-08:00"
+					end: "2025-01-02T00:00:00-08:00"
+				}
+				blackout_window {
+					id: "maintenance"
+					schedule: "0 2 * * 6 in America/Los_Angeles"
+					duration: { seconds: 14400 }
+					action: DEFER
+					job: "job"
+				}
+			`), should.BeNil)
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					start: "2024-12-20T00:00:00Z"
+					end: "2025-01-02T00:00:00Z"
+				}
+			`), should.ErrLike("missing 'id' field"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "dup"
+					start: "2024-12-20T00:00:00Z"
+					end: "2025-01-02T00:00:00Z"
+				}
+				blackout_window {
+					id: "dup"
+					start: "2024-12-20T00:00:00Z"
+					end: "2025-01-02T00:00:00Z"
+				}
+			`), should.ErrLike(`duplicate id "dup"`))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+				}
+			`), should.ErrLike("either 'start' and 'end' or 'schedule' and 'duration' should be set"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					start: "2024-12-20T00:00:00Z"
+					end: "2025-01-02T00:00:00Z"
+					schedule: "0 2 * * 6"
+				}
+			`), should.ErrLike("not both"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					start: "2025-01-02T00:00:00Z"
+					end: "2024-12-20T00:00:00Z"
+				}
+			`), should.ErrLike("'end' should be after 'start'"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					start: "yesterday"
+					end: "2024-12-20T00:00:00Z"
+				}
+			`), should.ErrLike("bad 'start' field"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					schedule: "with 10s interval"
+					duration: { seconds: 10 }
+				}
+			`), should.ErrLike("expecting a cron-like expression"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					schedule: "0 2 * * 6 in Nowhere/Special"
+					duration: { seconds: 10 }
+				}
+			`), should.ErrLike("bad time zone"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					schedule: "0 2 * * 6"
+				}
+			`), should.ErrLike("missing 'duration' field"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					schedule: "0 2 * * 6"
+					duration: { seconds: -10 }
+				}
+			`), should.ErrLike("bad 'duration' field"))
+
+			assert.Loosely(t, validate(`
+				blackout_window {
+					id: "w"
+					start: "2024-12-20T00:00:00Z"
+					end: "2025-01-02T00:00:00Z"
+					job: "unknown"
+				}
+			`), should.ErrLike(`referencing unknown job "unknown"`))
+		})
+
+		t.Run("job dependencies", func(t *ftt.Test) {
+			validate := func(cfg string) error {
+				ctx := &validation.Context{Context: testContext()}
+				assert.Loosely(t, rules.ValidateConfig(ctx, "projects/p", "luci-scheduler.cfg", []byte(cfg)), should.BeNil)
+				return ctx.Finalize()
+			}
+
+			assert.Loosely(t, validate(`
+				trigger {
+					id: "nightly"
+					triggers: "a"
+					triggers: "b"
+					noop: { }
+				}
+				job {
+					id: "a"
+					noop: { }
+				}
+				job {
+					id: "b"
+					noop: { }
+				}
+				job {
+					id: "c"
+					depends_on: "a"
+					depends_on: "b"
+					noop: { }
+				}
+			`), should.BeNil)
+
+			assert.Loosely(t, validate(`
+				job {
+					id: "c"
+					depends_on: "unknown"
+					noop: { }
+				}
+			`), should.ErrLike(`referencing unknown job "unknown" in 'depends_on' field`))
+
+			assert.Loosely(t, validate(`
+				trigger {
+					id: "nightly"
+					triggers: "c"
+					noop: { }
+				}
+				job {
+					id: "c"
+					depends_on: "nightly"
+					noop: { }
+				}
+			`), should.ErrLike(`"nightly" in 'depends_on' field is a trigger, only jobs can be depended on`))
+
+			assert.Loosely(t, validate(`
+				job {
+					id: "c"
+					depends_on: "c"
+					noop: { }
+				}
+			`), should.ErrLike("the job can't depend on itself"))
+
+			assert.Loosely(t, validate(`
+				job {
+					id: "a"
+					depends_on: "c"
+					noop: { }
+				}
+				job {
+					id: "b"
+					depends_on: "a"
+					noop: { }
+				}
+				job {
+					id: "c"
+					depends_on: "b"
+					noop: { }
+				}
+			`), should.ErrLike("dependencies form a cycle: a -> c -> b -> a"))
+		})
+	})
+}
+
+func TestLinkDependencies(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		defs := []Definition{
+			{JobID: "p/a"},
+			{JobID: "p/b"},
+			{JobID: "p/c", DependsOn: []string{"p/a", "p/b", "p/disabled"}},
+			{JobID: "p/d", DependsOn: []string{"p/disabled"}},
+			{JobID: "p/e", DependsOn: []string{"p/a"}},
+		}
+		linkDependencies(testContext(), defs)
+		assert.Loosely(t, defs, should.Match([]Definition{
+			{JobID: "p/a", DependentJobIDs: []string{"p/c", "p/e"}},
+			{JobID: "p/b", DependentJobIDs: []string{"p/c"}},
+			{JobID: "p/c", DependsOn: []string{"p/a", "p/b"}},
+			{JobID: "p/d"},
+			{JobID: "p/e", DependsOn: []string{"p/a"}},
+		}))
+	})
+}
+
+func TestMarshalBlackoutWindows(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Filters windows by job ID", t, func(t *ftt.Test) {
+		windows := []*messages.BlackoutWindow{
+			{Id: "all"},
+			{Id: "some", Job: []string{"job-1", "job-2"}},
+			{Id: "other", Job: []string{"job-3"}},
+		}
+
+		unmarshal := func(blob []byte) (ids []string) {
+			list := &internal.BlackoutWindowList{}
+			assert.Loosely(t, proto.Unmarshal(blob, list), should.BeNil)
+			for _, w := range list.Windows {
+				ids = append(ids, w.Id)
+			}
+			return
+		}
+
+		assert.Loosely(t, unmarshal(marshalBlackoutWindows("job-1", windows)), should.Match([]string{"all", "some"}))
+		assert.Loosely(t, unmarshal(marshalBlackoutWindows("job-3", windows)), should.Match([]string{"all", "other"}))
+		assert.Loosely(t, marshalBlackoutWindows("job-1", nil), should.BeNil)
+		assert.Loosely(t, marshalBlackoutWindows("job-4", windows[1:]), should.BeNil)
 	})
 }
 
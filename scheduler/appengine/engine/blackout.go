@@ -0,0 +1,167 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	ds "go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/messages"
+	"go.chromium.org/luci/scheduler/appengine/schedule"
+	"go.chromium.org/luci/scheduler/appengine/task"
+)
+
+// maxRecurringWindowStarts limits how many consecutive starts of a recurring
+// blackout window are examined when looking for the end of overlapping
+// windows.
+const maxRecurringWindowStarts = 1000
+
+// unmarshalBlackoutWindows deserializes Job.BlackoutWindowsRaw.
+func unmarshalBlackoutWindows(raw []byte) ([]*messages.BlackoutWindow, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	list := internal.BlackoutWindowList{}
+	if err := proto.Unmarshal(raw, &list); err != nil {
+		return nil, err
+	}
+	return list.Windows, nil
+}
+
+// activeBlackoutWindow returns a blackout window that is active at the given
+// moment in time, along with the time it ends.
+//
+// If several windows are active, the first one (in order of their definition
+// in the config) is returned. Returns nil if there are no active windows.
+//
+// Broken windows (they are validated when the config is imported, so this
+// should not really happen) are logged and skipped.
+func activeBlackoutWindow(c context.Context, raw []byte, now time.Time) (*messages.BlackoutWindow, time.Time) {
+	windows, err := unmarshalBlackoutWindows(raw)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "Failed to unmarshal BlackoutWindowsRaw, ignoring blackout windows")
+		return nil, time.Time{}
+	}
+	for _, w := range windows {
+		switch end, err := blackoutWindowEnd(w, now); {
+		case err != nil:
+			logging.WithError(err).Errorf(c, "Broken blackout window %q, ignoring it", w.Id)
+		case !end.IsZero():
+			return w, end
+		}
+	}
+	return nil, time.Time{}
+}
+
+// blackoutWindowEnd returns when the window ends if it is active at the given
+// moment in time or zero time if it is not active.
+func blackoutWindowEnd(w *messages.BlackoutWindow, now time.Time) (time.Time, error) {
+	if w.Schedule == "" {
+		start, err := time.Parse(time.RFC3339, w.Start)
+		if err != nil {
+			return time.Time{}, errors.Annotate(err, "bad start").Err()
+		}
+		end, err := time.Parse(time.RFC3339, w.End)
+		if err != nil {
+			return time.Time{}, errors.Annotate(err, "bad end").Err()
+		}
+		if now.Before(start) || !now.Before(end) {
+			return time.Time{}, nil
+		}
+		return end.UTC(), nil
+	}
+
+	sched, err := schedule.Parse(w.Schedule, 0)
+	if err != nil {
+		return time.Time{}, errors.Annotate(err, "bad schedule").Err()
+	}
+	dur := w.Duration.AsDuration()
+	if dur <= 0 {
+		return time.Time{}, errors.Reason("bad duration %s", dur).Err()
+	}
+
+	// Only a window that started within (now-dur, now] can cover 'now'. If there
+	// is one, keep extending the end while the following windows overlap it.
+	start := sched.Next(now.Add(-dur), time.Time{})
+	if start.IsZero() || start == schedule.DistantFuture || start.After(now) {
+		return time.Time{}, nil
+	}
+	end := start.Add(dur)
+	for i := 0; i < maxRecurringWindowStarts; i++ {
+		start = sched.Next(start, time.Time{})
+		if start.IsZero() || start == schedule.DistantFuture || start.After(end) {
+			break
+		}
+		end = start.Add(dur)
+	}
+	return end.UTC(), nil
+}
+
+// recordSkippedTick records a cron tick suppressed by a blackout window as
+// a finished invocation with StatusSkipped status.
+//
+// Must be called within a Job transaction. Unlike regular invocations, the
+// invocation entity is stored as part of this transaction: it is already
+// finished, so nothing else will ever touch it. It is added straight to the
+// job's FinishedInvocationsRaw list, to make it show up in the listings right
+// away.
+func recordSkippedTick(c context.Context, job *Job, trigger *internal.Trigger, w *messages.BlackoutWindow, end time.Time) error {
+	assertInTransaction(c)
+
+	now := clock.Now(c).UTC()
+	id, err := generateInvocationID(c)
+	if err != nil {
+		return errors.Annotate(err, "failed to generate invocation ID").Err()
+	}
+	inv := &Invocation{
+		ID:                  id,
+		JobID:               job.JobID,
+		IndexedJobID:        job.JobID,
+		RealmID:             job.RealmID,
+		Started:             now,
+		Finished:            now,
+		Revision:            job.Revision,
+		RevisionURL:         job.RevisionURL,
+		Task:                job.Task,
+		TriggeredJobIDs:     job.TriggeredJobIDs,
+		IncomingTriggersRaw: marshalTriggersList([]*internal.Trigger{trigger}),
+		Status:              task.StatusSkipped,
+	}
+	inv.debugLog(c, "The cron tick happened within the blackout window %q", w.Id)
+	inv.debugLog(c, "The invocation is skipped, the window ends at %s", end)
+	if err := ds.Put(c, inv); err != nil {
+		return transient.Tag.Apply(err)
+	}
+
+	finished, err := unmarshalFinishedInvs(job.FinishedInvocationsRaw)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "Failed to unmarshal FinishedInvocationsRaw, resetting it")
+	}
+	job.FinishedInvocationsRaw = marshalFinishedInvs(append(finished, &internal.FinishedInvocation{
+		InvocationId: id,
+		Finished:     timestamppb.New(now),
+	}))
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/appengine/tq/tqtesting"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/scheduler/appengine/catalog"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/messages"
+	"go.chromium.org/luci/scheduler/appengine/task"
+)
+
+func TestBlackoutWindowEnd(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("One-time window", t, func(t *ftt.Test) {
+		w := &messages.BlackoutWindow{
+			Id:    "freeze",
+			Start: "2015-09-14T22:42:00Z",
+			End:   "2015-09-14T23:00:00Z",
+		}
+		end, err := blackoutWindowEnd(w, epoch.Add(-time.Second))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end.IsZero(), should.BeTrue)
+
+		end, err = blackoutWindowEnd(w, epoch)
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end, should.Match(epoch.Add(18*time.Minute)))
+
+		end, err = blackoutWindowEnd(w, epoch.Add(18*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end.IsZero(), should.BeTrue)
+	})
+
+	ftt.Run("Recurring window", t, func(t *ftt.Test) {
+		// Each hour at :30 for 10 min.
+		w := &messages.BlackoutWindow{
+			Id:       "maintenance",
+			Schedule: "30 * * * *",
+			Duration: durationpb.New(10 * time.Minute),
+		}
+		hour := epoch.Truncate(time.Hour)
+
+		end, err := blackoutWindowEnd(w, hour.Add(29*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end.IsZero(), should.BeTrue)
+
+		end, err = blackoutWindowEnd(w, hour.Add(30*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end, should.Match(hour.Add(40*time.Minute)))
+
+		end, err = blackoutWindowEnd(w, hour.Add(39*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end, should.Match(hour.Add(40*time.Minute)))
+
+		end, err = blackoutWindowEnd(w, hour.Add(40*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end.IsZero(), should.BeTrue)
+	})
+
+	ftt.Run("Overlapping recurring windows", t, func(t *ftt.Test) {
+		// Each day at 22:00 and 22:10 for 15 min, i.e. from 22:00 till 22:25.
+		w := &messages.BlackoutWindow{
+			Id:       "overlapping",
+			Schedule: "0,10 22 * * *",
+			Duration: durationpb.New(15 * time.Minute),
+		}
+		day := epoch.Truncate(24 * time.Hour)
+
+		end, err := blackoutWindowEnd(w, day.Add(22*time.Hour+5*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end, should.Match(day.Add(22*time.Hour+25*time.Minute)))
+
+		end, err = blackoutWindowEnd(w, day.Add(22*time.Hour+25*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end.IsZero(), should.BeTrue)
+	})
+
+	ftt.Run("Recurring window in a time zone", t, func(t *ftt.Test) {
+		// Each day at 9 AM PDT (16:00 UTC) for 1 hour.
+		w := &messages.BlackoutWindow{
+			Id:       "morning",
+			Schedule: "0 9 * * * in America/Los_Angeles",
+			Duration: durationpb.New(time.Hour),
+		}
+		day := epoch.Truncate(24 * time.Hour)
+
+		end, err := blackoutWindowEnd(w, day.Add(16*time.Hour+30*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end, should.Match(day.Add(17*time.Hour)))
+
+		end, err = blackoutWindowEnd(w, day.Add(9*time.Hour+30*time.Minute))
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, end.IsZero(), should.BeTrue)
+	})
+}
+
+func TestCronBlackout(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("with fake env", t, func(t *ftt.Test) {
+		const testJobID = "project/job"
+
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+
+		updateJob := func(schedule string, w *messages.BlackoutWindow) {
+			blob, err := proto.Marshal(&internal.BlackoutWindowList{
+				Windows: []*messages.BlackoutWindow{w},
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, e.UpdateProjectJobs(c, "project", []catalog.Definition{
+				{
+					JobID:           testJobID,
+					RealmID:         "project:testing",
+					Revision:        "rev1",
+					Schedule:        schedule,
+					Task:            noopTaskBytes(),
+					BlackoutWindows: blob,
+				},
+			}), should.BeNil)
+			datastore.GetTestable(c).CatchupIndexes()
+		}
+
+		mgr.launchTask = func(ctx context.Context, ctl task.Controller) error {
+			ctl.State().Status = task.StatusSucceeded
+			return nil
+		}
+
+		tq := tqtesting.GetTestable(c, e.cfg.Dispatcher)
+		tq.CreateQueues()
+
+		listStatuses := func() []task.Status {
+			datastore.GetTestable(c).CatchupIndexes()
+			job, err := e.getJob(c, testJobID)
+			assert.Loosely(t, err, should.BeNil)
+			invs, _, err := e.ListInvocations(c, job, ListInvocationsOpts{FinishedOnly: true})
+			assert.Loosely(t, err, should.BeNil)
+			var out []task.Status
+			for _, inv := range invs {
+				out = append(out, inv.Status)
+			}
+			return out
+		}
+
+		t.Run("SKIP", func(t *ftt.Test) {
+			// Ticks every 10 sec, the window covers ticks at 20 and 30 sec.
+			updateJob("*/10 * * * * * *", &messages.BlackoutWindow{
+				Id:    "freeze",
+				Start: epoch.Add(15 * time.Second).Format(time.RFC3339),
+				End:   epoch.Add(35 * time.Second).Format(time.RFC3339),
+			})
+
+			_, _, err := tq.RunSimulation(c, &tqtesting.SimulationParams{
+				Deadline: epoch.Add(45 * time.Second),
+			})
+			assert.Loosely(t, err, should.BeNil)
+
+			// Most recent first.
+			assert.Loosely(t, listStatuses(), should.Match([]task.Status{
+				task.StatusSucceeded,
+				task.StatusSkipped,
+				task.StatusSkipped,
+				task.StatusSucceeded,
+			}))
+		})
+
+		t.Run("DEFER", func(t *ftt.Test) {
+			// Ticks every 10 sec, the window covers ticks at 20 and 30 sec.
+			updateJob("*/10 * * * * * *", &messages.BlackoutWindow{
+				Id:     "freeze",
+				Start:  epoch.Add(15 * time.Second).Format(time.RFC3339),
+				End:    epoch.Add(35 * time.Second).Format(time.RFC3339),
+				Action: messages.BlackoutWindow_DEFER,
+			})
+
+			var enqueuedAt []time.Time
+			_, _, err := tq.RunSimulation(c, &tqtesting.SimulationParams{
+				Deadline: epoch.Add(45 * time.Second),
+				ShouldStopBefore: func(t tqtesting.Task) bool {
+					if _, ok := t.Payload.(*internal.EnqueueTriggersTask); ok {
+						enqueuedAt = append(enqueuedAt, t.Task.ETA)
+					}
+					return false
+				},
+			})
+			assert.Loosely(t, err, should.BeNil)
+
+			// Deferred ticks are enqueued when the window ends.
+			assert.Loosely(t, enqueuedAt, should.Match([]time.Time{
+				epoch.Add(10 * time.Second),
+				epoch.Add(35 * time.Second),
+				epoch.Add(35 * time.Second),
+				epoch.Add(40 * time.Second),
+			}))
+
+			// Nothing is skipped.
+			for _, s := range listStatuses() {
+				assert.Loosely(t, s, should.Equal(task.StatusSucceeded))
+			}
+		})
+	})
+}
@@ -237,6 +237,7 @@ func (ctl *taskController) EmitTrigger(ctx context.Context, trigger *internal.Tr
 	ctl.DebugLog("Emitting a trigger %s", trigger.Id)
 	trigger.JobId = ctl.JobID()
 	trigger.InvocationId = ctl.InvocationID()
+	trigger.Lineage = ctl.saved.Lineage
 
 	// See docs for internal.Trigger proto. Tuple (created, order_in_batch) used
 	// for casual ordering of triggers emitted by an invocation. Callers of
@@ -32,6 +32,7 @@ import (
 	api "go.chromium.org/luci/scheduler/api/scheduler/v1"
 	"go.chromium.org/luci/scheduler/appengine/engine/cron"
 	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/messages"
 )
 
 // pokeCron instantiates a cron state machine and calls the callback to advance
@@ -98,13 +99,29 @@ func pokeCron(c context.Context, job *Job, disp *tq.Dispatcher, cb func(m *cron.
 			})
 		case cron.StartInvocationAction:
 			trigger := cronTrigger(a, now)
-			logging.Infof(c, "Emitting cron trigger %s", trigger.Id)
-			tasks = append(tasks, &tq.Task{
+			enqueueTask := &tq.Task{
 				Payload: &internal.EnqueueTriggersTask{
 					JobId:    job.JobID,
 					Triggers: []*internal.Trigger{trigger},
 				},
-			})
+			}
+			// Blackout windows apply only to absolute schedules, see BlackoutWindow
+			// in config.proto.
+			if sched.IsAbsolute() {
+				if w, end := activeBlackoutWindow(c, job.BlackoutWindowsRaw, now); w != nil {
+					if w.Action != messages.BlackoutWindow_DEFER {
+						logging.Infof(c, "Skipping cron trigger %s due to blackout window %q", trigger.Id, w.Id)
+						if err := recordSkippedTick(c, job, trigger, w, end); err != nil {
+							return errors.Annotate(err, "failed to record skipped tick").Err()
+						}
+						continue
+					}
+					logging.Infof(c, "Deferring cron trigger %s until %s due to blackout window %q", trigger.Id, end, w.Id)
+					enqueueTask.ETA = end
+				}
+			}
+			logging.Infof(c, "Emitting cron trigger %s", trigger.Id)
+			tasks = append(tasks, enqueueTask)
 		default:
 			return errors.Reason("unknown action %T emitted by the cron machine", action).Err()
 		}
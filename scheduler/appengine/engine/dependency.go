@@ -0,0 +1,223 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/appengine/tq"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	ds "go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+)
+
+// barrierRetention is how long dependency barriers are kept around.
+//
+// Dependencies that succeed more than this apart within the same lineage don't
+// trigger the dependent job.
+const barrierRetention = 7 * 24 * time.Hour
+
+// DependencyBarrier tracks which dependencies of a job have succeeded within
+// some lineage.
+//
+// Root entity. ID is "<JobID>:<Lineage>". Created when the first dependency
+// succeeds, fired (i.e. emits a trigger for the job) when the last one does.
+// Entities older than barrierRetention are deleted.
+type DependencyBarrier struct {
+	_kind  string         `gae:"$kind,DependencyBarrier"`
+	_extra ds.PropertyMap `gae:"-,extra"`
+
+	// ID is "<JobID>:<Lineage>" string.
+	ID string `gae:"$id"`
+
+	// JobID is '<ProjectID>/<JobName>' string of the dependent job.
+	JobID string `gae:",noindex"`
+
+	// Lineage is the lineage of succeeded invocations, see internal.Trigger.
+	Lineage string `gae:",noindex"`
+
+	// Created is when the first dependency succeeded. Used for cleanup.
+	Created time.Time
+
+	// Succeeded is a sorted list of jobIDs of succeeded dependencies.
+	Succeeded []string `gae:",noindex"`
+
+	// Fired is true if the barrier emitted the trigger for the job already.
+	Fired bool `gae:",noindex"`
+}
+
+// invocationLineage returns a lineage of a new invocation.
+//
+// It is taken from the most recent incoming trigger that has it (triggers are
+// sorted by time already). If there's no such triggers, the invocation starts
+// a new lineage, identified by the invocation itself.
+func invocationLineage(inv *Invocation, triggers []*internal.Trigger) string {
+	for i := len(triggers) - 1; i >= 0; i-- {
+		if triggers[i].Lineage != "" {
+			return triggers[i].Lineage
+		}
+	}
+	return fmt.Sprintf("%s/%d", inv.JobID, inv.ID)
+}
+
+// execDependencySucceededTask updates the dependency barrier of a job when one
+// of its dependencies succeeds, triggering the job if all dependencies have
+// succeeded within the lineage.
+func (e *engineImpl) execDependencySucceededTask(c context.Context, tqTask proto.Message) error {
+	msg := tqTask.(*internal.DependencySucceededTask)
+
+	c = logging.SetField(c, "JobID", msg.JobId)
+
+	// Don't bother if the job is inactive. Triggers would be discarded anyway.
+	job, err := e.getJob(c, msg.JobId)
+	switch {
+	case err != nil:
+		logging.WithError(err).Errorf(c, "Failed to grab Job entity")
+		return err
+	case job == nil || !job.Enabled || job.Paused:
+		logging.Warningf(c, "Ignoring success of %q since the job is inactive", msg.DependencyJobId)
+		return nil
+	case !stringset.NewFromSlice(job.DependsOn...).Has(msg.DependencyJobId):
+		logging.Warningf(c, "The job no longer depends on %q, ignoring its success", msg.DependencyJobId)
+		return nil
+	}
+
+	// The dependent job gets the payload of the trigger that started the
+	// invocation that completed the barrier.
+	inv, err := e.getInvocation(c, msg.DependencyJobId, msg.InvId)
+	if err != nil {
+		logging.WithError(err).Errorf(c, "Failed to grab the succeeded invocation")
+		return err
+	}
+	incoming, err := inv.IncomingTriggers()
+	if err != nil {
+		logging.WithError(err).Errorf(c, "Failed to deserialize incoming triggers, ignoring them")
+	}
+
+	err = runTxn(c, func(c context.Context) error {
+		now := clock.Now(c).UTC()
+
+		barrier := &DependencyBarrier{ID: fmt.Sprintf("%s:%s", job.JobID, msg.Lineage)}
+		switch err := ds.Get(c, barrier); {
+		case err == ds.ErrNoSuchEntity:
+			barrier.JobID = job.JobID
+			barrier.Lineage = msg.Lineage
+			barrier.Created = now
+		case err != nil:
+			return transient.Tag.Apply(err)
+		case barrier.Fired:
+			logging.Infof(c, "The barrier for lineage %q has already fired", msg.Lineage)
+			return nil
+		}
+
+		succeeded := stringset.NewFromSlice(barrier.Succeeded...)
+		if !succeeded.Add(msg.DependencyJobId) {
+			return nil // a retry of an already processed task
+		}
+		barrier.Succeeded = succeeded.ToSortedSlice()
+
+		var missing []string
+		for _, dep := range job.DependsOn {
+			if !succeeded.Has(dep) {
+				missing = append(missing, dep)
+			}
+		}
+
+		if len(missing) != 0 {
+			logging.Infof(c, "Lineage %q is still waiting for %q", msg.Lineage, missing)
+		} else {
+			logging.Infof(c, "All dependencies succeeded in lineage %q, triggering the job", msg.Lineage)
+			barrier.Fired = true
+			err := e.cfg.Dispatcher.AddTask(c, &tq.Task{
+				Payload: &internal.EnqueueTriggersTask{
+					JobId:    job.JobID,
+					Triggers: []*internal.Trigger{dependencyTrigger(barrier, inv, incoming, now)},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return transient.Tag.Apply(ds.Put(c, barrier))
+	})
+	if err != nil {
+		return err
+	}
+
+	cleanupStaleBarriers(c)
+	return nil
+}
+
+// dependencyTrigger constructs a trigger emitted by a fired barrier.
+//
+// It copies the payload of the most recent trigger consumed by the given
+// invocation (i.e. the one that completed the barrier), so e.g. a revision
+// that triggered the dependencies triggers the dependent job too.
+func dependencyTrigger(b *DependencyBarrier, inv *Invocation, incoming []*internal.Trigger, now time.Time) *internal.Trigger {
+	t := &internal.Trigger{
+		Id:           "dependencies:" + b.ID,
+		JobId:        inv.JobID,
+		InvocationId: inv.ID,
+		Created:      timestamppb.New(now),
+		Title:        "All dependencies succeeded",
+		Lineage:      b.Lineage,
+	}
+	if len(incoming) != 0 {
+		last := incoming[len(incoming)-1]
+		t.Url = last.Url
+		t.EmittedByUser = last.EmittedByUser
+		t.Payload = proto.Clone(last).(*internal.Trigger).Payload
+	} else {
+		// Should not really happen: all invocations are started by triggers. Use
+		// a payload that doesn't add any properties.
+		t.Payload = &internal.Trigger_Webui{Webui: &api.WebUITrigger{}}
+	}
+	return t
+}
+
+// cleanupStaleBarriers deletes some dependency barriers older than
+// barrierRetention.
+//
+// Best effort. Logs errors.
+func cleanupStaleBarriers(c context.Context) {
+	q := ds.NewQuery("DependencyBarrier").
+		Lt("Created", clock.Now(c).UTC().Add(-barrierRetention)).
+		KeysOnly(true).
+		Limit(100)
+	var keys []*ds.Key
+	if err := ds.GetAll(c, q, &keys); err != nil {
+		logging.WithError(err).Warningf(c, "Failed to query stale dependency barriers")
+		return
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if err := ds.Delete(c, keys); err != nil {
+		logging.WithError(err).Warningf(c, "Failed to delete stale dependency barriers")
+		return
+	}
+	logging.Infof(c, "Deleted %d stale dependency barriers", len(keys))
+}
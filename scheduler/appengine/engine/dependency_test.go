@@ -0,0 +1,162 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/appengine/tq/tqtesting"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	api "go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/catalog"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/task"
+)
+
+func TestInvocationLineage(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		inv := &Invocation{ID: 123, JobID: "project/job"}
+
+		assert.Loosely(t, invocationLineage(inv, nil), should.Equal("project/job/123"))
+		assert.Loosely(t, invocationLineage(inv, []*internal.Trigger{
+			{Id: "a", Lineage: "old"},
+			{Id: "b", Lineage: "new"},
+			{Id: "c"},
+		}), should.Equal("new"))
+	})
+}
+
+func TestDependencies(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("with fake env", t, func(t *ftt.Test) {
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+
+		tq := tqtesting.GetTestable(c, e.cfg.Dispatcher)
+		tq.CreateQueues()
+
+		const (
+			nightly = "project/nightly"
+			jobA    = "project/a"
+			jobB    = "project/b"
+			jobC    = "project/c"
+		)
+
+		// "nightly" triggers "a" and "b", "c" runs after both succeed.
+		def := func(jobID string) catalog.Definition {
+			return catalog.Definition{
+				JobID:    jobID,
+				RealmID:  "project:testing",
+				Revision: "rev1",
+				Schedule: "triggered",
+				Task:     noopTaskBytes(),
+			}
+		}
+		defs := []catalog.Definition{def(nightly), def(jobA), def(jobB), def(jobC)}
+		defs[0].Flavor = catalog.JobFlavorTrigger
+		defs[0].TriggeredJobIDs = []string{jobA, jobB}
+		defs[1].DependentJobIDs = []string{jobC}
+		defs[2].DependentJobIDs = []string{jobC}
+		defs[3].DependsOn = []string{jobA, jobB}
+		assert.Loosely(t, e.UpdateProjectJobs(c, "project", defs), should.BeNil)
+
+		// Jobs with failing invocations.
+		failing := map[string]bool{}
+		var cRequests []task.Request
+		mgr.launchTask = func(ctx context.Context, ctl task.Controller) error {
+			switch ctl.JobID() {
+			case nightly:
+				ctl.EmitTrigger(ctx, &internal.Trigger{
+					Id:      fmt.Sprintf("rev-%d", ctl.InvocationID()),
+					Payload: &internal.Trigger_Noop{Noop: &api.NoopTrigger{Data: "rev"}},
+				})
+			case jobC:
+				cRequests = append(cRequests, ctl.Request())
+			}
+			if failing[ctl.JobID()] {
+				ctl.State().Status = task.StatusFailed
+			} else {
+				ctl.State().Status = task.StatusSucceeded
+			}
+			return nil
+		}
+
+		runNightly := func() string {
+			inv := forceInvocation(c, e, nightly)
+			_, _, err := tq.RunSimulation(c, &tqtesting.SimulationParams{
+				Deadline: clock.Now(c).Add(time.Minute),
+			})
+			assert.Loosely(t, err, should.BeNil)
+			datastore.GetTestable(c).CatchupIndexes()
+			return fmt.Sprintf("%s/%d", nightly, inv.ID)
+		}
+
+		t.Run("runs after all dependencies succeed", func(t *ftt.Test) {
+			lineage := runNightly()
+
+			assert.Loosely(t, cRequests, should.HaveLength(1))
+			req := cRequests[0]
+			assert.Loosely(t, req.TriggerIDs(), should.Match([]string{"dependencies:project/c:" + lineage}))
+			assert.Loosely(t, req.StringProperty("noop_trigger_data"), should.Equal("rev"))
+			assert.Loosely(t, req.IncomingTriggers[0].Lineage, should.Equal(lineage))
+
+			barrier := &DependencyBarrier{ID: jobC + ":" + lineage}
+			assert.Loosely(t, datastore.Get(c, barrier), should.BeNil)
+			assert.Loosely(t, barrier.Fired, should.BeTrue)
+			assert.Loosely(t, barrier.Succeeded, should.Match([]string{jobA, jobB}))
+
+			// Each lineage triggers "c" once.
+			runNightly()
+			assert.Loosely(t, cRequests, should.HaveLength(2))
+		})
+
+		t.Run("doesn't run if a dependency fails", func(t *ftt.Test) {
+			failing[jobB] = true
+			lineage := runNightly()
+
+			assert.Loosely(t, cRequests, should.HaveLength(0))
+
+			barrier := &DependencyBarrier{ID: jobC + ":" + lineage}
+			assert.Loosely(t, datastore.Get(c, barrier), should.BeNil)
+			assert.Loosely(t, barrier.Fired, should.BeFalse)
+			assert.Loosely(t, barrier.Succeeded, should.Match([]string{jobA}))
+		})
+
+		t.Run("stale barriers are cleaned up", func(t *ftt.Test) {
+			failing[jobB] = true
+			lineage := runNightly()
+
+			// Far in the future, another lineage cleans up the old barrier.
+			clock.Get(c).(testclock.TestClock).Add(barrierRetention + time.Hour)
+			failing[jobB] = false
+			runNightly()
+
+			barrier := &DependencyBarrier{ID: jobC + ":" + lineage}
+			assert.Loosely(t, datastore.Get(c, barrier), should.Equal(datastore.ErrNoSuchEntity))
+		})
+	})
+}
@@ -263,6 +263,7 @@ func (e *engineImpl) init() {
 	e.cfg.Dispatcher.RegisterTask(&internal.InvocationFinishedTask{}, e.execInvocationFinishedTask, "completions", nil)
 	e.cfg.Dispatcher.RegisterTask(&internal.FanOutTriggersTask{}, e.execFanOutTriggersTask, "triggers", nil)
 	e.cfg.Dispatcher.RegisterTask(&internal.EnqueueTriggersTask{}, e.execEnqueueTriggersTask, "triggers", nil)
+	e.cfg.Dispatcher.RegisterTask(&internal.DependencySucceededTask{}, e.execDependencySucceededTask, "triggers", nil)
 	e.cfg.Dispatcher.RegisterTask(&internal.ScheduleTimersTask{}, e.execScheduleTimersTask, "timers", nil)
 	e.cfg.Dispatcher.RegisterTask(&internal.TimerTask{}, e.execTimerTask, "timers", nil)
 	e.cfg.Dispatcher.RegisterTask(&internal.CronTickTask{}, e.execCronTickTask, "crons", nil)
@@ -944,6 +945,9 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 				Task:                def.Task,
 				TriggeringPolicyRaw: def.TriggeringPolicy,
 				TriggeredJobIDs:     def.TriggeredJobIDs,
+				BlackoutWindowsRaw:  def.BlackoutWindows,
+				DependsOn:           def.DependsOn,
+				DependentJobIDs:     def.DependentJobIDs,
 			}
 		}
 		wasDisabled := !job.Enabled
@@ -960,6 +964,9 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 		job.Task = def.Task
 		job.TriggeringPolicyRaw = def.TriggeringPolicy
 		job.TriggeredJobIDs = def.TriggeredJobIDs
+		job.BlackoutWindowsRaw = def.BlackoutWindows
+		job.DependsOn = def.DependsOn
+		job.DependentJobIDs = def.DependentJobIDs
 
 		// If job triggering policy has changed, schedule a triage to potentially
 		// act based on the new policy.
@@ -1109,11 +1116,13 @@ func (e *engineImpl) allocateInvocation(c context.Context, job *Job, req task.Re
 			RevisionURL:     job.RevisionURL,
 			Task:            job.Task,
 			TriggeredJobIDs: job.TriggeredJobIDs,
+			DependentJobIDs: job.DependentJobIDs,
 			Status:          task.StatusStarting,
 		}, &req)
 		if err != nil {
 			return
 		}
+		inv.Lineage = invocationLineage(inv, req.IncomingTriggers)
 		inv.debugLog(c, "New invocation is queued and will start shortly")
 		if req.TriggeredBy != "" {
 			inv.debugLog(c, "Triggered by %s", req.TriggeredBy)
@@ -1396,6 +1405,21 @@ func (e *engineImpl) invChanging(c context.Context, old, fresh *Invocation, time
 
 	var tasks []*tq.Task
 
+	// When the invocation succeeds, notify jobs that depend on it, so they can
+	// update their dependency barriers.
+	if !old.Status.Final() && fresh.Status == task.StatusSucceeded {
+		for _, jobID := range fresh.DependentJobIDs {
+			tasks = append(tasks, &tq.Task{
+				Payload: &internal.DependencySucceededTask{
+					JobId:           jobID,
+					DependencyJobId: fresh.JobID,
+					InvId:           fresh.ID,
+					Lineage:         fresh.Lineage,
+				},
+			})
+		}
+	}
+
 	if !old.Status.Final() && fresh.Status.Final() {
 		// When invocation finishes, make it appear in the list of finished
 		// invocations (by setting the indexed field), and notify the parent job
@@ -1863,6 +1887,9 @@ func (e *engineImpl) execTriageJobStateTask(c context.Context, tqTask proto.Mess
 			_, err := e.enqueueInvocations(c, job, req)
 			return err
 		},
+		kickTriageLater: func(c context.Context, delay time.Duration) error {
+			return e.kickTriageLater(c, jobID, delay)
+		},
 	}
 
 	// Store the triage log no matter what (even if 'prepare' fails or the
@@ -774,8 +774,10 @@ func TestEnqueueInvocations(t *testing.T) {
 		invIDs := map[int64]bool{}
 		for _, inv := range invs {
 			invIDs[inv.ID] = true
+			assert.Loosely(t, inv.Lineage, should.Equal(fmt.Sprintf("project/job/%d", inv.ID)))
 			cpy := *inv
 			cpy.ID = 0
+			cpy.Lineage = ""
 			invsByTrigger[inv.TriggeredBy] = cpy
 		}
 		assert.Loosely(t, invsByTrigger, should.Resemble(map[identity.Identity]Invocation{
@@ -939,6 +941,7 @@ func TestLaunchInvocationTask(t *testing.T) {
 				Finished:       epoch,
 				Revision:       job.Revision,
 				Task:           job.Task,
+				Lineage:        fmt.Sprintf("project/job/%d", inv.ID),
 				Status:         task.StatusSucceeded,
 				MutationsCount: 2,
 				DebugLog: "[22:42:00.000] New invocation is queued and will start shortly\n" +
@@ -1058,6 +1061,7 @@ func TestAbortJob(t *testing.T) {
 				Finished:       epoch,
 				Revision:       "rev1",
 				Task:           noopTaskBytes(),
+				Lineage:        fmt.Sprintf("%s/%d", jobID, expectedInvID),
 				Status:         task.StatusAborted,
 				MutationsCount: 1,
 				DebugLog: "[22:42:00.000] New invocation is queued and will start shortly\n" +
@@ -1352,11 +1356,13 @@ func TestOneJobTriggersAnother(t *testing.T) {
 			assert.Loosely(t, err, should.BeNil)
 
 			// How these triggers are seen from outside the task.
+			expectedLineage := fmt.Sprintf("%s/%d", triggeringJob, triggeringInvID)
 			expectedTrigger1 := &internal.Trigger{
 				Id:           "t1",
 				JobId:        triggeringJob,
 				InvocationId: triggeringInvID,
 				Created:      timestamppb.New(epoch.Add(1 * time.Second)),
+				Lineage:      expectedLineage,
 			}
 			expectedTrigger2 := &internal.Trigger{
 				Id:           "t2",
@@ -1364,6 +1370,7 @@ func TestOneJobTriggersAnother(t *testing.T) {
 				InvocationId: triggeringInvID,
 				Created:      timestamppb.New(epoch.Add(1 * time.Second)),
 				OrderInBatch: 1, // second call to EmitTrigger done by the invocation
+				Lineage:      expectedLineage,
 			}
 
 			// All the tasks we've just executed.
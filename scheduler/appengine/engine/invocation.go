@@ -217,6 +217,16 @@ type Invocation struct {
 	// The list is sorted and without duplicates.
 	TriggeredJobIDs []string `gae:",noindex"`
 
+	// DependentJobIDs is a list of jobIDs of jobs which depend on this job.
+	// The list is sorted and without duplicates.
+	DependentJobIDs []string `gae:",noindex"`
+
+	// Lineage identifies a chain of invocations that originates from the same
+	// root event, see internal.Trigger proto.
+	//
+	// Set when the invocation is created and never changes.
+	Lineage string `gae:",noindex"`
+
 	// DebugLog is short free form text log with debug messages.
 	DebugLog string `gae:",noindex"`
 
@@ -259,6 +269,8 @@ func (e *Invocation) isEqual(other *Invocation) bool {
 		e.RevisionURL == other.RevisionURL &&
 		bytes.Equal(e.Task, other.Task) &&
 		equalSortedLists(e.TriggeredJobIDs, other.TriggeredJobIDs) &&
+		equalSortedLists(e.DependentJobIDs, other.DependentJobIDs) &&
+		e.Lineage == other.Lineage &&
 		e.DebugLog == other.DebugLog &&
 		e.RetryCount == other.RetryCount &&
 		e.Status == other.Status &&
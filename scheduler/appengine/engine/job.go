@@ -106,6 +106,15 @@ type Job struct {
 	// The list is sorted and without duplicates.
 	TriggeredJobIDs []string `gae:",noindex"`
 
+	// DependsOn is a list of jobIDs that must all succeed within the same
+	// lineage before this job is triggered.
+	// The list is sorted and without duplicates.
+	DependsOn []string `gae:",noindex"`
+
+	// DependentJobIDs is a list of jobIDs of jobs that depend on this job.
+	// The list is sorted and without duplicates.
+	DependentJobIDs []string `gae:",noindex"`
+
 	// Cron holds the state of the cron state machine.
 	Cron cron.State `gae:",noindex"`
 
@@ -115,6 +124,13 @@ type Job struct {
 	// the triage.
 	TriggeringPolicyRaw []byte `gae:",noindex"`
 
+	// BlackoutWindowsRaw is serialized internal.BlackoutWindowList proto with
+	// blackout windows that apply to this job, see db.proto.
+	//
+	// It is taken from the job definition stored in the catalog. Used when
+	// handling cron ticks.
+	BlackoutWindowsRaw []byte `gae:",noindex"`
+
 	// ActiveInvocations is ordered set of active invocation IDs.
 	//
 	// It contains IDs of pending, running or recently finished invocations,
@@ -137,6 +153,14 @@ type Job struct {
 	// horizon can be fetched using a regular datastore query.
 	FinishedInvocationsRaw []byte `gae:",noindex"`
 
+	// RecentLaunches is when recent invocations were requested by the triggering
+	// policy, most recent last.
+	//
+	// Populated only if the triggering policy looks at the launch history (see
+	// policy.HistoryHorizon). Entries older than the horizon are evicted during
+	// triages.
+	RecentLaunches []time.Time `gae:",noindex"`
+
 	// LastTriage is a time when the last triage transaction was committed.
 	LastTriage time.Time `gae:",noindex"`
 }
@@ -228,10 +252,14 @@ func (e *Job) IsEqual(other *Job) bool {
 		e.LastTriage.Equal(other.LastTriage) &&
 		bytes.Equal(e.Task, other.Task) &&
 		equalSortedLists(e.TriggeredJobIDs, other.TriggeredJobIDs) &&
+		equalSortedLists(e.DependsOn, other.DependsOn) &&
+		equalSortedLists(e.DependentJobIDs, other.DependentJobIDs) &&
 		e.Cron.Equal(&other.Cron) &&
 		bytes.Equal(e.TriggeringPolicyRaw, other.TriggeringPolicyRaw) &&
+		bytes.Equal(e.BlackoutWindowsRaw, other.BlackoutWindowsRaw) &&
 		equalInt64Lists(e.ActiveInvocations, other.ActiveInvocations) &&
-		bytes.Equal(e.FinishedInvocationsRaw, other.FinishedInvocationsRaw))
+		bytes.Equal(e.FinishedInvocationsRaw, other.FinishedInvocationsRaw) &&
+		equalTimeLists(e.RecentLaunches, other.RecentLaunches))
 }
 
 // MatchesDefinition returns true if job definition in the entity matches the
@@ -243,7 +271,10 @@ func (e *Job) MatchesDefinition(def catalog.Definition) bool {
 		e.Schedule == def.Schedule &&
 		bytes.Equal(e.Task, def.Task) &&
 		bytes.Equal(e.TriggeringPolicyRaw, def.TriggeringPolicy) &&
-		equalSortedLists(e.TriggeredJobIDs, def.TriggeredJobIDs)
+		bytes.Equal(e.BlackoutWindowsRaw, def.BlackoutWindows) &&
+		equalSortedLists(e.TriggeredJobIDs, def.TriggeredJobIDs) &&
+		equalSortedLists(e.DependsOn, def.DependsOn) &&
+		equalSortedLists(e.DependentJobIDs, def.DependentJobIDs)
 }
 
 // CronTickTime returns time when the cron job is expected to start again.
@@ -22,6 +22,7 @@ import (
 	"go.chromium.org/luci/common/errors"
 
 	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/task"
 )
 
 // basePolicy returns a generic policy customizable by the `reduce` func.
@@ -72,29 +73,35 @@ func basePolicy(maxConcurrentInvs, maxBatchSize int, reducer func([]*internal.Tr
 			batch := triggers[:size]
 			triggers = triggers[size:]
 
-			// Put them into the new invocation, deriving its properties from the most
-			// recent trigger (which is last in the list, since triggers are sorted by
-			// time already)
-			req := RequestBuilder{env: env}
-			req.FromTrigger(batch[len(batch)-1])
-			req.IncomingTriggers = batch
-
-			// If all triggers were submitted by the same single identity (perhaps the
-			// scheduler service itself as indicated by empty EmittedByUser),
-			// attribute the invocation to this identity. This is mostly for UI,
-			// nothing really depends on TriggeredBy field.
-			idents := stringset.New(1)
-			for _, t := range batch {
-				idents.Add(t.EmittedByUser)
-			}
-			if idents.Len() == 1 {
-				req.TriggeredBy = identity.Identity(idents.ToSlice()[0])
-			}
-
-			out.Requests = append(out.Requests, req.Request)
+			out.Requests = append(out.Requests, batchRequest(env, batch))
 			slots--
 		}
 
 		return
 	}, nil
 }
+
+// batchRequest prepares a request to start an invocation that consumes the
+// given batch of triggers.
+//
+// Properties of the invocation are derived from the most recent trigger (which
+// is last in the list, since triggers are sorted by time already).
+func batchRequest(env Environment, batch []*internal.Trigger) task.Request {
+	req := RequestBuilder{env: env}
+	req.FromTrigger(batch[len(batch)-1])
+	req.IncomingTriggers = batch
+
+	// If all triggers were submitted by the same single identity (perhaps the
+	// scheduler service itself as indicated by empty EmittedByUser), attribute
+	// the invocation to this identity. This is mostly for UI, nothing really
+	// depends on TriggeredBy field.
+	idents := stringset.New(1)
+	for _, t := range batch {
+		idents.Add(t.EmittedByUser)
+	}
+	if idents.Len() == 1 {
+		req.TriggeredBy = identity.Identity(idents.ToSlice()[0])
+	}
+
+	return req.Request
+}
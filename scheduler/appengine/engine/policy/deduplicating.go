@@ -0,0 +1,101 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/scheduler/appengine/internal"
+)
+
+// DeduplicatingPolicy instantiates new DEDUPLICATING policy function.
+//
+// It groups pending triggers by the properties and tags they would pass to an
+// invocation, and collapses each group into one new invocation. Groups are
+// launched in order of their oldest trigger.
+func DeduplicatingPolicy(maxConcurrentInvs, maxBatchSize int) (Func, error) {
+	switch {
+	case maxConcurrentInvs <= 0:
+		return nil, errors.Reason("max_concurrent_invocations should be positive").Err()
+	case maxBatchSize <= 0:
+		return nil, errors.Reason("max_batch_size should be positive").Err()
+	}
+
+	return func(env Environment, in In) (out Out) {
+		slots := maxConcurrentInvs - len(in.ActiveInvocations)
+		switch {
+		case len(in.Triggers) == 0:
+			return // nothing new to launch
+		case slots <= 0:
+			env.DebugLog(
+				"Max concurrent invocations is %d and there's %d running => refusing to launch more",
+				maxConcurrentInvs, len(in.ActiveInvocations))
+			return // maxed all available slots
+		}
+
+		// Group triggers by their payload, preserving the order of the oldest
+		// trigger in each group. Triggers within a group stay sorted by time.
+		var keys []string
+		groups := map[string][]*internal.Trigger{}
+		for _, t := range in.Triggers {
+			key := payloadKey(t)
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], t)
+		}
+
+		for _, key := range keys {
+			if slots == 0 {
+				break
+			}
+			batch := groups[key]
+			if len(batch) > maxBatchSize {
+				batch = batch[:maxBatchSize]
+			}
+			if len(batch) > 1 {
+				env.DebugLog("Collapsing %d triggers with identical payload into one invocation", len(batch))
+			}
+			out.Requests = append(out.Requests, batchRequest(env, batch))
+			slots--
+		}
+
+		return
+	}, nil
+}
+
+// payloadKey returns a string that identifies properties and tags that the
+// trigger would pass to an invocation.
+//
+// Triggers with equal keys result in identical invocations.
+func payloadKey(t *internal.Trigger) string {
+	req := RequestBuilder{} // no env: don't spam the triage log
+	req.FromTrigger(t)
+
+	props, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.Properties)
+	if err != nil {
+		panic(errors.Annotate(err, "failed to marshal properties").Err())
+	}
+
+	tags := append([]string(nil), req.Tags...)
+	sort.Strings(tags)
+
+	return string(props) + "\x00" + strings.Join(tags, "\x00")
+}
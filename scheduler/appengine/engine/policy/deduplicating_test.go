@@ -0,0 +1,147 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/task"
+)
+
+func TestDeduplicating(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With simulator", t, func(t *ftt.Test) {
+		var err error
+		s := Simulator{
+			OnRequest: func(s *Simulator, r task.Request) time.Duration {
+				return time.Minute
+			},
+			OnDebugLog: func(format string, args ...any) {
+				t.Logf(format+"\n", args...)
+			},
+		}
+
+		t.Run("Max concurrent invocations must be positive", func(t *ftt.Test) {
+			s.Policy, err = DeduplicatingPolicy(0, 1)
+			assert.Loosely(t, err, should.NotBeNil)
+		})
+
+		t.Run("Max batch size must be positive", func(t *ftt.Test) {
+			s.Policy, err = DeduplicatingPolicy(1, 0)
+			assert.Loosely(t, err, should.NotBeNil)
+		})
+
+		t.Run("Collapses identical payloads", func(t *ftt.Test) {
+			s.Policy, err = DeduplicatingPolicy(1, 1000)
+			assert.Loosely(t, err, should.BeNil)
+
+			s.AddTrigger(0,
+				internal.NoopTrigger("t1", "a"),
+				internal.NoopTrigger("t2", "b"),
+				internal.NoopTrigger("t3", "a"))
+
+			// Triggers with "a" payload go first, since "a" was seen first.
+			assert.Loosely(t, s.Invocations, should.HaveLength(1))
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t1", "t3"}))
+			assert.Loosely(t, s.Last().Request.StringProperty("noop_trigger_data"), should.Equal("a"))
+			assert.Loosely(t, s.PendingTriggers, should.HaveLength(1))
+
+			// More identical triggers arrive while "b" is still pending.
+			s.AddTrigger(30*time.Second,
+				internal.NoopTrigger("t4", "b"),
+				internal.NoopTrigger("t5", "c"))
+			assert.Loosely(t, s.Invocations, should.HaveLength(1))
+
+			s.AdvanceTime(30 * time.Second)
+			assert.Loosely(t, s.Invocations, should.HaveLength(2))
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t2", "t4"}))
+
+			s.AdvanceTime(time.Minute)
+			assert.Loosely(t, s.Invocations, should.HaveLength(3))
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t5"}))
+			assert.Loosely(t, s.PendingTriggers, should.HaveLength(0))
+			assert.Loosely(t, s.DiscardedTriggers, should.HaveLength(0))
+		})
+
+		t.Run("Launches distinct payloads concurrently", func(t *ftt.Test) {
+			s.Policy, err = DeduplicatingPolicy(2, 1000)
+			assert.Loosely(t, err, should.BeNil)
+
+			s.AddTrigger(0,
+				internal.NoopTrigger("t1", "a"),
+				internal.NoopTrigger("t2", "b"),
+				internal.NoopTrigger("t3", "c"),
+				internal.NoopTrigger("t4", "b"))
+
+			assert.Loosely(t, s.Invocations, should.HaveLength(2))
+			assert.Loosely(t, s.Invocations[0].Request.TriggerIDs(), should.Match([]string{"t1"}))
+			assert.Loosely(t, s.Invocations[1].Request.TriggerIDs(), should.Match([]string{"t2", "t4"}))
+			assert.Loosely(t, s.PendingTriggers, should.HaveLength(1))
+		})
+
+		t.Run("Respects max batch size", func(t *ftt.Test) {
+			s.Policy, err = DeduplicatingPolicy(1, 2)
+			assert.Loosely(t, err, should.BeNil)
+
+			s.AddTrigger(0,
+				internal.NoopTrigger("t1", "a"),
+				internal.NoopTrigger("t2", "a"),
+				internal.NoopTrigger("t3", "a"))
+
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t1", "t2"}))
+			s.AdvanceTime(time.Minute)
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t3"}))
+		})
+
+		t.Run("Ignores tags order", func(t *ftt.Test) {
+			s.Policy, err = DeduplicatingPolicy(1, 1000)
+			assert.Loosely(t, err, should.BeNil)
+
+			bbTrigger := func(id string, tags ...string) internal.Trigger {
+				return internal.Trigger{
+					Id: id,
+					Payload: &internal.Trigger_Buildbucket{
+						Buildbucket: &scheduler.BuildbucketTrigger{
+							Properties: &structpb.Struct{
+								Fields: map[string]*structpb.Value{
+									"k": structpb.NewStringValue("v"),
+								},
+							},
+							Tags: tags,
+						},
+					},
+				}
+			}
+
+			s.AddTrigger(0,
+				bbTrigger("t1", "a:1", "b:2"),
+				bbTrigger("t2", "c:3"),
+				bbTrigger("t3", "b:2", "a:1"))
+
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t1", "t3"}))
+			assert.Loosely(t, s.PendingTriggers, should.HaveLength(1))
+		})
+	})
+}
@@ -47,6 +47,12 @@ type In struct {
 	ActiveInvocations []int64
 	// Triggers is a list of pending triggers sorted by time, more recent last.
 	Triggers []*internal.Trigger
+	// RecentLaunches is a list of times when recent invocations of the job were
+	// requested by the policy, sorted by time, more recent last.
+	//
+	// Only covers the interval returned by HistoryHorizon. Older entries may or
+	// may not be present.
+	RecentLaunches []time.Time
 }
 
 // Out contains the decision of a triggering policy function.
@@ -65,6 +71,13 @@ type Out struct {
 	//
 	// A trigger associated with a request must not be also slated for discard.
 	Discard []*internal.Trigger
+
+	// RetriageAt, if not zero, is when the policy wants to be called again even
+	// if nothing else happens with the job by then.
+	//
+	// Used by policies that hold pending triggers until some moment in time
+	// (e.g. until a rate limit frees up).
+	RetriageAt time.Time
 }
 
 // Func is the concrete implementation of a triggering policy.
@@ -98,6 +111,10 @@ func New(p *messages.TriggeringPolicy) (Func, error) {
 		return LogarithmicBatchingPolicy(int(p.MaxConcurrentInvocations), int(p.MaxBatchSize), float64(p.LogBase))
 	case messages.TriggeringPolicy_NEWEST_FIRST:
 		return NewestFirstPolicy(int(p.MaxConcurrentInvocations), p.PendingTimeout.AsDuration())
+	case messages.TriggeringPolicy_RATE_LIMITED:
+		return RateLimitedPolicy(int(p.MaxConcurrentInvocations), int(p.MaxBatchSize), int(p.MaxInvocationsPerWindow), p.RateLimitWindow.AsDuration())
+	case messages.TriggeringPolicy_DEDUPLICATING:
+		return DeduplicatingPolicy(int(p.MaxConcurrentInvocations), int(p.MaxBatchSize))
 	default:
 		return nil, errors.Reason("unrecognized triggering policy kind %d", p.Kind).Err()
 	}
@@ -124,6 +141,17 @@ func Default() Func {
 	return f
 }
 
+// HistoryHorizon returns how far back in time the policy needs to see launched
+// invocations (see In.RecentLaunches).
+//
+// Returns 0 if the policy doesn't look at the launch history at all.
+func HistoryHorizon(p *messages.TriggeringPolicy) time.Duration {
+	if p.Kind == messages.TriggeringPolicy_RATE_LIMITED {
+		return p.RateLimitWindow.AsDuration()
+	}
+	return 0
+}
+
 // UnmarshalDefinition deserializes TriggeringPolicy, filling in defaults.
 func UnmarshalDefinition(b []byte) (*messages.TriggeringPolicy, error) {
 	msg := &messages.TriggeringPolicy{}
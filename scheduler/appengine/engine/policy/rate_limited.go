@@ -0,0 +1,96 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/scheduler/appengine/internal"
+)
+
+// RateLimitedPolicy instantiates new RATE_LIMITED policy function.
+//
+// It behaves like GREEDY_BATCHING, but launches at most `maxInvs` invocations
+// within any `window` interval. Triggers that can't be launched because of the
+// rate limit stay pending, and the policy asks to be called again when the
+// oldest launch in the window expires.
+func RateLimitedPolicy(maxConcurrentInvs, maxBatchSize, maxInvs int, window time.Duration) (Func, error) {
+	switch {
+	case maxInvs <= 0:
+		return nil, errors.Reason("max_invocations_per_window should be positive").Err()
+	case window <= 0:
+		return nil, errors.Reason("rate_limit_window should be positive").Err()
+	}
+
+	greedy, err := basePolicy(maxConcurrentInvs, maxBatchSize, func(triggers []*internal.Trigger) int {
+		return len(triggers)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(env Environment, in In) (out Out) {
+		if len(in.Triggers) == 0 {
+			return // nothing new to launch
+		}
+
+		// Launches that happened within (Now-window, Now], oldest first.
+		recent := in.RecentLaunches
+		for len(recent) > 0 && !recent[0].After(in.Now.Add(-window)) {
+			recent = recent[1:]
+		}
+
+		quota := maxInvs - len(recent)
+		if quota <= 0 {
+			env.DebugLog(
+				"Max invocations per %s is %d and there were %d launched => refusing to launch more",
+				window, maxInvs, len(recent))
+			out.RetriageAt = recent[0].Add(window)
+			return
+		}
+
+		out = greedy(env, in)
+		if len(out.Requests) > quota {
+			env.DebugLog(
+				"Max invocations per %s is %d and there were %d launched => launching only %d out of %d",
+				window, maxInvs, len(recent), quota, len(out.Requests))
+			out.Requests = out.Requests[:quota]
+		}
+
+		// If some triggers are still pending, and the quota is used up now, ask to
+		// be called again when the oldest launch leaves the window. Otherwise the
+		// pending triggers will be examined when some invocation finishes.
+		if len(out.Requests) == quota && pendingAfter(in.Triggers, out) {
+			if len(recent) > 0 {
+				out.RetriageAt = recent[0].Add(window)
+			} else {
+				out.RetriageAt = in.Now.Add(window)
+			}
+		}
+		return
+	}, nil
+}
+
+// pendingAfter returns true if some of the given triggers are neither consumed
+// nor discarded by the policy decision.
+func pendingAfter(triggers []*internal.Trigger, out Out) bool {
+	handled := 0
+	for _, r := range out.Requests {
+		handled += len(r.IncomingTriggers)
+	}
+	return handled+len(out.Discard) < len(triggers)
+}
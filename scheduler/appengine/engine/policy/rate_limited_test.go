@@ -0,0 +1,128 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/task"
+)
+
+func TestRateLimited(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With simulator", t, func(t *ftt.Test) {
+		var err error
+		s := Simulator{
+			OnRequest: func(s *Simulator, r task.Request) time.Duration {
+				return time.Minute
+			},
+			OnDebugLog: func(format string, args ...any) {
+				t.Logf(format+"\n", args...)
+			},
+		}
+
+		t.Run("Max invocations per window must be positive", func(t *ftt.Test) {
+			s.Policy, err = RateLimitedPolicy(1, 1, 0, time.Hour)
+			assert.Loosely(t, err, should.NotBeNil)
+		})
+
+		t.Run("Rate limit window must be positive", func(t *ftt.Test) {
+			s.Policy, err = RateLimitedPolicy(1, 1, 1, 0)
+			assert.Loosely(t, err, should.NotBeNil)
+		})
+
+		t.Run("Max concurrent invocations must be positive", func(t *ftt.Test) {
+			s.Policy, err = RateLimitedPolicy(0, 1, 1, time.Hour)
+			assert.Loosely(t, err, should.NotBeNil)
+		})
+
+		t.Run("Rate limiting with batching works", func(t *ftt.Test) {
+			// At most 2 invocations per hour, unlimited batching.
+			s.Policy, err = RateLimitedPolicy(10, 1000, 2, time.Hour)
+			assert.Loosely(t, err, should.BeNil)
+
+			// The first two triggers are launched right away.
+			s.AddTrigger(0, internal.NoopTrigger("t1", "t1_data"))
+			s.AddTrigger(time.Minute, internal.NoopTrigger("t2", "t2_data"))
+			assert.Loosely(t, s.Invocations, should.HaveLength(2))
+
+			// The quota is exhausted, new triggers stay pending.
+			s.AddTrigger(time.Minute,
+				internal.NoopTrigger("t3", "t3_data"),
+				internal.NoopTrigger("t4", "t4_data"))
+			assert.Loosely(t, s.Invocations, should.HaveLength(2))
+			assert.Loosely(t, s.PendingTriggers, should.HaveLength(2))
+
+			// Still exhausted right before the first launch leaves the window.
+			s.AdvanceTime(57 * time.Minute)
+			assert.Loosely(t, s.Invocations, should.HaveLength(2))
+
+			// When it leaves the window, the pending triggers are collapsed into
+			// one invocation.
+			s.AdvanceTime(time.Minute)
+			assert.Loosely(t, s.Invocations, should.HaveLength(3))
+			assert.Loosely(t, s.Last().Created, should.Equal(time.Hour))
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t3", "t4"}))
+			assert.Loosely(t, s.PendingTriggers, should.HaveLength(0))
+			assert.Loosely(t, s.DiscardedTriggers, should.HaveLength(0))
+		})
+
+		t.Run("Rate limiting without batching works", func(t *ftt.Test) {
+			// At most 1 invocation per hour, one trigger per invocation.
+			s.Policy, err = RateLimitedPolicy(10, 1, 1, time.Hour)
+			assert.Loosely(t, err, should.BeNil)
+
+			s.AddTrigger(0,
+				internal.NoopTrigger("t1", ""),
+				internal.NoopTrigger("t2", ""),
+				internal.NoopTrigger("t3", ""))
+
+			// Triggers are launched one per hour, oldest first.
+			for i, id := range []string{"t1", "t2", "t3"} {
+				assert.Loosely(t, s.Invocations, should.HaveLength(i+1))
+				assert.Loosely(t, s.Last().Created, should.Equal(time.Duration(i)*time.Hour))
+				assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{id}))
+				assert.Loosely(t, s.PendingTriggers, should.HaveLength(2-i))
+				s.AdvanceTime(time.Hour)
+			}
+
+			// Nothing else happens.
+			s.AdvanceTime(5 * time.Hour)
+			assert.Loosely(t, s.Invocations, should.HaveLength(3))
+		})
+
+		t.Run("Respects max concurrent invocations", func(t *ftt.Test) {
+			s.Policy, err = RateLimitedPolicy(1, 1, 10, time.Hour)
+			assert.Loosely(t, err, should.BeNil)
+
+			s.AddTrigger(0,
+				internal.NoopTrigger("t1", ""),
+				internal.NoopTrigger("t2", ""))
+			assert.Loosely(t, s.Invocations, should.HaveLength(1))
+
+			// The second one is launched when the first invocation finishes.
+			s.AdvanceTime(time.Minute)
+			assert.Loosely(t, s.Invocations, should.HaveLength(2))
+			assert.Loosely(t, s.Last().Request.TriggerIDs(), should.Match([]string{"t2"}))
+		})
+	})
+}
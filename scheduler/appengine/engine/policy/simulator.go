@@ -91,6 +91,10 @@ type Simulator struct {
 	nextInvID int64
 	// invIDs is a set of running invocations.
 	invIDs map[int64]*SimulatedInvocation
+	// launches is when invocations were requested, most recent last.
+	launches []time.Time
+	// retriageAt is when the next triage requested by the policy happens.
+	retriageAt time.Time
 }
 
 // SimulatedInvocation contains details of an invocation.
@@ -174,6 +178,7 @@ func (s *Simulator) triage() {
 		Now:               s.Now,
 		ActiveInvocations: invs,
 		Triggers:          triggers,
+		RecentLaunches:    append([]time.Time(nil), s.launches...),
 	})
 
 	// Instantiate all new invocations and collect a set of consumed triggers.
@@ -204,6 +209,12 @@ func (s *Simulator) triage() {
 		}
 		s.PendingTriggers = filtered
 	}
+
+	// Schedule a triage requested by the policy, unless it is already scheduled.
+	if at := out.RetriageAt; at.After(s.Now) && !at.Equal(s.retriageAt) {
+		s.retriageAt = at
+		s.scheduleEvent(event{eta: at, cb: s.triage})
+	}
 }
 
 // handleRequest is called for each invocation request created by the policy.
@@ -222,6 +233,7 @@ func (s *Simulator) handleRequest(r task.Request) {
 		Running:  true,
 	}
 	s.Invocations = append(s.Invocations, inv)
+	s.launches = append(s.launches, s.Now)
 
 	s.nextInvID++
 	id := s.nextInvID
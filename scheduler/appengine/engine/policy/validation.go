@@ -29,7 +29,9 @@ func ValidateDefinition(ctx *validation.Context, p *messages.TriggeringPolicy) {
 		messages.TriggeringPolicy_UNDEFINED, // same as GREEDY_BATCHING
 		messages.TriggeringPolicy_GREEDY_BATCHING,
 		messages.TriggeringPolicy_LOGARITHMIC_BATCHING,
-		messages.TriggeringPolicy_NEWEST_FIRST:
+		messages.TriggeringPolicy_NEWEST_FIRST,
+		messages.TriggeringPolicy_RATE_LIMITED,
+		messages.TriggeringPolicy_DEDUPLICATING:
 		// ok
 	default:
 		ctx.Errorf("unrecognized policy kind %d", p.Kind)
@@ -54,6 +56,18 @@ func ValidateDefinition(ctx *validation.Context, p *messages.TriggeringPolicy) {
 		ctx.Errorf("pending_timeout is non-zero with non-NEWEST_FIRST policy, but pending_timeout doesn't apply to those policies")
 	}
 
+	// Rate limits are required for RATE_LIMITED and meaningless for the rest.
+	if p.Kind == messages.TriggeringPolicy_RATE_LIMITED {
+		if p.MaxInvocationsPerWindow <= 0 {
+			ctx.Errorf("max_invocations_per_window should be positive, got %d", p.MaxInvocationsPerWindow)
+		}
+		if p.RateLimitWindow.AsDuration() <= 0 {
+			ctx.Errorf("rate_limit_window should be positive, got %s", p.RateLimitWindow.AsDuration())
+		}
+	} else if p.MaxInvocationsPerWindow != 0 || p.RateLimitWindow.AsDuration() != 0 {
+		ctx.Errorf("max_invocations_per_window or rate_limit_window is set with non-RATE_LIMITED policy, but they don't apply to those policies")
+	}
+
 	if p.Kind == messages.TriggeringPolicy_LOGARITHMIC_BATCHING && p.LogBase < 1.0001 {
 		ctx.Errorf("log_base should be larger or equal 1.0001, got %f", p.LogBase)
 	}
@@ -59,5 +59,21 @@ func TestValidation(t *testing.T) {
 		assert.Loosely(t, run(messages.TriggeringPolicy{
 			PendingTimeout: durationpb.New(time.Hour)}),
 			should.ErrLike("pending_timeout is non-zero with non-NEWEST_FIRST policy"))
+		assert.Loosely(t, run(messages.TriggeringPolicy{
+			Kind: messages.TriggeringPolicy_DEDUPLICATING}), should.BeNil)
+		assert.Loosely(t, run(messages.TriggeringPolicy{
+			Kind:                    messages.TriggeringPolicy_RATE_LIMITED,
+			MaxInvocationsPerWindow: 5,
+			RateLimitWindow:         durationpb.New(time.Hour)}), should.BeNil)
+		assert.Loosely(t, run(messages.TriggeringPolicy{
+			Kind: messages.TriggeringPolicy_RATE_LIMITED}),
+			should.ErrLike("max_invocations_per_window should be positive, got 0"))
+		assert.Loosely(t, run(messages.TriggeringPolicy{
+			Kind:                    messages.TriggeringPolicy_RATE_LIMITED,
+			MaxInvocationsPerWindow: 5}),
+			should.ErrLike("rate_limit_window should be positive, got 0s"))
+		assert.Loosely(t, run(messages.TriggeringPolicy{
+			MaxInvocationsPerWindow: 5}),
+			should.ErrLike("is set with non-RATE_LIMITED policy"))
 	})
 }
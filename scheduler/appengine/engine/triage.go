@@ -70,6 +70,12 @@ type triageOp struct {
 	// Implemented by the engine, see engineImpl.enqueueInvocations.
 	enqueueInvocations func(c context.Context, job *Job, req []task.Request) error
 
+	// kickTriageLater transactionally schedules another triage of the job.
+	//
+	// Used when the triggering policy asks to be called again later. Implemented
+	// by the engine, see engineImpl.kickTriageLater.
+	kickTriageLater func(c context.Context, delay time.Duration) error
+
 	// maxAllowedTriggers limits how many pending triggers are allowed to exist.
 	//
 	// If the pending triggers set has more triggers, oldest ones are forcefully
@@ -407,7 +413,8 @@ func (op *triageOp) processTriggers(c context.Context, job *Job) (*dsset.PopOp,
 	// not the only input to the triggering policy, so we call it on each triage,
 	// even if there's no pending triggers.
 	op.debugInfoLog(c, "Invoking the triggering policy function")
-	out := op.triggeringPolicy(c, job, triggers)
+	now := clock.Now(c).UTC()
+	out, horizon := op.triggeringPolicy(c, job, triggers, now)
 	op.debugInfoLog(c, "The policy requested %d new invocations", len(out.Requests))
 
 	// Actually pop all consumed triggers and start the corresponding invocations.
@@ -427,6 +434,10 @@ func (op *triageOp) processTriggers(c context.Context, job *Job) (*dsset.PopOp,
 		op.debugInfoLog(c, "New invocations enqueued, consumed %d triggers", consumed)
 	}
 
+	// Remember when invocations were launched if the policy needs this. Drop
+	// entries the policy is no longer interested in.
+	recordLaunches(job, now, horizon, len(out.Requests))
+
 	// Discard triggers the policy decided are no longer needed.
 	if len(out.Discard) != 0 {
 		for _, t := range out.Discard {
@@ -435,28 +446,71 @@ func (op *triageOp) processTriggers(c context.Context, job *Job) (*dsset.PopOp,
 		op.debugInfoLog(c, "%d triggers discarded, according to policy", len(out.Discard))
 	}
 
+	// Come back later if the policy wants to reconsider pending triggers.
+	if delay := out.RetriageAt.Sub(now); !out.RetriageAt.IsZero() && delay > 0 {
+		op.debugInfoLog(c, "The policy asked for another triage at %s", out.RetriageAt)
+		if err := op.kickTriageLater(c, delay); err != nil {
+			op.debugErrLog(c, err, "Failed to schedule the delayed triage")
+			return nil, err
+		}
+	}
+
 	return popOp, nil
 }
 
 // triggeringPolicy decides how to convert a set of pending triggers into
 // a bunch of new invocations.
 //
+// Also returns for how long the policy wants to see launched invocations, see
+// policy.HistoryHorizon.
+//
 // Called within a job transaction. Must not do any expensive calls.
-func (op *triageOp) triggeringPolicy(c context.Context, job *Job, triggers []*internal.Trigger) policy.Out {
+func (op *triageOp) triggeringPolicy(c context.Context, job *Job, triggers []*internal.Trigger, now time.Time) (policy.Out, time.Duration) {
 	var policyFunc policy.Func
+	var horizon time.Duration
 	p, err := policy.UnmarshalDefinition(job.TriggeringPolicyRaw)
 	if err == nil {
 		policyFunc, err = op.policyFactory(p)
+		horizon = policy.HistoryHorizon(p)
 	}
 	if err != nil {
 		op.debugErrLog(c, err, "Failed to instantiate the triggering policy function, using the default policy instead")
 		policyFunc = policy.Default()
+		horizon = 0
 	}
-	return policyFunc(policyFuncEnv{c, op}, policy.In{
-		Now:               clock.Now(c).UTC(),
+	out := policyFunc(policyFuncEnv{c, op}, policy.In{
+		Now:               now,
 		ActiveInvocations: job.ActiveInvocations,
 		Triggers:          triggers,
+		RecentLaunches:    job.RecentLaunches,
 	})
+	return out, horizon
+}
+
+// recordLaunches appends 'count' launches happening at 'now' to
+// job.RecentLaunches, evicting entries older than 'horizon'.
+//
+// Zero horizon means the policy doesn't care about launches at all, so the list
+// is cleared.
+func recordLaunches(job *Job, now time.Time, horizon time.Duration, count int) {
+	if horizon <= 0 {
+		job.RecentLaunches = nil
+		return
+	}
+	oldest := now.Add(-horizon)
+	kept := make([]time.Time, 0, len(job.RecentLaunches)+count)
+	for _, ts := range job.RecentLaunches {
+		if ts.After(oldest) {
+			kept = append(kept, ts)
+		}
+	}
+	for i := 0; i < count; i++ {
+		kept = append(kept, now)
+	}
+	if len(kept) == 0 {
+		kept = nil
+	}
+	job.RecentLaunches = kept
 }
 
 // policyFuncEnv implements policy.Environment through triageOp.
@@ -20,6 +20,8 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/common/clock"
@@ -297,6 +299,47 @@ func TestTriageOp(t *testing.T) {
 			assert.Loosely(t, after.ActiveInvocations, should.HaveLength(0))
 			assert.Loosely(t, tb.requests, should.HaveLength(0))
 		})
+
+		t.Run("records launches and retriages", func(t *ftt.Test) {
+			tb.retriageAt = epoch.Add(time.Hour)
+
+			policyRaw, err := proto.Marshal(&messages.TriggeringPolicy{
+				Kind:                    messages.TriggeringPolicy_RATE_LIMITED,
+				MaxInvocationsPerWindow: 10,
+				RateLimitWindow:         durationpb.New(time.Hour),
+			})
+			assert.Loosely(t, err, should.BeNil)
+
+			triggers := []*internal.Trigger{
+				{
+					Id:      "t0",
+					Created: timestamppb.New(epoch),
+				},
+				{
+					Id:      "t1",
+					Created: timestamppb.New(epoch),
+				},
+			}
+			pendingTriggersSet(c, "job").Add(c, triggers)
+
+			after, err := tb.runTestTriage(c, &Job{
+				JobID:               "job",
+				Enabled:             true,
+				TriggeringPolicyRaw: policyRaw,
+				RecentLaunches: []time.Time{
+					epoch.Add(-2 * time.Hour), // outside of the window
+					epoch.Add(-time.Minute),
+				},
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, tb.requests, should.HaveLength(2))
+			assert.Loosely(t, after.RecentLaunches, should.Match([]time.Time{
+				epoch.Add(-time.Minute),
+				epoch,
+				epoch,
+			}))
+			assert.Loosely(t, tb.kicks, should.Match([]time.Duration{time.Hour}))
+		})
 	})
 }
 
@@ -304,10 +347,12 @@ type triageTestBed struct {
 	// Inputs.
 	maxAllowedTriggers     int
 	policyDiscardsTriggers bool
+	retriageAt             time.Time
 
 	// Outputs.
 	nextInvID int64
 	requests  []task.Request
+	kicks     []time.Duration
 }
 
 // runTestTriage runs the triage operation and refetches the job after it.
@@ -331,6 +376,7 @@ func (t *triageTestBed) runTestTriage(c context.Context, before *Job) (after *Jo
 						})
 					}
 				}
+				out.RetriageAt = t.retriageAt
 				return
 			}, nil
 		},
@@ -342,6 +388,10 @@ func (t *triageTestBed) runTestTriage(c context.Context, before *Job) (after *Jo
 			}
 			return nil
 		},
+		kickTriageLater: func(c context.Context, delay time.Duration) error {
+			t.kicks = append(t.kicks, delay)
+			return nil
+		},
 		maxAllowedTriggers: t.maxAllowedTriggers,
 	}
 
@@ -142,6 +142,21 @@ func equalInt64Lists(a, b []int64) bool {
 	return true
 }
 
+// equalTimeLists returns true if two lists of timestamps are equal.
+//
+// Order is important.
+func equalTimeLists(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, s := range a {
+		if !s.Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // marshalTriggersList serializes list of triggers.
 //
 // Panics on errors.
@@ -21,6 +21,7 @@
 package internal
 
 import (
+	messages "go.chromium.org/luci/scheduler/appengine/messages"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -136,6 +137,54 @@ func (x *FinishedInvocationList) GetInvocations() []*FinishedInvocation {
 	return nil
 }
 
+// BlackoutWindowList is stored in Job entities as BlackoutWindowsRaw.
+//
+// It contains blackout windows (as defined in the project config) that apply
+// to the job.
+type BlackoutWindowList struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Windows       []*messages.BlackoutWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlackoutWindowList) Reset() {
+	*x = BlackoutWindowList{}
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlackoutWindowList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlackoutWindowList) ProtoMessage() {}
+
+func (x *BlackoutWindowList) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlackoutWindowList.ProtoReflect.Descriptor instead.
+func (*BlackoutWindowList) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlackoutWindowList) GetWindows() []*messages.BlackoutWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
 var File_go_chromium_org_luci_scheduler_appengine_internal_db_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc = string([]byte{
@@ -145,7 +194,11 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc = st
 	0x6e, 0x61, 0x6c, 0x2f, 0x64, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x64, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x12, 0x46, 0x69,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3e, 0x67, 0x6f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69,
+	0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x12, 0x46, 0x69,
 	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74,
@@ -158,11 +211,16 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc = st
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73,
 	0x68, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69,
-	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x6f,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
-	0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x50, 0x0a, 0x12, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x42, 0x33, 0x5a, 0x31,
+	0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f,
+	0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -177,20 +235,23 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescGZIP
 	return file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_goTypes = []any{
-	(*FinishedInvocation)(nil),     // 0: internal.db.FinishedInvocation
-	(*FinishedInvocationList)(nil), // 1: internal.db.FinishedInvocationList
-	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+	(*FinishedInvocation)(nil),      // 0: internal.db.FinishedInvocation
+	(*FinishedInvocationList)(nil),  // 1: internal.db.FinishedInvocationList
+	(*BlackoutWindowList)(nil),      // 2: internal.db.BlackoutWindowList
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+	(*messages.BlackoutWindow)(nil), // 4: scheduler.config.BlackoutWindow
 }
 var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_depIdxs = []int32{
-	2, // 0: internal.db.FinishedInvocation.finished:type_name -> google.protobuf.Timestamp
+	3, // 0: internal.db.FinishedInvocation.finished:type_name -> google.protobuf.Timestamp
 	0, // 1: internal.db.FinishedInvocationList.invocations:type_name -> internal.db.FinishedInvocation
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 2: internal.db.BlackoutWindowList.windows:type_name -> scheduler.config.BlackoutWindow
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_init() }
@@ -204,7 +265,7 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc), len(file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,1172 +12,2061 @@ func init() {
 			"internal.admin.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 107, 112, 28, 89,
-			118, 32, 6, 87, 102, 86, 21, 10, 23, 36, 8, 92, 128, 32,
-			88, 4, 217, 151, 197, 38, 1, 176, 139, 133, 7, 31, 61, 4,
-			187, 167, 7, 143, 34, 89, 221, 104, 128, 93, 0, 154, 211, 221,
-			51, 3, 102, 85, 221, 2, 178, 145, 149, 89, 157, 153, 5, 176,
-			186, 167, 53, 90, 173, 70, 51, 223, 231, 8, 61, 172, 209, 216,
-			214, 132, 119, 28, 43, 173, 119, 215, 107, 199, 198, 206, 70, 72,
-			33, 89, 107, 121, 44, 217, 10, 75, 138, 176, 189, 138, 117, 196,
-			238, 31, 255, 144, 237, 95, 27, 142, 245, 35, 108, 71, 248, 25,
-			231, 220, 123, 51, 111, 1, 224, 171, 123, 164, 31, 14, 34, 250,
-			81, 39, 243, 62, 207, 61, 121, 238, 121, 221, 115, 201, 31, 205,
-			145, 87, 118, 124, 127, 199, 229, 51, 237, 192, 143, 252, 90, 167,
-			57, 19, 57, 45, 30, 70, 118, 171, 93, 194, 71, 244, 148, 40,
-			80, 82, 5, 10, 119, 72, 255, 166, 42, 67, 199, 73, 95, 200,
-			235, 190, 215, 8, 199, 13, 102, 76, 89, 85, 5, 210, 81, 146,
-			241, 108, 207, 15, 199, 77, 102, 76, 101, 170, 2, 88, 250, 174,
-			65, 70, 234, 126, 171, 116, 168, 209, 165, 193, 184, 201, 7, 240,
-			232, 129, 241, 225, 188, 44, 178, 227, 187, 182, 183, 83, 242, 131,
-			29, 109, 140, 221, 54, 15, 103, 246, 60, 255, 192, 75, 198, 219,
-			174, 253, 239, 134, 241, 183, 77, 235, 222, 131, 165, 127, 207, 188,
-			112, 79, 212, 126, 32, 171, 148, 30, 114, 215, 125, 7, 42, 108,
-			66, 221, 183, 127, 58, 67, 250, 104, 230, 66, 234, 87, 12, 131,
-			252, 249, 9, 98, 156, 160, 214, 133, 20, 157, 255, 233, 9, 134,
-			53, 234, 190, 203, 150, 58, 205, 38, 15, 66, 118, 141, 137, 182,
-			38, 67, 214, 176, 35, 155, 57, 94, 196, 131, 250, 174, 237, 237,
-			112, 214, 244, 131, 150, 29, 17, 182, 236, 183, 187, 129, 179, 179,
-			27, 177, 249, 217, 217, 175, 200, 10, 172, 226, 213, 75, 140, 45,
-			186, 46, 195, 119, 33, 11, 120, 200, 131, 125, 222, 40, 17, 182,
-			27, 69, 237, 112, 97, 102, 166, 193, 247, 185, 235, 183, 121, 16,
-			42, 156, 212, 253, 150, 152, 105, 221, 119, 175, 213, 196, 32, 102,
-			8, 97, 85, 222, 112, 194, 40, 112, 106, 157, 200, 241, 61, 102,
-			123, 13, 214, 9, 57, 115, 60, 22, 250, 157, 160, 206, 241, 73,
-			205, 241, 236, 160, 139, 227, 10, 139, 236, 192, 137, 118, 153, 31,
-			224, 255, 253, 78, 68, 88, 203, 111, 56, 77, 167, 110, 67, 11,
-			69, 102, 7, 156, 181, 121, 208, 114, 162, 136, 55, 88, 59, 240,
-			247, 157, 6, 111, 176, 104, 215, 142, 88, 180, 11, 179, 115, 93,
-			255, 192, 241, 118, 24, 44, 169, 3, 149, 66, 168, 68, 88, 139,
-			71, 11, 132, 48, 248, 187, 122, 104, 96, 33, 243, 155, 106, 68,
-			117, 191, 193, 89, 171, 19, 70, 44, 224, 145, 237, 120, 216, 170,
-			93, 243, 247, 225, 149, 196, 24, 97, 158, 31, 57, 117, 94, 100,
-			209, 174, 19, 50, 215, 9, 35, 104, 65, 239, 209, 107, 28, 26,
-			78, 195, 9, 235, 174, 237, 180, 120, 80, 122, 210, 32, 28, 79,
-			199, 133, 26, 68, 59, 240, 27, 157, 58, 79, 198, 65, 146, 129,
-			124, 169, 113, 16, 38, 103, 215, 240, 235, 157, 22, 247, 34, 91,
-			45, 210, 140, 31, 48, 63, 218, 229, 1, 107, 217, 17, 15, 28,
-			219, 13, 19, 84, 227, 2, 69, 187, 156, 48, 125, 244, 241, 164,
-			214, 184, 131, 53, 161, 97, 207, 110, 113, 24, 144, 78, 91, 158,
-			159, 188, 67, 188, 59, 81, 8, 51, 242, 68, 83, 126, 16, 178,
-			150, 221, 101, 53, 14, 148, 210, 96, 145, 207, 184, 215, 240, 131,
-			144, 3, 81, 180, 3, 191, 229, 71, 156, 9, 156, 68, 33, 107,
-			240, 192, 217, 231, 13, 214, 12, 252, 22, 17, 88, 8, 253, 102,
-			116, 0, 100, 34, 41, 136, 133, 109, 94, 7, 10, 98, 237, 192,
-			1, 194, 10, 128, 118, 60, 65, 69, 97, 136, 99, 39, 108, 243,
-			126, 101, 131, 109, 172, 223, 221, 124, 184, 88, 45, 179, 202, 6,
-			123, 80, 93, 127, 191, 178, 82, 94, 97, 75, 31, 176, 205, 251,
-			101, 182, 188, 254, 224, 131, 106, 229, 222, 253, 77, 118, 127, 125,
-			117, 165, 92, 221, 96, 139, 107, 43, 108, 121, 125, 109, 179, 90,
-			89, 218, 218, 92, 175, 110, 16, 86, 88, 220, 96, 149, 141, 2,
-			190, 89, 92, 251, 128, 149, 191, 254, 160, 90, 222, 216, 96, 235,
-			85, 86, 121, 247, 193, 106, 165, 188, 194, 30, 46, 86, 171, 139,
-			107, 155, 149, 242, 70, 145, 85, 214, 150, 87, 183, 86, 42, 107,
-			247, 138, 108, 105, 107, 147, 173, 173, 111, 18, 182, 90, 121, 183,
-			178, 89, 94, 97, 155, 235, 69, 236, 246, 104, 61, 182, 126, 151,
-			189, 91, 174, 46, 223, 95, 92, 219, 92, 92, 170, 172, 86, 54,
-			63, 192, 14, 239, 86, 54, 215, 160, 179, 187, 235, 85, 194, 22,
-			217, 131, 197, 234, 102, 101, 121, 107, 117, 177, 202, 30, 108, 85,
-			31, 172, 111, 148, 25, 204, 108, 165, 178, 177, 188, 186, 88, 121,
-			183, 188, 82, 98, 149, 53, 182, 182, 206, 202, 239, 151, 215, 54,
-			217, 198, 253, 197, 213, 213, 222, 137, 18, 182, 254, 112, 173, 92,
-			133, 209, 235, 211, 100, 75, 101, 182, 90, 89, 92, 90, 45, 67,
-			87, 56, 207, 149, 74, 181, 188, 188, 9, 19, 74, 126, 45, 87,
-			86, 202, 107, 155, 139, 171, 69, 194, 54, 30, 148, 151, 43, 139,
-			171, 69, 86, 254, 122, 249, 221, 7, 171, 139, 213, 15, 138, 178,
-			209, 141, 242, 123, 91, 229, 181, 205, 202, 226, 42, 91, 89, 124,
-			119, 241, 94, 121, 131, 77, 61, 11, 43, 15, 170, 235, 203, 91,
-			213, 242, 187, 48, 234, 245, 187, 108, 99, 107, 105, 99, 179, 178,
-			185, 181, 89, 102, 247, 214, 215, 87, 16, 217, 27, 229, 234, 251,
-			149, 229, 242, 198, 29, 182, 186, 190, 129, 8, 219, 218, 40, 23,
-			9, 91, 89, 220, 92, 196, 174, 31, 84, 215, 239, 86, 54, 55,
-			238, 192, 239, 165, 173, 141, 10, 34, 174, 178, 182, 89, 174, 86,
-			183, 30, 108, 86, 214, 215, 166, 217, 253, 245, 135, 229, 247, 203,
-			85, 182, 188, 184, 181, 81, 94, 65, 12, 175, 175, 193, 108, 129,
-			86, 202, 235, 213, 15, 160, 89, 192, 3, 174, 64, 145, 61, 188,
-			95, 222, 188, 95, 174, 2, 82, 17, 91, 139, 128, 134, 141, 205,
-			106, 101, 121, 83, 47, 182, 94, 101, 155, 235, 213, 77, 162, 205,
-			147, 173, 149, 239, 173, 86, 238, 149, 215, 150, 203, 240, 122, 29,
-			154, 121, 88, 217, 40, 79, 179, 197, 106, 101, 3, 10, 84, 176,
-			99, 246, 112, 241, 3, 182, 190, 133, 179, 134, 133, 218, 218, 40,
-			19, 241, 91, 35, 221, 34, 174, 39, 171, 220, 101, 139, 43, 239,
-			87, 96, 228, 178, 244, 131, 245, 141, 141, 138, 36, 23, 68, 219,
-			242, 125, 137, 243, 18, 33, 57, 98, 152, 212, 98, 169, 113, 248,
-			149, 163, 86, 33, 245, 10, 233, 39, 102, 238, 21, 241, 83, 60,
-			188, 148, 170, 224, 195, 1, 241, 83, 60, 124, 53, 85, 196, 135,
-			134, 248, 41, 30, 94, 78, 205, 224, 67, 249, 83, 60, 188, 146,
-			42, 224, 67, 34, 126, 138, 135, 147, 169, 139, 248, 240, 85, 241,
-			83, 60, 156, 74, 221, 193, 135, 151, 197, 207, 127, 113, 158, 152,
-			233, 20, 205, 254, 162, 1, 91, 95, 254, 207, 206, 179, 69, 22,
-			111, 189, 200, 32, 121, 200, 189, 40, 100, 54, 107, 251, 142, 23,
-			33, 91, 115, 90, 176, 205, 52, 120, 155, 123, 13, 238, 33, 91,
-			180, 189, 174, 120, 254, 169, 239, 33, 55, 113, 253, 186, 237, 18,
-			86, 183, 93, 238, 53, 236, 160, 200, 184, 7, 220, 191, 193, 108,
-			104, 171, 238, 119, 68, 61, 41, 29, 32, 47, 109, 6, 118, 61,
-			217, 49, 212, 11, 216, 16, 64, 84, 64, 24, 118, 76, 223, 21,
-			76, 145, 109, 238, 114, 217, 144, 3, 91, 169, 107, 71, 206, 62,
-			7, 166, 102, 123, 140, 183, 253, 250, 46, 179, 35, 182, 181, 185,
-			204, 90, 78, 195, 67, 142, 238, 123, 132, 189, 109, 123, 29, 216,
-			6, 230, 138, 108, 238, 246, 235, 179, 69, 197, 168, 219, 129, 239,
-			242, 118, 228, 212, 217, 189, 128, 239, 248, 129, 99, 123, 241, 232,
-			217, 193, 174, 83, 223, 101, 252, 113, 196, 97, 76, 200, 160, 143,
-			41, 85, 179, 235, 123, 7, 118, 0, 37, 124, 214, 229, 118, 192,
-			124, 143, 3, 3, 132, 45, 191, 229, 120, 157, 136, 227, 126, 201,
-			110, 205, 198, 243, 115, 125, 111, 167, 196, 86, 185, 221, 78, 166,
-			28, 112, 86, 8, 91, 220, 14, 120, 163, 192, 66, 95, 108, 192,
-			158, 207, 92, 110, 183, 137, 44, 198, 34, 187, 230, 114, 152, 185,
-			199, 57, 224, 181, 233, 7, 66, 20, 105, 195, 222, 42, 54, 244,
-			78, 8, 187, 146, 205, 62, 154, 191, 113, 109, 215, 239, 4, 204,
-			117, 60, 110, 7, 132, 97, 235, 223, 156, 122, 186, 208, 1, 235,
-			57, 131, 37, 167, 145, 139, 239, 114, 22, 160, 148, 227, 132, 184,
-			39, 176, 217, 217, 217, 185, 107, 248, 207, 230, 236, 236, 2, 254,
-			243, 33, 76, 253, 246, 237, 219, 183, 175, 205, 205, 95, 187, 62,
-			183, 57, 127, 125, 225, 230, 237, 133, 155, 183, 75, 183, 213, 223,
-			135, 37, 182, 212, 37, 176, 144, 81, 224, 212, 35, 24, 96, 36,
-			167, 136, 173, 23, 217, 1, 103, 220, 11, 59, 1, 23, 79, 15,
-			56, 171, 3, 150, 125, 111, 159, 7, 145, 88, 95, 177, 41, 177,
-			143, 170, 119, 151, 9, 187, 126, 253, 250, 237, 100, 46, 7, 7,
-			7, 37, 135, 71, 77, 148, 16, 131, 102, 29, 254, 133, 18, 165,
-			232, 113, 52, 13, 18, 27, 103, 208, 179, 183, 19, 194, 164, 46,
-			177, 242, 99, 187, 213, 118, 121, 72, 136, 250, 201, 230, 22, 216,
-			178, 223, 106, 119, 34, 174, 125, 11, 216, 225, 131, 245, 141, 202,
-			215, 217, 35, 192, 204, 212, 244, 163, 146, 20, 121, 146, 66, 177,
-			240, 121, 71, 188, 73, 132, 231, 144, 71, 219, 114, 129, 167, 176,
-			250, 218, 214, 234, 234, 244, 244, 177, 229, 144, 222, 167, 102, 167,
-			239, 104, 99, 154, 127, 214, 152, 118, 120, 4, 173, 248, 205, 134,
-			221, 213, 198, 22, 70, 65, 167, 30, 97, 7, 251, 182, 203, 162,
-			125, 217, 99, 79, 241, 43, 209, 126, 145, 225, 128, 238, 124, 209,
-			41, 237, 151, 162, 125, 128, 158, 54, 35, 81, 168, 19, 242, 58,
-			187, 202, 230, 102, 103, 123, 103, 120, 253, 137, 51, 124, 232, 120,
-			215, 231, 217, 163, 123, 60, 218, 232, 134, 17, 111, 193, 235, 197,
-			240, 174, 227, 242, 205, 222, 133, 184, 91, 89, 45, 111, 86, 222,
-			45, 179, 102, 36, 135, 241, 164, 58, 87, 154, 145, 26, 233, 86,
-			101, 109, 243, 214, 13, 22, 57, 245, 189, 144, 189, 201, 166, 166,
-			166, 196, 147, 233, 102, 84, 106, 28, 220, 119, 118, 118, 87, 236,
-			8, 107, 77, 179, 55, 222, 96, 215, 231, 167, 217, 183, 25, 190,
-			91, 245, 15, 212, 43, 133, 183, 153, 25, 182, 8, 227, 109, 248,
-			7, 33, 54, 9, 31, 203, 220, 236, 172, 198, 195, 194, 82, 92,
-			64, 112, 169, 185, 91, 71, 63, 163, 184, 53, 168, 62, 119, 235,
-			198, 141, 27, 175, 95, 191, 53, 155, 176, 141, 26, 111, 250, 1,
-			103, 91, 158, 243, 88, 181, 114, 251, 245, 217, 195, 173, 148, 190,
-			216, 98, 78, 137, 249, 179, 169, 41, 129, 148, 25, 92, 44, 248,
-			155, 102, 215, 244, 225, 60, 131, 130, 161, 29, 64, 151, 106, 231,
-			178, 214, 14, 18, 192, 116, 15, 1, 220, 120, 34, 1, 188, 109,
-			239, 219, 236, 145, 88, 200, 82, 189, 19, 4, 220, 139, 160, 200,
-			187, 142, 235, 58, 161, 70, 0, 192, 77, 89, 11, 159, 178, 55,
-			217, 147, 43, 60, 133, 204, 217, 155, 201, 211, 146, 199, 15, 150,
-			58, 142, 219, 224, 193, 212, 52, 76, 108, 67, 98, 72, 118, 33,
-			16, 51, 45, 218, 130, 63, 40, 179, 38, 230, 238, 120, 17, 204,
-			92, 150, 20, 83, 151, 211, 70, 12, 76, 151, 106, 208, 242, 84,
-			15, 10, 110, 62, 3, 5, 21, 47, 140, 108, 47, 42, 121, 254,
-			129, 54, 107, 249, 148, 121, 254, 1, 123, 147, 245, 148, 121, 234,
-			68, 147, 113, 63, 123, 198, 158, 127, 80, 218, 225, 81, 25, 104,
-			77, 60, 155, 154, 214, 38, 222, 59, 121, 89, 24, 128, 169, 227,
-			39, 122, 235, 137, 19, 149, 171, 165, 164, 12, 246, 160, 27, 237,
-			10, 53, 162, 135, 204, 244, 101, 154, 154, 62, 76, 131, 247, 120,
-			180, 156, 172, 250, 212, 52, 114, 250, 183, 55, 214, 215, 216, 187,
-			118, 187, 237, 120, 59, 132, 176, 138, 39, 158, 8, 157, 189, 136,
-			66, 128, 134, 166, 110, 27, 55, 186, 30, 177, 69, 108, 28, 82,
-			98, 32, 184, 253, 188, 208, 238, 35, 186, 2, 201, 197, 6, 161,
-			165, 40, 154, 17, 79, 161, 179, 194, 103, 32, 53, 124, 126, 237,
-			179, 150, 239, 69, 187, 159, 95, 251, 172, 97, 119, 63, 223, 252,
-			12, 182, 238, 207, 23, 62, 107, 57, 222, 231, 11, 159, 133, 188,
-			254, 249, 71, 165, 207, 64, 88, 130, 15, 246, 243, 111, 126, 88,
-			32, 236, 96, 151, 7, 156, 137, 218, 208, 144, 237, 30, 216, 221,
-			144, 241, 199, 32, 191, 129, 170, 39, 36, 129, 38, 200, 0, 13,
-			103, 199, 137, 66, 16, 105, 92, 206, 100, 79, 69, 134, 93, 21,
-			9, 19, 157, 21, 25, 246, 86, 196, 173, 22, 187, 68, 169, 228,
-			83, 30, 248, 215, 218, 118, 163, 33, 148, 199, 232, 192, 87, 173,
-			113, 187, 190, 43, 36, 50, 37, 197, 129, 244, 39, 25, 74, 81,
-			202, 79, 176, 141, 239, 248, 172, 211, 70, 33, 65, 85, 157, 114,
-			74, 188, 36, 31, 206, 29, 47, 235, 77, 23, 9, 246, 239, 183,
-			69, 203, 162, 167, 194, 135, 5, 22, 118, 154, 77, 231, 49, 72,
-			163, 78, 221, 6, 241, 10, 86, 17, 232, 0, 229, 208, 169, 194,
-			214, 230, 114, 97, 250, 78, 207, 83, 34, 196, 197, 79, 58, 78,
-			192, 27, 37, 182, 200, 208, 184, 114, 93, 16, 67, 136, 26, 185,
-			243, 41, 15, 88, 184, 235, 119, 220, 134, 66, 101, 39, 228, 40,
-			75, 78, 217, 97, 220, 91, 131, 213, 186, 4, 134, 49, 13, 11,
-			224, 129, 14, 236, 9, 129, 230, 40, 41, 1, 34, 237, 158, 174,
-			218, 118, 16, 38, 221, 212, 56, 97, 40, 209, 129, 124, 83, 175,
-			243, 118, 196, 106, 126, 180, 139, 125, 66, 93, 97, 50, 80, 115,
-			8, 143, 140, 3, 132, 94, 191, 217, 12, 121, 132, 194, 218, 93,
-			63, 96, 92, 124, 107, 69, 86, 152, 159, 157, 123, 29, 246, 134,
-			185, 155, 155, 179, 115, 11, 215, 103, 23, 230, 110, 150, 102, 231,
-			62, 44, 72, 234, 14, 25, 194, 241, 230, 210, 182, 195, 136, 48,
-			44, 137, 253, 251, 94, 34, 53, 223, 44, 50, 104, 173, 36, 63,
-			32, 123, 223, 222, 168, 7, 78, 59, 42, 130, 172, 219, 35, 168,
-			217, 12, 54, 71, 230, 215, 62, 230, 245, 72, 200, 120, 32, 56,
-			10, 98, 23, 244, 136, 228, 15, 220, 170, 97, 7, 13, 194, 62,
-			138, 252, 202, 198, 250, 6, 126, 100, 83, 211, 199, 136, 167, 165,
-			150, 255, 169, 227, 186, 54, 126, 93, 220, 187, 182, 181, 49, 211,
-			240, 235, 225, 204, 67, 94, 155, 73, 134, 50, 83, 229, 77, 30,
-			112, 175, 206, 103, 238, 185, 126, 205, 118, 183, 215, 113, 12, 225,
-			12, 12, 104, 70, 235, 100, 26, 45, 87, 187, 126, 163, 4, 147,
-			17, 156, 166, 136, 223, 185, 24, 18, 123, 4, 242, 34, 32, 189,
-			164, 126, 60, 82, 19, 130, 169, 214, 184, 154, 45, 111, 144, 99,
-			167, 72, 216, 71, 143, 194, 40, 104, 98, 85, 109, 70, 126, 61,
-			44, 181, 5, 103, 131, 185, 204, 207, 184, 78, 45, 176, 131, 46,
-			10, 221, 165, 221, 168, 229, 94, 194, 95, 170, 238, 52, 90, 92,
-			72, 76, 200, 170, 147, 176, 205, 235, 108, 242, 242, 7, 215, 46,
-			183, 174, 93, 110, 108, 94, 190, 191, 112, 249, 221, 133, 203, 27,
-			165, 203, 205, 15, 39, 75, 108, 213, 217, 227, 7, 78, 200, 81,
-			201, 1, 4, 37, 171, 212, 9, 185, 104, 237, 109, 191, 97, 35,
-			177, 78, 134, 236, 163, 71, 149, 141, 117, 37, 210, 220, 21, 204,
-			170, 33, 193, 169, 233, 71, 223, 156, 18, 118, 202, 133, 153, 153,
-			143, 253, 134, 125, 77, 140, 16, 77, 123, 77, 63, 216, 225, 37,
-			143, 71, 51, 118, 219, 193, 53, 129, 105, 65, 41, 161, 70, 136,
-			225, 206, 28, 109, 30, 167, 154, 244, 65, 216, 52, 224, 209, 175,
-			161, 121, 208, 150, 211, 140, 120, 192, 234, 118, 27, 191, 15, 191,
-			201, 118, 184, 199, 3, 91, 124, 105, 234, 43, 11, 5, 87, 142,
-			209, 95, 34, 132, 12, 16, 43, 157, 50, 104, 250, 23, 141, 220,
-			48, 249, 183, 13, 146, 78, 167, 204, 20, 77, 127, 223, 48, 71,
-			243, 191, 108, 176, 106, 162, 221, 42, 202, 247, 155, 72, 240, 136,
-			225, 208, 241, 234, 186, 132, 69, 142, 23, 177, 216, 187, 157, 48,
-			2, 74, 120, 154, 74, 68, 142, 211, 137, 62, 100, 142, 87, 119,
-			59, 161, 179, 15, 74, 226, 73, 146, 129, 225, 101, 112, 124, 125,
-			10, 52, 0, 204, 157, 82, 160, 5, 32, 29, 33, 255, 157, 152,
-			140, 65, 211, 191, 108, 152, 52, 255, 95, 27, 108, 205, 247, 174,
-			121, 124, 71, 232, 192, 61, 154, 180, 173, 52, 70, 80, 34, 143,
-			215, 164, 215, 100, 197, 88, 185, 220, 183, 221, 14, 15, 133, 57,
-			50, 105, 12, 141, 166, 97, 228, 184, 46, 219, 181, 247, 57, 243,
-			244, 62, 177, 105, 89, 145, 8, 205, 77, 40, 231, 77, 63, 0,
-			165, 88, 89, 14, 14, 35, 76, 42, 140, 69, 249, 47, 57, 6,
-			41, 70, 6, 231, 169, 144, 98, 224, 180, 115, 39, 21, 104, 1,
-			56, 52, 92, 203, 10, 238, 74, 254, 159, 97, 114, 238, 176, 23,
-			131, 183, 218, 81, 247, 73, 30, 140, 62, 146, 41, 195, 251, 165,
-			207, 143, 119, 70, 16, 124, 171, 28, 17, 165, 231, 116, 68, 96,
-			151, 47, 228, 132, 248, 143, 134, 72, 150, 166, 47, 164, 230, 135,
-			94, 250, 32, 94, 250, 32, 94, 250, 32, 94, 250, 32, 94, 250,
-			32, 94, 250, 32, 254, 106, 124, 16, 101, 229, 110, 128, 159, 202,
-			7, 17, 187, 27, 46, 197, 238, 134, 87, 83, 175, 41, 119, 3,
-			252, 84, 62, 136, 216, 221, 112, 57, 118, 55, 92, 73, 220, 13,
-			87, 98, 119, 195, 100, 226, 110, 128, 159, 202, 7, 17, 123, 64,
-			224, 231, 127, 104, 162, 15, 194, 154, 79, 13, 229, 255, 161, 201,
-			22, 133, 124, 231, 212, 25, 238, 160, 172, 197, 195, 208, 222, 145,
-			198, 224, 174, 223, 65, 241, 53, 224, 215, 96, 163, 1, 101, 105,
-			223, 119, 26, 172, 193, 155, 142, 135, 236, 175, 211, 118, 133, 82,
-			68, 122, 235, 35, 251, 237, 130, 2, 188, 248, 160, 18, 130, 222,
-			23, 117, 219, 78, 221, 118, 149, 158, 4, 106, 97, 228, 139, 253,
-			43, 2, 125, 31, 184, 24, 232, 137, 28, 180, 33, 201, 213, 2,
-			30, 182, 125, 15, 122, 6, 165, 14, 29, 31, 208, 94, 172, 70,
-			220, 69, 43, 60, 232, 15, 117, 174, 118, 35, 216, 95, 157, 58,
-			103, 119, 125, 159, 125, 166, 76, 38, 65, 187, 206, 150, 236, 96,
-			234, 144, 172, 81, 66, 81, 99, 26, 246, 166, 78, 224, 133, 236,
-			9, 239, 165, 209, 237, 115, 2, 127, 40, 228, 90, 243, 185, 147,
-			177, 4, 244, 127, 23, 200, 196, 97, 9, 72, 24, 130, 159, 36,
-			2, 253, 155, 6, 201, 110, 96, 9, 122, 135, 100, 155, 14, 119,
-			49, 130, 195, 154, 26, 152, 191, 116, 88, 30, 42, 137, 130, 165,
-			187, 88, 170, 236, 69, 65, 183, 42, 171, 228, 223, 35, 3, 218,
-			99, 58, 68, 172, 61, 222, 197, 80, 144, 254, 42, 252, 164, 69,
-			146, 65, 25, 17, 195, 64, 6, 230, 199, 142, 52, 254, 62, 188,
-			173, 138, 66, 11, 230, 87, 140, 194, 79, 76, 146, 193, 135, 244,
-			14, 33, 94, 199, 117, 183, 69, 3, 208, 232, 224, 124, 254, 72,
-			3, 107, 29, 215, 197, 242, 247, 83, 213, 126, 79, 1, 244, 18,
-			57, 225, 117, 90, 53, 30, 108, 39, 253, 27, 247, 83, 213, 1,
-			241, 52, 46, 36, 108, 60, 178, 144, 5, 3, 135, 66, 226, 169,
-			40, 244, 10, 33, 53, 223, 87, 195, 72, 51, 99, 42, 7, 93,
-			193, 51, 81, 224, 13, 108, 165, 83, 143, 100, 145, 12, 78, 245,
-			204, 19, 240, 40, 155, 239, 212, 163, 120, 150, 176, 209, 203, 186,
-			89, 172, 123, 116, 150, 171, 78, 24, 197, 179, 116, 21, 176, 148,
-			37, 233, 61, 199, 107, 20, 238, 144, 254, 184, 4, 45, 145, 172,
-			144, 203, 229, 138, 62, 9, 233, 178, 212, 213, 115, 164, 63, 70,
-			34, 29, 36, 100, 109, 107, 117, 117, 251, 253, 197, 213, 173, 242,
-			80, 106, 233, 59, 199, 203, 200, 3, 98, 50, 74, 72, 158, 121,
-			78, 33, 89, 76, 252, 133, 164, 228, 127, 235, 188, 144, 146, 191,
-			245, 50, 82, 231, 165, 148, 252, 82, 74, 126, 41, 37, 191, 148,
-			146, 95, 74, 201, 127, 157, 145, 58, 119, 147, 72, 157, 187, 79,
-			143, 212, 41, 38, 145, 58, 197, 47, 27, 169, 243, 143, 45, 148,
-			146, 211, 243, 169, 155, 70, 254, 223, 181, 216, 35, 177, 225, 62,
-			234, 13, 210, 17, 251, 105, 39, 224, 13, 177, 229, 225, 142, 94,
-			4, 198, 18, 58, 33, 90, 76, 253, 38, 19, 178, 26, 145, 94,
-			153, 150, 141, 174, 151, 70, 215, 179, 91, 32, 15, 187, 93, 20,
-			110, 149, 17, 16, 77, 227, 161, 223, 226, 12, 118, 242, 14, 72,
-			210, 197, 184, 115, 194, 90, 200, 128, 107, 156, 133, 157, 118, 219,
-			15, 148, 51, 130, 121, 194, 42, 24, 15, 206, 22, 150, 198, 30,
-			191, 132, 227, 17, 22, 162, 201, 30, 6, 22, 55, 207, 92, 103,
-			143, 179, 183, 55, 226, 233, 8, 135, 141, 108, 72, 250, 226, 60,
-			34, 13, 241, 194, 23, 212, 128, 93, 202, 69, 78, 42, 66, 73,
-			122, 250, 197, 253, 178, 193, 161, 175, 26, 114, 211, 29, 14, 220,
-			153, 196, 92, 92, 56, 102, 212, 28, 48, 150, 6, 158, 170, 33,
-			169, 240, 23, 244, 219, 28, 106, 26, 202, 198, 107, 225, 132, 162,
-			140, 28, 154, 46, 157, 15, 146, 219, 210, 0, 109, 221, 48, 89,
-			190, 200, 182, 60, 63, 104, 112, 88, 41, 88, 1, 191, 249, 228,
-			21, 32, 228, 132, 48, 255, 102, 161, 238, 168, 130, 12, 106, 221,
-			56, 125, 78, 65, 22, 181, 110, 92, 120, 133, 252, 49, 40, 83,
-			6, 77, 191, 153, 122, 215, 200, 255, 174, 201, 30, 161, 20, 119,
-			136, 74, 158, 208, 147, 230, 166, 171, 113, 38, 118, 48, 194, 64,
-			132, 46, 194, 138, 162, 156, 92, 140, 221, 160, 240, 11, 100, 94,
-			110, 163, 207, 36, 224, 245, 78, 16, 194, 154, 203, 69, 147, 164,
-			231, 7, 204, 38, 241, 166, 172, 104, 106, 81, 110, 94, 60, 136,
-			159, 162, 183, 245, 113, 155, 215, 35, 177, 225, 133, 60, 66, 111,
-			5, 46, 41, 15, 57, 97, 251, 118, 224, 216, 94, 4, 213, 107,
-			33, 247, 234, 92, 69, 158, 201, 23, 154, 27, 208, 246, 24, 15,
-			2, 63, 120, 230, 202, 73, 244, 168, 133, 195, 145, 200, 117, 51,
-			12, 106, 189, 153, 59, 73, 10, 36, 157, 54, 114, 41, 154, 126,
-			203, 92, 181, 242, 163, 216, 30, 8, 217, 241, 200, 229, 2, 25,
-			57, 88, 146, 183, 114, 39, 200, 36, 212, 128, 149, 94, 76, 159,
-			207, 231, 117, 71, 131, 141, 232, 236, 173, 134, 235, 186, 152, 62,
-			169, 32, 131, 90, 139, 131, 227, 10, 178, 168, 181, 120, 110, 130,
-			76, 99, 147, 6, 181, 150, 211, 19, 249, 137, 222, 38, 27, 126,
-			167, 230, 242, 67, 141, 26, 25, 40, 27, 67, 80, 115, 224, 140,
-			130, 44, 106, 45, 231, 207, 201, 70, 77, 106, 149, 143, 54, 42,
-			125, 221, 189, 141, 154, 25, 40, 27, 67, 6, 181, 202, 113, 163,
-			166, 69, 173, 114, 254, 28, 185, 138, 141, 90, 212, 186, 151, 30,
-			207, 159, 239, 109, 84, 210, 203, 161, 86, 173, 12, 20, 206, 41,
-			200, 160, 214, 189, 254, 17, 5, 65, 67, 99, 103, 200, 53, 108,
-			53, 77, 173, 74, 122, 34, 207, 142, 12, 85, 49, 189, 222, 134,
-			211, 89, 40, 31, 67, 6, 181, 42, 241, 112, 211, 22, 181, 42,
-			249, 115, 178, 225, 12, 181, 222, 73, 159, 63, 220, 112, 192, 219,
-			28, 253, 171, 146, 76, 226, 134, 51, 89, 40, 175, 86, 44, 99,
-			80, 235, 157, 120, 197, 50, 22, 181, 222, 57, 55, 65, 254, 158,
-			65, 204, 76, 138, 166, 55, 82, 239, 27, 249, 31, 25, 236, 81,
-			172, 83, 33, 185, 217, 44, 116, 188, 29, 151, 71, 190, 199, 184,
-			215, 105, 161, 71, 203, 247, 128, 242, 99, 58, 21, 34, 98, 76,
-			48, 138, 47, 145, 152, 108, 209, 10, 209, 241, 148, 244, 246, 52,
-			66, 239, 237, 29, 139, 61, 130, 166, 31, 9, 106, 207, 0, 217,
-			109, 100, 6, 73, 158, 164, 51, 200, 165, 182, 204, 225, 252, 73,
-			182, 118, 136, 92, 51, 130, 241, 108, 153, 39, 20, 100, 82, 107,
-			235, 212, 16, 249, 5, 131, 152, 105, 147, 166, 63, 74, 125, 203,
-			200, 239, 179, 71, 177, 250, 41, 103, 123, 16, 216, 237, 54, 15,
-			152, 29, 248, 29, 244, 133, 199, 200, 197, 237, 72, 99, 14, 207,
-			154, 73, 111, 203, 88, 204, 14, 2, 187, 43, 63, 91, 160, 202,
-			143, 114, 195, 100, 129, 164, 211, 38, 76, 228, 155, 230, 68, 254,
-			26, 44, 236, 161, 238, 158, 193, 111, 77, 51, 149, 134, 202, 49,
-			148, 165, 214, 55, 7, 134, 20, 100, 80, 235, 155, 195, 103, 20,
-			100, 81, 235, 155, 249, 115, 177, 1, 230, 167, 87, 200, 87, 119,
-			252, 82, 125, 55, 240, 91, 78, 167, 133, 250, 175, 219, 169, 59,
-			51, 97, 125, 151, 55, 58, 46, 15, 102, 236, 182, 14, 237, 207,
-			205, 68, 129, 179, 179, 3, 218, 164, 48, 209, 244, 199, 47, 243,
-			79, 181, 229, 20, 254, 194, 36, 125, 155, 162, 46, 29, 36, 166,
-			211, 144, 230, 22, 211, 105, 208, 81, 146, 137, 156, 200, 21, 214,
-			142, 254, 170, 0, 232, 16, 177, 58, 129, 43, 140, 27, 85, 248,
-			73, 139, 36, 93, 15, 124, 111, 124, 74, 26, 101, 226, 190, 75,
-			203, 129, 239, 201, 214, 239, 167, 170, 88, 138, 206, 144, 204, 1,
-			175, 117, 156, 241, 105, 105, 216, 72, 138, 63, 228, 181, 173, 74,
-			82, 94, 148, 131, 230, 61, 223, 111, 143, 207, 31, 105, 126, 205,
-			247, 219, 90, 243, 80, 138, 222, 36, 125, 59, 78, 228, 184, 60,
-			28, 191, 142, 21, 206, 106, 21, 238, 137, 55, 73, 29, 85, 150,
-			46, 146, 1, 12, 52, 170, 117, 234, 123, 60, 26, 191, 129, 85,
-			207, 107, 85, 151, 146, 183, 73, 117, 189, 206, 82, 63, 233, 107,
-			219, 93, 215, 183, 27, 133, 107, 100, 64, 155, 58, 189, 64, 136,
-			242, 58, 251, 158, 60, 218, 164, 61, 41, 12, 146, 19, 250, 212,
-			11, 23, 201, 128, 54, 53, 74, 73, 26, 196, 49, 185, 50, 248,
-			187, 240, 99, 131, 12, 246, 206, 6, 138, 5, 188, 237, 171, 98,
-			240, 27, 22, 43, 224, 77, 185, 128, 240, 147, 230, 73, 46, 224,
-			251, 14, 232, 108, 114, 13, 99, 152, 190, 78, 72, 59, 240, 219,
-			60, 136, 28, 30, 162, 109, 234, 201, 134, 167, 170, 86, 20, 186,
-			142, 236, 157, 112, 60, 195, 44, 232, 26, 126, 23, 108, 66, 143,
-			226, 236, 80, 23, 198, 139, 119, 97, 38, 93, 44, 221, 249, 240,
-			246, 11, 126, 39, 119, 98, 224, 237, 191, 120, 133, 100, 105, 122,
-			48, 229, 25, 228, 143, 211, 104, 9, 26, 76, 209, 249, 223, 75,
-			247, 24, 117, 230, 110, 33, 55, 89, 221, 90, 174, 176, 197, 78,
-			180, 235, 7, 200, 97, 86, 157, 58, 247, 48, 50, 202, 107, 72,
-			61, 125, 177, 109, 215, 161, 164, 120, 83, 100, 239, 243, 0, 112,
-			202, 230, 75, 179, 108, 10, 10, 20, 228, 171, 194, 244, 29, 130,
-			150, 104, 80, 209, 61, 63, 66, 107, 142, 136, 61, 112, 92, 206,
-			248, 99, 12, 223, 113, 60, 86, 247, 91, 109, 215, 177, 65, 82,
-			137, 37, 77, 217, 70, 137, 176, 15, 100, 11, 113, 176, 67, 221,
-			111, 119, 165, 184, 163, 138, 49, 59, 146, 214, 26, 21, 121, 113,
-			112, 112, 80, 178, 113, 164, 2, 91, 162, 92, 56, 179, 90, 89,
-			46, 175, 109, 148, 175, 205, 151, 102, 9, 97, 91, 158, 203, 195,
-			36, 204, 9, 101, 242, 54, 90, 198, 65, 82, 112, 237, 3, 148,
-			203, 118, 2, 46, 100, 45, 199, 67, 131, 0, 74, 117, 202, 114,
-			160, 89, 54, 122, 208, 164, 70, 230, 132, 61, 5, 208, 102, 18,
-			235, 254, 75, 139, 27, 149, 141, 34, 97, 15, 43, 155, 247, 65,
-			89, 211, 21, 119, 212, 121, 87, 42, 160, 96, 162, 86, 10, 74,
-			221, 59, 149, 181, 149, 162, 20, 57, 85, 216, 26, 12, 209, 1,
-			4, 162, 217, 109, 131, 243, 158, 238, 149, 140, 30, 219, 53, 148,
-			176, 206, 118, 252, 125, 30, 160, 51, 32, 49, 110, 160, 13, 8,
-			196, 208, 150, 35, 182, 146, 240, 232, 140, 98, 13, 112, 40, 69,
-			165, 14, 70, 83, 15, 148, 178, 39, 127, 90, 41, 106, 141, 166,
-			174, 144, 191, 101, 8, 117, 236, 44, 168, 99, 191, 102, 48, 249,
-			117, 196, 194, 179, 52, 205, 213, 186, 76, 242, 117, 140, 86, 177,
-			195, 189, 144, 77, 133, 157, 250, 46, 40, 49, 242, 235, 23, 143,
-			167, 133, 139, 33, 240, 59, 59, 187, 4, 125, 10, 182, 215, 64,
-			197, 173, 211, 234, 105, 8, 86, 172, 183, 29, 237, 35, 149, 109,
-			105, 154, 199, 217, 220, 41, 242, 47, 44, 165, 122, 188, 106, 14,
-			229, 255, 220, 98, 91, 158, 243, 9, 72, 220, 13, 238, 69, 78,
-			211, 17, 82, 56, 198, 22, 137, 62, 48, 188, 11, 245, 46, 101,
-			126, 106, 240, 216, 179, 130, 3, 219, 69, 249, 91, 154, 195, 160,
-			161, 86, 219, 143, 184, 87, 71, 171, 29, 179, 27, 13, 12, 126,
-			178, 227, 6, 89, 217, 174, 239, 178, 143, 253, 26, 219, 69, 245,
-			77, 24, 99, 61, 219, 101, 194, 80, 42, 62, 144, 202, 10, 170,
-			112, 1, 175, 163, 244, 35, 119, 68, 230, 68, 4, 159, 57, 251,
-			188, 81, 82, 168, 14, 133, 166, 135, 145, 40, 182, 27, 112, 187,
-			209, 101, 53, 206, 61, 22, 194, 127, 64, 227, 11, 29, 151, 123,
-			145, 219, 101, 225, 158, 211, 110, 99, 85, 16, 158, 100, 135, 78,
-			8, 244, 225, 248, 13, 41, 2, 212, 65, 50, 229, 13, 248, 0,
-			152, 239, 54, 112, 44, 114, 53, 113, 106, 145, 131, 197, 2, 14,
-			40, 41, 18, 22, 237, 194, 82, 33, 31, 144, 49, 124, 192, 10,
-			2, 238, 118, 225, 91, 128, 177, 1, 22, 85, 176, 75, 108, 56,
-			172, 117, 217, 193, 174, 207, 247, 129, 206, 91, 78, 20, 246, 160,
-			157, 45, 139, 14, 109, 175, 27, 237, 226, 217, 141, 136, 217, 168,
-			126, 133, 68, 4, 55, 219, 128, 13, 92, 24, 92, 194, 68, 55,
-			204, 192, 226, 230, 52, 221, 240, 213, 254, 1, 77, 55, 124, 117,
-			240, 20, 249, 80, 6, 13, 89, 147, 230, 72, 254, 93, 182, 46,
-			163, 41, 97, 141, 3, 214, 12, 28, 238, 53, 220, 174, 48, 87,
-			138, 175, 203, 9, 213, 200, 4, 174, 195, 93, 255, 32, 100, 157,
-			54, 240, 139, 13, 197, 134, 217, 86, 37, 30, 5, 40, 29, 147,
-			241, 40, 64, 233, 152, 236, 31, 84, 144, 69, 173, 201, 97, 74,
-			222, 196, 81, 152, 212, 186, 106, 14, 231, 103, 147, 81, 220, 223,
-			220, 124, 192, 92, 199, 219, 67, 114, 115, 194, 182, 107, 119, 159,
-			216, 17, 8, 236, 87, 227, 142, 96, 78, 87, 251, 79, 40, 200,
-			162, 214, 213, 83, 67, 228, 23, 49, 72, 10, 20, 183, 146, 121,
-			195, 202, 239, 179, 197, 122, 212, 177, 221, 120, 74, 176, 11, 151,
-			88, 5, 150, 0, 196, 104, 116, 33, 242, 144, 237, 250, 7, 250,
-			154, 176, 3, 199, 117, 229, 10, 214, 49, 152, 150, 224, 199, 184,
-			203, 147, 145, 21, 129, 230, 88, 221, 15, 132, 123, 176, 129, 188,
-			7, 118, 194, 88, 119, 71, 213, 176, 148, 59, 69, 6, 112, 246,
-			22, 181, 102, 210, 121, 57, 94, 43, 11, 208, 41, 5, 25, 212,
-			154, 25, 82, 74, 62, 168, 63, 51, 103, 206, 202, 106, 105, 106,
-			205, 166, 39, 228, 43, 80, 110, 102, 211, 67, 10, 50, 168, 53,
-			59, 60, 166, 32, 139, 90, 179, 103, 207, 201, 106, 25, 106, 205,
-			197, 189, 129, 234, 50, 23, 247, 6, 170, 203, 92, 220, 27, 168,
-			46, 115, 113, 111, 89, 106, 205, 167, 153, 124, 149, 69, 136, 42,
-			200, 160, 214, 252, 136, 106, 50, 107, 81, 107, 254, 252, 43, 178,
-			90, 31, 181, 174, 167, 167, 228, 171, 190, 44, 64, 106, 88, 125,
-			6, 181, 174, 159, 41, 40, 200, 162, 214, 245, 203, 147, 228, 247,
-			164, 201, 226, 173, 212, 162, 145, 255, 247, 77, 166, 201, 93, 49,
-			7, 82, 220, 194, 237, 2, 242, 19, 146, 128, 189, 171, 213, 118,
-			121, 11, 248, 133, 250, 156, 129, 205, 132, 9, 11, 211, 121, 241,
-			46, 103, 32, 194, 178, 48, 2, 70, 214, 178, 235, 187, 184, 230,
-			7, 187, 220, 195, 79, 210, 137, 88, 131, 215, 157, 134, 140, 36,
-			142, 101, 13, 162, 190, 113, 215, 238, 120, 117, 177, 147, 59, 222,
-			190, 47, 28, 50, 208, 215, 154, 31, 241, 5, 134, 44, 57, 230,
-			91, 117, 219, 155, 20, 198, 49, 217, 78, 67, 113, 120, 60, 75,
-			134, 236, 47, 153, 203, 226, 131, 10, 114, 168, 46, 65, 177, 0,
-			212, 37, 59, 80, 222, 9, 216, 16, 148, 243, 57, 148, 188, 71,
-			179, 83, 188, 149, 27, 33, 37, 101, 117, 248, 154, 121, 166, 112,
-			145, 181, 124, 207, 143, 124, 79, 114, 55, 199, 171, 7, 220, 198,
-			168, 91, 97, 200, 137, 141, 15, 25, 168, 208, 167, 25, 31, 190,
-			150, 163, 154, 241, 225, 107, 167, 199, 200, 127, 35, 117, 187, 251,
-			169, 138, 145, 255, 167, 6, 211, 69, 93, 29, 195, 49, 26, 129,
-			163, 132, 172, 238, 58, 245, 61, 86, 80, 50, 49, 171, 117, 34,
-			208, 118, 29, 79, 124, 201, 63, 27, 148, 177, 169, 200, 39, 50,
-			10, 160, 238, 123, 205, 14, 108, 247, 211, 2, 145, 47, 134, 71,
-			224, 34, 247, 115, 163, 228, 155, 4, 190, 159, 244, 90, 234, 129,
-			145, 127, 143, 105, 98, 124, 76, 141, 61, 36, 8, 164, 185, 163,
-			148, 119, 30, 70, 225, 33, 21, 30, 195, 7, 98, 229, 78, 116,
-			5, 31, 249, 90, 110, 4, 191, 24, 11, 150, 108, 221, 20, 56,
-			183, 112, 61, 214, 37, 103, 179, 112, 61, 214, 251, 79, 42, 200,
-			162, 214, 250, 208, 48, 249, 207, 96, 61, 210, 52, 253, 245, 212,
-			35, 35, 255, 123, 6, 235, 85, 35, 14, 209, 188, 240, 232, 201,
-			221, 242, 192, 142, 234, 187, 177, 224, 113, 72, 194, 32, 71, 37,
-			9, 88, 166, 141, 158, 5, 130, 141, 245, 200, 122, 139, 208, 136,
-			182, 31, 58, 145, 31, 116, 229, 215, 37, 60, 173, 40, 198, 237,
-			59, 54, 97, 229, 150, 19, 197, 123, 55, 16, 187, 192, 5, 112,
-			174, 175, 231, 198, 72, 145, 164, 211, 105, 192, 197, 7, 38, 45,
-			188, 2, 34, 194, 86, 117, 85, 4, 176, 66, 211, 50, 164, 20,
-			219, 108, 8, 226, 77, 35, 178, 62, 144, 200, 74, 35, 178, 62,
-			144, 200, 74, 35, 178, 62, 24, 26, 38, 43, 216, 174, 65, 173,
-			15, 205, 225, 194, 235, 216, 90, 179, 167, 49, 140, 142, 110, 162,
-			149, 147, 151, 118, 74, 172, 16, 240, 102, 56, 179, 203, 237, 70,
-			56, 211, 178, 195, 136, 7, 5, 213, 31, 236, 111, 31, 198, 253,
-			193, 135, 247, 161, 220, 118, 210, 184, 191, 125, 120, 106, 8, 247,
-			183, 52, 236, 111, 31, 153, 99, 133, 89, 236, 79, 40, 103, 108,
-			106, 227, 254, 226, 28, 116, 182, 203, 31, 79, 139, 243, 180, 66,
-			184, 146, 156, 41, 224, 77, 213, 17, 240, 236, 143, 226, 142, 208,
-			164, 209, 63, 172, 32, 139, 90, 31, 141, 158, 38, 95, 199, 142,
-			44, 106, 125, 203, 156, 202, 191, 195, 30, 196, 154, 150, 240, 119,
-			218, 59, 72, 137, 118, 3, 67, 111, 65, 34, 83, 246, 37, 60,
-			64, 32, 149, 87, 233, 53, 20, 156, 209, 111, 181, 156, 168, 164,
-			198, 0, 251, 210, 183, 204, 113, 5, 25, 212, 250, 214, 217, 75,
-			10, 130, 110, 175, 76, 34, 1, 167, 97, 95, 218, 54, 207, 201,
-			87, 105, 132, 84, 35, 233, 12, 181, 182, 7, 212, 208, 97, 173,
-			183, 233, 152, 130, 44, 106, 109, 159, 205, 147, 191, 105, 17, 40,
-			151, 222, 75, 121, 70, 254, 127, 53, 217, 81, 165, 243, 105, 36,
-			45, 67, 116, 152, 45, 167, 4, 18, 165, 227, 54, 132, 192, 175,
-			203, 207, 199, 18, 247, 166, 182, 211, 215, 125, 15, 52, 177, 144,
-			57, 158, 8, 15, 144, 248, 106, 219, 97, 40, 162, 251, 57, 243,
-			248, 65, 79, 67, 216, 87, 194, 204, 14, 96, 68, 248, 76, 212,
-			72, 134, 47, 245, 20, 222, 96, 33, 111, 219, 128, 121, 183, 27,
-			107, 60, 146, 57, 125, 236, 215, 112, 200, 77, 103, 167, 35, 57,
-			202, 20, 158, 44, 241, 61, 124, 169, 125, 133, 30, 122, 166, 167,
-			229, 199, 165, 177, 54, 25, 175, 4, 93, 180, 120, 4, 101, 69,
-			19, 186, 26, 34, 25, 106, 187, 83, 115, 157, 58, 212, 83, 10,
-			3, 136, 3, 123, 185, 60, 46, 107, 6, 190, 69, 215, 20, 59,
-			121, 6, 205, 95, 174, 164, 134, 12, 126, 106, 174, 164, 134, 12,
-			126, 106, 174, 164, 134, 12, 16, 107, 75, 82, 67, 198, 52, 210,
-			0, 17, 5, 101, 168, 213, 146, 212, 144, 193, 239, 167, 37, 169,
-			33, 131, 223, 79, 235, 108, 62, 182, 161, 253, 159, 247, 201, 91,
-			47, 106, 67, 75, 140, 61, 71, 140, 104, 79, 11, 9, 207, 127,
-			73, 99, 93, 225, 27, 100, 224, 109, 191, 22, 86, 5, 246, 233,
-			56, 233, 107, 7, 254, 199, 188, 30, 73, 83, 142, 2, 233, 24,
-			201, 214, 59, 65, 232, 7, 210, 160, 35, 33, 122, 142, 244, 183,
-			237, 29, 190, 29, 58, 159, 138, 168, 163, 76, 53, 7, 15, 54,
-			156, 79, 121, 225, 1, 233, 23, 173, 183, 221, 46, 45, 144, 52,
-			136, 55, 50, 148, 103, 80, 179, 111, 189, 237, 215, 170, 248, 142,
-			190, 66, 6, 60, 254, 56, 218, 238, 233, 138, 192, 163, 101, 124,
-			82, 232, 16, 90, 137, 165, 151, 120, 216, 87, 73, 223, 199, 126,
-			109, 59, 224, 77, 105, 214, 25, 62, 212, 58, 111, 86, 179, 31,
-			227, 255, 191, 216, 68, 92, 50, 212, 211, 45, 204, 231, 117, 50,
-			144, 8, 82, 106, 90, 167, 181, 142, 147, 26, 85, 189, 228, 179,
-			39, 249, 95, 25, 100, 68, 223, 115, 212, 52, 191, 74, 250, 106,
-			176, 13, 198, 241, 80, 175, 106, 189, 29, 83, 161, 180, 4, 165,
-			171, 170, 18, 157, 32, 253, 241, 177, 19, 236, 214, 170, 38, 15,
-			242, 223, 32, 25, 44, 79, 139, 164, 79, 82, 137, 196, 38, 213,
-			186, 145, 93, 84, 85, 17, 122, 89, 46, 171, 137, 35, 58, 6,
-			241, 248, 186, 112, 131, 100, 5, 252, 20, 26, 27, 34, 214, 199,
-			126, 77, 89, 12, 63, 246, 107, 133, 71, 228, 164, 134, 69, 222,
-			124, 161, 149, 190, 68, 78, 38, 104, 223, 118, 26, 114, 202, 39,
-			146, 135, 149, 70, 225, 87, 13, 98, 189, 237, 215, 94, 168, 225,
-			60, 201, 169, 119, 114, 176, 49, 76, 167, 73, 6, 133, 8, 36,
-			161, 129, 249, 145, 222, 86, 54, 224, 85, 85, 148, 0, 74, 108,
-			219, 32, 150, 137, 80, 188, 170, 132, 10, 147, 36, 167, 138, 2,
-			85, 118, 156, 109, 40, 223, 9, 37, 186, 114, 29, 103, 3, 225,
-			194, 223, 54, 9, 73, 208, 67, 223, 34, 131, 218, 124, 147, 153,
-			140, 31, 79, 147, 188, 89, 213, 240, 3, 243, 58, 79, 72, 24,
-			217, 65, 196, 27, 219, 81, 168, 8, 68, 62, 217, 68, 186, 109,
-			58, 158, 19, 238, 138, 247, 150, 176, 37, 171, 71, 155, 33, 189,
-			72, 78, 196, 166, 158, 237, 90, 23, 167, 213, 95, 29, 136, 159,
-			45, 117, 97, 206, 114, 50, 25, 241, 245, 9, 136, 142, 146, 76,
-			211, 241, 108, 23, 195, 6, 115, 85, 1, 208, 73, 114, 74, 108,
-			42, 219, 177, 221, 184, 15, 171, 13, 138, 199, 85, 101, 61, 62,
-			75, 114, 251, 14, 63, 216, 238, 4, 238, 120, 78, 16, 21, 192,
-			91, 129, 59, 255, 189, 52, 233, 143, 101, 94, 250, 58, 233, 187,
-			199, 35, 96, 74, 116, 172, 119, 105, 212, 71, 147, 31, 61, 242,
-			28, 190, 246, 85, 50, 120, 143, 71, 26, 19, 160, 231, 143, 69,
-			107, 220, 204, 185, 39, 189, 134, 214, 190, 70, 78, 246, 180, 70,
-			159, 184, 70, 249, 227, 57, 10, 189, 73, 114, 15, 128, 92, 128,
-			118, 143, 146, 106, 254, 104, 164, 36, 198, 223, 210, 91, 164, 191,
-			202, 65, 176, 120, 193, 122, 55, 73, 110, 177, 230, 7, 209, 11,
-			86, 91, 38, 167, 176, 218, 115, 205, 244, 73, 141, 220, 37, 39,
-			116, 230, 70, 47, 60, 157, 235, 61, 169, 157, 47, 103, 178, 255,
-			241, 109, 210, 71, 51, 131, 169, 63, 51, 94, 218, 236, 95, 218,
-			236, 255, 90, 109, 246, 151, 241, 167, 65, 173, 211, 169, 251, 100,
-			133, 152, 89, 180, 222, 219, 70, 254, 43, 154, 50, 207, 31, 183,
-			253, 144, 135, 154, 104, 172, 22, 55, 41, 35, 227, 249, 133, 200,
-			156, 21, 54, 246, 97, 242, 243, 38, 73, 103, 209, 198, 206, 204,
-			153, 252, 255, 100, 48, 201, 32, 89, 147, 163, 216, 192, 108, 215,
-			69, 195, 20, 11, 237, 200, 9, 155, 93, 152, 172, 198, 48, 81,
-			105, 3, 62, 92, 115, 92, 39, 234, 178, 197, 229, 85, 97, 195,
-			106, 234, 165, 74, 114, 147, 239, 213, 40, 106, 157, 72, 69, 49,
-			225, 203, 134, 207, 67, 111, 50, 98, 252, 177, 19, 70, 69, 113,
-			14, 34, 137, 193, 193, 81, 97, 72, 85, 212, 9, 60, 142, 218,
-			203, 34, 170, 23, 78, 40, 70, 224, 114, 230, 136, 89, 215, 109,
-			23, 166, 188, 107, 135, 172, 144, 176, 11, 180, 175, 237, 240, 168,
-			208, 27, 62, 122, 130, 100, 178, 194, 246, 204, 178, 39, 21, 100,
-			82, 139, 13, 158, 85, 144, 69, 45, 246, 234, 53, 242, 243, 6,
-			34, 203, 160, 214, 101, 115, 37, 31, 178, 222, 61, 33, 70, 153,
-			38, 238, 9, 67, 192, 142, 179, 207, 61, 24, 106, 145, 181, 124,
-			140, 11, 70, 71, 65, 211, 9, 66, 180, 182, 87, 197, 183, 243,
-			252, 131, 5, 21, 228, 114, 118, 84, 65, 38, 181, 46, 159, 158,
-			84, 144, 69, 173, 203, 243, 75, 132, 227, 88, 209, 96, 253, 149,
-			252, 215, 123, 199, 154, 172, 174, 12, 231, 56, 100, 21, 124, 225,
-			1, 161, 41, 59, 59, 162, 32, 232, 117, 244, 162, 130, 44, 106,
-			93, 45, 222, 34, 127, 75, 144, 154, 69, 173, 27, 230, 235, 249,
-			95, 53, 153, 218, 194, 132, 173, 186, 29, 240, 125, 192, 138, 221,
-			137, 124, 80, 98, 235, 186, 238, 135, 104, 252, 216, 175, 161, 111,
-			194, 246, 52, 123, 56, 188, 157, 66, 3, 8, 40, 148, 61, 198,
-			154, 234, 131, 229, 105, 164, 56, 60, 251, 107, 187, 174, 127, 128,
-			169, 19, 188, 46, 107, 115, 79, 184, 122, 252, 128, 5, 29, 207,
-			19, 249, 49, 146, 101, 67, 63, 12, 86, 227, 143, 121, 29, 184,
-			14, 244, 28, 15, 24, 40, 21, 216, 49, 58, 59, 156, 166, 162,
-			66, 229, 207, 17, 162, 220, 83, 17, 137, 69, 142, 69, 37, 124,
-			235, 55, 178, 131, 10, 50, 169, 117, 227, 212, 105, 5, 1, 242,
-			216, 77, 242, 255, 23, 132, 152, 166, 214, 29, 243, 43, 249, 207,
-			89, 188, 171, 179, 0, 127, 133, 114, 8, 10, 101, 201, 251, 39,
-			140, 28, 182, 150, 231, 24, 181, 104, 253, 216, 97, 167, 13, 106,
-			221, 201, 158, 82, 144, 73, 173, 59, 67, 99, 10, 178, 168, 117,
-			231, 226, 45, 242, 151, 130, 2, 50, 212, 42, 155, 175, 231, 255,
-			153, 201, 148, 84, 129, 7, 21, 164, 83, 9, 13, 6, 190, 102,
-			72, 69, 121, 185, 200, 108, 40, 43, 18, 131, 201, 100, 43, 110,
-			188, 142, 204, 15, 200, 49, 235, 8, 19, 180, 189, 174, 178, 115,
-			20, 213, 73, 3, 7, 25, 73, 232, 180, 28, 215, 70, 99, 252,
-			33, 49, 69, 237, 171, 88, 252, 208, 59, 162, 118, 58, 253, 59,
-			103, 149, 21, 100, 132, 49, 7, 211, 18, 129, 245, 218, 220, 197,
-			52, 120, 131, 57, 33, 97, 54, 122, 118, 220, 46, 115, 237, 8,
-			120, 105, 226, 42, 85, 91, 78, 188, 126, 79, 88, 16, 108, 237,
-			216, 245, 200, 24, 212, 42, 199, 100, 148, 49, 169, 85, 142, 201,
-			40, 99, 81, 171, 204, 110, 146, 239, 138, 245, 200, 82, 107, 221,
-			44, 231, 255, 55, 227, 8, 30, 176, 249, 80, 231, 96, 135, 24,
-			69, 165, 41, 156, 162, 9, 198, 65, 50, 241, 48, 23, 73, 163,
-			120, 164, 61, 157, 250, 100, 117, 16, 110, 14, 108, 79, 100, 72,
-			131, 226, 192, 147, 212, 70, 187, 219, 233, 89, 207, 98, 34, 254,
-			40, 243, 153, 227, 133, 17, 183, 27, 4, 56, 132, 162, 166, 47,
-			136, 177, 172, 65, 173, 245, 172, 194, 81, 214, 164, 214, 250, 216,
-			37, 5, 89, 212, 90, 47, 45, 147, 191, 204, 32, 198, 250, 168,
-			245, 200, 188, 159, 255, 103, 153, 94, 134, 211, 238, 68, 33, 147,
-			153, 4, 91, 126, 192, 53, 87, 176, 23, 249, 49, 177, 42, 243,
-			218, 39, 29, 222, 225, 50, 248, 151, 19, 109, 99, 84, 142, 160,
-			77, 152, 41, 114, 69, 65, 38, 117, 248, 66, 67, 177, 29, 195,
-			39, 2, 202, 153, 100, 80, 128, 80, 148, 62, 227, 233, 162, 192,
-			215, 226, 193, 14, 39, 172, 213, 113, 35, 167, 237, 30, 30, 144,
-			178, 207, 201, 38, 0, 193, 53, 59, 20, 18, 150, 242, 39, 10,
-			6, 145, 216, 247, 4, 135, 128, 117, 143, 152, 203, 237, 48, 138,
-			91, 233, 217, 190, 213, 201, 67, 109, 39, 246, 124, 13, 235, 152,
-			93, 34, 182, 51, 202, 60, 70, 220, 139, 156, 128, 39, 107, 27,
-			170, 175, 165, 196, 214, 65, 92, 19, 73, 65, 180, 3, 142, 80,
-			4, 207, 24, 68, 129, 237, 133, 42, 131, 207, 2, 124, 247, 78,
-			196, 154, 24, 91, 221, 114, 26, 7, 118, 87, 238, 17, 181, 174,
-			148, 31, 4, 163, 144, 158, 25, 60, 36, 21, 136, 208, 219, 233,
-			34, 17, 113, 227, 49, 170, 0, 143, 232, 174, 23, 110, 250, 78,
-			77, 90, 116, 225, 131, 199, 146, 82, 54, 47, 73, 199, 93, 104,
-			55, 185, 112, 166, 68, 65, 151, 196, 88, 40, 98, 156, 182, 219,
-			85, 153, 118, 66, 59, 233, 132, 85, 86, 66, 45, 66, 32, 150,
-			224, 177, 76, 101, 37, 196, 29, 146, 176, 26, 215, 3, 26, 26,
-			37, 148, 84, 149, 173, 89, 29, 56, 109, 42, 226, 147, 193, 229,
-			79, 253, 26, 34, 229, 228, 58, 230, 123, 232, 51, 168, 245, 40,
-			75, 21, 100, 82, 235, 209, 200, 21, 5, 89, 212, 122, 52, 119,
-			151, 16, 17, 78, 82, 79, 57, 70, 28, 186, 81, 207, 141, 144,
-			123, 42, 114, 131, 155, 167, 243, 11, 64, 46, 176, 195, 36, 20,
-			238, 7, 172, 80, 40, 162, 112, 41, 165, 191, 112, 82, 208, 181,
-			141, 4, 16, 139, 120, 73, 152, 0, 239, 9, 19, 224, 253, 67,
-			90, 152, 0, 31, 25, 149, 110, 92, 131, 90, 205, 56, 214, 220,
-			200, 0, 164, 251, 245, 155, 253, 167, 52, 191, 126, 147, 142, 144,
-			178, 242, 235, 239, 154, 99, 249, 175, 176, 216, 10, 8, 43, 153,
-			236, 51, 48, 250, 216, 99, 43, 215, 30, 118, 145, 29, 207, 15,
-			180, 113, 194, 214, 182, 43, 189, 146, 66, 40, 218, 205, 13, 107,
-			254, 253, 221, 209, 211, 136, 50, 67, 120, 13, 98, 63, 232, 94,
-			110, 24, 199, 111, 8, 227, 117, 28, 69, 157, 6, 72, 143, 183,
-			118, 7, 6, 53, 151, 167, 123, 106, 68, 115, 121, 186, 99, 103,
-			100, 35, 104, 202, 62, 171, 69, 84, 183, 204, 156, 22, 81, 221,
-			234, 31, 213, 34, 170, 91, 103, 198, 113, 76, 38, 77, 183, 83,
-			29, 35, 246, 41, 182, 165, 65, 29, 131, 81, 63, 49, 79, 107,
-			241, 164, 159, 200, 230, 68, 60, 233, 39, 253, 67, 90, 60, 233,
-			39, 114, 41, 112, 246, 129, 92, 10, 19, 71, 17, 196, 213, 96,
-			20, 129, 92, 10, 19, 71, 17, 208, 17, 60, 105, 128, 178, 99,
-			100, 142, 229, 139, 218, 82, 52, 120, 211, 238, 184, 194, 69, 121,
-			115, 86, 198, 251, 59, 240, 101, 62, 118, 90, 157, 86, 28, 249,
-			10, 232, 143, 36, 250, 77, 28, 64, 36, 209, 111, 34, 250, 35,
-			137, 126, 139, 166, 15, 82, 159, 26, 177, 79, 243, 32, 55, 158,
-			248, 52, 31, 155, 87, 148, 223, 50, 13, 16, 81, 80, 150, 90,
-			143, 7, 78, 107, 30, 206, 199, 99, 23, 53, 15, 231, 227, 87,
-			47, 203, 70, 12, 106, 117, 37, 250, 45, 156, 120, 55, 118, 140,
-			194, 196, 187, 18, 253, 22, 78, 188, 123, 6, 250, 54, 211, 105,
-			154, 249, 118, 234, 215, 12, 35, 118, 46, 126, 59, 119, 142, 156,
-			36, 233, 116, 218, 74, 209, 204, 231, 230, 207, 27, 150, 240, 63,
-			89, 208, 245, 231, 228, 20, 57, 73, 178, 0, 193, 152, 127, 46,
-			61, 78, 6, 73, 159, 0, 179, 0, 15, 36, 176, 65, 173, 159,
-			59, 49, 146, 192, 22, 181, 126, 110, 236, 76, 92, 221, 160, 214,
-			119, 210, 231, 227, 215, 70, 26, 224, 19, 9, 156, 165, 214, 119,
-			78, 38, 213, 97, 6, 223, 25, 77, 186, 131, 57, 124, 231, 220,
-			4, 138, 164, 232, 8, 77, 127, 215, 48, 207, 231, 191, 205, 22,
-			99, 230, 6, 31, 11, 126, 219, 78, 148, 228, 13, 99, 13, 238,
-			58, 251, 34, 46, 204, 7, 30, 181, 30, 52, 132, 203, 201, 105,
-			181, 253, 0, 115, 1, 30, 138, 153, 2, 214, 0, 218, 32, 111,
-			48, 110, 7, 174, 131, 97, 217, 92, 156, 18, 18, 71, 82, 124,
-			183, 129, 81, 96, 39, 133, 43, 53, 141, 131, 137, 193, 44, 128,
-			3, 67, 10, 52, 0, 28, 30, 87, 160, 5, 224, 185, 9, 242,
-			127, 89, 210, 243, 154, 254, 85, 195, 28, 203, 255, 15, 22, 91,
-			244, 226, 20, 110, 90, 38, 63, 121, 232, 222, 78, 130, 141, 234,
-			1, 151, 206, 55, 167, 5, 2, 43, 70, 59, 61, 214, 170, 56,
-			30, 97, 45, 167, 30, 36, 41, 53, 23, 195, 208, 217, 241, 146,
-			40, 15, 185, 19, 213, 186, 138, 230, 75, 192, 59, 81, 250, 42,
-			138, 51, 165, 53, 97, 224, 129, 166, 94, 187, 54, 119, 147, 181,
-			28, 79, 233, 250, 122, 14, 194, 18, 90, 102, 0, 163, 184, 69,
-			105, 167, 74, 123, 92, 226, 234, 44, 79, 173, 171, 13, 211, 111,
-			138, 196, 112, 209, 46, 239, 138, 227, 183, 56, 51, 216, 112, 150,
-			186, 66, 143, 82, 155, 88, 221, 117, 184, 144, 221, 4, 111, 239,
-			202, 240, 43, 213, 22, 166, 197, 109, 217, 123, 188, 199, 143, 14,
-			154, 89, 18, 124, 23, 225, 126, 173, 191, 134, 237, 178, 103, 247,
-			46, 106, 178, 4, 145, 153, 20, 162, 160, 11, 4, 37, 34, 212,
-			96, 60, 252, 177, 93, 143, 228, 182, 170, 175, 210, 14, 143, 164,
-			159, 56, 8, 120, 61, 18, 242, 169, 152, 57, 57, 20, 64, 24,
-			198, 180, 99, 100, 112, 253, 251, 20, 136, 228, 144, 27, 86, 160,
-			5, 224, 232, 105, 50, 135, 46, 224, 236, 15, 140, 212, 191, 97,
-			24, 249, 75, 76, 88, 68, 101, 148, 155, 219, 77, 66, 21, 195,
-			88, 127, 29, 16, 14, 203, 244, 15, 140, 28, 240, 114, 225, 177,
-			76, 255, 134, 97, 158, 198, 198, 51, 152, 76, 235, 55, 12, 51,
-			167, 64, 3, 192, 254, 33, 5, 90, 0, 142, 140, 202, 170, 6,
-			77, 255, 208, 48, 135, 229, 75, 24, 245, 15, 147, 170, 6, 190,
-			237, 63, 161, 64, 11, 192, 83, 67, 164, 66, 204, 116, 150, 102,
-			127, 211, 72, 253, 59, 134, 145, 191, 195, 122, 44, 177, 199, 15,
-			190, 71, 200, 215, 180, 113, 152, 77, 214, 160, 233, 223, 52, 114,
-			167, 113, 72, 89, 152, 205, 143, 212, 108, 178, 248, 213, 253, 72,
-			13, 41, 139, 179, 249, 145, 154, 77, 22, 103, 243, 35, 152, 205,
-			31, 24, 88, 215, 160, 233, 31, 27, 102, 62, 255, 31, 24, 172,
-			199, 123, 35, 14, 99, 116, 100, 252, 167, 23, 113, 228, 43, 45,
-			12, 45, 116, 221, 30, 213, 29, 3, 57, 19, 133, 165, 68, 216,
-			125, 255, 128, 239, 243, 0, 137, 8, 249, 133, 228, 64, 122, 173,
-			94, 145, 171, 183, 239, 90, 39, 2, 217, 203, 245, 189, 29, 153,
-			247, 185, 225, 52, 49, 87, 95, 164, 68, 117, 49, 27, 64, 255,
-			143, 21, 209, 100, 17, 253, 63, 6, 188, 72, 208, 2, 112, 252,
-			44, 185, 69, 204, 116, 31, 205, 254, 150, 145, 250, 251, 134, 145,
-			159, 98, 168, 151, 243, 176, 238, 212, 184, 46, 115, 36, 18, 183,
-			134, 235, 62, 131, 166, 127, 203, 200, 13, 32, 174, 251, 0, 215,
-			191, 173, 112, 221, 135, 184, 254, 109, 133, 235, 62, 196, 245, 111,
-			43, 92, 247, 33, 174, 127, 91, 81, 78, 31, 160, 250, 239, 24,
-			230, 152, 124, 9, 67, 255, 59, 73, 85, 3, 223, 246, 15, 43,
-			208, 2, 112, 244, 180, 172, 106, 210, 244, 223, 77, 122, 53, 179,
-			8, 18, 5, 26, 0, 14, 168, 94, 77, 11, 192, 184, 87, 139,
-			166, 255, 158, 97, 82, 249, 210, 202, 32, 152, 85, 160, 1, 96,
-			223, 73, 5, 98, 225, 161, 97, 242, 107, 6, 49, 211, 57, 154,
-			253, 71, 70, 234, 31, 27, 70, 254, 23, 12, 166, 92, 100, 241,
-			241, 200, 24, 113, 136, 77, 17, 201, 99, 135, 61, 39, 241, 84,
-			2, 237, 5, 194, 24, 43, 172, 84, 54, 22, 151, 86, 203, 43,
-			5, 132, 30, 224, 65, 97, 241, 187, 186, 181, 182, 86, 89, 187,
-			39, 128, 141, 229, 251, 229, 149, 173, 184, 220, 195, 197, 202, 38,
-			190, 195, 197, 200, 25, 52, 253, 143, 140, 220, 16, 206, 45, 7,
-			139, 241, 19, 195, 60, 131, 163, 207, 225, 103, 252, 19, 133, 209,
-			28, 46, 198, 79, 140, 126, 170, 64, 11, 192, 211, 99, 164, 76,
-			204, 116, 63, 205, 254, 142, 145, 250, 51, 195, 200, 191, 206, 116,
-			109, 57, 158, 91, 114, 28, 1, 102, 115, 68, 109, 147, 180, 209,
-			111, 208, 244, 239, 24, 24, 6, 151, 78, 247, 195, 112, 126, 215,
-			48, 47, 97, 135, 253, 72, 27, 191, 107, 152, 167, 20, 104, 0,
-			56, 116, 65, 129, 22, 128, 23, 11, 228, 14, 86, 53, 104, 250,
-			247, 13, 243, 76, 254, 154, 208, 49, 183, 163, 80, 198, 238, 246,
-			238, 102, 189, 155, 25, 145, 109, 1, 41, 253, 190, 250, 10, 250,
-			145, 148, 126, 31, 70, 37, 65, 11, 192, 211, 99, 228, 3, 236,
-			201, 164, 233, 63, 48, 204, 241, 252, 59, 76, 243, 46, 62, 71,
-			103, 108, 3, 207, 89, 2, 151, 106, 74, 142, 142, 97, 191, 29,
-			30, 143, 195, 204, 96, 219, 106, 28, 48, 169, 63, 48, 114, 35,
-			10, 180, 0, 28, 59, 67, 126, 195, 192, 129, 88, 52, 253, 135,
-			192, 121, 126, 201, 96, 186, 27, 19, 25, 143, 39, 89, 97, 212,
-			101, 83, 133, 61, 199, 107, 44, 224, 145, 43, 76, 160, 42, 69,
-			81, 77, 181, 17, 131, 34, 58, 195, 60, 72, 164, 4, 177, 217,
-			130, 62, 209, 19, 169, 25, 167, 219, 113, 162, 144, 187, 205, 120,
-			18, 240, 133, 252, 161, 162, 162, 126, 252, 66, 254, 208, 232, 63,
-			173, 64, 28, 245, 248, 89, 60, 186, 217, 111, 166, 105, 250, 167,
-			134, 57, 154, 63, 203, 86, 133, 65, 73, 248, 87, 117, 126, 45,
-			234, 165, 51, 88, 82, 181, 10, 250, 200, 79, 141, 126, 69, 27,
-			32, 31, 255, 212, 160, 35, 100, 3, 91, 205, 208, 244, 31, 25,
-			230, 112, 190, 12, 162, 8, 96, 88, 102, 172, 208, 230, 167, 209,
-			39, 72, 21, 98, 61, 64, 236, 59, 240, 101, 92, 164, 12, 90,
-			139, 71, 144, 17, 173, 102, 21, 104, 0, 216, 119, 66, 129, 22,
-			128, 167, 134, 72, 27, 71, 144, 165, 233, 63, 1, 161, 178, 198,
-			14, 57, 132, 89, 219, 241, 66, 165, 65, 206, 192, 55, 33, 10,
-			176, 125, 233, 80, 179, 235, 117, 63, 104, 72, 174, 45, 22, 11,
-			198, 126, 100, 113, 148, 128, 163, 134, 151, 205, 96, 151, 10, 65,
-			176, 195, 253, 137, 209, 63, 174, 64, 11, 192, 115, 19, 228, 35,
-			28, 94, 31, 77, 255, 41, 136, 138, 239, 50, 229, 134, 22, 81,
-			114, 168, 175, 236, 118, 90, 40, 174, 216, 13, 244, 135, 181, 149,
-			138, 174, 182, 168, 67, 214, 202, 125, 219, 113, 161, 96, 60, 146,
-			190, 12, 182, 174, 70, 2, 252, 255, 79, 21, 99, 238, 7, 61,
-			60, 253, 167, 198, 232, 233, 56, 252, 232, 47, 6, 200, 194, 51,
-			253, 156, 109, 14, 59, 25, 159, 81, 6, 144, 153, 70, 77, 70,
-			30, 13, 168, 71, 165, 70, 45, 255, 172, 75, 181, 10, 159, 16,
-			122, 87, 126, 181, 154, 143, 247, 72, 228, 133, 113, 52, 242, 130,
-			222, 34, 57, 245, 193, 203, 156, 74, 71, 147, 5, 197, 185, 136,
-			171, 113, 217, 194, 71, 100, 236, 104, 151, 171, 78, 24, 209, 197,
-			227, 34, 114, 94, 41, 105, 19, 42, 29, 173, 217, 19, 155, 179,
-			116, 253, 195, 185, 23, 198, 221, 219, 255, 60, 39, 142, 115, 93,
-			122, 170, 107, 248, 43, 47, 93, 195, 47, 93, 195, 47, 232, 26,
-			30, 141, 93, 195, 247, 19, 215, 240, 253, 196, 53, 60, 77, 126,
-			44, 143, 115, 229, 83, 231, 141, 252, 191, 110, 176, 163, 4, 222,
-			155, 65, 65, 56, 30, 221, 110, 188, 217, 62, 65, 170, 87, 150,
-			204, 200, 15, 68, 226, 138, 182, 29, 40, 39, 44, 147, 59, 161,
-			131, 90, 247, 49, 93, 134, 85, 251, 64, 28, 87, 214, 206, 115,
-			229, 165, 53, 9, 205, 21, 231, 204, 188, 102, 216, 59, 23, 27,
-			204, 160, 224, 185, 220, 105, 205, 176, 119, 110, 252, 44, 185, 164,
-			12, 123, 19, 230, 116, 97, 76, 168, 166, 78, 132, 108, 91, 77,
-			35, 62, 200, 147, 133, 82, 231, 52, 131, 223, 196, 196, 171, 154,
-			193, 111, 98, 114, 138, 124, 32, 236, 111, 23, 83, 151, 140, 252,
-			187, 199, 12, 31, 152, 137, 54, 121, 199, 75, 38, 141, 251, 91,
-			248, 132, 41, 107, 199, 26, 46, 230, 46, 36, 230, 188, 130, 89,
-			210, 204, 121, 133, 30, 115, 94, 97, 224, 188, 102, 206, 43, 92,
-			152, 214, 204, 121, 133, 226, 181, 152, 171, 255, 214, 21, 242, 181,
-			23, 231, 234, 135, 142, 102, 15, 199, 172, 80, 189, 120, 38, 135,
-			255, 210, 17, 166, 191, 158, 126, 242, 41, 239, 211, 36, 251, 177,
-			95, 83, 161, 121, 253, 213, 204, 199, 126, 173, 210, 56, 186, 125,
-			88, 199, 108, 31, 55, 72, 159, 220, 178, 229, 105, 225, 167, 237,
-			30, 170, 40, 125, 149, 12, 162, 218, 191, 237, 120, 219, 24, 22,
-			137, 1, 101, 86, 245, 4, 62, 173, 120, 34, 2, 50, 62, 125,
-			158, 57, 230, 244, 121, 54, 57, 125, 126, 133, 156, 146, 193, 221,
-			219, 181, 238, 118, 39, 228, 129, 140, 62, 59, 41, 31, 47, 117,
-			183, 66, 30, 188, 60, 165, 126, 228, 148, 122, 153, 12, 200, 66,
-			184, 115, 223, 34, 57, 69, 54, 114, 219, 206, 151, 142, 208, 106,
-			28, 123, 26, 151, 253, 98, 219, 245, 127, 41, 79, 95, 187, 79,
-			221, 174, 95, 127, 185, 93, 191, 220, 174, 95, 112, 187, 206, 63,
-			115, 187, 214, 34, 185, 254, 149, 204, 139, 85, 72, 53, 141, 252,
-			95, 90, 79, 59, 136, 141, 142, 81, 32, 98, 169, 27, 74, 87,
-			38, 224, 46, 214, 31, 241, 68, 69, 173, 75, 158, 251, 216, 54,
-			90, 220, 165, 167, 250, 136, 115, 88, 89, 213, 241, 8, 10, 174,
-			180, 19, 57, 104, 81, 193, 131, 36, 186, 238, 132, 42, 213, 174,
-			141, 193, 12, 117, 158, 28, 56, 233, 181, 88, 36, 141, 169, 175,
-			185, 55, 165, 214, 225, 51, 46, 132, 237, 121, 254, 1, 186, 127,
-			59, 218, 129, 106, 153, 124, 9, 209, 210, 51, 157, 216, 89, 174,
-			156, 160, 78, 239, 97, 237, 67, 249, 88, 122, 141, 190, 104, 169,
-			143, 119, 123, 225, 176, 109, 216, 145, 141, 143, 132, 143, 213, 118,
-			67, 159, 29, 230, 68, 72, 77, 50, 226, 238, 80, 7, 242, 240,
-			167, 56, 16, 95, 183, 93, 55, 212, 68, 161, 66, 238, 20, 249,
-			61, 117, 173, 131, 53, 105, 14, 229, 255, 129, 17, 31, 109, 87,
-			247, 20, 254, 53, 30, 113, 127, 177, 179, 222, 186, 75, 118, 178,
-			199, 37, 59, 217, 115, 114, 123, 114, 240, 20, 249, 57, 37, 185,
-			21, 205, 209, 252, 39, 172, 178, 18, 203, 152, 194, 161, 163, 168,
-			188, 231, 200, 54, 186, 134, 65, 23, 62, 246, 27, 192, 147, 125,
-			60, 234, 125, 88, 76, 206, 95, 194, 103, 28, 56, 141, 6, 247,
-			122, 78, 119, 23, 123, 188, 192, 197, 30, 47, 112, 145, 142, 144,
-			95, 50, 148, 27, 120, 222, 204, 231, 187, 106, 168, 61, 234, 249,
-			83, 135, 60, 123, 116, 196, 228, 139, 15, 217, 204, 192, 64, 116,
-			63, 242, 124, 44, 22, 155, 22, 181, 230, 199, 207, 146, 31, 90,
-			234, 76, 246, 155, 230, 84, 254, 251, 150, 118, 117, 143, 242, 222,
-			36, 135, 193, 123, 76, 28, 234, 184, 126, 40, 6, 245, 196, 181,
-			86, 209, 148, 218, 113, 176, 56, 60, 36, 244, 91, 48, 65, 117,
-			182, 21, 131, 84, 74, 202, 207, 175, 40, 169, 168, 51, 47, 12,
-			242, 131, 46, 157, 72, 49, 9, 105, 178, 37, 177, 199, 106, 83,
-			166, 184, 19, 59, 223, 100, 175, 176, 54, 41, 72, 30, 168, 248,
-			128, 219, 123, 194, 131, 35, 131, 3, 35, 221, 81, 136, 183, 46,
-			1, 114, 27, 78, 192, 235, 194, 148, 222, 106, 219, 1, 110, 99,
-			130, 121, 238, 56, 145, 60, 55, 40, 47, 133, 76, 44, 250, 241,
-			129, 80, 135, 3, 155, 20, 124, 37, 238, 75, 248, 47, 149, 81,
-			43, 241, 60, 6, 220, 117, 164, 221, 38, 57, 30, 255, 102, 172,
-			138, 0, 219, 127, 115, 226, 146, 118, 60, 254, 205, 43, 147, 228,
-			207, 50, 234, 124, 252, 170, 121, 46, 255, 79, 50, 24, 86, 195,
-			106, 120, 84, 91, 159, 212, 1, 215, 78, 175, 202, 144, 50, 244,
-			83, 180, 124, 60, 65, 174, 184, 198, 84, 51, 97, 170, 36, 62,
-			47, 23, 199, 120, 86, 31, 44, 139, 109, 226, 184, 176, 79, 60,
-			109, 201, 34, 20, 128, 92, 223, 111, 79, 23, 19, 30, 33, 51,
-			14, 134, 45, 17, 204, 115, 100, 93, 156, 80, 71, 69, 228, 35,
-			85, 163, 43, 150, 97, 198, 43, 230, 55, 201, 209, 90, 184, 25,
-			247, 132, 188, 36, 139, 48, 41, 169, 117, 82, 191, 42, 7, 182,
-			37, 207, 143, 136, 182, 156, 248, 109, 197, 46, 229, 231, 33, 232,
-			99, 90, 134, 207, 131, 136, 232, 28, 135, 55, 64, 146, 112, 157,
-			186, 19, 185, 93, 45, 236, 40, 206, 167, 16, 30, 254, 146, 147,
-			111, 2, 158, 37, 110, 197, 195, 14, 205, 144, 53, 124, 40, 221,
-			61, 230, 20, 254, 255, 135, 232, 62, 157, 1, 106, 238, 211, 242,
-			59, 172, 230, 244, 252, 14, 171, 103, 243, 228, 215, 13, 149, 224,
-			225, 61, 115, 36, 255, 93, 131, 109, 125, 145, 84, 30, 226, 52,
-			190, 228, 101, 207, 153, 168, 100, 69, 198, 168, 97, 60, 151, 32,
-			212, 67, 25, 73, 50, 56, 170, 156, 150, 104, 226, 189, 56, 23,
-			72, 198, 162, 214, 123, 195, 84, 238, 107, 89, 106, 189, 111, 14,
-			231, 63, 121, 118, 46, 144, 191, 154, 145, 102, 51, 48, 128, 156,
-			150, 219, 226, 253, 56, 153, 72, 214, 162, 214, 251, 167, 134, 148,
-			156, 209, 71, 173, 111, 152, 231, 65, 206, 184, 171, 127, 116, 201,
-			102, 118, 248, 100, 45, 238, 194, 135, 50, 32, 20, 227, 147, 198,
-			36, 241, 135, 224, 134, 142, 153, 88, 14, 118, 125, 45, 56, 78,
-			95, 58, 152, 60, 30, 194, 233, 253, 228, 15, 237, 239, 71, 28,
-			32, 50, 221, 70, 6, 198, 158, 211, 82, 113, 124, 163, 127, 92,
-			75, 197, 241, 141, 115, 19, 228, 190, 202, 152, 178, 109, 114, 43,
-			191, 112, 92, 198, 20, 65, 62, 226, 242, 231, 16, 63, 86, 121,
-			19, 194, 49, 178, 13, 102, 61, 217, 142, 179, 158, 228, 168, 245,
-			40, 173, 172, 72, 185, 44, 64, 103, 21, 100, 80, 235, 81, 254,
-			130, 130, 44, 106, 61, 186, 120, 73, 86, 235, 167, 150, 157, 190,
-			34, 95, 245, 103, 1, 82, 86, 175, 126, 131, 90, 246, 57, 149,
-			163, 164, 223, 162, 150, 125, 233, 178, 172, 70, 168, 85, 139, 123,
-			35, 89, 128, 84, 111, 196, 160, 86, 45, 238, 141, 88, 212, 170,
-			197, 189, 13, 80, 171, 158, 190, 42, 95, 13, 100, 1, 82, 25,
-			87, 6, 12, 106, 213, 207, 171, 38, 7, 44, 106, 213, 39, 167,
-			101, 181, 19, 212, 106, 164, 231, 229, 171, 19, 89, 128, 212, 176,
-			78, 24, 212, 106, 92, 44, 42, 200, 162, 86, 99, 102, 142, 44,
-			10, 235, 217, 199, 41, 215, 200, 223, 100, 154, 22, 15, 155, 192,
-			129, 188, 248, 24, 37, 103, 32, 252, 88, 140, 142, 237, 103, 154,
-			149, 236, 99, 153, 73, 2, 173, 100, 123, 38, 211, 172, 100, 123,
-			61, 86, 178, 189, 1, 170, 89, 201, 246, 70, 206, 105, 86, 178,
-			189, 11, 175, 36, 86, 178, 17, 114, 239, 185, 13, 2, 160, 55,
-			204, 72, 243, 198, 76, 187, 54, 163, 238, 219, 144, 198, 178, 33,
-			249, 170, 164, 158, 23, 174, 147, 204, 242, 174, 227, 54, 240, 20,
-			35, 222, 223, 40, 109, 89, 18, 162, 148, 164, 195, 93, 123, 14,
-			173, 89, 39, 170, 248, 187, 240, 144, 228, 170, 188, 185, 209, 182,
-			235, 226, 196, 103, 192, 181, 122, 2, 162, 215, 73, 174, 14, 13,
-			7, 220, 147, 231, 104, 207, 148, 14, 247, 94, 194, 174, 171, 113,
-			193, 194, 7, 228, 84, 53, 206, 92, 33, 78, 139, 206, 147, 108,
-			8, 29, 37, 70, 148, 35, 173, 168, 177, 84, 101, 73, 145, 186,
-			175, 25, 39, 183, 131, 223, 133, 255, 220, 32, 100, 133, 215, 58,
-			59, 162, 217, 91, 36, 131, 151, 30, 200, 86, 217, 209, 86, 147,
-			194, 208, 65, 85, 20, 167, 11, 164, 79, 202, 119, 114, 86, 207,
-			174, 169, 42, 32, 138, 185, 29, 212, 119, 199, 45, 28, 152, 132,
-			242, 51, 196, 170, 242, 166, 74, 46, 104, 36, 201, 5, 199, 72,
-			86, 108, 107, 241, 185, 110, 132, 150, 22, 62, 252, 202, 23, 165,
-			136, 183, 255, 124, 80, 88, 138, 230, 94, 90, 138, 94, 90, 138,
-			126, 166, 150, 162, 179, 177, 165, 104, 61, 177, 20, 173, 99, 92,
-			80, 138, 166, 79, 167, 198, 141, 252, 85, 134, 95, 60, 6, 53,
-			96, 74, 239, 48, 18, 78, 24, 140, 60, 142, 66, 6, 236, 133,
-			69, 78, 91, 51, 44, 156, 206, 157, 76, 124, 44, 99, 73, 198,
-			237, 12, 64, 186, 166, 62, 22, 235, 191, 192, 66, 199, 232, 72,
-			18, 60, 125, 198, 28, 214, 212, 230, 51, 177, 32, 7, 12, 251,
-			76, 238, 132, 166, 54, 159, 57, 53, 68, 62, 20, 187, 193, 185,
-			212, 5, 35, 191, 198, 20, 119, 17, 193, 90, 177, 26, 163, 120,
-			150, 52, 22, 133, 187, 118, 192, 19, 21, 38, 224, 77, 113, 157,
-			4, 214, 156, 18, 60, 113, 90, 219, 38, 206, 229, 134, 146, 109,
-			98, 194, 84, 161, 203, 48, 171, 137, 56, 172, 25, 102, 53, 33,
-			103, 37, 54, 134, 9, 58, 146, 68, 67, 159, 55, 47, 168, 136,
-			231, 52, 64, 113, 126, 233, 44, 181, 206, 203, 228, 184, 34, 54,
-			250, 252, 240, 89, 45, 54, 250, 252, 196, 121, 114, 95, 196, 70,
-			95, 76, 93, 49, 242, 111, 176, 67, 76, 87, 108, 117, 33, 44,
-			132, 8, 179, 112, 93, 145, 172, 8, 69, 227, 102, 40, 180, 42,
-			16, 173, 181, 108, 77, 23, 115, 103, 146, 200, 234, 130, 249, 138,
-			138, 158, 214, 220, 67, 166, 116, 15, 141, 104, 113, 214, 133, 209,
-			188, 22, 103, 93, 56, 127, 129, 188, 161, 226, 172, 47, 155, 231,
-			242, 51, 128, 127, 22, 240, 29, 254, 152, 43, 235, 144, 162, 28,
-			212, 14, 133, 72, 102, 135, 123, 44, 176, 189, 56, 102, 26, 16,
-			114, 57, 238, 21, 22, 253, 242, 192, 176, 22, 166, 125, 89, 102,
-			58, 17, 97, 218, 151, 207, 230, 201, 154, 136, 160, 158, 78, 205,
-			25, 249, 37, 150, 176, 112, 253, 112, 166, 238, 51, 188, 199, 35,
-			44, 20, 199, 108, 129, 30, 170, 146, 89, 105, 153, 165, 166, 101,
-			228, 146, 101, 165, 104, 250, 170, 121, 77, 196, 59, 91, 24, 239,
-			124, 149, 156, 196, 128, 101, 75, 196, 59, 191, 150, 22, 1, 201,
-			8, 102, 0, 38, 9, 108, 80, 235, 181, 129, 193, 4, 182, 168,
-			245, 218, 48, 141, 171, 27, 212, 42, 166, 199, 226, 215, 104, 29,
-			210, 170, 163, 125, 104, 96, 56, 129, 45, 106, 21, 71, 79, 99,
-			154, 50, 140, 15, 47, 153, 103, 11, 23, 197, 130, 235, 118, 195,
-			94, 179, 33, 209, 66, 200, 75, 61, 33, 228, 37, 25, 193, 47,
-			66, 200, 75, 167, 70, 181, 16, 242, 210, 153, 113, 114, 67, 133,
-			144, 207, 152, 231, 10, 147, 113, 63, 49, 98, 107, 93, 38, 247,
-			40, 166, 110, 145, 57, 80, 237, 195, 90, 206, 196, 189, 1, 113,
-			207, 196, 189, 193, 188, 102, 78, 141, 105, 145, 231, 51, 103, 243,
-			100, 14, 123, 51, 169, 53, 107, 158, 47, 188, 202, 196, 54, 203,
-			62, 233, 240, 160, 27, 27, 24, 3, 30, 5, 14, 199, 91, 17,
-			154, 161, 106, 28, 147, 250, 197, 93, 129, 42, 55, 43, 201, 198,
-			66, 138, 156, 165, 227, 10, 178, 168, 53, 123, 110, 34, 150, 210,
-			254, 254, 121, 242, 230, 139, 251, 50, 237, 70, 203, 241, 164, 108,
-			54, 24, 59, 135, 240, 233, 179, 189, 152, 95, 54, 33, 79, 254,
-			75, 132, 212, 228, 191, 180, 227, 54, 255, 179, 18, 106, 11, 191,
-			144, 37, 39, 123, 62, 69, 58, 78, 250, 184, 7, 155, 182, 112,
-			204, 230, 170, 10, 212, 242, 147, 152, 122, 126, 18, 122, 135, 12,
-			0, 75, 217, 142, 2, 199, 222, 81, 137, 78, 158, 230, 125, 37,
-			80, 124, 19, 75, 211, 187, 132, 212, 3, 223, 219, 22, 73, 82,
-			132, 231, 118, 178, 212, 187, 156, 165, 158, 17, 162, 143, 84, 36,
-			78, 233, 175, 171, 159, 244, 46, 57, 217, 178, 61, 123, 135, 7,
-			178, 169, 126, 108, 234, 226, 177, 77, 189, 43, 74, 138, 70, 78,
-			180, 52, 136, 94, 35, 212, 174, 71, 206, 62, 223, 214, 67, 135,
-			50, 204, 154, 178, 170, 195, 226, 141, 158, 244, 163, 74, 70, 227,
-			40, 69, 189, 66, 246, 249, 98, 141, 70, 154, 71, 3, 7, 232,
-			60, 57, 173, 162, 50, 182, 227, 198, 67, 30, 141, 247, 225, 40,
-			70, 212, 75, 213, 222, 6, 143, 232, 42, 25, 149, 103, 9, 183,
-			21, 169, 96, 149, 220, 51, 157, 167, 84, 214, 83, 7, 43, 54,
-			120, 148, 255, 151, 6, 233, 143, 177, 252, 20, 138, 232, 77, 38,
-			110, 30, 78, 38, 30, 83, 70, 192, 15, 28, 175, 241, 188, 148,
-			81, 197, 210, 244, 107, 100, 80, 144, 149, 83, 223, 219, 62, 216,
-			229, 222, 115, 248, 245, 79, 32, 101, 57, 245, 189, 135, 187, 220,
-			163, 87, 200, 169, 164, 5, 207, 247, 234, 194, 129, 111, 85, 79,
-			170, 98, 107, 240, 176, 240, 175, 25, 100, 248, 8, 93, 208, 81,
-			146, 193, 227, 127, 82, 169, 16, 0, 61, 71, 250, 27, 80, 116,
-			219, 245, 119, 84, 178, 31, 124, 176, 234, 239, 208, 101, 50, 40,
-			63, 181, 237, 182, 239, 186, 60, 144, 83, 158, 120, 154, 158, 83,
-			61, 41, 95, 62, 192, 42, 243, 239, 146, 204, 34, 144, 42, 93,
-			33, 67, 135, 55, 202, 227, 114, 173, 156, 127, 234, 215, 242, 197,
-			92, 226, 191, 66, 133, 162, 115, 255, 101, 4, 219, 75, 69, 231,
-			103, 170, 232, 156, 121, 94, 151, 248, 88, 170, 130, 63, 77, 106,
-			157, 73, 173, 224, 79, 139, 90, 227, 169, 183, 241, 103, 154, 90,
-			103, 83, 239, 145, 247, 68, 246, 147, 243, 169, 130, 145, 47, 51,
-			252, 108, 226, 204, 39, 248, 49, 0, 26, 197, 5, 78, 139, 15,
-			42, 172, 19, 138, 155, 247, 61, 145, 125, 87, 183, 49, 250, 7,
-			94, 156, 213, 20, 83, 161, 156, 207, 157, 36, 29, 149, 9, 229,
-			162, 185, 152, 223, 61, 42, 182, 170, 203, 77, 197, 185, 90, 20,
-			240, 241, 218, 37, 233, 164, 150, 103, 165, 113, 63, 194, 211, 87,
-			33, 111, 118, 92, 225, 139, 67, 158, 177, 211, 115, 230, 216, 9,
-			195, 248, 210, 14, 153, 126, 228, 98, 86, 37, 76, 72, 153, 212,
-			186, 120, 70, 101, 244, 0, 209, 240, 226, 252, 91, 228, 7, 50,
-			218, 239, 106, 234, 77, 35, 255, 61, 131, 245, 14, 207, 245, 253,
-			144, 187, 93, 214, 18, 249, 222, 244, 80, 61, 208, 25, 237, 48,
-			244, 235, 14, 102, 206, 68, 123, 104, 124, 251, 140, 72, 150, 42,
-			169, 66, 5, 26, 168, 163, 174, 201, 253, 37, 218, 105, 226, 53,
-			254, 56, 98, 145, 189, 179, 192, 230, 102, 53, 245, 243, 170, 60,
-			1, 148, 2, 249, 253, 53, 115, 78, 200, 239, 41, 148, 223, 95,
-			35, 35, 40, 128, 167, 132, 252, 94, 76, 159, 70, 1, 59, 37,
-			229, 247, 98, 58, 151, 192, 232, 160, 29, 74, 96, 16, 192, 71,
-			70, 227, 234, 6, 181, 174, 165, 207, 198, 175, 65, 126, 191, 150,
-			238, 79, 96, 120, 79, 70, 19, 216, 162, 214, 181, 51, 227, 113,
-			117, 147, 90, 165, 116, 41, 126, 109, 130, 72, 46, 143, 63, 34,
-			12, 66, 249, 133, 233, 4, 6, 177, 188, 120, 45, 174, 142, 41,
-			176, 231, 226, 215, 34, 9, 118, 82, 29, 211, 96, 95, 40, 38,
-			48, 148, 159, 153, 141, 171, 99, 42, 236, 11, 241, 235, 52, 200,
-			205, 218, 224, 49, 29, 54, 73, 38, 135, 9, 177, 39, 206, 39,
-			90, 253, 117, 115, 68, 211, 234, 175, 155, 89, 77, 171, 191, 222,
-			55, 168, 105, 245, 215, 135, 105, 162, 213, 223, 48, 169, 166, 213,
-			223, 136, 171, 1, 178, 110, 244, 157, 212, 180, 250, 27, 67, 195,
-			178, 154, 73, 173, 155, 166, 50, 255, 2, 154, 110, 198, 222, 76,
-			104, 242, 230, 196, 164, 230, 144, 190, 121, 245, 181, 36, 71, 248,
-			173, 196, 237, 153, 5, 104, 64, 115, 130, 222, 58, 49, 166, 57,
-			65, 111, 157, 205, 39, 57, 194, 95, 55, 149, 181, 60, 157, 5,
-			104, 68, 243, 33, 189, 46, 178, 201, 72, 31, 210, 235, 175, 94,
-			78, 114, 132, 223, 54, 85, 214, 238, 76, 26, 32, 221, 145, 115,
-			123, 96, 72, 115, 228, 220, 30, 190, 164, 57, 114, 110, 203, 204,
-			159, 232, 200, 89, 48, 151, 149, 231, 36, 13, 80, 236, 99, 129,
-			119, 3, 211, 154, 143, 101, 225, 234, 87, 53, 31, 203, 194, 226,
-			82, 146, 63, 252, 142, 249, 154, 114, 76, 96, 102, 22, 221, 133,
-			113, 39, 30, 73, 159, 65, 173, 59, 195, 87, 52, 23, 198, 157,
-			233, 171, 137, 171, 225, 13, 83, 181, 159, 75, 3, 68, 52, 199,
-			195, 27, 3, 175, 106, 142, 135, 55, 46, 223, 214, 28, 15, 111,
-			188, 241, 38, 121, 95, 152, 99, 22, 83, 247, 141, 252, 219, 236,
-			136, 144, 163, 76, 244, 34, 101, 120, 40, 249, 151, 244, 192, 216,
-			225, 94, 73, 22, 46, 41, 214, 183, 33, 121, 153, 50, 197, 44,
-			230, 206, 38, 166, 152, 37, 115, 68, 51, 197, 44, 245, 152, 98,
-			150, 250, 7, 53, 83, 204, 146, 36, 69, 113, 17, 152, 25, 95,
-			231, 149, 1, 72, 63, 152, 190, 220, 79, 53, 227, 203, 242, 233,
-			49, 242, 142, 186, 234, 235, 158, 57, 55, 255, 85, 140, 88, 82,
-			33, 62, 109, 216, 117, 180, 81, 139, 203, 205, 132, 151, 80, 92,
-			136, 23, 10, 190, 27, 179, 100, 209, 52, 44, 247, 61, 83, 197,
-			221, 194, 144, 238, 93, 40, 42, 200, 162, 214, 189, 153, 217, 196,
-			163, 48, 240, 28, 201, 92, 143, 168, 111, 34, 181, 103, 236, 73,
-			136, 5, 54, 249, 188, 240, 6, 25, 214, 52, 0, 145, 246, 147,
-			94, 36, 40, 206, 110, 135, 117, 219, 243, 184, 74, 87, 137, 18,
-			245, 134, 120, 244, 118, 58, 103, 12, 153, 95, 76, 196, 251, 167,
-			253, 47, 15, 41, 188, 20, 241, 254, 106, 109, 217, 61, 34, 222,
-			127, 33, 110, 199, 79, 79, 164, 46, 25, 249, 255, 196, 100, 71,
-			8, 94, 15, 97, 107, 219, 59, 142, 39, 68, 172, 16, 243, 60,
-			8, 171, 161, 158, 91, 173, 250, 96, 25, 163, 40, 180, 195, 9,
-			120, 183, 177, 243, 169, 176, 190, 213, 236, 144, 223, 186, 33, 114,
-			178, 200, 19, 233, 201, 249, 244, 16, 83, 227, 4, 124, 18, 147,
-			208, 136, 204, 247, 78, 212, 37, 120, 219, 5, 199, 172, 49, 11,
-			236, 128, 131, 140, 212, 105, 113, 86, 11, 252, 61, 238, 49, 249,
-			185, 202, 140, 36, 31, 139, 27, 30, 27, 254, 129, 23, 31, 70,
-			7, 206, 82, 223, 83, 249, 184, 147, 116, 50, 74, 180, 210, 14,
-			230, 203, 25, 31, 18, 172, 132, 80, 37, 18, 218, 139, 145, 18,
-			113, 202, 31, 62, 21, 225, 172, 6, 106, 113, 244, 59, 57, 39,
-			114, 103, 201, 52, 252, 238, 167, 214, 121, 243, 100, 225, 124, 140,
-			198, 154, 102, 128, 148, 125, 18, 177, 177, 244, 167, 168, 117, 126,
-			64, 90, 239, 251, 81, 224, 77, 32, 83, 64, 223, 141, 67, 18,
-			11, 230, 217, 252, 99, 25, 2, 23, 155, 144, 37, 95, 210, 35,
-			134, 166, 132, 133, 4, 136, 79, 89, 46, 166, 21, 50, 132, 9,
-			17, 131, 102, 132, 220, 140, 166, 241, 202, 138, 158, 202, 193, 14,
-			100, 92, 135, 39, 88, 130, 239, 245, 94, 12, 83, 232, 57, 24,
-			82, 200, 141, 106, 226, 77, 225, 204, 120, 204, 165, 255, 219, 225,
-			231, 184, 182, 238, 168, 145, 205, 105, 37, 103, 35, 78, 37, 38,
-			19, 124, 252, 236, 179, 111, 255, 192, 32, 153, 77, 40, 123, 228,
-			92, 131, 118, 54, 225, 217, 39, 219, 226, 179, 9, 69, 98, 241,
-			200, 126, 14, 171, 9, 20, 75, 206, 40, 164, 245, 51, 10, 227,
-			113, 136, 61, 154, 62, 78, 84, 227, 136, 251, 59, 164, 31, 7,
-			139, 241, 246, 37, 146, 21, 179, 140, 47, 214, 63, 52, 123, 236,
-			45, 168, 202, 82, 95, 108, 199, 249, 19, 233, 61, 189, 243, 210,
-			123, 250, 114, 199, 249, 153, 238, 56, 227, 207, 52, 42, 252, 43,
-			169, 40, 159, 75, 221, 52, 242, 127, 105, 96, 100, 236, 113, 161,
-			245, 182, 215, 61, 116, 254, 22, 207, 149, 201, 147, 187, 120, 131,
-			214, 30, 111, 96, 118, 62, 113, 37, 47, 126, 15, 34, 57, 100,
-			221, 111, 203, 11, 127, 143, 68, 82, 2, 59, 247, 15, 164, 179,
-			198, 137, 240, 106, 174, 3, 206, 26, 120, 22, 218, 241, 234, 110,
-			167, 193, 123, 78, 32, 7, 28, 99, 240, 234, 156, 193, 46, 165,
-			242, 136, 217, 238, 129, 221, 13, 147, 35, 193, 250, 141, 23, 94,
-			4, 58, 184, 223, 60, 18, 92, 126, 46, 119, 146, 252, 199, 26,
-			39, 31, 202, 255, 228, 153, 193, 229, 50, 97, 75, 80, 196, 220,
-			155, 215, 226, 43, 54, 126, 86, 177, 230, 146, 169, 188, 120, 24,
-			180, 216, 4, 116, 207, 117, 161, 39, 198, 188, 48, 120, 138, 124,
-			43, 185, 29, 108, 42, 255, 222, 177, 65, 208, 184, 250, 135, 66,
-			160, 95, 52, 134, 60, 11, 29, 232, 7, 11, 39, 227, 104, 94,
-			188, 33, 236, 202, 36, 105, 42, 181, 185, 104, 190, 154, 255, 128,
-			109, 194, 246, 38, 93, 175, 114, 44, 10, 209, 12, 55, 78, 59,
-			146, 182, 151, 56, 126, 245, 25, 161, 129, 80, 53, 9, 17, 207,
-			66, 71, 186, 70, 94, 156, 120, 69, 211, 200, 139, 133, 75, 228,
-			87, 12, 165, 146, 207, 153, 35, 249, 191, 241, 140, 56, 75, 28,
-			217, 151, 138, 178, 196, 1, 62, 71, 228, 162, 149, 129, 17, 229,
-			52, 179, 192, 92, 28, 99, 105, 193, 104, 135, 41, 89, 85, 102,
-			129, 27, 230, 104, 254, 45, 182, 24, 212, 156, 40, 176, 131, 110,
-			146, 138, 73, 238, 109, 120, 207, 9, 111, 176, 125, 30, 212, 236,
-			200, 105, 41, 209, 175, 39, 194, 54, 137, 77, 189, 209, 19, 155,
-			122, 35, 119, 74, 179, 43, 220, 160, 35, 228, 45, 161, 67, 223,
-			78, 221, 49, 242, 215, 89, 188, 105, 190, 120, 120, 219, 109, 61,
-			167, 219, 130, 57, 161, 133, 183, 45, 244, 132, 183, 45, 196, 1,
-			8, 64, 225, 11, 195, 103, 52, 213, 121, 65, 187, 157, 247, 127,
-			44, 126, 145, 163, 253, 209, 39, 71, 142, 246, 71, 159, 60, 199,
-			185, 206, 167, 202, 75, 95, 222, 169, 89, 184, 69, 78, 87, 185,
-			221, 120, 32, 114, 55, 44, 99, 218, 134, 77, 59, 220, 163, 231,
-			241, 162, 86, 120, 184, 29, 139, 85, 253, 242, 73, 165, 81, 88,
-			33, 163, 171, 120, 153, 89, 162, 29, 96, 181, 228, 52, 169, 161,
-			159, 38, 61, 77, 178, 142, 183, 159, 220, 255, 144, 113, 188, 253,
-			74, 163, 176, 69, 242, 135, 91, 9, 241, 244, 39, 182, 245, 58,
-			201, 224, 177, 34, 41, 31, 105, 110, 197, 232, 147, 210, 113, 189,
-			87, 69, 249, 194, 107, 132, 10, 79, 167, 178, 202, 62, 101, 104,
-			133, 73, 50, 248, 142, 83, 223, 19, 21, 158, 86, 240, 111, 26,
-			100, 44, 233, 79, 57, 2, 95, 124, 214, 244, 142, 118, 208, 82,
-			8, 154, 175, 244, 76, 237, 174, 237, 173, 119, 226, 116, 166, 56,
-			177, 184, 66, 129, 19, 122, 244, 61, 61, 35, 110, 206, 112, 26,
-			2, 89, 253, 120, 77, 70, 165, 17, 246, 28, 234, 52, 159, 255,
-			80, 103, 161, 65, 70, 202, 30, 102, 70, 237, 233, 231, 9, 19,
-			253, 162, 189, 4, 132, 170, 204, 232, 98, 83, 255, 2, 216, 76,
-			196, 104, 235, 121, 196, 232, 194, 142, 148, 193, 191, 64, 87, 69,
-			16, 247, 91, 177, 135, 241, 73, 61, 137, 66, 133, 21, 114, 2,
-			79, 22, 3, 113, 61, 165, 175, 243, 132, 104, 190, 210, 248, 70,
-			24, 233, 39, 253, 98, 82, 255, 127, 122, 73, 92, 148, 240, 195,
-			167, 95, 148, 240, 82, 236, 127, 41, 246, 255, 172, 179, 97, 220,
-			79, 142, 215, 190, 77, 246, 148, 2, 112, 222, 200, 111, 179, 99,
-			55, 26, 93, 174, 21, 41, 39, 69, 146, 74, 191, 41, 82, 38,
-			202, 45, 7, 8, 243, 61, 96, 71, 11, 172, 16, 112, 187, 113,
-			77, 62, 191, 38, 50, 13, 21, 122, 100, 111, 205, 83, 51, 97,
-			142, 107, 82, 236, 68, 143, 20, 59, 209, 63, 162, 73, 177, 19,
-			99, 103, 200, 31, 25, 66, 236, 184, 146, 154, 54, 242, 191, 99,
-			176, 227, 54, 25, 125, 192, 34, 245, 179, 202, 120, 62, 229, 7,
-			50, 33, 162, 205, 92, 25, 118, 57, 29, 31, 242, 34, 135, 115,
-			118, 139, 235, 69, 5, 147, 109, 48, 207, 247, 174, 245, 164, 79,
-			118, 187, 69, 188, 154, 33, 254, 60, 18, 169, 31, 62, 189, 61,
-			222, 213, 113, 34, 110, 22, 229, 97, 65, 19, 125, 174, 228, 38,
-			18, 209, 103, 178, 39, 100, 115, 178, 199, 79, 48, 217, 19, 178,
-			57, 169, 135, 108, 78, 197, 238, 5, 35, 3, 80, 159, 230, 39,
-			152, 202, 13, 106, 126, 130, 169, 97, 74, 254, 64, 94, 245, 57,
-			151, 186, 110, 228, 255, 225, 81, 252, 37, 155, 187, 142, 197, 61,
-			167, 190, 199, 252, 102, 147, 133, 32, 201, 218, 189, 121, 4, 237,
-			136, 1, 31, 60, 6, 99, 135, 176, 133, 250, 90, 211, 246, 66,
-			230, 119, 34, 145, 88, 187, 5, 154, 229, 113, 107, 168, 99, 78,
-			94, 204, 85, 208, 34, 67, 231, 114, 133, 36, 50, 116, 222, 188,
-			170, 69, 134, 206, 247, 68, 134, 206, 15, 188, 162, 69, 134, 206,
-			179, 203, 90, 100, 232, 252, 212, 52, 249, 83, 83, 4, 105, 190,
-			153, 122, 203, 200, 255, 19, 147, 29, 149, 74, 152, 235, 251, 123,
-			97, 124, 154, 16, 253, 67, 146, 207, 125, 236, 215, 68, 54, 123,
-			188, 82, 182, 33, 228, 94, 208, 254, 124, 230, 241, 199, 248, 69,
-			148, 159, 66, 61, 74, 127, 141, 118, 3, 63, 138, 92, 25, 68,
-			216, 241, 128, 219, 5, 254, 99, 167, 133, 151, 8, 34, 114, 129,
-			27, 16, 38, 114, 186, 97, 181, 120, 80, 135, 114, 165, 139, 203,
-			33, 159, 150, 190, 70, 113, 19, 73, 142, 135, 14, 170, 135, 248,
-			141, 168, 173, 75, 42, 59, 161, 156, 148, 19, 9, 21, 127, 90,
-			95, 29, 17, 223, 22, 175, 14, 30, 224, 148, 57, 108, 48, 12,
-			244, 171, 102, 28, 185, 153, 1, 72, 191, 250, 244, 171, 146, 172,
-			69, 84, 231, 87, 233, 8, 249, 27, 150, 184, 250, 244, 237, 212,
-			170, 145, 255, 95, 76, 214, 43, 248, 41, 211, 132, 162, 203, 67,
-			8, 61, 124, 254, 94, 12, 13, 6, 251, 144, 75, 173, 245, 112,
-			13, 73, 171, 199, 172, 123, 17, 184, 130, 56, 102, 165, 86, 8,
-			149, 244, 22, 175, 239, 218, 158, 19, 182, 24, 230, 152, 111, 241,
-			86, 29, 183, 94, 192, 59, 40, 138, 242, 250, 246, 162, 140, 226,
-			150, 103, 49, 53, 235, 132, 200, 7, 212, 147, 138, 61, 44, 177,
-			13, 95, 165, 232, 239, 121, 129, 51, 86, 131, 236, 69, 134, 234,
-			1, 143, 226, 119, 2, 79, 24, 145, 159, 60, 33, 54, 37, 25,
-			149, 154, 203, 211, 150, 17, 116, 190, 183, 115, 99, 242, 2, 208,
-			20, 181, 222, 145, 203, 40, 46, 101, 125, 167, 231, 82, 214, 119,
-			228, 50, 138, 75, 89, 223, 161, 35, 228, 151, 13, 113, 229, 231,
-			102, 234, 235, 6, 232, 211, 199, 75, 229, 199, 220, 222, 220, 147,
-			50, 65, 228, 47, 146, 52, 28, 62, 7, 131, 209, 230, 131, 226,
-			11, 71, 28, 22, 180, 219, 47, 55, 101, 198, 33, 188, 253, 114,
-			75, 206, 9, 83, 201, 90, 91, 114, 78, 226, 246, 203, 45, 57,
-			39, 113, 251, 229, 150, 228, 184, 120, 251, 229, 251, 146, 227, 138,
-			251, 46, 223, 151, 28, 87, 220, 119, 249, 190, 228, 184, 226, 190,
-			203, 247, 165, 67, 55, 99, 154, 212, 122, 104, 22, 228, 43, 51,
-			11, 144, 234, 27, 154, 124, 120, 250, 188, 130, 44, 106, 61, 100,
-			23, 201, 31, 27, 152, 123, 54, 109, 167, 26, 70, 254, 119, 13,
-			118, 84, 165, 144, 167, 7, 240, 198, 95, 140, 86, 143, 207, 221,
-			135, 199, 29, 142, 254, 226, 252, 249, 24, 53, 163, 72, 48, 185,
-			102, 155, 7, 200, 4, 43, 43, 135, 72, 73, 40, 15, 18, 239,
-			89, 131, 90, 182, 100, 9, 89, 192, 123, 77, 158, 50, 200, 34,
-			195, 174, 73, 134, 157, 197, 85, 168, 201, 232, 104, 204, 128, 107,
-			213, 232, 89, 5, 89, 212, 170, 201, 8, 143, 44, 160, 172, 110,
-			78, 203, 87, 70, 26, 32, 213, 136, 145, 165, 86, 125, 224, 156,
-			130, 160, 164, 76, 109, 133, 153, 101, 173, 250, 228, 20, 249, 239,
-			13, 204, 44, 155, 110, 165, 218, 70, 254, 159, 27, 199, 205, 144,
-			217, 141, 70, 40, 83, 15, 38, 135, 135, 125, 145, 149, 97, 50,
-			60, 202, 119, 67, 254, 76, 134, 207, 166, 208, 50, 121, 116, 41,
-			167, 197, 149, 190, 189, 165, 137, 188, 217, 21, 191, 16, 60, 90,
-			44, 12, 168, 234, 180, 38, 182, 101, 227, 125, 231, 135, 191, 228,
-			30, 244, 247, 25, 212, 106, 229, 196, 101, 241, 125, 128, 126, 79,
-			146, 94, 31, 34, 220, 147, 100, 143, 105, 112, 45, 79, 146, 61,
-			102, 193, 181, 60, 73, 246, 125, 128, 112, 95, 34, 188, 15, 17,
-			238, 75, 132, 247, 33, 194, 125, 137, 112, 76, 136, 107, 249, 18,
-			225, 152, 15, 215, 242, 39, 167, 200, 223, 21, 137, 105, 211, 143,
-			83, 223, 54, 242, 191, 105, 176, 163, 58, 165, 192, 183, 70, 183,
-			13, 238, 218, 221, 94, 63, 154, 178, 225, 62, 147, 142, 171, 210,
-			61, 138, 154, 73, 220, 98, 172, 84, 38, 13, 197, 56, 19, 154,
-			167, 196, 88, 206, 160, 214, 99, 73, 176, 57, 192, 88, 87, 98,
-			12, 147, 213, 198, 89, 234, 49, 87, 173, 213, 149, 24, 195, 84,
-			181, 86, 87, 98, 44, 7, 24, 251, 84, 50, 138, 28, 50, 138,
-			79, 37, 163, 200, 33, 142, 62, 149, 140, 34, 135, 56, 250, 84,
-			50, 138, 28, 48, 138, 207, 204, 75, 242, 149, 153, 6, 136, 40,
-			40, 75, 173, 207, 6, 206, 40, 200, 160, 214, 103, 227, 23, 20,
-			100, 81, 235, 179, 139, 5, 242, 11, 166, 72, 147, 251, 93, 35,
-			245, 255, 51, 140, 252, 255, 108, 232, 179, 246, 3, 113, 154, 68,
-			92, 94, 172, 16, 44, 206, 220, 219, 141, 134, 118, 192, 121, 177,
-			209, 144, 190, 0, 223, 139, 2, 140, 192, 149, 87, 122, 39, 4,
-			46, 21, 158, 195, 52, 238, 7, 226, 142, 143, 21, 237, 2, 142,
-			228, 232, 127, 178, 154, 147, 33, 97, 15, 100, 144, 181, 16, 50,
-			66, 30, 45, 160, 171, 65, 10, 29, 120, 151, 132, 39, 227, 17,
-			133, 43, 52, 116, 92, 33, 216, 132, 123, 78, 187, 45, 44, 212,
-			71, 215, 80, 229, 248, 253, 174, 129, 183, 26, 200, 28, 191, 191,
-			100, 152, 163, 42, 139, 111, 6, 193, 156, 150, 227, 247, 151, 146,
-			180, 174, 41, 11, 64, 58, 34, 171, 26, 52, 253, 61, 195, 28,
-			209, 146, 246, 126, 175, 55, 105, 239, 247, 140, 220, 160, 150, 180,
-			247, 123, 198, 176, 202, 44, 108, 210, 244, 247, 13, 243, 172, 202,
-			164, 155, 69, 112, 80, 203, 179, 251, 125, 227, 212, 168, 150, 103,
-			247, 251, 198, 153, 113, 242, 127, 192, 183, 66, 104, 246, 215, 141,
-			212, 15, 13, 35, 255, 47, 13, 166, 27, 41, 208, 163, 31, 95,
-			249, 212, 147, 82, 65, 48, 38, 224, 7, 113, 137, 103, 127, 44,
-			137, 249, 31, 179, 194, 202, 59, 221, 133, 152, 43, 82, 33, 68,
-			234, 230, 106, 113, 211, 126, 24, 107, 175, 120, 45, 155, 176, 219,
-			131, 182, 27, 37, 3, 83, 237, 96, 182, 37, 228, 83, 237, 128,
-			239, 59, 126, 71, 12, 143, 176, 200, 169, 239, 245, 124, 128, 240,
-			56, 94, 59, 98, 208, 244, 175, 27, 57, 145, 10, 155, 192, 218,
-			253, 64, 173, 29, 193, 181, 251, 129, 90, 59, 130, 107, 247, 3,
-			181, 118, 4, 215, 238, 7, 176, 118, 95, 193, 170, 152, 18, 222,
-			60, 83, 184, 154, 40, 132, 123, 78, 155, 121, 62, 115, 125, 111,
-			71, 222, 238, 31, 240, 16, 249, 43, 14, 138, 200, 134, 12, 145,
-			92, 190, 79, 129, 216, 146, 76, 206, 76, 112, 157, 127, 195, 56,
-			61, 166, 108, 206, 255, 111, 0, 0, 0, 255, 255, 21, 194, 85,
-			226, 91, 193, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 11, 148, 28, 201,
+			117, 30, 8, 119, 62, 170, 186, 42, 26, 232, 46, 68, 227, 209,
+			40, 0, 51, 49, 133, 153, 233, 6, 166, 81, 0, 26, 243, 196,
+			240, 213, 64, 55, 128, 198, 0, 221, 96, 117, 99, 134, 51, 28,
+			177, 39, 187, 42, 171, 59, 7, 89, 153, 197, 204, 44, 52, 138,
+			54, 223, 162, 77, 75, 150, 45, 89, 34, 69, 89, 191, 69, 83,
+			166, 245, 248, 87, 94, 175, 233, 179, 182, 101, 105, 87, 203, 181,
+			229, 135, 252, 150, 215, 231, 232, 88, 103, 37, 174, 245, 224, 152,
+			171, 35, 243, 172, 117, 86, 214, 218, 43, 238, 249, 110, 68, 100,
+			102, 85, 55, 94, 67, 202, 71, 187, 103, 120, 120, 6, 29, 89,
+			241, 184, 113, 227, 198, 141, 27, 247, 222, 184, 151, 125, 203, 96,
+			143, 110, 134, 225, 166, 239, 158, 238, 70, 97, 18, 110, 244, 218,
+			167, 19, 175, 227, 198, 137, 211, 233, 214, 233, 19, 159, 144, 21,
+			234, 186, 66, 237, 69, 86, 94, 211, 117, 248, 20, 27, 141, 221,
+			102, 24, 180, 226, 41, 67, 24, 51, 86, 67, 23, 249, 126, 86,
+			8, 156, 32, 140, 167, 76, 97, 204, 20, 26, 178, 112, 225, 51,
+			6, 155, 108, 134, 157, 250, 80, 167, 23, 198, 211, 46, 111, 224,
+			211, 13, 227, 181, 57, 85, 101, 51, 244, 157, 96, 179, 30, 70,
+			155, 57, 24, 251, 93, 55, 62, 125, 43, 8, 183, 131, 12, 222,
+			238, 198, 127, 50, 140, 31, 55, 173, 203, 55, 46, 252, 255, 205,
+			71, 46, 203, 214, 55, 84, 147, 250, 43, 174, 239, 191, 132, 6,
+			107, 104, 187, 81, 164, 190, 206, 177, 255, 209, 96, 71, 134, 49,
+			224, 118, 186, 73, 255, 110, 179, 31, 101, 133, 69, 252, 126, 225,
+			163, 187, 79, 132, 209, 175, 122, 18, 245, 7, 156, 4, 13, 249,
+			182, 38, 240, 239, 10, 236, 232, 240, 4, 226, 36, 234, 53, 147,
+			187, 205, 224, 11, 6, 43, 174, 82, 13, 254, 34, 43, 182, 61,
+			215, 167, 197, 179, 102, 198, 230, 142, 15, 79, 167, 46, 43, 214,
+			47, 81, 173, 197, 32, 137, 250, 13, 213, 164, 250, 126, 54, 150,
+			251, 204, 43, 204, 186, 229, 246, 137, 10, 202, 13, 252, 201, 103,
+			89, 225, 182, 227, 247, 92, 162, 128, 177, 185, 131, 59, 58, 127,
+			25, 191, 54, 100, 165, 243, 230, 243, 70, 237, 43, 38, 43, 208,
+			71, 254, 34, 99, 65, 207, 247, 215, 233, 55, 234, 116, 124, 174,
+			186, 163, 131, 229, 158, 239, 83, 253, 43, 35, 141, 114, 160, 11,
+			252, 56, 219, 19, 244, 58, 27, 110, 164, 154, 99, 124, 227, 202,
+			72, 99, 76, 126, 77, 43, 197, 73, 228, 5, 155, 170, 146, 5,
+			192, 81, 73, 126, 149, 149, 30, 101, 108, 35, 12, 53, 24, 182,
+			48, 102, 74, 24, 10, 223, 100, 133, 119, 177, 61, 18, 219, 170,
+			74, 129, 166, 122, 104, 7, 164, 18, 143, 170, 251, 94, 51, 73,
+			103, 233, 123, 177, 110, 91, 164, 182, 59, 103, 121, 205, 139, 147,
+			116, 150, 190, 46, 92, 40, 50, 251, 150, 23, 180, 176, 31, 211,
+			26, 188, 206, 138, 212, 153, 94, 209, 187, 33, 93, 213, 58, 121,
+			132, 149, 83, 36, 242, 113, 198, 150, 111, 94, 187, 182, 254, 242,
+			252, 181, 155, 139, 149, 145, 11, 31, 223, 157, 196, 199, 228, 100,
+			52, 141, 159, 126, 64, 26, 151, 19, 127, 91, 68, 254, 213, 39,
+			217, 123, 54, 195, 122, 115, 43, 10, 59, 94, 175, 67, 251, 200,
+			239, 53, 189, 211, 113, 115, 203, 109, 245, 124, 55, 58, 237, 116,
+			243, 165, 219, 103, 79, 39, 145, 183, 185, 233, 70, 177, 218, 6,
+			229, 244, 199, 234, 61, 247, 75, 237, 95, 155, 108, 116, 77, 182,
+			229, 227, 204, 244, 90, 138, 164, 77, 175, 5, 158, 150, 120, 137,
+			47, 41, 186, 220, 144, 5, 80, 126, 47, 242, 167, 44, 73, 249,
+			189, 200, 231, 179, 204, 110, 70, 97, 48, 53, 163, 8, 63, 29,
+			187, 126, 49, 10, 3, 213, 251, 149, 145, 6, 213, 226, 167, 89,
+			97, 219, 221, 232, 121, 83, 39, 20, 241, 100, 213, 95, 113, 55,
+			110, 46, 101, 245, 101, 61, 116, 31, 132, 97, 119, 106, 110, 71,
+			247, 203, 97, 216, 205, 117, 143, 90, 252, 25, 54, 186, 233, 37,
+			158, 239, 198, 83, 231, 168, 193, 225, 92, 131, 203, 242, 151, 172,
+			141, 174, 203, 231, 217, 216, 70, 207, 243, 91, 27, 189, 230, 45,
+			55, 153, 122, 154, 154, 30, 203, 53, 189, 144, 253, 154, 53, 207,
+			183, 185, 80, 102, 163, 93, 167, 239, 135, 78, 171, 118, 138, 141,
+			229, 166, 206, 31, 97, 108, 211, 13, 220, 200, 73, 188, 48, 80,
+			39, 71, 238, 75, 109, 156, 237, 201, 79, 189, 246, 24, 27, 203,
+			77, 141, 115, 102, 183, 156, 196, 81, 43, 67, 127, 215, 190, 104,
+			176, 241, 193, 217, 160, 90, 228, 118, 67, 93, 13, 127, 99, 177,
+			34, 183, 173, 22, 208, 138, 220, 54, 175, 178, 82, 228, 222, 246,
+			98, 64, 34, 215, 48, 45, 243, 231, 24, 235, 70, 97, 215, 141,
+			18, 207, 141, 167, 236, 123, 110, 238, 70, 174, 42, 134, 78, 156,
+			205, 120, 170, 32, 44, 12, 141, 191, 107, 14, 227, 59, 113, 54,
+			52, 132, 241, 240, 67, 152, 217, 16, 23, 94, 124, 237, 133, 135,
+			220, 39, 47, 166, 133, 171, 255, 250, 81, 86, 228, 246, 248, 72,
+			96, 176, 191, 111, 51, 99, 15, 183, 198, 71, 248, 220, 207, 218,
+			226, 98, 216, 237, 71, 222, 230, 86, 34, 230, 206, 156, 125, 86,
+			172, 109, 185, 226, 218, 205, 139, 75, 98, 190, 151, 108, 133, 81,
+			92, 103, 76, 92, 243, 154, 110, 16, 187, 45, 209, 11, 90, 110,
+			36, 146, 45, 87, 204, 119, 157, 38, 106, 202, 95, 102, 197, 203,
+			110, 4, 28, 139, 185, 250, 25, 49, 131, 10, 53, 245, 83, 237,
+			196, 139, 76, 244, 195, 158, 232, 56, 125, 17, 132, 137, 232, 197,
+			174, 72, 182, 188, 88, 180, 61, 223, 21, 238, 157, 166, 219, 77,
+			132, 23, 136, 102, 216, 233, 250, 158, 19, 52, 93, 177, 237, 37,
+			91, 34, 201, 186, 175, 51, 241, 170, 234, 33, 220, 72, 28, 47,
+			16, 142, 104, 134, 221, 190, 8, 219, 249, 106, 194, 73, 24, 19,
+			244, 191, 173, 36, 233, 158, 63, 125, 122, 123, 123, 187, 238, 16,
+			164, 146, 171, 200, 122, 241, 233, 107, 75, 23, 23, 151, 87, 23,
+			79, 205, 213, 207, 48, 38, 110, 6, 190, 27, 199, 34, 114, 63,
+			220, 243, 34, 183, 37, 54, 250, 194, 233, 118, 125, 175, 233, 108,
+			248, 174, 240, 157, 109, 17, 70, 194, 217, 140, 92, 183, 37, 146,
+			16, 176, 110, 71, 94, 226, 5, 155, 179, 34, 14, 219, 201, 182,
+			19, 185, 76, 180, 60, 28, 45, 27, 189, 100, 0, 77, 26, 50,
+			47, 30, 168, 16, 6, 194, 9, 68, 109, 126, 85, 44, 173, 214,
+			196, 133, 249, 213, 165, 213, 89, 38, 94, 89, 90, 187, 178, 114,
+			115, 77, 188, 50, 223, 104, 204, 47, 175, 45, 45, 174, 138, 149,
+			134, 184, 184, 178, 188, 176, 180, 182, 180, 178, 188, 42, 86, 46,
+			137, 249, 229, 87, 197, 75, 75, 203, 11, 179, 194, 245, 146, 45,
+			55, 18, 238, 157, 110, 4, 232, 195, 72, 120, 64, 160, 219, 170,
+			51, 177, 234, 186, 3, 195, 183, 67, 185, 106, 113, 215, 109, 122,
+			109, 175, 41, 32, 176, 244, 156, 77, 87, 108, 134, 183, 221, 40,
+			240, 130, 77, 209, 117, 163, 142, 23, 99, 17, 99, 225, 4, 45,
+			38, 124, 175, 227, 37, 180, 99, 227, 157, 51, 170, 51, 86, 98,
+			134, 201, 173, 202, 8, 199, 95, 37, 110, 241, 145, 27, 172, 204,
+			204, 210, 88, 250, 167, 53, 194, 173, 253, 35, 79, 178, 31, 51,
+			152, 105, 143, 112, 251, 240, 200, 51, 70, 245, 7, 12, 161, 118,
+			135, 104, 58, 129, 216, 112, 133, 219, 241, 18, 160, 109, 163, 47,
+			20, 95, 7, 60, 137, 19, 223, 138, 197, 76, 220, 107, 110, 9,
+			39, 22, 106, 247, 203, 207, 39, 4, 77, 40, 10, 123, 155, 91,
+			76, 204, 223, 88, 2, 196, 162, 25, 6, 113, 175, 51, 208, 145,
+			219, 26, 238, 39, 183, 73, 85, 95, 117, 198, 24, 179, 236, 17,
+			131, 91, 135, 75, 19, 236, 223, 90, 204, 182, 71, 204, 17, 110,
+			61, 110, 86, 170, 255, 212, 18, 55, 3, 239, 195, 61, 87, 120,
+			45, 55, 72, 188, 182, 231, 70, 154, 236, 212, 24, 216, 33, 75,
+			137, 240, 98, 144, 54, 81, 72, 203, 109, 245, 136, 126, 18, 151,
+			0, 219, 114, 65, 213, 221, 40, 188, 237, 181, 168, 163, 78, 55,
+			76, 220, 160, 217, 167, 133, 113, 90, 45, 47, 216, 100, 194, 73,
+			59, 20, 139, 78, 115, 75, 188, 25, 110, 136, 45, 7, 139, 33,
+			188, 32, 113, 163, 192, 241, 197, 70, 175, 221, 118, 35, 185, 65,
+			150, 22, 98, 64, 18, 185, 77, 55, 72, 116, 219, 88, 120, 9,
+			163, 111, 222, 109, 183, 85, 215, 168, 142, 69, 178, 229, 36, 98,
+			203, 185, 237, 10, 199, 143, 92, 167, 213, 23, 27, 174, 27, 136,
+			24, 255, 113, 34, 87, 196, 158, 239, 6, 137, 223, 23, 241, 45,
+			175, 219, 165, 166, 91, 46, 211, 3, 122, 49, 232, 195, 11, 91,
+			94, 211, 241, 253, 190, 104, 250, 174, 19, 184, 45, 108, 0, 17,
+			250, 45, 130, 69, 173, 38, 77, 45, 241, 168, 90, 228, 2, 37,
+			179, 76, 36, 91, 88, 42, 226, 3, 241, 86, 216, 243, 91, 196,
+			10, 34, 215, 239, 139, 48, 32, 216, 128, 197, 235, 189, 56, 17,
+			27, 41, 166, 104, 33, 183, 183, 66, 247, 54, 232, 188, 227, 37,
+			241, 0, 218, 197, 69, 57, 160, 19, 244, 147, 45, 80, 140, 147,
+			8, 199, 247, 103, 133, 19, 51, 225, 135, 248, 0, 108, 208, 194,
+			208, 18, 214, 25, 219, 195, 10, 88, 219, 2, 22, 183, 164, 75,
+			6, 183, 30, 47, 143, 233, 146, 197, 173, 199, 199, 39, 216, 107,
+			68, 5, 6, 183, 166, 205, 201, 234, 117, 177, 210, 197, 70, 112,
+			124, 172, 113, 36, 218, 145, 231, 6, 45, 191, 47, 2, 167, 163,
+			119, 151, 23, 107, 200, 104, 62, 34, 222, 10, 183, 99, 209, 235,
+			130, 95, 172, 106, 54, 44, 110, 46, 165, 80, 24, 5, 116, 174,
+			161, 48, 48, 84, 121, 92, 151, 44, 110, 77, 239, 227, 236, 221,
+			4, 133, 201, 173, 147, 230, 190, 234, 153, 12, 138, 43, 107, 107,
+			55, 132, 239, 5, 183, 136, 220, 188, 184, 235, 59, 253, 187, 14,
+			100, 22, 208, 94, 15, 100, 26, 220, 58, 89, 222, 163, 75, 22,
+			183, 78, 78, 84, 216, 119, 27, 24, 169, 52, 194, 237, 186, 249,
+			180, 85, 189, 45, 230, 155, 73, 207, 241, 211, 41, 225, 20, 174,
+			139, 37, 44, 65, 191, 235, 138, 150, 219, 246, 2, 55, 22, 91,
+			225, 118, 126, 77, 196, 182, 231, 251, 106, 5, 155, 110, 28, 187,
+			45, 134, 53, 68, 141, 20, 178, 89, 208, 156, 104, 134, 81, 228,
+			198, 221, 48, 0, 237, 11, 18, 206, 98, 13, 112, 9, 91, 177,
+			94, 154, 96, 99, 128, 201, 180, 184, 117, 218, 174, 42, 120, 173,
+			34, 74, 19, 186, 100, 112, 235, 116, 101, 191, 46, 161, 230, 161,
+			195, 170, 153, 205, 173, 51, 246, 81, 245, 147, 93, 68, 169, 162,
+			75, 6, 183, 206, 236, 59, 168, 75, 22, 183, 206, 28, 62, 162,
+			154, 21, 184, 117, 54, 29, 173, 80, 68, 73, 143, 86, 48, 184,
+			117, 54, 29, 173, 96, 113, 235, 108, 58, 90, 145, 91, 115, 182,
+			80, 63, 21, 169, 196, 117, 201, 224, 214, 220, 164, 238, 178, 104,
+			113, 107, 238, 216, 163, 170, 217, 40, 183, 206, 217, 51, 234, 167,
+			209, 34, 74, 26, 172, 81, 131, 91, 231, 14, 213, 116, 201, 226,
+			214, 185, 39, 166, 217, 207, 154, 12, 224, 219, 239, 29, 153, 55,
+			170, 63, 99, 138, 156, 220, 149, 114, 32, 205, 45, 252, 62, 144,
+			159, 145, 4, 206, 174, 78, 215, 119, 59, 224, 23, 122, 59, 131,
+			205, 196, 25, 11, 203, 243, 226, 45, 87, 64, 132, 21, 113, 226,
+			36, 174, 232, 56, 205, 45, 90, 243, 237, 45, 55, 160, 45, 233,
+			37, 162, 229, 54, 189, 150, 43, 247, 101, 42, 107, 48, 189, 199,
+			125, 167, 23, 52, 229, 73, 238, 5, 183, 195, 38, 29, 38, 24,
+			107, 57, 76, 220, 243, 130, 88, 178, 162, 28, 98, 31, 211, 180,
+			251, 117, 63, 45, 205, 225, 133, 123, 71, 177, 191, 108, 46, 243,
+			55, 150, 136, 67, 245, 25, 9, 22, 78, 183, 235, 58, 17, 118,
+			0, 6, 195, 129, 32, 169, 43, 38, 216, 208, 137, 226, 242, 216,
+			102, 239, 45, 77, 178, 58, 179, 109, 3, 76, 254, 125, 230, 161,
+			218, 99, 162, 19, 6, 97, 18, 6, 138, 187, 121, 65, 51, 114,
+			157, 24, 148, 41, 47, 149, 146, 48, 13, 98, 28, 239, 51, 71,
+			117, 201, 224, 214, 251, 74, 92, 151, 44, 110, 189, 239, 192, 65,
+			246, 235, 56, 236, 76, 110, 95, 25, 89, 50, 170, 191, 108, 136,
+			188, 168, 155, 199, 112, 138, 70, 112, 148, 88, 52, 125, 175, 121,
+			75, 212, 84, 197, 154, 216, 232, 37, 73, 24, 96, 70, 55, 151,
+			190, 99, 40, 19, 51, 73, 200, 132, 115, 59, 244, 232, 180, 108,
+			247, 112, 220, 159, 144, 136, 124, 56, 60, 130, 139, 92, 41, 237,
+			103, 223, 197, 176, 127, 236, 229, 145, 27, 70, 245, 253, 34, 39,
+			198, 167, 212, 56, 64, 130, 32, 205, 77, 121, 49, 16, 137, 27,
+			131, 157, 132, 34, 114, 33, 194, 128, 40, 241, 137, 165, 243, 83,
+			67, 89, 6, 183, 150, 75, 147, 180, 99, 44, 44, 217, 138, 41,
+			113, 110, 209, 122, 172, 40, 206, 102, 209, 122, 172, 148, 247, 234,
+			146, 197, 173, 149, 202, 62, 246, 15, 176, 30, 54, 183, 63, 48,
+			242, 134, 81, 253, 89, 67, 11, 18, 187, 172, 200, 70, 95, 196,
+			97, 47, 106, 186, 234, 180, 220, 118, 146, 230, 86, 42, 120, 12,
+			73, 24, 108, 167, 36, 129, 101, 90, 29, 88, 32, 28, 172, 59,
+			214, 27, 20, 138, 219, 74, 236, 37, 97, 212, 87, 187, 171, 185,
+			229, 4, 155, 46, 137, 113, 183, 61, 135, 137, 197, 142, 167, 47,
+			17, 49, 214, 65, 225, 194, 54, 184, 245, 129, 210, 65, 54, 203,
+			108, 219, 6, 46, 94, 53, 121, 237, 81, 136, 8, 55, 27, 215,
+			32, 13, 56, 192, 102, 40, 225, 151, 125, 182, 36, 241, 218, 132,
+			172, 87, 21, 178, 108, 66, 214, 171, 10, 89, 54, 33, 235, 213,
+			202, 62, 182, 64, 253, 26, 220, 122, 205, 220, 87, 123, 142, 122,
+			107, 15, 116, 54, 11, 138, 108, 247, 112, 204, 186, 245, 205, 186,
+			168, 69, 110, 59, 62, 189, 229, 58, 173, 248, 116, 199, 137, 19,
+			55, 170, 233, 241, 112, 190, 189, 150, 142, 135, 141, 247, 154, 58,
+			118, 108, 58, 223, 94, 155, 168, 208, 249, 102, 155, 38, 183, 62,
+			104, 30, 172, 157, 161, 241, 228, 101, 77, 204, 172, 94, 153, 63,
+			139, 193, 182, 220, 59, 39, 68, 55, 4, 229, 144, 112, 165, 56,
+			83, 228, 182, 245, 64, 56, 223, 62, 152, 14, 4, 202, 252, 96,
+			121, 159, 46, 89, 220, 250, 224, 254, 3, 236, 3, 52, 144, 197,
+			173, 15, 153, 51, 213, 151, 196, 141, 244, 166, 69, 2, 26, 174,
+			87, 232, 219, 105, 181, 48, 36, 36, 50, 73, 162, 161, 8, 193,
+			242, 212, 229, 213, 109, 137, 118, 20, 118, 104, 252, 102, 216, 233,
+			120, 137, 58, 178, 108, 58, 151, 62, 100, 78, 233, 146, 193, 173,
+			15, 29, 62, 174, 75, 24, 246, 201, 105, 34, 96, 219, 180, 185,
+			181, 110, 30, 81, 63, 217, 84, 210, 157, 216, 5, 110, 173, 143,
+			105, 208, 177, 214, 235, 252, 160, 46, 89, 220, 90, 63, 92, 101,
+			159, 182, 152, 105, 23, 184, 125, 107, 36, 48, 170, 255, 167, 153,
+			167, 194, 7, 32, 105, 220, 113, 220, 56, 17, 142, 154, 18, 36,
+			74, 207, 111, 1, 9, 108, 64, 126, 222, 149, 184, 215, 114, 39,
+			125, 51, 12, 112, 19, 139, 133, 23, 180, 195, 168, 163, 182, 116,
+			40, 186, 78, 76, 155, 26, 56, 10, 220, 237, 129, 93, 66, 99,
+			101, 204, 108, 27, 68, 74, 223, 100, 139, 12, 124, 117, 79, 113,
+			91, 34, 118, 187, 14, 48, 239, 247, 211, 27, 143, 98, 78, 111,
+			134, 27, 4, 114, 219, 219, 236, 41, 142, 50, 131, 61, 134, 21,
+			195, 161, 166, 251, 131, 0, 29, 162, 225, 9, 181, 185, 114, 172,
+			141, 144, 193, 4, 134, 232, 184, 9, 234, 202, 46, 84, 83, 47,
+			216, 76, 25, 106, 183, 183, 225, 123, 77, 180, 59, 161, 246, 34,
+			196, 129, 91, 165, 42, 45, 107, 1, 123, 209, 55, 229, 73, 94,
+			48, 71, 138, 40, 77, 233, 146, 193, 45, 95, 81, 67, 129, 182,
+			154, 175, 168, 161, 0, 98, 237, 40, 106, 40, 152, 134, 141, 18,
+			211, 165, 2, 183, 58, 138, 26, 10, 180, 127, 58, 138, 26, 10,
+			180, 127, 58, 135, 171, 169, 14, 237, 191, 92, 97, 239, 125, 88,
+			29, 90, 90, 216, 169, 68, 187, 151, 214, 188, 250, 109, 42, 235,
+			106, 175, 179, 177, 171, 225, 70, 220, 144, 164, 8, 35, 67, 55,
+			10, 223, 116, 155, 137, 82, 229, 232, 34, 63, 200, 138, 205, 94,
+			20, 135, 145, 82, 232, 168, 18, 63, 194, 202, 93, 103, 211, 93,
+			143, 189, 143, 184, 164, 152, 43, 52, 74, 248, 176, 234, 125, 196,
+			173, 221, 96, 101, 217, 123, 215, 239, 243, 26, 179, 33, 222, 40,
+			117, 233, 120, 78, 191, 117, 53, 220, 104, 208, 111, 252, 81, 54,
+			22, 184, 119, 146, 245, 129, 161, 24, 62, 93, 164, 47, 181, 30,
+			227, 75, 169, 244, 146, 130, 125, 146, 141, 190, 25, 110, 172, 67,
+			221, 4, 176, 199, 230, 246, 13, 245, 238, 182, 27, 197, 55, 233,
+			223, 183, 55, 17, 159, 85, 6, 134, 197, 124, 158, 99, 99, 153,
+			32, 165, 167, 117, 32, 55, 112, 214, 162, 145, 175, 121, 255, 73,
+			254, 43, 131, 77, 230, 207, 28, 61, 205, 247, 176, 209, 13, 28,
+			131, 174, 30, 237, 241, 220, 104, 187, 52, 168, 95, 64, 237, 134,
+			110, 196, 143, 178, 114, 106, 195, 161, 217, 91, 141, 236, 67, 245,
+			117, 86, 160, 250, 124, 150, 141, 42, 42, 81, 216, 228, 185, 97,
+			212, 16, 13, 93, 133, 63, 161, 150, 213, 20, 214, 238, 136, 167,
+			159, 107, 79, 179, 162, 44, 223, 131, 198, 42, 204, 122, 51, 220,
+			80, 8, 193, 159, 181, 55, 216, 222, 28, 22, 221, 246, 67, 173,
+			244, 113, 182, 55, 67, 251, 186, 215, 82, 83, 222, 147, 125, 92,
+			106, 213, 190, 223, 96, 214, 213, 112, 227, 161, 58, 174, 178, 146,
+			254, 77, 1, 155, 150, 249, 9, 86, 32, 33, 130, 246, 194, 216,
+			220, 228, 96, 47, 171, 248, 169, 33, 107, 128, 18, 187, 14, 196,
+			50, 82, 119, 150, 26, 170, 84, 155, 102, 37, 93, 21, 84, 217,
+			243, 214, 81, 191, 23, 43, 116, 149, 122, 30, 186, 233, 197, 181,
+			31, 55, 25, 203, 208, 195, 223, 203, 198, 115, 243, 205, 102, 50,
+			149, 131, 97, 0, 155, 141, 28, 126, 128, 176, 99, 140, 197, 137,
+			19, 37, 110, 107, 61, 137, 53, 129, 168, 47, 107, 68, 183, 109,
+			47, 240, 226, 45, 249, 187, 69, 191, 51, 253, 105, 45, 230, 143,
+			177, 61, 138, 42, 220, 214, 250, 70, 159, 166, 85, 110, 140, 165,
+			223, 46, 244, 49, 103, 53, 153, 2, 77, 70, 149, 160, 239, 111,
+			123, 129, 227, 147, 105, 166, 212, 144, 5, 62, 205, 38, 228, 161,
+			178, 174, 69, 145, 169, 81, 106, 54, 46, 63, 55, 180, 246, 248,
+			48, 43, 221, 246, 220, 237, 117, 88, 7, 74, 84, 99, 20, 229,
+			155, 145, 63, 247, 167, 109, 86, 78, 101, 94, 254, 28, 27, 189,
+			236, 38, 96, 74, 60, 175, 205, 207, 241, 192, 234, 254, 29, 223,
+			177, 219, 175, 177, 241, 203, 110, 146, 161, 47, 230, 199, 118, 69,
+			107, 218, 205, 145, 187, 253, 140, 222, 222, 199, 246, 14, 244, 198,
+			239, 186, 70, 213, 221, 57, 10, 127, 134, 149, 110, 128, 92, 64,
+			187, 59, 73, 181, 186, 211, 26, 69, 214, 82, 254, 44, 43, 55,
+			92, 8, 22, 15, 217, 238, 25, 86, 154, 223, 8, 163, 228, 33,
+			155, 93, 100, 19, 212, 236, 129, 102, 122, 183, 78, 46, 177, 61,
+			121, 230, 198, 31, 201, 245, 144, 255, 65, 99, 254, 46, 253, 124,
+			123, 42, 251, 47, 190, 192, 70, 121, 97, 124, 228, 159, 24, 239,
+			232, 236, 223, 209, 217, 255, 87, 213, 217, 63, 129, 175, 184, 120,
+			31, 24, 185, 194, 22, 152, 89, 36, 237, 189, 99, 84, 159, 207,
+			93, 230, 221, 59, 221, 16, 10, 150, 76, 52, 214, 139, 155, 213,
+			137, 221, 232, 182, 215, 116, 165, 200, 92, 196, 165, 243, 112, 105,
+			31, 251, 132, 201, 236, 34, 233, 216, 133, 121, 186, 250, 127, 24,
+			66, 49, 72, 209, 118, 73, 214, 128, 30, 87, 224, 12, 23, 177,
+			147, 120, 113, 187, 143, 201, 230, 24, 38, 238, 43, 2, 124, 120,
+			195, 243, 189, 164, 47, 230, 47, 94, 35, 162, 95, 106, 231, 107,
+			213, 213, 33, 63, 120, 163, 216, 232, 37, 132, 20, 253, 99, 43,
+			116, 99, 168, 81, 220, 59, 94, 156, 204, 10, 114, 214, 128, 241,
+			35, 78, 48, 27, 116, 135, 246, 145, 155, 244, 162, 0, 198, 21,
+			38, 230, 1, 26, 62, 18, 4, 190, 43, 60, 57, 107, 40, 143,
+			220, 136, 116, 246, 181, 116, 23, 215, 49, 141, 250, 166, 155, 212,
+			114, 171, 37, 175, 141, 192, 128, 193, 45, 81, 220, 171, 75, 38,
+			183, 196, 248, 97, 93, 178, 184, 37, 30, 63, 197, 62, 97, 16,
+			178, 12, 110, 61, 97, 46, 84, 99, 49, 120, 38, 164, 40, 203,
+			206, 85, 50, 11, 56, 98, 211, 187, 237, 6, 0, 117, 86, 116,
+			194, 56, 209, 134, 130, 182, 23, 197, 184, 183, 10, 224, 210, 139,
+			220, 7, 7, 22, 87, 144, 39, 138, 251, 117, 201, 228, 214, 19,
+			7, 166, 117, 201, 226, 214, 19, 115, 23, 152, 75, 176, 146, 194,
+			250, 249, 234, 7, 6, 97, 205, 86, 87, 64, 183, 230, 15, 107,
+			5, 31, 26, 32, 220, 158, 78, 22, 39, 117, 9, 163, 238, 127,
+			76, 151, 160, 216, 158, 125, 150, 253, 152, 36, 53, 139, 91, 79,
+			155, 207, 85, 191, 223, 20, 250, 8, 147, 186, 234, 110, 228, 222,
+			6, 86, 156, 94, 18, 226, 18, 219, 204, 223, 253, 8, 141, 111,
+			134, 27, 192, 214, 117, 39, 200, 233, 195, 241, 235, 12, 41, 64,
+			110, 123, 206, 160, 178, 166, 113, 227, 226, 9, 16, 71, 156, 160,
+			127, 199, 247, 195, 109, 183, 85, 23, 243, 65, 95, 116, 93, 82,
+			119, 51, 108, 252, 168, 23, 144, 217, 45, 191, 108, 80, 23, 201,
+			102, 238, 29, 183, 9, 182, 132, 145, 83, 128, 65, 169, 176, 155,
+			108, 81, 187, 182, 166, 66, 109, 207, 145, 162, 220, 61, 17, 73,
+			85, 118, 69, 37, 246, 250, 211, 197, 113, 93, 50, 185, 245, 244,
+			196, 1, 93, 2, 242, 196, 51, 236, 123, 36, 33, 218, 220, 122,
+			209, 124, 190, 250, 81, 145, 158, 234, 80, 18, 246, 58, 224, 4,
+			232, 191, 165, 81, 150, 253, 126, 23, 200, 113, 180, 60, 0, 212,
+			178, 247, 93, 193, 134, 142, 228, 197, 226, 132, 46, 153, 220, 122,
+			177, 114, 80, 151, 44, 110, 189, 248, 216, 179, 236, 55, 36, 5,
+			20, 184, 181, 104, 62, 87, 253, 55, 166, 208, 82, 5, 192, 118,
+			149, 81, 9, 16, 37, 97, 78, 145, 10, 33, 209, 157, 21, 14,
+			234, 2, 223, 142, 104, 246, 162, 72, 154, 200, 212, 58, 138, 48,
+			98, 187, 172, 35, 38, 232, 4, 125, 173, 231, 152, 149, 250, 51,
+			50, 121, 131, 44, 188, 142, 231, 59, 164, 140, 31, 18, 83, 244,
+			185, 74, 213, 135, 126, 99, 250, 164, 203, 239, 115, 177, 180, 64,
+			140, 48, 229, 96, 65, 220, 139, 92, 61, 92, 126, 119, 201, 105,
+			192, 52, 16, 51, 225, 144, 101, 199, 239, 11, 223, 129, 190, 85,
+			147, 180, 219, 74, 143, 156, 116, 253, 238, 178, 32, 212, 219, 174,
+			235, 81, 48, 184, 181, 152, 146, 81, 193, 228, 214, 98, 74, 70,
+			48, 152, 44, 138, 103, 216, 103, 228, 122, 20, 161, 200, 93, 172,
+			254, 190, 177, 3, 15, 212, 125, 156, 231, 96, 185, 169, 40, 54,
+			239, 4, 249, 233, 145, 100, 18, 56, 190, 247, 17, 104, 40, 135,
+			251, 203, 83, 159, 106, 14, 225, 102, 219, 129, 198, 57, 148, 184,
+			17, 78, 118, 208, 110, 245, 6, 214, 115, 54, 19, 127, 180, 250,
+			204, 11, 226, 196, 117, 90, 12, 140, 86, 83, 211, 219, 196, 88,
+			17, 74, 235, 162, 198, 81, 209, 228, 214, 202, 193, 227, 186, 4,
+			21, 118, 253, 34, 251, 141, 2, 97, 108, 148, 91, 111, 152, 87,
+			170, 255, 166, 48, 200, 112, 186, 189, 36, 38, 165, 87, 24, 137,
+			78, 24, 165, 42, 58, 144, 74, 18, 166, 196, 170, 190, 138, 15,
+			247, 220, 30, 20, 205, 116, 102, 177, 220, 193, 168, 13, 65, 107,
+			152, 41, 113, 69, 73, 38, 77, 236, 208, 24, 203, 64, 106, 58,
+			186, 156, 41, 6, 229, 5, 155, 100, 60, 72, 247, 77, 68, 86,
+			132, 142, 27, 109, 186, 76, 116, 122, 126, 226, 117, 253, 97, 128,
+			180, 126, 78, 117, 17, 6, 179, 98, 195, 1, 219, 8, 131, 212,
+			158, 136, 223, 189, 56, 211, 239, 73, 14, 129, 117, 79, 132, 239,
+			58, 113, 146, 246, 50, 112, 124, 139, 48, 26, 62, 137, 131, 48,
+			135, 117, 1, 243, 135, 70, 132, 151, 96, 107, 186, 2, 102, 253,
+			200, 205, 214, 54, 214, 187, 165, 46, 86, 32, 174, 109, 123, 16,
+			150, 147, 173, 129, 42, 203, 43, 107, 232, 201, 9, 98, 167, 9,
+			18, 116, 252, 243, 216, 247, 94, 34, 218, 142, 231, 199, 162, 227,
+			181, 182, 157, 190, 58, 35, 54, 250, 74, 126, 0, 211, 208, 214,
+			57, 18, 141, 32, 69, 69, 81, 24, 157, 152, 101, 34, 14, 59,
+			57, 84, 1, 143, 100, 174, 151, 102, 250, 222, 134, 210, 232, 98,
+			195, 83, 77, 37, 155, 215, 149, 225, 46, 118, 218, 46, 150, 39,
+			114, 147, 168, 207, 82, 44, 204, 138, 184, 215, 237, 250, 36, 64,
+			225, 91, 236, 100, 131, 192, 106, 159, 243, 16, 72, 37, 120, 170,
+			3, 139, 62, 78, 72, 6, 147, 92, 206, 161, 161, 85, 39, 239,
+			18, 213, 74, 116, 220, 56, 134, 76, 218, 214, 196, 215, 114, 19,
+			32, 224, 158, 187, 65, 141, 191, 235, 126, 128, 217, 243, 141, 34,
+			215, 37, 147, 91, 111, 76, 62, 169, 75, 22, 183, 222, 56, 123,
+			137, 49, 233, 78, 210, 28, 241, 140, 212, 117, 163, 89, 154, 100,
+			151, 181, 231, 134, 107, 30, 168, 158, 23, 75, 109, 236, 250, 28,
+			133, 135, 145, 168, 213, 102, 73, 184, 84, 210, 95, 60, 13, 34,
+			146, 39, 111, 78, 196, 83, 174, 0, 5, 244, 164, 237, 230, 144,
+			93, 221, 178, 54, 33, 67, 139, 235, 78, 238, 87, 102, 92, 131,
+			91, 109, 83, 27, 134, 97, 247, 104, 167, 205, 32, 52, 181, 203,
+			218, 132, 12, 189, 109, 155, 79, 178, 69, 109, 215, 223, 50, 15,
+			86, 159, 23, 169, 22, 16, 43, 153, 157, 51, 128, 62, 181, 216,
+			170, 181, 199, 41, 178, 25, 132, 81, 14, 78, 216, 63, 182, 148,
+			85, 82, 10, 69, 91, 165, 125, 186, 100, 113, 107, 107, 255, 1,
+			66, 153, 33, 173, 6, 169, 29, 244, 86, 105, 31, 193, 79, 118,
+			80, 173, 174, 54, 204, 17, 27, 202, 235, 212, 228, 9, 85, 246,
+			216, 184, 46, 65, 149, 61, 49, 169, 75, 80, 101, 31, 60, 164,
+			58, 129, 130, 218, 60, 172, 126, 2, 18, 58, 10, 9, 134, 84,
+			94, 151, 247, 235, 18, 148, 215, 135, 166, 8, 38, 147, 219, 221,
+			145, 158, 145, 218, 20, 187, 74, 161, 110, 2, 166, 15, 155, 196,
+			23, 109, 147, 20, 234, 31, 86, 221, 153, 36, 53, 127, 88, 45,
+			133, 73, 114, 242, 135, 213, 82, 208, 236, 35, 181, 20, 38, 65,
+			17, 165, 205, 0, 69, 164, 150, 194, 36, 137, 53, 226, 147, 236,
+			5, 106, 102, 114, 43, 49, 15, 86, 103, 115, 75, 209, 114, 219,
+			78, 207, 135, 124, 16, 138, 103, 206, 136, 237, 45, 175, 185, 133,
+			245, 233, 56, 119, 188, 78, 175, 163, 208, 111, 18, 250, 19, 133,
+			126, 147, 0, 72, 20, 250, 77, 66, 127, 162, 208, 111, 113, 123,
+			123, 228, 35, 70, 106, 211, 220, 46, 77, 101, 54, 205, 59, 230,
+			147, 218, 166, 105, 163, 196, 116, 169, 200, 173, 59, 99, 7, 116,
+			201, 224, 214, 157, 131, 143, 233, 146, 197, 173, 59, 143, 63, 161,
+			58, 49, 184, 213, 87, 232, 183, 104, 226, 125, 53, 113, 139, 208,
+			223, 87, 232, 183, 104, 226, 253, 67, 24, 27, 118, 209, 194, 159,
+			28, 249, 1, 195, 72, 141, 139, 127, 178, 116, 132, 237, 133, 157,
+			202, 26, 225, 133, 143, 154, 159, 48, 44, 106, 101, 91, 192, 249,
+			71, 217, 4, 219, 203, 138, 248, 17, 48, 127, 204, 158, 98, 227,
+			108, 84, 22, 139, 40, 143, 101, 101, 131, 91, 31, 219, 51, 153,
+			149, 45, 110, 125, 236, 224, 161, 180, 185, 193, 173, 143, 219, 199,
+			210, 159, 97, 249, 248, 184, 189, 39, 43, 23, 185, 245, 241, 189,
+			89, 115, 204, 224, 227, 251, 179, 225, 48, 135, 143, 31, 57, 74,
+			34, 41, 25, 66, 237, 207, 24, 230, 177, 234, 159, 20, 243, 41,
+			115, 3, 163, 164, 189, 237, 37, 218, 31, 129, 56, 153, 239, 221,
+			38, 97, 39, 9, 193, 163, 86, 34, 104, 71, 176, 169, 58, 221,
+			48, 74, 156, 32, 25, 246, 153, 2, 107, 192, 109, 208, 109, 9,
+			215, 137, 124, 248, 127, 225, 19, 140, 101, 94, 139, 122, 10, 253,
+			150, 27, 213, 25, 219, 11, 68, 97, 251, 0, 152, 180, 88, 228,
+			246, 103, 140, 177, 138, 46, 26, 40, 238, 155, 210, 69, 11, 197,
+			35, 71, 217, 255, 109, 41, 203, 171, 253, 253, 134, 121, 176, 250,
+			187, 150, 152, 15, 68, 168, 221, 125, 82, 141, 57, 104, 17, 210,
+			136, 147, 57, 27, 193, 85, 1, 213, 168, 18, 156, 159, 68, 47,
+			240, 238, 228, 154, 120, 1, 19, 29, 175, 25, 133, 234, 241, 70,
+			93, 204, 199, 177, 183, 25, 100, 94, 30, 234, 36, 218, 232, 107,
+			154, 175, 131, 119, 210, 253, 113, 86, 116, 148, 83, 22, 142, 7,
+			116, 245, 212, 169, 179, 207, 136, 142, 23, 232, 187, 190, 98, 87,
+			52, 32, 48, 122, 147, 212, 14, 116, 68, 97, 56, 15, 176, 165,
+			208, 74, 78, 27, 2, 233, 106, 248, 20, 204, 176, 45, 164, 237,
+			109, 203, 237, 163, 22, 147, 51, 195, 49, 124, 161, 15, 158, 29,
+			110, 235, 67, 172, 233, 123, 52, 98, 168, 120, 123, 95, 185, 95,
+			233, 190, 102, 197, 182, 43, 58, 206, 45, 119, 192, 142, 46, 26,
+			55, 46, 102, 206, 119, 9, 157, 215, 249, 159, 113, 92, 14, 156,
+			222, 179, 233, 41, 234, 70, 12, 254, 41, 56, 197, 163, 62, 124,
+			202, 164, 135, 26, 224, 113, 239, 56, 205, 68, 29, 171, 233, 92,
+			146, 80, 108, 194, 43, 96, 75, 57, 58, 53, 113, 237, 198, 66,
+			210, 204, 153, 70, 157, 70, 74, 74, 59, 70, 129, 214, 127, 84,
+			23, 137, 28, 74, 251, 116, 209, 66, 113, 255, 1, 118, 150, 76,
+			192, 197, 31, 50, 70, 126, 216, 48, 170, 199, 161, 243, 104, 184,
+			109, 229, 229, 230, 247, 51, 87, 197, 56, 189, 191, 142, 73, 131,
+			165, 253, 67, 70, 9, 188, 220, 182, 11, 216, 52, 159, 51, 204,
+			3, 212, 121, 1, 222, 1, 246, 231, 12, 179, 164, 139, 6, 126,
+			45, 87, 244, 175, 22, 138, 147, 251, 85, 83, 131, 219, 159, 55,
+			204, 125, 170, 41, 160, 254, 124, 214, 212, 160, 95, 203, 123, 116,
+			209, 66, 113, 162, 194, 150, 152, 105, 23, 121, 241, 47, 26, 35,
+			127, 217, 48, 170, 47, 138, 76, 66, 191, 43, 240, 3, 66, 126,
+			238, 54, 142, 217, 20, 13, 110, 255, 69, 163, 116, 128, 102, 83,
+			196, 108, 126, 84, 207, 166, 8, 118, 100, 255, 168, 6, 169, 72,
+			179, 249, 81, 61, 155, 34, 237, 186, 31, 53, 38, 247, 179, 159,
+			7, 251, 40, 154, 6, 183, 191, 104, 152, 213, 234, 95, 51, 114,
+			227, 173, 123, 184, 46, 9, 71, 65, 6, 217, 213, 5, 153, 56,
+			29, 114, 45, 244, 253, 92, 221, 152, 174, 78, 185, 11, 75, 157,
+			137, 43, 225, 54, 220, 61, 136, 136, 136, 95, 40, 14, 148, 111,
+			53, 40, 114, 101, 191, 96, 236, 141, 94, 2, 217, 11, 142, 140,
+			160, 51, 242, 243, 131, 243, 39, 40, 95, 137, 234, 114, 54, 64,
+			255, 23, 53, 209, 20, 9, 253, 95, 4, 94, 84, 209, 66, 113,
+			234, 48, 123, 150, 153, 246, 40, 47, 254, 21, 99, 228, 167, 13,
+			163, 58, 3, 162, 17, 45, 55, 110, 122, 27, 110, 94, 230, 200,
+			36, 238, 28, 174, 71, 13, 110, 255, 21, 163, 52, 70, 184, 30,
+			5, 174, 191, 172, 113, 61, 74, 184, 254, 178, 198, 245, 40, 225,
+			250, 203, 26, 215, 163, 132, 235, 47, 107, 202, 25, 5, 170, 255,
+			170, 97, 30, 84, 63, 2, 244, 191, 154, 53, 5, 232, 127, 213,
+			40, 239, 211, 69, 11, 197, 253, 7, 84, 83, 147, 219, 63, 153,
+			141, 106, 22, 169, 200, 116, 209, 64, 81, 177, 217, 81, 156, 187,
+			246, 79, 102, 163, 90, 220, 254, 41, 195, 228, 234, 71, 171, 64,
+			197, 162, 46, 26, 40, 142, 238, 213, 69, 170, 92, 217, 199, 126,
+			0, 142, 67, 37, 94, 252, 27, 198, 200, 223, 52, 140, 234, 167,
+			12, 161, 77, 100, 132, 183, 40, 143, 56, 252, 164, 60, 121, 28,
+			117, 25, 35, 238, 24, 67, 161, 131, 23, 71, 241, 121, 104, 185,
+			107, 11, 75, 171, 243, 23, 174, 45, 46, 212, 168, 116, 99, 254,
+			230, 170, 254, 187, 113, 115, 121, 121, 105, 249, 178, 44, 172, 94,
+			188, 178, 184, 112, 51, 173, 247, 202, 252, 210, 26, 253, 70, 139,
+			81, 50, 184, 253, 55, 140, 82, 133, 22, 163, 132, 197, 248, 138,
+			97, 30, 162, 197, 40, 209, 54, 254, 138, 198, 104, 137, 22, 227,
+			43, 70, 153, 235, 162, 133, 202, 7, 14, 178, 69, 102, 218, 101,
+			94, 252, 91, 6, 140, 11, 213, 231, 114, 123, 49, 55, 183, 236,
+			57, 2, 24, 214, 142, 107, 155, 162, 141, 178, 193, 237, 191, 101,
+			148, 56, 209, 70, 25, 224, 252, 109, 195, 60, 78, 3, 150, 137,
+			54, 254, 182, 97, 78, 16, 109, 148, 9, 156, 191, 109, 84, 30,
+			209, 69, 11, 149, 31, 171, 177, 23, 169, 169, 193, 237, 191, 107,
+			152, 135, 170, 167, 128, 201, 40, 89, 79, 98, 225, 237, 114, 154,
+			13, 30, 102, 76, 245, 5, 82, 250, 187, 122, 23, 148, 105, 23,
+			252, 93, 64, 165, 138, 22, 126, 61, 112, 144, 189, 74, 35, 153,
+			220, 254, 121, 195, 156, 170, 190, 36, 114, 214, 197, 7, 24, 76,
+			172, 186, 184, 121, 130, 75, 181, 209, 212, 241, 209, 40, 137, 122,
+			110, 10, 135, 89, 160, 190, 53, 28, 32, 248, 159, 55, 74, 147,
+			186, 104, 161, 120, 240, 16, 251, 28, 56, 79, 25, 100, 250, 11,
+			224, 60, 127, 202, 208, 39, 36, 153, 49, 209, 43, 52, 28, 196,
+			10, 147, 190, 152, 169, 225, 49, 216, 121, 122, 214, 85, 59, 145,
+			137, 162, 185, 171, 141, 4, 138, 229, 152, 136, 216, 206, 164, 4,
+			121, 216, 226, 62, 49, 224, 169, 169, 213, 243, 194, 75, 98, 215,
+			111, 167, 147, 192, 14, 249, 5, 77, 69, 101, 218, 33, 191, 96,
+			148, 15, 232, 34, 65, 61, 117, 152, 77, 211, 28, 108, 110, 127,
+			213, 48, 247, 87, 15, 139, 107, 82, 161, 132, 125, 208, 83, 74,
+			104, 201, 67, 100, 59, 184, 45, 125, 53, 235, 21, 110, 172, 95,
+			53, 202, 154, 54, 224, 70, 248, 85, 131, 79, 178, 85, 234, 181,
+			192, 237, 191, 103, 152, 251, 170, 139, 16, 69, 128, 225, 89, 165,
+			66, 203, 230, 151, 163, 79, 72, 21, 114, 61, 32, 246, 109, 135,
+			202, 47, 82, 57, 173, 165, 16, 20, 100, 175, 69, 93, 52, 80,
+			28, 221, 163, 139, 22, 138, 19, 21, 214, 37, 8, 138, 220, 254,
+			135, 16, 42, 55, 196, 144, 65, 88, 116, 225, 247, 164, 110, 144,
+			167, 177, 39, 100, 5, 113, 91, 61, 130, 113, 154, 205, 48, 106,
+			41, 174, 45, 23, 11, 176, 239, 88, 28, 45, 224, 104, 240, 138,
+			5, 26, 82, 35, 8, 39, 220, 63, 52, 202, 83, 186, 104, 161,
+			120, 228, 40, 251, 32, 129, 55, 202, 237, 95, 130, 168, 120, 93,
+			104, 51, 180, 244, 146, 163, 251, 202, 86, 175, 67, 226, 138, 211,
+			162, 55, 44, 93, 125, 69, 215, 71, 84, 14, 16, 175, 45, 156,
+			219, 142, 231, 163, 98, 10, 201, 104, 129, 122, 215, 144, 128, 255,
+			255, 146, 102, 204, 101, 220, 195, 237, 95, 50, 246, 31, 72, 221,
+			143, 62, 251, 6, 19, 195, 46, 67, 146, 143, 116, 147, 48, 186,
+			219, 91, 213, 15, 178, 125, 151, 60, 223, 93, 72, 43, 174, 186,
+			9, 127, 158, 217, 120, 24, 148, 122, 155, 12, 219, 85, 7, 91,
+			208, 51, 197, 6, 181, 56, 185, 167, 244, 137, 223, 249, 165, 111,
+			25, 149, 79, 226, 191, 181, 47, 20, 216, 228, 46, 117, 241, 176,
+			10, 78, 251, 202, 177, 129, 254, 38, 247, 16, 167, 121, 203, 217,
+			212, 190, 21, 186, 136, 167, 108, 45, 23, 26, 52, 188, 214, 152,
+			178, 232, 49, 86, 238, 11, 127, 138, 237, 147, 214, 175, 245, 92,
+			53, 38, 172, 153, 66, 163, 34, 127, 88, 200, 42, 79, 179, 137,
+			109, 215, 185, 149, 175, 58, 70, 85, 199, 241, 57, 87, 241, 34,
+			219, 163, 244, 42, 235, 120, 71, 60, 101, 19, 46, 196, 14, 27,
+			243, 48, 30, 198, 84, 171, 181, 126, 215, 229, 243, 172, 236, 6,
+			189, 142, 236, 161, 112, 23, 108, 46, 6, 189, 206, 112, 47, 37,
+			52, 83, 93, 140, 42, 6, 49, 85, 164, 14, 166, 119, 116, 176,
+			42, 127, 31, 238, 67, 183, 227, 23, 89, 25, 222, 219, 1, 246,
+			197, 212, 40, 117, 242, 196, 142, 78, 232, 165, 241, 112, 23, 89,
+			59, 254, 44, 27, 149, 87, 161, 152, 60, 45, 198, 230, 142, 238,
+			210, 133, 239, 202, 215, 17, 113, 67, 87, 230, 75, 172, 34, 189,
+			31, 215, 155, 97, 203, 93, 135, 167, 226, 84, 153, 58, 120, 116,
+			71, 7, 171, 84, 241, 98, 216, 114, 151, 130, 118, 216, 24, 143,
+			7, 202, 228, 68, 210, 15, 18, 231, 206, 212, 30, 229, 68, 66,
+			37, 62, 199, 70, 93, 233, 44, 58, 53, 46, 140, 153, 241, 185,
+			169, 29, 61, 47, 202, 223, 27, 186, 98, 237, 103, 139, 108, 226,
+			65, 200, 242, 69, 56, 168, 184, 62, 156, 136, 30, 2, 111, 178,
+			205, 32, 226, 139, 111, 19, 241, 243, 108, 44, 112, 113, 245, 149,
+			84, 100, 61, 32, 29, 50, 217, 104, 39, 25, 218, 111, 139, 12,
+			63, 192, 38, 82, 144, 214, 35, 112, 116, 69, 207, 167, 239, 7,
+			73, 125, 81, 183, 107, 160, 89, 99, 60, 237, 135, 202, 124, 129,
+			177, 48, 112, 195, 246, 122, 203, 109, 194, 139, 103, 119, 44, 173,
+			160, 202, 48, 120, 101, 106, 184, 224, 54, 125, 254, 66, 70, 158,
+			163, 119, 161, 174, 235, 114, 99, 238, 160, 208, 155, 108, 60, 114,
+			177, 87, 220, 150, 154, 89, 153, 102, 86, 191, 239, 204, 26, 170,
+			25, 77, 164, 177, 87, 247, 66, 69, 126, 156, 165, 31, 214, 193,
+			225, 136, 37, 149, 27, 123, 244, 199, 101, 167, 227, 86, 63, 194,
+			198, 7, 209, 3, 143, 40, 146, 188, 136, 57, 22, 200, 103, 44,
+			74, 240, 168, 214, 13, 90, 244, 168, 182, 208, 176, 220, 160, 197,
+			223, 151, 77, 216, 162, 9, 63, 185, 3, 220, 193, 158, 135, 231,
+			93, 125, 142, 237, 29, 152, 192, 131, 14, 93, 251, 167, 54, 59,
+			176, 107, 223, 252, 3, 108, 127, 47, 192, 37, 45, 234, 70, 46,
+			72, 86, 130, 56, 245, 245, 209, 187, 16, 221, 205, 124, 109, 217,
+			75, 99, 114, 160, 11, 249, 145, 191, 202, 198, 64, 31, 142, 122,
+			208, 44, 119, 227, 220, 131, 77, 185, 190, 144, 181, 188, 96, 125,
+			214, 48, 27, 249, 190, 248, 115, 172, 212, 118, 157, 164, 23, 185,
+			177, 122, 240, 125, 100, 71, 191, 151, 100, 133, 85, 55, 105, 164,
+			149, 121, 135, 237, 185, 237, 70, 240, 11, 161, 174, 201, 245, 111,
+			124, 238, 249, 7, 4, 234, 229, 92, 83, 186, 209, 156, 103, 55,
+			151, 95, 94, 108, 44, 93, 90, 90, 92, 144, 96, 14, 116, 95,
+			253, 33, 131, 141, 229, 102, 2, 118, 40, 95, 198, 168, 245, 82,
+			37, 248, 14, 182, 17, 217, 129, 136, 78, 249, 41, 226, 3, 8,
+			14, 71, 175, 98, 35, 248, 78, 127, 203, 247, 217, 146, 16, 200,
+			61, 175, 212, 72, 203, 242, 183, 46, 137, 72, 202, 71, 47, 45,
+			95, 181, 75, 118, 165, 80, 123, 154, 237, 219, 49, 21, 62, 193,
+			198, 22, 22, 47, 94, 155, 111, 204, 175, 45, 173, 44, 87, 70,
+			16, 249, 32, 155, 93, 197, 56, 89, 46, 189, 53, 90, 249, 196,
+			39, 62, 241, 9, 179, 246, 213, 34, 219, 191, 27, 19, 220, 149,
+			31, 103, 147, 182, 6, 38, 61, 207, 10, 190, 179, 225, 250, 228,
+			124, 56, 62, 247, 212, 3, 177, 217, 250, 53, 52, 105, 200, 150,
+			252, 61, 10, 53, 64, 193, 248, 220, 201, 7, 235, 1, 252, 85,
+			161, 241, 8, 43, 227, 95, 185, 217, 129, 171, 114, 163, 132, 15,
+			132, 247, 42, 43, 17, 223, 107, 185, 233, 154, 232, 50, 56, 133,
+			210, 249, 169, 56, 21, 224, 96, 229, 198, 30, 245, 81, 7, 202,
+			24, 35, 110, 183, 238, 5, 45, 247, 14, 29, 161, 133, 134, 228,
+			156, 75, 248, 130, 101, 127, 51, 14, 3, 205, 107, 208, 67, 9,
+			31, 104, 248, 231, 50, 110, 81, 82, 113, 6, 118, 157, 222, 48,
+			147, 128, 43, 38, 33, 224, 156, 218, 202, 142, 63, 181, 143, 200,
+			96, 92, 126, 214, 175, 33, 107, 127, 203, 100, 54, 144, 129, 165,
+			95, 123, 245, 198, 226, 250, 194, 202, 205, 11, 215, 22, 43, 6,
+			150, 158, 62, 92, 186, 182, 50, 191, 86, 49, 211, 242, 210, 242,
+			218, 179, 79, 87, 172, 180, 193, 77, 249, 193, 206, 87, 56, 55,
+			87, 41, 240, 10, 219, 67, 229, 75, 75, 31, 88, 92, 120, 246,
+			233, 74, 113, 240, 203, 185, 185, 202, 40, 223, 203, 202, 244, 229,
+			194, 202, 202, 181, 74, 41, 237, 115, 117, 173, 177, 180, 124, 185,
+			82, 78, 251, 188, 220, 88, 185, 121, 163, 194, 210, 30, 174, 47,
+			174, 174, 206, 95, 94, 172, 140, 165, 53, 46, 188, 186, 182, 184,
+			90, 217, 51, 0, 214, 185, 185, 202, 222, 116, 136, 197, 229, 155,
+			215, 43, 227, 124, 31, 219, 75, 197, 85, 13, 196, 196, 208, 167,
+			103, 159, 174, 84, 50, 64, 100, 47, 251, 6, 62, 60, 251, 116,
+			133, 215, 46, 178, 2, 145, 33, 231, 108, 252, 218, 252, 133, 197,
+			107, 235, 43, 55, 176, 105, 230, 175, 85, 140, 236, 91, 99, 241,
+			198, 226, 252, 218, 226, 66, 197, 202, 127, 123, 255, 205, 165, 198,
+			226, 66, 197, 172, 53, 217, 254, 221, 78, 200, 93, 183, 80, 142,
+			22, 204, 187, 208, 2, 245, 53, 76, 11, 181, 223, 48, 217, 228,
+			46, 82, 194, 174, 131, 188, 55, 11, 77, 3, 78, 125, 98, 199,
+			16, 232, 136, 40, 123, 168, 55, 21, 173, 38, 47, 111, 90, 119,
+			145, 55, 209, 197, 14, 130, 253, 174, 29, 167, 185, 20, 120, 158,
+			221, 181, 249, 208, 224, 244, 237, 225, 78, 245, 194, 46, 167, 250,
+			139, 108, 223, 142, 142, 30, 248, 116, 253, 180, 193, 166, 238, 134,
+			156, 251, 176, 68, 115, 128, 37, 190, 56, 140, 193, 199, 118, 69,
+			1, 141, 179, 99, 173, 127, 210, 96, 7, 119, 191, 87, 236, 10,
+			195, 123, 88, 177, 227, 38, 91, 161, 150, 147, 119, 10, 35, 215,
+			233, 231, 161, 190, 26, 170, 85, 94, 124, 179, 238, 34, 190, 41,
+			104, 118, 64, 250, 103, 76, 118, 96, 215, 206, 119, 5, 244, 24,
+			99, 94, 208, 237, 37, 82, 22, 6, 194, 202, 141, 50, 125, 33,
+			230, 5, 46, 219, 75, 210, 223, 45, 250, 157, 201, 79, 84, 225,
+			249, 12, 80, 155, 0, 125, 228, 46, 51, 29, 134, 147, 159, 97,
+			21, 105, 51, 89, 143, 147, 200, 117, 58, 94, 176, 41, 79, 219,
+			243, 133, 182, 227, 199, 110, 99, 66, 254, 188, 170, 127, 69, 11,
+			34, 160, 40, 215, 162, 56, 208, 66, 254, 156, 182, 168, 253, 181,
+			50, 27, 203, 221, 194, 224, 153, 255, 166, 115, 219, 89, 87, 87,
+			105, 133, 137, 49, 124, 187, 161, 110, 215, 103, 216, 126, 20, 215,
+			195, 94, 226, 70, 235, 77, 223, 137, 99, 32, 74, 185, 211, 115,
+			252, 182, 130, 159, 46, 234, 95, 248, 51, 108, 18, 95, 215, 181,
+			107, 201, 58, 110, 254, 241, 20, 203, 67, 182, 15, 53, 174, 171,
+			10, 128, 40, 230, 11, 236, 24, 62, 174, 235, 119, 124, 235, 238,
+			135, 123, 142, 31, 175, 59, 65, 107, 125, 203, 137, 183, 166, 246,
+			163, 131, 11, 230, 148, 209, 56, 140, 138, 151, 85, 189, 69, 170,
+			54, 31, 180, 174, 56, 241, 22, 63, 207, 14, 226, 199, 117, 21,
+			89, 170, 185, 229, 54, 111, 173, 247, 146, 246, 243, 83, 71, 242,
+			227, 19, 132, 171, 84, 231, 34, 170, 220, 76, 218, 207, 243, 85,
+			182, 7, 107, 215, 241, 62, 226, 174, 183, 195, 136, 206, 208, 241,
+			185, 19, 247, 186, 199, 214, 87, 84, 131, 235, 97, 203, 61, 95,
+			88, 189, 177, 184, 184, 208, 24, 211, 189, 92, 10, 35, 126, 140,
+			177, 205, 48, 69, 240, 24, 97, 173, 188, 25, 106, 244, 62, 195,
+			38, 155, 77, 57, 103, 175, 185, 174, 110, 228, 241, 84, 101, 0,
+			89, 205, 38, 77, 214, 107, 42, 26, 143, 249, 11, 236, 64, 134,
+			172, 124, 195, 125, 249, 134, 147, 41, 158, 114, 77, 159, 97, 147,
+			221, 254, 206, 134, 124, 96, 196, 110, 127, 184, 217, 19, 164, 101,
+			137, 220, 38, 137, 122, 135, 242, 181, 115, 63, 240, 58, 171, 52,
+			155, 235, 110, 0, 77, 213, 186, 19, 185, 129, 19, 79, 61, 74,
+			149, 109, 40, 8, 27, 227, 205, 230, 34, 253, 56, 79, 191, 241,
+			147, 108, 95, 184, 241, 102, 83, 18, 214, 122, 55, 114, 219, 222,
+			157, 169, 199, 9, 75, 19, 248, 129, 200, 234, 6, 125, 230, 39,
+			88, 165, 25, 111, 57, 81, 151, 56, 107, 220, 117, 154, 238, 212,
+			19, 178, 170, 252, 190, 172, 63, 131, 176, 227, 109, 175, 157, 232,
+			30, 167, 169, 218, 24, 125, 83, 189, 205, 176, 74, 119, 171, 59,
+			56, 240, 12, 85, 27, 239, 110, 117, 243, 227, 30, 103, 123, 187,
+			91, 249, 65, 79, 80, 181, 61, 221, 173, 220, 136, 79, 179, 131,
+			168, 212, 113, 19, 7, 113, 24, 114, 181, 103, 169, 246, 254, 238,
+			86, 247, 186, 250, 113, 0, 206, 168, 183, 209, 79, 233, 227, 20,
+			213, 29, 195, 55, 77, 33, 111, 251, 250, 241, 71, 118, 217, 170,
+			157, 103, 123, 242, 116, 207, 203, 76, 82, 126, 197, 128, 16, 116,
+			113, 101, 97, 113, 125, 117, 233, 181, 197, 138, 9, 49, 234, 218,
+			210, 218, 226, 122, 227, 230, 242, 218, 210, 245, 197, 138, 149, 19,
+			236, 175, 218, 165, 147, 149, 167, 174, 218, 165, 39, 43, 211, 132,
+			158, 29, 68, 89, 251, 61, 139, 141, 15, 94, 203, 249, 187, 216,
+			33, 173, 119, 139, 221, 100, 125, 219, 139, 104, 179, 118, 28, 121,
+			112, 166, 68, 185, 95, 213, 90, 117, 147, 87, 188, 200, 189, 68,
+			239, 95, 249, 53, 246, 104, 16, 226, 221, 84, 208, 114, 162, 214,
+			122, 166, 255, 92, 119, 154, 8, 134, 161, 222, 221, 165, 189, 28,
+			13, 194, 85, 85, 57, 59, 61, 230, 85, 213, 161, 61, 97, 221,
+			109, 79, 28, 97, 229, 142, 211, 93, 119, 17, 188, 143, 30, 42,
+			149, 26, 165, 142, 211, 149, 81, 251, 94, 102, 79, 102, 85, 215,
+			125, 119, 211, 105, 246, 215, 33, 151, 175, 147, 142, 104, 29, 106,
+			107, 223, 107, 38, 241, 212, 88, 202, 255, 106, 89, 139, 107, 212,
+			224, 106, 28, 6, 36, 159, 95, 212, 181, 7, 110, 173, 123, 254,
+			88, 144, 205, 224, 210, 219, 149, 194, 85, 187, 84, 168, 20, 175,
+			218, 165, 98, 101, 244, 170, 93, 42, 85, 202, 87, 237, 82, 185,
+			194, 106, 127, 105, 47, 219, 147, 191, 110, 240, 121, 86, 104, 210,
+			129, 139, 37, 30, 159, 59, 126, 207, 203, 73, 253, 34, 78, 226,
+			243, 69, 41, 219, 55, 100, 75, 72, 65, 216, 100, 46, 36, 16,
+			245, 170, 14, 37, 126, 153, 21, 223, 140, 81, 131, 174, 175, 227,
+			115, 143, 223, 187, 239, 171, 171, 212, 121, 249, 234, 234, 250, 242,
+			74, 227, 250, 252, 181, 134, 106, 206, 15, 51, 219, 119, 62, 210,
+			31, 60, 179, 233, 19, 175, 179, 137, 94, 32, 239, 234, 88, 99,
+			212, 154, 200, 215, 26, 207, 126, 189, 134, 250, 15, 72, 87, 135,
+			153, 13, 165, 244, 224, 201, 74, 159, 248, 12, 219, 211, 114, 55,
+			122, 176, 135, 180, 156, 102, 50, 120, 158, 140, 209, 79, 13, 250,
+			133, 191, 196, 202, 88, 184, 0, 211, 163, 171, 219, 248, 220, 169,
+			123, 163, 64, 45, 177, 110, 212, 200, 218, 243, 43, 108, 52, 113,
+			162, 77, 55, 137, 167, 38, 133, 53, 51, 62, 87, 127, 144, 174,
+			214, 168, 9, 240, 218, 208, 205, 249, 43, 172, 162, 84, 177, 235,
+			234, 154, 27, 79, 237, 39, 190, 53, 123, 239, 46, 149, 38, 119,
+			65, 54, 106, 76, 184, 3, 229, 193, 125, 113, 224, 97, 246, 197,
+			77, 54, 161, 254, 94, 135, 187, 102, 24, 37, 83, 7, 133, 113,
+			127, 128, 116, 103, 178, 77, 99, 188, 61, 80, 254, 163, 219, 110,
+			213, 215, 216, 248, 32, 50, 242, 138, 112, 235, 1, 21, 225, 124,
+			127, 118, 81, 195, 209, 36, 111, 95, 213, 207, 155, 108, 124, 112,
+			98, 252, 50, 227, 170, 205, 186, 23, 36, 81, 216, 234, 53, 221,
+			214, 148, 113, 159, 113, 246, 169, 54, 75, 105, 147, 124, 71, 185,
+			93, 96, 62, 96, 71, 11, 217, 254, 56, 205, 38, 117, 7, 232,
+			108, 219, 161, 7, 89, 74, 181, 197, 115, 63, 189, 34, 127, 225,
+			243, 76, 147, 203, 122, 228, 118, 194, 219, 234, 9, 238, 189, 134,
+			29, 87, 13, 26, 178, 126, 237, 52, 43, 16, 251, 225, 140, 41,
+			6, 84, 25, 225, 37, 102, 95, 92, 105, 44, 84, 12, 156, 135,
+			242, 235, 250, 141, 165, 197, 139, 139, 21, 179, 246, 12, 43, 74,
+			158, 130, 163, 51, 229, 42, 149, 17, 85, 84, 125, 24, 250, 215,
+			155, 215, 47, 44, 54, 42, 102, 237, 38, 155, 24, 218, 135, 252,
+			0, 219, 215, 88, 92, 91, 92, 134, 114, 96, 253, 230, 242, 75,
+			203, 43, 175, 64, 179, 54, 240, 89, 159, 195, 6, 223, 207, 42,
+			217, 231, 213, 149, 155, 13, 130, 230, 207, 154, 172, 50, 188, 41,
+			249, 33, 54, 185, 54, 223, 184, 188, 184, 182, 78, 154, 137, 172,
+			235, 253, 172, 146, 255, 225, 210, 18, 233, 115, 30, 101, 71, 242,
+			95, 23, 63, 176, 182, 184, 188, 138, 81, 26, 243, 203, 151, 33,
+			20, 12, 245, 167, 85, 44, 22, 102, 144, 255, 225, 210, 210, 226,
+			181, 133, 138, 61, 252, 121, 101, 121, 113, 229, 82, 165, 48, 60,
+			58, 169, 93, 138, 188, 202, 14, 14, 127, 93, 95, 92, 94, 107,
+			188, 90, 25, 29, 30, 120, 117, 177, 241, 242, 210, 197, 197, 74,
+			137, 31, 100, 60, 255, 195, 245, 197, 181, 43, 43, 11, 149, 242,
+			110, 39, 22, 175, 76, 214, 126, 198, 96, 123, 242, 42, 144, 1,
+			166, 98, 252, 113, 59, 108, 107, 255, 202, 100, 99, 57, 93, 8,
+			30, 108, 147, 171, 222, 186, 227, 123, 78, 172, 206, 67, 70, 159,
+			230, 241, 229, 65, 207, 159, 7, 23, 93, 138, 111, 91, 116, 25,
+			253, 99, 40, 186, 20, 42, 197, 218, 175, 152, 172, 50, 172, 29,
+			25, 194, 155, 113, 55, 188, 229, 231, 103, 62, 204, 252, 134, 79,
+			117, 235, 174, 167, 250, 46, 135, 149, 253, 199, 249, 176, 202, 147,
+			235, 191, 49, 216, 184, 186, 118, 106, 196, 230, 49, 86, 123, 24,
+			140, 13, 174, 200, 99, 119, 91, 145, 255, 42, 243, 250, 17, 139,
+			237, 29, 208, 253, 60, 40, 116, 31, 102, 251, 114, 241, 40, 215,
+			125, 247, 182, 235, 19, 26, 198, 231, 78, 223, 91, 187, 84, 95,
+			202, 218, 93, 67, 179, 243, 147, 75, 11, 139, 215, 111, 172, 172,
+			45, 46, 95, 124, 85, 31, 18, 141, 74, 174, 123, 170, 54, 176,
+			5, 143, 63, 12, 194, 255, 200, 48, 89, 187, 193, 42, 195, 179,
+			1, 67, 223, 101, 62, 149, 17, 62, 201, 38, 150, 87, 214, 87,
+			151, 22, 22, 215, 23, 47, 93, 90, 188, 184, 182, 42, 13, 13,
+			105, 237, 181, 138, 153, 95, 155, 191, 104, 177, 201, 93, 32, 225,
+			243, 74, 69, 40, 181, 150, 167, 30, 4, 250, 58, 110, 247, 55,
+			156, 40, 81, 26, 197, 19, 172, 146, 186, 203, 234, 120, 232, 216,
+			184, 229, 198, 68, 246, 157, 216, 8, 159, 101, 188, 27, 198, 94,
+			226, 221, 134, 35, 68, 162, 42, 99, 227, 218, 141, 138, 254, 101,
+			41, 80, 145, 198, 103, 25, 15, 220, 77, 103, 168, 54, 174, 31,
+			86, 163, 162, 127, 73, 107, 63, 198, 246, 180, 194, 30, 180, 50,
+			89, 172, 115, 163, 49, 38, 191, 165, 85, 6, 2, 178, 131, 247,
+			238, 25, 12, 199, 62, 205, 38, 156, 205, 205, 8, 157, 235, 142,
+			164, 34, 112, 60, 253, 76, 21, 171, 87, 89, 73, 227, 1, 150,
+			39, 96, 98, 189, 43, 181, 219, 38, 44, 79, 129, 254, 241, 49,
+			182, 199, 139, 215, 83, 155, 255, 148, 41, 204, 153, 82, 99, 204,
+			139, 83, 171, 104, 237, 39, 25, 99, 25, 177, 241, 31, 48, 216,
+			184, 60, 96, 100, 72, 186, 166, 190, 22, 238, 162, 169, 75, 91,
+			73, 153, 252, 134, 106, 112, 225, 189, 159, 53, 140, 47, 24, 246,
+			23, 12, 227, 199, 141, 189, 188, 180, 248, 129, 27, 215, 150, 46,
+			46, 173, 77, 125, 247, 40, 149, 151, 174, 171, 242, 215, 71, 7,
+			127, 127, 107, 244, 43, 134, 85, 122, 107, 180, 177, 183, 157, 239,
+			143, 251, 121, 15, 10, 243, 110, 23, 201, 12, 154, 69, 229, 55,
+			113, 225, 4, 1, 82, 36, 64, 198, 120, 241, 226, 181, 149, 213,
+			197, 5, 2, 163, 204, 237, 149, 27, 139, 203, 83, 95, 215, 67,
+			102, 206, 22, 95, 48, 216, 33, 109, 101, 85, 103, 173, 27, 52,
+			195, 150, 150, 110, 199, 231, 206, 222, 107, 240, 134, 106, 74, 40,
+			89, 84, 13, 47, 156, 218, 129, 146, 249, 229, 5, 5, 203, 24,
+			47, 222, 152, 191, 248, 210, 226, 66, 6, 205, 129, 104, 183, 94,
+			248, 199, 216, 4, 180, 173, 160, 13, 175, 69, 194, 245, 148, 125,
+			55, 123, 105, 6, 17, 212, 175, 47, 167, 45, 20, 82, 228, 234,
+			148, 185, 189, 188, 178, 188, 168, 193, 32, 19, 241, 171, 25, 24,
+			227, 189, 129, 166, 252, 99, 172, 162, 213, 67, 41, 74, 10, 119,
+			51, 249, 102, 0, 40, 37, 83, 138, 140, 39, 115, 16, 236, 231,
+			19, 215, 22, 151, 47, 175, 93, 89, 191, 209, 88, 36, 203, 221,
+			212, 119, 235, 225, 39, 58, 131, 13, 249, 167, 12, 54, 38, 181,
+			55, 164, 112, 82, 74, 133, 39, 239, 53, 121, 146, 128, 168, 246,
+			133, 23, 104, 88, 75, 19, 196, 33, 206, 175, 45, 94, 158, 191,
+			248, 234, 250, 133, 197, 213, 53, 112, 178, 149, 134, 164, 81, 198,
+			11, 243, 215, 174, 173, 188, 146, 33, 130, 189, 153, 118, 83, 123,
+			157, 237, 29, 32, 119, 8, 197, 36, 76, 99, 6, 171, 139, 203,
+			23, 243, 66, 252, 30, 150, 146, 119, 197, 224, 123, 88, 74, 252,
+			21, 19, 108, 84, 1, 144, 218, 18, 173, 218, 115, 172, 164, 201,
+			23, 162, 57, 73, 216, 67, 23, 131, 18, 35, 218, 173, 24, 184,
+			6, 73, 154, 174, 152, 181, 151, 217, 129, 93, 73, 143, 31, 103,
+			143, 106, 251, 229, 186, 132, 115, 113, 249, 226, 202, 194, 210, 242,
+			229, 92, 159, 140, 41, 26, 148, 80, 106, 250, 172, 152, 181, 37,
+			54, 62, 72, 64, 252, 8, 59, 116, 115, 237, 210, 243, 200, 163,
+			176, 180, 48, 63, 116, 33, 98, 76, 81, 81, 197, 196, 205, 12,
+			212, 85, 177, 106, 118, 201, 168, 24, 181, 85, 54, 49, 68, 10,
+			252, 40, 155, 82, 55, 148, 221, 160, 154, 100, 195, 196, 33, 149,
+			160, 11, 139, 215, 150, 174, 47, 193, 32, 107, 214, 174, 48, 150,
+			173, 49, 206, 172, 171, 171, 43, 203, 235, 151, 112, 209, 91, 203,
+			117, 85, 102, 114, 77, 43, 6, 238, 35, 59, 23, 190, 98, 158,
+			44, 226, 196, 250, 222, 229, 147, 197, 210, 247, 46, 87, 190, 31,
+			255, 126, 255, 114, 229, 7, 150, 175, 22, 75, 95, 31, 173, 188,
+			53, 90, 251, 15, 22, 227, 25, 101, 165, 58, 143, 15, 176, 82,
+			170, 68, 145, 62, 155, 239, 186, 7, 65, 234, 102, 185, 79, 234,
+			182, 171, 126, 105, 164, 189, 225, 198, 220, 241, 2, 60, 181, 91,
+			87, 23, 225, 251, 223, 152, 85, 3, 85, 166, 46, 156, 59, 3,
+			93, 20, 238, 219, 133, 115, 39, 215, 69, 245, 63, 25, 108, 234,
+			110, 192, 190, 45, 165, 199, 50, 219, 31, 222, 118, 163, 200, 35,
+			215, 220, 245, 84, 20, 178, 239, 47, 10, 77, 230, 26, 170, 207,
+			49, 191, 128, 19, 235, 142, 219, 202, 122, 42, 220, 191, 167, 189,
+			212, 68, 247, 113, 21, 4, 138, 219, 135, 89, 177, 50, 121, 171,
+			246, 223, 155, 108, 124, 208, 45, 146, 47, 176, 146, 175, 28, 135,
+			149, 135, 238, 204, 125, 60, 41, 235, 215, 84, 253, 70, 218, 178,
+			250, 207, 13, 86, 210, 159, 249, 65, 102, 119, 157, 100, 139, 186,
+			43, 92, 48, 43, 70, 131, 202, 248, 30, 119, 29, 233, 110, 165,
+			190, 163, 12, 75, 141, 239, 58, 224, 164, 235, 136, 193, 233, 6,
+			42, 46, 88, 185, 49, 161, 190, 95, 84, 159, 225, 157, 155, 68,
+			142, 231, 15, 212, 181, 169, 110, 69, 255, 144, 86, 62, 207, 14,
+			235, 126, 91, 110, 130, 248, 67, 173, 172, 17, 28, 40, 203, 141,
+			67, 170, 194, 130, 250, 93, 183, 29, 114, 61, 254, 151, 38, 219,
+			167, 45, 136, 173, 20, 117, 215, 25, 115, 130, 32, 76, 242, 200,
+			219, 41, 244, 237, 104, 87, 159, 79, 27, 53, 114, 29, 84, 255,
+			119, 131, 177, 236, 167, 187, 98, 241, 81, 54, 166, 92, 96, 97,
+			41, 85, 138, 54, 38, 63, 93, 242, 124, 23, 58, 184, 13, 119,
+			211, 11, 148, 79, 147, 44, 104, 215, 0, 59, 117, 13, 224, 13,
+			86, 138, 221, 142, 19, 36, 94, 147, 8, 108, 124, 238, 217, 135,
+			2, 190, 190, 170, 90, 55, 210, 126, 106, 51, 172, 164, 191, 166,
+			220, 114, 132, 143, 50, 107, 117, 113, 173, 98, 192, 244, 51, 127,
+			109, 105, 126, 181, 98, 158, 252, 73, 147, 141, 170, 157, 132, 131,
+			99, 81, 102, 68, 24, 224, 149, 227, 250, 163, 228, 110, 149, 239,
+			30, 205, 127, 188, 209, 88, 89, 91, 153, 171, 252, 246, 206, 143,
+			231, 42, 95, 31, 229, 251, 216, 30, 253, 113, 238, 204, 220, 185,
+			202, 91, 195, 159, 158, 174, 252, 123, 210, 241, 232, 79, 103, 215,
+			215, 192, 61, 87, 150, 175, 189, 90, 49, 242, 63, 204, 229, 126,
+			48, 249, 49, 118, 72, 255, 240, 194, 11, 47, 188, 240, 92, 238,
+			199, 191, 244, 125, 197, 225, 159, 159, 207, 253, 252, 99, 59, 127,
+			126, 33, 247, 243, 23, 191, 175, 200, 39, 217, 152, 254, 249, 250,
+			252, 7, 42, 223, 250, 214, 183, 190, 53, 122, 225, 99, 187, 167,
+			245, 169, 12, 57, 40, 196, 87, 140, 215, 78, 221, 55, 183, 79,
+			102, 195, 26, 200, 236, 83, 29, 206, 236, 211, 112, 219, 190, 75,
+			113, 16, 216, 207, 29, 96, 167, 119, 13, 121, 134, 189, 20, 6,
+			242, 125, 192, 105, 229, 152, 160, 94, 6, 216, 168, 80, 189, 239,
+			11, 130, 218, 113, 86, 210, 102, 78, 126, 136, 141, 182, 194, 38,
+			158, 61, 40, 207, 130, 98, 43, 108, 222, 140, 252, 147, 39, 217,
+			190, 53, 247, 78, 114, 227, 2, 201, 1, 234, 96, 28, 99, 163,
+			11, 139, 151, 230, 111, 94, 91, 147, 42, 83, 156, 146, 21, 227,
+			252, 42, 219, 139, 109, 145, 90, 86, 249, 209, 123, 25, 228, 167,
+			126, 243, 23, 176, 33, 198, 230, 198, 235, 0, 184, 174, 97, 105,
+			236, 65, 39, 186, 116, 254, 67, 108, 60, 65, 64, 209, 238, 134,
+			18, 212, 248, 189, 29, 222, 166, 126, 139, 186, 29, 159, 59, 36,
+			187, 221, 1, 125, 99, 15, 250, 187, 177, 33, 231, 114, 254, 18,
+			27, 71, 189, 102, 123, 115, 93, 70, 2, 184, 95, 255, 191, 77,
+			253, 151, 26, 123, 85, 179, 37, 106, 117, 225, 248, 107, 143, 221,
+			119, 161, 174, 254, 206, 62, 164, 141, 153, 24, 121, 201, 96, 223,
+			146, 33, 232, 38, 70, 120, 245, 5, 177, 128, 4, 0, 120, 225,
+			146, 222, 174, 232, 177, 150, 82, 8, 33, 210, 12, 189, 207, 73,
+			194, 57, 33, 61, 229, 233, 217, 84, 157, 237, 136, 94, 247, 194,
+			59, 209, 235, 222, 137, 94, 247, 80, 209, 235, 246, 141, 236, 213,
+			129, 234, 38, 71, 78, 170, 64, 118, 7, 70, 158, 215, 129, 236,
+			240, 167, 138, 16, 114, 104, 164, 154, 69, 8, 57, 84, 170, 176,
+			139, 58, 66, 200, 97, 243, 145, 234, 179, 20, 52, 29, 97, 191,
+			229, 123, 169, 83, 217, 123, 41, 236, 83, 10, 106, 211, 113, 240,
+			38, 222, 11, 232, 232, 17, 173, 176, 153, 70, 221, 64, 152, 140,
+			195, 42, 78, 131, 140, 21, 114, 88, 5, 107, 150, 177, 66, 14,
+			243, 195, 186, 100, 113, 235, 240, 209, 99, 108, 133, 25, 163, 220,
+			62, 54, 50, 99, 84, 23, 212, 6, 210, 15, 155, 193, 64, 78,
+			145, 242, 77, 61, 250, 87, 8, 136, 147, 48, 36, 242, 184, 237,
+			68, 94, 216, 139, 229, 70, 73, 194, 144, 34, 172, 252, 158, 193,
+			204, 209, 17, 100, 17, 57, 89, 125, 203, 16, 55, 85, 184, 125,
+			181, 205, 241, 14, 172, 27, 118, 123, 8, 243, 36, 16, 161, 221,
+			141, 232, 81, 158, 118, 130, 106, 9, 176, 21, 53, 87, 0, 128,
+			46, 197, 77, 188, 83, 56, 47, 243, 40, 121, 29, 104, 119, 69,
+			237, 33, 249, 121, 237, 69, 217, 92, 126, 20, 51, 168, 94, 31,
+			224, 179, 39, 196, 187, 197, 159, 80, 27, 71, 113, 241, 243, 162,
+			134, 164, 77, 241, 249, 211, 167, 221, 59, 14, 130, 158, 212, 155,
+			97, 135, 66, 99, 118, 156, 122, 7, 153, 240, 80, 253, 163, 47,
+			82, 36, 193, 209, 17, 147, 91, 199, 70, 107, 242, 111, 27, 40,
+			80, 223, 139, 220, 154, 30, 155, 148, 127, 35, 165, 202, 254, 199,
+			228, 223, 72, 168, 242, 248, 9, 214, 100, 102, 97, 132, 219, 179,
+			35, 115, 70, 245, 21, 129, 123, 38, 80, 34, 249, 116, 162, 94,
+			236, 33, 249, 83, 31, 216, 115, 68, 55, 2, 73, 122, 183, 241,
+			186, 208, 245, 101, 16, 127, 209, 12, 97, 82, 87, 81, 193, 212,
+			37, 157, 34, 11, 73, 206, 77, 128, 20, 64, 2, 179, 133, 131,
+			236, 61, 204, 46, 80, 164, 195, 186, 57, 94, 61, 75, 204, 78,
+			221, 112, 242, 163, 98, 187, 200, 214, 233, 206, 161, 1, 137, 188,
+			208, 30, 25, 81, 204, 178, 46, 153, 220, 170, 239, 217, 203, 62,
+			70, 125, 35, 43, 137, 57, 86, 253, 176, 88, 210, 234, 194, 172,
+			253, 116, 44, 72, 147, 134, 176, 16, 142, 192, 169, 39, 194, 13,
+			138, 137, 136, 119, 146, 18, 0, 196, 51, 112, 154, 81, 24, 199,
+			105, 196, 38, 196, 63, 12, 220, 120, 22, 141, 61, 245, 184, 20,
+			112, 129, 159, 4, 155, 178, 235, 88, 195, 134, 216, 28, 103, 205,
+			162, 46, 153, 220, 58, 91, 102, 236, 6, 17, 251, 51, 35, 47,
+			25, 213, 197, 29, 196, 238, 250, 173, 135, 167, 246, 223, 5, 181,
+			35, 125, 135, 249, 116, 245, 223, 237, 164, 118, 76, 8, 4, 221,
+			221, 16, 202, 227, 9, 75, 8, 116, 36, 253, 83, 221, 200, 11,
+			146, 28, 189, 127, 167, 73, 93, 83, 193, 245, 190, 186, 226, 167,
+			228, 173, 80, 214, 233, 75, 101, 154, 120, 183, 56, 43, 62, 40,
+			183, 196, 160, 148, 128, 61, 129, 5, 250, 46, 69, 232, 68, 70,
+			163, 134, 201, 173, 103, 70, 143, 203, 191, 109, 76, 94, 125, 47,
+			114, 235, 189, 99, 71, 229, 223, 64, 202, 177, 167, 228, 223, 22,
+			183, 222, 91, 63, 199, 254, 19, 144, 101, 114, 235, 170, 57, 93,
+			253, 157, 93, 89, 3, 242, 60, 81, 210, 53, 39, 183, 166, 146,
+			192, 59, 206, 45, 124, 210, 114, 186, 174, 21, 135, 193, 31, 7,
+			180, 13, 10, 63, 64, 91, 18, 245, 220, 65, 180, 153, 57, 180,
+			153, 54, 208, 160, 190, 23, 184, 117, 117, 108, 66, 254, 109, 112,
+			235, 106, 229, 17, 249, 183, 197, 173, 171, 143, 61, 201, 254, 179,
+			193, 30, 217, 33, 134, 170, 244, 2, 119, 123, 198, 122, 158, 149,
+			22, 84, 149, 135, 206, 152, 251, 233, 187, 100, 204, 221, 171, 123,
+			212, 121, 56, 207, 222, 87, 86, 151, 185, 102, 91, 170, 221, 219,
+			202, 196, 249, 115, 255, 200, 120, 128, 84, 156, 93, 23, 17, 41,
+			220, 211, 106, 241, 226, 211, 242, 245, 179, 66, 79, 37, 173, 90,
+			151, 223, 171, 15, 123, 29, 168, 222, 103, 5, 106, 127, 198, 100,
+			123, 111, 200, 215, 215, 240, 73, 243, 54, 249, 180, 140, 226, 190,
+			51, 30, 190, 2, 13, 1, 82, 80, 131, 159, 203, 226, 204, 67,
+			175, 48, 152, 46, 83, 85, 222, 17, 110, 254, 57, 86, 114, 154,
+			254, 122, 236, 146, 166, 193, 26, 10, 111, 174, 90, 205, 55, 253,
+			85, 55, 33, 59, 244, 168, 67, 127, 199, 124, 137, 77, 108, 248,
+			78, 243, 86, 216, 131, 51, 97, 208, 10, 183, 213, 195, 69, 177,
+			179, 253, 5, 85, 241, 21, 170, 215, 24, 215, 13, 101, 89, 190,
+			119, 106, 84, 98, 183, 217, 139, 188, 164, 175, 76, 95, 113, 237,
+			251, 76, 54, 62, 216, 116, 183, 188, 166, 210, 249, 95, 121, 217,
+			12, 56, 255, 171, 188, 166, 120, 213, 151, 15, 49, 111, 15, 133,
+			152, 127, 134, 149, 244, 34, 40, 245, 210, 225, 29, 119, 14, 77,
+			178, 141, 180, 42, 127, 47, 43, 202, 240, 120, 74, 87, 61, 125,
+			191, 121, 215, 231, 169, 122, 67, 53, 131, 30, 2, 11, 11, 27,
+			187, 10, 207, 127, 140, 21, 101, 29, 92, 227, 86, 95, 90, 186,
+			33, 181, 155, 11, 139, 151, 22, 27, 21, 163, 246, 61, 6, 179,
+			230, 155, 62, 159, 99, 118, 20, 250, 218, 122, 83, 221, 57, 238,
+			124, 211, 175, 55, 66, 223, 165, 21, 163, 186, 252, 49, 198, 54,
+			35, 39, 128, 113, 63, 9, 165, 170, 132, 126, 45, 171, 175, 107,
+			97, 109, 150, 217, 104, 4, 213, 115, 99, 113, 126, 97, 177, 33,
+			157, 105, 214, 26, 75, 151, 47, 47, 54, 224, 61, 3, 96, 86,
+			94, 89, 38, 96, 86, 0, 43, 72, 129, 31, 204, 63, 7, 160,
+			78, 165, 1, 239, 20, 179, 157, 166, 175, 51, 25, 236, 66, 185,
+			243, 77, 95, 86, 71, 181, 218, 15, 218, 172, 162, 200, 211, 11,
+			54, 111, 132, 190, 215, 236, 243, 23, 153, 141, 232, 16, 83, 198,
+			221, 80, 60, 220, 162, 254, 146, 23, 180, 26, 212, 136, 191, 139,
+			85, 59, 206, 29, 120, 130, 170, 72, 38, 235, 89, 104, 0, 29,
+			143, 127, 170, 227, 220, 185, 152, 86, 200, 66, 133, 196, 252, 113,
+			6, 117, 233, 58, 37, 123, 200, 178, 88, 88, 141, 61, 29, 231,
+			14, 37, 118, 64, 74, 14, 126, 152, 149, 252, 112, 115, 125, 195,
+			137, 37, 97, 153, 141, 81, 63, 220, 188, 224, 196, 46, 191, 192,
+			38, 84, 124, 202, 117, 132, 248, 8, 123, 201, 253, 201, 107, 92,
+			181, 64, 82, 241, 176, 151, 240, 23, 229, 20, 114, 112, 175, 119,
+			221, 72, 111, 56, 16, 158, 213, 56, 212, 113, 238, 228, 0, 191,
+			225, 70, 106, 179, 44, 178, 125, 144, 133, 215, 41, 217, 165, 110,
+			51, 122, 63, 16, 38, 208, 230, 26, 154, 200, 110, 106, 127, 130,
+			217, 64, 42, 104, 225, 230, 242, 194, 226, 165, 165, 229, 197, 5,
+			210, 74, 77, 92, 110, 44, 46, 46, 188, 186, 126, 97, 126, 237,
+			226, 21, 120, 108, 25, 124, 138, 237, 191, 182, 114, 121, 190, 177,
+			180, 118, 229, 250, 210, 197, 236, 23, 242, 104, 94, 94, 124, 5,
+			58, 158, 75, 75, 141, 213, 181, 138, 133, 47, 141, 249, 181, 197,
+			117, 173, 240, 183, 249, 62, 182, 119, 97, 113, 225, 38, 204, 40,
+			243, 8, 10, 83, 41, 212, 126, 221, 146, 249, 36, 118, 217, 248,
+			145, 235, 248, 29, 101, 70, 149, 133, 129, 109, 174, 94, 3, 234,
+			50, 126, 107, 121, 49, 174, 64, 202, 53, 167, 145, 150, 83, 82,
+			45, 60, 16, 169, 242, 99, 57, 166, 73, 218, 211, 65, 214, 184,
+			2, 213, 172, 38, 203, 245, 46, 81, 178, 194, 123, 237, 254, 20,
+			220, 168, 36, 195, 187, 224, 152, 142, 212, 16, 175, 135, 193, 84,
+			153, 34, 53, 148, 213, 151, 149, 128, 215, 85, 86, 229, 150, 74,
+			195, 189, 99, 8, 74, 93, 230, 196, 183, 26, 84, 143, 191, 200,
+			202, 189, 200, 95, 167, 136, 213, 83, 174, 122, 109, 179, 163, 209,
+			205, 200, 191, 132, 26, 212, 176, 212, 83, 37, 126, 113, 48, 187,
+			242, 166, 122, 1, 181, 163, 121, 46, 55, 18, 245, 144, 111, 149,
+			58, 133, 181, 43, 155, 181, 63, 107, 221, 51, 109, 53, 45, 236,
+			212, 232, 119, 114, 149, 237, 135, 95, 229, 194, 3, 174, 114, 241,
+			219, 88, 229, 35, 172, 164, 190, 197, 83, 255, 8, 199, 125, 185,
+			145, 126, 120, 232, 53, 126, 46, 203, 157, 237, 170, 199, 136, 59,
+			154, 232, 108, 113, 88, 30, 93, 187, 118, 141, 149, 116, 87, 96,
+			110, 177, 239, 186, 221, 245, 78, 38, 242, 161, 124, 29, 14, 66,
+			227, 26, 182, 245, 102, 216, 11, 18, 197, 79, 247, 234, 175, 23,
+			241, 17, 71, 214, 88, 110, 152, 93, 19, 91, 211, 183, 118, 154,
+			14, 58, 114, 219, 49, 222, 161, 64, 197, 191, 30, 185, 155, 238,
+			157, 110, 172, 162, 147, 140, 225, 91, 67, 126, 226, 103, 216, 254,
+			124, 149, 117, 247, 78, 211, 239, 181, 192, 133, 81, 149, 231, 170,
+			46, 202, 95, 106, 175, 178, 61, 121, 162, 134, 107, 123, 135, 252,
+			138, 20, 56, 250, 209, 156, 74, 139, 174, 242, 230, 244, 34, 31,
+			158, 127, 138, 133, 175, 199, 110, 83, 153, 19, 152, 250, 180, 234,
+			54, 107, 127, 222, 96, 19, 67, 20, 143, 238, 241, 46, 73, 189,
+			35, 71, 110, 22, 42, 225, 187, 218, 60, 42, 99, 146, 44, 65,
+			190, 166, 61, 162, 222, 96, 151, 27, 186, 136, 72, 45, 89, 60,
+			32, 53, 193, 221, 18, 106, 231, 115, 118, 127, 210, 100, 227, 152,
+			229, 130, 219, 126, 37, 66, 10, 201, 40, 165, 33, 227, 237, 240,
+			9, 243, 219, 227, 19, 246, 219, 225, 19, 121, 42, 46, 60, 12,
+			21, 95, 181, 75, 86, 197, 190, 144, 252, 248, 31, 30, 127, 55,
+			123, 81, 235, 94, 84, 3, 8, 253, 117, 167, 219, 85, 250, 151,
+			248, 180, 142, 151, 123, 30, 191, 156, 202, 13, 208, 222, 124, 237,
+			236, 67, 95, 25, 174, 254, 227, 191, 110, 200, 220, 38, 63, 98,
+			223, 51, 183, 201, 51, 239, 104, 135, 223, 209, 14, 63, 148, 118,
+			184, 50, 114, 88, 169, 132, 249, 200, 21, 173, 18, 86, 127, 66,
+			101, 188, 127, 228, 133, 44, 183, 201, 12, 43, 163, 170, 125, 104,
+			228, 176, 137, 248, 117, 165, 223, 252, 5, 91, 149, 58, 82, 131,
+			252, 200, 200, 83, 70, 213, 17, 3, 215, 205, 52, 251, 177, 163,
+			85, 196, 152, 143, 163, 163, 128, 65, 211, 41, 67, 199, 234, 80,
+			122, 136, 164, 24, 103, 177, 28, 25, 20, 83, 20, 32, 85, 237,
+			42, 149, 80, 16, 42, 195, 71, 74, 7, 228, 223, 101, 110, 61,
+			106, 238, 165, 216, 190, 35, 229, 17, 110, 61, 58, 182, 7, 26,
+			54, 123, 164, 60, 98, 12, 148, 76, 89, 162, 70, 140, 91, 194,
+			124, 68, 54, 98, 200, 174, 50, 118, 140, 98, 235, 145, 2, 242,
+			184, 121, 168, 90, 167, 64, 133, 136, 46, 39, 98, 151, 18, 156,
+			16, 104, 114, 66, 45, 157, 247, 53, 3, 75, 41, 176, 109, 110,
+			29, 207, 84, 221, 69, 110, 29, 87, 17, 161, 165, 102, 242, 248,
+			4, 215, 37, 139, 91, 199, 15, 28, 100, 87, 117, 88, 236, 39,
+			204, 71, 171, 239, 214, 17, 117, 7, 6, 86, 135, 32, 150, 250,
+			65, 96, 64, 132, 224, 39, 82, 24, 140, 34, 183, 158, 24, 211,
+			163, 66, 3, 249, 68, 154, 97, 25, 177, 129, 159, 56, 246, 72,
+			22, 99, 123, 218, 60, 87, 125, 94, 100, 143, 55, 40, 142, 118,
+			47, 160, 188, 19, 208, 140, 33, 78, 154, 223, 137, 7, 40, 78,
+			69, 248, 79, 135, 55, 149, 142, 89, 149, 72, 203, 172, 181, 253,
+			152, 231, 52, 215, 195, 155, 22, 183, 166, 143, 61, 162, 75, 37,
+			110, 77, 63, 58, 71, 113, 143, 71, 76, 179, 132, 31, 197, 89,
+			246, 167, 12, 157, 218, 250, 164, 89, 175, 246, 197, 224, 45, 120,
+			16, 79, 30, 133, 6, 77, 220, 232, 182, 227, 199, 162, 213, 131,
+			44, 165, 162, 202, 237, 130, 56, 166, 49, 135, 24, 186, 50, 254,
+			185, 204, 166, 166, 130, 253, 122, 145, 208, 156, 57, 77, 184, 109,
+			90, 54, 0, 73, 75, 69, 110, 157, 28, 211, 214, 11, 236, 148,
+			147, 213, 19, 186, 68, 105, 85, 78, 177, 95, 180, 101, 64, 241,
+			231, 71, 54, 140, 234, 223, 177, 135, 39, 144, 237, 16, 153, 103,
+			58, 157, 200, 76, 24, 184, 167, 232, 47, 228, 64, 129, 54, 3,
+			211, 57, 161, 20, 235, 81, 24, 48, 145, 120, 205, 91, 113, 74,
+			154, 20, 34, 213, 217, 136, 67, 191, 151, 100, 145, 11, 99, 49,
+			227, 213, 221, 58, 181, 56, 229, 123, 183, 92, 205, 119, 192, 29,
+			78, 208, 212, 37, 63, 98, 58, 159, 61, 24, 82, 203, 109, 187,
+			17, 56, 106, 47, 72, 60, 159, 72, 125, 91, 194, 235, 202, 80,
+			146, 216, 29, 148, 86, 89, 165, 97, 217, 72, 99, 244, 74, 245,
+			41, 229, 17, 37, 184, 102, 7, 50, 137, 42, 118, 118, 115, 73,
+			45, 15, 146, 249, 107, 5, 142, 26, 130, 118, 158, 154, 78, 228,
+			250, 228, 90, 155, 159, 14, 37, 10, 168, 209, 207, 103, 207, 196,
+			233, 130, 215, 78, 48, 34, 215, 225, 221, 34, 235, 171, 163, 92,
+			116, 67, 196, 40, 86, 19, 199, 154, 59, 237, 182, 219, 84, 249,
+			99, 86, 52, 198, 37, 32, 50, 4, 179, 38, 26, 204, 98, 154,
+			52, 66, 211, 52, 206, 180, 27, 180, 166, 149, 50, 120, 54, 91,
+			32, 221, 150, 169, 6, 10, 110, 213, 70, 235, 123, 116, 195, 186,
+			88, 188, 227, 52, 17, 77, 118, 32, 18, 106, 215, 241, 162, 56,
+			141, 39, 29, 187, 154, 239, 97, 251, 62, 95, 58, 8, 6, 108,
+			27, 96, 186, 47, 152, 23, 100, 216, 113, 131, 66, 251, 191, 48,
+			186, 151, 253, 168, 193, 138, 248, 17, 140, 236, 93, 246, 222, 234,
+			247, 26, 148, 250, 92, 17, 11, 113, 219, 45, 72, 112, 1, 97,
+			216, 11, 242, 139, 139, 249, 42, 42, 168, 139, 69, 167, 185, 149,
+			210, 4, 90, 51, 236, 182, 200, 69, 196, 198, 140, 251, 188, 25,
+			110, 76, 15, 196, 154, 220, 242, 98, 202, 214, 140, 222, 5, 212,
+			78, 55, 22, 23, 84, 160, 203, 58, 163, 176, 228, 134, 202, 17,
+			240, 46, 187, 148, 149, 77, 110, 189, 107, 108, 15, 251, 235, 26,
+			122, 131, 91, 243, 246, 120, 245, 139, 15, 9, 253, 93, 9, 151,
+			73, 202, 21, 58, 220, 65, 70, 226, 152, 154, 92, 234, 102, 232,
+			251, 78, 87, 25, 0, 178, 201, 101, 20, 197, 64, 63, 94, 179,
+			15, 35, 81, 47, 238, 57, 126, 110, 70, 88, 155, 121, 187, 156,
+			149, 77, 110, 205, 239, 217, 203, 62, 174, 243, 6, 92, 54, 43,
+			213, 72, 44, 169, 88, 201, 80, 106, 105, 41, 72, 194, 63, 171,
+			227, 39, 231, 102, 150, 241, 118, 36, 175, 136, 183, 64, 148, 189,
+			174, 198, 253, 205, 37, 162, 42, 111, 32, 74, 165, 31, 110, 198,
+			117, 209, 80, 34, 145, 98, 92, 6, 153, 93, 47, 171, 56, 247,
+			50, 59, 193, 229, 242, 88, 46, 59, 193, 229, 241, 9, 22, 234,
+			236, 4, 47, 153, 147, 213, 13, 177, 10, 98, 7, 180, 196, 114,
+			28, 145, 50, 36, 133, 111, 218, 12, 49, 5, 82, 111, 92, 186,
+			120, 238, 220, 185, 23, 178, 128, 176, 179, 2, 219, 142, 137, 218,
+			220, 153, 185, 167, 79, 157, 157, 59, 53, 119, 102, 237, 204, 153,
+			243, 244, 255, 83, 103, 158, 63, 127, 230, 76, 45, 5, 13, 33,
+			248, 95, 74, 65, 3, 30, 95, 42, 235, 52, 10, 56, 162, 94,
+			218, 199, 217, 45, 2, 13, 57, 95, 204, 125, 213, 15, 137, 197,
+			160, 117, 15, 192, 176, 210, 247, 7, 235, 153, 83, 103, 206, 158,
+			58, 51, 119, 87, 176, 204, 92, 202, 116, 131, 178, 21, 172, 168,
+			172, 220, 6, 162, 211, 90, 43, 19, 21, 246, 47, 112, 58, 25,
+			40, 173, 153, 7, 171, 95, 53, 210, 124, 110, 41, 95, 87, 32,
+			14, 243, 135, 60, 242, 156, 140, 57, 179, 28, 119, 158, 21, 221,
+			48, 70, 218, 52, 181, 151, 28, 154, 134, 248, 8, 56, 197, 76,
+			236, 186, 96, 147, 233, 5, 230, 68, 58, 179, 51, 98, 78, 156,
+			20, 39, 197, 179, 160, 146, 249, 14, 158, 242, 59, 167, 175, 133,
+			241, 250, 124, 176, 233, 250, 110, 92, 35, 187, 41, 2, 110, 247,
+			197, 42, 252, 23, 91, 78, 31, 201, 98, 230, 196, 252, 245, 116,
+			238, 86, 1, 51, 210, 115, 199, 193, 182, 166, 18, 133, 27, 116,
+			176, 173, 237, 63, 192, 150, 105, 234, 54, 183, 94, 49, 103, 170,
+			243, 66, 171, 252, 176, 46, 72, 78, 131, 112, 220, 187, 77, 220,
+			119, 144, 204, 222, 105, 39, 16, 129, 137, 197, 0, 19, 233, 200,
+			118, 17, 29, 86, 117, 201, 224, 214, 43, 71, 142, 235, 146, 197,
+			173, 87, 158, 156, 102, 31, 162, 145, 11, 220, 122, 221, 220, 95,
+			125, 191, 144, 90, 238, 28, 198, 145, 213, 41, 20, 173, 80, 226,
+			173, 153, 241, 143, 29, 60, 3, 86, 58, 229, 162, 10, 184, 193,
+			174, 82, 72, 10, 69, 12, 160, 113, 80, 48, 184, 245, 186, 74,
+			137, 97, 152, 5, 139, 91, 175, 243, 73, 246, 81, 130, 164, 200,
+			45, 199, 172, 86, 187, 153, 200, 168, 147, 226, 45, 45, 100, 231,
+			115, 54, 170, 188, 164, 184, 136, 63, 171, 146, 245, 80, 46, 189,
+			217, 221, 171, 100, 41, 254, 118, 48, 5, 9, 90, 209, 198, 248,
+			105, 169, 192, 45, 71, 249, 87, 24, 148, 52, 201, 225, 7, 116,
+			201, 226, 150, 51, 117, 152, 253, 183, 8, 216, 109, 114, 123, 107,
+			164, 107, 84, 191, 100, 228, 37, 62, 47, 16, 109, 231, 118, 24,
+			1, 122, 114, 161, 104, 144, 200, 87, 151, 201, 142, 72, 199, 140,
+			41, 38, 97, 66, 41, 143, 164, 112, 40, 2, 176, 47, 202, 11,
+			24, 51, 105, 4, 79, 66, 18, 169, 54, 34, 215, 185, 37, 243,
+			62, 40, 169, 95, 113, 113, 41, 54, 164, 185, 123, 178, 135, 86,
+			234, 100, 100, 169, 71, 70, 75, 29, 126, 216, 129, 91, 42, 178,
+			58, 221, 56, 60, 179, 35, 15, 63, 147, 14, 63, 111, 116, 140,
+			132, 71, 19, 199, 9, 183, 222, 180, 39, 136, 23, 155, 234, 180,
+			121, 211, 102, 89, 217, 228, 214, 155, 123, 199, 211, 234, 72, 241,
+			98, 243, 244, 103, 176, 160, 91, 246, 222, 172, 108, 114, 235, 86,
+			101, 95, 90, 221, 228, 150, 111, 143, 167, 63, 3, 48, 95, 113,
+			126, 250, 25, 191, 239, 217, 155, 101, 103, 9, 204, 199, 85, 150,
+			19, 100, 103, 9, 164, 7, 0, 74, 6, 183, 130, 209, 49, 93,
+			178, 184, 21, 140, 235, 164, 43, 35, 37, 110, 5, 21, 68, 7,
+			47, 162, 38, 196, 225, 96, 95, 45, 75, 221, 18, 154, 179, 170,
+			38, 216, 103, 168, 232, 212, 68, 56, 111, 43, 44, 79, 234, 146,
+			197, 173, 240, 224, 33, 93, 42, 113, 43, 156, 122, 74, 245, 105,
+			160, 207, 240, 240, 73, 73, 13, 22, 183, 111, 143, 244, 255, 223,
+			67, 13, 224, 73, 183, 75, 227, 89, 94, 152, 237, 44, 47, 76,
+			1, 37, 157, 210, 5, 107, 191, 93, 222, 171, 75, 22, 183, 182,
+			43, 251, 116, 169, 196, 173, 109, 254, 4, 33, 4, 37, 252, 56,
+			249, 184, 234, 19, 25, 100, 20, 146, 45, 211, 24, 200, 53, 99,
+			80, 174, 153, 113, 149, 107, 6, 40, 191, 163, 82, 253, 200, 164,
+			49, 119, 20, 202, 45, 66, 249, 29, 133, 114, 75, 162, 252, 206,
+			225, 147, 236, 39, 129, 114, 155, 23, 62, 54, 242, 191, 25, 70,
+			245, 71, 12, 177, 54, 164, 191, 77, 185, 153, 35, 218, 189, 64,
+			50, 56, 117, 83, 110, 122, 173, 244, 92, 9, 90, 196, 107, 147,
+			80, 248, 78, 47, 104, 110, 9, 135, 13, 101, 129, 155, 85, 113,
+			172, 51, 113, 70, 89, 167, 116, 16, 254, 160, 149, 221, 160, 148,
+			189, 72, 75, 60, 177, 194, 53, 210, 221, 124, 172, 52, 37, 211,
+			221, 216, 35, 188, 240, 113, 243, 199, 117, 186, 27, 218, 122, 31,
+			31, 29, 99, 239, 70, 190, 26, 236, 53, 110, 127, 210, 176, 121,
+			245, 148, 152, 23, 93, 223, 105, 186, 91, 114, 247, 227, 212, 233,
+			5, 16, 31, 55, 3, 100, 159, 211, 162, 20, 140, 107, 117, 198,
+			38, 144, 207, 6, 205, 13, 106, 191, 55, 251, 96, 226, 67, 101,
+			31, 251, 33, 83, 141, 96, 112, 251, 79, 27, 246, 84, 245, 211,
+			166, 152, 23, 164, 220, 233, 231, 100, 180, 33, 124, 37, 206, 45,
+			149, 37, 117, 120, 114, 98, 166, 215, 37, 103, 164, 65, 195, 156,
+			212, 158, 156, 32, 196, 104, 129, 16, 60, 203, 237, 100, 73, 217,
+			2, 119, 59, 143, 98, 38, 90, 110, 228, 221, 70, 231, 94, 50,
+			144, 13, 160, 29, 133, 29, 180, 29, 200, 43, 170, 0, 16, 142,
+			31, 6, 46, 101, 37, 83, 169, 217, 152, 216, 118, 144, 23, 141,
+			116, 36, 8, 6, 42, 8, 172, 89, 17, 224, 218, 20, 169, 227,
+			128, 190, 81, 218, 57, 191, 159, 67, 28, 226, 248, 255, 105, 195,
+			158, 204, 62, 152, 248, 112, 240, 16, 251, 85, 141, 56, 147, 219,
+			127, 206, 176, 143, 85, 127, 9, 136, 243, 195, 77, 39, 242, 146,
+			173, 142, 215, 188, 31, 246, 218, 126, 24, 70, 51, 126, 184, 57,
+			3, 203, 228, 236, 242, 137, 19, 154, 82, 210, 28, 113, 177, 152,
+			73, 19, 206, 157, 37, 220, 17, 114, 31, 24, 183, 236, 174, 200,
+			125, 40, 220, 102, 57, 235, 8, 185, 179, 216, 38, 145, 43, 150,
+			233, 200, 66, 30, 25, 48, 42, 33, 3, 241, 237, 70, 240, 4,
+			249, 45, 74, 254, 168, 52, 110, 217, 101, 64, 91, 102, 149, 131,
+			27, 148, 84, 219, 57, 252, 155, 6, 97, 119, 42, 251, 64, 232,
+			62, 114, 148, 125, 143, 198, 191, 197, 237, 31, 50, 236, 3, 213,
+			63, 48, 196, 252, 221, 113, 222, 141, 188, 48, 242, 18, 239, 35,
+			110, 60, 60, 189, 29, 240, 214, 153, 230, 28, 72, 163, 234, 100,
+			9, 55, 211, 235, 79, 95, 171, 27, 55, 220, 38, 20, 105, 119,
+			233, 49, 67, 28, 132, 195, 59, 93, 47, 114, 113, 228, 203, 84,
+			61, 97, 79, 18, 229, 240, 232, 67, 233, 130, 251, 74, 171, 50,
+			104, 166, 190, 11, 186, 144, 41, 225, 135, 12, 187, 146, 125, 48,
+			241, 97, 114, 63, 178, 144, 74, 116, 217, 72, 84, 99, 31, 168,
+			254, 242, 125, 246, 249, 12, 229, 20, 114, 98, 49, 100, 65, 62,
+			161, 210, 82, 181, 228, 115, 8, 156, 80, 74, 55, 42, 37, 212,
+			142, 19, 244, 115, 148, 38, 213, 25, 27, 110, 170, 6, 82, 210,
+			162, 35, 98, 95, 102, 48, 202, 221, 49, 234, 200, 254, 168, 232,
+			121, 7, 26, 238, 110, 100, 7, 125, 49, 177, 195, 142, 174, 78,
+			57, 133, 164, 29, 105, 181, 34, 104, 64, 116, 202, 167, 116, 76,
+			220, 21, 182, 156, 30, 226, 135, 223, 101, 237, 181, 44, 9, 248,
+			177, 97, 156, 72, 184, 65, 216, 219, 220, 202, 173, 3, 84, 83,
+			63, 154, 95, 7, 219, 84, 41, 127, 190, 87, 175, 67, 129, 219,
+			95, 50, 236, 131, 213, 255, 235, 158, 100, 155, 237, 103, 13, 135,
+			170, 170, 84, 57, 242, 153, 117, 211, 241, 89, 126, 19, 99, 191,
+			37, 206, 166, 74, 120, 185, 91, 186, 227, 12, 27, 212, 77, 154,
+			218, 135, 137, 174, 211, 247, 67, 167, 37, 239, 238, 1, 110, 52,
+			146, 95, 66, 93, 17, 110, 186, 208, 64, 157, 151, 87, 12, 104,
+			240, 189, 160, 153, 232, 38, 2, 209, 141, 136, 159, 132, 219, 65,
+			62, 217, 196, 44, 9, 39, 113, 90, 49, 78, 147, 64, 107, 244,
+			20, 12, 194, 198, 190, 20, 95, 5, 19, 31, 246, 31, 96, 223,
+			155, 102, 88, 251, 105, 195, 172, 84, 63, 42, 125, 57, 105, 142,
+			194, 241, 55, 177, 167, 183, 58, 58, 45, 153, 214, 235, 231, 208,
+			217, 114, 155, 30, 169, 84, 113, 39, 128, 29, 64, 191, 169, 162,
+			189, 7, 167, 9, 129, 183, 199, 146, 86, 134, 46, 46, 67, 148,
+			175, 178, 83, 200, 156, 106, 63, 173, 211, 120, 216, 116, 184, 254,
+			180, 49, 58, 150, 203, 169, 246, 211, 198, 248, 4, 142, 86, 149,
+			83, 237, 43, 134, 57, 93, 253, 140, 41, 200, 101, 35, 206, 113,
+			202, 65, 169, 34, 78, 179, 249, 170, 12, 186, 105, 90, 47, 117,
+			161, 193, 220, 178, 198, 90, 228, 128, 175, 210, 237, 252, 234, 210,
+			83, 8, 74, 197, 10, 182, 243, 97, 202, 27, 29, 170, 20, 32,
+			177, 75, 30, 191, 179, 195, 120, 74, 201, 110, 91, 41, 30, 181,
+			142, 170, 25, 194, 25, 58, 113, 253, 254, 44, 232, 136, 146, 181,
+			8, 47, 14, 166, 19, 166, 179, 75, 3, 253, 170, 43, 225, 4,
+			125, 236, 111, 248, 195, 246, 183, 157, 254, 16, 70, 207, 166, 56,
+			68, 190, 156, 175, 232, 124, 57, 54, 4, 108, 251, 43, 70, 169,
+			166, 139, 148, 40, 232, 137, 39, 217, 95, 151, 56, 52, 185, 253,
+			115, 134, 121, 164, 250, 151, 83, 28, 166, 172, 70, 13, 156, 242,
+			153, 110, 47, 201, 78, 189, 12, 39, 58, 121, 42, 0, 186, 4,
+			180, 72, 39, 239, 89, 141, 16, 66, 15, 38, 114, 86, 98, 0,
+			233, 219, 36, 153, 235, 153, 41, 89, 112, 23, 250, 86, 130, 187,
+			244, 116, 78, 19, 100, 138, 214, 70, 95, 228, 253, 105, 52, 254,
+			104, 59, 209, 233, 64, 104, 20, 219, 174, 239, 159, 26, 208, 162,
+			59, 201, 144, 28, 55, 128, 195, 51, 103, 206, 136, 153, 52, 7,
+			207, 199, 222, 45, 122, 1, 113, 47, 183, 117, 34, 69, 47, 210,
+			0, 253, 92, 134, 94, 28, 163, 63, 103, 148, 14, 234, 162, 133,
+			226, 225, 42, 251, 95, 37, 122, 45, 110, 255, 162, 97, 30, 168,
+			254, 115, 83, 192, 45, 74, 107, 208, 82, 97, 70, 128, 179, 168,
+			244, 76, 164, 96, 30, 144, 115, 244, 124, 238, 139, 218, 185, 89,
+			137, 91, 202, 232, 43, 206, 9, 156, 128, 97, 91, 60, 191, 147,
+			183, 65, 128, 220, 160, 189, 186, 161, 176, 178, 59, 31, 147, 76,
+			112, 55, 119, 38, 137, 57, 90, 24, 54, 188, 50, 57, 93, 122,
+			14, 205, 113, 93, 92, 87, 186, 97, 223, 137, 244, 161, 173, 119,
+			207, 217, 250, 153, 51, 103, 206, 50, 226, 26, 65, 79, 42, 128,
+			124, 156, 16, 27, 158, 239, 37, 72, 176, 235, 196, 138, 203, 228,
+			180, 132, 18, 221, 86, 129, 240, 171, 23, 3, 135, 244, 47, 34,
+			129, 150, 42, 18, 246, 39, 247, 179, 255, 32, 23, 195, 230, 246,
+			191, 48, 204, 211, 213, 175, 153, 200, 225, 38, 53, 62, 242, 240,
+			113, 134, 17, 165, 236, 168, 77, 7, 186, 227, 7, 160, 108, 40,
+			165, 114, 43, 144, 161, 27, 39, 122, 254, 180, 147, 243, 204, 201,
+			158, 104, 9, 41, 217, 21, 42, 122, 152, 20, 194, 117, 22, 202,
+			123, 108, 131, 22, 3, 182, 243, 120, 86, 168, 79, 182, 156, 96,
+			104, 127, 72, 162, 198, 70, 134, 180, 112, 219, 21, 161, 159, 154,
+			30, 148, 21, 86, 65, 190, 229, 14, 227, 34, 150, 234, 33, 164,
+			62, 140, 194, 109, 34, 12, 208, 215, 70, 216, 219, 177, 131, 158,
+			19, 45, 167, 175, 83, 119, 217, 200, 10, 8, 140, 87, 117, 209,
+			192, 2, 28, 57, 169, 139, 22, 138, 167, 234, 236, 111, 202, 229,
+			41, 112, 251, 87, 192, 206, 191, 44, 151, 231, 1, 197, 157, 29,
+			194, 201, 125, 87, 235, 28, 230, 187, 139, 80, 67, 11, 185, 21,
+			246, 162, 140, 79, 49, 109, 51, 0, 61, 70, 9, 244, 142, 36,
+			140, 158, 27, 0, 12, 66, 87, 208, 215, 141, 181, 129, 103, 104,
+			209, 88, 126, 139, 220, 109, 209, 242, 46, 129, 121, 130, 39, 162,
+			25, 250, 81, 34, 17, 137, 175, 126, 37, 219, 1, 56, 238, 127,
+			37, 227, 246, 5, 11, 197, 39, 158, 148, 138, 95, 219, 44, 114,
+			251, 107, 134, 121, 182, 250, 63, 25, 36, 14, 106, 243, 142, 102,
+			75, 90, 112, 84, 8, 233, 41, 211, 66, 126, 96, 5, 186, 62,
+			243, 239, 46, 58, 222, 133, 102, 119, 159, 61, 123, 187, 211, 47,
+			202, 25, 105, 10, 67, 98, 173, 175, 25, 71, 102, 117, 209, 66,
+			241, 244, 25, 246, 231, 161, 191, 40, 240, 226, 215, 141, 145, 127,
+			96, 26, 213, 79, 80, 198, 191, 84, 8, 142, 51, 6, 24, 185,
+			155, 61, 36, 241, 199, 146, 167, 102, 125, 253, 98, 40, 181, 109,
+			172, 246, 180, 137, 54, 234, 5, 200, 10, 136, 14, 180, 38, 61,
+			140, 52, 165, 170, 253, 35, 145, 72, 110, 1, 234, 139, 74, 176,
+			135, 197, 250, 58, 146, 47, 34, 249, 95, 161, 204, 237, 183, 12,
+			115, 47, 37, 255, 43, 148, 71, 80, 26, 83, 169, 54, 225, 25,
+			48, 80, 52, 85, 81, 55, 252, 247, 200, 196, 39, 27, 26, 40,
+			141, 141, 171, 154, 198, 80, 209, 84, 69, 36, 66, 43, 64, 247,
+			241, 187, 144, 253, 54, 118, 53, 243, 0, 7, 51, 202, 198, 163,
+			229, 63, 133, 4, 28, 135, 146, 167, 119, 112, 68, 137, 233, 15,
+			125, 240, 204, 169, 23, 230, 79, 189, 230, 156, 250, 200, 250, 235,
+			167, 94, 175, 139, 215, 79, 188, 62, 243, 93, 127, 226, 236, 236,
+			217, 51, 103, 62, 250, 248, 180, 90, 47, 153, 140, 244, 119, 117,
+			250, 177, 2, 9, 120, 191, 107, 148, 199, 116, 209, 194, 175, 227,
+			19, 236, 171, 166, 206, 70, 250, 251, 134, 57, 89, 253, 138, 41,
+			21, 121, 131, 48, 58, 82, 197, 71, 54, 126, 205, 13, 114, 48,
+			230, 69, 215, 249, 139, 215, 226, 33, 144, 223, 248, 208, 7, 157,
+			83, 31, 57, 115, 234, 133, 245, 215, 235, 175, 159, 58, 13, 88,
+			159, 6, 172, 111, 192, 158, 140, 147, 202, 75, 220, 8, 118, 249,
+			218, 251, 162, 48, 76, 106, 248, 92, 123, 159, 140, 87, 87, 171,
+			179, 52, 7, 185, 155, 204, 234, 228, 186, 196, 93, 114, 117, 24,
+			141, 43, 156, 56, 14, 155, 158, 163, 121, 22, 25, 25, 0, 178,
+			178, 255, 209, 49, 28, 187, 93, 100, 96, 113, 145, 235, 47, 80,
+			110, 11, 117, 188, 231, 129, 207, 9, 93, 222, 156, 68, 32, 239,
+			60, 93, 145, 53, 39, 220, 114, 212, 142, 210, 207, 187, 116, 218,
+			63, 205, 130, 11, 36, 13, 254, 126, 134, 112, 208, 195, 239, 27,
+			101, 69, 15, 36, 13, 254, 190, 177, 143, 179, 127, 9, 93, 89,
+			193, 52, 185, 253, 5, 19, 150, 161, 189, 121, 203, 144, 78, 27,
+			41, 175, 116, 33, 100, 105, 77, 32, 64, 234, 60, 254, 208, 83,
+			75, 183, 193, 253, 108, 237, 67, 118, 111, 105, 12, 135, 13, 186,
+			166, 46, 80, 53, 177, 209, 75, 18, 24, 104, 2, 113, 115, 9,
+			3, 169, 128, 114, 110, 75, 179, 142, 118, 58, 28, 220, 6, 124,
+			191, 23, 39, 64, 34, 161, 68, 177, 255, 248, 4, 229, 231, 60,
+			37, 106, 39, 197, 25, 50, 52, 157, 20, 39, 107, 231, 119, 245,
+			47, 152, 197, 80, 142, 126, 214, 156, 189, 120, 222, 232, 179, 212,
+			215, 11, 174, 116, 155, 94, 178, 213, 219, 160, 87, 140, 155, 97,
+			180, 229, 249, 254, 105, 116, 231, 222, 233, 70, 210, 210, 5, 73,
+			182, 21, 54, 101, 242, 88, 82, 140, 69, 176, 222, 34, 234, 212,
+			137, 186, 236, 11, 236, 23, 141, 132, 116, 160, 147, 71, 142, 147,
+			36, 176, 172, 0, 199, 234, 188, 1, 110, 112, 234, 100, 247, 245,
+			78, 72, 129, 43, 4, 50, 54, 163, 35, 186, 228, 147, 162, 139,
+			188, 189, 110, 174, 93, 20, 77, 63, 108, 222, 58, 65, 169, 158,
+			229, 146, 101, 168, 166, 172, 193, 179, 162, 139, 140, 135, 97, 47,
+			150, 93, 100, 12, 28, 27, 76, 46, 154, 186, 47, 205, 226, 54,
+			72, 193, 73, 122, 217, 5, 38, 181, 169, 207, 96, 205, 2, 247,
+			78, 34, 251, 185, 11, 248, 210, 0, 30, 139, 20, 198, 124, 166,
+			74, 40, 11, 147, 84, 89, 32, 251, 209, 208, 229, 1, 83, 233,
+			60, 227, 19, 117, 177, 10, 78, 170, 151, 247, 188, 242, 195, 19,
+			181, 51, 226, 228, 233, 115, 233, 10, 139, 83, 242, 142, 113, 142,
+			14, 229, 248, 60, 144, 120, 118, 238, 252, 153, 51, 98, 254, 186,
+			184, 185, 118, 113, 86, 156, 203, 23, 234, 245, 250, 110, 29, 213,
+			196, 169, 129, 60, 208, 16, 198, 197, 12, 76, 130, 74, 77, 228,
+			197, 234, 161, 163, 227, 159, 200, 117, 112, 246, 244, 185, 221, 225,
+			16, 27, 61, 229, 183, 131, 158, 206, 102, 32, 228, 26, 207, 205,
+			158, 61, 51, 123, 246, 249, 172, 3, 101, 237, 68, 181, 89, 113,
+			54, 3, 250, 89, 113, 99, 184, 237, 115, 3, 173, 158, 75, 171,
+			134, 184, 30, 57, 144, 206, 234, 106, 59, 156, 17, 47, 160, 166,
+			56, 123, 234, 153, 187, 89, 94, 119, 223, 37, 194, 133, 28, 138,
+			109, 166, 86, 125, 211, 129, 79, 149, 186, 215, 52, 85, 82, 115,
+			109, 252, 147, 218, 252, 165, 249, 229, 249, 156, 57, 88, 249, 96,
+			97, 3, 223, 92, 187, 168, 48, 127, 93, 17, 182, 190, 30, 19,
+			77, 16, 41, 75, 141, 9, 188, 166, 218, 97, 180, 237, 68, 82,
+			30, 104, 57, 125, 159, 188, 73, 99, 135, 84, 217, 232, 94, 65,
+			20, 229, 57, 14, 166, 62, 43, 164, 227, 169, 52, 227, 2, 174,
+			36, 114, 130, 152, 244, 108, 245, 116, 100, 29, 156, 140, 134, 150,
+			93, 13, 141, 191, 225, 52, 111, 237, 232, 222, 239, 171, 49, 20,
+			105, 147, 10, 70, 132, 77, 169, 69, 104, 186, 26, 227, 59, 93,
+			128, 206, 131, 149, 230, 201, 28, 127, 11, 71, 248, 97, 216, 157,
+			37, 229, 58, 38, 6, 175, 33, 2, 28, 253, 8, 181, 19, 240,
+			67, 214, 78, 108, 184, 109, 92, 140, 83, 202, 114, 72, 45, 77,
+			250, 250, 21, 185, 123, 149, 218, 41, 84, 123, 85, 217, 234, 83,
+			224, 154, 97, 144, 120, 65, 47, 236, 197, 126, 191, 6, 54, 64,
+			97, 94, 9, 209, 210, 119, 41, 15, 247, 172, 232, 184, 78, 160,
+			147, 182, 131, 69, 129, 53, 160, 39, 129, 25, 101, 147, 72, 47,
+			11, 144, 229, 187, 184, 96, 196, 122, 192, 20, 131, 181, 148, 23,
+			8, 47, 104, 121, 48, 163, 169, 123, 19, 58, 198, 6, 131, 69,
+			78, 75, 253, 56, 33, 28, 141, 254, 161, 219, 71, 174, 207, 244,
+			252, 195, 117, 253, 11, 102, 122, 254, 225, 186, 254, 5, 83, 229,
+			59, 45, 152, 166, 133, 226, 254, 3, 236, 191, 147, 2, 135, 197,
+			237, 47, 153, 230, 254, 234, 151, 76, 177, 160, 30, 78, 232, 60,
+			192, 96, 202, 234, 49, 133, 188, 73, 168, 211, 47, 173, 167, 160,
+			133, 200, 122, 219, 241, 85, 26, 123, 71, 180, 92, 232, 120, 90,
+			224, 132, 231, 161, 224, 105, 58, 42, 133, 109, 10, 236, 172, 240,
+			18, 150, 125, 79, 143, 138, 220, 97, 9, 199, 52, 58, 32, 189,
+			212, 248, 66, 222, 53, 202, 185, 230, 230, 18, 164, 147, 249, 27,
+			116, 74, 222, 212, 110, 207, 185, 93, 166, 84, 115, 88, 49, 92,
+			221, 176, 106, 185, 224, 7, 94, 32, 154, 80, 78, 192, 121, 122,
+			219, 33, 192, 153, 0, 43, 15, 35, 39, 242, 252, 190, 216, 244,
+			18, 17, 121, 173, 156, 96, 152, 226, 23, 55, 240, 47, 153, 74,
+			99, 87, 160, 132, 194, 95, 50, 71, 39, 116, 145, 16, 202, 39,
+			217, 37, 66, 175, 205, 237, 47, 155, 230, 236, 183, 225, 179, 41,
+			187, 181, 101, 71, 105, 17, 121, 202, 77, 45, 228, 146, 138, 248,
+			203, 230, 196, 164, 46, 34, 79, 185, 121, 240, 144, 46, 150, 80,
+			156, 122, 138, 141, 179, 34, 21, 233, 231, 195, 39, 21, 136, 5,
+			110, 255, 132, 249, 109, 185, 149, 202, 97, 10, 54, 117, 148, 22,
+			169, 223, 49, 77, 119, 144, 252, 127, 194, 228, 85, 93, 180, 80,
+			60, 246, 136, 46, 150, 80, 124, 116, 78, 129, 88, 40, 209, 207,
+			226, 44, 251, 183, 184, 197, 21, 112, 139, 251, 25, 211, 60, 81,
+			253, 39, 247, 176, 195, 130, 56, 64, 145, 91, 78, 208, 130, 35,
+			161, 23, 52, 195, 78, 238, 82, 143, 63, 97, 147, 75, 98, 165,
+			1, 13, 194, 188, 148, 145, 151, 104, 135, 148, 181, 180, 167, 197,
+			89, 152, 202, 68, 246, 186, 112, 80, 107, 55, 160, 14, 246, 130,
+			157, 96, 130, 175, 116, 192, 177, 90, 110, 226, 120, 126, 38, 175,
+			226, 66, 247, 51, 166, 202, 232, 94, 128, 63, 136, 253, 51, 230,
+			228, 227, 186, 104, 225, 215, 233, 25, 246, 255, 43, 16, 38, 70,
+			185, 253, 243, 166, 249, 88, 245, 251, 10, 98, 126, 87, 39, 150,
+			48, 21, 52, 149, 59, 138, 116, 10, 193, 69, 78, 242, 24, 114,
+			90, 132, 199, 74, 220, 107, 54, 93, 156, 105, 138, 149, 234, 61,
+			14, 134, 6, 240, 114, 153, 212, 37, 43, 237, 40, 221, 125, 234,
+			125, 139, 200, 10, 206, 166, 123, 30, 34, 83, 142, 53, 107, 206,
+			181, 209, 103, 25, 231, 82, 151, 75, 186, 49, 164, 250, 104, 213,
+			1, 124, 172, 240, 45, 215, 7, 129, 234, 118, 188, 4, 29, 145,
+			156, 164, 250, 129, 197, 208, 27, 80, 220, 197, 41, 171, 220, 232,
+			15, 93, 75, 59, 78, 208, 131, 85, 73, 73, 103, 129, 187, 13,
+			251, 18, 141, 25, 215, 197, 43, 56, 111, 129, 9, 104, 123, 36,
+			63, 139, 165, 123, 195, 224, 124, 8, 22, 133, 173, 76, 13, 67,
+			64, 229, 103, 161, 178, 122, 43, 174, 56, 120, 28, 43, 115, 2,
+			180, 96, 178, 242, 14, 157, 141, 215, 206, 225, 170, 22, 224, 240,
+			198, 185, 164, 190, 196, 66, 61, 14, 138, 69, 109, 190, 70, 219,
+			179, 118, 161, 54, 43, 229, 77, 166, 12, 47, 233, 155, 193, 243,
+			105, 165, 236, 29, 225, 121, 81, 187, 80, 211, 183, 247, 166, 210,
+			71, 7, 57, 25, 97, 35, 76, 182, 88, 54, 140, 154, 47, 224,
+			76, 211, 57, 195, 190, 77, 228, 131, 173, 131, 80, 15, 162, 217,
+			111, 250, 185, 187, 215, 168, 77, 228, 153, 22, 145, 64, 62, 227,
+			1, 72, 189, 253, 243, 38, 63, 170, 139, 72, 32, 111, 62, 42,
+			216, 159, 147, 155, 188, 196, 237, 175, 226, 42, 246, 180, 192, 139,
+			52, 156, 66, 224, 63, 18, 115, 46, 204, 67, 155, 100, 138, 199,
+			177, 215, 34, 223, 37, 200, 166, 53, 145, 56, 241, 173, 58, 155,
+			187, 42, 86, 2, 151, 230, 172, 228, 147, 1, 51, 240, 128, 171,
+			46, 122, 209, 92, 99, 27, 107, 155, 174, 91, 43, 204, 77, 166,
+			84, 36, 128, 210, 34, 114, 188, 155, 99, 154, 209, 131, 69, 125,
+			213, 228, 7, 216, 251, 8, 248, 50, 183, 255, 158, 105, 62, 90,
+			157, 19, 250, 129, 148, 214, 137, 208, 36, 146, 144, 180, 107, 164,
+			118, 129, 173, 64, 92, 89, 91, 187, 33, 154, 142, 239, 167, 195,
+			149, 139, 212, 133, 230, 233, 101, 36, 116, 55, 39, 166, 40, 161,
+			123, 193, 44, 91, 40, 30, 121, 132, 93, 161, 225, 24, 183, 127,
+			209, 52, 31, 175, 158, 23, 67, 15, 170, 134, 71, 77, 119, 67,
+			238, 157, 213, 192, 113, 134, 152, 88, 191, 104, 154, 122, 141, 24,
+			20, 202, 8, 246, 166, 138, 80, 40, 155, 226, 56, 251, 25, 232,
+			147, 138, 188, 248, 207, 204, 145, 255, 98, 26, 213, 191, 148, 50,
+			226, 148, 133, 198, 234, 242, 67, 187, 37, 37, 219, 236, 52, 87,
+			46, 178, 16, 180, 100, 27, 199, 167, 91, 109, 154, 92, 95, 25,
+			43, 200, 201, 222, 3, 106, 250, 130, 242, 146, 73, 222, 32, 157,
+			97, 212, 121, 188, 29, 70, 126, 107, 192, 155, 124, 104, 36, 168,
+			137, 192, 73, 255, 153, 89, 154, 96, 63, 6, 250, 42, 66, 247,
+			243, 203, 166, 89, 169, 254, 121, 227, 143, 92, 249, 35, 150, 146,
+			105, 197, 126, 21, 167, 75, 243, 92, 9, 39, 102, 121, 165, 155,
+			166, 183, 34, 105, 138, 126, 89, 11, 110, 69, 210, 20, 253, 178,
+			169, 52, 69, 69, 50, 5, 254, 178, 169, 53, 69, 69, 136, 117,
+			191, 106, 190, 163, 41, 122, 251, 154, 162, 34, 105, 138, 126, 53,
+			67, 56, 52, 69, 191, 106, 42, 77, 81, 145, 52, 69, 191, 106,
+			238, 227, 236, 51, 146, 124, 76, 110, 127, 13, 236, 105, 251, 254,
+			138, 34, 47, 30, 82, 246, 104, 229, 113, 222, 33, 56, 147, 8,
+			60, 196, 198, 28, 16, 235, 9, 23, 231, 242, 23, 144, 20, 104,
+			136, 247, 95, 203, 128, 6, 29, 124, 77, 139, 247, 69, 18, 239,
+			191, 102, 238, 63, 192, 94, 38, 152, 45, 110, 255, 22, 164, 251,
+			43, 15, 44, 220, 175, 74, 32, 213, 79, 173, 33, 32, 53, 16,
+			144, 129, 127, 75, 203, 192, 69, 146, 129, 127, 75, 203, 192, 69,
+			146, 129, 127, 75, 203, 192, 69, 211, 230, 246, 91, 223, 190, 12,
+			92, 132, 59, 30, 58, 74, 139, 69, 244, 171, 100, 224, 34, 201,
+			192, 111, 105, 25, 184, 72, 50, 240, 91, 90, 6, 46, 146, 12,
+			252, 150, 150, 129, 139, 82, 6, 126, 75, 203, 192, 69, 224, 244,
+			27, 223, 190, 12, 92, 36, 25, 248, 27, 25, 136, 48, 85, 124,
+			67, 159, 127, 69, 146, 129, 191, 161, 101, 224, 34, 92, 148, 237,
+			111, 104, 25, 184, 72, 50, 240, 55, 180, 12, 92, 148, 50, 240,
+			55, 76, 113, 150, 253, 43, 73, 127, 69, 110, 127, 19, 50, 240,
+			255, 252, 157, 147, 129, 233, 14, 25, 65, 180, 195, 50, 103, 226,
+			135, 151, 196, 174, 223, 6, 61, 75, 105, 72, 4, 97, 112, 74,
+			109, 228, 124, 87, 93, 18, 109, 103, 153, 136, 67, 233, 4, 5,
+			245, 162, 223, 167, 107, 85, 188, 21, 246, 124, 120, 154, 226, 114,
+			5, 235, 129, 122, 152, 234, 250, 25, 186, 32, 250, 126, 83, 139,
+			190, 69, 18, 125, 191, 169, 69, 223, 34, 137, 190, 223, 52, 167,
+			103, 40, 210, 93, 209, 28, 229, 246, 127, 132, 228, 251, 140, 158,
+			190, 20, 74, 7, 28, 184, 83, 153, 107, 163, 63, 176, 13, 211,
+			17, 33, 160, 252, 199, 108, 129, 70, 11, 40, 166, 11, 4, 1,
+			229, 63, 102, 11, 52, 106, 161, 120, 76, 176, 31, 150, 43, 80,
+			226, 246, 31, 128, 3, 60, 247, 144, 2, 138, 134, 97, 238, 198,
+			183, 43, 163, 168, 233, 192, 217, 214, 79, 175, 16, 69, 146, 84,
+			254, 32, 155, 85, 201, 64, 81, 73, 42, 69, 60, 212, 179, 255,
+			0, 146, 202, 75, 52, 139, 50, 183, 255, 179, 105, 30, 173, 190,
+			91, 168, 167, 212, 233, 68, 114, 174, 23, 56, 152, 104, 239, 67,
+			169, 130, 123, 13, 20, 188, 97, 160, 155, 164, 35, 67, 104, 249,
+			207, 166, 185, 87, 23, 13, 20, 199, 15, 232, 162, 133, 226, 212,
+			17, 230, 50, 211, 30, 229, 197, 79, 90, 35, 223, 109, 33, 88,
+			157, 126, 121, 126, 47, 28, 58, 59, 197, 60, 229, 199, 228, 4,
+			116, 51, 16, 109, 231, 86, 118, 47, 208, 167, 62, 214, 240, 147,
+			86, 169, 66, 86, 165, 81, 28, 250, 159, 178, 76, 9, 208, 40,
+			29, 177, 159, 178, 148, 237, 112, 148, 142, 216, 79, 89, 202, 122,
+			62, 74, 71, 236, 167, 172, 201, 253, 170, 169, 193, 237, 79, 91,
+			230, 17, 245, 35, 14, 139, 79, 103, 77, 113, 88, 124, 218, 82,
+			94, 16, 163, 116, 88, 124, 218, 58, 92, 101, 255, 122, 15, 3,
+			179, 41, 254, 132, 53, 242, 53, 203, 168, 254, 253, 61, 26, 101,
+			52, 221, 76, 86, 130, 241, 163, 227, 38, 42, 142, 33, 173, 48,
+			162, 35, 16, 113, 72, 211, 53, 2, 35, 96, 11, 110, 211, 145,
+			172, 214, 130, 233, 197, 192, 137, 146, 239, 25, 234, 168, 116, 245,
+			50, 81, 72, 120, 109, 229, 94, 72, 106, 227, 147, 34, 114, 219,
+			211, 177, 72, 188, 174, 216, 114, 98, 209, 220, 66, 54, 230, 150,
+			122, 130, 151, 173, 53, 30, 49, 183, 212, 99, 106, 52, 57, 49,
+			43, 91, 195, 144, 211, 166, 150, 111, 66, 26, 218, 112, 161, 176,
+			140, 40, 75, 130, 52, 226, 235, 163, 49, 18, 221, 16, 97, 234,
+			194, 182, 184, 237, 185, 219, 218, 28, 239, 41, 253, 123, 162, 218,
+			56, 57, 67, 100, 54, 186, 82, 143, 14, 95, 143, 192, 85, 164,
+			79, 122, 15, 79, 3, 221, 237, 1, 4, 128, 129, 181, 90, 10,
+			66, 112, 29, 173, 83, 247, 149, 45, 99, 59, 245, 60, 35, 73,
+			136, 188, 223, 193, 196, 114, 157, 224, 6, 231, 6, 185, 73, 16,
+			86, 107, 112, 139, 128, 69, 160, 166, 252, 158, 208, 27, 70, 113,
+			98, 64, 225, 247, 83, 20, 96, 241, 136, 50, 93, 245, 102, 240,
+			108, 186, 36, 90, 162, 148, 248, 79, 85, 8, 232, 42, 241, 186,
+			202, 116, 188, 225, 110, 57, 183, 61, 188, 238, 12, 221, 24, 164,
+			159, 197, 111, 132, 11, 158, 198, 34, 216, 30, 93, 114, 180, 203,
+			4, 237, 93, 57, 203, 13, 229, 158, 75, 114, 114, 95, 180, 188,
+			86, 48, 157, 199, 133, 244, 250, 151, 54, 8, 85, 105, 90, 98,
+			211, 239, 103, 23, 252, 158, 214, 153, 70, 238, 41, 141, 168, 58,
+			67, 184, 2, 88, 163, 231, 17, 73, 122, 149, 162, 13, 158, 173,
+			139, 27, 250, 153, 80, 219, 137, 147, 83, 90, 95, 157, 209, 153,
+			164, 49, 92, 241, 195, 13, 24, 235, 148, 113, 88, 227, 152, 40,
+			75, 136, 0, 39, 8, 41, 33, 156, 64, 60, 115, 70, 248, 80,
+			138, 38, 98, 198, 105, 194, 242, 162, 76, 210, 250, 141, 168, 62,
+			138, 240, 206, 17, 103, 218, 9, 69, 56, 106, 109, 104, 213, 34,
+			55, 70, 21, 79, 242, 10, 16, 155, 90, 138, 88, 145, 23, 97,
+			156, 132, 230, 216, 105, 187, 155, 61, 64, 173, 53, 251, 29, 47,
+			134, 91, 122, 0, 121, 183, 229, 250, 222, 6, 220, 82, 92, 224,
+			133, 36, 2, 248, 241, 64, 67, 216, 237, 197, 91, 74, 1, 79,
+			126, 150, 233, 10, 37, 253, 174, 186, 193, 180, 66, 224, 159, 142,
+			81, 184, 73, 4, 195, 239, 197, 29, 121, 227, 38, 6, 136, 43,
+			185, 236, 46, 198, 129, 57, 176, 25, 150, 36, 253, 235, 215, 195,
+			126, 95, 4, 42, 102, 130, 211, 76, 176, 79, 225, 93, 217, 207,
+			109, 34, 58, 231, 113, 5, 145, 125, 57, 177, 27, 43, 84, 163,
+			31, 66, 145, 238, 33, 10, 125, 31, 189, 71, 34, 220, 14, 16,
+			62, 210, 119, 97, 30, 80, 254, 150, 201, 22, 28, 82, 211, 56,
+			19, 210, 88, 214, 246, 2, 101, 12, 201, 54, 75, 126, 55, 246,
+			98, 183, 221, 243, 243, 206, 124, 212, 127, 51, 108, 129, 116, 37,
+			152, 68, 23, 96, 219, 66, 204, 213, 197, 50, 132, 141, 168, 69,
+			11, 31, 52, 251, 2, 11, 130, 80, 101, 110, 93, 204, 107, 22,
+			145, 211, 201, 132, 237, 129, 241, 116, 4, 140, 13, 109, 16, 33,
+			165, 117, 40, 186, 81, 136, 36, 180, 240, 46, 4, 173, 147, 57,
+			195, 15, 55, 213, 126, 131, 106, 12, 230, 131, 38, 60, 32, 176,
+			207, 32, 24, 75, 109, 136, 14, 129, 160, 140, 34, 4, 171, 6,
+			153, 122, 130, 113, 38, 108, 167, 92, 37, 114, 219, 117, 56, 241,
+			96, 25, 102, 7, 64, 131, 95, 149, 27, 123, 155, 129, 54, 31,
+			105, 93, 64, 102, 128, 0, 152, 155, 48, 201, 74, 69, 14, 232,
+			32, 55, 87, 117, 176, 225, 84, 255, 9, 171, 52, 201, 206, 51,
+			219, 46, 225, 96, 251, 41, 203, 228, 213, 89, 209, 192, 185, 161,
+			220, 231, 17, 5, 88, 221, 103, 21, 12, 116, 172, 196, 30, 94,
+			252, 170, 83, 187, 68, 199, 224, 79, 89, 234, 14, 81, 162, 99,
+			240, 167, 172, 242, 94, 93, 180, 208, 117, 101, 31, 251, 95, 160,
+			114, 44, 225, 134, 241, 119, 44, 243, 72, 245, 23, 11, 162, 129,
+			51, 105, 224, 253, 220, 101, 47, 201, 244, 247, 116, 201, 75, 34,
+			167, 121, 11, 171, 74, 207, 147, 193, 39, 213, 149, 108, 224, 28,
+			194, 171, 25, 220, 240, 225, 19, 135, 19, 145, 56, 6, 201, 143,
+			162, 134, 147, 239, 52, 162, 252, 198, 167, 59, 78, 156, 128, 241,
+			226, 130, 73, 159, 193, 7, 79, 223, 62, 91, 159, 171, 159, 171,
+			101, 103, 146, 188, 77, 231, 44, 118, 132, 199, 154, 12, 39, 116,
+			190, 6, 62, 214, 246, 238, 0, 58, 98, 113, 217, 157, 16, 125,
+			234, 135, 153, 88, 27, 221, 36, 7, 194, 7, 63, 116, 250, 187,
+			158, 210, 16, 100, 63, 110, 68, 78, 208, 220, 58, 37, 193, 124,
+			189, 245, 212, 235, 245, 215, 91, 79, 213, 102, 51, 222, 9, 34,
+			217, 5, 50, 37, 23, 43, 193, 90, 221, 164, 53, 128, 4, 118,
+			234, 42, 151, 108, 135, 138, 29, 248, 14, 44, 193, 168, 21, 187,
+			65, 34, 1, 86, 8, 137, 92, 223, 117, 98, 247, 212, 235, 173,
+			167, 78, 183, 195, 112, 195, 137, 200, 152, 133, 205, 160, 92, 106,
+			103, 197, 134, 75, 174, 110, 25, 92, 67, 195, 14, 246, 84, 3,
+			233, 35, 20, 140, 50, 68, 65, 76, 37, 8, 176, 107, 104, 78,
+			238, 157, 174, 2, 44, 19, 242, 165, 134, 149, 38, 240, 33, 32,
+			203, 13, 212, 149, 254, 113, 165, 221, 85, 238, 130, 27, 46, 206,
+			233, 212, 148, 218, 75, 194, 142, 147, 72, 102, 153, 18, 13, 145,
+			144, 220, 84, 105, 48, 150, 97, 114, 9, 35, 5, 201, 172, 212,
+			237, 201, 133, 205, 220, 12, 161, 100, 105, 131, 255, 233, 67, 35,
+			236, 37, 221, 94, 146, 238, 1, 195, 38, 178, 78, 139, 5, 20,
+			213, 77, 160, 68, 62, 196, 127, 199, 226, 7, 117, 209, 194, 175,
+			135, 171, 236, 179, 227, 180, 37, 76, 110, 255, 154, 101, 30, 175,
+			254, 193, 94, 177, 162, 140, 225, 34, 31, 157, 138, 196, 184, 221,
+			194, 85, 209, 149, 5, 234, 102, 18, 251, 20, 121, 228, 223, 20,
+			195, 245, 16, 97, 154, 131, 205, 29, 231, 86, 250, 80, 79, 29,
+			118, 98, 123, 43, 132, 229, 43, 114, 93, 114, 186, 71, 44, 150,
+			94, 19, 116, 130, 149, 188, 13, 179, 86, 26, 10, 27, 190, 59,
+			10, 145, 3, 112, 6, 225, 224, 135, 20, 206, 129, 23, 19, 51,
+			234, 192, 133, 24, 136, 211, 52, 189, 136, 72, 127, 15, 39, 232,
+			103, 135, 213, 42, 181, 234, 227, 196, 38, 25, 241, 110, 189, 231,
+			8, 20, 235, 254, 96, 216, 139, 200, 241, 69, 10, 69, 56, 243,
+			192, 97, 96, 66, 189, 237, 181, 112, 38, 75, 32, 96, 152, 105,
+			41, 149, 117, 226, 247, 235, 226, 146, 58, 91, 213, 239, 179, 217,
+			115, 58, 28, 11, 192, 17, 70, 143, 243, 118, 72, 47, 0, 15,
+			215, 210, 54, 161, 119, 224, 174, 210, 209, 126, 202, 66, 172, 4,
+			34, 9, 187, 88, 78, 165, 218, 69, 135, 233, 155, 22, 114, 53,
+			165, 120, 76, 76, 116, 194, 22, 34, 4, 57, 74, 84, 128, 48,
+			41, 28, 63, 14, 113, 77, 7, 90, 226, 243, 84, 81, 186, 197,
+			58, 126, 60, 43, 139, 240, 145, 141, 197, 12, 252, 87, 209, 23,
+			221, 2, 0, 48, 0, 202, 36, 192, 19, 112, 92, 105, 201, 35,
+			10, 200, 87, 145, 216, 133, 206, 7, 33, 101, 252, 89, 209, 220,
+			234, 132, 45, 241, 212, 157, 19, 218, 161, 89, 91, 87, 21, 16,
+			162, 229, 69, 110, 51, 9, 233, 49, 219, 204, 102, 206, 252, 74,
+			187, 18, 71, 75, 7, 244, 234, 123, 77, 47, 241, 251, 164, 26,
+			29, 184, 144, 107, 178, 213, 129, 132, 8, 129, 94, 91, 82, 175,
+			215, 206, 123, 2, 75, 122, 109, 165, 75, 32, 5, 74, 69, 28,
+			57, 107, 19, 8, 108, 55, 122, 128, 64, 51, 87, 23, 243, 203,
+			11, 192, 133, 110, 144, 27, 32, 223, 136, 137, 147, 39, 227, 222,
+			134, 86, 127, 54, 157, 219, 174, 147, 200, 80, 70, 219, 39, 79,
+			210, 170, 202, 136, 89, 228, 219, 34, 37, 166, 148, 35, 61, 24,
+			121, 122, 241, 174, 167, 17, 19, 53, 231, 244, 198, 233, 230, 233,
+			250, 83, 181, 186, 10, 19, 241, 246, 217, 230, 14, 150, 121, 113,
+			254, 229, 197, 249, 181, 85, 28, 172, 103, 235, 3, 128, 157, 23,
+			181, 250, 83, 181, 156, 80, 188, 188, 178, 166, 24, 101, 198, 67,
+			112, 163, 32, 202, 132, 4, 69, 75, 129, 167, 130, 183, 28, 1,
+			199, 44, 189, 177, 17, 143, 69, 75, 124, 14, 25, 135, 244, 67,
+			159, 88, 94, 252, 180, 72, 222, 84, 62, 255, 153, 113, 181, 175,
+			94, 112, 136, 55, 242, 160, 189, 1, 185, 118, 78, 222, 30, 242,
+			210, 82, 43, 108, 42, 91, 24, 216, 119, 254, 74, 17, 139, 247,
+			188, 251, 153, 51, 26, 158, 89, 197, 13, 225, 130, 227, 196, 234,
+			200, 205, 126, 38, 74, 36, 235, 1, 44, 216, 224, 126, 89, 248,
+			150, 142, 162, 20, 108, 77, 73, 87, 57, 86, 135, 215, 136, 217,
+			149, 68, 177, 57, 80, 151, 34, 235, 89, 37, 87, 147, 140, 5,
+			181, 40, 122, 17, 189, 0, 23, 85, 210, 86, 43, 130, 39, 188,
+			202, 126, 244, 104, 56, 120, 49, 154, 18, 53, 214, 86, 22, 86,
+			102, 18, 39, 104, 69, 158, 119, 2, 142, 18, 211, 177, 142, 39,
+			65, 162, 171, 215, 233, 70, 225, 109, 165, 157, 85, 238, 125, 50,
+			79, 9, 16, 175, 111, 123, 201, 54, 238, 224, 242, 182, 69, 94,
+			90, 138, 69, 224, 138, 31, 147, 52, 66, 166, 48, 92, 217, 161,
+			75, 8, 163, 168, 47, 156, 13, 184, 68, 168, 115, 81, 9, 5,
+			50, 76, 58, 101, 13, 77, 60, 80, 150, 160, 251, 4, 252, 129,
+			165, 27, 19, 182, 76, 216, 233, 34, 114, 17, 46, 37, 250, 220,
+			132, 118, 247, 215, 178, 99, 20, 154, 212, 95, 203, 142, 81, 200,
+			142, 191, 102, 241, 71, 116, 209, 66, 241, 177, 26, 41, 88, 74,
+			208, 85, 255, 186, 101, 62, 165, 126, 180, 108, 42, 234, 158, 160,
+			98, 254, 245, 172, 39, 168, 152, 127, 221, 226, 79, 234, 34, 181,
+			61, 113, 146, 93, 102, 166, 93, 230, 197, 223, 180, 70, 126, 199,
+			50, 170, 47, 164, 214, 183, 187, 171, 91, 176, 209, 119, 49, 194,
+			65, 119, 4, 155, 219, 111, 90, 165, 253, 236, 3, 204, 182, 203,
+			16, 177, 127, 219, 50, 247, 87, 175, 10, 153, 45, 22, 139, 77,
+			118, 187, 142, 44, 74, 119, 219, 89, 185, 27, 156, 88, 212, 46,
+			47, 174, 73, 65, 241, 198, 202, 234, 90, 109, 80, 165, 143, 223,
+			148, 240, 81, 38, 1, 252, 183, 181, 0, 94, 38, 1, 252, 183,
+			173, 242, 132, 46, 90, 24, 152, 79, 178, 167, 8, 12, 40, 182,
+			45, 115, 95, 245, 24, 38, 7, 202, 136, 193, 36, 64, 77, 234,
+			29, 146, 140, 82, 33, 219, 66, 77, 245, 86, 214, 51, 228, 152,
+			183, 172, 242, 30, 93, 132, 218, 219, 154, 168, 176, 55, 169, 103,
+			147, 219, 223, 176, 204, 169, 234, 146, 80, 1, 136, 7, 66, 131,
+			36, 97, 246, 218, 57, 27, 41, 125, 196, 53, 48, 189, 103, 207,
+			136, 216, 109, 214, 89, 109, 31, 209, 245, 121, 48, 43, 186, 208,
+			167, 128, 129, 50, 190, 161, 245, 103, 101, 162, 140, 111, 224, 54,
+			163, 138, 80, 118, 91, 7, 15, 177, 21, 102, 218, 140, 23, 191,
+			105, 141, 252, 25, 219, 168, 206, 239, 48, 111, 238, 186, 164, 97,
+			59, 95, 239, 148, 116, 187, 204, 89, 2, 25, 20, 203, 86, 233,
+			16, 57, 129, 51, 172, 235, 239, 97, 93, 55, 4, 242, 39, 187,
+			145, 156, 117, 156, 228, 173, 129, 121, 115, 169, 50, 28, 169, 245,
+			6, 203, 93, 211, 247, 251, 89, 81, 107, 70, 167, 114, 149, 17,
+			105, 50, 238, 134, 9, 124, 101, 245, 122, 51, 90, 239, 223, 211,
+			171, 194, 104, 189, 127, 79, 175, 55, 35, 189, 227, 239, 89, 124,
+			146, 253, 35, 152, 246, 24, 80, 243, 135, 128, 239, 103, 77, 113,
+			129, 58, 29, 212, 4, 43, 168, 112, 245, 111, 73, 58, 144, 186,
+			209, 112, 208, 99, 24, 246, 121, 28, 112, 78, 228, 226, 104, 192,
+			85, 169, 246, 46, 217, 246, 61, 240, 150, 196, 178, 58, 186, 51,
+			101, 30, 84, 122, 41, 109, 38, 172, 167, 237, 212, 135, 247, 156,
+			191, 87, 15, 78, 160, 30, 66, 13, 181, 70, 12, 205, 122, 218,
+			69, 61, 223, 133, 244, 28, 23, 183, 207, 234, 110, 176, 6, 74,
+			96, 165, 243, 103, 208, 213, 252, 13, 50, 104, 190, 161, 180, 230,
+			218, 166, 233, 16, 204, 120, 223, 160, 130, 114, 166, 120, 199, 110,
+			248, 195, 12, 239, 216, 13, 127, 152, 225, 29, 74, 219, 63, 4,
+			222, 151, 8, 237, 8, 99, 96, 155, 7, 170, 47, 74, 98, 114,
+			163, 97, 180, 147, 3, 70, 222, 154, 170, 96, 78, 131, 59, 164,
+			227, 130, 216, 63, 105, 167, 227, 98, 69, 63, 105, 151, 43, 186,
+			104, 161, 56, 185, 159, 253, 0, 236, 10, 12, 124, 240, 51, 182,
+			249, 88, 245, 83, 134, 184, 145, 61, 188, 133, 48, 17, 109, 120,
+			73, 228, 68, 125, 81, 187, 229, 246, 207, 195, 11, 213, 173, 169,
+			216, 99, 202, 246, 168, 93, 18, 65, 2, 117, 54, 124, 168, 208,
+			41, 36, 186, 89, 167, 90, 150, 80, 217, 23, 91, 234, 40, 211,
+			118, 147, 247, 234, 9, 128, 25, 127, 198, 86, 108, 157, 17, 51,
+			254, 140, 173, 152, 49, 69, 156, 180, 63, 99, 43, 71, 14, 70,
+			204, 248, 51, 246, 163, 130, 173, 209, 116, 108, 110, 127, 214, 54,
+			143, 84, 47, 137, 53, 103, 115, 72, 93, 128, 235, 187, 152, 113,
+			226, 157, 19, 58, 129, 245, 117, 98, 40, 74, 180, 138, 86, 206,
+			73, 13, 2, 235, 223, 103, 51, 136, 236, 2, 138, 41, 68, 176,
+			254, 125, 214, 86, 247, 53, 70, 214, 191, 207, 218, 135, 171, 72,
+			130, 97, 218, 99, 188, 248, 23, 236, 145, 31, 177, 141, 234, 247,
+			24, 98, 48, 72, 46, 182, 190, 131, 176, 94, 112, 57, 82, 25,
+			116, 112, 41, 200, 14, 98, 39, 190, 21, 11, 74, 72, 161, 193,
+			210, 6, 220, 40, 179, 161, 209, 189, 0, 250, 189, 40, 0, 87,
+			144, 230, 96, 68, 89, 211, 206, 254, 218, 23, 0, 146, 56, 190,
+			187, 117, 54, 247, 198, 233, 239, 232, 255, 152, 76, 154, 3, 217,
+			53, 78, 122, 237, 182, 226, 125, 99, 6, 183, 255, 130, 93, 58,
+			40, 11, 101, 110, 255, 160, 173, 158, 220, 140, 225, 201, 205, 15,
+			218, 234, 141, 205, 88, 121, 196, 24, 44, 154, 170, 136, 195, 122,
+			12, 76, 243, 115, 182, 185, 95, 254, 72, 207, 150, 63, 167, 87,
+			99, 140, 24, 218, 231, 108, 101, 113, 26, 35, 134, 246, 57, 155,
+			35, 224, 10, 154, 26, 220, 254, 188, 109, 30, 83, 77, 141, 34,
+			21, 199, 117, 145, 126, 85, 126, 46, 99, 100, 72, 249, 188, 125,
+			228, 168, 106, 106, 114, 251, 135, 109, 179, 166, 154, 194, 15, 240,
+			135, 109, 229, 171, 50, 70, 103, 200, 15, 219, 202, 87, 101, 140,
+			108, 223, 63, 108, 139, 199, 84, 83, 56, 186, 218, 234, 157, 212,
+			152, 105, 21, 169, 184, 87, 23, 225, 21, 107, 43, 67, 213, 24,
+			81, 240, 23, 236, 169, 195, 105, 250, 144, 111, 142, 179, 243, 15,
+			28, 10, 88, 47, 252, 233, 214, 134, 202, 28, 50, 166, 63, 213,
+			91, 27, 213, 71, 135, 115, 128, 164, 193, 206, 84, 146, 144, 111,
+			51, 79, 73, 237, 195, 140, 95, 146, 143, 5, 90, 153, 203, 30,
+			63, 206, 246, 102, 58, 196, 117, 21, 103, 221, 106, 236, 201, 62,
+			46, 181, 248, 179, 172, 164, 222, 25, 180, 40, 162, 247, 216, 92,
+			117, 71, 182, 130, 53, 13, 110, 35, 173, 91, 251, 32, 59, 184,
+			115, 200, 107, 94, 156, 240, 121, 54, 150, 141, 160, 243, 232, 62,
+			90, 207, 33, 164, 190, 179, 101, 35, 223, 166, 118, 131, 241, 193,
+			24, 156, 212, 241, 121, 54, 170, 34, 55, 170, 78, 239, 159, 121,
+			68, 55, 184, 112, 238, 33, 2, 59, 107, 72, 175, 254, 38, 131,
+			111, 212, 248, 72, 253, 158, 97, 157, 159, 127, 39, 172, 243, 59,
+			97, 157, 31, 50, 172, 243, 254, 251, 132, 117, 62, 161, 195, 58,
+			31, 28, 185, 194, 190, 136, 35, 108, 132, 219, 71, 71, 30, 53,
+			170, 127, 193, 16, 59, 119, 15, 84, 252, 82, 51, 140, 195, 76,
+			6, 162, 65, 150, 51, 85, 113, 200, 108, 226, 104, 87, 31, 121,
+			116, 209, 105, 68, 150, 216, 46, 52, 17, 97, 27, 254, 63, 2,
+			174, 243, 9, 252, 173, 98, 175, 229, 238, 50, 100, 220, 112, 182,
+			83, 103, 14, 29, 240, 249, 104, 169, 202, 198, 116, 136, 230, 99,
+			42, 68, 160, 204, 39, 120, 204, 28, 213, 37, 131, 91, 199, 74,
+			7, 116, 201, 226, 214, 177, 169, 195, 236, 184, 14, 178, 252, 136,
+			121, 162, 118, 80, 158, 159, 184, 69, 59, 113, 58, 13, 166, 154,
+			24, 69, 212, 58, 162, 75, 8, 52, 125, 244, 113, 93, 178, 184,
+			245, 200, 244, 12, 123, 85, 70, 244, 61, 62, 242, 132, 81, 189,
+			190, 11, 248, 96, 40, 185, 201, 123, 65, 54, 105, 72, 73, 78,
+			124, 151, 41, 171, 201, 34, 158, 216, 241, 146, 12, 78, 109, 152,
+			35, 220, 122, 220, 172, 171, 0, 126, 72, 165, 248, 120, 26, 234,
+			15, 209, 228, 30, 31, 59, 166, 75, 6, 183, 30, 127, 228, 132,
+			46, 89, 220, 122, 124, 246, 148, 244, 172, 52, 185, 61, 59, 82,
+			55, 16, 77, 117, 39, 235, 187, 55, 164, 131, 245, 21, 148, 208,
+			50, 165, 90, 252, 161, 240, 189, 80, 239, 12, 135, 90, 214, 50,
+			59, 84, 255, 109, 111, 83, 7, 230, 233, 118, 97, 61, 87, 114,
+			143, 36, 27, 29, 232, 111, 86, 45, 54, 197, 207, 59, 101, 62,
+			167, 34, 216, 97, 254, 167, 212, 252, 77, 136, 9, 214, 169, 177,
+			147, 186, 100, 112, 235, 212, 83, 115, 186, 100, 113, 235, 212, 51,
+			207, 166, 71, 238, 159, 59, 201, 222, 247, 208, 76, 250, 180, 86,
+			68, 171, 131, 119, 159, 254, 161, 174, 127, 248, 78, 28, 191, 249,
+			210, 237, 179, 67, 99, 214, 254, 154, 125, 247, 140, 38, 7, 88,
+			241, 205, 112, 3, 167, 175, 202, 88, 245, 102, 184, 177, 212, 218,
+			121, 54, 171, 92, 67, 217, 199, 165, 22, 127, 154, 141, 42, 199,
+			130, 41, 251, 190, 71, 179, 174, 138, 60, 70, 100, 22, 95, 247,
+			2, 153, 204, 136, 146, 169, 88, 141, 61, 244, 117, 41, 160, 92,
+			70, 72, 164, 149, 120, 137, 239, 82, 138, 162, 114, 67, 22, 116,
+			38, 140, 98, 150, 9, 227, 73, 54, 161, 20, 95, 235, 27, 253,
+			245, 94, 236, 70, 42, 3, 207, 94, 245, 249, 66, 255, 102, 236,
+			70, 124, 138, 141, 42, 199, 246, 169, 50, 253, 174, 139, 124, 150,
+			217, 120, 83, 55, 53, 67, 83, 56, 152, 59, 179, 17, 54, 88,
+			225, 237, 202, 72, 131, 106, 241, 211, 172, 176, 237, 110, 244, 188,
+			169, 19, 84, 253, 80, 174, 250, 43, 238, 198, 205, 165, 172, 190,
+			172, 135, 238, 131, 48, 236, 78, 205, 237, 232, 158, 124, 140, 178,
+			238, 81, 139, 63, 147, 37, 152, 56, 39, 140, 161, 156, 105, 90,
+			13, 153, 182, 209, 117, 33, 211, 228, 174, 248, 83, 79, 239, 200,
+			77, 145, 215, 82, 164, 205, 243, 109, 46, 148, 217, 168, 138, 88,
+			84, 91, 100, 99, 170, 18, 54, 55, 68, 48, 77, 80, 74, 176,
+			169, 102, 210, 82, 74, 106, 170, 69, 150, 34, 230, 237, 201, 52,
+			95, 58, 46, 101, 154, 219, 247, 148, 105, 158, 123, 71, 166, 121,
+			71, 166, 121, 72, 153, 166, 250, 160, 50, 205, 129, 145, 43, 236,
+			155, 150, 148, 105, 106, 8, 111, 251, 27, 150, 246, 232, 76, 61,
+			5, 148, 9, 73, 197, 245, 83, 15, 198, 149, 87, 42, 217, 177,
+			6, 35, 80, 156, 192, 188, 241, 218, 72, 109, 14, 76, 4, 202,
+			131, 88, 204, 104, 221, 172, 218, 220, 242, 62, 127, 66, 197, 146,
+			71, 179, 212, 21, 64, 111, 44, 105, 58, 8, 131, 184, 215, 209,
+			43, 237, 37, 112, 81, 23, 206, 80, 228, 67, 165, 94, 217, 130,
+			71, 191, 211, 36, 63, 19, 117, 84, 230, 52, 46, 97, 59, 215,
+			153, 26, 162, 62, 232, 219, 150, 227, 30, 4, 31, 35, 125, 67,
+			172, 244, 244, 41, 92, 221, 40, 108, 245, 212, 35, 201, 129, 233,
+			96, 54, 176, 229, 165, 57, 72, 161, 212, 192, 11, 38, 165, 14,
+			72, 197, 195, 84, 6, 204, 5, 236, 162, 48, 220, 169, 116, 193,
+			134, 180, 20, 68, 67, 100, 163, 212, 96, 232, 212, 86, 100, 48,
+			235, 246, 54, 124, 175, 153, 201, 159, 114, 0, 165, 13, 97, 148,
+			79, 0, 234, 117, 29, 166, 20, 66, 98, 173, 52, 193, 126, 214,
+			208, 82, 226, 180, 89, 169, 254, 55, 134, 184, 41, 99, 110, 64,
+			22, 129, 173, 65, 70, 162, 107, 123, 110, 52, 4, 108, 38, 182,
+			106, 135, 213, 150, 219, 234, 209, 38, 85, 145, 82, 183, 224, 135,
+			2, 105, 230, 54, 4, 87, 175, 133, 55, 157, 210, 161, 8, 240,
+			194, 42, 139, 136, 68, 169, 171, 115, 22, 167, 73, 53, 33, 236,
+			110, 111, 133, 240, 227, 209, 182, 238, 129, 241, 149, 220, 90, 64,
+			14, 141, 146, 202, 161, 129, 121, 77, 171, 96, 237, 82, 166, 157,
+			30, 159, 64, 74, 99, 155, 82, 26, 207, 154, 251, 145, 210, 120,
+			97, 232, 165, 139, 58, 65, 7, 60, 123, 65, 145, 181, 26, 116,
+			114, 187, 238, 1, 204, 127, 213, 77, 6, 63, 206, 102, 47, 105,
+			51, 181, 94, 10, 41, 130, 15, 207, 166, 144, 66, 114, 157, 85,
+			65, 178, 165, 184, 60, 203, 39, 117, 10, 15, 147, 91, 115, 102,
+			181, 218, 215, 160, 238, 124, 4, 119, 55, 144, 207, 236, 132, 152,
+			189, 125, 144, 205, 2, 0, 209, 23, 6, 96, 112, 46, 189, 48,
+			32, 146, 251, 220, 212, 97, 246, 121, 75, 103, 29, 121, 183, 57,
+			83, 253, 172, 37, 82, 81, 40, 13, 173, 144, 2, 184, 13, 79,
+			87, 41, 30, 1, 154, 139, 234, 133, 136, 155, 220, 115, 173, 149,
+			141, 47, 43, 230, 226, 57, 32, 180, 13, 188, 54, 212, 6, 131,
+			73, 48, 81, 102, 198, 36, 165, 164, 217, 220, 132, 165, 249, 21,
+			94, 7, 94, 162, 229, 233, 212, 9, 84, 71, 229, 91, 83, 49,
+			17, 229, 201, 55, 61, 40, 198, 77, 103, 206, 7, 219, 20, 177,
+			91, 249, 62, 230, 252, 9, 1, 190, 131, 80, 122, 145, 11, 123,
+			189, 52, 228, 147, 155, 104, 7, 198, 18, 28, 99, 105, 174, 141,
+			212, 80, 42, 173, 183, 58, 102, 99, 230, 45, 230, 185, 49, 34,
+			119, 16, 95, 209, 126, 150, 202, 112, 173, 86, 46, 231, 125, 26,
+			185, 190, 135, 167, 36, 233, 18, 90, 69, 44, 140, 190, 164, 129,
+			237, 191, 251, 232, 113, 93, 178, 184, 245, 238, 39, 167, 217, 63,
+			129, 123, 25, 130, 136, 90, 215, 204, 35, 213, 255, 161, 0, 252,
+			193, 156, 128, 40, 201, 249, 73, 109, 187, 57, 175, 130, 124, 52,
+			195, 14, 69, 49, 72, 185, 198, 76, 59, 99, 170, 224, 99, 17,
+			249, 45, 166, 254, 197, 141, 27, 23, 65, 170, 27, 253, 236, 83,
+			142, 188, 73, 154, 72, 232, 57, 164, 31, 134, 93, 120, 83, 104,
+			8, 212, 243, 202, 184, 227, 32, 51, 201, 206, 117, 25, 112, 195,
+			80, 145, 205, 16, 164, 50, 170, 139, 151, 161, 177, 23, 97, 155,
+			237, 108, 213, 206, 63, 212, 29, 12, 156, 41, 166, 21, 181, 78,
+			139, 244, 174, 146, 11, 102, 144, 45, 39, 136, 102, 149, 150, 228,
+			65, 9, 122, 151, 158, 113, 201, 102, 20, 251, 197, 247, 220, 86,
+			206, 181, 163, 46, 86, 32, 91, 108, 123, 177, 34, 223, 108, 140,
+			28, 97, 15, 196, 56, 161, 73, 50, 144, 228, 98, 199, 211, 162,
+			176, 60, 2, 68, 11, 118, 104, 213, 54, 67, 252, 255, 199, 232,
+			222, 46, 112, 235, 90, 202, 186, 16, 150, 251, 90, 233, 160, 46,
+			89, 220, 186, 118, 184, 202, 126, 80, 114, 219, 2, 183, 222, 111,
+			78, 86, 63, 67, 25, 205, 35, 209, 142, 60, 55, 104, 193, 175,
+			23, 164, 141, 233, 14, 48, 89, 204, 112, 32, 189, 200, 205, 165,
+			28, 47, 123, 176, 195, 75, 44, 40, 79, 30, 114, 62, 148, 132,
+			42, 31, 60, 166, 240, 23, 8, 42, 125, 90, 20, 12, 110, 189,
+			95, 101, 250, 24, 193, 123, 37, 235, 253, 251, 184, 58, 215, 138,
+			220, 122, 217, 220, 87, 253, 112, 230, 0, 71, 166, 113, 223, 11,
+			110, 169, 183, 101, 93, 223, 233, 255, 209, 65, 90, 44, 0, 0,
+			13, 105, 209, 224, 214, 203, 42, 249, 199, 8, 94, 14, 89, 47,
+			79, 84, 180, 156, 49, 138, 52, 17, 199, 32, 103, 92, 202, 111,
+			58, 205, 82, 52, 167, 80, 194, 140, 74, 130, 52, 28, 148, 105,
+			54, 213, 167, 48, 37, 159, 36, 116, 243, 112, 64, 159, 17, 118,
+			157, 136, 123, 27, 187, 156, 143, 88, 166, 69, 152, 50, 69, 123,
+			183, 209, 213, 142, 208, 54, 102, 41, 228, 166, 179, 28, 45, 100,
+			41, 46, 70, 204, 81, 74, 113, 49, 165, 75, 72, 113, 113, 228,
+			40, 251, 178, 60, 10, 75, 220, 106, 155, 7, 170, 159, 183, 196,
+			146, 22, 159, 160, 14, 108, 110, 57, 94, 0, 64, 179, 61, 7,
+			92, 59, 137, 8, 35, 111, 211, 11, 224, 103, 159, 69, 234, 142,
+			65, 124, 120, 211, 73, 110, 46, 240, 243, 16, 243, 3, 98, 128,
+			23, 108, 185, 145, 94, 46, 117, 199, 215, 66, 90, 62, 106, 53,
+			6, 76, 178, 7, 104, 217, 51, 29, 157, 34, 40, 22, 94, 50,
+			11, 134, 76, 30, 80, 177, 18, 174, 211, 30, 53, 231, 201, 69,
+			23, 206, 48, 198, 134, 163, 5, 52, 29, 248, 181, 0, 44, 213,
+			1, 69, 173, 80, 207, 174, 149, 187, 19, 156, 22, 232, 22, 147,
+			190, 22, 35, 166, 145, 123, 43, 35, 157, 6, 5, 214, 26, 49,
+			173, 166, 179, 199, 243, 211, 187, 171, 201, 222, 134, 108, 83, 42,
+			96, 153, 244, 130, 150, 12, 110, 181, 203, 21, 181, 160, 72, 247,
+			208, 158, 220, 79, 79, 188, 71, 74, 148, 63, 35, 180, 170, 231,
+			145, 62, 5, 222, 141, 10, 131, 36, 157, 235, 84, 3, 4, 160,
+			200, 25, 47, 243, 187, 72, 141, 89, 130, 120, 234, 149, 38, 148,
+			110, 182, 204, 173, 55, 109, 173, 48, 45, 23, 81, 210, 249, 209,
+			202, 6, 183, 222, 172, 234, 124, 111, 101, 139, 91, 111, 62, 118,
+			92, 53, 99, 200, 184, 241, 164, 250, 137, 21, 81, 210, 10, 94,
+			134, 108, 28, 71, 132, 46, 89, 220, 186, 117, 252, 9, 213, 108,
+			12, 153, 55, 244, 104, 99, 69, 148, 244, 104, 99, 200, 202, 145,
+			142, 54, 102, 113, 203, 79, 71, 219, 195, 173, 142, 125, 82, 141,
+			182, 167, 136, 210, 81, 93, 50, 184, 213, 57, 166, 187, 220, 99,
+			113, 171, 51, 125, 66, 53, 219, 203, 173, 192, 158, 83, 205, 246,
+			34, 149, 135, 173, 193, 218, 139, 84, 30, 143, 205, 234, 18, 178,
+			117, 156, 62, 203, 230, 165, 162, 56, 25, 185, 109, 100, 15, 11,
+			181, 206, 117, 27, 56, 222, 198, 211, 115, 249, 74, 55, 187, 23,
+			165, 10, 88, 117, 177, 129, 88, 157, 148, 38, 51, 133, 112, 207,
+			20, 57, 133, 112, 111, 64, 33, 220, 83, 201, 254, 100, 146, 167,
+			222, 228, 145, 92, 146, 167, 222, 35, 143, 166, 10, 209, 191, 50,
+			201, 46, 63, 176, 134, 7, 23, 193, 211, 74, 95, 117, 186, 187,
+			145, 90, 10, 149, 94, 180, 162, 126, 170, 235, 239, 181, 115, 172,
+			112, 113, 203, 243, 91, 200, 79, 27, 247, 218, 109, 239, 78, 154,
+			183, 150, 74, 200, 221, 27, 111, 57, 103, 201, 44, 184, 167, 65,
+			127, 215, 94, 97, 165, 134, 219, 94, 237, 58, 77, 23, 237, 164,
+			251, 184, 110, 39, 75, 252, 28, 43, 53, 209, 113, 228, 6, 42,
+			23, 252, 161, 250, 240, 232, 117, 26, 186, 145, 86, 172, 189, 202,
+			38, 26, 250, 4, 238, 175, 38, 78, 226, 242, 57, 86, 140, 49,
+			80, 166, 21, 219, 209, 139, 134, 165, 161, 106, 238, 150, 91, 184,
+			246, 143, 13, 198, 22, 220, 141, 222, 166, 236, 246, 89, 86, 192,
+			237, 58, 80, 189, 138, 157, 189, 102, 149, 235, 13, 183, 221, 144,
+			213, 249, 121, 54, 170, 4, 246, 41, 243, 1, 91, 234, 6, 64,
+			85, 236, 58, 81, 115, 75, 37, 54, 86, 165, 234, 105, 102, 53,
+			220, 54, 212, 174, 145, 219, 86, 104, 180, 34, 183, 141, 6, 82,
+			62, 87, 106, 99, 85, 186, 112, 254, 181, 231, 223, 46, 69, 92,
+			253, 167, 227, 82, 245, 119, 246, 29, 213, 223, 59, 170, 191, 239,
+			168, 234, 47, 203, 82, 187, 146, 169, 254, 86, 216, 179, 82, 199,
+			119, 96, 100, 202, 168, 158, 20, 180, 227, 245, 51, 37, 242, 84,
+			37, 123, 35, 220, 85, 113, 110, 131, 189, 192, 103, 53, 167, 41,
+			58, 80, 82, 57, 99, 193, 80, 15, 154, 251, 137, 161, 74, 213,
+			203, 193, 244, 4, 5, 11, 61, 152, 42, 52, 192, 66, 15, 242,
+			73, 213, 204, 224, 214, 33, 83, 103, 54, 133, 30, 228, 80, 42,
+			153, 131, 97, 31, 42, 105, 121, 17, 122, 144, 67, 19, 21, 246,
+			154, 60, 13, 142, 140, 60, 98, 84, 151, 133, 230, 46, 0, 58,
+			119, 47, 213, 60, 75, 105, 255, 40, 208, 68, 78, 118, 114, 219,
+			185, 88, 31, 51, 146, 39, 158, 200, 29, 19, 71, 74, 149, 236,
+			152, 56, 170, 102, 37, 179, 255, 29, 85, 179, 146, 7, 195, 81,
+			53, 43, 121, 48, 28, 85, 179, 50, 160, 14, 57, 102, 62, 162,
+			126, 66, 214, 163, 99, 233, 233, 2, 211, 232, 177, 177, 138, 46,
+			161, 230, 190, 195, 186, 38, 44, 173, 71, 143, 177, 43, 210, 218,
+			248, 216, 200, 147, 70, 245, 93, 98, 136, 233, 202, 163, 142, 222,
+			109, 198, 218, 21, 42, 247, 194, 77, 5, 235, 195, 93, 73, 205,
+			8, 208, 60, 86, 58, 148, 89, 2, 107, 230, 163, 218, 190, 103,
+			163, 148, 183, 4, 214, 198, 116, 214, 43, 204, 175, 182, 191, 170,
+			75, 22, 183, 106, 199, 30, 97, 239, 210, 169, 179, 158, 48, 143,
+			84, 79, 139, 134, 219, 22, 145, 187, 233, 222, 249, 127, 216, 187,
+			150, 231, 56, 174, 181, 222, 167, 79, 143, 52, 115, 228, 215, 28,
+			189, 219, 146, 211, 234, 196, 30, 61, 70, 35, 201, 150, 109, 44,
+			249, 129, 31, 137, 99, 217, 55, 36, 227, 216, 134, 13, 170, 209,
+			76, 75, 119, 208, 104, 90, 238, 30, 217, 241, 226, 198, 220, 75,
+			81, 220, 220, 64, 177, 225, 38, 129, 109, 18, 170, 82, 44, 168,
+			98, 1, 27, 168, 162, 128, 42, 170, 216, 80, 197, 142, 53, 151,
+			98, 147, 5, 20, 252, 1, 212, 247, 157, 239, 156, 238, 150, 100,
+			73, 86, 188, 212, 110, 206, 116, 247, 233, 243, 234, 115, 190, 231,
+			239, 23, 104, 115, 159, 94, 57, 160, 187, 146, 140, 13, 145, 143,
+			81, 77, 203, 87, 118, 134, 91, 215, 198, 73, 63, 75, 84, 107,
+			138, 121, 235, 172, 28, 72, 49, 111, 157, 29, 118, 197, 7, 42,
+			92, 108, 194, 154, 99, 238, 45, 47, 217, 194, 97, 218, 163, 160,
+			179, 21, 181, 179, 238, 241, 187, 65, 7, 111, 90, 10, 87, 240,
+			68, 65, 195, 66, 20, 196, 155, 33, 110, 84, 134, 251, 106, 34,
+			15, 199, 188, 227, 112, 110, 73, 103, 210, 158, 86, 116, 76, 156,
+			67, 223, 1, 16, 28, 41, 173, 56, 90, 65, 167, 156, 94, 228,
+			38, 195, 98, 78, 242, 41, 98, 66, 195, 50, 147, 124, 170, 231,
+			68, 82, 230, 146, 79, 21, 165, 121, 28, 12, 122, 206, 128, 185,
+			12, 61, 46, 167, 30, 135, 62, 151, 123, 138, 73, 153, 75, 94,
+			238, 235, 23, 21, 205, 202, 85, 177, 135, 253, 49, 53, 225, 105,
+			67, 112, 214, 14, 172, 70, 151, 227, 156, 86, 104, 116, 57, 10,
+			51, 21, 67, 178, 5, 253, 170, 156, 236, 211, 37, 46, 121, 101,
+			112, 72, 204, 107, 166, 174, 25, 251, 180, 95, 50, 239, 49, 3,
+			187, 130, 88, 123, 112, 140, 18, 96, 101, 59, 124, 174, 235, 135,
+			185, 156, 49, 111, 131, 197, 61, 147, 161, 244, 154, 57, 57, 144,
+			162, 244, 154, 25, 118, 197, 28, 190, 205, 150, 124, 214, 30, 245,
+			223, 241, 212, 49, 235, 61, 221, 130, 80, 118, 109, 49, 142, 130,
+			78, 212, 12, 158, 5, 144, 238, 19, 235, 202, 109, 7, 158, 49,
+			165, 156, 228, 179, 180, 108, 56, 132, 153, 241, 89, 57, 164, 75,
+			92, 242, 217, 211, 35, 70, 74, 251, 110, 84, 92, 123, 109, 63,
+			220, 76, 173, 177, 209, 108, 147, 108, 118, 66, 255, 91, 193, 127,
+			247, 119, 88, 223, 216, 247, 125, 233, 210, 179, 185, 164, 64, 21,
+			252, 128, 208, 54, 247, 7, 251, 232, 221, 55, 37, 212, 250, 63,
+			235, 18, 199, 51, 159, 34, 184, 161, 131, 54, 24, 100, 148, 15,
+			62, 95, 213, 69, 144, 168, 54, 33, 7, 82, 57, 226, 243, 85,
+			42, 201, 69, 209, 211, 170, 197, 157, 229, 78, 212, 4, 231, 53,
+			223, 215, 209, 46, 90, 181, 24, 44, 91, 181, 181, 64, 190, 39,
+			4, 120, 173, 151, 17, 155, 138, 156, 244, 165, 196, 121, 139, 211,
+			89, 201, 180, 16, 157, 222, 248, 171, 90, 168, 235, 159, 242, 61,
+			113, 124, 163, 214, 174, 173, 5, 17, 85, 85, 192, 170, 198, 118,
+			173, 234, 71, 234, 78, 124, 178, 122, 140, 158, 195, 146, 156, 22,
+			82, 17, 184, 164, 17, 234, 135, 114, 30, 31, 231, 213, 162, 186,
+			146, 138, 103, 145, 85, 209, 167, 131, 107, 50, 15, 116, 29, 44,
+			102, 175, 119, 117, 199, 127, 177, 60, 47, 250, 117, 0, 210, 178,
+			169, 60, 14, 58, 67, 221, 216, 138, 94, 125, 81, 215, 247, 48,
+			232, 200, 7, 162, 143, 8, 24, 150, 53, 1, 3, 62, 146, 223,
+			215, 27, 46, 233, 57, 42, 199, 15, 131, 142, 251, 61, 19, 5,
+			51, 202, 123, 172, 136, 51, 66, 172, 5, 109, 72, 236, 111, 134,
+			160, 169, 64, 0, 70, 234, 31, 179, 50, 162, 224, 121, 179, 221,
+			56, 232, 202, 168, 226, 221, 242, 215, 197, 9, 181, 172, 154, 245,
+			245, 101, 112, 72, 28, 32, 132, 227, 24, 60, 241, 113, 179, 190,
+			14, 184, 132, 242, 156, 56, 153, 212, 208, 6, 200, 62, 140, 213,
+			224, 213, 227, 250, 182, 15, 224, 79, 255, 115, 38, 138, 59, 214,
+			5, 196, 119, 4, 81, 20, 70, 164, 84, 168, 130, 60, 45, 10,
+			13, 184, 117, 185, 21, 174, 145, 102, 145, 199, 63, 30, 132, 107,
+			242, 182, 56, 65, 159, 218, 50, 160, 203, 4, 17, 117, 121, 100,
+			47, 61, 167, 122, 156, 46, 126, 136, 143, 156, 255, 145, 200, 221,
+			132, 85, 47, 239, 136, 83, 219, 15, 74, 89, 76, 69, 76, 44,
+			133, 43, 213, 96, 213, 29, 221, 117, 137, 235, 39, 14, 23, 227,
+			240, 199, 82, 41, 58, 239, 31, 197, 109, 30, 197, 109, 190, 209,
+			184, 205, 193, 131, 198, 56, 12, 88, 247, 240, 167, 45, 249, 160,
+			117, 7, 127, 114, 201, 135, 172, 37, 252, 233, 72, 62, 108, 125,
+			36, 62, 18, 118, 151, 37, 157, 81, 203, 103, 238, 187, 30, 126,
+			54, 208, 199, 16, 24, 218, 240, 99, 128, 113, 86, 228, 250, 96,
+			147, 222, 66, 68, 54, 149, 246, 184, 205, 104, 28, 62, 111, 27,
+			46, 208, 46, 144, 195, 70, 243, 199, 197, 150, 112, 186, 80, 186,
+			28, 179, 111, 186, 63, 222, 41, 182, 42, 17, 44, 38, 60, 90,
+			116, 226, 65, 230, 144, 142, 58, 32, 68, 68, 60, 143, 8, 100,
+			121, 117, 171, 165, 156, 171, 184, 103, 172, 193, 80, 234, 143, 206,
+			107, 198, 241, 22, 230, 203, 30, 19, 57, 120, 45, 232, 5, 93,
+			40, 158, 65, 201, 150, 124, 108, 176, 164, 75, 92, 242, 177, 243,
+			55, 196, 159, 80, 96, 235, 164, 117, 141, 185, 127, 192, 188, 108,
+			243, 90, 97, 24, 7, 6, 147, 38, 78, 194, 30, 95, 160, 159,
+			63, 5, 28, 8, 86, 57, 47, 238, 68, 91, 245, 206, 86, 132,
+			109, 48, 171, 130, 124, 84, 89, 72, 19, 180, 195, 39, 40, 188,
+			222, 7, 193, 39, 29, 175, 83, 91, 91, 240, 230, 102, 73, 126,
+			135, 49, 156, 68, 159, 179, 227, 88, 32, 191, 79, 217, 115, 74,
+			126, 183, 80, 126, 159, 18, 189, 40, 128, 91, 32, 160, 131, 192,
+			221, 143, 2, 182, 69, 242, 123, 153, 120, 243, 45, 146, 223, 203,
+			133, 83, 73, 25, 4, 240, 222, 62, 243, 56, 196, 68, 58, 195,
+			230, 50, 200, 239, 211, 68, 85, 108, 145, 252, 62, 45, 250, 146,
+			50, 68, 77, 14, 14, 153, 199, 109, 201, 43, 78, 197, 92, 182,
+			65, 36, 119, 70, 147, 50, 8, 229, 103, 38, 146, 50, 136, 229,
+			229, 105, 243, 56, 151, 124, 198, 153, 51, 151, 193, 109, 59, 147,
+			122, 28, 214, 242, 204, 153, 114, 82, 134, 251, 103, 102, 205, 227,
+			32, 53, 59, 103, 204, 101, 240, 126, 205, 166, 26, 15, 254, 175,
+			89, 145, 116, 206, 225, 146, 207, 142, 140, 38, 90, 253, 5, 187,
+			55, 165, 213, 95, 32, 222, 101, 53, 104, 23, 186, 181, 227, 9,
+			244, 223, 11, 69, 153, 104, 245, 243, 182, 76, 105, 245, 243, 230,
+			49, 24, 172, 249, 238, 227, 186, 196, 37, 159, 63, 85, 164, 199,
+			108, 201, 47, 18, 89, 176, 26, 166, 139, 198, 61, 13, 85, 94,
+			28, 41, 81, 149, 16, 83, 112, 113, 114, 138, 30, 227, 146, 95,
+			74, 252, 216, 93, 80, 210, 113, 30, 48, 56, 151, 142, 105, 239,
+			30, 144, 174, 95, 26, 214, 1, 208, 142, 228, 151, 137, 238, 216,
+			2, 220, 84, 126, 217, 244, 20, 6, 229, 114, 223, 152, 46, 113,
+			201, 47, 191, 163, 173, 229, 57, 201, 175, 216, 227, 116, 41, 231,
+			64, 73, 59, 14, 192, 51, 119, 133, 116, 123, 229, 153, 187, 82,
+			212, 30, 117, 240, 204, 93, 57, 87, 162, 74, 186, 36, 95, 176,
+			111, 211, 37, 160, 30, 95, 48, 149, 116, 193, 181, 158, 9, 125,
+			141, 73, 190, 48, 121, 93, 151, 184, 228, 11, 55, 111, 81, 37,
+			221, 146, 47, 218, 83, 116, 169, 219, 129, 146, 174, 4, 124, 82,
+			139, 166, 37, 224, 147, 90, 44, 234, 174, 118, 115, 201, 23, 39,
+			38, 169, 146, 188, 228, 87, 109, 93, 127, 222, 129, 146, 174, 36,
+			223, 37, 249, 213, 30, 109, 197, 7, 63, 200, 213, 179, 87, 116,
+			137, 75, 126, 245, 234, 53, 241, 88, 153, 99, 110, 90, 239, 51,
+			119, 201, 219, 33, 228, 104, 19, 125, 64, 41, 156, 96, 150, 208,
+			48, 208, 160, 57, 84, 232, 230, 138, 222, 250, 30, 210, 94, 166,
+			77, 49, 55, 243, 195, 137, 41, 230, 22, 77, 144, 50, 197, 220,
+			202, 152, 98, 110, 145, 15, 84, 153, 98, 110, 209, 82, 68, 83,
+			204, 109, 123, 80, 155, 98, 114, 146, 223, 54, 143, 65, 253, 183,
+			11, 218, 208, 15, 250, 233, 237, 254, 1, 68, 224, 99, 182, 45,
+			249, 93, 123, 238, 252, 117, 68, 3, 36, 161, 10, 160, 213, 51,
+			173, 86, 216, 184, 4, 61, 141, 188, 44, 176, 85, 175, 108, 173,
+			153, 45, 89, 85, 13, 75, 249, 174, 173, 67, 204, 161, 73, 119,
+			207, 148, 169, 73, 176, 148, 239, 206, 204, 26, 93, 245, 235, 30,
+			113, 227, 181, 229, 169, 153, 250, 86, 20, 135, 90, 123, 147, 167,
+			244, 255, 21, 250, 223, 191, 42, 138, 41, 13, 224, 54, 254, 43,
+			199, 4, 138, 179, 203, 113, 189, 214, 110, 147, 214, 197, 171, 168,
+			107, 61, 84, 127, 45, 57, 121, 118, 202, 62, 156, 136, 247, 111,
+			5, 37, 226, 189, 125, 36, 226, 29, 137, 120, 111, 84, 196, 27,
+			222, 93, 196, 251, 87, 91, 39, 225, 188, 205, 220, 127, 176, 211,
+			208, 248, 106, 193, 167, 99, 18, 55, 107, 202, 175, 78, 24, 116,
+			49, 89, 13, 83, 207, 160, 205, 144, 128, 7, 40, 160, 49, 14,
+			162, 102, 173, 133, 188, 235, 205, 54, 70, 154, 93, 154, 71, 73,
+			39, 38, 98, 13, 232, 114, 189, 213, 68, 240, 86, 88, 216, 81,
+			80, 2, 160, 20, 12, 243, 92, 139, 154, 157, 23, 72, 219, 219,
+			9, 16, 137, 110, 193, 123, 30, 0, 184, 242, 214, 70, 224, 173,
+			68, 33, 64, 217, 209, 231, 234, 129, 185, 58, 10, 126, 39, 168,
+			195, 220, 53, 194, 231, 148, 249, 13, 89, 175, 113, 135, 160, 187,
+			32, 252, 91, 127, 108, 137, 104, 165, 87, 104, 221, 244, 120, 155,
+			96, 165, 132, 42, 108, 53, 181, 84, 36, 120, 241, 198, 187, 78,
+			248, 17, 36, 102, 141, 228, 135, 197, 4, 252, 46, 128, 37, 251,
+			184, 63, 106, 134, 113, 37, 101, 128, 164, 119, 66, 74, 171, 227,
+			88, 5, 200, 47, 234, 33, 235, 125, 193, 98, 153, 146, 173, 74,
+			191, 111, 98, 76, 125, 123, 216, 253, 132, 98, 26, 141, 9, 153,
+			246, 165, 116, 16, 193, 56, 145, 223, 134, 145, 201, 57, 154, 208,
+			131, 161, 76, 136, 4, 44, 8, 114, 51, 154, 198, 239, 221, 49,
+			12, 208, 134, 232, 19, 105, 253, 112, 75, 0, 46, 26, 125, 220,
+			193, 153, 226, 27, 239, 3, 180, 216, 207, 27, 135, 6, 152, 191,
+			7, 135, 204, 46, 253, 171, 162, 184, 254, 218, 91, 34, 26, 9,
+			205, 38, 125, 82, 255, 93, 81, 127, 239, 107, 83, 244, 255, 130,
+			137, 28, 152, 50, 118, 166, 176, 164, 210, 80, 236, 131, 167, 161,
+			148, 5, 15, 58, 181, 3, 88, 77, 224, 182, 36, 29, 197, 73,
+			167, 163, 12, 153, 156, 9, 52, 125, 28, 171, 234, 162, 191, 40,
+			10, 240, 78, 244, 212, 203, 138, 232, 130, 222, 152, 244, 137, 129,
+			148, 193, 8, 255, 199, 183, 69, 85, 186, 235, 112, 39, 206, 63,
+			147, 247, 116, 241, 200, 123, 122, 228, 61, 125, 163, 222, 211, 161,
+			125, 141, 10, 255, 77, 138, 242, 105, 235, 34, 115, 255, 131, 97,
+			168, 243, 110, 185, 18, 89, 250, 88, 8, 247, 108, 118, 144, 222,
+			40, 166, 237, 116, 51, 92, 15, 20, 194, 102, 132, 27, 27, 212,
+			67, 84, 95, 245, 112, 83, 29, 94, 59, 67, 99, 97, 59, 7,
+			11, 3, 206, 44, 4, 109, 197, 33, 156, 46, 141, 48, 133, 26,
+			150, 38, 195, 73, 112, 212, 60, 56, 165, 12, 158, 115, 235, 121,
+			237, 69, 236, 213, 158, 213, 154, 45, 180, 100, 152, 160, 51, 8,
+			172, 3, 29, 60, 92, 221, 145, 45, 112, 58, 127, 92, 252, 125,
+			106, 39, 63, 229, 254, 213, 190, 217, 2, 224, 99, 134, 158, 149,
+			17, 42, 107, 154, 76, 186, 65, 227, 77, 37, 15, 208, 78, 251,
+			250, 177, 95, 234, 16, 72, 123, 174, 253, 76, 210, 128, 127, 226,
+			164, 248, 109, 173, 227, 150, 236, 113, 247, 163, 93, 163, 218, 225,
+			245, 219, 99, 218, 95, 179, 37, 224, 75, 43, 25, 181, 22, 116,
+			149, 146, 9, 207, 6, 93, 165, 116, 174, 36, 86, 181, 218, 92,
+			182, 223, 113, 127, 203, 251, 24, 120, 172, 201, 245, 74, 109, 209,
+			3, 237, 225, 193, 9, 129, 132, 217, 128, 228, 125, 98, 61, 225,
+			81, 211, 34, 80, 99, 202, 166, 69, 48, 0, 229, 145, 183, 116,
+			9, 76, 38, 254, 219, 192, 105, 75, 42, 249, 156, 221, 235, 254,
+			116, 159, 192, 89, 168, 254, 135, 133, 205, 54, 55, 14, 22, 138,
+			202, 115, 146, 207, 153, 121, 5, 179, 192, 156, 9, 154, 5, 179,
+			192, 92, 81, 138, 7, 218, 44, 48, 111, 247, 185, 55, 188, 155,
+			6, 217, 69, 147, 41, 122, 116, 182, 121, 155, 181, 24, 206, 138,
+			103, 65, 180, 82, 235, 52, 55, 180, 232, 151, 124, 95, 230, 189,
+			96, 110, 153, 55, 66, 5, 216, 21, 230, 243, 39, 83, 118, 133,
+			121, 217, 43, 110, 40, 29, 250, 138, 181, 200, 220, 11, 158, 57,
+			52, 95, 63, 188, 237, 74, 190, 152, 132, 183, 45, 216, 35, 169,
+			240, 54, 109, 95, 96, 232, 17, 94, 32, 211, 128, 138, 98, 88,
+			40, 106, 253, 24, 84, 231, 5, 247, 180, 17, 115, 254, 243, 194,
+			97, 32, 54, 58, 79, 119, 64, 108, 116, 158, 186, 215, 15, 81,
+			81, 74, 94, 250, 225, 78, 77, 255, 146, 232, 175, 6, 181, 198,
+			135, 42, 62, 244, 54, 134, 135, 2, 212, 140, 28, 21, 130, 130,
+			70, 53, 6, 71, 161, 90, 160, 127, 238, 53, 252, 59, 162, 239,
+			1, 98, 7, 37, 218, 1, 62, 150, 36, 14, 147, 7, 71, 37,
+			14, 247, 139, 174, 102, 251, 153, 206, 39, 230, 213, 92, 179, 253,
+			236, 94, 195, 127, 36, 220, 237, 181, 196, 152, 232, 139, 117, 93,
+			22, 57, 176, 44, 104, 249, 40, 229, 86, 236, 60, 173, 236, 246,
+			246, 170, 186, 223, 159, 18, 82, 121, 58, 181, 85, 118, 143, 166,
+			249, 37, 113, 226, 126, 179, 190, 174, 30, 216, 235, 198, 223, 99,
+			98, 32, 121, 159, 118, 4, 190, 126, 175, 229, 98, 42, 115, 86,
+			9, 154, 41, 159, 101, 231, 105, 229, 189, 90, 251, 55, 182, 116,
+			22, 2, 194, 11, 38, 233, 179, 254, 31, 49, 49, 104, 136, 173,
+			94, 60, 212, 236, 94, 123, 53, 99, 82, 20, 53, 174, 104, 253,
+			197, 50, 221, 97, 227, 29, 39, 147, 11, 75, 219, 154, 204, 211,
+			77, 78, 229, 73, 59, 153, 60, 105, 63, 16, 114, 103, 123, 229,
+			160, 232, 86, 239, 81, 147, 87, 168, 194, 170, 184, 215, 136, 51,
+			89, 195, 246, 193, 179, 134, 253, 134, 232, 125, 183, 253, 116, 43,
+			216, 10, 50, 239, 121, 69, 143, 15, 251, 150, 72, 72, 141, 11,
+			142, 91, 78, 124, 136, 217, 77, 196, 122, 126, 16, 177, 222, 95,
+			35, 157, 224, 16, 175, 42, 131, 250, 177, 97, 60, 158, 175, 122,
+			147, 186, 201, 191, 35, 142, 129, 127, 25, 92, 176, 123, 189, 107,
+			84, 136, 148, 239, 86, 189, 175, 0, 255, 160, 223, 246, 112, 90,
+			200, 119, 19, 162, 91, 230, 78, 88, 127, 206, 142, 212, 144, 35,
+			53, 228, 141, 170, 33, 251, 97, 210, 188, 175, 49, 105, 250, 173,
+			37, 177, 174, 21, 146, 81, 230, 46, 123, 187, 30, 124, 105, 57,
+			187, 9, 196, 170, 29, 226, 167, 92, 197, 236, 73, 157, 73, 1,
+			210, 216, 71, 176, 29, 45, 0, 158, 121, 173, 49, 77, 255, 79,
+			171, 12, 11, 63, 163, 11, 164, 60, 71, 35, 182, 78, 131, 73,
+			71, 78, 90, 20, 57, 105, 188, 74, 92, 242, 145, 129, 65, 241,
+			143, 160, 65, 49, 233, 156, 179, 38, 152, 251, 215, 204, 219, 237,
+			208, 75, 55, 24, 115, 82, 52, 35, 184, 55, 142, 0, 152, 29,
+			64, 78, 245, 90, 20, 6, 58, 97, 178, 8, 51, 60, 160, 70,
+			197, 8, 212, 38, 219, 240, 218, 97, 123, 26, 169, 151, 65, 72,
+			6, 65, 15, 64, 35, 1, 150, 213, 124, 30, 137, 22, 2, 159,
+			222, 122, 240, 34, 61, 38, 45, 108, 105, 16, 235, 129, 0, 81,
+			236, 92, 126, 36, 17, 197, 74, 153, 16, 210, 82, 38, 132, 180,
+			148, 9, 33, 45, 165, 67, 72, 199, 141, 187, 3, 92, 104, 227,
+			36, 69, 170, 160, 209, 241, 188, 118, 119, 128, 46, 48, 94, 148,
+			226, 111, 9, 163, 102, 206, 186, 192, 220, 191, 100, 222, 171, 133,
+			141, 244, 40, 174, 55, 235, 235, 94, 184, 186, 234, 197, 32, 89,
+			215, 90, 169, 129, 138, 1, 6, 26, 246, 193, 93, 70, 108, 219,
+			104, 161, 254, 184, 90, 131, 196, 157, 45, 208, 55, 49, 167, 167,
+			253, 98, 215, 57, 76, 143, 28, 102, 15, 38, 3, 7, 157, 158,
+			203, 251, 73, 164, 234, 121, 123, 50, 21, 169, 122, 158, 100, 88,
+			21, 169, 122, 190, 199, 68, 177, 66, 246, 177, 119, 86, 151, 32,
+			251, 120, 124, 66, 252, 11, 152, 131, 185, 116, 174, 89, 55, 152,
+			251, 119, 182, 183, 83, 74, 242, 90, 97, 184, 30, 155, 116, 213,
+			52, 121, 33, 36, 128, 131, 182, 217, 8, 234, 168, 29, 161, 28,
+			14, 41, 115, 33, 50, 203, 67, 31, 222, 221, 99, 245, 104, 125,
+			26, 210, 214, 58, 157, 22, 12, 152, 226, 157, 171, 109, 110, 70,
+			225, 39, 205, 13, 69, 149, 7, 131, 11, 78, 37, 1, 192, 172,
+			97, 27, 80, 144, 59, 73, 163, 40, 110, 74, 167, 10, 225, 94,
+			177, 39, 114, 148, 222, 77, 104, 57, 110, 67, 66, 0, 120, 242,
+			200, 48, 175, 146, 242, 133, 86, 135, 70, 8, 20, 208, 104, 114,
+			152, 72, 207, 142, 138, 183, 51, 179, 3, 74, 211, 53, 66, 20,
+			226, 48, 59, 215, 105, 89, 115, 92, 214, 215, 105, 89, 115, 252,
+			190, 175, 211, 178, 86, 81, 166, 215, 101, 175, 248, 41, 224, 73,
+			56, 210, 89, 178, 30, 48, 247, 255, 108, 47, 43, 136, 106, 83,
+			137, 94, 151, 219, 6, 116, 59, 192, 131, 106, 26, 52, 246, 73,
+			64, 90, 244, 246, 39, 104, 173, 238, 50, 239, 101, 216, 21, 84,
+			30, 159, 158, 33, 52, 26, 108, 4, 128, 204, 222, 140, 55, 192,
+			188, 29, 123, 27, 193, 70, 29, 14, 52, 24, 85, 140, 23, 111,
+			160, 243, 47, 67, 241, 3, 199, 108, 98, 45, 81, 80, 92, 34,
+			221, 148, 184, 226, 61, 12, 53, 7, 93, 230, 2, 52, 219, 52,
+			50, 59, 24, 250, 13, 205, 182, 240, 208, 144, 141, 70, 237, 87,
+			119, 200, 27, 167, 141, 74, 247, 101, 175, 105, 4, 29, 116, 9,
+			177, 38, 29, 199, 129, 105, 188, 79, 211, 232, 224, 52, 222, 167,
+			105, 116, 112, 26, 239, 211, 52, 58, 56, 141, 247, 101, 47, 16,
+			193, 34, 176, 231, 199, 214, 111, 50, 208, 239, 119, 215, 18, 82,
+			104, 220, 218, 230, 145, 172, 68, 3, 29, 70, 107, 56, 69, 116,
+			247, 202, 13, 38, 213, 159, 84, 158, 160, 238, 83, 142, 73, 254,
+			49, 129, 125, 229, 160, 79, 143, 168, 79, 57, 236, 211, 35, 234,
+			83, 14, 251, 244, 136, 250, 148, 195, 62, 61, 162, 29, 55, 7,
+			155, 207, 99, 218, 113, 115, 24, 149, 254, 152, 118, 220, 28, 238,
+			184, 143, 105, 199, 205, 97, 124, 199, 99, 114, 48, 231, 192, 250,
+			242, 196, 246, 233, 18, 152, 72, 158, 152, 119, 67, 149, 79, 250,
+			71, 169, 74, 240, 244, 62, 241, 198, 196, 255, 192, 198, 212, 37,
+			157, 186, 245, 99, 230, 254, 202, 246, 94, 161, 225, 164, 135, 16,
+			7, 44, 139, 245, 144, 197, 169, 128, 227, 58, 149, 39, 153, 206,
+			58, 212, 131, 12, 16, 216, 128, 127, 130, 36, 29, 251, 143, 56,
+			128, 183, 227, 230, 68, 117, 129, 135, 11, 208, 197, 188, 42, 57,
+			204, 64, 54, 108, 123, 91, 155, 141, 90, 39, 16, 122, 223, 76,
+			84, 44, 111, 165, 22, 69, 41, 116, 144, 76, 45, 240, 73, 41,
+			8, 249, 86, 11, 63, 67, 243, 92, 51, 136, 133, 202, 40, 222,
+			141, 204, 89, 167, 142, 150, 65, 52, 52, 59, 129, 129, 58, 105,
+			102, 68, 21, 189, 111, 234, 69, 2, 249, 199, 245, 252, 91, 194,
+			67, 138, 61, 75, 242, 134, 221, 231, 247, 238, 108, 156, 58, 100,
+			144, 235, 149, 55, 104, 229, 32, 213, 43, 111, 208, 202, 65, 166,
+			87, 222, 144, 189, 226, 44, 241, 188, 242, 192, 126, 203, 31, 50,
+			71, 71, 150, 141, 90, 87, 8, 107, 42, 48, 21, 194, 154, 10,
+			10, 174, 46, 113, 201, 131, 209, 51, 226, 60, 241, 152, 242, 85,
+			187, 215, 63, 187, 253, 195, 9, 87, 189, 29, 218, 173, 174, 221,
+			198, 172, 213, 110, 93, 130, 172, 85, 90, 177, 72, 57, 202, 87,
+			139, 82, 148, 137, 113, 148, 175, 217, 253, 254, 91, 187, 229, 7,
+			39, 47, 211, 245, 130, 229, 108, 205, 180, 26, 14, 129, 53, 202,
+			134, 69, 22, 81, 190, 214, 219, 39, 254, 9, 54, 133, 110, 233,
+			60, 181, 58, 204, 253, 27, 230, 237, 212, 146, 41, 65, 7, 206,
+			123, 220, 69, 19, 172, 146, 76, 226, 142, 158, 179, 195, 139, 28,
+			187, 104, 206, 101, 97, 214, 50, 172, 189, 123, 119, 246, 90, 37,
+			16, 43, 243, 148, 78, 57, 160, 38, 228, 17, 37, 242, 116, 99,
+			102, 69, 68, 50, 8, 242, 20, 242, 136, 18, 16, 144, 166, 144,
+			71, 114, 88, 151, 184, 228, 17, 5, 81, 117, 195, 242, 136, 237,
+			9, 186, 4, 9, 19, 177, 169, 4, 140, 188, 113, 207, 105, 93,
+			130, 59, 9, 40, 17, 249, 10, 121, 92, 26, 23, 255, 5, 131,
+			155, 151, 185, 159, 88, 191, 203, 152, 251, 239, 108, 183, 46, 2,
+			204, 120, 236, 173, 53, 129, 247, 64, 247, 8, 142, 81, 132, 178,
+			41, 197, 59, 101, 137, 56, 216, 87, 136, 241, 198, 209, 250, 191,
+			115, 46, 39, 136, 212, 57, 115, 183, 240, 198, 113, 151, 130, 41,
+			69, 106, 28, 114, 82, 208, 27, 61, 172, 171, 230, 65, 64, 253,
+			196, 94, 227, 15, 97, 70, 63, 201, 159, 198, 161, 3, 6, 45,
+			254, 41, 109, 167, 121, 28, 241, 79, 105, 37, 34, 35, 22, 255,
+			148, 62, 72, 36, 196, 226, 159, 210, 86, 14, 116, 88, 252, 37,
+			141, 56, 178, 8, 241, 151, 52, 226, 121, 28, 241, 151, 52, 226,
+			200, 33, 196, 95, 210, 136, 35, 133, 16, 127, 89, 26, 23, 223,
+			193, 136, 23, 100, 215, 207, 153, 245, 135, 140, 185, 127, 198, 188,
+			157, 150, 18, 53, 226, 169, 165, 219, 8, 90, 181, 23, 89, 111,
+			181, 246, 148, 236, 187, 148, 211, 123, 106, 82, 163, 49, 149, 36,
+			21, 153, 81, 131, 75, 177, 159, 226, 68, 248, 57, 203, 195, 94,
+			66, 156, 8, 159, 49, 130, 129, 86, 60, 6, 159, 49, 194, 57,
+			87, 60, 6, 159, 49, 194, 87, 87, 60, 6, 159, 49, 130, 129,
+			46, 216, 76, 58, 191, 96, 182, 70, 252, 103, 57, 44, 106, 62,
+			0, 128, 102, 255, 5, 203, 159, 208, 69, 14, 197, 162, 164, 71,
+			109, 233, 124, 206, 236, 183, 233, 34, 112, 78, 124, 206, 8, 124,
+			186, 0, 193, 124, 206, 231, 172, 103, 80, 23, 25, 92, 29, 58,
+			163, 175, 114, 40, 142, 249, 226, 103, 112, 52, 10, 217, 245, 75,
+			102, 253, 41, 99, 238, 255, 146, 31, 13, 22, 157, 87, 15, 35,
+			149, 193, 213, 80, 66, 43, 13, 55, 14, 13, 76, 70, 10, 37,
+			226, 102, 163, 65, 254, 183, 176, 221, 1, 230, 188, 32, 242, 110,
+			126, 120, 47, 179, 224, 73, 169, 223, 54, 21, 94, 24, 1, 177,
+			13, 240, 38, 104, 133, 47, 104, 36, 248, 41, 201, 220, 150, 98,
+			225, 125, 72, 137, 13, 240, 42, 252, 162, 22, 144, 173, 132, 4,
+			107, 16, 13, 233, 212, 2, 212, 23, 244, 224, 53, 91, 74, 120,
+			143, 215, 155, 155, 155, 65, 227, 213, 51, 10, 108, 8, 191, 100,
+			121, 216, 93, 136, 13, 225, 11, 61, 163, 138, 169, 224, 11, 61,
+			163, 138, 169, 224, 11, 61, 163, 138, 169, 224, 11, 61, 163, 72,
+			84, 240, 165, 158, 81, 129, 51, 250, 165, 158, 81, 1, 203, 223,
+			249, 82, 207, 168, 2, 219, 255, 82, 207, 168, 176, 109, 233, 124,
+			197, 236, 97, 186, 8, 83, 248, 21, 179, 245, 189, 80, 241, 87,
+			236, 164, 110, 19, 0, 123, 127, 197, 6, 135, 12, 154, 251, 215,
+			12, 44, 96, 238, 247, 204, 75, 27, 226, 96, 71, 215, 42, 72,
+			106, 92, 233, 220, 44, 1, 6, 84, 216, 54, 119, 236, 255, 233,
+			36, 46, 55, 216, 217, 53, 247, 31, 70, 223, 17, 158, 12, 93,
+			19, 96, 104, 10, 106, 196, 45, 2, 127, 174, 54, 35, 157, 166,
+			136, 162, 67, 210, 48, 93, 15, 66, 214, 225, 190, 165, 185, 57,
+			177, 121, 224, 93, 172, 175, 103, 62, 71, 248, 219, 204, 29, 160,
+			185, 127, 205, 242, 125, 9, 40, 251, 55, 122, 238, 122, 112, 238,
+			190, 209, 115, 167, 64, 217, 191, 209, 115, 167, 64, 217, 191, 129,
+			185, 251, 53, 13, 202, 254, 45, 179, 7, 253, 73, 163, 22, 193,
+			194, 129, 128, 34, 32, 255, 128, 136, 74, 48, 58, 42, 74, 94,
+			213, 40, 13, 246, 14, 243, 252, 173, 158, 103, 5, 224, 254, 45,
+			203, 203, 20, 128, 251, 183, 172, 127, 96, 165, 107, 51, 10, 59,
+			225, 133, 255, 31, 0, 36, 115, 154, 32, 188, 57, 1, 0},
 	)
 }
 
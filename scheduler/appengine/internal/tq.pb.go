@@ -357,6 +357,82 @@ func (x *InvocationFinishedTask) GetTriggers() *FanOutTriggersTask {
 	return nil
 }
 
+// DependencySucceededTask is emitted when an invocation of a job that some
+// other job depends on finishes successfully.
+//
+// It is enqueued transactionally, one per dependent job. Results in an update
+// of the dependency barrier of the dependent job and, if all its dependencies
+// have succeeded within the lineage, in a new trigger for it.
+//
+// Queue: "triggers".
+type DependencySucceededTask struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobId           string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                                 // the dependent job
+	DependencyJobId string                 `protobuf:"bytes,2,opt,name=dependency_job_id,json=dependencyJobId,proto3" json:"dependency_job_id,omitempty"` // the job that succeeded
+	InvId           int64                  `protobuf:"varint,3,opt,name=inv_id,json=invId,proto3" json:"inv_id,omitempty"`                                // the invocation of dependency_job_id
+	Lineage         string                 `protobuf:"bytes,4,opt,name=lineage,proto3" json:"lineage,omitempty"`                                          // the lineage of the invocation
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DependencySucceededTask) Reset() {
+	*x = DependencySucceededTask{}
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencySucceededTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencySucceededTask) ProtoMessage() {}
+
+func (x *DependencySucceededTask) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencySucceededTask.ProtoReflect.Descriptor instead.
+func (*DependencySucceededTask) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DependencySucceededTask) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DependencySucceededTask) GetDependencyJobId() string {
+	if x != nil {
+		return x.DependencyJobId
+	}
+	return ""
+}
+
+func (x *DependencySucceededTask) GetInvId() int64 {
+	if x != nil {
+		return x.InvId
+	}
+	return 0
+}
+
+func (x *DependencySucceededTask) GetLineage() string {
+	if x != nil {
+		return x.Lineage
+	}
+	return ""
+}
+
 // FanOutTriggersTask is a batch task that emits a bunch of triggers.
 //
 // It is enqueued transactionally. It fans out into many EnqueueTriggersTask,
@@ -373,7 +449,7 @@ type FanOutTriggersTask struct {
 
 func (x *FanOutTriggersTask) Reset() {
 	*x = FanOutTriggersTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +461,7 @@ func (x *FanOutTriggersTask) String() string {
 func (*FanOutTriggersTask) ProtoMessage() {}
 
 func (x *FanOutTriggersTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +474,7 @@ func (x *FanOutTriggersTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FanOutTriggersTask.ProtoReflect.Descriptor instead.
 func (*FanOutTriggersTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FanOutTriggersTask) GetJobIds() []string {
@@ -431,7 +507,7 @@ type EnqueueTriggersTask struct {
 
 func (x *EnqueueTriggersTask) Reset() {
 	*x = EnqueueTriggersTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -443,7 +519,7 @@ func (x *EnqueueTriggersTask) String() string {
 func (*EnqueueTriggersTask) ProtoMessage() {}
 
 func (x *EnqueueTriggersTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +532,7 @@ func (x *EnqueueTriggersTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnqueueTriggersTask.ProtoReflect.Descriptor instead.
 func (*EnqueueTriggersTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{7}
+	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EnqueueTriggersTask) GetJobId() string {
@@ -489,7 +565,7 @@ type ScheduleTimersTask struct {
 
 func (x *ScheduleTimersTask) Reset() {
 	*x = ScheduleTimersTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +577,7 @@ func (x *ScheduleTimersTask) String() string {
 func (*ScheduleTimersTask) ProtoMessage() {}
 
 func (x *ScheduleTimersTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +590,7 @@ func (x *ScheduleTimersTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleTimersTask.ProtoReflect.Descriptor instead.
 func (*ScheduleTimersTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{8}
+	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ScheduleTimersTask) GetJobId() string {
@@ -555,7 +631,7 @@ type TimerTask struct {
 
 func (x *TimerTask) Reset() {
 	*x = TimerTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +643,7 @@ func (x *TimerTask) String() string {
 func (*TimerTask) ProtoMessage() {}
 
 func (x *TimerTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +656,7 @@ func (x *TimerTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerTask.ProtoReflect.Descriptor instead.
 func (*TimerTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{9}
+	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TimerTask) GetJobId() string {
@@ -621,7 +697,7 @@ type CronTickTask struct {
 
 func (x *CronTickTask) Reset() {
 	*x = CronTickTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -633,7 +709,7 @@ func (x *CronTickTask) String() string {
 func (*CronTickTask) ProtoMessage() {}
 
 func (x *CronTickTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +722,7 @@ func (x *CronTickTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CronTickTask.ProtoReflect.Descriptor instead.
 func (*CronTickTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{10}
+	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CronTickTask) GetJobId() string {
@@ -705,7 +781,16 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDesc = st
 	0x3b, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x74, 0x71, 0x2e,
 	0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x12,
+	0x73, 0x6b, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x22, 0x8d, 0x01, 0x0a,
+	0x17, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x69,
+	0x6e, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x76,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x67, 0x65, 0x22, 0x65, 0x0a, 0x12,
 	0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x54, 0x61,
 	0x73, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x09, 0x52, 0x06, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x74,
@@ -755,7 +840,7 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescGZIP
 	return file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_goTypes = []any{
 	(*ReadProjectConfigTask)(nil),      // 0: internal.tq.ReadProjectConfigTask
 	(*LaunchInvocationTask)(nil),       // 1: internal.tq.LaunchInvocationTask
@@ -763,21 +848,22 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_goTypes = []
 	(*TriageJobStateTask)(nil),         // 3: internal.tq.TriageJobStateTask
 	(*KickTriageTask)(nil),             // 4: internal.tq.KickTriageTask
 	(*InvocationFinishedTask)(nil),     // 5: internal.tq.InvocationFinishedTask
-	(*FanOutTriggersTask)(nil),         // 6: internal.tq.FanOutTriggersTask
-	(*EnqueueTriggersTask)(nil),        // 7: internal.tq.EnqueueTriggersTask
-	(*ScheduleTimersTask)(nil),         // 8: internal.tq.ScheduleTimersTask
-	(*TimerTask)(nil),                  // 9: internal.tq.TimerTask
-	(*CronTickTask)(nil),               // 10: internal.tq.CronTickTask
-	(*Trigger)(nil),                    // 11: internal.triggers.Trigger
-	(*Timer)(nil),                      // 12: internal.timers.Timer
+	(*DependencySucceededTask)(nil),    // 6: internal.tq.DependencySucceededTask
+	(*FanOutTriggersTask)(nil),         // 7: internal.tq.FanOutTriggersTask
+	(*EnqueueTriggersTask)(nil),        // 8: internal.tq.EnqueueTriggersTask
+	(*ScheduleTimersTask)(nil),         // 9: internal.tq.ScheduleTimersTask
+	(*TimerTask)(nil),                  // 10: internal.tq.TimerTask
+	(*CronTickTask)(nil),               // 11: internal.tq.CronTickTask
+	(*Trigger)(nil),                    // 12: internal.triggers.Trigger
+	(*Timer)(nil),                      // 13: internal.timers.Timer
 }
 var file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_depIdxs = []int32{
 	1,  // 0: internal.tq.LaunchInvocationsBatchTask.tasks:type_name -> internal.tq.LaunchInvocationTask
-	6,  // 1: internal.tq.InvocationFinishedTask.triggers:type_name -> internal.tq.FanOutTriggersTask
-	11, // 2: internal.tq.FanOutTriggersTask.triggers:type_name -> internal.triggers.Trigger
-	11, // 3: internal.tq.EnqueueTriggersTask.triggers:type_name -> internal.triggers.Trigger
-	12, // 4: internal.tq.ScheduleTimersTask.timers:type_name -> internal.timers.Timer
-	12, // 5: internal.tq.TimerTask.timer:type_name -> internal.timers.Timer
+	7,  // 1: internal.tq.InvocationFinishedTask.triggers:type_name -> internal.tq.FanOutTriggersTask
+	12, // 2: internal.tq.FanOutTriggersTask.triggers:type_name -> internal.triggers.Trigger
+	12, // 3: internal.tq.EnqueueTriggersTask.triggers:type_name -> internal.triggers.Trigger
+	13, // 4: internal.tq.ScheduleTimersTask.timers:type_name -> internal.timers.Timer
+	13, // 5: internal.tq.TimerTask.timer:type_name -> internal.timers.Timer
 	6,  // [6:6] is the sub-list for method output_type
 	6,  // [6:6] is the sub-list for method input_type
 	6,  // [6:6] is the sub-list for extension type_name
@@ -798,7 +884,7 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDesc), len(file_go_chromium_org_luci_scheduler_appengine_internal_tq_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -37,6 +37,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BlackoutWindow_Action int32
+
+const (
+	// Cron ticks that happen within the window are skipped. Each skipped tick
+	// is recorded in the job's invocation history with SKIPPED status.
+	BlackoutWindow_SKIP BlackoutWindow_Action = 0
+	// Cron ticks that happen within the window are deferred until the window
+	// ends. Multiple deferred ticks are collapsed by the job's triggering
+	// policy as usual.
+	BlackoutWindow_DEFER BlackoutWindow_Action = 1
+)
+
+// Enum value maps for BlackoutWindow_Action.
+var (
+	BlackoutWindow_Action_name = map[int32]string{
+		0: "SKIP",
+		1: "DEFER",
+	}
+	BlackoutWindow_Action_value = map[string]int32{
+		"SKIP":  0,
+		"DEFER": 1,
+	}
+)
+
+func (x BlackoutWindow_Action) Enum() *BlackoutWindow_Action {
+	p := new(BlackoutWindow_Action)
+	*p = x
+	return p
+}
+
+func (x BlackoutWindow_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlackoutWindow_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[0].Descriptor()
+}
+
+func (BlackoutWindow_Action) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[0]
+}
+
+func (x BlackoutWindow_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlackoutWindow_Action.Descriptor instead.
+func (BlackoutWindow_Action) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1, 0}
+}
+
 type Acl_Role int32
 
 const (
@@ -70,11 +121,11 @@ func (x Acl_Role) String() string {
 }
 
 func (Acl_Role) Descriptor() protoreflect.EnumDescriptor {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[0].Descriptor()
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[1].Descriptor()
 }
 
 func (Acl_Role) Type() protoreflect.EnumType {
-	return &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[0]
+	return &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[1]
 }
 
 func (x Acl_Role) Number() protoreflect.EnumNumber {
@@ -83,7 +134,7 @@ func (x Acl_Role) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Acl_Role.Descriptor instead.
 func (Acl_Role) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1, 0}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type TriggeringPolicy_Kind int32
@@ -107,6 +158,17 @@ const (
 	// trigger or expire. The timeout for pending triggers is specified by the
 	// pending_timeout field below.
 	TriggeringPolicy_NEWEST_FIRST TriggeringPolicy_Kind = 3
+	// A greedy triggering function (same as GREEDY_BATCHING) that additionally
+	// limits how many invocations can be started within a sliding time window.
+	// The limit is specified by max_invocations_per_window and
+	// rate_limit_window fields below. Triggers that arrive when the limit is
+	// exhausted stay pending until the window slides far enough.
+	TriggeringPolicy_RATE_LIMITED TriggeringPolicy_Kind = 4
+	// A triggering function that collapses pending triggers with identical
+	// properties and tags into a single invocation. Triggers with different
+	// payloads are never batched together: each distinct payload gets its own
+	// invocation, oldest payloads first.
+	TriggeringPolicy_DEDUPLICATING TriggeringPolicy_Kind = 5
 )
 
 // Enum value maps for TriggeringPolicy_Kind.
@@ -116,12 +178,16 @@ var (
 		1: "GREEDY_BATCHING",
 		2: "LOGARITHMIC_BATCHING",
 		3: "NEWEST_FIRST",
+		4: "RATE_LIMITED",
+		5: "DEDUPLICATING",
 	}
 	TriggeringPolicy_Kind_value = map[string]int32{
 		"UNDEFINED":            0,
 		"GREEDY_BATCHING":      1,
 		"LOGARITHMIC_BATCHING": 2,
 		"NEWEST_FIRST":         3,
+		"RATE_LIMITED":         4,
+		"DEDUPLICATING":        5,
 	}
 )
 
@@ -136,11 +202,11 @@ func (x TriggeringPolicy_Kind) String() string {
 }
 
 func (TriggeringPolicy_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[1].Descriptor()
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[2].Descriptor()
 }
 
 func (TriggeringPolicy_Kind) Type() protoreflect.EnumType {
-	return &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[1]
+	return &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes[2]
 }
 
 func (x TriggeringPolicy_Kind) Number() protoreflect.EnumNumber {
@@ -149,7 +215,7 @@ func (x TriggeringPolicy_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TriggeringPolicy_Kind.Descriptor instead.
 func (TriggeringPolicy_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{3, 0}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // ProjectConfig defines a schema for a config file that describe jobs belonging
@@ -163,9 +229,12 @@ type ProjectConfig struct {
 	// Deprecated and unused. Use realms permissions instead.
 	//
 	// Deprecated: Marked as deprecated in go.chromium.org/luci/scheduler/appengine/messages/config.proto.
-	AclSets       []*AclSet `protobuf:"bytes,3,rep,name=acl_sets,json=aclSets,proto3" json:"acl_sets,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	AclSets []*AclSet `protobuf:"bytes,3,rep,name=acl_sets,json=aclSets,proto3" json:"acl_sets,omitempty"`
+	// BlackoutWindow is a set of time intervals during which jobs defined in the
+	// project are not started by their schedules.
+	BlackoutWindow []*BlackoutWindow `protobuf:"bytes,5,rep,name=blackout_window,json=blackoutWindow,proto3" json:"blackout_window,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ProjectConfig) Reset() {
@@ -220,6 +289,132 @@ func (x *ProjectConfig) GetAclSets() []*AclSet {
 	return nil
 }
 
+func (x *ProjectConfig) GetBlackoutWindow() []*BlackoutWindow {
+	if x != nil {
+		return x.BlackoutWindow
+	}
+	return nil
+}
+
+// BlackoutWindow defines a period of time (one-time or recurring) when cron
+// ticks of jobs with absolute schedules (i.e. cron-like expressions) are either
+// skipped or deferred until the window ends.
+//
+// Jobs can still be triggered by other jobs, via the API or the UI during
+// a blackout window. Jobs with relative schedules (e.g. "with 10s interval")
+// and triggering jobs (e.g. gitiles pollers) are not affected.
+//
+// One-time windows are defined via 'start' and 'end' fields, recurring windows
+// via 'schedule' and 'duration' fields. Exactly one of these pairs must be set.
+type BlackoutWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id is a name of the window, unique within the project.
+	//
+	// It shows up in the UI and in invocation logs. Required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Start is when a one-time window starts, as RFC3339 timestamp, e.g.
+	// "2024-12-20T00:00:00-08:00".
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End is when a one-time window ends, as RFC3339 timestamp, e.g.
+	// "2025-01-02T00:00:00-08:00".
+	End string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// Schedule defines when a recurring window starts, as a cron-like
+	// expression, possibly with a time zone (see Job.schedule), e.g.
+	// "0 2 * * 6 in America/Los_Angeles" for every Saturday at 2 AM.
+	Schedule string `protobuf:"bytes,4,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Duration is how long a recurring window lasts after each start.
+	Duration *durationpb.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Action defines what to do with cron ticks within the window.
+	//
+	// Default is SKIP.
+	Action BlackoutWindow_Action `protobuf:"varint,6,opt,name=action,proto3,enum=scheduler.config.BlackoutWindow_Action" json:"action,omitempty"`
+	// Job is a list of IDs of jobs the window applies to.
+	//
+	// If empty, the window applies to all jobs in the project.
+	Job           []string `protobuf:"bytes,7,rep,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlackoutWindow) Reset() {
+	*x = BlackoutWindow{}
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlackoutWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlackoutWindow) ProtoMessage() {}
+
+func (x *BlackoutWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlackoutWindow.ProtoReflect.Descriptor instead.
+func (*BlackoutWindow) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlackoutWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlackoutWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *BlackoutWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *BlackoutWindow) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *BlackoutWindow) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *BlackoutWindow) GetAction() BlackoutWindow_Action {
+	if x != nil {
+		return x.Action
+	}
+	return BlackoutWindow_SKIP
+}
+
+func (x *BlackoutWindow) GetJob() []string {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
 // Deprecated in favor of LUCI Realms. This proto is totally unused now, exists
 // only to not break older configs that still may have deprecated fields
 // populated.
@@ -235,7 +430,7 @@ type Acl struct {
 
 func (x *Acl) Reset() {
 	*x = Acl{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +442,7 @@ func (x *Acl) String() string {
 func (*Acl) ProtoMessage() {}
 
 func (x *Acl) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +455,7 @@ func (x *Acl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Acl.ProtoReflect.Descriptor instead.
 func (*Acl) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{2}
 }
 
 // Deprecated: Marked as deprecated in go.chromium.org/luci/scheduler/appengine/messages/config.proto.
@@ -294,7 +489,7 @@ type AclSet struct {
 
 func (x *AclSet) Reset() {
 	*x = AclSet{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +501,7 @@ func (x *AclSet) String() string {
 func (*AclSet) ProtoMessage() {}
 
 func (x *AclSet) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +514,7 @@ func (x *AclSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AclSet.ProtoReflect.Descriptor instead.
 func (*AclSet) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{2}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{3}
 }
 
 // Deprecated: Marked as deprecated in go.chromium.org/luci/scheduler/appengine/messages/config.proto.
@@ -382,13 +577,28 @@ type TriggeringPolicy struct {
 	//
 	// Default is 7 days.
 	PendingTimeout *durationpb.Duration `protobuf:"bytes,5,opt,name=pending_timeout,json=pendingTimeout,proto3" json:"pending_timeout,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// How many invocations can be started within rate_limit_window.
+	//
+	// For example, setting this to 3 and rate_limit_window to 1 hour will make
+	// the job start at most 3 invocations in any 1 hour interval. This value is
+	// ignored by policy kinds other than RATE_LIMITED.
+	//
+	// Required for RATE_LIMITED.
+	MaxInvocationsPerWindow int64 `protobuf:"varint,6,opt,name=max_invocations_per_window,json=maxInvocationsPerWindow,proto3" json:"max_invocations_per_window,omitempty"`
+	// The duration of the sliding window used by RATE_LIMITED policy.
+	//
+	// See max_invocations_per_window. This value is ignored by policy kinds other
+	// than RATE_LIMITED.
+	//
+	// Required for RATE_LIMITED.
+	RateLimitWindow *durationpb.Duration `protobuf:"bytes,7,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TriggeringPolicy) Reset() {
 	*x = TriggeringPolicy{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +610,7 @@ func (x *TriggeringPolicy) String() string {
 func (*TriggeringPolicy) ProtoMessage() {}
 
 func (x *TriggeringPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +623,7 @@ func (x *TriggeringPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggeringPolicy.ProtoReflect.Descriptor instead.
 func (*TriggeringPolicy) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{3}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TriggeringPolicy) GetKind() TriggeringPolicy_Kind {
@@ -451,6 +661,20 @@ func (x *TriggeringPolicy) GetPendingTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *TriggeringPolicy) GetMaxInvocationsPerWindow() int64 {
+	if x != nil {
+		return x.MaxInvocationsPerWindow
+	}
+	return 0
+}
+
+func (x *TriggeringPolicy) GetRateLimitWindow() *durationpb.Duration {
+	if x != nil {
+		return x.RateLimitWindow
+	}
+	return nil
+}
+
 // Job specifies a single regular job belonging to a project.
 //
 // Such jobs runs on a schedule or can be triggered by some trigger.
@@ -486,6 +710,11 @@ type Job struct {
 	//     "0 1/3 * * *" - each 3 hours but starting 1:00 AM UTC
 	//     "0 2,10,18 * * *" - at 2 AM UTC, 10 AM UTC, 6 PM UTC
 	//     "0 7 * * *" - at 7 AM UTC, once a day.
+	//   - "0 9 * * 1-5 in America/Los_Angeles": cron-like expression evaluated
+	//     against the local time in the given IANA time zone instead of UTC.
+	//     Moments skipped when clocks are set forward for daylight saving time
+	//     are triggered once, right after the transition. Moments repeated when
+	//     clocks are set back are triggered only once, on the first occurrence.
 	//   - "with 10s interval": runs invocations in a loop, waiting 10s after
 	//     finishing invocation before starting a new one. Overruns are not
 	//     possible.
@@ -516,6 +745,21 @@ type Job struct {
 	// If not specified defaults to GREEDY_BATCHING with 1 max concurrent
 	// invocation. See comments in TriggeringPolicy for more details.
 	TriggeringPolicy *TriggeringPolicy `protobuf:"bytes,7,opt,name=triggering_policy,json=triggeringPolicy,proto3" json:"triggering_policy,omitempty"`
+	// A list of IDs of jobs in this project that must all succeed before this
+	// job runs.
+	//
+	// Invocations are matched by their lineage: an invocation started by
+	// a trigger belongs to the same lineage as the invocation that emitted the
+	// trigger, while invocations started by a schedule or manually start new
+	// lineages. When all listed jobs have an invocation that succeeded within the
+	// same lineage, this job is triggered once for that lineage.
+	//
+	// For example, if a trigger "nightly" triggers builders "A" and "B", a job
+	// with depends_on: "A" and depends_on: "B" runs once each night after both
+	// builders succeed.
+	//
+	// Dependencies must not form cycles.
+	DependsOn []string `protobuf:"bytes,9,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 	// Noop is used for testing. It is "do nothing" task.
 	Noop *NoopTask `protobuf:"bytes,100,opt,name=noop,proto3" json:"noop,omitempty"`
 	// UrlFetch can be used to make a simple HTTP call.
@@ -528,7 +772,7 @@ type Job struct {
 
 func (x *Job) Reset() {
 	*x = Job{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +784,7 @@ func (x *Job) String() string {
 func (*Job) ProtoMessage() {}
 
 func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +797,7 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{4}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Job) GetId() string {
@@ -607,6 +851,13 @@ func (x *Job) GetTriggeringPolicy() *TriggeringPolicy {
 	return nil
 }
 
+func (x *Job) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
 func (x *Job) GetNoop() *NoopTask {
 	if x != nil {
 		return x.Noop
@@ -680,7 +931,7 @@ type Trigger struct {
 
 func (x *Trigger) Reset() {
 	*x = Trigger{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -692,7 +943,7 @@ func (x *Trigger) String() string {
 func (*Trigger) ProtoMessage() {}
 
 func (x *Trigger) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +956,7 @@ func (x *Trigger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{5}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Trigger) GetId() string {
@@ -792,7 +1043,7 @@ type NoopTask struct {
 
 func (x *NoopTask) Reset() {
 	*x = NoopTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +1055,7 @@ func (x *NoopTask) String() string {
 func (*NoopTask) ProtoMessage() {}
 
 func (x *NoopTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1068,7 @@ func (x *NoopTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoopTask.ProtoReflect.Descriptor instead.
 func (*NoopTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NoopTask) GetSleepMs() int64 {
@@ -927,7 +1178,7 @@ type GitilesTask struct {
 
 func (x *GitilesTask) Reset() {
 	*x = GitilesTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -939,7 +1190,7 @@ func (x *GitilesTask) String() string {
 func (*GitilesTask) ProtoMessage() {}
 
 func (x *GitilesTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1203,7 @@ func (x *GitilesTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitilesTask.ProtoReflect.Descriptor instead.
 func (*GitilesTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{7}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GitilesTask) GetRepo() string {
@@ -998,7 +1249,7 @@ type UrlFetchTask struct {
 
 func (x *UrlFetchTask) Reset() {
 	*x = UrlFetchTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1010,7 +1261,7 @@ func (x *UrlFetchTask) String() string {
 func (*UrlFetchTask) ProtoMessage() {}
 
 func (x *UrlFetchTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1274,7 @@ func (x *UrlFetchTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlFetchTask.ProtoReflect.Descriptor instead.
 func (*UrlFetchTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{8}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UrlFetchTask) GetMethod() string {
@@ -1076,7 +1327,7 @@ type BuildbucketTask struct {
 
 func (x *BuildbucketTask) Reset() {
 	*x = BuildbucketTask{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1088,7 +1339,7 @@ func (x *BuildbucketTask) String() string {
 func (*BuildbucketTask) ProtoMessage() {}
 
 func (x *BuildbucketTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1101,7 +1352,7 @@ func (x *BuildbucketTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildbucketTask.ProtoReflect.Descriptor instead.
 func (*BuildbucketTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{9}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BuildbucketTask) GetServer() string {
@@ -1154,7 +1405,7 @@ type TaskDefWrapper struct {
 
 func (x *TaskDefWrapper) Reset() {
 	*x = TaskDefWrapper{}
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1166,7 +1417,7 @@ func (x *TaskDefWrapper) String() string {
 func (*TaskDefWrapper) ProtoMessage() {}
 
 func (x *TaskDefWrapper) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,7 +1430,7 @@ func (x *TaskDefWrapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskDefWrapper.ProtoReflect.Descriptor instead.
 func (*TaskDefWrapper) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{10}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TaskDefWrapper) GetNoop() *NoopTask {
@@ -1223,7 +1474,7 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x74, 0x6f, 0x22, 0x89, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x33,
@@ -1233,151 +1484,185 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x67, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18,
 	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x04,
-	0x10, 0x05, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x12, 0x32, 0x0a, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63,
-	0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x12, 0x21, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
-	0x64, 0x54, 0x6f, 0x22, 0x2c, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x52,
-	0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x49, 0x47, 0x47,
-	0x45, 0x52, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10,
-	0x01, 0x22, 0x4f, 0x0a, 0x06, 0x41, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x6c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x61, 0x63,
-	0x6c, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x42,
-	0x61, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x56, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
-	0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
-	0x0a, 0x0f, 0x47, 0x52, 0x45, 0x45, 0x44, 0x59, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x4f, 0x47, 0x41, 0x52, 0x49, 0x54, 0x48, 0x4d,
-	0x49, 0x43, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a,
-	0x0c, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x03, 0x22,
-	0xc0, 0x03, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x6c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
-	0x61, 0x63, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53,
-	0x65, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04,
-	0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63,
-	0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x12, 0x43, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x66,
-	0x10, 0x67, 0x22, 0x8c, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
-	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04,
-	0x61, 0x63, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63,
-	0x6c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x08, 0x61,
-	0x63, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18,
-	0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x08, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x37, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69,
-	0x6c, 0x65, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74,
-	0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x22, 0x4c, 0x0a, 0x08, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x0a,
-	0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x8a, 0x01, 0x0a, 0x0b, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
-	0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x5f,
-	0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x61, 0x74, 0x68, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61,
-	0x74, 0x68, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65,
-	0x67, 0x65, 0x78, 0x70, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x59, 0x0a, 0x0c,
-	0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x54, 0x61,
-	0x73, 0x6b, 0x44, 0x65, 0x66, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04,
-	0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f,
-	0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x09,
-	0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x43, 0x0a, 0x0b, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x37,
-	0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x07,
-	0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x42, 0x74, 0xa2,
-	0xfe, 0x23, 0x3d, 0x0a, 0x3b, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x6c, 0x75,
-	0x63, 0x69, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x66, 0x67,
-	0x5a, 0x31, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x18, 0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x0f,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x8d, 0x02, 0x0a, 0x0e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62,
+	0x22, 0x1d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b,
+	0x49, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x46, 0x45, 0x52, 0x10, 0x01, 0x22,
+	0x8a, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x12, 0x32, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x6c, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0a, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x09, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x22, 0x2c,
+	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x22, 0x4f, 0x0a, 0x06,
+	0x41, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
+	0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x41, 0x63, 0x6c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x22, 0x93, 0x04,
+	0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x3c, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x42, 0x61, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x45, 0x0a, 0x11, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x7b, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0d,
+	0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x47, 0x52, 0x45, 0x45, 0x44, 0x59, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x4f, 0x47, 0x41, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x49,
+	0x43, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x05, 0x22, 0xdf, 0x03, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x65, 0x61, 0x6c, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x63, 0x6c,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x6c, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x07,
+	0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x72, 0x6c, 0x5f, 0x66,
+	0x65, 0x74, 0x63, 0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x72,
+	0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x12, 0x43, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a,
+	0x04, 0x08, 0x66, 0x10, 0x67, 0x22, 0x8c, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x2d, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x41, 0x63, 0x6c, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x1d,
+	0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x12, 0x4f, 0x0a,
+	0x11, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b,
+	0x0a, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x6e,
+	0x6f, 0x6f, 0x70, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x6f,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x37, 0x0a, 0x07, 0x67,
+	0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x67, 0x69, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x08, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x5f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22,
+	0x59, 0x0a, 0x0c, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x81, 0x02, 0x0a,
+	0x0e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x66, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x12,
+	0x3b, 0x0a, 0x09, 0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x43, 0x0a, 0x0b,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x37, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x42, 0x74, 0xa2, 0xfe, 0x23, 0x3d, 0x0a, 0x3b, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2f,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x3a, 0x6c, 0x75, 0x63, 0x69, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x63, 0x66, 0x67, 0x5a, 0x31, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d,
+	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1392,50 +1677,56 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_goTypes = []any{
-	(Acl_Role)(0),               // 0: scheduler.config.Acl.Role
-	(TriggeringPolicy_Kind)(0),  // 1: scheduler.config.TriggeringPolicy.Kind
-	(*ProjectConfig)(nil),       // 2: scheduler.config.ProjectConfig
-	(*Acl)(nil),                 // 3: scheduler.config.Acl
-	(*AclSet)(nil),              // 4: scheduler.config.AclSet
-	(*TriggeringPolicy)(nil),    // 5: scheduler.config.TriggeringPolicy
-	(*Job)(nil),                 // 6: scheduler.config.Job
-	(*Trigger)(nil),             // 7: scheduler.config.Trigger
-	(*NoopTask)(nil),            // 8: scheduler.config.NoopTask
-	(*GitilesTask)(nil),         // 9: scheduler.config.GitilesTask
-	(*UrlFetchTask)(nil),        // 10: scheduler.config.UrlFetchTask
-	(*BuildbucketTask)(nil),     // 11: scheduler.config.BuildbucketTask
-	(*TaskDefWrapper)(nil),      // 12: scheduler.config.TaskDefWrapper
-	(*durationpb.Duration)(nil), // 13: google.protobuf.Duration
+	(BlackoutWindow_Action)(0),  // 0: scheduler.config.BlackoutWindow.Action
+	(Acl_Role)(0),               // 1: scheduler.config.Acl.Role
+	(TriggeringPolicy_Kind)(0),  // 2: scheduler.config.TriggeringPolicy.Kind
+	(*ProjectConfig)(nil),       // 3: scheduler.config.ProjectConfig
+	(*BlackoutWindow)(nil),      // 4: scheduler.config.BlackoutWindow
+	(*Acl)(nil),                 // 5: scheduler.config.Acl
+	(*AclSet)(nil),              // 6: scheduler.config.AclSet
+	(*TriggeringPolicy)(nil),    // 7: scheduler.config.TriggeringPolicy
+	(*Job)(nil),                 // 8: scheduler.config.Job
+	(*Trigger)(nil),             // 9: scheduler.config.Trigger
+	(*NoopTask)(nil),            // 10: scheduler.config.NoopTask
+	(*GitilesTask)(nil),         // 11: scheduler.config.GitilesTask
+	(*UrlFetchTask)(nil),        // 12: scheduler.config.UrlFetchTask
+	(*BuildbucketTask)(nil),     // 13: scheduler.config.BuildbucketTask
+	(*TaskDefWrapper)(nil),      // 14: scheduler.config.TaskDefWrapper
+	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
 }
 var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_depIdxs = []int32{
-	6,  // 0: scheduler.config.ProjectConfig.job:type_name -> scheduler.config.Job
-	7,  // 1: scheduler.config.ProjectConfig.trigger:type_name -> scheduler.config.Trigger
-	4,  // 2: scheduler.config.ProjectConfig.acl_sets:type_name -> scheduler.config.AclSet
-	0,  // 3: scheduler.config.Acl.role:type_name -> scheduler.config.Acl.Role
-	3,  // 4: scheduler.config.AclSet.acls:type_name -> scheduler.config.Acl
-	1,  // 5: scheduler.config.TriggeringPolicy.kind:type_name -> scheduler.config.TriggeringPolicy.Kind
-	13, // 6: scheduler.config.TriggeringPolicy.pending_timeout:type_name -> google.protobuf.Duration
-	3,  // 7: scheduler.config.Job.acls:type_name -> scheduler.config.Acl
-	5,  // 8: scheduler.config.Job.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
-	8,  // 9: scheduler.config.Job.noop:type_name -> scheduler.config.NoopTask
-	10, // 10: scheduler.config.Job.url_fetch:type_name -> scheduler.config.UrlFetchTask
-	11, // 11: scheduler.config.Job.buildbucket:type_name -> scheduler.config.BuildbucketTask
-	3,  // 12: scheduler.config.Trigger.acls:type_name -> scheduler.config.Acl
-	5,  // 13: scheduler.config.Trigger.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
-	8,  // 14: scheduler.config.Trigger.noop:type_name -> scheduler.config.NoopTask
-	9,  // 15: scheduler.config.Trigger.gitiles:type_name -> scheduler.config.GitilesTask
-	8,  // 16: scheduler.config.TaskDefWrapper.noop:type_name -> scheduler.config.NoopTask
-	10, // 17: scheduler.config.TaskDefWrapper.url_fetch:type_name -> scheduler.config.UrlFetchTask
-	11, // 18: scheduler.config.TaskDefWrapper.buildbucket:type_name -> scheduler.config.BuildbucketTask
-	9,  // 19: scheduler.config.TaskDefWrapper.gitiles:type_name -> scheduler.config.GitilesTask
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	8,  // 0: scheduler.config.ProjectConfig.job:type_name -> scheduler.config.Job
+	9,  // 1: scheduler.config.ProjectConfig.trigger:type_name -> scheduler.config.Trigger
+	6,  // 2: scheduler.config.ProjectConfig.acl_sets:type_name -> scheduler.config.AclSet
+	4,  // 3: scheduler.config.ProjectConfig.blackout_window:type_name -> scheduler.config.BlackoutWindow
+	15, // 4: scheduler.config.BlackoutWindow.duration:type_name -> google.protobuf.Duration
+	0,  // 5: scheduler.config.BlackoutWindow.action:type_name -> scheduler.config.BlackoutWindow.Action
+	1,  // 6: scheduler.config.Acl.role:type_name -> scheduler.config.Acl.Role
+	5,  // 7: scheduler.config.AclSet.acls:type_name -> scheduler.config.Acl
+	2,  // 8: scheduler.config.TriggeringPolicy.kind:type_name -> scheduler.config.TriggeringPolicy.Kind
+	15, // 9: scheduler.config.TriggeringPolicy.pending_timeout:type_name -> google.protobuf.Duration
+	15, // 10: scheduler.config.TriggeringPolicy.rate_limit_window:type_name -> google.protobuf.Duration
+	5,  // 11: scheduler.config.Job.acls:type_name -> scheduler.config.Acl
+	7,  // 12: scheduler.config.Job.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
+	10, // 13: scheduler.config.Job.noop:type_name -> scheduler.config.NoopTask
+	12, // 14: scheduler.config.Job.url_fetch:type_name -> scheduler.config.UrlFetchTask
+	13, // 15: scheduler.config.Job.buildbucket:type_name -> scheduler.config.BuildbucketTask
+	5,  // 16: scheduler.config.Trigger.acls:type_name -> scheduler.config.Acl
+	7,  // 17: scheduler.config.Trigger.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
+	10, // 18: scheduler.config.Trigger.noop:type_name -> scheduler.config.NoopTask
+	11, // 19: scheduler.config.Trigger.gitiles:type_name -> scheduler.config.GitilesTask
+	10, // 20: scheduler.config.TaskDefWrapper.noop:type_name -> scheduler.config.NoopTask
+	12, // 21: scheduler.config.TaskDefWrapper.url_fetch:type_name -> scheduler.config.UrlFetchTask
+	13, // 22: scheduler.config.TaskDefWrapper.buildbucket:type_name -> scheduler.config.BuildbucketTask
+	11, // 23: scheduler.config.TaskDefWrapper.gitiles:type_name -> scheduler.config.GitilesTask
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init() }
@@ -1448,8 +1739,8 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc), len(file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -41,6 +41,7 @@ type Schedule struct {
 	randSeed uint64
 
 	cronExpr  *cronexpr.Expression // set for absolute schedules
+	location  *time.Location       // set for absolute schedules with a time zone
 	interval  time.Duration        // set for relative schedules
 	triggered bool                 // set for triggered schedule
 }
@@ -58,6 +59,11 @@ func (s *Schedule) IsAbsolute() bool {
 	return s.cronExpr != nil || s.triggered
 }
 
+// Interval is the interval of a relative schedule or 0 for absolute ones.
+func (s *Schedule) Interval() time.Duration {
+	return s.interval
+}
+
 // Next tells when to run the job the next time.
 //
 // 'now' is current time. 'prev' is when previous invocation has finished (or
@@ -69,6 +75,9 @@ func (s *Schedule) Next(now, prev time.Time) time.Time {
 
 	// For an absolute schedule just look at the time table.
 	if s.cronExpr != nil {
+		if s.location != nil {
+			return s.nextInLocation(now)
+		}
 		return s.cronExpr.Next(now)
 	}
 
@@ -110,6 +119,11 @@ func (s *Schedule) String() string {
 //     schedule. Overruns are not possible.
 //   - "continuously" is alias for "with 0s interval", meaning the job will run
 //     in a loop without any pauses.
+//   - "0 9 * * 1-5 in America/Los_Angeles": cron-like expression evaluated
+//     against the wall clock of the given IANA time zone instead of UTC. Wall
+//     clock times skipped by a daylight saving time transition fire once, at
+//     the end of the transition. Wall clock times repeated by a transition fire
+//     only once, at their first occurrence.
 //   - "triggered" schedule indicates that job is always started via a trigger.
 //     'Next' always returns DistantFuture constant.
 func Parse(expr string, randSeed uint64) (sched *Schedule, err error) {
@@ -126,9 +140,12 @@ func Parse(expr string, randSeed uint64) (sched *Schedule, err error) {
 	default:
 		toParse = expr
 	}
-	if strings.HasPrefix(toParse, "with ") {
+	switch {
+	case strings.HasPrefix(toParse, "with "):
 		sched, err = parseWithSchedule(toParse, randSeed)
-	} else {
+	case strings.Contains(toParse, " in "):
+		sched, err = parseCronScheduleInZone(toParse, randSeed)
+	default:
 		sched, err = parseCronSchedule(toParse, randSeed)
 	}
 	if sched != nil {
@@ -164,3 +181,94 @@ func parseCronSchedule(expr string, randSeed uint64) (*Schedule, error) {
 	}
 	return &Schedule{cronExpr: exp}, nil
 }
+
+// parseCronScheduleInZone parses "<crontab-like schedule> in <time zone>".
+func parseCronScheduleInZone(expr string, randSeed uint64) (*Schedule, error) {
+	idx := strings.LastIndex(expr, " in ")
+	cron, zone := strings.TrimSpace(expr[:idx]), strings.TrimSpace(expr[idx+len(" in "):])
+	if cron == "" || zone == "" {
+		return nil, errors.New("expecting format \"<cron expression> in <time zone>\"")
+	}
+	if cron == "triggered" || cron == "continuously" {
+		return nil, fmt.Errorf("a time zone can't be used with %q schedule", cron)
+	}
+	// "Local" is the time zone of the server, it is not what users want.
+	if zone == "Local" {
+		return nil, fmt.Errorf("bad time zone %q - use an IANA time zone name", zone)
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("bad time zone %q - %s", zone, err)
+	}
+	sched, err := parseCronSchedule(cron, randSeed)
+	if err != nil {
+		return nil, err
+	}
+	sched.location = loc
+	return sched, nil
+}
+
+// nextInLocation returns the next tick of a cron schedule with a time zone.
+//
+// The cron expression is evaluated against the wall clock in s.location. To
+// avoid dealing with daylight saving time transitions inside cronexpr, wall
+// clock readings are represented by UTC times with the same fields, and only
+// converted to actual moments in time when looking for the next tick.
+func (s *Schedule) nextInLocation(now time.Time) time.Time {
+	wall := wallClock(now.In(s.location))
+	for {
+		wall = s.cronExpr.Next(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		// The wall clock reading may have been already passed if 'now' is within
+		// a repeated hour (when clocks are set back). Skip such readings, they
+		// have fired already during the first occurrence of the hour.
+		if next := fromWallClock(wall, s.location); next.After(now) {
+			return next
+		}
+	}
+}
+
+// wallClock returns an UTC time with the same wall clock reading as 't'.
+func wallClock(t time.Time) time.Time {
+	return time.Date(
+		t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(),
+		time.UTC)
+}
+
+// fromWallClock returns the earliest moment in time when the wall clock in
+// the given location shows the given reading (represented by an UTC time).
+//
+// If there's no such moment (the reading falls into a gap created when clocks
+// are set forward), returns the moment the gap ends.
+func fromWallClock(wall time.Time, loc *time.Location) time.Time {
+	// Time zone offsets that can possibly be in effect around the wall clock
+	// reading. Offset transitions are never closer than a day from each other.
+	var offsets []int
+	for _, d := range []time.Duration{-24 * time.Hour, 0, 24 * time.Hour} {
+		_, off := wall.Add(d).In(loc).Zone()
+		offsets = append(offsets, off)
+	}
+
+	var earliest, gapEnd time.Time
+	for _, off := range offsets {
+		t := wall.Add(-time.Duration(off) * time.Second).In(loc)
+		switch reading := wallClock(t); {
+		case reading.Equal(wall):
+			if earliest.IsZero() || t.Before(earliest) {
+				earliest = t
+			}
+		case reading.After(wall):
+			// 't' is after the gap, the gap ends when its time zone starts.
+			if start, _ := t.ZoneBounds(); !start.IsZero() && start.After(gapEnd) {
+				gapEnd = start
+			}
+		}
+	}
+	if !earliest.IsZero() {
+		return earliest.UTC()
+	}
+	return gapEnd.UTC()
+}
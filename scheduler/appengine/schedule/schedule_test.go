@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth"
 	"go.chromium.org/luci/common/testing/truth/assert"
 	"go.chromium.org/luci/common/testing/truth/should"
 )
@@ -69,6 +70,7 @@ func TestAbsoluteSchedule(t *testing.T) {
 	ftt.Run("Next works", t, func(t *ftt.Test) {
 		sched, _ := Parse("*/15 * * * * * *", 0)
 		assert.Loosely(t, sched.IsAbsolute(), should.BeTrue)
+		assert.Loosely(t, sched.Interval(), should.BeZero)
 		assert.Loosely(t, sched.Next(epoch, time.Time{}), should.Match(epoch.Add(15*time.Second)))
 		assert.Loosely(t, sched.Next(epoch.Add(15*time.Second), epoch), should.Match(epoch.Add(30*time.Second)))
 	})
@@ -112,6 +114,103 @@ func TestAbsoluteSchedule(t *testing.T) {
 	})
 }
 
+func TestAbsoluteScheduleInTimeZone(t *testing.T) {
+	t.Parallel()
+
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("no tzdata: %s", err)
+	}
+	inLA := func(t string) time.Time {
+		tm, err := time.ParseInLocation("2006-01-02 15:04:05 MST", t, la)
+		if err != nil {
+			panic(err)
+		}
+		return tm.UTC()
+	}
+
+	ftt.Run("Parsing success", t, func(t *ftt.Test) {
+		sched, err := Parse("0 9 * * 1-5 in America/Los_Angeles", 0)
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, sched.String(), should.Equal("0 9 * * 1-5 in America/Los_Angeles"))
+		assert.Loosely(t, sched.IsAbsolute(), should.BeTrue)
+	})
+
+	ftt.Run("Parsing error", t, func(t *ftt.Test) {
+		for _, bad := range []string{
+			"0 9 * * * in Mars/Olympus_Mons",
+			"0 9 * * * in Local",
+			"0 9 * * * in ",
+			"not a schedule in UTC",
+			"triggered in UTC",
+			"continuously in UTC",
+			"with 10s interval in UTC",
+		} {
+			sched, err := Parse(bad, 0)
+			assert.Loosely(t, err, should.NotBeNil, truth.Explain("%q", bad))
+			assert.Loosely(t, sched, should.BeNil)
+		}
+	})
+
+	ftt.Run("UTC zone is same as no zone", t, func(t *ftt.Test) {
+		assert.Loosely(t, timeTable("0 */3 * * * in UTC", epoch, 4), should.Match(timeTable("0 */3 * * *", epoch, 4)))
+	})
+
+	ftt.Run("Once a day", t, func(t *ftt.Test) {
+		assert.Loosely(t, timeTable("0 9 * * * in America/Los_Angeles", epoch, 3), should.Match([]time.Time{
+			inLA("2015-09-15 09:00:00 PDT"),
+			inLA("2015-09-16 09:00:00 PDT"),
+			inLA("2015-09-17 09:00:00 PDT"),
+		}))
+	})
+
+	ftt.Run("Across DST transitions", t, func(t *ftt.Test) {
+		// Local time stays the same, UTC time shifts.
+		assert.Loosely(t, timeTable("0 9 * * * in America/Los_Angeles", inLA("2015-11-01 00:00:00 PDT"), 2), should.Match([]time.Time{
+			inLA("2015-11-01 09:00:00 PST"),
+			inLA("2015-11-02 09:00:00 PST"),
+		}))
+		assert.Loosely(t, timeTable("0 9 * * * in America/Los_Angeles", inLA("2016-03-12 12:00:00 PST"), 2), should.Match([]time.Time{
+			inLA("2016-03-13 09:00:00 PDT"),
+			inLA("2016-03-14 09:00:00 PDT"),
+		}))
+	})
+
+	ftt.Run("Skipped hour", t, func(t *ftt.Test) {
+		// 2:30 AM doesn't exist on 2016-03-13 in LA, the clock jumps from 2:00 AM
+		// to 3:00 AM. The tick happens at 3:00 AM instead.
+		assert.Loosely(t, timeTable("30 2 * * * in America/Los_Angeles", inLA("2016-03-12 12:00:00 PST"), 2), should.Match([]time.Time{
+			inLA("2016-03-13 03:00:00 PDT"),
+			inLA("2016-03-14 02:30:00 PDT"),
+		}))
+		// All ticks within the gap collapse into one.
+		assert.Loosely(t, timeTable("*/20 * * * * in America/Los_Angeles", inLA("2016-03-13 01:30:00 PST"), 5), should.Match([]time.Time{
+			inLA("2016-03-13 01:40:00 PST"),
+			inLA("2016-03-13 03:00:00 PDT"),
+			inLA("2016-03-13 03:20:00 PDT"),
+			inLA("2016-03-13 03:40:00 PDT"),
+			inLA("2016-03-13 04:00:00 PDT"),
+		}))
+	})
+
+	ftt.Run("Repeated hour", t, func(t *ftt.Test) {
+		// 1:30 AM happens twice on 2015-11-01 in LA, the clock is set back from
+		// 2:00 AM PDT to 1:00 AM PST. The tick happens only once.
+		assert.Loosely(t, timeTable("30 1 * * * in America/Los_Angeles", inLA("2015-10-31 12:00:00 PDT"), 2), should.Match([]time.Time{
+			inLA("2015-11-01 01:30:00 PDT"),
+			inLA("2015-11-02 01:30:00 PST"),
+		}))
+		// Repeated readings are skipped, the schedule resumes after the repeated
+		// hour.
+		assert.Loosely(t, timeTable("*/20 * * * * in America/Los_Angeles", inLA("2015-11-01 01:30:00 PDT"), 4), should.Match([]time.Time{
+			inLA("2015-11-01 01:40:00 PDT"),
+			inLA("2015-11-01 02:00:00 PST"),
+			inLA("2015-11-01 02:20:00 PST"),
+			inLA("2015-11-01 02:40:00 PST"),
+		}))
+	})
+}
+
 func TestRelativeSchedule(t *testing.T) {
 	t.Parallel()
 
@@ -138,6 +237,7 @@ func TestRelativeSchedule(t *testing.T) {
 	ftt.Run("Next works", t, func(t *ftt.Test) {
 		sched, _ := Parse("with 15s interval", 0)
 		assert.Loosely(t, sched.IsAbsolute(), should.BeFalse)
+		assert.Loosely(t, sched.Interval(), should.Equal(15*time.Second))
 
 		// First tick is pseudorandom.
 		assert.Loosely(t, sched.Next(epoch, time.Time{}), should.Match(epoch.Add(14*time.Second+177942239*time.Nanosecond)))
@@ -58,6 +58,9 @@ const (
 	// StatusAborted means the task was forcefully aborted (manually or due to
 	// hard deadline).
 	StatusAborted Status = "ABORTED"
+	// StatusSkipped means the task should have been started by the schedule, but
+	// the cron tick happened within a blackout window.
+	StatusSkipped Status = "SKIPPED"
 )
 
 // Initial returns true if Status is Starting or Retrying.
@@ -71,7 +74,7 @@ func (s Status) Initial() bool {
 // Final returns true if Status represents some final status.
 func (s Status) Final() bool {
 	switch s {
-	case StatusSucceeded, StatusFailed, StatusOverrun, StatusAborted:
+	case StatusSucceeded, StatusFailed, StatusOverrun, StatusAborted, StatusSkipped:
 		return true
 	default:
 		return false
@@ -60,6 +60,7 @@ func InstallHandlers(r *router.Router, base router.MiddlewareChain, cfg Config)
 	r.GET("/jobs/:ProjectID", m, projectPage)
 	r.GET("/jobs/:ProjectID/:JobName", m, jobPage)
 	r.GET("/jobs/:ProjectID/:JobName/:InvID", m, invocationPage)
+	r.GET("/dag/:ProjectID", m, dagPage)
 
 	// All POST forms must be protected with XSRF token.
 	mxsrf := m.Extend(xsrf.WithTokenCheck)
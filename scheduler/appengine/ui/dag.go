@@ -0,0 +1,121 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"context"
+	"sort"
+
+	"go.chromium.org/luci/server/router"
+	"go.chromium.org/luci/server/templates"
+
+	"go.chromium.org/luci/scheduler/appengine/engine"
+)
+
+// dagNode is a job in the dependency graph.
+type dagNode struct {
+	// Job is the job itself.
+	Job *schedulerJob
+
+	// DependsOn is a sorted list of names of visible jobs this job depends on.
+	DependsOn []string
+}
+
+// dagLayer is a set of jobs whose dependencies are all in preceding layers.
+type dagLayer []*dagNode
+
+func dagPage(c *router.Context) {
+	projectID := c.Params.ByName("ProjectID")
+	jobs, err := config(c.Request.Context()).Engine.GetVisibleProjectJobs(c.Request.Context(), projectID)
+	if err != nil {
+		panic(err)
+	}
+	templates.MustRender(c.Request.Context(), c.Writer, "pages/dag.html", map[string]any{
+		"ProjectID": projectID,
+		"Layers":    dagLayers(c.Request.Context(), jobs),
+	})
+}
+
+// hasDependencies is true if some of the jobs depend on each other.
+func hasDependencies(jobs []*engine.Job) bool {
+	for _, j := range jobs {
+		if len(j.DependsOn) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// dagLayers arranges jobs that participate in dependency relations into
+// layers, such that each job is placed right after its deepest dependency.
+//
+// Only dependencies between the given jobs are considered.
+func dagLayers(c context.Context, jobs []*engine.Job) []dagLayer {
+	byID := make(map[string]*engine.Job, len(jobs))
+	for _, j := range jobs {
+		byID[j.JobID] = j
+	}
+
+	// Dependencies are validated to not have cycles, but be defensive anyway,
+	// since a bad config shouldn't break the page.
+	depth := make(map[string]int, len(jobs))
+	visiting := make(map[string]bool, len(jobs))
+	var visit func(j *engine.Job) int
+	visit = func(j *engine.Job) int {
+		if d, ok := depth[j.JobID]; ok {
+			return d
+		}
+		if visiting[j.JobID] {
+			return 0
+		}
+		visiting[j.JobID] = true
+		d := 0
+		for _, id := range j.DependsOn {
+			if dep := byID[id]; dep != nil {
+				if dd := visit(dep) + 1; dd > d {
+					d = dd
+				}
+			}
+		}
+		visiting[j.JobID] = false
+		depth[j.JobID] = d
+		return d
+	}
+
+	var layers []dagLayer
+	for _, job := range sortJobs(c, jobs) {
+		j := byID[job.ProjectID+"/"+job.JobName]
+		node := &dagNode{Job: job}
+		for _, id := range j.DependsOn {
+			if dep := byID[id]; dep != nil {
+				node.DependsOn = append(node.DependsOn, dep.JobName())
+			}
+		}
+		linked := len(node.DependsOn) != 0
+		for _, id := range j.DependentJobIDs {
+			linked = linked || byID[id] != nil
+		}
+		if !linked {
+			continue
+		}
+		sort.Strings(node.DependsOn)
+		d := visit(j)
+		for len(layers) <= d {
+			layers = append(layers, nil)
+		}
+		layers[d] = append(layers[d], node)
+	}
+	return layers
+}
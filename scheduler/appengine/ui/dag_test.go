@@ -0,0 +1,65 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ui
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/scheduler/appengine/presentation"
+)
+
+func TestDAGTemplate(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Renders non-empty graph", t, func(t *ftt.Test) {
+		tmpl, err := template.New("").
+			Funcs(prepareTemplates("").FuncMap).
+			ParseFiles(
+				"../frontend/templates/includes/base.html",
+				"../frontend/templates/pages/dag.html",
+			)
+		assert.Loosely(t, err, should.BeNil)
+
+		job := func(name string) *schedulerJob {
+			return &schedulerJob{
+				ProjectID:  "proj",
+				JobName:    name,
+				State:      presentation.PublicStateScheduled,
+				LabelClass: "label-primary",
+			}
+		}
+
+		out := bytes.Buffer{}
+		err = tmpl.ExecuteTemplate(&out, "content", map[string]any{
+			"ProjectID": "proj",
+			"Layers": []dagLayer{
+				{{Job: job("a")}, {Job: job("b")}},
+				{{Job: job("c"), DependsOn: []string{"a", "b"}}},
+			},
+		})
+		assert.Loosely(t, err, should.BeNil)
+
+		html := out.String()
+		assert.Loosely(t, html, should.ContainSubstring(`<a href="/jobs/proj/c">c</a>`))
+		assert.Loosely(t, html, should.ContainSubstring(`<a href="/jobs/proj/a">a</a>, <a href="/jobs/proj/b">b</a>`))
+		assert.Loosely(t, html, should.ContainSubstring("Stage 1"))
+	})
+}
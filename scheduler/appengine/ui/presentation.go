@@ -273,6 +273,7 @@ var statusToRowClass = map[task.Status]string{
 	task.StatusFailed:    "danger",
 	task.StatusOverrun:   "warning",
 	task.StatusAborted:   "danger",
+	task.StatusSkipped:   "",
 }
 
 var statusToLabelClass = map[task.Status]string{
@@ -283,6 +284,7 @@ var statusToLabelClass = map[task.Status]string{
 	task.StatusFailed:    "label-danger",
 	task.StatusOverrun:   "label-warning",
 	task.StatusAborted:   "label-danger",
+	task.StatusSkipped:   "label-default",
 }
 
 // makeInvocation builds UI presentation of some Invocation of a job.
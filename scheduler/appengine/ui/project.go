@@ -62,6 +62,7 @@ func projectPage(c *router.Context) {
 	templates.MustRender(c.Request.Context(), c.Writer, "pages/project.html", map[string]any{
 		"ProjectID":    projectID,
 		"ProjectEmpty": len(sorted) == 0,
+		"HasDAG":       hasDependencies(jobs),
 		"Filter":       filter,
 		"Jobs":         filtered,
 	})
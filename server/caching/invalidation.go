@@ -0,0 +1,106 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package caching
+
+import (
+	"context"
+	"sync"
+)
+
+// Invalidation is a notification that an item in some global cache namespace
+// has changed and all processes should drop their local copies of it.
+type Invalidation struct {
+	Namespace string // a global cache namespace, see GlobalCache
+	Key       string // a key within this namespace
+}
+
+// InvalidationBus broadcasts cache invalidations to all service processes.
+//
+// It is usually implemented on top of some pub/sub mechanism (e.g. Redis
+// pub/sub, see server/redisconn). Delivery is best effort: messages may be
+// lost (e.g. when a process is reconnecting). Libraries that use the bus must
+// be able to eventually converge without it.
+//
+// Implementations are expected to call DispatchInvalidation for each received
+// message (including ones published by the current process).
+type InvalidationBus interface {
+	// Publish sends the invalidation to all processes subscribed to the bus.
+	Publish(ctx context.Context, inv Invalidation) error
+}
+
+var invalidationBusKey = "server.caching Invalidation Bus"
+
+// WithInvalidationBus installs an invalidation bus implementation into the
+// supplied context.
+func WithInvalidationBus(ctx context.Context, bus InvalidationBus) context.Context {
+	return context.WithValue(ctx, &invalidationBusKey, bus)
+}
+
+// GetInvalidationBus returns the invalidation bus installed in the context or
+// nil if it is not available in the current environment.
+func GetInvalidationBus(ctx context.Context) InvalidationBus {
+	bus, _ := ctx.Value(&invalidationBusKey).(InvalidationBus)
+	return bus
+}
+
+// InvalidationHandler is called when an invalidation for a key arrives.
+//
+// The context is a long-lived background context of the process (with the
+// process cache installed into it). The handler must be fast and must not
+// block.
+type InvalidationHandler func(ctx context.Context, key string)
+
+var (
+	invalidationHandlersM sync.RWMutex
+	invalidationHandlers  = map[string][]InvalidationHandler{}
+)
+
+// RegisterInvalidationHandler registers a callback that is called when an
+// invalidation for the given global cache namespace arrives through the
+// invalidation bus.
+//
+// It must be called during init time. There can be multiple handlers per
+// namespace. They are called in order of registration.
+func RegisterInvalidationHandler(namespace string, h InvalidationHandler) {
+	checkStillInitTime()
+	invalidationHandlersM.Lock()
+	defer invalidationHandlersM.Unlock()
+	invalidationHandlers[namespace] = append(invalidationHandlers[namespace], h)
+}
+
+// HasInvalidationHandlers returns true if some invalidation handlers are
+// registered.
+//
+// InvalidationBus implementations can use it to avoid subscribing to
+// invalidations no one in the process is interested in.
+func HasInvalidationHandlers() bool {
+	invalidationHandlersM.RLock()
+	defer invalidationHandlersM.RUnlock()
+	return len(invalidationHandlers) != 0
+}
+
+// DispatchInvalidation calls all handlers registered for the invalidation's
+// namespace.
+//
+// It is called by InvalidationBus implementations when they receive
+// a message. Invalidations for namespaces without handlers are ignored.
+func DispatchInvalidation(ctx context.Context, inv Invalidation) {
+	invalidationHandlersM.RLock()
+	handlers := invalidationHandlers[inv.Namespace]
+	invalidationHandlersM.RUnlock()
+	for _, h := range handlers {
+		h(ctx, inv.Key)
+	}
+}
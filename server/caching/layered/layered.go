@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math"
+	"sync/atomic"
 	"time"
 
 	"go.chromium.org/luci/common/clock"
@@ -30,10 +32,18 @@ import (
 	"go.chromium.org/luci/server/caching"
 )
 
+// DefaultVersionCheckInterval is the default value of VersionCheckInterval
+// parameter.
+const DefaultVersionCheckInterval = time.Minute
+
 // ErrCantSatisfyMinTTL is returned by GetOrCreate if the factory function
 // produces an item that expires sooner than the requested MinTTL.
 var ErrCantSatisfyMinTTL = errors.New("new item produced by the factory has insufficient TTL")
 
+// errNoGlobalCache is returned by fetchVersion if the global cache is not
+// available.
+var errNoGlobalCache = errors.New("no global cache")
+
 // RegisterCache registers a layered cache used by a process.
 //
 // It must be called during init time to declare an intent that a package
@@ -49,10 +59,24 @@ func RegisterCache[T any](p Parameters[T]) Cache[T] {
 	if p.Unmarshal == nil {
 		panic("Unmarshal is required")
 	}
-	return Cache[T]{
+	if p.VersionCheckInterval < 0 {
+		panic("VersionCheckInterval must be non-negative")
+	}
+	if p.EnableInvalidation && p.VersionCheckInterval == 0 {
+		p.VersionCheckInterval = DefaultVersionCheckInterval
+	}
+	c := Cache[T]{
 		procCache: caching.RegisterLRUCache[string, *itemWithExp[T]](p.ProcessCacheCapacity),
 		params:    p,
 	}
+	if p.EnableInvalidation {
+		caching.RegisterInvalidationHandler(p.GlobalNamespace, func(ctx context.Context, key string) {
+			if lru := c.procCache.LRU(ctx); lru != nil {
+				lru.Remove(key)
+			}
+		})
+	}
+	return c
 }
 
 // Parameters describes parameters of a layered cache.
@@ -86,6 +110,29 @@ type Parameters[T any] struct {
 	// the item. If AllowNoProcessCacheFallback is false, it would instead
 	// return caching.ErrNoProcessCache.
 	AllowNoProcessCacheFallback bool
+
+	// EnableInvalidation is true to allow calling Invalidate.
+	//
+	// When enabled, each cached item is tagged with the current version of its
+	// key, stored in "<GlobalNamespace>.versions" global cache namespace.
+	// Invalidate bumps the version and broadcasts the invalidation to all
+	// processes through caching.InvalidationBus, so they can drop their local
+	// copies of the item. Processes that miss the broadcast notice the version
+	// change within VersionCheckInterval. If the version record is evicted from
+	// the global cache, all copies of the item are considered stale.
+	//
+	// Has a cost of an extra global cache fetch when fetching an item from the
+	// global cache and once per VersionCheckInterval per locally cached item.
+	EnableInvalidation bool
+
+	// VersionCheckInterval is how often to compare the version of an item in the
+	// process cache to the current version of its key in the global cache.
+	//
+	// It bounds how long a process that missed an invalidation broadcast can use
+	// a stale item. Used only if EnableInvalidation is true.
+	//
+	// Default is DefaultVersionCheckInterval.
+	VersionCheckInterval time.Duration
 }
 
 // Cache implements a cache of serializable objects on top of process and
@@ -170,19 +217,21 @@ func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T],
 
 	// Check that the item is in the local cache, its TTL is acceptable and we
 	// don't want to randomly prematurely expire it, see WithRandomizedExpiration.
+	// If invalidation is enabled, also check the item wasn't invalidated while
+	// we weren't looking.
 	var ignored *itemWithExp[T]
 	if item, ok := lru.Get(ctx, key); ok {
-		if item.isAcceptableTTL(now, o.minTTL) && !item.randomlyExpired(ctx, now, o.expRandThreshold) {
+		if item.isAcceptableTTL(now, o.minTTL) && !item.randomlyExpired(ctx, now, o.expRandThreshold) && c.isCurrentVersion(ctx, key, item, now) {
 			return item.val, nil
 		}
 		ignored = item
 	}
 
 	// Either the item is not in the local cache, or the cached copy expires too
-	// soon or we randomly decided that we want to prematurely refresh it. Attempt
-	// to fetch from the global cache or create a new one. Disable expiration
-	// randomization at this point, it has served its purpose already, since only
-	// unlucky callers will reach this code path.
+	// soon, or it was invalidated, or we randomly decided that we want to
+	// prematurely refresh it. Attempt to fetch from the global cache or create
+	// a new one. Disable expiration randomization at this point, it has served
+	// its purpose already, since only unlucky callers will reach this code path.
 	v, err := lru.Create(ctx, key, func() (*itemWithExp[T], time.Duration, error) {
 		// Now that we have the lock, recheck that the item still needs a refresh.
 		// Purposely ignore an item we decided we want to prematurely expire.
@@ -192,14 +241,26 @@ func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T],
 			}
 		}
 
-		// Attempt to grab it from the global cache, verifying TTL is acceptable.
-		if item := c.maybeFetchItem(ctx, key); item != nil && item.isAcceptableTTL(now, o.minTTL) {
+		// If invalidation is enabled, grab the current version of the key before
+		// fetching or creating the item. If the item is invalidated concurrently
+		// with the fetch or creation, it will end up tagged with an old version and
+		// will be ignored by future readers.
+		var ver uint64
+		if c.params.EnableInvalidation {
+			ver = c.currentVersion(ctx, key)
+		}
+
+		// Attempt to grab it from the global cache, verifying TTL and version are
+		// acceptable.
+		if item := c.maybeFetchItem(ctx, key); item != nil && item.isAcceptableTTL(now, o.minTTL) && item.ver == ver {
+			item.checked.Store(now.UnixNano())
 			return item, item.expiration(now), nil
 		}
 
 		// Either a cache miss, problems with the cached item or its TTL is not
 		// acceptable. Need a to make a new item.
-		var item itemWithExp[T]
+		item := &itemWithExp[T]{ver: ver}
+		item.checked.Store(now.UnixNano())
 		val, exp, err := fn()
 		item.val = val
 		switch {
@@ -220,10 +281,10 @@ func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T],
 		// an item here if someone else refreshed it already. But this is
 		// unavoidable given GlobalCache semantics and generally rare and harmless
 		// (given Cache guarantees or rather lack of there of).
-		if err := c.maybeStoreItem(ctx, key, &item, now); err != nil {
+		if err := c.maybeStoreItem(ctx, key, item, now); err != nil {
 			return nil, 0, err
 		}
-		return &item, item.expiration(now), nil
+		return item, item.expiration(now), nil
 	})
 
 	if err != nil {
@@ -233,6 +294,57 @@ func (c *Cache[T]) GetOrCreate(ctx context.Context, key string, fn lru.Maker[T],
 	return v.val, nil
 }
 
+// Invalidate drops the item from the global cache and from process caches of
+// all processes.
+//
+// The process cache of the current process is updated right away. Other
+// processes are notified through caching.InvalidationBus (if available) on
+// a best effort basis. Processes that miss the notification notice the item is
+// stale within VersionCheckInterval.
+//
+// Returns an error if the global cache can't be updated. In that case other
+// processes may keep using the stale item until it expires.
+//
+// Panics if the cache was registered without EnableInvalidation.
+func (c *Cache[T]) Invalidate(ctx context.Context, key string) error {
+	if !c.params.EnableInvalidation {
+		panic("Invalidate requires EnableInvalidation parameter to be set")
+	}
+
+	// Bump the version first. This makes all copies of the item stale, including
+	// ones being stored concurrently by GetOrCreate calls that started before
+	// the invalidation.
+	if g := caching.GlobalCache(ctx, c.versionsNamespace()); g != nil {
+		if err := g.Set(ctx, key, serializeVersion(newVersion(ctx)), 0); err != nil {
+			return errors.Annotate(err, "failed to bump the version of %q", key).Err()
+		}
+	}
+
+	// Drop the item itself, in case the version record is evicted. BlobCache
+	// has no deletion, so overwrite the item with an empty blob (which is treated
+	// as a cache miss) that expires soon.
+	if g := caching.GlobalCache(ctx, c.params.GlobalNamespace); g != nil {
+		if err := g.Set(ctx, key, nil, time.Second); err != nil {
+			return errors.Annotate(err, "failed to drop %q from the global cache", key).Err()
+		}
+	}
+
+	if lru := c.procCache.LRU(ctx); lru != nil {
+		lru.Remove(key)
+	}
+
+	if bus := caching.GetInvalidationBus(ctx); bus != nil {
+		inv := caching.Invalidation{Namespace: c.params.GlobalNamespace, Key: key}
+		if err := bus.Publish(ctx, inv); err != nil {
+			logging.WithError(err).Warningf(ctx,
+				"Failed to broadcast invalidation of %q, other processes will notice it within %s",
+				key, c.params.VersionCheckInterval)
+		}
+	}
+
+	return nil
+}
+
 // CachedLocally returns the number of items stored in the local process memory.
 func (c *Cache[T]) CachedLocally(ctx context.Context) int {
 	return c.procCache.LRU(ctx).Len()
@@ -251,6 +363,10 @@ func (c *Cache[T]) Parameters() Parameters[T] {
 // Serialized items with different value of the first byte are rejected.
 const formatVersionByte = 1
 
+// versionedFormatVersionByte is used instead of formatVersionByte by caches
+// with EnableInvalidation. Such items also contain the version of the key.
+const versionedFormatVersionByte = 2
+
 // options is collection of options for GetOrCreate.
 type options struct {
 	minTTL           time.Duration
@@ -266,10 +382,14 @@ func (o expRandThresholdOpt) apply(opts *options) { opts.expRandThreshold = time
 // itemWithExp is what is actually stored (pointer to it) in the process cache.
 //
 // It is a user-generated value plus its expiration time (or zero time if it
-// doesn't expire).
+// doesn't expire). If invalidation is enabled, it also has the version of the
+// key the item was created under and when this version was last confirmed to
+// be current.
 type itemWithExp[T any] struct {
-	val T
-	exp time.Time
+	val     T
+	exp     time.Time
+	ver     uint64
+	checked atomic.Int64 // unix nanos
 }
 
 // isAcceptableTTL returns true if item's TTL is large enough.
@@ -322,10 +442,105 @@ func (i *itemWithExp[T]) expiration(now time.Time) time.Duration {
 	return d
 }
 
+// versionsNamespace is a global cache namespace with versions of keys.
+func (c *Cache[T]) versionsNamespace() string {
+	return c.params.GlobalNamespace + ".versions"
+}
+
+// isCurrentVersion returns false if the item in the process cache was
+// invalidated.
+//
+// Compares the item version to the current version of the key in the global
+// cache at most once per VersionCheckInterval. Trusts the item if the global
+// cache is not available. Always returns true if invalidation is disabled.
+func (c *Cache[T]) isCurrentVersion(ctx context.Context, key string, item *itemWithExp[T], now time.Time) bool {
+	if !c.params.EnableInvalidation {
+		return true
+	}
+	if now.Sub(time.Unix(0, item.checked.Load())) < c.params.VersionCheckInterval {
+		return true
+	}
+	ver, err := c.fetchVersion(ctx, key)
+	switch {
+	case err == caching.ErrCacheMiss:
+		// The version record was evicted, we don't know if the item is current.
+		return false
+	case err != nil:
+		return true
+	case ver != item.ver:
+		return false
+	default:
+		item.checked.Store(now.UnixNano())
+		return true
+	}
+}
+
+// currentVersion returns the current version of the key, creating the version
+// record in the global cache if it is missing.
+//
+// The record is missing if the key is new or its record was evicted. In the
+// latter case copies of the item tagged with the previous version may still be
+// around, so the new record gets a new random version to make them stale.
+//
+// Returns 0 if the global cache is not available. Logs errors inside.
+func (c *Cache[T]) currentVersion(ctx context.Context, key string) uint64 {
+	switch ver, err := c.fetchVersion(ctx, key); {
+	case err == nil:
+		return ver
+	case err != caching.ErrCacheMiss:
+		return 0
+	}
+	ver := newVersion(ctx)
+	g := caching.GlobalCache(ctx, c.versionsNamespace())
+	if err := g.Set(ctx, key, serializeVersion(ver), 0); err != nil {
+		logging.WithError(err).Errorf(ctx, "Failed to store the version of %q in the global cache", key)
+		return 0
+	}
+	return ver
+}
+
+// fetchVersion fetches the current version of the key from the global cache.
+//
+// Returns caching.ErrCacheMiss if there's no version record for the key. Other
+// errors mean the global cache is not available or the version can't be
+// fetched. They are logged inside.
+func (c *Cache[T]) fetchVersion(ctx context.Context, key string) (uint64, error) {
+	g := caching.GlobalCache(ctx, c.versionsNamespace())
+	if g == nil {
+		return 0, errNoGlobalCache
+	}
+
+	blob, err := g.Get(ctx, key)
+	switch {
+	case err == caching.ErrCacheMiss:
+		return 0, err
+	case err != nil:
+		logging.WithError(err).Errorf(ctx, "Failed to read the version of %q from the global cache", key)
+		return 0, err
+	case len(blob) != 8:
+		logging.Errorf(ctx, "Bad version of %q in the global cache: %q", key, blob)
+		return 0, fmt.Errorf("bad version blob")
+	}
+	return binary.LittleEndian.Uint64(blob), nil
+}
+
+// newVersion generates a random non-zero version.
+func newVersion(ctx context.Context) uint64 {
+	return uint64(mathrand.Int63n(ctx, math.MaxInt64)) + 1
+}
+
+// serializeVersion is reverse of what fetchVersion does.
+func serializeVersion(ver uint64) []byte {
+	blob := make([]byte, 8)
+	binary.LittleEndian.PutUint64(blob, ver)
+	return blob
+}
+
 // maybeFetchItem attempts to fetch the item from the global cache.
 //
 // If the global cache is not available or the cached item there is broken
-// returns nil. Logs errors inside.
+// returns nil. Logs errors inside. Empty blobs (see Invalidate) are silently
+// treated as a cache miss.
 func (c *Cache[T]) maybeFetchItem(ctx context.Context, key string) *itemWithExp[T] {
 	g := caching.GlobalCache(ctx, c.params.GlobalNamespace)
 	if g == nil {
@@ -339,6 +554,9 @@ func (c *Cache[T]) maybeFetchItem(ctx context.Context, key string) *itemWithExp[
 		}
 		return nil
 	}
+	if len(blob) == 0 {
+		return nil // the item was dropped by Invalidate
+	}
 
 	item, err := c.deserializeItem(blob)
 	if err != nil {
@@ -383,11 +601,21 @@ func (c *Cache[T]) serializeItem(item *itemWithExp[T]) ([]byte, error) {
 		deadline = uint64(item.exp.Unix())
 	}
 
-	// <version_byte> + <uint64 deadline timestamp> + <blob>
-	output := make([]byte, 9+len(blob))
-	output[0] = formatVersionByte
+	if !c.params.EnableInvalidation {
+		// <version_byte> + <uint64 deadline timestamp> + <blob>
+		output := make([]byte, 9+len(blob))
+		output[0] = formatVersionByte
+		binary.LittleEndian.PutUint64(output[1:], deadline)
+		copy(output[9:], blob)
+		return output, nil
+	}
+
+	// <version_byte> + <uint64 deadline timestamp> + <uint64 key version> + <blob>
+	output := make([]byte, 17+len(blob))
+	output[0] = versionedFormatVersionByte
 	binary.LittleEndian.PutUint64(output[1:], deadline)
-	copy(output[9:], blob)
+	binary.LittleEndian.PutUint64(output[9:], item.ver)
+	copy(output[17:], blob)
 	return output, nil
 }
 
@@ -397,8 +625,23 @@ func (c *Cache[T]) deserializeItem(blob []byte) (item *itemWithExp[T], err error
 		err = fmt.Errorf("the received buffer is too small")
 		return
 	}
-	if blob[0] != formatVersionByte {
-		err = fmt.Errorf("bad format version, expecting %d, got %d", formatVersionByte, blob[0])
+	// Caches with enabled invalidation understand both formats. Items in the
+	// old format are considered to have version 0.
+	header := 9
+	switch {
+	case blob[0] == formatVersionByte:
+	case blob[0] == versionedFormatVersionByte && c.params.EnableInvalidation:
+		header = 17
+		if len(blob) < header {
+			err = fmt.Errorf("the received buffer is too small")
+			return
+		}
+	default:
+		expected := formatVersionByte
+		if c.params.EnableInvalidation {
+			expected = versionedFormatVersionByte
+		}
+		err = fmt.Errorf("bad format version, expecting %d, got %d", expected, blob[0])
 		return
 	}
 	item = &itemWithExp[T]{}
@@ -406,6 +649,9 @@ func (c *Cache[T]) deserializeItem(blob []byte) (item *itemWithExp[T], err error
 	if deadline != 0 {
 		item.exp = time.Unix(int64(deadline), 0)
 	}
-	item.val, err = c.params.Unmarshal(blob[9:])
+	if header == 17 {
+		item.ver = binary.LittleEndian.Uint64(blob[9:])
+	}
+	item.val, err = c.params.Unmarshal(blob[header:])
 	return
 }
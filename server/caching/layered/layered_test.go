@@ -23,8 +23,11 @@ import (
 	"testing"
 	"time"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/data/rand/mathrand"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/memlogger"
 	"go.chromium.org/luci/common/testing/ftt"
 	"go.chromium.org/luci/common/testing/truth/assert"
 	"go.chromium.org/luci/common/testing/truth/should"
@@ -54,6 +57,29 @@ var testingCacheWithFallback = RegisterCache(Parameters[[]byte]{
 	AllowNoProcessCacheFallback: true,
 })
 
+var testingInvalidatableCache = RegisterCache(Parameters[[]byte]{
+	GlobalNamespace: "invalidatable",
+	Marshal: func(item []byte) ([]byte, error) {
+		return item, nil
+	},
+	Unmarshal: func(blob []byte) ([]byte, error) {
+		return blob, nil
+	},
+	EnableInvalidation: true,
+})
+
+// processesBus delivers invalidations to a bunch of fake processes.
+type processesBus struct {
+	procs []context.Context
+}
+
+func (b *processesBus) Publish(ctx context.Context, inv caching.Invalidation) error {
+	for _, proc := range b.procs {
+		caching.DispatchInvalidation(proc, inv)
+	}
+	return nil
+}
+
 func TestCache(t *testing.T) {
 	t.Parallel()
 
@@ -257,6 +283,122 @@ func TestCache(t *testing.T) {
 	})
 }
 
+func TestInvalidation(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With two processes", t, func(t *ftt.Test) {
+		ctx := context.Background()
+		ctx = mathrand.Set(ctx, rand.New(rand.NewSource(12345)))
+		ctx, tc := testclock.UseTime(ctx, time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC))
+		ctx = cachingtest.WithGlobalCache(ctx, map[string]caching.BlobCache{
+			"invalidatable":          cachingtest.NewBlobCache(),
+			"invalidatable.versions": cachingtest.NewBlobCache(),
+		})
+
+		proc1 := caching.WithEmptyProcessCache(ctx)
+		proc2 := caching.WithEmptyProcessCache(ctx)
+
+		calls := 0
+		value := []byte("v1")
+		getter := func() ([]byte, time.Duration, error) {
+			calls++
+			return value, time.Hour, nil
+		}
+		get := func(ctx context.Context) []byte {
+			item, err := testingInvalidatableCache.GetOrCreate(ctx, "item", getter)
+			assert.Loosely(t, err, should.BeNil)
+			return item
+		}
+
+		// Both processes have the item cached locally.
+		assert.Loosely(t, get(proc1), should.Match([]byte("v1")))
+		assert.Loosely(t, get(proc2), should.Match([]byte("v1")))
+		assert.Loosely(t, calls, should.Equal(1))
+
+		value = []byte("v2")
+
+		t.Run("Broadcast", func(t *ftt.Test) {
+			bus := &processesBus{procs: []context.Context{proc1, proc2}}
+			proc1 = caching.WithInvalidationBus(proc1, bus)
+
+			assert.Loosely(t, testingInvalidatableCache.Invalidate(proc1, "item"), should.BeNil)
+
+			// Both processes see the new value right away.
+			assert.Loosely(t, get(proc2), should.Match([]byte("v2")))
+			assert.Loosely(t, get(proc1), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+		})
+
+		t.Run("Missed broadcast", func(t *ftt.Test) {
+			assert.Loosely(t, testingInvalidatableCache.Invalidate(proc1, "item"), should.BeNil)
+
+			// The process that invalidated the item sees the new value.
+			assert.Loosely(t, get(proc1), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+
+			// The other one uses the stale item for a while.
+			assert.Loosely(t, get(proc2), should.Match([]byte("v1")))
+
+			// But notices the version change eventually and picks up the new item
+			// from the global cache.
+			tc.Add(DefaultVersionCheckInterval)
+			assert.Loosely(t, get(proc2), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+
+			// The item in proc1 is still current.
+			assert.Loosely(t, get(proc1), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+		})
+
+		t.Run("Stale global item is ignored", func(t *ftt.Test) {
+			assert.Loosely(t, testingInvalidatableCache.Invalidate(proc1, "item"), should.BeNil)
+
+			// Simulate a racing GetOrCreate that stores an item created before the
+			// invalidation.
+			blob, err := testingInvalidatableCache.serializeItem(&itemWithExp[[]byte]{
+				val: []byte("v1"),
+				exp: clock.Now(ctx).Add(time.Hour),
+			})
+			assert.Loosely(t, err, should.BeNil)
+			global := caching.GlobalCache(ctx, "invalidatable")
+			assert.Loosely(t, global.Set(ctx, "item", blob, 0), should.BeNil)
+
+			// It is ignored.
+			assert.Loosely(t, get(caching.WithEmptyProcessCache(ctx)), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+		})
+
+		t.Run("Evicted version record", func(t *ftt.Test) {
+			// proc2 misses the broadcast and then the new version record is evicted.
+			assert.Loosely(t, testingInvalidatableCache.Invalidate(proc1, "item"), should.BeNil)
+			versions := caching.GlobalCache(ctx, "invalidatable.versions").(*cachingtest.BlobCache)
+			versions.LRU.Remove("item")
+
+			// The stale item is not resurrected once proc2 rechecks the version.
+			tc.Add(DefaultVersionCheckInterval)
+			assert.Loosely(t, get(proc2), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+		})
+
+		t.Run("Dropped global item is a silent cache miss", func(t *ftt.Test) {
+			assert.Loosely(t, testingInvalidatableCache.Invalidate(proc1, "item"), should.BeNil)
+
+			ctx := memlogger.Use(caching.WithEmptyProcessCache(ctx))
+			assert.Loosely(t, get(ctx), should.Match([]byte("v2")))
+			assert.Loosely(t, calls, should.Equal(2))
+
+			log := logging.Get(ctx).(*memlogger.MemLogger)
+			assert.Loosely(t, log.HasFunc(func(e *memlogger.LogEntry) bool {
+				return e.Level >= logging.Warning
+			}), should.BeFalse)
+		})
+
+		t.Run("Requires EnableInvalidation", func(t *ftt.Test) {
+			assert.Loosely(t, func() { testingCache.Invalidate(ctx, "item") }, should.Panic)
+		})
+	})
+}
+
 func TestSerialization(t *testing.T) {
 	t.Parallel()
 
@@ -275,7 +417,7 @@ func TestSerialization(t *testing.T) {
 		}
 
 		t.Run("Happy path with deadline", func(t *ftt.Test) {
-			originalItem := itemWithExp[[]byte]{[]byte("blah-blah"), now}
+			originalItem := itemWithExp[[]byte]{val: []byte("blah-blah"), exp: now}
 
 			blob, err := c.serializeItem(&originalItem)
 			assert.Loosely(t, err, should.BeNil)
@@ -287,7 +429,7 @@ func TestSerialization(t *testing.T) {
 		})
 
 		t.Run("Happy path without deadline", func(t *ftt.Test) {
-			originalItem := itemWithExp[[]byte]{[]byte("blah-blah"), time.Time{}}
+			originalItem := itemWithExp[[]byte]{val: []byte("blah-blah")}
 
 			blob, err := c.serializeItem(&originalItem)
 			assert.Loosely(t, err, should.BeNil)
@@ -317,13 +459,42 @@ func TestSerialization(t *testing.T) {
 			assert.Loosely(t, err, should.ErrLike("bad format version"))
 		})
 
+		t.Run("Versioned format", func(t *ftt.Test) {
+			c.params.EnableInvalidation = true
+
+			originalItem := itemWithExp[[]byte]{val: []byte("blah-blah"), exp: now, ver: 123}
+			blob, err := c.serializeItem(&originalItem)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, blob[0], should.Equal[byte](versionedFormatVersionByte))
+
+			item, err := c.deserializeItem(blob)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, item.exp.Equal(now), should.BeTrue)
+			assert.Loosely(t, item.ver, should.Equal[uint64](123))
+			assert.Loosely(t, item.val, should.Match(originalItem.val))
+
+			// Non-versioned items are still understood and have version 0.
+			c.params.EnableInvalidation = false
+			blob, err = c.serializeItem(&originalItem)
+			assert.Loosely(t, err, should.BeNil)
+			c.params.EnableInvalidation = true
+			item, err = c.deserializeItem(blob)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, item.ver, should.BeZero)
+			assert.Loosely(t, item.val, should.Match(originalItem.val))
+
+			// Small buffer.
+			_, err = c.deserializeItem([]byte{versionedFormatVersionByte, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+			assert.Loosely(t, err, should.ErrLike("buffer is too small"))
+		})
+
 		t.Run("Unmarshal error", func(t *ftt.Test) {
 			fail := errors.New("failure")
 			c.params.Unmarshal = func(blob []byte) ([]byte, error) {
 				return nil, fail
 			}
 
-			blob, err := c.serializeItem(&itemWithExp[[]byte]{[]byte("blah-blah"), now})
+			blob, err := c.serializeItem(&itemWithExp[[]byte]{val: []byte("blah-blah"), exp: now})
 			assert.Loosely(t, err, should.BeNil)
 
 			_, err = c.deserializeItem(blob)
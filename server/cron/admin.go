@@ -20,6 +20,8 @@ import (
 	"html"
 	"html/template"
 	"net/url"
+	"strings"
+	"time"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
@@ -54,9 +56,52 @@ func (portalPage) Overview(ctx context.Context) (template.HTML, error) {
 			</p>
 		`)
 	}
+	if sched := Default.scheduledHandlers(); len(sched) != 0 {
+		text += scheduledTable(ctx, sched)
+	}
 	return text, nil
 }
 
+// scheduledTable renders a table with state of scheduled handlers.
+func scheduledTable(ctx context.Context, sched []scheduledInfo) template.HTML {
+	now := clock.Now(ctx).UTC()
+	fmtTime := func(t time.Time, none string) string {
+		if t.IsZero() {
+			return none
+		}
+		t = t.UTC().Truncate(time.Second)
+		if t.After(now) {
+			return fmt.Sprintf("%s (in %s)", t.Format(time.RFC3339), t.Sub(now).Truncate(time.Second))
+		}
+		return fmt.Sprintf("%s (%s ago)", t.Format(time.RFC3339), now.Sub(t).Truncate(time.Second))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<p>Handlers registered with a schedule:</p>
+		<table class="table table-condensed">
+		<tr><th>ID</th><th>Schedule</th><th>Next run</th><th>Last run</th><th>Last result</th></tr>
+	`)
+	for _, s := range sched {
+		result := ""
+		switch {
+		case s.LastRun.IsZero():
+		case s.LastErr != nil:
+			result = s.LastErr.Error()
+		default:
+			result = "OK"
+		}
+		fmt.Fprintf(&sb, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+			html.EscapeString(s.ID),
+			html.EscapeString(s.Schedule),
+			html.EscapeString(fmtTime(s.Next, "not running in this process")),
+			html.EscapeString(fmtTime(s.LastRun, "never")),
+			html.EscapeString(result),
+		)
+	}
+	sb.WriteString("</table>\n")
+	return template.HTML(sb.String())
+}
+
 func (portalPage) Actions(ctx context.Context) ([]portal.Action, error) {
 	var actions []portal.Action
 	for _, id := range Default.handlerIDs() {
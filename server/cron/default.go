@@ -29,3 +29,8 @@ var Default Dispatcher
 func RegisterHandler(id string, h Handler) {
 	Default.RegisterHandler(id, h)
 }
+
+// RegisterScheduledHandler is a shortcut for Default.RegisterScheduledHandler.
+func RegisterScheduledHandler(id, schedule string, h Handler) {
+	Default.RegisterScheduledHandler(id, schedule, h)
+}
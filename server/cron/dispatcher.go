@@ -85,6 +85,7 @@ type Dispatcher struct {
 
 	m sync.RWMutex
 	h map[string]Handler
+	s map[string]*scheduled // handlers registered via RegisterScheduledHandler
 }
 
 // handlerIDRe is used to validate handler IDs.
@@ -113,6 +114,35 @@ func (d *Dispatcher) RegisterHandler(id string, h Handler) {
 	d.h[id] = h
 }
 
+// RegisterScheduledHandler registers a cron handler together with a schedule
+// it should run on when the server executes cron handlers by itself (see
+// RunScheduled).
+//
+// The schedule uses the same syntax as LUCI Scheduler job schedules, e.g.
+// "*/5 * * * *", "0 9 * * 1-5 in America/Los_Angeles" or "with 10m interval".
+// Triggered schedules and zero intervals (e.g. "continuously") are not
+// supported.
+//
+// The handler is also reachable via "<serving-prefix>/<id>" exactly like
+// handlers registered via RegisterHandler, so the same binary can still be
+// driven by Cloud Scheduler or cron.yaml when deployed there.
+//
+// Panics if the ID or the schedule are malformed or a handler with such ID is
+// already registered.
+func (d *Dispatcher) RegisterScheduledHandler(id, sched string, h Handler) {
+	s, err := parseSchedule(id, sched)
+	if err != nil {
+		panic(fmt.Sprintf("bad schedule %q of cron handler %q: %s", sched, id, err))
+	}
+	d.RegisterHandler(id, h)
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.s == nil {
+		d.s = make(map[string]*scheduled, 1)
+	}
+	d.s[id] = &scheduled{id: id, sched: s}
+}
+
 // InstallCronRoutes installs routes that handle requests from Cloud Scheduler.
 func (d *Dispatcher) InstallCronRoutes(r *router.Router, prefix string) {
 	if prefix == "" {
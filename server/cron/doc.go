@@ -22,4 +22,18 @@
 // running on Appengine). By default registered handlers are exposed as
 // "/internal/cron/<handler-id>" endpoints. This URL path should be used when
 // configuring Cloud Scheduler jobs or in cron.yaml when running on Appengine.
+//
+// # Running handlers in-process
+//
+// When the server runs elsewhere (e.g. locally, in Kubernetes or in integration
+// tests) it can run handlers by itself. Register them with a schedule via
+// RegisterScheduledHandler and pass -cron-run-scheduled flag to the server:
+//
+//	cron.RegisterScheduledHandler("refresh", "*/5 * * * *", refresh)
+//
+// If the server has multiple replicas, only the elected leader fires each
+// tick. By default the leader is elected through Redis if redisconn module is
+// configured. See -cron-leader-election flag and Elector interface for other
+// options. The admin portal page lists the next planned run of each scheduled
+// handler.
 package cron
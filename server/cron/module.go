@@ -18,12 +18,14 @@ import (
 	"context"
 	"flag"
 	"strings"
+	"time"
 
 	"go.chromium.org/luci/common/errors"
 	luciflag "go.chromium.org/luci/common/flag"
 	"go.chromium.org/luci/common/logging"
 
 	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/redisconn"
 )
 
 // ModuleName can be used to refer to this module when declaring dependencies.
@@ -56,6 +58,36 @@ type ModuleOptions struct {
 	//
 	// Default is an empty list.
 	AuthorizedCallers []string
+
+	// RunScheduled, if true, makes the server run handlers registered via
+	// RegisterScheduledHandler by itself, on their schedules.
+	//
+	// Useful when the server runs outside of Appengine or Cloud Scheduler,
+	// e.g. locally, in Kubernetes or in integration tests.
+	//
+	// Default is false.
+	RunScheduled bool
+
+	// LeaderElection defines how replicas of the server decide which one of
+	// them runs scheduled handlers when RunScheduled is true.
+	//
+	// Possible values:
+	//   * "local": no coordination, each replica runs all scheduled handlers.
+	//   * "redis": use Redis, requires redisconn module to be configured.
+	//   * "auto": use "redis" if Redis is configured, "local" otherwise.
+	//
+	// Ignored if Elector is set. Default is "auto".
+	LeaderElection string
+
+	// LeaderLease is how long an elected replica remains the leader.
+	//
+	// Default is 1 min.
+	LeaderLease time.Duration
+
+	// Elector, if set, is used to elect a replica that runs scheduled handlers.
+	//
+	// Can be used to plug in a custom leader election implementation.
+	Elector Elector
 }
 
 // Register registers the command line flags.
@@ -69,6 +101,18 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		`URL prefix to serve registered cron handlers from, must start with '/internal/'.`)
 	f.Var(luciflag.StringSlice(&o.AuthorizedCallers), "cron-authorized-caller",
 		`Service account email to accept calls from. May be repeated.`)
+	if o.LeaderElection == "" {
+		o.LeaderElection = "auto"
+	}
+	if o.LeaderLease == 0 {
+		o.LeaderLease = time.Minute
+	}
+	f.BoolVar(&o.RunScheduled, "cron-run-scheduled", o.RunScheduled,
+		`If set, run handlers registered with a schedule in-process.`)
+	f.StringVar(&o.LeaderElection, "cron-leader-election", o.LeaderElection,
+		`How replicas elect the one that runs scheduled handlers: "local", "redis" or "auto".`)
+	f.DurationVar(&o.LeaderLease, "cron-leader-lease", o.LeaderLease,
+		`How long an elected replica remains the leader when running scheduled handlers.`)
 }
 
 // NewModule returns a server module that sets up a cron dispatcher.
@@ -102,7 +146,9 @@ func (*cronModule) Name() module.Name {
 
 // Dependencies is part of module.Module interface.
 func (*cronModule) Dependencies() []module.Dependency {
-	return nil
+	return []module.Dependency{
+		module.OptionalDependency(redisconn.ModuleName), // for leader election
+	}
 }
 
 // Initialize is part of module.Module interface.
@@ -121,5 +167,47 @@ func (m *cronModule) Initialize(ctx context.Context, host module.Host, opts modu
 	m.opts.Dispatcher.AuthorizedCallers = m.opts.AuthorizedCallers
 	m.opts.Dispatcher.InstallCronRoutes(host.Routes(), m.opts.ServingPrefix)
 
+	if m.opts.RunScheduled {
+		elector, err := m.elector(ctx)
+		if err != nil {
+			return nil, err
+		}
+		host.RunInBackground("luci.cron.scheduler", func(ctx context.Context) {
+			m.opts.Dispatcher.RunScheduled(ctx, RunOptions{
+				Elector:  elector,
+				LeaseTTL: m.opts.LeaderLease,
+			})
+		})
+	}
+
 	return ctx, nil
 }
+
+// elector returns an Elector to use based on module options.
+func (m *cronModule) elector(ctx context.Context) (Elector, error) {
+	if m.opts.Elector != nil {
+		return m.opts.Elector, nil
+	}
+	mode := m.opts.LeaderElection
+	if mode == "" || mode == "auto" {
+		if redisconn.GetPool(ctx) != nil {
+			mode = "redis"
+		} else {
+			mode = "local"
+		}
+	}
+	switch mode {
+	case "local":
+		logging.Warningf(ctx, "Running scheduled cron handlers without leader election, "+
+			"each replica of the server will run them")
+		return &LocalElector{}, nil
+	case "redis":
+		if redisconn.GetPool(ctx) == nil {
+			return nil, errors.Reason("-cron-leader-election redis requires Redis to be configured").Err()
+		}
+		logging.Infof(ctx, "Using Redis for leader election of cron replicas")
+		return &RedisElector{}, nil
+	default:
+		return nil, errors.Reason(`-cron-leader-election should be "local", "redis" or "auto", got %q`, mode).Err()
+	}
+}
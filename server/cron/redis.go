@@ -0,0 +1,94 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/redisconn"
+)
+
+// DefaultRedisElectorKey is a Redis key used by RedisElector by default.
+const DefaultRedisElectorKey = "luci.cron.leader"
+
+var (
+	// electScript takes or extends the lease if it is free or already ours.
+	electScript = redis.NewScript(1, `
+		local cur = redis.call('GET', KEYS[1])
+		if cur == false or cur == ARGV[1] then
+			redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+			return 1
+		end
+		return 0
+	`)
+
+	// resignScript releases the lease if it is ours.
+	resignScript = redis.NewScript(1, `
+		if redis.call('GET', KEYS[1]) == ARGV[1] then
+			return redis.call('DEL', KEYS[1])
+		end
+		return 0
+	`)
+)
+
+// RedisElector is an Elector that keeps the lease in Redis.
+//
+// It uses the Redis connection pool from the context, see redisconn package.
+type RedisElector struct {
+	// Key is a Redis key to store the lease in.
+	//
+	// Default is DefaultRedisElectorKey.
+	Key string
+}
+
+// Elect is a part of Elector interface.
+func (e *RedisElector) Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error) {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to connect to Redis").Tag(transient.Tag).Err()
+	}
+	defer conn.Close()
+	won, err := redis.Bool(electScript.DoContext(ctx, conn, e.key(), replica, ttl.Milliseconds()))
+	if err != nil {
+		return false, errors.Annotate(err, "failed to elect the leader").Tag(transient.Tag).Err()
+	}
+	return won, nil
+}
+
+// Resign is a part of Elector interface.
+func (e *RedisElector) Resign(ctx context.Context, replica string) error {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return errors.Annotate(err, "failed to connect to Redis").Tag(transient.Tag).Err()
+	}
+	defer conn.Close()
+	if _, err := resignScript.DoContext(ctx, conn, e.key(), replica); err != nil {
+		return errors.Annotate(err, "failed to resign").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+func (e *RedisElector) key() string {
+	if e.Key != "" {
+		return e.Key
+	}
+	return DefaultRedisElectorKey
+}
@@ -0,0 +1,341 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/scheduler/appengine/schedule"
+)
+
+// clockTag tags timers used by the in-process scheduler.
+const clockTag = "cron-scheduler"
+
+// leaseClockTag tags timers used to renew the leadership lease.
+const leaseClockTag = "cron-lease"
+
+// scheduled is a handler registered via RegisterScheduledHandler.
+type scheduled struct {
+	id    string
+	sched *schedule.Schedule
+
+	// Guarded by Dispatcher.m.
+	next    time.Time // when the next run is planned, zero if not running
+	lastRun time.Time // when the last run by this replica started
+	lastErr error     // the outcome of the last run by this replica
+}
+
+// scheduledInfo is a snapshot of the state of a scheduled handler.
+type scheduledInfo struct {
+	ID       string
+	Schedule string
+	Next     time.Time
+	LastRun  time.Time
+	LastErr  error
+}
+
+// parseSchedule parses a schedule of a scheduled handler.
+func parseSchedule(id, sched string) (*schedule.Schedule, error) {
+	if sched == "triggered" {
+		return nil, errors.Reason("triggered schedules are not supported").Err()
+	}
+	s, err := schedule.Parse(sched, idHash(id))
+	if err != nil {
+		return nil, err
+	}
+	if !s.IsAbsolute() && s.Interval() == 0 {
+		return nil, errors.Reason("zero intervals are not supported").Err()
+	}
+	return s, nil
+}
+
+// idHash is a hash of a handler ID used to spread out ticks of handlers with
+// relative schedules, so they don't all run at once.
+func idHash(id string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(id))
+	return h.Sum64()
+}
+
+// nextTick returns when the handler should run next.
+//
+// Ticks of relative schedules are aligned to a wall-clock grid with the
+// schedule interval as a step and an offset derived from the handler ID. This
+// way all replicas agree on tick times regardless of when they started and how
+// long the previous runs took, and the election picks a single replica to run
+// each tick. The returned time is always after `now`.
+func (s *scheduled) nextTick(now, prev time.Time) time.Time {
+	if s.sched.IsAbsolute() {
+		return s.sched.Next(now, prev)
+	}
+	step := int64(s.sched.Interval())
+	offset := int64(idHash(s.id) % uint64(step))
+	sinceTick := (now.UnixNano() - offset) % step
+	if sinceTick < 0 {
+		sinceTick += step
+	}
+	return now.Add(time.Duration(step - sinceTick))
+}
+
+// Elector elects a replica of the server that runs scheduled handlers.
+//
+// The leadership is a lease: a replica that won an election remains the leader
+// for the requested TTL. It can extend its lease by calling Elect again.
+type Elector interface {
+	// Elect attempts to make `replica` the leader or to extend its existing
+	// leadership for the given TTL.
+	//
+	// Returns true if `replica` is the leader now.
+	Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error)
+
+	// Resign gives up the leadership if `replica` is the current leader.
+	Resign(ctx context.Context, replica string) error
+}
+
+// LocalElector is an Elector that keeps the lease in memory of the process.
+//
+// It is appropriate only when there's a single replica of the server, e.g.
+// when running locally or in integration tests.
+type LocalElector struct {
+	m      sync.Mutex
+	leader string
+	expiry time.Time
+}
+
+// Elect is a part of Elector interface.
+func (e *LocalElector) Elect(ctx context.Context, replica string, ttl time.Duration) (bool, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	now := clock.Now(ctx)
+	if e.leader != replica && now.Before(e.expiry) {
+		return false, nil
+	}
+	e.leader = replica
+	e.expiry = now.Add(ttl)
+	return true, nil
+}
+
+// Resign is a part of Elector interface.
+func (e *LocalElector) Resign(ctx context.Context, replica string) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if e.leader == replica {
+		e.leader = ""
+		e.expiry = time.Time{}
+	}
+	return nil
+}
+
+// RunOptions configure the in-process scheduler.
+type RunOptions struct {
+	// Elector is used to elect a replica that runs scheduled handlers.
+	//
+	// Default is a new LocalElector, i.e. no coordination with other replicas.
+	Elector Elector
+
+	// Replica identifies this replica of the server in elections.
+	//
+	// Default is a random string prefixed by the hostname.
+	Replica string
+
+	// LeaseTTL is how long the elected leader remains the leader.
+	//
+	// Should be larger than possible clock skew between replicas. Each tick
+	// extends the lease of the leader (and it is also periodically extended while
+	// a handler is running), so replicas change leadership only when the leader
+	// dies or stops firing ticks for longer than the TTL.
+	//
+	// Default is 1 min.
+	LeaseTTL time.Duration
+}
+
+// RunScheduled runs handlers registered via RegisterScheduledHandler on their
+// schedules until the context is canceled.
+//
+// Several replicas of the server may run it concurrently. Each tick is fired
+// only by the replica that wins the election at the time of the tick. Missed
+// ticks (e.g. when the previous run is still ongoing) are skipped.
+//
+// Relative schedules (e.g. "with 10m interval") are also aligned to the wall
+// clock, so that all replicas fire the same ticks: such handler runs every 10
+// min at the same offset within the interval, not 10 min after the previous
+// run finished.
+//
+// Handlers are executed with the context passed to RunScheduled. Waits for
+// all running handlers to finish before returning.
+func (d *Dispatcher) RunScheduled(ctx context.Context, opts RunOptions) {
+	if opts.Elector == nil {
+		opts.Elector = &LocalElector{}
+	}
+	if opts.Replica == "" {
+		opts.Replica = defaultReplicaID()
+	}
+	if opts.LeaseTTL == 0 {
+		opts.LeaseTTL = time.Minute
+	}
+
+	d.m.RLock()
+	handlers := make([]*scheduled, 0, len(d.s))
+	for _, s := range d.s {
+		handlers = append(handlers, s)
+	}
+	d.m.RUnlock()
+
+	logging.Infof(ctx, "Running %d scheduled cron handler(s) as replica %q", len(handlers), opts.Replica)
+
+	var wg sync.WaitGroup
+	wg.Add(len(handlers))
+	for _, s := range handlers {
+		go func() {
+			defer wg.Done()
+			d.scheduleLoop(ctx, s, &opts)
+		}()
+	}
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := opts.Elector.Resign(ctx, opts.Replica); err != nil {
+		logging.Warningf(ctx, "Failed to resign the cron leadership: %s", err)
+	}
+}
+
+// scheduleLoop runs a single scheduled handler until the context is canceled.
+func (d *Dispatcher) scheduleLoop(ctx context.Context, s *scheduled, opts *RunOptions) {
+	defer d.setNext(s, time.Time{})
+
+	var prev time.Time
+	for {
+		now := clock.Now(ctx)
+		next := s.nextTick(now, prev)
+		d.setNext(s, next)
+		if res := <-clock.After(clock.Tag(ctx, clockTag), next.Sub(now)); res.Err != nil {
+			return
+		}
+
+		leader, err := opts.Elector.Elect(ctx, opts.Replica, opts.LeaseTTL)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			logging.Errorf(ctx, "Skipping a tick of cron handler %q: failed to check the leadership: %s", s.id, err)
+		case !leader:
+			logging.Debugf(ctx, "Skipping a tick of cron handler %q: not the leader", s.id)
+		default:
+			d.runScheduledHandler(ctx, s, opts)
+		}
+
+		prev = clock.Now(ctx)
+	}
+}
+
+// runScheduledHandler executes a scheduled handler, recording its outcome.
+//
+// Keeps renewing the leadership lease while the handler is running, so that
+// other replicas don't start running the handler concurrently.
+func (d *Dispatcher) runScheduledHandler(ctx context.Context, s *scheduled, opts *RunOptions) {
+	d.m.Lock()
+	s.lastRun = clock.Now(ctx)
+	d.m.Unlock()
+
+	done := make(chan struct{})
+	renewerDone := make(chan struct{})
+	go func() {
+		defer close(renewerDone)
+		renewLease(ctx, s.id, opts, done)
+	}()
+
+	err := d.executeHandlerByID(ctx, s.id)
+	close(done)
+	<-renewerDone
+	if err != nil {
+		errors.Log(ctx, errors.Annotate(err, "error in scheduled cron handler %q", s.id).Err())
+	}
+
+	d.m.Lock()
+	s.lastErr = err
+	d.m.Unlock()
+}
+
+// renewLease extends the leadership lease every LeaseTTL/2 until `done` is
+// closed or the context is canceled.
+func renewLease(ctx context.Context, id string, opts *RunOptions, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case res := <-clock.After(clock.Tag(ctx, leaseClockTag), opts.LeaseTTL/2):
+			if res.Err != nil {
+				return
+			}
+		}
+		switch leader, err := opts.Elector.Elect(ctx, opts.Replica, opts.LeaseTTL); {
+		case err != nil:
+			logging.Warningf(ctx, "Failed to renew the cron leadership while running %q: %s", id, err)
+		case !leader:
+			logging.Warningf(ctx, "Lost the cron leadership while running %q", id)
+		}
+	}
+}
+
+// setNext records when a scheduled handler is planned to run next.
+func (d *Dispatcher) setNext(s *scheduled, next time.Time) {
+	d.m.Lock()
+	s.next = next
+	d.m.Unlock()
+}
+
+// scheduledHandlers returns the state of scheduled handlers sorted by ID.
+func (d *Dispatcher) scheduledHandlers() []scheduledInfo {
+	d.m.RLock()
+	defer d.m.RUnlock()
+	out := make([]scheduledInfo, 0, len(d.s))
+	for _, s := range d.s {
+		out = append(out, scheduledInfo{
+			ID:       s.id,
+			Schedule: s.sched.String(),
+			Next:     s.next,
+			LastRun:  s.lastRun,
+			LastErr:  s.lastErr,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+// defaultReplicaID generates an ID of this replica for elections.
+func defaultReplicaID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return fmt.Sprintf("%s-%s", host, hex.EncodeToString(buf))
+}
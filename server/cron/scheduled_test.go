@@ -0,0 +1,376 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/redisconn"
+)
+
+var testTime = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestScheduled(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With dispatcher", t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testTime)
+		ctx = gologger.StdConfig.Use(ctx)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		d := &Dispatcher{}
+
+		// Advance the clock whenever the scheduler sleeps, stopping it after
+		// a number of ticks.
+		ticks := 0
+		tc.SetTimerCallback(func(dur time.Duration, timer clock.Timer) {
+			if testclock.HasTags(timer, clockTag) {
+				if ticks++; ticks > 3 {
+					cancel()
+				} else {
+					tc.Add(dur)
+				}
+			}
+		})
+
+		t.Run("Registration", func(t *ftt.Test) {
+			d.RegisterScheduledHandler("h2", "with 10m interval", func(context.Context) error { return nil })
+			d.RegisterScheduledHandler("h1", "0 * * * *", func(context.Context) error { return nil })
+
+			assert.Loosely(t, d.handlerIDs(), should.Match([]string{"h1", "h2"}))
+			info := d.scheduledHandlers()
+			assert.Loosely(t, info, should.HaveLength(2))
+			assert.Loosely(t, info[0].ID, should.Equal("h1"))
+			assert.Loosely(t, info[0].Schedule, should.Equal("0 * * * *"))
+			assert.Loosely(t, info[0].Next.IsZero(), should.BeTrue)
+			assert.Loosely(t, info[1].ID, should.Equal("h2"))
+
+			assert.Loosely(t, func() {
+				d.RegisterScheduledHandler("h1", "0 * * * *", func(context.Context) error { return nil })
+			}, should.PanicLike("already registered"))
+			assert.Loosely(t, func() {
+				d.RegisterScheduledHandler("h3", "bad schedule", func(context.Context) error { return nil })
+			}, should.PanicLike("bad schedule"))
+			assert.Loosely(t, func() {
+				d.RegisterScheduledHandler("h3", "triggered", func(context.Context) error { return nil })
+			}, should.PanicLike("not supported"))
+			assert.Loosely(t, func() {
+				d.RegisterScheduledHandler("h3", "continuously", func(context.Context) error { return nil })
+			}, should.PanicLike("zero intervals are not supported"))
+		})
+
+		t.Run("Runs on schedule", func(t *ftt.Test) {
+			var runs []time.Time
+			var next []time.Time
+			d.RegisterScheduledHandler("h", "*/10 * * * *", func(ctx context.Context) error {
+				runs = append(runs, clock.Now(ctx))
+				next = append(next, d.scheduledHandlers()[0].Next)
+				return nil
+			})
+
+			d.RunScheduled(ctx, RunOptions{})
+
+			assert.Loosely(t, runs, should.Match([]time.Time{
+				testTime.Add(10 * time.Minute),
+				testTime.Add(20 * time.Minute),
+				testTime.Add(30 * time.Minute),
+			}))
+			assert.Loosely(t, next, should.Match(runs))
+
+			info := d.scheduledHandlers()[0]
+			assert.Loosely(t, info.Next.IsZero(), should.BeTrue)
+			assert.Loosely(t, info.LastRun, should.Match(runs[2]))
+			assert.Loosely(t, info.LastErr, should.BeNil)
+		})
+
+		t.Run("Skips ticks when not the leader", func(t *ftt.Test) {
+			elector := &LocalElector{}
+			won, err := elector.Elect(ctx, "another", time.Hour)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, won, should.BeTrue)
+
+			calls := 0
+			d.RegisterScheduledHandler("h", "*/10 * * * *", func(ctx context.Context) error {
+				calls++
+				return nil
+			})
+
+			d.RunScheduled(ctx, RunOptions{Elector: elector, Replica: "this"})
+			assert.Loosely(t, calls, should.BeZero)
+		})
+	})
+}
+
+func TestScheduledLease(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Renews the lease while the handler is running", t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testTime)
+		ctx = gologger.StdConfig.Use(ctx)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Fire the first tick right away and stop on the second one. Let the
+		// handler know when the lease renewal timer is armed.
+		ticks := 0
+		armed := make(chan struct{}, 1)
+		tc.SetTimerCallback(func(dur time.Duration, timer clock.Timer) {
+			switch {
+			case testclock.HasTags(timer, clockTag):
+				if ticks++; ticks > 1 {
+					cancel()
+				} else {
+					tc.Add(dur)
+				}
+			case testclock.HasTags(timer, leaseClockTag):
+				select {
+				case armed <- struct{}{}:
+				default:
+				}
+			}
+		})
+
+		waitArmed := func() error {
+			select {
+			case <-armed:
+				return nil
+			case <-time.After(10 * time.Second):
+				return errors.New("the lease renewal timer wasn't armed")
+			}
+		}
+
+		elector := &LocalElector{}
+		stolen := false
+
+		d := &Dispatcher{}
+		d.RegisterScheduledHandler("h", "*/10 * * * *", func(ctx context.Context) error {
+			// Run for longer than the lease TTL.
+			for i := 0; i < 3; i++ {
+				if err := waitArmed(); err != nil {
+					return err
+				}
+				tc.Add(30 * time.Second)
+			}
+			// Wait for the last renewal to finish.
+			if err := waitArmed(); err != nil {
+				return err
+			}
+			won, err := elector.Elect(ctx, "another", time.Minute)
+			stolen = won
+			return err
+		})
+
+		d.RunScheduled(ctx, RunOptions{
+			Elector:  elector,
+			Replica:  "this",
+			LeaseTTL: time.Minute,
+		})
+
+		assert.Loosely(t, d.scheduledHandlers()[0].LastErr, should.BeNil)
+		assert.Loosely(t, stolen, should.BeFalse)
+	})
+}
+
+func TestScheduledReplicas(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Replicas run each tick of a relative schedule once", t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testTime)
+		ctx = gologger.StdConfig.Use(ctx)
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		replicas := []string{"a", "b", "c"}
+
+		// Collect deadlines of scheduler timers armed by replicas. Each replica
+		// tags its context with its name.
+		type armedTimer struct {
+			replica  string
+			deadline time.Time
+		}
+		armed := make(chan armedTimer, len(replicas))
+		tc.SetTimerCallback(func(dur time.Duration, timer clock.Timer) {
+			for _, r := range replicas {
+				if testclock.HasTags(timer, r, clockTag) {
+					armed <- armedTimer{r, tc.Now().Add(dur)}
+				}
+			}
+		})
+
+		waitArmed := func() armedTimer {
+			select {
+			case a := <-armed:
+				return a
+			case <-time.After(10 * time.Second):
+				t.Fatalf("the scheduler timer wasn't armed")
+				return armedTimer{}
+			}
+		}
+
+		var m sync.Mutex
+		var runs []time.Time
+		elector := &LocalElector{}
+		pending := map[string]time.Time{}
+
+		var wg sync.WaitGroup
+		start := func(replica string) {
+			d := &Dispatcher{}
+			d.RegisterScheduledHandler("h", "with 10m interval", func(ctx context.Context) error {
+				m.Lock()
+				runs = append(runs, clock.Now(ctx))
+				m.Unlock()
+				return nil
+			})
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.RunScheduled(clock.Tag(ctx, replica), RunOptions{
+					Elector:  elector,
+					Replica:  replica,
+					LeaseTTL: time.Minute,
+				})
+			}()
+			a := waitArmed()
+			pending[a.replica] = a.deadline
+		}
+
+		// Moves the clock tick by tick, waiting for replicas that fired a tick to
+		// arm their timers again.
+		advance := func(until time.Time) {
+			for {
+				var next time.Time
+				for _, deadline := range pending {
+					if next.IsZero() || deadline.Before(next) {
+						next = deadline
+					}
+				}
+				if next.After(until) {
+					tc.Set(until)
+					return
+				}
+				fired := 0
+				for r, deadline := range pending {
+					if !deadline.After(next) {
+						delete(pending, r)
+						fired++
+					}
+				}
+				tc.Set(next)
+				for ; fired > 0; fired-- {
+					a := waitArmed()
+					pending[a.replica] = a.deadline
+				}
+			}
+		}
+
+		// Start replicas at different times.
+		start("a")
+		advance(testTime.Add(7 * time.Minute))
+		start("b")
+		advance(testTime.Add(23*time.Minute + 17*time.Second))
+		start("c")
+		advance(testTime.Add(3 * time.Hour))
+
+		cancel()
+		wg.Wait()
+
+		assert.Loosely(t, len(runs), should.BeGreaterThan(15))
+		for i := 1; i < len(runs); i++ {
+			assert.Loosely(t, runs[i].Sub(runs[i-1]), should.Equal(10*time.Minute))
+		}
+	})
+}
+
+func TestElectors(t *testing.T) {
+	t.Parallel()
+
+	testElector := func(t *ftt.Test, ctx context.Context, tc testclock.TestClock, e Elector) {
+		elect := func(replica string) bool {
+			won, err := e.Elect(ctx, replica, time.Minute)
+			assert.Loosely(t, err, should.BeNil, truth.LineContext(1))
+			return won
+		}
+
+		// The first replica wins and can extend the lease.
+		assert.Loosely(t, elect("a"), should.BeTrue)
+		assert.Loosely(t, elect("b"), should.BeFalse)
+		tc.Add(50 * time.Second)
+		assert.Loosely(t, elect("a"), should.BeTrue)
+		tc.Add(50 * time.Second)
+		assert.Loosely(t, elect("b"), should.BeFalse)
+
+		// Another replica takes over after the lease expires.
+		tc.Add(time.Minute)
+		assert.Loosely(t, elect("b"), should.BeTrue)
+		assert.Loosely(t, elect("a"), should.BeFalse)
+
+		// Resigning by a non-leader is noop.
+		assert.Loosely(t, e.Resign(ctx, "a"), should.BeNil)
+		assert.Loosely(t, elect("a"), should.BeFalse)
+
+		// Resigning by the leader frees the lease.
+		assert.Loosely(t, e.Resign(ctx, "b"), should.BeNil)
+		assert.Loosely(t, elect("a"), should.BeTrue)
+	}
+
+	ftt.Run("LocalElector", t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testTime)
+		testElector(t, ctx, tc, &LocalElector{})
+	})
+
+	ftt.Run("RedisElector", t, func(t *ftt.Test) {
+		s, err := miniredis.Run()
+		assert.Loosely(t, err, should.BeNil)
+		defer s.Close()
+
+		pool := &redis.Pool{
+			Dial: func() (redis.Conn, error) { return redis.Dial("tcp", s.Addr()) },
+		}
+		defer pool.Close()
+
+		ctx, tc := testclock.UseTime(context.Background(), testTime)
+		ctx = redisconn.UsePool(ctx, pool)
+
+		// miniredis doesn't follow the test clock, forward it explicitly.
+		testElector(t, ctx, &redisClock{TestClock: tc, s: s}, &RedisElector{})
+	})
+}
+
+// redisClock is a TestClock that also advances miniredis time.
+type redisClock struct {
+	testclock.TestClock
+	s *miniredis.Miniredis
+}
+
+func (c *redisClock) Add(d time.Duration) {
+	c.TestClock.Add(d)
+	c.s.FastForward(d)
+}
@@ -111,6 +111,12 @@
 //	  _ "go.chromium.org/luci/server/encryptedcookies/session/datastore"
 //	)
 //
+// Other available implementations are ".../session/redis" (requires
+// server/redisconn module) and ".../session/spanner" (requires server/span
+// module). If more than one implementation is linked in, pick one via
+// `-encrypted-cookies-session-store-kind` flag ("datastore", "redis" or
+// "spanner").
+//
 // # Inactive sessions cleanup
 //
 // When using Cloud Datastore as a session storage, configure a time-to-live
@@ -118,6 +124,13 @@
 // field. See https://cloud.google.com/datastore/docs/ttl. This step is usually
 // done via Terraform.
 //
+// When using Redis, sessions are stored as keys with a TTL and expire on their
+// own.
+//
+// When using Cloud Spanner, create `EncryptedCookiesSessions` table as defined
+// in session/spanner/init_db.sql. Its row deletion policy takes care of the
+// cleanup.
+//
 // A session is considered expired if it wasn't accessed for more than 14 days.
 //
 // # Exposed routes
@@ -61,6 +61,9 @@ type ModuleOptions struct {
 	RedirectURL string
 
 	// SessionStoreKind can be used to pick a concrete implementation of a store.
+	//
+	// One of "datastore", "redis" or "spanner", depending on what
+	// implementations are linked into the binary.
 	SessionStoreKind string
 
 	// SessionStoreNamespace can be used to namespace sessions in the store.
@@ -40,7 +40,7 @@ import (
 //
 // It defines how long to keep inactive session in the datastore before they
 // are cleaned up by a TTL policy.
-const InactiveSessionExpiration time.Duration = 14 * 24 * time.Hour
+const InactiveSessionExpiration = session.InactiveSessionExpiration
 
 // Store uses Cloud Datastore for sessions.
 type Store struct {
@@ -107,13 +107,7 @@ func (s *Store) UpdateSession(ctx context.Context, id session.ID, cb func(*sessi
 		if proto.Equal(mutable, original) {
 			return nil
 		}
-		var lastRefresh time.Time
-		if mutable.LastRefresh != nil {
-			lastRefresh = mutable.LastRefresh.AsTime()
-		} else {
-			lastRefresh = clock.Now(ctx).UTC()
-		}
-		exp := lastRefresh.Add(InactiveSessionExpiration)
+		exp := session.ExpirationTime(mutable, clock.Now(ctx))
 		return datastore.Put(ctx, makeEntity(id, mutable, exp))
 	}, &datastore.TransactionOptions{Attempts: 5})
 	if err == cbErr {
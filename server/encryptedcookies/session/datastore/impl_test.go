@@ -29,9 +29,17 @@ import (
 	"go.chromium.org/luci/gae/service/datastore"
 
 	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/internal/storetest"
 	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
 )
 
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	ctx := memory.Use(context.Background())
+	storetest.RunConformance(ctx, t, &Store{})
+}
+
 func TestWorks(t *testing.T) {
 	t.Parallel()
 
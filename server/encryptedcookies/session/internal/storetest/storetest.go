@@ -0,0 +1,142 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storetest contains a conformance test suite for session.Store
+// implementations.
+package storetest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+)
+
+// RunConformance tests the store implementation satisfies session.Store
+// contract.
+//
+// The context should be configured with everything the store needs.
+func RunConformance(ctx context.Context, t *testing.T, store session.Store) {
+	ftt.Run("Conformance", t, func(t *ftt.Test) {
+		fetch := func(id session.ID) *sessionpb.Session {
+			s, err := store.FetchSession(ctx, id)
+			assert.Loosely(t, err, should.BeNil)
+			return s
+		}
+
+		t.Run("Missing session", func(t *ftt.Test) {
+			assert.Loosely(t, fetch(session.GenerateID()), should.BeNil)
+		})
+
+		t.Run("Create and update", func(t *ftt.Test) {
+			id := session.GenerateID()
+
+			err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				assert.Loosely(t, s, should.Match(&sessionpb.Session{}))
+				s.State = sessionpb.State_STATE_OPEN
+				s.Generation = 1
+				s.Email = "abc@example.com"
+				return nil
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, fetch(id), should.Match(&sessionpb.Session{
+				State:      sessionpb.State_STATE_OPEN,
+				Generation: 1,
+				Email:      "abc@example.com",
+			}))
+
+			err = store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				assert.Loosely(t, s.Email, should.Equal("abc@example.com"))
+				s.State = sessionpb.State_STATE_CLOSED
+				s.Generation++
+				return nil
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, fetch(id), should.Match(&sessionpb.Session{
+				State:      sessionpb.State_STATE_CLOSED,
+				Generation: 2,
+				Email:      "abc@example.com",
+			}))
+
+			// Other sessions are unaffected.
+			assert.Loosely(t, fetch(session.GenerateID()), should.BeNil)
+		})
+
+		t.Run("Noop update doesn't create a session", func(t *ftt.Test) {
+			id := session.GenerateID()
+			err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error { return nil })
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, fetch(id), should.BeNil)
+		})
+
+		t.Run("Callback errors are returned as is", func(t *ftt.Test) {
+			id := session.GenerateID()
+			boom := errors.New("boom")
+			err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				s.State = sessionpb.State_STATE_OPEN
+				return boom
+			})
+			assert.Loosely(t, err, should.Equal(boom))
+			assert.Loosely(t, fetch(id), should.BeNil)
+		})
+
+		t.Run("Concurrent updates", func(t *ftt.Test) {
+			id := session.GenerateID()
+
+			const workers = 5
+			const updates = 3
+
+			var wg sync.WaitGroup
+			errs := make(chan error, workers)
+			for range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for range updates {
+						// Retry transient errors (e.g. due to contention), there must be no
+						// lost updates.
+						for {
+							err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+								s.Generation++
+								return nil
+							})
+							if err == nil {
+								break
+							}
+							if !transient.Tag.In(err) {
+								errs <- err
+								return
+							}
+						}
+					}
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				assert.Loosely(t, err, should.BeNil)
+			}
+			assert.Loosely(t, fetch(id).Generation, should.Equal[int32](workers*updates))
+		})
+	})
+}
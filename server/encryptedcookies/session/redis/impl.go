@@ -0,0 +1,182 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements session storage over Redis.
+//
+// Sessions are stored as serialized protos under keys with a TTL, so inactive
+// and closed sessions are deleted by Redis itself once they expire. See
+// session.InactiveSessionExpiration.
+//
+// Uses the Redis connection pool configured via server/redisconn module.
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/encryptedcookies/internal"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+// maxAttempts is how many times UpdateSession attempts to commit an update if
+// the session is concurrently modified.
+const maxAttempts = 5
+
+// Store uses Redis for sessions.
+type Store struct {
+	Namespace string // a prefix for Redis keys or "" for default
+}
+
+var _ session.Store = (*Store)(nil)
+
+func init() {
+	internal.RegisterStoreImpl(internal.StoreImpl{
+		ID: "redis",
+		Factory: func(ctx context.Context, namespace string) (session.Store, error) {
+			return &Store{Namespace: namespace}, nil
+		},
+		Deps: []module.Dependency{
+			module.RequiredDependency(redisconn.ModuleName),
+		},
+	})
+}
+
+// FetchSession fetches an existing session with the given ID.
+//
+// Returns (nil, nil) if there's no such session. All errors are transient.
+func (s *Store) FetchSession(ctx context.Context, id session.ID) (*sessionpb.Session, error) {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+	defer conn.Close()
+	return fetch(ctx, conn, s.key(id))
+}
+
+// UpdateSession transactionally updates or creates a session.
+//
+// If fetches the session, calls the callback to mutate it, and stores the
+// result. If it is a new session, the callback receives an empty proto.
+//
+// The callback may be called multiple times in case the transaction is
+// retried. Errors from callbacks are returned as is. All other errors are
+// transient.
+func (s *Store) UpdateSession(ctx context.Context, id session.ID, cb func(*sessionpb.Session) error) error {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return transient.Tag.Apply(err)
+	}
+	defer conn.Close()
+
+	key := s.key(id)
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		switch committed, err := update(ctx, conn, key, cb); {
+		case err != nil:
+			return err
+		case committed:
+			return nil
+		}
+	}
+	return errors.Reason("too many concurrent modifications of session %s", id).Tag(transient.Tag).Err()
+}
+
+// key returns a Redis key with the session.
+func (s *Store) key(id session.ID) string {
+	if s.Namespace != "" {
+		return fmt.Sprintf("%s:encryptedcookies.Session:%s", s.Namespace, id)
+	}
+	return fmt.Sprintf("encryptedcookies.Session:%s", id)
+}
+
+// fetch fetches and deserializes a session, returning (nil, nil) if missing.
+func fetch(ctx context.Context, conn redis.Conn, key string) (*sessionpb.Session, error) {
+	blob, err := redis.Bytes(redis.DoContext(conn, ctx, "GET", key))
+	switch {
+	case err == redis.ErrNil:
+		return nil, nil
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	}
+	s := &sessionpb.Session{}
+	if err := proto.Unmarshal(blob, s); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal the session").Tag(transient.Tag).Err()
+	}
+	return s, nil
+}
+
+// update does one attempt to update the session via optimistic locking.
+//
+// Returns (false, nil) if the session was modified concurrently and the update
+// should be retried. Pooled connections take care of unwatching keys when they
+// are returned to the pool.
+func update(ctx context.Context, conn redis.Conn, key string, cb func(*sessionpb.Session) error) (committed bool, err error) {
+	if _, err := redis.DoContext(conn, ctx, "WATCH", key); err != nil {
+		return false, transient.Tag.Apply(err)
+	}
+
+	mutable, err := fetch(ctx, conn, key)
+	if err != nil {
+		return false, err
+	}
+	if mutable == nil {
+		mutable = &sessionpb.Session{}
+	}
+	original := proto.Clone(mutable)
+	if err := cb(mutable); err != nil {
+		return false, err
+	}
+	if proto.Equal(mutable, original) {
+		return true, nil
+	}
+
+	blob, err := proto.Marshal(mutable)
+	if err != nil {
+		return false, errors.Annotate(err, "failed to marshal the session").Err()
+	}
+
+	// Note: closed sessions may already be past their expiration time, in which
+	// case they are just deleted.
+	now := clock.Now(ctx)
+	ttl := session.ExpirationTime(mutable, now).Sub(now)
+
+	if err := conn.Send("MULTI"); err != nil {
+		return false, transient.Tag.Apply(err)
+	}
+	if ttl < time.Millisecond {
+		err = conn.Send("DEL", key)
+	} else {
+		err = conn.Send("SET", key, blob, "PX", ttl.Milliseconds())
+	}
+	if err != nil {
+		return false, transient.Tag.Apply(err)
+	}
+	switch reply, err := redis.DoContext(conn, ctx, "EXEC"); {
+	case err == redis.ErrNil || (err == nil && reply == nil):
+		return false, nil // the key was modified concurrently
+	case err != nil:
+		return false, transient.Tag.Apply(err)
+	}
+	return true, nil
+}
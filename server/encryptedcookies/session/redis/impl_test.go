@@ -0,0 +1,115 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/internal/storetest"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+func testContext(t testing.TB) (context.Context, *miniredis.Miniredis) {
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+
+	pool := &redis.Pool{
+		MaxActive: 16,
+		Wait:      true,
+		Dial:      func() (redis.Conn, error) { return redis.Dial("tcp", s.Addr()) },
+	}
+	t.Cleanup(func() { pool.Close() })
+
+	return redisconn.UsePool(context.Background(), pool), s
+}
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	ctx, _ := testContext(t)
+	storetest.RunConformance(ctx, t, &Store{Namespace: "ns"})
+}
+
+func TestExpiry(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With Redis", t, func(t *ftt.Test) {
+		ctx, srv := testContext(t)
+		testTime := testclock.TestRecentTimeUTC.Round(time.Millisecond)
+		ctx, _ = testclock.UseTime(ctx, testTime)
+
+		store := Store{Namespace: "ns"}
+		id := session.GenerateID()
+		key := store.key(id)
+
+		update := func(cb func(s *sessionpb.Session)) {
+			err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+				cb(s)
+				return nil
+			})
+			assert.Loosely(t, err, should.BeNil)
+		}
+
+		t.Run("Session without LastRefresh", func(t *ftt.Test) {
+			update(func(s *sessionpb.Session) { s.State = sessionpb.State_STATE_OPEN })
+			assert.Loosely(t, srv.TTL(key), should.Equal(session.InactiveSessionExpiration))
+		})
+
+		t.Run("Closed session", func(t *ftt.Test) {
+			update(func(s *sessionpb.Session) {
+				s.State = sessionpb.State_STATE_OPEN
+				s.LastRefresh = timestamppb.New(testTime.Add(-time.Hour))
+			})
+			assert.Loosely(t, srv.TTL(key), should.Equal(session.InactiveSessionExpiration-time.Hour))
+
+			update(func(s *sessionpb.Session) {
+				s.State = sessionpb.State_STATE_CLOSED
+				s.Closed = timestamppb.New(testTime)
+			})
+			assert.Loosely(t, srv.TTL(key), should.Equal(session.InactiveSessionExpiration-time.Hour))
+
+			// Deleted by Redis when expires.
+			srv.FastForward(session.InactiveSessionExpiration)
+			s, err := store.FetchSession(ctx, id)
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, s, should.BeNil)
+		})
+
+		t.Run("Already expired session", func(t *ftt.Test) {
+			update(func(s *sessionpb.Session) { s.State = sessionpb.State_STATE_OPEN })
+			update(func(s *sessionpb.Session) {
+				s.State = sessionpb.State_STATE_CLOSED
+				s.LastRefresh = timestamppb.New(testTime.Add(-session.InactiveSessionExpiration))
+			})
+			assert.Loosely(t, srv.Exists(key), should.BeFalse)
+		})
+	})
+}
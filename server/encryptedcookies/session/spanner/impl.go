@@ -0,0 +1,165 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spanner implements session storage over Cloud Spanner.
+//
+// Sessions are stored in EncryptedCookiesSessions table, see init_db.sql for
+// its schema. The table has a row deletion policy that cleans up inactive and
+// closed sessions based on session.InactiveSessionExpiration.
+//
+// Uses the Spanner client configured via server/span module.
+package spanner
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/encryptedcookies/internal"
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/span"
+)
+
+// TableName is the name of the Spanner table with sessions.
+const TableName = "EncryptedCookiesSessions"
+
+// Store uses Cloud Spanner for sessions.
+type Store struct {
+	Namespace string // the value of Namespace column to use or "" for default
+}
+
+var _ session.Store = (*Store)(nil)
+
+func init() {
+	internal.RegisterStoreImpl(internal.StoreImpl{
+		ID: "spanner",
+		Factory: func(ctx context.Context, namespace string) (session.Store, error) {
+			return &Store{Namespace: namespace}, nil
+		},
+		Deps: []module.Dependency{
+			module.RequiredDependency(span.ModuleName),
+		},
+	})
+}
+
+// FetchSession fetches an existing session with the given ID.
+//
+// Returns (nil, nil) if there's no such session. All errors are transient.
+func (s *Store) FetchSession(ctx context.Context, id session.ID) (*sessionpb.Session, error) {
+	ctx, cancel := span.ReadOnlyTransaction(ctx)
+	defer cancel()
+	return s.fetch(ctx, id)
+}
+
+// UpdateSession transactionally updates or creates a session.
+//
+// If fetches the session, calls the callback to mutate it, and stores the
+// result. If it is a new session, the callback receives an empty proto.
+//
+// The callback may be called multiple times in case the transaction is
+// retried. Errors from callbacks are returned as is. All other errors are
+// transient.
+func (s *Store) UpdateSession(ctx context.Context, id session.ID, cb func(*sessionpb.Session) error) error {
+	var cbErr error
+	_, err := span.ReadWriteTransaction(ctx, func(ctx context.Context) error {
+		cbErr = nil
+		mutable, err := s.fetch(ctx, id)
+		if err != nil {
+			return err
+		}
+		if mutable == nil {
+			mutable = &sessionpb.Session{}
+		}
+		original := proto.Clone(mutable)
+		if cbErr = cb(mutable); cbErr != nil {
+			return cbErr
+		}
+		if proto.Equal(mutable, original) {
+			return nil
+		}
+		m, err := s.mutation(id, mutable, session.ExpirationTime(mutable, clock.Now(ctx)))
+		if err != nil {
+			return err
+		}
+		span.BufferWrite(ctx, m)
+		return nil
+	})
+	if err == cbErr {
+		return cbErr // can also be nil on success
+	}
+	return transient.Tag.Apply(err)
+}
+
+// fetch reads the session in the current transaction.
+func (s *Store) fetch(ctx context.Context, id session.ID) (*sessionpb.Session, error) {
+	row, err := span.ReadRow(ctx, TableName, spanner.Key{s.Namespace, rowID(id)}, []string{"Session"})
+	switch {
+	case spanner.ErrCode(err) == codes.NotFound:
+		return nil, nil
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	}
+	var blob []byte
+	if err := row.Column(0, &blob); err != nil {
+		return nil, errors.Annotate(err, "failed to read the session").Tag(transient.Tag).Err()
+	}
+	sess := &sessionpb.Session{}
+	if err := proto.Unmarshal(blob, sess); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal the session").Tag(transient.Tag).Err()
+	}
+	return sess, nil
+}
+
+// mutation returns a mutation that stores the session.
+func (s *Store) mutation(id session.ID, sess *sessionpb.Session, exp time.Time) (*spanner.Mutation, error) {
+	blob, err := proto.Marshal(sess)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to marshal the session").Err()
+	}
+	return spanner.InsertOrUpdateMap(TableName, map[string]any{
+		"Namespace":   s.Namespace,
+		"SessionID":   rowID(id),
+		"Session":     blob,
+		"State":       int64(sess.State),
+		"Created":     asTime(sess.Created),
+		"LastRefresh": asTime(sess.LastRefresh),
+		"NextRefresh": asTime(sess.NextRefresh),
+		"Closed":      asTime(sess.Closed),
+		"Sub":         spanner.NullString{StringVal: sess.Sub, Valid: sess.Sub != ""},
+		"Email":       spanner.NullString{StringVal: sess.Email, Valid: sess.Email != ""},
+		"ExpireAt":    exp,
+	}), nil
+}
+
+func rowID(id session.ID) string {
+	return base64.RawStdEncoding.EncodeToString(id)
+}
+
+func asTime(t *timestamppb.Timestamp) spanner.NullTime {
+	if t == nil {
+		return spanner.NullTime{}
+	}
+	return spanner.NullTime{Time: t.AsTime(), Valid: true}
+}
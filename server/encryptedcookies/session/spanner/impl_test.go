@@ -0,0 +1,74 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanner
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/spantest"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/encryptedcookies/session"
+	"go.chromium.org/luci/server/encryptedcookies/session/internal/storetest"
+	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
+	"go.chromium.org/luci/server/span"
+)
+
+func TestConformance(t *testing.T) {
+	ctx := spantest.SpannerTestContext(t, cleanupDatabase)
+	storetest.RunConformance(ctx, t, &Store{Namespace: "ns"})
+}
+
+func TestExpireAt(t *testing.T) {
+	ftt.Run("With Spanner", t, func(t *ftt.Test) {
+		ctx := spantest.SpannerTestContext(t, cleanupDatabase)
+		testTime := testclock.TestRecentTimeUTC.Round(time.Millisecond)
+		ctx, _ = testclock.UseTime(ctx, testTime)
+
+		store := Store{}
+		id := session.GenerateID()
+
+		expireAt := func() time.Time {
+			row, err := span.ReadRow(span.Single(ctx), TableName, spanner.Key{"", rowID(id)}, []string{"ExpireAt"})
+			assert.Loosely(t, err, should.BeNil)
+			var exp time.Time
+			assert.Loosely(t, row.Column(0, &exp), should.BeNil)
+			return exp.UTC()
+		}
+
+		err := store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+			s.State = sessionpb.State_STATE_OPEN
+			s.Email = "abc@example.com"
+			return nil
+		})
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, expireAt(), should.Match(testTime.Add(session.InactiveSessionExpiration)))
+
+		err = store.UpdateSession(ctx, id, func(s *sessionpb.Session) error {
+			s.State = sessionpb.State_STATE_CLOSED
+			s.LastRefresh = timestamppb.New(testTime.Add(5 * time.Hour))
+			return nil
+		})
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, expireAt(), should.Match(testTime.Add(5*time.Hour+session.InactiveSessionExpiration)))
+	})
+}
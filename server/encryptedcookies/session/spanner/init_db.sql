@@ -0,0 +1,39 @@
+-- Copyright 2025 The LUCI Authors.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+--------------------------------------------------------------------------------
+-- This script initializes Spanner tables required by the encryptedcookies
+-- session store. Apply it as part of the database schema of the service.
+CREATE TABLE EncryptedCookiesSessions (
+    -- Namespace is Store.Namespace, usually empty.
+    Namespace STRING(MAX) NOT NULL,
+    -- SessionID is base64-encoded session.ID.
+    SessionID STRING(MAX) NOT NULL,
+    -- Session is serialized sessionpb.Session.
+    Session BYTES(MAX) NOT NULL,
+
+    -- Fields extracted from Session for ad-hoc queries.
+    State INT64 NOT NULL,
+    Created TIMESTAMP,
+    LastRefresh TIMESTAMP,
+    NextRefresh TIMESTAMP,
+    Closed TIMESTAMP,
+    Sub STRING(MAX),
+    Email STRING(MAX),
+
+    -- ExpireAt is used by the row deletion policy to clean up inactive and
+    -- closed sessions.
+    ExpireAt TIMESTAMP NOT NULL,
+) PRIMARY KEY (Namespace, SessionID),
+  ROW DELETION POLICY (OLDER_THAN(ExpireAt, INTERVAL 0 DAY));
@@ -20,10 +20,28 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
+	"time"
 
 	"go.chromium.org/luci/server/encryptedcookies/session/sessionpb"
 )
 
+// InactiveSessionExpiration defines how long to keep inactive (including
+// closed) sessions in a store before they are cleaned up by the store's TTL
+// mechanism.
+const InactiveSessionExpiration time.Duration = 14 * 24 * time.Hour
+
+// ExpirationTime returns when the stored session can be deleted.
+//
+// It is derived from the session's LastRefresh (or `now` if LastRefresh is not
+// populated) based on InactiveSessionExpiration.
+func ExpirationTime(s *sessionpb.Session, now time.Time) time.Time {
+	lastRefresh := now
+	if s.LastRefresh != nil {
+		lastRefresh = s.LastRefresh.AsTime()
+	}
+	return lastRefresh.UTC().Add(InactiveSessionExpiration)
+}
+
 // ID identifies a session.
 type ID []byte
 
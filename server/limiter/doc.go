@@ -14,7 +14,10 @@
 
 // Package limiter implements load shedding for servers.
 //
-// Currently only supports setting a hard limit on a number of concurrently
-// processed requests. Over-engineered to support more features in the future
-// without significantly altering its API.
+// Supports setting a hard limit on a number of concurrently processed requests
+// or deriving this limit from the observed latency (see AdaptiveOptions). A part
+// of the limit can be reserved for particular classes of peers (see
+// PeerClassFromAuthState), so that a single noisy peer can't starve the rest.
+// Requests of lower priority (see prpc.Priority) are rejected first when the
+// load grows (see Options.PriorityLimits).
 package limiter
@@ -20,18 +20,33 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/grpc/prpc"
 )
 
 // NewServerInterceptor returns a UnifiedServerInterceptor that uses the given
 // limiter to accept or drop gRPC requests.
+//
+// The priority of requests is taken from prpc.PriorityFromContext. Requests
+// rejected due to their low priority are failed with ResourceExhausted, other
+// rejected requests are failed with Unavailable. Both come with a retry-after
+// hint in the response metadata (see prpc.SetRetryAfter).
 func NewServerInterceptor(l *Limiter) grpcutil.UnifiedServerInterceptor {
 	return func(ctx context.Context, fullMethod string, handler func(context.Context) error) error {
 		done, err := l.CheckRequest(ctx, &RequestInfo{
 			CallLabel: fullMethod,
 			PeerLabel: PeerLabelFromAuthState(ctx),
+			PeerClass: PeerClassFromAuthState(ctx),
+			Priority:  prpc.PriorityFromContext(ctx),
 		})
 		if err != nil {
+			if herr := prpc.SetRetryAfter(ctx, l.RetryAfter()); herr != nil {
+				logging.Warningf(ctx, "Failed to set the retry-after hint: %s", herr)
+			}
+			if IsLowPriorityRejection(err) {
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
 			return status.Error(codes.Unavailable, err.Error())
 		}
 		defer done()
@@ -17,17 +17,26 @@ package limiter
 import (
 	"context"
 	"fmt"
-	"sync/atomic"
+	"math"
+	"sync"
+	"time"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/errors/errtag"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/tsmon/field"
 	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/grpc/prpc"
 )
 
 // ErrLimitReached is returned by CheckRequest when some limit is reached.
 var ErrLimitReached = errors.New("the server limit reached")
 
+// lowPriorityTag is applied to errors caused by load shedding of lower
+// priority requests.
+var lowPriorityTag = errtag.Make("rejected due to its low priority", true)
+
 var (
 	// Number of in-flight requests.
 	concurrencyCurGauge = metric.NewInt(
@@ -37,7 +46,7 @@ var (
 		field.String("limiter"), // name of the limiter that reports the metric
 	)
 
-	// Configured maximum number of in-flight requests.
+	// Configured (or adaptively derived) maximum number of in-flight requests.
 	concurrencyMaxGauge = metric.NewInt(
 		"server/limiter/concurrency/max",
 		"A configured limit on a number of concurrently processed requests.",
@@ -45,6 +54,42 @@ var (
 		field.String("limiter"), // name of the limiter that reports the metric
 	)
 
+	// Number of in-flight requests per peer class.
+	concurrencyPeerClassGauge = metric.NewInt(
+		"server/limiter/concurrency/peer_class",
+		"Number of requests from a class of peers being processed right now.",
+		nil,
+		field.String("limiter"),    // name of the limiter that reports the metric
+		field.String("peer_class"), // e.g. "bot", see PeerClassFromAuthState
+	)
+
+	// Number of in-flight requests per priority.
+	concurrencyPriorityGauge = metric.NewInt(
+		"server/limiter/concurrency/priority",
+		"Number of requests of a priority being processed right now.",
+		nil,
+		field.String("limiter"),  // name of the limiter that reports the metric
+		field.String("priority"), // e.g. "low", see prpc.Priority
+	)
+
+	// Counter with rejected requests per priority.
+	rejectedPriorityCounter = metric.NewCounter(
+		"server/limiter/rejected_by_priority",
+		"Number of rejected requests per priority.",
+		nil,
+		field.String("limiter"),  // name of the limiter that did the rejection
+		field.String("priority"), // e.g. "low", see prpc.Priority
+		field.String("reason"))   // why the request was rejected
+
+	// Counter with rejected requests per peer class.
+	rejectedPeerClassCounter = metric.NewCounter(
+		"server/limiter/rejected_by_peer_class",
+		"Number of rejected requests per peer class.",
+		nil,
+		field.String("limiter"),    // name of the limiter that did the rejection
+		field.String("peer_class"), // e.g. "bot", see PeerClassFromAuthState
+		field.String("reason"))     // why the request was rejected
+
 	// Counter with rejected requests.
 	rejectedCounter = metric.NewCounter(
 		"server/limiter/rejected",
@@ -61,6 +106,55 @@ type Options struct {
 	Name                  string // used for metric fields, logs and error messages
 	AdvisoryMode          bool   // if true, don't actually reject requests, just log
 	MaxConcurrentRequests int64  // a hard limit on a number of concurrent requests
+
+	// Adaptive, if set, enables the adaptive concurrency limit.
+	//
+	// The limit is derived from the observed latency of requests and it never
+	// exceeds MaxConcurrentRequests.
+	Adaptive *AdaptiveOptions
+
+	// PeerReservations is a fraction of the concurrency limit reserved for
+	// requests from a class of peers, keyed by RequestInfo.PeerClass.
+	//
+	// A peer class can always use its reserved capacity. Capacity not reserved
+	// by any class is shared by all peers on a first come first served basis.
+	// Thus a single noisy class of peers can't take the capacity reserved for
+	// other classes.
+	//
+	// Each fraction must be in range [0, 1] and their sum must not exceed 1.
+	PeerReservations map[string]float64
+
+	// PriorityLimits is a fraction of the concurrency limit that requests of
+	// a priority can use, keyed by RequestInfo.Priority.
+	//
+	// A request is rejected if the total number of in-flight requests is at
+	// or above this fraction of the concurrency limit. This makes lower priority
+	// requests rejected first when the load grows. Priorities not in the map can
+	// use the entire limit.
+	//
+	// Each fraction must be in range (0, 1].
+	PriorityLimits map[prpc.Priority]float64
+
+	// RetryAfter is a hint sent to clients with rejections, telling them when
+	// to retry.
+	//
+	// Default is 1 sec.
+	RetryAfter time.Duration
+}
+
+// AdaptiveOptions configure the adaptive concurrency limit.
+//
+// The limit is adjusted using the additive increase/multiplicative decrease
+// (AIMD) algorithm: when a request is slower than LatencyThreshold the limit
+// is multiplied by BackoffRatio, otherwise it is increased by one if the
+// limiter is at least half loaded. The limit is multiplied at most once per
+// LatencyThreshold, so that a burst of slow requests finishing at the same time
+// is treated as a single overload signal.
+type AdaptiveOptions struct {
+	MinConcurrentRequests     int64         // the adaptive limit never goes below this, default is 10
+	InitialConcurrentRequests int64         // the initial value of the limit, default is 100
+	LatencyThreshold          time.Duration // requests slower than this indicate overload, required
+	BackoffRatio              float64       // a multiplier applied to the limit on overload, default is 0.9
 }
 
 // Limiter is a stateful runtime object that decides whether to accept or reject
@@ -75,9 +169,15 @@ type Options struct {
 //
 // All methods are safe for concurrent use.
 type Limiter struct {
-	opts        Options // options passed to New, as is
+	opts        Options // options passed to New, with defaults populated
 	titleForLog string  // how the limiter is named in logs and error replies
-	concurrency int64   // atomic int with number of current in-flight requests
+
+	m           sync.Mutex
+	limit       float64                 // the current concurrency limit
+	lastBackoff time.Time               // when the adaptive limit was lowered last time
+	concurrency int64                   // number of current in-flight requests
+	peerClasses map[string]int64        // number of in-flight requests per peer class
+	priorities  map[prpc.Priority]int64 // number of in-flight requests per priority
 }
 
 // RequestInfo holds information about a single inbound request.
@@ -95,6 +195,9 @@ type Limiter struct {
 type RequestInfo struct {
 	CallLabel string // an RPC or an endpoint being called (if known)
 	PeerLabel string // who's making the request (if known), see also peer.go
+	PeerClass string // a class of the peer (if known), see PeerReservations
+
+	Priority prpc.Priority // the priority class of the request, see PriorityLimits
 }
 
 // New returns a new limiter.
@@ -104,9 +207,62 @@ func New(opts Options) (*Limiter, error) {
 	if opts.MaxConcurrentRequests <= 0 {
 		return nil, errors.New("max concurrent requests must be positive")
 	}
+
+	total := 0.0
+	for class, r := range opts.PeerReservations {
+		if r < 0 || r > 1 {
+			return nil, errors.Reason("reservation for peer class %q must be in range [0, 1], got %v", class, r).Err()
+		}
+		total += r
+	}
+	if total > 1 {
+		return nil, errors.Reason("sum of peer reservations must not exceed 1, got %v", total).Err()
+	}
+	for p, f := range opts.PriorityLimits {
+		if f <= 0 || f > 1 {
+			return nil, errors.Reason("limit for priority %q must be in range (0, 1], got %v", p, f).Err()
+		}
+	}
+	if opts.RetryAfter == 0 {
+		opts.RetryAfter = time.Second
+	}
+
+	limit := float64(opts.MaxConcurrentRequests)
+	title := fmt.Sprintf("%s<=%d", opts.Name, opts.MaxConcurrentRequests)
+
+	if opts.Adaptive != nil {
+		a := *opts.Adaptive
+		if a.LatencyThreshold <= 0 {
+			return nil, errors.New("adaptive latency threshold must be positive")
+		}
+		if a.MinConcurrentRequests == 0 {
+			a.MinConcurrentRequests = 10
+		}
+		if a.InitialConcurrentRequests == 0 {
+			a.InitialConcurrentRequests = 100
+		}
+		if a.BackoffRatio == 0 {
+			a.BackoffRatio = 0.9
+		}
+		switch {
+		case a.MinConcurrentRequests < 0:
+			return nil, errors.New("adaptive min concurrent requests must be positive")
+		case a.BackoffRatio <= 0 || a.BackoffRatio >= 1:
+			return nil, errors.Reason("adaptive backoff ratio must be in range (0, 1), got %v", a.BackoffRatio).Err()
+		}
+		a.MinConcurrentRequests = min(a.MinConcurrentRequests, opts.MaxConcurrentRequests)
+		a.InitialConcurrentRequests = min(max(a.InitialConcurrentRequests, a.MinConcurrentRequests), opts.MaxConcurrentRequests)
+		opts.Adaptive = &a
+		limit = float64(a.InitialConcurrentRequests)
+		title = fmt.Sprintf("%s<=adaptive(%d..%d)", opts.Name, a.MinConcurrentRequests, opts.MaxConcurrentRequests)
+	}
+
 	return &Limiter{
 		opts:        opts,
-		titleForLog: fmt.Sprintf("%s<=%d", opts.Name, opts.MaxConcurrentRequests),
+		titleForLog: title,
+		limit:       limit,
+		peerClasses: map[string]int64{},
+		priorities:  map[prpc.Priority]int64{},
 	}, nil
 }
 
@@ -114,35 +270,141 @@ func New(opts Options) (*Limiter, error) {
 //
 // Must be called periodically (at least once per every metrics flush).
 func (l *Limiter) ReportMetrics(ctx context.Context) {
-	concurrencyCurGauge.Set(ctx, atomic.LoadInt64(&l.concurrency), l.opts.Name)
-	concurrencyMaxGauge.Set(ctx, l.opts.MaxConcurrentRequests, l.opts.Name)
+	l.m.Lock()
+	cur := l.concurrency
+	limit := int64(l.limit)
+	peerClasses := make(map[string]int64, len(l.peerClasses))
+	for class, count := range l.peerClasses {
+		peerClasses[class] = count
+	}
+	priorities := make(map[prpc.Priority]int64, len(l.priorities))
+	for p, count := range l.priorities {
+		priorities[p] = count
+	}
+	l.m.Unlock()
+
+	concurrencyCurGauge.Set(ctx, cur, l.opts.Name)
+	concurrencyMaxGauge.Set(ctx, limit, l.opts.Name)
+	for class, count := range peerClasses {
+		concurrencyPeerClassGauge.Set(ctx, count, l.opts.Name, class)
+	}
+	for p, count := range priorities {
+		concurrencyPriorityGauge.Set(ctx, count, l.opts.Name, p.String())
+	}
+}
+
+// RetryAfter is a hint to send to clients with rejections.
+func (l *Limiter) RetryAfter() time.Duration {
+	return l.opts.RetryAfter
 }
 
 // CheckRequest should be called before processing a request.
 //
 // If it returns an error, the request should be declined as soon as possible
-// with Unavailable/HTTP 503 status and the given error (which is an annotated
-// ErrLimitReached).
+// with Unavailable/HTTP 503 status (or ResourceExhausted/HTTP 429 if
+// IsLowPriorityRejection returns true) and the given error (which is an
+// annotated ErrLimitReached). Use RetryAfter as a hint for the client.
 //
 // If it succeeds, the request should be processed as usual, and the returned
 // callback called afterwards to notify the limiter the processing is done.
 func (l *Limiter) CheckRequest(ctx context.Context, ri *RequestInfo) (done func(), err error) {
-	// TODO(vadimsh): This is the simplest limiter implementation possible. It
-	// will likely learn more tricks once we understand what features we need.
-	for {
-		cur := atomic.LoadInt64(&l.concurrency)
-		if cur >= l.opts.MaxConcurrentRequests && !l.opts.AdvisoryMode {
-			return nil, l.reject(ctx, ri, "max concurrency")
+	l.m.Lock()
+	reason := l.exceededLimit(ri.PeerClass, ri.Priority)
+	if reason != "" && !l.opts.AdvisoryMode {
+		l.m.Unlock()
+		return nil, l.reject(ctx, ri, reason)
+	}
+	l.concurrency++
+	l.peerClasses[ri.PeerClass]++
+	l.priorities[ri.Priority]++
+	l.m.Unlock()
+
+	// Now that we have definitely grabbed the execution slot, report the
+	// advisory rejection message. Doing it sooner may result in duplications.
+	if reason != "" {
+		_ = l.reject(ctx, ri, reason) // actually ignore the error
+	}
+
+	var started time.Time
+	if l.opts.Adaptive != nil {
+		started = clock.Now(ctx)
+	}
+
+	return func() {
+		var now time.Time
+		if l.opts.Adaptive != nil {
+			now = clock.Now(ctx)
+		}
+		l.m.Lock()
+		defer l.m.Unlock()
+		if l.opts.Adaptive != nil {
+			l.adapt(now, now.Sub(started))
 		}
-		if !atomic.CompareAndSwapInt64(&l.concurrency, cur, cur+1) {
-			continue // race, try again
+		l.concurrency--
+		// Note: keep zero entries to report zero in metrics for known peers.
+		l.peerClasses[ri.PeerClass]--
+		l.priorities[ri.Priority]--
+	}, nil
+}
+
+// exceededLimit checks if a new request from the given peer class exceeds any
+// limits.
+//
+// Returns a name of the exceeded limit or an empty string if the request can
+// be accepted. Must be called under the lock.
+func (l *Limiter) exceededLimit(class string, priority prpc.Priority) string {
+	limit := int64(l.limit)
+	if l.concurrency >= limit {
+		return "max concurrency"
+	}
+	if frac, ok := l.opts.PriorityLimits[priority]; ok {
+		if l.concurrency >= int64(math.Floor(frac*float64(limit))) {
+			return "priority concurrency"
 		}
-		// Now that we have definitely grabbed the execution slot, report the
-		// advisory rejection message. Doing it sooner may result in duplications.
-		if cur >= l.opts.MaxConcurrentRequests && l.opts.AdvisoryMode {
-			_ = l.reject(ctx, ri, "max concurrency") // actually ignore the error
+	}
+	if len(l.opts.PeerReservations) == 0 {
+		return ""
+	}
+
+	// The peer class can always use its reserved capacity.
+	if l.peerClasses[class] < l.reserved(class, limit) {
+		return ""
+	}
+
+	// Otherwise it competes for the shared capacity with other classes.
+	shared := limit
+	for c := range l.opts.PeerReservations {
+		shared -= l.reserved(c, limit)
+	}
+	for c, count := range l.peerClasses {
+		shared -= max(0, count-l.reserved(c, limit))
+	}
+	if shared <= 0 {
+		return "peer concurrency"
+	}
+	return ""
+}
+
+// reserved is a number of concurrent requests reserved for the peer class.
+func (l *Limiter) reserved(class string, limit int64) int64 {
+	return int64(math.Floor(l.opts.PeerReservations[class] * float64(limit)))
+}
+
+// adapt adjusts the adaptive limit based on the latency of a finished request.
+//
+// Must be called under the lock before the request is removed from the
+// in-flight counter.
+func (l *Limiter) adapt(now time.Time, latency time.Duration) {
+	a := l.opts.Adaptive
+	if latency > a.LatencyThreshold {
+		// Slow requests that were in flight during the last backoff are likely
+		// slow for the same reason. Don't punish the limit for them again.
+		if now.Sub(l.lastBackoff) >= a.LatencyThreshold {
+			l.limit = max(l.limit*a.BackoffRatio, float64(a.MinConcurrentRequests))
+			l.lastBackoff = now
 		}
-		return func() { atomic.AddInt64(&l.concurrency, -1) }, nil
+	} else if float64(l.concurrency)*2 >= l.limit {
+		l.limit = min(l.limit+1, float64(l.opts.MaxConcurrentRequests))
 	}
 }
 
@@ -152,10 +414,25 @@ func (l *Limiter) CheckRequest(ctx context.Context, ri *RequestInfo) (done func(
 // It updates metrics and logs and returns an annotated ErrLimitReached error.
 func (l *Limiter) reject(ctx context.Context, ri *RequestInfo, reason string) error {
 	rejectedCounter.Add(ctx, 1, l.opts.Name, ri.CallLabel, ri.PeerLabel, reason)
+	rejectedPeerClassCounter.Add(ctx, 1, l.opts.Name, ri.PeerClass, reason)
+	rejectedPriorityCounter.Add(ctx, 1, l.opts.Name, ri.Priority.String(), reason)
 	if l.opts.AdvisoryMode {
 		logging.Warningf(ctx, "limiter %q in advisory mode: the request hit the %s limit", l.titleForLog, reason)
 	} else {
 		logging.Errorf(ctx, "limiter %q: the request hit the %s limit", l.titleForLog, reason)
 	}
-	return errors.Annotate(ErrLimitReached, "limiter %q: %s limit", l.titleForLog, reason).Err()
+	err := errors.Annotate(ErrLimitReached, "limiter %q: %s limit", l.titleForLog, reason).Err()
+	if reason == "priority concurrency" {
+		err = lowPriorityTag.Apply(err)
+	}
+	return err
+}
+
+// IsLowPriorityRejection returns true if the error returned by CheckRequest
+// means the request was rejected because of its low priority.
+//
+// Such requests are rejected to keep capacity for higher priority requests
+// and should be reported to clients as ResourceExhausted errors.
+func IsLowPriorityRejection(err error) bool {
+	return lowPriorityTag.In(err)
 }
@@ -18,12 +18,22 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/testing/ftt"
 	"go.chromium.org/luci/common/testing/truth"
 	"go.chromium.org/luci/common/testing/truth/assert"
 	"go.chromium.org/luci/common/testing/truth/should"
 	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/grpc/prpc"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
 )
 
 func TestMaxConcurrencyLimit(t *testing.T) {
@@ -91,6 +101,319 @@ func TestMaxConcurrencyLimit(t *testing.T) {
 	})
 }
 
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Validation", t, func(t *ftt.Test) {
+		check := func(opts Options) error {
+			opts.Name = "test"
+			if opts.MaxConcurrentRequests == 0 {
+				opts.MaxConcurrentRequests = 100
+			}
+			_, err := New(opts)
+			return err
+		}
+
+		assert.Loosely(t, check(Options{MaxConcurrentRequests: -1}), should.ErrLike("must be positive"))
+		assert.Loosely(t, check(Options{
+			PeerReservations: map[string]float64{"a": 1.5},
+		}), should.ErrLike("must be in range [0, 1]"))
+		assert.Loosely(t, check(Options{
+			PeerReservations: map[string]float64{"a": 0.6, "b": 0.6},
+		}), should.ErrLike("must not exceed 1"))
+		assert.Loosely(t, check(Options{
+			Adaptive: &AdaptiveOptions{},
+		}), should.ErrLike("latency threshold must be positive"))
+		assert.Loosely(t, check(Options{
+			Adaptive: &AdaptiveOptions{LatencyThreshold: time.Second, BackoffRatio: 1.5},
+		}), should.ErrLike("backoff ratio must be in range (0, 1)"))
+
+		l, err := New(Options{
+			Name:                  "test",
+			MaxConcurrentRequests: 50,
+			Adaptive:              &AdaptiveOptions{LatencyThreshold: time.Second},
+		})
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, l.limit, should.Equal(50.0)) // capped by the max
+		assert.Loosely(t, l.opts.Adaptive.MinConcurrentRequests, should.Equal[int64](10))
+	})
+}
+
+func TestPeerReservations(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+
+		l, err := New(Options{
+			Name:                  "test-limiter",
+			MaxConcurrentRequests: 10,
+			PeerReservations:      map[string]float64{"user": 0.3, "admin": 0.1},
+		})
+		assert.Loosely(t, err, should.BeNil)
+
+		var dones []func()
+		defer func() {
+			for _, done := range dones {
+				done()
+			}
+		}()
+		call := func(class string) error {
+			done, err := l.CheckRequest(ctx, &RequestInfo{CallLabel: "call", PeerLabel: "peer", PeerClass: class})
+			if err == nil {
+				dones = append(dones, done)
+			}
+			return err
+		}
+
+		// Bots can take only the shared capacity: 10 - 3 - 1 = 6.
+		for range 6 {
+			assert.Loosely(t, call("bot"), should.BeNil)
+		}
+		assert.Loosely(t, call("bot"), should.ErrLike("peer concurrency limit"))
+
+		// Users still have their reserved capacity.
+		for range 3 {
+			assert.Loosely(t, call("user"), should.BeNil)
+		}
+		assert.Loosely(t, call("user"), should.ErrLike("peer concurrency limit"))
+
+		// And so do admins.
+		assert.Loosely(t, call("admin"), should.BeNil)
+
+		// Everything is used up now.
+		assert.Loosely(t, call("admin"), should.ErrLike("max concurrency limit"))
+
+		l.ReportMetrics(ctx)
+		assert.Loosely(t, concurrencyPeerClassGauge.Get(ctx, "test-limiter", "bot"), should.Equal(6))
+		assert.Loosely(t, concurrencyPeerClassGauge.Get(ctx, "test-limiter", "user"), should.Equal(3))
+		assert.Loosely(t, rejectedPeerClassCounter.Get(ctx, "test-limiter", "bot", "peer concurrency"), should.Equal(1))
+		assert.Loosely(t, rejectedCounter.Get(ctx, "test-limiter", "call", "peer", "peer concurrency"), should.Equal(2))
+
+		// When a bot request finishes, the shared slot can be used by a user.
+		dones[0]()
+		dones = dones[1:]
+		assert.Loosely(t, call("user"), should.BeNil)
+		assert.Loosely(t, call("bot"), should.ErrLike("max concurrency limit"))
+	})
+}
+
+func TestAdaptiveLimit(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx, _ = tsmon.WithDummyInMemory(ctx)
+
+		l, err := New(Options{
+			Name:                  "test-limiter",
+			MaxConcurrentRequests: 12,
+			Adaptive: &AdaptiveOptions{
+				MinConcurrentRequests:     2,
+				InitialConcurrentRequests: 10,
+				LatencyThreshold:          time.Second,
+				BackoffRatio:              0.5,
+			},
+		})
+		assert.Loosely(t, err, should.BeNil)
+
+		// Starts n requests that take the given time.
+		run := func(n int, latency time.Duration) {
+			var dones []func()
+			for range n {
+				done, err := l.CheckRequest(ctx, &RequestInfo{})
+				assert.Loosely(t, err, should.BeNil, truth.LineContext())
+				dones = append(dones, done)
+			}
+			tc.Add(latency)
+			for _, done := range dones {
+				done()
+			}
+		}
+
+		t.Run("Backs off on slow requests", func(t *ftt.Test) {
+			run(1, 2*time.Second)
+			assert.Loosely(t, l.limit, should.Equal(5.0))
+			run(1, 2*time.Second)
+			assert.Loosely(t, l.limit, should.Equal(2.5))
+			run(1, 2*time.Second)
+			assert.Loosely(t, l.limit, should.Equal(2.0))
+
+			// The adaptive limit is enforced.
+			l.ReportMetrics(ctx)
+			assert.Loosely(t, concurrencyMaxGauge.Get(ctx, "test-limiter"), should.Equal(2))
+			d1, _ := l.CheckRequest(ctx, &RequestInfo{})
+			d2, _ := l.CheckRequest(ctx, &RequestInfo{})
+			_, err := l.CheckRequest(ctx, &RequestInfo{})
+			assert.Loosely(t, err, should.ErrLike("max concurrency limit"))
+			d1()
+			d2()
+		})
+
+		t.Run("Backs off once per window", func(t *ftt.Test) {
+			slow, err := l.CheckRequest(ctx, &RequestInfo{})
+			assert.Loosely(t, err, should.BeNil)
+
+			// A burst of slow requests finishing together lowers the limit once.
+			run(4, 2*time.Second)
+			assert.Loosely(t, l.limit, should.Equal(5.0))
+
+			// A slow request finishing soon after is ignored too.
+			tc.Add(500 * time.Millisecond)
+			slow()
+			assert.Loosely(t, l.limit, should.Equal(5.0))
+
+			// But the next window can lower the limit again.
+			run(1, 2*time.Second)
+			assert.Loosely(t, l.limit, should.Equal(2.5))
+		})
+
+		t.Run("Grows when loaded and fast", func(t *ftt.Test) {
+			// Lightly loaded: no growth.
+			run(2, time.Millisecond)
+			assert.Loosely(t, l.limit, should.Equal(10.0))
+
+			// Loaded: grows while at least half of the limit is used.
+			run(6, time.Millisecond)
+			assert.Loosely(t, l.limit, should.Equal(11.0))
+			run(6, time.Millisecond)
+			assert.Loosely(t, l.limit, should.Equal(12.0))
+
+			// Never exceeds the max.
+			run(12, time.Millisecond)
+			assert.Loosely(t, l.limit, should.Equal(12.0))
+		})
+	})
+}
+
+func TestPriorityLimits(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+
+		l, err := New(Options{
+			Name:                  "test-limiter",
+			MaxConcurrentRequests: 10,
+			PriorityLimits: map[prpc.Priority]float64{
+				prpc.PriorityLow:    0.5,
+				prpc.PriorityNormal: 0.8,
+			},
+		})
+		assert.Loosely(t, err, should.BeNil)
+
+		var dones []func()
+		defer func() {
+			for _, done := range dones {
+				done()
+			}
+		}()
+		call := func(p prpc.Priority) error {
+			done, err := l.CheckRequest(ctx, &RequestInfo{CallLabel: "call", PeerLabel: "peer", Priority: p})
+			if err == nil {
+				dones = append(dones, done)
+			}
+			return err
+		}
+
+		// Low priority requests can use only half of the limit.
+		for range 5 {
+			assert.Loosely(t, call(prpc.PriorityLow), should.BeNil)
+		}
+		err = call(prpc.PriorityLow)
+		assert.Loosely(t, err, should.ErrLike("priority concurrency limit"))
+		assert.Loosely(t, IsLowPriorityRejection(err), should.BeTrue)
+
+		// Normal priority requests can use more.
+		for range 3 {
+			assert.Loosely(t, call(prpc.PriorityNormal), should.BeNil)
+		}
+		assert.Loosely(t, call(prpc.PriorityNormal), should.ErrLike("priority concurrency limit"))
+
+		// High priority requests can use the rest.
+		for range 2 {
+			assert.Loosely(t, call(prpc.PriorityHigh), should.BeNil)
+		}
+		err = call(prpc.PriorityHigh)
+		assert.Loosely(t, err, should.ErrLike("max concurrency limit"))
+		assert.Loosely(t, IsLowPriorityRejection(err), should.BeFalse)
+
+		l.ReportMetrics(ctx)
+		assert.Loosely(t, concurrencyPriorityGauge.Get(ctx, "test-limiter", "low"), should.Equal(5))
+		assert.Loosely(t, concurrencyPriorityGauge.Get(ctx, "test-limiter", "high"), should.Equal(2))
+		assert.Loosely(t, rejectedPriorityCounter.Get(ctx, "test-limiter", "low", "priority concurrency"), should.Equal(1))
+		assert.Loosely(t, rejectedPriorityCounter.Get(ctx, "test-limiter", "high", "max concurrency"), should.Equal(1))
+	})
+}
+
+func TestServerInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+
+		l, err := New(Options{
+			Name:                  "test-limiter",
+			MaxConcurrentRequests: 2,
+			PriorityLimits:        map[prpc.Priority]float64{prpc.PriorityLow: 0.5},
+		})
+		assert.Loosely(t, err, should.BeNil)
+		intr := NewServerInterceptor(l)
+
+		block := make(chan struct{})
+		started := make(chan struct{})
+		go func() {
+			_ = intr(ctx, "/svc/Method", func(context.Context) error {
+				close(started)
+				<-block
+				return nil
+			})
+		}()
+		<-started
+		defer close(block)
+
+		noop := func(context.Context) error { return nil }
+
+		err = intr(prpc.WithPriority(ctx, prpc.PriorityLow), "/svc/Method", noop)
+		assert.Loosely(t, status.Code(err), should.Equal(codes.ResourceExhausted))
+
+		assert.Loosely(t, intr(ctx, "/svc/Method", noop), should.BeNil)
+	})
+}
+
+func TestPeerLabel(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		label := func(id identity.Identity) string {
+			ctx := auth.WithState(context.Background(), &authtest.FakeState{Identity: id})
+			return PeerLabelFromAuthState(ctx)
+		}
+		assert.Loosely(t, PeerLabelFromAuthState(context.Background()), should.Equal("unknown"))
+		assert.Loosely(t, label(identity.AnonymousIdentity), should.Equal("anonymous"))
+		assert.Loosely(t, label("user:someone@example.com"), should.Equal("authenticated"))
+		assert.Loosely(t, label("user:sa@project.iam.gserviceaccount.com"), should.Equal("authenticated"))
+		assert.Loosely(t, label("bot:bot-1"), should.Equal("authenticated"))
+	})
+}
+
+func TestPeerClass(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		class := func(id identity.Identity) string {
+			ctx := auth.WithState(context.Background(), &authtest.FakeState{Identity: id})
+			return PeerClassFromAuthState(ctx)
+		}
+		assert.Loosely(t, PeerClassFromAuthState(context.Background()), should.Equal("unknown"))
+		assert.Loosely(t, class(identity.AnonymousIdentity), should.Equal("anonymous"))
+		assert.Loosely(t, class("user:someone@example.com"), should.Equal("authenticated"))
+		assert.Loosely(t, class("user:sa@project.iam.gserviceaccount.com"), should.Equal("bot"))
+		assert.Loosely(t, class("bot:bot-1"), should.Equal("bot"))
+		assert.Loosely(t, class("project:chromium"), should.Equal("bot"))
+	})
+}
+
 func makeConcurrentRequests(ctx context.Context, t testing.TB, l *Limiter, count int, block chan struct{}, wg *sync.WaitGroup) (accepted, rejected int) {
 	t.Helper()
 	verdicts := make(chan error) // nil if accepted, non-nil if rejected
@@ -18,10 +18,15 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/grpc/prpc"
 
 	"go.chromium.org/luci/server/module"
 )
@@ -36,6 +41,16 @@ const defaultMaxConcurrentRPCs = 100000 // e.g. unlimited
 type ModuleOptions struct {
 	MaxConcurrentRPCs int64 // limit on a number of incoming concurrent RPCs (default is 100000, i.e. unlimited)
 	AdvisoryMode      bool  // if set, don't enforce MaxConcurrentRPCs, but still report violations
+
+	AdaptiveLatency           time.Duration      // if set, derive the concurrency limit from RPC latency, see AdaptiveOptions
+	AdaptiveMinConcurrentRPCs int64              // the adaptive limit never goes below this (default is 10)
+	PeerReservations          map[string]float64 // fraction of the concurrency limit reserved per peer class, see peer.go
+
+	// PriorityLimits is a fraction of the concurrency limit RPCs of a priority
+	// can use (see prpc.Priority).
+	//
+	// If nil, defaults to 0.8 for low priority RPCs. Use an empty map to disable.
+	PriorityLimits map[prpc.Priority]float64
 }
 
 // Register registers the command line flags.
@@ -55,6 +70,125 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		o.AdvisoryMode,
 		"If set, don't enforce -limiter-max-concurrent-rpcs, but still report violations",
 	)
+	f.DurationVar(
+		&o.AdaptiveLatency,
+		"limiter-adaptive-latency",
+		o.AdaptiveLatency,
+		"If set, adaptively lower the concurrency limit (capped by -limiter-max-concurrent-rpcs) "+
+			"when RPCs get slower than this",
+	)
+	f.Int64Var(
+		&o.AdaptiveMinConcurrentRPCs,
+		"limiter-adaptive-min-concurrent-rpcs",
+		o.AdaptiveMinConcurrentRPCs,
+		"The adaptive concurrency limit never goes below this (default is 10)",
+	)
+	f.Var(
+		(*reservationsFlag)(&o.PeerReservations),
+		"limiter-peer-reservation",
+		`A fraction of the concurrency limit reserved for a class of peers as "<class>:<fraction>", `+
+			`e.g. "authenticated:0.3". Classes are "anonymous", "authenticated", "bot" or "unknown". May be repeated.`,
+	)
+	if o.PriorityLimits == nil {
+		o.PriorityLimits = defaultPriorityLimits()
+	}
+	f.Var(
+		&priorityLimitsFlag{m: &o.PriorityLimits},
+		"limiter-priority-limit",
+		`A fraction of the concurrency limit RPCs of a priority can use as "<priority>:<fraction>", `+
+			`e.g. "low:0.5". Priorities are "low", "normal" or "high". May be repeated.`,
+	)
+}
+
+// defaultPriorityLimits returns the default value of PriorityLimits.
+func defaultPriorityLimits() map[prpc.Priority]float64 {
+	return map[prpc.Priority]float64{prpc.PriorityLow: 0.8}
+}
+
+// priorityLimitsFlag implements flag.Value for PriorityLimits.
+//
+// The first Set call replaces the defaults.
+type priorityLimitsFlag struct {
+	m   *map[prpc.Priority]float64
+	set bool
+}
+
+// String is part of flag.Value interface.
+func (l *priorityLimitsFlag) String() string {
+	if l == nil || l.m == nil {
+		return ""
+	}
+	pairs := make([]string, 0, len(*l.m))
+	for p, v := range *l.m {
+		pairs = append(pairs, fmt.Sprintf("%s:%v", p, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set is part of flag.Value interface.
+func (l *priorityLimitsFlag) Set(v string) error {
+	name, frac, ok := strings.Cut(v, ":")
+	if !ok {
+		return errors.Reason(`expecting "<priority>:<fraction>", got %q`, v).Err()
+	}
+	var p prpc.Priority
+	switch name {
+	case "low":
+		p = prpc.PriorityLow
+	case "normal":
+		p = prpc.PriorityNormal
+	case "high":
+		p = prpc.PriorityHigh
+	default:
+		return errors.Reason("unknown priority %q", name).Err()
+	}
+	f, err := strconv.ParseFloat(frac, 64)
+	if err != nil {
+		return errors.Annotate(err, "bad fraction in %q", v).Err()
+	}
+	if !l.set {
+		*l.m = map[prpc.Priority]float64{}
+		l.set = true
+	}
+	(*l.m)[p] = f
+	return nil
+}
+
+// reservationsFlag implements flag.Value for PeerReservations.
+type reservationsFlag map[string]float64
+
+// String is part of flag.Value interface.
+func (r *reservationsFlag) String() string {
+	if r == nil {
+		return ""
+	}
+	pairs := make([]string, 0, len(*r))
+	for class, v := range *r {
+		pairs = append(pairs, fmt.Sprintf("%s:%v", class, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set is part of flag.Value interface.
+func (r *reservationsFlag) Set(v string) error {
+	class, frac, ok := strings.Cut(v, ":")
+	if !ok || class == "" {
+		return errors.Reason(`expecting "<class>:<fraction>", got %q`, v).Err()
+	}
+	f, err := strconv.ParseFloat(frac, 64)
+	if err != nil {
+		return errors.Annotate(err, "bad fraction in %q", v).Err()
+	}
+	if *r == nil {
+		*r = map[string]float64{}
+	}
+	if _, dup := (*r)[class]; dup {
+		return errors.Reason("peer class %q is specified more than once", class).Err()
+	}
+	(*r)[class] = f
+	return nil
 }
 
 // NewModule returns a server module that installs default limiters applied to
@@ -100,11 +234,24 @@ func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts mo
 	if m.opts.MaxConcurrentRPCs == 0 {
 		m.opts.MaxConcurrentRPCs = defaultMaxConcurrentRPCs
 	}
+	if m.opts.PriorityLimits == nil {
+		m.opts.PriorityLimits = defaultPriorityLimits()
+	}
+	var adaptive *AdaptiveOptions
+	if m.opts.AdaptiveLatency != 0 {
+		adaptive = &AdaptiveOptions{
+			MinConcurrentRequests: m.opts.AdaptiveMinConcurrentRPCs,
+			LatencyThreshold:      m.opts.AdaptiveLatency,
+		}
+	}
 	var err error
 	m.rpcLimiter, err = New(Options{
 		Name:                  "rpc",
 		AdvisoryMode:          m.opts.AdvisoryMode,
 		MaxConcurrentRequests: m.opts.MaxConcurrentRPCs,
+		Adaptive:              adaptive,
+		PeerReservations:      m.opts.PeerReservations,
+		PriorityLimits:        m.opts.PriorityLimits,
 	})
 	if err != nil {
 		return nil, err
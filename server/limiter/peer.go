@@ -16,6 +16,7 @@ package limiter
 
 import (
 	"context"
+	"strings"
 
 	"go.chromium.org/luci/auth/identity"
 
@@ -38,3 +39,28 @@ func PeerLabelFromAuthState(ctx context.Context) string {
 	}
 	return "unknown"
 }
+
+// PeerClassFromAuthState looks at the auth.State in the context and derives
+// a peer class from it.
+//
+// Currently returns one of "unknown", "anonymous", "bot", "authenticated".
+// "bot" is used for non-human peers: bots, LUCI projects, services and service
+// accounts. These classes can be used as keys in Options.PeerReservations to
+// make sure e.g. a fleet of bots doesn't starve interactive users.
+func PeerClassFromAuthState(ctx context.Context) string {
+	if s := auth.GetState(ctx); s != nil {
+		id := s.PeerIdentity()
+		switch id.Kind() {
+		case identity.Anonymous:
+			return "anonymous"
+		case identity.Bot, identity.Project, identity.Service:
+			return "bot"
+		case identity.User:
+			if strings.HasSuffix(id.Email(), ".gserviceaccount.com") {
+				return "bot"
+			}
+		}
+		return "authenticated"
+	}
+	return "unknown"
+}
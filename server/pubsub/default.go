@@ -27,7 +27,8 @@ import (
 // environment and module's options.
 //
 // You still need to register your handlers in it using RegisterHandler and
-// configure Cloud Pub/Sub subscriptions that push to them.
+// configure Cloud Pub/Sub subscriptions that push to them (or pull subscriptions
+// to pull messages from, see ModuleOptions).
 var Default Dispatcher
 
 // RegisterHandler is a shortcut for Default.RegisterHandler.
@@ -78,7 +78,8 @@ var (
 type Message struct {
 	// Data is the PubSub message payload.
 	Data []byte
-	// Subscription is the full subscription name that pushed the message.
+	// Subscription is the full subscription name that pushed (or from which
+	// the server pulled) the message.
 	// Format: projects/myproject/subscriptions/mysubscription.
 	Subscription string
 	// MessageID is the PubSub message ID.
@@ -89,7 +90,7 @@ type Message struct {
 	// Guaranteed to be non-nil.
 	Attributes map[string]string
 	// Query is the query part of the HTTP request string.
-	// Guaranteed to be non-nil.
+	// Empty for pulled messages. Guaranteed to be non-nil.
 	Query url.Values
 }
 
@@ -98,7 +99,8 @@ type Message struct {
 // Transient errors are transformed into HTTP 500 replies to Cloud Pub/Sub,
 // which may trigger a retry based on the pub/sub subscription retry policy.
 // Returning a non-transient error results in a error-level logging message
-// and HTTP 202 reply, which does not trigger a retry.
+// and HTTP 202 reply, which does not trigger a retry. Pulled messages are
+// handled the same way, see Dispatcher's Pull.
 type Handler func(ctx context.Context, message Message) error
 
 // JSONPB wraps a handler by deserializing messages as JSONPB protobufs
@@ -273,6 +275,30 @@ func (d *Dispatcher) executeHandlerByID(ctx context.Context, id string, c *route
 		return errors.Reason("no pubsub handler with ID %q is registered", id).Err()
 	}
 
+	return d.executeHandler(ctx, id, h, func() (Message, error) {
+		// Parse the push message wrapper.
+		msg, err := readMessageWrapper(c)
+		if err != nil {
+			return Message{}, errors.Annotate(err, "reading pub/sub message wrapper").Err()
+		}
+		message := Message{
+			Data:         msg.Message.Data,
+			Subscription: msg.Subscription,
+			MessageID:    msg.Message.MessageID,
+			PublishTime:  msg.Message.PublishTime,
+			Attributes:   msg.Message.Attributes,
+			Query:        c.Request.URL.Query(),
+		}
+		if message.Attributes == nil {
+			message.Attributes = map[string]string{}
+		}
+		return message, nil
+	})
+}
+
+// executeHandler reads a message and passes it to the handler, collecting
+// metrics.
+func (d *Dispatcher) executeHandler(ctx context.Context, id string, h Handler, read func() (Message, error)) error {
 	start := clock.Now(ctx)
 	result := "panic"
 	defer func() {
@@ -280,27 +306,14 @@ func (d *Dispatcher) executeHandlerByID(ctx context.Context, id string, c *route
 		callsDurationMS.Add(ctx, float64(clock.Since(ctx, start).Milliseconds()), id, result)
 	}()
 
-	// Parse the push message wrapper.
-	msg, err := readMessageWrapper(c)
+	message, err := read()
 	if err != nil {
 		if transient.Tag.In(err) {
 			result = "transient"
 		} else {
 			result = "fatal"
 		}
-		return errors.Annotate(err, "reading pub/sub message wrapper").Err()
-	}
-
-	message := Message{
-		Data:         msg.Message.Data,
-		Subscription: msg.Subscription,
-		MessageID:    msg.Message.MessageID,
-		PublishTime:  msg.Message.PublishTime,
-		Attributes:   msg.Message.Attributes,
-		Query:        c.Request.URL.Query(),
-	}
-	if message.Attributes == nil {
-		message.Attributes = map[string]string{}
+		return err
 	}
 
 	err = h(ctx, message)
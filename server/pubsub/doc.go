@@ -26,4 +26,11 @@
 // Cloud Pub/Sub push subscriptions used with this module must be configured
 // to send Wrapped messages. See https://cloud.google.com/pubsub/docs/push
 // for more.
+//
+// Alternatively the server can pull messages from Cloud Pub/Sub pull
+// subscriptions itself, e.g. if it is not reachable by Cloud Pub/Sub. This is
+// configured via -pubsub-pull-subscription flag (see ModuleOptions for more
+// details). Pulled messages are delivered to the same registered handlers.
+// Setting PUBSUB_EMULATOR_HOST environment variable redirects pulling to
+// the Cloud Pub/Sub emulator.
 package pubsub
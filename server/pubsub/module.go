@@ -17,12 +17,23 @@ package pubsub
 import (
 	"context"
 	"flag"
+	"os"
+	"sort"
 	"strings"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
 
 	"go.chromium.org/luci/common/errors"
 	luciflag "go.chromium.org/luci/common/flag"
 	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcmon"
 
+	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/module"
 )
 
@@ -55,6 +66,22 @@ type ModuleOptions struct {
 	//
 	// Default is an empty list.
 	AuthorizedCallers []string
+
+	// PullSubscriptions maps handler IDs to full names of Cloud Pub/Sub pull
+	// subscriptions (i.e. "projects/<project>/subscriptions/<id>") to pull
+	// messages for these handlers from.
+	//
+	// This is an alternative to push subscriptions that doesn't require
+	// exposing the server to Cloud Pub/Sub. Messages are pulled in background
+	// while the server is running and handled by the same handlers.
+	//
+	// Default is an empty map.
+	PullSubscriptions map[string]string
+
+	// PullOptions configure flow control when pulling messages.
+	//
+	// Applies to each subscription separately.
+	PullOptions PullOptions
 }
 
 // Register registers the command line flags.
@@ -68,6 +95,17 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		`URL prefix to serve registered pubsub handlers from, must start with '/internal/'.`)
 	f.Var(luciflag.StringSlice(&o.AuthorizedCallers), "pubsub-authorized-caller",
 		`Service account email to accept calls from. May be repeated.`)
+	if o.PullSubscriptions == nil {
+		o.PullSubscriptions = map[string]string{}
+	}
+	f.Var(luciflag.StringMap(o.PullSubscriptions), "pubsub-pull-subscription",
+		`A pair "<handler-id>:projects/<project>/subscriptions/<id>" with a subscription to pull messages for the handler from. May be repeated.`)
+	f.IntVar(&o.PullOptions.MaxOutstandingMessages, "pubsub-pull-max-outstanding-messages", o.PullOptions.MaxOutstandingMessages,
+		`Maximum number of pulled messages handled concurrently per subscription. Default is the client library default.`)
+	f.IntVar(&o.PullOptions.MaxOutstandingBytes, "pubsub-pull-max-outstanding-bytes", o.PullOptions.MaxOutstandingBytes,
+		`Maximum total size of pulled messages handled concurrently per subscription. Default is the client library default.`)
+	f.DurationVar(&o.PullOptions.DrainTimeout, "pubsub-pull-drain-timeout", o.PullOptions.DrainTimeout,
+		`How long to wait for handlers of pulled messages when the server is shutting down. Default is 30s.`)
 }
 
 // NewModule returns a server module that sets up a pubsub dispatcher.
@@ -119,5 +157,95 @@ func (m *pubsubModule) Initialize(ctx context.Context, host module.Host, opts mo
 	m.opts.Dispatcher.AuthorizedCallers = m.opts.AuthorizedCallers
 	m.opts.Dispatcher.InstallPubSubRoutes(host.Routes(), m.opts.ServingPrefix)
 
+	if len(m.opts.PullSubscriptions) != 0 {
+		if err := m.initPull(ctx, host); err != nil {
+			return nil, err
+		}
+	}
+
 	return ctx, nil
 }
+
+// initPull launches background goroutines that pull messages.
+func (m *pubsubModule) initPull(ctx context.Context, host module.Host) error {
+	opts := []option.ClientOption{
+		option.WithGRPCDialOption(grpc.WithStatsHandler(&grpcmon.ClientRPCStatsMonitor{})),
+	}
+	// The client library connects to the emulator without authentication.
+	if os.Getenv("PUBSUB_EMULATOR_HOST") == "" {
+		creds, err := auth.GetPerRPCCredentials(ctx, auth.AsSelf, auth.WithScopes(auth.CloudOAuthScopes...))
+		if err != nil {
+			return errors.Annotate(err, "failed to get PerRPCCredentials").Err()
+		}
+		opts = append(opts, option.WithGRPCDialOption(grpc.WithPerRPCCredentials(creds)))
+	}
+
+	ids := make([]string, 0, len(m.opts.PullSubscriptions))
+	for id := range m.opts.PullSubscriptions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	clients := map[string]*pubsub.Client{}
+	for _, id := range ids {
+		name := m.opts.PullSubscriptions[id]
+		project, subID, err := parseSubscriptionName(name)
+		if err != nil {
+			return errors.Annotate(err, "bad -pubsub-pull-subscription for handler %q", id).Err()
+		}
+
+		client := clients[project]
+		if client == nil {
+			client, err = pubsub.NewClient(ctx, project, opts...)
+			if err != nil {
+				return errors.Annotate(err, "failed to initialize Cloud Pub/Sub client").Err()
+			}
+			clients[project] = client
+			host.RegisterCleanup(func(ctx context.Context) {
+				if err := client.Close(); err != nil {
+					logging.Warningf(ctx, "Failed to close Cloud Pub/Sub client: %s", err)
+				}
+			})
+		}
+
+		logging.Infof(ctx, "Pubsub handler %q is pulling from %q", id, name)
+		sub := client.Subscription(subID)
+		host.RunInBackground("luci.pubsub.pull", func(ctx context.Context) {
+			m.pull(ctx, sub, id)
+		})
+	}
+	return nil
+}
+
+// pull pulls messages from the subscription until the context is canceled,
+// restarting the pulling with a backoff if it fails.
+func (m *pubsubModule) pull(ctx context.Context, sub *pubsub.Subscription, id string) {
+	err := retry.Retry(ctx, transient.Only(pullRetryPolicy), func() error {
+		return m.opts.Dispatcher.Pull(ctx, sub, id, m.opts.PullOptions)
+	}, func(err error, wait time.Duration) {
+		logging.Errorf(ctx, "Pubsub handler %q stopped pulling, will retry in %s: %s", id, wait, err)
+	})
+	if err != nil && ctx.Err() == nil {
+		logging.Errorf(ctx, "Pubsub handler %q stopped pulling: %s", id, err)
+	}
+}
+
+// pullRetryPolicy is retry.Iterator that retries indefinitely.
+func pullRetryPolicy() retry.Iterator {
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Retries: -1,
+			Delay:   time.Second,
+		},
+		MaxDelay: time.Minute,
+	}
+}
+
+// parseSubscriptionName parses "projects/<project>/subscriptions/<id>".
+func parseSubscriptionName(name string) (project, id string, err error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "projects" || parts[1] == "" || parts[2] != "subscriptions" || parts[3] == "" {
+		return "", "", errors.Reason("%q is not a full subscription name", name).Err()
+	}
+	return parts[1], parts[3], nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/runtime/paniccatcher"
+)
+
+// PullOptions configure how messages are pulled from a subscription.
+//
+// Zero values mean defaults of the Cloud Pub/Sub client library.
+type PullOptions struct {
+	// MaxOutstandingMessages is the maximum number of messages that are being
+	// handled concurrently.
+	MaxOutstandingMessages int
+
+	// MaxOutstandingBytes is the maximum total size of messages that are being
+	// handled concurrently.
+	MaxOutstandingBytes int
+
+	// DrainTimeout is how long to wait for handlers that are still running when
+	// the pulling is stopped.
+	//
+	// Once it expires, contexts of such handlers are canceled. Messages that
+	// were received, but not yet handled, are returned to Cloud Pub/Sub right
+	// away.
+	//
+	// Default is 30 sec.
+	DrainTimeout time.Duration
+}
+
+// Pull receives messages from the given pull subscription and dispatches them
+// to a handler with the given ID.
+//
+// Messages are acknowledged if the handler succeeds or fails with a fatal error
+// (including Ignore errors) and are returned to Cloud Pub/Sub for a redelivery
+// if the handler fails with a transient error or panics. This matches how push
+// subscriptions handle HTTP status codes returned by InstallPubSubRoutes
+// routes. Messages are also exposed through the same monitoring metrics.
+//
+// Blocks until the context is canceled (in which case it waits for running
+// handlers to finish and returns nil) or the subscription can't be pulled
+// anymore (in which case it returns the error tagged as transient, so the
+// caller may retry).
+//
+// Returns an error right away if there's no handler with the given ID.
+func (d *Dispatcher) Pull(ctx context.Context, sub *pubsub.Subscription, id string, opts PullOptions) error {
+	d.m.RLock()
+	h := d.h[id]
+	d.m.RUnlock()
+	if h == nil {
+		return errors.Reason("no pubsub handler with ID %q is registered", id).Err()
+	}
+
+	if opts.MaxOutstandingMessages != 0 {
+		sub.ReceiveSettings.MaxOutstandingMessages = opts.MaxOutstandingMessages
+	}
+	if opts.MaxOutstandingBytes != 0 {
+		sub.ReceiveSettings.MaxOutstandingBytes = opts.MaxOutstandingBytes
+	}
+	if opts.DrainTimeout == 0 {
+		opts.DrainTimeout = 30 * time.Second
+	}
+
+	// Handlers run in a context that outlives `ctx` by DrainTimeout to let them
+	// finish processing of messages when the pulling stops.
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandlers()
+	stopDrain := context.AfterFunc(ctx, func() {
+		logging.Infof(ctx, "Draining pubsub handler %q", id)
+		if r := <-clock.After(handlerCtx, opts.DrainTimeout); r.Err == nil {
+			logging.Warningf(ctx, "Pubsub handler %q didn't finish in %s, canceling it", id, opts.DrainTimeout)
+			cancelHandlers()
+		}
+	})
+	defer stopDrain()
+
+	err := sub.Receive(ctx, func(_ context.Context, m *pubsub.Message) {
+		// Don't start handling new messages when draining.
+		if ctx.Err() != nil {
+			m.Nack()
+			return
+		}
+		// Panics are treated as transient errors, like in push subscriptions
+		// where they result in HTTP 500.
+		paniccatcher.Do(func() {
+			d.handlePulled(handlerCtx, sub, id, h, m)
+		}, func(p *paniccatcher.Panic) {
+			p.Log(handlerCtx, "Panic in pubsub handler %q: %s", id, p.Reason)
+			m.Nack()
+		})
+	})
+	if err != nil {
+		return errors.Annotate(err, "pulling from %s", sub).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// handlePulled passes a pulled message to the handler and acknowledges it or
+// returns it to Cloud Pub/Sub based on the outcome.
+func (d *Dispatcher) handlePulled(ctx context.Context, sub *pubsub.Subscription, id string, h Handler, m *pubsub.Message) {
+	err := d.executeHandler(ctx, id, h, func() (Message, error) {
+		msg := Message{
+			Data:         m.Data,
+			Subscription: sub.String(),
+			MessageID:    m.ID,
+			PublishTime:  m.PublishTime,
+			Attributes:   m.Attributes,
+			Query:        url.Values{},
+		}
+		if msg.Attributes == nil {
+			msg.Attributes = map[string]string{}
+		}
+		return msg, nil
+	})
+	switch {
+	case err == nil || Ignore.In(err):
+		m.Ack()
+	case transient.Tag.In(err):
+		errors.Log(ctx, errors.Annotate(err, "transient error in pubsub handler %q", id).Err())
+		m.Nack()
+	default:
+		errors.Log(ctx, errors.Annotate(err, "fatal error in pubsub handler %q", id).Err())
+		m.Ack()
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/logging/memlogger"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/common/tsmon/store"
+	"go.chromium.org/luci/common/tsmon/target"
+)
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With fake Pub/Sub", t, func(t *ftt.Test) {
+		ctx := context.Background()
+		ctx = gologger.StdConfig.Use(ctx)
+		ctx, _, _ = tsmon.WithFakes(ctx)
+		tsmon.GetState(ctx).SetStore(store.NewInMemory(&target.Task{}))
+
+		srv := pstest.NewServer()
+		t.Cleanup(func() { srv.Close() })
+		conn, err := grpc.NewClient(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		assert.Loosely(t, err, should.BeNil)
+		t.Cleanup(func() { conn.Close() })
+
+		client, err := pubsub.NewClient(ctx, "proj", option.WithGRPCConn(conn))
+		assert.Loosely(t, err, should.BeNil)
+		t.Cleanup(func() { client.Close() })
+
+		topic, err := client.CreateTopic(ctx, "topic")
+		assert.Loosely(t, err, should.BeNil)
+		sub, err := client.CreateSubscription(ctx, "sub", pubsub.SubscriptionConfig{
+			Topic:       topic,
+			AckDeadline: 10 * time.Second,
+		})
+		assert.Loosely(t, err, should.BeNil)
+
+		publish := func(data string) string {
+			return srv.Publish("projects/proj/topics/topic", []byte(data), map[string]string{"k": data})
+		}
+		acks := func(id string) int {
+			return srv.Message(id).Acks
+		}
+
+		d := &Dispatcher{}
+
+		t.Run("Unknown handler", func(t *ftt.Test) {
+			err := d.Pull(ctx, sub, "unknown", PullOptions{})
+			assert.Loosely(t, err, should.ErrLike(`no pubsub handler with ID "unknown"`))
+		})
+
+		t.Run("Handles messages", func(t *ftt.Test) {
+			var m sync.Mutex
+			calls := map[string]int{}
+			done := make(chan struct{}, 100)
+
+			d.RegisterHandler("h", func(ctx context.Context, msg Message) error {
+				assert.Loosely(t, msg.Subscription, should.Equal("projects/proj/subscriptions/sub"))
+				assert.Loosely(t, msg.Attributes, should.Match(map[string]string{"k": string(msg.Data)}))
+				assert.Loosely(t, msg.Query, should.NotBeNil)
+
+				m.Lock()
+				calls[string(msg.Data)]++
+				attempt := calls[string(msg.Data)]
+				m.Unlock()
+				defer func() { done <- struct{}{} }()
+
+				switch string(msg.Data) {
+				case "transient":
+					if attempt == 1 {
+						return transient.Tag.Apply(errors.New("boom"))
+					}
+					return nil
+				case "fatal":
+					return errors.New("boom")
+				case "ignore":
+					return Ignore.Apply(errors.New("boom"))
+				default:
+					return nil
+				}
+			})
+
+			ids := []string{publish("ok"), publish("transient"), publish("fatal"), publish("ignore")}
+
+			ctx, cancel := context.WithCancel(ctx)
+			pullErr := make(chan error)
+			go func() { pullErr <- d.Pull(ctx, sub, "h", PullOptions{MaxOutstandingMessages: 2}) }()
+
+			// 4 messages + one redelivery.
+			for range 5 {
+				<-done
+			}
+			cancel()
+			assert.Loosely(t, <-pullErr, should.BeNil)
+
+			assert.Loosely(t, calls, should.Match(map[string]int{
+				"ok":        1,
+				"transient": 2,
+				"fatal":     1,
+				"ignore":    1,
+			}))
+			for _, id := range ids {
+				assert.Loosely(t, acks(id), should.Equal(1))
+			}
+
+			metric := func(fieldVals ...any) any {
+				return tsmon.GetState(ctx).Store().Get(ctx, callsCounter, fieldVals)
+			}
+			assert.Loosely(t, metric("h", "OK"), should.Equal(int64(2)))
+			assert.Loosely(t, metric("h", "transient"), should.Equal(int64(1)))
+			assert.Loosely(t, metric("h", "fatal"), should.Equal(int64(1)))
+			assert.Loosely(t, metric("h", "ignore"), should.Equal(int64(1)))
+		})
+
+		t.Run("Recovers from panics", func(t *ftt.Test) {
+			calls := 0
+			done := make(chan struct{}, 2)
+
+			d.RegisterHandler("panicky", func(ctx context.Context, msg Message) error {
+				defer func() { done <- struct{}{} }()
+				if calls++; calls == 1 {
+					panic("boom")
+				}
+				return nil
+			})
+
+			id := publish("panicky")
+
+			ctx, cancel := context.WithCancel(ctx)
+			pullErr := make(chan error)
+			go func() { pullErr <- d.Pull(ctx, sub, "panicky", PullOptions{MaxOutstandingMessages: 1}) }()
+
+			// The panicking attempt and the redelivery.
+			for range 2 {
+				<-done
+			}
+			cancel()
+			assert.Loosely(t, <-pullErr, should.BeNil)
+
+			assert.Loosely(t, calls, should.Equal(2))
+			assert.Loosely(t, acks(id), should.Equal(1))
+
+			metric := func(fieldVals ...any) any {
+				return tsmon.GetState(ctx).Store().Get(ctx, callsCounter, fieldVals)
+			}
+			assert.Loosely(t, metric("panicky", "panic"), should.Equal(int64(1)))
+			assert.Loosely(t, metric("panicky", "OK"), should.Equal(int64(1)))
+		})
+
+		t.Run("Module retries pulling", func(t *ftt.Test) {
+			done := make(chan struct{}, 1)
+			d.RegisterHandler("late", func(ctx context.Context, msg Message) error {
+				done <- struct{}{}
+				return nil
+			})
+
+			ctx := memlogger.Use(ctx)
+			log := logging.Get(ctx).(*memlogger.MemLogger)
+
+			ctx, cancel := context.WithCancel(ctx)
+			pullDone := make(chan struct{})
+			go func() {
+				defer close(pullDone)
+				m := &pubsubModule{opts: &ModuleOptions{Dispatcher: d}}
+				m.pull(ctx, client.Subscription("late"), "late")
+			}()
+
+			// Wait until pulling from the missing subscription fails.
+			deadline := time.Now().Add(10 * time.Second)
+			for !log.HasFunc(func(e *memlogger.LogEntry) bool { return strings.Contains(e.Msg, "will retry") }) {
+				if time.Now().After(deadline) {
+					t.Fatalf("pulling didn't fail")
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			// Once the subscription appears, messages are pulled from it.
+			_, err := client.CreateSubscription(ctx, "late", pubsub.SubscriptionConfig{Topic: topic})
+			assert.Loosely(t, err, should.BeNil)
+			id := publish("late")
+
+			<-done
+			cancel()
+			<-pullDone
+			assert.Loosely(t, acks(id), should.Equal(1))
+		})
+
+		t.Run("Drains on shutdown", func(t *ftt.Test) {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var handlerErr error
+
+			d.RegisterHandler("slow", func(ctx context.Context, msg Message) error {
+				close(started)
+				<-release
+				handlerErr = ctx.Err()
+				return nil
+			})
+
+			id := publish("slow")
+
+			ctx, cancel := context.WithCancel(ctx)
+			pullErr := make(chan error)
+			go func() { pullErr <- d.Pull(ctx, sub, "slow", PullOptions{DrainTimeout: time.Minute}) }()
+
+			<-started
+			cancel()
+
+			// Pull waits for the handler to finish.
+			select {
+			case <-pullErr:
+				t.Fatalf("Pull returned before the handler finished")
+			case <-time.After(100 * time.Millisecond):
+			}
+			close(release)
+
+			assert.Loosely(t, <-pullErr, should.BeNil)
+			assert.Loosely(t, handlerErr, should.BeNil)
+			assert.Loosely(t, acks(id), should.Equal(1))
+		})
+
+		t.Run("Cancels handlers after drain timeout", func(t *ftt.Test) {
+			started := make(chan struct{})
+
+			d.RegisterHandler("stuck", func(ctx context.Context, msg Message) error {
+				close(started)
+				<-ctx.Done()
+				return transient.Tag.Apply(ctx.Err())
+			})
+
+			id := publish("stuck")
+
+			ctx, cancel := context.WithCancel(ctx)
+			pullErr := make(chan error)
+			go func() { pullErr <- d.Pull(ctx, sub, "stuck", PullOptions{DrainTimeout: time.Millisecond}) }()
+
+			<-started
+			cancel()
+
+			assert.Loosely(t, <-pullErr, should.BeNil)
+			assert.Loosely(t, acks(id), should.BeZero)
+		})
+	})
+}
+
+func TestParseSubscriptionName(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		project, id, err := parseSubscriptionName("projects/p/subscriptions/s")
+		assert.Loosely(t, err, should.BeNil)
+		assert.Loosely(t, project, should.Equal("p"))
+		assert.Loosely(t, id, should.Equal("s"))
+
+		for _, bad := range []string{"", "s", "projects/p/topics/s", "projects//subscriptions/s", "projects/p/subscriptions/"} {
+			_, _, err := parseSubscriptionName(bad)
+			assert.Loosely(t, err, should.ErrLike("is not a full subscription name"))
+		}
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisconn
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/caching"
+)
+
+const (
+	// pubSubPingInterval is how often to ping the server on an idle pub/sub
+	// connection to detect dead connections.
+	pubSubPingInterval = 30 * time.Second
+	// pubSubReconnectDelay is how long to wait before resubscribing after
+	// a pub/sub connection error.
+	pubSubReconnectDelay = 5 * time.Second
+)
+
+// redisInvalidationBus implements caching.InvalidationBus using Redis pub/sub.
+type redisInvalidationBus struct {
+	Channel string // a channel to publish invalidations to
+}
+
+var _ caching.InvalidationBus = (*redisInvalidationBus)(nil)
+
+// Publish sends the invalidation to all processes subscribed to the bus.
+func (b *redisInvalidationBus) Publish(ctx context.Context, inv caching.Invalidation) error {
+	blob, err := json.Marshal(&inv)
+	if err != nil {
+		return err
+	}
+
+	conn, err := Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("PUBLISH", b.Channel, blob)
+	return err
+}
+
+// subscribeInvalidations dispatches invalidations received through the given
+// Redis pub/sub channel via caching.DispatchInvalidation.
+//
+// Resubscribes on errors. Invalidations published while the subscription is
+// down are lost. Returns when the context is canceled.
+func subscribeInvalidations(ctx context.Context, pool *redis.Pool, channel string) {
+	for ctx.Err() == nil {
+		err := receiveInvalidations(ctx, pool, channel)
+		if ctx.Err() != nil {
+			return
+		}
+		logging.Warningf(ctx, "Redis pub/sub subscription to %q failed, resubscribing: %s", channel, err)
+		clock.Sleep(ctx, pubSubReconnectDelay)
+	}
+}
+
+// receiveInvalidations subscribes to the channel and dispatches received
+// invalidations until the context is canceled or the connection fails.
+func receiveInvalidations(ctx context.Context, pool *redis.Pool, channel string) error {
+	conn, err := pool.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	psc := redis.PubSubConn{Conn: conn}
+	defer psc.Close()
+
+	if err := psc.Subscribe(channel); err != nil {
+		return err
+	}
+
+	// Ping the server periodically to keep the connection alive and detect when
+	// it dies. Unsubscribe when the context is canceled, this unblocks the
+	// receive loop below.
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	defer func() {
+		close(done)
+		<-stopped // before closing the connection
+	}()
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(pubSubPingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				psc.Unsubscribe()
+				return
+			case <-ticker.C:
+				if err := psc.Ping(""); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		switch msg := psc.ReceiveWithTimeout(2 * pubSubPingInterval).(type) {
+		case error:
+			return msg
+		case redis.Subscription:
+			if msg.Count == 0 {
+				return errors.New("unsubscribed")
+			}
+		case redis.Message:
+			var inv caching.Invalidation
+			if err := json.Unmarshal(msg.Data, &inv); err != nil {
+				logging.Errorf(ctx, "Skipping malformed invalidation message %q: %s", msg.Data, err)
+				continue
+			}
+			caching.DispatchInvalidation(ctx, inv)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisconn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/caching"
+)
+
+var testInvalidations = make(chan string, 100)
+
+func init() {
+	caching.RegisterInvalidationHandler("redisconn-test", func(ctx context.Context, key string) {
+		testInvalidations <- key
+	})
+}
+
+func TestInvalidationBus(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With Redis", t, func(t *ftt.Test) {
+		s, err := miniredis.Run()
+		assert.Loosely(t, err, should.BeNil)
+		defer s.Close()
+
+		pool := &redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", s.Addr())
+			},
+		}
+		defer pool.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx = UsePool(ctx, pool)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			subscribeInvalidations(ctx, pool, "channel")
+		}()
+
+		// Publish until the subscription is established and the message arrives.
+		bus := &redisInvalidationBus{Channel: "channel"}
+		inv := caching.Invalidation{Namespace: "redisconn-test", Key: "key"}
+		var got string
+	loop:
+		for {
+			assert.Loosely(t, bus.Publish(ctx, inv), should.BeNil)
+			select {
+			case got = <-testInvalidations:
+				break loop
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+		assert.Loosely(t, got, should.Equal("key"))
+
+		// Stops when the context is canceled.
+		cancel()
+		<-done
+	})
+}
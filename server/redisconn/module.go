@@ -54,7 +54,7 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 
 // NewModule returns a server module that adds a Redis connection pool to the
 // global server context and installs Redis as the default caching.BlobCache
-// implementation.
+// and caching.InvalidationBus implementation.
 //
 // The Redis connection pool can be used through redisconn.Get(ctx).
 //
@@ -107,6 +107,21 @@ func (m *redisModule) Initialize(ctx context.Context, host module.Host, opts mod
 		return &redisBlobCache{Prefix: fmt.Sprintf("luci.blobcache.%s:", namespace)}
 	})
 
+	// Use Redis pub/sub to broadcast cache invalidations. Note that pub/sub
+	// channels are not scoped to a logical DB, so put the DB index into the
+	// channel name to keep invalidations as isolated as the blob cache is.
+	channel := fmt.Sprintf("luci.caching.invalidations.%d", m.opts.RedisDB)
+	ctx = caching.WithInvalidationBus(ctx, &redisInvalidationBus{Channel: channel})
+
+	// The subscription permanently occupies a connection from the pool, so
+	// subscribe only if some cache in the process wants to be invalidated.
+	// Handlers are registered during init time, i.e. they are all known now.
+	if caching.HasInvalidationHandlers() {
+		host.RunInBackground("luci.redisconn.invalidations", func(ctx context.Context) {
+			subscribeInvalidations(ctx, pool, channel)
+		})
+	}
+
 	// Close all connections when exiting gracefully.
 	host.RegisterCleanup(func(ctx context.Context) {
 		if err := pool.Close(); err != nil {
@@ -35,7 +35,8 @@
 //
 // When used that way, Redis is also installed as the default implementation
 // of caching.BlobCache (which basically speeds up various internal guts of
-// the LUCI server framework).
+// the LUCI server framework) and caching.InvalidationBus (which uses Redis
+// pub/sub to broadcast cache invalidations to all server processes).
 //
 // Can also be used as a low-level Redis connection pool library, see
 // NewPool(...)
@@ -48,6 +48,16 @@ func MustAddTask(ctx context.Context, task *Task) {
 	}
 }
 
+// Cancel is a shortcut for Default.Cancel.
+func Cancel(ctx context.Context, class, name string) error {
+	return Default.Cancel(ctx, class, name)
+}
+
+// Replace is a shortcut for Default.Replace.
+func Replace(ctx context.Context, name string, task *Task) error {
+	return Default.Replace(ctx, name, task)
+}
+
 // Sweep is a shortcut for Default.Sweep.
 func Sweep(ctx context.Context) error {
 	return Default.Sweep(ctx)
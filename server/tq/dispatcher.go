@@ -399,7 +399,8 @@ type Task struct {
 	// Can be used only with Cloud Tasks tasks, since PubSub doesn't support
 	// deduplication during enqueuing.
 	//
-	// Named tasks can only be used outside of transactions.
+	// The key can also be used to cancel a pending task, see Dispatcher's Cancel
+	// and Replace.
 	DeduplicationKey string
 
 	// Title is optional string that identifies the task in server logs.
@@ -621,8 +622,7 @@ func (d *Dispatcher) TaskClassRef(id string) TaskClassRef {
 //
 // If the task has a DeduplicationKey and there already was a recent task with
 // the same TaskClass ID and DeduplicationKey, silently ignores the added task.
-// This works only outside of transactions. Using DeduplicationKey with
-// transactional tasks results in an error.
+// Such named tasks can later be canceled via Cancel or Replace.
 //
 // Annotates retriable errors with transient.Tag.
 func (d *Dispatcher) AddTask(ctx context.Context, task *Task) (err error) {
@@ -656,6 +656,105 @@ func (d *Dispatcher) AddTask(ctx context.Context, task *Task) (err error) {
 	}
 
 	// Examine the context to see if we are inside a transaction.
+	txndb, err := d.txnDB(ctx, cls, "enqueuing")
+	if err != nil {
+		return err
+	}
+
+	// If not inside a transaction, submit the task right away.
+	if txndb == nil {
+		return internal.Submit(ctx, sub, payload, internal.TxnPathNone)
+	}
+	return d.submitAfterTxn(ctx, sub, txndb, cls, task.Title, payload, span)
+}
+
+// Cancel deletes a pending named task, preventing its future execution.
+//
+// `class` is an ID of a registered TaskClass and `name` is a DeduplicationKey
+// the task was added with. Works only with Cloud Tasks task classes that have
+// a static Queue (since the queue of a task can't be derived from its name).
+//
+// If the task has already been executed (or is being executed right now) or
+// there's no such task at all, does nothing. Note that Cloud Tasks doesn't
+// allow reusing the name of a deleted task for ~1h, see Replace for a way to
+// swap a pending task with another one.
+//
+// Follows the same transactional semantics as AddTask: if the given context
+// is transactional, the task will eventually be canceled if and only if the
+// transaction successfully commits (assuming a sweeper is running).
+//
+// Annotates retriable errors with transient.Tag.
+func (d *Dispatcher) Cancel(ctx context.Context, class, name string) (err error) {
+	sub, err := currentSubmitter(ctx)
+	if err != nil {
+		return err
+	}
+
+	cls, _, err := d.classByID(class)
+	if err != nil {
+		return err
+	}
+	ctx, span := startSpan(ctx, "go.chromium.org/luci/server/tq.Cancel", map[string]string{
+		"cr.dev.class": cls.ID,
+		"cr.dev.name":  name,
+	})
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	payload, err := d.prepCancelPayload(ctx, cls, name)
+	if err != nil {
+		return err
+	}
+
+	txndb, err := d.txnDB(ctx, cls, "canceling")
+	if err != nil {
+		return err
+	}
+	if txndb == nil {
+		return internal.Submit(ctx, sub, payload, internal.TxnPathNone)
+	}
+	return d.submitAfterTxn(ctx, sub, txndb, cls, "", payload, span)
+}
+
+// Replace adds a new named task and cancels a pending task with the given name.
+//
+// Both tasks must belong to the same task class. The new task must have
+// a DeduplicationKey different from `name`, since Cloud Tasks doesn't allow
+// reusing the name of a deleted task for ~1h. A common pattern is to derive
+// keys from some incrementing version number.
+//
+// The new task is added before the old one is canceled, so there's no window
+// when neither of them is pending. If the context is transactional, both
+// operations are committed together with the transaction.
+//
+// Annotates retriable errors with transient.Tag.
+func (d *Dispatcher) Replace(ctx context.Context, name string, task *Task) error {
+	cls, _, err := d.classByMsg(task.Payload)
+	if err != nil {
+		return err
+	}
+	switch {
+	case task.DeduplicationKey == "":
+		return errors.Reason("when replacing %q: the new task must have a DeduplicationKey", cls.ID).Err()
+	case task.DeduplicationKey == name:
+		return errors.Reason("when replacing %q: the new task must have a DeduplicationKey different from %q", cls.ID, name).Err()
+	}
+	if err := d.AddTask(ctx, task); err != nil {
+		return err
+	}
+	return d.Cancel(ctx, cls.ID, name)
+}
+
+// txnDB returns the transactional DB in the context, if the task class allows
+// using it.
+//
+// Returns an error if the task class kind doesn't match the context.
+func (d *Dispatcher) txnDB(ctx context.Context, cls *taskClassImpl, verb string) (db.DB, error) {
 	txndb := db.TxnDB(ctx)
 	switch cls.Kind {
 	case FollowsContext:
@@ -663,33 +762,27 @@ func (d *Dispatcher) AddTask(ctx context.Context, task *Task) (err error) {
 	case Transactional:
 		if txndb == nil {
 			if !db.Configured() {
-				return errors.Reason("enqueuing of tasks %q requires transactions support, "+
-					"see https://pkg.go.dev/go.chromium.org/luci/server/tq#hdr-Transactional_tasks", cls.ID).Err()
+				return nil, errors.Reason("%s of tasks %q requires transactions support, "+
+					"see https://pkg.go.dev/go.chromium.org/luci/server/tq#hdr-Transactional_tasks", verb, cls.ID).Err()
 			}
-			return errors.Reason("enqueuing of tasks %q must be done from inside a transaction", cls.ID).Err()
+			return nil, errors.Reason("%s of tasks %q must be done from inside a transaction", verb, cls.ID).Err()
 		}
 	case NonTransactional:
 		if txndb != nil {
-			return errors.Reason("enqueuing of tasks %q must be done outside of a transaction", cls.ID).Err()
+			return nil, errors.Reason("%s of tasks %q must be done outside of a transaction", verb, cls.ID).Err()
 		}
 	default:
 		panic(fmt.Sprintf("unrecognized TaskKind %v", cls.Kind))
 	}
+	return txndb, nil
+}
 
-	// If not inside a transaction, submit the task right away.
-	if txndb == nil {
-		return internal.Submit(ctx, sub, payload, internal.TxnPathNone)
-	}
-
-	// Named transactional tasks are not supported.
-	if task.DeduplicationKey != "" {
-		return errors.Reason("when enqueuing %q: can't use DeduplicationKey for a transactional task", cls.ID).Err()
-	}
-
-	// Otherwise transactionally commit a reminder and schedule a best-effort
-	// post-transaction enqueuing of the actual task. If it fails, the sweeper
-	// will eventually discover the reminder and enqueue the task. Note that this
-	// modifies `payload` with the reminder's ID.
+// submitAfterTxn transactionally commits a reminder and schedules
+// a best-effort post-transaction submission of the payload.
+//
+// If it fails, the sweeper will eventually discover the reminder and submit
+// the payload. Note that this modifies `payload` with the reminder's ID.
+func (d *Dispatcher) submitAfterTxn(ctx context.Context, sub Submitter, txndb db.DB, cls *taskClassImpl, title string, payload *reminder.Payload, span trace.Span) error {
 	r, err := d.attachToReminder(ctx, payload)
 	if err != nil {
 		return errors.Annotate(err, "failed to prepare a reminder").Err()
@@ -709,7 +802,7 @@ func (d *Dispatcher) AddTask(ctx context.Context, task *Task) (err error) {
 		var err error
 		ctx, span := startSpan(ctx, "go.chromium.org/luci/server/tq.PostTxn", map[string]string{
 			"cr.dev.class":    cls.ID,
-			"cr.dev.title":    task.Title,
+			"cr.dev.title":    title,
 			"cr.dev.reminder": r.ID,
 		})
 		defer func() {
@@ -901,7 +994,7 @@ func (d *Dispatcher) prepCloudTasksRequest(ctx context.Context, cls *taskClassIm
 
 	taskID := ""
 	if t.DeduplicationKey != "" {
-		taskID = queueID + "/tasks/" + cls.taskName(t, d.Namespace)
+		taskID = queueID + "/tasks/" + cls.taskName(t.DeduplicationKey, d.Namespace)
 	}
 
 	var scheduleTime *timestamppb.Timestamp
@@ -1017,6 +1110,29 @@ func (d *Dispatcher) prepCloudTasksRequest(ctx context.Context, cls *taskClassIm
 	return req, nil
 }
 
+// prepCancelPayload prepares a payload that deletes a named Cloud Tasks task.
+func (d *Dispatcher) prepCancelPayload(ctx context.Context, cls *taskClassImpl, name string) (*reminder.Payload, error) {
+	switch {
+	case name == "":
+		return nil, errors.Reason("when canceling %q: the task name is required", cls.ID).Err()
+	case cls.Topic != "":
+		return nil, errors.Reason("when canceling %q: PubSub tasks can't be canceled", cls.ID).Err()
+	case cls.Queue == "":
+		return nil, errors.Reason("when canceling %q: tasks that use QueuePicker can't be canceled", cls.ID).Err()
+	}
+	queueID, err := d.queueID(cls.Queue)
+	if err != nil {
+		return nil, err
+	}
+	return &reminder.Payload{
+		TaskClass: cls.ID,
+		Created:   clock.Now(ctx),
+		DeleteTaskRequest: &taskspb.DeleteTaskRequest{
+			Name: queueID + "/tasks/" + cls.taskName(name, d.Namespace),
+		},
+	}, nil
+}
+
 // makeETAHeader converts the given time into a decimal string representing
 // the number of seconds since the Unix epoch with microsecond resolution.
 func makeETAHeader(t time.Time) string {
@@ -1386,13 +1502,13 @@ func (cls *taskClassImpl) Definition() TaskClass {
 }
 
 // taskName returns a short ID for the task to use to dedup it.
-func (cls *taskClassImpl) taskName(t *Task, namespace string) string {
+func (cls *taskClassImpl) taskName(key, namespace string) string {
 	h := sha256.New()
 	h.Write([]byte(namespace))
 	h.Write([]byte{0})
 	h.Write([]byte(cls.ID))
 	h.Write([]byte{0})
-	h.Write([]byte(t.DeduplicationKey))
+	h.Write([]byte(key))
 	return hex.EncodeToString(h.Sum(nil))
 }
 
@@ -181,6 +181,57 @@ func TestAddTask(t *testing.T) {
 					"ca0a124846df4b453ae63e3ad7c63073b0d25941c6e63e5708fd590c016edcef"))
 		})
 
+		t.Run("Cancel", func(t *ftt.Test) {
+			assert.Loosely(t, d.Cancel(ctx, "test-dur", "key"), should.BeNil)
+
+			assert.Loosely(t, submitter.reqs, should.HaveLength(1))
+			assert.Loosely(t, submitter.reqs[0].TaskClass, should.Equal("test-dur"))
+			assert.Loosely(t, submitter.reqs[0].DeleteTaskRequest, should.Match(&taskspb.DeleteTaskRequest{
+				Name: "projects/proj/locations/reg/queues/queue-1/tasks/" +
+					"ca0a124846df4b453ae63e3ad7c63073b0d25941c6e63e5708fd590c016edcef",
+			}))
+		})
+
+		t.Run("Cancel missing task", func(t *ftt.Test) {
+			submitter.err = func(string) error {
+				return status.Errorf(codes.NotFound, "no such task")
+			}
+			assert.Loosely(t, d.Cancel(ctx, "test-dur", "key"), should.BeNil)
+		})
+
+		t.Run("Cancel errors", func(t *ftt.Test) {
+			assert.Loosely(t, d.Cancel(ctx, "unknown", "key"), should.ErrLike("no task class"))
+			assert.Loosely(t, d.Cancel(ctx, "test-dur", ""), should.ErrLike("the task name is required"))
+
+			d.RegisterTaskClass(TaskClass{
+				ID:          "test-ts",
+				Prototype:   &timestamppb.Timestamp{},
+				Kind:        NonTransactional,
+				QueuePicker: func(context.Context, *Task) (string, error) { return "q", nil },
+			})
+			assert.Loosely(t, d.Cancel(ctx, "test-ts", "key"), should.ErrLike("use QueuePicker"))
+			assert.Loosely(t, submitter.reqs, should.BeEmpty)
+		})
+
+		t.Run("Replace", func(t *ftt.Test) {
+			task.DeduplicationKey = "key-2"
+
+			assert.Loosely(t, d.Replace(ctx, "key", task), should.BeNil)
+
+			assert.Loosely(t, submitter.reqs, should.HaveLength(2))
+			assert.Loosely(t, submitter.reqs[0].CreateTaskRequest.Task.Name, should.NotEqual(
+				submitter.reqs[1].DeleteTaskRequest.Name))
+			assert.Loosely(t, submitter.reqs[1].DeleteTaskRequest.Name, should.Equal(
+				"projects/proj/locations/reg/queues/queue-1/tasks/"+
+					"ca0a124846df4b453ae63e3ad7c63073b0d25941c6e63e5708fd590c016edcef"))
+		})
+
+		t.Run("Replace with the same key", func(t *ftt.Test) {
+			task.DeduplicationKey = "key"
+			assert.Loosely(t, d.Replace(ctx, "key", task), should.ErrLike("must have a DeduplicationKey different"))
+			assert.Loosely(t, submitter.reqs, should.BeEmpty)
+		})
+
 		t.Run("Titleless task", func(t *ftt.Test) {
 			task.Title = ""
 
@@ -624,6 +675,99 @@ func TestTransactionalEnqueue(t *testing.T) {
 	})
 }
 
+func TestCancel(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With dispatcher", t, func(t *ftt.Test) {
+		var epoch = testclock.TestRecentTimeUTC
+
+		ctx, tc := testclock.UseTime(context.Background(), epoch)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if testclock.HasTags(t, tqtesting.ClockTag) {
+				tc.Add(d)
+			}
+		})
+		db := testutil.FakeDB{}
+
+		disp := Dispatcher{Sweeper: NewInProcSweeper(InProcSweeperOptions{})}
+		ctx, sched := TestingContext(ctx, &disp)
+
+		disp.RegisterTaskClass(TaskClass{
+			ID:        "test-dur",
+			Prototype: &durationpb.Duration{}, // just some proto type
+			Kind:      FollowsContext,
+			Queue:     "queue-1",
+			Handler: func(ctx context.Context, msg proto.Message) error {
+				return nil
+			},
+		})
+
+		addTask := func(ctx context.Context, key string, secs int64) {
+			assert.Loosely(t, disp.AddTask(ctx, &Task{
+				Payload:          &durationpb.Duration{Seconds: secs},
+				DeduplicationKey: key,
+				Delay:            time.Minute,
+			}), should.BeNil)
+		}
+
+		t.Run("Non-transactional", func(t *ftt.Test) {
+			addTask(ctx, "a", 1)
+			addTask(ctx, "b", 2)
+
+			assert.Loosely(t, disp.Cancel(ctx, "test-dur", "a"), should.BeNil)
+			assert.Loosely(t, sched.Tasks().Payloads(), should.Match([]protoreflect.ProtoMessage{
+				&durationpb.Duration{Seconds: 2},
+			}))
+
+			// Canceling it again is fine.
+			assert.Loosely(t, disp.Cancel(ctx, "test-dur", "a"), should.BeNil)
+
+			// Can't reuse the name.
+			addTask(ctx, "a", 3)
+			assert.Loosely(t, sched.Tasks(), should.HaveLength(1))
+		})
+
+		t.Run("Replace", func(t *ftt.Test) {
+			addTask(ctx, "v1", 1)
+			assert.Loosely(t, disp.Replace(ctx, "v1", &Task{
+				Payload:          &durationpb.Duration{Seconds: 2},
+				DeduplicationKey: "v2",
+				Delay:            2 * time.Minute,
+			}), should.BeNil)
+
+			var success tqtesting.TaskList
+			sched.TaskSucceeded = tqtesting.TasksCollector(&success)
+			sched.Run(ctx, tqtesting.StopWhenDrained())
+			assert.Loosely(t, success.Payloads(), should.Match([]protoreflect.ProtoMessage{
+				&durationpb.Duration{Seconds: 2},
+			}))
+		})
+
+		t.Run("Transactional", func(t *ftt.Test) {
+			addTask(db.Inject(ctx), "a", 1)
+			db.ExecDefers(ctx)
+			assert.Loosely(t, sched.Tasks(), should.HaveLength(1))
+
+			assert.Loosely(t, disp.Cancel(db.Inject(ctx), "test-dur", "a"), should.BeNil)
+			assert.Loosely(t, db.AllReminders(), should.HaveLength(1))
+
+			t.Run("Happy path", func(t *ftt.Test) {
+				db.ExecDefers(ctx)
+				assert.Loosely(t, db.AllReminders(), should.BeEmpty)
+				assert.Loosely(t, sched.Tasks(), should.BeEmpty)
+			})
+
+			t.Run("Sweeper", func(t *ftt.Test) {
+				// Make reminder sufficiently stale to be eligible for sweeping.
+				tc.Add(5 * time.Minute)
+				assert.Loosely(t, disp.Sweep(db.Inject(ctx)), should.BeNil)
+				assert.Loosely(t, db.AllReminders(), should.BeEmpty)
+				assert.Loosely(t, sched.Tasks(), should.BeEmpty)
+			})
+		})
+	})
+}
+
 func TestTesting(t *testing.T) {
 	t.Parallel()
 
@@ -770,6 +914,9 @@ func (s *submitter) Submit(ctx context.Context, req *reminder.Payload) error {
 }
 
 func title(req *reminder.Payload) string {
+	if req.CreateTaskRequest == nil {
+		return ""
+	}
 	url := ""
 	switch mt := req.CreateTaskRequest.Task.MessageType.(type) {
 	case *taskspb.Task_HttpRequest:
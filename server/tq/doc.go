@@ -17,6 +17,16 @@
 // It exposes a high-level API that operates with proto messages and hides
 // gory details such as serialization, routing, authentication, etc.
 //
+// # Named tasks
+//
+// A Cloud Tasks task with a DeduplicationKey is a named task. Adding a task
+// with the same name as a recently added one is a noop. A pending named task
+// can be canceled with Cancel (e.g. a delayed reminder that is no longer
+// needed) or swapped with another task with Replace (e.g. to postpone it).
+// Both work transactionally as well: the cancellation is then stored in the
+// database together with the transaction and is eventually applied by the
+// sweeper, exactly like transactional tasks.
+//
 // # Transactional tasks
 //
 // Tasks can be submitted as part of a database transaction. This is controlled
@@ -44,6 +54,11 @@
 //
 //	import _ "go.chromium.org/luci/server/tq/txn/spanner"
 //
+// For SQL databases accessed through database/sql (see the package doc for
+// how to set up the database and run transactions):
+//
+//	import tqsql "go.chromium.org/luci/server/tq/txn/sql"
+//
 // The exact location of the import doesn't matter as long as the package is
 // present in the import tree of the binary. If your tests use transactional
 // tasks, they'll need to import the corresponding packages as well.
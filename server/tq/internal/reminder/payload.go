@@ -35,13 +35,15 @@ type Payload struct {
 
 	CreateTaskRequest *taskspb.CreateTaskRequest // prepared Cloud Tasks request
 	PublishRequest    *pubsubpb.PublishRequest   // prepared PubSub request
+	DeleteTaskRequest *taskspb.DeleteTaskRequest // prepared Cloud Tasks cancellation
 }
 
 // injectReminderID is called when the payload is attached to a reminder.
 func (r *Payload) injectReminderID(id string) {
-	// We use reminder ID to dedup Cloud Tasks submitted by the sweeper.
+	// We use reminder ID to dedup Cloud Tasks submitted by the sweeper. Named
+	// tasks already have a stable name that serves the same purpose.
 	if req := r.CreateTaskRequest; req != nil {
-		if req.Task != nil { // may be nil in tests
+		if req.Task != nil && req.Task.Name == "" { // may be nil in tests
 			req.Task.Name = req.Parent + "/tasks/" + id
 		}
 	}
@@ -95,6 +95,12 @@ func (r *Reminder) AttachPayload(p *Payload) error {
 			return errors.Annotate(err, "failed to marshal PublishRequest").Err()
 		}
 		msg.Payload = &tqpb.Payload_PublishRequest{PublishRequest: blob}
+	case p.DeleteTaskRequest != nil:
+		blob, err := proto.Marshal(p.DeleteTaskRequest)
+		if err != nil {
+			return errors.Annotate(err, "failed to marshal DeleteTaskRequest").Err()
+		}
+		msg.Payload = &tqpb.Payload_DeleteTaskRequest{DeleteTaskRequest: blob}
 	default:
 		panic("malformed payload")
 	}
@@ -158,6 +164,12 @@ func (r *Reminder) Payload() (*Payload, error) {
 			return nil, errors.Annotate(err, "failed to unmarshal PublishRequest").Err()
 		}
 		p.PublishRequest = req
+	case *tqpb.Payload_DeleteTaskRequest:
+		req := &taskspb.DeleteTaskRequest{}
+		if err := proto.Unmarshal(blob.DeleteTaskRequest, req); err != nil {
+			return nil, errors.Annotate(err, "failed to unmarshal DeleteTaskRequest").Err()
+		}
+		p.DeleteTaskRequest = req
 	default:
 		return nil, errors.New("unrecognized task payload kind")
 	}
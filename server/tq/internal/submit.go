@@ -54,8 +54,9 @@ type Submitter interface {
 
 // Submit submits the prepared request through the given submitter.
 //
-// Recognizes AlreadyExists as success. Annotates retriable errors with
-// transient.Tag.
+// Recognizes AlreadyExists as success. For task cancellations also recognizes
+// NotFound as success, since the task may have already been executed or
+// deleted. Annotates retriable errors with transient.Tag.
 func Submit(ctx context.Context, s Submitter, payload *reminder.Payload, path TxnPath) error {
 	// Each individual RPC should be pretty quick. Also Cloud Tasks client bugs
 	// out if the context has a large deadline.
@@ -72,6 +73,11 @@ func Submit(ctx context.Context, s Submitter, payload *reminder.Payload, path Tx
 	if tr := payload.CreateTaskRequest; tr != nil && tr.Parent != "" {
 		queue = tr.Parent[strings.LastIndex(tr.Parent, "/")+1:]
 	}
+	if dr := payload.DeleteTaskRequest; dr != nil {
+		if parent, _, ok := strings.Cut(dr.Name, "/tasks/"); ok {
+			queue = parent[strings.LastIndex(parent, "/")+1:]
+		}
+	}
 
 	metrics.SubmitCount.Add(ctx, 1,
 		payload.TaskClass, queue, string(path), code.String())
@@ -81,6 +87,11 @@ func Submit(ctx context.Context, s Submitter, payload *reminder.Payload, path Tx
 	switch code {
 	case codes.OK, codes.AlreadyExists:
 		return nil
+	case codes.NotFound:
+		if payload.DeleteTaskRequest != nil {
+			return nil
+		}
+		return err
 	case codes.Internal,
 		codes.Unknown,
 		codes.Unavailable,
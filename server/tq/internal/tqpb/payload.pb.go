@@ -51,6 +51,7 @@ type Payload struct {
 	//
 	//	*Payload_CreateTaskRequest
 	//	*Payload_PublishRequest
+	//	*Payload_DeleteTaskRequest
 	Payload       isPayload_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -125,6 +126,15 @@ func (x *Payload) GetPublishRequest() []byte {
 	return nil
 }
 
+func (x *Payload) GetDeleteTaskRequest() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*Payload_DeleteTaskRequest); ok {
+			return x.DeleteTaskRequest
+		}
+	}
+	return nil
+}
+
 type isPayload_Payload interface {
 	isPayload_Payload()
 }
@@ -137,10 +147,16 @@ type Payload_PublishRequest struct {
 	PublishRequest []byte `protobuf:"bytes,21,opt,name=publish_request,json=publishRequest,proto3,oneof"` // serialized pubsub.v1.PublishRequest
 }
 
+type Payload_DeleteTaskRequest struct {
+	DeleteTaskRequest []byte `protobuf:"bytes,22,opt,name=delete_task_request,json=deleteTaskRequest,proto3,oneof"` // serialized cloud.tasks.v2.DeleteTaskRequest
+}
+
 func (*Payload_CreateTaskRequest) isPayload_Payload() {}
 
 func (*Payload_PublishRequest) isPayload_Payload() {}
 
+func (*Payload_DeleteTaskRequest) isPayload_Payload() {}
+
 var File_go_chromium_org_luci_server_tq_internal_tqpb_payload_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_server_tq_internal_tqpb_payload_proto_rawDesc = string([]byte{
@@ -151,7 +167,7 @@ var file_go_chromium_org_luci_server_tq_internal_tqpb_payload_proto_rawDesc = st
 	0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x74, 0x71, 0x2e, 0x69, 0x6e, 0x74,
 	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f,
 	0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x43, 0x6c, 0x61, 0x73,
 	0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
@@ -163,11 +179,14 @@ var file_go_chromium_org_luci_server_tq_internal_tqpb_payload_proto_rawDesc = st
 	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x0f, 0x70, 0x75, 0x62,
 	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01,
 	0x28, 0x0c, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42,
-	0x2e, 0x5a, 0x2c, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f,
-	0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x74,
-	0x71, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x71, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d,
+	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x74, 0x71, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x71, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -204,6 +223,7 @@ func file_go_chromium_org_luci_server_tq_internal_tqpb_payload_proto_init() {
 	file_go_chromium_org_luci_server_tq_internal_tqpb_payload_proto_msgTypes[0].OneofWrappers = []any{
 		(*Payload_CreateTaskRequest)(nil),
 		(*Payload_PublishRequest)(nil),
+		(*Payload_DeleteTaskRequest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
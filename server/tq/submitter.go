@@ -46,7 +46,9 @@ type Submitter interface {
 	// Submit submits a task, returning a gRPC status.
 	//
 	// AlreadyExists status indicates the task with request name already exists.
-	// Other statuses are handled using their usual semantics.
+	// NotFound status returned for a cancellation request indicates the task
+	// is not pending anymore (or never existed). Other statuses are handled using
+	// their usual semantics.
 	//
 	// Will be called from multiple goroutines at once.
 	Submit(ctx context.Context, p *reminder.Payload) error
@@ -90,13 +92,15 @@ func (s *CloudSubmitter) Close() {
 	s.pubsub.Close()
 }
 
-// Submit creates a task, returning a gRPC status.
+// Submit creates or deletes a task, returning a gRPC status.
 func (s *CloudSubmitter) Submit(ctx context.Context, p *reminder.Payload) (err error) {
 	switch {
 	case p.CreateTaskRequest != nil:
 		_, err = s.tasks.CreateTask(ctx, p.CreateTaskRequest)
 	case p.PublishRequest != nil:
 		_, err = s.pubsub.Publish(ctx, p.PublishRequest)
+	case p.DeleteTaskRequest != nil:
+		err = s.tasks.DeleteTask(ctx, p.DeleteTaskRequest)
 	default:
 		err = status.Errorf(codes.Internal, "unrecognized payload kind")
 	}
@@ -111,7 +111,8 @@ type Task struct {
 	Attempts  int       // 0 initially, incremented before each execution attempt
 	Executing bool      // true if executing right now
 
-	index int // index in tasksHeap
+	index   int  // index in tasksHeap
+	deleted bool // true if the task was deleted while executing
 }
 
 // Copy makes a shallow copy of the task.
@@ -209,7 +210,15 @@ type Executor interface {
 }
 
 // Submit schedules a task for later execution.
+//
+// Also handles task deletion requests: a pending task is removed from the
+// schedule, an executing task won't be retried. Returns NotFound if there's no
+// such pending or executing task. The name of a deleted task can't be reused.
 func (s *Scheduler) Submit(ctx context.Context, p *reminder.Payload) error {
+	if p.DeleteTaskRequest != nil {
+		return s.deleteTask(ctx, p.DeleteTaskRequest)
+	}
+
 	// Validate the request and transform it into *Task. Note that this validation
 	// is pretty sloppy. It validates only things Scheduler depends on. It doesn't
 	// validate full conformance to Cloud APIs.
@@ -256,6 +265,33 @@ func (s *Scheduler) Submit(ctx context.Context, p *reminder.Payload) error {
 	return nil
 }
 
+// deleteTask removes a task from the schedule.
+func (s *Scheduler) deleteTask(ctx context.Context, req *taskspb.DeleteTaskRequest) error {
+	if req.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "no Name in the request")
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.checkClockLocked(ctx)
+
+	for _, task := range s.tasks {
+		if task.Name == req.Name {
+			heap.Remove(&s.tasks, task.index)
+			s.wakeUpLocked()
+			return nil
+		}
+	}
+	for task := range s.executing {
+		if task.Name == req.Name && !task.deleted {
+			task.deleted = true
+			return nil
+		}
+	}
+	return status.Errorf(codes.NotFound, "task %q is not found", req.Name)
+}
+
 // prepCloudTasksTask makes *Task out of a Cloud Tasks request.
 func (s *Scheduler) prepCloudTasksTask(ctx context.Context, req *taskspb.CreateTaskRequest) (*Task, string, error) {
 	if req.Parent == "" {
@@ -451,7 +487,7 @@ func (s *Scheduler) tryDequeueTask(ctx context.Context, opts []RunOption) (stop
 		task.Finished = clock.Now(ctx)
 		delete(s.executing, task)
 
-		if retry {
+		if retry && !task.deleted {
 			if ok, delay := s.evalRetryLocked(task); ok {
 				task.ETA = clock.Now(ctx).Add(delay)
 				s.enqueueLocked(task)
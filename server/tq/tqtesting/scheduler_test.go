@@ -159,6 +159,41 @@ func TestScheduler(t *testing.T) {
 			assert.Loosely(t, capturedTask.Attempts, should.Equal(4))
 		})
 
+		deleteTask := func(name string) codes.Code {
+			return status.Code(sched.Submit(ctx, &reminder.Payload{
+				TaskClass: "default-task-class",
+				DeleteTaskRequest: &taskspb.DeleteTaskRequest{
+					Name: "projects/zzz/locations/zzz/queues/zzz/tasks/" + name,
+				},
+			}))
+		}
+
+		t.Run("Deleting pending tasks", func(t *ftt.Test) {
+			assert.Loosely(t, enqueue("1", "a", time.Time{}, ""), should.Equal(codes.OK))
+			assert.Loosely(t, enqueue("2", "b", time.Time{}, ""), should.Equal(codes.OK))
+
+			assert.Loosely(t, deleteTask("a"), should.Equal(codes.OK))
+			assert.Loosely(t, deleteTask("a"), should.Equal(codes.NotFound))
+			assert.Loosely(t, deleteTask("unknown"), should.Equal(codes.NotFound))
+
+			// The name of the deleted task can't be reused.
+			assert.Loosely(t, enqueue("3", "a", time.Time{}, ""), should.Equal(codes.AlreadyExists))
+
+			run(1)
+			assert.Loosely(t, payloads(exec.tasks), should.Match([]string{"2"}))
+		})
+
+		t.Run("Deleting executing tasks", func(t *ftt.Test) {
+			exec.execute = func(payload string, t *Task) bool {
+				deleteTask("a")
+				return false
+			}
+
+			enqueue(".", "a", time.Time{}, "")
+			run(1)
+			assert.Loosely(t, payloads(exec.tasks), should.HaveLength(1))
+		})
+
 		t.Run("Fails after multiple attempts", func(t *ftt.Test) {
 			sched.MaxAttempts = 10
 
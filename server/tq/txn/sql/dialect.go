@@ -0,0 +1,113 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dialect describes the flavor of SQL understood by the database.
+type Dialect int
+
+const (
+	// MySQL is MySQL or MariaDB.
+	MySQL Dialect = iota
+	// Postgres is PostgreSQL.
+	Postgres
+	// SQLite is SQLite.
+	SQLite
+)
+
+// String returns the name of the dialect.
+func (d Dialect) String() string {
+	switch d {
+	case MySQL:
+		return "mysql"
+	case Postgres:
+		return "postgres"
+	case SQLite:
+		return "sqlite"
+	default:
+		return fmt.Sprintf("Dialect(%d)", int(d))
+	}
+}
+
+// Schema returns DDL statements that create tables used by this package.
+//
+// Reminder IDs are compared as byte strings, so on MySQL they use a binary
+// collation. If you ever need to change this, change also the SQL queries.
+func (d Dialect) Schema() []string {
+	var id, blob string
+	switch d {
+	case MySQL:
+		id, blob = "VARCHAR(64) CHARACTER SET ascii COLLATE ascii_bin", "MEDIUMBLOB"
+	case Postgres:
+		id, blob = `VARCHAR(64) COLLATE "C"`, "BYTEA"
+	default:
+		id, blob = "VARCHAR(64)", "BLOB"
+	}
+	return []string{
+		`CREATE TABLE TQReminders (
+			ID ` + id + ` NOT NULL,
+			FreshUntil BIGINT NOT NULL,
+			Payload ` + blob + ` NOT NULL,
+			PRIMARY KEY (ID)
+		)`,
+		`CREATE TABLE TQLeases (
+			SectionID VARCHAR(255) NOT NULL,
+			LeaseID BIGINT NOT NULL,
+			SerializedParts TEXT NOT NULL,
+			ExpiresAt BIGINT NOT NULL,
+			PRIMARY KEY (SectionID, LeaseID)
+		)`,
+	}
+}
+
+// saveReminderQuery returns a query that inserts or overwrites a reminder.
+func (d Dialect) saveReminderQuery() string {
+	q := "INSERT INTO TQReminders (ID, FreshUntil, Payload) VALUES (?, ?, ?) "
+	if d == MySQL {
+		q += "ON DUPLICATE KEY UPDATE FreshUntil = VALUES(FreshUntil), Payload = VALUES(Payload)"
+	} else {
+		q += "ON CONFLICT (ID) DO UPDATE SET FreshUntil = excluded.FreshUntil, Payload = excluded.Payload"
+	}
+	return d.rebind(q)
+}
+
+// rebind converts a query with "?" placeholders into the dialect's syntax.
+func (d Dialect) rebind(q string) string {
+	if d != Postgres {
+		return q
+	}
+	var sb strings.Builder
+	n := 0
+	for _, r := range q {
+		if r == '?' {
+			n++
+			sb.WriteByte('$')
+			sb.WriteString(strconv.Itoa(n))
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// placeholders returns a comma-separated list of `n` "?" placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
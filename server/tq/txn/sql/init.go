@@ -0,0 +1,69 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sql contains Transactional Enqueue support for SQL databases
+// accessed through database/sql (e.g. MySQL, PostgreSQL or SQLite).
+//
+// Importing this package adds SQL transactions support to server/tq's AddTask.
+// Since the package name clashes with database/sql, it is usually imported
+// under an alias:
+//
+//	import tqsql "go.chromium.org/luci/server/tq/txn/sql"
+//
+// The database must be installed into the root server context via UseDB and
+// transactions that enqueue tasks must be started via RunInTransaction:
+//
+//	srv.Context = tqsql.UseDB(srv.Context, db, tqsql.Postgres)
+//	...
+//	err := tqsql.RunInTransaction(ctx, nil, func(ctx context.Context) error {
+//	  if _, err := tqsql.Tx(ctx).ExecContext(ctx, "UPDATE ..."); err != nil {
+//	    return err
+//	  }
+//	  return tq.AddTask(ctx, &tq.Task{...})
+//	})
+//
+// Reminders are stored in the "TQReminders" table and leases used by the
+// distributed sweeper are stored in the "TQLeases" table. Both must be created
+// prior to using this package, see Dialect's Schema.
+package sql
+
+import (
+	"context"
+
+	"go.chromium.org/luci/server/tq/internal/db"
+	"go.chromium.org/luci/server/tq/internal/lessor"
+)
+
+var impl sqlDB
+
+func init() {
+	db.Register(db.Impl{
+		Kind: impl.Kind(),
+		ProbeForTxn: func(ctx context.Context) db.DB {
+			if currentTxn(ctx) != nil {
+				return impl
+			}
+			return nil
+		},
+		NonTxn: func(ctx context.Context) db.DB {
+			return impl
+		},
+	})
+}
+
+func init() {
+	lessor.Register("sql", func(context.Context) (lessor.Lessor, error) {
+		return &sqlLessor{}, nil
+	})
+}
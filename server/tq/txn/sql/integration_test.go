@@ -0,0 +1,196 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package sql
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/internal/reminder"
+	"go.chromium.org/luci/server/tq/tqtesting"
+)
+
+func TestDistributedSweeping(t *testing.T) {
+	t.Parallel()
+
+	RunTest(t, func(disp *tq.Dispatcher) tq.Sweeper {
+		// Use smaller sweep tasks to hit more edge cases.
+		return tq.NewDistributedSweeper(disp, tq.DistributedSweeperOptions{
+			SweepShards:         4,
+			TasksPerScan:        10,
+			SecondaryScanShards: 4,
+		})
+	})
+}
+
+func TestInProcSweeping(t *testing.T) {
+	t.Parallel()
+
+	RunTest(t, func(disp *tq.Dispatcher) tq.Sweeper {
+		// Use smaller sweep tasks to hit more edge cases.
+		return tq.NewInProcSweeper(tq.InProcSweeperOptions{
+			SweepShards:             4,
+			TasksPerScan:            10,
+			SecondaryScanShards:     4,
+			SubmitBatchSize:         4,
+			SubmitConcurrentBatches: 2,
+		})
+	})
+}
+
+// RunTest ensures that transactionally submitted tasks eventually execute,
+// and only once, even if Cloud Tasks RPCs fail with high chance.
+func RunTest(t *testing.T, sweeper func(*tq.Dispatcher) tq.Sweeper) {
+	var epoch = testclock.TestRecentTimeUTC
+	const sweepSleep = "sweep sleep"
+
+	ctx, tc := testclock.UseTime(testContext(t), epoch)
+	tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+		if testclock.HasTags(t, tqtesting.ClockTag) {
+			panic("there should be no task retries, they all should fail fatally")
+		}
+		if testclock.HasTags(t, sweepSleep) {
+			tc.Add(d)
+		}
+	})
+
+	cfg, err := currentConfig(ctx)
+	assert.Loosely(t, err, should.BeNil)
+	_, err = cfg.db.ExecContext(ctx, "CREATE TABLE TestEntities (ID BIGINT NOT NULL PRIMARY KEY)")
+	assert.Loosely(t, err, should.BeNil)
+
+	disp := &tq.Dispatcher{}
+	ctx, sched := tq.TestingContext(ctx, disp)
+	disp.Sweeper = sweeper(disp)
+
+	// "Buganize" the submitter.
+	ctx = tq.UseSubmitter(ctx, &flakySubmitter{
+		Submitter:              sched,
+		InternalErrProbability: 0.3,
+		Rand:                   rand.New(rand.NewSource(123)),
+	})
+
+	// This will collect which tasks were executed and how many times.
+	mu := sync.Mutex{}
+	execed := map[int]int{}
+
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "work",
+		Prototype: &durationpb.Duration{}, // use it just as int container
+		Kind:      tq.Transactional,
+		Queue:     "default",
+		Handler: func(ctx context.Context, msg proto.Message) error {
+			d := msg.(*durationpb.Duration)
+			mu.Lock()
+			execed[int(d.Seconds)]++
+			mu.Unlock()
+			return nil
+		},
+	})
+
+	// Run a bunch of transactions that each add a row and submit a task. Every
+	// third transaction is rolled back, its task must not be executed.
+	landed := 0
+	for i := 1; i <= 100; i++ {
+		err := RunInTransaction(ctx, nil, func(ctx context.Context) error {
+			task := &tq.Task{Payload: &durationpb.Duration{Seconds: int64(i)}}
+			if err := disp.AddTask(ctx, task); err != nil {
+				return err
+			}
+			if _, err := Tx(ctx).ExecContext(ctx, "INSERT INTO TestEntities (ID) VALUES (?)", i); err != nil {
+				return err
+			}
+			if i%3 == 0 {
+				return errors.New("rollback")
+			}
+			return nil
+		})
+		if err == nil {
+			landed++
+		}
+	}
+	assert.Loosely(t, landed, should.Equal(67))
+
+	// Run rounds of sweeps until there are no reminders left or we appear to
+	// be stuck.
+	for {
+		var reminders int
+		if err := cfg.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM TQReminders").Scan(&reminders); err != nil {
+			panic(err)
+		}
+		if reminders == 0 && len(sched.Tasks()) == 0 {
+			break // no pending tasks and no reminders in the database, we are done
+		}
+		if clock.Now(ctx).Sub(epoch) >= 120*time.Minute {
+			panic("Looks like the test is stuck")
+		}
+
+		// Submit a bunch of sweep tasks and wait until they (and all their
+		// follow ups) are done.
+		disp.Sweep(ctx)
+		sched.Run(ctx, tqtesting.StopWhenDrained())
+
+		// Launch the next sweep a bit later. It is needed because we use "real"
+		// time when checking freshness of reminders.
+		clock.Sleep(clock.Tag(ctx, sweepSleep), time.Minute)
+	}
+
+	// All transactionally submitted tasks should have been executed.
+	assert.Loosely(t, len(execed), should.Equal(landed))
+	// And at most once.
+	for k, v := range execed {
+		if k%3 == 0 {
+			t.Errorf("task %d from a rolled back transaction was executed", k)
+		}
+		if v != 1 {
+			t.Errorf("task %d executed %d times", k, v)
+		}
+	}
+}
+
+type flakySubmitter struct {
+	Submitter              tq.Submitter
+	Rand                   *rand.Rand
+	InternalErrProbability float64
+
+	m sync.Mutex
+}
+
+func (f *flakySubmitter) Submit(ctx context.Context, req *reminder.Payload) error {
+	f.m.Lock()
+	fail := f.Rand.Float64() < f.InternalErrProbability
+	f.m.Unlock()
+	if fail {
+		return status.Errorf(codes.Internal, "Simulated internal error")
+	}
+	return f.Submitter.Submit(ctx, req)
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/tq/internal/lessor"
+	"go.chromium.org/luci/server/tq/internal/partition"
+)
+
+type sqlLessor struct {
+}
+
+func (l *sqlLessor) WithLease(ctx context.Context, sectionID string, part *partition.Partition, dur time.Duration, clbk lessor.WithLeaseCB) error {
+	expiresAt := clock.Now(ctx).Add(dur)
+	if d, ok := ctx.Deadline(); ok && expiresAt.After(d) {
+		expiresAt = d
+	}
+
+	lease, err := l.acquire(ctx, sectionID, part, expiresAt)
+	if err != nil {
+		return err
+	}
+	defer lease.remove(ctx) // failure to remove is logged & ignored.
+
+	lctx, cancel := clock.WithDeadline(ctx, lease.ExpiresAt)
+	defer cancel()
+	clbk(lctx, lease.parts)
+	return nil
+}
+
+func (*sqlLessor) acquire(ctx context.Context, sectionID string, desired *partition.Partition, expiresAt time.Time) (*lease, error) {
+	var acquired *lease
+	deletedExpired := 0
+
+	err := withTxn(ctx, func(tx *sql.Tx, d Dialect) error {
+		all, err := loadAll(ctx, tx, d, sectionID)
+		if err != nil {
+			return errors.Annotate(err, "failed to read leases").Err()
+		}
+		active, expired := activeAndExpired(ctx, all)
+		if len(expired) > 0 {
+			// Deleting >= 1 lease every time a new one is created suffices to avoid
+			// accumulating garbage above O(active leases).
+			if len(expired) > 50 {
+				expired = expired[:50]
+			}
+			if err := remove(ctx, tx, d, expired); err != nil {
+				return errors.Annotate(err, "failed to delete expired leases").Err()
+			}
+			deletedExpired = len(expired)
+		}
+		parts, err := availableForLease(desired, active)
+		if err != nil {
+			return errors.Annotate(err, "failed to decode available leases").Err()
+		}
+		acquired, err = save(ctx, tx, d, sectionID, expiresAt, parts, maxLeaseID(all))
+		return err
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to transact a lease").Tag(transient.Tag).Err()
+	}
+	if deletedExpired > 0 {
+		// If this is logged frequently, something is wrong either with the leasing
+		// process or the lessees are holding to lease longer than they should.
+		logging.Warningf(ctx, "deleted %d expired leases", deletedExpired)
+	}
+	return acquired, nil
+}
+
+type lease struct {
+	SectionID       string
+	LeaseID         int64
+	SerializedParts []string
+	ExpiresAt       time.Time
+
+	// Set only when lease object is created in save().
+	parts partition.SortedPartitions
+}
+
+func save(ctx context.Context, tx *sql.Tx, d Dialect, sectionID string, expiresAt time.Time, parts partition.SortedPartitions, max int64) (*lease, error) {
+	if len(parts) == 0 {
+		return &lease{
+			ExpiresAt: expiresAt,
+			parts:     parts,
+		}, nil // no need to save noop lease.
+	}
+
+	l := &lease{
+		SectionID:       sectionID,
+		SerializedParts: make([]string, len(parts)),
+		ExpiresAt:       expiresAt.UTC().Truncate(time.Microsecond),
+		parts:           parts,
+	}
+	for i, p := range parts {
+		l.SerializedParts[i] = p.String()
+	}
+
+	// Strictly increase the leaseID until it reaches to math.MaxInt64 then
+	// go back and increase from 1 again.
+	if max < math.MaxInt64 {
+		l.LeaseID = max + 1
+	} else {
+		l.LeaseID = 1
+	}
+
+	_, err := tx.ExecContext(ctx,
+		d.rebind("INSERT INTO TQLeases (SectionID, LeaseID, SerializedParts, ExpiresAt) VALUES (?, ?, ?, ?)"),
+		l.SectionID, l.LeaseID, strings.Join(l.SerializedParts, ","), l.ExpiresAt.UnixMicro())
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *lease) remove(ctx context.Context) {
+	if l.LeaseID == 0 {
+		return
+	}
+
+	err := withTxn(ctx, func(tx *sql.Tx, d Dialect) error {
+		return remove(ctx, tx, d, []*lease{l})
+	})
+	if err != nil {
+		// Log only. Once lease expires, it'll garbage-collected next time a new
+		// lease is acquired for the same sectionID.
+		logging.Warningf(ctx, "failed to remove lease %v: %s", l, err)
+	}
+}
+
+func loadAll(ctx context.Context, tx *sql.Tx, d Dialect, sectionID string) ([]*lease, error) {
+	rows, err := tx.QueryContext(ctx,
+		d.rebind("SELECT SectionID, LeaseID, SerializedParts, ExpiresAt FROM TQLeases WHERE SectionID = ?"),
+		sectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var all []*lease
+	for rows.Next() {
+		l := &lease{}
+		var parts string
+		var expiresAt int64
+		if err := rows.Scan(&l.SectionID, &l.LeaseID, &parts, &expiresAt); err != nil {
+			return nil, err
+		}
+		if parts != "" {
+			l.SerializedParts = strings.Split(parts, ",")
+		}
+		l.ExpiresAt = time.UnixMicro(expiresAt).UTC()
+		all = append(all, l)
+	}
+	return all, rows.Err()
+}
+
+func activeAndExpired(ctx context.Context, all []*lease) (active, expired []*lease) {
+	// Partition active leases in the front and expired at the end of the slice.
+	i, j := 0, len(all)
+	now := clock.Now(ctx)
+	for i < j {
+		if all[i].ExpiresAt.After(now) {
+			i++
+			continue
+		}
+		j--
+		all[i], all[j] = all[j], all[i]
+	}
+	return all[:i], all[i:]
+}
+
+func maxLeaseID(all []*lease) int64 {
+	var max int64 = 0
+	for _, l := range all {
+		if l.LeaseID > max {
+			max = l.LeaseID
+		}
+	}
+	return max
+}
+
+func availableForLease(desired *partition.Partition, active []*lease) (partition.SortedPartitions, error) {
+	builder := partition.NewSortedPartitionsBuilder(desired)
+	// Exclude from desired all partitions under currently active leases.
+	for _, l := range active {
+		for _, s := range l.SerializedParts {
+			p, err := partition.FromString(s)
+			if err != nil {
+				return nil, err
+			}
+			builder.Exclude(p)
+			if builder.IsEmpty() {
+				break
+			}
+		}
+	}
+	return builder.Result(), nil
+}
+
+func remove(ctx context.Context, tx *sql.Tx, d Dialect, ls []*lease) error {
+	for _, l := range ls {
+		if l.LeaseID == 0 {
+			continue
+		}
+		_, err := tx.ExecContext(ctx,
+			d.rebind("DELETE FROM TQLeases WHERE SectionID = ? AND LeaseID = ?"),
+			l.SectionID, l.LeaseID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
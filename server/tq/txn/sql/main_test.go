@@ -0,0 +1,49 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/mattn/go-sqlite3"
+
+	"go.chromium.org/luci/common/testing/registry"
+)
+
+func TestMain(m *testing.M) {
+	registry.RegisterCmpOption(cmp.AllowUnexported(lease{}))
+	m.Run()
+}
+
+// testContext returns a context with a fresh SQLite database installed.
+func testContext(t testing.TB) context.Context {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "tq.db")+"?_busy_timeout=5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	for _, stmt := range SQLite.Schema() {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return UseDB(context.Background(), db, SQLite)
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/tq/internal/reminder"
+)
+
+type sqlDB struct{}
+
+func (sqlDB) Kind() string {
+	return "sql"
+}
+
+func (sqlDB) Defer(ctx context.Context, cb func(context.Context)) {
+	t := currentTxn(ctx)
+	if t == nil {
+		panic("not a transactional context")
+	}
+	t.m.Lock()
+	t.defers = append(t.defers, cb)
+	t.m.Unlock()
+}
+
+func (sqlDB) SaveReminder(ctx context.Context, r *reminder.Reminder) error {
+	c, d, err := conn(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = c.ExecContext(ctx, d.saveReminderQuery(), r.ID, r.FreshUntil.UnixMicro(), r.RawPayload)
+	if err != nil {
+		return errors.Annotate(err, "failed to persist the Reminder %s", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+func (sqlDB) DeleteReminder(ctx context.Context, r *reminder.Reminder) error {
+	c, d, err := conn(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = c.ExecContext(ctx, d.rebind("DELETE FROM TQReminders WHERE ID = ?"), r.ID)
+	if err != nil {
+		return errors.Annotate(err, "failed to delete the Reminder %s", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+func (sqlDB) FetchRemindersMeta(ctx context.Context, low string, high string, limit int) (res []*reminder.Reminder, err error) {
+	c, d, err := conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.QueryContext(ctx,
+		d.rebind("SELECT ID, FreshUntil FROM TQReminders WHERE ID >= ? AND ID < ? ORDER BY ID LIMIT ?"),
+		low, high, limit)
+	if err == nil {
+		defer rows.Close()
+		for rows.Next() {
+			r := &reminder.Reminder{}
+			var freshUntil int64
+			if err = rows.Scan(&r.ID, &freshUntil); err != nil {
+				break
+			}
+			r.FreshUntil = time.UnixMicro(freshUntil).UTC()
+			res = append(res, r)
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+	}
+	if err != nil && err != context.DeadlineExceeded {
+		err = errors.Annotate(err, "failed to fetch Reminder keys").Tag(transient.Tag).Err()
+	}
+	return
+}
+
+func (sqlDB) FetchReminderRawPayloads(ctx context.Context, batch []*reminder.Reminder) ([]*reminder.Reminder, error) {
+	if len(batch) == 0 {
+		return batch, nil
+	}
+	c, d, err := conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]any, len(batch))
+	for i, r := range batch {
+		ids[i] = r.ID
+	}
+	rows, err := c.QueryContext(ctx,
+		d.rebind("SELECT ID, FreshUntil, Payload FROM TQReminders WHERE ID IN ("+placeholders(len(ids))+")"),
+		ids...)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch Reminders").Tag(transient.Tag).Err()
+	}
+	defer rows.Close()
+
+	type row struct {
+		freshUntil int64
+		payload    []byte
+	}
+	found := make(map[string]row, len(batch))
+	for rows.Next() {
+		var id string
+		var r row
+		if err = rows.Scan(&id, &r.freshUntil, &r.payload); err != nil {
+			break
+		}
+		found[id] = r
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+
+	// Preserve the order of the batch, omitting missing reminders.
+	res := make([]*reminder.Reminder, 0, len(found))
+	for _, r := range batch {
+		if f, ok := found[r.ID]; ok {
+			r.FreshUntil = time.UnixMicro(f.freshUntil).UTC()
+			r.RawPayload = f.payload
+			res = append(res, r)
+		}
+	}
+	if err != nil {
+		return res, errors.Annotate(err, "failed to fetch Reminders").Tag(transient.Tag).Err()
+	}
+	return res, nil
+}
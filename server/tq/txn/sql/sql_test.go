@@ -0,0 +1,131 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/testing/ftt"
+	"go.chromium.org/luci/common/testing/truth/assert"
+	"go.chromium.org/luci/common/testing/truth/should"
+
+	"go.chromium.org/luci/server/tq/internal/db"
+	"go.chromium.org/luci/server/tq/internal/reminder"
+	"go.chromium.org/luci/server/tq/internal/testutil"
+)
+
+func TestAcceptance(t *testing.T) {
+	ctx := testContext(t)
+	if testing.Verbose() {
+		ctx = gologger.StdConfig.Use(ctx)
+		ctx = logging.SetLevel(ctx, logging.Debug)
+	}
+	testutil.RunDBAcceptance(ctx, &sqlDB{}, t)
+}
+
+func TestAcceptablePrecision(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("sql supports up to Microsecond precision", t, func(t *ftt.Test) {
+		assert.Loosely(t, reminder.FreshUntilPrecision, should.BeGreaterThanOrEqual(time.Microsecond))
+	})
+}
+
+func TestTransactions(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("With database", t, func(t *ftt.Test) {
+		ctx := testContext(t)
+
+		rem := &reminder.Reminder{
+			ID:         "0123456789abcdef",
+			FreshUntil: time.Unix(1442540000, 0).UTC(),
+			RawPayload: []byte("payload"),
+		}
+		stored := func() []*reminder.Reminder {
+			res, err := impl.FetchRemindersMeta(ctx, "0", "g", 100)
+			assert.Loosely(t, err, should.BeNil)
+			return res
+		}
+
+		t.Run("Registered", func(t *ftt.Test) {
+			assert.Loosely(t, db.TxnDB(ctx), should.BeNil)
+			err := RunInTransaction(ctx, nil, func(ctx context.Context) error {
+				assert.Loosely(t, db.TxnDB(ctx), should.Equal[db.DB](impl))
+				assert.Loosely(t, Tx(ctx), should.NotBeNil)
+				return nil
+			})
+			assert.Loosely(t, err, should.BeNil)
+		})
+
+		t.Run("Commit", func(t *ftt.Test) {
+			deferred := 0
+			err := RunInTransaction(ctx, nil, func(tctx context.Context) error {
+				assert.Loosely(t, impl.SaveReminder(tctx, rem), should.BeNil)
+				impl.Defer(tctx, func(ctx context.Context) {
+					assert.Loosely(t, Tx(ctx), should.BeNil)
+					deferred++
+				})
+				assert.Loosely(t, deferred, should.BeZero)
+				return nil
+			})
+			assert.Loosely(t, err, should.BeNil)
+			assert.Loosely(t, deferred, should.Equal(1))
+			assert.Loosely(t, stored(), should.HaveLength(1))
+		})
+
+		t.Run("Rollback", func(t *ftt.Test) {
+			deferred := 0
+			boom := errors.New("boom")
+			err := RunInTransaction(ctx, nil, func(tctx context.Context) error {
+				assert.Loosely(t, impl.SaveReminder(tctx, rem), should.BeNil)
+				impl.Defer(tctx, func(ctx context.Context) { deferred++ })
+				return boom
+			})
+			assert.Loosely(t, err, should.Equal(boom))
+			assert.Loosely(t, deferred, should.BeZero)
+			assert.Loosely(t, stored(), should.BeEmpty)
+		})
+
+		t.Run("Nested", func(t *ftt.Test) {
+			err := RunInTransaction(ctx, nil, func(ctx context.Context) error {
+				return RunInTransaction(ctx, nil, func(ctx context.Context) error { return nil })
+			})
+			assert.Loosely(t, err, should.ErrLike("nested transactions are not supported"))
+		})
+
+		t.Run("No database", func(t *ftt.Test) {
+			err := RunInTransaction(context.Background(), nil, func(ctx context.Context) error { return nil })
+			assert.Loosely(t, err, should.ErrLike("no SQL database in the context"))
+		})
+	})
+}
+
+func TestRebind(t *testing.T) {
+	t.Parallel()
+
+	ftt.Run("Works", t, func(t *ftt.Test) {
+		q := "SELECT a FROM t WHERE b = ? AND c IN (" + placeholders(2) + ")"
+		assert.Loosely(t, MySQL.rebind(q), should.Equal("SELECT a FROM t WHERE b = ? AND c IN (?, ?)"))
+		assert.Loosely(t, Postgres.rebind(q), should.Equal("SELECT a FROM t WHERE b = $1 AND c IN ($2, $3)"))
+	})
+}
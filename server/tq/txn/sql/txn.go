@@ -0,0 +1,165 @@
+// Copyright 2025 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+)
+
+var configKey = "go.chromium.org/luci/server/tq/txn/sql.config"
+var txnKey = "go.chromium.org/luci/server/tq/txn/sql.txn"
+
+// config is stored in the context by UseDB.
+type config struct {
+	db      *sql.DB
+	dialect Dialect
+}
+
+// txn is stored in the context by RunInTransaction.
+type txn struct {
+	cfg *config
+	tx  *sql.Tx
+
+	m      sync.Mutex
+	defers []func(context.Context)
+}
+
+// UseDB installs the database into the context.
+//
+// It is used to run transactions in RunInTransaction and by the sweeper to
+// enumerate reminders. In a server environment it should be installed into
+// the root server context.
+func UseDB(ctx context.Context, db *sql.DB, dialect Dialect) context.Context {
+	return context.WithValue(ctx, &configKey, &config{db: db, dialect: dialect})
+}
+
+// RunInTransaction runs the callback inside a database transaction.
+//
+// The context passed to the callback carries the transaction. It can be
+// obtained via Tx to run queries within the transaction. Tasks added via
+// tq.AddTask with this context are enqueued if and only if the transaction
+// commits.
+//
+// The transaction is committed if the callback returns nil and rolled back
+// otherwise. Unlike Datastore and Spanner transactions, failed transactions are
+// not retried: whether it is safe to retry depends on the database and the
+// isolation level, see `opts`.
+//
+// Nested transactions are not supported.
+func RunInTransaction(ctx context.Context, opts *sql.TxOptions, cb func(ctx context.Context) error) error {
+	if currentTxn(ctx) != nil {
+		return errors.New("nested transactions are not supported")
+	}
+	cfg, err := currentConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := cfg.db.BeginTx(ctx, opts)
+	if err != nil {
+		return errors.Annotate(err, "failed to begin a transaction").Tag(transient.Tag).Err()
+	}
+	t := &txn{cfg: cfg, tx: tx}
+
+	done := false
+	defer func() {
+		if !done {
+			if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+				logging.Warningf(ctx, "Failed to rollback the transaction: %s", err)
+			}
+		}
+	}()
+
+	if err := cb(context.WithValue(ctx, &txnKey, t)); err != nil {
+		return err
+	}
+	done = true
+	if err := tx.Commit(); err != nil {
+		return errors.Annotate(err, "failed to commit the transaction").Err()
+	}
+
+	t.m.Lock()
+	defers := t.defers
+	t.defers = nil
+	t.m.Unlock()
+	for _, cb := range defers {
+		cb(ctx)
+	}
+	return nil
+}
+
+// Tx returns the current transaction or nil if not in a transaction.
+func Tx(ctx context.Context) *sql.Tx {
+	if t := currentTxn(ctx); t != nil {
+		return t.tx
+	}
+	return nil
+}
+
+func currentTxn(ctx context.Context) *txn {
+	t, _ := ctx.Value(&txnKey).(*txn)
+	return t
+}
+
+func currentConfig(ctx context.Context) (*config, error) {
+	if cfg, _ := ctx.Value(&configKey).(*config); cfg != nil {
+		return cfg, nil
+	}
+	return nil, errors.New("no SQL database in the context, see UseDB")
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// conn returns the current transaction, if any, or the database.
+func conn(ctx context.Context) (execer, Dialect, error) {
+	if t := currentTxn(ctx); t != nil {
+		return t.tx, t.cfg.dialect, nil
+	}
+	cfg, err := currentConfig(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return cfg.db, cfg.dialect, nil
+}
+
+// withTxn runs the callback in a new serializable transaction.
+//
+// Used internally when the transaction doesn't need to be exposed through
+// the context.
+func withTxn(ctx context.Context, cb func(tx *sql.Tx, d Dialect) error) error {
+	cfg, err := currentConfig(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := cfg.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+	if err := cb(tx, cfg.dialect); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}